@@ -0,0 +1,56 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/pflag"
+
+	"kubevirt.io/kubevirt/pkg/network/ifacenaming"
+)
+
+// guest-iface-naming runs inside the guest. It reads the interface naming
+// hints published by the network binding plugins through the SMBIOS OEM
+// strings and renders udev rules naming the guest interfaces after the VMI
+// interfaces.
+func main() {
+	var entriesDir, output string
+	pflag.StringVar(&entriesDir, "dmi-entries-dir", ifacenaming.DMIEntriesDir, "directory exposing the raw SMBIOS structures")
+	pflag.StringVar(&output, "output", "/etc/udev/rules.d/70-kubevirt-net-names.rules", "udev rules file to write, - for stdout")
+	pflag.Parse()
+
+	hints, err := ifacenaming.ReadHints(entriesDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to read interface naming hints: %v\n", err)
+		os.Exit(1)
+	}
+
+	rules := ifacenaming.UdevRules(hints)
+	if output == "-" {
+		fmt.Print(rules)
+		return
+	}
+	if err := os.WriteFile(output, []byte(rules), 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to write udev rules: %v\n", err)
+		os.Exit(1)
+	}
+}
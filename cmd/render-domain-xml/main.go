@@ -0,0 +1,102 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/yaml"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/golden"
+)
+
+// render-domain-xml renders the libvirt domain XML virt-launcher would define
+// for a VMI, without starting QEMU, and optionally compares it against a
+// golden file. Network binding plugin and hook sidecar authors can run it in
+// their pipelines to catch changes in the domain they receive.
+func main() {
+	var vmiFile, goldenFile, arch, hypervisor, ovmfPath string
+	var update bool
+	var networkBindings map[string]string
+	pflag.StringVar(&vmiFile, "vmi", "", "YAML or JSON file holding the VirtualMachineInstance to render")
+	pflag.StringVar(&goldenFile, "golden", "", "golden file to compare the rendered domain XML against")
+	pflag.BoolVar(&update, "update", false, "write the rendered domain XML to the golden file instead of comparing it")
+	pflag.StringVar(&arch, "arch", "", "architecture to render the domain for, defaults to the VMI one")
+	pflag.StringVar(&hypervisor, "hypervisor", v1.KvmHypervisorName, "hypervisor to render the domain for")
+	pflag.StringVar(&ovmfPath, "ovmf-path", "/usr/share/OVMF", "directory the EFI roms are expected in")
+	pflag.StringToStringVar(&networkBindings, "network-binding", nil, "network binding plugins and their domain attachment type, e.g. mybinding=tap")
+	pflag.Parse()
+
+	if vmiFile == "" {
+		exitWithError(fmt.Errorf("--vmi is required"))
+	}
+	if update && goldenFile == "" {
+		exitWithError(fmt.Errorf("--update requires --golden"))
+	}
+
+	vmi, err := readVMI(vmiFile)
+	if err != nil {
+		exitWithError(err)
+	}
+
+	opts := golden.Options{
+		Architecture:    arch,
+		Hypervisor:      hypervisor,
+		OVMFPath:        ovmfPath,
+		NetworkBindings: map[string]v1.InterfaceBindingPlugin{},
+	}
+	for name, domainAttachmentType := range networkBindings {
+		opts.NetworkBindings[name] = v1.InterfaceBindingPlugin{DomainAttachmentType: v1.DomainAttachmentType(domainAttachmentType)}
+	}
+
+	domainXML, err := golden.RenderDomainXML(vmi, opts)
+	if err != nil {
+		exitWithError(err)
+	}
+
+	if goldenFile == "" {
+		fmt.Print(string(domainXML))
+		return
+	}
+	if err := golden.CompareWithGoldenFile(domainXML, goldenFile, update); err != nil {
+		exitWithError(err)
+	}
+}
+
+func readVMI(path string) (*v1.VirtualMachineInstance, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the VMI: %v", err)
+	}
+	vmi := &v1.VirtualMachineInstance{}
+	if err := yaml.Unmarshal(data, vmi); err != nil {
+		return nil, fmt.Errorf("failed to decode the VMI: %v", err)
+	}
+	return vmi, nil
+}
+
+func exitWithError(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
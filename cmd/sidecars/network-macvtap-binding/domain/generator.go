@@ -0,0 +1,80 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package domain
+
+import (
+	"context"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/bindingplugin/errcode"
+	"kubevirt.io/kubevirt/pkg/network/bindingplugin/sdk"
+	"kubevirt.io/kubevirt/pkg/network/namescheme"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// MacvtapPluginName macvtap binding plugin name should be registered to Kubevirt through Kubevirt CR
+const MacvtapPluginName = "macvtap"
+
+// GenerateInterface generates an ethernet domain interface, backed by the macvtap device
+// created in the pod by the macvtap CNI for the interface network.
+func GenerateInterface(_ context.Context, vmi *v1.VirtualMachineInstance, iface sdk.Interface) (*api.Interface, error) {
+	// The guest MAC address must match the macvtap device one, otherwise its traffic is dropped.
+	mac := iface.Spec.MacAddress
+	if mac == "" {
+		mac = iface.Mac
+	}
+	if mac == "" {
+		return nil, errcode.Errorf(errcode.DeviceMissing,
+			"MAC address of the macvtap device of interface %q is not reported in the network-info", iface.Spec.Name)
+	}
+
+	address, err := sdk.InterfaceAddress(vmi, iface.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	var acpi *api.ACPI
+	if acpiIndex := iface.Spec.ACPIIndex; acpiIndex > 0 {
+		acpi = &api.ACPI{Index: uint(acpiIndex)}
+	}
+
+	const (
+		ifaceTypeEthernet = "ethernet"
+		// The macvtap device is created by the CNI, libvirt should not create nor remove it
+		unmanagedTarget = "no"
+	)
+	return &api.Interface{
+		Alias:   api.NewUserDefinedAlias(iface.Spec.Name),
+		Type:    ifaceTypeEthernet,
+		Target:  &api.InterfaceTarget{Device: podInterfaceName(vmi, iface), Managed: unmanagedTarget},
+		Model:   &api.Model{Type: iface.Model},
+		MAC:     &api.MAC{MAC: mac},
+		Address: address,
+		ACPI:    acpi,
+	}, nil
+}
+
+func podInterfaceName(vmi *v1.VirtualMachineInstance, iface sdk.Interface) string {
+	if iface.Status != nil && iface.Status.PodInterfaceName != "" {
+		return iface.Status.PodInterfaceName
+	}
+	return namescheme.HashedPodInterfaceName(iface.Network, vmi.Status.Interfaces)
+}
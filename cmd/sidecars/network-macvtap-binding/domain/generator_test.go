@@ -0,0 +1,107 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package domain_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/bindingplugin/errcode"
+	"kubevirt.io/kubevirt/pkg/network/bindingplugin/sdk"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+
+	"kubevirt.io/kubevirt/cmd/sidecars/network-macvtap-binding/domain"
+)
+
+var _ = Describe("macvtap domain interface", func() {
+	const (
+		networkName  = "blue"
+		reportedMac  = "02:00:00:00:00:01"
+		podIfaceName = "pod16477688c0e"
+	)
+
+	var (
+		vmi   *v1.VirtualMachineInstance
+		iface sdk.Interface
+	)
+
+	BeforeEach(func() {
+		vmi = &v1.VirtualMachineInstance{Spec: v1.VirtualMachineInstanceSpec{Architecture: "amd64"}}
+		iface = sdk.Interface{
+			Spec: v1.Interface{Name: networkName, Binding: &v1.PluginBinding{Name: domain.MacvtapPluginName}},
+			Network: v1.Network{
+				Name:          networkName,
+				NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "macvtap-net"}},
+			},
+			Model:  "virtio-non-transitional",
+			Status: &v1.VirtualMachineInstanceNetworkInterface{Name: networkName, PodInterfaceName: podIfaceName},
+			Mac:    reportedMac,
+		}
+	})
+
+	It("should generate an ethernet interface targeting the macvtap device", func() {
+		domainIface, err := domain.GenerateInterface(context.Background(), vmi, iface)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(domainIface).To(Equal(&api.Interface{
+			Alias:  api.NewUserDefinedAlias(networkName),
+			Type:   "ethernet",
+			Target: &api.InterfaceTarget{Device: podIfaceName, Managed: "no"},
+			Model:  &api.Model{Type: "virtio-non-transitional"},
+			MAC:    &api.MAC{MAC: reportedMac},
+		}))
+	})
+
+	It("should target the hashed pod interface name when the interface status is not reported yet", func() {
+		iface.Status = nil
+		domainIface, err := domain.GenerateInterface(context.Background(), vmi, iface)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(domainIface.Target.Device).To(Equal(podIfaceName))
+	})
+
+	It("should prefer the MAC address set in the interface spec", func() {
+		const specMac = "02:00:00:00:00:02"
+		iface.Spec.MacAddress = specMac
+		domainIface, err := domain.GenerateInterface(context.Background(), vmi, iface)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(domainIface.MAC.MAC).To(Equal(specMac))
+	})
+
+	It("should fail when the MAC address of the macvtap device is not reported", func() {
+		iface.Mac = ""
+		_, err := domain.GenerateInterface(context.Background(), vmi, iface)
+		Expect(err).To(MatchError(ContainSubstring(`MAC address of the macvtap device of interface "blue" is not reported`)))
+		code, _ := errcode.CodeOf(err)
+		Expect(code).To(Equal(errcode.DeviceMissing))
+	})
+
+	It("should set the PCI address and ACPI index of the interface", func() {
+		iface.Spec.PciAddress = "0000:81:01.0"
+		iface.Spec.ACPIIndex = 2
+		domainIface, err := domain.GenerateInterface(context.Background(), vmi, iface)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(domainIface.Address.Type).To(Equal("pci"))
+		Expect(domainIface.Address.Bus).To(Equal("0x81"))
+		Expect(domainIface.ACPI).To(Equal(&api.ACPI{Index: 2}))
+	})
+})
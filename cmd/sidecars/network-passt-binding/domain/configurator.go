@@ -29,7 +29,9 @@ import (
 
 	"kubevirt.io/client-go/log"
 
+	"kubevirt.io/kubevirt/pkg/network/ifacenaming"
 	"kubevirt.io/kubevirt/pkg/network/istio"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/virtio"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device"
 
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
@@ -38,6 +40,9 @@ import (
 type NetworkConfiguratorOptions struct {
 	IstioProxyInjectionEnabled bool
 	UseVirtioTransitional      bool
+	GuestInterfaceNamesEnabled bool
+	// Architecture of the VMI, selecting the virtio model valid for it
+	Architecture string
 }
 
 type PasstNetworkConfigurator struct {
@@ -113,6 +118,9 @@ func (p PasstNetworkConfigurator) Mutate(domainSpec *domainschema.DomainSpec) (*
 	} else {
 		domainSpecCopy.Devices.Interfaces = append(domainSpecCopy.Devices.Interfaces, *generatedIface)
 	}
+	if err := device.ValidatePCIAddresses(domainSpecCopy); err != nil {
+		return nil, fmt.Errorf("failed to add the passt interface to the domain spec: %v", err)
+	}
 
 	if domainSpecCopy.MemoryBacking == nil {
 		domainSpecCopy.MemoryBacking = &domainschema.MemoryBacking{
@@ -124,6 +132,13 @@ func (p PasstNetworkConfigurator) Mutate(domainSpec *domainschema.DomainSpec) (*
 			},
 		}
 	}
+	if p.options.GuestInterfaceNamesEnabled {
+		if hint, ok := ifacenaming.HintForDomainInterface(*generatedIface); ok {
+			ifacenaming.AddOEMStrings(domainSpecCopy, hint)
+		} else {
+			log.Log.Warningf("interface %q has neither PCI nor MAC address, guest interface name hint is not added", p.vmiSpecIface.Name)
+		}
+	}
 	log.Log.Infof("passt interface is added to domain spec successfully: %+v", generatedIface)
 
 	return domainSpecCopy, nil
@@ -158,11 +173,7 @@ func (p PasstNetworkConfigurator) generateInterface() (*domainschema.Interface,
 
 	var ifaceModelType string
 	if ifaceModel == vmschema.VirtIO {
-		if p.options.UseVirtioTransitional {
-			ifaceModelType = "virtio-transitional"
-		} else {
-			ifaceModelType = "virtio-non-transitional"
-		}
+		ifaceModelType = virtio.InterpretTransitionalModelType(&p.options.UseVirtioTransitional, p.options.Architecture)
 	} else {
 		ifaceModelType = p.vmiSpecIface.Model
 	}
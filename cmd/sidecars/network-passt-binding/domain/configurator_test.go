@@ -101,6 +101,34 @@ var _ = Describe("pod network configurator", func() {
 			Expect(err).To(HaveOccurred())
 		})
 
+		It("should fail given interface with PCI address used by another device", func() {
+			ifaces := []vmschema.Interface{{
+				Name: "default", Binding: &vmschema.PluginBinding{Name: "passt"},
+				PciAddress: "0000:02:02.0",
+			}}
+			networks := []vmschema.Network{*vmschema.DefaultPodNetwork()}
+			ifaceStatuses := []vmschema.VirtualMachineInstanceNetworkInterface{{Name: "default", PodInterfaceName: defaultPrimaryPodIfaceName}}
+
+			testMutator, err := domain.NewPasstNetworkConfigurator(
+				ifaces,
+				networks,
+				ifaceStatuses,
+				domain.NetworkConfiguratorOptions{},
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			testDomSpec := &domainschema.DomainSpec{
+				Devices: domainschema.Devices{
+					Disks: []domainschema.Disk{{
+						Target:  domainschema.DiskTarget{Bus: vmschema.DiskBusVirtio},
+						Address: &domainschema.Address{Type: domainschema.AddressPCI, Domain: "0x0000", Bus: "0x02", Slot: "0x02", Function: "0x0"},
+					}},
+				},
+			}
+			_, err = testMutator.Mutate(testDomSpec)
+			Expect(err).To(MatchError(ContainSubstring("PCI address 0000:02:02.0 is requested by more than one device")))
+		})
+
 		DescribeTable("should add interface to domain spec given iface with",
 			func(iface *vmschema.Interface, expectedDomainIface *domainschema.Interface) {
 				ifaces := []vmschema.Interface{*iface}
@@ -292,6 +320,17 @@ var _ = Describe("pod network configurator", func() {
 					Model:       &domainschema.Model{Type: "virtio-transitional"},
 				},
 			),
+			Entry("s390x architecture",
+				&domain.NetworkConfiguratorOptions{UseVirtioTransitional: true, Architecture: "s390x"},
+				&domainschema.Interface{
+					Alias:       domainschema.NewUserDefinedAlias("default"),
+					Type:        ifaceTypeVhostUser,
+					Source:      domainschema.InterfaceSource{Device: "eth0"},
+					Backend:     &domainschema.InterfaceBackend{Type: "passt", LogFile: domain.PasstLogFilePath},
+					PortForward: []domainschema.InterfacePortForward{{Proto: "tcp"}, {Proto: "udp"}},
+					Model:       &domainschema.Model{Type: "virtio"},
+				},
+			),
 			Entry("isitio proxy injection enabled",
 				&domain.NetworkConfiguratorOptions{IstioProxyInjectionEnabled: true},
 				&domainschema.Interface{
@@ -419,6 +458,63 @@ var _ = Describe("pod network configurator", func() {
 			Expect(testMutator.Mutate(mutatedDomSpec)).To(Equal(mutatedDomSpec))
 		})
 	})
+	Context("guest interface names", func() {
+		var (
+			networks      []vmschema.Network
+			ifaceStatuses []vmschema.VirtualMachineInstanceNetworkInterface
+		)
+
+		BeforeEach(func() {
+			networks = []vmschema.Network{*vmschema.DefaultPodNetwork()}
+			ifaceStatuses = []vmschema.VirtualMachineInstanceNetworkInterface{{Name: "default", PodInterfaceName: defaultPrimaryPodIfaceName}}
+		})
+
+		It("should publish the interface name hint when enabled", func() {
+			ifaces := []vmschema.Interface{{
+				Name:       "default",
+				Binding:    &vmschema.PluginBinding{Name: "passt"},
+				PciAddress: "0000:81:01.0",
+				MacAddress: "02:02:02:02:02:02",
+			}}
+			testMutator, err := domain.NewPasstNetworkConfigurator(
+				ifaces,
+				networks,
+				ifaceStatuses,
+				domain.NetworkConfiguratorOptions{GuestInterfaceNamesEnabled: true},
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			mutatedDomSpec, err := testMutator.Mutate(&domainschema.DomainSpec{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(mutatedDomSpec.OS.SMBios).To(Equal(&domainschema.SMBios{Mode: "sysinfo"}))
+			Expect(mutatedDomSpec.SysInfo).To(Equal(&domainschema.SysInfo{
+				Type: "smbios",
+				OEMStrings: &domainschema.OEMStrings{
+					Entries: []string{"kubevirt.io/interface:name=default,pci=0000:81:01.0,mac=02:02:02:02:02:02"},
+				},
+			}))
+		})
+
+		It("should not publish the interface name hint when disabled", func() {
+			ifaces := []vmschema.Interface{{
+				Name:       "default",
+				Binding:    &vmschema.PluginBinding{Name: "passt"},
+				MacAddress: "02:02:02:02:02:02",
+			}}
+			testMutator, err := domain.NewPasstNetworkConfigurator(
+				ifaces,
+				networks,
+				ifaceStatuses,
+				domain.NetworkConfiguratorOptions{},
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			mutatedDomSpec, err := testMutator.Mutate(&domainschema.DomainSpec{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(mutatedDomSpec.SysInfo).To(BeNil())
+			Expect(mutatedDomSpec.OS.SMBios).To(BeNil())
+		})
+	})
 	Context("should define memoryBacking for vhost-user", func() {
 		var testMutator *domain.PasstNetworkConfigurator
 		BeforeEach(func() {
@@ -40,6 +40,8 @@ import (
 
 	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
 	hooksV1alpha3 "kubevirt.io/kubevirt/pkg/hooks/v1alpha3"
+	"kubevirt.io/kubevirt/pkg/network/bindingplugin/sdk"
+	"kubevirt.io/kubevirt/pkg/network/ifacenaming"
 )
 
 type InfoServer struct {
@@ -89,6 +91,8 @@ func (s V1alpha3Server) OnDefineDomain(
 	opts := domain.NetworkConfiguratorOptions{
 		UseVirtioTransitional:      useVirtioTransitional,
 		IstioProxyInjectionEnabled: istioProxyInjectionEnabled,
+		GuestInterfaceNamesEnabled: ifacenaming.Enabled(vmi.GetAnnotations()),
+		Architecture:               sdk.Architecture(vmi),
 	}
 
 	passtConfigurator, err := domain.NewPasstNetworkConfigurator(
@@ -126,6 +130,22 @@ func (s V1alpha3Server) Shutdown(_ context.Context, _ *hooksV1alpha3.ShutdownPar
 	return &hooksV1alpha3.ShutdownResult{}, nil
 }
 
+// NegotiateMigration keeps the passt interfaces plugged, as the passt binding migrates them along with the domain
+func (s V1alpha3Server) NegotiateMigration(
+	_ context.Context,
+	_ *hooksV1alpha3.NegotiateMigrationParams,
+) (*hooksV1alpha3.NegotiateMigrationResult, error) {
+	return &hooksV1alpha3.NegotiateMigrationResult{}, nil
+}
+
+// CheckReadiness reports the passt interfaces as configured, as the passt binding has nothing to wait for
+func (s V1alpha3Server) CheckReadiness(
+	_ context.Context,
+	_ *hooksV1alpha3.CheckReadinessParams,
+) (*hooksV1alpha3.CheckReadinessResult, error) {
+	return &hooksV1alpha3.CheckReadinessResult{}, nil
+}
+
 func waitForShutdown(server *grpc.Server, errChan <-chan error, shutdownChan <-chan struct{}) {
 	// Handle signals to properly shutdown process
 	signalStopChan := make(chan os.Signal, 1)
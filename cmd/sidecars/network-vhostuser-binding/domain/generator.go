@@ -0,0 +1,131 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/network/bindingplugin/errcode"
+	"kubevirt.io/kubevirt/pkg/network/bindingplugin/sdk"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// VhostUserPluginName vhost-user binding plugin name should be registered to Kubevirt through Kubevirt CR
+const VhostUserPluginName = "vhostuser"
+
+const (
+	vhostUserModeClient = "client"
+	vhostUserModeServer = "server"
+
+	sharedMemoryBackingAccessMode = "shared"
+	memfdMemoryBackingSourceType  = "memfd"
+)
+
+// GenerateInterface generates a vhostuser domain interface, connected to the vhost-user socket
+// the network reports in the device info of the interface.
+func GenerateInterface(_ context.Context, vmi *v1.VirtualMachineInstance, iface sdk.Interface) (*api.Interface, error) {
+	if iface.Spec.Model != "" && iface.Spec.Model != v1.VirtIO {
+		return nil, errcode.Errorf(errcode.UnsupportedModel,
+			"interface %q model %q is not supported, vhost-user requires a virtio interface", iface.Spec.Name, iface.Spec.Model)
+	}
+
+	if iface.DeviceInfo == nil || iface.DeviceInfo.VhostUser == nil || iface.DeviceInfo.VhostUser.Path == "" {
+		return nil, errcode.Errorf(errcode.DeviceMissing,
+			"vhost-user socket of interface %q is not reported in the network-info", iface.Spec.Name)
+	}
+	mode, err := qemuVhostUserMode(iface.DeviceInfo.VhostUser.Mode)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := sdk.InterfaceAddress(vmi, iface.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	var mac *api.MAC
+	if iface.Spec.MacAddress != "" {
+		mac = &api.MAC{MAC: iface.Spec.MacAddress}
+	} else if iface.Mac != "" {
+		mac = &api.MAC{MAC: iface.Mac}
+	}
+
+	var acpi *api.ACPI
+	if acpiIndex := iface.Spec.ACPIIndex; acpiIndex > 0 {
+		acpi = &api.ACPI{Index: uint(acpiIndex)}
+	}
+
+	const (
+		ifaceTypeVhostUser = "vhostuser"
+		socketTypeUnix     = "unix"
+	)
+	return &api.Interface{
+		Alias: api.NewUserDefinedAlias(iface.Spec.Name),
+		Type:  ifaceTypeVhostUser,
+		Source: api.InterfaceSource{
+			Type: socketTypeUnix,
+			Path: iface.DeviceInfo.VhostUser.Path,
+			Mode: mode,
+		},
+		Model:   &api.Model{Type: iface.Model},
+		MAC:     mac,
+		Address: address,
+		ACPI:    acpi,
+	}, nil
+}
+
+// MutateDomain shares the guest memory with the vhost-user backend. The hugepages requested by the VMI
+// back the shared memory, which DPDK datapaths expect, falling back to memfd when none are requested.
+func MutateDomain(_ context.Context, _ *v1.VirtualMachineInstance, domainSpec *api.DomainSpec) error {
+	if domainSpec.MemoryBacking == nil {
+		domainSpec.MemoryBacking = &api.MemoryBacking{}
+	}
+	memoryBacking := domainSpec.MemoryBacking
+
+	if memoryBacking.Access != nil && memoryBacking.Access.Mode != sharedMemoryBackingAccessMode {
+		return fmt.Errorf("memory backing access mode must be 'shared'; cannot override existing mode: %q",
+			memoryBacking.Access.Mode)
+	}
+	memoryBacking.Access = &api.MemoryBackingAccess{Mode: sharedMemoryBackingAccessMode}
+
+	if memoryBacking.HugePages == nil && memoryBacking.Source == nil {
+		log.Log.Warning("vhost-user guest memory is not backed by hugepages, DPDK datapaths may fail to map it")
+		memoryBacking.Source = &api.MemoryBackingSource{Type: memfdMemoryBackingSourceType}
+	}
+	return nil
+}
+
+// qemuVhostUserMode returns the mode of the QEMU end of the vhost-user socket. The device info reports
+// the mode of the network end, QEMU takes the opposite one.
+func qemuVhostUserMode(networkMode string) (string, error) {
+	switch networkMode {
+	case vhostUserModeClient:
+		return vhostUserModeServer, nil
+	case vhostUserModeServer:
+		return vhostUserModeClient, nil
+	default:
+		return "", errcode.Errorf(errcode.SchemaMismatch, "vhost-user mode %q is not supported", networkMode)
+	}
+}
@@ -0,0 +1,142 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package domain_test
+
+import (
+	"context"
+	"encoding/xml"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	networkv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/bindingplugin/errcode"
+	"kubevirt.io/kubevirt/pkg/network/bindingplugin/sdk"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+
+	"kubevirt.io/kubevirt/cmd/sidecars/network-vhostuser-binding/domain"
+)
+
+var _ = Describe("vhost-user domain", func() {
+	const (
+		networkName = "dpdk"
+		socketPath  = "/var/run/vhost-user/pod2c26b46b68f.sock"
+	)
+
+	var (
+		vmi   *v1.VirtualMachineInstance
+		iface sdk.Interface
+	)
+
+	BeforeEach(func() {
+		vmi = &v1.VirtualMachineInstance{Spec: v1.VirtualMachineInstanceSpec{Architecture: "amd64"}}
+		iface = sdk.Interface{
+			Spec:    v1.Interface{Name: networkName, Binding: &v1.PluginBinding{Name: domain.VhostUserPluginName}},
+			Network: v1.Network{Name: networkName},
+			Model:   "virtio-non-transitional",
+			DeviceInfo: &networkv1.DeviceInfo{
+				Type:      "vhost-user",
+				VhostUser: &networkv1.VhostDevice{Mode: "client", Path: socketPath},
+			},
+		}
+	})
+
+	Context("interface", func() {
+		It("should generate a vhostuser interface connected to the reported socket", func() {
+			domainIface, err := domain.GenerateInterface(context.Background(), vmi, iface)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(domainIface).To(Equal(&api.Interface{
+				Alias:  api.NewUserDefinedAlias(networkName),
+				Type:   "vhostuser",
+				Source: api.InterfaceSource{Type: "unix", Path: socketPath, Mode: "server"},
+				Model:  &api.Model{Type: "virtio-non-transitional"},
+			}))
+
+			ifaceXML, err := xml.Marshal(domainIface)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(ifaceXML)).To(ContainSubstring(`<source type="unix" path="` + socketPath + `" mode="server">`))
+		})
+
+		DescribeTable("should connect QEMU with the opposite mode of the network", func(networkMode, expectedMode string) {
+			iface.DeviceInfo.VhostUser.Mode = networkMode
+			domainIface, err := domain.GenerateInterface(context.Background(), vmi, iface)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(domainIface.Source.Mode).To(Equal(expectedMode))
+		},
+			Entry("network client", "client", "server"),
+			Entry("network server", "server", "client"),
+		)
+
+		It("should set the MAC address of the interface spec, or the reported one", func() {
+			iface.Mac = "02:00:00:00:00:01"
+			domainIface, err := domain.GenerateInterface(context.Background(), vmi, iface)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(domainIface.MAC).To(Equal(&api.MAC{MAC: "02:00:00:00:00:01"}))
+
+			iface.Spec.MacAddress = "02:00:00:00:00:02"
+			domainIface, err = domain.GenerateInterface(context.Background(), vmi, iface)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(domainIface.MAC).To(Equal(&api.MAC{MAC: "02:00:00:00:00:02"}))
+		})
+
+		DescribeTable("should fail", func(mutate func(*sdk.Interface), expectedCode errcode.Code) {
+			mutate(&iface)
+			_, err := domain.GenerateInterface(context.Background(), vmi, iface)
+			code, _ := errcode.CodeOf(err)
+			Expect(code).To(Equal(expectedCode))
+		},
+			Entry("when the device info is not reported", func(iface *sdk.Interface) { iface.DeviceInfo = nil }, errcode.DeviceMissing),
+			Entry("when the socket is not reported", func(iface *sdk.Interface) { iface.DeviceInfo.VhostUser = nil }, errcode.DeviceMissing),
+			Entry("when the mode is unknown", func(iface *sdk.Interface) { iface.DeviceInfo.VhostUser.Mode = "dual" }, errcode.SchemaMismatch),
+			Entry("when the model is not virtio", func(iface *sdk.Interface) { iface.Spec.Model = "e1000" }, errcode.UnsupportedModel),
+		)
+	})
+
+	Context("memory backing", func() {
+		It("should share the hugepages backing the guest memory", func() {
+			hugePages := &api.HugePages{HugePage: []api.HugePage{{Size: "1", Unit: "G"}}}
+			domainSpec := &api.DomainSpec{MemoryBacking: &api.MemoryBacking{HugePages: hugePages}}
+
+			Expect(domain.MutateDomain(context.Background(), vmi, domainSpec)).To(Succeed())
+			Expect(domainSpec.MemoryBacking).To(Equal(&api.MemoryBacking{
+				HugePages: hugePages,
+				Access:    &api.MemoryBackingAccess{Mode: "shared"},
+			}))
+		})
+
+		It("should share memfd memory when the VMI has no hugepages", func() {
+			domainSpec := &api.DomainSpec{}
+
+			Expect(domain.MutateDomain(context.Background(), vmi, domainSpec)).To(Succeed())
+			Expect(domainSpec.MemoryBacking).To(Equal(&api.MemoryBacking{
+				Source: &api.MemoryBackingSource{Type: "memfd"},
+				Access: &api.MemoryBackingAccess{Mode: "shared"},
+			}))
+		})
+
+		It("should fail when the memory access mode is private", func() {
+			domainSpec := &api.DomainSpec{MemoryBacking: &api.MemoryBacking{Access: &api.MemoryBackingAccess{Mode: "private"}}}
+			Expect(domain.MutateDomain(context.Background(), vmi, domainSpec)).ToNot(Succeed())
+		})
+	})
+})
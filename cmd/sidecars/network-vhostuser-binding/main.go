@@ -0,0 +1,43 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package main
+
+import (
+	"os"
+
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/network/bindingplugin/sdk"
+
+	"kubevirt.io/kubevirt/cmd/sidecars/network-vhostuser-binding/domain"
+)
+
+func main() {
+	plugin := sdk.Plugin{
+		Name:              domain.VhostUserPluginName,
+		ReadDeviceInfo:    true,
+		GenerateInterface: domain.GenerateInterface,
+		MutateDomain:      domain.MutateDomain,
+	}
+	if err := sdk.Serve(plugin); err != nil {
+		log.Log.Reason(err).Error("vhost-user sidecar failed")
+		os.Exit(1)
+	}
+}
@@ -21,6 +21,7 @@ package main
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -31,6 +32,7 @@ import (
 	"os/exec"
 	"os/signal"
 	"path/filepath"
+	"slices"
 	"syscall"
 
 	"github.com/spf13/pflag"
@@ -57,6 +59,15 @@ const (
 	preCloudInitIsoBin = "preCloudInitIso"
 )
 
+// scriptHook is the ConfigMap script the shim runs for a single hook point, instead of looking up
+// binaries in $PATH
+type scriptHook struct {
+	hook        string
+	interpreter string
+}
+
+var script *scriptHook
+
 type infoServer struct {
 	Version string
 }
@@ -79,6 +90,16 @@ func (s infoServer) Info(ctx context.Context, params *hooksInfo.InfoParams) (*ho
 	}
 
 	for hookPointName, binName := range supportedHookPoints {
+		if script != nil {
+			if script.hook == binName {
+				hookPoints = append(hookPoints, &hooksInfo.HookPoint{
+					Name:     hookPointName,
+					Priority: 0,
+				})
+			}
+			continue
+		}
+
 		if _, err := exec.LookPath(binName); err != nil {
 			if errors.Is(err, exec.ErrNotFound) {
 				log.Log.Infof("Info: %s has not been found", binName)
@@ -107,9 +128,9 @@ type v1Alpha3Server struct {
 	done chan struct{}
 }
 
-func (s v1Alpha3Server) OnDefineDomain(_ context.Context, params *hooksV1alpha3.OnDefineDomainParams) (*hooksV1alpha3.OnDefineDomainResult, error) {
+func (s v1Alpha3Server) OnDefineDomain(ctx context.Context, params *hooksV1alpha3.OnDefineDomainParams) (*hooksV1alpha3.OnDefineDomainResult, error) {
 	log.Log.Info(onDefineDomainLoggingMessage)
-	newDomainXML, err := runOnDefineDomain(params.GetVmi(), params.GetDomainXML())
+	newDomainXML, err := runOnDefineDomain(ctx, params.GetVmi(), params.GetDomainXML())
 	if err != nil {
 		log.Log.Reason(err).Error("Failed OnDefineDomain")
 		return nil, err
@@ -119,9 +140,9 @@ func (s v1Alpha3Server) OnDefineDomain(_ context.Context, params *hooksV1alpha3.
 	}, nil
 }
 
-func (s v1Alpha3Server) PreCloudInitIso(_ context.Context, params *hooksV1alpha3.PreCloudInitIsoParams) (*hooksV1alpha3.PreCloudInitIsoResult, error) {
+func (s v1Alpha3Server) PreCloudInitIso(ctx context.Context, params *hooksV1alpha3.PreCloudInitIsoParams) (*hooksV1alpha3.PreCloudInitIsoResult, error) {
 	log.Log.Info(preCloudInitIsoLoggingMessage)
-	cloudInitData, err := runPreCloudInitIso(params.GetVmi(), params.GetCloudInitData())
+	cloudInitData, err := runPreCloudInitIso(ctx, params.GetVmi(), params.GetCloudInitData())
 	if err != nil {
 		log.Log.Reason(err).Error("Failed ProCloudInitIso")
 		return nil, err
@@ -137,9 +158,19 @@ func (s v1Alpha3Server) Shutdown(_ context.Context, _ *hooksV1alpha3.ShutdownPar
 	return &hooksV1alpha3.ShutdownResult{}, nil
 }
 
+// NegotiateMigration is never called, as the shim does not subscribe to the NegotiateMigration hook point
+func (s v1Alpha3Server) NegotiateMigration(_ context.Context, _ *hooksV1alpha3.NegotiateMigrationParams) (*hooksV1alpha3.NegotiateMigrationResult, error) {
+	return &hooksV1alpha3.NegotiateMigrationResult{}, nil
+}
+
+// CheckReadiness is never called, as the shim does not subscribe to the CheckReadiness hook point
+func (s v1Alpha3Server) CheckReadiness(_ context.Context, _ *hooksV1alpha3.CheckReadinessParams) (*hooksV1alpha3.CheckReadinessResult, error) {
+	return &hooksV1alpha3.CheckReadinessResult{}, nil
+}
+
 func (s v1Alpha2Server) OnDefineDomain(ctx context.Context, params *hooksV1alpha2.OnDefineDomainParams) (*hooksV1alpha2.OnDefineDomainResult, error) {
 	log.Log.Info(onDefineDomainLoggingMessage)
-	newDomainXML, err := runOnDefineDomain(params.GetVmi(), params.GetDomainXML())
+	newDomainXML, err := runOnDefineDomain(ctx, params.GetVmi(), params.GetDomainXML())
 	if err != nil {
 		log.Log.Reason(err).Error("Failed OnDefineDomain")
 		return nil, err
@@ -149,9 +180,9 @@ func (s v1Alpha2Server) OnDefineDomain(ctx context.Context, params *hooksV1alpha
 	}, nil
 }
 
-func (s v1Alpha2Server) PreCloudInitIso(_ context.Context, params *hooksV1alpha2.PreCloudInitIsoParams) (*hooksV1alpha2.PreCloudInitIsoResult, error) {
+func (s v1Alpha2Server) PreCloudInitIso(ctx context.Context, params *hooksV1alpha2.PreCloudInitIsoParams) (*hooksV1alpha2.PreCloudInitIsoResult, error) {
 	log.Log.Info(preCloudInitIsoLoggingMessage)
-	cloudInitData, err := runPreCloudInitIso(params.GetVmi(), params.GetCloudInitData())
+	cloudInitData, err := runPreCloudInitIso(ctx, params.GetVmi(), params.GetCloudInitData())
 	if err != nil {
 		log.Log.Reason(err).Error("Failed ProCloudInitIso")
 		return nil, err
@@ -163,7 +194,7 @@ func (s v1Alpha2Server) PreCloudInitIso(_ context.Context, params *hooksV1alpha2
 
 func (s v1Alpha1Server) OnDefineDomain(ctx context.Context, params *hooksV1alpha1.OnDefineDomainParams) (*hooksV1alpha1.OnDefineDomainResult, error) {
 	log.Log.Info(onDefineDomainLoggingMessage)
-	newDomainXML, err := runOnDefineDomain(params.GetVmi(), params.GetDomainXML())
+	newDomainXML, err := runOnDefineDomain(ctx, params.GetVmi(), params.GetDomainXML())
 	if err != nil {
 		log.Log.Reason(err).Error("Failed OnDefineDomain")
 		return nil, err
@@ -173,7 +204,11 @@ func (s v1Alpha1Server) OnDefineDomain(ctx context.Context, params *hooksV1alpha
 	}, nil
 }
 
-func runPreCloudInitIso(vmiJSON []byte, cloudInitDataJSON []byte) ([]byte, error) {
+func runPreCloudInitIso(ctx context.Context, vmiJSON []byte, cloudInitDataJSON []byte) ([]byte, error) {
+	if script != nil {
+		return runScript(ctx, vmiJSON, cloudInitDataJSON)
+	}
+
 	// Check binary exists
 	if _, err := exec.LookPath(preCloudInitIsoBin); err != nil {
 		return nil, fmt.Errorf("Failed in finding %s in $PATH: %v", preCloudInitIsoBin, err)
@@ -196,7 +231,7 @@ func runPreCloudInitIso(vmiJSON []byte, cloudInitDataJSON []byte) ([]byte, error
 		"--cloud-init", string(cloudInitDataJSON))
 
 	log.Log.Infof("Executing %s", preCloudInitIsoBin)
-	command := exec.Command(preCloudInitIsoBin, args...)
+	command := exec.CommandContext(ctx, preCloudInitIsoBin, args...)
 	if reader, err := command.StderrPipe(); err != nil {
 		log.Log.Reason(err).Infof("Could not pipe stderr")
 	} else {
@@ -205,7 +240,11 @@ func runPreCloudInitIso(vmiJSON []byte, cloudInitDataJSON []byte) ([]byte, error
 	return command.Output()
 }
 
-func runOnDefineDomain(vmiJSON []byte, domainXML []byte) ([]byte, error) {
+func runOnDefineDomain(ctx context.Context, vmiJSON []byte, domainXML []byte) ([]byte, error) {
+	if script != nil {
+		return runScript(ctx, vmiJSON, domainXML)
+	}
+
 	if _, err := exec.LookPath(onDefineDomainBin); err != nil {
 		return nil, fmt.Errorf("Failed in finding %s in $PATH due %v", onDefineDomainBin, err)
 	}
@@ -220,7 +259,7 @@ func runOnDefineDomain(vmiJSON []byte, domainXML []byte) ([]byte, error) {
 		"--domain", string(domainXML))
 
 	log.Log.Infof("Executing %s", onDefineDomainBin)
-	command := exec.Command(onDefineDomainBin, args...)
+	command := exec.CommandContext(ctx, onDefineDomainBin, args...)
 	if reader, err := command.StderrPipe(); err != nil {
 		log.Log.Reason(err).Infof("Could not pipe stderr")
 	} else {
@@ -229,6 +268,25 @@ func runOnDefineDomain(vmiJSON []byte, domainXML []byte) ([]byte, error) {
 	return command.Output()
 }
 
+// runScript runs the script hook, passing the hook input on stdin and returning what it prints on stdout.
+// The script is killed once virt-launcher cancels the call, or its deadline is exceeded.
+func runScript(ctx context.Context, vmiJSON []byte, input []byte) ([]byte, error) {
+	vmiSpec := virtv1.VirtualMachineInstance{}
+	if err := json.Unmarshal(vmiJSON, &vmiSpec); err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal given VMI spec: %s due %v", vmiJSON, err)
+	}
+
+	log.Log.Infof("Executing %s script with %s", script.hook, script.interpreter)
+	command := exec.CommandContext(ctx, script.interpreter, hooks.ScriptHookPath, "--vmi", string(vmiJSON))
+	command.Stdin = bytes.NewReader(input)
+	if reader, err := command.StderrPipe(); err != nil {
+		log.Log.Reason(err).Infof("Could not pipe stderr")
+	} else {
+		go logStderr(reader, script.hook)
+	}
+	return command.Output()
+}
+
 func logStderr(reader io.Reader, hookName string) {
 	scanner := bufio.NewScanner(reader)
 	scanner.Buffer(make([]byte, 1024), 512*1024)
@@ -241,14 +299,20 @@ func logStderr(reader io.Reader, hookName string) {
 	}
 }
 
-func parseCommandLineArgs() (string, error) {
+func parseCommandLineArgs() (string, *scriptHook, error) {
 	supportedVersions := []string{"v1alpha1", "v1alpha2", "v1alpha3"}
+	supportedScriptHooks := []string{hooks.OnDefineDomainScriptHook, hooks.PreCloudInitIsoScriptHook}
+	supportedInterpreters := []string{string(hooks.ScriptInterpreterShell), string(hooks.ScriptInterpreterPython)}
 	version := ""
+	hook := ""
+	interpreter := ""
 
 	pflag.StringVar(&version, "version", "", "hook version to use")
+	pflag.StringVar(&hook, "script-hook", "", fmt.Sprintf("hook implemented by the script mounted at %s", hooks.ScriptHookPath))
+	pflag.StringVar(&interpreter, "script-interpreter", string(hooks.ScriptInterpreterShell), "interpreter used to run the hook script")
 	pflag.Parse()
 	if version == "" {
-		return "", fmt.Errorf("Missing --version parameter. Supported options are %s.", supportedVersions)
+		return "", nil, fmt.Errorf("Missing --version parameter. Supported options are %s.", supportedVersions)
 	}
 
 	supported := false
@@ -259,10 +323,20 @@ func parseCommandLineArgs() (string, error) {
 		}
 	}
 	if !supported {
-		return "", fmt.Errorf("Version %s is not supported. Supported options are %s.", version, supportedVersions)
+		return "", nil, fmt.Errorf("Version %s is not supported. Supported options are %s.", version, supportedVersions)
+	}
+
+	if hook == "" {
+		return version, nil, nil
+	}
+	if !slices.Contains(supportedScriptHooks, hook) {
+		return "", nil, fmt.Errorf("Script hook %s is not supported. Supported options are %s.", hook, supportedScriptHooks)
+	}
+	if !slices.Contains(supportedInterpreters, interpreter) {
+		return "", nil, fmt.Errorf("Script interpreter %s is not supported. Supported options are %s.", interpreter, supportedInterpreters)
 	}
 
-	return version, nil
+	return version, &scriptHook{hook: hook, interpreter: interpreter}, nil
 }
 
 func getSocketPath() (string, error) {
@@ -288,11 +362,12 @@ func main() {
 	log.InitializeLogging("shim-sidecar")
 
 	// Shim arguments
-	version, err := parseCommandLineArgs()
+	version, hookScript, err := parseCommandLineArgs()
 	if err != nil {
 		log.Log.Reason(err).Errorf("Input error")
 		os.Exit(1)
 	}
+	script = hookScript
 
 	socketPath, err := getSocketPath()
 	if err != nil {
@@ -163,6 +163,10 @@ func main() {
 	removeMDEVCmd := NewRemoveMDEVCommand()
 	removeMDEVCmd.Flags().String("uuid", "", "uuid of the mediated device to remove")
 
+	bindPCIDriverCmd := NewBindPCIDriverCommand()
+	bindPCIDriverCmd.Flags().String("address", "", "the address of the PCI device")
+	bindPCIDriverCmd.Flags().String("driver", "", "the driver to bind the PCI device to, its default driver if empty")
+
 	cgroupsCmd := &cobra.Command{
 		Use:   "set-cgroups-resources",
 		Short: "Set cgroups resources",
@@ -214,6 +218,7 @@ func main() {
 		createTapCmd,
 		createMDEVCmd,
 		removeMDEVCmd,
+		bindPCIDriverCmd,
 		cgroupsCmd,
 	)
 
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+)
+
+var pciDevicesPath string = "/sys/bus/pci/devices"
+var pciDriversProbePath string = "/sys/bus/pci/drivers_probe"
+
+// bindPCIDriver overrides the driver matching of a PCI device and re-probes it.
+// An empty driver clears the override, so the device gets back to its default host driver.
+func bindPCIDriver(pciAddress string, driver string) error {
+	devicePath := filepath.Join(pciDevicesPath, pciAddress)
+	if _, err := os.Stat(devicePath); err != nil {
+		fmt.Printf("failed to bind PCI device %s, device not found\n", pciAddress)
+		return err
+	}
+
+	// writing a newline is how the driver override gets cleared
+	override := driver
+	if override == "" {
+		override = "\n"
+	}
+	if err := writeSysfsFile(filepath.Join(devicePath, "driver_override"), override); err != nil {
+		fmt.Printf("failed to set the driver override of PCI device %s\n", pciAddress)
+		return err
+	}
+
+	if _, err := os.Stat(filepath.Join(devicePath, "driver")); err == nil {
+		if err := writeSysfsFile(filepath.Join(devicePath, "driver", "unbind"), pciAddress); err != nil {
+			fmt.Printf("failed to unbind PCI device %s from its driver\n", pciAddress)
+			return err
+		}
+	}
+
+	if err := writeSysfsFile(pciDriversProbePath, pciAddress); err != nil {
+		fmt.Printf("failed to probe PCI device %s\n", pciAddress)
+		return err
+	}
+	fmt.Printf("Successfully probed PCI device %s with driver override %q\n", pciAddress, driver)
+	return nil
+}
+
+func writeSysfsFile(path string, value string) error {
+	f, err := os.OpenFile(path, os.O_WRONLY, 0200)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = f.WriteString(value)
+	return err
+}
+
+func NewBindPCIDriverCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "bind-pci-driver",
+		Short: "bind a PCI device to a driver",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pciAddress := cmd.Flag("address").Value.String()
+			if pciAddress == "" {
+				return fmt.Errorf("address argument cannot be empty")
+			}
+			driver := cmd.Flag("driver").Value.String()
+			return bindPCIDriver(pciAddress, driver)
+		},
+	}
+}
@@ -39,6 +39,7 @@ func main() {
 	memProfile := pflag.String("memProfile", "", "Path to store a memory profile. Profiling is skipped if empty")
 	timeoutSeconds := pflag.Int32("timeoutSeconds", 1, "Duration in seconds the probe will wait for the guest command to return.")
 	guestAgentPing := pflag.Bool("guestAgentPing", false, "Flag to specify readiness probe based of guest-agent ping")
+	successExitCodes := pflag.Int32Slice("successExitCodes", nil, "Exit codes of the guest command which are considered a success. The exit code is passed through if empty")
 
 	pflag.CommandLine.AddGoFlag(goflag.CommandLine.Lookup("v"))
 	pflag.Parse()
@@ -69,9 +70,26 @@ func main() {
 	}
 
 	saveMemoryProfile(*memProfile)
+	if len(*successExitCodes) > 0 {
+		exitCode = translateExitCode(exitCode, err, *successExitCodes)
+	}
 	os.Exit(exitCode)
 }
 
+// translateExitCode maps the exit code of the guest command to 0 if it is
+// one of the expected success codes and to 1 otherwise.
+func translateExitCode(exitCode int, err error, successExitCodes []int32) int {
+	if err != nil {
+		return 1
+	}
+	for _, successExitCode := range successExitCodes {
+		if int(successExitCode) == exitCode {
+			return 0
+		}
+	}
+	return 1
+}
+
 func saveMemoryProfile(path string) {
 	if len(path) > 0 {
 		log.Log.Info("creating memory profile")
@@ -41,6 +41,8 @@ other_images_x86_64_aarch64="
     //cmd/sidecars/network-slirp-binding:network-slirp-binding-image
     //cmd/sidecars/network-passt-binding:network-passt-binding-image
     //cmd/cniplugins/passt-binding/cmd:network-passt-binding-cni-image
+    //cmd/sidecars/network-macvtap-binding:network-macvtap-binding-image
+    //cmd/sidecars/network-vhostuser-binding:network-vhostuser-binding-image
     //cmd/pr-helper:pr-helper-image
     //containerimages:cirros-container-disk-image
     //containerimages:cirros-custom-container-disk-image
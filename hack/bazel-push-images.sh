@@ -67,6 +67,8 @@ if [[ "${ARCHITECTURE}" != "s390x" && "${ARCHITECTURE}" != "crossbuild-s390x" ]]
         network-slirp-binding
         network-passt-binding
         network-passt-binding-cni
+        network-macvtap-binding
+        network-vhostuser-binding
     "
 fi
 
@@ -38,6 +38,8 @@ import (
 	"kubevirt.io/client-go/precond"
 
 	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
+	netdns "kubevirt.io/kubevirt/pkg/network/dns"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/util/net/dns"
 )
@@ -48,6 +50,7 @@ type IsoCreationFunc func(isoOutFile, volumeID string, inDir string) error
 
 var cloudInitLocalDir = "/var/run/libvirt/cloud-init-dir"
 var cloudInitIsoFunc = defaultIsoFunc
+var podSearchDomainsFunc = defaultPodSearchDomains
 
 // Locations of data source disk files
 const (
@@ -128,8 +131,6 @@ func ReadCloudInitVolumeDataSource(vmi *v1.VirtualMachineInstance, secretSourceD
 		instancetype = vmi.Annotations[v1.InstancetypeAnnotation]
 	}
 
-	hostname := dns.SanitizeHostname(vmi)
-
 	for _, volume := range vmi.Spec.Volumes {
 		if volume.CloudInitNoCloud != nil {
 			keys, err := resolveNoCloudSecrets(vmi, secretSourceDir)
@@ -137,6 +138,11 @@ func ReadCloudInitVolumeDataSource(vmi *v1.VirtualMachineInstance, secretSourceD
 				return nil, err
 			}
 
+			hostname, err := guestHostname(vmi)
+			if err != nil {
+				return nil, err
+			}
+
 			cloudInitData, err = readCloudInitNoCloudSource(volume.CloudInitNoCloud)
 			cloudInitData.NoCloudMetaData = readCloudInitNoCloudMetaData(hostname, cloudInitUUIDFromVMI(vmi), instancetype, keys)
 			cloudInitData.VolumeName = volume.Name
@@ -149,6 +155,11 @@ func ReadCloudInitVolumeDataSource(vmi *v1.VirtualMachineInstance, secretSourceD
 				return nil, err
 			}
 
+			hostname, err := guestHostname(vmi)
+			if err != nil {
+				return nil, err
+			}
+
 			uuid := cloudInitUUIDFromVMI(vmi)
 			cloudInitData, err = readCloudInitConfigDriveSource(volume.CloudInitConfigDrive)
 			cloudInitData.ConfigDriveMetaData = readCloudInitConfigDriveMetaData(vmi.Name, uuid, hostname, vmi.Namespace, keys, instancetype)
@@ -159,6 +170,33 @@ func ReadCloudInitVolumeDataSource(vmi *v1.VirtualMachineInstance, secretSourceD
 	return nil, nil
 }
 
+// guestHostname returns the hostname passed to the guest through the cloud-init metadata.
+// Guests on the pod network learn their domain from the DHCP server of the pod network,
+// guests attached only to secondary networks get a fully qualified hostname instead.
+func guestHostname(vmi *v1.VirtualMachineInstance) (string, error) {
+	hostname := dns.SanitizeHostname(vmi)
+	if len(vmi.Spec.Networks) == 0 || vmispec.LookupPodNetwork(vmi.Spec.Networks) != nil {
+		return hostname, nil
+	}
+
+	searchDomains, err := podSearchDomainsFunc()
+	if err != nil {
+		return "", fmt.Errorf("failed to read the search domains of the pod: %v", err)
+	}
+	if domain := netdns.DomainNameWithSubdomain(searchDomains, vmi.Spec.Subdomain); domain != "" {
+		searchDomains = append([]string{domain}, searchDomains...)
+	}
+	if domain := netdns.GetDomainName(searchDomains); domain != "" {
+		return hostname + "." + domain, nil
+	}
+	return hostname, nil
+}
+
+func defaultPodSearchDomains() ([]string, error) {
+	_, searchDomains, err := netdns.GetResolvConfDetailsFromPod()
+	return searchDomains, err
+}
+
 func isNoCloudAccessCredential(accessCred v1.AccessCredential) bool {
 	return accessCred.SSHPublicKey != nil && accessCred.SSHPublicKey.PropagationMethod.NoCloud != nil
 }
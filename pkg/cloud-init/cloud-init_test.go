@@ -612,6 +612,68 @@ var _ = Describe("CloudInit", func() {
 		})
 	})
 
+	Describe("guest hostname", func() {
+		const (
+			vmiName   = "testvmi"
+			namespace = "testns"
+		)
+
+		searchDomains := []string{namespace + ".svc.cluster.local", "svc.cluster.local", "cluster.local"}
+
+		BeforeEach(func() {
+			podSearchDomainsFunc = func() ([]string, error) {
+				return searchDomains, nil
+			}
+			DeferCleanup(func() {
+				podSearchDomainsFunc = defaultPodSearchDomains
+			})
+		})
+
+		newVMI := func(subdomain string, networks ...v1.Network) *v1.VirtualMachineInstance {
+			return &v1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      vmiName,
+					Namespace: namespace,
+				},
+				Spec: v1.VirtualMachineInstanceSpec{
+					Subdomain: subdomain,
+					Networks:  networks,
+					Volumes: []v1.Volume{{
+						Name: "cloudinit",
+						VolumeSource: v1.VolumeSource{
+							CloudInitNoCloud: &v1.CloudInitNoCloudSource{UserData: "fake-userdata"},
+						},
+					}},
+				},
+			}
+		}
+
+		podNetwork := v1.Network{Name: "default", NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}}
+		secondaryNetwork := v1.Network{Name: "secondary", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "nad"}}}
+
+		DescribeTable("should be passed in the nocloud metadata", func(vmi *v1.VirtualMachineInstance, expectedHostname string) {
+			cloudInitData, err := ReadCloudInitVolumeDataSource(vmi, tmpDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cloudInitData.NoCloudMetaData.LocalHostname).To(Equal(expectedHostname))
+		},
+			Entry("unqualified without networks", newVMI(""), vmiName),
+			Entry("unqualified with the pod network", newVMI("", podNetwork, secondaryNetwork), vmiName),
+			Entry("unqualified with the pod network and a subdomain", newVMI("sub", podNetwork), vmiName),
+			Entry("fully qualified with secondary networks only",
+				newVMI("", secondaryNetwork), vmiName+"."+namespace+".svc.cluster.local"),
+			Entry("fully qualified with the subdomain with secondary networks only",
+				newVMI("sub", secondaryNetwork), vmiName+".sub."+namespace+".svc.cluster.local"),
+		)
+
+		It("should fail when the pod search domains cannot be read with secondary networks only", func() {
+			podSearchDomainsFunc = func() ([]string, error) {
+				return nil, errors.New("no resolv.conf")
+			}
+			_, err := ReadCloudInitVolumeDataSource(newVMI("", secondaryNetwork), tmpDir)
+			Expect(err).To(MatchError(ContainSubstring("no resolv.conf")))
+		})
+	})
+
 	Describe("PrepareLocalPath", func() {
 		It("should create the correct directory structure", func() {
 			namespace := "fake-namespace"
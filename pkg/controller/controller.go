@@ -105,6 +105,12 @@ const (
 	PVCNotReadyReason = "PVCNotReady"
 	// FailedHotplugSyncReason is set when a hotplug specific failure occurs during sync
 	FailedHotplugSyncReason = "FailedHotplugSync"
+	// HostDevicePendingReason is set when a hotplugged host device waits for its attachment pod to be allocated a device
+	HostDevicePendingReason = "HostDevicePending"
+	// HostDeviceAllocatedReason is set when the attachment pod of a hotplugged host device got a device allocated
+	HostDeviceAllocatedReason = "HostDeviceAllocated"
+	// HostDeviceDetachingReason is set when a hotplugged host device was removed from the VMI spec
+	HostDeviceDetachingReason = "HostDeviceDetaching"
 	// ErrImagePullReason is set when an error has occured while pulling an image for a containerDisk VM volume.
 	ErrImagePullReason = "ErrImagePull"
 	// ImagePullBackOffReason is set when an error has occured while pulling an image for a containerDisk VM volume,
@@ -403,6 +409,21 @@ func SetVMIPhaseTransitionTimestamp(oldStatus *v1.VirtualMachineInstanceStatus,
 	}
 }
 
+// SetVMIBootPhaseTimestamp records the time at which the VMI reached a boot phase.
+// The first recorded time of a phase is kept.
+func SetVMIBootPhaseTimestamp(status *v1.VirtualMachineInstanceStatus, phase v1.VirtualMachineInstanceBootPhase, timestamp metav1.Time) {
+	for _, bootPhaseTimestamp := range status.BootPhaseTimestamps {
+		if bootPhaseTimestamp.Phase == phase {
+			return
+		}
+	}
+
+	status.BootPhaseTimestamps = append(status.BootPhaseTimestamps, v1.VirtualMachineInstanceBootPhaseTimestamp{
+		Phase:     phase,
+		Timestamp: timestamp,
+	})
+}
+
 func SetVMIMigrationPhaseTransitionTimestamp(oldVMIMigration *v1.VirtualMachineInstanceMigration, newVMIMigration *v1.VirtualMachineInstanceMigration) {
 	if oldVMIMigration.Status.Phase != newVMIMigration.Status.Phase {
 		for _, transitionTimeStamp := range newVMIMigration.Status.PhaseTransitionTimestamps {
@@ -26,6 +26,7 @@ import (
 	"sync"
 	"time"
 
+	networkv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 	vsv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
 	routev1 "github.com/openshift/api/route/v1"
 	secv1 "github.com/openshift/api/security/v1"
@@ -36,6 +37,7 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	coordinationv1 "k8s.io/api/coordination/v1"
 	k8sv1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -82,6 +84,9 @@ const (
 	NotOperatorLabel = kubev1.ManagedByLabel + " notin (" + kubev1.ManagedByLabelOperatorValue + "," + kubev1.ManagedByLabelOperatorOldValue + " )"
 )
 
+// SecondaryNetworkEndpointSliceManager is the manager of the endpoint slices publishing VMIs secondary network endpoints.
+const SecondaryNetworkEndpointSliceManager = "virt-controller.kubevirt.io"
+
 const (
 	ByVMINameIndex      = "byVMIName"
 	ByMigrationUIDIndex = "byMigrationUID"
@@ -188,6 +193,18 @@ type KubeInformerFactory interface {
 	// Watches for the kubevirt export service
 	ExportService() cache.SharedIndexInformer
 
+	// Watches for services publishing VMIs secondary network endpoints
+	SecondaryNetworkService() cache.SharedIndexInformer
+
+	// Watches for the endpoint slices of services publishing VMIs secondary network endpoints
+	SecondaryNetworkEndpointSlice() cache.SharedIndexInformer
+
+	// Watches for Multus NetworkAttachmentDefinition objects
+	NetworkAttachmentDefinition() cache.SharedIndexInformer
+
+	// Fake NetworkAttachmentDefinition informer used when the NetworkAttachmentDefinition API is not installed
+	DummyNetworkAttachmentDefinition() cache.SharedIndexInformer
+
 	// ConfigMaps which are managed by the operator
 	OperatorConfigMap() cache.SharedIndexInformer
 
@@ -1118,6 +1135,45 @@ func (f *kubeInformerFactory) ExportService() cache.SharedIndexInformer {
 	})
 }
 
+func (f *kubeInformerFactory) SecondaryNetworkService() cache.SharedIndexInformer {
+	return f.getInformer("secondaryNetworkService", func() cache.SharedIndexInformer {
+		labelSelector, err := labels.Parse(kubev1.SecondaryNetworkEndpointsLabel)
+		if err != nil {
+			panic(err)
+		}
+
+		lw := NewListWatchFromClient(f.clientSet.CoreV1().RESTClient(), "services", k8sv1.NamespaceAll, fields.Everything(), labelSelector)
+		return cache.NewSharedIndexInformer(lw, &k8sv1.Service{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
+func (f *kubeInformerFactory) SecondaryNetworkEndpointSlice() cache.SharedIndexInformer {
+	return f.getInformer("secondaryNetworkEndpointSlice", func() cache.SharedIndexInformer {
+		labelSelector, err := labels.Parse(fmt.Sprintf("%s=%s", discoveryv1.LabelManagedBy, SecondaryNetworkEndpointSliceManager))
+		if err != nil {
+			panic(err)
+		}
+
+		lw := NewListWatchFromClient(f.clientSet.DiscoveryV1().RESTClient(), "endpointslices", k8sv1.NamespaceAll, fields.Everything(), labelSelector)
+		return cache.NewSharedIndexInformer(lw, &discoveryv1.EndpointSlice{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
+func (f *kubeInformerFactory) NetworkAttachmentDefinition() cache.SharedIndexInformer {
+	return f.getInformer("networkAttachmentDefinitionInformer", func() cache.SharedIndexInformer {
+		restClient := f.clientSet.NetworkClient().K8sCniCncfIoV1().RESTClient()
+		lw := cache.NewListWatchFromClient(restClient, "network-attachment-definitions", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &networkv1.NetworkAttachmentDefinition{}, f.defaultResync, cache.Indexers{})
+	})
+}
+
+func (f *kubeInformerFactory) DummyNetworkAttachmentDefinition() cache.SharedIndexInformer {
+	return f.getInformer("fakeNetworkAttachmentDefinitionInformer", func() cache.SharedIndexInformer {
+		informer, _ := testutils.NewFakeInformerFor(&networkv1.NetworkAttachmentDefinition{})
+		return informer
+	})
+}
+
 func (f *kubeInformerFactory) PersistentVolumeClaim() cache.SharedIndexInformer {
 	return f.getInformer("persistentVolumeClaimInformer", func() cache.SharedIndexInformer {
 		restClient := f.clientSet.CoreV1().RESTClient()
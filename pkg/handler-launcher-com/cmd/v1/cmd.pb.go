@@ -46,6 +46,9 @@ It has these top-level messages:
 	BackupRequest
 	RedefineCheckpointRequest
 	RedefineCheckpointResponse
+	DomainJobsResponse
+	CancelDomainJobRequest
+	QemuCommandLineResponse
 */
 package v1
 
@@ -621,6 +624,12 @@ func (*EmptyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []in
 type Response struct {
 	Success bool   `protobuf:"varint,1,opt,name=success" json:"success,omitempty"`
 	Message string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
+	// The interface virt-launcher failed to hot plug into the domain, when the command failed doing so
+	HotplugFailedInterface string `protobuf:"bytes,3,opt,name=hotplugFailedInterface" json:"hotplugFailedInterface,omitempty"`
+	// The sidecar which failed its readiness check, when the command failed to start the domain because of it
+	NotReadySidecar string `protobuf:"bytes,4,opt,name=notReadySidecar" json:"notReadySidecar,omitempty"`
+	// The code the sidecar classified its readiness failure with, if any
+	NotReadyErrorCode string `protobuf:"bytes,5,opt,name=notReadyErrorCode" json:"notReadyErrorCode,omitempty"`
 }
 
 func (m *Response) Reset()                    { *m = Response{} }
@@ -642,6 +651,27 @@ func (m *Response) GetMessage() string {
 	return ""
 }
 
+func (m *Response) GetHotplugFailedInterface() string {
+	if m != nil {
+		return m.HotplugFailedInterface
+	}
+	return ""
+}
+
+func (m *Response) GetNotReadySidecar() string {
+	if m != nil {
+		return m.NotReadySidecar
+	}
+	return ""
+}
+
+func (m *Response) GetNotReadyErrorCode() string {
+	if m != nil {
+		return m.NotReadyErrorCode
+	}
+	return ""
+}
+
 type DomainResponse struct {
 	Response *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
 	Domain   string    `protobuf:"bytes,2,opt,name=domain" json:"domain,omitempty"`
@@ -1082,6 +1112,78 @@ func (m *RedefineCheckpointResponse) GetCheckpointInvalid() bool {
 	return false
 }
 
+type DomainJobsResponse struct {
+	Response           *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	DomainJobsResponse string    `protobuf:"bytes,2,opt,name=domainJobsResponse" json:"domainJobsResponse,omitempty"`
+}
+
+func (m *DomainJobsResponse) Reset()                    { *m = DomainJobsResponse{} }
+func (m *DomainJobsResponse) String() string            { return proto.CompactTextString(m) }
+func (*DomainJobsResponse) ProtoMessage()               {}
+func (*DomainJobsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+
+func (m *DomainJobsResponse) GetResponse() *Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *DomainJobsResponse) GetDomainJobsResponse() string {
+	if m != nil {
+		return m.DomainJobsResponse
+	}
+	return ""
+}
+
+type CancelDomainJobRequest struct {
+	Vmi     *VMI   `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
+	Options []byte `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (m *CancelDomainJobRequest) Reset()                    { *m = CancelDomainJobRequest{} }
+func (m *CancelDomainJobRequest) String() string            { return proto.CompactTextString(m) }
+func (*CancelDomainJobRequest) ProtoMessage()               {}
+func (*CancelDomainJobRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+
+func (m *CancelDomainJobRequest) GetVmi() *VMI {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+func (m *CancelDomainJobRequest) GetOptions() []byte {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+type QemuCommandLineResponse struct {
+	Response *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	Args     []string  `protobuf:"bytes,2,rep,name=args" json:"args,omitempty"`
+}
+
+func (m *QemuCommandLineResponse) Reset()                    { *m = QemuCommandLineResponse{} }
+func (m *QemuCommandLineResponse) String() string            { return proto.CompactTextString(m) }
+func (*QemuCommandLineResponse) ProtoMessage()               {}
+func (*QemuCommandLineResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+
+func (m *QemuCommandLineResponse) GetResponse() *Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *QemuCommandLineResponse) GetArgs() []string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QemuVersionResponse)(nil), "kubevirt.cmd.v1.QemuVersionResponse")
 	proto.RegisterType((*VMI)(nil), "kubevirt.cmd.v1.VMI")
@@ -1119,6 +1221,9 @@ func init() {
 	proto.RegisterType((*BackupRequest)(nil), "kubevirt.cmd.v1.BackupRequest")
 	proto.RegisterType((*RedefineCheckpointRequest)(nil), "kubevirt.cmd.v1.RedefineCheckpointRequest")
 	proto.RegisterType((*RedefineCheckpointResponse)(nil), "kubevirt.cmd.v1.RedefineCheckpointResponse")
+	proto.RegisterType((*DomainJobsResponse)(nil), "kubevirt.cmd.v1.DomainJobsResponse")
+	proto.RegisterType((*CancelDomainJobRequest)(nil), "kubevirt.cmd.v1.CancelDomainJobRequest")
+	proto.RegisterType((*QemuCommandLineResponse)(nil), "kubevirt.cmd.v1.QemuCommandLineResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1167,6 +1272,9 @@ type CmdClient interface {
 	GetScreenshot(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*ScreenshotResponse, error)
 	BackupVirtualMachine(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*Response, error)
 	RedefineCheckpoint(ctx context.Context, in *RedefineCheckpointRequest, opts ...grpc.CallOption) (*RedefineCheckpointResponse, error)
+	GetDomainJobs(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*DomainJobsResponse, error)
+	CancelDomainJob(ctx context.Context, in *CancelDomainJobRequest, opts ...grpc.CallOption) (*Response, error)
+	GetQemuCommandLine(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*QemuCommandLineResponse, error)
 }
 
 type cmdClient struct {
@@ -1492,6 +1600,33 @@ func (c *cmdClient) RedefineCheckpoint(ctx context.Context, in *RedefineCheckpoi
 	return out, nil
 }
 
+func (c *cmdClient) GetDomainJobs(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*DomainJobsResponse, error) {
+	out := new(DomainJobsResponse)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GetDomainJobs", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) CancelDomainJob(ctx context.Context, in *CancelDomainJobRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/CancelDomainJob", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) GetQemuCommandLine(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*QemuCommandLineResponse, error) {
+	out := new(QemuCommandLineResponse)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GetQemuCommandLine", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Cmd service
 
 type CmdServer interface {
@@ -1530,6 +1665,9 @@ type CmdServer interface {
 	GetScreenshot(context.Context, *VMIRequest) (*ScreenshotResponse, error)
 	BackupVirtualMachine(context.Context, *BackupRequest) (*Response, error)
 	RedefineCheckpoint(context.Context, *RedefineCheckpointRequest) (*RedefineCheckpointResponse, error)
+	GetDomainJobs(context.Context, *VMIRequest) (*DomainJobsResponse, error)
+	CancelDomainJob(context.Context, *CancelDomainJobRequest) (*Response, error)
+	GetQemuCommandLine(context.Context, *VMIRequest) (*QemuCommandLineResponse, error)
 }
 
 func RegisterCmdServer(s *grpc.Server, srv CmdServer) {
@@ -2166,6 +2304,60 @@ func _Cmd_RedefineCheckpoint_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cmd_GetDomainJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).GetDomainJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/GetDomainJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).GetDomainJobs(ctx, req.(*VMIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_CancelDomainJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelDomainJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).CancelDomainJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/CancelDomainJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).CancelDomainJob(ctx, req.(*CancelDomainJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_GetQemuCommandLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).GetQemuCommandLine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/GetQemuCommandLine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).GetQemuCommandLine(ctx, req.(*VMIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Cmd_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kubevirt.cmd.v1.Cmd",
 	HandlerType: (*CmdServer)(nil),
@@ -2310,6 +2502,18 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RedefineCheckpoint",
 			Handler:    _Cmd_RedefineCheckpoint_Handler,
 		},
+		{
+			MethodName: "GetDomainJobs",
+			Handler:    _Cmd_GetDomainJobs_Handler,
+		},
+		{
+			MethodName: "CancelDomainJob",
+			Handler:    _Cmd_CancelDomainJob_Handler,
+		},
+		{
+			MethodName: "GetQemuCommandLine",
+			Handler:    _Cmd_GetQemuCommandLine_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/handler-launcher-com/cmd/v1/cmd.proto",
@@ -2318,132 +2522,141 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("pkg/handler-launcher-com/cmd/v1/cmd.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 2029 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0xef, 0x72, 0x1b, 0xb7,
-	0x11, 0x37, 0x45, 0x4a, 0x26, 0x57, 0x7f, 0x62, 0xc3, 0x92, 0x7c, 0x62, 0x6a, 0x5b, 0x45, 0x3b,
-	0xae, 0xd3, 0x26, 0x52, 0xed, 0x38, 0x99, 0x8e, 0xa7, 0x93, 0xb1, 0x45, 0xd1, 0x8a, 0x12, 0x53,
-	0xa6, 0x8f, 0x92, 0x3c, 0x4d, 0x9b, 0xc9, 0x40, 0x77, 0x10, 0x89, 0xea, 0x0e, 0x60, 0x0e, 0x38,
-	0xc6, 0xcc, 0xa7, 0x76, 0xd2, 0xe9, 0x87, 0xce, 0xf4, 0xc1, 0xfa, 0x04, 0xfd, 0xd6, 0x97, 0xe8,
-	0x0b, 0x64, 0x80, 0xbb, 0xa3, 0x8e, 0xbc, 0x3b, 0xd1, 0x1a, 0xf2, 0x13, 0x01, 0xec, 0xee, 0x6f,
-	0x17, 0x8b, 0xc5, 0x62, 0xf7, 0x08, 0x1f, 0xf5, 0x2f, 0xba, 0xbb, 0x3d, 0xc2, 0x5d, 0x8f, 0x06,
-	0x9f, 0x78, 0x24, 0xe4, 0x4e, 0x8f, 0x06, 0x9f, 0x38, 0xc2, 0xdf, 0x75, 0x7c, 0x77, 0x77, 0xf0,
-	0x58, 0xff, 0xec, 0xf4, 0x03, 0xa1, 0x04, 0xfa, 0xe0, 0x22, 0x3c, 0xa3, 0x03, 0x16, 0xa8, 0x1d,
-	0xbd, 0x36, 0x78, 0x8c, 0xcf, 0xe1, 0xce, 0x1b, 0xea, 0x87, 0xa7, 0x34, 0x90, 0x4c, 0x70, 0x9b,
-	0xca, 0xbe, 0xe0, 0x92, 0xa2, 0xcf, 0xa0, 0x1a, 0xc4, 0x63, 0xab, 0xb4, 0x5d, 0x7a, 0xb4, 0xfc,
-	0x64, 0x6b, 0x67, 0x42, 0x74, 0x27, 0x61, 0xb6, 0x47, 0xac, 0xc8, 0x82, 0x9b, 0x83, 0x08, 0xc9,
-	0x5a, 0xd8, 0x2e, 0x3d, 0xaa, 0xd9, 0xc9, 0x14, 0x3f, 0x80, 0xf2, 0x69, 0xeb, 0xd0, 0x30, 0xf8,
-	0xec, 0x2b, 0x29, 0xb8, 0x81, 0x5d, 0xb1, 0x93, 0x29, 0x7e, 0x0c, 0xe5, 0x46, 0xfb, 0x04, 0xad,
-	0xc1, 0x02, 0x73, 0x0d, 0x6d, 0xd5, 0x5e, 0x60, 0x2e, 0xaa, 0x43, 0x55, 0xb2, 0x33, 0x8f, 0xf1,
-	0xae, 0xb4, 0x16, 0xb6, 0xcb, 0x8f, 0x56, 0xed, 0xd1, 0x1c, 0xef, 0xc2, 0xcd, 0x4e, 0x34, 0xce,
-	0x88, 0xad, 0xc3, 0xe2, 0x80, 0x78, 0x21, 0x35, 0x66, 0x54, 0xec, 0x68, 0x82, 0x9b, 0xb0, 0xd8,
-	0x26, 0x5d, 0x2a, 0x35, 0xd9, 0x11, 0x21, 0x57, 0x46, 0xa2, 0x62, 0x47, 0x13, 0x84, 0xa0, 0x12,
-	0x72, 0xa6, 0x62, 0xd3, 0xcd, 0x58, 0xaf, 0x49, 0xf6, 0x23, 0xb5, 0xca, 0x06, 0xda, 0x8c, 0xf1,
-	0x53, 0x58, 0x6a, 0x51, 0x5f, 0x04, 0x43, 0xb4, 0x09, 0x4b, 0xc4, 0x4f, 0x01, 0xc5, 0xb3, 0x3c,
-	0x24, 0xfc, 0xdf, 0x12, 0x54, 0x1a, 0xd4, 0xf3, 0x32, 0xb6, 0xee, 0xc2, 0x92, 0x6f, 0xe0, 0x0c,
-	0xfb, 0xf2, 0x93, 0xbb, 0x19, 0x4f, 0x47, 0xda, 0xec, 0x98, 0x0d, 0x7d, 0x0c, 0x8b, 0x7d, 0xbd,
-	0x0d, 0xab, 0xbc, 0x5d, 0x7e, 0xb4, 0xfc, 0x64, 0x33, 0xc3, 0x6f, 0x36, 0x69, 0x47, 0x4c, 0xe8,
-	0x73, 0xa8, 0xb9, 0x4c, 0x2a, 0xc2, 0x1d, 0x2a, 0xad, 0x8a, 0x91, 0xb0, 0x32, 0x12, 0xb1, 0x1f,
-	0xed, 0x4b, 0x56, 0xf4, 0x08, 0x2a, 0x4e, 0x3f, 0x94, 0xd6, 0xa2, 0x11, 0x59, 0xcf, 0x88, 0x34,
-	0xda, 0x27, 0xb6, 0xe1, 0xc0, 0xcf, 0xa1, 0x7a, 0x2c, 0xfa, 0xc2, 0x13, 0xdd, 0x21, 0x7a, 0x0a,
-	0xc0, 0x43, 0x9f, 0x7c, 0xe7, 0x50, 0xcf, 0x93, 0x56, 0xc9, 0xc8, 0x6e, 0x64, 0x65, 0xa9, 0xe7,
-	0xd9, 0x35, 0xcd, 0xa8, 0x47, 0x12, 0xff, 0xab, 0x04, 0x4b, 0x9d, 0xd6, 0x1e, 0x13, 0x12, 0x61,
-	0x58, 0xf1, 0x09, 0x0f, 0xcf, 0x89, 0xa3, 0xc2, 0x80, 0x06, 0xc6, 0x4f, 0x35, 0x7b, 0x6c, 0x4d,
-	0x47, 0x51, 0x3f, 0x10, 0x6e, 0xe8, 0x24, 0x1e, 0x4e, 0xa6, 0xe9, 0x00, 0x2c, 0x8f, 0x05, 0x20,
-	0xba, 0x05, 0x65, 0x79, 0x11, 0x5a, 0x15, 0xb3, 0xaa, 0x87, 0xfa, 0xf0, 0xce, 0x89, 0xcf, 0xbc,
-	0xa1, 0xb5, 0x68, 0x16, 0xe3, 0x19, 0xfe, 0x67, 0x09, 0xaa, 0xfb, 0x4c, 0x5e, 0x1c, 0xf2, 0x73,
-	0x61, 0x98, 0x44, 0xe0, 0x13, 0x15, 0x1b, 0x12, 0xcf, 0xd0, 0x36, 0x2c, 0x9f, 0x11, 0xe7, 0x82,
-	0xf1, 0xee, 0x4b, 0xe6, 0xd1, 0xd8, 0x8c, 0xf4, 0x12, 0xba, 0x0f, 0xa0, 0xed, 0x25, 0x5e, 0x27,
-	0x89, 0x9f, 0x8a, 0x9d, 0x5a, 0xd1, 0x08, 0xda, 0x25, 0x09, 0x43, 0xc5, 0x30, 0xa4, 0x97, 0xf0,
-	0xff, 0x17, 0x60, 0xb5, 0xe1, 0x85, 0x52, 0xd1, 0xa0, 0x21, 0xf8, 0x39, 0xeb, 0xa2, 0x1d, 0x40,
-	0xcd, 0x77, 0x7d, 0xc2, 0x5d, 0x6d, 0x9f, 0x6c, 0x72, 0x72, 0xe6, 0xd1, 0x28, 0x94, 0xaa, 0x76,
-	0x0e, 0x05, 0xfd, 0x11, 0xb6, 0x5e, 0x06, 0x94, 0xea, 0x78, 0xb0, 0x69, 0x5f, 0x04, 0x8a, 0xf1,
-	0xee, 0x3e, 0x93, 0x91, 0xd8, 0x82, 0x11, 0x2b, 0x66, 0x40, 0xcf, 0xc0, 0xda, 0x13, 0x4e, 0x4f,
-	0xee, 0x33, 0xd9, 0xf7, 0xc8, 0xf0, 0xa5, 0x08, 0x9a, 0x2f, 0x0f, 0x0f, 0x42, 0x2a, 0x95, 0x34,
-	0xfb, 0xa9, 0xda, 0x85, 0x74, 0x2d, 0xdb, 0xa1, 0x01, 0x23, 0x5e, 0x43, 0x70, 0x29, 0x3c, 0xfa,
-	0x4a, 0x5c, 0x2a, 0xae, 0x44, 0xb2, 0x45, 0x74, 0xf4, 0x1c, 0x3e, 0x6c, 0x37, 0x0e, 0x8f, 0x4e,
-	0x5a, 0x2f, 0x5e, 0xfc, 0x40, 0x02, 0x9a, 0xc4, 0x56, 0xb2, 0xdd, 0x45, 0x23, 0x7e, 0x15, 0x8b,
-	0xd6, 0x7e, 0x7a, 0xd0, 0x3e, 0x79, 0xc5, 0x06, 0xb4, 0xc5, 0xba, 0x01, 0x51, 0x4c, 0xf0, 0x44,
-	0x7c, 0x29, 0xd2, 0x5e, 0x44, 0xc7, 0x9f, 0xc2, 0xd6, 0x21, 0x57, 0x34, 0x38, 0x27, 0x0e, 0xdd,
-	0x63, 0xdc, 0x65, 0xbc, 0x3b, 0xe2, 0xd1, 0xe1, 0xd0, 0xa2, 0xaa, 0x27, 0xdc, 0x24, 0x1c, 0xa2,
-	0x19, 0xfe, 0xdf, 0x4d, 0xd8, 0x38, 0x8d, 0x8e, 0xae, 0x45, 0x9c, 0x1e, 0xe3, 0xf4, 0x75, 0x5f,
-	0x0b, 0x48, 0xf4, 0x35, 0xac, 0x8f, 0x13, 0xa2, 0x38, 0x8f, 0xb3, 0x6a, 0xf6, 0xae, 0x47, 0x64,
-	0x3b, 0x57, 0x08, 0x3d, 0x85, 0x8d, 0x16, 0xf5, 0xf7, 0x88, 0xe7, 0x09, 0xc1, 0x3b, 0x8a, 0x28,
-	0xd9, 0xa6, 0x01, 0x13, 0xd1, 0x59, 0xae, 0xda, 0xf9, 0x44, 0xf4, 0x7b, 0xb8, 0xd3, 0x0e, 0xa8,
-	0x5e, 0x77, 0x88, 0xa2, 0xee, 0xa9, 0xf0, 0x42, 0x3f, 0xce, 0x1e, 0x35, 0x3b, 0x8f, 0xa4, 0xd3,
-	0xbf, 0x8a, 0x5d, 0x6a, 0x4e, 0x2b, 0x2f, 0xfd, 0x27, 0x3e, 0xb7, 0x47, 0xac, 0xa8, 0x03, 0x35,
-	0x13, 0x7e, 0xfa, 0xe6, 0xc4, 0x79, 0xe3, 0xb3, 0x8c, 0x5c, 0xae, 0x9b, 0x76, 0x46, 0x72, 0x4d,
-	0xae, 0x82, 0xa1, 0x7d, 0x89, 0x53, 0x10, 0xf3, 0x4b, 0x85, 0x31, 0xbf, 0x0f, 0xab, 0x4e, 0xfa,
-	0xd2, 0x58, 0x37, 0xcd, 0x06, 0xee, 0x67, 0x93, 0x50, 0x9a, 0xcb, 0x1e, 0x17, 0x42, 0x3f, 0x95,
-	0x60, 0x8b, 0x25, 0x61, 0xb0, 0x2f, 0x7c, 0xc2, 0xf8, 0x0b, 0xa5, 0x88, 0xd3, 0xf3, 0x29, 0x57,
-	0x56, 0xd5, 0xec, 0xad, 0xf9, 0x9e, 0x7b, 0x3b, 0x2c, 0xc2, 0x89, 0xf6, 0x5a, 0xac, 0x07, 0x71,
-	0x40, 0x23, 0xe2, 0x28, 0x08, 0xad, 0x9a, 0xd1, 0xfe, 0xc5, 0x75, 0xb5, 0xa7, 0x22, 0x5d, 0xab,
-	0xcd, 0x41, 0xae, 0xbf, 0x85, 0xb5, 0xf1, 0x83, 0xd0, 0x69, 0xf3, 0x82, 0x0e, 0xe3, 0x68, 0xd7,
-	0x43, 0xb4, 0x9b, 0x7e, 0x5a, 0xf3, 0x02, 0x23, 0xc9, 0x9d, 0xf1, 0xab, 0xfb, 0x6c, 0xe1, 0x0f,
-	0xa5, 0xfa, 0x2b, 0xb8, 0x7f, 0xb5, 0x17, 0x72, 0x14, 0x8d, 0xbd, 0xe1, 0xb5, 0x34, 0xda, 0xf7,
-	0x70, 0xb7, 0x60, 0x57, 0x39, 0x30, 0xcf, 0xc7, 0xed, 0xfd, 0x6d, 0xc6, 0xde, 0xc2, 0xdb, 0x9e,
-	0x52, 0x89, 0x07, 0x00, 0xa7, 0xad, 0x43, 0x9b, 0x7e, 0xaf, 0xd3, 0x1b, 0x7a, 0x08, 0xe5, 0x81,
-	0xcf, 0xe2, 0x3b, 0x9c, 0x7d, 0x1a, 0x35, 0xa7, 0x66, 0x40, 0xcf, 0xe1, 0xa6, 0x88, 0x8e, 0x21,
-	0xd6, 0xfe, 0xf0, 0xfd, 0x0e, 0xcd, 0x4e, 0xc4, 0xf0, 0x31, 0xdc, 0xba, 0xb4, 0xe7, 0x9a, 0xda,
-	0xad, 0x71, 0xed, 0x2b, 0x97, 0xa8, 0x3f, 0x95, 0x60, 0xb9, 0xf9, 0x8e, 0x3a, 0x09, 0xe2, 0x7d,
-	0x00, 0xd7, 0x9c, 0xca, 0x11, 0xf1, 0x69, 0xec, 0xbc, 0xd4, 0x8a, 0x46, 0x6a, 0x08, 0xdf, 0x27,
-	0xdc, 0x4d, 0x1e, 0xdc, 0x78, 0xaa, 0x2b, 0x9d, 0x17, 0x41, 0x37, 0x49, 0x26, 0x66, 0x8c, 0x1e,
-	0xc2, 0x9a, 0x62, 0x3e, 0x15, 0xa1, 0xea, 0x50, 0x47, 0x70, 0x57, 0x9a, 0x1c, 0xb2, 0x68, 0x4f,
-	0xac, 0xe2, 0x35, 0x58, 0x69, 0xfa, 0x7d, 0x35, 0x8c, 0xad, 0xc0, 0x5f, 0x40, 0xd5, 0x4e, 0x55,
-	0x92, 0x32, 0x74, 0x1c, 0x2a, 0x65, 0xfc, 0xbc, 0x25, 0x53, 0x4d, 0xf1, 0xa9, 0x94, 0xa4, 0x9b,
-	0x04, 0x46, 0x32, 0xc5, 0xdf, 0xc1, 0x5a, 0x14, 0x5b, 0xb3, 0x96, 0xb1, 0x9b, 0xb0, 0x14, 0x6d,
-	0x3e, 0xd6, 0x10, 0xcf, 0x30, 0x87, 0x3b, 0x91, 0x02, 0x93, 0x5d, 0x67, 0xd5, 0xb2, 0x0d, 0xcb,
-	0xee, 0x25, 0x5a, 0x52, 0x42, 0xa4, 0x96, 0xf0, 0x3b, 0xb8, 0x6d, 0x9e, 0x53, 0x73, 0x9b, 0x66,
-	0xd4, 0xf6, 0x31, 0xdc, 0xee, 0x4e, 0x62, 0xc5, 0x3a, 0xb3, 0x04, 0xfc, 0x8f, 0x12, 0x6c, 0x18,
-	0xd5, 0x27, 0x92, 0x06, 0xaf, 0x98, 0x54, 0xb3, 0xaa, 0x7f, 0x0a, 0x1b, 0xdd, 0x3c, 0xbc, 0xd8,
-	0x84, 0x7c, 0x22, 0xfe, 0x77, 0x09, 0x2c, 0x63, 0x86, 0xae, 0xa8, 0xe4, 0x50, 0x2a, 0xea, 0xcf,
-	0xec, 0xf6, 0x67, 0x60, 0x75, 0x0b, 0x20, 0x63, 0x63, 0x0a, 0xe9, 0x78, 0x08, 0x2b, 0xd1, 0xb5,
-	0x99, 0xcd, 0x84, 0x3a, 0x54, 0xe9, 0x3b, 0xa6, 0x1a, 0xc2, 0x8d, 0x54, 0x2e, 0xda, 0xa3, 0xb9,
-	0x8e, 0x3d, 0xa9, 0xdc, 0xd7, 0xa1, 0x8a, 0x0b, 0xd8, 0x78, 0x86, 0xbf, 0x81, 0x5b, 0xc6, 0x13,
-	0x6d, 0x5d, 0xa6, 0xbf, 0xe7, 0xb5, 0xcd, 0x5e, 0xc4, 0x85, 0xdc, 0x8b, 0xf8, 0x55, 0x1c, 0x67,
-	0x11, 0xf6, 0x4c, 0x7b, 0xc3, 0x02, 0x56, 0x75, 0x45, 0xf9, 0x23, 0xbd, 0x6e, 0xb6, 0xfa, 0x1c,
-	0x36, 0x43, 0x7e, 0x6e, 0x44, 0x8f, 0xf3, 0x8c, 0x2e, 0xa0, 0xe2, 0xb7, 0x70, 0x3b, 0xea, 0x8f,
-	0xf6, 0x43, 0xbf, 0x7f, 0x5d, 0xa5, 0x75, 0xa8, 0xba, 0xa1, 0xdf, 0x6f, 0x13, 0xd5, 0x8b, 0x0f,
-	0x7f, 0x34, 0xc7, 0x67, 0xf0, 0x41, 0xa7, 0x79, 0x3a, 0x8f, 0xbb, 0xa7, 0x93, 0x19, 0x1d, 0x98,
-	0xaa, 0x28, 0x4e, 0xc4, 0xf1, 0x14, 0xff, 0xad, 0x04, 0x5b, 0xaf, 0x4c, 0xc7, 0xde, 0xa2, 0x44,
-	0x86, 0x01, 0xd5, 0x0f, 0xe2, 0x1c, 0xae, 0xba, 0x37, 0x89, 0x19, 0x2b, 0xce, 0x12, 0xf0, 0xb7,
-	0xba, 0xde, 0xfd, 0x2b, 0x75, 0x54, 0x64, 0x47, 0x87, 0x3a, 0x01, 0x55, 0xf3, 0x7b, 0x6a, 0x24,
-	0x6c, 0xee, 0xb3, 0x40, 0x0d, 0x6d, 0xa2, 0xe8, 0x5c, 0xd2, 0x26, 0x86, 0x15, 0x37, 0x01, 0x6c,
-	0x9d, 0x45, 0xfa, 0xca, 0xf6, 0xd8, 0x1a, 0x96, 0x80, 0x3a, 0x4e, 0x40, 0x29, 0x97, 0x3d, 0x31,
-	0xb3, 0x3b, 0x11, 0x54, 0x7c, 0xe6, 0x27, 0xc9, 0xc1, 0x8c, 0xf5, 0x9a, 0x4b, 0x14, 0x31, 0x77,
-	0x74, 0xc5, 0x36, 0x63, 0xfc, 0x06, 0x56, 0xf7, 0x88, 0x73, 0x11, 0xf6, 0xe7, 0xe7, 0x3c, 0x07,
-	0xb6, 0x6c, 0xea, 0xd2, 0x73, 0xc6, 0x69, 0xa3, 0x47, 0x9d, 0x8b, 0xbe, 0x60, 0xfc, 0xda, 0x67,
-	0x73, 0x1f, 0xc0, 0x19, 0x09, 0xc7, 0x1a, 0x52, 0x2b, 0xf8, 0xef, 0x25, 0xa8, 0xe7, 0x69, 0x99,
-	0x39, 0x08, 0x2f, 0x75, 0x1c, 0xf2, 0x01, 0xf1, 0x58, 0xd2, 0x72, 0x66, 0x09, 0x4f, 0xfe, 0x73,
-	0x17, 0xca, 0x0d, 0xdf, 0x45, 0x47, 0x80, 0x3a, 0x43, 0xee, 0x8c, 0x17, 0x45, 0xe8, 0xc3, 0xdc,
-	0xcd, 0x45, 0x6e, 0xa8, 0x17, 0x5b, 0x83, 0x6f, 0xa0, 0xd7, 0x70, 0xa7, 0x4d, 0x42, 0x49, 0xe7,
-	0x06, 0xf8, 0x06, 0x36, 0x4e, 0x78, 0x7f, 0xae, 0x90, 0x1d, 0x58, 0x8f, 0x32, 0xe6, 0x04, 0x62,
-	0xb6, 0x63, 0x19, 0x4b, 0xac, 0x57, 0x83, 0xda, 0xb0, 0x79, 0x12, 0xe7, 0xcb, 0x79, 0x3a, 0xd3,
-	0xa6, 0x92, 0xaa, 0xb9, 0x01, 0x1e, 0x83, 0xd5, 0x11, 0xe7, 0xca, 0xa6, 0x67, 0x42, 0xcc, 0x0f,
-	0xd5, 0x86, 0xcd, 0x4e, 0x2f, 0x54, 0xae, 0xf8, 0x81, 0xcf, 0x0d, 0xf3, 0x08, 0xd0, 0xd7, 0xcc,
-	0xf3, 0xe6, 0x86, 0xd7, 0x86, 0xf5, 0x7d, 0xea, 0x51, 0x35, 0xbf, 0xc3, 0x79, 0x0b, 0x1b, 0x51,
-	0xa3, 0x30, 0x09, 0xf9, 0xcb, 0xec, 0xe7, 0xc4, 0x89, 0x86, 0x62, 0xea, 0xa9, 0xeb, 0x2b, 0x39,
-	0x12, 0x3a, 0x26, 0x41, 0x97, 0xaa, 0x19, 0x2c, 0xfd, 0x13, 0xdc, 0x6b, 0x10, 0xee, 0xd0, 0x09,
-	0x6f, 0x5e, 0x7e, 0x64, 0x99, 0xed, 0xe8, 0x59, 0x97, 0x13, 0x2f, 0x32, 0xb2, 0x2d, 0xdc, 0x86,
-	0x47, 0x09, 0x0f, 0xfb, 0x33, 0x60, 0xfe, 0x19, 0x1e, 0xbc, 0x64, 0x9c, 0x78, 0x6c, 0xf2, 0x26,
-	0xcd, 0xc3, 0xe0, 0x23, 0x40, 0x5f, 0x0a, 0xd5, 0xf7, 0xc2, 0xee, 0x97, 0x42, 0xaa, 0x7d, 0x3a,
-	0x60, 0x0e, 0x95, 0x33, 0xe0, 0xb5, 0xa0, 0x76, 0x40, 0x55, 0xd4, 0xa4, 0xa0, 0x7b, 0x19, 0xce,
-	0x74, 0xbb, 0x55, 0x7f, 0x90, 0xed, 0xdc, 0xc7, 0xba, 0x27, 0x13, 0x54, 0x6b, 0x23, 0x38, 0xf3,
-	0x78, 0x4f, 0xc3, 0xfc, 0x75, 0x01, 0xe6, 0xd8, 0xcb, 0x6f, 0x72, 0xde, 0xca, 0x01, 0x55, 0xa3,
-	0xe6, 0x66, 0x1a, 0x2c, 0xce, 0x90, 0x33, 0x7d, 0x91, 0x01, 0xad, 0x1e, 0x50, 0xd3, 0x44, 0x4c,
-	0xb5, 0xf3, 0x61, 0x3e, 0x60, 0xa6, 0x01, 0xb9, 0x81, 0xfe, 0x62, 0x5c, 0x90, 0x6a, 0x06, 0xa6,
-	0x41, 0x7f, 0x94, 0x0f, 0x9d, 0xd7, 0x4e, 0xdc, 0x40, 0x7b, 0x50, 0xd1, 0x45, 0xf7, 0x34, 0xcc,
-	0x2b, 0xcf, 0xbc, 0x09, 0x15, 0xdd, 0x94, 0xa0, 0x5f, 0x64, 0x31, 0x2e, 0x5b, 0xfc, 0xfa, 0xbd,
-	0x02, 0x6a, 0x2a, 0x19, 0xd7, 0x46, 0x4d, 0x40, 0x4e, 0xd2, 0x98, 0x6c, 0x3e, 0x8a, 0xce, 0x24,
-	0xdd, 0x43, 0x98, 0xdb, 0x63, 0x4d, 0xdc, 0x9a, 0x51, 0xad, 0x8e, 0x70, 0xc1, 0x1f, 0x1d, 0xa9,
-	0x42, 0x7e, 0x5a, 0xce, 0xd3, 0x67, 0x93, 0xfa, 0xff, 0xea, 0xfa, 0xe1, 0x99, 0xf3, 0xe7, 0x57,
-	0x9c, 0x47, 0x32, 0x65, 0x48, 0xa3, 0x7d, 0x22, 0x67, 0x7c, 0xec, 0x32, 0x98, 0xf1, 0xff, 0x48,
-	0xb3, 0xbc, 0xc9, 0x70, 0x40, 0x55, 0xdc, 0xa7, 0x4c, 0xdb, 0xfe, 0x76, 0xf6, 0x6b, 0xf3, 0x78,
-	0x83, 0x83, 0x6f, 0x20, 0x02, 0xeb, 0x07, 0x54, 0x65, 0x7a, 0x92, 0xab, 0x4d, 0xcc, 0x7e, 0x54,
-	0x2b, 0x6c, 0x6a, 0xf0, 0x0d, 0xf4, 0x2d, 0xa0, 0x6c, 0xc7, 0x81, 0xf2, 0x3e, 0xcc, 0x15, 0xb4,
-	0x25, 0x57, 0xbb, 0xc4, 0x81, 0xbb, 0xa3, 0xa4, 0x35, 0xde, 0x7a, 0x4c, 0xf3, 0xcf, 0x6f, 0x72,
-	0xbe, 0x65, 0xe6, 0xb5, 0x2e, 0x26, 0xd7, 0xac, 0x6a, 0xbf, 0x8f, 0x9a, 0x8c, 0xab, 0xfd, 0xf3,
-	0xab, 0xac, 0xe3, 0x33, 0xed, 0x49, 0x54, 0x09, 0x46, 0x1d, 0xc4, 0xd4, 0x4a, 0x70, 0xac, 0xd1,
-	0xb8, 0xda, 0x1d, 0x02, 0x50, 0xb6, 0xba, 0xcf, 0xf1, 0x76, 0x61, 0xa3, 0x51, 0xff, 0xdd, 0x7b,
-	0xf1, 0x26, 0x0a, 0xf7, 0x2a, 0xdf, 0x2c, 0x0c, 0x1e, 0x9f, 0x2d, 0x99, 0x3f, 0x9c, 0x3f, 0xfd,
-	0x39, 0x00, 0x00, 0xff, 0xff, 0xbf, 0x9c, 0x76, 0x5b, 0x9d, 0x1e, 0x00, 0x00,
+	// 2167 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0x5f, 0x73, 0xdb, 0xb8,
+	0x11, 0xb7, 0x6c, 0xd9, 0xb1, 0xd6, 0x7f, 0x72, 0x41, 0x6c, 0x87, 0xd6, 0xf5, 0x72, 0x2e, 0xda,
+	0x49, 0x7d, 0x6d, 0xce, 0x6e, 0x72, 0xb9, 0x4c, 0x27, 0xd3, 0xe9, 0x24, 0x96, 0x65, 0x9f, 0x73,
+	0x56, 0xa2, 0x50, 0xb1, 0x33, 0x4d, 0x9b, 0xb9, 0x81, 0x49, 0x58, 0x42, 0x4d, 0x02, 0x3a, 0x02,
+	0xd4, 0x45, 0xe9, 0x4b, 0x3b, 0xd7, 0xe9, 0x43, 0x67, 0xfa, 0xd5, 0xfa, 0xd8, 0xbe, 0xf5, 0x4b,
+	0xf4, 0x0b, 0x74, 0x00, 0x92, 0x32, 0x25, 0x92, 0x72, 0x3c, 0xd2, 0x93, 0x01, 0xec, 0xee, 0x6f,
+	0x17, 0xbb, 0x8b, 0x05, 0x96, 0x32, 0x7c, 0xd1, 0xbd, 0x68, 0xef, 0x76, 0x08, 0x77, 0x3d, 0x1a,
+	0x7c, 0xe9, 0x91, 0x90, 0x3b, 0x1d, 0x1a, 0x7c, 0xe9, 0x08, 0x7f, 0xd7, 0xf1, 0xdd, 0xdd, 0xde,
+	0x03, 0xfd, 0x67, 0xa7, 0x1b, 0x08, 0x25, 0xd0, 0xcd, 0x8b, 0xf0, 0x8c, 0xf6, 0x58, 0xa0, 0x76,
+	0xf4, 0x5a, 0xef, 0x01, 0x3e, 0x87, 0xdb, 0xaf, 0xa8, 0x1f, 0x9e, 0xd2, 0x40, 0x32, 0xc1, 0x6d,
+	0x2a, 0xbb, 0x82, 0x4b, 0x8a, 0xbe, 0x86, 0xc5, 0x20, 0x1e, 0x5b, 0xa5, 0xad, 0xd2, 0xf6, 0xd2,
+	0xc3, 0xcd, 0x9d, 0x11, 0xd1, 0x9d, 0x84, 0xd9, 0x1e, 0xb0, 0x22, 0x0b, 0x6e, 0xf4, 0x22, 0x24,
+	0x6b, 0x76, 0xab, 0xb4, 0x5d, 0xb1, 0x93, 0x29, 0xfe, 0x1c, 0xe6, 0x4e, 0x1b, 0x47, 0x86, 0xc1,
+	0x67, 0xcf, 0xa5, 0xe0, 0x06, 0x76, 0xd9, 0x4e, 0xa6, 0xf8, 0x01, 0xcc, 0xd5, 0x9a, 0x27, 0x68,
+	0x15, 0x66, 0x99, 0x6b, 0x68, 0x2b, 0xf6, 0x2c, 0x73, 0x51, 0x15, 0x16, 0x25, 0x3b, 0xf3, 0x18,
+	0x6f, 0x4b, 0x6b, 0x76, 0x6b, 0x6e, 0x7b, 0xc5, 0x1e, 0xcc, 0xf1, 0x2e, 0xdc, 0x68, 0x45, 0xe3,
+	0x8c, 0xd8, 0x1a, 0xcc, 0xf7, 0x88, 0x17, 0x52, 0x63, 0x46, 0xd9, 0x8e, 0x26, 0xb8, 0x0e, 0xf3,
+	0x4d, 0xd2, 0xa6, 0x52, 0x93, 0x1d, 0x11, 0x72, 0x65, 0x24, 0xca, 0x76, 0x34, 0x41, 0x08, 0xca,
+	0x21, 0x67, 0x2a, 0x36, 0xdd, 0x8c, 0xf5, 0x9a, 0x64, 0x1f, 0xa8, 0x35, 0x67, 0xa0, 0xcd, 0x18,
+	0x3f, 0x82, 0x85, 0x06, 0xf5, 0x45, 0xd0, 0x47, 0x1b, 0xb0, 0x40, 0xfc, 0x14, 0x50, 0x3c, 0xcb,
+	0x43, 0xc2, 0xff, 0x29, 0x41, 0xb9, 0x46, 0x3d, 0x2f, 0x63, 0xeb, 0x2e, 0x2c, 0xf8, 0x06, 0xce,
+	0xb0, 0x2f, 0x3d, 0xbc, 0x93, 0xf1, 0x74, 0xa4, 0xcd, 0x8e, 0xd9, 0xd0, 0x7d, 0x98, 0xef, 0xea,
+	0x6d, 0x58, 0x73, 0x5b, 0x73, 0xdb, 0x4b, 0x0f, 0x37, 0x32, 0xfc, 0x66, 0x93, 0x76, 0xc4, 0x84,
+	0x1e, 0x43, 0xc5, 0x65, 0x52, 0x11, 0xee, 0x50, 0x69, 0x95, 0x8d, 0x84, 0x95, 0x91, 0x88, 0xfd,
+	0x68, 0x5f, 0xb2, 0xa2, 0x6d, 0x28, 0x3b, 0xdd, 0x50, 0x5a, 0xf3, 0x46, 0x64, 0x2d, 0x23, 0x52,
+	0x6b, 0x9e, 0xd8, 0x86, 0x03, 0x3f, 0x85, 0xc5, 0xd7, 0xa2, 0x2b, 0x3c, 0xd1, 0xee, 0xa3, 0x47,
+	0x00, 0x3c, 0xf4, 0xc9, 0x77, 0x0e, 0xf5, 0x3c, 0x69, 0x95, 0x8c, 0xec, 0x7a, 0x56, 0x96, 0x7a,
+	0x9e, 0x5d, 0xd1, 0x8c, 0x7a, 0x24, 0xf1, 0x3f, 0x4a, 0xb0, 0xd0, 0x6a, 0xec, 0x31, 0x21, 0x11,
+	0x86, 0x65, 0x9f, 0xf0, 0xf0, 0x9c, 0x38, 0x2a, 0x0c, 0x68, 0x60, 0xfc, 0x54, 0xb1, 0x87, 0xd6,
+	0x74, 0x16, 0x75, 0x03, 0xe1, 0x86, 0x4e, 0xe2, 0xe1, 0x64, 0x9a, 0x4e, 0xc0, 0xb9, 0xa1, 0x04,
+	0x44, 0x9f, 0xc0, 0x9c, 0xbc, 0x08, 0xad, 0xb2, 0x59, 0xd5, 0x43, 0x1d, 0xbc, 0x73, 0xe2, 0x33,
+	0xaf, 0x6f, 0xcd, 0x9b, 0xc5, 0x78, 0x86, 0xff, 0x5e, 0x82, 0xc5, 0x7d, 0x26, 0x2f, 0x8e, 0xf8,
+	0xb9, 0x30, 0x4c, 0x22, 0xf0, 0x89, 0x8a, 0x0d, 0x89, 0x67, 0x68, 0x0b, 0x96, 0xce, 0x88, 0x73,
+	0xc1, 0x78, 0xfb, 0x80, 0x79, 0x34, 0x36, 0x23, 0xbd, 0x84, 0xee, 0x02, 0x68, 0x7b, 0x89, 0xd7,
+	0x4a, 0xf2, 0xa7, 0x6c, 0xa7, 0x56, 0x34, 0x82, 0x76, 0x49, 0xc2, 0x50, 0x36, 0x0c, 0xe9, 0x25,
+	0xfc, 0xbf, 0x59, 0x58, 0xa9, 0x79, 0xa1, 0x54, 0x34, 0xa8, 0x09, 0x7e, 0xce, 0xda, 0x68, 0x07,
+	0x50, 0xfd, 0x7d, 0x97, 0x70, 0x57, 0xdb, 0x27, 0xeb, 0x9c, 0x9c, 0x79, 0x34, 0x4a, 0xa5, 0x45,
+	0x3b, 0x87, 0x82, 0x7e, 0x0b, 0x9b, 0x07, 0x01, 0xa5, 0x3a, 0x1f, 0x6c, 0xda, 0x15, 0x81, 0x62,
+	0xbc, 0xbd, 0xcf, 0x64, 0x24, 0x36, 0x6b, 0xc4, 0x8a, 0x19, 0xd0, 0x13, 0xb0, 0xf6, 0x84, 0xd3,
+	0x91, 0xfb, 0x4c, 0x76, 0x3d, 0xd2, 0x3f, 0x10, 0x41, 0xfd, 0xe0, 0xe8, 0x30, 0xa4, 0x52, 0x49,
+	0xb3, 0x9f, 0x45, 0xbb, 0x90, 0xae, 0x65, 0x5b, 0x34, 0x60, 0xc4, 0xab, 0x09, 0x2e, 0x85, 0x47,
+	0x8f, 0xc5, 0xa5, 0xe2, 0x72, 0x24, 0x5b, 0x44, 0x47, 0x4f, 0xe1, 0xd3, 0x66, 0xed, 0xe8, 0xc5,
+	0x49, 0xe3, 0xd9, 0xb3, 0x1f, 0x48, 0x40, 0x93, 0xdc, 0x4a, 0xb6, 0x3b, 0x6f, 0xc4, 0xc7, 0xb1,
+	0x68, 0xed, 0xa7, 0x87, 0xcd, 0x93, 0x63, 0xd6, 0xa3, 0x0d, 0xd6, 0x0e, 0x88, 0x62, 0x82, 0x27,
+	0xe2, 0x0b, 0x91, 0xf6, 0x22, 0x3a, 0xfe, 0x0a, 0x36, 0x8f, 0xb8, 0xa2, 0xc1, 0x39, 0x71, 0xe8,
+	0x1e, 0xe3, 0x2e, 0xe3, 0xed, 0x01, 0x8f, 0x4e, 0x87, 0x06, 0x55, 0x1d, 0xe1, 0x26, 0xe9, 0x10,
+	0xcd, 0xf0, 0x7f, 0x6f, 0xc0, 0xfa, 0x69, 0x14, 0xba, 0x06, 0x71, 0x3a, 0x8c, 0xd3, 0x97, 0x5d,
+	0x2d, 0x20, 0xd1, 0xb7, 0xb0, 0x36, 0x4c, 0x88, 0xf2, 0xdc, 0x2a, 0x15, 0x9c, 0xf5, 0x88, 0x6c,
+	0xe7, 0x0a, 0xa1, 0x47, 0xb0, 0xde, 0xa0, 0xfe, 0x1e, 0xf1, 0x3c, 0x21, 0x78, 0x4b, 0x11, 0x25,
+	0x9b, 0x34, 0x60, 0x22, 0x8a, 0xe5, 0x8a, 0x9d, 0x4f, 0x44, 0xbf, 0x86, 0xdb, 0xcd, 0x80, 0xea,
+	0x75, 0x87, 0x28, 0xea, 0x9e, 0x0a, 0x2f, 0xf4, 0xe3, 0xea, 0x51, 0xb1, 0xf3, 0x48, 0xba, 0xfc,
+	0xab, 0xd8, 0xa5, 0x56, 0xb9, 0xa0, 0xfc, 0x27, 0x3e, 0xb7, 0x07, 0xac, 0xa8, 0x05, 0x15, 0x93,
+	0x7e, 0xfa, 0xe4, 0xc4, 0x75, 0xe3, 0xeb, 0x8c, 0x5c, 0xae, 0x9b, 0x76, 0x06, 0x72, 0x75, 0xae,
+	0x82, 0xbe, 0x7d, 0x89, 0x53, 0x90, 0xf3, 0x0b, 0x85, 0x39, 0xbf, 0x0f, 0x2b, 0x4e, 0xfa, 0xd0,
+	0x58, 0x37, 0xcc, 0x06, 0xee, 0x66, 0x8b, 0x50, 0x9a, 0xcb, 0x1e, 0x16, 0x42, 0x3f, 0x96, 0x60,
+	0x93, 0x25, 0x69, 0xb0, 0x2f, 0x7c, 0xc2, 0xf8, 0x33, 0xa5, 0x88, 0xd3, 0xf1, 0x29, 0x57, 0xd6,
+	0xa2, 0xd9, 0x5b, 0xfd, 0x23, 0xf7, 0x76, 0x54, 0x84, 0x13, 0xed, 0xb5, 0x58, 0x0f, 0xe2, 0x80,
+	0x06, 0xc4, 0x41, 0x12, 0x5a, 0x15, 0xa3, 0xfd, 0x77, 0xd7, 0xd5, 0x9e, 0xca, 0x74, 0xad, 0x36,
+	0x07, 0xb9, 0xfa, 0x06, 0x56, 0x87, 0x03, 0xa1, 0xcb, 0xe6, 0x05, 0xed, 0xc7, 0xd9, 0xae, 0x87,
+	0x68, 0x37, 0x7d, 0xb5, 0xe6, 0x25, 0x46, 0x52, 0x3b, 0xe3, 0x5b, 0xf7, 0xc9, 0xec, 0x6f, 0x4a,
+	0xd5, 0x63, 0xb8, 0x3b, 0xde, 0x0b, 0x39, 0x8a, 0x86, 0xee, 0xf0, 0x4a, 0x1a, 0xed, 0x7b, 0xb8,
+	0x53, 0xb0, 0xab, 0x1c, 0x98, 0xa7, 0xc3, 0xf6, 0xfe, 0x32, 0x63, 0x6f, 0xe1, 0x69, 0x4f, 0xa9,
+	0xc4, 0x3d, 0x80, 0xd3, 0xc6, 0x91, 0x4d, 0xbf, 0xd7, 0xe5, 0x0d, 0xdd, 0x83, 0xb9, 0x9e, 0xcf,
+	0xe2, 0x33, 0x9c, 0xbd, 0x1a, 0x35, 0xa7, 0x66, 0x40, 0x4f, 0xe1, 0x86, 0x88, 0xc2, 0x10, 0x6b,
+	0xbf, 0xf7, 0x71, 0x41, 0xb3, 0x13, 0x31, 0xfc, 0x1a, 0x3e, 0xb9, 0xb4, 0xe7, 0x9a, 0xda, 0xad,
+	0x61, 0xed, 0xcb, 0x97, 0xa8, 0x3f, 0x96, 0x60, 0xa9, 0xfe, 0x9e, 0x3a, 0x09, 0xe2, 0x5d, 0x00,
+	0xd7, 0x44, 0xe5, 0x05, 0xf1, 0x69, 0xec, 0xbc, 0xd4, 0x8a, 0x46, 0xaa, 0x09, 0xdf, 0x27, 0xdc,
+	0x4d, 0x2e, 0xdc, 0x78, 0xaa, 0x5f, 0x3a, 0xcf, 0x82, 0x76, 0x52, 0x4c, 0xcc, 0x18, 0xdd, 0x83,
+	0x55, 0xc5, 0x7c, 0x2a, 0x42, 0xd5, 0xa2, 0x8e, 0xe0, 0xae, 0x34, 0x35, 0x64, 0xde, 0x1e, 0x59,
+	0xc5, 0xab, 0xb0, 0x5c, 0xf7, 0xbb, 0xaa, 0x1f, 0x5b, 0x81, 0xff, 0x55, 0x82, 0x45, 0x3b, 0xf5,
+	0x94, 0x94, 0xa1, 0xe3, 0x50, 0x29, 0xe3, 0xfb, 0x2d, 0x99, 0x6a, 0x8a, 0x4f, 0xa5, 0x24, 0xed,
+	0x24, 0x33, 0x92, 0x29, 0x7a, 0x0c, 0x1b, 0x1d, 0xa1, 0xba, 0x5e, 0xd8, 0x3e, 0x20, 0xcc, 0xa3,
+	0xee, 0x20, 0xb2, 0xf1, 0x63, 0xa0, 0x80, 0x8a, 0xb6, 0xe1, 0x26, 0x17, 0xca, 0xa6, 0xc4, 0xed,
+	0xb7, 0x98, 0x4b, 0x1d, 0x12, 0xc4, 0xef, 0x84, 0xd1, 0x65, 0x74, 0x1f, 0x6e, 0x25, 0x4b, 0xf5,
+	0x20, 0x10, 0x41, 0x4d, 0xb8, 0x34, 0x7e, 0x3e, 0x64, 0x09, 0xf8, 0x3b, 0x58, 0x8d, 0x92, 0x7d,
+	0xd2, 0x77, 0xf5, 0x06, 0x2c, 0x44, 0xd1, 0x88, 0x77, 0x1c, 0xcf, 0x30, 0x87, 0xdb, 0x91, 0x02,
+	0x53, 0xee, 0x27, 0xd5, 0xb2, 0x05, 0x4b, 0xee, 0x25, 0x5a, 0xf2, 0xa6, 0x49, 0x2d, 0xe1, 0xf7,
+	0x70, 0xcb, 0xdc, 0xef, 0xe6, 0x78, 0x4f, 0xa8, 0xed, 0x3e, 0xdc, 0x6a, 0x8f, 0x62, 0xc5, 0x3a,
+	0xb3, 0x04, 0xfc, 0xb7, 0x12, 0xac, 0x1b, 0xd5, 0x27, 0x92, 0x06, 0xc7, 0x4c, 0xaa, 0x49, 0xd5,
+	0x3f, 0x82, 0xf5, 0x76, 0x1e, 0x5e, 0x6c, 0x42, 0x3e, 0x11, 0xff, 0xb3, 0x04, 0x96, 0x31, 0x43,
+	0x3f, 0xf1, 0x64, 0x5f, 0x2a, 0xea, 0x4f, 0xec, 0xf6, 0x27, 0x60, 0xb5, 0x0b, 0x20, 0x63, 0x63,
+	0x0a, 0xe9, 0xb8, 0x0f, 0xcb, 0xd1, 0x39, 0x9e, 0xcc, 0x84, 0x2a, 0x2c, 0xd2, 0xf7, 0x4c, 0x99,
+	0x6c, 0x9e, 0x35, 0x67, 0x75, 0x30, 0xd7, 0xb9, 0x27, 0x95, 0xfb, 0x32, 0x54, 0xf1, 0x21, 0x8a,
+	0x67, 0xf8, 0x2d, 0x7c, 0x62, 0x3c, 0xd1, 0xd4, 0x7d, 0xc3, 0x47, 0xd6, 0x91, 0x6c, 0x65, 0x98,
+	0xcd, 0xad, 0x0c, 0xcf, 0xe1, 0x56, 0x0a, 0x7b, 0xa2, 0xbd, 0x61, 0x01, 0x2b, 0xfa, 0x89, 0xfb,
+	0x81, 0x5e, 0xb7, 0x7c, 0x3e, 0x86, 0x8d, 0x90, 0x9f, 0x1b, 0xd1, 0xd7, 0x79, 0x46, 0x17, 0x50,
+	0xf1, 0x1b, 0xb8, 0x15, 0x35, 0x6c, 0xfb, 0xa1, 0xdf, 0xbd, 0xae, 0xd2, 0x2a, 0x2c, 0xba, 0xa1,
+	0xdf, 0x6d, 0x12, 0xd5, 0x89, 0x83, 0x3f, 0x98, 0xe3, 0x33, 0xb8, 0xd9, 0xaa, 0x9f, 0x4e, 0xe3,
+	0xec, 0xe9, 0xe2, 0x4a, 0x7b, 0xe6, 0x99, 0x16, 0xdf, 0x0c, 0xf1, 0x14, 0xff, 0xa5, 0x04, 0x9b,
+	0xc7, 0xe6, 0x13, 0x42, 0x83, 0x12, 0x19, 0x06, 0x54, 0xdf, 0xd0, 0x53, 0x38, 0xea, 0xde, 0x28,
+	0x66, 0xac, 0x38, 0x4b, 0xc0, 0xef, 0xf4, 0x03, 0xfc, 0x4f, 0xd4, 0x51, 0x91, 0x1d, 0x2d, 0xea,
+	0x04, 0x54, 0x4d, 0xef, 0xee, 0x93, 0xb0, 0xb1, 0xcf, 0x02, 0xd5, 0xb7, 0x89, 0xa2, 0x53, 0x29,
+	0x9b, 0x18, 0x96, 0xdd, 0x04, 0xb0, 0x71, 0x16, 0xe9, 0x9b, 0xb3, 0x87, 0xd6, 0xb0, 0x04, 0xd4,
+	0x72, 0x02, 0x4a, 0xb9, 0xec, 0x88, 0x89, 0xdd, 0x89, 0xa0, 0xec, 0x33, 0x3f, 0x29, 0x0e, 0x66,
+	0xac, 0xd7, 0x5c, 0xa2, 0x88, 0x39, 0xa3, 0xcb, 0xb6, 0x19, 0xe3, 0x57, 0xb0, 0xb2, 0x47, 0x9c,
+	0x8b, 0xb0, 0x3b, 0x3d, 0xe7, 0x39, 0xb0, 0x69, 0x53, 0x97, 0x9e, 0x33, 0x4e, 0x6b, 0x1d, 0xea,
+	0x5c, 0x74, 0x05, 0xe3, 0xd7, 0x8e, 0xcd, 0x5d, 0x00, 0x67, 0x20, 0x1c, 0x6b, 0x48, 0xad, 0xe0,
+	0xbf, 0x96, 0xa0, 0x9a, 0xa7, 0x65, 0xe2, 0x24, 0xbc, 0xd4, 0x71, 0xc4, 0x7b, 0xc4, 0x63, 0x49,
+	0x0f, 0x9c, 0x25, 0xe0, 0x3f, 0x03, 0x8a, 0x6e, 0xd6, 0xe7, 0xe2, 0x6c, 0xe2, 0x0c, 0xd9, 0x01,
+	0xe4, 0x66, 0xc0, 0xe2, 0xf0, 0xe5, 0x50, 0xf0, 0x5b, 0xd8, 0xa8, 0xe9, 0x8f, 0x30, 0xde, 0xc0,
+	0x84, 0xe9, 0x45, 0xd0, 0x85, 0x3b, 0xfa, 0x83, 0x5f, 0xfc, 0x7e, 0x3b, 0x66, 0x9c, 0x4e, 0x21,
+	0x1d, 0x49, 0x10, 0x7f, 0x9e, 0xab, 0xd8, 0x66, 0xfc, 0xf0, 0xdf, 0x9b, 0x30, 0x57, 0xf3, 0x5d,
+	0xf4, 0x02, 0x50, 0xab, 0xcf, 0x9d, 0xe1, 0x47, 0x2e, 0xfa, 0x34, 0xd7, 0xf0, 0x68, 0x8b, 0xd5,
+	0x62, 0x9d, 0x78, 0x06, 0xbd, 0x84, 0xdb, 0x4d, 0x12, 0x4a, 0x3a, 0x35, 0xc0, 0x57, 0xb0, 0x7e,
+	0xc2, 0xbb, 0x53, 0x85, 0x6c, 0xc1, 0x5a, 0x74, 0xe1, 0x8c, 0x20, 0x66, 0x3b, 0xd0, 0xa1, 0x7b,
+	0x69, 0x3c, 0xa8, 0x0d, 0x1b, 0x27, 0xfc, 0x3c, 0x0f, 0x76, 0x22, 0x67, 0xda, 0x54, 0x52, 0x35,
+	0x35, 0xc0, 0xd7, 0x60, 0xb5, 0xc4, 0xb9, 0xb2, 0xe9, 0x99, 0x10, 0xd3, 0x43, 0xb5, 0x61, 0xa3,
+	0xd5, 0x09, 0x95, 0x2b, 0x7e, 0xe0, 0x53, 0xc3, 0x7c, 0x01, 0xe8, 0x5b, 0xe6, 0x79, 0x53, 0xc3,
+	0x6b, 0xc2, 0xda, 0x3e, 0xf5, 0xa8, 0x9a, 0x5e, 0x70, 0xde, 0xc0, 0x7a, 0xd4, 0xf8, 0x8d, 0x42,
+	0xfe, 0x34, 0x23, 0x35, 0xda, 0x20, 0x5e, 0x19, 0x75, 0x7d, 0x24, 0x07, 0x42, 0xaf, 0x49, 0xd0,
+	0xa6, 0x6a, 0x02, 0x4b, 0x7f, 0x0f, 0x9f, 0x45, 0xd5, 0x6a, 0xd8, 0xd0, 0x81, 0x82, 0x09, 0x43,
+	0xcf, 0xda, 0x9c, 0x78, 0x91, 0x91, 0x4d, 0xe1, 0xd6, 0x3c, 0x4a, 0x78, 0xd8, 0x9d, 0x00, 0xf3,
+	0x0f, 0xf0, 0xf9, 0x01, 0xe3, 0xc4, 0x63, 0x1f, 0xe8, 0xf4, 0x0d, 0x7e, 0x01, 0xe8, 0x9b, 0xa8,
+	0xc7, 0xfc, 0x46, 0x48, 0xb5, 0x4f, 0x7b, 0xcc, 0xa1, 0x72, 0x02, 0xbc, 0x06, 0x54, 0x0e, 0xa9,
+	0x8a, 0xae, 0x01, 0xf4, 0x59, 0x86, 0x33, 0xdd, 0x3e, 0x57, 0x3f, 0xcf, 0x90, 0x87, 0x9b, 0x4f,
+	0x93, 0x54, 0xab, 0x03, 0x38, 0xf3, 0xf6, 0xb9, 0x0a, 0xf3, 0xe7, 0x05, 0x98, 0x43, 0x0f, 0x27,
+	0x53, 0xf3, 0x96, 0x0f, 0xa9, 0x1a, 0xf4, 0x86, 0x57, 0xc1, 0xe2, 0x0c, 0x39, 0xd3, 0x56, 0x1a,
+	0xd0, 0xc5, 0x43, 0x6a, 0x7a, 0xb0, 0x2b, 0xed, 0xbc, 0x97, 0x0f, 0x98, 0xe9, 0xdf, 0x66, 0xd0,
+	0x1f, 0x8d, 0x0b, 0x52, 0xbd, 0xd4, 0x55, 0xd0, 0x5f, 0xe4, 0x43, 0xe7, 0x75, 0x63, 0x33, 0x68,
+	0x0f, 0xca, 0xba, 0x67, 0xb9, 0x0a, 0x73, 0x6c, 0xcc, 0xeb, 0x50, 0xd6, 0x3d, 0x1d, 0xfa, 0x49,
+	0x16, 0xe3, 0xf2, 0x93, 0x4d, 0xf5, 0xb3, 0x02, 0x6a, 0xaa, 0x18, 0x57, 0x06, 0x3d, 0x54, 0x4e,
+	0xd1, 0x18, 0xed, 0xdd, 0xaa, 0x78, 0x1c, 0x4b, 0xea, 0xf4, 0x58, 0x23, 0xa7, 0x66, 0xd0, 0xea,
+	0x20, 0x5c, 0xf0, 0xc3, 0x55, 0xaa, 0x0f, 0xba, 0xaa, 0xe6, 0xe9, 0xd8, 0xa4, 0x7e, 0x8f, 0xbc,
+	0x7e, 0x7a, 0xe6, 0xfc, 0x98, 0x19, 0xd7, 0x91, 0xcc, 0x33, 0xa4, 0xd6, 0x3c, 0x91, 0x13, 0x5e,
+	0x76, 0x19, 0xcc, 0x68, 0xc3, 0x13, 0xdd, 0xc9, 0x70, 0x48, 0x55, 0xdc, 0xe6, 0x5d, 0xb5, 0xfd,
+	0xad, 0x0c, 0x79, 0xa4, 0x3f, 0xc4, 0x33, 0x88, 0xc0, 0xda, 0x21, 0x55, 0x99, 0x96, 0x6e, 0xbc,
+	0x89, 0xd9, 0x8f, 0xa4, 0x85, 0x3d, 0x21, 0x9e, 0x41, 0xef, 0x00, 0x65, 0x1b, 0x36, 0x94, 0xf7,
+	0xa1, 0xb5, 0xa0, 0xab, 0x1b, 0xef, 0x12, 0x07, 0xee, 0x0c, 0x8a, 0xd6, 0x70, 0xe7, 0x76, 0x95,
+	0x7f, 0x7e, 0x91, 0xf3, 0x6d, 0x3a, 0xaf, 0xf3, 0x33, 0xb5, 0x66, 0x45, 0xfb, 0x7d, 0xd0, 0xa3,
+	0x8d, 0xf7, 0xcf, 0xcf, 0xb2, 0x8e, 0xcf, 0x74, 0x77, 0xd1, 0x4b, 0x30, 0x6a, 0xc0, 0xae, 0x7c,
+	0x09, 0x0e, 0xf5, 0x69, 0xe3, 0xdd, 0x21, 0x00, 0x65, 0x9b, 0xa3, 0x1c, 0x6f, 0x17, 0xf6, 0x69,
+	0xd5, 0x5f, 0x7d, 0x14, 0xef, 0x88, 0x6b, 0x2e, 0xbb, 0xa1, 0xeb, 0xba, 0x26, 0xdb, 0x47, 0x99,
+	0xa3, 0x7e, 0x73, 0xa4, 0xc5, 0x41, 0xd9, 0x68, 0xe5, 0x37, 0x41, 0xe3, 0xdd, 0xf3, 0x0e, 0x50,
+	0x5c, 0x43, 0x52, 0x2d, 0xce, 0x78, 0x93, 0xb7, 0x73, 0xab, 0x48, 0x4e, 0x87, 0x84, 0x67, 0xf6,
+	0xca, 0x6f, 0x67, 0x7b, 0x0f, 0xce, 0x16, 0xcc, 0x7f, 0x53, 0x7c, 0xf5, 0xff, 0x01, 0x00, 0x77,
+	0x55, 0xd3, 0x8a, 0x7a, 0x21, 0x00, 0x00,
 }
@@ -61,6 +61,26 @@ func (mr *MockCmdClientMockRecorder) BackupVirtualMachine(ctx, in any, opts ...a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackupVirtualMachine", reflect.TypeOf((*MockCmdClient)(nil).BackupVirtualMachine), varargs...)
 }
 
+// CancelDomainJob mocks base method.
+func (m *MockCmdClient) CancelDomainJob(ctx context.Context, in *CancelDomainJobRequest, opts ...grpc.CallOption) (*Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CancelDomainJob", varargs...)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelDomainJob indicates an expected call of CancelDomainJob.
+func (mr *MockCmdClientMockRecorder) CancelDomainJob(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDomainJob", reflect.TypeOf((*MockCmdClient)(nil).CancelDomainJob), varargs...)
+}
+
 // CancelVirtualMachineMigration mocks base method.
 func (m *MockCmdClient) CancelVirtualMachineMigration(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error) {
 	m.ctrl.T.Helper()
@@ -201,6 +221,26 @@ func (mr *MockCmdClientMockRecorder) GetDomainDirtyRateStats(ctx, in any, opts .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainDirtyRateStats", reflect.TypeOf((*MockCmdClient)(nil).GetDomainDirtyRateStats), varargs...)
 }
 
+// GetDomainJobs mocks base method.
+func (m *MockCmdClient) GetDomainJobs(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*DomainJobsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDomainJobs", varargs...)
+	ret0, _ := ret[0].(*DomainJobsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDomainJobs indicates an expected call of GetDomainJobs.
+func (mr *MockCmdClientMockRecorder) GetDomainJobs(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainJobs", reflect.TypeOf((*MockCmdClient)(nil).GetDomainJobs), varargs...)
+}
+
 // GetDomainStats mocks base method.
 func (m *MockCmdClient) GetDomainStats(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*DomainStatsResponse, error) {
 	m.ctrl.T.Helper()
@@ -281,6 +321,26 @@ func (mr *MockCmdClientMockRecorder) GetLaunchMeasurement(ctx, in any, opts ...a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLaunchMeasurement", reflect.TypeOf((*MockCmdClient)(nil).GetLaunchMeasurement), varargs...)
 }
 
+// GetQemuCommandLine mocks base method.
+func (m *MockCmdClient) GetQemuCommandLine(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*QemuCommandLineResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetQemuCommandLine", varargs...)
+	ret0, _ := ret[0].(*QemuCommandLineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQemuCommandLine indicates an expected call of GetQemuCommandLine.
+func (mr *MockCmdClientMockRecorder) GetQemuCommandLine(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQemuCommandLine", reflect.TypeOf((*MockCmdClient)(nil).GetQemuCommandLine), varargs...)
+}
+
 // GetQemuVersion mocks base method.
 func (m *MockCmdClient) GetQemuVersion(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*QemuVersionResponse, error) {
 	m.ctrl.T.Helper()
@@ -780,6 +840,21 @@ func (mr *MockCmdServerMockRecorder) BackupVirtualMachine(arg0, arg1 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackupVirtualMachine", reflect.TypeOf((*MockCmdServer)(nil).BackupVirtualMachine), arg0, arg1)
 }
 
+// CancelDomainJob mocks base method.
+func (m *MockCmdServer) CancelDomainJob(arg0 context.Context, arg1 *CancelDomainJobRequest) (*Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelDomainJob", arg0, arg1)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelDomainJob indicates an expected call of CancelDomainJob.
+func (mr *MockCmdServerMockRecorder) CancelDomainJob(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDomainJob", reflect.TypeOf((*MockCmdServer)(nil).CancelDomainJob), arg0, arg1)
+}
+
 // CancelVirtualMachineMigration mocks base method.
 func (m *MockCmdServer) CancelVirtualMachineMigration(arg0 context.Context, arg1 *VMIRequest) (*Response, error) {
 	m.ctrl.T.Helper()
@@ -885,6 +960,21 @@ func (mr *MockCmdServerMockRecorder) GetDomainDirtyRateStats(arg0, arg1 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainDirtyRateStats", reflect.TypeOf((*MockCmdServer)(nil).GetDomainDirtyRateStats), arg0, arg1)
 }
 
+// GetDomainJobs mocks base method.
+func (m *MockCmdServer) GetDomainJobs(arg0 context.Context, arg1 *VMIRequest) (*DomainJobsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDomainJobs", arg0, arg1)
+	ret0, _ := ret[0].(*DomainJobsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDomainJobs indicates an expected call of GetDomainJobs.
+func (mr *MockCmdServerMockRecorder) GetDomainJobs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainJobs", reflect.TypeOf((*MockCmdServer)(nil).GetDomainJobs), arg0, arg1)
+}
+
 // GetDomainStats mocks base method.
 func (m *MockCmdServer) GetDomainStats(arg0 context.Context, arg1 *EmptyRequest) (*DomainStatsResponse, error) {
 	m.ctrl.T.Helper()
@@ -945,6 +1035,21 @@ func (mr *MockCmdServerMockRecorder) GetLaunchMeasurement(arg0, arg1 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLaunchMeasurement", reflect.TypeOf((*MockCmdServer)(nil).GetLaunchMeasurement), arg0, arg1)
 }
 
+// GetQemuCommandLine mocks base method.
+func (m *MockCmdServer) GetQemuCommandLine(arg0 context.Context, arg1 *VMIRequest) (*QemuCommandLineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQemuCommandLine", arg0, arg1)
+	ret0, _ := ret[0].(*QemuCommandLineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQemuCommandLine indicates an expected call of GetQemuCommandLine.
+func (mr *MockCmdServerMockRecorder) GetQemuCommandLine(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQemuCommandLine", reflect.TypeOf((*MockCmdServer)(nil).GetQemuCommandLine), arg0, arg1)
+}
+
 // GetQemuVersion mocks base method.
 func (m *MockCmdServer) GetQemuVersion(arg0 context.Context, arg1 *EmptyRequest) (*QemuVersionResponse, error) {
 	m.ctrl.T.Helper()
@@ -10,6 +10,7 @@
 package hooks
 
 import (
+	context "context"
 	reflect "reflect"
 	time "time"
 
@@ -18,6 +19,7 @@ import (
 
 	cloudinit "kubevirt.io/kubevirt/pkg/cloud-init"
 	api "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	stats "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
 )
 
 // MockManager is a mock of Manager interface.
@@ -44,48 +46,77 @@ func (m *MockManager) EXPECT() *MockManagerMockRecorder {
 	return m.recorder
 }
 
+// CheckReadiness mocks base method.
+func (m *MockManager) CheckReadiness(arg0 context.Context, arg1 *v1.VirtualMachineInstance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckReadiness", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckReadiness indicates an expected call of CheckReadiness.
+func (mr *MockManagerMockRecorder) CheckReadiness(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckReadiness", reflect.TypeOf((*MockManager)(nil).CheckReadiness), arg0, arg1)
+}
+
 // Collect mocks base method.
-func (m *MockManager) Collect(arg0 uint, arg1 time.Duration) error {
+func (m *MockManager) Collect(arg0 uint, arg1 time.Duration, arg2 map[string]string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Collect", arg0, arg1)
+	ret := m.ctrl.Call(m, "Collect", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Collect indicates an expected call of Collect.
-func (mr *MockManagerMockRecorder) Collect(arg0, arg1 any) *gomock.Call {
+func (mr *MockManagerMockRecorder) Collect(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Collect", reflect.TypeOf((*MockManager)(nil).Collect), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Collect", reflect.TypeOf((*MockManager)(nil).Collect), arg0, arg1, arg2)
+}
+
+// NegotiateMigration mocks base method.
+func (m *MockManager) NegotiateMigration(arg0 context.Context, arg1 *v1.VirtualMachineInstance, arg2 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NegotiateMigration", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NegotiateMigration indicates an expected call of NegotiateMigration.
+func (mr *MockManagerMockRecorder) NegotiateMigration(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NegotiateMigration", reflect.TypeOf((*MockManager)(nil).NegotiateMigration), arg0, arg1, arg2)
 }
 
 // OnDefineDomain mocks base method.
-func (m *MockManager) OnDefineDomain(arg0 *api.DomainSpec, arg1 *v1.VirtualMachineInstance) (string, error) {
+func (m *MockManager) OnDefineDomain(arg0 context.Context, arg1 *api.DomainSpec, arg2 *v1.VirtualMachineInstance) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "OnDefineDomain", arg0, arg1)
+	ret := m.ctrl.Call(m, "OnDefineDomain", arg0, arg1, arg2)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // OnDefineDomain indicates an expected call of OnDefineDomain.
-func (mr *MockManagerMockRecorder) OnDefineDomain(arg0, arg1 any) *gomock.Call {
+func (mr *MockManagerMockRecorder) OnDefineDomain(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnDefineDomain", reflect.TypeOf((*MockManager)(nil).OnDefineDomain), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnDefineDomain", reflect.TypeOf((*MockManager)(nil).OnDefineDomain), arg0, arg1, arg2)
 }
 
 // PreCloudInitIso mocks base method.
-func (m *MockManager) PreCloudInitIso(arg0 *v1.VirtualMachineInstance, arg1 *cloudinit.CloudInitData) (*cloudinit.CloudInitData, error) {
+func (m *MockManager) PreCloudInitIso(arg0 context.Context, arg1 *v1.VirtualMachineInstance, arg2 *cloudinit.CloudInitData) (*cloudinit.CloudInitData, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PreCloudInitIso", arg0, arg1)
+	ret := m.ctrl.Call(m, "PreCloudInitIso", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*cloudinit.CloudInitData)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // PreCloudInitIso indicates an expected call of PreCloudInitIso.
-func (mr *MockManagerMockRecorder) PreCloudInitIso(arg0, arg1 any) *gomock.Call {
+func (mr *MockManagerMockRecorder) PreCloudInitIso(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreCloudInitIso", reflect.TypeOf((*MockManager)(nil).PreCloudInitIso), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreCloudInitIso", reflect.TypeOf((*MockManager)(nil).PreCloudInitIso), arg0, arg1, arg2)
 }
 
 // Shutdown mocks base method.
@@ -101,3 +132,17 @@ func (mr *MockManagerMockRecorder) Shutdown() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Shutdown", reflect.TypeOf((*MockManager)(nil).Shutdown))
 }
+
+// Stats mocks base method.
+func (m *MockManager) Stats() []stats.DomainStatsHookSidecar {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stats")
+	ret0, _ := ret[0].([]stats.DomainStatsHookSidecar)
+	return ret0
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockManagerMockRecorder) Stats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockManager)(nil).Stats))
+}
@@ -30,8 +30,32 @@ import (
 const HookSidecarListAnnotationName = "hooks.kubevirt.io/hookSidecars"
 const HookSocketsSharedDirectory = "/var/run/kubevirt-hooks"
 
+// HookSidecarPluginsAnnotationName is set on the virt-launcher pod, mapping the hook sidecar containers
+// to the name of the plugin they run.
+const HookSidecarPluginsAnnotationName = "hooks.kubevirt.io/hookSidecarPlugins"
+
 const ContainerNameEnvVar = "CONTAINER_NAME"
 
+// ScriptHookPath is where the script of a script hook is mounted in the sidecar
+const ScriptHookPath = "/var/run/kubevirt-hook-script/hook"
+
+// ScriptHookDefaultVersion is the hook version the sidecar-shim serves a script hook with when no args are given
+const ScriptHookDefaultVersion = "v1alpha3"
+
+// Hook points a script hook can be attached to, named after the binaries the sidecar-shim looks up
+const (
+	OnDefineDomainScriptHook  = "onDefineDomain"
+	PreCloudInitIsoScriptHook = "preCloudInitIso"
+)
+
+type ScriptInterpreter string
+
+// Interpreters available in the sidecar-shim image to run script hooks
+const (
+	ScriptInterpreterShell  ScriptInterpreter = "sh"
+	ScriptInterpreterPython ScriptInterpreter = "python3"
+)
+
 type HookSidecarList []HookSidecar
 
 type ConfigMap struct {
@@ -40,6 +64,17 @@ type ConfigMap struct {
 	HookPath string `json:"hookPath"`
 }
 
+// Script is a hook script stored in a ConfigMap and run by the sidecar-shim with one of its built-in
+// interpreters. The script receives the domain XML (onDefineDomain) or the cloud-init data as JSON
+// (preCloudInitIso) on stdin and the VMI as JSON through the --vmi argument, and is expected to print
+// the mutated input on stdout.
+type Script struct {
+	ConfigMapName string            `json:"configMapName"`
+	Key           string            `json:"key"`
+	Hook          string            `json:"hook"`
+	Interpreter   ScriptInterpreter `json:"interpreter,omitempty"`
+}
+
 type PVC struct {
 	Name              string `json:"name"`
 	VolumePath        string `json:"volumePath"`
@@ -52,8 +87,13 @@ type HookSidecar struct {
 	Command         []string                         `json:"command,omitempty"`
 	Args            []string                         `json:"args,omitempty"`
 	ConfigMap       *ConfigMap                       `json:"configMap,omitempty"`
+	Script          *Script                          `json:"script,omitempty"`
 	PVC             *PVC                             `json:"pvc,omitempty"`
 	DownwardAPI     v1.NetworkBindingDownwardAPIType `json:"-"`
+	// PluginName is the name of the plugin running in the sidecar, like a network binding plugin
+	PluginName string `json:"-"`
+	// Resources are the sidecar container resources requested by the plugin registration
+	Resources *v1.ResourceRequirementsWithoutClaims `json:"-"`
 }
 
 func UnmarshalHookSidecarList(vmiObject *v1.VirtualMachineInstance) (HookSidecarList, error) {
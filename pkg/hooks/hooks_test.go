@@ -65,5 +65,36 @@ var _ = Describe("HooksAPI", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(equality.Semantic.DeepEqual(hookSidecarList, expectedHookSidecarList)).To(BeTrue())
 		})
+
+		It("by unmarshalling a script hook", func() {
+			vmiHookObject := &v1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						hooks.HookSidecarListAnnotationName: `
+[
+  {
+    "script": {
+      "configMapName": "my-hooks",
+      "key": "hook.py",
+      "hook": "onDefineDomain",
+      "interpreter": "python3"
+    }
+  }
+]
+`,
+					},
+				},
+			}
+			hookSidecarList, err := hooks.UnmarshalHookSidecarList(vmiHookObject)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hookSidecarList).To(Equal(hooks.HookSidecarList{{
+				Script: &hooks.Script{
+					ConfigMapName: "my-hooks",
+					Key:           "hook.py",
+					Hook:          hooks.OnDefineDomainScriptHook,
+					Interpreter:   hooks.ScriptInterpreterPython,
+				},
+			}}))
+		})
 	})
 })
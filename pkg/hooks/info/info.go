@@ -22,3 +22,5 @@ package info
 const OnDefineDomainHookPointName = "OnDefineDomain"
 const PreCloudInitIsoHookPointName = "PreCloudInitIso"
 const ShutdownHookPointName = "Shutdown"
+const NegotiateMigrationHookPointName = "NegotiateMigration"
+const CheckReadinessHookPointName = "CheckReadiness"
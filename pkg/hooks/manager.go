@@ -23,14 +23,20 @@ import (
 	"context"
 	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
@@ -39,8 +45,10 @@ import (
 	hooksV1alpha1 "kubevirt.io/kubevirt/pkg/hooks/v1alpha1"
 	hooksV1alpha2 "kubevirt.io/kubevirt/pkg/hooks/v1alpha2"
 	hooksV1alpha3 "kubevirt.io/kubevirt/pkg/hooks/v1alpha3"
+	"kubevirt.io/kubevirt/pkg/network/bindingplugin/errcode"
 	grpcutil "kubevirt.io/kubevirt/pkg/util/net/grpc"
 	virtwrapApi "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
 )
 
 //go:generate mockgen -source $GOFILE -package=$GOPACKAGE -destination=generated_mock_$GOFILE
@@ -50,6 +58,7 @@ const dialSockErr = "Failed to Dial hook socket: %s"
 type callBackClient struct {
 	SocketPath           string
 	Version              string
+	ContainerName        string
 	subscribedHookPoints []*hooksInfo.HookPoint
 }
 
@@ -58,14 +67,29 @@ var once sync.Once
 
 type (
 	Manager interface {
-		Collect(uint, time.Duration) error
-		OnDefineDomain(*virtwrapApi.DomainSpec, *v1.VirtualMachineInstance) (string, error)
-		PreCloudInitIso(*v1.VirtualMachineInstance, *cloudinit.CloudInitData) (*cloudinit.CloudInitData, error)
+		Collect(uint, time.Duration, map[string]string) error
+		// OnDefineDomain and PreCloudInitIso call the sidecars with the deadline and the cancellation of
+		// the context, so that the sidecars stop waiting once virt-launcher gave up on the call
+		OnDefineDomain(context.Context, *virtwrapApi.DomainSpec, *v1.VirtualMachineInstance) (string, error)
+		PreCloudInitIso(context.Context, *v1.VirtualMachineInstance, *cloudinit.CloudInitData) (*cloudinit.CloudInitData, error)
 		Shutdown() error
+		// NegotiateMigration returns the interfaces the sidecars ask to unplug from the source domain
+		// before the migration, and to plug into the target domain once it completed. It is called
+		// with the role of virt-launcher in the migration, see the v1alpha3 MigrationRole constants.
+		NegotiateMigration(context.Context, *v1.VirtualMachineInstance, string) ([]string, error)
+		// CheckReadiness returns once the sidecars completed the configuration of the VMI, before its domain
+		// is started. The fatal error a sidecar reported is returned as an errcode.NotReadyError. The sidecars
+		// which cannot be dialed, or do not implement the hook point, are considered ready.
+		CheckReadiness(context.Context, *v1.VirtualMachineInstance) error
+		Stats() []stats.DomainStatsHookSidecar
 	}
 	hookManager struct {
 		CallbacksPerHookPoint     map[string][]*callBackClient
 		hookSocketSharedDirectory string
+
+		statsLock sync.Mutex
+		// sidecarStats counts the requests sent to the hook sidecars, by sidecar container name
+		sidecarStats map[string]*stats.DomainStatsHookSidecar
 	}
 )
 
@@ -77,10 +101,16 @@ func GetManager() Manager {
 }
 
 func newManager(baseDir string) *hookManager {
-	return &hookManager{CallbacksPerHookPoint: make(map[string][]*callBackClient), hookSocketSharedDirectory: baseDir}
+	return &hookManager{
+		CallbacksPerHookPoint:     make(map[string][]*callBackClient),
+		hookSocketSharedDirectory: baseDir,
+		sidecarStats:              make(map[string]*stats.DomainStatsHookSidecar),
+	}
 }
 
-func (m *hookManager) Collect(numberOfRequestedHookSidecars uint, timeout time.Duration) error {
+// Collect waits for the requested hook sidecars to expose their sockets. pluginsBySidecar maps the
+// containers of the sidecars running a plugin to the plugin name, which is reported in the sidecars stats.
+func (m *hookManager) Collect(numberOfRequestedHookSidecars uint, timeout time.Duration, pluginsBySidecar map[string]string) error {
 	callbacksPerHookPoint, err := m.collectSideCarSockets(numberOfRequestedHookSidecars, timeout)
 	if err != nil {
 		return err
@@ -92,9 +122,56 @@ func (m *hookManager) Collect(numberOfRequestedHookSidecars uint, timeout time.D
 
 	m.CallbacksPerHookPoint = callbacksPerHookPoint
 
+	m.statsLock.Lock()
+	defer m.statsLock.Unlock()
+	for _, callbacks := range callbacksPerHookPoint {
+		for _, callback := range callbacks {
+			m.sidecarStats[callback.ContainerName] = &stats.DomainStatsHookSidecar{
+				Container: callback.ContainerName,
+				Plugin:    pluginsBySidecar[callback.ContainerName],
+			}
+		}
+	}
+
 	return nil
 }
 
+// Stats returns the number of requests sent to every collected hook sidecar, and how many of them failed,
+// by the binding plugin error code of the failures when the sidecar classified them
+func (m *hookManager) Stats() []stats.DomainStatsHookSidecar {
+	m.statsLock.Lock()
+	defer m.statsLock.Unlock()
+
+	sidecarStats := make([]stats.DomainStatsHookSidecar, 0, len(m.sidecarStats))
+	for _, containerName := range slices.Sorted(maps.Keys(m.sidecarStats)) {
+		containerStats := *m.sidecarStats[containerName]
+		containerStats.ErrorsByCode = maps.Clone(containerStats.ErrorsByCode)
+		sidecarStats = append(sidecarStats, containerStats)
+	}
+	return sidecarStats
+}
+
+func (m *hookManager) recordRequest(callback *callBackClient, err error) {
+	m.statsLock.Lock()
+	defer m.statsLock.Unlock()
+
+	sidecarStats, exists := m.sidecarStats[callback.ContainerName]
+	if !exists {
+		sidecarStats = &stats.DomainStatsHookSidecar{Container: callback.ContainerName}
+		m.sidecarStats[callback.ContainerName] = sidecarStats
+	}
+	sidecarStats.Requests++
+	if err != nil {
+		sidecarStats.Errors++
+	}
+	if code, ok := errcode.CodeOf(err); ok {
+		if sidecarStats.ErrorsByCode == nil {
+			sidecarStats.ErrorsByCode = map[string]uint64{}
+		}
+		sidecarStats.ErrorsByCode[string(code)]++
+	}
+}
+
 // TODO: Handle sockets in parallel, when a socket appears, run a goroutine trying to read Info from it
 func (m *hookManager) collectSideCarSockets(numberOfRequestedHookSidecars uint, timeout time.Duration) (map[string][]*callBackClient, error) {
 	callbacksPerHookPoint := make(map[string][]*callBackClient)
@@ -202,6 +279,7 @@ func processSideCarSocket(socketPath string) (*callBackClient, bool, error) {
 			return &callBackClient{
 				SocketPath:           socketPath,
 				Version:              version,
+				ContainerName:        filepath.Base(filepath.Dir(socketPath)),
 				subscribedHookPoints: info.GetHookPoints(),
 			}, false, nil
 		}
@@ -226,7 +304,7 @@ func sortCallbacksPerHookPoint(callbacksPerHookPoint map[string][]*callBackClien
 	}
 }
 
-func (m *hookManager) OnDefineDomain(domainSpec *virtwrapApi.DomainSpec, vmi *v1.VirtualMachineInstance) (string, error) {
+func (m *hookManager) OnDefineDomain(ctx context.Context, domainSpec *virtwrapApi.DomainSpec, vmi *v1.VirtualMachineInstance) (string, error) {
 	domainSpecXML, err := xml.MarshalIndent(domainSpec, "", "\t")
 	if err != nil {
 		return "", fmt.Errorf("Failed to marshal domain spec: %v", domainSpec)
@@ -243,7 +321,8 @@ func (m *hookManager) OnDefineDomain(domainSpec *virtwrapApi.DomainSpec, vmi *v1
 	}
 
 	for _, callback := range callbacks {
-		domainSpecXML, err = m.onDefineDomainCallback(callback, domainSpecXML, vmiJSON)
+		domainSpecXML, err = m.onDefineDomainCallback(ctx, callback, domainSpecXML, vmiJSON)
+		m.recordRequest(callback, err)
 		if err != nil {
 			return "", err
 		}
@@ -252,7 +331,7 @@ func (m *hookManager) OnDefineDomain(domainSpec *virtwrapApi.DomainSpec, vmi *v1
 	return string(domainSpecXML), nil
 }
 
-func (m *hookManager) onDefineDomainCallback(callback *callBackClient, domainSpecXML, vmiJSON []byte) ([]byte, error) {
+func (m *hookManager) onDefineDomainCallback(ctx context.Context, callback *callBackClient, domainSpecXML, vmiJSON []byte) ([]byte, error) {
 	conn, err := grpcutil.DialSocketWithTimeout(callback.SocketPath, 1)
 	if err != nil {
 		log.Log.Reason(err).Errorf(dialSockErr, callback.SocketPath)
@@ -260,7 +339,7 @@ func (m *hookManager) onDefineDomainCallback(callback *callBackClient, domainSpe
 	}
 	defer conn.Close()
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
 	defer cancel()
 
 	switch callback.Version {
@@ -354,7 +433,7 @@ func preCloudInitIsoValidateResult(dataSource cloudinit.DataSourceType, initData
 	return resultData, nil
 }
 
-func (m *hookManager) PreCloudInitIso(vmi *v1.VirtualMachineInstance, cloudInitData *cloudinit.CloudInitData) (*cloudinit.CloudInitData, error) {
+func (m *hookManager) PreCloudInitIso(ctx context.Context, vmi *v1.VirtualMachineInstance, cloudInitData *cloudinit.CloudInitData) (*cloudinit.CloudInitData, error) {
 	callbacks, found := m.CallbacksPerHookPoint[hooksInfo.PreCloudInitIsoHookPointName]
 	if !found {
 		return cloudInitData, nil
@@ -372,45 +451,51 @@ func (m *hookManager) PreCloudInitIso(vmi *v1.VirtualMachineInstance, cloudInitD
 			conn, err := grpcutil.DialSocketWithTimeout(callback.SocketPath, 1)
 			if err != nil {
 				log.Log.Reason(err).Errorf(dialSockErr, callback.SocketPath)
+				m.recordRequest(callback, err)
 				return cloudInitData, err
 			}
 			defer conn.Close()
 
 			client := hooksV1alpha2.NewCallbacksClient(conn)
-			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			callCtx, cancel := context.WithTimeout(ctx, time.Minute)
 			defer cancel()
 
-			result, err := client.PreCloudInitIso(ctx, &hooksV1alpha2.PreCloudInitIsoParams{
+			result, err := client.PreCloudInitIso(callCtx, &hooksV1alpha2.PreCloudInitIsoParams{
 				CloudInitData:          cloudInitDataJSON,
 				CloudInitNoCloudSource: cloudInitNoCloudSourceJSON,
 				Vmi:                    vmiJSON,
 			})
 			if err != nil {
 				log.Log.Reason(err).Error("Failed to call PreCloudInitIso")
+				m.recordRequest(callback, err)
 				return cloudInitData, err
 			}
+			m.recordRequest(callback, nil)
 			return preCloudInitIsoValidateResult(cloudInitData.DataSource, result.GetCloudInitData(), result.GetCloudInitNoCloudSource())
 		case hooksV1alpha3.Version:
 			conn, err := grpcutil.DialSocketWithTimeout(callback.SocketPath, 1)
 			if err != nil {
 				log.Log.Reason(err).Errorf(dialSockErr, callback.SocketPath)
+				m.recordRequest(callback, err)
 				return cloudInitData, err
 			}
 			defer conn.Close()
 
 			client := hooksV1alpha3.NewCallbacksClient(conn)
-			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			callCtx, cancel := context.WithTimeout(ctx, time.Minute)
 			defer cancel()
 
-			result, err := client.PreCloudInitIso(ctx, &hooksV1alpha3.PreCloudInitIsoParams{
+			result, err := client.PreCloudInitIso(callCtx, &hooksV1alpha3.PreCloudInitIsoParams{
 				CloudInitData:          cloudInitDataJSON,
 				CloudInitNoCloudSource: cloudInitNoCloudSourceJSON,
 				Vmi:                    vmiJSON,
 			})
 			if err != nil {
 				log.Log.Reason(err).Error("Failed to call PreCloudInitIso")
+				m.recordRequest(callback, err)
 				return cloudInitData, err
 			}
+			m.recordRequest(callback, nil)
 			return preCloudInitIsoValidateResult(cloudInitData.DataSource, result.GetCloudInitData(), result.GetCloudInitNoCloudSource())
 		default:
 			log.Log.Errorf("Unsupported callback version: %s", callback.Version)
@@ -430,6 +515,7 @@ func (m *hookManager) Shutdown() error {
 			conn, err := grpcutil.DialSocketWithTimeout(callback.SocketPath, 1)
 			if err != nil {
 				log.Log.Reason(err).Error("Failed to run Shutdown")
+				m.recordRequest(callback, err)
 				return err
 			}
 			defer conn.Close()
@@ -438,7 +524,9 @@ func (m *hookManager) Shutdown() error {
 			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
 			defer cancel()
 
-			if _, err := client.Shutdown(ctx, &hooksV1alpha3.ShutdownParams{}); err != nil {
+			_, err = client.Shutdown(ctx, &hooksV1alpha3.ShutdownParams{})
+			m.recordRequest(callback, err)
+			if err != nil {
 				log.Log.Reason(err).Error("Failed to run Shutdown")
 				return err
 			}
@@ -448,3 +536,113 @@ func (m *hookManager) Shutdown() error {
 	}
 	return nil
 }
+
+func (m *hookManager) NegotiateMigration(ctx context.Context, vmi *v1.VirtualMachineInstance, role string) ([]string, error) {
+	callbacks, found := m.CallbacksPerHookPoint[hooksInfo.NegotiateMigrationHookPointName]
+	if !found {
+		return nil, nil
+	}
+
+	vmiJSON, err := json.Marshal(vmi)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal VMI spec: %v, err: %v", vmi, err)
+	}
+
+	var replugInterfaces []string
+	for _, callback := range callbacks {
+		ifaceNames, err := m.negotiateMigrationCallback(ctx, callback, vmiJSON, role)
+		m.recordRequest(callback, err)
+		if err != nil {
+			return nil, err
+		}
+		for _, ifaceName := range ifaceNames {
+			if !slices.Contains(replugInterfaces, ifaceName) {
+				replugInterfaces = append(replugInterfaces, ifaceName)
+			}
+		}
+	}
+	return replugInterfaces, nil
+}
+
+func (m *hookManager) negotiateMigrationCallback(ctx context.Context, callback *callBackClient, vmiJSON []byte, role string) ([]string, error) {
+	if callback.Version != hooksV1alpha3.Version {
+		log.Log.Errorf("Unsupported callback version: %s", callback.Version)
+		return nil, nil
+	}
+
+	conn, err := grpcutil.DialSocketWithTimeout(callback.SocketPath, 1)
+	if err != nil {
+		log.Log.Reason(err).Errorf(dialSockErr, callback.SocketPath)
+		return nil, err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	client := hooksV1alpha3.NewCallbacksClient(conn)
+	result, err := client.NegotiateMigration(ctx, &hooksV1alpha3.NegotiateMigrationParams{
+		Vmi:  vmiJSON,
+		Role: role,
+	})
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to call NegotiateMigration")
+		return nil, err
+	}
+	return result.GetReplugInterfaces(), nil
+}
+
+func (m *hookManager) CheckReadiness(ctx context.Context, vmi *v1.VirtualMachineInstance) error {
+	callbacks, found := m.CallbacksPerHookPoint[hooksInfo.CheckReadinessHookPointName]
+	if !found {
+		return nil
+	}
+
+	vmiJSON, err := json.Marshal(vmi)
+	if err != nil {
+		return fmt.Errorf("failed to marshal VMI spec: %v, err: %v", vmi, err)
+	}
+
+	for _, callback := range callbacks {
+		result, err := m.checkReadinessCallback(ctx, callback, vmiJSON)
+		if fatalError := result.GetFatalError(); fatalError != "" {
+			err = errcode.NotReady(callback.ContainerName, errcode.Code(result.GetErrorCode()), errors.New(fatalError))
+		}
+		m.recordRequest(callback, err)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *hookManager) checkReadinessCallback(ctx context.Context, callback *callBackClient, vmiJSON []byte) (*hooksV1alpha3.CheckReadinessResult, error) {
+	if callback.Version != hooksV1alpha3.Version {
+		log.Log.Errorf("Unsupported callback version: %s", callback.Version)
+		return nil, nil
+	}
+
+	conn, err := grpcutil.DialSocketWithTimeout(callback.SocketPath, 1)
+	if err != nil {
+		// The sidecar cannot report its readiness, waiting for it would block the start of the domain forever
+		log.Log.Reason(err).Warningf(dialSockErr+", not waiting for its readiness", callback.SocketPath)
+		return nil, nil
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	client := hooksV1alpha3.NewCallbacksClient(conn)
+	result, err := client.CheckReadiness(ctx, &hooksV1alpha3.CheckReadinessParams{Vmi: vmiJSON})
+	if status.Code(err) == codes.Unimplemented {
+		// Sidecars built before the CheckReadiness hook point was introduced are ready once started
+		log.Log.Infof("Sidecar %s does not implement CheckReadiness, considering it ready", callback.ContainerName)
+		return nil, nil
+	}
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to call CheckReadiness")
+		return nil, err
+	}
+	return result, nil
+}
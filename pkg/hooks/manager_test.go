@@ -31,6 +31,8 @@ import (
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -42,7 +44,9 @@ import (
 	cloudinit "kubevirt.io/kubevirt/pkg/cloud-init"
 	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
 	hooksV1alpha3 "kubevirt.io/kubevirt/pkg/hooks/v1alpha3"
+	"kubevirt.io/kubevirt/pkg/network/bindingplugin/errcode"
 	virtwrapApi "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
 )
 
 type infoServer struct {
@@ -59,6 +63,17 @@ func (s *infoServer) Info(
 
 type callbackServer struct {
 	done chan struct{}
+	// when set, OnDefineDomain blocks until its context is done and closes the channel
+	onDefineDomainCancelled chan struct{}
+	// when set, OnDefineDomain fails with the error
+	onDefineDomainErr error
+	// the interfaces NegotiateMigration asks to replug, by migration role
+	replugInterfaces map[string][]string
+	// when set, CheckReadiness reports it as a fatal error, classified by readinessErrorCode
+	readinessFatalError string
+	readinessErrorCode  string
+	// when set, CheckReadiness fails with it
+	readinessErr error
 
 	// For the tests
 	countOnDefineDomain  int
@@ -67,12 +82,21 @@ type callbackServer struct {
 }
 
 func (s *callbackServer) OnDefineDomain(
-	_ context.Context,
+	ctx context.Context,
 	params *hooksV1alpha3.OnDefineDomainParams,
 ) (*hooksV1alpha3.OnDefineDomainResult, error) {
 	GinkgoWriter.Println("Hook's OnDefineDomain method has been called")
 	s.countOnDefineDomain++
 
+	if s.onDefineDomainCancelled != nil {
+		<-ctx.Done()
+		close(s.onDefineDomainCancelled)
+		return nil, ctx.Err()
+	}
+	if s.onDefineDomainErr != nil {
+		return nil, s.onDefineDomainErr
+	}
+
 	return &hooksV1alpha3.OnDefineDomainResult{
 		DomainXML: params.GetDomainXML(),
 	}, nil
@@ -99,6 +123,30 @@ func (s *callbackServer) Shutdown(
 	return &hooksV1alpha3.ShutdownResult{}, nil
 }
 
+func (s *callbackServer) NegotiateMigration(
+	_ context.Context,
+	params *hooksV1alpha3.NegotiateMigrationParams,
+) (*hooksV1alpha3.NegotiateMigrationResult, error) {
+	GinkgoWriter.Println("Hook's NegotiateMigration method has been called")
+	return &hooksV1alpha3.NegotiateMigrationResult{
+		ReplugInterfaces: s.replugInterfaces[params.GetRole()],
+	}, nil
+}
+
+func (s *callbackServer) CheckReadiness(
+	_ context.Context,
+	_ *hooksV1alpha3.CheckReadinessParams,
+) (*hooksV1alpha3.CheckReadinessResult, error) {
+	GinkgoWriter.Println("Hook's CheckReadiness method has been called")
+	if s.readinessErr != nil {
+		return nil, s.readinessErr
+	}
+	return &hooksV1alpha3.CheckReadinessResult{
+		FatalError: s.readinessFatalError,
+		ErrorCode:  s.readinessErrorCode,
+	}, nil
+}
+
 type testCase struct {
 	socketPath string
 	info       infoServer
@@ -208,7 +256,7 @@ var _ = Describe("HooksManager", func() {
 			t.Run()
 
 			manager := newManager(socketDir)
-			err := manager.Collect(1, collectTimeout)
+			err := manager.Collect(1, collectTimeout, nil)
 			Expect(err).ToNot(HaveOccurred())
 
 			callbackMaps := manager.CallbacksPerHookPoint
@@ -232,7 +280,7 @@ var _ = Describe("HooksManager", func() {
 			}
 
 			manager := newManager(socketDir)
-			err := manager.Collect(uint(len(hookNames)), collectTimeout)
+			err := manager.Collect(uint(len(hookNames)), collectTimeout, nil)
 			Expect(err).ToNot(HaveOccurred())
 
 			callbackMaps := manager.CallbacksPerHookPoint
@@ -259,7 +307,7 @@ var _ = Describe("HooksManager", func() {
 			}
 
 			manager := newManager(socketDir)
-			err := manager.Collect(uint(len(hookNameList)), collectTimeout)
+			err := manager.Collect(uint(len(hookNameList)), collectTimeout, nil)
 			Expect(err).ToNot(HaveOccurred())
 
 			callbackMaps := manager.CallbacksPerHookPoint
@@ -281,7 +329,7 @@ var _ = Describe("HooksManager", func() {
 				t.Run()
 
 				manager := newManager(socketDir)
-				err := manager.Collect(1, collectTimeout)
+				err := manager.Collect(1, collectTimeout, nil)
 				Expect(err).ToNot(HaveOccurred())
 
 				By("Calling OnDefineDomain")
@@ -299,7 +347,7 @@ var _ = Describe("HooksManager", func() {
 				}
 
 				Expect(t.callback.countOnDefineDomain).To(Equal(0))
-				resultXML, err := manager.OnDefineDomain(domainSpec, vmi)
+				resultXML, err := manager.OnDefineDomain(context.Background(), domainSpec, vmi)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(t.callback.countOnDefineDomain).To(Equal(1))
 
@@ -315,7 +363,7 @@ var _ = Describe("HooksManager", func() {
 				}
 
 				Expect(t.callback.countPreCloudInitIso).To(Equal(0))
-				resultInitData, err := manager.PreCloudInitIso(vmi, initData)
+				resultInitData, err := manager.PreCloudInitIso(context.Background(), vmi, initData)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(t.callback.countPreCloudInitIso).To(Equal(1))
 				Expect(initData).To(Equal(resultInitData))
@@ -325,8 +373,185 @@ var _ = Describe("HooksManager", func() {
 				err = manager.Shutdown()
 				Expect(err).ToNot(HaveOccurred())
 				Expect(t.callback.countShutdown).To(Equal(1))
+
+				By("Reporting the requests of the sidecar")
+				Expect(manager.Stats()).To(ConsistOf(stats.DomainStatsHookSidecar{
+					Container: filepath.Base(filepath.Dir(t.socketPath)),
+					Requests:  3,
+				}))
 				Expect(t.Stop()).ToNot(HaveOccurred())
 			})
+
+			It("should cancel the sidecar call when the context is cancelled", func() {
+				t := newTestCase(socketDir, "hook1")
+				t.info.HookPoints = []*hooksInfo.HookPoint{
+					{Name: hooksInfo.OnDefineDomainHookPointName},
+				}
+				t.callback.onDefineDomainCancelled = make(chan struct{})
+				t.Run()
+				DeferCleanup(func() { Expect(t.Stop()).ToNot(HaveOccurred()) })
+
+				manager := newManager(socketDir)
+				Expect(manager.Collect(1, collectTimeout, nil)).To(Succeed())
+
+				domainSpec := &virtwrapApi.DomainSpec{}
+				Expect(xml.Unmarshal(domainXML, domainSpec)).To(Succeed())
+
+				ctx, cancel := context.WithCancel(context.Background())
+				time.AfterFunc(100*time.Millisecond, cancel)
+				_, err := manager.OnDefineDomain(ctx, domainSpec, &v1.VirtualMachineInstance{})
+				Expect(err).To(HaveOccurred())
+				Eventually(t.callback.onDefineDomainCancelled).Should(BeClosed())
+
+				Expect(manager.Stats()).To(ConsistOf(stats.DomainStatsHookSidecar{
+					Container: filepath.Base(filepath.Dir(t.socketPath)),
+					Requests:  1,
+					Errors:    1,
+				}))
+			})
+
+			It("should count the sidecar failures by their binding plugin error code", func() {
+				t := newTestCase(socketDir, "hook1")
+				t.info.HookPoints = []*hooksInfo.HookPoint{
+					{Name: hooksInfo.OnDefineDomainHookPointName},
+				}
+				t.callback.onDefineDomainErr = errcode.Errorf(errcode.DeviceMissing, "no device for network %q", "blue")
+				t.Run()
+				DeferCleanup(func() { Expect(t.Stop()).ToNot(HaveOccurred()) })
+
+				manager := newManager(socketDir)
+				Expect(manager.Collect(1, collectTimeout, nil)).To(Succeed())
+
+				domainSpec := &virtwrapApi.DomainSpec{}
+				Expect(xml.Unmarshal(domainXML, domainSpec)).To(Succeed())
+
+				for range 2 {
+					_, err := manager.OnDefineDomain(context.Background(), domainSpec, &v1.VirtualMachineInstance{})
+					Expect(err).To(MatchError(ContainSubstring(`binding plugin error DeviceMissing: no device for network "blue"`)))
+				}
+
+				Expect(manager.Stats()).To(ConsistOf(stats.DomainStatsHookSidecar{
+					Container:    filepath.Base(filepath.Dir(t.socketPath)),
+					Requests:     2,
+					Errors:       2,
+					ErrorsByCode: map[string]uint64{"DeviceMissing": 2},
+				}))
+			})
+
+			It("should merge the interfaces the sidecars ask to replug across the migration", func() {
+				replugInterfacesByHook := map[string]map[string][]string{
+					"hook1": {hooksV1alpha3.MigrationRoleSource: {"blue", "red"}},
+					"hook2": {hooksV1alpha3.MigrationRoleSource: {"red", "green"}, hooksV1alpha3.MigrationRoleTarget: {"green"}},
+				}
+				for hookName, replugInterfaces := range replugInterfacesByHook {
+					t := newTestCase(socketDir, hookName)
+					t.info.HookPoints = []*hooksInfo.HookPoint{
+						{Name: hooksInfo.NegotiateMigrationHookPointName},
+					}
+					t.callback.replugInterfaces = replugInterfaces
+					t.Run()
+					DeferCleanup(func() { Expect(t.Stop()).ToNot(HaveOccurred()) })
+				}
+
+				manager := newManager(socketDir)
+				Expect(manager.Collect(uint(len(replugInterfacesByHook)), collectTimeout, nil)).To(Succeed())
+
+				vmi := &v1.VirtualMachineInstance{}
+				Expect(manager.NegotiateMigration(context.Background(), vmi, hooksV1alpha3.MigrationRoleSource)).
+					To(ConsistOf("blue", "red", "green"))
+				Expect(manager.NegotiateMigration(context.Background(), vmi, hooksV1alpha3.MigrationRoleTarget)).
+					To(ConsistOf("green"))
+			})
+
+			It("should not replug interfaces when no sidecar negotiates the migration", func() {
+				t := newTestCase(socketDir, "hook1")
+				t.info.HookPoints = []*hooksInfo.HookPoint{
+					{Name: hooksInfo.OnDefineDomainHookPointName},
+				}
+				t.callback.replugInterfaces = map[string][]string{hooksV1alpha3.MigrationRoleSource: {"blue"}}
+				t.Run()
+				DeferCleanup(func() { Expect(t.Stop()).ToNot(HaveOccurred()) })
+
+				manager := newManager(socketDir)
+				Expect(manager.Collect(1, collectTimeout, nil)).To(Succeed())
+
+				Expect(manager.NegotiateMigration(context.Background(), &v1.VirtualMachineInstance{}, hooksV1alpha3.MigrationRoleSource)).
+					To(BeEmpty())
+			})
+
+			It("should succeed the readiness check once the sidecars completed the configuration", func() {
+				t := newTestCase(socketDir, "hook1")
+				t.info.HookPoints = []*hooksInfo.HookPoint{
+					{Name: hooksInfo.CheckReadinessHookPointName},
+				}
+				t.Run()
+				DeferCleanup(func() { Expect(t.Stop()).ToNot(HaveOccurred()) })
+
+				manager := newManager(socketDir)
+				Expect(manager.Collect(1, collectTimeout, nil)).To(Succeed())
+
+				Expect(manager.CheckReadiness(context.Background(), &v1.VirtualMachineInstance{})).To(Succeed())
+			})
+
+			It("should fail the readiness check with the fatal error of the sidecar", func() {
+				t := newTestCase(socketDir, "hook1")
+				t.info.HookPoints = []*hooksInfo.HookPoint{
+					{Name: hooksInfo.CheckReadinessHookPointName},
+				}
+				t.callback.readinessFatalError = `vdpa device "/dev/vhost-vdpa-0" not found`
+				t.callback.readinessErrorCode = string(errcode.DeviceMissing)
+				t.Run()
+				DeferCleanup(func() { Expect(t.Stop()).ToNot(HaveOccurred()) })
+
+				manager := newManager(socketDir)
+				Expect(manager.Collect(1, collectTimeout, nil)).To(Succeed())
+
+				err := manager.CheckReadiness(context.Background(), &v1.VirtualMachineInstance{})
+				var notReadyErr *errcode.NotReadyError
+				Expect(errors.As(err, &notReadyErr)).To(BeTrue())
+				Expect(notReadyErr.Sidecar).To(Equal(filepath.Base(filepath.Dir(t.socketPath))))
+				Expect(notReadyErr.Code).To(Equal(errcode.DeviceMissing))
+				Expect(err).To(MatchError(ContainSubstring(`vdpa device "/dev/vhost-vdpa-0" not found`)))
+				Expect(manager.Stats()).To(ConsistOf(stats.DomainStatsHookSidecar{
+					Container:    filepath.Base(filepath.Dir(t.socketPath)),
+					Requests:     1,
+					Errors:       1,
+					ErrorsByCode: map[string]uint64{"DeviceMissing": 1},
+				}))
+			})
+
+			It("should consider ready the sidecars which do not implement the readiness check", func() {
+				t := newTestCase(socketDir, "hook1")
+				t.info.HookPoints = []*hooksInfo.HookPoint{
+					{Name: hooksInfo.CheckReadinessHookPointName},
+				}
+				t.callback.readinessErr = status.Error(codes.Unimplemented, "method CheckReadiness not implemented")
+				t.Run()
+				DeferCleanup(func() { Expect(t.Stop()).ToNot(HaveOccurred()) })
+
+				manager := newManager(socketDir)
+				Expect(manager.Collect(1, collectTimeout, nil)).To(Succeed())
+
+				Expect(manager.CheckReadiness(context.Background(), &v1.VirtualMachineInstance{})).To(Succeed())
+			})
+
+			It("should fail the readiness check when the sidecar fails to answer it", func() {
+				t := newTestCase(socketDir, "hook1")
+				t.info.HookPoints = []*hooksInfo.HookPoint{
+					{Name: hooksInfo.CheckReadinessHookPointName},
+				}
+				t.callback.readinessErr = status.Error(codes.Internal, "sidecar failure")
+				t.Run()
+				DeferCleanup(func() { Expect(t.Stop()).ToNot(HaveOccurred()) })
+
+				manager := newManager(socketDir)
+				Expect(manager.Collect(1, collectTimeout, nil)).To(Succeed())
+
+				err := manager.CheckReadiness(context.Background(), &v1.VirtualMachineInstance{})
+				Expect(err).To(MatchError(ContainSubstring("sidecar failure")))
+				var notReadyErr *errcode.NotReadyError
+				Expect(errors.As(err, &notReadyErr)).To(BeFalse())
+			})
 		})
 
 		AfterEach(func() {
@@ -0,0 +1,116 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package sdk
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	cloudinit "kubevirt.io/kubevirt/pkg/cloud-init"
+	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
+	hooksV1alpha3 "kubevirt.io/kubevirt/pkg/hooks/v1alpha3"
+	grpcutil "kubevirt.io/kubevirt/pkg/util/net/grpc"
+)
+
+// Client calls the hook points of a sidecar the way virt-launcher does
+type Client struct {
+	conn      *grpc.ClientConn
+	info      hooksInfo.InfoClient
+	callbacks hooksV1alpha3.CallbacksClient
+}
+
+// Dial connects to the sidecar serving its hooks on the unix socket
+func Dial(socketPath string) (*Client, error) {
+	conn, err := grpcutil.DialSocket(socketPath)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		conn:      conn,
+		info:      hooksInfo.NewInfoClient(conn),
+		callbacks: hooksV1alpha3.NewCallbacksClient(conn),
+	}, nil
+}
+
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// Info returns the name of the sidecar, the hook API versions it serves and the hook points it subscribed to
+func (c *Client) Info(ctx context.Context) (*hooksInfo.InfoResult, error) {
+	return c.info.Info(ctx, &hooksInfo.InfoParams{})
+}
+
+func (c *Client) OnDefineDomain(ctx context.Context, vmi *v1.VirtualMachineInstance, domainXML []byte) ([]byte, error) {
+	params, err := NewOnDefineDomainParams(vmi, domainXML)
+	if err != nil {
+		return nil, err
+	}
+	result, err := c.callbacks.OnDefineDomain(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return result.GetDomainXML(), nil
+}
+
+func (c *Client) PreCloudInitIso(ctx context.Context, vmi *v1.VirtualMachineInstance, data *cloudinit.CloudInitData) (*cloudinit.CloudInitData, error) {
+	params, err := NewPreCloudInitIsoParams(vmi, data)
+	if err != nil {
+		return nil, err
+	}
+	result, err := c.callbacks.PreCloudInitIso(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePreCloudInitIsoResult(result, data.DataSource)
+}
+
+func (c *Client) NegotiateMigration(ctx context.Context, vmi *v1.VirtualMachineInstance, role string) ([]string, error) {
+	params, err := NewNegotiateMigrationParams(vmi, role)
+	if err != nil {
+		return nil, err
+	}
+	result, err := c.callbacks.NegotiateMigration(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return result.GetReplugInterfaces(), nil
+}
+
+// CheckReadiness returns the fatal error the sidecar reported, or the failure of the call
+func (c *Client) CheckReadiness(ctx context.Context, vmi *v1.VirtualMachineInstance) error {
+	params, err := NewCheckReadinessParams(vmi)
+	if err != nil {
+		return err
+	}
+	result, err := c.callbacks.CheckReadiness(ctx, params)
+	if err != nil {
+		return err
+	}
+	return ParseCheckReadinessResult(result)
+}
+
+func (c *Client) Shutdown(ctx context.Context) error {
+	_, err := c.callbacks.Shutdown(ctx, &hooksV1alpha3.ShutdownParams{})
+	return err
+}
@@ -0,0 +1,83 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Package fake serves hook sidecar callbacks in the test process, so that they are called through
+// the hook API without virt-launcher nor a cluster.
+package fake
+
+import (
+	"os"
+	"path/filepath"
+
+	"google.golang.org/grpc"
+
+	"kubevirt.io/kubevirt/pkg/hooks/sdk"
+	grpcutil "kubevirt.io/kubevirt/pkg/util/net/grpc"
+)
+
+const socketName = "hook.sock"
+
+// Server serves hooks on a unix socket of a temporary directory
+type Server struct {
+	SocketPath string
+
+	dir        string
+	grpcServer *grpc.Server
+	shutdown   <-chan struct{}
+}
+
+// NewServer starts serving the hooks, until the server is stopped
+func NewServer(hooks sdk.Hooks) (*Server, error) {
+	dir, err := os.MkdirTemp("", "hooks")
+	if err != nil {
+		return nil, err
+	}
+	socketPath := filepath.Join(dir, socketName)
+	socket, err := grpcutil.CreateSocket(socketPath)
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+
+	grpcServer, shutdown := sdk.NewServer(hooks)
+	go grpcServer.Serve(socket)
+
+	return &Server{
+		SocketPath: socketPath,
+		dir:        dir,
+		grpcServer: grpcServer,
+		shutdown:   shutdown,
+	}, nil
+}
+
+// Client returns a client calling the hooks the way virt-launcher does
+func (s *Server) Client() (*sdk.Client, error) {
+	return sdk.Dial(s.SocketPath)
+}
+
+// ShutdownRequested returns a channel closed once the Shutdown hook point was called
+func (s *Server) ShutdownRequested() <-chan struct{} {
+	return s.shutdown
+}
+
+// Stop stops serving the hooks and removes the socket
+func (s *Server) Stop() {
+	s.grpcServer.Stop()
+	os.RemoveAll(s.dir)
+}
@@ -0,0 +1,219 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package sdk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	cloudinit "kubevirt.io/kubevirt/pkg/cloud-init"
+	hooksV1alpha3 "kubevirt.io/kubevirt/pkg/hooks/v1alpha3"
+)
+
+// NewOnDefineDomainParams builds the parameters virt-launcher calls the OnDefineDomain hook point with
+func NewOnDefineDomainParams(vmi *v1.VirtualMachineInstance, domainXML []byte) (*hooksV1alpha3.OnDefineDomainParams, error) {
+	vmiJSON, err := json.Marshal(vmi)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal VMI: %v", err)
+	}
+	return &hooksV1alpha3.OnDefineDomainParams{
+		DomainXML: domainXML,
+		Vmi:       vmiJSON,
+	}, nil
+}
+
+// ParseOnDefineDomainParams returns the VMI and the domain XML the OnDefineDomain hook point is called with
+func ParseOnDefineDomainParams(params *hooksV1alpha3.OnDefineDomainParams) (*v1.VirtualMachineInstance, []byte, error) {
+	vmi := &v1.VirtualMachineInstance{}
+	if err := json.Unmarshal(params.GetVmi(), vmi); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal VMI: %v", err)
+	}
+	return vmi, params.GetDomainXML(), nil
+}
+
+// NewOnDefineDomainResult builds the result of the OnDefineDomain hook point
+func NewOnDefineDomainResult(domainXML []byte) *hooksV1alpha3.OnDefineDomainResult {
+	return &hooksV1alpha3.OnDefineDomainResult{
+		DomainXML: domainXML,
+	}
+}
+
+// NewPreCloudInitIsoParams builds the parameters virt-launcher calls the PreCloudInitIso hook point with.
+// The cloud-init data is also sent as a CloudInitNoCloudSource, for the sidecars predating CloudInitData.
+func NewPreCloudInitIsoParams(vmi *v1.VirtualMachineInstance, data *cloudinit.CloudInitData) (*hooksV1alpha3.PreCloudInitIsoParams, error) {
+	vmiJSON, err := json.Marshal(vmi)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal VMI: %v", err)
+	}
+	dataJSON, noCloudSourceJSON, err := marshalCloudInitData(data)
+	if err != nil {
+		return nil, err
+	}
+	return &hooksV1alpha3.PreCloudInitIsoParams{
+		CloudInitData:          dataJSON,
+		CloudInitNoCloudSource: noCloudSourceJSON,
+		Vmi:                    vmiJSON,
+	}, nil
+}
+
+// ParsePreCloudInitIsoParams returns the VMI and the cloud-init data the PreCloudInitIso hook point is called with
+func ParsePreCloudInitIsoParams(params *hooksV1alpha3.PreCloudInitIsoParams) (*v1.VirtualMachineInstance, *cloudinit.CloudInitData, error) {
+	vmi := &v1.VirtualMachineInstance{}
+	if err := json.Unmarshal(params.GetVmi(), vmi); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal VMI: %v", err)
+	}
+	data, err := unmarshalCloudInitData(params.GetCloudInitData(), params.GetCloudInitNoCloudSource(), "")
+	if err != nil {
+		return nil, nil, err
+	}
+	return vmi, data, nil
+}
+
+// NewPreCloudInitIsoResult builds the result of the PreCloudInitIso hook point
+func NewPreCloudInitIsoResult(data *cloudinit.CloudInitData) (*hooksV1alpha3.PreCloudInitIsoResult, error) {
+	dataJSON, noCloudSourceJSON, err := marshalCloudInitData(data)
+	if err != nil {
+		return nil, err
+	}
+	return &hooksV1alpha3.PreCloudInitIsoResult{
+		CloudInitData:          dataJSON,
+		CloudInitNoCloudSource: noCloudSourceJSON,
+	}, nil
+}
+
+// ParsePreCloudInitIsoResult returns the cloud-init data the PreCloudInitIso hook point returned.
+// The data source is kept from the data sent to the hook point, when the sidecar returned a
+// CloudInitNoCloudSource only.
+func ParsePreCloudInitIsoResult(result *hooksV1alpha3.PreCloudInitIsoResult, dataSource cloudinit.DataSourceType) (*cloudinit.CloudInitData, error) {
+	return unmarshalCloudInitData(result.GetCloudInitData(), result.GetCloudInitNoCloudSource(), dataSource)
+}
+
+// NewNegotiateMigrationParams builds the parameters virt-launcher calls the NegotiateMigration hook point with
+func NewNegotiateMigrationParams(vmi *v1.VirtualMachineInstance, role string) (*hooksV1alpha3.NegotiateMigrationParams, error) {
+	vmiJSON, err := json.Marshal(vmi)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal VMI: %v", err)
+	}
+	return &hooksV1alpha3.NegotiateMigrationParams{
+		Vmi:  vmiJSON,
+		Role: role,
+	}, nil
+}
+
+// ParseNegotiateMigrationParams returns the VMI and the migration role the NegotiateMigration hook point is called with
+func ParseNegotiateMigrationParams(params *hooksV1alpha3.NegotiateMigrationParams) (*v1.VirtualMachineInstance, string, error) {
+	vmi := &v1.VirtualMachineInstance{}
+	if err := json.Unmarshal(params.GetVmi(), vmi); err != nil {
+		return nil, "", fmt.Errorf("failed to unmarshal VMI: %v", err)
+	}
+	switch role := params.GetRole(); role {
+	case MigrationRoleSource, MigrationRoleTarget:
+		return vmi, role, nil
+	default:
+		return nil, "", fmt.Errorf("unknown migration role %q", role)
+	}
+}
+
+// NewNegotiateMigrationResult builds the result of the NegotiateMigration hook point
+func NewNegotiateMigrationResult(replugInterfaces []string) *hooksV1alpha3.NegotiateMigrationResult {
+	return &hooksV1alpha3.NegotiateMigrationResult{
+		ReplugInterfaces: replugInterfaces,
+	}
+}
+
+// NewCheckReadinessParams builds the parameters virt-launcher calls the CheckReadiness hook point with
+func NewCheckReadinessParams(vmi *v1.VirtualMachineInstance) (*hooksV1alpha3.CheckReadinessParams, error) {
+	vmiJSON, err := json.Marshal(vmi)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal VMI: %v", err)
+	}
+	return &hooksV1alpha3.CheckReadinessParams{Vmi: vmiJSON}, nil
+}
+
+// ParseCheckReadinessParams returns the VMI the CheckReadiness hook point is called with
+func ParseCheckReadinessParams(params *hooksV1alpha3.CheckReadinessParams) (*v1.VirtualMachineInstance, error) {
+	vmi := &v1.VirtualMachineInstance{}
+	if err := json.Unmarshal(params.GetVmi(), vmi); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal VMI: %v", err)
+	}
+	return vmi, nil
+}
+
+// NewCheckReadinessResult builds the result of the CheckReadiness hook point, reporting the fatal error when set,
+// and its code when the error exposes one
+func NewCheckReadinessResult(fatalErr error) *hooksV1alpha3.CheckReadinessResult {
+	if fatalErr == nil {
+		return &hooksV1alpha3.CheckReadinessResult{}
+	}
+	result := &hooksV1alpha3.CheckReadinessResult{FatalError: fatalErr.Error()}
+	var codeErr interface{ ErrorCode() string }
+	if errors.As(fatalErr, &codeErr) {
+		result.ErrorCode = codeErr.ErrorCode()
+	}
+	return result
+}
+
+// ParseCheckReadinessResult returns the fatal error reported by the CheckReadiness hook point, if any
+func ParseCheckReadinessResult(result *hooksV1alpha3.CheckReadinessResult) error {
+	if fatalError := result.GetFatalError(); fatalError != "" {
+		return errors.New(fatalError)
+	}
+	return nil
+}
+
+func marshalCloudInitData(data *cloudinit.CloudInitData) ([]byte, []byte, error) {
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal CloudInitData: %v", err)
+	}
+	noCloudSourceJSON, err := json.Marshal(v1.CloudInitNoCloudSource{
+		UserData:    data.UserData,
+		NetworkData: data.NetworkData,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal CloudInitNoCloudSource: %v", err)
+	}
+	return dataJSON, noCloudSourceJSON, nil
+}
+
+func unmarshalCloudInitData(dataJSON, noCloudSourceJSON []byte, dataSource cloudinit.DataSourceType) (*cloudinit.CloudInitData, error) {
+	var data *cloudinit.CloudInitData
+	if len(dataJSON) > 0 {
+		if err := json.Unmarshal(dataJSON, &data); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal CloudInitData: %v", err)
+		}
+	}
+	if cloudinit.IsValidCloudInitData(data) {
+		return data, nil
+	}
+
+	noCloudSource := &v1.CloudInitNoCloudSource{}
+	if err := json.Unmarshal(noCloudSourceJSON, noCloudSource); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal CloudInitNoCloudSource: %v", err)
+	}
+	return &cloudinit.CloudInitData{
+		DataSource:  dataSource,
+		UserData:    noCloudSource.UserData,
+		NetworkData: noCloudSource.NetworkData,
+	}, nil
+}
@@ -0,0 +1,232 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Package sdk is the library hook sidecars are built with. It serves the v1alpha3 version of the
+// hook API, and hides its gRPC services and the encoding of their parameters behind typed callbacks:
+//
+//	err := sdk.Serve("/var/run/kubevirt-hooks/my-hook.sock", sdk.Hooks{
+//		Name: "my-hook",
+//		OnDefineDomain: func(ctx context.Context, vmi *v1.VirtualMachineInstance, domainXML []byte) ([]byte, error) {
+//			return domainXML, nil
+//		},
+//	})
+//
+// The callbacks are called with the context of the virt-launcher request. It carries the deadline
+// of the call and is cancelled once virt-launcher gives up on it, e.g. when the VMI is deleted while
+// starting, so that callbacks waiting on devices or files should stop waiting when it is done.
+//
+// The exported API of the package is kept backwards compatible across releases, new hook points
+// are added as new optional callbacks. The fake package serves the callbacks on a temporary
+// socket, so that sidecars can be tested against the Client the way virt-launcher calls them.
+package sdk
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"google.golang.org/grpc"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	cloudinit "kubevirt.io/kubevirt/pkg/cloud-init"
+	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
+	hooksV1alpha3 "kubevirt.io/kubevirt/pkg/hooks/v1alpha3"
+	grpcutil "kubevirt.io/kubevirt/pkg/util/net/grpc"
+)
+
+// Version is the version of the hook API served by the sidecars
+const Version = hooksV1alpha3.Version
+
+// The roles virt-launcher calls NegotiateMigration with
+const (
+	MigrationRoleSource = hooksV1alpha3.MigrationRoleSource
+	MigrationRoleTarget = hooksV1alpha3.MigrationRoleTarget
+)
+
+// OnDefineDomainFunc returns the libvirt domain XML of the VMI, mutated before the domain is defined
+type OnDefineDomainFunc func(ctx context.Context, vmi *v1.VirtualMachineInstance, domainXML []byte) ([]byte, error)
+
+// PreCloudInitIsoFunc returns the cloud-init data of the VMI, mutated before the cloud-init ISO is generated
+type PreCloudInitIsoFunc func(ctx context.Context, vmi *v1.VirtualMachineInstance, data *cloudinit.CloudInitData) (*cloudinit.CloudInitData, error)
+
+// NegotiateMigrationFunc returns the names of the VMI interfaces to unplug from the source domain before the
+// migration, and to plug into the target domain once it completed. It is called on both sides of the migration,
+// with either the MigrationRoleSource or the MigrationRoleTarget role.
+type NegotiateMigrationFunc func(ctx context.Context, vmi *v1.VirtualMachineInstance, role string) ([]string, error)
+
+// CheckReadinessFunc returns once the sidecar completed the configuration of the VMI, before virt-launcher starts
+// its domain. A returned error is fatal: the VMI fails with it instead of being started.
+type CheckReadinessFunc func(ctx context.Context, vmi *v1.VirtualMachineInstance) error
+
+// Hooks are the callbacks of a sidecar. The sidecar only subscribes to the hook points it has a callback for.
+type Hooks struct {
+	// Name is the name the sidecar reports to virt-launcher
+	Name string
+	// Priority orders the sidecars subscribed to the same hook point, the highest first
+	Priority int32
+
+	OnDefineDomain     OnDefineDomainFunc
+	PreCloudInitIso    PreCloudInitIsoFunc
+	NegotiateMigration NegotiateMigrationFunc
+	CheckReadiness     CheckReadinessFunc
+	// OnShutdown is called when virt-launcher shuts the sidecar down, before the server stops
+	OnShutdown func()
+}
+
+type server struct {
+	hooks        Hooks
+	shutdownOnce sync.Once
+	shutdown     chan struct{}
+}
+
+// NewServer returns a gRPC server serving the hooks, and a channel closed once virt-launcher
+// requested the sidecar to shut down
+func NewServer(hooks Hooks) (*grpc.Server, <-chan struct{}) {
+	s := &server{hooks: hooks, shutdown: make(chan struct{})}
+	grpcServer := grpc.NewServer()
+	hooksInfo.RegisterInfoServer(grpcServer, s)
+	hooksV1alpha3.RegisterCallbacksServer(grpcServer, s)
+	return grpcServer, s.shutdown
+}
+
+// Serve serves the hooks on the unix socket until virt-launcher shuts the sidecar down, or the
+// sidecar is terminated by a signal
+func Serve(socketPath string, hooks Hooks) error {
+	socket, err := grpcutil.CreateSocket(socketPath)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(socketPath)
+
+	grpcServer, shutdown := NewServer(hooks)
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- grpcServer.Serve(socket)
+	}()
+	log.Log.Infof("%s sidecar is now exposing its services on socket %s using %q API version", hooks.Name, socketPath, Version)
+
+	signalStopChan := make(chan os.Signal, 1)
+	signal.Notify(signalStopChan, os.Interrupt, syscall.SIGHUP, syscall.SIGTERM, syscall.SIGQUIT)
+	defer signal.Stop(signalStopChan)
+
+	select {
+	case s := <-signalStopChan:
+		log.Log.Infof("%s sidecar received signal: %s", hooks.Name, s.String())
+	case err = <-errChan:
+		return err
+	case <-shutdown:
+		log.Log.Infof("%s sidecar is shutting down", hooks.Name)
+	}
+	grpcServer.GracefulStop()
+	return nil
+}
+
+func (s *server) Info(_ context.Context, _ *hooksInfo.InfoParams) (*hooksInfo.InfoResult, error) {
+	hookPoints := []*hooksInfo.HookPoint{{Name: hooksInfo.ShutdownHookPointName, Priority: s.hooks.Priority}}
+	if s.hooks.OnDefineDomain != nil {
+		hookPoints = append(hookPoints, &hooksInfo.HookPoint{Name: hooksInfo.OnDefineDomainHookPointName, Priority: s.hooks.Priority})
+	}
+	if s.hooks.PreCloudInitIso != nil {
+		hookPoints = append(hookPoints, &hooksInfo.HookPoint{Name: hooksInfo.PreCloudInitIsoHookPointName, Priority: s.hooks.Priority})
+	}
+	if s.hooks.NegotiateMigration != nil {
+		hookPoints = append(hookPoints, &hooksInfo.HookPoint{Name: hooksInfo.NegotiateMigrationHookPointName, Priority: s.hooks.Priority})
+	}
+	if s.hooks.CheckReadiness != nil {
+		hookPoints = append(hookPoints, &hooksInfo.HookPoint{Name: hooksInfo.CheckReadinessHookPointName, Priority: s.hooks.Priority})
+	}
+	return &hooksInfo.InfoResult{
+		Name:       s.hooks.Name,
+		Versions:   []string{Version},
+		HookPoints: hookPoints,
+	}, nil
+}
+
+func (s *server) OnDefineDomain(ctx context.Context, params *hooksV1alpha3.OnDefineDomainParams) (*hooksV1alpha3.OnDefineDomainResult, error) {
+	if s.hooks.OnDefineDomain == nil {
+		return NewOnDefineDomainResult(params.GetDomainXML()), nil
+	}
+	vmi, domainXML, err := ParseOnDefineDomainParams(params)
+	if err != nil {
+		return nil, err
+	}
+	domainXML, err = s.hooks.OnDefineDomain(ctx, vmi, domainXML)
+	if err != nil {
+		return nil, err
+	}
+	return NewOnDefineDomainResult(domainXML), nil
+}
+
+func (s *server) PreCloudInitIso(ctx context.Context, params *hooksV1alpha3.PreCloudInitIsoParams) (*hooksV1alpha3.PreCloudInitIsoResult, error) {
+	if s.hooks.PreCloudInitIso == nil {
+		return &hooksV1alpha3.PreCloudInitIsoResult{
+			CloudInitData:          params.GetCloudInitData(),
+			CloudInitNoCloudSource: params.GetCloudInitNoCloudSource(),
+		}, nil
+	}
+	vmi, data, err := ParsePreCloudInitIsoParams(params)
+	if err != nil {
+		return nil, err
+	}
+	data, err = s.hooks.PreCloudInitIso(ctx, vmi, data)
+	if err != nil {
+		return nil, err
+	}
+	return NewPreCloudInitIsoResult(data)
+}
+
+func (s *server) NegotiateMigration(ctx context.Context, params *hooksV1alpha3.NegotiateMigrationParams) (*hooksV1alpha3.NegotiateMigrationResult, error) {
+	if s.hooks.NegotiateMigration == nil {
+		return NewNegotiateMigrationResult(nil), nil
+	}
+	vmi, role, err := ParseNegotiateMigrationParams(params)
+	if err != nil {
+		return nil, err
+	}
+	replugInterfaces, err := s.hooks.NegotiateMigration(ctx, vmi, role)
+	if err != nil {
+		return nil, err
+	}
+	return NewNegotiateMigrationResult(replugInterfaces), nil
+}
+
+func (s *server) CheckReadiness(ctx context.Context, params *hooksV1alpha3.CheckReadinessParams) (*hooksV1alpha3.CheckReadinessResult, error) {
+	if s.hooks.CheckReadiness == nil {
+		return NewCheckReadinessResult(nil), nil
+	}
+	vmi, err := ParseCheckReadinessParams(params)
+	if err != nil {
+		return nil, err
+	}
+	return NewCheckReadinessResult(s.hooks.CheckReadiness(ctx, vmi)), nil
+}
+
+func (s *server) Shutdown(_ context.Context, _ *hooksV1alpha3.ShutdownParams) (*hooksV1alpha3.ShutdownResult, error) {
+	s.shutdownOnce.Do(func() {
+		if s.hooks.OnShutdown != nil {
+			s.hooks.OnShutdown()
+		}
+		close(s.shutdown)
+	})
+	return &hooksV1alpha3.ShutdownResult{}, nil
+}
@@ -0,0 +1,208 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package sdk_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	cloudinit "kubevirt.io/kubevirt/pkg/cloud-init"
+	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
+	"kubevirt.io/kubevirt/pkg/hooks/sdk"
+	"kubevirt.io/kubevirt/pkg/hooks/sdk/fake"
+	hooksV1alpha3 "kubevirt.io/kubevirt/pkg/hooks/v1alpha3"
+)
+
+var _ = Describe("Hook sidecar SDK", func() {
+	var vmi *v1.VirtualMachineInstance
+
+	BeforeEach(func() {
+		vmi = &v1.VirtualMachineInstance{ObjectMeta: metav1.ObjectMeta{Name: "testvmi", Namespace: "default"}}
+	})
+
+	newClient := func(hooks sdk.Hooks) (*fake.Server, *sdk.Client) {
+		server, err := fake.NewServer(hooks)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(server.Stop)
+		client, err := server.Client()
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(client.Close)
+		return server, client
+	}
+
+	It("should only subscribe to the hook points with a callback", func() {
+		_, client := newClient(sdk.Hooks{
+			Name:     "test-hook",
+			Priority: 10,
+			OnDefineDomain: func(_ context.Context, _ *v1.VirtualMachineInstance, domainXML []byte) ([]byte, error) {
+				return domainXML, nil
+			},
+		})
+
+		info, err := client.Info(context.Background())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.GetName()).To(Equal("test-hook"))
+		Expect(info.GetVersions()).To(ConsistOf(hooksV1alpha3.Version))
+		Expect(info.GetHookPoints()).To(HaveLen(2))
+		var names []string
+		for _, hookPoint := range info.GetHookPoints() {
+			Expect(hookPoint.GetPriority()).To(BeEquivalentTo(10))
+			names = append(names, hookPoint.GetName())
+		}
+		Expect(names).To(ConsistOf(hooksInfo.OnDefineDomainHookPointName, hooksInfo.ShutdownHookPointName))
+	})
+
+	It("should call OnDefineDomain with the VMI and the domain XML", func() {
+		_, client := newClient(sdk.Hooks{
+			OnDefineDomain: func(_ context.Context, vmi *v1.VirtualMachineInstance, domainXML []byte) ([]byte, error) {
+				return append(domainXML, []byte("<!-- "+vmi.Name+" -->")...), nil
+			},
+		})
+
+		domainXML, err := client.OnDefineDomain(context.Background(), vmi, []byte("<domain/>"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(domainXML)).To(Equal("<domain/><!-- testvmi -->"))
+	})
+
+	It("should return the error of OnDefineDomain", func() {
+		_, client := newClient(sdk.Hooks{
+			OnDefineDomain: func(_ context.Context, _ *v1.VirtualMachineInstance, _ []byte) ([]byte, error) {
+				return nil, errors.New("device missing")
+			},
+		})
+
+		_, err := client.OnDefineDomain(context.Background(), vmi, []byte("<domain/>"))
+		Expect(err).To(MatchError(ContainSubstring("device missing")))
+	})
+
+	It("should call PreCloudInitIso with the cloud-init data", func() {
+		_, client := newClient(sdk.Hooks{
+			PreCloudInitIso: func(_ context.Context, _ *v1.VirtualMachineInstance, data *cloudinit.CloudInitData) (*cloudinit.CloudInitData, error) {
+				data.UserData += "\nhostname: " + vmi.Name
+				return data, nil
+			},
+		})
+
+		data, err := client.PreCloudInitIso(context.Background(), vmi, &cloudinit.CloudInitData{
+			DataSource:      cloudinit.DataSourceNoCloud,
+			UserData:        "#cloud-config",
+			NoCloudMetaData: &cloudinit.NoCloudMetadata{InstanceID: "testvmi"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data.DataSource).To(Equal(cloudinit.DataSourceNoCloud))
+		Expect(data.UserData).To(Equal("#cloud-config\nhostname: testvmi"))
+		Expect(data.NoCloudMetaData.InstanceID).To(Equal("testvmi"))
+	})
+
+	It("should call NegotiateMigration with the role of virt-launcher in the migration", func() {
+		_, client := newClient(sdk.Hooks{
+			NegotiateMigration: func(_ context.Context, vmi *v1.VirtualMachineInstance, role string) ([]string, error) {
+				if role == sdk.MigrationRoleSource {
+					return []string{vmi.Name + "-nic"}, nil
+				}
+				return nil, nil
+			},
+		})
+
+		info, err := client.Info(context.Background())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.GetHookPoints()).To(ContainElement(HaveField("Name", hooksInfo.NegotiateMigrationHookPointName)))
+
+		Expect(client.NegotiateMigration(context.Background(), vmi, sdk.MigrationRoleSource)).To(ConsistOf("testvmi-nic"))
+		Expect(client.NegotiateMigration(context.Background(), vmi, sdk.MigrationRoleTarget)).To(BeEmpty())
+		_, err = client.NegotiateMigration(context.Background(), vmi, "bystander")
+		Expect(err).To(MatchError(ContainSubstring(`unknown migration role "bystander"`)))
+	})
+
+	It("should report the fatal error of the readiness check", func() {
+		_, client := newClient(sdk.Hooks{
+			CheckReadiness: func(_ context.Context, vmi *v1.VirtualMachineInstance) error {
+				if vmi.Name == "testvmi" {
+					return errors.New("vdpa device is missing")
+				}
+				return nil
+			},
+		})
+
+		info, err := client.Info(context.Background())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.GetHookPoints()).To(ContainElement(HaveField("Name", hooksInfo.CheckReadinessHookPointName)))
+
+		Expect(client.CheckReadiness(context.Background(), vmi)).To(MatchError("vdpa device is missing"))
+		Expect(client.CheckReadiness(context.Background(), &v1.VirtualMachineInstance{ObjectMeta: metav1.ObjectMeta{Name: "ready"}})).To(Succeed())
+	})
+
+	It("should notify the shutdown requested by virt-launcher", func() {
+		shutdown := false
+		server, client := newClient(sdk.Hooks{
+			OnShutdown: func() { shutdown = true },
+		})
+
+		Expect(client.Shutdown(context.Background())).To(Succeed())
+		Expect(server.ShutdownRequested()).To(BeClosed())
+		Expect(shutdown).To(BeTrue())
+		Expect(client.Shutdown(context.Background())).To(Succeed())
+	})
+
+	Context("messages", func() {
+		It("should fall back to the legacy CloudInitNoCloudSource of the result", func() {
+			data, err := sdk.ParsePreCloudInitIsoResult(&hooksV1alpha3.PreCloudInitIsoResult{
+				CloudInitNoCloudSource: []byte(`{"userData":"#cloud-config"}`),
+			}, cloudinit.DataSourceConfigDrive)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(Equal(&cloudinit.CloudInitData{
+				DataSource: cloudinit.DataSourceConfigDrive,
+				UserData:   "#cloud-config",
+			}))
+		})
+
+		It("should round trip the OnDefineDomain parameters", func() {
+			params, err := sdk.NewOnDefineDomainParams(vmi, []byte("<domain/>"))
+			Expect(err).ToNot(HaveOccurred())
+			parsedVMI, domainXML, err := sdk.ParseOnDefineDomainParams(params)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parsedVMI.Name).To(Equal(vmi.Name))
+			Expect(domainXML).To(Equal([]byte("<domain/>")))
+		})
+
+		It("should report the code of the readiness fatal error", func() {
+			result := sdk.NewCheckReadinessResult(fmt.Errorf("wrapped: %w", codedError{code: "DeviceMissing"}))
+			Expect(result.GetFatalError()).To(Equal("wrapped: coded error"))
+			Expect(result.GetErrorCode()).To(Equal("DeviceMissing"))
+
+			result = sdk.NewCheckReadinessResult(errors.New("vdpa device is missing"))
+			Expect(result.GetErrorCode()).To(BeEmpty())
+		})
+	})
+})
+
+type codedError struct {
+	code string
+}
+
+func (e codedError) Error() string     { return "coded error" }
+func (e codedError) ErrorCode() string { return e.code }
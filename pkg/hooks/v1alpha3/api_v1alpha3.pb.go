@@ -16,6 +16,10 @@ It has these top-level messages:
 	PreCloudInitIsoResult
 	ShutdownParams
 	ShutdownResult
+	NegotiateMigrationParams
+	NegotiateMigrationResult
+	CheckReadinessParams
+	CheckReadinessResult
 */
 package v1alpha3
 
@@ -164,6 +168,94 @@ func (m *ShutdownResult) String() string            { return proto.CompactTextSt
 func (*ShutdownResult) ProtoMessage()               {}
 func (*ShutdownResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
 
+type NegotiateMigrationParams struct {
+	// vmi is VirtualMachineInstance is object of virtual machine currently processed by virt-launcher, it is encoded as JSON
+	Vmi []byte `protobuf:"bytes,1,opt,name=vmi,proto3" json:"vmi,omitempty"`
+	// role is the side of the migration virt-launcher runs on, either "source" or "target"
+	Role string `protobuf:"bytes,2,opt,name=role" json:"role,omitempty"`
+}
+
+func (m *NegotiateMigrationParams) Reset()                    { *m = NegotiateMigrationParams{} }
+func (m *NegotiateMigrationParams) String() string            { return proto.CompactTextString(m) }
+func (*NegotiateMigrationParams) ProtoMessage()               {}
+func (*NegotiateMigrationParams) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *NegotiateMigrationParams) GetVmi() []byte {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+func (m *NegotiateMigrationParams) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+type NegotiateMigrationResult struct {
+	// replugInterfaces are the names of the VMI interfaces to unplug from the source domain before the
+	// migration starts, and to plug back into the target domain once the migration completed
+	ReplugInterfaces []string `protobuf:"bytes,1,rep,name=replugInterfaces" json:"replugInterfaces,omitempty"`
+}
+
+func (m *NegotiateMigrationResult) Reset()                    { *m = NegotiateMigrationResult{} }
+func (m *NegotiateMigrationResult) String() string            { return proto.CompactTextString(m) }
+func (*NegotiateMigrationResult) ProtoMessage()               {}
+func (*NegotiateMigrationResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *NegotiateMigrationResult) GetReplugInterfaces() []string {
+	if m != nil {
+		return m.ReplugInterfaces
+	}
+	return nil
+}
+
+type CheckReadinessParams struct {
+	// vmi is VirtualMachineInstance is object of virtual machine currently processed by virt-launcher, it is encoded as JSON
+	Vmi []byte `protobuf:"bytes,1,opt,name=vmi,proto3" json:"vmi,omitempty"`
+}
+
+func (m *CheckReadinessParams) Reset()                    { *m = CheckReadinessParams{} }
+func (m *CheckReadinessParams) String() string            { return proto.CompactTextString(m) }
+func (*CheckReadinessParams) ProtoMessage()               {}
+func (*CheckReadinessParams) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *CheckReadinessParams) GetVmi() []byte {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+type CheckReadinessResult struct {
+	// fatalError is set when the sidecar cannot complete the configuration of the VMI, which then fails
+	// instead of being started. The call returns once the configuration completed or failed.
+	FatalError string `protobuf:"bytes,1,opt,name=fatalError" json:"fatalError,omitempty"`
+	// errorCode classifies the fatal error, when the sidecar reported it with a code
+	ErrorCode string `protobuf:"bytes,2,opt,name=errorCode" json:"errorCode,omitempty"`
+}
+
+func (m *CheckReadinessResult) Reset()                    { *m = CheckReadinessResult{} }
+func (m *CheckReadinessResult) String() string            { return proto.CompactTextString(m) }
+func (*CheckReadinessResult) ProtoMessage()               {}
+func (*CheckReadinessResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *CheckReadinessResult) GetFatalError() string {
+	if m != nil {
+		return m.FatalError
+	}
+	return ""
+}
+
+func (m *CheckReadinessResult) GetErrorCode() string {
+	if m != nil {
+		return m.ErrorCode
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*OnDefineDomainParams)(nil), "kubevirt.hooks.v1alpha3.OnDefineDomainParams")
 	proto.RegisterType((*OnDefineDomainResult)(nil), "kubevirt.hooks.v1alpha3.OnDefineDomainResult")
@@ -171,6 +263,10 @@ func init() {
 	proto.RegisterType((*PreCloudInitIsoResult)(nil), "kubevirt.hooks.v1alpha3.PreCloudInitIsoResult")
 	proto.RegisterType((*ShutdownParams)(nil), "kubevirt.hooks.v1alpha3.ShutdownParams")
 	proto.RegisterType((*ShutdownResult)(nil), "kubevirt.hooks.v1alpha3.ShutdownResult")
+	proto.RegisterType((*NegotiateMigrationParams)(nil), "kubevirt.hooks.v1alpha3.NegotiateMigrationParams")
+	proto.RegisterType((*NegotiateMigrationResult)(nil), "kubevirt.hooks.v1alpha3.NegotiateMigrationResult")
+	proto.RegisterType((*CheckReadinessParams)(nil), "kubevirt.hooks.v1alpha3.CheckReadinessParams")
+	proto.RegisterType((*CheckReadinessResult)(nil), "kubevirt.hooks.v1alpha3.CheckReadinessResult")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -187,6 +283,8 @@ type CallbacksClient interface {
 	OnDefineDomain(ctx context.Context, in *OnDefineDomainParams, opts ...grpc.CallOption) (*OnDefineDomainResult, error)
 	PreCloudInitIso(ctx context.Context, in *PreCloudInitIsoParams, opts ...grpc.CallOption) (*PreCloudInitIsoResult, error)
 	Shutdown(ctx context.Context, in *ShutdownParams, opts ...grpc.CallOption) (*ShutdownResult, error)
+	NegotiateMigration(ctx context.Context, in *NegotiateMigrationParams, opts ...grpc.CallOption) (*NegotiateMigrationResult, error)
+	CheckReadiness(ctx context.Context, in *CheckReadinessParams, opts ...grpc.CallOption) (*CheckReadinessResult, error)
 }
 
 type callbacksClient struct {
@@ -224,12 +322,32 @@ func (c *callbacksClient) Shutdown(ctx context.Context, in *ShutdownParams, opts
 	return out, nil
 }
 
+func (c *callbacksClient) NegotiateMigration(ctx context.Context, in *NegotiateMigrationParams, opts ...grpc.CallOption) (*NegotiateMigrationResult, error) {
+	out := new(NegotiateMigrationResult)
+	err := grpc.Invoke(ctx, "/kubevirt.hooks.v1alpha3.Callbacks/NegotiateMigration", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *callbacksClient) CheckReadiness(ctx context.Context, in *CheckReadinessParams, opts ...grpc.CallOption) (*CheckReadinessResult, error) {
+	out := new(CheckReadinessResult)
+	err := grpc.Invoke(ctx, "/kubevirt.hooks.v1alpha3.Callbacks/CheckReadiness", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Callbacks service
 
 type CallbacksServer interface {
 	OnDefineDomain(context.Context, *OnDefineDomainParams) (*OnDefineDomainResult, error)
 	PreCloudInitIso(context.Context, *PreCloudInitIsoParams) (*PreCloudInitIsoResult, error)
 	Shutdown(context.Context, *ShutdownParams) (*ShutdownResult, error)
+	NegotiateMigration(context.Context, *NegotiateMigrationParams) (*NegotiateMigrationResult, error)
+	CheckReadiness(context.Context, *CheckReadinessParams) (*CheckReadinessResult, error)
 }
 
 func RegisterCallbacksServer(s *grpc.Server, srv CallbacksServer) {
@@ -290,6 +408,42 @@ func _Callbacks_Shutdown_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Callbacks_NegotiateMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NegotiateMigrationParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallbacksServer).NegotiateMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.hooks.v1alpha3.Callbacks/NegotiateMigration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallbacksServer).NegotiateMigration(ctx, req.(*NegotiateMigrationParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Callbacks_CheckReadiness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckReadinessParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallbacksServer).CheckReadiness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.hooks.v1alpha3.Callbacks/CheckReadiness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallbacksServer).CheckReadiness(ctx, req.(*CheckReadinessParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Callbacks_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kubevirt.hooks.v1alpha3.Callbacks",
 	HandlerType: (*CallbacksServer)(nil),
@@ -306,6 +460,14 @@ var _Callbacks_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Shutdown",
 			Handler:    _Callbacks_Shutdown_Handler,
 		},
+		{
+			MethodName: "NegotiateMigration",
+			Handler:    _Callbacks_NegotiateMigration_Handler,
+		},
+		{
+			MethodName: "CheckReadiness",
+			Handler:    _Callbacks_CheckReadiness_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api_v1alpha3.proto",
@@ -314,24 +476,33 @@ var _Callbacks_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("api_v1alpha3.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 296 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4a, 0x2c, 0xc8, 0x8c,
-	0x2f, 0x33, 0x4c, 0xcc, 0x29, 0xc8, 0x48, 0x34, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0xcf, 0x2e, 0x4d, 0x4a, 0x2d, 0xcb, 0x2c, 0x2a, 0xd1, 0xcb, 0xc8, 0xcf, 0xcf, 0x2e, 0xd6, 0x83,
-	0x49, 0x2b, 0xb9, 0x71, 0x89, 0xf8, 0xe7, 0xb9, 0xa4, 0xa6, 0x65, 0xe6, 0xa5, 0xba, 0xe4, 0xe7,
-	0x26, 0x66, 0xe6, 0x05, 0x24, 0x16, 0x25, 0xe6, 0x16, 0x0b, 0xc9, 0x70, 0x71, 0xa6, 0x80, 0xf9,
-	0x11, 0xbe, 0x3e, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x3c, 0x41, 0x08, 0x01, 0x21, 0x01, 0x2e, 0xe6,
-	0xb2, 0xdc, 0x4c, 0x09, 0x26, 0xb0, 0x38, 0x88, 0xa9, 0x64, 0x82, 0x6e, 0x4e, 0x50, 0x6a, 0x71,
-	0x69, 0x4e, 0x09, 0x7e, 0x73, 0x94, 0xda, 0x19, 0xb9, 0x44, 0x03, 0x8a, 0x52, 0x9d, 0x73, 0xf2,
-	0x4b, 0x53, 0x3c, 0xf3, 0x32, 0x4b, 0x3c, 0x8b, 0xf3, 0xa1, 0xf6, 0x9b, 0x71, 0x89, 0x25, 0xc3,
-	0x44, 0xfd, 0xf2, 0xc1, 0x0a, 0x82, 0xf3, 0x4b, 0x8b, 0x92, 0x53, 0xa1, 0x86, 0xe0, 0x90, 0xc5,
-	0x74, 0x99, 0x90, 0x0a, 0x17, 0x2f, 0x5c, 0xad, 0x4b, 0x62, 0x49, 0xa2, 0x04, 0x33, 0x58, 0x0e,
-	0x55, 0x50, 0xa9, 0x14, 0xc3, 0x21, 0x50, 0x0f, 0x90, 0xeb, 0x10, 0xe2, 0xac, 0x15, 0xe0, 0xe2,
-	0x0b, 0xce, 0x28, 0x2d, 0x49, 0xc9, 0x2f, 0x87, 0x06, 0x3c, 0xb2, 0x08, 0xc4, 0x05, 0x46, 0x67,
-	0x98, 0xb8, 0x38, 0x9d, 0x13, 0x73, 0x72, 0x92, 0x12, 0x93, 0xb3, 0x8b, 0x85, 0xf2, 0xb8, 0xf8,
-	0x50, 0x03, 0x5a, 0x48, 0x57, 0x0f, 0x47, 0xe4, 0xea, 0x61, 0x8b, 0x59, 0x29, 0x62, 0x95, 0x43,
-	0xfd, 0x5f, 0xc8, 0xc5, 0x8f, 0x16, 0x30, 0x42, 0x7a, 0x38, 0x4d, 0xc0, 0x1a, 0x97, 0x52, 0x44,
-	0xab, 0x87, 0x5a, 0x19, 0xc3, 0xc5, 0x01, 0x0b, 0x02, 0x21, 0x75, 0x9c, 0x7a, 0x51, 0xc3, 0x4d,
-	0x8a, 0xb0, 0x42, 0x88, 0xe9, 0x49, 0x6c, 0xe0, 0x1c, 0x61, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff,
-	0x99, 0x7e, 0x92, 0xc5, 0x27, 0x03, 0x00, 0x00,
+	// 439 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xdd, 0x8a, 0x13, 0x31,
+	0x14, 0xc7, 0x19, 0xbb, 0x88, 0x73, 0xd0, 0x5a, 0xc2, 0xaa, 0xc3, 0x20, 0xb2, 0x04, 0xc1, 0x22,
+	0x38, 0xb0, 0xae, 0x78, 0x2d, 0xb4, 0x2e, 0x14, 0xdc, 0x75, 0x99, 0xf5, 0xc2, 0x0b, 0x41, 0x4e,
+	0x67, 0x4e, 0xdb, 0xd0, 0x34, 0xa9, 0x49, 0xa6, 0x82, 0x2f, 0xe0, 0x2b, 0xf9, 0x78, 0xd2, 0x6c,
+	0xc6, 0x6e, 0x3f, 0xa6, 0x56, 0xef, 0x32, 0xff, 0xf3, 0x3f, 0x5f, 0xc9, 0x8f, 0x01, 0x86, 0x73,
+	0xf1, 0x75, 0x71, 0x8a, 0x72, 0x3e, 0xc1, 0xb3, 0x6c, 0x6e, 0xb4, 0xd3, 0xec, 0xc9, 0xb4, 0x1a,
+	0xd2, 0x42, 0x18, 0x97, 0x4d, 0xb4, 0x9e, 0xda, 0xac, 0x0e, 0xf3, 0x73, 0x38, 0xfe, 0xa8, 0xfa,
+	0x34, 0x12, 0x8a, 0xfa, 0x7a, 0x86, 0x42, 0x5d, 0xa1, 0xc1, 0x99, 0x65, 0x4f, 0x21, 0x2e, 0xfd,
+	0xf7, 0xe7, 0x8b, 0x0f, 0x49, 0x74, 0x12, 0x75, 0xef, 0xe7, 0x2b, 0x81, 0x75, 0xa0, 0xb5, 0x98,
+	0x89, 0xe4, 0x8e, 0xd7, 0x97, 0x47, 0xfe, 0x66, 0xb3, 0x4e, 0x4e, 0xb6, 0x92, 0x6e, 0x7f, 0x1d,
+	0xfe, 0x33, 0x82, 0x47, 0x57, 0x86, 0x7a, 0x52, 0x57, 0xe5, 0x40, 0x09, 0x37, 0xb0, 0x3a, 0xf4,
+	0x7f, 0x0b, 0x8f, 0x8b, 0x5a, 0xbd, 0xd4, 0xde, 0x70, 0xad, 0x2b, 0x53, 0x50, 0x28, 0xd2, 0x10,
+	0xdd, 0x9e, 0x8c, 0x3d, 0x87, 0x07, 0x7f, 0xbc, 0x7d, 0x74, 0x98, 0xb4, 0x7c, 0x6c, 0x5d, 0xe4,
+	0xd5, 0xd6, 0x20, 0x61, 0x81, 0xff, 0x1d, 0xe4, 0xb0, 0xb6, 0x1d, 0x68, 0x5f, 0x4f, 0x2a, 0x57,
+	0xea, 0xef, 0xe1, 0xe2, 0x6f, 0x2b, 0x37, 0x13, 0xf0, 0x77, 0x90, 0x5c, 0xd2, 0x58, 0x3b, 0x81,
+	0x8e, 0x2e, 0xc4, 0xd8, 0xa0, 0x13, 0xba, 0x7e, 0xa6, 0xb0, 0x6e, 0xb4, 0x5a, 0x97, 0xc1, 0x91,
+	0xd1, 0x92, 0xfc, 0x0d, 0xc4, 0xb9, 0x3f, 0xf3, 0xf3, 0x5d, 0x15, 0xc2, 0x7e, 0x2f, 0xa1, 0x63,
+	0x68, 0x2e, 0xab, 0xf1, 0x40, 0x39, 0x32, 0x23, 0x2c, 0xc8, 0x26, 0xd1, 0x49, 0xab, 0x1b, 0xe7,
+	0x5b, 0x3a, 0xef, 0xc2, 0x71, 0x6f, 0x42, 0xc5, 0x34, 0x27, 0x2c, 0x85, 0x22, 0x6b, 0x9b, 0xa6,
+	0xe0, 0x9f, 0x36, 0x9d, 0xa1, 0xdb, 0x33, 0x80, 0x11, 0x3a, 0x94, 0xef, 0x8d, 0xd1, 0xc6, 0x27,
+	0xc4, 0xf9, 0x2d, 0x65, 0x89, 0x0b, 0x2d, 0x0f, 0x3d, 0x5d, 0xd6, 0x2b, 0xac, 0x84, 0xd7, 0xbf,
+	0x8e, 0x20, 0xee, 0xa1, 0x94, 0x43, 0x2c, 0xa6, 0x96, 0x29, 0x68, 0xaf, 0x23, 0xc7, 0x5e, 0x65,
+	0x0d, 0x98, 0x67, 0xbb, 0x18, 0x4f, 0x0f, 0xb5, 0x87, 0xd9, 0xbf, 0xc1, 0xc3, 0x0d, 0x44, 0x58,
+	0xd6, 0x58, 0x61, 0x27, 0xd5, 0xe9, 0xc1, 0xfe, 0xd0, 0xf2, 0x0b, 0xdc, 0xab, 0x61, 0x60, 0x2f,
+	0x1a, 0x73, 0xd7, 0x09, 0x4a, 0xff, 0x6e, 0x0c, 0xd5, 0x7f, 0x00, 0xdb, 0xc6, 0x82, 0x9d, 0x36,
+	0xa6, 0x37, 0x51, 0x98, 0xfe, 0x4b, 0x4a, 0xe8, 0xad, 0xa0, 0xbd, 0x0e, 0xc8, 0x9e, 0xc7, 0xdb,
+	0xc5, 0x5c, 0x7a, 0xa8, 0xfd, 0xa6, 0xdf, 0xf0, 0xae, 0xff, 0x0f, 0x9e, 0xfd, 0x1e, 0x00, 0x53,
+	0x3b, 0xb3, 0x50, 0x1d, 0x05, 0x00, 0x00,
 }
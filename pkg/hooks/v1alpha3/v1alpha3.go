@@ -20,3 +20,9 @@
 package v1alpha3
 
 const Version = "v1alpha3"
+
+// The roles virt-launcher calls the NegotiateMigration hook point with
+const (
+	MigrationRoleSource = "source"
+	MigrationRoleTarget = "target"
+)
@@ -70,9 +70,20 @@ func ReplacePVCByHostDisk(vmi *v1.VirtualMachineInstance) error {
 		}
 	}
 
+	// A PVC holding the kernel boot artifacts is consumed as a directory as well, and no image file should
+	// be created on it.
+	var kernelBootVolume string
+	if util.HasKernelBootVolume(vmi) {
+		kernelBootVolume = vmi.Spec.Domain.Firmware.KernelBoot.Volume.Name
+	}
+
 	for i := range vmi.Spec.Volumes {
 		volume := vmi.Spec.Volumes[i]
 		volumeSource := &vmi.Spec.Volumes[i].VolumeSource
+		if volume.Name == kernelBootVolume {
+			log.Log.V(4).Infof("this volume %s holds kernel boot artifacts, will not be replaced by HostDisk", volume.Name)
+			continue
+		}
 		if volumeSource.PersistentVolumeClaim != nil {
 			if shouldSkipVolumeSource(passthoughFSVolumes, hotplugVolumes, pvcVolume, volume.Name) {
 				continue
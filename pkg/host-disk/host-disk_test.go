@@ -462,6 +462,19 @@ var _ = Describe("HostDisk", func() {
 			),
 		)
 
+		It("in filemode should not replace the volume holding kernel boot artifacts", func() {
+			mode := k8sv1.PersistentVolumeFilesystem
+			vmi.Status.VolumeStatus[0].PersistentVolumeClaimInfo.VolumeMode = &mode
+			vmi.Spec.Domain.Firmware = &v1.Firmware{
+				KernelBoot: &v1.KernelBoot{
+					Volume: &v1.KernelBootVolume{Name: volumeName, KernelPath: "/vmlinuz"},
+				},
+			}
+
+			Expect(ReplacePVCByHostDisk(vmi)).To(Succeed())
+			assertNoHostDisk()
+		})
+
 		It("in filemode without capacity or requested PVC size should fail", func() {
 			mode := k8sv1.PersistentVolumeFilesystem
 			vmi.Status.VolumeStatus[0].PersistentVolumeClaimInfo.VolumeMode = &mode
@@ -186,8 +186,8 @@ func addProbeOverheads(vmi *v1.VirtualMachineInstance, quantity *resource.Quanti
 	// that we add per added probe.
 	virtProbeTotalAdditionalOverhead := resource.MustParse("100Mi")
 	virtProbeOverhead := resource.MustParse("10Mi")
-	hasLiveness := vmi.Spec.LivenessProbe != nil && vmi.Spec.LivenessProbe.Exec != nil
-	hasReadiness := vmi.Spec.ReadinessProbe != nil && vmi.Spec.ReadinessProbe.Exec != nil
+	hasLiveness := vmi.Spec.LivenessProbe != nil && (vmi.Spec.LivenessProbe.Exec != nil || vmi.Spec.LivenessProbe.GuestAgentExec != nil)
+	hasReadiness := vmi.Spec.ReadinessProbe != nil && (vmi.Spec.ReadinessProbe.Exec != nil || vmi.Spec.ReadinessProbe.GuestAgentExec != nil)
 	if hasLiveness {
 		quantity.Add(virtProbeOverhead)
 	}
@@ -79,6 +79,23 @@ func WithKernelBoot(image, kernelPath, initrdPath, kernelArgs string) Option {
 	}
 }
 
+// WithKernelBootVolume configures a VMI to boot from a kernel stored on one of its PVC or DataVolume volumes.
+func WithKernelBootVolume(volumeName, kernelPath, initrdPath, kernelArgs string) Option {
+	return func(vmi *v1.VirtualMachineInstance) {
+		if vmi.Spec.Domain.Firmware == nil {
+			vmi.Spec.Domain.Firmware = &v1.Firmware{}
+		}
+		vmi.Spec.Domain.Firmware.KernelBoot = &v1.KernelBoot{
+			KernelArgs: kernelArgs,
+			Volume: &v1.KernelBootVolume{
+				Name:       volumeName,
+				KernelPath: kernelPath,
+				InitrdPath: initrdPath,
+			},
+		}
+	}
+}
+
 func WithKernelBootContainerImagePullSecret(imagePullSecret string) Option {
 	return func(vmi *v1.VirtualMachineInstance) {
 		if vmi.Spec.Domain.Firmware == nil {
@@ -135,6 +135,18 @@ func MultusNetwork(name, nadName string) *kvirtv1.Network {
 	}
 }
 
+// NativeNetwork returns a Network with the given name, associated to the given PodNetwork
+func NativeNetwork(name, podNetworkName string) *kvirtv1.Network {
+	return &kvirtv1.Network{
+		Name: name,
+		NetworkSource: kvirtv1.NetworkSource{
+			Native: &kvirtv1.NativeNetwork{
+				PodNetworkName: podNetworkName,
+			},
+		},
+	}
+}
+
 // WithHostname sets the hostname parameter.
 func WithHostname(hostname string) Option {
 	return func(vmi *kvirtv1.VirtualMachineInstance) {
@@ -57,3 +57,15 @@ func WithSEVAttestation() Option {
 		vmi.Spec.Domain.LaunchSecurity.SEV.Attestation = &v1.SEVAttestation{}
 	}
 }
+
+func WithSEVSNPAttestation() Option {
+	return func(vmi *v1.VirtualMachineInstance) {
+		if vmi.Spec.Domain.LaunchSecurity == nil {
+			vmi.Spec.Domain.LaunchSecurity = &v1.LaunchSecurity{}
+		}
+		if vmi.Spec.Domain.LaunchSecurity.SNP == nil {
+			vmi.Spec.Domain.LaunchSecurity.SNP = &v1.SEVSNP{}
+		}
+		vmi.Spec.Domain.LaunchSecurity.SNP.Attestation = &v1.SEVAttestation{}
+	}
+}
@@ -0,0 +1,105 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package hostdevice
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// ValidateLiveUpdateHostDevices checks that the host devices added to or removed from a running VM can be
+// hotplugged. Host devices can only be added or removed as a whole, updating a device in place is not supported.
+// Only hotplugged host devices can be removed, the ones the VM started with are part of the virt-launcher pod.
+func ValidateLiveUpdateHostDevices(current, desired []v1.HostDevice, statuses []v1.HostDeviceStatus, permittedHostDevices *v1.PermittedHostDevices) error {
+	currentByName := indexByName(current)
+	for _, hostDevice := range desired {
+		currentDevice, exists := currentByName[hostDevice.Name]
+		if !exists {
+			if err := IsHotpluggable(hostDevice, permittedHostDevices); err != nil {
+				return err
+			}
+			continue
+		}
+		if !equality.Semantic.DeepEqual(currentDevice, hostDevice) {
+			return fmt.Errorf("host device %s cannot be updated while the VM is running", hostDevice.Name)
+		}
+	}
+
+	desiredByName := indexByName(desired)
+	hotplugged := hotpluggedHostDevices(statuses)
+	for _, hostDevice := range current {
+		if _, exists := desiredByName[hostDevice.Name]; exists {
+			continue
+		}
+		if err := IsHotpluggable(hostDevice, permittedHostDevices); err != nil {
+			return err
+		}
+		if _, exists := hotplugged[hostDevice.Name]; !exists {
+			return fmt.Errorf("host device %s was not hotplugged, only hotplugged host devices can be removed", hostDevice.Name)
+		}
+	}
+	return nil
+}
+
+func hotpluggedHostDevices(statuses []v1.HostDeviceStatus) map[string]struct{} {
+	hotplugged := make(map[string]struct{})
+	for _, status := range statuses {
+		if status.HotplugHostDevice != nil {
+			hotplugged[status.Name] = struct{}{}
+		}
+	}
+	return hotplugged
+}
+
+// IsHotpluggable returns an error explaining why the host device cannot be added to or removed from a running VM.
+// Only PCI host devices permitted in the KubeVirt CR and advertised by KubeVirt itself can be hotplugged, since
+// virt-handler needs to resolve the device allocated on the node.
+func IsHotpluggable(hostDevice v1.HostDevice, permittedHostDevices *v1.PermittedHostDevices) error {
+	if hostDevice.ClaimRequest != nil {
+		return fmt.Errorf("host device %s is a DRA device, which cannot be hotplugged", hostDevice.Name)
+	}
+	if hostDevice.AttachIOMMUGroup {
+		return fmt.Errorf("host device %s requests its whole IOMMU group, which cannot be hotplugged", hostDevice.Name)
+	}
+	if permittedHostDevices != nil {
+		for _, pciHostDevice := range permittedHostDevices.PciHostDevices {
+			if !strings.EqualFold(pciHostDevice.ResourceName, hostDevice.DeviceName) {
+				continue
+			}
+			if pciHostDevice.ExternalResourceProvider {
+				return fmt.Errorf("host device %s is provided by an external device plugin, which cannot be hotplugged", hostDevice.Name)
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("host device %s is not a permitted PCI host device, only those can be hotplugged", hostDevice.Name)
+}
+
+func indexByName(hostDevices []v1.HostDevice) map[string]v1.HostDevice {
+	byName := make(map[string]v1.HostDevice, len(hostDevices))
+	for _, hostDevice := range hostDevices {
+		byName[hostDevice.Name] = hostDevice
+	}
+	return byName
+}
@@ -0,0 +1,105 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package hostdevice_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/liveupdate/hostdevice"
+)
+
+var _ = Describe("LiveUpdate host devices", func() {
+	const (
+		nicResource      = "example.org/nic"
+		externalResource = "example.org/external"
+		vgpuResource     = "example.org/vgpu"
+	)
+
+	permittedHostDevices := &v1.PermittedHostDevices{
+		PciHostDevices: []v1.PciHostDevice{
+			{PCIVendorSelector: "8086:1572", ResourceName: nicResource},
+			{PCIVendorSelector: "10de:1eb8", ResourceName: externalResource, ExternalResourceProvider: true},
+		},
+		MediatedDevices: []v1.MediatedHostDevice{
+			{MDEVNameSelector: "GRID T4-1Q", ResourceName: vgpuResource},
+		},
+	}
+
+	nic := func(name string) v1.HostDevice {
+		return v1.HostDevice{Name: name, DeviceName: nicResource}
+	}
+
+	hotplugged := func(name string) v1.HostDeviceStatus {
+		return v1.HostDeviceStatus{Name: name, Phase: v1.HostDeviceReady, HotplugHostDevice: &v1.HotplugHostDeviceStatus{}}
+	}
+
+	It("should accept adding and removing permitted PCI host devices", func() {
+		current := []v1.HostDevice{nic("nic1"), nic("nic2")}
+		desired := []v1.HostDevice{nic("nic2"), nic("nic3")}
+		statuses := []v1.HostDeviceStatus{hotplugged("nic1"), hotplugged("nic2")}
+		Expect(hostdevice.ValidateLiveUpdateHostDevices(current, desired, statuses, permittedHostDevices)).To(Succeed())
+	})
+
+	DescribeTable("should reject removing a host device the VM started with", func(statuses []v1.HostDeviceStatus) {
+		current := []v1.HostDevice{nic("nic1"), nic("nic2")}
+		desired := []v1.HostDevice{nic("nic2")}
+		Expect(hostdevice.ValidateLiveUpdateHostDevices(current, desired, statuses, permittedHostDevices)).To(
+			MatchError("host device nic1 was not hotplugged, only hotplugged host devices can be removed"))
+	},
+		Entry("with a status", []v1.HostDeviceStatus{{Name: "nic1", Phase: v1.HostDeviceReady}, hotplugged("nic2")}),
+		Entry("without a status", nil),
+	)
+
+	It("should reject updating a host device in place", func() {
+		updated := nic("nic1")
+		updated.Tag = "storage"
+		Expect(hostdevice.ValidateLiveUpdateHostDevices([]v1.HostDevice{nic("nic1")}, []v1.HostDevice{updated}, nil, permittedHostDevices)).To(
+			MatchError("host device nic1 cannot be updated while the VM is running"))
+	})
+
+	It("should reject removing a host device which cannot be hotplugged", func() {
+		current := []v1.HostDevice{{Name: "vgpu", DeviceName: vgpuResource}}
+		Expect(hostdevice.ValidateLiveUpdateHostDevices(current, nil, nil, permittedHostDevices)).To(
+			MatchError("host device vgpu is not a permitted PCI host device, only those can be hotplugged"))
+	})
+
+	DescribeTable("should reject hotplugging", func(hostDevice v1.HostDevice, permitted *v1.PermittedHostDevices, expectedErr string) {
+		Expect(hostdevice.ValidateLiveUpdateHostDevices(nil, []v1.HostDevice{hostDevice}, nil, permitted)).To(MatchError(expectedErr))
+	},
+		Entry("a DRA host device",
+			v1.HostDevice{Name: "dev", ClaimRequest: &v1.ClaimRequest{}}, permittedHostDevices,
+			"host device dev is a DRA device, which cannot be hotplugged"),
+		Entry("a host device requesting its IOMMU group",
+			v1.HostDevice{Name: "dev", DeviceName: nicResource, AttachIOMMUGroup: true}, permittedHostDevices,
+			"host device dev requests its whole IOMMU group, which cannot be hotplugged"),
+		Entry("a host device provided by an external device plugin",
+			v1.HostDevice{Name: "dev", DeviceName: externalResource}, permittedHostDevices,
+			"host device dev is provided by an external device plugin, which cannot be hotplugged"),
+		Entry("a mediated device",
+			v1.HostDevice{Name: "dev", DeviceName: vgpuResource}, permittedHostDevices,
+			"host device dev is not a permitted PCI host device, only those can be hotplugged"),
+		Entry("a host device when no host device is permitted",
+			v1.HostDevice{Name: "dev", DeviceName: nicResource}, nil,
+			"host device dev is not a permitted PCI host device, only those can be hotplugged"),
+	)
+})
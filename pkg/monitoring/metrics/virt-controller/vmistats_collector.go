@@ -20,6 +20,7 @@
 package virtcontroller
 
 import (
+	"encoding/json"
 	"strconv"
 	"strings"
 
@@ -34,6 +35,7 @@ import (
 	k6tv1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/hooks"
 	"kubevirt.io/kubevirt/pkg/hypervisor"
 	netresources "kubevirt.io/kubevirt/pkg/network/resources"
 	"kubevirt.io/kubevirt/pkg/util/migrations"
@@ -47,6 +49,8 @@ const (
 
 	annotationPrefix        = "vm.kubevirt.io/"
 	instancetypeVendorLabel = "instancetype.kubevirt.io/vendor"
+
+	hookSidecarContainerPrefix = "hook-sidecar-"
 )
 
 var (
@@ -65,6 +69,9 @@ var (
 			vmiVnicInfo,
 			vmiLauncherMemoryOverhead,
 			vmiEphemeralHotplugVolume,
+			vmiHookSidecarRestarts,
+			vmiHookSidecarInfo,
+			vmiBootPhaseSeconds,
 		},
 		CollectCallback: vmiStatsCollectorCallback,
 	}
@@ -148,6 +155,31 @@ var (
 		},
 		[]string{"namespace", "name", "volume_name"},
 	)
+
+	vmiHookSidecarRestarts = operatormetrics.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_hook_sidecar_restarts_total",
+			Help: "Total number of restarts of the hook sidecar containers of the VirtualMachineInstance (VMI) virt-launcher pod.",
+		},
+		[]string{"namespace", "name", "container", "plugin"},
+	)
+
+	vmiHookSidecarInfo = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_hook_sidecar_info",
+			Help: "Information about the hook sidecar containers of the VirtualMachineInstance (VMI) virt-launcher pod, " +
+				"relating each container to the plugin it runs. Used to aggregate the containers resource usage by plugin.",
+		},
+		[]string{"namespace", "name", "pod", "container", "plugin"},
+	)
+
+	vmiBootPhaseSeconds = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_boot_phase_seconds",
+			Help: "Time in seconds from the VirtualMachineInstance (VMI) creation until it reached the boot phase, such as the virt-launcher pod being scheduled or the guest agent connecting.",
+		},
+		[]string{"node", "namespace", "name", "phase"},
+	)
 )
 
 func vmiStatsCollectorCallback() []operatormetrics.CollectorResult {
@@ -176,6 +208,9 @@ func reportVmisStats(vmis []*k6tv1.VirtualMachineInstance) []operatormetrics.Col
 		crs = append(crs, CollectVmisVnicInfo(vmi)...)
 		crs = append(crs, collectVMILauncherMemoryOverhead(vmi))
 		crs = append(crs, collectVMIEphemeralHotplug(vmi)...)
+		crs = append(crs, collectVMIHookSidecarRestarts(vmi)...)
+		crs = append(crs, collectVMIHookSidecarInfo(vmi)...)
+		crs = append(crs, collectVMIBootPhases(vmi)...)
 	}
 
 	return crs
@@ -252,10 +287,6 @@ func getSystemInfoFromAnnotations(annotations map[string]string) (os, workload,
 }
 
 func getGuestOSInfo(vmi *k6tv1.VirtualMachineInstance) (kernelRelease, guestOSMachineArch, name, versionID string) {
-	if vmi.Status.GuestOSInfo == (k6tv1.VirtualMachineInstanceGuestOSInfo{}) {
-		return
-	}
-
 	if vmi.Status.GuestOSInfo.KernelRelease != "" {
 		kernelRelease = vmi.Status.GuestOSInfo.KernelRelease
 	}
@@ -284,9 +315,17 @@ func getVMIMachine(vmi *k6tv1.VirtualMachineInstance) (guestOSMachineType string
 }
 
 func getVMIPod(vmi *k6tv1.VirtualMachineInstance) string {
+	if pod := getVMILauncherPod(vmi); pod != nil {
+		return pod.Name
+	}
+
+	return none
+}
+
+func getVMILauncherPod(vmi *k6tv1.VirtualMachineInstance) *k8sv1.Pod {
 	objs, err := indexers.KVPod.ByIndex(cache.NamespaceIndex, vmi.Namespace)
 	if err != nil {
-		return none
+		return nil
 	}
 
 	for _, obj := range objs {
@@ -297,12 +336,12 @@ func getVMIPod(vmi *k6tv1.VirtualMachineInstance) string {
 
 		if pod.Labels["kubevirt.io/created-by"] == string(vmi.UID) && pod.Status.Phase == k8sv1.PodRunning {
 			if vmi.Status.NodeName == pod.Spec.NodeName {
-				return pod.Name
+				return pod
 			}
 		}
 	}
 
-	return none
+	return nil
 }
 
 func getVMIInstancetype(vmi *k6tv1.VirtualMachineInstance) string {
@@ -527,3 +566,73 @@ func collectVMIEphemeralHotplug(vmi *k6tv1.VirtualMachineInstance) []operatormet
 
 	return results
 }
+
+func collectVMIHookSidecarRestarts(vmi *k6tv1.VirtualMachineInstance) []operatormetrics.CollectorResult {
+	results := []operatormetrics.CollectorResult{}
+
+	pod := getVMILauncherPod(vmi)
+	if pod == nil {
+		return results
+	}
+
+	plugins := hookSidecarPlugins(vmi, pod)
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		if !strings.HasPrefix(containerStatus.Name, hookSidecarContainerPrefix) {
+			continue
+		}
+		results = append(results, operatormetrics.CollectorResult{
+			Metric: vmiHookSidecarRestarts,
+			Labels: []string{vmi.Namespace, vmi.Name, containerStatus.Name, plugins[containerStatus.Name]},
+			Value:  float64(containerStatus.RestartCount),
+		})
+	}
+
+	return results
+}
+
+func collectVMIHookSidecarInfo(vmi *k6tv1.VirtualMachineInstance) []operatormetrics.CollectorResult {
+	results := []operatormetrics.CollectorResult{}
+
+	pod := getVMILauncherPod(vmi)
+	if pod == nil {
+		return results
+	}
+
+	plugins := hookSidecarPlugins(vmi, pod)
+	for _, container := range pod.Spec.Containers {
+		if !strings.HasPrefix(container.Name, hookSidecarContainerPrefix) {
+			continue
+		}
+		results = append(results, operatormetrics.CollectorResult{
+			Metric: vmiHookSidecarInfo,
+			Labels: []string{vmi.Namespace, vmi.Name, pod.Name, container.Name, plugins[container.Name]},
+			Value:  1.0,
+		})
+	}
+
+	return results
+}
+
+func hookSidecarPlugins(vmi *k6tv1.VirtualMachineInstance, pod *k8sv1.Pod) map[string]string {
+	plugins := map[string]string{}
+	if pluginsAnnotation, exists := pod.Annotations[hooks.HookSidecarPluginsAnnotationName]; exists {
+		if err := json.Unmarshal([]byte(pluginsAnnotation), &plugins); err != nil {
+			log.Log.Object(vmi).Reason(err).Warning("failed to parse the hook sidecar plugins of the virt-launcher pod")
+		}
+	}
+	return plugins
+}
+
+func collectVMIBootPhases(vmi *k6tv1.VirtualMachineInstance) []operatormetrics.CollectorResult {
+	results := []operatormetrics.CollectorResult{}
+
+	for _, bootPhase := range vmi.Status.BootPhaseTimestamps {
+		results = append(results, operatormetrics.CollectorResult{
+			Metric: vmiBootPhaseSeconds,
+			Labels: []string{vmi.Status.NodeName, vmi.Namespace, vmi.Name, string(bootPhase.Phase)},
+			Value:  bootPhase.Timestamp.Sub(vmi.CreationTimestamp.Time).Seconds(),
+		})
+	}
+
+	return results
+}
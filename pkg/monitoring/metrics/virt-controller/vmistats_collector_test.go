@@ -20,6 +20,8 @@
 package virtcontroller
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -34,6 +36,7 @@ import (
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
 
 	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/hooks"
 	"kubevirt.io/kubevirt/pkg/instancetype/apply"
 	"kubevirt.io/kubevirt/pkg/instancetype/find"
 	preferencefind "kubevirt.io/kubevirt/pkg/instancetype/preference/find"
@@ -577,6 +580,119 @@ var _ = Describe("VMI Stats Collector", func() {
 			Expect(metric1.Value).To(BeNumerically("<", metric2.Value))
 		})
 	})
+
+	Context("VMI hook sidecar restarts", func() {
+		setupTestCollector()
+
+		vmi := &k6tv1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-vmi",
+				Namespace: "test-hooks-ns",
+				UID:       "test-hooks-vmi-uid",
+			},
+			Status: k6tv1.VirtualMachineInstanceStatus{
+				NodeName: "test-node",
+			},
+		}
+
+		It("should collect the restarts of every hook sidecar with its plugin", func() {
+			podMeta := newPodMetaForInformer("virt-launcher-hookspod", "test-hooks-ns", "test-hooks-vmi-uid")
+			podMeta.Annotations = map[string]string{
+				hooks.HookSidecarPluginsAnnotationName: `{"hook-sidecar-1":"test-plugin"}`,
+			}
+			pod := &k8sv1.Pod{
+				ObjectMeta: podMeta,
+				Spec:       k8sv1.PodSpec{NodeName: "test-node"},
+				Status: k8sv1.PodStatus{
+					Phase: k8sv1.PodRunning,
+					ContainerStatuses: []k8sv1.ContainerStatus{
+						{Name: "compute", RestartCount: 5},
+						{Name: "hook-sidecar-0", RestartCount: 1},
+						{Name: "hook-sidecar-1", RestartCount: 2},
+					},
+				},
+			}
+			Expect(indexers.KVPod.Add(pod)).To(Succeed())
+			DeferCleanup(func() { Expect(indexers.KVPod.Delete(pod)).To(Succeed()) })
+
+			crs := collectVMIHookSidecarRestarts(vmi)
+			Expect(crs).To(HaveLen(2))
+			Expect(crs[0].Metric.GetOpts().Name).To(Equal("kubevirt_vmi_hook_sidecar_restarts_total"))
+			Expect(crs[0].Labels).To(Equal([]string{"test-hooks-ns", "test-vmi", "hook-sidecar-0", ""}))
+			Expect(crs[0].Value).To(Equal(1.0))
+			Expect(crs[1].Labels).To(Equal([]string{"test-hooks-ns", "test-vmi", "hook-sidecar-1", "test-plugin"}))
+			Expect(crs[1].Value).To(Equal(2.0))
+		})
+
+		It("should collect the info of every hook sidecar with its plugin", func() {
+			podMeta := newPodMetaForInformer("virt-launcher-hookspod", "test-hooks-ns", "test-hooks-vmi-uid")
+			podMeta.Annotations = map[string]string{
+				hooks.HookSidecarPluginsAnnotationName: `{"hook-sidecar-1":"test-plugin"}`,
+			}
+			pod := &k8sv1.Pod{
+				ObjectMeta: podMeta,
+				Spec: k8sv1.PodSpec{
+					NodeName: "test-node",
+					Containers: []k8sv1.Container{
+						{Name: "compute"},
+						{Name: "hook-sidecar-0"},
+						{Name: "hook-sidecar-1"},
+					},
+				},
+				Status: k8sv1.PodStatus{Phase: k8sv1.PodRunning},
+			}
+			Expect(indexers.KVPod.Add(pod)).To(Succeed())
+			DeferCleanup(func() { Expect(indexers.KVPod.Delete(pod)).To(Succeed()) })
+
+			crs := collectVMIHookSidecarInfo(vmi)
+			Expect(crs).To(HaveLen(2))
+			Expect(crs[0].Metric.GetOpts().Name).To(Equal("kubevirt_vmi_hook_sidecar_info"))
+			Expect(crs[0].Labels).To(Equal([]string{"test-hooks-ns", "test-vmi", "virt-launcher-hookspod", "hook-sidecar-0", ""}))
+			Expect(crs[0].Value).To(Equal(1.0))
+			Expect(crs[1].Labels).To(Equal([]string{"test-hooks-ns", "test-vmi", "virt-launcher-hookspod", "hook-sidecar-1", "test-plugin"}))
+		})
+
+		It("should not collect anything without a running virt-launcher pod", func() {
+			Expect(collectVMIHookSidecarRestarts(vmi)).To(BeEmpty())
+			Expect(collectVMIHookSidecarInfo(vmi)).To(BeEmpty())
+		})
+	})
+
+	Context("VMI boot phases", func() {
+		created := metav1.NewTime(time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC))
+
+		It("should collect the seconds from the VMI creation to every reached boot phase", func() {
+			vmi := &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "test-vmi",
+					Namespace:         "test-ns",
+					CreationTimestamp: created,
+				},
+				Status: k6tv1.VirtualMachineInstanceStatus{
+					NodeName: "test-node",
+					BootPhaseTimestamps: []k6tv1.VirtualMachineInstanceBootPhaseTimestamp{
+						{Phase: k6tv1.BootPhasePodScheduled, Timestamp: metav1.NewTime(created.Add(2 * time.Second))},
+						{Phase: k6tv1.BootPhaseDomainDefined, Timestamp: metav1.NewTime(created.Add(15 * time.Second))},
+					},
+				},
+			}
+
+			crs := collectVMIBootPhases(vmi)
+			Expect(crs).To(HaveLen(2))
+			Expect(crs[0].Metric.GetOpts().Name).To(Equal("kubevirt_vmi_boot_phase_seconds"))
+			Expect(crs[0].Labels).To(Equal([]string{"test-node", "test-ns", "test-vmi", "PodScheduled"}))
+			Expect(crs[0].Value).To(Equal(2.0))
+			Expect(crs[1].Labels).To(Equal([]string{"test-node", "test-ns", "test-vmi", "DomainDefined"}))
+			Expect(crs[1].Value).To(Equal(15.0))
+		})
+
+		It("should not collect anything before the VMI reached a boot phase", func() {
+			vmi := &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-vmi", Namespace: "test-ns", CreationTimestamp: created},
+			}
+			Expect(collectVMIBootPhases(vmi)).To(BeEmpty())
+		})
+	})
 })
 
 func setupMigrationPods() {
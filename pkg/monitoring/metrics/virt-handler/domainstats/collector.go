@@ -42,6 +42,8 @@ var (
 		networkMetrics{},
 		cpuAffinityMetrics{},
 		filesystemMetrics{},
+		hookSidecarMetrics{},
+		nodeSwapMetrics{},
 	}
 
 	Collector = operatormetrics.Collector{
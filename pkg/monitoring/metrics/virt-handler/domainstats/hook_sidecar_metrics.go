@@ -0,0 +1,85 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package domainstats
+
+import "github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+
+var (
+	hookSidecarRequests = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_hook_sidecar_requests_total",
+			Help: "Total number of requests sent by virt-launcher to the hook sidecar.",
+		},
+	)
+
+	hookSidecarRequestErrors = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_hook_sidecar_request_errors_total",
+			Help: "Total number of requests sent by virt-launcher to the hook sidecar which failed.",
+		},
+	)
+
+	hookSidecarBindingPluginErrors = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_hook_sidecar_binding_plugin_errors_total",
+			Help: "Total number of requests sent by virt-launcher to the hook sidecar which failed, by the binding plugin error code the sidecar classified the failure with.",
+		},
+	)
+)
+
+type hookSidecarMetrics struct{}
+
+func (hookSidecarMetrics) Describe() []operatormetrics.Metric {
+	return []operatormetrics.Metric{
+		hookSidecarRequests,
+		hookSidecarRequestErrors,
+		hookSidecarBindingPluginErrors,
+	}
+}
+
+func (hookSidecarMetrics) Collect(vmiReport *VirtualMachineInstanceReport) []operatormetrics.CollectorResult {
+	var crs []operatormetrics.CollectorResult
+
+	if vmiReport.vmiStats.DomainStats == nil {
+		return crs
+	}
+
+	for _, hookSidecar := range vmiReport.vmiStats.DomainStats.HookSidecars {
+		hookSidecarLabels := map[string]string{
+			"container": hookSidecar.Container,
+			"plugin":    hookSidecar.Plugin,
+		}
+
+		crs = append(crs,
+			vmiReport.newCollectorResultWithLabels(hookSidecarRequests, float64(hookSidecar.Requests), hookSidecarLabels),
+			vmiReport.newCollectorResultWithLabels(hookSidecarRequestErrors, float64(hookSidecar.Errors), hookSidecarLabels),
+		)
+
+		for code, errors := range hookSidecar.ErrorsByCode {
+			crs = append(crs, vmiReport.newCollectorResultWithLabels(hookSidecarBindingPluginErrors, float64(errors), map[string]string{
+				"container": hookSidecar.Container,
+				"plugin":    hookSidecar.Plugin,
+				"code":      code,
+			}))
+		}
+	}
+
+	return crs
+}
@@ -0,0 +1,90 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package domainstats
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k6tv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/testing"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+var _ = Describe("hook sidecar metrics", func() {
+	Context("on Collect", func() {
+		vmi := &k6tv1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-vmi-1",
+				Namespace: "test-ns-1",
+			},
+		}
+
+		vmiStats := &VirtualMachineInstanceStats{
+			DomainStats: &stats.DomainStats{
+				HookSidecars: []stats.DomainStatsHookSidecar{
+					{
+						Container: "hook-sidecar-0",
+						Plugin:    "test-plugin",
+						Requests:  3,
+						Errors:    1,
+						ErrorsByCode: map[string]uint64{
+							"DeviceMissing": 1,
+						},
+					},
+				},
+			},
+		}
+
+		vmiReport := newVirtualMachineInstanceReport(vmi, vmiStats)
+
+		DescribeTable("should collect metrics values", func(metric operatormetrics.Metric, expectedValue float64) {
+			crs := hookSidecarMetrics{}.Collect(vmiReport)
+			Expect(crs).To(ContainElement(testing.GomegaContainsCollectorResultMatcher(metric, expectedValue)))
+		},
+			Entry("kubevirt_vmi_hook_sidecar_requests_total", hookSidecarRequests, 3.0),
+			Entry("kubevirt_vmi_hook_sidecar_request_errors_total", hookSidecarRequestErrors, 1.0),
+			Entry("kubevirt_vmi_hook_sidecar_binding_plugin_errors_total", hookSidecarBindingPluginErrors, 1.0),
+		)
+
+		It("should label the binding plugin errors with their code", func() {
+			crs := hookSidecarMetrics{}.Collect(vmiReport)
+			Expect(crs).To(ContainElement(HaveField("ConstLabels", HaveKeyWithValue("code", "DeviceMissing"))))
+		})
+
+		It("should label the metrics with the container and plugin of the sidecar", func() {
+			crs := hookSidecarMetrics{}.Collect(vmiReport)
+			Expect(crs).ToNot(BeEmpty())
+			for _, cr := range crs {
+				Expect(cr.ConstLabels).To(HaveKeyWithValue("container", "hook-sidecar-0"))
+				Expect(cr.ConstLabels).To(HaveKeyWithValue("plugin", "test-plugin"))
+			}
+		})
+
+		It("result should be empty if there are no hook sidecars", func() {
+			vmiStats.DomainStats.HookSidecars = nil
+			crs := hookSidecarMetrics{}.Collect(vmiReport)
+			Expect(crs).To(BeEmpty())
+		})
+	})
+})
@@ -19,7 +19,18 @@
 
 package domainstats
 
-import "github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+import (
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+
+	k6tv1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	networkTypePod       = "pod"
+	networkTypeMultus    = "multus"
+	networkTypeNative    = "native"
+	networkTypeUnmanaged = "unmanaged"
+)
 
 var (
 	networkTrafficBytesDeprecated = operatormetrics.NewCounter(
@@ -84,8 +95,34 @@ var (
 			Help: "The total number of tx packets dropped on vNIC interfaces.",
 		},
 	)
+
+	networkUsageReceiveBytes = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_network_usage_receive_bytes_total",
+			Help: "Total network traffic received in bytes, aggregated per VMI network. " +
+				"Traffic of interfaces not declared in the VMI spec is reported under the unmanaged network type.",
+		},
+	)
+
+	networkUsageTransmitBytes = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_network_usage_transmit_bytes_total",
+			Help: "Total network traffic transmitted in bytes, aggregated per VMI network. " +
+				"Traffic of interfaces not declared in the VMI spec is reported under the unmanaged network type.",
+		},
+	)
 )
 
+type networkUsage struct {
+	rxBytes uint64
+	txBytes uint64
+}
+
+type networkUsageKey struct {
+	network     string
+	networkType string
+}
+
 type networkMetrics struct{}
 
 func (networkMetrics) Describe() []operatormetrics.Metric {
@@ -99,6 +136,8 @@ func (networkMetrics) Describe() []operatormetrics.Metric {
 		networkTransmitErrors,
 		networkReceivePacketsDropped,
 		networkTransmitPacketsDropped,
+		networkUsageReceiveBytes,
+		networkUsageTransmitBytes,
 	}
 }
 
@@ -161,5 +200,64 @@ func (networkMetrics) Collect(vmiReport *VirtualMachineInstanceReport) []operato
 		}
 	}
 
+	crs = append(crs, collectNetworkUsage(vmiReport)...)
+
 	return crs
 }
+
+// collectNetworkUsage sums the traffic of the VMI interfaces per network, so
+// it can be accounted to the network it was sent over.
+func collectNetworkUsage(vmiReport *VirtualMachineInstanceReport) []operatormetrics.CollectorResult {
+	networkTypes := vmiNetworkTypes(vmiReport.vmi)
+
+	var keys []networkUsageKey
+	usage := map[networkUsageKey]*networkUsage{}
+	for _, net := range vmiReport.vmiStats.DomainStats.Net {
+		if !net.NameSet {
+			continue
+		}
+
+		key := networkUsageKey{networkType: networkTypeUnmanaged}
+		if networkType, exists := networkTypes[net.Alias]; net.AliasSet && exists {
+			key = networkUsageKey{network: net.Alias, networkType: networkType}
+		}
+		if _, exists := usage[key]; !exists {
+			usage[key] = &networkUsage{}
+			keys = append(keys, key)
+		}
+		if net.RxBytesSet {
+			usage[key].rxBytes += net.RxBytes
+		}
+		if net.TxBytesSet {
+			usage[key].txBytes += net.TxBytes
+		}
+	}
+
+	var crs []operatormetrics.CollectorResult
+	for _, key := range keys {
+		labels := map[string]string{"network": key.network, "network_type": key.networkType}
+		crs = append(crs,
+			vmiReport.newCollectorResultWithLabels(networkUsageReceiveBytes, float64(usage[key].rxBytes), labels),
+			vmiReport.newCollectorResultWithLabels(networkUsageTransmitBytes, float64(usage[key].txBytes), labels),
+		)
+	}
+
+	return crs
+}
+
+// vmiNetworkTypes maps the names of the networks declared in the VMI spec,
+// which are also the names of their interfaces, to their type.
+func vmiNetworkTypes(vmi *k6tv1.VirtualMachineInstance) map[string]string {
+	networkTypes := map[string]string{}
+	for _, network := range vmi.Spec.Networks {
+		switch {
+		case network.Pod != nil:
+			networkTypes[network.Name] = networkTypePod
+		case network.Multus != nil:
+			networkTypes[network.Name] = networkTypeMultus
+		case network.Native != nil:
+			networkTypes[network.Name] = networkTypeNative
+		}
+	}
+	return networkTypes
+}
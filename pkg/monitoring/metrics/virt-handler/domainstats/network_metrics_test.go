@@ -89,4 +89,56 @@ var _ = Describe("network metrics", func() {
 			Expect(crs).To(BeEmpty())
 		})
 	})
+
+	Context("on Collect network usage", func() {
+		vmi := &k6tv1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-vmi-1",
+				Namespace: "test-ns-1",
+			},
+			Spec: k6tv1.VirtualMachineInstanceSpec{
+				Networks: []k6tv1.Network{
+					*k6tv1.DefaultPodNetwork(),
+					{Name: "blue", NetworkSource: k6tv1.NetworkSource{Multus: &k6tv1.MultusNetwork{NetworkName: "blue-net"}}},
+					{Name: "green", NetworkSource: k6tv1.NetworkSource{Native: &k6tv1.NativeNetwork{PodNetworkName: "green-net"}}},
+				},
+			},
+		}
+
+		vmiStats := &VirtualMachineInstanceStats{
+			DomainStats: &stats.DomainStats{
+				Net: []stats.DomainStatsNet{
+					{NameSet: true, Name: "tap0", AliasSet: true, Alias: "default", RxBytesSet: true, RxBytes: 10, TxBytesSet: true, TxBytes: 20},
+					{NameSet: true, Name: "tap1", AliasSet: true, Alias: "blue", RxBytesSet: true, RxBytes: 1, TxBytesSet: true, TxBytes: 2},
+					{NameSet: true, Name: "tap2", AliasSet: true, Alias: "green", RxBytesSet: true, RxBytes: 3, TxBytesSet: true, TxBytes: 4},
+					{NameSet: true, Name: "vnet2", RxBytesSet: true, RxBytes: 100, TxBytesSet: true, TxBytes: 200},
+					{NameSet: true, Name: "vnet3", AliasSet: true, Alias: "hook", RxBytesSet: true, RxBytes: 5, TxBytesSet: true, TxBytes: 5},
+				},
+			},
+		}
+
+		vmiReport := newVirtualMachineInstanceReport(vmi, vmiStats)
+
+		usageOf := func(crs []operatormetrics.CollectorResult, metric operatormetrics.Metric, network, networkType string) []float64 {
+			var values []float64
+			for _, cr := range crs {
+				if cr.Metric.GetOpts().Name == metric.GetOpts().Name &&
+					cr.ConstLabels["network"] == network && cr.ConstLabels["network_type"] == networkType {
+					values = append(values, cr.Value)
+				}
+			}
+			return values
+		}
+
+		DescribeTable("should aggregate traffic per network", func(network, networkType string, expectedRx, expectedTx float64) {
+			crs := networkMetrics{}.Collect(vmiReport)
+			Expect(usageOf(crs, networkUsageReceiveBytes, network, networkType)).To(ConsistOf(expectedRx))
+			Expect(usageOf(crs, networkUsageTransmitBytes, network, networkType)).To(ConsistOf(expectedTx))
+		},
+			Entry("on the pod network", "default", "pod", 10.0, 20.0),
+			Entry("on a multus network", "blue", "multus", 1.0, 2.0),
+			Entry("on a native network", "green", "native", 3.0, 4.0),
+			Entry("on interfaces not declared in the VMI spec", "", "unmanaged", 105.0, 205.0),
+		)
+	})
 })
@@ -0,0 +1,79 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package domainstats
+
+import "github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+
+var (
+	nodeSwapUsage = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_node_swap_usage_bytes",
+			Help: "The amount of node swap used by the virt-launcher pod of the VirtualMachineInstance (VMI) in bytes.",
+		},
+	)
+
+	nodeSwapInTrafficBytes = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_node_swap_in_traffic_bytes_total",
+			Help: "The total amount of data read from the node swap by the virt-launcher pod of the VirtualMachineInstance (VMI) in bytes.",
+		},
+	)
+
+	nodeSwapOutTrafficBytes = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_node_swap_out_traffic_bytes_total",
+			Help: "The total amount of data written to the node swap by the virt-launcher pod of the VirtualMachineInstance (VMI) in bytes.",
+		},
+	)
+)
+
+type nodeSwapMetrics struct{}
+
+func (nodeSwapMetrics) Describe() []operatormetrics.Metric {
+	return []operatormetrics.Metric{
+		nodeSwapUsage,
+		nodeSwapInTrafficBytes,
+		nodeSwapOutTrafficBytes,
+	}
+}
+
+func (nodeSwapMetrics) Collect(vmiReport *VirtualMachineInstanceReport) []operatormetrics.CollectorResult {
+	var crs []operatormetrics.CollectorResult
+
+	if vmiReport.vmiStats.DomainStats == nil || vmiReport.vmiStats.DomainStats.HostSwap == nil {
+		return crs
+	}
+
+	hostSwap := vmiReport.vmiStats.DomainStats.HostSwap
+
+	if hostSwap.UsageSet {
+		crs = append(crs, vmiReport.newCollectorResult(nodeSwapUsage, float64(hostSwap.Usage)))
+	}
+
+	if hostSwap.SwapInSet {
+		crs = append(crs, vmiReport.newCollectorResult(nodeSwapInTrafficBytes, float64(hostSwap.SwapIn)))
+	}
+
+	if hostSwap.SwapOutSet {
+		crs = append(crs, vmiReport.newCollectorResult(nodeSwapOutTrafficBytes, float64(hostSwap.SwapOut)))
+	}
+
+	return crs
+}
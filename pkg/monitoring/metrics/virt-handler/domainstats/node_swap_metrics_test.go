@@ -0,0 +1,73 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package domainstats
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k6tv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/testing"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+var _ = Describe("node swap metrics", func() {
+	Context("on Collect", func() {
+		vmi := &k6tv1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-vmi-1",
+				Namespace: "test-ns-1",
+			},
+		}
+
+		vmiStats := &VirtualMachineInstanceStats{
+			DomainStats: &stats.DomainStats{
+				HostSwap: &stats.DomainStatsHostSwap{
+					UsageSet:   true,
+					Usage:      1,
+					SwapInSet:  true,
+					SwapIn:     2,
+					SwapOutSet: true,
+					SwapOut:    3,
+				},
+			},
+		}
+
+		vmiReport := newVirtualMachineInstanceReport(vmi, vmiStats)
+
+		DescribeTable("should collect metrics values", func(metric operatormetrics.Metric, expectedValue float64) {
+			crs := nodeSwapMetrics{}.Collect(vmiReport)
+			Expect(crs).To(ContainElement(testing.GomegaContainsCollectorResultMatcher(metric, expectedValue)))
+		},
+			Entry("kubevirt_vmi_node_swap_usage_bytes", nodeSwapUsage, 1.0),
+			Entry("kubevirt_vmi_node_swap_in_traffic_bytes_total", nodeSwapInTrafficBytes, 2.0),
+			Entry("kubevirt_vmi_node_swap_out_traffic_bytes_total", nodeSwapOutTrafficBytes, 3.0),
+		)
+
+		It("result should be empty if the node swap is not reported", func() {
+			vmiStats.DomainStats.HostSwap = nil
+			crs := nodeSwapMetrics{}.Collect(vmiReport)
+			Expect(crs).To(BeEmpty())
+		})
+	})
+})
@@ -258,4 +258,31 @@ var vmsAlerts = []promv1.Rule{
 			operatorHealthImpactLabelKey: "none",
 		},
 	},
+	{
+		Alert: "HookSidecarRestarting",
+		Expr:  intstr.FromString("plugin:kubevirt_vmi_hook_sidecar_restarts:increase10m{plugin!=''} > 0"),
+		For:   ptr.To(promv1.Duration("10m")),
+		Annotations: map[string]string{
+			descriptionAnnotationKey: "The hook sidecar containers of the {{ $labels.plugin }} network binding plugin keep restarting",
+			summaryAnnotationKey:     "Hook sidecar containers of a network binding plugin have been restarting for more than 10 minutes",
+		},
+		Labels: map[string]string{
+			severityAlertLabelKey:        "warning",
+			operatorHealthImpactLabelKey: "none",
+		},
+	},
+	{
+		Alert: "HookSidecarRequestErrors",
+		Expr:  intstr.FromString("plugin:kubevirt_vmi_hook_sidecar_request_errors:ratio_rate5m{plugin!=''} > 0.05"),
+		For:   ptr.To(promv1.Duration("10m")),
+		Annotations: map[string]string{
+			descriptionAnnotationKey: "More than 5% of the requests of virt-launcher to the hook sidecars of the " +
+				"{{ $labels.plugin }} network binding plugin failed in the last 10 minutes",
+			summaryAnnotationKey: "Requests to the hook sidecars of a network binding plugin are failing",
+		},
+		Labels: map[string]string{
+			severityAlertLabelKey:        "warning",
+			operatorHealthImpactLabelKey: "none",
+		},
+	},
 }
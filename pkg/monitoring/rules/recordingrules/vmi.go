@@ -127,4 +127,60 @@ var vmiRecordingRules = []operatorrules.RecordingRule{
 		MetricType: operatormetrics.CounterType,
 		Expr:       intstr.FromString("kubevirt_vmi_migration_data_bytes_total"),
 	},
+	{
+		MetricsOpts: operatormetrics.MetricOpts{
+			Name: "plugin:kubevirt_vmi_hook_sidecar_restarts:increase10m",
+			Help: "The number of restarts of the hook sidecar containers in the last 10 minutes, aggregated by plugin.",
+		},
+		MetricType: operatormetrics.GaugeType,
+		Expr:       intstr.FromString("sum by (plugin) (increase(kubevirt_vmi_hook_sidecar_restarts_total[10m]))"),
+	},
+	{
+		MetricsOpts: operatormetrics.MetricOpts{
+			Name: "plugin:kubevirt_vmi_hook_sidecar_request_errors:ratio_rate5m",
+			Help: "The ratio of the requests to the hook sidecars which failed in the last 5 minutes, aggregated by plugin.",
+		},
+		MetricType: operatormetrics.GaugeType,
+		Expr: intstr.FromString(
+			"sum by (plugin) (rate(kubevirt_vmi_hook_sidecar_request_errors_total[5m])) / " +
+				"sum by (plugin) (rate(kubevirt_vmi_hook_sidecar_requests_total[5m]))",
+		),
+	},
+	{
+		MetricsOpts: operatormetrics.MetricOpts{
+			Name: "plugin:kubevirt_vmi_hook_sidecar_cpu_usage_seconds:rate5m",
+			Help: "The CPU usage of the hook sidecar containers in the last 5 minutes, aggregated by plugin.",
+		},
+		MetricType: operatormetrics.GaugeType,
+		Expr: intstr.FromString(
+			"sum by (plugin) (rate(container_cpu_usage_seconds_total{container=~'hook-sidecar-.*', pod=~'virt-launcher-.*'}[5m]) " +
+				"* on (namespace, pod, container) group_left(plugin) kubevirt_vmi_hook_sidecar_info)",
+		),
+	},
+	{
+		MetricsOpts: operatormetrics.MetricOpts{
+			Name: "plugin:kubevirt_vmi_hook_sidecar_memory_working_set_bytes:sum",
+			Help: "The memory working set of the hook sidecar containers, aggregated by plugin.",
+		},
+		MetricType: operatormetrics.GaugeType,
+		Expr: intstr.FromString(
+			"sum by (plugin) (container_memory_working_set_bytes{container=~'hook-sidecar-.*', pod=~'virt-launcher-.*'} " +
+				"* on (namespace, pod, container) group_left(plugin) kubevirt_vmi_hook_sidecar_info)",
+		),
+	},
+	{
+		MetricsOpts: operatormetrics.MetricOpts{
+			Name: "plugin:kubevirt_vmi_hook_sidecar_memory_limit_usage:max_ratio",
+			Help: "The highest ratio between the memory working set of a hook sidecar container and its memory limit, " +
+				"aggregated by plugin.",
+		},
+		MetricType: operatormetrics.GaugeType,
+		Expr: intstr.FromString(
+			"max by (plugin) ((max by (namespace, pod, container) " +
+				"(container_memory_working_set_bytes{container=~'hook-sidecar-.*', pod=~'virt-launcher-.*'}) / " +
+				"max by (namespace, pod, container) " +
+				"(kube_pod_container_resource_limits{container=~'hook-sidecar-.*', pod=~'virt-launcher-.*', resource='memory'})) " +
+				"* on (namespace, pod, container) group_left(plugin) kubevirt_vmi_hook_sidecar_info)",
+		),
+	},
 }
@@ -30,12 +30,57 @@ func TestAdmitter(t *testing.T) {
 }
 
 type stubClusterConfigChecker struct {
-	bridgeBindingOnPodNetEnabled   bool
-	passtBindingFeatureGateEnabled bool
+	bridgeBindingOnPodNetEnabled          bool
+	passtBindingFeatureGateEnabled        bool
+	nativeMultiNetworkFeatureGateEnabled  bool
+	interfaceFirewallFeatureGateEnabled   bool
+	interfaceOffloadsFeatureGateEnabled   bool
+	interfaceInterruptsFeatureGateEnabled bool
+	routerAdvertisementFeatureGateEnabled bool
+	portsEnforcementFeatureGateEnabled    bool
+	networkEmulationFeatureGateEnabled    bool
+	interfaceMirroringFeatureGateEnabled  bool
+	metadataServiceFeatureGateEnabled     bool
 }
 
 func (s stubClusterConfigChecker) PasstBindingEnabled() bool { return s.passtBindingFeatureGateEnabled }
 
+func (s stubClusterConfigChecker) NativeMultiNetworkEnabled() bool {
+	return s.nativeMultiNetworkFeatureGateEnabled
+}
+
 func (s stubClusterConfigChecker) IsBridgeInterfaceOnPodNetworkEnabled() bool {
 	return s.bridgeBindingOnPodNetEnabled
 }
+
+func (s stubClusterConfigChecker) InterfaceFirewallEnabled() bool {
+	return s.interfaceFirewallFeatureGateEnabled
+}
+
+func (s stubClusterConfigChecker) InterfaceOffloadsEnabled() bool {
+	return s.interfaceOffloadsFeatureGateEnabled
+}
+
+func (s stubClusterConfigChecker) InterfaceInterruptsEnabled() bool {
+	return s.interfaceInterruptsFeatureGateEnabled
+}
+
+func (s stubClusterConfigChecker) IPv6RouterAdvertisementEnabled() bool {
+	return s.routerAdvertisementFeatureGateEnabled
+}
+
+func (s stubClusterConfigChecker) MasqueradePortsEnforcementEnabled() bool {
+	return s.portsEnforcementFeatureGateEnabled
+}
+
+func (s stubClusterConfigChecker) NetworkEmulationEnabled() bool {
+	return s.networkEmulationFeatureGateEnabled
+}
+
+func (s stubClusterConfigChecker) InterfaceMirroringEnabled() bool {
+	return s.interfaceMirroringFeatureGateEnabled
+}
+
+func (s stubClusterConfigChecker) MetadataServiceEnabled() bool {
+	return s.metadataServiceFeatureGateEnabled
+}
@@ -32,6 +32,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/network/admitter"
+	"kubevirt.io/kubevirt/pkg/pointer"
 )
 
 var _ = Describe("Validating VMI network spec", func() {
@@ -123,4 +124,17 @@ var _ = Describe("Validating VMI network spec", func() {
 				Field:   "fake.domain.devices.interfaces[0].state",
 			}))
 	})
+
+	It("should reject an invalid network interface multi-queue policy", func() {
+		vm := libvmi.New(libvmi.WithNetwork(v1.DefaultPodNetwork()), libvmi.WithInterface(*v1.DefaultMasqueradeNetworkInterface()))
+		vm.Spec.Domain.Devices.NetworkInterfaceMultiQueuePolicy = &v1.NetworkQueuesPolicy{MaxQueues: pointer.P(uint32(512))}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), &vm.Spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(
+			ConsistOf(metav1.StatusCause{
+				Type:    "FieldValueInvalid",
+				Message: "maxQueues must be between 1 and 256",
+				Field:   "fake.domain.devices.networkInterfaceMultiqueuePolicy.maxQueues",
+			}))
+	})
 })
@@ -41,6 +41,14 @@ func validateInterfaceBinding(
 		causes = append(causes, validateMasqueradeBinding(fieldPath, idx, iface, networksByName[iface.Name])...)
 		causes = append(causes, validateBridgeBinding(fieldPath, idx, iface, networksByName[iface.Name], config)...)
 		causes = append(causes, validatePasstBinding(fieldPath, idx, iface, networksByName[iface.Name], config)...)
+		causes = append(causes, validateInterfaceFirewall(fieldPath, idx, iface, config)...)
+		causes = append(causes, validateInterfaceOffloads(fieldPath, idx, iface, config)...)
+		causes = append(causes, validateInterfaceInterrupts(fieldPath, idx, iface, config)...)
+		causes = append(causes, validateRouterAdvertisement(fieldPath, idx, iface, config)...)
+		causes = append(causes, validatePortsEnforcement(fieldPath, idx, iface, config)...)
+		causes = append(causes, validateNetworkEmulation(fieldPath, idx, iface, config)...)
+		causes = append(causes, validateInterfaceMirror(fieldPath, idx, iface, config)...)
+		causes = append(causes, validatePromiscuous(fieldPath, idx, iface)...)
 	}
 	return causes
 }
@@ -0,0 +1,109 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter
+
+import (
+	"fmt"
+	"net"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	firewallProtocolTCP  = "TCP"
+	firewallProtocolUDP  = "UDP"
+	firewallProtocolICMP = "ICMP"
+)
+
+func validateInterfaceFirewall(
+	fieldPath *field.Path, idx int, iface v1.Interface, config clusterConfigChecker,
+) []metav1.StatusCause {
+	if iface.Firewall == nil {
+		return nil
+	}
+
+	firewallField := fieldPath.Child("domain", "devices", "interfaces").Index(idx).Child("firewall")
+	if !config.InterfaceFirewallEnabled() {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "InterfaceFirewall feature gate is not enabled",
+			Field:   firewallField.String(),
+		}}
+	}
+	if iface.Masquerade == nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("firewall of interface %s is supported only with the masquerade binding", iface.Name),
+			Field:   firewallField.String(),
+		}}
+	}
+
+	var causes []metav1.StatusCause
+	for ruleIdx, rule := range iface.Firewall.Ingress {
+		causes = append(causes, validateFirewallRule(firewallField.Child("ingress").Index(ruleIdx), rule)...)
+	}
+	return causes
+}
+
+func validateFirewallRule(ruleField *field.Path, rule v1.FirewallRule) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	switch rule.Protocol {
+	case "", firewallProtocolTCP, firewallProtocolUDP, firewallProtocolICMP:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Unknown protocol, only TCP, UDP or ICMP allowed",
+			Field:   ruleField.Child("protocol").String(),
+		})
+	}
+
+	if len(rule.Ports) > 0 && rule.Protocol != firewallProtocolTCP && rule.Protocol != firewallProtocolUDP {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Ports are supported only with the TCP or UDP protocols",
+			Field:   ruleField.Child("ports").String(),
+		})
+	}
+	for portIdx, port := range rule.Ports {
+		if port <= 0 || port > 65535 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "Port field must be in range 0 < x < 65536.",
+				Field:   ruleField.Child("ports").Index(portIdx).String(),
+			})
+		}
+	}
+
+	for cidrIdx, cidr := range rule.From {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("Invalid CIDR %q", cidr),
+				Field:   ruleField.Child("from").Index(cidrIdx).String(),
+			})
+		}
+	}
+
+	return causes
+}
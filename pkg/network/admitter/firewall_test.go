@@ -0,0 +1,108 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/admitter"
+)
+
+var _ = Describe("Validating interface firewall", func() {
+	newSpec := func(bindingMethod v1.InterfaceBindingMethod, rules ...v1.FirewallRule) *v1.VirtualMachineInstanceSpec {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   "default",
+			InterfaceBindingMethod: bindingMethod,
+			Firewall:               &v1.InterfaceFirewall{Ingress: rules},
+		}}
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+		return spec
+	}
+
+	masquerade := v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}
+	enabledFirewall := stubClusterConfigChecker{interfaceFirewallFeatureGateEnabled: true}
+
+	It("should reject a firewall when the feature gate is disabled", func() {
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), newSpec(masquerade), stubClusterConfigChecker{})
+
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "InterfaceFirewall feature gate is not enabled",
+			Field:   "fake.domain.devices.interfaces[0].firewall",
+		}))
+	})
+
+	It("should reject a firewall on a non masquerade interface", func() {
+		spec := newSpec(v1.InterfaceBindingMethod{PasstBinding: &v1.InterfacePasstBinding{}})
+		clusterConfig := stubClusterConfigChecker{interfaceFirewallFeatureGateEnabled: true, passtBindingFeatureGateEnabled: true}
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, clusterConfig)
+
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "firewall of interface default is supported only with the masquerade binding",
+			Field:   "fake.domain.devices.interfaces[0].firewall",
+		}))
+	})
+
+	It("should accept valid rules on a masquerade interface", func() {
+		spec := newSpec(masquerade,
+			v1.FirewallRule{Protocol: "TCP", Ports: []int32{22, 443}, From: []string{"10.0.0.0/8", "fd00::/64"}},
+			v1.FirewallRule{Protocol: "ICMP"},
+			v1.FirewallRule{From: []string{"192.168.0.0/24"}},
+		)
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, enabledFirewall)
+
+		Expect(validator.Validate()).To(BeEmpty())
+	})
+
+	DescribeTable("should reject an invalid rule", func(rule v1.FirewallRule, expectedCause metav1.StatusCause) {
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), newSpec(masquerade, rule), enabledFirewall)
+
+		Expect(validator.Validate()).To(ConsistOf(expectedCause))
+	},
+		Entry("with an unknown protocol", v1.FirewallRule{Protocol: "SCTP"}, metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "Unknown protocol, only TCP, UDP or ICMP allowed",
+			Field:   "fake.domain.devices.interfaces[0].firewall.ingress[0].protocol",
+		}),
+		Entry("with ports and no protocol", v1.FirewallRule{Ports: []int32{80}}, metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "Ports are supported only with the TCP or UDP protocols",
+			Field:   "fake.domain.devices.interfaces[0].firewall.ingress[0].ports",
+		}),
+		Entry("with an out of range port", v1.FirewallRule{Protocol: "UDP", Ports: []int32{65536}}, metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "Port field must be in range 0 < x < 65536.",
+			Field:   "fake.domain.devices.interfaces[0].firewall.ingress[0].ports[0]",
+		}),
+		Entry("with an invalid CIDR", v1.FirewallRule{From: []string{"10.0.0.1"}}, metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: `Invalid CIDR "10.0.0.1"`,
+			Field:   "fake.domain.devices.interfaces[0].firewall.ingress[0].from[0]",
+		}),
+	)
+})
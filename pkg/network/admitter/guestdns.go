@@ -0,0 +1,80 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter
+
+import (
+	"fmt"
+	"net"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// The DHCP server of virt-launcher advertises the guest DNS configuration, which is kept within the pod DNS limits
+const (
+	maxGuestDNSNameservers = 3
+	maxGuestDNSSearches    = 32
+)
+
+func validateGuestDNS(fieldPath *field.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	if spec.GuestDNS == nil {
+		return nil
+	}
+	guestDNSField := fieldPath.Child("guestDNS")
+
+	var causes []metav1.StatusCause
+	if len(spec.GuestDNS.Nameservers) > maxGuestDNSNameservers {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("must not have more than %d nameservers", maxGuestDNSNameservers),
+			Field:   guestDNSField.Child("nameservers").String(),
+		})
+	}
+	for idx, nameserver := range spec.GuestDNS.Nameservers {
+		if net.ParseIP(nameserver) == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("nameserver %q must be a valid IP address", nameserver),
+				Field:   guestDNSField.Child("nameservers").Index(idx).String(),
+			})
+		}
+	}
+
+	if len(spec.GuestDNS.Searches) > maxGuestDNSSearches {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("must not have more than %d search domains", maxGuestDNSSearches),
+			Field:   guestDNSField.Child("searches").String(),
+		})
+	}
+	for idx, search := range spec.GuestDNS.Searches {
+		if errs := validation.IsDNS1123Subdomain(search); len(errs) > 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("search domain %q must be a lowercase RFC 1123 subdomain", search),
+				Field:   guestDNSField.Child("searches").Index(idx).String(),
+			})
+		}
+	}
+	return causes
+}
@@ -0,0 +1,70 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/admitter"
+)
+
+var _ = Describe("Validating guest DNS", func() {
+	newSpec := func(guestDNS *v1.GuestDNSConfig) *v1.VirtualMachineInstanceSpec {
+		return &v1.VirtualMachineInstanceSpec{GuestDNS: guestDNS}
+	}
+
+	It("should accept a valid guest DNS configuration", func() {
+		spec := newSpec(&v1.GuestDNSConfig{
+			Nameservers: []string{"10.0.0.53", "2001:db8::53"},
+			Searches:    []string{"dc.example.com", "example.com"},
+		})
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+
+		Expect(validator.Validate()).To(BeEmpty())
+	})
+
+	DescribeTable("should reject an invalid guest DNS configuration", func(guestDNS *v1.GuestDNSConfig, expectedCause metav1.StatusCause) {
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), newSpec(guestDNS), stubClusterConfigChecker{})
+
+		Expect(validator.Validate()).To(ConsistOf(expectedCause))
+	},
+		Entry("with an invalid nameserver", &v1.GuestDNSConfig{Nameservers: []string{"dns.example.com"}}, metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: `nameserver "dns.example.com" must be a valid IP address`,
+			Field:   "fake.guestDNS.nameservers[0]",
+		}),
+		Entry("with too many nameservers", &v1.GuestDNSConfig{Nameservers: []string{"10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4"}}, metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "must not have more than 3 nameservers",
+			Field:   "fake.guestDNS.nameservers",
+		}),
+		Entry("with an invalid search domain", &v1.GuestDNSConfig{Searches: []string{"Example_Com"}}, metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: `search domain "Example_Com" must be a lowercase RFC 1123 subdomain`,
+			Field:   "fake.guestDNS.searches[0]",
+		}),
+	)
+})
@@ -0,0 +1,91 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// maxInterruptVectors is the size limit of the MSI-X table of a PCI device
+const maxInterruptVectors = 2048
+
+func validateInterfaceInterrupts(
+	fieldPath *field.Path, idx int, iface v1.Interface, config clusterConfigChecker,
+) []metav1.StatusCause {
+	if iface.Interrupts == nil {
+		return nil
+	}
+
+	interruptsField := fieldPath.Child("domain", "devices", "interfaces").Index(idx).Child("interrupts")
+	if !config.InterfaceInterruptsEnabled() {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "InterfaceInterrupts feature gate is not enabled",
+			Field:   interruptsField.String(),
+		}}
+	}
+	if iface.Model != "" && iface.Model != v1.VirtIO {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("interrupts of interface %s are supported only with the virtio model", iface.Name),
+			Field:   interruptsField.String(),
+		}}
+	}
+	if iface.SRIOV != nil || iface.PasstBinding != nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("interrupts of interface %s are not supported with the SR-IOV or passt bindings", iface.Name),
+			Field:   interruptsField.String(),
+		}}
+	}
+
+	switch iface.Interrupts.Mode {
+	case "", v1.InterfaceInterruptModeMSIX:
+		if vectors := iface.Interrupts.Vectors; vectors != nil && (*vectors == 0 || *vectors > maxInterruptVectors) {
+			return []metav1.StatusCause{{
+				Type: metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("interrupt vectors of interface %s must be between 1 and %d",
+					iface.Name, maxInterruptVectors),
+				Field: interruptsField.Child("vectors").String(),
+			}}
+		}
+	case v1.InterfaceInterruptModeINTx:
+		if iface.Interrupts.Vectors != nil {
+			return []metav1.StatusCause{{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("interrupt vectors of interface %s cannot be set with the INTx mode", iface.Name),
+				Field:   interruptsField.Child("vectors").String(),
+			}}
+		}
+	default:
+		return []metav1.StatusCause{{
+			Type: metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("interrupt mode %q of interface %s is not supported, supported modes are %s and %s",
+				iface.Interrupts.Mode, iface.Name, v1.InterfaceInterruptModeMSIX, v1.InterfaceInterruptModeINTx),
+			Field: interruptsField.Child("mode").String(),
+		}}
+	}
+	return nil
+}
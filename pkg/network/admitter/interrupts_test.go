@@ -0,0 +1,132 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/admitter"
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Validating interface interrupts", func() {
+	newSpec := func(
+		model string, bindingMethod v1.InterfaceBindingMethod, interrupts *v1.InterfaceInterrupts,
+	) *v1.VirtualMachineInstanceSpec {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   "default",
+			Model:                  model,
+			InterfaceBindingMethod: bindingMethod,
+			Interrupts:             interrupts,
+		}}
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+		return spec
+	}
+
+	masquerade := v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}
+	msixVectors := &v1.InterfaceInterrupts{Vectors: pointer.P(uint32(34))}
+	enabledInterrupts := stubClusterConfigChecker{interfaceInterruptsFeatureGateEnabled: true, passtBindingFeatureGateEnabled: true}
+
+	It("should reject interrupts when the feature gate is disabled", func() {
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), newSpec("", masquerade, msixVectors), stubClusterConfigChecker{})
+
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "InterfaceInterrupts feature gate is not enabled",
+			Field:   "fake.domain.devices.interfaces[0].interrupts",
+		}))
+	})
+
+	DescribeTable("should accept interrupts", func(model string, interrupts *v1.InterfaceInterrupts) {
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), newSpec(model, masquerade, interrupts), enabledInterrupts)
+
+		Expect(validator.Validate()).To(BeEmpty())
+	},
+		Entry("with the implicit virtio model", "", msixVectors),
+		Entry("with the virtio model", v1.VirtIO, msixVectors),
+		Entry("with the explicit MSIX mode",
+			"", &v1.InterfaceInterrupts{Mode: v1.InterfaceInterruptModeMSIX, Vectors: pointer.P(uint32(2048))}),
+		Entry("with the INTx mode", "", &v1.InterfaceInterrupts{Mode: v1.InterfaceInterruptModeINTx}),
+	)
+
+	It("should reject interrupts on a non virtio interface", func() {
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), newSpec("e1000", masquerade, msixVectors), enabledInterrupts)
+
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "interrupts of interface default are supported only with the virtio model",
+			Field:   "fake.domain.devices.interfaces[0].interrupts",
+		}))
+	})
+
+	It("should reject interrupts on a passt interface", func() {
+		spec := newSpec("", v1.InterfaceBindingMethod{PasstBinding: &v1.InterfacePasstBinding{}}, msixVectors)
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, enabledInterrupts)
+
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "interrupts of interface default are not supported with the SR-IOV or passt bindings",
+			Field:   "fake.domain.devices.interfaces[0].interrupts",
+		}))
+	})
+
+	It("should reject an unknown interrupt mode", func() {
+		spec := newSpec("", masquerade, &v1.InterfaceInterrupts{Mode: "MSI"})
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, enabledInterrupts)
+
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueNotSupported",
+			Message: `interrupt mode "MSI" of interface default is not supported, supported modes are MSIX and INTx`,
+			Field:   "fake.domain.devices.interfaces[0].interrupts.mode",
+		}))
+	})
+
+	DescribeTable("should reject out of range vectors", func(vectors uint32) {
+		spec := newSpec("", masquerade, &v1.InterfaceInterrupts{Vectors: pointer.P(vectors)})
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, enabledInterrupts)
+
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "interrupt vectors of interface default must be between 1 and 2048",
+			Field:   "fake.domain.devices.interfaces[0].interrupts.vectors",
+		}))
+	},
+		Entry("with no vectors", uint32(0)),
+		Entry("with more vectors than an MSI-X table holds", uint32(2049)),
+	)
+
+	It("should reject vectors with the INTx mode", func() {
+		spec := newSpec("", masquerade, &v1.InterfaceInterrupts{Mode: v1.InterfaceInterruptModeINTx, Vectors: pointer.P(uint32(4))})
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, enabledInterrupts)
+
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "interrupt vectors of interface default cannot be set with the INTx mode",
+			Field:   "fake.domain.devices.interfaces[0].interrupts.vectors",
+		}))
+	})
+})
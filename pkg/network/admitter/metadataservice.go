@@ -0,0 +1,74 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+func validateMetadataService(
+	fieldPath *field.Path, spec *v1.VirtualMachineInstanceSpec, config clusterConfigChecker,
+) []metav1.StatusCause {
+	if spec.MetadataService == nil {
+		return nil
+	}
+
+	metadataServiceField := fieldPath.Child("metadataService")
+	if !config.MetadataServiceEnabled() {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "MetadataService feature gate is not enabled",
+			Field:   metadataServiceField.String(),
+		}}
+	}
+
+	// The service is reachable by the guest through the masquerade bridge of the pod network
+	var podIface *v1.Interface
+	if podNetwork := vmispec.LookupPodNetwork(spec.Networks); podNetwork != nil {
+		podIface = vmispec.LookupInterfaceByName(spec.Domain.Devices.Interfaces, podNetwork.Name)
+	}
+	if podIface == nil || podIface.Masquerade == nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "the metadata service requires an interface with the masquerade binding on the pod network",
+			Field:   metadataServiceField.String(),
+		}}
+	}
+
+	var causes []metav1.StatusCause
+	for key := range spec.MetadataService.Data {
+		if key == "" || strings.Contains(key, "/") {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("metadata key %q must be non-empty and must not contain '/'", key),
+				Field:   metadataServiceField.Child("data").String(),
+			})
+		}
+	}
+	return causes
+}
@@ -0,0 +1,84 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/admitter"
+)
+
+var _ = Describe("Validating the metadata service", func() {
+	newSpec := func(bindingMethod v1.InterfaceBindingMethod, metadataService *v1.MetadataService) *v1.VirtualMachineInstanceSpec {
+		spec := &v1.VirtualMachineInstanceSpec{MetadataService: metadataService}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{Name: "default", InterfaceBindingMethod: bindingMethod}}
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+		return spec
+	}
+	masquerade := v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}
+	enabledConfig := stubClusterConfigChecker{metadataServiceFeatureGateEnabled: true}
+
+	It("should accept the metadata service with a masquerade pod network interface", func() {
+		spec := newSpec(masquerade, &v1.MetadataService{Data: map[string]string{"role": "db"}})
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, enabledConfig)
+
+		Expect(validator.Validate()).To(BeEmpty())
+	})
+
+	It("should reject the metadata service when the feature gate is not enabled", func() {
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), newSpec(masquerade, &v1.MetadataService{}), stubClusterConfigChecker{})
+
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "MetadataService feature gate is not enabled",
+			Field:   "fake.metadataService",
+		}))
+	})
+
+	It("should reject the metadata service without a masquerade pod network interface", func() {
+		spec := newSpec(v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}, &v1.MetadataService{})
+		config := enabledConfig
+		config.bridgeBindingOnPodNetEnabled = true
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, config)
+
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "the metadata service requires an interface with the masquerade binding on the pod network",
+			Field:   "fake.metadataService",
+		}))
+	})
+
+	It("should reject an invalid metadata key", func() {
+		spec := newSpec(masquerade, &v1.MetadataService{Data: map[string]string{"app/role": "db"}})
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, enabledConfig)
+
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: `metadata key "app/role" must be non-empty and must not contain '/'`,
+			Field:   "fake.metadataService.data",
+		}))
+	})
+})
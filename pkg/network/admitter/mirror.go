@@ -0,0 +1,73 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+func validateInterfaceMirror(
+	fieldPath *field.Path, idx int, iface v1.Interface, config clusterConfigChecker,
+) []metav1.StatusCause {
+	if iface.Mirror == nil {
+		return nil
+	}
+
+	mirrorField := fieldPath.Child("domain", "devices", "interfaces").Index(idx).Child("mirror")
+	if !config.InterfaceMirroringEnabled() {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "InterfaceMirroring feature gate is not enabled",
+			Field:   mirrorField.String(),
+		}}
+	}
+	if iface.Bridge == nil && iface.Masquerade == nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("mirroring of interface %s is supported only with the bridge and masquerade bindings", iface.Name),
+			Field:   mirrorField.String(),
+		}}
+	}
+
+	var causes []metav1.StatusCause
+	if iface.Mirror.NetworkName == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf("mirroring of interface %s requires a network name", iface.Name),
+			Field:   mirrorField.Child("networkName").String(),
+		})
+	}
+	switch iface.Mirror.Direction {
+	case "", v1.InterfaceMirrorIngress, v1.InterfaceMirrorEgress, v1.InterfaceMirrorBoth:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("mirror direction %q is not supported, must be one of: %s, %s, %s",
+				iface.Mirror.Direction, v1.InterfaceMirrorIngress, v1.InterfaceMirrorEgress, v1.InterfaceMirrorBoth),
+			Field: mirrorField.Child("direction").String(),
+		})
+	}
+	return causes
+}
@@ -0,0 +1,104 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/admitter"
+)
+
+var _ = Describe("Validating interface mirroring", func() {
+	newSpec := func(bindingMethod v1.InterfaceBindingMethod, mirror *v1.InterfaceMirror) *v1.VirtualMachineInstanceSpec {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   "default",
+			InterfaceBindingMethod: bindingMethod,
+			Mirror:                 mirror,
+		}}
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+		return spec
+	}
+
+	masquerade := v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}
+	enabledClusterConfig := stubClusterConfigChecker{interfaceMirroringFeatureGateEnabled: true}
+
+	It("should reject mirroring when the feature gate is disabled", func() {
+		mirror := &v1.InterfaceMirror{NetworkName: "monitoring"}
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), newSpec(masquerade, mirror), stubClusterConfigChecker{})
+
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "InterfaceMirroring feature gate is not enabled",
+			Field:   "fake.domain.devices.interfaces[0].mirror",
+		}))
+	})
+
+	DescribeTable("should accept mirroring on a masquerade interface", func(direction v1.InterfaceMirrorDirection) {
+		mirror := &v1.InterfaceMirror{NetworkName: "monitoring-ns/monitoring", Direction: direction}
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), newSpec(masquerade, mirror), enabledClusterConfig)
+
+		Expect(validator.Validate()).To(BeEmpty())
+	},
+		Entry("with the default direction", v1.InterfaceMirrorDirection("")),
+		Entry("with the ingress direction", v1.InterfaceMirrorIngress),
+		Entry("with the egress direction", v1.InterfaceMirrorEgress),
+		Entry("with both directions", v1.InterfaceMirrorBoth),
+	)
+
+	It("should reject mirroring on a passt interface", func() {
+		spec := newSpec(v1.InterfaceBindingMethod{PasstBinding: &v1.InterfacePasstBinding{}}, &v1.InterfaceMirror{NetworkName: "monitoring"})
+		clusterConfig := stubClusterConfigChecker{interfaceMirroringFeatureGateEnabled: true, passtBindingFeatureGateEnabled: true}
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, clusterConfig)
+
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "mirroring of interface default is supported only with the bridge and masquerade bindings",
+			Field:   "fake.domain.devices.interfaces[0].mirror",
+		}))
+	})
+
+	It("should reject mirroring without a network name", func() {
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), newSpec(masquerade, &v1.InterfaceMirror{}), enabledClusterConfig)
+
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueRequired",
+			Message: "mirroring of interface default requires a network name",
+			Field:   "fake.domain.devices.interfaces[0].mirror.networkName",
+		}))
+	})
+
+	It("should reject an unknown mirror direction", func() {
+		mirror := &v1.InterfaceMirror{NetworkName: "monitoring", Direction: "sideways"}
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), newSpec(masquerade, mirror), enabledClusterConfig)
+
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueNotSupported",
+			Message: "mirror direction \"sideways\" is not supported, must be one of: ingress, egress, both",
+			Field:   "fake.domain.devices.interfaces[0].mirror.direction",
+		}))
+	})
+})
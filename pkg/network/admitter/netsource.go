@@ -68,13 +68,14 @@ func validateSinglePodNetwork(field *k8sfield.Path, spec *v1.VirtualMachineInsta
 func validateSingleNetworkSource(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	for idx, net := range spec.Networks {
-		if net.Pod == nil && net.Multus == nil {
+		sourcesCount := countNetworkSources(net.NetworkSource)
+		if sourcesCount == 0 {
 			causes = append(causes, metav1.StatusCause{
 				Type:    metav1.CauseTypeFieldValueRequired,
 				Message: "should have a network type",
 				Field:   field.Child("networks").Index(idx).String(),
 			})
-		} else if net.Pod != nil && net.Multus != nil {
+		} else if sourcesCount > 1 {
 			causes = append(causes, metav1.StatusCause{
 				Type:    metav1.CauseTypeFieldValueRequired,
 				Message: "should have only one network type",
@@ -97,3 +98,43 @@ func validateMultusNetworkSource(field *k8sfield.Path, spec *v1.VirtualMachineIn
 	}
 	return nil
 }
+
+func countNetworkSources(source v1.NetworkSource) int {
+	count := 0
+	if source.Pod != nil {
+		count++
+	}
+	if source.Multus != nil {
+		count++
+	}
+	if source.Native != nil {
+		count++
+	}
+	return count
+}
+
+func validateNativeNetworkSource(
+	field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config clusterConfigChecker,
+) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, net := range spec.Networks {
+		if net.Native == nil {
+			continue
+		}
+		if !config.NativeMultiNetworkEnabled() {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "NativeMultiNetwork feature gate is not enabled",
+				Field:   field.Child("networks").Index(idx).Child("native").String(),
+			})
+		}
+		if net.Native.PodNetworkName == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "native network must have a podNetworkName",
+				Field:   field.Child("networks").Index(idx).Child("native", "podNetworkName").String(),
+			})
+		}
+	}
+	return causes
+}
@@ -258,4 +258,63 @@ var _ = Describe("Validate network source", func() {
 		causes := validator.Validate()
 		Expect(causes).To(BeEmpty())
 	})
+	Context("native network source", func() {
+		newSpecWithNativeNetwork := func(podNetworkName string) *v1.VirtualMachineInstanceSpec {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name:                   "native",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+			}}
+			spec.Networks = []v1.Network{{
+				Name:          "native",
+				NetworkSource: v1.NetworkSource{Native: &v1.NativeNetwork{PodNetworkName: podNetworkName}},
+			}}
+			return spec
+		}
+
+		It("should accept a native network when the feature gate is enabled", func() {
+			spec := newSpecWithNativeNetwork("blue")
+			validator := admitter.NewValidator(
+				k8sfield.NewPath("fake"),
+				spec,
+				stubClusterConfigChecker{nativeMultiNetworkFeatureGateEnabled: true},
+			)
+			Expect(validator.Validate()).To(BeEmpty())
+		})
+
+		It("should reject a native network when the feature gate is disabled", func() {
+			spec := newSpecWithNativeNetwork("blue")
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+			causes := validator.Validate()
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.networks[0].native"))
+			Expect(causes[0].Message).To(Equal("NativeMultiNetwork feature gate is not enabled"))
+		})
+
+		It("should reject a native network without podNetworkName", func() {
+			spec := newSpecWithNativeNetwork("")
+			validator := admitter.NewValidator(
+				k8sfield.NewPath("fake"),
+				spec,
+				stubClusterConfigChecker{nativeMultiNetworkFeatureGateEnabled: true},
+			)
+			causes := validator.Validate()
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.networks[0].native.podNetworkName"))
+			Expect(causes[0].Message).To(Equal("native network must have a podNetworkName"))
+		})
+
+		It("should reject a network with both multus and native sources", func() {
+			spec := newSpecWithNativeNetwork("blue")
+			spec.Networks[0].Multus = &v1.MultusNetwork{NetworkName: "blue"}
+			validator := admitter.NewValidator(
+				k8sfield.NewPath("fake"),
+				spec,
+				stubClusterConfigChecker{nativeMultiNetworkFeatureGateEnabled: true},
+			)
+			causes := validator.Validate()
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Message).To(Equal("should have only one network type"))
+		})
+	})
 })
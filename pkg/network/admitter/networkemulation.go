@@ -0,0 +1,93 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// maxNetworkEmulationDelay is the longest delay the kernel netem qdisc accepts, in microseconds on 32 bits
+const maxNetworkEmulationDelay = time.Duration(math.MaxUint32) * time.Microsecond
+
+func validateNetworkEmulation(
+	fieldPath *field.Path, idx int, iface v1.Interface, config clusterConfigChecker,
+) []metav1.StatusCause {
+	if iface.NetworkEmulation == nil {
+		return nil
+	}
+
+	networkEmulationField := fieldPath.Child("domain", "devices", "interfaces").Index(idx).Child("networkEmulation")
+	if !config.NetworkEmulationEnabled() {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "NetworkEmulation feature gate is not enabled",
+			Field:   networkEmulationField.String(),
+		}}
+	}
+	if iface.Bridge == nil && iface.Masquerade == nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("network emulation of interface %s is supported only with the bridge and masquerade bindings", iface.Name),
+			Field:   networkEmulationField.String(),
+		}}
+	}
+
+	var causes []metav1.StatusCause
+	emulation := iface.NetworkEmulation
+	causes = append(causes, validateNetworkEmulationDelay(networkEmulationField.Child("delay"), emulation.Delay)...)
+	causes = append(causes, validateNetworkEmulationDelay(networkEmulationField.Child("jitter"), emulation.Jitter)...)
+	if emulation.Jitter != nil && emulation.Delay == nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf("network emulation jitter of interface %s requires a delay", iface.Name),
+			Field:   networkEmulationField.Child("delay").String(),
+		})
+	}
+	if emulation.LossPercentage != "" {
+		loss, err := strconv.ParseFloat(emulation.LossPercentage, 32)
+		if err != nil || loss < 0 || loss > 100 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("loss percentage %q must be a number between 0 and 100", emulation.LossPercentage),
+				Field:   networkEmulationField.Child("lossPercentage").String(),
+			})
+		}
+	}
+	return causes
+}
+
+func validateNetworkEmulationDelay(fieldPath *field.Path, delay *metav1.Duration) []metav1.StatusCause {
+	if delay == nil || (delay.Duration >= 0 && delay.Duration <= maxNetworkEmulationDelay) {
+		return nil
+	}
+	return []metav1.StatusCause{{
+		Type:    metav1.CauseTypeFieldValueInvalid,
+		Message: fmt.Sprintf("%s must be between 0 and %s", delay.Duration, maxNetworkEmulationDelay),
+		Field:   fieldPath.String(),
+	}}
+}
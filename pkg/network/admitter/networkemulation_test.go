@@ -0,0 +1,121 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/admitter"
+)
+
+var _ = Describe("Validating interface network emulation", func() {
+	newSpec := func(bindingMethod v1.InterfaceBindingMethod, emulation *v1.InterfaceNetworkEmulation) *v1.VirtualMachineInstanceSpec {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   "default",
+			InterfaceBindingMethod: bindingMethod,
+			NetworkEmulation:       emulation,
+		}}
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+		return spec
+	}
+
+	masquerade := v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}
+	enabledClusterConfig := stubClusterConfigChecker{networkEmulationFeatureGateEnabled: true}
+
+	It("should reject network emulation when the feature gate is disabled", func() {
+		emulation := &v1.InterfaceNetworkEmulation{LossPercentage: "1"}
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), newSpec(masquerade, emulation), stubClusterConfigChecker{})
+
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "NetworkEmulation feature gate is not enabled",
+			Field:   "fake.domain.devices.interfaces[0].networkEmulation",
+		}))
+	})
+
+	It("should accept network emulation on a masquerade interface", func() {
+		emulation := &v1.InterfaceNetworkEmulation{
+			Delay:          &metav1.Duration{Duration: 100 * time.Millisecond},
+			Jitter:         &metav1.Duration{Duration: 10 * time.Millisecond},
+			LossPercentage: "0.5",
+		}
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), newSpec(masquerade, emulation), enabledClusterConfig)
+
+		Expect(validator.Validate()).To(BeEmpty())
+	})
+
+	It("should reject network emulation on a passt interface", func() {
+		spec := newSpec(v1.InterfaceBindingMethod{PasstBinding: &v1.InterfacePasstBinding{}}, &v1.InterfaceNetworkEmulation{LossPercentage: "1"})
+		clusterConfig := stubClusterConfigChecker{networkEmulationFeatureGateEnabled: true, passtBindingFeatureGateEnabled: true}
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, clusterConfig)
+
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "network emulation of interface default is supported only with the bridge and masquerade bindings",
+			Field:   "fake.domain.devices.interfaces[0].networkEmulation",
+		}))
+	})
+
+	It("should reject a jitter without a delay", func() {
+		emulation := &v1.InterfaceNetworkEmulation{Jitter: &metav1.Duration{Duration: time.Millisecond}}
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), newSpec(masquerade, emulation), enabledClusterConfig)
+
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueRequired",
+			Message: "network emulation jitter of interface default requires a delay",
+			Field:   "fake.domain.devices.interfaces[0].networkEmulation.delay",
+		}))
+	})
+
+	It("should reject a negative delay", func() {
+		emulation := &v1.InterfaceNetworkEmulation{Delay: &metav1.Duration{Duration: -time.Second}}
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), newSpec(masquerade, emulation), enabledClusterConfig)
+
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "-1s must be between 0 and 1h11m34.967295s",
+			Field:   "fake.domain.devices.interfaces[0].networkEmulation.delay",
+		}))
+	})
+
+	DescribeTable("should reject an invalid loss percentage", func(loss string) {
+		emulation := &v1.InterfaceNetworkEmulation{LossPercentage: loss}
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), newSpec(masquerade, emulation), enabledClusterConfig)
+
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "loss percentage \"" + loss + "\" must be a number between 0 and 100",
+			Field:   "fake.domain.devices.interfaces[0].networkEmulation.lossPercentage",
+		}))
+	},
+		Entry("not a number", "ten"),
+		Entry("negative", "-1"),
+		Entry("above 100", "100.5"),
+	)
+})
@@ -0,0 +1,90 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/admitter"
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Validating interface offloads", func() {
+	newSpec := func(model string, bindingMethod v1.InterfaceBindingMethod) *v1.VirtualMachineInstanceSpec {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   "default",
+			Model:                  model,
+			InterfaceBindingMethod: bindingMethod,
+			Offloads:               &v1.InterfaceOffloads{TSO: pointer.P(false), Checksum: pointer.P(false)},
+		}}
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+		return spec
+	}
+
+	masquerade := v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}
+	enabledOffloads := stubClusterConfigChecker{interfaceOffloadsFeatureGateEnabled: true, passtBindingFeatureGateEnabled: true}
+
+	It("should reject offloads when the feature gate is disabled", func() {
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), newSpec("", masquerade), stubClusterConfigChecker{})
+
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "InterfaceOffloads feature gate is not enabled",
+			Field:   "fake.domain.devices.interfaces[0].offloads",
+		}))
+	})
+
+	DescribeTable("should accept offloads", func(model string) {
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), newSpec(model, masquerade), enabledOffloads)
+
+		Expect(validator.Validate()).To(BeEmpty())
+	},
+		Entry("with the implicit virtio model", ""),
+		Entry("with the virtio model", v1.VirtIO),
+	)
+
+	It("should reject offloads on a non virtio interface", func() {
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), newSpec("e1000", masquerade), enabledOffloads)
+
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "offloads of interface default are supported only with the virtio model",
+			Field:   "fake.domain.devices.interfaces[0].offloads",
+		}))
+	})
+
+	It("should reject offloads on a passt interface", func() {
+		spec := newSpec("", v1.InterfaceBindingMethod{PasstBinding: &v1.InterfacePasstBinding{}})
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, enabledOffloads)
+
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "offloads of interface default are not supported with the SR-IOV or passt bindings",
+			Field:   "fake.domain.devices.interfaces[0].offloads",
+		}))
+	})
+})
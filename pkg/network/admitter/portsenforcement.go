@@ -0,0 +1,61 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+func validatePortsEnforcement(
+	fieldPath *field.Path, idx int, iface v1.Interface, config clusterConfigChecker,
+) []metav1.StatusCause {
+	if iface.PortsEnforcement == "" {
+		return nil
+	}
+
+	portsEnforcementField := fieldPath.Child("domain", "devices", "interfaces").Index(idx).Child("portsEnforcement")
+	if !config.MasqueradePortsEnforcementEnabled() {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "MasqueradePortsEnforcement feature gate is not enabled",
+			Field:   portsEnforcementField.String(),
+		}}
+	}
+	if iface.PortsEnforcement != v1.PortsEnforcementStrict {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("ports enforcement %q is not supported, the supported value is %q", iface.PortsEnforcement, v1.PortsEnforcementStrict),
+			Field:   portsEnforcementField.String(),
+		}}
+	}
+	if iface.Masquerade == nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("ports enforcement of interface %s is supported only with the masquerade binding", iface.Name),
+			Field:   portsEnforcementField.String(),
+		}}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/admitter"
+)
+
+var _ = Describe("Validating interface ports enforcement", func() {
+	newSpec := func(bindingMethod v1.InterfaceBindingMethod, enforcement v1.PortsEnforcement) *v1.VirtualMachineInstanceSpec {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   "default",
+			InterfaceBindingMethod: bindingMethod,
+			Ports:                  []v1.Port{{Port: 80}},
+			PortsEnforcement:       enforcement,
+		}}
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+		return spec
+	}
+
+	masquerade := v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}
+
+	It("should reject ports enforcement when the feature gate is disabled", func() {
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), newSpec(masquerade, v1.PortsEnforcementStrict), stubClusterConfigChecker{})
+
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "MasqueradePortsEnforcement feature gate is not enabled",
+			Field:   "fake.domain.devices.interfaces[0].portsEnforcement",
+		}))
+	})
+
+	It("should accept strict ports enforcement on a masquerade interface", func() {
+		clusterConfig := stubClusterConfigChecker{portsEnforcementFeatureGateEnabled: true}
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), newSpec(masquerade, v1.PortsEnforcementStrict), clusterConfig)
+
+		Expect(validator.Validate()).To(BeEmpty())
+	})
+
+	It("should reject an unknown ports enforcement", func() {
+		clusterConfig := stubClusterConfigChecker{portsEnforcementFeatureGateEnabled: true}
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), newSpec(masquerade, "Loose"), clusterConfig)
+
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueNotSupported",
+			Message: `ports enforcement "Loose" is not supported, the supported value is "Strict"`,
+			Field:   "fake.domain.devices.interfaces[0].portsEnforcement",
+		}))
+	})
+
+	It("should reject ports enforcement on a non masquerade interface", func() {
+		spec := newSpec(v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}, v1.PortsEnforcementStrict)
+		clusterConfig := stubClusterConfigChecker{portsEnforcementFeatureGateEnabled: true, bridgeBindingOnPodNetEnabled: true}
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, clusterConfig)
+
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "ports enforcement of interface default is supported only with the masquerade binding",
+			Field:   "fake.domain.devices.interfaces[0].portsEnforcement",
+		}))
+	})
+})
@@ -0,0 +1,80 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+func validatePromiscuous(fieldPath *field.Path, idx int, iface v1.Interface) []metav1.StatusCause {
+	if !iface.Promiscuous || iface.Bridge != nil {
+		return nil
+	}
+	return []metav1.StatusCause{{
+		Type:    metav1.CauseTypeFieldValueInvalid,
+		Message: fmt.Sprintf("promiscuous interface %s is supported only with the bridge binding", iface.Name),
+		Field:   fieldPath.Child("domain", "devices", "interfaces").Index(idx).Child("promiscuous").String(),
+	}}
+}
+
+// ValidatePromiscuousInterfacesPolicy rejects the promiscuous interfaces of a VMI whose namespace,
+// given by its labels, is not selected by the cluster policy.
+// When no policy is set, promiscuous interfaces are not allowed in any namespace.
+func ValidatePromiscuousInterfacesPolicy(
+	fieldPath *field.Path, spec *v1.VirtualMachineInstanceSpec, namespaceLabels map[string]string, policy *metav1.LabelSelector,
+) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if !iface.Promiscuous {
+			continue
+		}
+		if allowed, err := promiscuousInterfacesAllowed(namespaceLabels, policy); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("promiscuous interface %s cannot be admitted: %v", iface.Name, err),
+				Field:   fieldPath.Child("domain", "devices", "interfaces").Index(idx).Child("promiscuous").String(),
+			})
+		} else if !allowed {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("promiscuous interface %s is not allowed in this namespace", iface.Name),
+				Field:   fieldPath.Child("domain", "devices", "interfaces").Index(idx).Child("promiscuous").String(),
+			})
+		}
+	}
+	return causes
+}
+
+func promiscuousInterfacesAllowed(namespaceLabels map[string]string, policy *metav1.LabelSelector) (bool, error) {
+	if policy == nil {
+		return false, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(policy)
+	if err != nil {
+		return false, fmt.Errorf("invalid promiscuous interfaces namespace label selector: %v", err)
+	}
+	return selector.Matches(labels.Set(namespaceLabels)), nil
+}
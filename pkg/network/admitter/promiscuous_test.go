@@ -0,0 +1,106 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/admitter"
+)
+
+var _ = Describe("Validating promiscuous interfaces", func() {
+	newSpec := func(bindingMethod v1.InterfaceBindingMethod, promiscuous bool) *v1.VirtualMachineInstanceSpec {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   "default",
+			InterfaceBindingMethod: bindingMethod,
+			Promiscuous:            promiscuous,
+		}}
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+		return spec
+	}
+
+	bridge := v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}
+	clusterConfig := stubClusterConfigChecker{bridgeBindingOnPodNetEnabled: true}
+
+	It("should accept a promiscuous bridge interface", func() {
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), newSpec(bridge, true), clusterConfig)
+
+		Expect(validator.Validate()).To(BeEmpty())
+	})
+
+	It("should reject a promiscuous masquerade interface", func() {
+		spec := newSpec(v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}, true)
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, clusterConfig)
+
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "promiscuous interface default is supported only with the bridge binding",
+			Field:   "fake.domain.devices.interfaces[0].promiscuous",
+		}))
+	})
+
+	Context("namespace policy", func() {
+		allowedLabels := map[string]string{"tenant": "approved"}
+		policy := &metav1.LabelSelector{MatchLabels: allowedLabels}
+
+		It("should accept a promiscuous interface in a selected namespace", func() {
+			Expect(admitter.ValidatePromiscuousInterfacesPolicy(
+				k8sfield.NewPath("fake"), newSpec(bridge, true), allowedLabels, policy,
+			)).To(BeEmpty())
+		})
+
+		It("should accept non promiscuous interfaces when no policy is set", func() {
+			Expect(admitter.ValidatePromiscuousInterfacesPolicy(
+				k8sfield.NewPath("fake"), newSpec(bridge, false), nil, nil,
+			)).To(BeEmpty())
+		})
+
+		DescribeTable("should reject a promiscuous interface", func(namespaceLabels map[string]string, policy *metav1.LabelSelector) {
+			Expect(admitter.ValidatePromiscuousInterfacesPolicy(
+				k8sfield.NewPath("fake"), newSpec(bridge, true), namespaceLabels, policy,
+			)).To(ConsistOf(metav1.StatusCause{
+				Type:    "FieldValueInvalid",
+				Message: "promiscuous interface default is not allowed in this namespace",
+				Field:   "fake.domain.devices.interfaces[0].promiscuous",
+			}))
+		},
+			Entry("when no policy is set", allowedLabels, nil),
+			Entry("in a namespace not selected by the policy", map[string]string{"tenant": "other"}, policy),
+			Entry("in a namespace without labels", nil, policy),
+		)
+
+		It("should reject a promiscuous interface when the policy is invalid", func() {
+			invalidPolicy := &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{
+				Key: "tenant", Operator: "Bogus",
+			}}}
+
+			causes := admitter.ValidatePromiscuousInterfacesPolicy(k8sfield.NewPath("fake"), newSpec(bridge, true), allowedLabels, invalidPolicy)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Message).To(HavePrefix("promiscuous interface default cannot be admitted: invalid promiscuous interfaces namespace label selector"))
+		})
+	})
+})
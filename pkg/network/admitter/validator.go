@@ -23,11 +23,22 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
 )
 
 type clusterConfigChecker interface {
 	IsBridgeInterfaceOnPodNetworkEnabled() bool
 	PasstBindingEnabled() bool
+	NativeMultiNetworkEnabled() bool
+	InterfaceFirewallEnabled() bool
+	InterfaceOffloadsEnabled() bool
+	InterfaceInterruptsEnabled() bool
+	IPv6RouterAdvertisementEnabled() bool
+	MasqueradePortsEnforcementEnabled() bool
+	NetworkEmulationEnabled() bool
+	InterfaceMirroringEnabled() bool
+	MetadataServiceEnabled() bool
 }
 
 type Validator struct {
@@ -50,6 +61,7 @@ func (v Validator) Validate() []metav1.StatusCause {
 	causes = append(causes, validateSinglePodNetwork(v.field, v.vmiSpec)...)
 	causes = append(causes, validateSingleNetworkSource(v.field, v.vmiSpec)...)
 	causes = append(causes, validateMultusNetworkSource(v.field, v.vmiSpec)...)
+	causes = append(causes, validateNativeNetworkSource(v.field, v.vmiSpec, v.configChecker)...)
 	causes = append(causes, validateInterfaceStateValue(v.field, v.vmiSpec)...)
 	causes = append(causes, validateInterfaceBinding(v.field, v.vmiSpec, v.configChecker)...)
 	causes = append(causes, validateNetworkNameUnique(v.field, v.vmiSpec)...)
@@ -57,6 +69,12 @@ func (v Validator) Validate() []metav1.StatusCause {
 	causes = append(causes, validateInterfaceNameUnique(v.field, v.vmiSpec)...)
 	causes = append(causes, validateInterfacesAssignedToNetworks(v.field, v.vmiSpec)...)
 	causes = append(causes, validateInterfacesFields(v.field, v.vmiSpec)...)
+	causes = append(causes, validateGuestDNS(v.field, v.vmiSpec)...)
+	causes = append(causes, validateMetadataService(v.field, v.vmiSpec, v.configChecker)...)
+	causes = append(causes, vmispec.ValidateNetworkQueuesPolicy(
+		v.field.Child("domain", "devices", "networkInterfaceMultiqueuePolicy"),
+		v.vmiSpec.Domain.Devices.NetworkInterfaceMultiQueuePolicy,
+	)...)
 
 	return causes
 }
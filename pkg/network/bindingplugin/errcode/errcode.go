@@ -0,0 +1,130 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Package errcode classifies the failures of network binding plugin sidecars, so that they are
+// reported alike across plugins. The code is carried in the error message, which is kept across
+// the sidecar gRPC call and the virt-launcher command reply, and is read back with CodeOf.
+// The readiness failures of the sidecars carry their code in a field of the replies instead.
+package errcode
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Code classifies a binding plugin failure
+type Code string
+
+const (
+	// DeviceInfoTimeout the device info of the network was not reported in time
+	DeviceInfoTimeout Code = "DeviceInfoTimeout"
+	// DeviceMissing the device backing the interface is not reported or not found
+	DeviceMissing Code = "DeviceMissing"
+	// UnsupportedModel the interface model, or its address, is not supported by the plugin
+	UnsupportedModel Code = "UnsupportedModel"
+	// SchemaMismatch the domain or the network-info could not be decoded by the plugin
+	SchemaMismatch Code = "SchemaMismatch"
+)
+
+var codes = []Code{DeviceInfoTimeout, DeviceMissing, UnsupportedModel, SchemaMismatch}
+
+const messageMarker = "binding plugin error "
+
+// Error is a binding plugin failure classified by its code
+type Error struct {
+	Code Code
+	Err  error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s%s: %v", messageMarker, e.Code, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// ErrorCode returns the code of the error, as reported in the result of the CheckReadiness hook point
+func (e *Error) ErrorCode() string {
+	return string(e.Code)
+}
+
+// New classifies the error with the code
+func New(code Code, err error) error {
+	return &Error{Code: code, Err: err}
+}
+
+// Errorf formats an error classified with the code
+func Errorf(code Code, format string, a ...any) error {
+	return New(code, fmt.Errorf(format, a...))
+}
+
+// CodeOf returns the code of the error. Errors which crossed a process boundary are no longer typed,
+// their code is read from their message.
+func CodeOf(err error) (Code, bool) {
+	if err == nil {
+		return "", false
+	}
+	var codeErr *Error
+	if errors.As(err, &codeErr) {
+		return codeErr.Code, true
+	}
+	var notReadyErr *NotReadyError
+	if errors.As(err, &notReadyErr) && notReadyErr.Code != "" {
+		return notReadyErr.Code, true
+	}
+	return codeOfMessage(err.Error())
+}
+
+// NotReadyError is the fatal failure a sidecar reported while virt-launcher waited for it to complete the
+// configuration of the VMI, before starting its domain. The VMI fails instead of being started.
+// The code is empty when the sidecar did not classify its failure.
+type NotReadyError struct {
+	Sidecar string
+	Code    Code
+	Err     error
+}
+
+func (e *NotReadyError) Error() string {
+	return fmt.Sprintf("binding plugin failed its readiness check in sidecar %s: %v", e.Sidecar, e.Err)
+}
+
+func (e *NotReadyError) Unwrap() error {
+	return e.Err
+}
+
+// NotReady reports the fatal failure of the readiness check of the sidecar, classified by the code if not empty
+func NotReady(sidecar string, code Code, err error) error {
+	return &NotReadyError{Sidecar: sidecar, Code: code, Err: err}
+}
+
+// EventReason returns the reason of the VMI events reporting the failures with the code
+func EventReason(code Code) string {
+	return "BindingPlugin" + string(code)
+}
+
+func codeOfMessage(message string) (Code, bool) {
+	for _, code := range codes {
+		if strings.Contains(message, messageMarker+string(code)+":") {
+			return code, true
+		}
+	}
+	return "", false
+}
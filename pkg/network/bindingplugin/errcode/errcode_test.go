@@ -0,0 +1,87 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package errcode_test
+
+import (
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/network/bindingplugin/errcode"
+)
+
+var _ = Describe("Binding plugin error codes", func() {
+	It("should return the code of a wrapped error", func() {
+		err := fmt.Errorf("failed to generate domain interface %q: %w", "blue",
+			errcode.Errorf(errcode.DeviceMissing, "vdpa device of network %q is not reported", "blue"))
+
+		code, ok := errcode.CodeOf(err)
+		Expect(ok).To(BeTrue())
+		Expect(code).To(Equal(errcode.DeviceMissing))
+	})
+
+	It("should return the code of an error which lost its type", func() {
+		sidecarErr := errcode.New(errcode.DeviceInfoTimeout, errors.New("context deadline exceeded"))
+		err := fmt.Errorf("server error. command SyncVMI failed: %q", sidecarErr.Error())
+
+		code, ok := errcode.CodeOf(err)
+		Expect(ok).To(BeTrue())
+		Expect(code).To(Equal(errcode.DeviceInfoTimeout))
+	})
+
+	It("should not return a code for an unclassified error", func() {
+		_, ok := errcode.CodeOf(errors.New("failed to dial hook socket"))
+		Expect(ok).To(BeFalse())
+
+		_, ok = errcode.CodeOf(nil)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should keep the wrapped error", func() {
+		err := errcode.New(errcode.SchemaMismatch, errors.ErrUnsupported)
+		Expect(err).To(MatchError(errors.ErrUnsupported))
+		Expect(err).To(MatchError("binding plugin error SchemaMismatch: unsupported operation"))
+	})
+
+	It("should report the code a readiness failure is classified with", func() {
+		err := errcode.NotReady("hook-sidecar-0", errcode.DeviceMissing, errors.New(`vdpa device "/dev/vhost-vdpa-0" not found`))
+		Expect(err).To(MatchError(`binding plugin failed its readiness check in sidecar hook-sidecar-0: vdpa device "/dev/vhost-vdpa-0" not found`))
+		code, ok := errcode.CodeOf(fmt.Errorf("failed to sync: %w", err))
+		Expect(ok).To(BeTrue())
+		Expect(code).To(Equal(errcode.DeviceMissing))
+	})
+
+	It("should not report a code for an unclassified readiness failure", func() {
+		_, ok := errcode.CodeOf(errcode.NotReady("hook-sidecar-0", "", errors.ErrUnsupported))
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should report the code of the error for the CheckReadiness hook point", func() {
+		var codeErr interface{ ErrorCode() string }
+		Expect(errors.As(fmt.Errorf("wrapped: %w", errcode.New(errcode.SchemaMismatch, errors.ErrUnsupported)), &codeErr)).To(BeTrue())
+		Expect(codeErr.ErrorCode()).To(Equal("SchemaMismatch"))
+	})
+
+	It("should map the code to the event reason", func() {
+		Expect(errcode.EventReason(errcode.UnsupportedModel)).To(Equal("BindingPluginUnsupportedModel"))
+	})
+})
@@ -0,0 +1,64 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package sdk
+
+import (
+	"runtime"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/bindingplugin/errcode"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/virtio"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device"
+)
+
+const s390x = "s390x"
+
+// Architecture returns the architecture of the VMI. It falls back to the architecture the sidecar
+// runs on, which is the one of the node, when the VMI spec does not set it.
+func Architecture(vmi *v1.VirtualMachineInstance) string {
+	if vmi.Spec.Architecture != "" {
+		return vmi.Spec.Architecture
+	}
+	return runtime.GOARCH
+}
+
+// InterfaceModel returns the domain interface model of the VMI interface. The virtio model is the one
+// valid for the architecture of the VMI: the PCI virtio models on amd64 and arm64, and the generic
+// virtio model on s390x, where the device is placed on the CCW bus.
+func InterfaceModel(vmi *v1.VirtualMachineInstance, iface v1.Interface) string {
+	if iface.Model != "" && iface.Model != v1.VirtIO {
+		return iface.Model
+	}
+	return virtio.InterpretTransitionalModelType(vmi.Spec.Domain.Devices.UseVirtioTransitional, Architecture(vmi))
+}
+
+// InterfaceAddress returns the domain address of the VMI interface, or nil when libvirt should
+// assign it. The PCI address set on the interface is rejected on s390x, which has no PCI bus.
+func InterfaceAddress(vmi *v1.VirtualMachineInstance, iface v1.Interface) (*api.Address, error) {
+	if iface.PciAddress == "" {
+		return nil, nil
+	}
+	if Architecture(vmi) == s390x {
+		return nil, errcode.Errorf(errcode.UnsupportedModel, "interface %q sets a PCI address, which is not supported on %s", iface.Name, s390x)
+	}
+	return device.NewPciAddressField(iface.PciAddress)
+}
@@ -0,0 +1,86 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package sdk
+
+import (
+	"fmt"
+
+	networkv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// Interface is a VMI interface bound to the plugin
+type Interface struct {
+	Spec    v1.Interface
+	Network v1.Network
+	// Model is the domain interface model, valid for the architecture of the VMI
+	Model string
+	// Status is nil until the interface is reported in the VMI status
+	Status *v1.VirtualMachineInstanceNetworkInterface
+	// DeviceInfo is nil when the plugin does not read the device info, or the network reports none
+	DeviceInfo *networkv1.DeviceInfo
+	// Mac is the MAC address the network reports for the pod interface, read along with the device info
+	Mac string
+}
+
+// BoundInterfaces returns the VMI interfaces bound to the plugin, with their network and status
+func BoundInterfaces(vmi *v1.VirtualMachineInstance, pluginName string) ([]Interface, error) {
+	var ifaces []Interface
+	for _, ifaceSpec := range vmi.Spec.Domain.Devices.Interfaces {
+		if ifaceSpec.Binding == nil || ifaceSpec.Binding.Name != pluginName {
+			continue
+		}
+		network := vmispec.LookupNetworkByName(vmi.Spec.Networks, ifaceSpec.Name)
+		if network == nil {
+			return nil, fmt.Errorf("network of interface %q not found", ifaceSpec.Name)
+		}
+		ifaces = append(ifaces, Interface{
+			Spec:    ifaceSpec,
+			Network: *network,
+			Model:   InterfaceModel(vmi, ifaceSpec),
+			Status:  vmispec.LookupInterfaceStatusByName(vmi.Status.Interfaces, ifaceSpec.Name),
+		})
+	}
+	return ifaces, nil
+}
+
+// LookupDomainInterfaceByAlias returns the domain interface with the given user defined alias name
+func LookupDomainInterfaceByAlias(domainSpec *api.DomainSpec, name string) *api.Interface {
+	for i, iface := range domainSpec.Devices.Interfaces {
+		if iface.Alias != nil && iface.Alias.GetName() == name {
+			return &domainSpec.Devices.Interfaces[i]
+		}
+	}
+	return nil
+}
+
+// ReplaceDomainInterface replaces the domain interface with the alias of the given interface,
+// or appends the interface when the domain has none with its alias.
+func ReplaceDomainInterface(domainSpec *api.DomainSpec, iface api.Interface) {
+	if existingIface := LookupDomainInterfaceByAlias(domainSpec, iface.Alias.GetName()); existingIface != nil {
+		*existingIface = iface
+		return
+	}
+	domainSpec.Devices.Interfaces = append(domainSpec.Devices.Interfaces, iface)
+}
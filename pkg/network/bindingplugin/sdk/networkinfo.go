@@ -0,0 +1,76 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"time"
+
+	networkv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"kubevirt.io/kubevirt/pkg/network/bindingplugin/errcode"
+	"kubevirt.io/kubevirt/pkg/network/downwardapi"
+)
+
+const (
+	networkInfoPollInterval = 100 * time.Millisecond
+	networkInfoTimeout      = 10 * time.Second
+)
+
+// ReadNetworkInfo waits for the network-info file to be populated and returns its content.
+// kubelet populates the downward API volume once the network-info annotation is set on the pod,
+// which may happen after the sidecar is called.
+func ReadNetworkInfo(ctx context.Context, path string) (*downwardapi.NetworkInfo, error) {
+	var networkInfoBytes []byte
+	err := wait.PollUntilContextTimeout(ctx, networkInfoPollInterval, networkInfoTimeout, true, func(_ context.Context) (bool, error) {
+		var err error
+		networkInfoBytes, err = os.ReadFile(path)
+		return len(networkInfoBytes) > 0, err
+	})
+	if err != nil {
+		return nil, errcode.Errorf(errcode.DeviceInfoTimeout, "failed to read network-info from %s: %w", path, err)
+	}
+
+	networkInfo := &downwardapi.NetworkInfo{}
+	if err := json.Unmarshal(networkInfoBytes, networkInfo); err != nil {
+		return nil, errcode.Errorf(errcode.SchemaMismatch, "failed to unmarshal network-info: %w", err)
+	}
+	return networkInfo, nil
+}
+
+// LookupDeviceInfo returns the device info of the network, or nil when the network reports none
+func LookupDeviceInfo(networkInfo *downwardapi.NetworkInfo, networkName string) *networkv1.DeviceInfo {
+	if iface := lookupNetworkInfoInterface(networkInfo, networkName); iface != nil {
+		return iface.DeviceInfo
+	}
+	return nil
+}
+
+func lookupNetworkInfoInterface(networkInfo *downwardapi.NetworkInfo, networkName string) *downwardapi.Interface {
+	for i, iface := range networkInfo.Interfaces {
+		if iface.Network == networkName {
+			return &networkInfo.Interfaces[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,217 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Package sdk is the library network binding plugin sidecars are built with. It finds the VMI
+// interfaces bound to the plugin, reads their device info from the network-info downward API
+// volume, and replaces the domain interfaces generated by virt-launcher with the ones generated
+// by the plugin, matching them by alias:
+//
+//	err := sdk.Serve(sdk.Plugin{
+//		Name:           "my-binding",
+//		ReadDeviceInfo: true,
+//		GenerateInterface: func(ctx context.Context, vmi *v1.VirtualMachineInstance, iface sdk.Interface) (*api.Interface, error) {
+//			return &api.Interface{
+//				Type:   "vdpa",
+//				Source: api.InterfaceSource{Device: iface.DeviceInfo.Vdpa.Path},
+//			}, nil
+//		},
+//	})
+//
+// The hook server is served with the hooks SDK, the plugin can be tested with its fake package
+// by serving Plugin.Hooks. Failures are classified with the codes of the errcode package, which
+// the callbacks should use as well, so that virt-launcher and virt-handler report them alike.
+package sdk
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"path/filepath"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/hooks"
+	hooksdk "kubevirt.io/kubevirt/pkg/hooks/sdk"
+	"kubevirt.io/kubevirt/pkg/network/bindingplugin/errcode"
+	"kubevirt.io/kubevirt/pkg/network/domainspec"
+	"kubevirt.io/kubevirt/pkg/network/downwardapi"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// Some domain parameters require the qemu XML namespace, which is dropped when the domain spec is unmarshalled.
+// e.g: https://libvirt.org/drvqemu.html#pass-through-of-arbitrary-qemu-commands
+const libvirtDomainQemuSchema = "http://libvirt.org/schemas/domain/qemu/1.0"
+
+// GenerateInterfaceFunc returns the domain interface of a VMI interface bound to the plugin.
+// The alias of the VMI interface is set on the returned interface when it has none.
+type GenerateInterfaceFunc func(ctx context.Context, vmi *v1.VirtualMachineInstance, iface Interface) (*api.Interface, error)
+
+// MutateDomainFunc mutates the domain spec, after the interfaces of the plugin were set on it
+type MutateDomainFunc func(ctx context.Context, vmi *v1.VirtualMachineInstance, domainSpec *api.DomainSpec) error
+
+// CheckInterfaceFunc returns once the configuration of a VMI interface bound to the plugin completed, before
+// virt-launcher starts the domain. A returned error is fatal: the VMI fails with it instead of being started,
+// e.g. when the vDPA device of the interface does not exist.
+type CheckInterfaceFunc func(ctx context.Context, vmi *v1.VirtualMachineInstance, iface Interface) error
+
+// Plugin is a network binding plugin served by a sidecar
+type Plugin struct {
+	// Name is the name the binding plugin is registered with in the KubeVirt CR
+	Name string
+	// ReadDeviceInfo waits for the network-info downward API volume, and sets the device info of the interfaces
+	ReadDeviceInfo bool
+	// NetworkInfoPath is the path of the network-info file, defaulting to the downward API volume mount
+	NetworkInfoPath string
+
+	GenerateInterface GenerateInterfaceFunc
+	// MutateDomain is optional, for the domain changes the plugin needs beyond its interfaces
+	MutateDomain MutateDomainFunc
+	// ReplugOnMigration unplugs the interfaces of the plugin from the source domain before a migration, and
+	// plugs them into the target domain once it completed, for the devices which cannot be migrated (e.g. vDPA).
+	// The interfaces plugged into the target domain are the ones generated by the plugin on the target.
+	ReplugOnMigration bool
+	// CheckInterface is optional, it reports the plugin ready once it succeeded for all the interfaces of the plugin
+	CheckInterface CheckInterfaceFunc
+}
+
+// Hooks returns the hooks serving the plugin
+func (p Plugin) Hooks() hooksdk.Hooks {
+	hooks := hooksdk.Hooks{
+		Name:           p.Name,
+		OnDefineDomain: p.onDefineDomain,
+	}
+	if p.ReplugOnMigration {
+		hooks.NegotiateMigration = p.negotiateMigration
+	}
+	if p.CheckInterface != nil {
+		hooks.CheckReadiness = p.checkReadiness
+	}
+	return hooks
+}
+
+// SocketPath returns the path of the hook socket of the plugin sidecar
+func (p Plugin) SocketPath() string {
+	return filepath.Join(hooks.HookSocketsSharedDirectory, p.Name+".sock")
+}
+
+// Serve serves the plugin until virt-launcher shuts the sidecar down, or the sidecar is terminated by a signal
+func Serve(plugin Plugin) error {
+	if plugin.Name == "" || plugin.GenerateInterface == nil {
+		return fmt.Errorf("binding plugin requires a name and a GenerateInterface callback")
+	}
+	return hooksdk.Serve(plugin.SocketPath(), plugin.Hooks())
+}
+
+func (p Plugin) onDefineDomain(ctx context.Context, vmi *v1.VirtualMachineInstance, domainXML []byte) ([]byte, error) {
+	ifaces, err := p.boundInterfaces(ctx, vmi)
+	if err != nil {
+		return nil, err
+	}
+	if len(ifaces) == 0 {
+		return domainXML, nil
+	}
+
+	domainSpec := &api.DomainSpec{XmlNS: libvirtDomainQemuSchema}
+	if err := xml.Unmarshal(domainXML, domainSpec); err != nil {
+		return nil, errcode.Errorf(errcode.SchemaMismatch, "failed to unmarshal given domain spec: %w", err)
+	}
+
+	for _, iface := range ifaces {
+		domainIface, err := p.GenerateInterface(ctx, vmi, iface)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate domain interface %q: %w", iface.Spec.Name, err)
+		}
+		if domainIface.Alias == nil {
+			domainIface.Alias = api.NewUserDefinedAlias(iface.Spec.Name)
+		}
+		ReplaceDomainInterface(domainSpec, *domainIface)
+	}
+	// The qemu namespace elements generated by virt-launcher are dropped when the domain spec is unmarshalled,
+	// the interrupts of all the interfaces are set again, including the ones generated by the plugin.
+	domainspec.SetInterfaceInterrupts(domainSpec, vmi.Spec.Domain.Devices.Interfaces)
+
+	if p.MutateDomain != nil {
+		if err := p.MutateDomain(ctx, vmi, domainSpec); err != nil {
+			return nil, err
+		}
+	}
+
+	newDomainXML, err := xml.Marshal(domainSpec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal updated domain spec: %v", err)
+	}
+	return newDomainXML, nil
+}
+
+func (p Plugin) negotiateMigration(_ context.Context, vmi *v1.VirtualMachineInstance, _ string) ([]string, error) {
+	ifaces, err := BoundInterfaces(vmi, p.Name)
+	if err != nil {
+		return nil, err
+	}
+	var ifaceNames []string
+	for _, iface := range ifaces {
+		if iface.Spec.State != v1.InterfaceStateAbsent {
+			ifaceNames = append(ifaceNames, iface.Spec.Name)
+		}
+	}
+	return ifaceNames, nil
+}
+
+func (p Plugin) checkReadiness(ctx context.Context, vmi *v1.VirtualMachineInstance) error {
+	ifaces, err := p.boundInterfaces(ctx, vmi)
+	if err != nil {
+		return err
+	}
+	for _, iface := range ifaces {
+		if iface.Spec.State == v1.InterfaceStateAbsent {
+			continue
+		}
+		if err := p.CheckInterface(ctx, vmi, iface); err != nil {
+			return fmt.Errorf("interface %q is not ready: %w", iface.Spec.Name, err)
+		}
+	}
+	return nil
+}
+
+// boundInterfaces returns the interfaces bound to the plugin, with their device info when the plugin reads it
+func (p Plugin) boundInterfaces(ctx context.Context, vmi *v1.VirtualMachineInstance) ([]Interface, error) {
+	ifaces, err := BoundInterfaces(vmi, p.Name)
+	if err != nil || len(ifaces) == 0 || !p.ReadDeviceInfo {
+		return ifaces, err
+	}
+
+	networkInfo, err := ReadNetworkInfo(ctx, p.networkInfoPath())
+	if err != nil {
+		return nil, err
+	}
+	for i := range ifaces {
+		if networkInfoIface := lookupNetworkInfoInterface(networkInfo, ifaces[i].Network.Name); networkInfoIface != nil {
+			ifaces[i].DeviceInfo = networkInfoIface.DeviceInfo
+			ifaces[i].Mac = networkInfoIface.Mac
+		}
+	}
+	return ifaces, nil
+}
+
+func (p Plugin) networkInfoPath() string {
+	if p.NetworkInfoPath != "" {
+		return p.NetworkInfoPath
+	}
+	return filepath.Join(downwardapi.MountPath, downwardapi.NetworkInfoVolumePath)
+}
@@ -0,0 +1,374 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package sdk_test
+
+import (
+	"context"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	networkv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
+	hooksdk "kubevirt.io/kubevirt/pkg/hooks/sdk"
+	"kubevirt.io/kubevirt/pkg/hooks/sdk/fake"
+	"kubevirt.io/kubevirt/pkg/network/bindingplugin/errcode"
+	"kubevirt.io/kubevirt/pkg/network/bindingplugin/sdk"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const (
+	pluginName  = "my-binding"
+	networkInfo = `{"interfaces":[{"network":"blue","deviceInfo":{"type":"vdpa","vdpa":{"path":"/dev/vhost-vdpa-0"}},"mac":"02:00:00:00:00:01"}]}`
+)
+
+var _ = Describe("Network binding plugin SDK", func() {
+	var vmi *v1.VirtualMachineInstance
+
+	BeforeEach(func() {
+		vmi = &v1.VirtualMachineInstance{
+			Spec: v1.VirtualMachineInstanceSpec{
+				Domain: v1.DomainSpec{Devices: v1.Devices{Interfaces: []v1.Interface{
+					{Name: "default", InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}},
+					{Name: "blue", Binding: &v1.PluginBinding{Name: pluginName}},
+				}}},
+				Networks: []v1.Network{
+					*v1.DefaultPodNetwork(),
+					{Name: "blue", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "blue-net"}}},
+				},
+			},
+			Status: v1.VirtualMachineInstanceStatus{Interfaces: []v1.VirtualMachineInstanceNetworkInterface{
+				{Name: "blue", PodInterfaceName: "pod16477688c0e"},
+			}},
+		}
+	})
+
+	newClient := func(plugin sdk.Plugin) *hooksdk.Client {
+		server, err := fake.NewServer(plugin.Hooks())
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(server.Stop)
+		client, err := server.Client()
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(client.Close)
+		return client
+	}
+
+	Context("interfaces", func() {
+		It("should return the interfaces bound to the plugin", func() {
+			ifaces, err := sdk.BoundInterfaces(vmi, pluginName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ifaces).To(HaveLen(1))
+			Expect(ifaces[0].Spec.Name).To(Equal("blue"))
+			Expect(ifaces[0].Network.Multus.NetworkName).To(Equal("blue-net"))
+			Expect(ifaces[0].Status.PodInterfaceName).To(Equal("pod16477688c0e"))
+		})
+
+		DescribeTable("should select the interface model valid for the architecture", func(arch, model, expectedModel string) {
+			vmi.Spec.Architecture = arch
+			vmi.Spec.Domain.Devices.Interfaces[1].Model = model
+			ifaces, err := sdk.BoundInterfaces(vmi, pluginName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ifaces[0].Model).To(Equal(expectedModel))
+		},
+			Entry("virtio on amd64", "amd64", "", "virtio-non-transitional"),
+			Entry("virtio on arm64", "arm64", v1.VirtIO, "virtio-non-transitional"),
+			Entry("virtio on s390x", "s390x", "", "virtio"),
+			Entry("e1000 on amd64", "amd64", "e1000", "e1000"),
+		)
+
+		It("should reject a PCI address on s390x", func() {
+			vmi.Spec.Domain.Devices.Interfaces[1].PciAddress = "0000:81:01.0"
+
+			vmi.Spec.Architecture = "amd64"
+			address, err := sdk.InterfaceAddress(vmi, vmi.Spec.Domain.Devices.Interfaces[1])
+			Expect(err).ToNot(HaveOccurred())
+			Expect(address.Type).To(Equal("pci"))
+
+			vmi.Spec.Architecture = "s390x"
+			_, err = sdk.InterfaceAddress(vmi, vmi.Spec.Domain.Devices.Interfaces[1])
+			code, _ := errcode.CodeOf(err)
+			Expect(code).To(Equal(errcode.UnsupportedModel))
+		})
+
+		It("should fail when the network of a bound interface is missing", func() {
+			vmi.Spec.Networks = vmi.Spec.Networks[:1]
+			_, err := sdk.BoundInterfaces(vmi, pluginName)
+			Expect(err).To(MatchError(ContainSubstring(`network of interface "blue" not found`)))
+		})
+
+		It("should replace the domain interface with the same alias", func() {
+			domainSpec := &api.DomainSpec{}
+			domainSpec.Devices.Interfaces = []api.Interface{
+				{Alias: api.NewUserDefinedAlias("default"), Type: "ethernet"},
+				{Alias: api.NewUserDefinedAlias("blue"), Type: "ethernet"},
+			}
+			sdk.ReplaceDomainInterface(domainSpec, api.Interface{Alias: api.NewUserDefinedAlias("blue"), Type: "vhostuser"})
+			sdk.ReplaceDomainInterface(domainSpec, api.Interface{Alias: api.NewUserDefinedAlias("red"), Type: "vhostuser"})
+
+			Expect(domainSpec.Devices.Interfaces).To(HaveLen(3))
+			Expect(domainSpec.Devices.Interfaces[0].Type).To(Equal("ethernet"))
+			Expect(domainSpec.Devices.Interfaces[1].Type).To(Equal("vhostuser"))
+			Expect(domainSpec.Devices.Interfaces[2].Alias.GetName()).To(Equal("red"))
+		})
+	})
+
+	Context("network-info", func() {
+		var networkInfoPath string
+
+		BeforeEach(func() {
+			networkInfoPath = filepath.Join(GinkgoT().TempDir(), "network-info")
+		})
+
+		It("should read the device info of the networks", func() {
+			Expect(os.WriteFile(networkInfoPath, []byte(networkInfo), 0o600)).To(Succeed())
+
+			info, err := sdk.ReadNetworkInfo(context.Background(), networkInfoPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sdk.LookupDeviceInfo(info, "blue")).To(Equal(&networkv1.DeviceInfo{
+				Type: "vdpa",
+				Vdpa: &networkv1.VdpaDevice{Path: "/dev/vhost-vdpa-0"},
+			}))
+			Expect(sdk.LookupDeviceInfo(info, "red")).To(BeNil())
+		})
+
+		It("should stop waiting for the network-info once the context is done", func() {
+			Expect(os.WriteFile(networkInfoPath, nil, 0o600)).To(Succeed())
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			_, err := sdk.ReadNetworkInfo(ctx, networkInfoPath)
+			Expect(err).To(MatchError(context.Canceled))
+			code, _ := errcode.CodeOf(err)
+			Expect(code).To(Equal(errcode.DeviceInfoTimeout))
+		})
+	})
+
+	Context("OnDefineDomain", func() {
+		onDefineDomain := func(client *hooksdk.Client, domainSpec *api.DomainSpec) (*api.DomainSpec, error) {
+			domainXML, err := xml.Marshal(domainSpec)
+			Expect(err).ToNot(HaveOccurred())
+			newDomainXML, err := client.OnDefineDomain(context.Background(), vmi, domainXML)
+			if err != nil {
+				return nil, err
+			}
+			newDomainSpec := &api.DomainSpec{}
+			Expect(xml.Unmarshal(newDomainXML, newDomainSpec)).To(Succeed())
+			return newDomainSpec, nil
+		}
+
+		It("should set the interfaces generated with the device info", func() {
+			networkInfoPath := filepath.Join(GinkgoT().TempDir(), "network-info")
+			Expect(os.WriteFile(networkInfoPath, []byte(networkInfo), 0o600)).To(Succeed())
+
+			client := newClient(sdk.Plugin{
+				Name:            pluginName,
+				ReadDeviceInfo:  true,
+				NetworkInfoPath: networkInfoPath,
+				GenerateInterface: func(_ context.Context, _ *v1.VirtualMachineInstance, iface sdk.Interface) (*api.Interface, error) {
+					return &api.Interface{
+						Type:   "vdpa",
+						Source: api.InterfaceSource{Device: iface.DeviceInfo.Vdpa.Path},
+						MAC:    &api.MAC{MAC: iface.Mac},
+					}, nil
+				},
+				MutateDomain: func(_ context.Context, _ *v1.VirtualMachineInstance, domainSpec *api.DomainSpec) error {
+					domainSpec.MemoryBacking = &api.MemoryBacking{Access: &api.MemoryBackingAccess{Mode: "shared"}}
+					return nil
+				},
+			})
+
+			domainSpec := &api.DomainSpec{}
+			domainSpec.Devices.Interfaces = []api.Interface{
+				{Alias: api.NewUserDefinedAlias("default"), Type: "ethernet"},
+				{Alias: api.NewUserDefinedAlias("blue"), Type: "ethernet"},
+			}
+			newDomainSpec, err := onDefineDomain(client, domainSpec)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(newDomainSpec.Devices.Interfaces).To(HaveLen(2))
+			Expect(newDomainSpec.Devices.Interfaces[0].Type).To(Equal("ethernet"))
+			blueIface := newDomainSpec.Devices.Interfaces[1]
+			Expect(blueIface.Alias.GetName()).To(Equal("blue"))
+			Expect(blueIface.Type).To(Equal("vdpa"))
+			Expect(blueIface.Source.Device).To(Equal("/dev/vhost-vdpa-0"))
+			Expect(blueIface.MAC.MAC).To(Equal("02:00:00:00:00:01"))
+			Expect(newDomainSpec.MemoryBacking.Access.Mode).To(Equal("shared"))
+		})
+
+		It("should override the interrupt vectors of the interfaces, including the generated ones", func() {
+			vmi.Spec.Domain.Devices.Interfaces[0].Interrupts = &v1.InterfaceInterrupts{Mode: v1.InterfaceInterruptModeINTx}
+			vmi.Spec.Domain.Devices.Interfaces[1].Interrupts = &v1.InterfaceInterrupts{Vectors: pointer.P(uint32(34))}
+			client := newClient(sdk.Plugin{
+				Name: pluginName,
+				GenerateInterface: func(_ context.Context, _ *v1.VirtualMachineInstance, _ sdk.Interface) (*api.Interface, error) {
+					return &api.Interface{Type: "vdpa", Model: &api.Model{Type: v1.VirtIO}}, nil
+				},
+			})
+
+			domainSpec := &api.DomainSpec{}
+			domainSpec.Devices.Interfaces = []api.Interface{
+				{Alias: api.NewUserDefinedAlias("default"), Type: "ethernet"},
+				{Alias: api.NewUserDefinedAlias("blue"), Type: "ethernet"},
+			}
+			domainXML, err := xml.Marshal(domainSpec)
+			Expect(err).ToNot(HaveOccurred())
+			newDomainXML, err := client.OnDefineDomain(context.Background(), vmi, domainXML)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(string(newDomainXML)).To(ContainSubstring(`<qemu:override>` +
+				`<qemu:device alias="ua-default"><qemu:frontend>` +
+				`<qemu:property name="vectors" type="unsigned" value="0"></qemu:property>` +
+				`</qemu:frontend></qemu:device>` +
+				`<qemu:device alias="ua-blue"><qemu:frontend>` +
+				`<qemu:property name="vectors" type="unsigned" value="34"></qemu:property>` +
+				`</qemu:frontend></qemu:device>` +
+				`</qemu:override>`))
+		})
+
+		It("should report the code of the plugin failure to the client", func() {
+			client := newClient(sdk.Plugin{
+				Name: pluginName,
+				GenerateInterface: func(_ context.Context, _ *v1.VirtualMachineInstance, iface sdk.Interface) (*api.Interface, error) {
+					return nil, errcode.Errorf(errcode.DeviceMissing, "no device for network %q", iface.Network.Name)
+				},
+			})
+
+			_, err := onDefineDomain(client, &api.DomainSpec{})
+			Expect(err).To(MatchError(ContainSubstring(`no device for network "blue"`)))
+			code, _ := errcode.CodeOf(err)
+			Expect(code).To(Equal(errcode.DeviceMissing))
+		})
+
+		It("should keep the domain when no interface is bound to the plugin", func() {
+			client := newClient(sdk.Plugin{
+				Name: "other-binding",
+				GenerateInterface: func(_ context.Context, _ *v1.VirtualMachineInstance, _ sdk.Interface) (*api.Interface, error) {
+					Fail("no interface should be generated")
+					return nil, nil
+				},
+			})
+
+			domainSpec := &api.DomainSpec{}
+			domainSpec.Devices.Interfaces = []api.Interface{{Alias: api.NewUserDefinedAlias("blue"), Type: "ethernet"}}
+			newDomainSpec, err := onDefineDomain(client, domainSpec)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(newDomainSpec.Devices.Interfaces).To(HaveLen(1))
+			Expect(newDomainSpec.Devices.Interfaces[0].Type).To(Equal("ethernet"))
+		})
+	})
+
+	Context("NegotiateMigration", func() {
+		DescribeTable("should ask to replug the interfaces of the plugin", func(role string) {
+			vmi.Spec.Domain.Devices.Interfaces = append(vmi.Spec.Domain.Devices.Interfaces,
+				v1.Interface{Name: "red", Binding: &v1.PluginBinding{Name: pluginName}, State: v1.InterfaceStateAbsent},
+			)
+			vmi.Spec.Networks = append(vmi.Spec.Networks,
+				v1.Network{Name: "red", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "red-net"}}},
+			)
+			client := newClient(sdk.Plugin{Name: pluginName, ReplugOnMigration: true})
+
+			Expect(client.NegotiateMigration(context.Background(), vmi, role)).To(ConsistOf("blue"))
+		},
+			Entry("on the source", hooksdk.MigrationRoleSource),
+			Entry("on the target", hooksdk.MigrationRoleTarget),
+		)
+
+		It("should not subscribe to the migration negotiation when the plugin migrates its interfaces", func() {
+			client := newClient(sdk.Plugin{Name: pluginName})
+
+			info, err := client.Info(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.GetHookPoints()).ToNot(ContainElement(HaveField("Name", hooksInfo.NegotiateMigrationHookPointName)))
+		})
+	})
+
+	Context("CheckReadiness", func() {
+		var networkInfoPath string
+
+		BeforeEach(func() {
+			networkInfoPath = filepath.Join(GinkgoT().TempDir(), "network-info")
+			Expect(os.WriteFile(networkInfoPath, []byte(networkInfo), 0o600)).To(Succeed())
+		})
+
+		checkDevice := func(devicePath string) sdk.CheckInterfaceFunc {
+			return func(_ context.Context, _ *v1.VirtualMachineInstance, iface sdk.Interface) error {
+				if iface.DeviceInfo.Vdpa.Path != devicePath {
+					return errcode.Errorf(errcode.DeviceMissing, "vdpa device %q not found", iface.DeviceInfo.Vdpa.Path)
+				}
+				return nil
+			}
+		}
+
+		It("should report ready once the interfaces of the plugin are", func() {
+			client := newClient(sdk.Plugin{
+				Name:            pluginName,
+				ReadDeviceInfo:  true,
+				NetworkInfoPath: networkInfoPath,
+				CheckInterface:  checkDevice("/dev/vhost-vdpa-0"),
+			})
+
+			info, err := client.Info(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.GetHookPoints()).To(ContainElement(HaveField("Name", hooksInfo.CheckReadinessHookPointName)))
+			Expect(client.CheckReadiness(context.Background(), vmi)).To(Succeed())
+		})
+
+		It("should report the fatal error of an interface which is not ready", func() {
+			client := newClient(sdk.Plugin{
+				Name:            pluginName,
+				ReadDeviceInfo:  true,
+				NetworkInfoPath: networkInfoPath,
+				CheckInterface:  checkDevice("/dev/vhost-vdpa-1"),
+			})
+
+			err := client.CheckReadiness(context.Background(), vmi)
+			Expect(err).To(MatchError(ContainSubstring(`interface "blue" is not ready`)))
+			code, _ := errcode.CodeOf(err)
+			Expect(code).To(Equal(errcode.DeviceMissing))
+		})
+
+		It("should not check the absent interfaces", func() {
+			vmi.Spec.Domain.Devices.Interfaces[1].State = v1.InterfaceStateAbsent
+			client := newClient(sdk.Plugin{
+				Name:            pluginName,
+				ReadDeviceInfo:  true,
+				NetworkInfoPath: networkInfoPath,
+				CheckInterface:  checkDevice("/dev/vhost-vdpa-1"),
+			})
+
+			Expect(client.CheckReadiness(context.Background(), vmi)).To(Succeed())
+		})
+
+		It("should not subscribe to the readiness check without an interface check", func() {
+			client := newClient(sdk.Plugin{Name: pluginName})
+
+			info, err := client.Info(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.GetHookPoints()).ToNot(ContainElement(HaveField("Name", hooksInfo.CheckReadinessHookPointName)))
+		})
+	})
+})
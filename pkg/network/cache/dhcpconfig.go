@@ -99,6 +99,9 @@ type DHCPConfig struct {
 	IPAMDisabled        bool
 	Gateway             net.IP
 	Subdomain           string
+	DNSNameservers      []string
+	DNSSearchDomains    []string
+	RouterAdvertisement bool
 }
 
 func (d DHCPConfig) String() string {
@@ -0,0 +1,112 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package controllers
+
+import (
+	"slices"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+// FailedInterfaceHotplugsAnnotation lists, comma separated, the VMI interfaces whose hotplug failed and was rolled back.
+// A listed interface is not hotplugged again, until it is removed from the VM (or marked absent) and added back.
+const FailedInterfaceHotplugsAnnotation = "network.kubevirt.io/failed-interface-hotplugs"
+
+// MaxInterfaceHotplugAttempts is the number of failed attempts to hot plug an interface into the domain, after which
+// the hotplug is rolled back. virt-handler counts the attempts with an exponential backoff between them.
+const MaxInterfaceHotplugAttempts = 5
+
+// failedInterfaceHotplugs returns the interfaces whose hotplug has failed, the ones already rolled back
+// and the ones virt-handler exhausted the attempts to attach to the domain.
+func failedInterfaceHotplugs(vmi *v1.VirtualMachineInstance) map[string]struct{} {
+	failedIfaces := map[string]struct{}{}
+	if annotation := vmi.Annotations[FailedInterfaceHotplugsAnnotation]; annotation != "" {
+		for _, ifaceName := range strings.Split(annotation, ",") {
+			failedIfaces[ifaceName] = struct{}{}
+		}
+	}
+
+	for _, ifaceStatus := range vmi.Status.Interfaces {
+		if ifaceStatus.HotplugFailedAttempts >= MaxInterfaceHotplugAttempts &&
+			!vmispec.ContainsInfoSource(ifaceStatus.InfoSource, vmispec.InfoSourceDomain) {
+			failedIfaces[ifaceStatus.Name] = struct{}{}
+		}
+	}
+	return failedIfaces
+}
+
+// rollbackFailedHotplugs marks the failed interfaces for hotunplug, which detaches them from the pod and
+// releases their pod devices. Once detached, the interfaces are cleared from the VMI spec.
+func rollbackFailedHotplugs(vmiIfaces []v1.Interface, failedIfaces map[string]struct{}) {
+	for i := range vmiIfaces {
+		if _, failed := failedIfaces[vmiIfaces[i].Name]; failed {
+			vmiIfaces[i].State = v1.InterfaceStateAbsent
+		}
+	}
+}
+
+// failedHotplugsAnnotationValue returns the value of the failed hotplugs annotation, forgetting the interfaces
+// which are no longer requested by the VM, so that adding them back hotplugs them again.
+func failedHotplugsAnnotationValue(vmIfaces []v1.Interface, failedIfaces map[string]struct{}) string {
+	vmIfacesByName := vmispec.IndexInterfaceSpecByName(vmIfaces)
+	var ifaceNames []string
+	for ifaceName := range failedIfaces {
+		if vmIface, exists := vmIfacesByName[ifaceName]; exists && vmIface.State != v1.InterfaceStateAbsent {
+			ifaceNames = append(ifaceNames, ifaceName)
+		}
+	}
+	slices.Sort(ifaceNames)
+	return strings.Join(ifaceNames, ",")
+}
+
+func setFailedHotplugsAnnotation(vmi *v1.VirtualMachineInstance, value string) {
+	if value == "" {
+		delete(vmi.Annotations, FailedInterfaceHotplugsAnnotation)
+		return
+	}
+	if vmi.Annotations == nil {
+		vmi.Annotations = map[string]string{}
+	}
+	vmi.Annotations[FailedInterfaceHotplugsAnnotation] = value
+}
+
+// failedHotplugsAnnotationPatch returns the patch operations updating the failed hotplugs annotation of the VMI
+func failedHotplugsAnnotationPatch(vmi, newVMI *v1.VirtualMachineInstance) []patch.PatchOption {
+	currentValue, currentExists := vmi.Annotations[FailedInterfaceHotplugsAnnotation]
+	newValue, newExists := newVMI.Annotations[FailedInterfaceHotplugsAnnotation]
+	annotationPath := "/metadata/annotations/" + patch.EscapeJSONPointer(FailedInterfaceHotplugsAnnotation)
+
+	switch {
+	case currentExists == newExists && currentValue == newValue:
+		return nil
+	case !newExists:
+		return []patch.PatchOption{patch.WithTest(annotationPath, currentValue), patch.WithRemove(annotationPath)}
+	case vmi.Annotations == nil:
+		return []patch.PatchOption{patch.WithAdd("/metadata/annotations", map[string]string{FailedInterfaceHotplugsAnnotation: newValue})}
+	case currentExists:
+		return []patch.PatchOption{patch.WithTest(annotationPath, currentValue), patch.WithReplace(annotationPath, newValue)}
+	default:
+		return []patch.PatchOption{patch.WithAdd(annotationPath, newValue)}
+	}
+}
@@ -31,12 +31,14 @@ import (
 	"kubevirt.io/client-go/kubevirt"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/network/macallocator"
 	"kubevirt.io/kubevirt/pkg/network/namescheme"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 )
 
 type clusterConfigurer interface {
 	LiveUpdateNADRefEnabled() bool
+	GetMacGenerationPolicy() *v1.MacGenerationPolicy
 }
 
 type VMController struct {
@@ -89,7 +91,14 @@ func (v *VMController) Sync(vm *v1.VirtualMachine, vmi *v1.VirtualMachineInstanc
 
 		updatedVMI := syncVMIInterfaces(vm, vmi, vmiIfaceStatusesByName, v.clusterConfigurer.LiveUpdateNADRefEnabled())
 
-		if err := v.vmiInterfacesPatch(&updatedVMI.Spec, vmi); err != nil {
+		if err := allocateHotpluggedIfacesMACAddresses(v.clusterConfigurer.GetMacGenerationPolicy(), updatedVMI, vmi); err != nil {
+			return vm, &syncError{
+				fmt.Errorf("error encountered when allocating the MAC addresses of hotplugged interfaces: %v", err),
+				hotPlugNetworkInterfaceErrorReason,
+			}
+		}
+
+		if err := v.vmiInterfacesPatch(updatedVMI, vmi); err != nil {
 			return vm, &syncError{
 				fmt.Errorf("error encountered when trying to patch vmi: %v", err),
 				hotPlugNetworkInterfaceErrorReason,
@@ -120,8 +129,15 @@ func syncVMIInterfaces(
 ) *v1.VirtualMachineInstance {
 	vmiCopy := vmi.DeepCopy()
 	hasOrdinalIfaces := namescheme.HasOrdinalSecondaryIfaces(vmi.Spec.Networks, vmi.Status.Interfaces)
-	updatedVmiSpec := applyDynamicIfaceRequestOnVMI(vm, vmiCopy, hasOrdinalIfaces)
+	failedHotplugs := map[string]struct{}{}
+	if !hasOrdinalIfaces {
+		failedHotplugs = failedInterfaceHotplugs(vmi)
+	}
+	updatedVmiSpec := applyDynamicIfaceRequestOnVMI(vm, vmiCopy, hasOrdinalIfaces, failedHotplugs)
 	vmiCopy.Spec = *updatedVmiSpec
+	rollbackFailedHotplugs(vmiCopy.Spec.Domain.Devices.Interfaces, failedHotplugs)
+	setFailedHotplugsAnnotation(vmiCopy, failedHotplugsAnnotationValue(vm.Spec.Template.Spec.Domain.Devices.Interfaces, failedHotplugs))
+	syncEnforcedPorts(vm.Spec.Template.Spec.Domain.Devices.Interfaces, vmiCopy.Spec.Domain.Devices.Interfaces)
 
 	ifaces, networks := clearDetachedIfacesFromVMI(vmiCopy.Spec.Domain.Devices.Interfaces, vmiCopy.Spec.Networks, indexedStatusIfaces)
 	vmiCopy.Spec.Domain.Devices.Interfaces = ifaces
@@ -134,17 +150,40 @@ func syncVMIInterfaces(
 	return vmiCopy
 }
 
-func (v *VMController) vmiInterfacesPatch(newVmiSpec *v1.VirtualMachineInstanceSpec, vmi *v1.VirtualMachineInstance) error {
+// allocateHotpluggedIfacesMACAddresses allocates the MAC addresses of the interfaces hotplugged to the VMI, the same
+// way the MAC addresses of the interfaces are allocated when the VMI is created.
+// Interfaces which were already part of the VMI are left untouched, as the guest already uses their MAC address.
+func allocateHotpluggedIfacesMACAddresses(policy *v1.MacGenerationPolicy, updatedVMI, vmi *v1.VirtualMachineInstance) error {
+	if policy == nil {
+		return nil
+	}
+	allocator, err := macallocator.New(policy)
+	if err != nil {
+		return err
+	}
+	currentIfacesByName := vmispec.IndexInterfaceSpecByName(vmi.Spec.Domain.Devices.Interfaces)
+	return macallocator.AllocateInterfacesMACAddresses(allocator, updatedVMI, func(iface v1.Interface) bool {
+		_, exists := currentIfacesByName[iface.Name]
+		return !exists
+	})
+}
+
+func (v *VMController) vmiInterfacesPatch(newVMI, vmi *v1.VirtualMachineInstance) error {
+	newVmiSpec := &newVMI.Spec
+	failedHotplugsPatch := failedHotplugsAnnotationPatch(vmi, newVMI)
 	if equality.Semantic.DeepEqual(vmi.Spec.Domain.Devices.Interfaces, newVmiSpec.Domain.Devices.Interfaces) &&
-		equality.Semantic.DeepEqual(vmi.Spec.Networks, newVmiSpec.Networks) {
+		equality.Semantic.DeepEqual(vmi.Spec.Networks, newVmiSpec.Networks) &&
+		len(failedHotplugsPatch) == 0 {
 		return nil
 	}
-	patchBytes, err := patch.New(
+	patchSet := patch.New(
 		patch.WithTest("/spec/networks", vmi.Spec.Networks),
 		patch.WithAdd("/spec/networks", newVmiSpec.Networks),
 		patch.WithTest("/spec/domain/devices/interfaces", vmi.Spec.Domain.Devices.Interfaces),
 		patch.WithAdd("/spec/domain/devices/interfaces", newVmiSpec.Domain.Devices.Interfaces),
-	).GeneratePayload()
+	)
+	patchSet.AddOption(failedHotplugsPatch...)
+	patchBytes, err := patchSet.GeneratePayload()
 	if err != nil {
 		return err
 	}
@@ -159,14 +198,16 @@ func applyDynamicIfaceRequestOnVMI(
 	vm *v1.VirtualMachine,
 	vmi *v1.VirtualMachineInstance,
 	hasOrdinalIfaces bool,
+	failedHotplugs map[string]struct{},
 ) *v1.VirtualMachineInstanceSpec {
 	vmiSpecCopy := vmi.Spec.DeepCopy()
 	vmiIndexedInterfaces := vmispec.IndexInterfaceSpecByName(vmiSpecCopy.Domain.Devices.Interfaces)
 	vmIndexedNetworks := vmispec.IndexNetworkSpecByName(vm.Spec.Template.Spec.Networks)
 	for _, vmIface := range vm.Spec.Template.Spec.Domain.Devices.Interfaces {
 		vmiIfaceCopy, existsInVMISpec := vmiIndexedInterfaces[vmIface.Name]
+		_, hasHotplugFailed := failedHotplugs[vmIface.Name]
 
-		shouldHotplugIface := !existsInVMISpec &&
+		shouldHotplugIface := !existsInVMISpec && !hasHotplugFailed &&
 			vmIface.State != v1.InterfaceStateAbsent &&
 			(vmIface.InterfaceBindingMethod.Bridge != nil || vmIface.InterfaceBindingMethod.SRIOV != nil)
 
@@ -189,6 +230,17 @@ func applyDynamicIfaceRequestOnVMI(
 	return vmiSpecCopy
 }
 
+// syncEnforcedPorts updates the ports of the VMI interfaces which keep enforcing them, as these are applied live.
+func syncEnforcedPorts(vmIfaces, vmiIfaces []v1.Interface) {
+	vmIfacesByName := vmispec.IndexInterfaceSpecByName(vmIfaces)
+	for i := range vmiIfaces {
+		vmIface, exists := vmIfacesByName[vmiIfaces[i].Name]
+		if exists && vmispec.HasEnforcedPorts(vmIface) && vmispec.HasEnforcedPorts(vmiIfaces[i]) {
+			vmiIfaces[i].Ports = vmIface.Ports
+		}
+	}
+}
+
 func syncNetworks(vmNets, vmiNets []v1.Network) []v1.Network {
 	vmIndexedNets := vmispec.IndexNetworkSpecByName(vmNets)
 	var updatedVMINets []v1.Network
@@ -37,6 +37,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
 	"kubevirt.io/kubevirt/pkg/network/controllers"
+	"kubevirt.io/kubevirt/pkg/network/macallocator"
 	"kubevirt.io/kubevirt/pkg/network/namescheme"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 )
@@ -95,6 +96,35 @@ var _ = Describe("VM Network Controller", func() {
 		),
 	)
 
+	It("should allocate the MAC address of a hotplugged interface", func() {
+		clientset := fake.NewSimpleClientset()
+		c := controllers.NewVMController(clientset, stubClusterConfigurer{
+			macGenerationPolicy: &v1.MacGenerationPolicy{Allocator: v1.MacAllocatorDeterministic},
+		})
+
+		vmi := libvmi.New(
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+			libvmi.WithNetwork(v1.DefaultPodNetwork()),
+		)
+		vm := libvmi.NewVirtualMachine(vmi.DeepCopy())
+
+		vm = plugNetworkInterface(vm, libvmi.InterfaceDeviceWithBridgeBinding("foonet"))
+
+		_, err := clientset.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Create(context.Background(), vmi, k8smetav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = c.Sync(vm, vmi)
+		Expect(err).NotTo(HaveOccurred())
+
+		updatedVMI, err := clientset.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Get(context.Background(), vmi.Name, k8smetav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		ifaces := updatedVMI.Spec.Domain.Devices.Interfaces
+		Expect(ifaces).To(HaveLen(2))
+		Expect(ifaces[0].MacAddress).To(BeEmpty(), "the existing interface MAC address should be left untouched")
+		Expect(ifaces[1].Name).To(Equal("foonet"))
+		Expect(ifaces[1].MacAddress).To(HavePrefix(macallocator.DefaultOUI))
+	})
+
 	It("sync fails when VMI patch returns an error", func() {
 		clientset := fake.NewSimpleClientset()
 		c := controllers.NewVMController(clientset, stubClusterConfigurer{})
@@ -403,6 +433,37 @@ var _ = Describe("VM Network Controller", func() {
 		Expect(iface.State).NotTo(Equal(v1.InterfaceStateAbsent))
 	})
 
+	DescribeTable("sync updates the ports of an existing interface", func(enforcement v1.PortsEnforcement, expectedPorts []v1.Port) {
+		clientset := fake.NewSimpleClientset()
+		c := controllers.NewVMController(clientset, stubClusterConfigurer{})
+		iface := libvmi.InterfaceDeviceWithMasqueradeBinding(v1.Port{Port: 80})
+		iface.PortsEnforcement = enforcement
+		vmi := libvmi.New(
+			libvmi.WithInterface(iface),
+			libvmi.WithNetwork(v1.DefaultPodNetwork()),
+		)
+
+		vm := libvmi.NewVirtualMachine(vmi.DeepCopy())
+
+		_, err := clientset.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Create(context.Background(), vmi, k8smetav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		vm.Spec.Template.Spec.Domain.Devices.Interfaces[0].Ports = []v1.Port{{Port: 80}, {Port: 8080}}
+
+		_, err = c.Sync(vm, vmi)
+		Expect(err).NotTo(HaveOccurred())
+
+		updatedVMI, err := clientset.KubevirtV1().
+			VirtualMachineInstances(vmi.Namespace).
+			Get(context.Background(), vmi.Name, k8smetav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(updatedVMI.Spec.Domain.Devices.Interfaces[0].Ports).To(Equal(expectedPorts))
+	},
+		Entry("when the ports are enforced", v1.PortsEnforcementStrict, []v1.Port{{Port: 80}, {Port: 8080}}),
+		Entry("unless the ports are not enforced", v1.PortsEnforcement(""), []v1.Port{{Port: 80}}),
+	)
+
 	DescribeTable("sync updates link state of an existing interface", func(fromState, toState v1.InterfaceState) {
 		clientset := fake.NewSimpleClientset()
 		c := controllers.NewVMController(clientset, stubClusterConfigurer{})
@@ -665,6 +726,145 @@ var _ = Describe("VM Network Controller", func() {
 		Expect(updatedVMI.Spec.Domain.Devices.Interfaces).To(Equal(originalVMI.Spec.Domain.Devices.Interfaces))
 	})
 
+	Context("failed interface hotplug", func() {
+		var clientset *fake.Clientset
+
+		BeforeEach(func() {
+			clientset = fake.NewSimpleClientset()
+		})
+
+		syncAndGetVMI := func(vm *v1.VirtualMachine, vmi *v1.VirtualMachineInstance) (*v1.VirtualMachine, *v1.VirtualMachineInstance) {
+			// Simulate the existence of the VMI on the server (to allow the Sync to patch it).
+			_, err := clientset.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Create(context.Background(), vmi, k8smetav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			c := controllers.NewVMController(clientset, stubClusterConfigurer{})
+			updatedVM, err := c.Sync(vm, vmi)
+			Expect(err).NotTo(HaveOccurred())
+
+			updatedVMI, err := clientset.KubevirtV1().
+				VirtualMachineInstances(vmi.Namespace).
+				Get(context.Background(), vmi.Name, k8smetav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			return updatedVM, updatedVMI
+		}
+
+		It("is rolled back by marking the interface for hotunplug", func() {
+			vmi := libvmi.New(
+				libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+				libvmi.WithInterface(libvmi.InterfaceDeviceWithBridgeBinding(secondaryNetName1)),
+				libvmi.WithNetwork(v1.DefaultPodNetwork()),
+				libvmi.WithNetwork(libvmi.MultusNetwork(secondaryNetName1, nadName)),
+				libvmistatus.WithStatus(
+					libvmistatus.New(
+						libvmistatus.WithInterfaceStatus(v1.VirtualMachineInstanceNetworkInterface{
+							Name:             defaultNetName,
+							PodInterfaceName: namescheme.PrimaryPodInterfaceName,
+							InfoSource:       vmispec.InfoSourceDomain,
+						}),
+						libvmistatus.WithInterfaceStatus(v1.VirtualMachineInstanceNetworkInterface{
+							Name:                  secondaryNetName1,
+							PodInterfaceName:      namescheme.GenerateHashedInterfaceName(secondaryNetName1),
+							InfoSource:            vmispec.InfoSourceMultusStatus,
+							HotplugFailedAttempts: controllers.MaxInterfaceHotplugAttempts,
+						}),
+					),
+				),
+			)
+			vm := libvmi.NewVirtualMachine(vmi.DeepCopy())
+			originalVM := vm.DeepCopy()
+
+			updatedVM, updatedVMI := syncAndGetVMI(vm, vmi)
+
+			Expect(updatedVM).To(Equal(originalVM))
+			Expect(updatedVMI.Spec.Domain.Devices.Interfaces[1].State).To(Equal(v1.InterfaceStateAbsent))
+			Expect(updatedVMI.Annotations).To(HaveKeyWithValue(controllers.FailedInterfaceHotplugsAnnotation, secondaryNetName1))
+		})
+
+		It("is not rolled back before the hotplug attempts are exhausted", func() {
+			vmi := libvmi.New(
+				libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+				libvmi.WithInterface(libvmi.InterfaceDeviceWithBridgeBinding(secondaryNetName1)),
+				libvmi.WithNetwork(v1.DefaultPodNetwork()),
+				libvmi.WithNetwork(libvmi.MultusNetwork(secondaryNetName1, nadName)),
+				libvmistatus.WithStatus(
+					libvmistatus.New(
+						libvmistatus.WithInterfaceStatus(v1.VirtualMachineInstanceNetworkInterface{
+							Name:                  secondaryNetName1,
+							PodInterfaceName:      namescheme.GenerateHashedInterfaceName(secondaryNetName1),
+							InfoSource:            vmispec.InfoSourceMultusStatus,
+							HotplugFailedAttempts: controllers.MaxInterfaceHotplugAttempts - 1,
+						}),
+					),
+				),
+			)
+			originalVMI := vmi.DeepCopy()
+			vm := libvmi.NewVirtualMachine(vmi.DeepCopy())
+
+			_, updatedVMI := syncAndGetVMI(vm, vmi)
+
+			Expect(updatedVMI.Spec.Domain.Devices.Interfaces).To(Equal(originalVMI.Spec.Domain.Devices.Interfaces))
+			Expect(updatedVMI.Annotations).NotTo(HaveKey(controllers.FailedInterfaceHotplugsAnnotation))
+		})
+
+		It("is not rolled back when the interface is attached to the domain", func() {
+			vmi := libvmi.New(
+				libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+				libvmi.WithInterface(libvmi.InterfaceDeviceWithBridgeBinding(secondaryNetName1)),
+				libvmi.WithNetwork(v1.DefaultPodNetwork()),
+				libvmi.WithNetwork(libvmi.MultusNetwork(secondaryNetName1, nadName)),
+				libvmistatus.WithStatus(
+					libvmistatus.New(
+						libvmistatus.WithInterfaceStatus(v1.VirtualMachineInstanceNetworkInterface{
+							Name:                  secondaryNetName1,
+							InfoSource:            vmispec.NewInfoSource(vmispec.InfoSourceMultusStatus, vmispec.InfoSourceDomain),
+							HotplugFailedAttempts: controllers.MaxInterfaceHotplugAttempts,
+						}),
+					),
+				),
+			)
+			originalVMI := vmi.DeepCopy()
+			vm := libvmi.NewVirtualMachine(vmi.DeepCopy())
+
+			_, updatedVMI := syncAndGetVMI(vm, vmi)
+
+			Expect(updatedVMI.Spec.Domain.Devices.Interfaces).To(Equal(originalVMI.Spec.Domain.Devices.Interfaces))
+			Expect(updatedVMI.Annotations).NotTo(HaveKey(controllers.FailedInterfaceHotplugsAnnotation))
+		})
+
+		It("is not hotplugged again while the VM requests the interface", func() {
+			vmi := libvmi.New(
+				libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+				libvmi.WithNetwork(v1.DefaultPodNetwork()),
+				libvmi.WithAnnotation(controllers.FailedInterfaceHotplugsAnnotation, secondaryNetName1),
+			)
+			originalVMI := vmi.DeepCopy()
+			vm := libvmi.NewVirtualMachine(vmi.DeepCopy())
+			vm = plugNetworkInterface(vm, libvmi.InterfaceDeviceWithBridgeBinding(secondaryNetName1))
+			originalVM := vm.DeepCopy()
+
+			updatedVM, updatedVMI := syncAndGetVMI(vm, vmi)
+
+			Expect(updatedVM).To(Equal(originalVM))
+			Expect(updatedVMI.Spec.Networks).To(Equal(originalVMI.Spec.Networks))
+			Expect(updatedVMI.Spec.Domain.Devices.Interfaces).To(Equal(originalVMI.Spec.Domain.Devices.Interfaces))
+			Expect(updatedVMI.Annotations).To(HaveKeyWithValue(controllers.FailedInterfaceHotplugsAnnotation, secondaryNetName1))
+		})
+
+		It("is forgotten once the interface is removed from the VM", func() {
+			vmi := libvmi.New(
+				libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+				libvmi.WithNetwork(v1.DefaultPodNetwork()),
+				libvmi.WithAnnotation(controllers.FailedInterfaceHotplugsAnnotation, secondaryNetName1),
+			)
+			vm := libvmi.NewVirtualMachine(vmi.DeepCopy())
+
+			_, updatedVMI := syncAndGetVMI(vm, vmi)
+
+			Expect(updatedVMI.Annotations).NotTo(HaveKey(controllers.FailedInterfaceHotplugsAnnotation))
+		})
+	})
+
 	DescribeTable("sync handles NAD reference updates", func(isFGEnabled bool, expectedNADNameOnVMISpec string) {
 		clientset := fake.NewSimpleClientset()
 		c := controllers.NewVMController(clientset, stubClusterConfigurer{isLiveUpdateNADRefEnabled: isFGEnabled})
@@ -791,8 +991,13 @@ func newEmptyVM() *v1.VirtualMachine {
 
 type stubClusterConfigurer struct {
 	isLiveUpdateNADRefEnabled bool
+	macGenerationPolicy       *v1.MacGenerationPolicy
 }
 
 func (s stubClusterConfigurer) LiveUpdateNADRefEnabled() bool {
 	return s.isLiveUpdateNADRefEnabled
 }
+
+func (s stubClusterConfigurer) GetMacGenerationPolicy() *v1.MacGenerationPolicy {
+	return s.macGenerationPolicy
+}
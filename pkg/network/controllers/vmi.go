@@ -88,7 +88,7 @@ func calculateSecondaryIfaceStatuses(
 
 	networkStatusesByPodIfaceName := multus.NetworkStatusesByPodIfaceName(networkStatuses)
 	podIfaceNamesByNetworkName := namescheme.CreateFromNetworkStatuses(vmi.Spec.Networks, networkStatuses)
-	for _, network := range vmispec.FilterMultusNonDefaultNetworks(vmi.Spec.Networks) {
+	for _, network := range vmispec.FilterSecondaryNetworks(vmi.Spec.Networks) {
 		vmiIfaceStatus := vmispec.LookupInterfaceStatusByName(vmi.Status.Interfaces, network.Name)
 		podIfaceName, wasFound := podIfaceNamesByNetworkName[network.Name]
 		if !wasFound {
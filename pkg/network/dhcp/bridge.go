@@ -39,6 +39,7 @@ type BridgeConfigGenerator struct {
 	vmiSpecIfaces    []v1.Interface
 	vmiSpecIface     *v1.Interface
 	subdomain        string
+	guestDNS         *v1.GuestDNSConfig
 }
 
 func (d *BridgeConfigGenerator) Generate() (*cache.DHCPConfig, error) {
@@ -65,6 +66,7 @@ func (d *BridgeConfigGenerator) Generate() (*cache.DHCPConfig, error) {
 	}
 	dhcpConfig.Mtu = uint16(podNicLink.Attrs().MTU)
 	dhcpConfig.Subdomain = d.subdomain
+	setGuestDNS(dhcpConfig, d.guestDNS)
 
 	return dhcpConfig, nil
 }
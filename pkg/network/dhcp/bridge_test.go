@@ -96,6 +96,29 @@ var _ = Describe("Bridge DHCP configurator", func() {
 			expectedConfig.Subdomain = subdomain
 			Expect(*config).To(Equal(expectedConfig))
 		})
+		It("Should advertise the guest DNS configuration", func() {
+			Expect(cache.WriteDHCPInterfaceCache(
+				&cacheCreator, launcherPID, ifaceName, &cache.DHCPConfig{IPAMDisabled: false},
+			)).To(Succeed())
+
+			iface := v1.Interface{Name: "network"}
+			generator = BridgeConfigGenerator{
+				cacheCreator:     &cacheCreator,
+				podInterfaceName: ifaceName,
+				vmiSpecIfaces:    []v1.Interface{iface},
+				vmiSpecIface:     &iface,
+				handler:          mockHandler,
+				guestDNS:         &v1.GuestDNSConfig{Nameservers: []string{"10.0.0.53"}, Searches: []string{"dc.example.com"}},
+			}
+
+			link := &netlink.GenericLink{LinkAttrs: netlink.LinkAttrs{Name: ifaceName, MTU: 1410}}
+			mockHandler.EXPECT().LinkByName(virtnetlink.GenerateNewBridgedVmiInterfaceName(ifaceName)).Return(link, nil)
+
+			config, err := generator.Generate()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.DNSNameservers).To(Equal([]string{"10.0.0.53"}))
+			Expect(config.DNSSearchDomains).To(Equal([]string{"dc.example.com"}))
+		})
 		It("Should succeed with no ipam", func() {
 			Expect(cache.WriteDHCPInterfaceCache(
 				&cacheCreator, launcherPID, ifaceName, &cache.DHCPConfig{IPAMDisabled: true},
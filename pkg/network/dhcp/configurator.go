@@ -54,7 +54,7 @@ type ConfigGenerator interface {
 }
 
 func NewBridgeConfigurator(cacheCreator cacheCreator, advertisingIfaceName string, handler netdriver.NetworkHandler, podInterfaceName string,
-	vmiSpecIfaces []v1.Interface, vmiSpecIface *v1.Interface, subdomain string) *configurator {
+	vmiSpecIfaces []v1.Interface, vmiSpecIface *v1.Interface, subdomain string, guestDNS *v1.GuestDNSConfig) *configurator {
 	return &configurator{
 		podInterfaceName:     podInterfaceName,
 		advertisingIfaceName: advertisingIfaceName,
@@ -67,17 +67,18 @@ func NewBridgeConfigurator(cacheCreator cacheCreator, advertisingIfaceName strin
 			vmiSpecIfaces:    vmiSpecIfaces,
 			vmiSpecIface:     vmiSpecIface,
 			subdomain:        subdomain,
+			guestDNS:         guestDNS,
 		},
 	}
 }
 
 func NewMasqueradeConfigurator(advertisingIfaceName string, handler netdriver.NetworkHandler, vmiSpecIface *v1.Interface, vmiSpecNetwork *v1.Network, podInterfaceName string,
-	subdomain string) *configurator {
+	subdomain string, guestDNS *v1.GuestDNSConfig) *configurator {
 	return &configurator{
 		podInterfaceName:     podInterfaceName,
 		advertisingIfaceName: advertisingIfaceName,
 		configGenerator: &MasqueradeConfigGenerator{handler: handler, vmiSpecIface: vmiSpecIface, vmiSpecNetwork: vmiSpecNetwork,
-			subdomain: subdomain, podInterfaceName: podInterfaceName},
+			subdomain: subdomain, guestDNS: guestDNS, podInterfaceName: podInterfaceName},
 		handler:              handler,
 		dhcpStartedDirectory: defaultDHCPStartedDirectory,
 	}
@@ -113,3 +114,12 @@ func (d *configurator) getDHCPStartedFilePath(podInterfaceName string) string {
 func (d *configurator) Generate() (*cache.DHCPConfig, error) {
 	return d.configGenerator.Generate()
 }
+
+// setGuestDNS overrides the DNS configuration of the pod advertised to the guest
+func setGuestDNS(dhcpConfig *cache.DHCPConfig, guestDNS *v1.GuestDNSConfig) {
+	if guestDNS == nil {
+		return
+	}
+	dhcpConfig.DNSNameservers = guestDNS.Nameservers
+	dhcpConfig.DNSSearchDomains = guestDNS.Searches
+}
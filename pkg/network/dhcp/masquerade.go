@@ -34,6 +34,7 @@ type MasqueradeConfigGenerator struct {
 	vmiSpecNetwork   *v1.Network
 	podInterfaceName string
 	subdomain        string
+	guestDNS         *v1.GuestDNSConfig
 }
 
 func (d *MasqueradeConfigGenerator) Generate() (*cache.DHCPConfig, error) {
@@ -45,6 +46,7 @@ func (d *MasqueradeConfigGenerator) Generate() (*cache.DHCPConfig, error) {
 
 	dhcpConfig.Name = podNicLink.Attrs().Name
 	dhcpConfig.Subdomain = d.subdomain
+	setGuestDNS(dhcpConfig, d.guestDNS)
 	dhcpConfig.Mtu = uint16(podNicLink.Attrs().MTU)
 
 	ipv4Enabled, err := d.handler.HasIPv4GlobalUnicastAddress(d.podInterfaceName)
@@ -75,6 +77,7 @@ func (d *MasqueradeConfigGenerator) Generate() (*cache.DHCPConfig, error) {
 		}
 		dhcpConfig.IPv6 = *ipv6
 		dhcpConfig.AdvertisingIPv6Addr = ipv6Gateway.IP.To16()
+		dhcpConfig.RouterAdvertisement = d.vmiSpecIface.RouterAdvertisement != nil
 	}
 
 	return dhcpConfig, nil
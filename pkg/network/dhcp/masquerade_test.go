@@ -152,6 +152,15 @@ var _ = Describe("Masquerade DHCP configurator", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(*config).To(Equal(generateExpectedConfigOnlyIPv6Enabled(vmiSpecNetwork, nil, mtu, ifaceName, subdomain)))
 			})
+			It("Should enable the router advertisement when the interface asks for it", func() {
+				vmiSpecIface.RouterAdvertisement = &v1.InterfaceRouterAdvertisement{}
+
+				config, err := generator.Generate()
+				Expect(err).ToNot(HaveOccurred())
+				expectedConfig := generateExpectedConfigOnlyIPv6Enabled(vmiSpecNetwork, nil, mtu, ifaceName, subdomain)
+				expectedConfig.RouterAdvertisement = true
+				Expect(*config).To(Equal(expectedConfig))
+			})
 		})
 
 		When("Both Ipv4 and IPv6 are enabled", func() {
@@ -0,0 +1,173 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package routeradvertiser
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"time"
+
+	"golang.org/x/net/ipv6"
+
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	// unsolicitedInterval is how often the router advertisement is repeated without a solicitation,
+	// within the range recommended by RFC 4861.
+	unsolicitedInterval = 200 * time.Second
+	routerLifetime      = 1800 * time.Second
+	curHopLimit         = 64
+	// ndpHopLimit is the hop limit the neighbor discovery messages are required to be sent and received with
+	ndpHopLimit = 255
+
+	flagManagedAddress     = 0x80
+	flagOtherConfiguration = 0x40
+	flagOnLink             = 0x80
+
+	optionSourceLinkLayerAddress = 1
+	optionPrefixInformation      = 3
+	optionMTU                    = 5
+
+	infiniteLifetime = 0xffffffff
+)
+
+var (
+	allNodesAddr   = net.ParseIP("ff02::1")
+	allRoutersAddr = net.ParseIP("ff02::2")
+)
+
+// SingleClientRouterAdvertiser announces the server interface as the default router of the guest, both periodically
+// and in response to router solicitations. The guest is told to get its address and DNS servers over DHCPv6.
+func SingleClientRouterAdvertiser(serverIfaceName string, prefix *net.IPNet, mtu uint16) error {
+	log.Log.Info("Starting SingleClientRouterAdvertiser")
+
+	iface, err := net.InterfaceByName(serverIfaceName)
+	if err != nil {
+		return fmt.Errorf("couldn't create router advertiser, couldn't get the server interface: %v", err)
+	}
+
+	conn, err := newConnection(iface)
+	if err != nil {
+		return fmt.Errorf("couldn't create router advertiser: %v", err)
+	}
+	defer conn.Close()
+
+	advertisement := NewRouterAdvertisement(iface.HardwareAddr, prefix, mtu)
+	destination := &net.IPAddr{IP: allNodesAddr, Zone: iface.Name}
+	controlMessage := &ipv6.ControlMessage{IfIndex: iface.Index, HopLimit: ndpHopLimit}
+	advertise := func() {
+		if _, err := conn.WriteTo(advertisement, controlMessage, destination); err != nil {
+			log.Log.Reason(err).Error("failed to send a router advertisement")
+		}
+	}
+
+	go func() {
+		ticker := time.NewTicker(unsolicitedInterval)
+		defer ticker.Stop()
+		for {
+			advertise()
+			<-ticker.C
+		}
+	}()
+
+	buffer := make([]byte, iface.MTU)
+	for {
+		_, cm, _, err := conn.ReadFrom(buffer)
+		if err != nil {
+			return fmt.Errorf("failed to read router solicitations: %v", err)
+		}
+		if cm != nil && cm.IfIndex == iface.Index && cm.HopLimit == ndpHopLimit {
+			log.Log.V(4).Info("replying to a router solicitation")
+			advertise()
+		}
+	}
+}
+
+func newConnection(iface *net.Interface) (*ipv6.PacketConn, error) {
+	const errorString = "failed creating connection for router advertiser"
+	icmpConn, err := net.ListenPacket("ip6:ipv6-icmp", "::")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", errorString, err)
+	}
+
+	conn := ipv6.NewPacketConn(icmpConn)
+	var filter ipv6.ICMPFilter
+	filter.SetAll(true)
+	filter.Accept(ipv6.ICMPTypeRouterSolicitation)
+
+	for _, setup := range []func() error{
+		func() error { return conn.SetICMPFilter(&filter) },
+		func() error { return conn.SetControlMessage(ipv6.FlagInterface|ipv6.FlagHopLimit, true) },
+		func() error { return conn.SetMulticastHopLimit(ndpHopLimit) },
+		func() error { return conn.SetMulticastInterface(iface) },
+		func() error { return conn.JoinGroup(iface, &net.IPAddr{IP: allRoutersAddr}) },
+	} {
+		if err := setup(); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("%s: %v", errorString, err)
+		}
+	}
+	return conn, nil
+}
+
+// NewRouterAdvertisement builds the ICMPv6 router advertisement message, leaving the checksum to the kernel.
+// The prefix is advertised as on-link but not for autonomous address configuration, as the guest address
+// is assigned over DHCPv6.
+func NewRouterAdvertisement(sourceMAC net.HardwareAddr, prefix *net.IPNet, mtu uint16) []byte {
+	const headerLen = 16
+	message := make([]byte, headerLen)
+	message[0] = byte(ipv6.ICMPTypeRouterAdvertisement)
+	message[4] = curHopLimit
+	message[5] = flagManagedAddress | flagOtherConfiguration
+	binary.BigEndian.PutUint16(message[6:8], uint16(routerLifetime/time.Second))
+
+	if len(sourceMAC) > 0 {
+		option := make([]byte, 8)
+		option[0] = optionSourceLinkLayerAddress
+		option[1] = 1
+		copy(option[2:], sourceMAC)
+		message = append(message, option...)
+	}
+
+	if mtu > 0 {
+		option := make([]byte, 8)
+		option[0] = optionMTU
+		option[1] = 1
+		binary.BigEndian.PutUint32(option[4:8], uint32(mtu))
+		message = append(message, option...)
+	}
+
+	if prefix != nil {
+		prefixLen, _ := prefix.Mask.Size()
+		option := make([]byte, 32)
+		option[0] = optionPrefixInformation
+		option[1] = 4
+		option[2] = byte(prefixLen)
+		option[3] = flagOnLink
+		binary.BigEndian.PutUint32(option[4:8], infiniteLifetime)
+		binary.BigEndian.PutUint32(option[8:12], infiniteLifetime)
+		copy(option[16:32], prefix.IP.Mask(prefix.Mask).To16())
+		message = append(message, option...)
+	}
+
+	return message
+}
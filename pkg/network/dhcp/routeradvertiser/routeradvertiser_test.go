@@ -0,0 +1,63 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package routeradvertiser_test
+
+import (
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/network/dhcp/routeradvertiser"
+)
+
+var _ = Describe("Router advertisement", func() {
+	mac, _ := net.ParseMAC("02:00:00:00:00:01")
+	_, prefix, _ := net.ParseCIDR("fd10:0:2::2/120")
+
+	It("should build a router advertisement asking the guest to use DHCPv6", func() {
+		message := routeradvertiser.NewRouterAdvertisement(mac, prefix, 1400)
+
+		Expect(message).To(HaveLen(16 + 8 + 8 + 32))
+		By("setting the header")
+		Expect(message[:16]).To(Equal([]byte{
+			134, 0, 0, 0, // type, code, checksum
+			64, 0xc0, 0x07, 0x08, // hop limit, managed and other flags, 1800s router lifetime
+			0, 0, 0, 0, // reachable time
+			0, 0, 0, 0, // retransmission timer
+		}))
+		By("setting the source link layer address")
+		Expect(message[16:24]).To(Equal([]byte{1, 1, 0x02, 0, 0, 0, 0, 0x01}))
+		By("setting the MTU")
+		Expect(message[24:32]).To(Equal([]byte{5, 1, 0, 0, 0, 0, 0x05, 0x78}))
+		By("setting the on-link prefix")
+		Expect(message[32:48]).To(Equal([]byte{
+			3, 4, 120, 0x80,
+			0xff, 0xff, 0xff, 0xff,
+			0xff, 0xff, 0xff, 0xff,
+			0, 0, 0, 0,
+		}))
+		Expect(net.IP(message[48:64]).String()).To(Equal("fd10:0:2::"))
+	})
+
+	It("should omit the options which are not known", func() {
+		Expect(routeradvertiser.NewRouterAdvertisement(nil, nil, 0)).To(HaveLen(16))
+	})
+})
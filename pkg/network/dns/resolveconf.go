@@ -85,6 +85,37 @@ func ParseNameservers(content string) (*Nameservers, error) {
 	}, nil
 }
 
+// NameserversFromIPs sorts the given DNS server IP addresses by family, ignoring the invalid ones
+func NameserversFromIPs(ips []string) *Nameservers {
+	nameservers := &Nameservers{}
+	for _, ip := range ips {
+		parsedIP := net.ParseIP(ip)
+		if parsedIP == nil {
+			log.Log.Warningf("Ignoring invalid nameserver '%s'", ip)
+			continue
+		}
+		if ipv4 := parsedIP.To4(); ipv4 != nil {
+			nameservers.IPv4 = append(nameservers.IPv4, ipv4)
+		} else {
+			nameservers.IPv6 = append(nameservers.IPv6, parsedIP.To16())
+		}
+	}
+	return nameservers
+}
+
+// MergeNameservers returns the preferred nameservers, completed with the fallback ones
+// for the IP families the preferred nameservers do not cover
+func MergeNameservers(preferred, fallback *Nameservers) *Nameservers {
+	merged := &Nameservers{IPv4: preferred.IPv4, IPv6: preferred.IPv6}
+	if len(merged.IPv4) == 0 {
+		merged.IPv4 = fallback.IPv4
+	}
+	if len(merged.IPv6) == 0 {
+		merged.IPv6 = fallback.IPv6
+	}
+	return merged
+}
+
 func ParseSearchDomains(content string) ([]string, error) {
 	var searchDomains []string
 
@@ -75,6 +75,38 @@ var _ = Describe("Resolveconf", func() {
 		})
 	})
 
+	Context("Function NameserversFromIPs()", func() {
+		It("should sort the nameservers by family and ignore the invalid ones", func() {
+			nameservers := NameserversFromIPs([]string{"10.0.0.53", "2001:db8::53", "mynameserver", "10.0.1.53"})
+			Expect(nameservers.IPv4).To(Equal([][]byte{{10, 0, 0, 53}, {10, 0, 1, 53}}))
+			Expect(nameservers.IPv6).To(Equal([][]byte{net.ParseIP("2001:db8::53").To16()}))
+		})
+	})
+
+	Context("Function MergeNameservers()", func() {
+		podNameservers := &Nameservers{
+			IPv4: [][]byte{{10, 96, 0, 10}},
+			IPv6: [][]byte{net.ParseIP("fd00:10:96::a").To16()},
+		}
+
+		It("should prefer the given nameservers of both families", func() {
+			preferred := NameserversFromIPs([]string{"10.0.0.53", "2001:db8::53"})
+			Expect(MergeNameservers(preferred, podNameservers)).To(Equal(preferred))
+		})
+
+		It("should fall back to the pod IPv4 nameservers when only IPv6 ones are given", func() {
+			nameservers := MergeNameservers(NameserversFromIPs([]string{"2001:db8::53"}), podNameservers)
+			Expect(nameservers.IPv4).To(Equal(podNameservers.IPv4))
+			Expect(nameservers.IPv6).To(Equal([][]byte{net.ParseIP("2001:db8::53").To16()}))
+		})
+
+		It("should fall back to the pod IPv6 nameservers when only IPv4 ones are given", func() {
+			nameservers := MergeNameservers(NameserversFromIPs([]string{"10.0.0.53"}), podNameservers)
+			Expect(nameservers.IPv4).To(Equal([][]byte{{10, 0, 0, 53}}))
+			Expect(nameservers.IPv6).To(Equal(podNameservers.IPv6))
+		})
+	})
+
 	Context("Function ParseSearchDomains()", func() {
 		It("should return a string of search domains", func() {
 			resolvConf := "search cluster.local svc.cluster.local example.com\nnameserver 8.8.8.8\n"
@@ -0,0 +1,89 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package domainspec
+
+import (
+	"strconv"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const interruptVectorsProperty = "vectors"
+
+// SetInterfaceInterrupts overrides the interrupt vectors of the virtio devices of the domain interfaces whose
+// VMI interface configures its interrupts. libvirt has no interface setting for them, so the property of the
+// QEMU device is overridden instead, zero vectors disabling MSI-X in favor of INTx.
+// VMI interfaces which have no domain interface yet, e.g. the ones a binding plugin generates, are skipped.
+func SetInterfaceInterrupts(domainSpec *api.DomainSpec, vmiIfaces []v1.Interface) {
+	ifaceAliases := map[string]struct{}{}
+	var interruptDevices []api.QEMUOverrideDevice
+	for _, iface := range vmiIfaces {
+		alias := api.UserAliasPrefix + iface.Name
+		ifaceAliases[alias] = struct{}{}
+
+		vectors, isSet := interruptVectors(iface.Interrupts)
+		if !isSet || iface.State == v1.InterfaceStateAbsent ||
+			LookupIfaceByAliasName(domainSpec.Devices.Interfaces, iface.Name) == nil {
+			continue
+		}
+		interruptDevices = append(interruptDevices, api.QEMUOverrideDevice{
+			Alias: alias,
+			Frontend: api.QEMUOverrideFrontend{
+				Properties: []api.QEMUOverrideProperty{{
+					Name:  interruptVectorsProperty,
+					Type:  "unsigned",
+					Value: strconv.FormatUint(uint64(vectors), 10),
+				}},
+			},
+		})
+	}
+
+	var devices []api.QEMUOverrideDevice
+	if domainSpec.QEMUOverride != nil {
+		for _, device := range domainSpec.QEMUOverride.Devices {
+			if _, isIfaceDevice := ifaceAliases[device.Alias]; !isIfaceDevice {
+				devices = append(devices, device)
+			}
+		}
+	}
+	devices = append(devices, interruptDevices...)
+
+	if len(devices) == 0 {
+		domainSpec.QEMUOverride = nil
+		return
+	}
+	domainSpec.QEMUOverride = &api.QEMUOverride{Devices: devices}
+}
+
+// interruptVectors returns the vectors of the interface device, or false to keep the hypervisor default
+func interruptVectors(interrupts *v1.InterfaceInterrupts) (uint32, bool) {
+	switch {
+	case interrupts == nil:
+		return 0, false
+	case interrupts.Mode == v1.InterfaceInterruptModeINTx:
+		return 0, true
+	case interrupts.Vectors == nil:
+		return 0, false
+	default:
+		return *interrupts.Vectors, true
+	}
+}
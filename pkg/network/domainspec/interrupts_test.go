@@ -0,0 +1,135 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package domainspec_test
+
+import (
+	"encoding/xml"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/domainspec"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+var _ = Describe("Interface interrupts", func() {
+	const (
+		iface1 = "iface1"
+		iface2 = "iface2"
+	)
+
+	newDomainSpec := func(ifaceNames ...string) *api.DomainSpec {
+		domainSpec := &api.DomainSpec{}
+		for _, name := range ifaceNames {
+			domainSpec.Devices.Interfaces = append(domainSpec.Devices.Interfaces, api.Interface{
+				Alias: api.NewUserDefinedAlias(name),
+				Model: &api.Model{Type: v1.VirtIO},
+			})
+		}
+		return domainSpec
+	}
+
+	vectorsDevice := func(alias, vectors string) api.QEMUOverrideDevice {
+		return api.QEMUOverrideDevice{
+			Alias: alias,
+			Frontend: api.QEMUOverrideFrontend{
+				Properties: []api.QEMUOverrideProperty{{Name: "vectors", Type: "unsigned", Value: vectors}},
+			},
+		}
+	}
+
+	It("should not override devices when no interface configures its interrupts", func() {
+		domainSpec := newDomainSpec(iface1)
+
+		domainspec.SetInterfaceInterrupts(domainSpec, []v1.Interface{{Name: iface1}})
+
+		Expect(domainSpec.QEMUOverride).To(BeNil())
+	})
+
+	It("should not override devices when the interrupts keep the hypervisor default", func() {
+		domainSpec := newDomainSpec(iface1)
+
+		domainspec.SetInterfaceInterrupts(domainSpec, []v1.Interface{{
+			Name:       iface1,
+			Interrupts: &v1.InterfaceInterrupts{Mode: v1.InterfaceInterruptModeMSIX},
+		}})
+
+		Expect(domainSpec.QEMUOverride).To(BeNil())
+	})
+
+	It("should override the vectors of the interface devices", func() {
+		domainSpec := newDomainSpec(iface1, iface2)
+
+		domainspec.SetInterfaceInterrupts(domainSpec, []v1.Interface{
+			{Name: iface1, Interrupts: &v1.InterfaceInterrupts{Vectors: pointer.P(uint32(34))}},
+			{Name: iface2, Interrupts: &v1.InterfaceInterrupts{Mode: v1.InterfaceInterruptModeINTx}},
+		})
+
+		Expect(domainSpec.QEMUOverride).To(Equal(&api.QEMUOverride{Devices: []api.QEMUOverrideDevice{
+			vectorsDevice("ua-iface1", "34"),
+			vectorsDevice("ua-iface2", "0"),
+		}}))
+	})
+
+	It("should skip the interfaces which are absent or have no domain interface", func() {
+		domainSpec := newDomainSpec(iface1)
+
+		domainspec.SetInterfaceInterrupts(domainSpec, []v1.Interface{
+			{Name: iface1, State: v1.InterfaceStateAbsent, Interrupts: &v1.InterfaceInterrupts{Vectors: pointer.P(uint32(34))}},
+			{Name: iface2, Interrupts: &v1.InterfaceInterrupts{Vectors: pointer.P(uint32(34))}},
+		})
+
+		Expect(domainSpec.QEMUOverride).To(BeNil())
+	})
+
+	It("should replace the interface devices overrides and keep the other devices ones", func() {
+		domainSpec := newDomainSpec(iface1)
+		otherDevice := vectorsDevice("ua-other-device", "4")
+		domainSpec.QEMUOverride = &api.QEMUOverride{Devices: []api.QEMUOverrideDevice{
+			vectorsDevice("ua-iface1", "8"),
+			otherDevice,
+		}}
+
+		domainspec.SetInterfaceInterrupts(domainSpec, []v1.Interface{
+			{Name: iface1, Interrupts: &v1.InterfaceInterrupts{Vectors: pointer.P(uint32(34))}},
+		})
+
+		Expect(domainSpec.QEMUOverride).To(Equal(&api.QEMUOverride{Devices: []api.QEMUOverrideDevice{
+			otherDevice,
+			vectorsDevice("ua-iface1", "34"),
+		}}))
+	})
+
+	It("should marshal the override to the libvirt qemu namespace", func() {
+		domainSpec := newDomainSpec(iface1)
+
+		domainspec.SetInterfaceInterrupts(domainSpec, []v1.Interface{
+			{Name: iface1, Interrupts: &v1.InterfaceInterrupts{Vectors: pointer.P(uint32(34))}},
+		})
+
+		domainXML, err := xml.Marshal(domainSpec)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(domainXML)).To(ContainSubstring(`<qemu:override><qemu:device alias="ua-iface1"><qemu:frontend>` +
+			`<qemu:property name="vectors" type="unsigned" value="34"></qemu:property></qemu:frontend></qemu:device></qemu:override>`))
+	})
+})
@@ -21,7 +21,10 @@ package downwardapi
 
 import (
 	"cmp"
+	"crypto/sha256"
 	"encoding/json"
+	"fmt"
+	"io"
 	"slices"
 
 	networkv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
@@ -36,6 +39,27 @@ const (
 	NetworkInfoVolumePath = "network-info"
 )
 
+// PluginNetworkInfoAnnot returns the annotation holding the network-info of the interfaces bound to the
+// network binding plugin, when the network-info is scoped to the plugins.
+func PluginNetworkInfoAnnot(pluginName string) string {
+	return NetworkInfoAnnot + "-" + hashPluginName(pluginName)
+}
+
+// PluginNetworkInfoVolumeName returns the name of the downward API volume projecting the network-info of the
+// network binding plugin, which is mounted in the plugin sidecar only.
+func PluginNetworkInfoVolumeName(pluginName string) string {
+	return NetworkInfoVolumeName + "-" + hashPluginName(pluginName)
+}
+
+// hashPluginName returns a short hash of the plugin name, as plugin names are not restricted to the
+// characters and length allowed in annotation and volume names.
+func hashPluginName(pluginName string) string {
+	const hashedNameLen = 12
+	hash := sha256.New()
+	_, _ = io.WriteString(hash, pluginName)
+	return fmt.Sprintf("%x", hash.Sum(nil))[:hashedNameLen]
+}
+
 func CreateNetworkInfoAnnotationValue(networkStatusesByNetworkName map[string]networkv1.NetworkStatus) string {
 	networkInfo := generateNetworkInfo(networkStatusesByNetworkName)
 	networkInfoBytes, err := json.Marshal(networkInfo)
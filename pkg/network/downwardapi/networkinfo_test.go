@@ -92,4 +92,15 @@ var _ = Describe("Network info", func() {
 
 		Expect(actualNetworkInfo).To(Equal(expectedNetworkInfo))
 	})
+
+	It("should scope the network info annotation and volume name to the binding plugin", func() {
+		annotation := downwardapi.PluginNetworkInfoAnnot("my-binding")
+		volumeName := downwardapi.PluginNetworkInfoVolumeName("my-binding")
+
+		Expect(annotation).To(HavePrefix(downwardapi.NetworkInfoAnnot + "-"))
+		Expect(volumeName).To(HavePrefix(downwardapi.NetworkInfoVolumeName + "-"))
+		Expect(annotation).To(HaveLen(len(downwardapi.NetworkInfoAnnot) + 13))
+		Expect(volumeName).To(HaveSuffix(annotation[len(downwardapi.NetworkInfoAnnot):]))
+		Expect(downwardapi.PluginNetworkInfoAnnot("other-binding")).ToNot(Equal(annotation))
+	})
 })
@@ -23,6 +23,7 @@ package driver
 
 import (
 	"fmt"
+	"net"
 	"os"
 
 	"github.com/vishvananda/netlink"
@@ -33,6 +34,7 @@ import (
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/network/cache"
+	"kubevirt.io/kubevirt/pkg/network/dhcp/routeradvertiser"
 	dhcpserver "kubevirt.io/kubevirt/pkg/network/dhcp/server"
 	dhcpserverv6 "kubevirt.io/kubevirt/pkg/network/dhcp/serverv6"
 	"kubevirt.io/kubevirt/pkg/network/dns"
@@ -165,8 +167,13 @@ func (h *NetworkUtilsHandler) StartDHCP(nic *cache.DHCPConfig, bridgeInterfaceNa
 		return fmt.Errorf("Failed to get DNS servers from resolv.conf: %v", err)
 	}
 
-	domain := dns.DomainNameWithSubdomain(searchDomains, nic.Subdomain)
-	if domain != "" {
+	if len(nic.DNSNameservers) > 0 {
+		nameservers = dns.MergeNameservers(dns.NameserversFromIPs(nic.DNSNameservers), nameservers)
+	}
+
+	if len(nic.DNSSearchDomains) > 0 {
+		searchDomains = nic.DNSSearchDomains
+	} else if domain := dns.DomainNameWithSubdomain(searchDomains, nic.Subdomain); domain != "" {
 		searchDomains = append([]string{domain}, searchDomains...)
 	}
 
@@ -204,6 +211,15 @@ func (h *NetworkUtilsHandler) StartDHCP(nic *cache.DHCPConfig, bridgeInterfaceNa
 				panic(err)
 			}
 		}()
+
+		if nic.RouterAdvertisement {
+			prefix := &net.IPNet{IP: nic.IPv6.IP.Mask(nic.IPv6.Mask), Mask: nic.IPv6.Mask}
+			go func() {
+				if err := RouterAdvertiser(bridgeInterfaceName, prefix, nic.Mtu); err != nil {
+					log.Log.Reason(err).Error("failed to run IPv6 router advertiser")
+				}
+			}()
+		}
 	}
 
 	return nil
@@ -212,3 +228,4 @@ func (h *NetworkUtilsHandler) StartDHCP(nic *cache.DHCPConfig, bridgeInterfaceNa
 // Allow mocking for tests
 var DHCPServer = dhcpserver.SingleClientDHCPServer
 var DHCPv6Server = dhcpserverv6.SingleClientDHCPv6Server
+var RouterAdvertiser = routeradvertiser.SingleClientRouterAdvertiser
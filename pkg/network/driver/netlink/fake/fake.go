@@ -31,12 +31,16 @@ type NetLink struct {
 	ip6AddressesByLinkName map[string][]vishnetlink.Addr
 	routes4                []vishnetlink.Route
 	routes6                []vishnetlink.Route
+	qdiscsByLinkIndex      map[int][]vishnetlink.Qdisc
+	filtersByLinkIndex     map[int][]vishnetlink.Filter
 }
 
 func New() *NetLink {
 	return &NetLink{
 		ip4AddressesByLinkName: map[string][]vishnetlink.Addr{},
 		ip6AddressesByLinkName: map[string][]vishnetlink.Addr{},
+		qdiscsByLinkIndex:      map[int][]vishnetlink.Qdisc{},
+		filtersByLinkIndex:     map[int][]vishnetlink.Filter{},
 	}
 }
 
@@ -147,6 +151,15 @@ func (n *NetLink) LinkSetLearningOff(link vishnetlink.Link) error {
 	return nil
 }
 
+func (n *NetLink) LinkSetPromiscOn(link vishnetlink.Link) error {
+	l := n.lookupLinkByName(link.Attrs().Name)
+	if l == nil {
+		return vishnetlink.LinkNotFoundError{}
+	}
+	l.Attrs().Promisc = 1
+	return nil
+}
+
 func (n *NetLink) LinkGetProtinfo(link vishnetlink.Link) (vishnetlink.Protinfo, error) {
 	l := n.lookupLinkByName(link.Attrs().Name)
 	if l == nil {
@@ -232,6 +245,56 @@ func (n *NetLink) RouteAdd(route *vishnetlink.Route) error {
 	return nil
 }
 
+func (n *NetLink) QdiscReplace(qdisc vishnetlink.Qdisc) error {
+	linkIndex := qdisc.Attrs().LinkIndex
+	if l := n.lookupLinkByIndex(linkIndex); l == nil {
+		return vishnetlink.LinkNotFoundError{}
+	}
+	var qdiscs []vishnetlink.Qdisc
+	for _, q := range n.qdiscsByLinkIndex[linkIndex] {
+		if q.Attrs().Parent != qdisc.Attrs().Parent {
+			qdiscs = append(qdiscs, q)
+		}
+	}
+	n.qdiscsByLinkIndex[linkIndex] = append(qdiscs, qdisc)
+	return nil
+}
+
+func (n *NetLink) QdiscList(link vishnetlink.Link) ([]vishnetlink.Qdisc, error) {
+	if l := n.lookupLinkByName(link.Attrs().Name); l == nil {
+		return nil, vishnetlink.LinkNotFoundError{}
+	}
+	return n.qdiscsByLinkIndex[link.Attrs().Index], nil
+}
+
+func (n *NetLink) FilterReplace(filter vishnetlink.Filter) error {
+	linkIndex := filter.Attrs().LinkIndex
+	if l := n.lookupLinkByIndex(linkIndex); l == nil {
+		return vishnetlink.LinkNotFoundError{}
+	}
+	var filters []vishnetlink.Filter
+	for _, f := range n.filtersByLinkIndex[linkIndex] {
+		if f.Attrs().Parent != filter.Attrs().Parent || f.Attrs().Handle != filter.Attrs().Handle {
+			filters = append(filters, f)
+		}
+	}
+	n.filtersByLinkIndex[linkIndex] = append(filters, filter)
+	return nil
+}
+
+func (n *NetLink) FilterList(link vishnetlink.Link, parent uint32) ([]vishnetlink.Filter, error) {
+	if l := n.lookupLinkByName(link.Attrs().Name); l == nil {
+		return nil, vishnetlink.LinkNotFoundError{}
+	}
+	var filters []vishnetlink.Filter
+	for _, f := range n.filtersByLinkIndex[link.Attrs().Index] {
+		if f.Attrs().Parent == parent {
+			filters = append(filters, f)
+		}
+	}
+	return filters, nil
+}
+
 func (n *NetLink) lookupLinkByName(name string) vishnetlink.Link {
 	for i, l := range n.links {
 		if l.Attrs().Name == name {
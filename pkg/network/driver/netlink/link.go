@@ -70,6 +70,10 @@ func (n NetLink) LinkSetLearningOff(link netlink.Link) error {
 	return withErrDescr(netlink.LinkSetLearning(link, false), "LinkSetLearningOff")
 }
 
+func (n NetLink) LinkSetPromiscOn(link netlink.Link) error {
+	return withErrDescr(netlink.SetPromiscOn(link), "LinkSetPromiscOn")
+}
+
 func (n NetLink) LinkGetProtinfo(link netlink.Link) (netlink.Protinfo, error) {
 	return netlink.LinkGetProtinfo(link)
 }
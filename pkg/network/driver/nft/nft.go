@@ -54,6 +54,11 @@ func (n NFTBin) AddRule(family IPFamily, table, chain string, rulespec ...string
 	return execute(cmd)
 }
 
+func (n NFTBin) FlushChain(family IPFamily, table, name string) error {
+	cmd := exec.Command(nftBin, "flush", "chain", string(family), table, name)
+	return execute(cmd)
+}
+
 func execute(cmd *exec.Cmd) error {
 	if output, err := cmd.CombinedOutput(); err != nil {
 		return fmt.Errorf("%s, error: %v", string(output), err)
@@ -223,6 +223,12 @@ func (n NMState) setupInterface(iface Interface, link vishnetlink.Link) error {
 			return err
 		}
 	}
+
+	if val := iface.LinuxStack.Promiscuous; val != nil && *val {
+		if err := n.adapter.LinkSetPromiscOn(link); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
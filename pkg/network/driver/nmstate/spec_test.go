@@ -432,6 +432,24 @@ var _ = Describe("NMState Spec interfaces", func() {
 			}))
 		})
 	})
+
+	It("enables promiscuous mode", func() {
+		adapter := newTestAdapter()
+		nmState = nmstate.New(nmstate.WithAdapter(adapter))
+
+		Expect(nmState.Apply(&nmstate.Spec{Interfaces: []nmstate.Interface{
+			{
+				Name:       dummyName,
+				TypeName:   nmstate.TypeDummy,
+				State:      nmstate.IfaceStateUp,
+				LinuxStack: nmstate.LinuxIfaceStack{Promiscuous: pointer.P(true)},
+			},
+		}})).To(Succeed())
+
+		link, err := adapter.LinkByName(dummyName)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(link.Attrs().Promisc).To(Equal(1))
+	})
 })
 
 var _ = Describe("NMState Spec Linux Stack", func() {
@@ -90,6 +90,7 @@ type TapDevice struct {
 type LinuxIfaceStack struct {
 	IP4RouteLocalNet *bool `json:"ip4-route-local-net,omitempty"`
 	PortLearning     *bool `json:"port-learning,omitempty"`
+	Promiscuous      *bool `json:"promiscuous,omitempty"`
 }
 
 type LinuxStack struct {
@@ -130,6 +131,7 @@ type adapter interface {
 	LinkSetMaster(vishnetlink.Link, *vishnetlink.Bridge) error
 	LinkSetName(vishnetlink.Link, string) error
 	LinkSetLearningOff(vishnetlink.Link) error
+	LinkSetPromiscOn(vishnetlink.Link) error
 	AddrList(vishnetlink.Link, int) ([]vishnetlink.Addr, error)
 	AddrAdd(vishnetlink.Link, *vishnetlink.Addr) error
 	AddrDel(vishnetlink.Link, *vishnetlink.Addr) error
@@ -0,0 +1,40 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ */
+
+package errors
+
+import "fmt"
+
+// InterfaceHotplugError is the failure to plug an interface into a running domain.
+// virt-launcher reports the interface to virt-handler in the reply of the sync command.
+type InterfaceHotplugError struct {
+	wrappedErr error
+	Interface  string
+}
+
+func (e InterfaceHotplugError) Error() string {
+	return fmt.Sprintf("failed to hot plug interface %q: %v", e.Interface, e.wrappedErr)
+}
+func (e InterfaceHotplugError) Unwrap() error { return e.wrappedErr }
+
+func CreateInterfaceHotplugError(ifaceName string, err error) *InterfaceHotplugError {
+	return &InterfaceHotplugError{
+		wrappedErr: err,
+		Interface:  ifaceName,
+	}
+}
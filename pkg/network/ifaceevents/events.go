@@ -0,0 +1,152 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ifaceevents
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+const (
+	// TypeInterfaceConfigured is emitted for the interfaces the VMI has been started with
+	TypeInterfaceConfigured = "io.kubevirt.vmi.interface.configured"
+	// TypeInterfaceHotplugged is emitted for the interfaces added to a running VMI
+	TypeInterfaceHotplugged = "io.kubevirt.vmi.interface.hotplugged"
+	// TypeInterfaceRemoved is emitted for the interfaces removed from the VMI
+	TypeInterfaceRemoved = "io.kubevirt.vmi.interface.removed"
+)
+
+// Event is a CloudEvent, in the structured JSON format, about a VMI interface
+type Event struct {
+	SpecVersion     string        `json:"specversion"`
+	ID              string        `json:"id"`
+	Source          string        `json:"source"`
+	Type            string        `json:"type"`
+	Subject         string        `json:"subject"`
+	Time            time.Time     `json:"time"`
+	DataContentType string        `json:"datacontenttype"`
+	Data            InterfaceData `json:"data"`
+}
+
+// InterfaceData describes the interface the event is about
+type InterfaceData struct {
+	Namespace          string `json:"namespace"`
+	VMIName            string `json:"vmiName"`
+	VMIUID             string `json:"vmiUID"`
+	Node               string `json:"node"`
+	Interface          string `json:"interface"`
+	Network            string `json:"network,omitempty"`
+	MAC                string `json:"mac"`
+	PodInterfaceName   string `json:"podInterfaceName,omitempty"`
+	GuestInterfaceName string `json:"guestInterfaceName,omitempty"`
+}
+
+// Collect returns the events about the interfaces which have been configured, hotplugged or removed
+// between the old status and the current status of the VMI.
+// An interface is considered plugged as soon as its status reports a MAC address.
+func Collect(oldStatus *v1.VirtualMachineInstanceStatus, vmi *v1.VirtualMachineInstance, node string, now time.Time) []Event {
+	oldIfaces := pluggedInterfaces(oldStatus.Interfaces)
+	newIfaces := pluggedInterfaces(vmi.Status.Interfaces)
+
+	addedType := TypeInterfaceHotplugged
+	if oldStatus.Phase != v1.Running {
+		addedType = TypeInterfaceConfigured
+	}
+
+	var events []Event
+	for _, iface := range vmi.Status.Interfaces {
+		if _, plugged := newIfaces[iface.Name]; !plugged {
+			continue
+		}
+		if _, wasPlugged := oldIfaces[iface.Name]; !wasPlugged {
+			events = append(events, newEvent(addedType, vmi, iface, node, now))
+		}
+	}
+	for _, iface := range oldStatus.Interfaces {
+		if _, wasPlugged := oldIfaces[iface.Name]; !wasPlugged {
+			continue
+		}
+		if _, plugged := newIfaces[iface.Name]; !plugged {
+			events = append(events, newEvent(TypeInterfaceRemoved, vmi, iface, node, now))
+		}
+	}
+	return events
+}
+
+func pluggedInterfaces(statuses []v1.VirtualMachineInstanceNetworkInterface) map[string]struct{} {
+	plugged := make(map[string]struct{})
+	for _, iface := range statuses {
+		if iface.Name != "" && iface.MAC != "" {
+			plugged[iface.Name] = struct{}{}
+		}
+	}
+	return plugged
+}
+
+func newEvent(eventType string, vmi *v1.VirtualMachineInstance, iface v1.VirtualMachineInstanceNetworkInterface, node string, now time.Time) Event {
+	return Event{
+		SpecVersion:     "1.0",
+		ID:              eventID(eventType, vmi, iface),
+		Source:          fmt.Sprintf("/apis/kubevirt.io/v1/namespaces/%s/virtualmachineinstances/%s", vmi.Namespace, vmi.Name),
+		Type:            eventType,
+		Subject:         iface.Name,
+		Time:            now.UTC(),
+		DataContentType: "application/json",
+		Data: InterfaceData{
+			Namespace:          vmi.Namespace,
+			VMIName:            vmi.Name,
+			VMIUID:             string(vmi.UID),
+			Node:               node,
+			Interface:          iface.Name,
+			Network:            networkName(vmi.Spec.Networks, iface.Name),
+			MAC:                iface.MAC,
+			PodInterfaceName:   iface.PodInterfaceName,
+			GuestInterfaceName: iface.InterfaceName,
+		},
+	}
+}
+
+// eventID is derived from the event content, so that the redelivery of the same event keeps its id
+func eventID(eventType string, vmi *v1.VirtualMachineInstance, iface v1.VirtualMachineInstanceNetworkInterface) string {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s/%s/%s/%s", vmi.UID, eventType, iface.Name, iface.MAC)))
+	return fmt.Sprintf("%x", hash[:16])
+}
+
+// networkName returns the NetworkAttachmentDefinition the interface is connected to, the PodNetwork of a native
+// network, or "pod" for the pod network
+func networkName(networks []v1.Network, ifaceName string) string {
+	network := vmispec.LookupNetworkByName(networks, ifaceName)
+	switch {
+	case network == nil:
+		return ""
+	case network.Pod != nil:
+		return "pod"
+	case network.Multus != nil:
+		return network.Multus.NetworkName
+	case network.Native != nil:
+		return network.Native.PodNetworkName
+	}
+	return ""
+}
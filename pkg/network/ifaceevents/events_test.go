@@ -0,0 +1,148 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ifaceevents_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/network/ifaceevents"
+)
+
+var _ = Describe("Interface events", func() {
+	const (
+		node       = "node01"
+		secondary  = "secondary"
+		nadName    = "blue-nad"
+		defaultMAC = "02:00:00:00:00:01"
+		secondMAC  = "02:00:00:00:00:02"
+	)
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	newVMI := func(ifaceStatuses ...v1.VirtualMachineInstanceNetworkInterface) *v1.VirtualMachineInstance {
+		vmi := libvmi.New(
+			libvmi.WithNamespace("default"),
+			libvmi.WithName("testvmi"),
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+			libvmi.WithNetwork(v1.DefaultPodNetwork()),
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithBridgeBinding(secondary)),
+			libvmi.WithNetwork(libvmi.MultusNetwork(secondary, nadName)),
+		)
+		vmi.UID = "1234"
+		vmi.Status.Phase = v1.Running
+		vmi.Status.Interfaces = ifaceStatuses
+		return vmi
+	}
+
+	defaultIface := v1.VirtualMachineInstanceNetworkInterface{Name: "default", MAC: defaultMAC, PodInterfaceName: "eth0", InterfaceName: "eth0"}
+	secondaryIface := v1.VirtualMachineInstanceNetworkInterface{Name: secondary, MAC: secondMAC, PodInterfaceName: "pod16367aadb6f"}
+
+	It("should report the interfaces of a starting VMI as configured", func() {
+		oldStatus := &v1.VirtualMachineInstanceStatus{Phase: v1.Scheduled}
+		vmi := newVMI(defaultIface, secondaryIface)
+
+		events := ifaceevents.Collect(oldStatus, vmi, node, now)
+
+		Expect(events).To(HaveLen(2))
+		Expect(events[0].Type).To(Equal(ifaceevents.TypeInterfaceConfigured))
+		Expect(events[0].SpecVersion).To(Equal("1.0"))
+		Expect(events[0].Source).To(Equal("/apis/kubevirt.io/v1/namespaces/default/virtualmachineinstances/testvmi"))
+		Expect(events[0].Subject).To(Equal("default"))
+		Expect(events[0].Time).To(Equal(now))
+		Expect(events[0].Data).To(Equal(ifaceevents.InterfaceData{
+			Namespace:          "default",
+			VMIName:            "testvmi",
+			VMIUID:             "1234",
+			Node:               node,
+			Interface:          "default",
+			Network:            "pod",
+			MAC:                defaultMAC,
+			PodInterfaceName:   "eth0",
+			GuestInterfaceName: "eth0",
+		}))
+		Expect(events[1].Type).To(Equal(ifaceevents.TypeInterfaceConfigured))
+		Expect(events[1].Data.Network).To(Equal(nadName))
+	})
+
+	It("should report an interface added to a running VMI as hotplugged", func() {
+		oldStatus := &v1.VirtualMachineInstanceStatus{Phase: v1.Running, Interfaces: []v1.VirtualMachineInstanceNetworkInterface{defaultIface}}
+		vmi := newVMI(defaultIface, secondaryIface)
+
+		events := ifaceevents.Collect(oldStatus, vmi, node, now)
+
+		Expect(events).To(HaveLen(1))
+		Expect(events[0].Type).To(Equal(ifaceevents.TypeInterfaceHotplugged))
+		Expect(events[0].Data.Interface).To(Equal(secondary))
+		Expect(events[0].Data.MAC).To(Equal(secondMAC))
+	})
+
+	It("should report an interface gone from the VMI as removed", func() {
+		oldStatus := &v1.VirtualMachineInstanceStatus{Phase: v1.Running, Interfaces: []v1.VirtualMachineInstanceNetworkInterface{defaultIface, secondaryIface}}
+		vmi := newVMI(defaultIface)
+
+		events := ifaceevents.Collect(oldStatus, vmi, node, now)
+
+		Expect(events).To(HaveLen(1))
+		Expect(events[0].Type).To(Equal(ifaceevents.TypeInterfaceRemoved))
+		Expect(events[0].Data.Interface).To(Equal(secondary))
+		Expect(events[0].Data.PodInterfaceName).To(Equal("pod16367aadb6f"))
+	})
+
+	It("should not report interfaces without a MAC address or without changes", func() {
+		oldStatus := &v1.VirtualMachineInstanceStatus{Phase: v1.Running, Interfaces: []v1.VirtualMachineInstanceNetworkInterface{defaultIface}}
+		vmi := newVMI(defaultIface, v1.VirtualMachineInstanceNetworkInterface{Name: secondary})
+
+		Expect(ifaceevents.Collect(oldStatus, vmi, node, now)).To(BeEmpty())
+	})
+
+	It("should report the PodNetwork of an interface on a native network", func() {
+		const (
+			nativeNet      = "native"
+			podNetworkName = "green-net"
+		)
+		oldStatus := &v1.VirtualMachineInstanceStatus{Phase: v1.Running, Interfaces: []v1.VirtualMachineInstanceNetworkInterface{defaultIface}}
+		vmi := newVMI(defaultIface, v1.VirtualMachineInstanceNetworkInterface{Name: nativeNet, MAC: secondMAC})
+		vmi.Spec.Domain.Devices.Interfaces = append(vmi.Spec.Domain.Devices.Interfaces, libvmi.InterfaceDeviceWithBridgeBinding(nativeNet))
+		vmi.Spec.Networks = append(vmi.Spec.Networks, *libvmi.NativeNetwork(nativeNet, podNetworkName))
+
+		events := ifaceevents.Collect(oldStatus, vmi, node, now)
+
+		Expect(events).To(HaveLen(1))
+		Expect(events[0].Data.Interface).To(Equal(nativeNet))
+		Expect(events[0].Data.Network).To(Equal(podNetworkName))
+	})
+
+	It("should keep the id of the same event", func() {
+		oldStatus := &v1.VirtualMachineInstanceStatus{Phase: v1.Running}
+		vmi := newVMI(defaultIface)
+
+		first := ifaceevents.Collect(oldStatus, vmi, node, now)
+		second := ifaceevents.Collect(oldStatus, vmi, node, now.Add(time.Minute))
+
+		Expect(first[0].ID).ToNot(BeEmpty())
+		Expect(first[0].ID).To(Equal(second[0].ID))
+	})
+})
@@ -0,0 +1,172 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ifaceevents
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	cloudEventsContentType = "application/cloudevents+json"
+	defaultWebhookTimeout  = 10 * time.Second
+
+	// deliveryQueueSize bounds the events waiting for delivery, further events are dropped
+	deliveryQueueSize = 1024
+)
+
+// DefaultDeliveryBackoff retries the delivery of an event up to five times, over about 15 seconds
+var DefaultDeliveryBackoff = wait.Backoff{
+	Duration: time.Second,
+	Factor:   2,
+	Steps:    5,
+}
+
+type delivery struct {
+	url   string
+	event Event
+}
+
+type statusError struct {
+	statusCode int
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("webhook responded with status %d", e.statusCode)
+}
+
+// WebhookNotifier posts the interface events to a webhook, in the CloudEvents structured JSON format
+type WebhookNotifier struct {
+	client     *http.Client
+	backoff    wait.Backoff
+	deliveries chan delivery
+}
+
+func NewWebhookNotifier() *WebhookNotifier {
+	return NewWebhookNotifierWithBackoff(DefaultDeliveryBackoff)
+}
+
+func NewWebhookNotifierWithBackoff(backoff wait.Backoff) *WebhookNotifier {
+	return &WebhookNotifier{
+		client:     &http.Client{Timeout: defaultWebhookTimeout},
+		backoff:    backoff,
+		deliveries: make(chan delivery, deliveryQueueSize),
+	}
+}
+
+// Run delivers the queued events with the given number of workers until stopCh is closed
+func (n *WebhookNotifier) Run(workers int, stopCh <-chan struct{}) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	for i := 0; i < workers; i++ {
+		go n.runWorker(ctx)
+	}
+	<-stopCh
+}
+
+func (n *WebhookNotifier) runWorker(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case d := <-n.deliveries:
+			if err := n.deliver(ctx, d); err != nil {
+				log.Log.Reason(err).Warningf("failed to deliver event %s about interface %s of %s/%s",
+					d.event.Type, d.event.Data.Interface, d.event.Data.Namespace, d.event.Data.VMIName)
+			}
+		}
+	}
+}
+
+// Notify queues the events for delivery, so that a slow webhook does not delay the caller.
+// The events are dropped when the queue is full.
+func (n *WebhookNotifier) Notify(url string, events []Event) {
+	if url == "" {
+		return
+	}
+	for _, event := range events {
+		select {
+		case n.deliveries <- delivery{url: url, event: event}:
+		default:
+			log.Log.Warningf("dropping event %s about interface %s of %s/%s, too many events are pending delivery",
+				event.Type, event.Data.Interface, event.Data.Namespace, event.Data.VMIName)
+		}
+	}
+}
+
+// deliver sends the event, retrying with the notifier backoff as long as the failure may be transient
+func (n *WebhookNotifier) deliver(ctx context.Context, d delivery) error {
+	var lastErr error
+	err := wait.ExponentialBackoffWithContext(ctx, n.backoff, func(ctx context.Context) (bool, error) {
+		lastErr = n.Send(ctx, d.url, d.event)
+		if lastErr == nil {
+			return true, nil
+		}
+		if !isRetriable(lastErr) {
+			return false, lastErr
+		}
+		return false, nil
+	})
+	if err != nil && lastErr != nil {
+		return lastErr
+	}
+	return err
+}
+
+// isRetriable returns whether the webhook may accept the event later, it is not the case when it rejected its content
+func isRetriable(err error) bool {
+	var statusErr *statusError
+	if !errors.As(err, &statusErr) {
+		return true
+	}
+	return statusErr.statusCode == http.StatusTooManyRequests || statusErr.statusCode >= http.StatusInternalServerError
+}
+
+// Send posts a single event to the webhook
+func (n *WebhookNotifier) Send(ctx context.Context, url string, event Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %v", err)
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %v", err)
+	}
+	request.Header.Set("Content-Type", cloudEventsContentType)
+
+	response, err := n.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed to post event: %v", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return &statusError{statusCode: response.StatusCode}
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ifaceevents_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"kubevirt.io/kubevirt/pkg/network/ifaceevents"
+)
+
+var _ = Describe("Webhook notifier", func() {
+	event := ifaceevents.Event{
+		SpecVersion: "1.0",
+		ID:          "1",
+		Type:        ifaceevents.TypeInterfaceHotplugged,
+		Data:        ifaceevents.InterfaceData{Interface: "secondary", MAC: "02:00:00:00:00:02"},
+	}
+
+	It("should post the event as a structured CloudEvent", func() {
+		received := make(chan ifaceevents.Event, 1)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			Expect(r.Method).To(Equal(http.MethodPost))
+			Expect(r.Header.Get("Content-Type")).To(Equal("application/cloudevents+json"))
+			var receivedEvent ifaceevents.Event
+			Expect(json.NewDecoder(r.Body).Decode(&receivedEvent)).To(Succeed())
+			received <- receivedEvent
+		}))
+		defer server.Close()
+
+		Expect(ifaceevents.NewWebhookNotifier().Send(context.Background(), server.URL, event)).To(Succeed())
+		Eventually(received).Should(Receive(Equal(event)))
+	})
+
+	It("should fail when the webhook rejects the event", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		Expect(ifaceevents.NewWebhookNotifier().Send(context.Background(), server.URL, event)).To(
+			MatchError("webhook responded with status 500"))
+	})
+
+	Context("delivering in the background", func() {
+		var (
+			notifier *ifaceevents.WebhookNotifier
+			stop     chan struct{}
+		)
+
+		BeforeEach(func() {
+			notifier = ifaceevents.NewWebhookNotifierWithBackoff(wait.Backoff{Duration: time.Millisecond, Steps: 3})
+			stop = make(chan struct{})
+			go notifier.Run(2, stop)
+			DeferCleanup(func() { close(stop) })
+		})
+
+		It("should deliver the events", func() {
+			received := make(chan string, 2)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var receivedEvent ifaceevents.Event
+				if err := json.NewDecoder(r.Body).Decode(&receivedEvent); err == nil {
+					received <- receivedEvent.ID
+				}
+			}))
+			defer server.Close()
+
+			second := event
+			second.ID = "2"
+			notifier.Notify(server.URL, []ifaceevents.Event{event, second})
+
+			Eventually(received).Should(HaveLen(2))
+			Expect([]string{<-received, <-received}).To(ConsistOf("1", "2"))
+		})
+
+		It("should retry the delivery while the webhook is unavailable", func() {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if attempts.Add(1) < 3 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+				}
+			}))
+			defer server.Close()
+
+			notifier.Notify(server.URL, []ifaceevents.Event{event})
+
+			Eventually(attempts.Load).Should(BeEquivalentTo(3))
+			Consistently(attempts.Load, 50*time.Millisecond).Should(BeEquivalentTo(3))
+		})
+
+		It("should give up after the last attempt", func() {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts.Add(1)
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}))
+			defer server.Close()
+
+			notifier.Notify(server.URL, []ifaceevents.Event{event})
+
+			Eventually(attempts.Load).Should(BeEquivalentTo(3))
+			Consistently(attempts.Load, 50*time.Millisecond).Should(BeEquivalentTo(3))
+		})
+
+		It("should not retry an event the webhook rejected", func() {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts.Add(1)
+				w.WriteHeader(http.StatusBadRequest)
+			}))
+			defer server.Close()
+
+			notifier.Notify(server.URL, []ifaceevents.Event{event})
+
+			Eventually(attempts.Load).Should(BeEquivalentTo(1))
+			Consistently(attempts.Load, 50*time.Millisecond).Should(BeEquivalentTo(1))
+		})
+	})
+})
@@ -0,0 +1,107 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ifacenaming
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+const (
+	// DMIEntriesDir is where the guest kernel exposes the raw SMBIOS structures.
+	DMIEntriesDir = "/sys/firmware/dmi/entries"
+
+	smbiosTypeOEMStrings = 11
+
+	// The OEM strings structure header: type, length, handle (2 bytes) and strings count.
+	oemStringsHeaderLen = 5
+)
+
+// ReadOEMStrings returns the strings of all the SMBIOS OEM strings structures
+// found under the given DMI entries directory.
+func ReadOEMStrings(entriesDir string) ([]string, error) {
+	rawPaths, err := filepath.Glob(filepath.Join(entriesDir, fmt.Sprintf("%d-*", smbiosTypeOEMStrings), "raw"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(rawPaths)
+
+	var oemStrings []string
+	for _, rawPath := range rawPaths {
+		raw, err := os.ReadFile(rawPath)
+		if err != nil {
+			return nil, err
+		}
+		structStrings, err := parseOEMStringsStructure(raw)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %v", rawPath, err)
+		}
+		oemStrings = append(oemStrings, structStrings...)
+	}
+	return oemStrings, nil
+}
+
+func parseOEMStringsStructure(raw []byte) ([]string, error) {
+	if len(raw) < oemStringsHeaderLen {
+		return nil, fmt.Errorf("structure is too short: %d bytes", len(raw))
+	}
+	if raw[0] != smbiosTypeOEMStrings {
+		return nil, fmt.Errorf("unexpected structure type %d", raw[0])
+	}
+	formattedLen := int(raw[1])
+	if formattedLen < oemStringsHeaderLen || formattedLen > len(raw) {
+		return nil, fmt.Errorf("invalid structure length %d", formattedLen)
+	}
+
+	count := int(raw[4])
+	stringSet := bytes.Split(raw[formattedLen:], []byte{0})
+	if len(stringSet) < count {
+		return nil, fmt.Errorf("expected %d strings, found %d", count, len(stringSet))
+	}
+
+	oemStrings := make([]string, 0, count)
+	for _, s := range stringSet[:count] {
+		oemStrings = append(oemStrings, string(s))
+	}
+	return oemStrings, nil
+}
+
+// ReadHints returns the interface naming hints published through the SMBIOS OEM strings.
+func ReadHints(entriesDir string) ([]Hint, error) {
+	oemStrings, err := ReadOEMStrings(entriesDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var hints []Hint
+	for _, oemString := range oemStrings {
+		hint, ok, err := ParseOEMString(oemString)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			hints = append(hints, hint)
+		}
+	}
+	return hints, nil
+}
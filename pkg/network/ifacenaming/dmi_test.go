@@ -0,0 +1,81 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ifacenaming_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/network/ifacenaming"
+)
+
+var _ = Describe("DMI OEM strings", func() {
+	var entriesDir string
+
+	BeforeEach(func() {
+		entriesDir = GinkgoT().TempDir()
+	})
+
+	writeEntry := func(entry string, raw []byte) {
+		entryDir := filepath.Join(entriesDir, entry)
+		Expect(os.MkdirAll(entryDir, 0o755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(entryDir, "raw"), raw, 0o644)).To(Succeed())
+	}
+
+	oemStringsStructure := func(strs ...string) []byte {
+		raw := []byte{11, 5, 0x00, 0x01, byte(len(strs))}
+		for _, s := range strs {
+			raw = append(raw, append([]byte(s), 0)...)
+		}
+		return append(raw, 0)
+	}
+
+	It("reads the hints from the OEM strings structures", func() {
+		writeEntry("11-0", oemStringsStructure(
+			"vendor:some-data",
+			"kubevirt.io/interface:name=default,pci=0000:01:00.0",
+		))
+		writeEntry("11-1", oemStringsStructure("kubevirt.io/interface:name=blue,mac=02:00:00:00:00:02"))
+		writeEntry("1-0", []byte{1, 4, 0x00, 0x02, 0, 0})
+
+		hints, err := ifacenaming.ReadHints(entriesDir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hints).To(Equal([]ifacenaming.Hint{
+			{Name: "default", PCIAddress: "0000:01:00.0"},
+			{Name: "blue", MAC: "02:00:00:00:00:02"},
+		}))
+	})
+
+	It("reads no hints when there are no OEM strings", func() {
+		hints, err := ifacenaming.ReadHints(entriesDir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hints).To(BeEmpty())
+	})
+
+	It("fails on a truncated structure", func() {
+		writeEntry("11-0", []byte{11, 5, 0x00})
+
+		_, err := ifacenaming.ReadOEMStrings(entriesDir)
+		Expect(err).To(HaveOccurred())
+	})
+})
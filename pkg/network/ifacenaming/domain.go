@@ -0,0 +1,91 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ifacenaming
+
+import (
+	"strings"
+
+	"kubevirt.io/kubevirt/pkg/util/hardware"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// Enabled reports whether the VMI annotations request interface naming hints.
+func Enabled(annotations map[string]string) bool {
+	return strings.EqualFold(annotations[GuestInterfaceNamesAnnotation], "true")
+}
+
+// HintForDomainInterface builds the naming hint of a domain interface.
+// An interface without an alias, or without a PCI or MAC address to identify
+// it in the guest, yields no hint.
+func HintForDomainInterface(iface api.Interface) (Hint, bool) {
+	if iface.Alias == nil || !iface.Alias.IsUserDefined() {
+		return Hint{}, false
+	}
+
+	hint := Hint{Name: iface.Alias.GetName()}
+	if iface.Address != nil && iface.Address.Type == api.AddressPCI {
+		hint.PCIAddress = hardware.PCIAddressToString(iface.Address)
+	}
+	if iface.MAC != nil {
+		hint.MAC = iface.MAC.MAC
+	}
+	if hint.validate() != nil {
+		return Hint{}, false
+	}
+	return hint, true
+}
+
+// AddOEMStrings publishes the hints to the guest through the domain SMBIOS OEM strings.
+// A previously published hint of an interface with the same name is replaced.
+func AddOEMStrings(domainSpec *api.DomainSpec, hints ...Hint) {
+	if len(hints) == 0 {
+		return
+	}
+
+	if domainSpec.SysInfo == nil {
+		domainSpec.SysInfo = &api.SysInfo{Type: "smbios"}
+	}
+	if domainSpec.OS.SMBios == nil {
+		domainSpec.OS.SMBios = &api.SMBios{Mode: "sysinfo"}
+	}
+
+	names := map[string]struct{}{}
+	for _, hint := range hints {
+		names[hint.Name] = struct{}{}
+	}
+
+	if domainSpec.SysInfo.OEMStrings == nil {
+		domainSpec.SysInfo.OEMStrings = &api.OEMStrings{}
+	}
+
+	var oemStrings []string
+	for _, oemString := range domainSpec.SysInfo.OEMStrings.Entries {
+		if hint, ok, err := ParseOEMString(oemString); ok && err == nil {
+			if _, exists := names[hint.Name]; exists {
+				continue
+			}
+		}
+		oemStrings = append(oemStrings, oemString)
+	}
+	for _, hint := range hints {
+		oemStrings = append(oemStrings, hint.OEMString())
+	}
+	domainSpec.SysInfo.OEMStrings.Entries = oemStrings
+}
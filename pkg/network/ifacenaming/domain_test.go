@@ -0,0 +1,100 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ifacenaming_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/network/ifacenaming"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+var _ = Describe("Interface naming domain hints", func() {
+	DescribeTable("Enabled", func(annotations map[string]string, expected bool) {
+		Expect(ifacenaming.Enabled(annotations)).To(Equal(expected))
+	},
+		Entry("without annotations", nil, false),
+		Entry("with annotation set to true", map[string]string{ifacenaming.GuestInterfaceNamesAnnotation: "true"}, true),
+		Entry("with annotation set to false", map[string]string{ifacenaming.GuestInterfaceNamesAnnotation: "false"}, false),
+	)
+
+	Context("HintForDomainInterface", func() {
+		It("uses the PCI and MAC addresses of the interface", func() {
+			iface := api.Interface{
+				Alias: api.NewUserDefinedAlias("default"),
+				Address: &api.Address{
+					Type: api.AddressPCI, Domain: "0x0000", Bus: "0x01", Slot: "0x00", Function: "0x0",
+				},
+				MAC: &api.MAC{MAC: "02:00:00:00:00:01"},
+			}
+
+			hint, ok := ifacenaming.HintForDomainInterface(iface)
+			Expect(ok).To(BeTrue())
+			Expect(hint).To(Equal(ifacenaming.Hint{Name: "default", PCIAddress: "0000:01:00.0", MAC: "02:00:00:00:00:01"}))
+		})
+
+		It("yields no hint for an interface without addresses", func() {
+			_, ok := ifacenaming.HintForDomainInterface(api.Interface{Alias: api.NewUserDefinedAlias("default")})
+			Expect(ok).To(BeFalse())
+		})
+
+		It("yields no hint for an interface without a user defined alias", func() {
+			_, ok := ifacenaming.HintForDomainInterface(api.Interface{MAC: &api.MAC{MAC: "02:00:00:00:00:01"}})
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Context("AddOEMStrings", func() {
+		It("enables the SMBIOS sysinfo mode", func() {
+			domainSpec := &api.DomainSpec{}
+
+			ifacenaming.AddOEMStrings(domainSpec, ifacenaming.Hint{Name: "default", MAC: "02:00:00:00:00:01"})
+
+			Expect(domainSpec.OS.SMBios).To(Equal(&api.SMBios{Mode: "sysinfo"}))
+			Expect(domainSpec.SysInfo).To(Equal(&api.SysInfo{
+				Type:       "smbios",
+				OEMStrings: &api.OEMStrings{Entries: []string{"kubevirt.io/interface:name=default,mac=02:00:00:00:00:01"}},
+			}))
+		})
+
+		It("replaces the existing hint of the same interface and keeps other OEM strings", func() {
+			domainSpec := &api.DomainSpec{
+				OS: api.OS{SMBios: &api.SMBios{Mode: "sysinfo"}},
+				SysInfo: &api.SysInfo{
+					Type: "smbios",
+					OEMStrings: &api.OEMStrings{Entries: []string{
+						"vendor:some-data",
+						"kubevirt.io/interface:name=default,mac=02:00:00:00:00:01",
+						"kubevirt.io/interface:name=blue,mac=02:00:00:00:00:02",
+					}},
+				},
+			}
+
+			ifacenaming.AddOEMStrings(domainSpec, ifacenaming.Hint{Name: "default", PCIAddress: "0000:01:00.0"})
+
+			Expect(domainSpec.SysInfo.OEMStrings.Entries).To(Equal([]string{
+				"vendor:some-data",
+				"kubevirt.io/interface:name=blue,mac=02:00:00:00:00:02",
+				"kubevirt.io/interface:name=default,pci=0000:01:00.0",
+			}))
+		})
+	})
+})
@@ -0,0 +1,134 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Package ifacenaming carries VMI interface names to the guest through
+// SMBIOS OEM strings, so a guest-side generator can render udev rules that
+// give the guest interfaces the same names as the VMI interfaces.
+package ifacenaming
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+)
+
+const (
+	// GuestInterfaceNamesAnnotation opts a VMI into publishing interface naming hints to the guest.
+	GuestInterfaceNamesAnnotation = "network.kubevirt.io/guest-interface-names"
+
+	oemStringPrefix = "kubevirt.io/interface:"
+
+	keyName = "name"
+	keyPCI  = "pci"
+	keyMAC  = "mac"
+
+	// linuxIfaceNameMaxLen is IFNAMSIZ minus the terminating NUL.
+	linuxIfaceNameMaxLen = 15
+)
+
+// Hint maps a guest NIC, identified by its PCI address or MAC address, to a logical name.
+type Hint struct {
+	Name       string
+	PCIAddress string
+	MAC        string
+}
+
+// OEMString encodes the hint in the format carried by the SMBIOS type 11 structure.
+func (h Hint) OEMString() string {
+	fields := []string{keyName + "=" + h.Name}
+	if h.PCIAddress != "" {
+		fields = append(fields, keyPCI+"="+h.PCIAddress)
+	}
+	if h.MAC != "" {
+		fields = append(fields, keyMAC+"="+h.MAC)
+	}
+	return oemStringPrefix + strings.Join(fields, ",")
+}
+
+// ParseOEMString decodes an OEM string produced by Hint.OEMString.
+// OEM strings which are not interface naming hints are reported with ok set to false.
+func ParseOEMString(s string) (hint Hint, ok bool, err error) {
+	data, found := strings.CutPrefix(s, oemStringPrefix)
+	if !found {
+		return Hint{}, false, nil
+	}
+
+	for _, field := range strings.Split(data, ",") {
+		key, value, found := strings.Cut(field, "=")
+		if !found {
+			return Hint{}, true, fmt.Errorf("malformed field %q in interface naming hint %q", field, s)
+		}
+		switch key {
+		case keyName:
+			hint.Name = value
+		case keyPCI:
+			hint.PCIAddress = value
+		case keyMAC:
+			hint.MAC = value
+		}
+	}
+
+	if err := hint.validate(); err != nil {
+		return Hint{}, true, fmt.Errorf("invalid interface naming hint %q: %v", s, err)
+	}
+	return hint, true, nil
+}
+
+func (h Hint) validate() error {
+	if h.Name == "" {
+		return fmt.Errorf("name is missing")
+	}
+	if len(h.Name) > linuxIfaceNameMaxLen {
+		return fmt.Errorf("name %q is longer than %d characters", h.Name, linuxIfaceNameMaxLen)
+	}
+	if strings.ContainsAny(h.Name, "/:\" \t\n") || h.Name == "." || h.Name == ".." {
+		return fmt.Errorf("name %q is not a valid interface name", h.Name)
+	}
+	if h.PCIAddress == "" && h.MAC == "" {
+		return fmt.Errorf("neither PCI nor MAC address is set")
+	}
+	if h.PCIAddress != "" && strings.ContainsAny(h.PCIAddress, "\"\\ ") {
+		return fmt.Errorf("PCI address %q is not valid", h.PCIAddress)
+	}
+	if h.MAC != "" {
+		if _, err := net.ParseMAC(h.MAC); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UdevRules renders udev rules renaming the guest NICs according to the given hints.
+// A NIC is matched by its PCI address when known, otherwise by its MAC address.
+func UdevRules(hints []Hint) string {
+	sorted := append([]Hint(nil), hints...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+
+	var sb strings.Builder
+	sb.WriteString("# Generated from KubeVirt interface naming hints, do not edit.\n")
+	for _, hint := range sorted {
+		match := fmt.Sprintf("ATTR{address}==%q", strings.ToLower(hint.MAC))
+		if hint.PCIAddress != "" {
+			match = fmt.Sprintf("KERNELS==%q", hint.PCIAddress)
+		}
+		fmt.Fprintf(&sb, "SUBSYSTEM==\"net\", ACTION==\"add\", %s, NAME=%q\n", match, hint.Name)
+	}
+	return sb.String()
+}
@@ -0,0 +1,83 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ifacenaming_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/network/ifacenaming"
+)
+
+var _ = Describe("Interface naming hint", func() {
+	DescribeTable("round trips through an OEM string", func(hint ifacenaming.Hint, expectedOEMString string) {
+		Expect(hint.OEMString()).To(Equal(expectedOEMString))
+
+		parsedHint, ok, err := ifacenaming.ParseOEMString(expectedOEMString)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ok).To(BeTrue())
+		Expect(parsedHint).To(Equal(hint))
+	},
+		Entry("with PCI address",
+			ifacenaming.Hint{Name: "default", PCIAddress: "0000:01:00.0"},
+			"kubevirt.io/interface:name=default,pci=0000:01:00.0",
+		),
+		Entry("with MAC address",
+			ifacenaming.Hint{Name: "blue", MAC: "02:00:00:00:00:01"},
+			"kubevirt.io/interface:name=blue,mac=02:00:00:00:00:01",
+		),
+		Entry("with PCI and MAC addresses",
+			ifacenaming.Hint{Name: "red", PCIAddress: "0000:02:00.0", MAC: "02:00:00:00:00:02"},
+			"kubevirt.io/interface:name=red,pci=0000:02:00.0,mac=02:00:00:00:00:02",
+		),
+	)
+
+	It("ignores OEM strings which are not naming hints", func() {
+		_, ok, err := ifacenaming.ParseOEMString("vendor:some-data")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ok).To(BeFalse())
+	})
+
+	DescribeTable("rejects invalid hints", func(oemString string) {
+		_, ok, err := ifacenaming.ParseOEMString(oemString)
+		Expect(ok).To(BeTrue())
+		Expect(err).To(HaveOccurred())
+	},
+		Entry("malformed field", "kubevirt.io/interface:name=default,pci"),
+		Entry("missing name", "kubevirt.io/interface:pci=0000:01:00.0"),
+		Entry("name too long", "kubevirt.io/interface:name=averyveryverylongname,pci=0000:01:00.0"),
+		Entry("name with a slash", "kubevirt.io/interface:name=a/b,pci=0000:01:00.0"),
+		Entry("missing addresses", "kubevirt.io/interface:name=default"),
+		Entry("invalid MAC address", "kubevirt.io/interface:name=default,mac=02:00"),
+	)
+
+	It("renders udev rules sorted by name", func() {
+		hints := []ifacenaming.Hint{
+			{Name: "red", MAC: "02:00:00:00:00:0A"},
+			{Name: "blue", PCIAddress: "0000:01:00.0", MAC: "02:00:00:00:00:01"},
+		}
+
+		Expect(ifacenaming.UdevRules(hints)).To(Equal(
+			"# Generated from KubeVirt interface naming hints, do not edit.\n" +
+				`SUBSYSTEM=="net", ACTION=="add", KERNELS=="0000:01:00.0", NAME="blue"` + "\n" +
+				`SUBSYSTEM=="net", ACTION=="add", ATTR{address}=="02:00:00:00:00:0a", NAME="red"` + "\n",
+		))
+	})
+})
@@ -32,7 +32,7 @@ import (
 const tapNameForPrimaryIface = "tap0"
 
 func GenerateTapDeviceName(podInterfaceName string, network v1.Network) string {
-	if vmispec.IsSecondaryMultusNetwork(network) {
+	if vmispec.IsSecondaryNetwork(network) {
 		return "tap" + podInterfaceName[3:]
 	}
 
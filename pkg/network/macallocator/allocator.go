@@ -0,0 +1,117 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package macallocator
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// DefaultOUI is a locally administered unicast prefix, used when the policy does not specify one.
+const DefaultOUI = "02:6b:76"
+
+const (
+	ouiLength = 3
+	macLength = 6
+)
+
+// Allocator allocates the MAC addresses of VMI interfaces
+type Allocator interface {
+	// Allocate returns the MAC address allocated to each of the given interfaces of the VMI, by interface name.
+	// Interfaces missing from the result are left without a MAC address.
+	Allocate(vmi *v1.VirtualMachineInstance, ifaceNames []string) (map[string]string, error)
+}
+
+// New returns the allocator selected by the policy
+func New(policy *v1.MacGenerationPolicy) (Allocator, error) {
+	switch policy.Allocator {
+	case "", v1.MacAllocatorDeterministic:
+		return NewDeterministicAllocator(policy.OUI)
+	case v1.MacAllocatorRandom:
+		return NewRandomAllocator(policy.OUI)
+	case v1.MacAllocatorExternal:
+		if policy.External == nil || policy.External.WebhookURL == "" {
+			return nil, errors.New("the external MAC allocator requires a webhook URL")
+		}
+		return NewExternalAllocator(policy.External.WebhookURL), nil
+	}
+	return nil, fmt.Errorf("unsupported MAC allocator %q", policy.Allocator)
+}
+
+// AllocateMACAddresses sets the MAC addresses given by the allocator on the VMI interfaces which do not specify one
+func AllocateMACAddresses(allocator Allocator, vmi *v1.VirtualMachineInstance) error {
+	return AllocateInterfacesMACAddresses(allocator, vmi, func(v1.Interface) bool { return true })
+}
+
+// AllocateInterfacesMACAddresses sets the MAC addresses given by the allocator on the VMI interfaces selected by the
+// predicate which do not specify one
+func AllocateInterfacesMACAddresses(allocator Allocator, vmi *v1.VirtualMachineInstance, predicate func(v1.Interface) bool) error {
+	var ifaceNames []string
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		if iface.MacAddress == "" && predicate(iface) {
+			ifaceNames = append(ifaceNames, iface.Name)
+		}
+	}
+	if len(ifaceNames) == 0 {
+		return nil
+	}
+
+	macAddresses, err := allocator.Allocate(vmi, ifaceNames)
+	if err != nil {
+		return fmt.Errorf("failed to allocate MAC addresses: %v", err)
+	}
+
+	for i := range vmi.Spec.Domain.Devices.Interfaces {
+		iface := &vmi.Spec.Domain.Devices.Interfaces[i]
+		macAddress, allocated := macAddresses[iface.Name]
+		if iface.MacAddress != "" || !allocated {
+			continue
+		}
+		if mac, err := net.ParseMAC(macAddress); err != nil || len(mac) != macLength {
+			return fmt.Errorf("invalid MAC address %q allocated to interface %s", macAddress, iface.Name)
+		}
+		iface.MacAddress = macAddress
+	}
+	return nil
+}
+
+// ValidateOUI checks the OUI is formatted as "xx:xx:xx" and represents a unicast address.
+func ValidateOUI(oui string) error {
+	_, err := parseOUI(oui)
+	return err
+}
+
+func parseOUI(oui string) (net.HardwareAddr, error) {
+	if oui == "" {
+		oui = DefaultOUI
+	}
+	mac, err := net.ParseMAC(oui + ":00:00:00")
+	if err != nil || len(mac) != macLength {
+		return nil, fmt.Errorf("invalid OUI %q, expected format is xx:xx:xx", oui)
+	}
+	const multicastBit = 0x01
+	if mac[0]&multicastBit != 0 {
+		return nil, fmt.Errorf("invalid OUI %q, it must represent a unicast address", oui)
+	}
+	return mac[:ouiLength], nil
+}
@@ -0,0 +1,123 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package macallocator_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/macallocator"
+)
+
+type stubAllocator struct {
+	macAddresses map[string]string
+	err          error
+	ifaceNames   []string
+}
+
+func (a *stubAllocator) Allocate(_ *v1.VirtualMachineInstance, ifaceNames []string) (map[string]string, error) {
+	a.ifaceNames = ifaceNames
+	return a.macAddresses, a.err
+}
+
+var _ = Describe("MAC address allocation", func() {
+	DescribeTable("should select the allocator of the policy", func(policy *v1.MacGenerationPolicy, expectedAllocator macallocator.Allocator) {
+		allocator, err := macallocator.New(policy)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allocator).To(BeAssignableToTypeOf(expectedAllocator))
+	},
+		Entry("deterministic by default", &v1.MacGenerationPolicy{}, &macallocator.DeterministicAllocator{}),
+		Entry("deterministic",
+			&v1.MacGenerationPolicy{Allocator: v1.MacAllocatorDeterministic}, &macallocator.DeterministicAllocator{}),
+		Entry("random", &v1.MacGenerationPolicy{Allocator: v1.MacAllocatorRandom}, &macallocator.RandomAllocator{}),
+		Entry("external", &v1.MacGenerationPolicy{
+			Allocator: v1.MacAllocatorExternal,
+			External:  &v1.ExternalMacAllocator{WebhookURL: "http://allocator.example"},
+		}, &macallocator.ExternalAllocator{}),
+	)
+
+	DescribeTable("should fail to create the allocator", func(policy *v1.MacGenerationPolicy) {
+		_, err := macallocator.New(policy)
+		Expect(err).To(HaveOccurred())
+	},
+		Entry("with an unsupported allocator", &v1.MacGenerationPolicy{Allocator: "Sequential"}),
+		Entry("with an invalid OUI", &v1.MacGenerationPolicy{Allocator: v1.MacAllocatorRandom, OUI: "01:00:5e"}),
+		Entry("with the external allocator without a webhook", &v1.MacGenerationPolicy{Allocator: v1.MacAllocatorExternal}),
+	)
+
+	It("should only request MAC addresses for the interfaces without one", func() {
+		vmi := newVMI(vmUID)
+		vmi.Spec.Domain.Devices.Interfaces[0].MacAddress = customMAC
+		allocator := &stubAllocator{macAddresses: map[string]string{net1: "02:00:00:00:00:0a", net2: "02:00:00:00:00:0b"}}
+
+		Expect(macallocator.AllocateMACAddresses(allocator, vmi)).To(Succeed())
+
+		Expect(allocator.ifaceNames).To(Equal([]string{net2}))
+		Expect(macAddresses(vmi)).To(Equal([]string{customMAC, "02:00:00:00:00:0b"}))
+	})
+
+	It("should not call the allocator when every interface has a MAC address", func() {
+		vmi := newVMI(vmUID)
+		for i := range vmi.Spec.Domain.Devices.Interfaces {
+			vmi.Spec.Domain.Devices.Interfaces[i].MacAddress = customMAC
+		}
+		allocator := &stubAllocator{err: errors.New("unexpected call")}
+
+		Expect(macallocator.AllocateMACAddresses(allocator, vmi)).To(Succeed())
+		Expect(allocator.ifaceNames).To(BeNil())
+	})
+
+	It("should leave the interfaces the allocator did not allocate a MAC address to", func() {
+		vmi := newVMI(vmUID)
+		allocator := &stubAllocator{macAddresses: map[string]string{net1: "02:00:00:00:00:0a"}}
+
+		Expect(macallocator.AllocateMACAddresses(allocator, vmi)).To(Succeed())
+		Expect(macAddresses(vmi)).To(Equal([]string{"02:00:00:00:00:0a", ""}))
+	})
+
+	It("should fail when the allocator fails", func() {
+		allocator := &stubAllocator{err: errors.New("pool exhausted")}
+		Expect(macallocator.AllocateMACAddresses(allocator, newVMI(vmUID))).To(
+			MatchError("failed to allocate MAC addresses: pool exhausted"))
+	})
+
+	It("should reject an invalid MAC address given by the allocator", func() {
+		allocator := &stubAllocator{macAddresses: map[string]string{net1: "02:00:00:00:00:00:00:00"}}
+		Expect(macallocator.AllocateMACAddresses(allocator, newVMI(vmUID))).To(
+			MatchError(`invalid MAC address "02:00:00:00:00:00:00:00" allocated to interface net1`))
+	})
+
+	Context("at random", func() {
+		It("should allocate a distinct MAC address under the OUI to every interface", func() {
+			allocator, err := macallocator.NewRandomAllocator("0a:bc:de")
+			Expect(err).NotTo(HaveOccurred())
+			vmi := newVMI(vmUID)
+
+			Expect(macallocator.AllocateMACAddresses(allocator, vmi)).To(Succeed())
+
+			Expect(macAddresses(vmi)).To(HaveEach(HavePrefix("0a:bc:de:")))
+			Expect(vmi.Spec.Domain.Devices.Interfaces[0].MacAddress).NotTo(Equal(vmi.Spec.Domain.Devices.Interfaces[1].MacAddress))
+		})
+	})
+})
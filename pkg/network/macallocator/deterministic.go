@@ -0,0 +1,76 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package macallocator
+
+import (
+	"crypto/sha256"
+	"net"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// DeterministicAllocator derives the MAC addresses from the VMI owner and the interface network name.
+// The MAC addresses of a VMI owned by a VM are kept across restarts, as they are derived from the VM UID.
+type DeterministicAllocator struct {
+	prefix net.HardwareAddr
+}
+
+func NewDeterministicAllocator(oui string) (*DeterministicAllocator, error) {
+	prefix, err := parseOUI(oui)
+	if err != nil {
+		return nil, err
+	}
+	return &DeterministicAllocator{prefix: prefix}, nil
+}
+
+// Allocate allocates nothing when the VMI has no stable identity yet
+func (a *DeterministicAllocator) Allocate(vmi *v1.VirtualMachineInstance, ifaceNames []string) (map[string]string, error) {
+	seed := macGenerationSeed(vmi)
+	if seed == "" {
+		return nil, nil
+	}
+
+	macAddresses := make(map[string]string, len(ifaceNames))
+	for _, ifaceName := range ifaceNames {
+		macAddresses[ifaceName] = a.generateMACAddress(seed, ifaceName)
+	}
+	return macAddresses, nil
+}
+
+func (a *DeterministicAllocator) generateMACAddress(seed, networkName string) string {
+	hash := sha256.Sum256([]byte(seed + "/" + networkName))
+	mac := make(net.HardwareAddr, 0, macLength)
+	mac = append(mac, a.prefix...)
+	mac = append(mac, hash[:macLength-ouiLength]...)
+	return mac.String()
+}
+
+// macGenerationSeed returns the owner VM UID, falling back to the VMI namespaced name.
+func macGenerationSeed(vmi *v1.VirtualMachineInstance) string {
+	if owner := metav1.GetControllerOf(vmi); owner != nil && owner.Kind == v1.VirtualMachineGroupVersionKind.Kind {
+		return string(owner.UID)
+	}
+	if vmi.Name == "" {
+		return ""
+	}
+	return vmi.Namespace + "/" + vmi.Name
+}
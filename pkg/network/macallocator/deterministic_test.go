@@ -0,0 +1,140 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package macallocator_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/network/macallocator"
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+const (
+	net1      = "net1"
+	net2      = "net2"
+	customMAC = "02:00:00:00:00:01"
+	vmUID     = "f7b5b2d0-0a57-4b8b-9f43-2f2d6c2ad1f3"
+)
+
+func newVMI(ownerUID string, opts ...libvmi.Option) *v1.VirtualMachineInstance {
+	opts = append([]libvmi.Option{
+		libvmi.WithInterface(libvmi.InterfaceDeviceWithBridgeBinding(net1)),
+		libvmi.WithNetwork(libvmi.MultusNetwork(net1, "nad1")),
+		libvmi.WithInterface(libvmi.InterfaceDeviceWithBridgeBinding(net2)),
+		libvmi.WithNetwork(libvmi.MultusNetwork(net2, "nad2")),
+	}, opts...)
+	vmi := libvmi.New(opts...)
+	if ownerUID != "" {
+		vmi.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: v1.VirtualMachineGroupVersionKind.GroupVersion().String(),
+			Kind:       v1.VirtualMachineGroupVersionKind.Kind,
+			Name:       "vm",
+			UID:        types.UID(ownerUID),
+			Controller: pointer.P(true),
+		}}
+	}
+	return vmi
+}
+
+func macAddresses(vmi *v1.VirtualMachineInstance) []string {
+	var macs []string
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		macs = append(macs, iface.MacAddress)
+	}
+	return macs
+}
+
+var _ = Describe("Deterministic MAC address allocation", func() {
+	const anotherUID = "0d4d3bb3-3aa6-4b4b-8a7c-8a1c0b9d4c11"
+
+	allocate := func(oui string, vmi *v1.VirtualMachineInstance) error {
+		allocator, err := macallocator.NewDeterministicAllocator(oui)
+		if err != nil {
+			return err
+		}
+		return macallocator.AllocateMACAddresses(allocator, vmi)
+	}
+
+	It("should generate the same MAC addresses for VMIs of the same VM", func() {
+		vmi := newVMI(vmUID, libvmi.WithName("vmi-a"))
+		restartedVMI := newVMI(vmUID, libvmi.WithName("vmi-b"))
+
+		Expect(allocate("", vmi)).To(Succeed())
+		Expect(allocate("", restartedVMI)).To(Succeed())
+
+		Expect(macAddresses(vmi)).To(Equal(macAddresses(restartedVMI)))
+		Expect(macAddresses(vmi)).To(HaveEach(HavePrefix(macallocator.DefaultOUI + ":")))
+		Expect(vmi.Spec.Domain.Devices.Interfaces[0].MacAddress).NotTo(Equal(vmi.Spec.Domain.Devices.Interfaces[1].MacAddress))
+	})
+
+	It("should generate different MAC addresses for different VMs", func() {
+		vmi := newVMI(vmUID)
+		anotherVMI := newVMI(anotherUID)
+
+		Expect(allocate("", vmi)).To(Succeed())
+		Expect(allocate("", anotherVMI)).To(Succeed())
+
+		Expect(macAddresses(vmi)).NotTo(Equal(macAddresses(anotherVMI)))
+	})
+
+	It("should use the configured OUI and keep explicitly set MAC addresses", func() {
+		vmi := newVMI(vmUID)
+		vmi.Spec.Domain.Devices.Interfaces[0].MacAddress = customMAC
+
+		Expect(allocate("0a:bc:de", vmi)).To(Succeed())
+
+		Expect(vmi.Spec.Domain.Devices.Interfaces[0].MacAddress).To(Equal(customMAC))
+		Expect(vmi.Spec.Domain.Devices.Interfaces[1].MacAddress).To(HavePrefix("0a:bc:de:"))
+	})
+
+	It("should derive the MAC addresses of a standalone VMI from its namespaced name", func() {
+		vmi := newVMI("", libvmi.WithName("vmi"), libvmi.WithNamespace("ns"))
+		sameVMI := newVMI("", libvmi.WithName("vmi"), libvmi.WithNamespace("ns"))
+
+		Expect(allocate("", vmi)).To(Succeed())
+		Expect(allocate("", sameVMI)).To(Succeed())
+
+		Expect(macAddresses(vmi)).To(HaveEach(Not(BeEmpty())))
+		Expect(macAddresses(vmi)).To(Equal(macAddresses(sameVMI)))
+	})
+
+	It("should not set MAC addresses on a standalone VMI without a name", func() {
+		vmi := newVMI("")
+		vmi.Name = ""
+		Expect(allocate("", vmi)).To(Succeed())
+		Expect(macAddresses(vmi)).To(HaveEach(BeEmpty()))
+	})
+
+	DescribeTable("should reject an invalid OUI", func(oui string) {
+		Expect(macallocator.ValidateOUI(oui)).NotTo(Succeed())
+		Expect(allocate(oui, newVMI(vmUID))).NotTo(Succeed())
+	},
+		Entry("with a wrong format", "02-6b-76"),
+		Entry("with too many octets", "02:6b:76:01"),
+		Entry("with a multicast address", "01:00:5e"),
+	)
+})
@@ -0,0 +1,102 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package macallocator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const defaultWebhookTimeout = 10 * time.Second
+
+// AllocationRequest is posted to the webhook of the external allocator
+type AllocationRequest struct {
+	Namespace string `json:"namespace"`
+	VMIName   string `json:"vmiName"`
+	// VMName and VMUID identify the VM owning the VMI, if any
+	VMName string `json:"vmName,omitempty"`
+	VMUID  string `json:"vmUID,omitempty"`
+	// Interfaces lists the names of the interfaces which need a MAC address
+	Interfaces []string `json:"interfaces"`
+}
+
+// AllocationResponse is the response of the webhook of the external allocator
+type AllocationResponse struct {
+	// MACAddresses holds the allocated MAC address of each interface, by interface name
+	MACAddresses map[string]string `json:"macAddresses"`
+}
+
+// ExternalAllocator requests the MAC addresses from a webhook, to integrate with MAC pool managers
+type ExternalAllocator struct {
+	client     *http.Client
+	webhookURL string
+}
+
+func NewExternalAllocator(webhookURL string) *ExternalAllocator {
+	return &ExternalAllocator{
+		client:     &http.Client{Timeout: defaultWebhookTimeout},
+		webhookURL: webhookURL,
+	}
+}
+
+func (a *ExternalAllocator) Allocate(vmi *v1.VirtualMachineInstance, ifaceNames []string) (map[string]string, error) {
+	allocationRequest := AllocationRequest{
+		Namespace:  vmi.Namespace,
+		VMIName:    vmi.Name,
+		Interfaces: ifaceNames,
+	}
+	if owner := metav1.GetControllerOf(vmi); owner != nil && owner.Kind == v1.VirtualMachineGroupVersionKind.Kind {
+		allocationRequest.VMName = owner.Name
+		allocationRequest.VMUID = string(owner.UID)
+	}
+	body, err := json.Marshal(allocationRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal allocation request: %v", err)
+	}
+
+	request, err := http.NewRequestWithContext(context.Background(), http.MethodPost, a.webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %v", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := a.client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to post allocation request: %v", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return nil, fmt.Errorf("webhook responded with status %d", response.StatusCode)
+	}
+
+	var allocationResponse AllocationResponse
+	if err := json.NewDecoder(response.Body).Decode(&allocationResponse); err != nil {
+		return nil, fmt.Errorf("failed to decode allocation response: %v", err)
+	}
+	return allocationResponse.MACAddresses, nil
+}
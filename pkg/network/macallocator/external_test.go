@@ -0,0 +1,68 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package macallocator_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/network/macallocator"
+)
+
+var _ = Describe("External MAC address allocation", func() {
+	It("should request the MAC addresses of the interfaces from the webhook", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			Expect(r.Method).To(Equal(http.MethodPost))
+			var request macallocator.AllocationRequest
+			Expect(json.NewDecoder(r.Body).Decode(&request)).To(Succeed())
+			Expect(request).To(Equal(macallocator.AllocationRequest{
+				Namespace:  "ns",
+				VMIName:    "vmi",
+				VMName:     "vm",
+				VMUID:      vmUID,
+				Interfaces: []string{net1, net2},
+			}))
+			Expect(json.NewEncoder(w).Encode(macallocator.AllocationResponse{
+				MACAddresses: map[string]string{net1: "02:00:00:00:00:0a", net2: "02:00:00:00:00:0b"},
+			})).To(Succeed())
+		}))
+		defer server.Close()
+
+		vmi := newVMI(vmUID, libvmi.WithName("vmi"), libvmi.WithNamespace("ns"))
+		Expect(macallocator.AllocateMACAddresses(macallocator.NewExternalAllocator(server.URL), vmi)).To(Succeed())
+		Expect(macAddresses(vmi)).To(Equal([]string{"02:00:00:00:00:0a", "02:00:00:00:00:0b"}))
+	})
+
+	It("should fail when the webhook rejects the request", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		_, err := macallocator.NewExternalAllocator(server.URL).Allocate(newVMI(vmUID), []string{net1})
+		Expect(err).To(MatchError("webhook responded with status 503"))
+	})
+})
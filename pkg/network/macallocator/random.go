@@ -0,0 +1,56 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package macallocator
+
+import (
+	"crypto/rand"
+	"fmt"
+	"net"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// RandomAllocator picks the MAC addresses at random, under the configured OUI
+type RandomAllocator struct {
+	prefix net.HardwareAddr
+}
+
+func NewRandomAllocator(oui string) (*RandomAllocator, error) {
+	prefix, err := parseOUI(oui)
+	if err != nil {
+		return nil, err
+	}
+	return &RandomAllocator{prefix: prefix}, nil
+}
+
+func (a *RandomAllocator) Allocate(_ *v1.VirtualMachineInstance, ifaceNames []string) (map[string]string, error) {
+	macAddresses := make(map[string]string, len(ifaceNames))
+	for _, ifaceName := range ifaceNames {
+		suffix := make([]byte, macLength-ouiLength)
+		if _, err := rand.Read(suffix); err != nil {
+			return nil, fmt.Errorf("failed to generate a random MAC address: %v", err)
+		}
+		mac := make(net.HardwareAddr, 0, macLength)
+		mac = append(mac, a.prefix...)
+		mac = append(mac, suffix...)
+		macAddresses[ifaceName] = mac.String()
+	}
+	return macAddresses, nil
+}
@@ -0,0 +1,220 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package metadataservice
+
+import (
+	"encoding/json"
+	"maps"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	// ServiceIPv4 is the link-local address the metadata service is reachable at from the guest.
+	ServiceIPv4 = "169.254.169.254"
+	ServicePort = 80
+
+	// DataAnnotationPrefix prefixes the VMI annotations which are served to the guest as user data.
+	DataAnnotationPrefix = "metadata.kubevirt.io/"
+)
+
+type Instance struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	UID       string `json:"uid"`
+	Hostname  string `json:"hostname"`
+	Subdomain string `json:"subdomain,omitempty"`
+}
+
+type NetworkInterface struct {
+	Name string   `json:"name"`
+	MAC  string   `json:"mac,omitempty"`
+	IPs  []string `json:"ips,omitempty"`
+}
+
+type Network struct {
+	Interfaces []NetworkInterface `json:"interfaces"`
+}
+
+// Server serves the metadata of the last VMI it was updated with.
+// The data revision is bumped on every update that changes the user data, so guest agents
+// polling it can re-apply their configuration without a reboot.
+type Server struct {
+	lock     sync.RWMutex
+	vmi      *v1.VirtualMachineInstance
+	revision uint64
+	mux      *http.ServeMux
+}
+
+func NewServer() *Server {
+	s := &Server{mux: http.NewServeMux()}
+	s.mux.HandleFunc("GET /v1/instance", s.serveInstance)
+	s.mux.HandleFunc("GET /v1/network", s.serveNetwork)
+	s.mux.HandleFunc("GET /v1/data", s.serveData)
+	s.mux.HandleFunc("GET /v1/data/{key}", s.serveDataKey)
+	s.mux.HandleFunc("GET /v1/revision", s.serveRevision)
+	return s
+}
+
+func (s *Server) Update(vmi *v1.VirtualMachineInstance) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.vmi == nil || !maps.Equal(userData(s.vmi), userData(vmi)) {
+		s.revision++
+	}
+	s.vmi = vmi.DeepCopy()
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) currentVMI() *v1.VirtualMachineInstance {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.vmi
+}
+
+func (s *Server) revisionSnapshot() (*v1.VirtualMachineInstance, uint64) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.vmi, s.revision
+}
+
+func (s *Server) serveInstance(w http.ResponseWriter, _ *http.Request) {
+	vmi := s.currentVMI()
+	if vmi == nil {
+		http.Error(w, "metadata is not available yet", http.StatusServiceUnavailable)
+		return
+	}
+	writeJSON(w, instance(vmi))
+}
+
+func (s *Server) serveNetwork(w http.ResponseWriter, _ *http.Request) {
+	vmi := s.currentVMI()
+	if vmi == nil {
+		http.Error(w, "metadata is not available yet", http.StatusServiceUnavailable)
+		return
+	}
+	writeJSON(w, network(vmi))
+}
+
+func (s *Server) serveData(w http.ResponseWriter, _ *http.Request) {
+	vmi := s.currentVMI()
+	if vmi == nil {
+		http.Error(w, "metadata is not available yet", http.StatusServiceUnavailable)
+		return
+	}
+	writeJSON(w, userData(vmi))
+}
+
+func (s *Server) serveRevision(w http.ResponseWriter, _ *http.Request) {
+	vmi, revision := s.revisionSnapshot()
+	if vmi == nil {
+		http.Error(w, "metadata is not available yet", http.StatusServiceUnavailable)
+		return
+	}
+	writeText(w, strconv.FormatUint(revision, 10))
+}
+
+func (s *Server) serveDataKey(w http.ResponseWriter, r *http.Request) {
+	vmi := s.currentVMI()
+	if vmi == nil {
+		http.Error(w, "metadata is not available yet", http.StatusServiceUnavailable)
+		return
+	}
+	value, exists := userData(vmi)[r.PathValue("key")]
+	if !exists {
+		http.NotFound(w, r)
+		return
+	}
+	writeText(w, value)
+}
+
+func writeText(w http.ResponseWriter, text string) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if _, err := w.Write([]byte(text)); err != nil {
+		log.Log.Reason(err).Warning("failed to write the metadata service response")
+	}
+}
+
+func writeJSON(w http.ResponseWriter, data any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		log.Log.Reason(err).Warning("failed to write the metadata service response")
+	}
+}
+
+func instance(vmi *v1.VirtualMachineInstance) Instance {
+	hostname := vmi.Spec.Hostname
+	if hostname == "" {
+		hostname = vmi.Name
+	}
+	return Instance{
+		Name:      vmi.Name,
+		Namespace: vmi.Namespace,
+		UID:       string(vmi.UID),
+		Hostname:  hostname,
+		Subdomain: vmi.Spec.Subdomain,
+	}
+}
+
+// network reports the interfaces of the VMI spec, with the addresses reported in the VMI status.
+func network(vmi *v1.VirtualMachineInstance) Network {
+	ifaceStatusByName := map[string]v1.VirtualMachineInstanceNetworkInterface{}
+	for _, ifaceStatus := range vmi.Status.Interfaces {
+		ifaceStatusByName[ifaceStatus.Name] = ifaceStatus
+	}
+
+	ifaces := make([]NetworkInterface, 0, len(vmi.Spec.Domain.Devices.Interfaces))
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		if iface.State == v1.InterfaceStateAbsent {
+			continue
+		}
+		networkIface := NetworkInterface{Name: iface.Name, MAC: iface.MacAddress}
+		if ifaceStatus, exists := ifaceStatusByName[iface.Name]; exists {
+			if ifaceStatus.MAC != "" {
+				networkIface.MAC = ifaceStatus.MAC
+			}
+			networkIface.IPs = ifaceStatus.IPs
+		}
+		ifaces = append(ifaces, networkIface)
+	}
+	return Network{Interfaces: ifaces}
+}
+
+// userData merges the data of the VMI spec with the prefixed VMI annotations, the latter taking precedence.
+func userData(vmi *v1.VirtualMachineInstance) map[string]string {
+	data := map[string]string{}
+	if vmi.Spec.MetadataService != nil {
+		maps.Copy(data, vmi.Spec.MetadataService.Data)
+	}
+	for annotation, value := range vmi.Annotations {
+		if key, found := strings.CutPrefix(annotation, DataAnnotationPrefix); found && key != "" {
+			data[key] = value
+		}
+	}
+	return data
+}
@@ -0,0 +1,195 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package metadataservice_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/metadataservice"
+)
+
+var _ = Describe("Metadata server", func() {
+	var server *metadataservice.Server
+
+	BeforeEach(func() {
+		server = metadataservice.NewServer()
+	})
+
+	get := func(path string) *http.Response {
+		recorder := httptest.NewRecorder()
+		server.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+		return recorder.Result()
+	}
+
+	decode := func(resp *http.Response, into any) {
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(resp.Header.Get("Content-Type")).To(Equal("application/json"))
+		Expect(json.NewDecoder(resp.Body).Decode(into)).To(Succeed())
+	}
+
+	newVMI := func() *v1.VirtualMachineInstance {
+		vmi := &v1.VirtualMachineInstance{
+			ObjectMeta: k8smetav1.ObjectMeta{Name: "testvmi", Namespace: "default", UID: "1234"},
+			Spec: v1.VirtualMachineInstanceSpec{
+				MetadataService: &v1.MetadataService{Data: map[string]string{"role": "db", "tier": "backend"}},
+			},
+		}
+		vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{
+			{Name: "default", MacAddress: "02:00:00:00:00:01"},
+			{Name: "blue"},
+			{Name: "red", State: v1.InterfaceStateAbsent},
+		}
+		vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{
+			{Name: "default", MAC: "02:00:00:00:00:01", IPs: []string{"10.244.0.10", "fd10:244::10"}},
+			{Name: "blue", MAC: "02:00:00:00:00:02"},
+		}
+		return vmi
+	}
+
+	It("should be unavailable until it is updated with a VMI", func() {
+		for _, path := range []string{"/v1/instance", "/v1/network", "/v1/data", "/v1/data/role", "/v1/revision"} {
+			resp := get(path)
+			resp.Body.Close()
+			Expect(resp.StatusCode).To(Equal(http.StatusServiceUnavailable), path)
+		}
+	})
+
+	It("should serve the instance identity", func() {
+		server.Update(newVMI())
+
+		var instance metadataservice.Instance
+		decode(get("/v1/instance"), &instance)
+		Expect(instance).To(Equal(metadataservice.Instance{
+			Name: "testvmi", Namespace: "default", UID: "1234", Hostname: "testvmi",
+		}))
+	})
+
+	It("should serve the VMI hostname and subdomain", func() {
+		vmi := newVMI()
+		vmi.Spec.Hostname = "db-0"
+		vmi.Spec.Subdomain = "db"
+		server.Update(vmi)
+
+		var instance metadataservice.Instance
+		decode(get("/v1/instance"), &instance)
+		Expect(instance.Hostname).To(Equal("db-0"))
+		Expect(instance.Subdomain).To(Equal("db"))
+	})
+
+	It("should serve the network configuration of the non-absent interfaces", func() {
+		server.Update(newVMI())
+
+		var network metadataservice.Network
+		decode(get("/v1/network"), &network)
+		Expect(network.Interfaces).To(Equal([]metadataservice.NetworkInterface{
+			{Name: "default", MAC: "02:00:00:00:00:01", IPs: []string{"10.244.0.10", "fd10:244::10"}},
+			{Name: "blue", MAC: "02:00:00:00:00:02"},
+		}))
+	})
+
+	It("should serve the user data overridden by the prefixed annotations", func() {
+		vmi := newVMI()
+		vmi.Annotations = map[string]string{
+			metadataservice.DataAnnotationPrefix + "tier":  "frontend",
+			metadataservice.DataAnnotationPrefix + "owner": "team-a",
+			metadataservice.DataAnnotationPrefix:           "ignored",
+			"kubevirt.io/unrelated":                        "ignored",
+		}
+		server.Update(vmi)
+
+		var data map[string]string
+		decode(get("/v1/data"), &data)
+		Expect(data).To(Equal(map[string]string{"role": "db", "tier": "frontend", "owner": "team-a"}))
+	})
+
+	It("should serve a single user data value as plain text", func() {
+		server.Update(newVMI())
+
+		resp := get("/v1/data/role")
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		body, err := io.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(body)).To(Equal("db"))
+	})
+
+	It("should not find a missing user data key", func() {
+		server.Update(newVMI())
+
+		resp := get("/v1/data/missing")
+		resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+	})
+
+	It("should serve the updates of the VMI after boot", func() {
+		vmi := newVMI()
+		server.Update(vmi)
+
+		updatedVMI := vmi.DeepCopy()
+		updatedVMI.Annotations = map[string]string{metadataservice.DataAnnotationPrefix + "role": "replica"}
+		server.Update(updatedVMI)
+
+		var data map[string]string
+		decode(get("/v1/data"), &data)
+		Expect(data).To(HaveKeyWithValue("role", "replica"))
+	})
+
+	It("should bump the data revision only when the user data changes", func() {
+		revision := func() string {
+			resp := get("/v1/revision")
+			defer resp.Body.Close()
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			body, err := io.ReadAll(resp.Body)
+			Expect(err).ToNot(HaveOccurred())
+			return string(body)
+		}
+
+		vmi := newVMI()
+		server.Update(vmi)
+		Expect(revision()).To(Equal("1"))
+
+		vmi.Status.Interfaces = nil
+		server.Update(vmi)
+		Expect(revision()).To(Equal("1"))
+
+		vmi.Spec.MetadataService.Data["role"] = "replica"
+		server.Update(vmi)
+		Expect(revision()).To(Equal("2"))
+	})
+
+	It("should only serve GET requests", func() {
+		server.Update(newVMI())
+
+		recorder := httptest.NewRecorder()
+		server.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/v1/data", nil))
+		Expect(recorder.Code).To(Equal(http.StatusMethodNotAllowed))
+	})
+})
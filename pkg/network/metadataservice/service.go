@@ -0,0 +1,91 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package metadataservice
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+const readHeaderTimeout = 10 * time.Second
+
+type listenFunc func(network, address string) (net.Listener, error)
+
+// Service serves the metadata of a VMI from the virt-launcher pod.
+// The address it listens on is configured on the masquerade bridge by virt-handler.
+type Service struct {
+	server  *Server
+	listen  listenFunc
+	started bool
+}
+
+type option func(*Service)
+
+func New(opts ...option) *Service {
+	s := &Service{
+		server: NewServer(),
+		listen: net.Listen,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+func WithListener(listen listenFunc) option {
+	return func(s *Service) {
+		s.listen = listen
+	}
+}
+
+// Sync updates the served metadata and starts serving it on the first sync of a VMI enabling the service.
+// It is not safe for concurrent use.
+func (s *Service) Sync(vmi *v1.VirtualMachineInstance) error {
+	if vmi.Spec.MetadataService == nil {
+		return nil
+	}
+	s.server.Update(vmi)
+	if s.started {
+		return nil
+	}
+
+	address := net.JoinHostPort(ServiceIPv4, strconv.Itoa(ServicePort))
+	listener, err := s.listen("tcp4", address)
+	if err != nil {
+		return fmt.Errorf("failed to listen on the metadata service address %s: %w", address, err)
+	}
+	s.started = true
+
+	httpServer := &http.Server{Handler: s.server, ReadHeaderTimeout: readHeaderTimeout}
+	go func() {
+		if err := httpServer.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Log.Object(vmi).Reason(err).Error("the metadata service stopped serving")
+		}
+	}()
+	log.Log.Object(vmi).Infof("Serving the metadata service on %s", address)
+	return nil
+}
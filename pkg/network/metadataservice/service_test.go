@@ -0,0 +1,99 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package metadataservice_test
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/metadataservice"
+)
+
+var _ = Describe("Metadata service", func() {
+	var (
+		listenCalls     int
+		listenedAddress string
+		listener        net.Listener
+		service         *metadataservice.Service
+	)
+
+	BeforeEach(func() {
+		listenCalls = 0
+		listenedAddress = ""
+		listener = nil
+		service = metadataservice.New(metadataservice.WithListener(func(_, address string) (net.Listener, error) {
+			listenCalls++
+			listenedAddress = address
+			var err error
+			listener, err = net.Listen("tcp4", "127.0.0.1:0")
+			return listener, err
+		}))
+		DeferCleanup(func() {
+			if listener != nil {
+				listener.Close()
+			}
+		})
+	})
+
+	It("should not serve VMIs which do not enable the metadata service", func() {
+		Expect(service.Sync(&v1.VirtualMachineInstance{})).To(Succeed())
+		Expect(listenCalls).To(BeZero())
+	})
+
+	It("should serve the synced VMI on the metadata service address once", func() {
+		vmi := &v1.VirtualMachineInstance{Spec: v1.VirtualMachineInstanceSpec{
+			MetadataService: &v1.MetadataService{Data: map[string]string{"role": "db"}},
+		}}
+		Expect(service.Sync(vmi)).To(Succeed())
+
+		updatedVMI := vmi.DeepCopy()
+		updatedVMI.Annotations = map[string]string{metadataservice.DataAnnotationPrefix + "role": "replica"}
+		Expect(service.Sync(updatedVMI)).To(Succeed())
+
+		Expect(listenCalls).To(Equal(1))
+		Expect(listenedAddress).To(Equal("169.254.169.254:80"))
+
+		resp, err := http.Get("http://" + listener.Addr().String() + "/v1/data/role")
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(body)).To(Equal("replica"))
+	})
+
+	It("should fail and retry when the address cannot be listened on", func() {
+		failing := metadataservice.New(metadataservice.WithListener(func(_, _ string) (net.Listener, error) {
+			listenCalls++
+			return nil, errors.New("cannot assign requested address")
+		}))
+		vmi := &v1.VirtualMachineInstance{Spec: v1.VirtualMachineInstanceSpec{MetadataService: &v1.MetadataService{}}}
+
+		Expect(failing.Sync(vmi)).To(MatchError(ContainSubstring("cannot assign requested address")))
+		Expect(failing.Sync(vmi)).To(HaveOccurred())
+		Expect(listenCalls).To(Equal(2))
+	})
+})
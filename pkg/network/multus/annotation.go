@@ -86,6 +86,10 @@ func GenerateCNIAnnotationFromNameScheme(
 				networkSelectionElements = append(networkSelectionElements, *bindingPluginAnnotationData)
 			}
 		}
+
+		if iface := vmispec.LookupInterfaceByName(interfaces, network.Name); iface != nil && iface.Mirror != nil {
+			networkSelectionElements = append(networkSelectionElements, newMirrorAnnotationData(namespace, iface))
+		}
 	}
 
 	if len(networkSelectionElements) == 0 {
@@ -120,6 +124,17 @@ func newAnnotationData(
 	}
 }
 
+// newMirrorAnnotationData requests an additional pod interface, attached to the mirror network,
+// which receives the traffic mirrored from the given interface.
+func newMirrorAnnotationData(namespace string, iface *v1.Interface) networkv1.NetworkSelectionElement {
+	nadNamespacedName := NetAttachDefNamespacedName(namespace, iface.Mirror.NetworkName)
+	return networkv1.NetworkSelectionElement{
+		InterfaceRequest: namescheme.GenerateMirrorInterfaceName(iface.Name),
+		Namespace:        nadNamespacedName.Namespace,
+		Name:             nadNamespacedName.Name,
+	}
+}
+
 func newBindingPluginAnnotationData(
 	registeredBindingPlugins map[string]v1.InterfaceBindingPlugin,
 	pluginName,
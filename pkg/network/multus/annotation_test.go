@@ -103,5 +103,29 @@ var _ = Describe("Multus annotations", func() {
 					`[{"namespace": "namespace1", "name": "my-binding", "cni-args": {"logicNetworkName": "default"}}]`),
 			)
 		})
+
+		It("should add the mirror network of a mirrored interface to multus annotation", func() {
+			vmi := &v1.VirtualMachineInstance{ObjectMeta: metav1.ObjectMeta{Name: "testvmi", Namespace: "default"}}
+			vmi.Spec.Networks = []v1.Network{
+				{Name: "default", NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}},
+				{Name: "blue", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "test1"}}},
+			}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{
+				{Name: "default", Mirror: &v1.InterfaceMirror{NetworkName: "monitoring-ns/monitoring"}},
+				{Name: "blue", Mirror: &v1.InterfaceMirror{NetworkName: "monitoring"}},
+			}
+
+			Expect(multus.GenerateCNIAnnotation(
+				vmi.Namespace,
+				vmi.Spec.Domain.Devices.Interfaces,
+				vmi.Spec.Networks,
+				nil)).To(MatchJSON(
+				`[
+					{"name": "monitoring","namespace": "monitoring-ns","interface": "mir37a8eec1ce1"},
+					{"name": "test1","namespace": "default","interface": "pod16477688c0e"},
+					{"name": "monitoring","namespace": "default","interface": "mir16477688c0e"}
+				]`,
+			))
+		})
 	})
 })
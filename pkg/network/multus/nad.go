@@ -49,7 +49,12 @@ func NetAttachDefNamespacedName(namespace, fullNetworkName string) types.Namespa
 	}
 }
 
-func NetworkToResource(virtClient kubecli.KubevirtClient, vmi *v1.VirtualMachineInstance) (map[string]string, error) {
+// NetAttachDefGetter resolves the NetworkAttachmentDefinitions referenced by VMI networks.
+type NetAttachDefGetter interface {
+	Get(namespace, name string) (*NetAttachDefInfo, error)
+}
+
+func NetworkToResource(nadGetter NetAttachDefGetter, vmi *v1.VirtualMachineInstance) (map[string]string, error) {
 	networkToResourceMap := map[string]string{}
 
 	for _, network := range vmi.Spec.Networks {
@@ -58,16 +63,33 @@ func NetworkToResource(virtClient kubecli.KubevirtClient, vmi *v1.VirtualMachine
 		}
 
 		nadNamespacedName := NetAttachDefNamespacedName(vmi.Namespace, network.Multus.NetworkName)
-		netAttachDef, err := virtClient.NetworkClient().
-			K8sCniCncfIoV1().
-			NetworkAttachmentDefinitions(nadNamespacedName.Namespace).
-			Get(context.Background(), nadNamespacedName.Name, metav1.GetOptions{})
+		netAttachDef, err := nadGetter.Get(nadNamespacedName.Namespace, nadNamespacedName.Name)
 		if err != nil {
 			return nil, fmt.Errorf("failed to locate network attachment definition %s", nadNamespacedName.String())
 		}
 
-		networkToResourceMap[network.Name] = netAttachDef.Annotations[ResourceNameAnnotation]
+		networkToResourceMap[network.Name] = netAttachDef.ResourceName
 	}
 
 	return networkToResourceMap, nil
 }
+
+type netAttachDefClientGetter struct {
+	virtClient kubecli.KubevirtClient
+}
+
+// NewNetAttachDefClientGetter returns a getter fetching the NetworkAttachmentDefinitions from the API server.
+func NewNetAttachDefClientGetter(virtClient kubecli.KubevirtClient) NetAttachDefGetter {
+	return netAttachDefClientGetter{virtClient: virtClient}
+}
+
+func (g netAttachDefClientGetter) Get(namespace, name string) (*NetAttachDefInfo, error) {
+	netAttachDef, err := g.virtClient.NetworkClient().
+		K8sCniCncfIoV1().
+		NetworkAttachmentDefinitions(namespace).
+		Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return resolveNetAttachDef(netAttachDef), nil
+}
@@ -0,0 +1,159 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package multus
+
+import (
+	"encoding/json"
+	"sync"
+
+	networkv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"kubevirt.io/client-go/log"
+)
+
+// NetAttachDefInfo is the resolved content of a NetworkAttachmentDefinition.
+// It is shared between callers and must not be modified.
+type NetAttachDefInfo struct {
+	// ResourceName is the device plugin resource backing the network, if any.
+	ResourceName string
+	// CNIConfig is the parsed CNI configuration, empty when the NetworkAttachmentDefinition has none.
+	CNIConfig CNIConfig
+	// DeviceInfoExpected is set when the network devices are allocated by a device plugin,
+	// in which case their device-info is expected to be reported in the pod network status.
+	DeviceInfoExpected bool
+}
+
+type CNIConfig struct {
+	CNIVersion  string
+	Name        string
+	PluginTypes []string
+}
+
+func resolveNetAttachDef(netAttachDef *networkv1.NetworkAttachmentDefinition) *NetAttachDefInfo {
+	resourceName := netAttachDef.Annotations[ResourceNameAnnotation]
+	info := &NetAttachDefInfo{
+		ResourceName:       resourceName,
+		DeviceInfoExpected: resourceName != "",
+	}
+
+	if netAttachDef.Spec.Config != "" {
+		cniConfig, err := parseCNIConfig(netAttachDef.Spec.Config)
+		if err != nil {
+			log.Log.Reason(err).Warningf("failed to parse the CNI config of network attachment definition %s/%s",
+				netAttachDef.Namespace, netAttachDef.Name)
+		} else {
+			info.CNIConfig = cniConfig
+		}
+	}
+
+	return info
+}
+
+func parseCNIConfig(rawConfig string) (CNIConfig, error) {
+	type pluginConfig struct {
+		Type string `json:"type"`
+	}
+	var config struct {
+		CNIVersion string         `json:"cniVersion"`
+		Name       string         `json:"name"`
+		Type       string         `json:"type"`
+		Plugins    []pluginConfig `json:"plugins"`
+	}
+	if err := json.Unmarshal([]byte(rawConfig), &config); err != nil {
+		return CNIConfig{}, err
+	}
+
+	cniConfig := CNIConfig{CNIVersion: config.CNIVersion, Name: config.Name}
+	if config.Type != "" {
+		cniConfig.PluginTypes = append(cniConfig.PluginTypes, config.Type)
+	}
+	for _, plugin := range config.Plugins {
+		cniConfig.PluginTypes = append(cniConfig.PluginTypes, plugin.Type)
+	}
+	return cniConfig, nil
+}
+
+type resolvedNetAttachDef struct {
+	resourceVersion string
+	info            *NetAttachDefInfo
+}
+
+// NetAttachDefCache serves the NetworkAttachmentDefinitions from an informer store and keeps
+// their resolved content until the informer reports them as updated or deleted.
+// NetworkAttachmentDefinitions not yet observed by the informer are fetched through the fallback getter.
+type NetAttachDefCache struct {
+	store    cache.Store
+	fallback NetAttachDefGetter
+
+	lock     sync.Mutex
+	resolved map[string]resolvedNetAttachDef
+}
+
+func NewNetAttachDefCache(informer cache.SharedIndexInformer, fallback NetAttachDefGetter) (*NetAttachDefCache, error) {
+	c := &NetAttachDefCache{
+		store:    informer.GetStore(),
+		fallback: fallback,
+		resolved: map[string]resolvedNetAttachDef{},
+	}
+
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(_, newObj interface{}) { c.invalidate(newObj) },
+		DeleteFunc: c.invalidate,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *NetAttachDefCache) Get(namespace, name string) (*NetAttachDefInfo, error) {
+	key := namespace + "/" + name
+	obj, exists, err := c.store.GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return c.fallback.Get(namespace, name)
+	}
+	netAttachDef := obj.(*networkv1.NetworkAttachmentDefinition)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if entry, ok := c.resolved[key]; ok && entry.resourceVersion == netAttachDef.ResourceVersion {
+		return entry.info, nil
+	}
+
+	info := resolveNetAttachDef(netAttachDef)
+	c.resolved[key] = resolvedNetAttachDef{resourceVersion: netAttachDef.ResourceVersion, info: info}
+	return info, nil
+}
+
+func (c *NetAttachDefCache) invalidate(obj interface{}) {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		log.Log.Reason(err).Error("failed to get the key of the network attachment definition")
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.resolved, key)
+}
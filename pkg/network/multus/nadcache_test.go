@@ -0,0 +1,174 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package multus_test
+
+import (
+	"context"
+	"fmt"
+
+	networkv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	framework "k8s.io/client-go/tools/cache/testing"
+
+	"kubevirt.io/kubevirt/pkg/network/multus"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("NetAttachDefCache", func() {
+	const (
+		namespace  = "default"
+		nadName    = "red"
+		bridgeConf = `{"cniVersion": "0.4.0", "name": "red", "type": "bridge", "bridge": "br10"}`
+	)
+
+	var (
+		ctx      context.Context
+		cancel   context.CancelFunc
+		informer cache.SharedIndexInformer
+		source   *framework.FakeControllerSource
+		fallback *stubNetAttachDefGetter
+		nadCache *multus.NetAttachDefCache
+	)
+
+	BeforeEach(func() {
+		informer, source = testutils.NewFakeInformerFor(&networkv1.NetworkAttachmentDefinition{})
+		fallback = &stubNetAttachDefGetter{}
+
+		var err error
+		nadCache, err = multus.NewNetAttachDefCache(informer, fallback)
+		Expect(err).ToNot(HaveOccurred())
+
+		ctx, cancel = context.WithCancel(context.Background())
+		go informer.Run(ctx.Done())
+		Expect(cache.WaitForCacheSync(ctx.Done(), informer.HasSynced)).To(BeTrue())
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	newNetAttachDef := func(config string, annotations map[string]string) *networkv1.NetworkAttachmentDefinition {
+		return &networkv1.NetworkAttachmentDefinition{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: nadName, Annotations: annotations},
+			Spec:       networkv1.NetworkAttachmentDefinitionSpec{Config: config},
+		}
+	}
+
+	waitForResourceVersion := func(resourceVersion string) {
+		Eventually(func() string {
+			obj, exists, err := informer.GetStore().GetByKey(namespace + "/" + nadName)
+			if err != nil || !exists {
+				return ""
+			}
+			return obj.(*networkv1.NetworkAttachmentDefinition).ResourceVersion
+		}).Should(Equal(resourceVersion))
+	}
+
+	addNetAttachDef := func(nad *networkv1.NetworkAttachmentDefinition) {
+		source.Add(nad)
+		waitForResourceVersion(nad.ResourceVersion)
+	}
+
+	It("resolves the network attachment definition from the informer", func() {
+		addNetAttachDef(newNetAttachDef(bridgeConf, nil))
+
+		Expect(nadCache.Get(namespace, nadName)).To(Equal(&multus.NetAttachDefInfo{
+			CNIConfig: multus.CNIConfig{CNIVersion: "0.4.0", Name: "red", PluginTypes: []string{"bridge"}},
+		}))
+		Expect(fallback.calls).To(BeZero())
+	})
+
+	It("resolves the resource name and device-info expectation", func() {
+		const conflist = `{"cniVersion": "1.0.0", "name": "red", "plugins": [{"type": "sriov"}, {"type": "tuning"}]}`
+		addNetAttachDef(newNetAttachDef(conflist, map[string]string{multus.ResourceNameAnnotation: "intel.com/sriov"}))
+
+		Expect(nadCache.Get(namespace, nadName)).To(Equal(&multus.NetAttachDefInfo{
+			ResourceName:       "intel.com/sriov",
+			CNIConfig:          multus.CNIConfig{CNIVersion: "1.0.0", Name: "red", PluginTypes: []string{"sriov", "tuning"}},
+			DeviceInfoExpected: true,
+		}))
+	})
+
+	It("keeps the resolved content of an unchanged network attachment definition", func() {
+		addNetAttachDef(newNetAttachDef(bridgeConf, nil))
+
+		first, err := nadCache.Get(namespace, nadName)
+		Expect(err).ToNot(HaveOccurred())
+		second, err := nadCache.Get(namespace, nadName)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(second).To(BeIdenticalTo(first))
+		Expect(fallback.calls).To(BeZero())
+	})
+
+	It("resolves an updated network attachment definition again", func() {
+		addNetAttachDef(newNetAttachDef(bridgeConf, nil))
+		Expect(nadCache.Get(namespace, nadName)).To(HaveField("ResourceName", BeEmpty()))
+
+		updatedNAD := newNetAttachDef(bridgeConf, map[string]string{multus.ResourceNameAnnotation: "intel.com/sriov"})
+		source.Modify(updatedNAD)
+		waitForResourceVersion(updatedNAD.ResourceVersion)
+
+		Expect(nadCache.Get(namespace, nadName)).To(HaveField("ResourceName", Equal("intel.com/sriov")))
+	})
+
+	It("falls back to the getter for a network attachment definition not observed by the informer", func() {
+		fallback.info = &multus.NetAttachDefInfo{ResourceName: "intel.com/sriov"}
+
+		Expect(nadCache.Get(namespace, nadName)).To(Equal(fallback.info))
+		Expect(fallback.calls).To(Equal(1))
+	})
+
+	It("falls back to the getter for a deleted network attachment definition", func() {
+		nad := newNetAttachDef(bridgeConf, nil)
+		addNetAttachDef(nad)
+		Expect(nadCache.Get(namespace, nadName)).ToNot(BeNil())
+
+		fallback.err = fmt.Errorf("not found")
+		source.Delete(nad.DeepCopy())
+		Eventually(func() bool {
+			_, exists, _ := informer.GetStore().GetByKey(namespace + "/" + nadName)
+			return exists
+		}).Should(BeFalse())
+
+		_, err := nadCache.Get(namespace, nadName)
+		Expect(err).To(MatchError("not found"))
+	})
+
+	It("ignores a malformed CNI config", func() {
+		addNetAttachDef(newNetAttachDef("{", map[string]string{multus.ResourceNameAnnotation: "intel.com/sriov"}))
+
+		Expect(nadCache.Get(namespace, nadName)).To(Equal(&multus.NetAttachDefInfo{ResourceName: "intel.com/sriov", DeviceInfoExpected: true}))
+	})
+})
+
+type stubNetAttachDefGetter struct {
+	info  *multus.NetAttachDefInfo
+	err   error
+	calls int
+}
+
+func (s *stubNetAttachDefGetter) Get(_, _ string) (*multus.NetAttachDefInfo, error) {
+	s.calls++
+	return s.info, s.err
+}
@@ -61,3 +61,14 @@ func LookupPodPrimaryIfaceName(networkStatuses []networkv1.NetworkStatus) string
 
 	return ""
 }
+
+// LookupPodPrimaryNetworkStatus returns the network status reported by the default CNI for the pod primary network.
+func LookupPodPrimaryNetworkStatus(networkStatuses []networkv1.NetworkStatus) *networkv1.NetworkStatus {
+	for i := range networkStatuses {
+		if networkStatuses[i].Default {
+			return &networkStatuses[i]
+		}
+	}
+
+	return nil
+}
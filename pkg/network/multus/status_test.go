@@ -130,6 +130,26 @@ var _ = Describe("Network Status", func() {
 			),
 		)
 	})
+
+	Context("LookupPodPrimaryNetworkStatus", func() {
+		It("should return nil when there is no default network status", func() {
+			networkStatuses := []networkv1.NetworkStatus{{Name: "some-net", Interface: "pod123456"}}
+			Expect(multus.LookupPodPrimaryNetworkStatus(networkStatuses)).To(BeNil())
+		})
+
+		It("should return the default network status", func() {
+			primaryNetworkStatus := networkv1.NetworkStatus{
+				Name:       "k8s-pod-network",
+				Default:    true,
+				DeviceInfo: &networkv1.DeviceInfo{Type: "vdpa", Version: "1.1.0"},
+			}
+			networkStatuses := []networkv1.NetworkStatus{
+				{Name: "some-net", Interface: "pod123456"},
+				primaryNetworkStatus,
+			}
+			Expect(multus.LookupPodPrimaryNetworkStatus(networkStatuses)).To(Equal(&primaryNetworkStatus))
+		})
+	})
 })
 
 func newStubPod(annotations map[string]string) *k8scorev1.Pod {
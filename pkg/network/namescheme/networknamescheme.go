@@ -39,6 +39,7 @@ const (
 	// (the interface created to hold the pod's IP address - and thus appease CNI).
 	maxIfaceNameLen   = 11
 	HashedIfacePrefix = "pod"
+	MirrorIfacePrefix = "mir"
 
 	PrimaryPodInterfaceName = "eth0"
 )
@@ -47,7 +48,7 @@ const (
 // The returned map associates between the network name and the generated pod interface name.
 // Primary network will use "eth0" and the secondary ones will be named with the hashed network name.
 func CreateHashedNetworkNameScheme(vmiNetworks []v1.Network) map[string]string {
-	networkNameSchemeMap := mapMultusNonDefaultNetworksToPodInterfaceName(vmiNetworks)
+	networkNameSchemeMap := mapSecondaryNetworksToPodInterfaceName(vmiNetworks)
 
 	if multusDefaultNetwork := vmispec.LookUpDefaultNetwork(vmiNetworks); multusDefaultNetwork != nil {
 		networkNameSchemeMap[multusDefaultNetwork.Name] = PrimaryPodInterfaceName
@@ -57,7 +58,7 @@ func CreateHashedNetworkNameScheme(vmiNetworks []v1.Network) map[string]string {
 }
 
 func HashedPodInterfaceName(network v1.Network, ifaceStatuses []v1.VirtualMachineInstanceNetworkInterface) string {
-	if vmispec.IsSecondaryMultusNetwork(network) {
+	if vmispec.IsSecondaryNetwork(network) {
 		return GenerateHashedInterfaceName(network.Name)
 	}
 
@@ -69,9 +70,9 @@ func HashedPodInterfaceName(network v1.Network, ifaceStatuses []v1.VirtualMachin
 	return PrimaryPodInterfaceName
 }
 
-func mapMultusNonDefaultNetworksToPodInterfaceName(networks []v1.Network) map[string]string {
+func mapSecondaryNetworksToPodInterfaceName(networks []v1.Network) map[string]string {
 	networkNameSchemeMap := map[string]string{}
-	for _, network := range vmispec.FilterMultusNonDefaultNetworks(networks) {
+	for _, network := range vmispec.FilterSecondaryNetworks(networks) {
 		networkNameSchemeMap[network.Name] = GenerateHashedInterfaceName(network.Name)
 	}
 	return networkNameSchemeMap
@@ -84,12 +85,21 @@ func GenerateHashedInterfaceName(networkName string) string {
 	return fmt.Sprintf("%s%s", HashedIfacePrefix, hashedName)
 }
 
+// GenerateMirrorInterfaceName returns the name of the pod interface which receives
+// the mirrored traffic of the given VMI interface.
+func GenerateMirrorInterfaceName(ifaceName string) string {
+	hash := sha256.New()
+	_, _ = io.WriteString(hash, ifaceName)
+	hashedName := fmt.Sprintf("%x", hash.Sum(nil))[:maxIfaceNameLen]
+	return fmt.Sprintf("%s%s", MirrorIfacePrefix, hashedName)
+}
+
 // CreateOrdinalNetworkNameScheme iterates over the VMI's Networks, and creates for each a pod interface name.
 // The returned map associates between the network name and the generated pod interface name.
 // Primary network will use "eth0" and the secondary ones will use "net<id>" format, where id is an enumeration
 // from 1 to n.
 func CreateOrdinalNetworkNameScheme(vmiNetworks []v1.Network) map[string]string {
-	networkNameSchemeMap := mapMultusNonDefaultNetworksToPodInterfaceOrdinalName(vmiNetworks)
+	networkNameSchemeMap := mapSecondaryNetworksToPodInterfaceOrdinalName(vmiNetworks)
 
 	if multusDefaultNetwork := vmispec.LookUpDefaultNetwork(vmiNetworks); multusDefaultNetwork != nil {
 		networkNameSchemeMap[multusDefaultNetwork.Name] = PrimaryPodInterfaceName
@@ -108,9 +118,9 @@ func OrdinalPodInterfaceName(name string, networks []v1.Network) string {
 	return ""
 }
 
-func mapMultusNonDefaultNetworksToPodInterfaceOrdinalName(networks []v1.Network) map[string]string {
+func mapSecondaryNetworksToPodInterfaceOrdinalName(networks []v1.Network) map[string]string {
 	networkNameSchemeMap := map[string]string{}
-	for i, network := range vmispec.FilterMultusNonDefaultNetworks(networks) {
+	for i, network := range vmispec.FilterSecondaryNetworks(networks) {
 		networkNameSchemeMap[network.Name] = generateOrdinalInterfaceName(i + 1)
 	}
 
@@ -181,7 +191,7 @@ func HasOrdinalSecondaryIfaces(
 	networks []v1.Network,
 	ifaceStatuses []v1.VirtualMachineInstanceNetworkInterface,
 ) bool {
-	secondaryNets := vmispec.FilterMultusNonDefaultNetworks(networks)
+	secondaryNets := vmispec.FilterSecondaryNetworks(networks)
 	if len(secondaryNets) == 0 {
 		return false
 	}
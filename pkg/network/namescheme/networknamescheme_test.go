@@ -103,6 +103,18 @@ var _ = Describe("Network Name Scheme", func() {
 				"network1": "net1",
 				"network2": "net2",
 			}),
+		Entry("ordinal, when native secondary networks exist",
+			namescheme.CreateOrdinalNetworkNameScheme,
+			[]virtv1.Network{
+				newPodNetwork(),
+				createMultusSecondaryNetwork("network1", "default/nad1"),
+				createNativeNetwork("network2", "podnetwork2"),
+			},
+			map[string]string{
+				"default":  namescheme.PrimaryPodInterfaceName,
+				"network1": "net1",
+				"network2": "net2",
+			}),
 	)
 
 	Context("CreateFromNetworkStatuses", func() {
@@ -199,6 +211,28 @@ var _ = Describe("Network Name Scheme", func() {
 			),
 		)
 	})
+	Context("GenerateMirrorInterfaceName", func() {
+		It("should return the given interface name's hashed mirror interface name", func() {
+			Expect(namescheme.GenerateMirrorInterfaceName("red")).To(Equal("mirb1f51a511f1"))
+		})
+	})
+	Context("HasOrdinalSecondaryIfaces", func() {
+		DescribeTable("should report the naming scheme of the secondary interfaces",
+			func(ifaceStatuses []virtv1.VirtualMachineInstanceNetworkInterface, expected bool) {
+				networks := []virtv1.Network{newPodNetwork(), createNativeNetwork("blue", "blue-podnetwork")}
+				Expect(namescheme.HasOrdinalSecondaryIfaces(networks, ifaceStatuses)).To(Equal(expected))
+			},
+			Entry("given a native network with an ordinal pod interface",
+				[]virtv1.VirtualMachineInstanceNetworkInterface{{Name: "blue", PodInterfaceName: "net1"}},
+				true,
+			),
+			Entry("given a native network with a hashed pod interface",
+				[]virtv1.VirtualMachineInstanceNetworkInterface{{Name: "blue", PodInterfaceName: "pod16477688c0e"}},
+				false,
+			),
+		)
+	})
+
 	Context("OrdinalPodInterfaceName", func() {
 		DescribeTable("should return empty string",
 			func(networkName string, networks []virtv1.Network) {
@@ -297,6 +331,17 @@ func createMultusNetwork(name, networkName string) virtv1.Network {
 	}
 }
 
+func createNativeNetwork(name, podNetworkName string) virtv1.Network {
+	return virtv1.Network{
+		Name: name,
+		NetworkSource: virtv1.NetworkSource{
+			Native: &virtv1.NativeNetwork{
+				PodNetworkName: podNetworkName,
+			},
+		},
+	}
+}
+
 func newPodNetwork() virtv1.Network {
 	return virtv1.Network{
 		Name: "default",
@@ -0,0 +1,66 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package nativenetwork
+
+import (
+	"encoding/json"
+	"fmt"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/namescheme"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+// PodNetworksAnnotation carries the attachment requests of the pod to PodNetwork objects
+// of the Kubernetes native multi-network API.
+// It is used until the pod API exposes a dedicated field for these requests.
+const PodNetworksAnnotation = "kubevirt.io/pod-networks"
+
+// PodNetworkAttachment represents a request to attach a pod interface to a PodNetwork object.
+type PodNetworkAttachment struct {
+	PodNetworkName string `json:"podNetworkName"`
+	InterfaceName  string `json:"interfaceName,omitempty"`
+}
+
+func GeneratePodNetworksAnnotation(networks []v1.Network) (string, error) {
+	return GeneratePodNetworksAnnotationFromNameScheme(networks, namescheme.CreateHashedNetworkNameScheme(networks))
+}
+
+func GeneratePodNetworksAnnotationFromNameScheme(networks []v1.Network, networkNameScheme map[string]string) (string, error) {
+	var attachments []PodNetworkAttachment
+	for _, network := range vmispec.FilterNetworksSpec(networks, vmispec.IsSecondaryNativeNetwork) {
+		attachments = append(attachments, PodNetworkAttachment{
+			PodNetworkName: network.Native.PodNetworkName,
+			InterfaceName:  networkNameScheme[network.Name],
+		})
+	}
+
+	if len(attachments) == 0 {
+		return "", nil
+	}
+
+	podNetworksAnnotation, err := json.Marshal(attachments)
+	if err != nil {
+		return "", fmt.Errorf("failed to create JSON list from pod network attachments: %v", attachments)
+	}
+
+	return string(podNetworksAnnotation), nil
+}
@@ -0,0 +1,54 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package nativenetwork_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/nativenetwork"
+)
+
+var _ = Describe("Native multi-network annotation", func() {
+	It("should not generate the annotation when there are no native networks", func() {
+		networks := []v1.Network{
+			{Name: "default", NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}},
+			{Name: "red", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "red-net"}}},
+		}
+
+		Expect(nativenetwork.GeneratePodNetworksAnnotation(networks)).To(BeEmpty())
+	})
+
+	It("should generate an attachment request per native network", func() {
+		networks := []v1.Network{
+			{Name: "default", NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}},
+			{Name: "red", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "red-net"}}},
+			{Name: "blue", NetworkSource: v1.NetworkSource{Native: &v1.NativeNetwork{PodNetworkName: "blue-net"}}},
+			{Name: "green", NetworkSource: v1.NetworkSource{Native: &v1.NativeNetwork{PodNetworkName: "green-net"}}},
+		}
+
+		Expect(nativenetwork.GeneratePodNetworksAnnotation(networks)).To(MatchJSON(
+			`[{"podNetworkName":"blue-net","interfaceName":"pod16477688c0e"},` +
+				`{"podNetworkName":"green-net","interfaceName":"podba4788b226a"}]`,
+		))
+	})
+})
@@ -0,0 +1,174 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package netbinding
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+// defaultDryRunTimeout is kept below the timeout of the admission webhooks, which call the dry run validation
+const defaultDryRunTimeout = 5 * time.Second
+
+// DryRunRequest is posted to the dry run validation endpoint of a binding plugin
+type DryRunRequest struct {
+	Binding string                         `json:"binding"`
+	Spec    *v1.VirtualMachineInstanceSpec `json:"spec"`
+}
+
+// DryRunResponse is the reply of the dry run validation endpoint of a binding plugin
+type DryRunResponse struct {
+	Allowed bool   `json:"allowed"`
+	Message string `json:"message,omitempty"`
+}
+
+// DryRunValidator validates a VMI spec against the binding plugins its interfaces use
+type DryRunValidator struct {
+	client *http.Client
+
+	// clients verifying the endpoint certificates with a CA bundle, keyed by the bundle
+	clientsLock sync.Mutex
+	clients     map[string]*http.Client
+}
+
+func NewDryRunValidator() *DryRunValidator {
+	return &DryRunValidator{
+		client:  &http.Client{Timeout: defaultDryRunTimeout},
+		clients: map[string]*http.Client{},
+	}
+}
+
+// Validate posts the spec to the dry run validation endpoint of each binding plugin used by the VMI interfaces.
+// Plugins which do not configure an endpoint are skipped.
+func (v *DryRunValidator) Validate(
+	field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, bindings map[string]v1.InterfaceBindingPlugin,
+) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	validated := map[string]struct{}{}
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.Binding == nil {
+			continue
+		}
+		bindingName := iface.Binding.Name
+		if _, exists := validated[bindingName]; exists {
+			continue
+		}
+		validated[bindingName] = struct{}{}
+
+		validation := bindings[bindingName].DryRunValidation
+		if validation == nil || validation.WebhookURL == "" {
+			continue
+		}
+
+		bindingField := field.Child("domain", "devices", "interfaces").Index(idx).Child("binding")
+		response, err := v.validate(context.Background(), validation, DryRunRequest{Binding: bindingName, Spec: spec})
+		if err != nil {
+			if validation.FailurePolicy == v1.InterfaceBindingDryRunFailurePolicyIgnore {
+				log.Log.Reason(err).Warningf("ignoring the failed dry run validation of network binding %s", bindingName)
+				continue
+			}
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("dry run validation of network binding %s failed: %v", bindingName, err),
+				Field:   bindingField.String(),
+			})
+		} else if !response.Allowed {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("network binding %s rejected the spec: %s", bindingName, response.Message),
+				Field:   bindingField.String(),
+			})
+		}
+	}
+	return causes
+}
+
+func (v *DryRunValidator) validate(
+	ctx context.Context, validation *v1.InterfaceBindingDryRunValidation, dryRunRequest DryRunRequest,
+) (*DryRunResponse, error) {
+	client, err := v.clientFor(validation.CABundle)
+	if err != nil {
+		return nil, err
+	}
+	return send(ctx, client, validation.WebhookURL, dryRunRequest)
+}
+
+// clientFor returns the client verifying the endpoint certificate with the CA bundle,
+// or with the system trust store when the bundle is empty
+func (v *DryRunValidator) clientFor(caBundle []byte) (*http.Client, error) {
+	if len(caBundle) == 0 {
+		return v.client, nil
+	}
+
+	v.clientsLock.Lock()
+	defer v.clientsLock.Unlock()
+	if client, exists := v.clients[string(caBundle)]; exists {
+		return client, nil
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caBundle) {
+		return nil, fmt.Errorf("failed to parse the CA bundle")
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	client := &http.Client{Transport: transport, Timeout: defaultDryRunTimeout}
+	v.clients[string(caBundle)] = client
+	return client, nil
+}
+
+func send(ctx context.Context, client *http.Client, url string, dryRunRequest DryRunRequest) (*DryRunResponse, error) {
+	body, err := json.Marshal(dryRunRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %v", err)
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %v", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to post request: %v", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return nil, fmt.Errorf("webhook responded with status %d", response.StatusCode)
+	}
+
+	var dryRunResponse DryRunResponse
+	if err := json.NewDecoder(response.Body).Decode(&dryRunResponse); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %v", err)
+	}
+	return &dryRunResponse, nil
+}
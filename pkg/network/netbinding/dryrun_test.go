@@ -0,0 +1,181 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package netbinding_test
+
+import (
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/network/netbinding"
+)
+
+var _ = Describe("Network binding dry run validation", func() {
+	const (
+		testNetworkName = "net1"
+		testBindingName = "binding1"
+	)
+
+	var (
+		server   *httptest.Server
+		requests []netbinding.DryRunRequest
+		reply    func(w http.ResponseWriter)
+	)
+
+	handleDryRun := func(w http.ResponseWriter, r *http.Request) {
+		defer GinkgoRecover()
+		var request netbinding.DryRunRequest
+		Expect(json.NewDecoder(r.Body).Decode(&request)).To(Succeed())
+		requests = append(requests, request)
+		reply(w)
+	}
+
+	newSpec := func() *v1.VirtualMachineInstanceSpec {
+		vmi := libvmi.New(
+			libvmi.WithInterface(v1.Interface{Name: testNetworkName, Binding: &v1.PluginBinding{Name: testBindingName}}),
+			libvmi.WithNetwork(&v1.Network{Name: testNetworkName}),
+		)
+		return &vmi.Spec
+	}
+
+	newBindings := func(failurePolicy v1.InterfaceBindingDryRunFailurePolicy) map[string]v1.InterfaceBindingPlugin {
+		return map[string]v1.InterfaceBindingPlugin{
+			testBindingName: {DryRunValidation: &v1.InterfaceBindingDryRunValidation{
+				WebhookURL:    server.URL,
+				FailurePolicy: failurePolicy,
+			}},
+		}
+	}
+
+	BeforeEach(func() {
+		requests = nil
+		reply = func(w http.ResponseWriter) {
+			Expect(json.NewEncoder(w).Encode(netbinding.DryRunResponse{Allowed: true})).To(Succeed())
+		}
+		server = httptest.NewServer(http.HandlerFunc(handleDryRun))
+		DeferCleanup(server.Close)
+	})
+
+	It("should post the spec to the plugin and accept it when allowed", func() {
+		spec := newSpec()
+
+		Expect(netbinding.NewDryRunValidator().Validate(k8sfield.NewPath("spec"), spec, newBindings(""))).To(BeEmpty())
+		Expect(requests).To(Equal([]netbinding.DryRunRequest{{Binding: testBindingName, Spec: spec}}))
+	})
+
+	It("should reject the spec when the plugin does not allow it", func() {
+		reply = func(w http.ResponseWriter) {
+			Expect(json.NewEncoder(w).Encode(netbinding.DryRunResponse{Message: "missing device"})).To(Succeed())
+		}
+
+		Expect(netbinding.NewDryRunValidator().Validate(k8sfield.NewPath("spec"), newSpec(), newBindings(""))).To(ConsistOf(
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "network binding binding1 rejected the spec: missing device",
+				Field:   "spec.domain.devices.interfaces[0].binding",
+			},
+		))
+	})
+
+	It("should skip plugins without a dry run validation endpoint", func() {
+		bindings := map[string]v1.InterfaceBindingPlugin{testBindingName: {SidecarImage: "image1"}}
+
+		Expect(netbinding.NewDryRunValidator().Validate(k8sfield.NewPath("spec"), newSpec(), bindings)).To(BeEmpty())
+		Expect(requests).To(BeEmpty())
+	})
+
+	Context("when the endpoint fails", func() {
+		BeforeEach(func() {
+			reply = func(w http.ResponseWriter) { w.WriteHeader(http.StatusInternalServerError) }
+		})
+
+		DescribeTable("should reject the spec", func(failurePolicy v1.InterfaceBindingDryRunFailurePolicy) {
+			Expect(netbinding.NewDryRunValidator().Validate(k8sfield.NewPath("spec"), newSpec(), newBindings(failurePolicy))).To(ConsistOf(
+				metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: "dry run validation of network binding binding1 failed: webhook responded with status 500",
+					Field:   "spec.domain.devices.interfaces[0].binding",
+				},
+			))
+		},
+			Entry("by default", v1.InterfaceBindingDryRunFailurePolicy("")),
+			Entry("with the Fail policy", v1.InterfaceBindingDryRunFailurePolicyFail),
+		)
+
+		It("should accept the spec with the Ignore policy", func() {
+			bindings := newBindings(v1.InterfaceBindingDryRunFailurePolicyIgnore)
+
+			Expect(netbinding.NewDryRunValidator().Validate(k8sfield.NewPath("spec"), newSpec(), bindings)).To(BeEmpty())
+		})
+	})
+
+	Context("when the endpoint serves TLS", func() {
+		var caBundle []byte
+
+		BeforeEach(func() {
+			server = httptest.NewTLSServer(http.HandlerFunc(handleDryRun))
+			DeferCleanup(server.Close)
+			caBundle = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+		})
+
+		newBindingsWithCABundle := func(caBundle []byte) map[string]v1.InterfaceBindingPlugin {
+			bindings := newBindings("")
+			bindings[testBindingName].DryRunValidation.CABundle = caBundle
+			return bindings
+		}
+
+		It("should verify the endpoint certificate with the CA bundle", func() {
+			validator := netbinding.NewDryRunValidator()
+
+			Expect(validator.Validate(k8sfield.NewPath("spec"), newSpec(), newBindingsWithCABundle(caBundle))).To(BeEmpty())
+			Expect(validator.Validate(k8sfield.NewPath("spec"), newSpec(), newBindingsWithCABundle(caBundle))).To(BeEmpty())
+			Expect(requests).To(HaveLen(2))
+		})
+
+		It("should reject the spec when the endpoint certificate is not trusted", func() {
+			Expect(netbinding.NewDryRunValidator().Validate(k8sfield.NewPath("spec"), newSpec(), newBindings(""))).To(ConsistOf(
+				HaveField("Message", ContainSubstring("certificate signed by unknown authority")),
+			))
+			Expect(requests).To(BeEmpty())
+		})
+
+		It("should reject the spec when the CA bundle cannot be parsed", func() {
+			bindings := newBindingsWithCABundle([]byte("garbage"))
+
+			Expect(netbinding.NewDryRunValidator().Validate(k8sfield.NewPath("spec"), newSpec(), bindings)).To(ConsistOf(
+				metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: "dry run validation of network binding binding1 failed: failed to parse the CA bundle",
+					Field:   "spec.domain.devices.interfaces[0].binding",
+				},
+			))
+		})
+	})
+})
@@ -25,6 +25,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/hooks"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
 )
 
 func NetBindingPluginSidecarList(vmi *v1.VirtualMachineInstance, config *v1.KubeVirtConfiguration) (hooks.HookSidecarList, error) {
@@ -57,15 +58,36 @@ func netBindingPluginSidecar(vmi *v1.VirtualMachineInstance, config *v1.KubeVirt
 		}
 	}
 
-	for _, pluginInfo := range bindingByName {
+	for bindingName, pluginInfo := range bindingByName {
 		if pluginInfo.SidecarImage != "" {
 			pluginSidecars = append(pluginSidecars, hooks.HookSidecar{
 				Image:           pluginInfo.SidecarImage,
 				ImagePullPolicy: config.ImagePullPolicy,
 				DownwardAPI:     pluginInfo.DownwardAPI,
+				PluginName:      bindingName,
+				Resources:       pluginInfo.SidecarResources,
 			})
 		}
 	}
 
 	return pluginSidecars, nil
 }
+
+// PodNetworkToResource maps the pod network to the device plugin resource requested by the binding plugin
+// of its interface.
+// Unlike Multus networks, whose resource is defined on their NetworkAttachmentDefinition, the device
+// attached by the default CNI has no other source to learn the resource from.
+func PodNetworkToResource(vmi *v1.VirtualMachineInstance, bindings map[string]v1.InterfaceBindingPlugin) map[string]string {
+	podNetwork := vmispec.LookupPodNetwork(vmi.Spec.Networks)
+	if podNetwork == nil {
+		return nil
+	}
+	iface := vmispec.LookupInterfaceByName(vmi.Spec.Domain.Devices.Interfaces, podNetwork.Name)
+	if iface == nil || iface.Binding == nil {
+		return nil
+	}
+	if plugin, exists := bindings[iface.Binding.Name]; exists && plugin.ResourceName != "" {
+		return map[string]string{podNetwork.Name: plugin.ResourceName}
+	}
+	return nil
+}
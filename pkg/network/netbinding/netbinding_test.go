@@ -23,6 +23,9 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/hooks"
@@ -41,6 +44,10 @@ var _ = Describe("Network Binding", func() {
 		testNetworkName3  = "net1"
 	)
 
+	testSidecarResources := &v1.ResourceRequirementsWithoutClaims{
+		Limits: k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse("64Mi")},
+	}
+
 	Context("binding plugin sidecar list", func() {
 		DescribeTable("should create the correct sidecars",
 			func(vmi *v1.VirtualMachineInstance, bindings map[string]v1.InterfaceBindingPlugin, expectedSidecars hooks.HookSidecarList) {
@@ -64,7 +71,13 @@ var _ = Describe("Network Binding", func() {
 					libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
 				),
 				map[string]v1.InterfaceBindingPlugin{testBindingName1: {SidecarImage: testSidecarImage1}},
-				hooks.HookSidecarList{{Image: testSidecarImage1}}),
+				hooks.HookSidecarList{{Image: testSidecarImage1, PluginName: testBindingName1}}),
+			Entry("VMI has binding plugin with sidecar resources",
+				libvmi.New(libvmi.WithInterface(v1.Interface{Name: testNetworkName1, Binding: &v1.PluginBinding{Name: testBindingName1}}),
+					libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+				),
+				map[string]v1.InterfaceBindingPlugin{testBindingName1: {SidecarImage: testSidecarImage1, SidecarResources: testSidecarResources}},
+				hooks.HookSidecarList{{Image: testSidecarImage1, PluginName: testBindingName1, Resources: testSidecarResources}}),
 			Entry("VMI has multiple plugin bindings",
 				libvmi.New(libvmi.WithInterface(v1.Interface{Name: testNetworkName1, Binding: &v1.PluginBinding{Name: testBindingName1}}),
 					libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
@@ -80,7 +93,10 @@ var _ = Describe("Network Binding", func() {
 					testBindingName1: {SidecarImage: testSidecarImage1, DownwardAPI: v1.DeviceInfo},
 					testBindingName2: {SidecarImage: testSidecarImage2},
 				},
-				hooks.HookSidecarList{{Image: testSidecarImage1, DownwardAPI: v1.DeviceInfo}, {Image: testSidecarImage2}}),
+				hooks.HookSidecarList{
+					{Image: testSidecarImage1, DownwardAPI: v1.DeviceInfo, PluginName: testBindingName1},
+					{Image: testSidecarImage2, PluginName: testBindingName2},
+				}),
 			Entry("VMI has no plugin bindings",
 				libvmi.New(libvmi.WithInterface(v1.Interface{
 					Name:                   testNetworkName1,
@@ -97,7 +113,7 @@ var _ = Describe("Network Binding", func() {
 					libvmi.WithNetwork(&v1.Network{Name: testNetworkName2}),
 				),
 				map[string]v1.InterfaceBindingPlugin{testBindingName1: {SidecarImage: testSidecarImage1}},
-				hooks.HookSidecarList{{Image: testSidecarImage1}}),
+				hooks.HookSidecarList{{Image: testSidecarImage1, PluginName: testBindingName1}}),
 		)
 
 		It("should retrun an error when VMI has binding plugin but config doesn't exist", func() {
@@ -111,4 +127,42 @@ var _ = Describe("Network Binding", func() {
 			Expect(err).To(HaveOccurred())
 		})
 	})
+
+	Context("pod network resource", func() {
+		const testResourceName = "vendor.com/vdpa"
+
+		DescribeTable("should map the pod network to the resource of its binding plugin",
+			func(vmi *v1.VirtualMachineInstance, bindings map[string]v1.InterfaceBindingPlugin, expectedResources map[string]string) {
+				Expect(netbinding.PodNetworkToResource(vmi, bindings)).To(Equal(expectedResources))
+			},
+			Entry("when the pod network interface uses a binding plugin with a resource",
+				libvmi.New(
+					libvmi.WithInterface(v1.Interface{Name: "default", Binding: &v1.PluginBinding{Name: testBindingName1}}),
+					libvmi.WithNetwork(v1.DefaultPodNetwork()),
+				),
+				map[string]v1.InterfaceBindingPlugin{testBindingName1: {DownwardAPI: v1.DeviceInfo, ResourceName: testResourceName}},
+				map[string]string{"default": testResourceName}),
+			Entry("not when the binding plugin has no resource",
+				libvmi.New(
+					libvmi.WithInterface(v1.Interface{Name: "default", Binding: &v1.PluginBinding{Name: testBindingName1}}),
+					libvmi.WithNetwork(v1.DefaultPodNetwork()),
+				),
+				map[string]v1.InterfaceBindingPlugin{testBindingName1: {SidecarImage: testSidecarImage1}},
+				nil),
+			Entry("not when the pod network interface does not use a binding plugin",
+				libvmi.New(
+					libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+					libvmi.WithNetwork(v1.DefaultPodNetwork()),
+				),
+				map[string]v1.InterfaceBindingPlugin{testBindingName1: {ResourceName: testResourceName}},
+				nil),
+			Entry("not for secondary networks",
+				libvmi.New(
+					libvmi.WithInterface(v1.Interface{Name: testNetworkName1, Binding: &v1.PluginBinding{Name: testBindingName1}}),
+					libvmi.WithNetwork(libvmi.MultusNetwork(testNetworkName1, "nad")),
+				),
+				map[string]v1.InterfaceBindingPlugin{testBindingName1: {ResourceName: testResourceName}},
+				nil),
+		)
+	})
 })
@@ -20,6 +20,8 @@
 package annotations
 
 import (
+	"maps"
+
 	k8scorev1 "k8s.io/api/core/v1"
 
 	networkv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
@@ -32,12 +34,14 @@ import (
 	"kubevirt.io/kubevirt/pkg/network/istio"
 	"kubevirt.io/kubevirt/pkg/network/multus"
 	"kubevirt.io/kubevirt/pkg/network/namescheme"
+	"kubevirt.io/kubevirt/pkg/network/nativenetwork"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 )
 
 type clusterConfigurer interface {
 	GetNetworkBindings() map[string]v1.InterfaceBindingPlugin
 	PodSecondaryInterfaceNamingUpgradeEnabled() bool
+	ScopedNetworkInfoEnabled() bool
 }
 
 type Generator struct {
@@ -72,6 +76,15 @@ func (g Generator) Generate(vmi *v1.VirtualMachineInstance) (map[string]string,
 		annotations[networkv1.NetworkAttachmentAnnot] = multusAnnotation
 	}
 
+	podNetworksAnnotation, err := nativenetwork.GeneratePodNetworksAnnotation(nonAbsentNets)
+	if err != nil {
+		return nil, err
+	}
+
+	if podNetworksAnnotation != "" {
+		annotations[nativenetwork.PodNetworksAnnotation] = podNetworksAnnotation
+	}
+
 	defaultMultusNetworks := vmispec.FilterNetworksSpec(vmi.Spec.Networks, func(network v1.Network) bool {
 		return network.NetworkSource.Multus != nil && network.NetworkSource.Multus.Default
 	})
@@ -109,7 +122,18 @@ func (g Generator) GenerateFromSource(vmi *v1.VirtualMachineInstance, sourcePod
 		return nil, err
 	}
 
-	return map[string]string{networkv1.NetworkAttachmentAnnot: multusNetworksAnnotation}, nil
+	annotations := map[string]string{networkv1.NetworkAttachmentAnnot: multusNetworksAnnotation}
+
+	podNetworksAnnotation, err := nativenetwork.GeneratePodNetworksAnnotationFromNameScheme(vmi.Spec.Networks, ordinalNameScheme)
+	if err != nil {
+		return nil, err
+	}
+
+	if podNetworksAnnotation != "" {
+		annotations[nativenetwork.PodNetworksAnnotation] = podNetworksAnnotation
+	}
+
+	return annotations, nil
 }
 
 // GenerateFromActivePod generates additional pod annotations, bases on information that exists on a live virt-launcher pod
@@ -120,13 +144,42 @@ func (g Generator) GenerateFromActivePod(vmi *v1.VirtualMachineInstance, pod *k8
 		annotations[downwardapi.NetworkInfoAnnot] = networkInfoAnnotation
 	}
 
+	if g.clusterConfigurer.ScopedNetworkInfoEnabled() {
+		maps.Copy(annotations, g.generatePluginNetworkInfoAnnotations(vmi, pod))
+	}
+
 	if updatedMultusAnnotation, shouldUpdate := g.generateMultusAnnotation(vmi, pod); shouldUpdate {
 		annotations[networkv1.NetworkAttachmentAnnot] = updatedMultusAnnotation
 	}
 
+	if updatedPodNetworksAnnotation, shouldUpdate := generatePodNetworksAnnotation(vmi, pod); shouldUpdate {
+		annotations[nativenetwork.PodNetworksAnnotation] = updatedPodNetworksAnnotation
+	}
+
 	return annotations
 }
 
+// generatePodNetworksAnnotation updates the native network attachment requests of the pod when
+// native network interfaces are hot plugged or unplugged, keeping the pod interface names in use.
+func generatePodNetworksAnnotation(vmi *v1.VirtualMachineInstance, pod *k8scorev1.Pod) (string, bool) {
+	_, vmiSpecNets, ifaceChangeRequired := ifacesAndNetsForMultusAnnotationUpdate(vmi)
+	if !ifaceChangeRequired {
+		return "", false
+	}
+
+	podIfaceNamesByNetworkName := namescheme.CreateFromNetworkStatuses(vmiSpecNets, multus.NetworkStatusesFromPod(pod))
+	updatedPodNetworksAnnotation, err := nativenetwork.GeneratePodNetworksAnnotationFromNameScheme(vmiSpecNets, podIfaceNamesByNetworkName)
+	if err != nil {
+		return "", false
+	}
+
+	if updatedPodNetworksAnnotation == pod.Annotations[nativenetwork.PodNetworksAnnotation] {
+		return "", false
+	}
+
+	return updatedPodNetworksAnnotation, true
+}
+
 func (g Generator) generateMultusAnnotation(vmi *v1.VirtualMachineInstance, pod *k8scorev1.Pod) (string, bool) {
 	vmiSpecIfaces, vmiSpecNets, ifaceChangeRequired := ifacesAndNetsForMultusAnnotationUpdate(vmi)
 	if !ifaceChangeRequired {
@@ -161,17 +214,44 @@ func (g Generator) generateMultusAnnotation(vmi *v1.VirtualMachineInstance, pod
 	return updatedMultusAnnotation, true
 }
 
+// generateNetworkInfoAnnotation generates the network-info annotation shared by the compute container and the binding
+// plugin sidecars. When the network-info is scoped, binding plugin interfaces are left out of it.
 func (g Generator) generateNetworkInfoAnnotation(vmi *v1.VirtualMachineInstance, pod *k8scorev1.Pod) string {
+	scopedNetworkInfo := g.clusterConfigurer.ScopedNetworkInfoEnabled()
 	ifaces := vmispec.FilterInterfacesSpec(vmi.Spec.Domain.Devices.Interfaces, func(iface v1.Interface) bool {
-		return iface.SRIOV != nil || vmispec.HasBindingPluginDeviceInfo(iface, g.clusterConfigurer.GetNetworkBindings())
+		return iface.SRIOV != nil ||
+			(!scopedNetworkInfo && vmispec.HasBindingPluginDeviceInfo(iface, g.clusterConfigurer.GetNetworkBindings()))
 	})
 
+	return generateNetworkInfoAnnotationValue(ifaces, vmi.Spec.Networks, pod)
+}
+
+// generatePluginNetworkInfoAnnotations generates a network-info annotation per binding plugin with device info,
+// holding the interfaces bound to the plugin only.
+func (g Generator) generatePluginNetworkInfoAnnotations(vmi *v1.VirtualMachineInstance, pod *k8scorev1.Pod) map[string]string {
+	ifacesByPluginName := map[string][]v1.Interface{}
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		if vmispec.HasBindingPluginDeviceInfo(iface, g.clusterConfigurer.GetNetworkBindings()) {
+			ifacesByPluginName[iface.Binding.Name] = append(ifacesByPluginName[iface.Binding.Name], iface)
+		}
+	}
+
+	annotations := map[string]string{}
+	for pluginName, ifaces := range ifacesByPluginName {
+		if networkInfoAnnotation := generateNetworkInfoAnnotationValue(ifaces, vmi.Spec.Networks, pod); networkInfoAnnotation != "" {
+			annotations[downwardapi.PluginNetworkInfoAnnot(pluginName)] = networkInfoAnnotation
+		}
+	}
+	return annotations
+}
+
+func generateNetworkInfoAnnotationValue(ifaces []v1.Interface, networks []v1.Network, pod *k8scorev1.Pod) string {
 	if len(ifaces) == 0 {
 		return ""
 	}
 
 	multusNetworkStatuses := multus.NetworkStatusesFromPod(pod)
-	networkStatusesByNetworkName := mapNetworkStatusesByNetworkName(ifaces, vmi.Spec.Networks, multusNetworkStatuses)
+	networkStatusesByNetworkName := mapNetworkStatusesByNetworkName(ifaces, networks, multusNetworkStatuses)
 	if len(networkStatusesByNetworkName) == 0 {
 		return ""
 	}
@@ -225,6 +305,13 @@ func mapNetworkStatusesByNetworkName(
 
 	networkStatusesByNetworkName := map[string]networkv1.NetworkStatus{}
 	for _, iface := range ifaces {
+		// The default CNI status may not specify the pod interface name, thus it is looked up by its default mark.
+		if podNetwork := vmispec.LookupPodNetwork(networks); podNetwork != nil && podNetwork.Name == iface.Name {
+			if ns := multus.LookupPodPrimaryNetworkStatus(multusNetworkStatuses); ns != nil {
+				networkStatusesByNetworkName[iface.Name] = *ns
+			}
+			continue
+		}
 		podIfaceName := podIfaceNameByNetworkName[iface.Name]
 		ns, exists := networkStatusesByPodIfaceName[podIfaceName]
 		if !exists {
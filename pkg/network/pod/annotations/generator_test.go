@@ -38,6 +38,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/network/downwardapi"
 	"kubevirt.io/kubevirt/pkg/network/istio"
 	"kubevirt.io/kubevirt/pkg/network/multus"
+	"kubevirt.io/kubevirt/pkg/network/nativenetwork"
 	"kubevirt.io/kubevirt/pkg/network/pod/annotations"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 )
@@ -125,6 +126,37 @@ var _ = Describe("Annotations Generator", func() {
 		})
 	})
 
+	Context("Native multi-network", func() {
+		It("should generate the pod networks annotation for native networks only", func() {
+			const (
+				multusNetworkName = "red"
+				nativeNetworkName = "blue"
+			)
+			vmi := libvmi.New(
+				libvmi.WithNamespace(testNamespace),
+				libvmi.WithInterface(*v1.DefaultMasqueradeNetworkInterface()),
+				libvmi.WithInterface(libvmi.InterfaceDeviceWithBridgeBinding(multusNetworkName)),
+				libvmi.WithInterface(libvmi.InterfaceDeviceWithBridgeBinding(nativeNetworkName)),
+				libvmi.WithNetwork(v1.DefaultPodNetwork()),
+				libvmi.WithNetwork(libvmi.MultusNetwork(multusNetworkName, "red-nad")),
+				libvmi.WithNetwork(libvmi.NativeNetwork(nativeNetworkName, "blue-podnetwork")),
+			)
+
+			generator := annotations.NewGenerator(stubClusterConfig{})
+			annotations, err := generator.Generate(vmi)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(annotations).To(HaveKeyWithValue(
+				networkv1.NetworkAttachmentAnnot,
+				"[{\"name\":\"red-nad\",\"namespace\":\"default\",\"interface\":\"podb1f51a511f1\"}]",
+			))
+			Expect(annotations).To(HaveKeyWithValue(
+				nativenetwork.PodNetworksAnnotation,
+				"[{\"podNetworkName\":\"blue-podnetwork\",\"interfaceName\":\"pod16477688c0e\"}]",
+			))
+		})
+	})
+
 	Context("Istio annotations", func() {
 		It("should generate Istio annotation when VMI is connected to pod network using masquerade binding", func() {
 			vmi := libvmi.New(
@@ -205,6 +237,26 @@ var _ = Describe("Annotations Generator", func() {
 			Expect(convertedAnnotations[networkv1.NetworkAttachmentAnnot]).To(MatchJSON(expectedMultusNetworksAnnotation))
 		})
 
+		It("should convert the naming scheme of native networks when source pod has ordinal naming", func() {
+			const nativeNetworkName = "green"
+			vmi.Spec.Domain.Devices.Interfaces = append(vmi.Spec.Domain.Devices.Interfaces,
+				libvmi.InterfaceDeviceWithBridgeBinding(nativeNetworkName))
+			vmi.Spec.Networks = append(vmi.Spec.Networks, *libvmi.NativeNetwork(nativeNetworkName, "green-podnetwork"))
+
+			sourcePodAnnotations := map[string]string{}
+			sourcePodAnnotations[networkv1.NetworkStatusAnnot] = ordinalMultusNetworkStatus
+
+			sourcePod := newStubVirtLauncherPod(vmi, sourcePodAnnotations)
+
+			generator := annotations.NewGenerator(stubClusterConfig{})
+			convertedAnnotations, err := generator.GenerateFromSource(vmi, sourcePod)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(convertedAnnotations[nativenetwork.PodNetworksAnnotation]).To(
+				MatchJSON(`[{"podNetworkName":"green-podnetwork","interfaceName":"net3"}]`),
+			)
+		})
+
 		It("should not convert the naming scheme when source pod does not have ordinal naming", func() {
 			sourcePodAnnotations := map[string]string{}
 			sourcePodAnnotations[networkv1.NetworkStatusAnnot] = `[
@@ -289,10 +341,10 @@ var _ = Describe("Annotations Generator", func() {
 		var clusterConfig stubClusterConfig
 
 		BeforeEach(func() {
-			clusterConfig.registeredPlugins = map[string]v1.InterfaceBindingPlugin{
+			clusterConfig = stubClusterConfig{registeredPlugins: map[string]v1.InterfaceBindingPlugin{
 				deviceInfoPlugin:    {DownwardAPI: v1.DeviceInfo},
 				nonDeviceInfoPlugin: {},
-			}
+			}}
 		})
 
 		It("Should not generate the network info annotation when there are no networks", func() {
@@ -388,6 +440,30 @@ var _ = Describe("Annotations Generator", func() {
 			))
 		})
 
+		It("Should generate the network info annotation when the pod network uses a binding plugin with device info", func() {
+			vmi := libvmi.New(
+				libvmi.WithNamespace(testNamespace),
+				libvmi.WithInterface(libvmi.InterfaceWithBindingPlugin(v1.DefaultPodNetwork().Name, v1.PluginBinding{Name: deviceInfoPlugin})),
+				libvmi.WithNetwork(v1.DefaultPodNetwork()),
+			)
+
+			const multusNetworkStatusWithPrimaryNetWithDeviceInfo = `[` +
+				`{"name":"k8s-pod-network","ips":["10.244.196.146"],"mac":"3a:17:d7:e5:0f:09","default":true,"dns":{},` +
+				`"device-info":{"type":"vdpa","version":"1.1.0","vdpa":{"parent-device":"vdpa:0000:65:00.5","driver":"vhost","path":"/dev/vhost-vdpa-1"}}}` +
+				`]`
+
+			podAnnotations := map[string]string{networkv1.NetworkStatusAnnot: multusNetworkStatusWithPrimaryNetWithDeviceInfo}
+
+			generator := annotations.NewGenerator(clusterConfig)
+			actualAnnotations := generator.GenerateFromActivePod(vmi, newStubVirtLauncherPod(vmi, podAnnotations))
+
+			Expect(actualAnnotations).To(HaveKeyWithValue(
+				downwardapi.NetworkInfoAnnot,
+				`{"interfaces":[{"network":"default","deviceInfo":{"type":"vdpa","version":"1.1.0",`+
+					`"vdpa":{"parent-device":"vdpa:0000:65:00.5","driver":"vhost","path":"/dev/vhost-vdpa-1"}},"mac":"3a:17:d7:e5:0f:09"}]}`,
+			))
+		})
+
 		It("Should generate the network info annotation when there is an SR-IOV interface", func() {
 			vmi := libvmi.New(
 				libvmi.WithNamespace(testNamespace),
@@ -411,6 +487,30 @@ var _ = Describe("Annotations Generator", func() {
 			))
 		})
 
+		It("Should generate the network info annotation when there is an SR-IOV interface on a native network", func() {
+			vmi := libvmi.New(
+				libvmi.WithNamespace(testNamespace),
+				libvmi.WithInterface(libvmi.InterfaceDeviceWithSRIOVBinding(networkName3)),
+				libvmi.WithNetwork(libvmi.NativeNetwork(networkName3, "sriov-podnetwork")),
+			)
+
+			const networkStatusWithPrimaryAndOrdinalSRIOVSecondaryNet = `[` +
+				`{"name":"k8s-pod-network","ips":["10.244.196.146","fd10:244::c491"],"default":true,"dns":{}},` +
+				`{"name":"sriov-podnetwork","interface":"net1","dns":{},` +
+				`"device-info":{"type":"pci","version":"1.0.0","pci":{"pci-address":"0000:65:00.3"}}}` +
+				`]`
+
+			podAnnotations := map[string]string{networkv1.NetworkStatusAnnot: networkStatusWithPrimaryAndOrdinalSRIOVSecondaryNet}
+
+			generator := annotations.NewGenerator(clusterConfig)
+			actualAnnotations := generator.GenerateFromActivePod(vmi, newStubVirtLauncherPod(vmi, podAnnotations))
+
+			Expect(actualAnnotations).To(HaveKeyWithValue(
+				downwardapi.NetworkInfoAnnot,
+				`{"interfaces":[{"network":"doo","deviceInfo":{"type":"pci","version":"1.0.0","pci":{"pci-address":"0000:65:00.3"}}}]}`,
+			))
+		})
+
 		It("Should generate the network info annotation when there is SR-IOV interface and binding plugin interface with device-info", func() {
 			vmi := libvmi.New(
 				libvmi.WithNamespace(testNamespace),
@@ -467,6 +567,62 @@ var _ = Describe("Annotations Generator", func() {
 
 			Expect(actualNetInfo.Interfaces).To(Equal(expectedNetInfo))
 		})
+
+		It("Should scope the binding plugin interfaces to the plugin network info annotation when scoped network info is enabled", func() {
+			clusterConfig.scopedNetworkInfoEnabled = true
+			vmi := libvmi.New(
+				libvmi.WithNamespace(testNamespace),
+				libvmi.WithInterface(libvmi.InterfaceWithBindingPlugin(networkName2, v1.PluginBinding{Name: deviceInfoPlugin})),
+				libvmi.WithInterface(libvmi.InterfaceDeviceWithSRIOVBinding(networkName3)),
+				libvmi.WithInterface(libvmi.InterfaceWithBindingPlugin(networkName1, v1.PluginBinding{Name: nonDeviceInfoPlugin})),
+				libvmi.WithNetwork(libvmi.MultusNetwork(networkName2, networkAttachmentDefinitionName2)),
+				libvmi.WithNetwork(libvmi.MultusNetwork(networkName3, networkAttachmentDefinitionName3)),
+				libvmi.WithNetwork(libvmi.MultusNetwork(networkName1, networkAttachmentDefinitionName1)),
+			)
+
+			const multusNetworkStatusWithPrimaryAndThreeSecondaryNets = `[` +
+				`{"name":"k8s-pod-network","ips":["10.244.196.146","fd10:244::c491"],"default":true,"dns":{}},` +
+				multusNetworkStatusEntryForDeviceInfo + "," +
+				multusNetworkStatusEntryForSRIOV + "," +
+				`{"name":"default/no-device-info","interface":"pod6446d58d6df","mac":"8a:37:d9:e7:0f:18","dns":{}}` +
+				`]`
+
+			podAnnotations := map[string]string{networkv1.NetworkStatusAnnot: multusNetworkStatusWithPrimaryAndThreeSecondaryNets}
+
+			generator := annotations.NewGenerator(clusterConfig)
+			actualAnnotations := generator.GenerateFromActivePod(vmi, newStubVirtLauncherPod(vmi, podAnnotations))
+
+			Expect(actualAnnotations).To(HaveKeyWithValue(
+				downwardapi.NetworkInfoAnnot,
+				`{"interfaces":[{"network":"doo","deviceInfo":{"type":"pci","version":"1.0.0","pci":{"pci-address":"0000:65:00.3"}}}]}`,
+			))
+			Expect(actualAnnotations).To(HaveKeyWithValue(
+				downwardapi.PluginNetworkInfoAnnot(deviceInfoPlugin),
+				`{"interfaces":[{"network":"foo","deviceInfo":{"type":"pci","version":"1.0.0","pci":{"pci-address":"0000:65:00.2"}}}]}`,
+			))
+			Expect(actualAnnotations).ToNot(HaveKey(downwardapi.PluginNetworkInfoAnnot(nonDeviceInfoPlugin)))
+		})
+
+		It("Should not generate the plugin network info annotation when scoped network info is disabled", func() {
+			vmi := libvmi.New(
+				libvmi.WithNamespace(testNamespace),
+				libvmi.WithInterface(libvmi.InterfaceWithBindingPlugin(networkName2, v1.PluginBinding{Name: deviceInfoPlugin})),
+				libvmi.WithNetwork(libvmi.MultusNetwork(networkName2, networkAttachmentDefinitionName2)),
+			)
+
+			const multusNetworkStatusWithPrimaryAndSecondaryNetsWithDeviceInfo = `[` +
+				`{"name":"k8s-pod-network","ips":["10.244.196.146","fd10:244::c491"],"default":true,"dns":{}},` +
+				multusNetworkStatusEntryForDeviceInfo +
+				`]`
+
+			podAnnotations := map[string]string{networkv1.NetworkStatusAnnot: multusNetworkStatusWithPrimaryAndSecondaryNetsWithDeviceInfo}
+
+			generator := annotations.NewGenerator(clusterConfig)
+			actualAnnotations := generator.GenerateFromActivePod(vmi, newStubVirtLauncherPod(vmi, podAnnotations))
+
+			Expect(actualAnnotations).To(HaveKey(downwardapi.NetworkInfoAnnot))
+			Expect(actualAnnotations).ToNot(HaveKey(downwardapi.PluginNetworkInfoAnnot(deviceInfoPlugin)))
+		})
 	})
 
 	Context("NIC Hotplug / Hotunplug", func() {
@@ -733,6 +889,40 @@ var _ = Describe("Annotations Generator", func() {
 			Expect(annotations[networkv1.NetworkAttachmentAnnot]).To(MatchJSON(expectedMultusNetAttach))
 		})
 
+		It("Should generate the pod networks annotation when a native network interface is hot plugged", func() {
+			vmi := libvmi.New(
+				libvmi.WithNamespace(testNamespace),
+				libvmi.WithInterface(*v1.DefaultBridgeNetworkInterface()),
+				libvmi.WithInterface(libvmi.InterfaceDeviceWithBridgeBinding(network1Name)),
+				libvmi.WithInterface(libvmi.InterfaceDeviceWithBridgeBinding(network2Name)),
+				libvmi.WithNetwork(v1.DefaultPodNetwork()),
+				libvmi.WithNetwork(libvmi.MultusNetwork(network1Name, networkAttachmentDefinitionName1)),
+				libvmi.WithNetwork(libvmi.NativeNetwork(network2Name, "blue-podnetwork")),
+				libvmistatus.WithStatus(
+					libvmistatus.New(
+						libvmistatus.WithInterfaceStatus(v1.VirtualMachineInstanceNetworkInterface{Name: "default", PodInterfaceName: "eth0"}),
+						libvmistatus.WithInterfaceStatus(v1.VirtualMachineInstanceNetworkInterface{
+							Name:       network1Name,
+							InfoSource: vmispec.InfoSourceMultusStatus,
+						}),
+					),
+				),
+			)
+
+			podAnnotations := map[string]string{
+				networkv1.NetworkAttachmentAnnot: multusNetworksAnnotation,
+				networkv1.NetworkStatusAnnot:     multusNetworkStatusWithPrimaryAndSecondaryNets,
+			}
+
+			generator := annotations.NewGenerator(stubClusterConfig{})
+			annotations := generator.GenerateFromActivePod(vmi, newStubVirtLauncherPod(vmi, podAnnotations))
+
+			Expect(annotations).To(HaveKeyWithValue(
+				nativenetwork.PodNetworksAnnotation,
+				`[{"podNetworkName":"blue-podnetwork","interfaceName":"pod16477688c0e"}]`,
+			))
+		})
+
 		It("Should remove the Multus network attachment annotation when the last secondary interface is hot unplugged", func() {
 			ifaceWithStateAbsent := libvmi.InterfaceDeviceWithBridgeBinding(network1Name)
 			ifaceWithStateAbsent.State = v1.InterfaceStateAbsent
@@ -791,6 +981,7 @@ func newStubVirtLauncherPod(vmi *v1.VirtualMachineInstance, podAnnotations map[s
 type stubClusterConfig struct {
 	registeredPlugins                     map[string]v1.InterfaceBindingPlugin
 	podSecondaryIfaceNamingUpgradeEnabled bool
+	scopedNetworkInfoEnabled              bool
 }
 
 func (s stubClusterConfig) GetNetworkBindings() map[string]v1.InterfaceBindingPlugin {
@@ -800,3 +991,7 @@ func (s stubClusterConfig) GetNetworkBindings() map[string]v1.InterfaceBindingPl
 func (s stubClusterConfig) PodSecondaryInterfaceNamingUpgradeEnabled() bool {
 	return s.podSecondaryIfaceNamingUpgradeEnabled
 }
+
+func (s stubClusterConfig) ScopedNetworkInfoEnabled() bool {
+	return s.scopedNetworkInfoEnabled
+}
@@ -0,0 +1,43 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package resources
+
+import (
+	k8scorev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+type CPUCalculator struct{}
+
+// Calculate returns the CPU the binding plugins used by the VMI interfaces add to the compute container,
+// e.g. for the vhost-user backend threads they spawn. Each plugin is accounted once, whatever the number of
+// interfaces using it.
+func (cc CPUCalculator) Calculate(
+	vmi *v1.VirtualMachineInstance,
+	registeredPlugins map[string]v1.InterfaceBindingPlugin,
+) resource.Quantity {
+	return sumPluginsRequests(
+		filterUniquePlugins(vmi.Spec.Domain.Devices.Interfaces, registeredPlugins),
+		k8scorev1.ResourceCPU,
+	)
+}
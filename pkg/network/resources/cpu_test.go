@@ -0,0 +1,103 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package resources_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/network/resources"
+
+	k8scorev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+)
+
+var _ = Describe("Network Binding plugin compute CPU overhead", func() {
+	const (
+		iface1name  = "net1"
+		iface2name  = "net2"
+		iface3name  = "net3"
+		plugin1name = "plugin1"
+		plugin2name = "plugin2"
+	)
+
+	newCPUPlugin := func(cpu string) v1.InterfaceBindingPlugin {
+		return newPlugin(&v1.ResourceRequirementsWithoutClaims{
+			Requests: map[k8scorev1.ResourceName]resource.Quantity{
+				k8scorev1.ResourceCPU: resource.MustParse(cpu),
+			},
+		})
+	}
+
+	DescribeTable("CPU overhead should be zero",
+		func(vmi *v1.VirtualMachineInstance, registeredPlugins map[string]v1.InterfaceBindingPlugin) {
+			cpuCalculator := resources.CPUCalculator{}
+
+			actualResult := cpuCalculator.Calculate(vmi, registeredPlugins)
+			Expect(actualResult.IsZero()).To(BeTrue())
+		},
+		Entry("when the VMI does not have NICs and there aren't any registered plugins", libvmi.New(), nil),
+		Entry("when no binding plugin is used on the VMI and there are registered plugins",
+			libvmi.New(
+				libvmi.WithInterface(*v1.DefaultBridgeNetworkInterface()),
+				libvmi.WithNetwork(v1.DefaultPodNetwork()),
+			),
+			map[string]v1.InterfaceBindingPlugin{plugin1name: newCPUPlugin("100m")},
+		),
+		Entry("when binding plugin is used on the VMI, but it does not require CPU overhead",
+			libvmi.New(
+				libvmi.WithInterface(v1.Interface{Name: iface1name, Binding: &v1.PluginBinding{Name: plugin1name}}),
+				libvmi.WithNetwork(&v1.Network{Name: iface1name}),
+			),
+			map[string]v1.InterfaceBindingPlugin{
+				plugin1name: newPlugin(
+					&v1.ResourceRequirementsWithoutClaims{
+						Requests: map[k8scorev1.ResourceName]resource.Quantity{
+							k8scorev1.ResourceMemory: resource.MustParse("500Mi"),
+						},
+					},
+				),
+			},
+		),
+	)
+
+	It("should sum the CPU overhead of each binding plugin used by the VMI once", func() {
+		vmi := libvmi.New(
+			libvmi.WithInterface(v1.Interface{Name: iface1name, Binding: &v1.PluginBinding{Name: plugin1name}}),
+			libvmi.WithNetwork(&v1.Network{Name: iface1name}),
+			libvmi.WithInterface(v1.Interface{Name: iface2name, Binding: &v1.PluginBinding{Name: plugin1name}}),
+			libvmi.WithNetwork(&v1.Network{Name: iface2name}),
+			libvmi.WithInterface(v1.Interface{Name: iface3name, Binding: &v1.PluginBinding{Name: plugin2name}}),
+			libvmi.WithNetwork(&v1.Network{Name: iface3name}),
+		)
+		registeredPlugins := map[string]v1.InterfaceBindingPlugin{
+			plugin1name: newCPUPlugin("200m"),
+			plugin2name: newCPUPlugin("1"),
+		}
+
+		actualResult := resources.CPUCalculator{}.Calculate(vmi, registeredPlugins)
+		Expect(actualResult.MilliValue()).To(Equal(int64(1200)))
+	})
+})
@@ -41,8 +41,9 @@ func (mc MemoryCalculator) Calculate(
 		totalMemory.Add(getPasstMemoryOverhead())
 	}
 
-	totalMemory.Add(sumPluginsMemoryRequests(
+	totalMemory.Add(sumPluginsRequests(
 		filterUniquePlugins(vmi.Spec.Domain.Devices.Interfaces, registeredPlugins),
+		k8scorev1.ResourceMemory,
 	))
 
 	return totalMemory
@@ -75,7 +76,7 @@ func filterUniquePlugins(interfaces []v1.Interface, registeredPlugins map[string
 	return uniquePlugins
 }
 
-func sumPluginsMemoryRequests(uniquePlugins []v1.InterfaceBindingPlugin) resource.Quantity {
+func sumPluginsRequests(uniquePlugins []v1.InterfaceBindingPlugin, resourceName k8scorev1.ResourceName) resource.Quantity {
 	result := resource.Quantity{}
 
 	for _, plugin := range uniquePlugins {
@@ -88,7 +89,7 @@ func sumPluginsMemoryRequests(uniquePlugins []v1.InterfaceBindingPlugin) resourc
 			continue
 		}
 
-		result.Add(requests[k8scorev1.ResourceMemory])
+		result.Add(requests[resourceName])
 	}
 
 	return result
@@ -37,7 +37,11 @@ func FilterNetsForLiveUpdate(vmi *v1.VirtualMachineInstance) []v1.Network {
 	netsToHotplug := filterNetsToHotplug(vmi.Spec.Domain.Devices.Interfaces, vmi.Spec.Networks, vmi.Status.Interfaces)
 	netsToHotunplug := filterNetsToHotunplug(vmi.Spec.Domain.Devices.Interfaces, vmi.Spec.Networks, vmi.Status.Interfaces)
 
-	return append(netsToHotplug, netsToHotunplug...)
+	netsWithEnforcedPorts := vmispec.FilterNetworksByInterfaces(vmi.Spec.Networks, vmispec.FilterInterfacesSpec(
+		vmi.Spec.Domain.Devices.Interfaces, vmispec.HasEnforcedPorts,
+	))
+
+	return append(append(netsToHotplug, netsToHotunplug...), netsWithEnforcedPorts...)
 }
 
 func FilterNetsForMigrationTarget(vmi *v1.VirtualMachineInstance) []v1.Network {
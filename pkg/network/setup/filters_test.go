@@ -206,6 +206,26 @@ var _ = Describe("Network setup filters", func() {
 		})
 	})
 
+	It("FilterNetsForLiveUpdate should return the networks whose interface has enforced ports", func() {
+		masqueradeIface := *v1.DefaultMasqueradeNetworkInterface()
+		masqueradeIface.PortsEnforcement = v1.PortsEnforcementStrict
+
+		vmi := libvmi.New(
+			libvmi.WithInterface(masqueradeIface),
+			libvmi.WithNetwork(v1.DefaultPodNetwork()),
+			libvmistatus.WithStatus(
+				libvmistatus.New(
+					libvmistatus.WithInterfaceStatus(v1.VirtualMachineInstanceNetworkInterface{
+						Name:       "default",
+						InfoSource: vmispec.InfoSourceDomain,
+					}),
+				),
+			),
+		)
+
+		Expect(network.FilterNetsForLiveUpdate(vmi)).To(Equal([]v1.Network{*v1.DefaultPodNetwork()}))
+	})
+
 	Context("FilterNetsForMigrationTarget", func() {
 		It("Should return a list of all networks - no matter their interface state", func() {
 			const absentNetName = "absent-net"
@@ -111,6 +111,7 @@ func (c *NetConf) Setup(vmi *v1.VirtualMachineInstance, networks []v1.Network, l
 		netpod.WithBindingPlugins(c.clusterConfigurer.GetNetworkBindings()),
 		netpod.WithLogger(log.Log.Object(vmi)),
 		netpod.WithVMIIfaceStatuses(vmi.Status.Interfaces),
+		netpod.WithMetadataService(vmi.Spec.MetadataService != nil),
 	)
 
 	if err := netpod.Setup(); err != nil {
@@ -0,0 +1,143 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package masquerade
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/driver/nft"
+	"kubevirt.io/kubevirt/pkg/network/driver/nmstate"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+const (
+	filterTable = "filter"
+
+	forwardChain          = "forward"
+	kubevirtPortsChain    = "KUBEVIRT_PORTS"
+	kubevirtFirewallChain = "KUBEVIRT_FIREWALL"
+)
+
+// setupFilterByFamily filters the traffic forwarded to the guest through the bridge,
+// by the enforced ports first and by the firewall rules next.
+func (m MasqPod) setupFilterByFamily(family nft.IPFamily, bridgeIfaceSpec *nmstate.Interface, vmiIface v1.Interface) error {
+	if !vmispec.HasEnforcedPorts(vmiIface) && vmiIface.Firewall == nil {
+		return nil
+	}
+
+	if err := m.nftable.AddTable(family, filterTable); err != nil {
+		return err
+	}
+	if err := m.nftable.AddChain(family, filterTable, forwardChain, "{ type filter hook forward priority 0; }"); err != nil {
+		return err
+	}
+	if err := m.setupPortsEnforcementByFamily(family, bridgeIfaceSpec, vmiIface); err != nil {
+		return err
+	}
+	return m.setupFirewallByFamily(family, bridgeIfaceSpec, vmiIface.Firewall)
+}
+
+// setupFirewallByFamily filters the traffic forwarded to the guest through the bridge,
+// accepting only the connections allowed by the firewall rules and the replies to
+// the connections initiated by the guest.
+// Rules matching only sources of the other IP family are not rendered.
+func (m MasqPod) setupFirewallByFamily(family nft.IPFamily, bridgeIfaceSpec *nmstate.Interface, firewall *v1.InterfaceFirewall) error {
+	if firewall == nil {
+		return nil
+	}
+
+	if err := m.nftable.AddChain(family, filterTable, kubevirtFirewallChain); err != nil {
+		return err
+	}
+	if err := m.nftable.AddRule(family, filterTable, forwardChain, "oifname", bridgeIfaceSpec.Name, "counter", "jump", kubevirtFirewallChain); err != nil {
+		return err
+	}
+	if err := m.nftable.AddRule(family, filterTable, kubevirtFirewallChain, "ct", "state", "{ established, related }", "counter", "accept"); err != nil {
+		return err
+	}
+
+	for _, rule := range firewall.Ingress {
+		rulespec, applicable := firewallRuleSpec(family, rule)
+		if !applicable {
+			continue
+		}
+		if err := m.nftable.AddRule(family, filterTable, kubevirtFirewallChain, append(rulespec, "counter", "accept")...); err != nil {
+			return fmt.Errorf("failed to define firewall rule %+v for family %s, err: %v", rule, family, err)
+		}
+	}
+
+	return m.nftable.AddRule(family, filterTable, kubevirtFirewallChain, "counter", "drop")
+}
+
+func firewallRuleSpec(family nft.IPFamily, rule v1.FirewallRule) ([]string, bool) {
+	var rulespec []string
+
+	if len(rule.From) > 0 {
+		cidrs := cidrsByFamily(family, rule.From)
+		if len(cidrs) == 0 {
+			return nil, false
+		}
+		rulespec = append(rulespec, string(family), "saddr", fmt.Sprintf("{ %s }", strings.Join(cidrs, ", ")))
+	}
+
+	switch protocol := strings.ToLower(rule.Protocol); protocol {
+	case "tcp", "udp":
+		if len(rule.Ports) > 0 {
+			var ports []string
+			for _, port := range rule.Ports {
+				ports = append(ports, strconv.Itoa(int(port)))
+			}
+			rulespec = append(rulespec, protocol, "dport", fmt.Sprintf("{ %s }", strings.Join(ports, ", ")))
+		} else {
+			rulespec = append(rulespec, "meta", "l4proto", protocol)
+		}
+	case "icmp":
+		rulespec = append(rulespec, "meta", "l4proto", icmpProtocol(family))
+	}
+
+	return rulespec, true
+}
+
+func cidrsByFamily(family nft.IPFamily, cidrs []string) []string {
+	var familyCIDRs []string
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			continue
+		}
+		isIPv4 := ipNet.IP.To4() != nil
+		if isIPv4 == (family == nft.IPv4) {
+			familyCIDRs = append(familyCIDRs, ipNet.String())
+		}
+	}
+	return familyCIDRs
+}
+
+func icmpProtocol(family nft.IPFamily) string {
+	if family == nft.IPv4 {
+		return "icmp"
+	}
+	return "ipv6-icmp"
+}
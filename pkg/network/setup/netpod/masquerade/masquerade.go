@@ -31,6 +31,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/network/driver/nmstate"
 	"kubevirt.io/kubevirt/pkg/network/istio"
 	"kubevirt.io/kubevirt/pkg/network/netmachinery"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
 	"kubevirt.io/kubevirt/pkg/util/net/ip"
 )
 
@@ -38,6 +39,7 @@ type nftable interface {
 	AddTable(family nft.IPFamily, name string) error
 	AddChain(family nft.IPFamily, table, name string, chainspec ...string) error
 	AddRule(family nft.IPFamily, table, chain string, rulespec ...string) error
+	FlushChain(family nft.IPFamily, table, name string) error
 }
 
 type MasqPod struct {
@@ -94,11 +96,60 @@ func (m MasqPod) Setup(bridgeIfaceSpec, podIfaceSpec *nmstate.Interface, vmiIfac
 		if err := m.setupNATByFamily(nft.IPv4, podIfaceSpec, bridgeIfaceSpec, vmiIface); err != nil {
 			return err
 		}
+		if err := m.setupFilterByFamily(nft.IPv4, bridgeIfaceSpec, vmiIface); err != nil {
+			return err
+		}
 	}
 	if bridgeIfaceSpec.IPv6.Enabled != nil && *bridgeIfaceSpec.IPv6.Enabled {
 		if err := m.setupNATByFamily(nft.IPv6, podIfaceSpec, bridgeIfaceSpec, vmiIface); err != nil {
 			return err
 		}
+		if err := m.setupFilterByFamily(nft.IPv6, bridgeIfaceSpec, vmiIface); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdatePorts re-applies the NAT and filtering rules of the interface, so that they match its updated list of ports.
+func (m MasqPod) UpdatePorts(bridgeIfaceSpec, podIfaceSpec *nmstate.Interface, vmiIface v1.Interface) error {
+	if bridgeIfaceSpec.IPv4.Enabled != nil && *bridgeIfaceSpec.IPv4.Enabled {
+		if err := m.flushChainsByFamily(nft.IPv4, vmiIface); err != nil {
+			return err
+		}
+	}
+	if bridgeIfaceSpec.IPv6.Enabled != nil && *bridgeIfaceSpec.IPv6.Enabled {
+		if err := m.flushChainsByFamily(nft.IPv6, vmiIface); err != nil {
+			return err
+		}
+	}
+	return m.Setup(bridgeIfaceSpec, podIfaceSpec, vmiIface)
+}
+
+func (m MasqPod) flushChainsByFamily(family nft.IPFamily, vmiIface v1.Interface) error {
+	natChains := []string{preroutingChain, inputChain, outputChain, postroutingChain, kubevirtPreInboundChain, kubevirtPostInboundChain}
+	if err := m.flushChains(family, natTable, natChains...); err != nil {
+		return err
+	}
+
+	if !vmispec.HasEnforcedPorts(vmiIface) && vmiIface.Firewall == nil {
+		return nil
+	}
+	filterChains := []string{forwardChain}
+	if vmispec.HasEnforcedPorts(vmiIface) {
+		filterChains = append(filterChains, kubevirtPortsChain)
+	}
+	if vmiIface.Firewall != nil {
+		filterChains = append(filterChains, kubevirtFirewallChain)
+	}
+	return m.flushChains(family, filterTable, filterChains...)
+}
+
+func (m MasqPod) flushChains(family nft.IPFamily, table string, chains ...string) error {
+	for _, chain := range chains {
+		if err := m.nftable.FlushChain(family, table, chain); err != nil {
+			return fmt.Errorf("failed to flush chain %s of table %s for family %s, err: %v", chain, table, family, err)
+		}
 	}
 	return nil
 }
@@ -188,7 +239,8 @@ func (m MasqPod) setupNATByFamily(family nft.IPFamily, podIfaceSpec, bridgeIface
 		}
 	}
 
-	if len(vmiIface.Ports) == 0 {
+	// With enforced ports, nothing but the listed ports is forwarded to the guest
+	if len(vmiIface.Ports) == 0 && !vmispec.HasEnforcedPorts(vmiIface) {
 		addressesToSnat := []string{ipLoopback(family)}
 		if m.istioEnabled {
 			// Skip forwarding for the reserved istio ports
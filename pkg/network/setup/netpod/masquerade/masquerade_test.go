@@ -246,6 +246,105 @@ family ip6 table nat chain output rulespec [ip6 daddr { ::1 } counter dnat to fd
 		Expect(nftStub.String()).To(Equal(expectedConfig), fmt.Sprintf("actual:\n%s\n\nexpected:\n%s", nftStub.String(), expectedConfig))
 	})
 
+	It("setup with IPv4 and IPv6, including firewall rules", func() {
+		nftStub := &nftableStub{}
+		masqPod := masquerade.New(masquerade.WithNftableAdapter(nftStub))
+
+		err := masqPod.Setup(
+			&nmstate.Interface{
+				Name:       "k6t-eth0",
+				Index:      1,
+				TypeName:   nmstate.TypeBridge,
+				State:      nmstate.IfaceStateUp,
+				MacAddress: "bb:bb:bb:bb:bb:bb",
+				IPv4: nmstate.IP{
+					Enabled: pointer.P(true),
+					Address: []nmstate.IPAddress{{IP: "10.0.2.1", PrefixLen: 24}},
+				},
+				IPv6: nmstate.IP{
+					Enabled: pointer.P(true),
+					Address: []nmstate.IPAddress{{IP: "fd10:0:2::1", PrefixLen: 120}},
+				},
+				Metadata: &nmstate.IfaceMetadata{Pid: 0, NetworkName: "default"},
+			},
+			&nmstate.Interface{
+				Name:       "eth0",
+				Index:      0,
+				TypeName:   nmstate.TypeVETH,
+				State:      nmstate.IfaceStateUp,
+				MacAddress: "aa:aa:aa:aa:aa:aa",
+				MTU:        1500,
+				IPv4: nmstate.IP{
+					Enabled: pointer.P(true),
+					Address: []nmstate.IPAddress{{IP: "10.222.222.1", PrefixLen: 30}},
+				},
+				IPv6: nmstate.IP{
+					Enabled: pointer.P(true),
+					Address: []nmstate.IPAddress{{IP: "2001::1", PrefixLen: 64}},
+				},
+				Metadata: &nmstate.IfaceMetadata{Pid: 0, NetworkName: "default"},
+			},
+			v1.Interface{
+				Name:                   "default",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+				Firewall: &v1.InterfaceFirewall{Ingress: []v1.FirewallRule{
+					{Protocol: "TCP", Ports: []int32{22, 443}, From: []string{"10.10.0.0/16", "fd00::/64"}},
+					{Protocol: "UDP", From: []string{"192.168.0.0/24"}},
+					{Protocol: "ICMP"},
+				}},
+			},
+		)
+		Expect(err).NotTo(HaveOccurred())
+		expectedConfig := `tables:
+family ip name nat
+family ip name filter
+family ip6 name nat
+family ip6 name filter
+chains:
+family ip table nat name prerouting chainspec [{ type nat hook prerouting priority -100; }]
+family ip table nat name input chainspec [{ type nat hook input priority 100; }]
+family ip table nat name output chainspec [{ type nat hook output priority -100; }]
+family ip table nat name postrouting chainspec [{ type nat hook postrouting priority 100; }]
+family ip table nat name KUBEVIRT_PREINBOUND chainspec []
+family ip table nat name KUBEVIRT_POSTINBOUND chainspec []
+family ip table filter name forward chainspec [{ type filter hook forward priority 0; }]
+family ip table filter name KUBEVIRT_FIREWALL chainspec []
+family ip6 table nat name prerouting chainspec [{ type nat hook prerouting priority -100; }]
+family ip6 table nat name input chainspec [{ type nat hook input priority 100; }]
+family ip6 table nat name output chainspec [{ type nat hook output priority -100; }]
+family ip6 table nat name postrouting chainspec [{ type nat hook postrouting priority 100; }]
+family ip6 table nat name KUBEVIRT_PREINBOUND chainspec []
+family ip6 table nat name KUBEVIRT_POSTINBOUND chainspec []
+family ip6 table filter name forward chainspec [{ type filter hook forward priority 0; }]
+family ip6 table filter name KUBEVIRT_FIREWALL chainspec []
+rules:
+family ip table nat chain postrouting rulespec [ip saddr 10.0.2.2 counter masquerade]
+family ip table nat chain prerouting rulespec [iifname eth0 counter jump KUBEVIRT_PREINBOUND]
+family ip table nat chain postrouting rulespec [oifname k6t-eth0 counter jump KUBEVIRT_POSTINBOUND]
+family ip table nat chain KUBEVIRT_PREINBOUND rulespec [counter dnat to 10.0.2.2]
+family ip table nat chain KUBEVIRT_POSTINBOUND rulespec [ip saddr { 127.0.0.1 } counter snat to 10.0.2.1]
+family ip table nat chain output rulespec [ip daddr { 127.0.0.1 } counter dnat to 10.0.2.2]
+family ip table filter chain forward rulespec [oifname k6t-eth0 counter jump KUBEVIRT_FIREWALL]
+family ip table filter chain KUBEVIRT_FIREWALL rulespec [ct state { established, related } counter accept]
+family ip table filter chain KUBEVIRT_FIREWALL rulespec [ip saddr { 10.10.0.0/16 } tcp dport { 22, 443 } counter accept]
+family ip table filter chain KUBEVIRT_FIREWALL rulespec [ip saddr { 192.168.0.0/24 } meta l4proto udp counter accept]
+family ip table filter chain KUBEVIRT_FIREWALL rulespec [meta l4proto icmp counter accept]
+family ip table filter chain KUBEVIRT_FIREWALL rulespec [counter drop]
+family ip6 table nat chain postrouting rulespec [ip6 saddr fd10:0:2::2 counter masquerade]
+family ip6 table nat chain prerouting rulespec [iifname eth0 counter jump KUBEVIRT_PREINBOUND]
+family ip6 table nat chain postrouting rulespec [oifname k6t-eth0 counter jump KUBEVIRT_POSTINBOUND]
+family ip6 table nat chain KUBEVIRT_PREINBOUND rulespec [counter dnat to fd10:0:2::2]
+family ip6 table nat chain KUBEVIRT_POSTINBOUND rulespec [ip6 saddr { ::1 } counter snat to fd10:0:2::1]
+family ip6 table nat chain output rulespec [ip6 daddr { ::1 } counter dnat to fd10:0:2::2]
+family ip6 table filter chain forward rulespec [oifname k6t-eth0 counter jump KUBEVIRT_FIREWALL]
+family ip6 table filter chain KUBEVIRT_FIREWALL rulespec [ct state { established, related } counter accept]
+family ip6 table filter chain KUBEVIRT_FIREWALL rulespec [ip6 saddr { fd00::/64 } tcp dport { 22, 443 } counter accept]
+family ip6 table filter chain KUBEVIRT_FIREWALL rulespec [meta l4proto ipv6-icmp counter accept]
+family ip6 table filter chain KUBEVIRT_FIREWALL rulespec [counter drop]
+`
+		Expect(nftStub.String()).To(Equal(expectedConfig), fmt.Sprintf("actual:\n%s\n\nexpected:\n%s", nftStub.String(), expectedConfig))
+	})
+
 	It("setup with IPv4 and IPv6, including ports", func() {
 		nftStub := &nftableStub{}
 		masqPod := masquerade.New(masquerade.WithNftableAdapter(nftStub))
@@ -339,6 +438,130 @@ family ip6 table nat chain output rulespec [ip6 daddr { ::1 } tcp dport 8080 cou
 		Expect(nftStub.String()).To(Equal(expectedConfig), fmt.Sprintf("actual:\n%s\n\nexpected:\n%s", nftStub.String(), expectedConfig))
 	})
 
+	Context("with enforced ports", func() {
+		var bridgeIfaceSpec, podIfaceSpec *nmstate.Interface
+
+		BeforeEach(func() {
+			bridgeIfaceSpec = &nmstate.Interface{
+				Name:     "k6t-eth0",
+				TypeName: nmstate.TypeBridge,
+				State:    nmstate.IfaceStateUp,
+				IPv4: nmstate.IP{
+					Enabled: pointer.P(true),
+					Address: []nmstate.IPAddress{{IP: "10.0.2.1", PrefixLen: 24}},
+				},
+				Metadata: &nmstate.IfaceMetadata{NetworkName: "default"},
+			}
+			podIfaceSpec = &nmstate.Interface{
+				Name:     "eth0",
+				TypeName: nmstate.TypeVETH,
+				State:    nmstate.IfaceStateUp,
+				IPv4: nmstate.IP{
+					Enabled: pointer.P(true),
+					Address: []nmstate.IPAddress{{IP: "10.222.222.1", PrefixLen: 30}},
+				},
+				Metadata: &nmstate.IfaceMetadata{NetworkName: "default"},
+			}
+		})
+
+		newIface := func(ports ...v1.Port) v1.Interface {
+			return v1.Interface{
+				Name:                   "default",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+				Ports:                  ports,
+				PortsEnforcement:       v1.PortsEnforcementStrict,
+			}
+		}
+
+		It("setup with IPv4, including ports", func() {
+			nftStub := &nftableStub{}
+			masqPod := masquerade.New(masquerade.WithNftableAdapter(nftStub))
+
+			Expect(masqPod.Setup(bridgeIfaceSpec, podIfaceSpec, newIface(
+				v1.Port{Port: 80},
+				v1.Port{Protocol: "UDP", Port: 53},
+				v1.Port{Protocol: "TCP", Port: 443},
+			))).To(Succeed())
+			expectedConfig := `tables:
+family ip name nat
+family ip name filter
+chains:
+family ip table nat name prerouting chainspec [{ type nat hook prerouting priority -100; }]
+family ip table nat name input chainspec [{ type nat hook input priority 100; }]
+family ip table nat name output chainspec [{ type nat hook output priority -100; }]
+family ip table nat name postrouting chainspec [{ type nat hook postrouting priority 100; }]
+family ip table nat name KUBEVIRT_PREINBOUND chainspec []
+family ip table nat name KUBEVIRT_POSTINBOUND chainspec []
+family ip table filter name forward chainspec [{ type filter hook forward priority 0; }]
+family ip table filter name KUBEVIRT_PORTS chainspec []
+rules:
+family ip table nat chain postrouting rulespec [ip saddr 10.0.2.2 counter masquerade]
+family ip table nat chain prerouting rulespec [iifname eth0 counter jump KUBEVIRT_PREINBOUND]
+family ip table nat chain postrouting rulespec [oifname k6t-eth0 counter jump KUBEVIRT_POSTINBOUND]
+family ip table nat chain KUBEVIRT_PREINBOUND rulespec [tcp dport { 80 } counter dnat to 10.0.2.2]
+family ip table nat chain KUBEVIRT_POSTINBOUND rulespec [tcp dport 80 ip saddr { 127.0.0.1 } counter snat to 10.0.2.1]
+family ip table nat chain output rulespec [ip daddr { 127.0.0.1 } tcp dport 80 counter dnat to 10.0.2.2]
+family ip table nat chain KUBEVIRT_PREINBOUND rulespec [udp dport { 53 } counter dnat to 10.0.2.2]
+family ip table nat chain KUBEVIRT_POSTINBOUND rulespec [udp dport 53 ip saddr { 127.0.0.1 } counter snat to 10.0.2.1]
+family ip table nat chain output rulespec [ip daddr { 127.0.0.1 } udp dport 53 counter dnat to 10.0.2.2]
+family ip table nat chain KUBEVIRT_PREINBOUND rulespec [tcp dport { 443 } counter dnat to 10.0.2.2]
+family ip table nat chain KUBEVIRT_POSTINBOUND rulespec [tcp dport 443 ip saddr { 127.0.0.1 } counter snat to 10.0.2.1]
+family ip table nat chain output rulespec [ip daddr { 127.0.0.1 } tcp dport 443 counter dnat to 10.0.2.2]
+family ip table filter chain forward rulespec [oifname k6t-eth0 counter jump KUBEVIRT_PORTS]
+family ip table filter chain KUBEVIRT_PORTS rulespec [ct state { established, related } counter return]
+family ip table filter chain KUBEVIRT_PORTS rulespec [tcp dport { 80, 443 } counter return]
+family ip table filter chain KUBEVIRT_PORTS rulespec [udp dport { 53 } counter return]
+family ip table filter chain KUBEVIRT_PORTS rulespec [counter drop]
+`
+			Expect(nftStub.String()).To(Equal(expectedConfig), fmt.Sprintf("actual:\n%s\n\nexpected:\n%s", nftStub.String(), expectedConfig))
+		})
+
+		It("setup with IPv4, no ports", func() {
+			nftStub := &nftableStub{}
+			masqPod := masquerade.New(masquerade.WithNftableAdapter(nftStub))
+
+			Expect(masqPod.Setup(bridgeIfaceSpec, podIfaceSpec, newIface())).To(Succeed())
+			expectedConfig := `tables:
+family ip name nat
+family ip name filter
+chains:
+family ip table nat name prerouting chainspec [{ type nat hook prerouting priority -100; }]
+family ip table nat name input chainspec [{ type nat hook input priority 100; }]
+family ip table nat name output chainspec [{ type nat hook output priority -100; }]
+family ip table nat name postrouting chainspec [{ type nat hook postrouting priority 100; }]
+family ip table nat name KUBEVIRT_PREINBOUND chainspec []
+family ip table nat name KUBEVIRT_POSTINBOUND chainspec []
+family ip table filter name forward chainspec [{ type filter hook forward priority 0; }]
+family ip table filter name KUBEVIRT_PORTS chainspec []
+rules:
+family ip table nat chain postrouting rulespec [ip saddr 10.0.2.2 counter masquerade]
+family ip table nat chain prerouting rulespec [iifname eth0 counter jump KUBEVIRT_PREINBOUND]
+family ip table nat chain postrouting rulespec [oifname k6t-eth0 counter jump KUBEVIRT_POSTINBOUND]
+family ip table filter chain forward rulespec [oifname k6t-eth0 counter jump KUBEVIRT_PORTS]
+family ip table filter chain KUBEVIRT_PORTS rulespec [ct state { established, related } counter return]
+family ip table filter chain KUBEVIRT_PORTS rulespec [counter drop]
+`
+			Expect(nftStub.String()).To(Equal(expectedConfig), fmt.Sprintf("actual:\n%s\n\nexpected:\n%s", nftStub.String(), expectedConfig))
+		})
+
+		It("update ports replaces the rules of the previous ports", func() {
+			nftStub := &nftableStub{}
+			masqPod := masquerade.New(masquerade.WithNftableAdapter(nftStub))
+			Expect(masqPod.Setup(bridgeIfaceSpec, podIfaceSpec, newIface(v1.Port{Port: 80}))).To(Succeed())
+
+			updatedIface := newIface(v1.Port{Port: 8080})
+			updatedIface.Firewall = &v1.InterfaceFirewall{Ingress: []v1.FirewallRule{{Protocol: "TCP"}}}
+			Expect(masqPod.UpdatePorts(bridgeIfaceSpec, podIfaceSpec, updatedIface)).To(Succeed())
+
+			expectedNftStub := &nftableStub{}
+			expectedMasqPod := masquerade.New(masquerade.WithNftableAdapter(expectedNftStub))
+			Expect(expectedMasqPod.Setup(bridgeIfaceSpec, podIfaceSpec, updatedIface)).To(Succeed())
+
+			Expect(nftStub.String()).To(Equal(expectedNftStub.String()))
+			Expect(nftStub.String()).NotTo(ContainSubstring("dport 80 "))
+		})
+	})
+
 	Context("with ISTIO", func() {
 		It("setup with IPv4 and IPv6, no ports", func() {
 			nftStub := &nftableStub{}
@@ -553,11 +776,21 @@ func (n *nftableStub) AddTable(family nft.IPFamily, name string) error {
 	if n.addTableErr != nil {
 		return n.addTableErr
 	}
+	for _, t := range n.Tables {
+		if t.Family == family && t.Name == name {
+			return nil
+		}
+	}
 	n.Tables = append(n.Tables, tableData{family, name})
 	return nil
 }
 
 func (n *nftableStub) AddChain(family nft.IPFamily, table string, name string, chainspec ...string) error {
+	for _, c := range n.Chains {
+		if c.Table.Family == family && c.Table.Name == table && c.Name == name {
+			return nil
+		}
+	}
 	n.Chains = append(n.Chains, chainData{
 		tableData{family, table},
 		name,
@@ -577,6 +810,17 @@ func (n *nftableStub) AddRule(family nft.IPFamily, table string, chain string, r
 	return nil
 }
 
+func (n *nftableStub) FlushChain(family nft.IPFamily, table string, name string) error {
+	var rules []ruleData
+	for _, r := range n.Rules {
+		if r.Chain.Table.Family != family || r.Chain.Table.Name != table || r.Chain.Name != name {
+			rules = append(rules, r)
+		}
+	}
+	n.Rules = rules
+	return nil
+}
+
 func (n *nftableStub) String() string {
 	var out string
 
@@ -0,0 +1,72 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package masquerade
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/driver/nft"
+	"kubevirt.io/kubevirt/pkg/network/driver/nmstate"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+// setupPortsEnforcementByFamily drops the traffic forwarded to the guest through the bridge,
+// unless it targets one of the listed ports or replies to a connection initiated by the guest.
+// The allowed traffic continues to be evaluated by the following chains, like the firewall one.
+func (m MasqPod) setupPortsEnforcementByFamily(family nft.IPFamily, bridgeIfaceSpec *nmstate.Interface, vmiIface v1.Interface) error {
+	if !vmispec.HasEnforcedPorts(vmiIface) {
+		return nil
+	}
+
+	if err := m.nftable.AddChain(family, filterTable, kubevirtPortsChain); err != nil {
+		return err
+	}
+	if err := m.nftable.AddRule(family, filterTable, forwardChain, "oifname", bridgeIfaceSpec.Name, "counter", "jump", kubevirtPortsChain); err != nil {
+		return err
+	}
+	if err := m.nftable.AddRule(family, filterTable, kubevirtPortsChain, "ct", "state", "{ established, related }", "counter", "return"); err != nil {
+		return err
+	}
+
+	portsByProtocol := map[string][]string{}
+	for _, port := range vmiIface.Ports {
+		protocol := strings.ToLower(port.Protocol)
+		if protocol == "" {
+			protocol = "tcp"
+		}
+		portsByProtocol[protocol] = append(portsByProtocol[protocol], strconv.Itoa(int(port.Port)))
+	}
+	for _, protocol := range []string{"tcp", "udp"} {
+		ports := portsByProtocol[protocol]
+		if len(ports) == 0 {
+			continue
+		}
+		portsSpec := fmt.Sprintf("{ %s }", strings.Join(ports, ", "))
+		if err := m.nftable.AddRule(family, filterTable, kubevirtPortsChain, protocol, "dport", portsSpec, "counter", "return"); err != nil {
+			return fmt.Errorf("failed to allow %s ports %s for family %s, err: %v", protocol, ports, family, err)
+		}
+	}
+
+	return m.nftable.AddRule(family, filterTable, kubevirtPortsChain, "counter", "drop")
+}
@@ -0,0 +1,123 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package mirror
+
+import (
+	"fmt"
+
+	vishnetlink "github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/driver/netlink"
+)
+
+type netlinkAdapter interface {
+	LinkByName(name string) (vishnetlink.Link, error)
+	QdiscReplace(qdisc vishnetlink.Qdisc) error
+	FilterReplace(filter vishnetlink.Filter) error
+}
+
+// Mirror copies the traffic of a link to another link, using a clsact qdisc with mirred actions.
+type Mirror struct {
+	netlink netlinkAdapter
+}
+
+type option func(*Mirror)
+
+func New(opts ...option) Mirror {
+	m := Mirror{netlink: netlink.NetLink{}}
+	for _, opt := range opts {
+		opt(&m)
+	}
+	return m
+}
+
+func WithNetlinkAdapter(h netlinkAdapter) option {
+	return func(m *Mirror) {
+		m.netlink = h
+	}
+}
+
+// Setup mirrors the traffic of the source link to the target link.
+// The source is expected to be the tap device of a guest interface, therefore the traffic received
+// by the tap is the traffic sent by the guest (egress) and the traffic the tap transmits is the traffic
+// received by the guest (ingress).
+func (m Mirror) Setup(sourceLinkName, targetLinkName string, direction v1.InterfaceMirrorDirection) error {
+	source, err := m.netlink.LinkByName(sourceLinkName)
+	if err != nil {
+		return fmt.Errorf("mirror: failed to find link %s: %v", sourceLinkName, err)
+	}
+	target, err := m.netlink.LinkByName(targetLinkName)
+	if err != nil {
+		return fmt.Errorf("mirror: failed to find link %s: %v", targetLinkName, err)
+	}
+
+	clsact := &vishnetlink.GenericQdisc{
+		QdiscAttrs: vishnetlink.QdiscAttrs{
+			LinkIndex: source.Attrs().Index,
+			Handle:    vishnetlink.MakeHandle(0xffff, 0),
+			Parent:    vishnetlink.HANDLE_CLSACT,
+		},
+		QdiscType: "clsact",
+	}
+	if err := m.netlink.QdiscReplace(clsact); err != nil {
+		return fmt.Errorf("mirror: failed to set the clsact qdisc of link %s: %v", sourceLinkName, err)
+	}
+
+	for _, parent := range filterParents(direction) {
+		filter := newMirrorFilter(source.Attrs().Index, parent, target.Attrs().Index)
+		if err := m.netlink.FilterReplace(filter); err != nil {
+			return fmt.Errorf("mirror: failed to set the mirror filter of link %s: %v", sourceLinkName, err)
+		}
+	}
+	return nil
+}
+
+func filterParents(direction v1.InterfaceMirrorDirection) []uint32 {
+	switch direction {
+	case v1.InterfaceMirrorIngress:
+		return []uint32{vishnetlink.HANDLE_MIN_EGRESS}
+	case v1.InterfaceMirrorEgress:
+		return []uint32{vishnetlink.HANDLE_MIN_INGRESS}
+	default:
+		return []uint32{vishnetlink.HANDLE_MIN_INGRESS, vishnetlink.HANDLE_MIN_EGRESS}
+	}
+}
+
+func newMirrorFilter(linkIndex int, parent uint32, targetIndex int) *vishnetlink.MatchAll {
+	return &vishnetlink.MatchAll{
+		FilterAttrs: vishnetlink.FilterAttrs{
+			LinkIndex: linkIndex,
+			Parent:    parent,
+			Handle:    1,
+			Priority:  1,
+			Protocol:  unix.ETH_P_ALL,
+		},
+		Actions: []vishnetlink.Action{
+			&vishnetlink.MirredAction{
+				ActionAttrs:  vishnetlink.ActionAttrs{Action: vishnetlink.TC_ACT_PIPE},
+				MirredAction: vishnetlink.TCA_EGRESS_MIRROR,
+				Ifindex:      targetIndex,
+			},
+		},
+	}
+}
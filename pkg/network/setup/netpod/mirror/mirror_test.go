@@ -0,0 +1,107 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package mirror_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	vishnetlink "github.com/vishvananda/netlink"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	nlfake "kubevirt.io/kubevirt/pkg/network/driver/netlink/fake"
+	"kubevirt.io/kubevirt/pkg/network/setup/netpod/mirror"
+)
+
+var _ = Describe("Interface mirroring", func() {
+	const (
+		tapName    = "tap0"
+		targetName = "mir0"
+	)
+
+	var (
+		nl         *nlfake.NetLink
+		tapLink    vishnetlink.Link
+		mirrorLink vishnetlink.Link
+	)
+
+	BeforeEach(func() {
+		nl = nlfake.New()
+		Expect(nl.LinkAdd(&vishnetlink.Tuntap{LinkAttrs: vishnetlink.LinkAttrs{Name: tapName}})).To(Succeed())
+		Expect(nl.LinkAdd(&vishnetlink.Veth{LinkAttrs: vishnetlink.LinkAttrs{Name: targetName}})).To(Succeed())
+
+		var err error
+		tapLink, err = nl.LinkByName(tapName)
+		Expect(err).NotTo(HaveOccurred())
+		mirrorLink, err = nl.LinkByName(targetName)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("sets a clsact qdisc on the source link", func() {
+		Expect(mirror.New(mirror.WithNetlinkAdapter(nl)).Setup(tapName, targetName, v1.InterfaceMirrorBoth)).To(Succeed())
+
+		qdiscs, err := nl.QdiscList(tapLink)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(qdiscs).To(HaveLen(1))
+		Expect(qdiscs[0].Type()).To(Equal("clsact"))
+		Expect(qdiscs[0].Attrs().Parent).To(Equal(uint32(vishnetlink.HANDLE_CLSACT)))
+	})
+
+	DescribeTable("mirrors the source link traffic to the target link",
+		func(direction v1.InterfaceMirrorDirection, expectIngressFilter, expectEgressFilter bool) {
+			Expect(mirror.New(mirror.WithNetlinkAdapter(nl)).Setup(tapName, targetName, direction)).To(Succeed())
+
+			assertMirrorFilter(nl, tapLink, vishnetlink.HANDLE_MIN_INGRESS, mirrorLink.Attrs().Index, expectIngressFilter)
+			assertMirrorFilter(nl, tapLink, vishnetlink.HANDLE_MIN_EGRESS, mirrorLink.Attrs().Index, expectEgressFilter)
+		},
+		Entry("for both directions by default", v1.InterfaceMirrorDirection(""), true, true),
+		Entry("for both directions", v1.InterfaceMirrorBoth, true, true),
+		Entry("for the traffic sent by the guest", v1.InterfaceMirrorEgress, true, false),
+		Entry("for the traffic received by the guest", v1.InterfaceMirrorIngress, false, true),
+	)
+
+	It("fails when the source link does not exist", func() {
+		err := mirror.New(mirror.WithNetlinkAdapter(nl)).Setup("missing", targetName, v1.InterfaceMirrorBoth)
+		Expect(err).To(MatchError(ContainSubstring("failed to find link missing")))
+	})
+
+	It("fails when the target link does not exist", func() {
+		err := mirror.New(mirror.WithNetlinkAdapter(nl)).Setup(tapName, "missing", v1.InterfaceMirrorBoth)
+		Expect(err).To(MatchError(ContainSubstring("failed to find link missing")))
+	})
+})
+
+func assertMirrorFilter(nl *nlfake.NetLink, link vishnetlink.Link, parent uint32, targetIndex int, expectFilter bool) {
+	filters, err := nl.FilterList(link, parent)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	if !expectFilter {
+		ExpectWithOffset(1, filters).To(BeEmpty())
+		return
+	}
+	ExpectWithOffset(1, filters).To(HaveLen(1))
+	matchAll, ok := filters[0].(*vishnetlink.MatchAll)
+	ExpectWithOffset(1, ok).To(BeTrue())
+	ExpectWithOffset(1, matchAll.Actions).To(ConsistOf(&vishnetlink.MirredAction{
+		ActionAttrs:  vishnetlink.ActionAttrs{Action: vishnetlink.TC_ACT_PIPE},
+		MirredAction: vishnetlink.TCA_EGRESS_MIRROR,
+		Ifindex:      targetIndex,
+	}))
+}
@@ -0,0 +1,99 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package netem
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	vishnetlink "github.com/vishvananda/netlink"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/driver/netlink"
+)
+
+type netlinkAdapter interface {
+	LinkByName(name string) (vishnetlink.Link, error)
+	QdiscReplace(qdisc vishnetlink.Qdisc) error
+}
+
+// NetEm applies network emulation on the root queue discipline of links, using the kernel netem qdisc.
+type NetEm struct {
+	netlink netlinkAdapter
+}
+
+type option func(*NetEm)
+
+func New(opts ...option) NetEm {
+	n := NetEm{netlink: netlink.NetLink{}}
+	for _, opt := range opts {
+		opt(&n)
+	}
+	return n
+}
+
+func WithNetlinkAdapter(h netlinkAdapter) option {
+	return func(n *NetEm) {
+		n.netlink = h
+	}
+}
+
+// Setup replaces the root qdisc of the link with a netem qdisc, degrading the traffic the link transmits.
+// On a tap device, this is the traffic sent to the guest.
+func (n NetEm) Setup(linkName string, emulation v1.InterfaceNetworkEmulation) error {
+	link, err := n.netlink.LinkByName(linkName)
+	if err != nil {
+		return fmt.Errorf("netem: failed to find link %s: %v", linkName, err)
+	}
+
+	netemAttrs, err := netemQdiscAttrs(emulation)
+	if err != nil {
+		return fmt.Errorf("netem: %v", err)
+	}
+	qdiscAttrs := vishnetlink.QdiscAttrs{
+		LinkIndex: link.Attrs().Index,
+		Handle:    vishnetlink.MakeHandle(1, 0),
+		Parent:    vishnetlink.HANDLE_ROOT,
+	}
+	if err := n.netlink.QdiscReplace(vishnetlink.NewNetem(qdiscAttrs, netemAttrs)); err != nil {
+		return fmt.Errorf("netem: failed to set the qdisc of link %s: %v", linkName, err)
+	}
+	return nil
+}
+
+func netemQdiscAttrs(emulation v1.InterfaceNetworkEmulation) (vishnetlink.NetemQdiscAttrs, error) {
+	var attrs vishnetlink.NetemQdiscAttrs
+	if emulation.Delay != nil {
+		attrs.Latency = uint32(emulation.Delay.Duration / time.Microsecond)
+	}
+	if emulation.Jitter != nil {
+		attrs.Jitter = uint32(emulation.Jitter.Duration / time.Microsecond)
+	}
+	if emulation.LossPercentage != "" {
+		loss, err := strconv.ParseFloat(emulation.LossPercentage, 32)
+		if err != nil {
+			return attrs, fmt.Errorf("invalid loss percentage %q: %v", emulation.LossPercentage, err)
+		}
+		attrs.Loss = float32(loss)
+	}
+	return attrs, nil
+}
@@ -0,0 +1,85 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package netem_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	vishnetlink "github.com/vishvananda/netlink"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	nlfake "kubevirt.io/kubevirt/pkg/network/driver/netlink/fake"
+	"kubevirt.io/kubevirt/pkg/network/setup/netpod/netem"
+)
+
+var _ = Describe("Network emulation", func() {
+	const tapName = "tap0"
+
+	var nl *nlfake.NetLink
+
+	BeforeEach(func() {
+		nl = nlfake.New()
+		Expect(nl.LinkAdd(&vishnetlink.Tuntap{LinkAttrs: vishnetlink.LinkAttrs{Name: tapName}})).To(Succeed())
+	})
+
+	It("sets a netem root qdisc on the link", func() {
+		emulation := v1.InterfaceNetworkEmulation{
+			Delay:          &metav1.Duration{Duration: 100 * time.Millisecond},
+			Jitter:         &metav1.Duration{Duration: 10 * time.Millisecond},
+			LossPercentage: "2.5",
+		}
+		Expect(netem.New(netem.WithNetlinkAdapter(nl)).Setup(tapName, emulation)).To(Succeed())
+
+		link, err := nl.LinkByName(tapName)
+		Expect(err).NotTo(HaveOccurred())
+		qdiscs, err := nl.QdiscList(link)
+		Expect(err).NotTo(HaveOccurred())
+
+		expectedQdisc := vishnetlink.NewNetem(
+			vishnetlink.QdiscAttrs{LinkIndex: link.Attrs().Index, Handle: vishnetlink.MakeHandle(1, 0), Parent: vishnetlink.HANDLE_ROOT},
+			vishnetlink.NetemQdiscAttrs{Latency: 100000, Jitter: 10000, Loss: 2.5},
+		)
+		Expect(qdiscs).To(Equal([]vishnetlink.Qdisc{expectedQdisc}))
+	})
+
+	It("replaces the previous netem qdisc of the link", func() {
+		netEm := netem.New(netem.WithNetlinkAdapter(nl))
+		Expect(netEm.Setup(tapName, v1.InterfaceNetworkEmulation{LossPercentage: "10"})).To(Succeed())
+		Expect(netEm.Setup(tapName, v1.InterfaceNetworkEmulation{LossPercentage: "20"})).To(Succeed())
+
+		link, err := nl.LinkByName(tapName)
+		Expect(err).NotTo(HaveOccurred())
+		qdiscs, err := nl.QdiscList(link)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(qdiscs).To(HaveLen(1))
+		Expect(qdiscs[0].(*vishnetlink.Netem).Loss).To(Equal(vishnetlink.Percentage2u32(20)))
+	})
+
+	It("fails when the link does not exist", func() {
+		err := netem.New(netem.WithNetlinkAdapter(nl)).Setup("missing", v1.InterfaceNetworkEmulation{LossPercentage: "1"})
+		Expect(err).To(MatchError(ContainSubstring("failed to find link missing")))
+	})
+})
@@ -25,6 +25,7 @@ import (
 	"net"
 	"strconv"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	k8serrors "k8s.io/apimachinery/pkg/util/errors"
 
 	"kubevirt.io/kubevirt/pkg/pointer"
@@ -34,9 +35,12 @@ import (
 	"kubevirt.io/kubevirt/pkg/network/driver/procsys"
 	neterrors "kubevirt.io/kubevirt/pkg/network/errors"
 	"kubevirt.io/kubevirt/pkg/network/link"
+	"kubevirt.io/kubevirt/pkg/network/metadataservice"
 	"kubevirt.io/kubevirt/pkg/network/namescheme"
 	"kubevirt.io/kubevirt/pkg/network/netmachinery"
 	"kubevirt.io/kubevirt/pkg/network/setup/netpod/masquerade"
+	"kubevirt.io/kubevirt/pkg/network/setup/netpod/mirror"
+	"kubevirt.io/kubevirt/pkg/network/setup/netpod/netem"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
@@ -53,6 +57,15 @@ type nmstateAdapter interface {
 
 type masqueradeAdapter interface {
 	Setup(bridgeIfaceSpec, podIfaceSpec *nmstate.Interface, vmiIface v1.Interface) error
+	UpdatePorts(bridgeIfaceSpec, podIfaceSpec *nmstate.Interface, vmiIface v1.Interface) error
+}
+
+type netemAdapter interface {
+	Setup(linkName string, emulation v1.InterfaceNetworkEmulation) error
+}
+
+type mirrorAdapter interface {
+	Setup(sourceLinkName, targetLinkName string, direction v1.InterfaceMirrorDirection) error
 }
 
 type cacheCreator interface {
@@ -74,12 +87,16 @@ type NetPod struct {
 
 	nmstateAdapter    nmstateAdapter
 	masqueradeAdapter masqueradeAdapter
+	netemAdapter      netemAdapter
+	mirrorAdapter     mirrorAdapter
 
 	cacheCreator cacheCreator
 	state        *State
 
 	bindingPluginsByName map[string]v1.InterfaceBindingPlugin
 
+	metadataServiceEnabled bool
+
 	log *log.FilteredLogger
 }
 
@@ -96,6 +113,8 @@ func NewNetPod(vmiNetworks []v1.Network, vmiIfaces []v1.Interface, vmiUID string
 
 		nmstateAdapter:    nmstate.New(),
 		masqueradeAdapter: masquerade.New(),
+		netemAdapter:      netem.New(),
+		mirrorAdapter:     mirror.New(),
 
 		cacheCreator:         cache.CacheCreator{},
 		bindingPluginsByName: map[string]v1.InterfaceBindingPlugin{},
@@ -123,6 +142,18 @@ func WithMasqueradeAdapter(h masqueradeAdapter) option {
 	}
 }
 
+func WithNetEmAdapter(h netemAdapter) option {
+	return func(n *NetPod) {
+		n.netemAdapter = h
+	}
+}
+
+func WithMirrorAdapter(h mirrorAdapter) option {
+	return func(n *NetPod) {
+		n.mirrorAdapter = h
+	}
+}
+
 func WithCacheCreator(c cacheCreator) option {
 	return func(n *NetPod) {
 		n.cacheCreator = c
@@ -135,6 +166,13 @@ func WithBindingPlugins(bindings map[string]v1.InterfaceBindingPlugin) option {
 	}
 }
 
+// WithMetadataService configures the metadata service address on the masquerade bridge of the pod network.
+func WithMetadataService(enabled bool) option {
+	return func(n *NetPod) {
+		n.metadataServiceEnabled = enabled
+	}
+}
+
 func WithLogger(logger *log.FilteredLogger) option {
 	return func(n *NetPod) {
 		n.log = logger
@@ -171,7 +209,7 @@ func (n NetPod) Setup() error {
 		return iface != nil && iface.State != v1.InterfaceStateAbsent
 	})
 	if len(pendingNets) == 0 && len(unplugIfaces) == 0 {
-		return nil
+		return n.updateEnforcedPorts(finishedNets)
 	}
 
 	err = n.state.NSExec.Do(func() error {
@@ -247,6 +285,14 @@ func (n NetPod) config(currentStatus *nmstate.Status) error {
 		return err
 	}
 
+	if err = n.setupNetworkEmulation(desiredSpec); err != nil {
+		return err
+	}
+
+	if err = n.setupInterfaceMirror(desiredSpec); err != nil {
+		return err
+	}
+
 	// Configuring NAT (nftables) is temporary done outside nmstate.
 	// This should be eventually embedded into the nmstate desired state and applied by it.
 	return n.setupNAT(desiredSpec, currentStatus)
@@ -390,6 +436,12 @@ func (n NetPod) bridgeBindingSpec(podIfaceName string, vmiIfaceIndex int, ifaceS
 		Metadata: &nmstate.IfaceMetadata{Pid: n.podPID, NetworkName: vmiNetworkName},
 	}
 
+	// Let the frames of MAC addresses other than the guest's own through both bridge ports.
+	if n.vmiSpecIfaces[vmiIfaceIndex].Promiscuous {
+		podIface.LinuxStack.Promiscuous = pointer.P(true)
+		tapIface.LinuxStack.Promiscuous = pointer.P(true)
+	}
+
 	dummyIface := nmstate.Interface{
 		Name:       podIfaceName,
 		TypeName:   nmstate.TypeDummy,
@@ -438,6 +490,11 @@ func (n NetPod) masqueradeBindingSpec(podIfaceName string, vmiIfaceIndex int, if
 			Enabled: pointer.P(true),
 			Address: []nmstate.IPAddress{ip4GatewayAddress},
 		}
+		// The gateway address is expected first, as the guest address is derived from it
+		if n.metadataServiceEnabled && vmiNetwork.Pod != nil {
+			bridgeIface.IPv4.Address = append(bridgeIface.IPv4.Address,
+				nmstate.IPAddress{IP: metadataservice.ServiceIPv4, PrefixLen: 32})
+		}
 		bridgeIface.LinuxStack.IP4RouteLocalNet = pointer.P(true)
 	}
 
@@ -542,7 +599,99 @@ func (n NetPod) setupNAT(desiredSpec *nmstate.Spec, currentStatus *nmstate.Statu
 	vmiIface := vmispec.FilterInterfacesSpec(n.vmiSpecIfaces, func(i v1.Interface) bool {
 		return i.Name == bridgeIfaceSpec.Metadata.NetworkName
 	})
-	return n.masqueradeAdapter.Setup(bridgeIfaceSpec, podIfaceSpec, vmiIface[0])
+	if err := n.masqueradeAdapter.Setup(bridgeIfaceSpec, podIfaceSpec, vmiIface[0]); err != nil {
+		return err
+	}
+	n.state.SetAppliedPorts(vmiIface[0].Name, vmiIface[0].Ports)
+	return nil
+}
+
+// setupNetworkEmulation sets the network emulation of the interfaces on their tap devices.
+// It is applied only when the taps are created, later changes of the emulation are not reflected.
+func (n NetPod) setupNetworkEmulation(desiredSpec *nmstate.Spec) error {
+	for _, iface := range desiredSpec.Interfaces {
+		if iface.TypeName != nmstate.TypeTap || iface.Metadata == nil {
+			continue
+		}
+		vmiIface := vmispec.LookupInterfaceByName(n.vmiSpecIfaces, iface.Metadata.NetworkName)
+		if vmiIface == nil || vmiIface.NetworkEmulation == nil {
+			continue
+		}
+		if err := n.netemAdapter.Setup(iface.Name, *vmiIface.NetworkEmulation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setupInterfaceMirror mirrors the traffic of the interfaces tap devices to their mirror pod interfaces.
+// Similar to the network emulation, it is applied only when the taps are created.
+func (n NetPod) setupInterfaceMirror(desiredSpec *nmstate.Spec) error {
+	for _, iface := range desiredSpec.Interfaces {
+		if iface.TypeName != nmstate.TypeTap || iface.Metadata == nil {
+			continue
+		}
+		vmiIface := vmispec.LookupInterfaceByName(n.vmiSpecIfaces, iface.Metadata.NetworkName)
+		if vmiIface == nil || vmiIface.Mirror == nil {
+			continue
+		}
+		mirrorIfaceName := namescheme.GenerateMirrorInterfaceName(vmiIface.Name)
+		if err := n.mirrorAdapter.Setup(iface.Name, mirrorIfaceName, vmiIface.Mirror.Direction); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateEnforcedPorts re-applies the NAT and filtering rules of the configured masquerade interfaces
+// with enforced ports, whose ports differ from the ones last applied.
+func (n NetPod) updateEnforcedPorts(finishedNets []v1.Network) error {
+	for ifIndex, iface := range n.vmiSpecIfaces {
+		if !vmispec.HasEnforcedPorts(iface) {
+			continue
+		}
+		if vmispec.LookupNetworkByName(finishedNets, iface.Name) == nil {
+			continue
+		}
+		if appliedPorts, known := n.state.AppliedPorts(iface.Name); known && equality.Semantic.DeepEqual(appliedPorts, iface.Ports) {
+			continue
+		}
+
+		err := n.state.NSExec.Do(func() error {
+			return n.updateMasqueradePorts(ifIndex)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update the ports of interface %s: %w", iface.Name, err)
+		}
+		n.state.SetAppliedPorts(iface.Name, iface.Ports)
+	}
+	return nil
+}
+
+func (n NetPod) updateMasqueradePorts(vmiIfaceIndex int) error {
+	currentStatus, err := n.nmstateAdapter.Read()
+	if err != nil {
+		return err
+	}
+
+	vmiIface := n.vmiSpecIfaces[vmiIfaceIndex]
+	podIfaceNameByVMINetwork := createNetworkNameScheme(n.vmiSpecNets, n.vmiIfaceStatuses, currentStatus.Interfaces)
+	podIfaceName := podIfaceNameByVMINetwork[vmiIface.Name]
+	podIfaceSpec := nmstate.LookupInterface(currentStatus.Interfaces, func(i nmstate.Interface) bool {
+		return i.Name == podIfaceName
+	})
+	if podIfaceSpec == nil {
+		return fmt.Errorf("pod link (%s) is missing", podIfaceName)
+	}
+
+	ifacesSpec, err := n.masqueradeBindingSpec(podIfaceName, vmiIfaceIndex, ifaceStatusByName(currentStatus.Interfaces))
+	if err != nil {
+		return err
+	}
+	bridgeIfaceSpec := &ifacesSpec[0]
+
+	n.log.Infof("Updating the ports of interface %s to %v", vmiIface.Name, vmiIface.Ports)
+	return n.masqueradeAdapter.UpdatePorts(bridgeIfaceSpec, podIfaceSpec, vmiIface)
 }
 
 func (n NetPod) lookupMasquradeBridge(desiredIfacesSpec []nmstate.Interface) *nmstate.Interface {
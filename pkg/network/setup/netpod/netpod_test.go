@@ -274,6 +274,256 @@ var _ = Describe("netpod", func() {
 		}))
 	})
 
+	Context("masquerade binding with network emulation", func() {
+		var nmstatestub nmstateStub
+
+		BeforeEach(func() {
+			nmstatestub = nmstateStub{status: nmstate.Status{
+				Interfaces: []nmstate.Interface{{
+					Name:       "eth0",
+					Index:      0,
+					TypeName:   nmstate.TypeVETH,
+					State:      nmstate.IfaceStateUp,
+					MacAddress: "12:34:56:78:90:ab",
+					MTU:        1500,
+					IPv4: nmstate.IP{
+						Enabled: pointer.P(true),
+						Address: []nmstate.IPAddress{{IP: primaryIPv4Address, PrefixLen: 30}},
+					},
+				}},
+			}}
+		})
+
+		newNetPod := func(emulation *v1.InterfaceNetworkEmulation, netemstub *netemStub) netpod.NetPod {
+			vmiIface := v1.Interface{
+				Name:                   defaultPodNetworkName,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+				NetworkEmulation:       emulation,
+			}
+			return netpod.NewNetPod(
+				[]v1.Network{*v1.DefaultPodNetwork()},
+				[]v1.Interface{vmiIface},
+				vmiUID, 0, 0, 0, state,
+				netpod.WithNMStateAdapter(&nmstatestub),
+				netpod.WithMasqueradeAdapter(&masqueradeStub{}),
+				netpod.WithNetEmAdapter(netemstub),
+				netpod.WithCacheCreator(&baseCacheCreator),
+			)
+		}
+
+		It("sets the network emulation on the tap device", func() {
+			emulation := &v1.InterfaceNetworkEmulation{LossPercentage: "5"}
+			netemstub := netemStub{}
+
+			Expect(newNetPod(emulation, &netemstub).Setup()).To(Succeed())
+			Expect(netemstub.emulationByLink).To(Equal(map[string]v1.InterfaceNetworkEmulation{"tap0": *emulation}))
+		})
+
+		It("does not set network emulation when not specified", func() {
+			netemstub := netemStub{}
+
+			Expect(newNetPod(nil, &netemstub).Setup()).To(Succeed())
+			Expect(netemstub.emulationByLink).To(BeEmpty())
+		})
+
+		It("fails setup when setting the network emulation fails", func() {
+			netemstub := netemStub{setupErr: errNetEmSetup}
+
+			err := newNetPod(&v1.InterfaceNetworkEmulation{LossPercentage: "5"}, &netemstub).Setup()
+			Expect(err).To(MatchError(errNetEmSetup))
+		})
+	})
+
+	Context("masquerade binding with interface mirroring", func() {
+		var nmstatestub nmstateStub
+
+		BeforeEach(func() {
+			nmstatestub = nmstateStub{status: nmstate.Status{
+				Interfaces: []nmstate.Interface{{
+					Name:       "eth0",
+					Index:      0,
+					TypeName:   nmstate.TypeVETH,
+					State:      nmstate.IfaceStateUp,
+					MacAddress: "12:34:56:78:90:ab",
+					MTU:        1500,
+					IPv4: nmstate.IP{
+						Enabled: pointer.P(true),
+						Address: []nmstate.IPAddress{{IP: primaryIPv4Address, PrefixLen: 30}},
+					},
+				}},
+			}}
+		})
+
+		newNetPod := func(ifaceMirror *v1.InterfaceMirror, mirrorstub *mirrorStub) netpod.NetPod {
+			vmiIface := v1.Interface{
+				Name:                   defaultPodNetworkName,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+				Mirror:                 ifaceMirror,
+			}
+			return netpod.NewNetPod(
+				[]v1.Network{*v1.DefaultPodNetwork()},
+				[]v1.Interface{vmiIface},
+				vmiUID, 0, 0, 0, state,
+				netpod.WithNMStateAdapter(&nmstatestub),
+				netpod.WithMasqueradeAdapter(&masqueradeStub{}),
+				netpod.WithMirrorAdapter(mirrorstub),
+				netpod.WithCacheCreator(&baseCacheCreator),
+			)
+		}
+
+		It("mirrors the tap device traffic to the mirror pod interface", func() {
+			mirrorstub := mirrorStub{}
+
+			ifaceMirror := &v1.InterfaceMirror{NetworkName: "monitoring", Direction: v1.InterfaceMirrorIngress}
+			Expect(newNetPod(ifaceMirror, &mirrorstub).Setup()).To(Succeed())
+			Expect(mirrorstub.mirrors).To(Equal([]mirrorSetup{
+				{source: "tap0", target: "mir37a8eec1ce1", direction: v1.InterfaceMirrorIngress},
+			}))
+		})
+
+		It("does not mirror the traffic when not specified", func() {
+			mirrorstub := mirrorStub{}
+
+			Expect(newNetPod(nil, &mirrorstub).Setup()).To(Succeed())
+			Expect(mirrorstub.mirrors).To(BeEmpty())
+		})
+
+		It("fails setup when mirroring the traffic fails", func() {
+			mirrorstub := mirrorStub{setupErr: errMirrorSetup}
+
+			err := newNetPod(&v1.InterfaceMirror{NetworkName: "monitoring"}, &mirrorstub).Setup()
+			Expect(err).To(MatchError(errMirrorSetup))
+		})
+	})
+
+	Context("masquerade binding with the metadata service", func() {
+		var nmstatestub nmstateStub
+
+		BeforeEach(func() {
+			nmstatestub = nmstateStub{status: nmstate.Status{
+				Interfaces: []nmstate.Interface{{
+					Name:       "eth0",
+					Index:      0,
+					TypeName:   nmstate.TypeVETH,
+					State:      nmstate.IfaceStateUp,
+					MacAddress: "12:34:56:78:90:ab",
+					MTU:        1500,
+					IPv4: nmstate.IP{
+						Enabled: pointer.P(true),
+						Address: []nmstate.IPAddress{{IP: primaryIPv4Address, PrefixLen: 30}},
+					},
+				}},
+			}}
+		})
+
+		bridgeIPv4Addresses := func(metadataServiceEnabled bool) []nmstate.IPAddress {
+			vmiIface := v1.Interface{
+				Name:                   defaultPodNetworkName,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+			}
+			netPod := netpod.NewNetPod(
+				[]v1.Network{*v1.DefaultPodNetwork()},
+				[]v1.Interface{vmiIface},
+				vmiUID, 0, 0, 0, state,
+				netpod.WithNMStateAdapter(&nmstatestub),
+				netpod.WithMasqueradeAdapter(&masqueradeStub{}),
+				netpod.WithCacheCreator(&baseCacheCreator),
+				netpod.WithMetadataService(metadataServiceEnabled),
+			)
+			Expect(netPod.Setup()).To(Succeed())
+
+			for _, iface := range nmstatestub.spec.Interfaces {
+				if iface.Name == "k6t-eth0" {
+					return iface.IPv4.Address
+				}
+			}
+			Fail("the masquerade bridge is not in the applied spec")
+			return nil
+		}
+
+		It("adds the metadata service address to the bridge, after the gateway address", func() {
+			Expect(bridgeIPv4Addresses(true)).To(Equal([]nmstate.IPAddress{
+				{IP: "10.0.2.1", PrefixLen: 24},
+				{IP: "169.254.169.254", PrefixLen: 32},
+			}))
+		})
+
+		It("does not add the metadata service address when not enabled", func() {
+			Expect(bridgeIPv4Addresses(false)).To(Equal([]nmstate.IPAddress{{IP: "10.0.2.1", PrefixLen: 24}}))
+		})
+	})
+
+	Context("masquerade binding with enforced ports", func() {
+		var (
+			nmstatestub nmstateStub
+			masqstub    masqueradeStub
+			vmiIface    v1.Interface
+		)
+
+		BeforeEach(func() {
+			nmstatestub = nmstateStub{status: nmstate.Status{
+				Interfaces: []nmstate.Interface{{
+					Name:     "eth0",
+					TypeName: nmstate.TypeVETH,
+					State:    nmstate.IfaceStateUp,
+					MTU:      1500,
+					IPv4: nmstate.IP{
+						Enabled: pointer.P(true),
+						Address: []nmstate.IPAddress{{IP: primaryIPv4Address, PrefixLen: 30}},
+					},
+				}},
+			}}
+			masqstub = masqueradeStub{}
+			vmiIface = v1.Interface{
+				Name:                   defaultPodNetworkName,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+				Ports:                  []v1.Port{{Port: 8080}},
+				PortsEnforcement:       v1.PortsEnforcementStrict,
+			}
+			Expect(state.SetFinished([]v1.Network{*v1.DefaultPodNetwork()})).To(Succeed())
+		})
+
+		newNetPod := func() netpod.NetPod {
+			return netpod.NewNetPod(
+				[]v1.Network{*v1.DefaultPodNetwork()},
+				[]v1.Interface{vmiIface},
+				vmiUID, 0, 0, 0, state,
+				netpod.WithNMStateAdapter(&nmstatestub),
+				netpod.WithMasqueradeAdapter(&masqstub),
+				netpod.WithCacheCreator(&baseCacheCreator),
+			)
+		}
+
+		It("updates the ports which differ from the applied ones", func() {
+			state.SetAppliedPorts(defaultPodNetworkName, []v1.Port{{Port: 80}})
+
+			Expect(newNetPod().Setup()).To(Succeed())
+
+			Expect(masqstub.updatedPorts).To(BeTrue())
+			Expect(masqstub.bridgeIfaceSpec.Name).To(Equal("k6t-eth0"))
+			Expect(masqstub.bridgeIfaceSpec.IPv4.Address).To(Equal([]nmstate.IPAddress{{IP: "10.0.2.1", PrefixLen: 24}}))
+			Expect(masqstub.podIfaceSpec.Name).To(Equal("eth0"))
+			Expect(masqstub.vmiIfaceSpec).To(Equal(vmiIface))
+			appliedPorts, known := state.AppliedPorts(defaultPodNetworkName)
+			Expect(known).To(BeTrue())
+			Expect(appliedPorts).To(Equal(vmiIface.Ports))
+		})
+
+		It("updates the ports when the applied ones are unknown", func() {
+			Expect(newNetPod().Setup()).To(Succeed())
+
+			Expect(masqstub.updatedPorts).To(BeTrue())
+		})
+
+		It("does not update the ports which are already applied", func() {
+			state.SetAppliedPorts(defaultPodNetworkName, vmiIface.Ports)
+
+			Expect(newNetPod().Setup()).To(Succeed())
+
+			Expect(masqstub.updatedPorts).To(BeFalse())
+		})
+	})
+
 	It("setup bridge binding with IP and a static route", func() {
 		const (
 			defaultGatewayIP4Address = "10.222.222.254"
@@ -664,6 +914,42 @@ var _ = Describe("netpod", func() {
 			Equal(&cache.DHCPConfig{IPAMDisabled: true}))
 	})
 
+	It("setup promiscuous bridge binding", func() {
+		nmstatestub := nmstateStub{status: nmstate.Status{
+			Interfaces: []nmstate.Interface{{
+				Name:       "eth0",
+				Index:      0,
+				TypeName:   nmstate.TypeVETH,
+				State:      nmstate.IfaceStateUp,
+				MacAddress: "12:34:56:78:90:ab",
+				MTU:        1500,
+				IPv4:       ipDisabled,
+				IPv6:       ipDisabled,
+			}},
+		}}
+
+		netPod := netpod.NewNetPod(
+			[]v1.Network{*v1.DefaultPodNetwork()},
+			[]v1.Interface{{
+				Name:                   defaultPodNetworkName,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+				Promiscuous:            true,
+			}},
+			vmiUID, 0, 0, 0, state,
+			netpod.WithNMStateAdapter(&nmstatestub),
+			netpod.WithCacheCreator(&baseCacheCreator),
+		)
+		Expect(netPod.Setup()).To(Succeed())
+		Expect(nmstatestub.spec.Interfaces).To(HaveLen(4))
+		Expect(nmstatestub.spec.Interfaces[1].Name).To(Equal("eth0-nic"))
+		Expect(nmstatestub.spec.Interfaces[1].LinuxStack).To(Equal(nmstate.LinuxIfaceStack{
+			PortLearning: pointer.P(false),
+			Promiscuous:  pointer.P(true),
+		}))
+		Expect(nmstatestub.spec.Interfaces[2].Name).To(Equal("tap0"))
+		Expect(nmstatestub.spec.Interfaces[2].LinuxStack).To(Equal(nmstate.LinuxIfaceStack{Promiscuous: pointer.P(true)}))
+	})
+
 	It("setup passt binding", func() {
 		nmstatestub := nmstateStub{status: nmstate.Status{
 			Interfaces: []nmstate.Interface{{Name: "eth0"}},
@@ -1894,6 +2180,7 @@ type masqueradeStub struct {
 	bridgeIfaceSpec *nmstate.Interface
 	podIfaceSpec    *nmstate.Interface
 	vmiIfaceSpec    v1.Interface
+	updatedPorts    bool
 }
 
 var errMasqueradeSetup = errors.New("masquerade Setup Test Error")
@@ -1908,6 +2195,50 @@ func (m *masqueradeStub) Setup(bridgeIfaceSpec, podIfaceSpec *nmstate.Interface,
 	return nil
 }
 
+func (m *masqueradeStub) UpdatePorts(bridgeIfaceSpec, podIfaceSpec *nmstate.Interface, vmiIfaceSpec v1.Interface) error {
+	m.updatedPorts = true
+	return m.Setup(bridgeIfaceSpec, podIfaceSpec, vmiIfaceSpec)
+}
+
+type netemStub struct {
+	setupErr        error
+	emulationByLink map[string]v1.InterfaceNetworkEmulation
+}
+
+var errNetEmSetup = errors.New("netem Setup Test Error")
+
+func (n *netemStub) Setup(linkName string, emulation v1.InterfaceNetworkEmulation) error {
+	if n.setupErr != nil {
+		return n.setupErr
+	}
+	if n.emulationByLink == nil {
+		n.emulationByLink = map[string]v1.InterfaceNetworkEmulation{}
+	}
+	n.emulationByLink[linkName] = emulation
+	return nil
+}
+
+type mirrorSetup struct {
+	source    string
+	target    string
+	direction v1.InterfaceMirrorDirection
+}
+
+type mirrorStub struct {
+	setupErr error
+	mirrors  []mirrorSetup
+}
+
+var errMirrorSetup = errors.New("mirror Setup Test Error")
+
+func (m *mirrorStub) Setup(sourceLinkName, targetLinkName string, direction v1.InterfaceMirrorDirection) error {
+	if m.setupErr != nil {
+		return m.setupErr
+	}
+	m.mirrors = append(m.mirrors, mirrorSetup{source: sourceLinkName, target: targetLinkName, direction: direction})
+	return nil
+}
+
 type tempCacheCreator struct {
 	once   sync.Once
 	tmpDir string
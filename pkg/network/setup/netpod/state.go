@@ -37,11 +37,15 @@ type stateCacheReaderWriterDeleter interface {
 type State struct {
 	cache stateCacheReaderWriterDeleter
 
+	// appliedPorts holds the ports last applied to the masquerade interface of each network.
+	// It is kept in memory only, the ports are applied again when it is lost.
+	appliedPorts map[string][]v1.Port
+
 	NSExec NSExecutor
 }
 
 func NewState(cache stateCacheReaderWriterDeleter, ns NSExecutor) *State {
-	return &State{cache: cache, NSExec: ns}
+	return &State{cache: cache, NSExec: ns, appliedPorts: map[string][]v1.Port{}}
 }
 
 func (s *State) PendingStartedFinished(nets []v1.Network) ([]v1.Network, []v1.Network, []v1.Network, error) {
@@ -94,3 +98,12 @@ func (s *State) Delete(nets []v1.Network) error {
 	}
 	return nil
 }
+
+func (s *State) AppliedPorts(networkName string) ([]v1.Port, bool) {
+	ports, exists := s.appliedPorts[networkName]
+	return ports, exists
+}
+
+func (s *State) SetAppliedPorts(networkName string, ports []v1.Port) {
+	s.appliedPorts[networkName] = append([]v1.Port{}, ports...)
+}
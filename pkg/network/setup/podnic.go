@@ -107,7 +107,8 @@ func (l *podNIC) newDHCPConfigurator() dhcpconfigurator.Configurator {
 			l.podInterfaceName,
 			l.vmi.Spec.Domain.Devices.Interfaces,
 			l.vmiSpecIface,
-			l.vmi.Spec.Subdomain)
+			l.vmi.Spec.Subdomain,
+			l.vmi.Spec.GuestDNS)
 	} else if l.vmiSpecIface.Masquerade != nil {
 		dhcpConfigurator = dhcpconfigurator.NewMasqueradeConfigurator(
 			link.GenerateBridgeName(l.podInterfaceName),
@@ -115,7 +116,8 @@ func (l *podNIC) newDHCPConfigurator() dhcpconfigurator.Configurator {
 			l.vmiSpecIface,
 			l.vmiSpecNetwork,
 			l.podInterfaceName,
-			l.vmi.Spec.Subdomain)
+			l.vmi.Spec.Subdomain,
+			l.vmi.Spec.GuestDNS)
 	}
 	return dhcpConfigurator
 }
@@ -188,3 +188,9 @@ func HasPasstBinding(vmi *v1.VirtualMachineInstance) bool {
 	}
 	return false
 }
+
+// HasEnforcedPorts checks whether only the listed ports of a masquerade interface are exposed,
+// allowing them to be updated while the VMI is running.
+func HasEnforcedPorts(iface v1.Interface) bool {
+	return iface.Masquerade != nil && iface.PortsEnforcement == v1.PortsEnforcementStrict
+}
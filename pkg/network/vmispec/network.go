@@ -44,6 +44,11 @@ func FilterMultusNonDefaultNetworks(networks []v1.Network) []v1.Network {
 	return FilterNetworksSpec(networks, IsSecondaryMultusNetwork)
 }
 
+// FilterSecondaryNetworks returns the secondary networks, regardless of the API providing them (Multus or native).
+func FilterSecondaryNetworks(networks []v1.Network) []v1.Network {
+	return FilterNetworksSpec(networks, IsSecondaryNetwork)
+}
+
 func FilterNetworksSpec(nets []v1.Network, predicate func(i v1.Network) bool) []v1.Network {
 	var filteredNets []v1.Network
 	for _, net := range nets {
@@ -56,7 +61,7 @@ func FilterNetworksSpec(nets []v1.Network, predicate func(i v1.Network) bool) []
 
 func LookUpDefaultNetwork(networks []v1.Network) *v1.Network {
 	for i, network := range networks {
-		if !IsSecondaryMultusNetwork(network) {
+		if !IsSecondaryNetwork(network) {
 			return &networks[i]
 		}
 	}
@@ -67,6 +72,14 @@ func IsSecondaryMultusNetwork(net v1.Network) bool {
 	return net.Multus != nil && !net.Multus.Default
 }
 
+func IsSecondaryNativeNetwork(net v1.Network) bool {
+	return net.Native != nil
+}
+
+func IsSecondaryNetwork(net v1.Network) bool {
+	return IsSecondaryMultusNetwork(net) || IsSecondaryNativeNetwork(net)
+}
+
 func IndexNetworkSpecByName(networks []v1.Network) map[string]v1.Network {
 	indexedNetworks := map[string]v1.Network{}
 	for _, network := range networks {
@@ -0,0 +1,76 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmispec
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// MaxNetworkQueues is the maximum number of queues of a tap device.
+const MaxNetworkQueues = uint32(256)
+
+// NetworkQueues returns the number of queue pairs of a multi-queue interface, for a guest with the given vCPUs.
+// Without a policy, each vCPU gets its own queue pair.
+func NetworkQueues(vcpus uint32, policy *v1.NetworkQueuesPolicy) uint32 {
+	vcpusPerQueue, maxQueues := uint32(1), MaxNetworkQueues
+	if policy != nil {
+		if policy.VCPUsPerQueue != nil && *policy.VCPUsPerQueue > 0 {
+			vcpusPerQueue = *policy.VCPUsPerQueue
+		}
+		if policy.MaxQueues != nil && *policy.MaxQueues > 0 && *policy.MaxQueues < maxQueues {
+			maxQueues = *policy.MaxQueues
+		}
+	}
+
+	queues := (vcpus + vcpusPerQueue - 1) / vcpusPerQueue
+	if queues > maxQueues {
+		queues = maxQueues
+	}
+	return queues
+}
+
+// ValidateNetworkQueuesPolicy checks that the policy ratio and cap are within the supported range.
+func ValidateNetworkQueuesPolicy(fieldPath *k8sfield.Path, policy *v1.NetworkQueuesPolicy) []metav1.StatusCause {
+	if policy == nil {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	if policy.VCPUsPerQueue != nil && *policy.VCPUsPerQueue == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "vcpusPerQueue must be greater than zero",
+			Field:   fieldPath.Child("vcpusPerQueue").String(),
+		})
+	}
+	if policy.MaxQueues != nil && (*policy.MaxQueues == 0 || *policy.MaxQueues > MaxNetworkQueues) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("maxQueues must be between 1 and %d", MaxNetworkQueues),
+			Field:   fieldPath.Child("maxQueues").String(),
+		})
+	}
+	return causes
+}
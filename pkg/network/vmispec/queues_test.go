@@ -0,0 +1,80 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmispec_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Network queues", func() {
+	DescribeTable("should calculate the number of queue pairs", func(vcpus uint32, policy *v1.NetworkQueuesPolicy, expectedQueues uint32) {
+		Expect(vmispec.NetworkQueues(vcpus, policy)).To(Equal(expectedQueues))
+	},
+		Entry("with a queue pair per vCPU when there is no policy", uint32(8), nil, uint32(8)),
+		Entry("capped to the tap device maximum when there is no policy", uint32(1024), nil, vmispec.MaxNetworkQueues),
+		Entry("with a queue pair per vCPU when the policy is empty", uint32(8), &v1.NetworkQueuesPolicy{}, uint32(8)),
+		Entry("with a queue pair every two vCPUs",
+			uint32(8), &v1.NetworkQueuesPolicy{VCPUsPerQueue: pointer.P(uint32(2))}, uint32(4)),
+		Entry("rounding up a partial queue pair",
+			uint32(5), &v1.NetworkQueuesPolicy{VCPUsPerQueue: pointer.P(uint32(2))}, uint32(3)),
+		Entry("with a single queue pair when there are less vCPUs than the ratio",
+			uint32(1), &v1.NetworkQueuesPolicy{VCPUsPerQueue: pointer.P(uint32(4))}, uint32(1)),
+		Entry("capped to the policy maximum",
+			uint32(64), &v1.NetworkQueuesPolicy{MaxQueues: pointer.P(uint32(16))}, uint32(16)),
+		Entry("capped to the policy maximum after the ratio",
+			uint32(64), &v1.NetworkQueuesPolicy{VCPUsPerQueue: pointer.P(uint32(2)), MaxQueues: pointer.P(uint32(16))}, uint32(16)),
+		Entry("not capped when the ratio stays below the policy maximum",
+			uint32(16), &v1.NetworkQueuesPolicy{VCPUsPerQueue: pointer.P(uint32(2)), MaxQueues: pointer.P(uint32(16))}, uint32(8)),
+	)
+
+	It("should accept a valid policy", func() {
+		policy := &v1.NetworkQueuesPolicy{VCPUsPerQueue: pointer.P(uint32(2)), MaxQueues: pointer.P(uint32(256))}
+		Expect(vmispec.ValidateNetworkQueuesPolicy(k8sfield.NewPath("fake"), policy)).To(BeEmpty())
+	})
+
+	DescribeTable("should reject an invalid policy", func(policy *v1.NetworkQueuesPolicy, expectedCause metav1.StatusCause) {
+		Expect(vmispec.ValidateNetworkQueuesPolicy(k8sfield.NewPath("fake"), policy)).To(ConsistOf(expectedCause))
+	},
+		Entry("with zero vCPUs per queue", &v1.NetworkQueuesPolicy{VCPUsPerQueue: pointer.P(uint32(0))}, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "vcpusPerQueue must be greater than zero",
+			Field:   "fake.vcpusPerQueue",
+		}),
+		Entry("with zero maximum queues", &v1.NetworkQueuesPolicy{MaxQueues: pointer.P(uint32(0))}, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "maxQueues must be between 1 and 256",
+			Field:   "fake.maxQueues",
+		}),
+		Entry("with maximum queues above the tap device limit", &v1.NetworkQueuesPolicy{MaxQueues: pointer.P(uint32(257))}, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "maxQueues must be between 1 and 256",
+			Field:   "fake.maxQueues",
+		}),
+	)
+})
@@ -110,6 +110,12 @@ func areNormalizedIfacesEqual(iface1, iface2 v1.Interface) bool {
 	normalizedIface2 := iface2.DeepCopy()
 	normalizedIface2.State = ""
 
+	// The ports of interfaces which keep enforcing them are updated live
+	if vmispec.HasEnforcedPorts(iface1) && vmispec.HasEnforcedPorts(iface2) {
+		normalizedIface1.Ports = nil
+		normalizedIface2.Ports = nil
+	}
+
 	return reflect.DeepEqual(normalizedIface1, normalizedIface2)
 }
 
@@ -160,6 +160,32 @@ var _ = Describe("IsRestartRequired", func() {
 		Entry("With FG disabled", !liveUpdateNADRefEnabled),
 	)
 
+	It("should not require restart when the enforced ports change", func() {
+		iface := libvmi.InterfaceDeviceWithMasqueradeBinding(v1.Port{Port: 80})
+		iface.PortsEnforcement = v1.PortsEnforcementStrict
+		vmi := libvmi.New(
+			libvmi.WithInterface(iface),
+			libvmi.WithNetwork(v1.DefaultPodNetwork()),
+		)
+
+		vm := libvmi.NewVirtualMachine(vmi).DeepCopy()
+		vm.Spec.Template.Spec.Domain.Devices.Interfaces[0].Ports = []v1.Port{{Port: 8080}}
+
+		Expect(vmliveupdate.IsRestartRequired(vm, vmi, stubClusterConfigurer{liveUpdateNADRefEnabled})).To(BeFalse())
+	})
+
+	It("should require restart when the ports change without being enforced", func() {
+		vmi := libvmi.New(
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding(v1.Port{Port: 80})),
+			libvmi.WithNetwork(v1.DefaultPodNetwork()),
+		)
+
+		vm := libvmi.NewVirtualMachine(vmi).DeepCopy()
+		vm.Spec.Template.Spec.Domain.Devices.Interfaces[0].Ports = []v1.Port{{Port: 8080}}
+
+		Expect(vmliveupdate.IsRestartRequired(vm, vmi, stubClusterConfigurer{liveUpdateNADRefEnabled})).To(BeTrue())
+	})
+
 	It("should require restart when interface binding changes", func() {
 		iface := libvmi.InterfaceDeviceWithBridgeBinding(secondaryNetName1)
 
@@ -0,0 +1,412 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ovf
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	v1 "kubevirt.io/api/core/v1"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+const (
+	diskHostResourcePrefix = "/disk/"
+
+	firmwareConfigKey   = "firmware"
+	secureBootConfigKey = "uefi.secureBoot.enabled"
+
+	// Generated names are used as labels of the virt-launcher and importer pods
+	maxNameLength = validation.DNS1123LabelMaxLength
+)
+
+var (
+	allocationUnitsRegex = regexp.MustCompile(`^byte\s*(?:\*\s*(\d+)\s*\^\s*(\d+))?$`)
+	invalidNameCharRegex = regexp.MustCompile(`[^a-z0-9-]+`)
+)
+
+// Options tune the conversion of an OVF descriptor
+type Options struct {
+	// Name overrides the name of the VirtualMachine, which defaults to the name of the virtual system
+	Name string
+	// Namespace of the VirtualMachine and DataVolumes
+	Namespace string
+	// DiskURLBase is the base URL the disk files of the descriptor are served from. The DataVolumes get an upload
+	// source when it is not set.
+	DiskURLBase string
+	// StorageClass of the DataVolumes, the default storage class is used when it is not set
+	StorageClass string
+	// NetworkMappings maps OVF network names to Multus network attachment definitions. A single unmapped
+	// network is connected to the pod network.
+	NetworkMappings map[string]string
+}
+
+// Convert produces the VirtualMachine described by the OVF descriptor, and the DataVolumes importing its disks
+func Convert(envelope *Envelope, opts Options) (*v1.VirtualMachine, []*cdiv1.DataVolume, error) {
+	if len(envelope.VirtualSystems) != 1 {
+		return nil, nil, fmt.Errorf("expected a single virtual system, found %d", len(envelope.VirtualSystems))
+	}
+	system := envelope.VirtualSystems[0]
+
+	name := opts.Name
+	if name == "" {
+		name = sanitizeName(system.Name)
+		if name == "" {
+			name = sanitizeName(system.ID)
+		}
+	}
+	if name == "" {
+		return nil, nil, errors.New("virtual system has no usable name, a name has to be provided")
+	}
+
+	vm := &v1.VirtualMachine{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       v1.VirtualMachineGroupVersionKind.Kind,
+			APIVersion: v1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: opts.Namespace,
+		},
+		Spec: v1.VirtualMachineSpec{
+			RunStrategy: pointer.P(v1.RunStrategyAlways),
+			Template:    &v1.VirtualMachineInstanceTemplateSpec{},
+		},
+	}
+	spec := &vm.Spec.Template.Spec
+
+	hardware := &system.VirtualHardware
+	items := hardware.AllItems()
+	if err := convertCPU(items, spec); err != nil {
+		return nil, nil, err
+	}
+	if err := convertMemory(items, spec); err != nil {
+		return nil, nil, err
+	}
+	convertFirmware(hardware, spec)
+	if err := convertNetworks(items, opts.NetworkMappings, spec); err != nil {
+		return nil, nil, err
+	}
+	dataVolumes, err := convertDisks(envelope, items, name, opts, spec)
+	if err != nil {
+		return nil, nil, err
+	}
+	return vm, dataVolumes, nil
+}
+
+func convertCPU(items []Item, spec *v1.VirtualMachineInstanceSpec) error {
+	for _, item := range items {
+		if item.ResourceType != ResourceTypeProcessor {
+			continue
+		}
+		if item.VirtualQuantity <= 0 {
+			return fmt.Errorf("invalid number of virtual CPUs %d", item.VirtualQuantity)
+		}
+		coresPerSocket := item.CoresPerSocket
+		if coresPerSocket <= 0 || item.VirtualQuantity%coresPerSocket != 0 {
+			coresPerSocket = 1
+		}
+		spec.Domain.CPU = &v1.CPU{
+			Sockets: uint32(item.VirtualQuantity / coresPerSocket),
+			Cores:   uint32(coresPerSocket),
+			Threads: 1,
+		}
+		return nil
+	}
+	return nil
+}
+
+func convertMemory(items []Item, spec *v1.VirtualMachineInstanceSpec) error {
+	for _, item := range items {
+		if item.ResourceType != ResourceTypeMemory {
+			continue
+		}
+		// The memory allocation units default to megabytes
+		units := item.AllocationUnits
+		if units == "" {
+			units = "byte * 2^20"
+		}
+		bytes, err := toBytes(item.VirtualQuantity, units)
+		if err != nil {
+			return fmt.Errorf("invalid memory: %v", err)
+		}
+		spec.Domain.Memory = &v1.Memory{Guest: resource.NewQuantity(bytes, resource.BinarySI)}
+		return nil
+	}
+	return nil
+}
+
+func convertFirmware(hardware *VirtualHardware, spec *v1.VirtualMachineInstanceSpec) {
+	if firmware, _ := hardware.Config(firmwareConfigKey); !strings.EqualFold(firmware, "efi") {
+		return
+	}
+	secureBoot, _ := hardware.Config(secureBootConfigKey)
+	isSecureBoot := strings.EqualFold(secureBoot, "true")
+	spec.Domain.Firmware = &v1.Firmware{
+		Bootloader: &v1.Bootloader{
+			EFI: &v1.EFI{SecureBoot: pointer.P(isSecureBoot)},
+		},
+	}
+	if isSecureBoot {
+		spec.Domain.Features = &v1.Features{SMM: &v1.FeatureState{Enabled: pointer.P(true)}}
+	}
+}
+
+// interfaceModel maps the network adapter type of the descriptor to the closest model KubeVirt emulates. SR-IOV
+// adapters are passed through.
+func interfaceModel(subType string) (model string, isSRIOV bool) {
+	switch strings.ToLower(subType) {
+	case "e1000":
+		return "e1000", false
+	case "e1000e":
+		return "e1000e", false
+	case "pcnet32", "pcnet":
+		return "pcnet", false
+	case "rtl8139":
+		return "rtl8139", false
+	case "sriovethernetcard", "virtualsriovethernetcard":
+		return "", true
+	default:
+		// Paravirtualized adapters like vmxnet3 are replaced by virtio
+		return v1.VirtIO, false
+	}
+}
+
+func convertNetworks(items []Item, networkMappings map[string]string, spec *v1.VirtualMachineInstanceSpec) error {
+	hasPodNetwork := false
+	for _, item := range items {
+		if item.ResourceType != ResourceTypeEthernetAdapter {
+			continue
+		}
+		name := fmt.Sprintf("nic-%d", len(spec.Networks))
+		model, isSRIOV := interfaceModel(item.ResourceSubType)
+
+		var ovfNetwork string
+		if len(item.Connection) > 0 {
+			ovfNetwork = item.Connection[0]
+		}
+		nad, isMapped := networkMappings[ovfNetwork]
+
+		iface := v1.Interface{Name: name, Model: model}
+		network := v1.Network{Name: name}
+		switch {
+		case isMapped:
+			network.Multus = &v1.MultusNetwork{NetworkName: nad}
+			if isSRIOV {
+				iface.SRIOV = &v1.InterfaceSRIOV{}
+			} else {
+				iface.Bridge = &v1.InterfaceBridge{}
+			}
+		case isSRIOV:
+			return fmt.Errorf("SR-IOV network adapter %q of network %q has to be mapped to a network attachment definition", item.ElementName, ovfNetwork)
+		case hasPodNetwork:
+			return fmt.Errorf("network %q is not mapped, only a single network can be connected to the pod network", ovfNetwork)
+		default:
+			hasPodNetwork = true
+			network.Pod = &v1.PodNetwork{}
+			iface.Masquerade = &v1.InterfaceMasquerade{}
+		}
+		spec.Domain.Devices.Interfaces = append(spec.Domain.Devices.Interfaces, iface)
+		spec.Networks = append(spec.Networks, network)
+	}
+	if len(spec.Networks) == 0 {
+		spec.Domain.Devices.AutoattachPodInterface = pointer.P(false)
+	}
+	return nil
+}
+
+func convertDisks(envelope *Envelope, items []Item, vmName string, opts Options, spec *v1.VirtualMachineInstanceSpec) ([]*cdiv1.DataVolume, error) {
+	controllers := make(map[string]Item)
+	for _, item := range items {
+		switch item.ResourceType {
+		case ResourceTypeIDEController, ResourceTypeSCSIController, ResourceTypeSATAController:
+			controllers[item.InstanceID] = item
+		}
+	}
+	disks := make(map[string]Disk, len(envelope.Disks))
+	for _, disk := range envelope.Disks {
+		disks[disk.DiskID] = disk
+	}
+	files := make(map[string]File, len(envelope.References))
+	for _, file := range envelope.References {
+		files[file.ID] = file
+	}
+
+	var dataVolumes []*cdiv1.DataVolume
+	for _, item := range items {
+		if item.ResourceType != ResourceTypeDiskDrive || len(item.HostResource) == 0 {
+			continue
+		}
+		_, diskID, found := strings.Cut(item.HostResource[0], diskHostResourcePrefix)
+		if !found {
+			return nil, fmt.Errorf("disk drive %q does not refer to a disk: %s", item.ElementName, item.HostResource[0])
+		}
+		disk, exists := disks[diskID]
+		if !exists {
+			return nil, fmt.Errorf("disk %s of disk drive %q is not described", diskID, item.ElementName)
+		}
+
+		name := limitNameLength(fmt.Sprintf("%s-disk-%d", vmName, len(dataVolumes)))
+		dataVolume, err := newDataVolume(name, disk, files, opts)
+		if err != nil {
+			return nil, err
+		}
+		dataVolumes = append(dataVolumes, dataVolume)
+
+		spec.Domain.Devices.Disks = append(spec.Domain.Devices.Disks, v1.Disk{
+			Name: name,
+			DiskDevice: v1.DiskDevice{
+				Disk: &v1.DiskTarget{Bus: diskBus(controllers[item.Parent])},
+			},
+		})
+		spec.Volumes = append(spec.Volumes, v1.Volume{
+			Name: name,
+			VolumeSource: v1.VolumeSource{
+				DataVolume: &v1.DataVolumeSource{Name: name},
+			},
+		})
+	}
+	if len(spec.Domain.Devices.Disks) > 0 {
+		spec.Domain.Devices.Disks[0].BootOrder = pointer.P(uint(1))
+	}
+	return dataVolumes, nil
+}
+
+// diskBus keeps the bus of the disk controller, since the guest may lack virtio drivers
+func diskBus(controller Item) v1.DiskBus {
+	switch controller.ResourceType {
+	case ResourceTypeSCSIController:
+		return v1.DiskBusSCSI
+	case ResourceTypeIDEController, ResourceTypeSATAController:
+		return v1.DiskBusSATA
+	default:
+		return v1.DiskBusVirtio
+	}
+}
+
+func newDataVolume(name string, disk Disk, files map[string]File, opts Options) (*cdiv1.DataVolume, error) {
+	capacity, err := strconv.ParseInt(disk.Capacity, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid capacity %q of disk %s: %v", disk.Capacity, disk.DiskID, err)
+	}
+	// The disk capacity is in bytes unless stated otherwise
+	units := disk.CapacityAllocationUnits
+	if units == "" {
+		units = "byte"
+	}
+	size, err := toBytes(capacity, units)
+	if err != nil {
+		return nil, fmt.Errorf("invalid capacity of disk %s: %v", disk.DiskID, err)
+	}
+
+	source := &cdiv1.DataVolumeSource{Upload: &cdiv1.DataVolumeSourceUpload{}}
+	if opts.DiskURLBase != "" {
+		file, exists := files[disk.FileRef]
+		if !exists {
+			return nil, fmt.Errorf("file %s of disk %s is not referenced", disk.FileRef, disk.DiskID)
+		}
+		source = &cdiv1.DataVolumeSource{
+			HTTP: &cdiv1.DataVolumeSourceHTTP{URL: strings.TrimSuffix(opts.DiskURLBase, "/") + "/" + file.Href},
+		}
+	}
+
+	dataVolume := &cdiv1.DataVolume{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "DataVolume",
+			APIVersion: cdiv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: opts.Namespace,
+		},
+		Spec: cdiv1.DataVolumeSpec{
+			Source: source,
+			Storage: &cdiv1.StorageSpec{
+				Resources: k8sv1.VolumeResourceRequirements{
+					Requests: k8sv1.ResourceList{
+						k8sv1.ResourceStorage: *resource.NewQuantity(size, resource.BinarySI),
+					},
+				},
+			},
+		},
+	}
+	if opts.StorageClass != "" {
+		dataVolume.Spec.Storage.StorageClassName = pointer.P(opts.StorageClass)
+	}
+	return dataVolume, nil
+}
+
+// toBytes converts a quantity expressed in programmatic units, like "byte * 2^20", to bytes
+func toBytes(quantity int64, units string) (int64, error) {
+	matches := allocationUnitsRegex.FindStringSubmatch(strings.TrimSpace(units))
+	if matches == nil {
+		return 0, fmt.Errorf("unsupported allocation units %q", units)
+	}
+	if quantity < 0 {
+		return 0, fmt.Errorf("negative quantity %d", quantity)
+	}
+	if matches[1] == "" {
+		return quantity, nil
+	}
+	base, err := strconv.ParseInt(matches[1], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("unsupported allocation units %q: %v", units, err)
+	}
+	exponent, err := strconv.ParseInt(matches[2], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("unsupported allocation units %q: %v", units, err)
+	}
+	bytes := quantity
+	for i := int64(0); i < exponent && bytes != 0; i++ {
+		if base != 0 && bytes > math.MaxInt64/base {
+			return 0, fmt.Errorf("quantity %d in units %q overflows", quantity, units)
+		}
+		bytes *= base
+	}
+	return bytes, nil
+}
+
+func sanitizeName(name string) string {
+	name = invalidNameCharRegex.ReplaceAllString(strings.ToLower(name), "-")
+	return limitNameLength(strings.Trim(name, "-"))
+}
+
+// limitNameLength truncates the names exceeding the maximal length, keeping them unique with a hash of the full name
+func limitNameLength(name string) string {
+	if len(name) <= maxNameLength {
+		return name
+	}
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(name))
+	suffix := fmt.Sprintf("-%08x", hash.Sum32())
+	return strings.TrimRight(name[:maxNameLength-len(suffix)], "-") + suffix
+}
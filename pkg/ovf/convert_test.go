@@ -0,0 +1,190 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ovf_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/ovf"
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("OVF conversion", func() {
+	var envelope *ovf.Envelope
+
+	BeforeEach(func() {
+		var err error
+		envelope, err = ovf.Parse(strings.NewReader(descriptor))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	storageNetworkMapping := map[string]string{"Storage Network": "storage-nad"}
+
+	It("should convert the virtual hardware", func() {
+		vm, _, err := ovf.Convert(envelope, ovf.Options{Namespace: "migrated", NetworkMappings: storageNetworkMapping})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(vm.Name).To(Equal("web-01"))
+		Expect(vm.Namespace).To(Equal("migrated"))
+		Expect(vm.Kind).To(Equal("VirtualMachine"))
+		Expect(vm.Spec.RunStrategy).To(HaveValue(Equal(v1.RunStrategyAlways)))
+
+		domain := vm.Spec.Template.Spec.Domain
+		Expect(domain.CPU).To(Equal(&v1.CPU{Sockets: 2, Cores: 2, Threads: 1}))
+		Expect(domain.Memory.Guest.Value()).To(Equal(int64(4096 * 1024 * 1024)))
+		Expect(domain.Firmware.Bootloader.EFI.SecureBoot).To(HaveValue(BeTrue()))
+		Expect(domain.Features.SMM.Enabled).To(HaveValue(BeTrue()))
+	})
+
+	It("should use the given name", func() {
+		vm, dataVolumes, err := ovf.Convert(envelope, ovf.Options{Name: "web", NetworkMappings: storageNetworkMapping})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vm.Name).To(Equal("web"))
+		Expect(dataVolumes[0].Name).To(Equal("web-disk-0"))
+	})
+
+	It("should map the network adapters to KubeVirt bindings", func() {
+		vm, _, err := ovf.Convert(envelope, ovf.Options{NetworkMappings: storageNetworkMapping})
+		Expect(err).ToNot(HaveOccurred())
+
+		spec := vm.Spec.Template.Spec
+		Expect(spec.Networks).To(Equal([]v1.Network{
+			{Name: "nic-0", NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}},
+			{Name: "nic-1", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "storage-nad"}}},
+		}))
+		Expect(spec.Domain.Devices.Interfaces).To(Equal([]v1.Interface{
+			{
+				Name:                   "nic-0",
+				Model:                  v1.VirtIO,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+			},
+			{
+				Name:                   "nic-1",
+				Model:                  "e1000e",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+			},
+		}))
+	})
+
+	It("should pass SR-IOV network adapters through", func() {
+		items := envelope.VirtualSystems[0].VirtualHardware.Items
+		items[len(items)-1].ResourceSubType = "SriovEthernetCard"
+
+		vm, _, err := ovf.Convert(envelope, ovf.Options{NetworkMappings: storageNetworkMapping})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vm.Spec.Template.Spec.Domain.Devices.Interfaces[1].SRIOV).ToNot(BeNil())
+		Expect(vm.Spec.Template.Spec.Domain.Devices.Interfaces[1].Model).To(BeEmpty())
+	})
+
+	It("should fail given more than one network is not mapped", func() {
+		_, _, err := ovf.Convert(envelope, ovf.Options{})
+		Expect(err).To(MatchError(ContainSubstring(`network "Storage Network" is not mapped`)))
+	})
+
+	It("should not attach the pod network given no network adapter", func() {
+		hardware := &envelope.VirtualSystems[0].VirtualHardware
+		hardware.Items = hardware.Items[:len(hardware.Items)-2]
+
+		vm, _, err := ovf.Convert(envelope, ovf.Options{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vm.Spec.Template.Spec.Networks).To(BeEmpty())
+		Expect(vm.Spec.Template.Spec.Domain.Devices.AutoattachPodInterface).To(HaveValue(BeFalse()))
+	})
+
+	It("should import every disk with a DataVolume", func() {
+		vm, dataVolumes, err := ovf.Convert(envelope, ovf.Options{
+			DiskURLBase:     "http://images.example.com/web-01/",
+			StorageClass:    "fast",
+			NetworkMappings: storageNetworkMapping,
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(dataVolumes).To(HaveLen(2))
+		Expect(dataVolumes[0].Name).To(Equal("web-01-disk-0"))
+		Expect(dataVolumes[0].Spec.Source.HTTP.URL).To(Equal("http://images.example.com/web-01/web-01-disk1.vmdk"))
+		Expect(dataVolumes[0].Spec.Storage.StorageClassName).To(HaveValue(Equal("fast")))
+		Expect(dataVolumes[0].Spec.Storage.Resources.Requests.Storage().Cmp(resource.MustParse("20Gi"))).To(BeZero())
+		Expect(dataVolumes[1].Spec.Storage.Resources.Requests.Storage().Value()).To(Equal(int64(1024 * 1024 * 1024)))
+
+		spec := vm.Spec.Template.Spec
+		Expect(spec.Domain.Devices.Disks).To(Equal([]v1.Disk{
+			{
+				Name:       "web-01-disk-0",
+				DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusSCSI}},
+				BootOrder:  pointer.P(uint(1)),
+			},
+			{
+				Name:       "web-01-disk-1",
+				DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusSATA}},
+			},
+		}))
+		Expect(spec.Volumes).To(ConsistOf(
+			v1.Volume{Name: "web-01-disk-0", VolumeSource: v1.VolumeSource{DataVolume: &v1.DataVolumeSource{Name: "web-01-disk-0"}}},
+			v1.Volume{Name: "web-01-disk-1", VolumeSource: v1.VolumeSource{DataVolume: &v1.DataVolumeSource{Name: "web-01-disk-1"}}},
+		))
+	})
+
+	It("should create DataVolumes with an upload source given no disk URL", func() {
+		_, dataVolumes, err := ovf.Convert(envelope, ovf.Options{NetworkMappings: storageNetworkMapping})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(dataVolumes[0].Spec.Source.Upload).ToNot(BeNil())
+		Expect(dataVolumes[0].Spec.Source.HTTP).To(BeNil())
+	})
+
+	It("should fail given a disk drive refers to an undescribed disk", func() {
+		envelope.Disks = envelope.Disks[:1]
+		_, _, err := ovf.Convert(envelope, ovf.Options{NetworkMappings: storageNetworkMapping})
+		Expect(err).To(MatchError(ContainSubstring("disk vmdisk2 of disk drive \"Hard disk 2\" is not described")))
+	})
+
+	DescribeTable("should fail given unsupported capacity", func(capacity, units, expectedErr string) {
+		envelope.Disks[0].Capacity = capacity
+		envelope.Disks[0].CapacityAllocationUnits = units
+		_, _, err := ovf.Convert(envelope, ovf.Options{NetworkMappings: storageNetworkMapping})
+		Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+	},
+		Entry("with a property reference", "${disk.size}", "", "invalid capacity"),
+		Entry("with unknown units", "20", "sectors", "unsupported allocation units"),
+		Entry("with a negative capacity", "-20", "byte * 2^30", "negative quantity -20"),
+		Entry("with a capacity overflowing", "20", "byte * 2^62", "quantity 20 in units \"byte * 2^62\" overflows"),
+	)
+
+	It("should limit the length of the generated names", func() {
+		envelope.VirtualSystems[0].Name = strings.Repeat("Web Server ", 10)
+		vm, dataVolumes, err := ovf.Convert(envelope, ovf.Options{NetworkMappings: storageNetworkMapping})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(vm.Name).To(HaveLen(validation.DNS1123LabelMaxLength))
+		Expect(validation.IsDNS1123Label(vm.Name)).To(BeEmpty())
+		Expect(dataVolumes).To(HaveLen(2))
+		for _, dataVolume := range dataVolumes {
+			Expect(validation.IsDNS1123Label(dataVolume.Name)).To(BeEmpty())
+		}
+		Expect(dataVolumes[0].Name).ToNot(Equal(dataVolumes[1].Name))
+	})
+})
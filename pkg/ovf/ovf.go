@@ -0,0 +1,170 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ovf
+
+import (
+	"archive/tar"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+)
+
+// Resource types of the virtual hardware items, as defined by the CIM_ResourceAllocationSettingData class
+const (
+	ResourceTypeProcessor       = 3
+	ResourceTypeMemory          = 4
+	ResourceTypeIDEController   = 5
+	ResourceTypeSCSIController  = 6
+	ResourceTypeEthernetAdapter = 10
+	ResourceTypeCDDrive         = 15
+	ResourceTypeDiskDrive       = 17
+	ResourceTypeSATAController  = 20
+)
+
+// Envelope is the root element of an OVF descriptor
+type Envelope struct {
+	XMLName        xml.Name        `xml:"Envelope"`
+	References     []File          `xml:"References>File"`
+	Disks          []Disk          `xml:"DiskSection>Disk"`
+	Networks       []Network       `xml:"NetworkSection>Network"`
+	VirtualSystems []VirtualSystem `xml:"VirtualSystem"`
+}
+
+// File is an external file referenced by the descriptor, like a disk image
+type File struct {
+	ID   string `xml:"id,attr"`
+	Href string `xml:"href,attr"`
+	Size int64  `xml:"size,attr"`
+}
+
+// Disk is a virtual disk of the DiskSection
+type Disk struct {
+	DiskID                  string `xml:"diskId,attr"`
+	FileRef                 string `xml:"fileRef,attr"`
+	Capacity                string `xml:"capacity,attr"`
+	CapacityAllocationUnits string `xml:"capacityAllocationUnits,attr"`
+	Format                  string `xml:"format,attr"`
+}
+
+// Network is a logical network of the NetworkSection
+type Network struct {
+	Name        string `xml:"name,attr"`
+	Description string `xml:"Description"`
+}
+
+// VirtualSystem describes a single virtual machine
+type VirtualSystem struct {
+	ID              string          `xml:"id,attr"`
+	Name            string          `xml:"Name"`
+	OperatingSystem OperatingSystem `xml:"OperatingSystemSection"`
+	VirtualHardware VirtualHardware `xml:"VirtualHardwareSection"`
+}
+
+// OperatingSystem describes the guest operating system
+type OperatingSystem struct {
+	ID          int    `xml:"id,attr"`
+	OSType      string `xml:"osType,attr"`
+	Description string `xml:"Description"`
+}
+
+// VirtualHardware lists the virtual hardware of a virtual system
+type VirtualHardware struct {
+	Items         []Item   `xml:"Item"`
+	EthernetPorts []Item   `xml:"EthernetPortItem"`
+	StorageItems  []Item   `xml:"StorageItem"`
+	Configs       []Config `xml:"Config"`
+	ExtraConfigs  []Config `xml:"ExtraConfig"`
+}
+
+// Item is a virtual hardware element, described by its resource allocation settings
+type Item struct {
+	InstanceID      string   `xml:"InstanceID"`
+	ResourceType    int      `xml:"ResourceType"`
+	ResourceSubType string   `xml:"ResourceSubType"`
+	ElementName     string   `xml:"ElementName"`
+	VirtualQuantity int64    `xml:"VirtualQuantity"`
+	CoresPerSocket  int64    `xml:"CoresPerSocket"`
+	AllocationUnits string   `xml:"AllocationUnits"`
+	Parent          string   `xml:"Parent"`
+	Address         string   `xml:"Address"`
+	AddressOnParent string   `xml:"AddressOnParent"`
+	HostResource    []string `xml:"HostResource"`
+	Connection      []string `xml:"Connection"`
+}
+
+// Config is a hypervisor specific key value setting of the virtual hardware
+type Config struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:"value,attr"`
+}
+
+// Parse reads an OVF descriptor
+func Parse(r io.Reader) (*Envelope, error) {
+	envelope := &Envelope{}
+	if err := xml.NewDecoder(r).Decode(envelope); err != nil {
+		return nil, fmt.Errorf("failed to parse OVF descriptor: %v", err)
+	}
+	if len(envelope.VirtualSystems) == 0 {
+		return nil, errors.New("OVF descriptor does not describe any virtual system")
+	}
+	return envelope, nil
+}
+
+// ParseOVA reads the OVF descriptor of an OVA archive, which has to be the first .ovf file of the archive
+func ParseOVA(r io.Reader) (*Envelope, error) {
+	archive := tar.NewReader(r)
+	for {
+		header, err := archive.Next()
+		if err == io.EOF {
+			return nil, errors.New("OVA archive does not contain an OVF descriptor")
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to read OVA archive: %v", err)
+		}
+		if header.Typeflag == tar.TypeReg && strings.EqualFold(path.Ext(header.Name), ".ovf") {
+			return Parse(archive)
+		}
+	}
+}
+
+// Config returns the value of the hypervisor specific setting with the given key
+func (h *VirtualHardware) Config(key string) (string, bool) {
+	for _, config := range h.Configs {
+		if config.Key == key {
+			return config.Value, true
+		}
+	}
+	for _, config := range h.ExtraConfigs {
+		if config.Key == key {
+			return config.Value, true
+		}
+	}
+	return "", false
+}
+
+// AllItems returns every virtual hardware item, no matter which element the descriptor used for it
+func (h *VirtualHardware) AllItems() []Item {
+	items := make([]Item, 0, len(h.Items)+len(h.EthernetPorts)+len(h.StorageItems))
+	items = append(items, h.Items...)
+	items = append(items, h.EthernetPorts...)
+	return append(items, h.StorageItems...)
+}
@@ -0,0 +1,190 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ovf_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/ovf"
+)
+
+const descriptor = `<?xml version="1.0" encoding="UTF-8"?>
+<Envelope xmlns="http://schemas.dmtf.org/ovf/envelope/1"
+          xmlns:ovf="http://schemas.dmtf.org/ovf/envelope/1"
+          xmlns:rasd="http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_ResourceAllocationSettingData"
+          xmlns:vmw="http://www.vmware.com/schema/ovf">
+  <References>
+    <File ovf:id="file1" ovf:href="web-01-disk1.vmdk" ovf:size="1073741824"/>
+    <File ovf:id="file2" ovf:href="web-01-disk2.vmdk" ovf:size="2048"/>
+  </References>
+  <DiskSection>
+    <Info>Virtual disk information</Info>
+    <Disk ovf:diskId="vmdisk1" ovf:fileRef="file1" ovf:capacity="20" ovf:capacityAllocationUnits="byte * 2^30"/>
+    <Disk ovf:diskId="vmdisk2" ovf:fileRef="file2" ovf:capacity="1073741824"/>
+  </DiskSection>
+  <NetworkSection>
+    <Info>The list of logical networks</Info>
+    <Network ovf:name="VM Network"/>
+    <Network ovf:name="Storage Network"/>
+  </NetworkSection>
+  <VirtualSystem ovf:id="web-01">
+    <Info>A virtual machine</Info>
+    <Name>Web_01</Name>
+    <OperatingSystemSection ovf:id="101" vmw:osType="otherLinux64Guest">
+      <Info>The kind of installed guest operating system</Info>
+    </OperatingSystemSection>
+    <VirtualHardwareSection>
+      <Info>Virtual hardware requirements</Info>
+      <Item>
+        <rasd:AllocationUnits>hertz * 10^6</rasd:AllocationUnits>
+        <rasd:ElementName>4 virtual CPU(s)</rasd:ElementName>
+        <rasd:InstanceID>1</rasd:InstanceID>
+        <rasd:ResourceType>3</rasd:ResourceType>
+        <rasd:VirtualQuantity>4</rasd:VirtualQuantity>
+        <vmw:CoresPerSocket ovf:required="false">2</vmw:CoresPerSocket>
+      </Item>
+      <Item>
+        <rasd:AllocationUnits>byte * 2^20</rasd:AllocationUnits>
+        <rasd:ElementName>4096MB of memory</rasd:ElementName>
+        <rasd:InstanceID>2</rasd:InstanceID>
+        <rasd:ResourceType>4</rasd:ResourceType>
+        <rasd:VirtualQuantity>4096</rasd:VirtualQuantity>
+      </Item>
+      <Item>
+        <rasd:ElementName>SCSI controller 0</rasd:ElementName>
+        <rasd:InstanceID>3</rasd:InstanceID>
+        <rasd:ResourceSubType>VirtualSCSI</rasd:ResourceSubType>
+        <rasd:ResourceType>6</rasd:ResourceType>
+      </Item>
+      <Item>
+        <rasd:ElementName>SATA controller 0</rasd:ElementName>
+        <rasd:InstanceID>4</rasd:InstanceID>
+        <rasd:ResourceType>20</rasd:ResourceType>
+      </Item>
+      <Item>
+        <rasd:AddressOnParent>0</rasd:AddressOnParent>
+        <rasd:ElementName>Hard disk 1</rasd:ElementName>
+        <rasd:HostResource>ovf:/disk/vmdisk1</rasd:HostResource>
+        <rasd:InstanceID>5</rasd:InstanceID>
+        <rasd:Parent>3</rasd:Parent>
+        <rasd:ResourceType>17</rasd:ResourceType>
+      </Item>
+      <Item>
+        <rasd:AddressOnParent>0</rasd:AddressOnParent>
+        <rasd:ElementName>Hard disk 2</rasd:ElementName>
+        <rasd:HostResource>ovf:/disk/vmdisk2</rasd:HostResource>
+        <rasd:InstanceID>6</rasd:InstanceID>
+        <rasd:Parent>4</rasd:Parent>
+        <rasd:ResourceType>17</rasd:ResourceType>
+      </Item>
+      <Item ovf:required="false">
+        <rasd:AutomaticAllocation>false</rasd:AutomaticAllocation>
+        <rasd:ElementName>CD/DVD drive 1</rasd:ElementName>
+        <rasd:InstanceID>7</rasd:InstanceID>
+        <rasd:Parent>4</rasd:Parent>
+        <rasd:ResourceType>15</rasd:ResourceType>
+      </Item>
+      <Item>
+        <rasd:AutomaticAllocation>true</rasd:AutomaticAllocation>
+        <rasd:Connection>VM Network</rasd:Connection>
+        <rasd:ElementName>Network adapter 1</rasd:ElementName>
+        <rasd:InstanceID>8</rasd:InstanceID>
+        <rasd:ResourceSubType>VmxNet3</rasd:ResourceSubType>
+        <rasd:ResourceType>10</rasd:ResourceType>
+      </Item>
+      <Item>
+        <rasd:AutomaticAllocation>true</rasd:AutomaticAllocation>
+        <rasd:Connection>Storage Network</rasd:Connection>
+        <rasd:ElementName>Network adapter 2</rasd:ElementName>
+        <rasd:InstanceID>9</rasd:InstanceID>
+        <rasd:ResourceSubType>E1000e</rasd:ResourceSubType>
+        <rasd:ResourceType>10</rasd:ResourceType>
+      </Item>
+      <vmw:Config ovf:required="false" vmw:key="firmware" vmw:value="efi"/>
+      <vmw:ExtraConfig ovf:required="false" vmw:key="uefi.secureBoot.enabled" vmw:value="TRUE"/>
+    </VirtualHardwareSection>
+  </VirtualSystem>
+</Envelope>
+`
+
+var _ = Describe("OVF descriptor", func() {
+	It("should parse the virtual system", func() {
+		envelope, err := ovf.Parse(strings.NewReader(descriptor))
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(envelope.References).To(HaveLen(2))
+		Expect(envelope.Disks).To(ContainElement(ovf.Disk{
+			DiskID:                  "vmdisk1",
+			FileRef:                 "file1",
+			Capacity:                "20",
+			CapacityAllocationUnits: "byte * 2^30",
+		}))
+		Expect(envelope.Networks).To(HaveLen(2))
+		Expect(envelope.VirtualSystems).To(HaveLen(1))
+
+		system := envelope.VirtualSystems[0]
+		Expect(system.Name).To(Equal("Web_01"))
+		Expect(system.OperatingSystem.OSType).To(Equal("otherLinux64Guest"))
+		Expect(system.VirtualHardware.AllItems()).To(HaveLen(9))
+		Expect(system.VirtualHardware.Items[0].CoresPerSocket).To(Equal(int64(2)))
+		secureBoot, found := system.VirtualHardware.Config("uefi.secureBoot.enabled")
+		Expect(found).To(BeTrue())
+		Expect(secureBoot).To(Equal("TRUE"))
+	})
+
+	It("should fail to parse a descriptor without virtual system", func() {
+		_, err := ovf.Parse(strings.NewReader(`<Envelope xmlns="http://schemas.dmtf.org/ovf/envelope/1"></Envelope>`))
+		Expect(err).To(MatchError(ContainSubstring("does not describe any virtual system")))
+	})
+
+	It("should parse the descriptor of an OVA archive", func() {
+		var archive bytes.Buffer
+		writer := tar.NewWriter(&archive)
+		for _, file := range []struct {
+			name    string
+			content string
+		}{
+			{"web-01.mf", "SHA256(web-01.ovf)= 0000"},
+			{"web-01.ovf", descriptor},
+			{"web-01-disk1.vmdk", "disk"},
+		} {
+			Expect(writer.WriteHeader(&tar.Header{Name: file.name, Mode: 0644, Size: int64(len(file.content))})).To(Succeed())
+			_, err := writer.Write([]byte(file.content))
+			Expect(err).ToNot(HaveOccurred())
+		}
+		Expect(writer.Close()).To(Succeed())
+
+		envelope, err := ovf.ParseOVA(&archive)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(envelope.VirtualSystems[0].Name).To(Equal("Web_01"))
+	})
+
+	It("should fail to parse an OVA archive without descriptor", func() {
+		var archive bytes.Buffer
+		Expect(tar.NewWriter(&archive).Close()).To(Succeed())
+		_, err := ovf.ParseOVA(&archive)
+		Expect(err).To(MatchError(ContainSubstring("does not contain an OVF descriptor")))
+	})
+})
@@ -57,6 +57,7 @@ func ValidateDisks(field *k8sfield.Path, disks []v1.Disk) []metav1.StatusCause {
 		causes = append(causes, validateCacheMode(field, idx, disk)...)
 		causes = append(causes, validateIOMode(field, idx, disk)...)
 		causes = append(causes, validateErrorPolicy(field, idx, disk)...)
+		causes = append(causes, validateEncryption(field, idx, disk)...)
 		// Verify disk and volume name can be a valid container name since disk
 		// name can become a container name which will fail to schedule if invalid
 		causes = append(causes, validateDiskNameAsContainerName(field, idx, disk)...)
@@ -278,9 +279,36 @@ func validateIOMode(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.Status
 	return causes
 }
 
+// ValidateEncryptedDisks makes sure encrypted disks are backed by persistent volumes, the
+// LUKS volume has to be formatted in advance and must survive the VMI
+func ValidateEncryptedDisks(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, disk := range spec.Domain.Devices.Disks {
+		if disk.Encryption == nil {
+			continue
+		}
+		for _, volume := range spec.Volumes {
+			if volume.Name != disk.Name {
+				continue
+			}
+			switch {
+			case volume.PersistentVolumeClaim != nil && !volume.PersistentVolumeClaim.Hotpluggable,
+				volume.DataVolume != nil && !volume.DataVolume.Hotpluggable:
+			default:
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s is only supported with persistentVolumeClaim and dataVolume volumes that are not hotpluggable", field.Child("domain", "devices", "disks").Index(idx).Child("encryption").String()),
+					Field:   field.Child("domain", "devices", "disks").Index(idx).Child("encryption").String(),
+				})
+			}
+		}
+	}
+	return causes
+}
+
 func validateErrorPolicy(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.StatusCause {
 	var causes []metav1.StatusCause
-	if disk.ErrorPolicy != nil && *disk.ErrorPolicy != v1.DiskErrorPolicyStop && *disk.ErrorPolicy != v1.DiskErrorPolicyIgnore && *disk.ErrorPolicy != v1.DiskErrorPolicyReport && *disk.ErrorPolicy != v1.DiskErrorPolicyEnospace {
+	if disk.ErrorPolicy != nil && *disk.ErrorPolicy != v1.DiskErrorPolicyStop && *disk.ErrorPolicy != v1.DiskErrorPolicyIgnore && *disk.ErrorPolicy != v1.DiskErrorPolicyReport && *disk.ErrorPolicy != v1.DiskErrorPolicyEnospace && *disk.ErrorPolicy != v1.DiskErrorPolicyRetry && *disk.ErrorPolicy != v1.DiskErrorPolicyPause {
 		causes = append(causes, metav1.StatusCause{
 			Type:    metav1.CauseTypeFieldValueInvalid,
 			Message: fmt.Sprintf("%s has invalid value \"%s\"", field.Index(idx).Child("errorPolicy").String(), *disk.ErrorPolicy),
@@ -290,6 +318,28 @@ func validateErrorPolicy(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.S
 	return causes
 }
 
+func validateEncryption(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if disk.Encryption == nil {
+		return causes
+	}
+	if disk.CDRom != nil || disk.LUN != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s is only supported with disk devices", field.Index(idx).Child("encryption").String()),
+			Field:   field.Index(idx).Child("encryption").String(),
+		})
+	}
+	if disk.Encryption.SecretName == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf("%s must not be empty", field.Index(idx).Child("encryption", "secretName").String()),
+			Field:   field.Index(idx).Child("encryption", "secretName").String(),
+		})
+	}
+	return causes
+}
+
 func validateDiskNameAsContainerName(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	for _, err := range validation.IsDNS1123Label(disk.Name) {
@@ -327,6 +327,24 @@ var _ = Describe("Disk Validation", func() {
 			Entry("report", v1.DiskErrorPolicyReport),
 			Entry("ignore", v1.DiskErrorPolicyIgnore),
 			Entry("enospace", v1.DiskErrorPolicyEnospace),
+			Entry("retry", v1.DiskErrorPolicyRetry),
+			Entry("pause", v1.DiskErrorPolicyPause),
+		)
+
+		DescribeTable("should validate disk encryption", func(device v1.DiskDevice, secretName string, expectedFields ...string) {
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name: "testdisk", DiskDevice: device, Encryption: &v1.DiskEncryption{SecretName: secretName}})
+
+			causes := ValidateDisks(k8sfield.NewPath("fake"), vmi.Spec.Domain.Devices.Disks)
+			Expect(causes).To(HaveLen(len(expectedFields)))
+			for i, field := range expectedFields {
+				Expect(causes[i].Field).To(Equal(field))
+			}
+		},
+			Entry("accept a disk device", v1.DiskDevice{Disk: &v1.DiskTarget{}}, "luks-secret"),
+			Entry("reject a cdrom device", v1.DiskDevice{CDRom: &v1.CDRomTarget{}}, "luks-secret", "fake[0].encryption"),
+			Entry("reject a lun device", v1.DiskDevice{LUN: &v1.LunTarget{}}, "luks-secret", "fake[0].encryption"),
+			Entry("reject an empty secret name", v1.DiskDevice{Disk: &v1.DiskTarget{}}, "", "fake[0].encryption.secretName"),
 		)
 
 		It("should reject invalid SN characters", func() {
@@ -565,4 +583,29 @@ var _ = Describe("Disk Validation", func() {
 			})
 		})
 	})
+
+	Context("with ValidateEncryptedDisks", func() {
+		DescribeTable("should validate the volume of encrypted disks", func(volumeSource v1.VolumeSource, expectedCauses int) {
+			spec := &v1.VirtualMachineInstanceSpec{
+				Domain: v1.DomainSpec{Devices: v1.Devices{Disks: []v1.Disk{{
+					Name:       "testdisk",
+					Encryption: &v1.DiskEncryption{SecretName: "luks-secret"},
+				}}}},
+				Volumes: []v1.Volume{{Name: "testdisk", VolumeSource: volumeSource}},
+			}
+
+			causes := ValidateEncryptedDisks(k8sfield.NewPath("fake"), spec)
+			Expect(causes).To(HaveLen(expectedCauses))
+			if expectedCauses > 0 {
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.disks[0].encryption"))
+			}
+		},
+			Entry("accept a persistentVolumeClaim", v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{}}, 0),
+			Entry("accept a dataVolume", v1.VolumeSource{DataVolume: &v1.DataVolumeSource{Name: "dv"}}, 0),
+			Entry("reject a hotpluggable persistentVolumeClaim", v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{Hotpluggable: true}}, 1),
+			Entry("reject a hotpluggable dataVolume", v1.VolumeSource{DataVolume: &v1.DataVolumeSource{Name: "dv", Hotpluggable: true}}, 1),
+			Entry("reject a containerDisk", v1.VolumeSource{ContainerDisk: &v1.ContainerDiskSource{Image: "image"}}, 1),
+			Entry("reject an emptyDisk", v1.VolumeSource{EmptyDisk: &v1.EmptyDiskSource{}}, 1),
+		)
+	})
 })
@@ -0,0 +1,96 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package encryption
+
+import (
+	"os"
+	"strings"
+
+	"github.com/google/uuid"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	// PassphraseKey is the key of the Secret data holding the LUKS passphrase
+	PassphraseKey = "passphrase"
+
+	passphraseEnvVarPrefix = "KUBEVIRT_DISK_PASSPHRASE_"
+)
+
+// secretUUIDns is the namespace of the libvirt secret UUIDs, it must never change
+// so that migration source and target define the same secrets
+var secretUUIDns = uuid.MustParse("a3c1c3f8-5b2e-4c5e-9f52-6d0f4d3e8b71")
+
+// PassphraseEnvVarName returns the name of the compute container environment
+// variable carrying the passphrase of the given disk
+func PassphraseEnvVarName(diskName string) string {
+	return passphraseEnvVarPrefix + strings.ToUpper(strings.ReplaceAll(diskName, "-", "_"))
+}
+
+// EncryptedDisks returns the disks of the VMI opened as LUKS volumes
+func EncryptedDisks(vmi *v1.VirtualMachineInstance) []v1.Disk {
+	var disks []v1.Disk
+	for _, disk := range vmi.Spec.Domain.Devices.Disks {
+		if disk.Encryption != nil {
+			disks = append(disks, disk)
+		}
+	}
+	return disks
+}
+
+// HasPassphraseEnvVars returns true if the passphrase of any encrypted disk is
+// exposed to the current process
+func HasPassphraseEnvVars() bool {
+	for _, env := range os.Environ() {
+		if strings.HasPrefix(env, passphraseEnvVarPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// EnvVars returns the environment variables exposing the passphrases of the
+// encrypted disks to the compute container, straight from the Secrets
+func EnvVars(vmi *v1.VirtualMachineInstance) []k8sv1.EnvVar {
+	var envVars []k8sv1.EnvVar
+	for _, disk := range EncryptedDisks(vmi) {
+		envVars = append(envVars, k8sv1.EnvVar{
+			Name: PassphraseEnvVarName(disk.Name),
+			ValueFrom: &k8sv1.EnvVarSource{
+				SecretKeyRef: &k8sv1.SecretKeySelector{
+					LocalObjectReference: k8sv1.LocalObjectReference{
+						Name: disk.Encryption.SecretName,
+					},
+					Key: PassphraseKey,
+				},
+			},
+		})
+	}
+	return envVars
+}
+
+// SecretUUID returns the UUID of the libvirt secret holding the passphrase of
+// the given disk, it only depends on the VMI UID and the disk name
+func SecretUUID(vmiUID types.UID, diskName string) string {
+	return uuid.NewSHA1(secretUUIDns, []byte(string(vmiUID)+"/"+diskName)).String()
+}
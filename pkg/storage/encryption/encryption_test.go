@@ -0,0 +1,73 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package encryption_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/storage/encryption"
+)
+
+var _ = Describe("Disk encryption", func() {
+	newVMI := func() *v1.VirtualMachineInstance {
+		vmi := libvmi.New(
+			libvmi.WithPersistentVolumeClaim("root-disk", "root-pvc"),
+			libvmi.WithPersistentVolumeClaim("data-disk", "data-pvc"),
+		)
+		vmi.Spec.Domain.Devices.Disks[1].Encryption = &v1.DiskEncryption{SecretName: "luks-secret"}
+		return vmi
+	}
+
+	It("should expose the passphrase of encrypted disks only", func() {
+		Expect(encryption.EnvVars(newVMI())).To(ConsistOf(k8sv1.EnvVar{
+			Name: "KUBEVIRT_DISK_PASSPHRASE_DATA_DISK",
+			ValueFrom: &k8sv1.EnvVarSource{
+				SecretKeyRef: &k8sv1.SecretKeySelector{
+					LocalObjectReference: k8sv1.LocalObjectReference{Name: "luks-secret"},
+					Key:                  encryption.PassphraseKey,
+				},
+			},
+		}))
+	})
+
+	It("should not expose anything without encrypted disks", func() {
+		vmi := libvmi.New(libvmi.WithPersistentVolumeClaim("root-disk", "root-pvc"))
+		Expect(encryption.EncryptedDisks(vmi)).To(BeEmpty())
+		Expect(encryption.EnvVars(vmi)).To(BeEmpty())
+	})
+
+	It("should detect the passphrases exposed to the process", func() {
+		Expect(encryption.HasPassphraseEnvVars()).To(BeFalse())
+		GinkgoT().Setenv(encryption.PassphraseEnvVarName("data-disk"), "secret")
+		Expect(encryption.HasPassphraseEnvVars()).To(BeTrue())
+	})
+
+	It("should derive stable secret UUIDs from the VMI UID and the disk name", func() {
+		Expect(encryption.SecretUUID("uid-1", "data-disk")).To(Equal(encryption.SecretUUID("uid-1", "data-disk")))
+		Expect(encryption.SecretUUID("uid-1", "data-disk")).ToNot(Equal(encryption.SecretUUID("uid-2", "data-disk")))
+		Expect(encryption.SecretUUID("uid-1", "data-disk")).ToNot(Equal(encryption.SecretUUID("uid-1", "root-disk")))
+	})
+})
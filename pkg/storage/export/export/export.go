@@ -25,6 +25,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"path"
+	"strconv"
 	"strings"
 	"time"
 
@@ -96,6 +97,7 @@ const (
 	blockVolumeMountPath = "/dev/export-volumes"
 	fileSystemMountPath  = "/export-volumes"
 	urlBasePath          = "/volumes"
+	exportNBDPort        = 10809
 
 	// annContentType is an annotation on a PVC indicating the content type. This is populated by CDI.
 	annContentType = "cdi.kubevirt.io/storage.contentType"
@@ -708,7 +710,11 @@ func (ctrl *VMExportController) updateVMExport(vmExport *exportv1.VirtualMachine
 }
 
 func (ctrl *VMExportController) handleSource(vmExport *exportv1.VirtualMachineExport, source exportSource) (time.Duration, error) {
-	service, err := ctrl.getOrCreateExportService(vmExport, source.ServicePorts())
+	ports := source.ServicePorts()
+	if isNBDEnabled(vmExport) {
+		ports = append(ports, nbdPort())
+	}
+	service, err := ctrl.getOrCreateExportService(vmExport, ports)
 	if err != nil {
 		return 0, err
 	}
@@ -1051,6 +1057,22 @@ func exportPort() corev1.ServicePort {
 	}
 }
 
+func nbdPort() corev1.ServicePort {
+	return corev1.ServicePort{
+		Name:     "nbd",
+		Protocol: "TCP",
+		Port:     exportNBDPort,
+		TargetPort: intstr.IntOrString{
+			Type:   intstr.Int,
+			IntVal: exportNBDPort,
+		},
+	}
+}
+
+func isNBDEnabled(vmExport *exportv1.VirtualMachineExport) bool {
+	return vmExport.Spec.NBD != nil && *vmExport.Spec.NBD
+}
+
 func (ctrl *VMExportController) getExporterPod(vmExport *exportv1.VirtualMachineExport) (*corev1.Pod, bool, error) {
 	key := controller.NamespacedKey(vmExport.Namespace, ctrl.getExportPodName(vmExport))
 	if obj, exists, err := ctrl.PodInformer.GetStore().GetByKey(key); err != nil {
@@ -1136,6 +1158,12 @@ func (ctrl *VMExportController) createExporterPodManifest(vmExport *exportv1.Vir
 		Name:  "EXPORT_SECRET_DEF_URI",
 		Value: secretManifestPath,
 	})
+	if isNBDEnabled(vmExport) {
+		podManifest.Spec.Containers[0].Env = append(podManifest.Spec.Containers[0].Env, corev1.EnvVar{
+			Name:  "EXPORT_NBD_PORT",
+			Value: strconv.Itoa(exportNBDPort),
+		})
+	}
 
 	tokenSecretRef := ""
 	if vmExport.Status != nil && vmExport.Status.TokenSecretRef != nil {
@@ -60,7 +60,35 @@ func (ctrl *VMExportController) getInteralLinks(exporterPod *corev1.Pod, service
 		return nil, err
 	}
 	host := fmt.Sprintf("%s.%s.svc", service.Name, service.Namespace)
-	return ctrl.getLinks(exporterPod, export, host, internal, internalCert, source)
+	exportLink, err := ctrl.getLinks(exporterPod, export, host, internal, internalCert, source)
+	if err != nil || exportLink == nil {
+		return exportLink, err
+	}
+	addNBDFormats(exportLink, CreateServerPaths(ContainerEnvToMap(exporterPod.Spec.Containers[0].Env)), host)
+	return exportLink, nil
+}
+
+// addNBDFormats adds the NBD endpoint to the volumes exported in raw format, when the export server serves NBD.
+// NBD is not an HTTP protocol, so it is only reachable through the internal service.
+func addNBDFormats(exportLink *exportv1.VirtualMachineExportLink, paths *ServerPaths, host string) {
+	if paths.NBDPort == "" {
+		return
+	}
+	for i, volume := range exportLink.Volumes {
+		for _, format := range volume.Formats {
+			if format.Format != exportv1.KubeVirtRaw {
+				continue
+			}
+			for _, volumeInfo := range paths.Volumes {
+				if volumeInfo.RawURI != "" && strings.HasSuffix(format.Url, volumeInfo.RawURI) {
+					exportLink.Volumes[i].Formats = append(exportLink.Volumes[i].Formats, exportv1.VirtualMachineExportVolumeFormat{
+						Format: exportv1.NBD,
+						Url:    fmt.Sprintf("nbds://%s:%s/%s", host, paths.NBDPort, volumeInfo.NBDExportName()),
+					})
+				}
+			}
+		}
+	}
 }
 
 func (ctrl *VMExportController) getExternalLinks(exporterPod *corev1.Pod, export *exportv1.VirtualMachineExport, source exportSource) (*exportv1.VirtualMachineExportLink, error) {
@@ -21,6 +21,7 @@ package export
 
 import (
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
 
@@ -43,10 +44,19 @@ type BackupInfo struct {
 	MapURI  string
 }
 
+// NBDExportName returns the name the raw volume is exported as over NBD
+func (vi *VolumeInfo) NBDExportName() string {
+	if vi.RawURI == "" {
+		return ""
+	}
+	return path.Base(path.Dir(vi.RawURI))
+}
+
 // ServerPaths contains static paths and per-volume paths
 type ServerPaths struct {
 	VMURI     string
 	SecretURI string
+	NBDPort   string
 	Volumes   []VolumeInfo
 	Backups   []BackupInfo
 }
@@ -75,6 +85,7 @@ func CreateServerPaths(env map[string]string) *ServerPaths {
 	result := &ServerPaths{
 		VMURI:     env["EXPORT_VM_DEF_URI"],
 		SecretURI: env["EXPORT_SECRET_DEF_URI"],
+		NBDPort:   env["EXPORT_NBD_PORT"],
 	}
 	for k, v := range env {
 		if strings.HasSuffix(k, "_EXPORT_PATH") {
@@ -52,6 +52,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/certificates/bootstrap"
 	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
@@ -284,6 +285,34 @@ var _ = Describe("PVC source", func() {
 		Expect(service.Name).To(Equal(fmt.Sprintf("%s-%s", exportPrefix, testVMExport.Name)))
 	})
 
+	It("Should properly update VMExport status with a valid token and kubevirt pvc over NBD", func() {
+		testVMExport := createPVCVMExport()
+		testVMExport.Spec.NBD = pointer.P(true)
+		pvcInformer.GetStore().Add(createPVC(testPVCName, "kubevirt"))
+		expectExporterCreate(k8sClient, k8sv1.PodRunning)
+		controller.RouteCache.Add(routeToHostAndService(components.VirtExportProxyServiceName))
+
+		vmExportClient.Fake.PrependReactor("update", "virtualmachineexports", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			update, ok := action.(testing.UpdateAction)
+			Expect(ok).To(BeTrue())
+			vmExport, ok := update.GetObject().(*exportv1.VirtualMachineExport)
+			Expect(ok).To(BeTrue())
+			Expect(vmExport.Status.Links.Internal.Volumes).To(HaveLen(1))
+			Expect(vmExport.Status.Links.Internal.Volumes[0].Formats).To(ContainElement(exportv1.VirtualMachineExportVolumeFormat{
+				Format: exportv1.NBD,
+				Url:    fmt.Sprintf("nbds://%s-%s.%s.svc:%d/%s", exportPrefix, vmExport.Name, testNamespace, exportNBDPort, testPVCName),
+			}))
+			verifyKubevirtExternal(vmExport, vmExport.Name, testNamespace, testVMExport.Spec.Source.Name)
+			return true, vmExport, nil
+		})
+		retry, err := controller.updateVMExport(testVMExport)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(BeEquivalentTo(0))
+		service, err := k8sClient.CoreV1().Services(testNamespace).Get(context.Background(), fmt.Sprintf("%s-%s", exportPrefix, testVMExport.Name), metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(service.Spec.Ports).To(ConsistOf(exportPort(), nbdPort()))
+	})
+
 	It("Should properly update VMExport status with a valid token and no pvc, pending pod", func() {
 		testVMExport := createPVCVMExport()
 		expectExporterCreate(k8sClient, k8sv1.PodPending)
@@ -241,6 +241,19 @@ func (s *exportServer) Run() {
 		ch <- err
 	}()
 
+	if s.Paths.NBDPort != "" {
+		nbdListener, err := net.Listen("tcp", ":"+s.Paths.NBDPort)
+		if err != nil {
+			panic(err)
+		}
+		defer nbdListener.Close()
+
+		nbdServer := newNBDServer(s.Paths.Volumes, s.buildNBDTLSConfig(), s.TokenGetter)
+		go func() {
+			ch <- nbdServer.Serve(nbdListener)
+		}()
+	}
+
 	if !s.Deadline.IsZero() {
 		log.Log.Infof("Deadline set to %s", s.Deadline)
 		select {
@@ -287,6 +300,17 @@ func (s *exportServer) buildServer() *http.Server {
 	}
 }
 
+func (s *exportServer) buildNBDTLSConfig() *tls.Config {
+	keyPair, err := tls.LoadX509KeyPair(s.CertFile, s.KeyFile)
+	if err != nil {
+		panic(err)
+	}
+	return &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{keyPair},
+	}
+}
+
 func (s *exportServer) AddFlags() {
 	flag.CommandLine.AddGoFlag(goflag.CommandLine.Lookup("v"))
 }
@@ -0,0 +1,566 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"golang.org/x/sys/unix"
+
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/storage/export/export"
+)
+
+// Read-only NBD server of the raw volumes, implementing the fixed newstyle handshake with mandatory TLS.
+// See https://github.com/NetworkBlockDevice/nbd/blob/master/doc/proto.md
+const (
+	nbdMagic                = 0x4e42444d41474943 // NBDMAGIC
+	nbdOptMagic             = 0x49484156454f5054 // IHAVEOPT
+	nbdOptReplyMagic        = 0x0003e889045565a9
+	nbdRequestMagic         = 0x25609513
+	nbdSimpleReplyMagic     = 0x67446698
+	nbdStructuredReplyMagic = 0x668e33ef
+
+	nbdFlagFixedNewstyle = 1 << 0
+	nbdFlagNoZeroes      = 1 << 1
+
+	nbdOptExportName      = 1
+	nbdOptAbort           = 2
+	nbdOptStartTLS        = 5
+	nbdOptInfo            = 6
+	nbdOptGo              = 7
+	nbdOptStructuredReply = 8
+	nbdOptListMetaContext = 9
+	nbdOptSetMetaContext  = 10
+
+	nbdRepAck         = 1
+	nbdRepInfo        = 3
+	nbdRepMetaContext = 4
+	nbdRepErrUnsup    = 1<<31 + 1
+	nbdRepErrPolicy   = 1<<31 + 2
+	nbdRepErrInvalid  = 1<<31 + 3
+	nbdRepErrTLSReqd  = 1<<31 + 5
+	nbdRepErrUnknown  = 1<<31 + 6
+
+	nbdInfoExport = 0
+
+	nbdTransmissionHasFlags     = 1 << 0
+	nbdTransmissionReadOnly     = 1 << 1
+	nbdTransmissionCanMultiConn = 1 << 8
+	nbdTransmissionFlags        = nbdTransmissionHasFlags | nbdTransmissionReadOnly | nbdTransmissionCanMultiConn
+
+	nbdCmdRead        = 0
+	nbdCmdWrite       = 1
+	nbdCmdDisc        = 2
+	nbdCmdFlush       = 3
+	nbdCmdTrim        = 4
+	nbdCmdCache       = 5
+	nbdCmdWriteZeroes = 6
+	nbdCmdBlockStatus = 7
+
+	nbdEPERM  = 1
+	nbdEIO    = 5
+	nbdEINVAL = 22
+
+	nbdReplyFlagDone        = 1 << 0
+	nbdReplyTypeNone        = 0
+	nbdReplyTypeOffsetData  = 1
+	nbdReplyTypeBlockStatus = 5
+	nbdReplyTypeError       = 1<<15 + 1
+
+	nbdStateHole = 1 << 0
+	nbdStateZero = 1 << 1
+
+	nbdBaseAllocation          = "base:allocation"
+	nbdBaseAllocationContextID = 1
+
+	nbdMaxOptionLength  = 64 * 1024
+	nbdMaxRequestLength = 32 * 1024 * 1024
+	nbdHandshakeTimeout = 30 * time.Second
+)
+
+var (
+	errNBDUnauthorized  = errors.New("invalid export token")
+	errNBDUnknownExport = errors.New("unknown export")
+	errNBDAborted       = errors.New("client aborted the handshake")
+)
+
+type nbdServer struct {
+	exports     map[string]string
+	tlsConfig   *tls.Config
+	tokenGetter TokenGetterFunc
+}
+
+// newNBDServer serves the raw volumes over NBD, under their NBD export name.
+func newNBDServer(volumes []export.VolumeInfo, tlsConfig *tls.Config, tokenGetter TokenGetterFunc) *nbdServer {
+	exports := map[string]string{}
+	for _, vi := range volumes {
+		if vi.RawURI == "" {
+			continue
+		}
+		p := vi.Path
+		if fi, err := os.Stat(p); err == nil && fi.IsDir() {
+			p = path.Join(p, "disk.img")
+		}
+		exports[vi.NBDExportName()] = p
+	}
+	return &nbdServer{
+		exports:     exports,
+		tlsConfig:   tlsConfig,
+		tokenGetter: tokenGetter,
+	}
+}
+
+func (s *nbdServer) Serve(listener net.Listener) error {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return err
+		}
+		go s.handleConn(conn)
+	}
+}
+
+func (s *nbdServer) handleConn(conn net.Conn) {
+	session := &nbdSession{server: s, conn: conn}
+	defer session.close()
+
+	if err := conn.SetDeadline(time.Now().Add(nbdHandshakeTimeout)); err != nil {
+		return
+	}
+	if err := session.handshake(); err != nil {
+		if !errors.Is(err, errNBDAborted) && !errors.Is(err, io.EOF) {
+			log.Log.Reason(err).Warningf("NBD handshake with %s failed", conn.RemoteAddr())
+		}
+		return
+	}
+	if err := session.conn.SetDeadline(time.Time{}); err != nil {
+		return
+	}
+	if err := session.transmission(); err != nil && !errors.Is(err, io.EOF) {
+		log.Log.Reason(err).Warningf("NBD session with %s failed", conn.RemoteAddr())
+	}
+}
+
+// open authenticates the client through the export name, formatted as <volume>/<token>, and opens the volume.
+func (s *nbdServer) open(exportName string) (*os.File, uint64, error) {
+	volume, token, _ := strings.Cut(exportName, "/")
+	expectedToken, err := s.tokenGetter()
+	if err != nil {
+		return nil, 0, err
+	}
+	if token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(expectedToken)) != 1 {
+		return nil, 0, errNBDUnauthorized
+	}
+	p, exists := s.exports[volume]
+	if !exists {
+		return nil, 0, errNBDUnknownExport
+	}
+
+	f, err := os.Open(p)
+	if err != nil {
+		return nil, 0, err
+	}
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		f.Close()
+		return nil, 0, err
+	}
+	return f, uint64(size), nil
+}
+
+type nbdSession struct {
+	server *nbdServer
+	conn   net.Conn
+
+	tls               bool
+	noZeroes          bool
+	structuredReplies bool
+	baseAllocation    bool
+
+	file *os.File
+	size uint64
+}
+
+func (c *nbdSession) close() {
+	if c.file != nil {
+		c.file.Close()
+	}
+	c.conn.Close()
+}
+
+func (c *nbdSession) handshake() error {
+	header := binary.BigEndian.AppendUint64(nil, nbdMagic)
+	header = binary.BigEndian.AppendUint64(header, nbdOptMagic)
+	header = binary.BigEndian.AppendUint16(header, nbdFlagFixedNewstyle|nbdFlagNoZeroes)
+	if _, err := c.conn.Write(header); err != nil {
+		return err
+	}
+
+	var clientFlags uint32
+	if err := binary.Read(c.conn, binary.BigEndian, &clientFlags); err != nil {
+		return err
+	}
+	if clientFlags&nbdFlagFixedNewstyle == 0 {
+		return fmt.Errorf("client does not support the fixed newstyle handshake")
+	}
+	c.noZeroes = clientFlags&nbdFlagNoZeroes != 0
+
+	for {
+		var option struct {
+			Magic  uint64
+			Option uint32
+			Length uint32
+		}
+		if err := binary.Read(c.conn, binary.BigEndian, &option); err != nil {
+			return err
+		}
+		if option.Magic != nbdOptMagic {
+			return fmt.Errorf("invalid option magic %#x", option.Magic)
+		}
+		if option.Length > nbdMaxOptionLength {
+			return fmt.Errorf("option %d is too long: %d bytes", option.Option, option.Length)
+		}
+		data := make([]byte, option.Length)
+		if _, err := io.ReadFull(c.conn, data); err != nil {
+			return err
+		}
+
+		done, err := c.handleOption(option.Option, data)
+		if err != nil || done {
+			return err
+		}
+	}
+}
+
+// handleOption handles a handshake option, returning true once the client moves to the transmission phase.
+func (c *nbdSession) handleOption(option uint32, data []byte) (bool, error) {
+	switch {
+	case option == nbdOptAbort:
+		_ = c.replyOption(option, nbdRepAck, nil)
+		return false, errNBDAborted
+	case option == nbdOptStartTLS:
+		if c.tls || len(data) != 0 {
+			return false, c.replyOption(option, nbdRepErrInvalid, nil)
+		}
+		if err := c.replyOption(option, nbdRepAck, nil); err != nil {
+			return false, err
+		}
+		tlsConn := tls.Server(c.conn, c.server.tlsConfig)
+		if err := tlsConn.HandshakeContext(context.Background()); err != nil {
+			return false, err
+		}
+		c.conn = tlsConn
+		c.tls = true
+		return false, nil
+	case !c.tls:
+		if option == nbdOptExportName {
+			return false, fmt.Errorf("client requested an export without TLS")
+		}
+		return false, c.replyOption(option, nbdRepErrTLSReqd, nil)
+	case option == nbdOptExportName:
+		if err := c.openExport(string(data)); err != nil {
+			return false, err
+		}
+		reply := binary.BigEndian.AppendUint64(nil, c.size)
+		reply = binary.BigEndian.AppendUint16(reply, nbdTransmissionFlags)
+		if !c.noZeroes {
+			reply = append(reply, make([]byte, 124)...)
+		}
+		_, err := c.conn.Write(reply)
+		return true, err
+	case option == nbdOptInfo || option == nbdOptGo:
+		return c.handleInfo(option, data)
+	case option == nbdOptStructuredReply:
+		if len(data) != 0 {
+			return false, c.replyOption(option, nbdRepErrInvalid, nil)
+		}
+		c.structuredReplies = true
+		return false, c.replyOption(option, nbdRepAck, nil)
+	case option == nbdOptListMetaContext || option == nbdOptSetMetaContext:
+		return false, c.handleMetaContext(option, data)
+	default:
+		return false, c.replyOption(option, nbdRepErrUnsup, nil)
+	}
+}
+
+func (c *nbdSession) openExport(exportName string) error {
+	if c.file != nil {
+		c.file.Close()
+		c.file = nil
+	}
+	file, size, err := c.server.open(exportName)
+	if err != nil {
+		return err
+	}
+	c.file, c.size = file, size
+	return nil
+}
+
+func (c *nbdSession) handleInfo(option uint32, data []byte) (bool, error) {
+	exportName, rest, ok := readNBDString(data)
+	if !ok || len(rest) < 2 || len(rest) != 2+2*int(binary.BigEndian.Uint16(rest)) {
+		return false, c.replyOption(option, nbdRepErrInvalid, nil)
+	}
+
+	if err := c.openExport(exportName); err != nil {
+		switch {
+		case errors.Is(err, errNBDUnauthorized):
+			return false, c.replyOption(option, nbdRepErrPolicy, nil)
+		case errors.Is(err, errNBDUnknownExport):
+			return false, c.replyOption(option, nbdRepErrUnknown, nil)
+		default:
+			return false, err
+		}
+	}
+
+	info := binary.BigEndian.AppendUint16(nil, nbdInfoExport)
+	info = binary.BigEndian.AppendUint64(info, c.size)
+	info = binary.BigEndian.AppendUint16(info, nbdTransmissionFlags)
+	if err := c.replyOption(option, nbdRepInfo, info); err != nil {
+		return false, err
+	}
+	if err := c.replyOption(option, nbdRepAck, nil); err != nil {
+		return false, err
+	}
+	return option == nbdOptGo, nil
+}
+
+// handleMetaContext only supports the base:allocation context, used by clients to skip holes.
+func (c *nbdSession) handleMetaContext(option uint32, data []byte) error {
+	if !c.structuredReplies {
+		return c.replyOption(option, nbdRepErrInvalid, nil)
+	}
+	_, rest, ok := readNBDString(data)
+	if !ok || len(rest) < 4 {
+		return c.replyOption(option, nbdRepErrInvalid, nil)
+	}
+	queryCount := binary.BigEndian.Uint32(rest)
+	rest = rest[4:]
+
+	var queries []string
+	for range queryCount {
+		var query string
+		if query, rest, ok = readNBDString(rest); !ok {
+			return c.replyOption(option, nbdRepErrInvalid, nil)
+		}
+		queries = append(queries, query)
+	}
+
+	selected := option == nbdOptListMetaContext && len(queries) == 0
+	for _, query := range queries {
+		if query == nbdBaseAllocation || (option == nbdOptListMetaContext && query == "base:") {
+			selected = true
+		}
+	}
+	if option == nbdOptSetMetaContext {
+		c.baseAllocation = selected
+	}
+	if selected {
+		reply := binary.BigEndian.AppendUint32(nil, nbdBaseAllocationContextID)
+		reply = append(reply, nbdBaseAllocation...)
+		if err := c.replyOption(option, nbdRepMetaContext, reply); err != nil {
+			return err
+		}
+	}
+	return c.replyOption(option, nbdRepAck, nil)
+}
+
+func (c *nbdSession) replyOption(option, replyType uint32, data []byte) error {
+	reply := binary.BigEndian.AppendUint64(nil, nbdOptReplyMagic)
+	reply = binary.BigEndian.AppendUint32(reply, option)
+	reply = binary.BigEndian.AppendUint32(reply, replyType)
+	reply = binary.BigEndian.AppendUint32(reply, uint32(len(data)))
+	_, err := c.conn.Write(append(reply, data...))
+	return err
+}
+
+func (c *nbdSession) transmission() error {
+	for {
+		var request struct {
+			Magic  uint32
+			Flags  uint16
+			Type   uint16
+			Cookie uint64
+			Offset uint64
+			Length uint32
+		}
+		if err := binary.Read(c.conn, binary.BigEndian, &request); err != nil {
+			return err
+		}
+		if request.Magic != nbdRequestMagic {
+			return fmt.Errorf("invalid request magic %#x", request.Magic)
+		}
+
+		var err error
+		switch request.Type {
+		case nbdCmdDisc:
+			return nil
+		case nbdCmdRead:
+			err = c.read(request.Cookie, request.Offset, request.Length)
+		case nbdCmdBlockStatus:
+			err = c.blockStatus(request.Cookie, request.Offset, request.Length)
+		case nbdCmdFlush, nbdCmdCache:
+			err = c.replyDone(request.Cookie)
+		case nbdCmdWrite:
+			if _, err = io.CopyN(io.Discard, c.conn, int64(request.Length)); err == nil {
+				err = c.replyError(request.Cookie, nbdEPERM)
+			}
+		case nbdCmdTrim, nbdCmdWriteZeroes:
+			err = c.replyError(request.Cookie, nbdEPERM)
+		default:
+			err = c.replyError(request.Cookie, nbdEINVAL)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (c *nbdSession) isValidRange(offset uint64, length uint32) bool {
+	return length > 0 && length <= nbdMaxRequestLength && offset <= c.size && uint64(length) <= c.size-offset
+}
+
+func (c *nbdSession) read(cookie, offset uint64, length uint32) error {
+	if !c.isValidRange(offset, length) {
+		return c.replyError(cookie, nbdEINVAL)
+	}
+	data := make([]byte, length)
+	if _, err := c.file.ReadAt(data, int64(offset)); err != nil {
+		log.Log.Reason(err).Errorf("failed to read %d bytes at offset %d", length, offset)
+		return c.replyError(cookie, nbdEIO)
+	}
+
+	if !c.structuredReplies {
+		_, err := c.conn.Write(append(simpleReply(cookie, 0), data...))
+		return err
+	}
+	payload := binary.BigEndian.AppendUint64(nil, offset)
+	return c.replyChunk(cookie, nbdReplyTypeOffsetData, append(payload, data...))
+}
+
+func (c *nbdSession) blockStatus(cookie, offset uint64, length uint32) error {
+	if !c.baseAllocation || !c.isValidRange(offset, length) {
+		return c.replyError(cookie, nbdEINVAL)
+	}
+	payload := binary.BigEndian.AppendUint32(nil, nbdBaseAllocationContextID)
+	for _, extent := range allocationExtents(c.file, offset, uint64(length)) {
+		payload = binary.BigEndian.AppendUint32(payload, extent.length)
+		payload = binary.BigEndian.AppendUint32(payload, extent.flags)
+	}
+	return c.replyChunk(cookie, nbdReplyTypeBlockStatus, payload)
+}
+
+func (c *nbdSession) replyDone(cookie uint64) error {
+	if !c.structuredReplies {
+		_, err := c.conn.Write(simpleReply(cookie, 0))
+		return err
+	}
+	return c.replyChunk(cookie, nbdReplyTypeNone, nil)
+}
+
+func (c *nbdSession) replyError(cookie uint64, errno uint32) error {
+	if !c.structuredReplies {
+		_, err := c.conn.Write(simpleReply(cookie, errno))
+		return err
+	}
+	payload := binary.BigEndian.AppendUint32(nil, errno)
+	payload = binary.BigEndian.AppendUint16(payload, 0)
+	return c.replyChunk(cookie, nbdReplyTypeError, payload)
+}
+
+// replyChunk sends the payload as the single, final chunk of a structured reply.
+func (c *nbdSession) replyChunk(cookie uint64, replyType uint16, payload []byte) error {
+	reply := binary.BigEndian.AppendUint32(nil, nbdStructuredReplyMagic)
+	reply = binary.BigEndian.AppendUint16(reply, nbdReplyFlagDone)
+	reply = binary.BigEndian.AppendUint16(reply, replyType)
+	reply = binary.BigEndian.AppendUint64(reply, cookie)
+	reply = binary.BigEndian.AppendUint32(reply, uint32(len(payload)))
+	_, err := c.conn.Write(append(reply, payload...))
+	return err
+}
+
+func simpleReply(cookie uint64, errno uint32) []byte {
+	reply := binary.BigEndian.AppendUint32(nil, nbdSimpleReplyMagic)
+	reply = binary.BigEndian.AppendUint32(reply, errno)
+	return binary.BigEndian.AppendUint64(reply, cookie)
+}
+
+// readNBDString reads a string prefixed by its 32 bit length, returning the remaining data.
+func readNBDString(data []byte) (string, []byte, bool) {
+	if len(data) < 4 {
+		return "", nil, false
+	}
+	length := binary.BigEndian.Uint32(data)
+	data = data[4:]
+	if uint64(len(data)) < uint64(length) {
+		return "", nil, false
+	}
+	return string(data[:length]), data[length:], true
+}
+
+type nbdExtent struct {
+	length uint32
+	flags  uint32
+}
+
+// allocationExtents describes the range as data and hole extents, using SEEK_DATA and SEEK_HOLE.
+// When holes cannot be detected, e.g. on block devices, the range is reported as data.
+func allocationExtents(f *os.File, offset, length uint64) []nbdExtent {
+	fd := int(f.Fd())
+	end := offset + length
+	var extents []nbdExtent
+	for offset < end {
+		dataStart, err := unix.Seek(fd, int64(offset), unix.SEEK_DATA)
+		if errors.Is(err, unix.ENXIO) {
+			dataStart = int64(end)
+		} else if err != nil {
+			return append(extents, nbdExtent{length: uint32(end - offset)})
+		}
+
+		if uint64(dataStart) > offset {
+			holeEnd := min(uint64(dataStart), end)
+			extents = append(extents, nbdExtent{length: uint32(holeEnd - offset), flags: nbdStateHole | nbdStateZero})
+			offset = holeEnd
+			continue
+		}
+
+		holeStart, err := unix.Seek(fd, int64(offset), unix.SEEK_HOLE)
+		if err != nil {
+			holeStart = int64(end)
+		}
+		dataEnd := min(uint64(holeStart), end)
+		extents = append(extents, nbdExtent{length: uint32(dataEnd - offset)})
+		offset = dataEnd
+	}
+	return extents
+}
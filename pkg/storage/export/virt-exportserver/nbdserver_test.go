@@ -0,0 +1,316 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/binary"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/certificates/triple"
+	"kubevirt.io/kubevirt/pkg/storage/export/export"
+)
+
+type nbdTestClient struct {
+	conn net.Conn
+}
+
+func dialNBD(addr string) *nbdTestClient {
+	conn, err := net.Dial("tcp", addr)
+	Expect(err).ToNot(HaveOccurred())
+	c := &nbdTestClient{conn: conn}
+
+	var header struct {
+		Magic    uint64
+		OptMagic uint64
+		Flags    uint16
+	}
+	Expect(binary.Read(conn, binary.BigEndian, &header)).To(Succeed())
+	Expect(header.Magic).To(Equal(uint64(nbdMagic)))
+	Expect(header.OptMagic).To(Equal(uint64(nbdOptMagic)))
+	Expect(header.Flags).To(Equal(uint16(nbdFlagFixedNewstyle | nbdFlagNoZeroes)))
+	Expect(binary.Write(conn, binary.BigEndian, uint32(nbdFlagFixedNewstyle|nbdFlagNoZeroes))).To(Succeed())
+	return c
+}
+
+func (c *nbdTestClient) option(option uint32, data []byte) (uint32, []byte) {
+	request := binary.BigEndian.AppendUint64(nil, nbdOptMagic)
+	request = binary.BigEndian.AppendUint32(request, option)
+	request = binary.BigEndian.AppendUint32(request, uint32(len(data)))
+	_, err := c.conn.Write(append(request, data...))
+	Expect(err).ToNot(HaveOccurred())
+	return c.optionReply(option)
+}
+
+func (c *nbdTestClient) optionReply(option uint32) (uint32, []byte) {
+	var reply struct {
+		Magic  uint64
+		Option uint32
+		Type   uint32
+		Length uint32
+	}
+	Expect(binary.Read(c.conn, binary.BigEndian, &reply)).To(Succeed())
+	Expect(reply.Magic).To(Equal(uint64(nbdOptReplyMagic)))
+	Expect(reply.Option).To(Equal(option))
+	data := make([]byte, reply.Length)
+	_, err := io.ReadFull(c.conn, data)
+	Expect(err).ToNot(HaveOccurred())
+	return reply.Type, data
+}
+
+func (c *nbdTestClient) startTLS() {
+	replyType, _ := c.option(nbdOptStartTLS, nil)
+	Expect(replyType).To(Equal(uint32(nbdRepAck)))
+	tlsConn := tls.Client(c.conn, &tls.Config{InsecureSkipVerify: true})
+	Expect(tlsConn.Handshake()).To(Succeed())
+	c.conn = tlsConn
+}
+
+func (c *nbdTestClient) setBaseAllocation(exportName string) {
+	replyType, _ := c.option(nbdOptStructuredReply, nil)
+	Expect(replyType).To(Equal(uint32(nbdRepAck)))
+
+	data := nbdString(exportName)
+	data = binary.BigEndian.AppendUint32(data, 1)
+	data = append(data, nbdString(nbdBaseAllocation)...)
+	replyType, context := c.option(nbdOptSetMetaContext, data)
+	Expect(replyType).To(Equal(uint32(nbdRepMetaContext)))
+	Expect(binary.BigEndian.Uint32(context)).To(Equal(uint32(nbdBaseAllocationContextID)))
+	Expect(string(context[4:])).To(Equal(nbdBaseAllocation))
+	replyType, _ = c.optionReply(nbdOptSetMetaContext)
+	Expect(replyType).To(Equal(uint32(nbdRepAck)))
+}
+
+// goExport requests the export, returning the reply of the server and the export size on success.
+func (c *nbdTestClient) goExport(exportName string) (uint32, uint64) {
+	data := binary.BigEndian.AppendUint16(nbdString(exportName), 0)
+	replyType, info := c.option(nbdOptGo, data)
+	if replyType != nbdRepInfo {
+		return replyType, 0
+	}
+	Expect(binary.BigEndian.Uint16(info)).To(Equal(uint16(nbdInfoExport)))
+	Expect(binary.BigEndian.Uint16(info[10:])).To(Equal(uint16(nbdTransmissionFlags)))
+	replyType, _ = c.optionReply(nbdOptGo)
+	return replyType, binary.BigEndian.Uint64(info[2:])
+}
+
+func (c *nbdTestClient) request(cmd uint16, cookie, offset uint64, length uint32) {
+	request := binary.BigEndian.AppendUint32(nil, nbdRequestMagic)
+	request = binary.BigEndian.AppendUint16(request, 0)
+	request = binary.BigEndian.AppendUint16(request, cmd)
+	request = binary.BigEndian.AppendUint64(request, cookie)
+	request = binary.BigEndian.AppendUint64(request, offset)
+	request = binary.BigEndian.AppendUint32(request, length)
+	_, err := c.conn.Write(request)
+	Expect(err).ToNot(HaveOccurred())
+}
+
+func (c *nbdTestClient) structuredReply(cookie uint64) (uint16, []byte) {
+	var reply struct {
+		Magic  uint32
+		Flags  uint16
+		Type   uint16
+		Cookie uint64
+		Length uint32
+	}
+	Expect(binary.Read(c.conn, binary.BigEndian, &reply)).To(Succeed())
+	Expect(reply.Magic).To(Equal(uint32(nbdStructuredReplyMagic)))
+	Expect(reply.Flags).To(Equal(uint16(nbdReplyFlagDone)))
+	Expect(reply.Cookie).To(Equal(cookie))
+	payload := make([]byte, reply.Length)
+	_, err := io.ReadFull(c.conn, payload)
+	Expect(err).ToNot(HaveOccurred())
+	return reply.Type, payload
+}
+
+func (c *nbdTestClient) simpleReply(cookie uint64) uint32 {
+	var reply struct {
+		Magic  uint32
+		Error  uint32
+		Cookie uint64
+	}
+	Expect(binary.Read(c.conn, binary.BigEndian, &reply)).To(Succeed())
+	Expect(reply.Magic).To(Equal(uint32(nbdSimpleReplyMagic)))
+	Expect(reply.Cookie).To(Equal(cookie))
+	return reply.Error
+}
+
+func nbdString(s string) []byte {
+	return append(binary.BigEndian.AppendUint32(nil, uint32(len(s))), s...)
+}
+
+var _ = Describe("NBD server", func() {
+	const (
+		token    = "token"
+		diskSize = 1024 * 1024
+		dataSize = 4096
+	)
+
+	var (
+		addr     string
+		listener net.Listener
+		data     []byte
+	)
+
+	BeforeEach(func() {
+		volumeDir := GinkgoT().TempDir()
+		disk, err := os.Create(filepath.Join(volumeDir, "disk.img"))
+		Expect(err).ToNot(HaveOccurred())
+		data = bytes.Repeat([]byte{0xab}, dataSize)
+		_, err = disk.WriteAt(data, 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(disk.Truncate(diskSize)).To(Succeed())
+		Expect(disk.Close()).To(Succeed())
+
+		ca, err := triple.NewCA("kubevirt.io", time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		keyPair, err := triple.NewServerKeyPair(ca, "export.default.svc", "export", "default", "cluster.local", nil, nil, time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		tlsConfig := &tls.Config{
+			Certificates: []tls.Certificate{{Certificate: [][]byte{keyPair.Cert.Raw}, PrivateKey: keyPair.Key}},
+		}
+
+		volumes := []export.VolumeInfo{
+			{Path: volumeDir, RawURI: "/volumes/test-pvc/disk.img"},
+			{Path: GinkgoT().TempDir(), ArchiveURI: "/volumes/archive-pvc/disk.tar.gz"},
+		}
+		server := newNBDServer(volumes, tlsConfig, func() (string, error) { return token, nil })
+		Expect(server.exports).To(HaveLen(1))
+
+		listener, err = net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		addr = listener.Addr().String()
+		go func() {
+			_ = server.Serve(listener)
+		}()
+	})
+
+	AfterEach(func() {
+		listener.Close()
+	})
+
+	It("should require TLS before serving an export", func() {
+		client := dialNBD(addr)
+		defer client.conn.Close()
+
+		replyType, _ := client.goExport("test-pvc/" + token)
+		Expect(replyType).To(Equal(uint32(nbdRepErrTLSReqd)))
+	})
+
+	DescribeTable("should reject", func(exportName string, expectedReply uint32) {
+		client := dialNBD(addr)
+		defer client.conn.Close()
+		client.startTLS()
+
+		replyType, _ := client.goExport(exportName)
+		Expect(replyType).To(Equal(expectedReply))
+	},
+		Entry("a missing token", "test-pvc", uint32(nbdRepErrPolicy)),
+		Entry("an invalid token", "test-pvc/invalid", uint32(nbdRepErrPolicy)),
+		Entry("an unknown volume", "unknown/"+token, uint32(nbdRepErrUnknown)),
+		Entry("a volume which is not exported in raw format", "archive-pvc/"+token, uint32(nbdRepErrUnknown)),
+	)
+
+	It("should serve reads of the raw volume with simple replies", func() {
+		client := dialNBD(addr)
+		defer client.conn.Close()
+		client.startTLS()
+
+		replyType, size := client.goExport("test-pvc/" + token)
+		Expect(replyType).To(Equal(uint32(nbdRepAck)))
+		Expect(size).To(Equal(uint64(diskSize)))
+
+		client.request(nbdCmdRead, 1, 0, dataSize)
+		Expect(client.simpleReply(1)).To(BeZero())
+		read := make([]byte, dataSize)
+		_, err := io.ReadFull(client.conn, read)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(read).To(Equal(data))
+
+		client.request(nbdCmdRead, 2, diskSize-dataSize, dataSize+1)
+		Expect(client.simpleReply(2)).To(Equal(uint32(nbdEINVAL)))
+
+		client.request(nbdCmdDisc, 3, 0, 0)
+	})
+
+	It("should serve reads and the allocation of the raw volume with structured replies", func() {
+		client := dialNBD(addr)
+		defer client.conn.Close()
+		client.startTLS()
+		client.setBaseAllocation("test-pvc/" + token)
+
+		replyType, _ := client.goExport("test-pvc/" + token)
+		Expect(replyType).To(Equal(uint32(nbdRepAck)))
+
+		client.request(nbdCmdRead, 1, 16, 32)
+		chunkType, payload := client.structuredReply(1)
+		Expect(chunkType).To(Equal(uint16(nbdReplyTypeOffsetData)))
+		Expect(binary.BigEndian.Uint64(payload)).To(Equal(uint64(16)))
+		Expect(payload[8:]).To(Equal(data[16:48]))
+
+		client.request(nbdCmdBlockStatus, 2, 0, diskSize)
+		chunkType, payload = client.structuredReply(2)
+		Expect(chunkType).To(Equal(uint16(nbdReplyTypeBlockStatus)))
+		Expect(binary.BigEndian.Uint32(payload)).To(Equal(uint32(nbdBaseAllocationContextID)))
+		var extents []nbdExtent
+		for descriptors := payload[4:]; len(descriptors) >= 8; descriptors = descriptors[8:] {
+			extents = append(extents, nbdExtent{
+				length: binary.BigEndian.Uint32(descriptors),
+				flags:  binary.BigEndian.Uint32(descriptors[4:]),
+			})
+		}
+		Expect(extents).ToNot(BeEmpty())
+		Expect(extents[0].flags).To(BeZero())
+		var total uint64
+		for _, extent := range extents {
+			total += uint64(extent.length)
+		}
+		Expect(total).To(Equal(uint64(diskSize)))
+	})
+
+	It("should reject writes", func() {
+		client := dialNBD(addr)
+		defer client.conn.Close()
+		client.startTLS()
+		client.setBaseAllocation("test-pvc/" + token)
+
+		replyType, _ := client.goExport("test-pvc/" + token)
+		Expect(replyType).To(Equal(uint32(nbdRepAck)))
+
+		client.request(nbdCmdWrite, 1, 0, 4)
+		_, err := client.conn.Write([]byte{1, 2, 3, 4})
+		Expect(err).ToNot(HaveOccurred())
+		chunkType, payload := client.structuredReply(1)
+		Expect(chunkType).To(Equal(uint16(nbdReplyTypeError)))
+		Expect(binary.BigEndian.Uint32(payload)).To(Equal(uint32(nbdEPERM)))
+
+		client.request(nbdCmdFlush, 2, 0, 0)
+		chunkType, _ = client.structuredReply(2)
+		Expect(chunkType).To(Equal(uint16(nbdReplyTypeNone)))
+	})
+})
@@ -58,6 +58,11 @@ func IsSEVAttestationRequested(vmi *v1.VirtualMachineInstance) bool {
 	return vmi.Spec.Domain.LaunchSecurity.SEV.Attestation != nil
 }
 
+// Check if a VMI spec requests SEV-SNP with attestation
+func IsSEVSNPAttestationRequested(vmi *v1.VirtualMachineInstance) bool {
+	return IsSEVSNPVMI(vmi) && vmi.Spec.Domain.LaunchSecurity.SNP.Attestation != nil
+}
+
 // Check if a VMI spec requests Intel TDX
 func IsTDXVMI(vmi *v1.VirtualMachineInstance) bool {
 	return vmi.Spec.Domain.LaunchSecurity != nil && vmi.Spec.Domain.LaunchSecurity.TDX != nil
@@ -0,0 +1,47 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package nodes
+
+import (
+	"encoding/json"
+
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// VCPULoad is the load of the vCPUs of a VMI between two heartbeats of virt-handler, in percent
+type VCPULoad struct {
+	Utilization uint32 `json:"utilization"`
+	Steal       uint32 `json:"steal"`
+}
+
+// VMIVCPULoads returns the vCPU load of the VMIs running on the node, keyed by namespace/name,
+// as published by virt-handler in the NodeVMIVCPULoadAnnotation annotation.
+func VMIVCPULoads(node *corev1.Node) (map[string]VCPULoad, error) {
+	value, exists := node.Annotations[v1.NodeVMIVCPULoadAnnotation]
+	if !exists {
+		return nil, nil
+	}
+	loads := map[string]VCPULoad{}
+	if err := json.Unmarshal([]byte(value), &loads); err != nil {
+		return nil, err
+	}
+	return loads, nil
+}
@@ -37,6 +37,9 @@ const (
 	ENV_VAR_LIBVIRT_DEBUG_LOGS          = "LIBVIRT_DEBUG_LOGS"
 	ENV_VAR_VIRTIOFSD_DEBUG_LOGS        = "VIRTIOFSD_DEBUG_LOGS"
 	ENV_VAR_VIRT_LAUNCHER_LOG_VERBOSITY = "VIRT_LAUNCHER_LOG_VERBOSITY"
+	ENV_VAR_NETWORK_INFO_POLL_INTERVAL  = "NETWORK_INFO_POLL_INTERVAL"
+	ENV_VAR_NETWORK_INFO_TIMEOUT        = "NETWORK_INFO_TIMEOUT"
+	ENV_VAR_NETWORK_INFO_POLL_BACKOFF   = "NETWORK_INFO_POLL_BACKOFF"
 )
 
 func IsNonRootVMI(vmi *v1.VirtualMachineInstance) bool {
@@ -136,6 +139,20 @@ func HasKernelBootContainerImage(vmi *v1.VirtualMachineInstance) bool {
 	return true
 }
 
+// Checks if kernel boot is defined with a volume
+func HasKernelBootVolume(vmi *v1.VirtualMachineInstance) bool {
+	if vmi == nil {
+		return false
+	}
+
+	vmiFirmware := vmi.Spec.Domain.Firmware
+	if (vmiFirmware == nil) || (vmiFirmware.KernelBoot == nil) || (vmiFirmware.KernelBoot.Volume == nil) {
+		return false
+	}
+
+	return true
+}
+
 // AlignImageSizeTo1MiB rounds down the size to the nearest multiple of 1MiB
 // A warning or an error may get logged
 // The caller is responsible for ensuring the rounded-down size is not 0
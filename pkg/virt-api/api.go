@@ -66,6 +66,7 @@ import (
 	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-api"
 	"kubevirt.io/kubevirt/pkg/monitoring/profiler"
 	netadmitter "kubevirt.io/kubevirt/pkg/network/admitter"
+	"kubevirt.io/kubevirt/pkg/network/netbinding"
 	mime "kubevirt.io/kubevirt/pkg/rest"
 	"kubevirt.io/kubevirt/pkg/rest/filter"
 	"kubevirt.io/kubevirt/pkg/service"
@@ -234,6 +235,7 @@ func (app *virtAPIApp) composeSubresources() {
 		subresourcesvmGVR := schema.GroupVersionResource{Group: version.Group, Version: version.Version, Resource: "virtualmachines"}
 		subresourcesvmiGVR := schema.GroupVersionResource{Group: version.Group, Version: version.Version, Resource: "virtualmachineinstances"}
 		expandvmspecGVR := schema.GroupVersionResource{Group: version.Group, Version: version.Version, Resource: "expand-vm-spec"}
+		batchGVR := schema.GroupVersionResource{Group: version.Group, Version: version.Version, Resource: "batch"}
 
 		subws := new(restful.WebService)
 		subws.Doc(fmt.Sprintf("KubeVirt \"%s\" Subresource API.", version.Version))
@@ -333,6 +335,14 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusOK, "OK", "").
 			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
 
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("networkresync")).
+			To(subresourceApp.NetworkResyncVMIRequestHandler).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"NetworkResync").
+			Doc("Re-run the network setup of a running VirtualMachineInstance object.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("pause")).
 			To(subresourceApp.PauseVMIRequestHandler).
 			Consumes(mime.MIME_ANY).
@@ -381,6 +391,19 @@ func (app *virtAPIApp) composeSubresources() {
 			Param(definitions.NameParam(subws)).
 			Operation(version.Version + "usbredir").
 			Doc("Open a websocket connection to connect to USB device on the specified VirtualMachineInstance."))
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("spice")).
+			To(subresourceApp.SpiceRequestHandler).
+			Param(definitions.NamespaceParam(subws)).
+			Param(definitions.NameParam(subws)).
+			Operation(version.Version + "Spice").
+			Doc("Open a websocket connection to the SPICE display of the specified VirtualMachineInstance."))
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("channel") + definitions.ChannelPath).
+			To(subresourceApp.ChannelRequestHandler).
+			Param(definitions.NamespaceParam(subws)).
+			Param(definitions.NameParam(subws)).
+			Param(definitions.ChannelParameter(subws)).
+			Operation(version.Version + "Channel").
+			Doc("Open a websocket connection to a virtio channel on the specified VirtualMachineInstance."))
 
 		// VMI endpoint
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("portforward") + definitions.PortPath).
@@ -428,6 +451,18 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "").
 			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
 
+		subws.Route(subws.PUT(definitions.NamespacedResourceBasePath(batchGVR)+definitions.OperationPath).
+			To(subresourceApp.BatchVMRequestHandler).
+			Reads(v1.BatchOperationOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.BatchOperationParameter(subws)).
+			Operation(version.Version+"BatchOperation").
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON).
+			Doc("Apply a lifecycle operation to all VirtualMachines matching a label selector.").
+			Returns(http.StatusOK, "OK", v1.BatchOperationResult{}).
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
 		subws.Route(subws.GET(definitions.SubResourcePath("version")).Produces(restful.MIME_JSON).
 			To(func(request *restful.Request, response *restful.Response) {
 				response.WriteAsJson(virtversion.Get())
@@ -488,6 +523,26 @@ func (app *virtAPIApp) composeSubresources() {
 			Writes(v1.VirtualMachineInstanceFileSystemList{}).
 			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceFileSystemList{}))
 
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("domainjobs")).
+			To(subresourceApp.DomainJobsRequestHandler).
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"DomainJobs").
+			Doc("Get list of libvirt jobs in progress on the domain of a VirtualMachineInstance").
+			Writes(v1.VirtualMachineInstanceDomainJobList{}).
+			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceDomainJobList{}))
+
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("qemucommandline")).
+			To(subresourceApp.QemuCommandLineRequestHandler).
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"QemuCommandLine").
+			Doc("Get the command line the QEMU process of a VirtualMachineInstance was started with, with its secrets redacted").
+			Writes(v1.VirtualMachineInstanceQemuCommandLine{}).
+			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceQemuCommandLine{}))
+
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("objectgraph")).
 			To(subresourceApp.VMIObjectGraph).
 			Consumes(restful.MIME_JSON).
@@ -550,6 +605,16 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusOK, "OK", "").
 			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
 
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("changemedia")).
+			To(subresourceApp.VMChangeMediaRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.ChangeMediaOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"vm-changemedia").
+			Doc("Inserts or ejects the media of a CD-ROM disk of a Virtual Machine.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("memorydump")).
 			To(subresourceApp.MemoryDumpVMRequestHandler).
 			Consumes(mime.MIME_ANY).
@@ -568,6 +633,35 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusOK, "OK", "").
 			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
 
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("memorydump")).
+			To(subresourceApp.MemoryDumpVMIRequestHandler).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"VMIMemoryDump").
+			Doc("Dumps the memory of a VirtualMachineInstance into the ephemeral storage of its pod, to be downloaded without a PVC.").
+			Returns(http.StatusAccepted, "Accepted", "").
+			Returns(http.StatusConflict, "Conflict", "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("memorydump")).
+			To(subresourceApp.MemoryDumpVMIRequestHandler).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"VMIMemoryDumpDownload").
+			Doc("Streams the memory dump of a VirtualMachineInstance. Supports range requests to resume interrupted downloads, responds with 409 while the dump is in progress.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusPartialContent, "Partial Content", "").
+			Returns(http.StatusConflict, "Conflict", "").
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
+		subws.Route(subws.DELETE(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("memorydump")).
+			To(subresourceApp.MemoryDumpVMIRequestHandler).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"VMIRemoveMemoryDump").
+			Doc("Removes the memory dump of a VirtualMachineInstance from the ephemeral storage of its pod.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
 		// AMD SEV endpoints
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("sev/fetchcertchain")).
 			To(subresourceApp.SEVFetchCertChainRequestHandler).
@@ -599,6 +693,16 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusOK, "OK", "").
 			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
 
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("sev/setupsnpsession")).
+			To(subresourceApp.SEVSetupSNPSessionHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.SEVSNPSessionOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"SEVSetupSNPSession").
+			Doc("Setup SEV-SNP launch parameters for a Virtual Machine").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("sev/injectlaunchsecret")).
 			To(subresourceApp.SEVInjectLaunchSecretHandler).
 			Consumes(mime.MIME_ANY).
@@ -655,6 +759,29 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
 			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
 
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("debugattach")).
+			To(subresourceApp.VMIDebugAttachRequestHandler).
+			Reads(v1.DebugAttachOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"DebugAttach").
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON).
+			Doc("Attach a time-limited debug container to the virt-launcher pod of a VirtualMachineInstance.").
+			Returns(http.StatusOK, "OK", v1.DebugAttachResult{}).
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("canceldomainjob")).
+			To(subresourceApp.CancelDomainJobRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.CancelDomainJobOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"CancelDomainJob").
+			Doc("Cancel a libvirt job in progress on the domain of a VirtualMachineInstance.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
 		// Return empty api resource list.
 		// K8s expects to be able to retrieve a resource list for each aggregated
 		// app in order to discover what resources it provides. Without returning
@@ -681,14 +808,26 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/vnc/screenshot",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/memorydump",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/console",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/spice",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/portforward",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/channel",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/backup",
 						Namespaced: true,
@@ -721,6 +860,10 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/softreboot",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/networkresync",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachines/start",
 						Namespaced: true,
@@ -761,6 +904,18 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/filesystemlist",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/domainjobs",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/canceldomainjob",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/qemucommandline",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/addvolume",
 						Namespaced: true,
@@ -785,6 +940,10 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/sev/setupsession",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/sev/setupsnpsession",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/sev/injectlaunchsecret",
 						Namespaced: true,
@@ -897,6 +1056,13 @@ func (app *virtAPIApp) Compose() {
 		}
 		resp.WriteErrorString(http.StatusUnauthorized, reason)
 	})
+	// throttle only authorized requests, to account them to an authenticated user.
+	// The limits are read from the cluster config, without it there is nothing to enforce.
+	if app.clusterConfig != nil {
+		restful.Filter(rest.NewSubresourceRateLimiter(app.clusterConfig, func() []string {
+			return app.authorizor.GetUserHeaders()
+		}).Filter)
+	}
 }
 
 func (app *virtAPIApp) ConfigureOpenAPIService() {
@@ -981,11 +1147,15 @@ func (app *virtAPIApp) prepareCertManager() {
 }
 
 func (app *virtAPIApp) registerValidatingWebhooks(informers *webhooks.Informers) {
+	netBindingDryRunValidator := netbinding.NewDryRunValidator()
 	http.HandleFunc(components.VMICreateValidatePath, func(w http.ResponseWriter, r *http.Request) {
-		validating_webhook.ServeVMICreate(w, r, app.clusterConfig, app.kubeVirtServiceAccounts,
+		validating_webhook.ServeVMICreate(w, r, app.clusterConfig, app.kubeVirtServiceAccounts, informers,
 			func(field *field.Path, vmiSpec *v1.VirtualMachineInstanceSpec, clusterCfg *virtconfig.ClusterConfig) []metav1.StatusCause {
 				return netadmitter.Validate(field, vmiSpec, clusterCfg)
 			},
+			func(field *field.Path, vmiSpec *v1.VirtualMachineInstanceSpec, clusterCfg *virtconfig.ClusterConfig) []metav1.StatusCause {
+				return netBindingDryRunValidator.Validate(field, vmiSpec, clusterCfg.GetNetworkBindings())
+			},
 		)
 	})
 	http.HandleFunc(components.VMIUpdateValidatePath, func(w http.ResponseWriter, r *http.Request) {
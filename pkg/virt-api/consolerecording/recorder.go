@@ -0,0 +1,135 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package consolerecording
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	// chunkSize is the amount of buffered transcript which triggers a delivery to the sink
+	chunkSize = 64 * 1024
+	// flushInterval bounds the time a transcript stays buffered, so that little is lost if virt-api goes away
+	flushInterval = 5 * time.Second
+)
+
+var defaultSessions = []v1.ConsoleSessionType{v1.ConsoleSessionSerial, v1.ConsoleSessionVNC}
+
+type configGetter func() *v1.ConsoleRecordingConfiguration
+type namespaceGetter func(name string) (*k8sv1.Namespace, error)
+type sinkFactory func(config *v1.ConsoleRecordingSink) (Sink, error)
+
+// SessionInfo identifies a console session
+type SessionInfo struct {
+	ID        string
+	Type      v1.ConsoleSessionType
+	Namespace string
+	Name      string
+	User      string
+	StartTime time.Time
+}
+
+// Recorder starts the recording of the console sessions selected by the policies of the KubeVirt CR
+type Recorder struct {
+	config        configGetter
+	getNamespace  namespaceGetter
+	newSink       sinkFactory
+	flushInterval time.Duration
+}
+
+func NewRecorder(config configGetter, getNamespace namespaceGetter) *Recorder {
+	return &Recorder{
+		config:        config,
+		getNamespace:  getNamespace,
+		newSink:       NewSink,
+		flushInterval: flushInterval,
+	}
+}
+
+// Start returns the recording of the session, or nil when the session is not recorded.
+// An error is returned when the session must be recorded but the recording cannot be started.
+func (r *Recorder) Start(info SessionInfo) (*Session, error) {
+	config := r.config()
+	if config == nil || len(config.Policies) == 0 {
+		return nil, nil
+	}
+
+	policy, err := r.findPolicy(config.Policies, info)
+	if err != nil {
+		return nil, err
+	}
+	if policy == nil {
+		return nil, nil
+	}
+
+	sink, err := r.newSink(&config.Sink)
+	if err != nil {
+		if policy.Required {
+			return nil, fmt.Errorf("failed to start the recording of the %s session: %v", info.Type, err)
+		}
+		log.Log.Reason(err).Warningf("not recording the %s session of %s/%s", info.Type, info.Namespace, info.Name)
+		return nil, nil
+	}
+
+	log.Log.V(2).Infof("recording the %s session %s of %s/%s opened by %s", info.Type, info.ID, info.Namespace, info.Name, info.User)
+	return newSession(info, sink, policy.Required, r.flushInterval), nil
+}
+
+// findPolicy returns the first policy selecting the namespace and the type of the session
+func (r *Recorder) findPolicy(policies []v1.ConsoleRecordingPolicy, info SessionInfo) (*v1.ConsoleRecordingPolicy, error) {
+	var namespace *k8sv1.Namespace
+	for i := range policies {
+		policy := &policies[i]
+
+		sessions := policy.Sessions
+		if len(sessions) == 0 {
+			sessions = defaultSessions
+		}
+		if !slices.Contains(sessions, info.Type) {
+			continue
+		}
+
+		if policy.NamespaceSelector != nil && (len(policy.NamespaceSelector.MatchLabels) > 0 || len(policy.NamespaceSelector.MatchExpressions) > 0) {
+			selector, err := metav1.LabelSelectorAsSelector(policy.NamespaceSelector)
+			if err != nil {
+				return nil, fmt.Errorf("invalid console recording namespace selector: %v", err)
+			}
+			if namespace == nil {
+				if namespace, err = r.getNamespace(info.Namespace); err != nil {
+					return nil, fmt.Errorf("failed to get namespace %s: %v", info.Namespace, err)
+				}
+			}
+			if !selector.Matches(labels.Set(namespace.Labels)) {
+				continue
+			}
+		}
+		return policy, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,364 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package consolerecording
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+type fakeSink struct {
+	lock   sync.Mutex
+	chunks []Chunk
+	err    error
+	// when set, Write blocks until it is closed
+	blocked chan struct{}
+}
+
+func (s *fakeSink) Write(_ context.Context, chunk Chunk) error {
+	s.lock.Lock()
+	blocked := s.blocked
+	s.lock.Unlock()
+	if blocked != nil {
+		<-blocked
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.err != nil {
+		return s.err
+	}
+	s.chunks = append(s.chunks, chunk)
+	return nil
+}
+
+func (s *fakeSink) fail(err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.err = err
+}
+
+func (s *fakeSink) block() chan struct{} {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.blocked = make(chan struct{})
+	return s.blocked
+}
+
+func (s *fakeSink) delivered() []Chunk {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return slices.Clone(s.chunks)
+}
+
+func (s *fakeSink) transcript(direction Direction) string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	var transcript string
+	for _, chunk := range s.chunks {
+		if chunk.Direction == direction {
+			transcript += string(chunk.Data)
+		}
+	}
+	return transcript
+}
+
+var _ = Describe("Console recorder", func() {
+	const namespace = "audited"
+
+	var (
+		config     *v1.ConsoleRecordingConfiguration
+		sink       *fakeSink
+		sinkErr    error
+		namespaces map[string]*k8sv1.Namespace
+		recorder   *Recorder
+		info       SessionInfo
+	)
+
+	BeforeEach(func() {
+		config = &v1.ConsoleRecordingConfiguration{
+			Sink:     v1.ConsoleRecordingSink{Webhook: &v1.ConsoleRecordingWebhookSink{URL: "https://recorder.example"}},
+			Policies: []v1.ConsoleRecordingPolicy{{}},
+		}
+		sink = &fakeSink{}
+		sinkErr = nil
+		namespaces = map[string]*k8sv1.Namespace{
+			namespace: {ObjectMeta: metav1.ObjectMeta{Name: namespace, Labels: map[string]string{"audit": "true"}}},
+			"other":   {ObjectMeta: metav1.ObjectMeta{Name: "other"}},
+		}
+		recorder = &Recorder{
+			config: func() *v1.ConsoleRecordingConfiguration { return config },
+			getNamespace: func(name string) (*k8sv1.Namespace, error) {
+				if ns, exists := namespaces[name]; exists {
+					return ns, nil
+				}
+				return nil, fmt.Errorf("namespace %s not found", name)
+			},
+			newSink: func(_ *v1.ConsoleRecordingSink) (Sink, error) {
+				return sink, sinkErr
+			},
+			flushInterval: time.Hour,
+		}
+		info = SessionInfo{ID: "1", Type: v1.ConsoleSessionSerial, Namespace: namespace, Name: "testvmi", User: "auditor", StartTime: time.Now()}
+	})
+
+	Context("policies", func() {
+		It("should not record when console recording is not configured", func() {
+			config = nil
+			Expect(recorder.Start(info)).To(BeNil())
+		})
+
+		It("should not record when no policy is defined", func() {
+			config.Policies = nil
+			Expect(recorder.Start(info)).To(BeNil())
+		})
+
+		It("should record all the sessions by default", func() {
+			for _, sessionType := range []v1.ConsoleSessionType{v1.ConsoleSessionSerial, v1.ConsoleSessionVNC} {
+				info.Type = sessionType
+				session, err := recorder.Start(info)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(session).ToNot(BeNil())
+				Expect(session.Close()).To(Succeed())
+			}
+		})
+
+		It("should only record the selected session types", func() {
+			config.Policies[0].Sessions = []v1.ConsoleSessionType{v1.ConsoleSessionVNC}
+			Expect(recorder.Start(info)).To(BeNil())
+		})
+
+		DescribeTable("should select namespaces by label", func(sessionNamespace string, recorded bool) {
+			config.Policies[0].NamespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"audit": "true"}}
+			info.Namespace = sessionNamespace
+
+			session, err := recorder.Start(info)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(session != nil).To(Equal(recorded))
+			if session != nil {
+				Expect(session.Close()).To(Succeed())
+			}
+		},
+			Entry("matching namespace", namespace, true),
+			Entry("other namespace", "other", false),
+		)
+
+		It("should apply the first policy selecting the namespace", func() {
+			config.Policies = []v1.ConsoleRecordingPolicy{
+				{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"audit": "true"}}, Required: true},
+				{},
+			}
+			sinkErr = fmt.Errorf("sink unavailable")
+
+			_, err := recorder.Start(info)
+			Expect(err).To(MatchError(ContainSubstring("sink unavailable")))
+
+			info.Namespace = "other"
+			Expect(recorder.Start(info)).To(BeNil())
+		})
+
+		It("should fail when the namespace cannot be fetched", func() {
+			config.Policies[0].NamespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"audit": "true"}}
+			info.Namespace = "missing"
+
+			_, err := recorder.Start(info)
+			Expect(err).To(MatchError(ContainSubstring("namespace missing not found")))
+		})
+
+		It("should not refuse the session when the sink is unavailable and recording is not required", func() {
+			sinkErr = fmt.Errorf("sink unavailable")
+			Expect(recorder.Start(info)).To(BeNil())
+		})
+	})
+
+	Context("sessions", func() {
+		var (
+			vmiConn    net.Conn
+			clientConn net.Conn
+		)
+
+		BeforeEach(func() {
+			vmiConn, clientConn = net.Pipe()
+			DeferCleanup(vmiConn.Close)
+			DeferCleanup(clientConn.Close)
+		})
+
+		startSession := func() *Session {
+			session, err := recorder.Start(info)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(session).ToNot(BeNil())
+			return session
+		}
+
+		exchange := func(conn net.Conn) {
+			go func() {
+				defer GinkgoRecover()
+				buf := make([]byte, 64)
+				n, err := vmiConn.Read(buf)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(buf[:n])).To(Equal("root\n"))
+				_, err = vmiConn.Write([]byte("Password: "))
+				Expect(err).ToNot(HaveOccurred())
+			}()
+
+			_, err := conn.Write([]byte("root\n"))
+			Expect(err).ToNot(HaveOccurred())
+			buf := make([]byte, 64)
+			n, err := conn.Read(buf)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(buf[:n])).To(Equal("Password: "))
+		}
+
+		It("should record the input and the output of the session", func() {
+			session := startSession()
+			exchange(session.Conn(clientConn))
+			Expect(session.Close()).To(Succeed())
+
+			Expect(sink.transcript(DirectionInput)).To(Equal("root\n"))
+			Expect(sink.transcript(DirectionOutput)).To(Equal("Password: "))
+			for _, chunk := range sink.delivered() {
+				Expect(chunk.Session.User).To(Equal("auditor"))
+			}
+		})
+
+		It("should deliver the transcript in sequenced chunks", func() {
+			session := startSession()
+			data := make([]byte, chunkSize)
+			Expect(session.Record(DirectionOutput, data)).To(Succeed())
+			Expect(session.Record(DirectionOutput, []byte("tail"))).To(Succeed())
+			Eventually(sink.delivered).Should(HaveLen(1))
+
+			Expect(session.Close()).To(Succeed())
+			chunks := sink.delivered()
+			Expect(chunks).To(HaveLen(2))
+			Expect(chunks[0].Sequence).To(Equal(0))
+			Expect(chunks[1].Sequence).To(Equal(1))
+			Expect(string(chunks[1].Data)).To(Equal("tail"))
+		})
+
+		It("should flush the transcript periodically", func() {
+			recorder.flushInterval = 10 * time.Millisecond
+			session := startSession()
+			Expect(session.Record(DirectionOutput, []byte("login: "))).To(Succeed())
+
+			Eventually(func() string { return sink.transcript(DirectionOutput) }).Should(Equal("login: "))
+			Expect(session.Close()).To(Succeed())
+		})
+
+		It("should close a required session when its transcript cannot be delivered", func() {
+			config.Policies[0].Required = true
+			session := startSession()
+			sink.fail(fmt.Errorf("sink unavailable"))
+
+			conn := session.Conn(clientConn)
+			Expect(session.Record(DirectionOutput, make([]byte, chunkSize))).To(Succeed())
+			Eventually(func() error { return session.Record(DirectionOutput, []byte("login: ")) }).
+				Should(MatchError(ContainSubstring("sink unavailable")))
+			_, err := conn.Write([]byte("root\n"))
+			Expect(err).To(MatchError(ContainSubstring("sink unavailable")))
+			Expect(session.Close()).To(HaveOccurred())
+		})
+
+		It("should keep a session which is not required when its transcript cannot be delivered", func() {
+			session := startSession()
+			sink.fail(fmt.Errorf("sink unavailable"))
+
+			Expect(session.Record(DirectionOutput, make([]byte, chunkSize))).To(Succeed())
+			exchange(session.Conn(clientConn))
+			Expect(session.Close()).To(Succeed())
+		})
+
+		It("should not wait for a slow sink to exchange the data of the session", func() {
+			unblock := sink.block()
+			session := startSession()
+			conn := session.Conn(clientConn)
+
+			exchanged := make(chan struct{})
+			go func() {
+				defer GinkgoRecover()
+				defer close(exchanged)
+				for range 3 {
+					Expect(session.Record(DirectionOutput, make([]byte, chunkSize))).To(Succeed())
+				}
+				exchange(conn)
+			}()
+			Eventually(exchanged).Should(BeClosed())
+			Expect(sink.delivered()).To(BeEmpty())
+
+			close(unblock)
+			Expect(session.Close()).To(Succeed())
+			Expect(sink.transcript(DirectionInput)).To(Equal("root\n"))
+			Expect(sink.transcript(DirectionOutput)).To(HaveLen(3*chunkSize + len("Password: ")))
+		})
+
+		It("should merge the chunks queued while the sink is slow", func() {
+			unblock := sink.block()
+			session := startSession()
+			for range 3 {
+				Expect(session.Record(DirectionOutput, make([]byte, chunkSize))).To(Succeed())
+			}
+
+			close(unblock)
+			Expect(session.Close()).To(Succeed())
+			Expect(len(sink.delivered())).To(BeNumerically("<", 3))
+			Expect(sink.transcript(DirectionOutput)).To(HaveLen(3 * chunkSize))
+		})
+
+		It("should close a required session when the delivery of its transcript lags behind", func() {
+			config.Policies[0].Required = true
+			unblock := sink.block()
+			session := startSession()
+
+			var err error
+			for range sinkQueueSize + 2 {
+				if err = session.Record(DirectionOutput, make([]byte, chunkSize)); err != nil {
+					break
+				}
+			}
+			Expect(err).To(MatchError(ContainSubstring("lagging behind")))
+
+			close(unblock)
+			Expect(session.Close()).To(HaveOccurred())
+		})
+
+		It("should keep a session which is not required when the delivery of its transcript lags behind", func() {
+			unblock := sink.block()
+			session := startSession()
+
+			for range sinkQueueSize + 2 {
+				Expect(session.Record(DirectionOutput, make([]byte, chunkSize))).To(Succeed())
+			}
+
+			close(unblock)
+			Expect(session.Close()).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,148 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package consolerecording
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	s3Algorithm  = "AWS4-HMAC-SHA256"
+	s3Service    = "s3"
+	amzDateFmt   = "20060102T150405Z"
+	amzDayFmt    = "20060102"
+	signedHeader = "host;x-amz-content-sha256;x-amz-date"
+)
+
+// s3Sink uploads every chunk as an object named <prefix>/<session path>/<direction>/<sequence>,
+// with path style requests signed with AWS Signature Version 4
+type s3Sink struct {
+	endpoint        *url.URL
+	region          string
+	bucket          string
+	prefix          string
+	accessKeyID     string
+	secretAccessKey string
+	client          *http.Client
+	now             func() time.Time
+}
+
+func newS3Sink(config *v1.ConsoleRecordingS3Sink, accessKeyID, secretAccessKey string) (*s3Sink, error) {
+	if accessKeyID == "" || secretAccessKey == "" {
+		return nil, fmt.Errorf("the console recording S3 credentials are not available")
+	}
+	endpoint, err := url.Parse(config.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid console recording S3 endpoint: %v", err)
+	}
+	return &s3Sink{
+		endpoint:        endpoint,
+		region:          config.Region,
+		bucket:          config.Bucket,
+		prefix:          config.Prefix,
+		accessKeyID:     accessKeyID,
+		secretAccessKey: secretAccessKey,
+		client:          &http.Client{Timeout: sinkTimeout},
+		now:             time.Now,
+	}, nil
+}
+
+func (s *s3Sink) objectKey(chunk Chunk) string {
+	return path.Join(s.prefix, sessionPath(chunk.Session), string(chunk.Direction), fmt.Sprintf("%08d", chunk.Sequence))
+}
+
+func (s *s3Sink) Write(ctx context.Context, chunk Chunk) error {
+	objectURL := *s.endpoint
+	objectURL.Path = path.Join("/", s.endpoint.Path, s.bucket, s.objectKey(chunk))
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPut, objectURL.String(), bytes.NewReader(chunk.Data))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %v", err)
+	}
+	request.Header.Set("Content-Type", "application/octet-stream")
+	s.sign(request, chunk.Data)
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed to upload transcript: %v", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("S3 responded with status %d", response.StatusCode)
+	}
+	return nil
+}
+
+func (s *s3Sink) sign(request *http.Request, payload []byte) {
+	now := s.now().UTC()
+	amzDate := now.Format(amzDateFmt)
+	day := now.Format(amzDayFmt)
+	payloadHash := sha256Hex(payload)
+
+	request.Header.Set("X-Amz-Date", amzDate)
+	request.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	canonicalRequest := strings.Join([]string{
+		request.Method,
+		request.URL.EscapedPath(),
+		request.URL.RawQuery,
+		"host:" + request.URL.Host,
+		"x-amz-content-sha256:" + payloadHash,
+		"x-amz-date:" + amzDate,
+		"",
+		signedHeader,
+		payloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{day, s.region, s3Service, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{s3Algorithm, amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.secretAccessKey), day)
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, s3Service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	request.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, s.accessKeyID, scope, signedHeader, signature))
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
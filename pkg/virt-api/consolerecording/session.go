@@ -0,0 +1,245 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package consolerecording
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"kubevirt.io/client-go/log"
+)
+
+// Direction tells whether a transcript chunk was sent by the client or by the VMI
+type Direction string
+
+const (
+	DirectionInput  Direction = "input"
+	DirectionOutput Direction = "output"
+)
+
+const (
+	sinkTimeout = 10 * time.Second
+	// sinkQueueSize bounds the chunks waiting for their delivery to the sink
+	sinkQueueSize = 16
+)
+
+// Session buffers the transcript of a console session and delivers it to the sink in chunks.
+// The chunks are queued and delivered in the background, so that a slow sink does not stall the console.
+type Session struct {
+	info     SessionInfo
+	sink     Sink
+	required bool
+
+	lock    sync.Mutex
+	buffers map[Direction]*bytes.Buffer
+	err     error
+	closed  bool
+
+	queue     chan Chunk
+	delivered chan struct{}
+
+	stop     chan struct{}
+	stopOnce sync.Once
+	done     chan struct{}
+}
+
+func newSession(info SessionInfo, sink Sink, required bool, interval time.Duration) *Session {
+	s := &Session{
+		info:     info,
+		sink:     sink,
+		required: required,
+		buffers: map[Direction]*bytes.Buffer{
+			DirectionInput:  {},
+			DirectionOutput: {},
+		},
+		queue:     make(chan Chunk, sinkQueueSize),
+		delivered: make(chan struct{}),
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+	go s.deliver()
+	go s.flushPeriodically(interval)
+	return s
+}
+
+// Conn records the data exchanged with the VMI through the connection
+func (s *Session) Conn(conn net.Conn) net.Conn {
+	return &recordedConn{Conn: conn, session: s}
+}
+
+// Record appends data to the transcript. An error is returned when the session is required to be
+// recorded and its transcript could not be delivered, or could not be queued for its delivery.
+func (s *Session) Record(direction Direction, data []byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.err != nil || s.closed {
+		return s.err
+	}
+	buffer := s.buffers[direction]
+	buffer.Write(data)
+	if buffer.Len() >= chunkSize {
+		s.enqueueLocked(direction)
+	}
+	return s.err
+}
+
+// Close delivers what is left of the transcript
+func (s *Session) Close() error {
+	s.stopOnce.Do(func() { close(s.stop) })
+	<-s.done
+
+	s.lock.Lock()
+	if !s.closed {
+		s.enqueueAllLocked()
+		s.closed = true
+		close(s.queue)
+	}
+	s.lock.Unlock()
+
+	<-s.delivered
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.err
+}
+
+func (s *Session) flushPeriodically(interval time.Duration) {
+	defer close(s.done)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			s.lock.Lock()
+			s.enqueueAllLocked()
+			s.lock.Unlock()
+		}
+	}
+}
+
+func (s *Session) enqueueAllLocked() {
+	s.enqueueLocked(DirectionInput)
+	s.enqueueLocked(DirectionOutput)
+}
+
+// enqueueLocked hands the buffered transcript over to the delivery, without waiting for it.
+// The transcript is dropped when the queue is full, which fails a required session.
+func (s *Session) enqueueLocked(direction Direction) {
+	buffer := s.buffers[direction]
+	if buffer.Len() == 0 {
+		return
+	}
+
+	chunk := Chunk{
+		Session:   &s.info,
+		Direction: direction,
+		Data:      bytes.Clone(buffer.Bytes()),
+	}
+	buffer.Reset()
+
+	select {
+	case s.queue <- chunk:
+	default:
+		log.Log.Errorf("dropping the %s transcript of the %s session %s of %s/%s, its delivery is lagging behind",
+			direction, s.info.Type, s.info.ID, s.info.Namespace, s.info.Name)
+		s.failLocked(fmt.Errorf("the delivery of the transcript is lagging behind"))
+	}
+}
+
+// deliver writes the queued chunks to the sink. The chunks queued meanwhile are merged per direction,
+// so that a slow sink receives fewer and larger chunks.
+func (s *Session) deliver() {
+	defer close(s.delivered)
+	sequence := map[Direction]int{}
+	for chunk := range s.queue {
+		pending := map[Direction][]byte{chunk.Direction: chunk.Data}
+		s.mergeQueued(pending)
+		for _, direction := range []Direction{DirectionInput, DirectionOutput} {
+			data, exists := pending[direction]
+			if !exists {
+				continue
+			}
+			s.write(Chunk{Session: &s.info, Direction: direction, Sequence: sequence[direction], Data: data})
+			sequence[direction]++
+		}
+	}
+}
+
+func (s *Session) mergeQueued(pending map[Direction][]byte) {
+	for {
+		select {
+		case chunk, ok := <-s.queue:
+			if !ok {
+				return
+			}
+			pending[chunk.Direction] = append(pending[chunk.Direction], chunk.Data...)
+		default:
+			return
+		}
+	}
+}
+
+func (s *Session) write(chunk Chunk) {
+	ctx, cancel := context.WithTimeout(context.Background(), sinkTimeout)
+	defer cancel()
+	if err := s.sink.Write(ctx, chunk); err != nil {
+		log.Log.Reason(err).Errorf("failed to deliver the %s transcript of the %s session %s of %s/%s",
+			chunk.Direction, s.info.Type, s.info.ID, s.info.Namespace, s.info.Name)
+		s.lock.Lock()
+		s.failLocked(err)
+		s.lock.Unlock()
+	}
+}
+
+// failLocked fails a required session, whose connection is then closed
+func (s *Session) failLocked(err error) {
+	if s.required && s.err == nil {
+		s.err = fmt.Errorf("failed to record the %s session: %v", s.info.Type, err)
+	}
+}
+
+type recordedConn struct {
+	net.Conn
+	session *Session
+}
+
+func (c *recordedConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	if n > 0 {
+		if recordErr := c.session.Record(DirectionOutput, b[:n]); recordErr != nil {
+			return n, recordErr
+		}
+	}
+	return n, err
+}
+
+// Write records the client input before it reaches the VMI
+func (c *recordedConn) Write(b []byte) (int, error) {
+	if err := c.session.Record(DirectionInput, b); err != nil {
+		return 0, err
+	}
+	return c.Conn.Write(b)
+}
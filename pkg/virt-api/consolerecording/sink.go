@@ -0,0 +1,151 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package consolerecording
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
+)
+
+const (
+	sessionIDHeader        = "X-KubeVirt-Console-Session-Id"
+	sessionTypeHeader      = "X-KubeVirt-Console-Session-Type"
+	sessionStartHeader     = "X-KubeVirt-Console-Session-Start"
+	sessionNamespaceHeader = "X-KubeVirt-Console-Namespace"
+	sessionNameHeader      = "X-KubeVirt-Console-Name"
+	sessionUserHeader      = "X-KubeVirt-Console-User"
+	directionHeader        = "X-KubeVirt-Console-Direction"
+	sequenceHeader         = "X-KubeVirt-Console-Sequence"
+)
+
+// Chunk is a piece of the transcript of a session, flowing in one direction
+type Chunk struct {
+	Session   *SessionInfo
+	Direction Direction
+	// Sequence orders the chunks of a session flowing in the same direction
+	Sequence int
+	Data     []byte
+}
+
+// Sink stores the session transcripts
+type Sink interface {
+	Write(ctx context.Context, chunk Chunk) error
+}
+
+// NewSink returns the sink configured in the KubeVirt CR
+func NewSink(config *v1.ConsoleRecordingSink) (Sink, error) {
+	switch {
+	case config.PersistentVolumeClaim != nil:
+		return &pvcSink{dir: components.ConsoleRecordingMountPath}, nil
+	case config.S3 != nil:
+		return newS3Sink(config.S3,
+			os.Getenv(components.ConsoleRecordingS3AccessKeyIDEnv),
+			os.Getenv(components.ConsoleRecordingS3SecretAccessKeyEnv),
+		)
+	case config.Webhook != nil:
+		return newWebhookSink(config.Webhook)
+	}
+	return nil, fmt.Errorf("no console recording sink is configured")
+}
+
+// sessionPath is the location of the session transcripts in the sink, e.g. <namespace>/<name>/20060102T150405Z-<id>
+func sessionPath(info *SessionInfo) string {
+	return filepath.Join(info.Namespace, info.Name, fmt.Sprintf("%s-%s", info.StartTime.UTC().Format("20060102T150405Z"), info.ID))
+}
+
+// pvcSink appends the transcripts to one file per session and direction
+type pvcSink struct {
+	dir string
+}
+
+func (s *pvcSink) Write(_ context.Context, chunk Chunk) error {
+	path := filepath.Join(s.dir, sessionPath(chunk.Session)+"."+string(chunk.Direction))
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0640)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(chunk.Data); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// webhookSink posts every chunk, described by the X-KubeVirt-Console-* headers
+type webhookSink struct {
+	url    string
+	client *http.Client
+}
+
+func newWebhookSink(config *v1.ConsoleRecordingWebhookSink) (*webhookSink, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if len(config.CABundle) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(config.CABundle) {
+			return nil, fmt.Errorf("failed to parse the console recording webhook CA bundle")
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+	return &webhookSink{
+		url:    config.URL,
+		client: &http.Client{Transport: transport, Timeout: sinkTimeout},
+	}, nil
+}
+
+func (s *webhookSink) Write(ctx context.Context, chunk Chunk) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(chunk.Data))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %v", err)
+	}
+	request.Header.Set("Content-Type", "application/octet-stream")
+	request.Header.Set(sessionIDHeader, chunk.Session.ID)
+	request.Header.Set(sessionTypeHeader, string(chunk.Session.Type))
+	request.Header.Set(sessionStartHeader, chunk.Session.StartTime.UTC().Format(time.RFC3339))
+	request.Header.Set(sessionNamespaceHeader, chunk.Session.Namespace)
+	request.Header.Set(sessionNameHeader, chunk.Session.Name)
+	request.Header.Set(sessionUserHeader, chunk.Session.User)
+	request.Header.Set(directionHeader, string(chunk.Direction))
+	request.Header.Set(sequenceHeader, strconv.Itoa(chunk.Sequence))
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed to post transcript: %v", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("webhook responded with status %d", response.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package consolerecording
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("Console recording sinks", func() {
+	var session *SessionInfo
+
+	BeforeEach(func() {
+		session = &SessionInfo{
+			ID:        "1234",
+			Type:      v1.ConsoleSessionSerial,
+			Namespace: "audited",
+			Name:      "testvmi",
+			User:      "auditor",
+			StartTime: time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC),
+		}
+	})
+
+	It("should fail when no sink is configured", func() {
+		_, err := NewSink(&v1.ConsoleRecordingSink{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should append the transcripts to a file per session and direction", func() {
+		sink := &pvcSink{dir: GinkgoT().TempDir()}
+		Expect(sink.Write(context.Background(), Chunk{Session: session, Direction: DirectionOutput, Data: []byte("login: ")})).To(Succeed())
+		Expect(sink.Write(context.Background(), Chunk{Session: session, Direction: DirectionOutput, Sequence: 1, Data: []byte("root")})).To(Succeed())
+
+		transcript, err := os.ReadFile(filepath.Join(sink.dir, "audited", "testvmi", "20240506T070809Z-1234.output"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(transcript)).To(Equal("login: root"))
+	})
+
+	Context("webhook", func() {
+		It("should post the chunks with the session metadata", func() {
+			received := make(chan http.Header, 1)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				Expect(r.Method).To(Equal(http.MethodPost))
+				body, err := io.ReadAll(r.Body)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(body)).To(Equal("login: "))
+				received <- r.Header
+			}))
+			defer server.Close()
+
+			sink, err := newWebhookSink(&v1.ConsoleRecordingWebhookSink{URL: server.URL})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sink.Write(context.Background(), Chunk{Session: session, Direction: DirectionOutput, Sequence: 3, Data: []byte("login: ")})).To(Succeed())
+
+			var header http.Header
+			Eventually(received).Should(Receive(&header))
+			Expect(header.Get("X-KubeVirt-Console-Session-Id")).To(Equal("1234"))
+			Expect(header.Get("X-KubeVirt-Console-Session-Type")).To(Equal("Serial"))
+			Expect(header.Get("X-KubeVirt-Console-Session-Start")).To(Equal("2024-05-06T07:08:09Z"))
+			Expect(header.Get("X-KubeVirt-Console-Namespace")).To(Equal("audited"))
+			Expect(header.Get("X-KubeVirt-Console-Name")).To(Equal("testvmi"))
+			Expect(header.Get("X-KubeVirt-Console-User")).To(Equal("auditor"))
+			Expect(header.Get("X-KubeVirt-Console-Direction")).To(Equal("output"))
+			Expect(header.Get("X-KubeVirt-Console-Sequence")).To(Equal("3"))
+		})
+
+		It("should fail when the webhook rejects the chunk", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			}))
+			defer server.Close()
+
+			sink, err := newWebhookSink(&v1.ConsoleRecordingWebhookSink{URL: server.URL})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sink.Write(context.Background(), Chunk{Session: session, Data: []byte("x")})).To(
+				MatchError("webhook responded with status 500"))
+		})
+
+		It("should reject a CA bundle without certificates", func() {
+			_, err := newWebhookSink(&v1.ConsoleRecordingWebhookSink{URL: "https://recorder.example", CABundle: []byte("garbage")})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("S3", func() {
+		It("should fail without credentials", func() {
+			_, err := newS3Sink(&v1.ConsoleRecordingS3Sink{Endpoint: "https://s3.example"}, "", "")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should upload every chunk as a signed object", func() {
+			type upload struct {
+				path   string
+				body   string
+				header http.Header
+			}
+			received := make(chan upload, 1)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				received <- upload{path: r.URL.Path, body: string(body), header: r.Header}
+			}))
+			defer server.Close()
+
+			sink, err := newS3Sink(&v1.ConsoleRecordingS3Sink{
+				Endpoint: server.URL,
+				Region:   "us-east-1",
+				Bucket:   "recordings",
+				Prefix:   "kubevirt",
+			}, "AKIDEXAMPLE", "secret")
+			Expect(err).ToNot(HaveOccurred())
+			sink.now = func() time.Time { return time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC) }
+
+			Expect(sink.Write(context.Background(), Chunk{Session: session, Direction: DirectionInput, Sequence: 2, Data: []byte("root\n")})).To(Succeed())
+
+			var u upload
+			Eventually(received).Should(Receive(&u))
+			Expect(u.path).To(Equal("/recordings/kubevirt/audited/testvmi/20240506T070809Z-1234/input/00000002"))
+			Expect(u.body).To(Equal("root\n"))
+			Expect(u.header.Get("X-Amz-Date")).To(Equal("20240506T070809Z"))
+			Expect(u.header.Get("X-Amz-Content-Sha256")).To(Equal(sha256Hex([]byte("root\n"))))
+			authorization := u.header.Get("Authorization")
+			Expect(authorization).To(HavePrefix("AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20240506/us-east-1/s3/aws4_request, SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature="))
+			Expect(strings.TrimPrefix(authorization[strings.Index(authorization, "Signature="):], "Signature=")).To(HaveLen(64))
+		})
+
+		It("should derive the signing key as specified by AWS", func() {
+			// Example from the AWS Signature Version 4 documentation
+			key := hmacSHA256([]byte("AWS4wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY"), "20120215")
+			key = hmacSHA256(key, "us-east-1")
+			key = hmacSHA256(key, "iam")
+			key = hmacSHA256(key, "aws4_request")
+			Expect(key).To(Equal([]byte{
+				0xf4, 0x78, 0x0e, 0x2d, 0x9f, 0x65, 0xfa, 0x89, 0x5f, 0x9c, 0x67, 0xb3, 0x2c, 0xe1, 0xba, 0xf0,
+				0xb0, 0xd8, 0xa4, 0x35, 0x05, 0xa0, 0x00, 0xa1, 0xa9, 0xe0, 0x90, 0xd4, 0x14, 0xdb, 0x40, 0x4d,
+			}))
+		})
+	})
+})
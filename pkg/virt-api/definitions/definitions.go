@@ -693,11 +693,15 @@ func SubResourcePath(subResource string) string {
 }
 
 const (
-	PortParamName     = "port"
-	TLSParamName      = "tls"
-	PortPath          = "/{port}"
-	ProtocolParamName = "protocol"
-	ProtocolPath      = "/{protocol}"
+	PortParamName      = "port"
+	TLSParamName       = "tls"
+	PortPath           = "/{port}"
+	ProtocolParamName  = "protocol"
+	ProtocolPath       = "/{protocol}"
+	ChannelParamName   = "channel"
+	ChannelPath        = "/{channel}"
+	OperationParamName = "operation"
+	OperationPath      = "/{operation}"
 )
 
 func PortForwardPortParameter(ws *restful.WebService) *restful.Parameter {
@@ -708,6 +712,14 @@ func PortForwardProtocolParameter(ws *restful.WebService) *restful.Parameter {
 	return ws.PathParameter(ProtocolParamName, "The protocol for portforward on the VirtualMachineInstance.")
 }
 
+func ChannelParameter(ws *restful.WebService) *restful.Parameter {
+	return ws.PathParameter(ChannelParamName, "The name of the virtio channel on the VirtualMachineInstance.")
+}
+
+func BatchOperationParameter(ws *restful.WebService) *restful.Parameter {
+	return ws.PathParameter(OperationParamName, "The lifecycle operation to apply: start, stop, restart or migrate.")
+}
+
 func noop(_ *restful.Request, _ *restful.Response) {}
 
 func VSOCKPortParameter(ws *restful.WebService) *restful.Parameter {
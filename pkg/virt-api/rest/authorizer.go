@@ -43,6 +43,7 @@ const (
 	userExtraHeaderPrefix = "X-Remote-Extra-"
 
 	namespacedResourceAttributesMinParts  = 9
+	namespacedBatchAttributesParts        = 8
 	namespacedResourceBaseAttributesParts = 7
 )
 
@@ -195,11 +196,16 @@ func (a *authorizor) generateAccessReview(req *restful.Request) (*authv1.Subject
 	// URL examples
 	// /apis/subresources.kubevirt.io/v1alpha3/namespaces/default/virtualmachineinstances/testvmi/console
 	// /apis/subresources.kubevirt.io/v1alpha3/namespaces/default/expand-vm-spec
+	// /apis/subresources.kubevirt.io/v1alpha3/namespaces/default/batch/start
 	pathSplit := strings.Split(req.Request.URL.Path, "/")
 	if len(pathSplit) >= namespacedResourceAttributesMinParts {
 		if err := addNamespacedResourceAttributes(pathSplit, req.Request.Method, r); err != nil {
 			return nil, err
 		}
+	} else if len(pathSplit) == namespacedBatchAttributesParts {
+		if err := addNamespacedBatchAttributes(pathSplit, req.Request.Method, r); err != nil {
+			return nil, err
+		}
 	} else if len(pathSplit) == namespacedResourceBaseAttributesParts {
 		if err := addNamespacedResourceBaseAttributes(pathSplit, req.Request.Method, r); err != nil {
 			return nil, err
@@ -271,6 +277,39 @@ func addNamespacedResourceBaseAttributes(pathSplit []string, requestMethod strin
 	return nil
 }
 
+func addNamespacedBatchAttributes(pathSplit []string, requestMethod string, r *authv1.SubjectAccessReview) error {
+	// URL example
+	// /apis/subresources.kubevirt.io/v1alpha3/namespaces/default/batch/start
+	group := pathSplit[2]
+	version := pathSplit[3]
+	namespace := pathSplit[5]
+	resource := pathSplit[6]
+	operation := pathSplit[7]
+
+	if resource != "batch" {
+		return fmt.Errorf("unknown resource type %s", resource)
+	}
+
+	verb, err := mapHttpVerbToRbacVerb(requestMethod, "")
+	if err != nil {
+		return err
+	}
+
+	// A batch operation acts on every VirtualMachine of the namespace matching
+	// its selector, so the caller has to be allowed to apply the operation to
+	// any VirtualMachine of the namespace.
+	r.Spec.ResourceAttributes = &authv1.ResourceAttributes{
+		Namespace:   namespace,
+		Verb:        verb,
+		Group:       group,
+		Version:     version,
+		Resource:    "virtualmachines",
+		Subresource: operation,
+	}
+
+	return nil
+}
+
 func mapHttpVerbToRbacVerb(httpVerb string, name string) (string, error) {
 	// see https://kubernetes.io/docs/reference/access-authn-authz/authorization/#determine-the-request-verb
 	// if name is empty, we assume plural verbs
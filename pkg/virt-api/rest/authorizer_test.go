@@ -191,6 +191,39 @@ var _ = Describe("Authorizer", func() {
 
 			})
 
+			Context("with namespaced batch operation", func() {
+				BeforeEach(func() {
+					req.Request.Method = http.MethodPut
+					req.Request.URL.Path = "/apis/subresources.kubevirt.io/v1alpha3/namespaces/default/batch/migrate"
+				})
+
+				It("should check the operation subresource on all VirtualMachines of the namespace", func() {
+					allowedFn = func(sar *authv1.SubjectAccessReview) (*authv1.SubjectAccessReview, error) {
+						Expect(sar.Spec.ResourceAttributes).To(Equal(&authv1.ResourceAttributes{
+							Namespace:   "default",
+							Verb:        "update",
+							Group:       "subresources.kubevirt.io",
+							Version:     "v1alpha3",
+							Resource:    "virtualmachines",
+							Subresource: "migrate",
+						}))
+						sar.Status.Allowed = true
+						return sar, nil
+					}
+					result, _, err := app.Authorize(req)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result).To(BeTrue())
+				})
+
+				It("should reject unknown base resources", func() {
+					req.Request.URL.Path = "/apis/subresources.kubevirt.io/v1alpha3/namespaces/default/unknown/migrate"
+					result, reason, err := app.Authorize(req)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result).To(BeFalse())
+					Expect(reason).To(Equal("unknown resource type unknown"))
+				})
+			})
+
 			DescribeTable("should allow all users for info endpoints", func(path string) {
 				req.Request.TLS = nil
 				req.Request.URL.Path = path
@@ -0,0 +1,164 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	BatchOperationStart   = "start"
+	BatchOperationStop    = "stop"
+	BatchOperationRestart = "restart"
+	BatchOperationMigrate = "migrate"
+
+	defaultBatchMaxConcurrency = 10
+	maxBatchMaxConcurrency     = 100
+)
+
+type batchOperationFunc func(name, namespace string) *errors.StatusError
+
+// BatchVMRequestHandler applies a lifecycle operation to all VirtualMachines
+// of a namespace matching the label selector of the request. The operation is
+// applied to at most MaxConcurrency VirtualMachines in parallel and the outcome
+// is reported per VirtualMachine, a failure for one VirtualMachine does not
+// abort the operation for the others.
+func (app *SubresourceAPIApp) BatchVMRequestHandler(request *restful.Request, response *restful.Response) {
+	namespace := request.PathParameter("namespace")
+	operation := request.PathParameter("operation")
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("No body"), response)
+		return
+	}
+
+	opts := &v1.BatchOperationOptions{}
+	defer request.Request.Body.Close()
+	if err := decodeBody(request, opts); err != nil {
+		writeError(err, response)
+		return
+	}
+
+	apply, statusErr := app.batchOperation(operation, opts.DryRun)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	maxConcurrency, statusErr := batchMaxConcurrency(opts.MaxConcurrency)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	if opts.LabelSelector == nil {
+		writeError(errors.NewBadRequest("labelSelector is required"), response)
+		return
+	}
+	selector, err := k8smetav1.LabelSelectorAsSelector(opts.LabelSelector)
+	if err != nil {
+		writeError(errors.NewBadRequest(fmt.Sprintf("invalid labelSelector: %v", err)), response)
+		return
+	}
+
+	vmList, err := app.virtCli.VirtualMachine(namespace).List(request.Request.Context(), k8smetav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	names := make([]string, 0, len(vmList.Items))
+	for _, vm := range vmList.Items {
+		names = append(names, vm.Name)
+	}
+	sort.Strings(names)
+
+	result := &v1.BatchOperationResult{
+		Items: runBatchOperation(names, namespace, maxConcurrency, apply),
+	}
+	if err := response.WriteHeaderAndEntity(http.StatusOK, result); err != nil {
+		log.Log.Reason(err).Error("Failed to write http response.")
+	}
+}
+
+func (app *SubresourceAPIApp) batchOperation(operation string, dryRun []string) (batchOperationFunc, *errors.StatusError) {
+	switch operation {
+	case BatchOperationStart:
+		return func(name, namespace string) *errors.StatusError {
+			return app.startVM(name, namespace, &v1.StartOptions{DryRun: dryRun})
+		}, nil
+	case BatchOperationStop:
+		return func(name, namespace string) *errors.StatusError {
+			return app.stopVM(name, namespace, &v1.StopOptions{DryRun: dryRun})
+		}, nil
+	case BatchOperationRestart:
+		return func(name, namespace string) *errors.StatusError {
+			return app.restartVM(name, namespace, &v1.RestartOptions{DryRun: dryRun})
+		}, nil
+	case BatchOperationMigrate:
+		return func(name, namespace string) *errors.StatusError {
+			return app.migrateVM(name, namespace, &v1.MigrateOptions{DryRun: dryRun})
+		}, nil
+	default:
+		return nil, errors.NewBadRequest(fmt.Sprintf("unsupported batch operation %q", operation))
+	}
+}
+
+func batchMaxConcurrency(maxConcurrency *int32) (int, *errors.StatusError) {
+	if maxConcurrency == nil {
+		return defaultBatchMaxConcurrency, nil
+	}
+	if *maxConcurrency < 1 || *maxConcurrency > maxBatchMaxConcurrency {
+		return 0, errors.NewBadRequest(fmt.Sprintf("maxConcurrency must be between 1 and %d", maxBatchMaxConcurrency))
+	}
+	return int(*maxConcurrency), nil
+}
+
+func runBatchOperation(names []string, namespace string, maxConcurrency int, apply batchOperationFunc) []v1.BatchOperationItemResult {
+	results := make([]v1.BatchOperationItemResult, len(names))
+	sem := make(chan struct{}, maxConcurrency)
+	wg := sync.WaitGroup{}
+	for i, name := range names {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, name string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i] = v1.BatchOperationItemResult{Name: name, Succeeded: true}
+			if statusErr := apply(name, namespace); statusErr != nil {
+				results[i].Succeeded = false
+				results[i].Error = statusErr.Error()
+			}
+		}(i, name)
+	}
+	wg.Wait()
+	return results
+}
@@ -0,0 +1,176 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/testing"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Batch VirtualMachine operations", func() {
+	const groupLabel = "kubevirt.io/group"
+
+	var (
+		request        *restful.Request
+		recorder       *httptest.ResponseRecorder
+		response       *restful.Response
+		virtClient     *kubecli.MockKubevirtClient
+		kubevirtClient *fake.Clientset
+		app            *SubresourceAPIApp
+	)
+
+	BeforeEach(func() {
+		request = restful.NewRequest(&http.Request{})
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+		response.SetRequestAccepts(restful.MIME_JSON)
+
+		virtClient = kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		kubevirtClient = fake.NewSimpleClientset()
+		fakeKubevirtClients := kubevirtClient.KubevirtV1()
+		virtClient.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(fakeKubevirtClients.VirtualMachines(metav1.NamespaceDefault)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(fakeKubevirtClients.VirtualMachineInstances(metav1.NamespaceDefault)).AnyTimes()
+
+		config, _, _ := testutils.NewFakeClusterConfigUsingKV(&v1.KubeVirt{})
+		app = NewSubresourceAPIApp(virtClient, 0, &tls.Config{InsecureSkipVerify: true}, config)
+	})
+
+	createVM := func(name, group string, runStrategy v1.VirtualMachineRunStrategy) {
+		vm := libvmi.NewVirtualMachine(
+			libvmi.New(libvmi.WithName(name), libvmi.WithNamespace(metav1.NamespaceDefault)),
+			libvmi.WithRunStrategy(runStrategy),
+		)
+		vm.Labels = map[string]string{groupLabel: group}
+		_, err := virtClient.VirtualMachine(metav1.NamespaceDefault).Create(context.Background(), vm, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	runStrategyOf := func(name string) v1.VirtualMachineRunStrategy {
+		vm, err := virtClient.VirtualMachine(metav1.NamespaceDefault).Get(context.Background(), name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		runStrategy, err := vm.RunStrategy()
+		Expect(err).ToNot(HaveOccurred())
+		return runStrategy
+	}
+
+	newBody := func(opts *v1.BatchOperationOptions) io.ReadCloser {
+		optsJson, err := json.Marshal(opts)
+		Expect(err).ToNot(HaveOccurred())
+		return &readCloserWrapper{bytes.NewReader(optsJson)}
+	}
+
+	groupSelector := func(group string) *metav1.LabelSelector {
+		return &metav1.LabelSelector{MatchLabels: map[string]string{groupLabel: group}}
+	}
+
+	It("should apply the operation to the selected VirtualMachines and report the outcome per VirtualMachine", func() {
+		createVM("vm-c", "web", v1.RunStrategyHalted)
+		createVM("vm-a", "web", v1.RunStrategyHalted)
+		createVM("vm-b", "web", v1.RunStrategyAlways)
+		createVM("vm-d", "db", v1.RunStrategyHalted)
+
+		request.PathParameters()["operation"] = BatchOperationStart
+		request.Request.Body = newBody(&v1.BatchOperationOptions{
+			LabelSelector:  groupSelector("web"),
+			MaxConcurrency: pointer.P(int32(2)),
+		})
+
+		app.BatchVMRequestHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusOK))
+
+		result := &v1.BatchOperationResult{}
+		Expect(json.NewDecoder(recorder.Body).Decode(result)).To(Succeed())
+		Expect(result.Items).To(HaveLen(3))
+		Expect(result.Items[0]).To(Equal(v1.BatchOperationItemResult{Name: "vm-a", Succeeded: true}))
+		Expect(result.Items[1].Name).To(Equal("vm-b"))
+		Expect(result.Items[1].Succeeded).To(BeFalse())
+		Expect(result.Items[1].Error).To(ContainSubstring("does not support manual start requests"))
+		Expect(result.Items[2]).To(Equal(v1.BatchOperationItemResult{Name: "vm-c", Succeeded: true}))
+
+		Expect(runStrategyOf("vm-a")).To(Equal(v1.RunStrategyAlways))
+		Expect(runStrategyOf("vm-c")).To(Equal(v1.RunStrategyAlways))
+		Expect(runStrategyOf("vm-d")).To(Equal(v1.RunStrategyHalted))
+	})
+
+	It("should pass dry run to the operation", func() {
+		createVM("vm-a", "web", v1.RunStrategyHalted)
+		kubevirtClient.PrependReactor("patch", "virtualmachines", func(action testing.Action) (bool, runtime.Object, error) {
+			Expect(action.(testing.PatchActionImpl).PatchOptions.DryRun).To(Equal(withDryRun()))
+			return true, &v1.VirtualMachine{}, nil
+		})
+
+		request.PathParameters()["operation"] = BatchOperationStart
+		request.Request.Body = newBody(&v1.BatchOperationOptions{
+			LabelSelector: groupSelector("web"),
+			DryRun:        withDryRun(),
+		})
+
+		app.BatchVMRequestHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusOK))
+		result := &v1.BatchOperationResult{}
+		Expect(json.NewDecoder(recorder.Body).Decode(result)).To(Succeed())
+		Expect(result.Items).To(ConsistOf(v1.BatchOperationItemResult{Name: "vm-a", Succeeded: true}))
+	})
+
+	DescribeTable("should reject invalid requests", func(operation string, opts *v1.BatchOperationOptions) {
+		request.PathParameters()["operation"] = operation
+		request.Request.Body = newBody(opts)
+
+		app.BatchVMRequestHandler(request, response)
+		ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+	},
+		Entry("with an unknown operation", "pause", &v1.BatchOperationOptions{LabelSelector: &metav1.LabelSelector{}}),
+		Entry("without a label selector", BatchOperationStop, &v1.BatchOperationOptions{}),
+		Entry("with an invalid label selector", BatchOperationStop, &v1.BatchOperationOptions{
+			LabelSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: groupLabel, Operator: "Bogus"}}},
+		}),
+		Entry("with a zero concurrency", BatchOperationStop, &v1.BatchOperationOptions{
+			LabelSelector:  &metav1.LabelSelector{},
+			MaxConcurrency: pointer.P(int32(0)),
+		}),
+		Entry("with a too large concurrency", BatchOperationStop, &v1.BatchOperationOptions{
+			LabelSelector:  &metav1.LabelSelector{},
+			MaxConcurrency: pointer.P(int32(maxBatchMaxConcurrency + 1)),
+		}),
+	)
+})
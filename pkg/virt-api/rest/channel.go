@@ -0,0 +1,65 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"fmt"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
+)
+
+func (app *SubresourceAPIApp) ChannelRequestHandler(request *restful.Request, response *restful.Response) {
+	channel := request.PathParameter(definitions.ChannelParamName)
+
+	streamer := NewRawStreamer(
+		app.FetchVirtualMachineInstance,
+		func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+			return validateVMIForChannel(vmi, channel)
+		},
+		app.virtHandlerDialer(func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+			return conn.ChannelURI(vmi, channel)
+		}),
+	)
+
+	streamer.Handle(request, response)
+}
+
+func validateVMIForChannel(vmi *v1.VirtualMachineInstance, channel string) *errors.StatusError {
+	found := false
+	for _, c := range vmi.Spec.Domain.Devices.VirtioChannels {
+		if c.Name == channel {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return errors.NewBadRequest(fmt.Sprintf("virtio channel %s is not defined on the VMI", channel))
+	}
+	if !vmi.IsRunning() {
+		return errors.NewBadRequest(vmiNotRunning)
+	}
+	return nil
+}
@@ -44,7 +44,7 @@ func (app *SubresourceAPIApp) ConsoleRequestHandler(request *restful.Request, re
 		app.virtHandlerDialer(func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
 			return conn.ConsoleURI(vmi)
 		}),
-	)
+	).WithRecording(app.consoleRecorder, v1.ConsoleSessionSerial)
 
 	streamer.Handle(request, response)
 }
@@ -0,0 +1,216 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+const (
+	debugAttachNotEnabledError = "Enable DebugAttach feature gate to use this API."
+
+	defaultDebugAttachDuration = 15 * time.Minute
+	maxDebugAttachDuration     = time.Hour
+
+	debugContainerPrefix     = "debug-"
+	debugTargetContainerName = "compute"
+
+	// DebugContainerAttachedReason is the reason of the event recorded on the VMI for every attached debug container
+	DebugContainerAttachedReason = "DebugContainerAttached"
+)
+
+// VMIDebugAttachRequestHandler handles the subresource attaching a debug container to the virt-launcher pod.
+// The container exits once the requested duration elapses, and every attach is recorded as an event of the VMI
+// along with the requesting user and the given reason.
+func (app *SubresourceAPIApp) VMIDebugAttachRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if !app.clusterConfig.DebugAttachEnabled() {
+		writeError(errors.NewBadRequest(debugAttachNotEnabledError), response)
+		return
+	}
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body, a reason is expected as the request body"), response)
+		return
+	}
+
+	opts := &v1.DebugAttachOptions{}
+	defer request.Request.Body.Close()
+	if err := decodeBody(request, opts); err != nil {
+		writeError(err, response)
+		return
+	}
+
+	duration, statusErr := debugAttachDuration(opts)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	vmi, statusErr := app.FetchVirtualMachineInstance(namespace, name)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	if vmi.Status.Phase != v1.Running {
+		writeError(errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning)), response)
+		return
+	}
+
+	result, statusErr := app.attachDebugContainer(vmi, opts, duration)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	app.recordDebugAttach(vmi, request.Request.Header.Get(userHeader), opts, result)
+
+	if err := response.WriteHeaderAndJson(http.StatusOK, result, restful.MIME_JSON); err != nil {
+		log.Log.Reason(err).Error("Failed to write http response.")
+	}
+}
+
+func debugAttachDuration(opts *v1.DebugAttachOptions) (time.Duration, *errors.StatusError) {
+	if opts.Reason == "" {
+		return 0, errors.NewBadRequest("DebugAttachOptions requires reason to be set")
+	}
+	if opts.Duration == nil {
+		return defaultDebugAttachDuration, nil
+	}
+	if opts.Duration.Duration <= 0 || opts.Duration.Duration > maxDebugAttachDuration {
+		return 0, errors.NewBadRequest(fmt.Sprintf("DebugAttachOptions duration must be positive and may not exceed %s", maxDebugAttachDuration))
+	}
+	return opts.Duration.Duration, nil
+}
+
+func (app *SubresourceAPIApp) attachDebugContainer(vmi *v1.VirtualMachineInstance, opts *v1.DebugAttachOptions, duration time.Duration) (*v1.DebugAttachResult, *errors.StatusError) {
+	podName, err := app.findPod(vmi.Namespace, vmi)
+	if err != nil {
+		return nil, errors.NewInternalError(fmt.Errorf("unable to find the virt-launcher pod: %v", err))
+	}
+	if podName == "" {
+		return nil, errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("no running virt-launcher pod found"))
+	}
+
+	pod, err := app.virtCli.CoreV1().Pods(vmi.Namespace).Get(context.Background(), podName, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.NewInternalError(fmt.Errorf("unable to retrieve the virt-launcher pod: %v", err))
+	}
+
+	var compute *k8sv1.Container
+	for i := range pod.Spec.Containers {
+		if pod.Spec.Containers[i].Name == debugTargetContainerName {
+			compute = &pod.Spec.Containers[i]
+		}
+	}
+	if compute == nil {
+		return nil, errors.NewInternalError(fmt.Errorf("no %s container found in pod %s", debugTargetContainerName, pod.Name))
+	}
+
+	container := newDebugContainer(compute, opts.Privileged, duration)
+	pod.Spec.EphemeralContainers = append(pod.Spec.EphemeralContainers, container)
+	if _, err := app.virtCli.CoreV1().Pods(pod.Namespace).UpdateEphemeralContainers(context.Background(), pod.Name, pod, metav1.UpdateOptions{}); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("unable to attach debug container to pod %s", pod.Name)
+		if statusErr, ok := err.(*errors.StatusError); ok && (errors.IsInvalid(err) || errors.IsForbidden(err)) {
+			return nil, statusErr
+		}
+		return nil, errors.NewInternalError(fmt.Errorf("unable to attach debug container: %v", err))
+	}
+
+	return &v1.DebugAttachResult{
+		PodName:             pod.Name,
+		ContainerName:       container.Name,
+		ExpirationTimestamp: metav1.NewTime(time.Now().Add(duration)),
+	}, nil
+}
+
+// newDebugContainer returns a container running the image of the compute container and sharing its process namespace,
+// users exec into it. Unless requested to be privileged, it runs with the security context of the compute container.
+// Ephemeral containers cannot be removed from a pod, the container expires by exiting once the duration elapses.
+func newDebugContainer(compute *k8sv1.Container, privileged bool, duration time.Duration) k8sv1.EphemeralContainer {
+	securityContext := compute.SecurityContext.DeepCopy()
+	if privileged {
+		securityContext = &k8sv1.SecurityContext{
+			Privileged: pointer.P(true),
+		}
+	}
+
+	return k8sv1.EphemeralContainer{
+		EphemeralContainerCommon: k8sv1.EphemeralContainerCommon{
+			Name:                     debugContainerPrefix + utilrand.String(5),
+			Image:                    compute.Image,
+			ImagePullPolicy:          k8sv1.PullIfNotPresent,
+			Command:                  []string{"sleep", strconv.Itoa(int(duration.Seconds()))},
+			TerminationMessagePolicy: k8sv1.TerminationMessageReadFile,
+			SecurityContext:          securityContext,
+		},
+		TargetContainerName: debugTargetContainerName,
+	}
+}
+
+// recordDebugAttach records the attach as an event of the VMI, which remains visible to the VMI owners
+func (app *SubresourceAPIApp) recordDebugAttach(vmi *v1.VirtualMachineInstance, user string, opts *v1.DebugAttachOptions, result *v1.DebugAttachResult) {
+	message := fmt.Sprintf("user %q attached debug container %s to pod %s until %s (privileged: %t), reason: %s",
+		user, result.ContainerName, result.PodName, result.ExpirationTimestamp.Format(time.RFC3339), opts.Privileged, opts.Reason)
+	log.Log.Object(vmi).Info(message)
+
+	now := metav1.Now()
+	event := &k8sv1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: vmi.Name + "-",
+			Namespace:    vmi.Namespace,
+		},
+		InvolvedObject: k8sv1.ObjectReference{
+			APIVersion: v1.GroupVersion.String(),
+			Kind:       v1.VirtualMachineInstanceGroupVersionKind.Kind,
+			Name:       vmi.Name,
+			Namespace:  vmi.Namespace,
+			UID:        vmi.UID,
+		},
+		Reason:         DebugContainerAttachedReason,
+		Message:        message,
+		Type:           k8sv1.EventTypeNormal,
+		Source:         k8sv1.EventSource{Component: "virt-api"},
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+	}
+	if _, err := app.virtCli.CoreV1().Events(vmi.Namespace).Create(context.Background(), event, metav1.CreateOptions{}); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("unable to record the debug container attach event")
+	}
+}
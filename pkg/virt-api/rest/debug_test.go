@@ -0,0 +1,214 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("Debug Attach Subresource api", func() {
+	const (
+		launcherPod   = "virt-launcher-testvmi"
+		launcherImage = "virt-launcher:latest"
+	)
+
+	var (
+		recorder   *httptest.ResponseRecorder
+		request    *restful.Request
+		response   *restful.Response
+		virtClient *kubecli.MockKubevirtClient
+		kubeClient *k8sfake.Clientset
+		vmi        *v1.VirtualMachineInstance
+	)
+
+	newApp := func(featureGates ...string) *SubresourceAPIApp {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{
+				FeatureGates: featureGates,
+			},
+		})
+		return NewSubresourceAPIApp(virtClient, 0, &tls.Config{InsecureSkipVerify: true}, config)
+	}
+
+	newDebugAttachBody := func(opts *v1.DebugAttachOptions) {
+		optsJson, err := json.Marshal(opts)
+		Expect(err).ToNot(HaveOccurred())
+		request.Request.Body = &readCloserWrapper{bytes.NewReader(optsJson)}
+	}
+
+	debugContainers := func() []k8sv1.EphemeralContainer {
+		pod, err := kubeClient.CoreV1().Pods(metav1.NamespaceDefault).Get(context.Background(), launcherPod, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return pod.Spec.EphemeralContainers
+	}
+
+	BeforeEach(func() {
+		request = restful.NewRequest(&http.Request{Header: http.Header{}})
+		request.PathParameters()["name"] = testVMName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+
+		vmi = libvmi.New(
+			libvmi.WithName(testVMName),
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmistatus.WithStatus(libvmistatus.New(libvmistatus.WithPhase(v1.Running))),
+		)
+
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
+		kubevirtClient := fake.NewSimpleClientset(vmi)
+		virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(kubevirtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault)).AnyTimes()
+
+		kubeClient = k8sfake.NewClientset(&k8sv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      launcherPod,
+				Namespace: metav1.NamespaceDefault,
+				Labels: map[string]string{
+					v1.AppLabel:       "virt-launcher",
+					v1.CreatedByLabel: string(vmi.UID),
+				},
+			},
+			Spec: k8sv1.PodSpec{
+				Containers: []k8sv1.Container{{
+					Name:  "compute",
+					Image: launcherImage,
+					SecurityContext: &k8sv1.SecurityContext{
+						RunAsNonRoot: pointer.P(true),
+						Capabilities: &k8sv1.Capabilities{Drop: []k8sv1.Capability{"ALL"}},
+					},
+				}},
+			},
+			Status: k8sv1.PodStatus{Phase: k8sv1.PodRunning},
+		})
+		virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+	})
+
+	It("should fail when the DebugAttach feature gate is disabled", func() {
+		newDebugAttachBody(&v1.DebugAttachOptions{Reason: "debugging"})
+		newApp().VMIDebugAttachRequestHandler(request, response)
+		ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		Expect(debugContainers()).To(BeEmpty())
+	})
+
+	DescribeTable("should reject invalid options", func(opts *v1.DebugAttachOptions) {
+		newDebugAttachBody(opts)
+		newApp(featuregate.DebugAttach).VMIDebugAttachRequestHandler(request, response)
+		ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		Expect(debugContainers()).To(BeEmpty())
+	},
+		Entry("without a reason", &v1.DebugAttachOptions{}),
+		Entry("with a negative duration", &v1.DebugAttachOptions{
+			Reason:   "debugging",
+			Duration: &metav1.Duration{Duration: -time.Minute},
+		}),
+		Entry("with a duration exceeding the maximum", &v1.DebugAttachOptions{
+			Reason:   "debugging",
+			Duration: &metav1.Duration{Duration: 2 * time.Hour},
+		}),
+	)
+
+	It("should fail when the VMI is not running", func() {
+		vmi.Status.Phase = v1.Scheduling
+		_, err := virtClient.VirtualMachineInstance(metav1.NamespaceDefault).Update(context.Background(), vmi, metav1.UpdateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		newDebugAttachBody(&v1.DebugAttachOptions{Reason: "debugging"})
+		newApp(featuregate.DebugAttach).VMIDebugAttachRequestHandler(request, response)
+		ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+	})
+
+	It("should attach a debug container running like the compute container by default", func() {
+		request.Request.Header.Set(userHeader, "admin")
+		newDebugAttachBody(&v1.DebugAttachOptions{Reason: "debugging"})
+		newApp(featuregate.DebugAttach).VMIDebugAttachRequestHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusOK))
+
+		result := &v1.DebugAttachResult{}
+		Expect(json.NewDecoder(recorder.Body).Decode(result)).To(Succeed())
+		Expect(result.PodName).To(Equal(launcherPod))
+		Expect(result.ExpirationTimestamp.Time).To(BeTemporally("~", time.Now().Add(defaultDebugAttachDuration), time.Minute))
+
+		containers := debugContainers()
+		Expect(containers).To(HaveLen(1))
+		Expect(containers[0].Name).To(Equal(result.ContainerName))
+		Expect(containers[0].Image).To(Equal(launcherImage))
+		Expect(containers[0].TargetContainerName).To(Equal("compute"))
+		Expect(containers[0].Command).To(Equal([]string{"sleep", "900"}))
+		Expect(containers[0].SecurityContext).To(Equal(&k8sv1.SecurityContext{
+			RunAsNonRoot: pointer.P(true),
+			Capabilities: &k8sv1.Capabilities{Drop: []k8sv1.Capability{"ALL"}},
+		}))
+	})
+
+	It("should attach a privileged debug container for the requested duration", func() {
+		newDebugAttachBody(&v1.DebugAttachOptions{
+			Reason:     "debugging",
+			Privileged: true,
+			Duration:   &metav1.Duration{Duration: 5 * time.Minute},
+		})
+		newApp(featuregate.DebugAttach).VMIDebugAttachRequestHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusOK))
+
+		containers := debugContainers()
+		Expect(containers).To(HaveLen(1))
+		Expect(containers[0].Image).To(Equal(launcherImage))
+		Expect(containers[0].Command).To(Equal([]string{"sleep", "300"}))
+		Expect(containers[0].SecurityContext.Privileged).To(HaveValue(BeTrue()))
+	})
+
+	It("should record the attach as an event of the VMI", func() {
+		request.Request.Header.Set(userHeader, "admin")
+		newDebugAttachBody(&v1.DebugAttachOptions{Reason: "debugging"})
+		newApp(featuregate.DebugAttach).VMIDebugAttachRequestHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusOK))
+
+		events, err := kubeClient.CoreV1().Events(metav1.NamespaceDefault).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(events.Items).To(HaveLen(1))
+		Expect(events.Items[0].Reason).To(Equal(DebugContainerAttachedReason))
+		Expect(events.Items[0].InvolvedObject.Name).To(Equal(testVMName))
+		Expect(events.Items[0].Message).To(And(ContainSubstring(`user "admin"`), ContainSubstring("reason: debugging")))
+	})
+})
@@ -0,0 +1,118 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+)
+
+const domainJobsNotEnabledError = "Enable DomainJobs feature gate to use this API."
+
+// DomainJobsRequestHandler handles the subresource listing the libvirt jobs in progress on the domain of a VMI
+func (app *SubresourceAPIApp) DomainJobsRequestHandler(request *restful.Request, response *restful.Response) {
+	if !app.clusterConfig.DomainJobsEnabled() {
+		writeError(errors.NewBadRequest(domainJobsNotEnabledError), response)
+		return
+	}
+
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.DomainJobsURI(vmi)
+	}
+
+	app.httpGetRequestHandler(request, response, validateDomainJobsVMI, getURL, v1.VirtualMachineInstanceDomainJobList{})
+}
+
+// CancelDomainJobRequestHandler handles the subresource cancelling a libvirt job in progress on the domain of a VMI,
+// e.g. a stuck migration or block copy.
+func (app *SubresourceAPIApp) CancelDomainJobRequestHandler(request *restful.Request, response *restful.Response) {
+	if !app.clusterConfig.DomainJobsEnabled() {
+		writeError(errors.NewBadRequest(domainJobsNotEnabledError), response)
+		return
+	}
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body, the domain job to cancel is expected as the request body"), response)
+		return
+	}
+
+	opts := &v1.CancelDomainJobOptions{}
+	defer request.Request.Body.Close()
+	if err := decodeBody(request, opts); err != nil {
+		writeError(err, response)
+		return
+	}
+
+	if err := validateCancelDomainJobOptions(opts); err != nil {
+		writeError(err, response)
+		return
+	}
+
+	// The body was consumed while validating it, forward the decoded options to virt-handler
+	body, err := json.Marshal(opts)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	request.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	log.Log.Infof("user %q requested to cancel the %s domain job of vmi %s/%s, disk: %q",
+		request.Request.Header.Get(userHeader), opts.Type,
+		request.PathParameter("namespace"), request.PathParameter("name"), opts.Disk)
+
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.CancelDomainJobURI(vmi)
+	}
+
+	app.putRequestHandler(request, response, validateDomainJobsVMI, getURL, false)
+}
+
+func validateDomainJobsVMI(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+	if vmi.Status.Phase != v1.Running {
+		return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+	}
+	return nil
+}
+
+func validateCancelDomainJobOptions(opts *v1.CancelDomainJobOptions) *errors.StatusError {
+	switch opts.Type {
+	case v1.DomainJobTypeDomain:
+		if opts.Disk != "" {
+			return errors.NewBadRequest("CancelDomainJobOptions disk may only be set for Block jobs")
+		}
+	case v1.DomainJobTypeBlock:
+		if opts.Disk == "" {
+			return errors.NewBadRequest("CancelDomainJobOptions requires disk to be set for Block jobs")
+		}
+	default:
+		return errors.NewBadRequest(fmt.Sprintf("CancelDomainJobOptions type must be either %s or %s", v1.DomainJobTypeDomain, v1.DomainJobTypeBlock))
+	}
+	return nil
+}
@@ -0,0 +1,226 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+
+	"github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	"go.uber.org/mock/gomock"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("Domain Jobs Subresources", func() {
+	const (
+		nodeName            = "mynode"
+		domainJobsPath      = "/v1/namespaces/default/virtualmachineinstances/testvmi/domainjobs"
+		cancelDomainJobPath = "/v1/namespaces/default/virtualmachineinstances/testvmi/canceldomainjob"
+	)
+
+	var (
+		backend     *ghttp.Server
+		backendPort int
+		recorder    *httptest.ResponseRecorder
+		request     *restful.Request
+		response    *restful.Response
+		virtClient  *kubecli.MockKubevirtClient
+	)
+
+	newApp := func(featureGates ...string) *SubresourceAPIApp {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{
+				FeatureGates: featureGates,
+			},
+		})
+		return NewSubresourceAPIApp(virtClient, backendPort, &tls.Config{InsecureSkipVerify: true}, config)
+	}
+
+	newCancelDomainJobBody := func(opts *v1.CancelDomainJobOptions) {
+		optsJson, err := json.Marshal(opts)
+		Expect(err).ToNot(HaveOccurred())
+		request.Request.Body = &readCloserWrapper{bytes.NewReader(optsJson)}
+	}
+
+	createVMI := func(phase v1.VirtualMachineInstancePhase) {
+		vmi := libvmi.New(
+			libvmi.WithName(testVMIName),
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmistatus.WithStatus(libvmistatus.New(
+				libvmistatus.WithPhase(phase),
+				libvmistatus.WithNodeName(nodeName),
+			)),
+		)
+		_, err := virtClient.VirtualMachineInstance(metav1.NamespaceDefault).Create(context.Background(), vmi, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		request = restful.NewRequest(&http.Request{Header: http.Header{}})
+		request.PathParameters()["name"] = testVMIName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+
+		backend = ghttp.NewTLSServer()
+		backendAddr := strings.Split(backend.Addr(), ":")
+		var err error
+		backendPort, err = strconv.Atoi(backendAddr[1])
+		Expect(err).ToNot(HaveOccurred())
+
+		kubeClient := fake.NewSimpleClientset(&k8sv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "virt-handler",
+				Namespace: "kubevirt",
+				Labels:    map[string]string{v1.AppLabel: "virt-handler"},
+			},
+			Spec: k8sv1.PodSpec{
+				NodeName: nodeName,
+			},
+			Status: k8sv1.PodStatus{
+				Phase: k8sv1.PodRunning,
+				PodIP: backendAddr[0],
+			},
+		})
+		kubevirtClient := kubevirtfake.NewSimpleClientset()
+
+		virtClient = kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(kubevirtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault)).AnyTimes()
+	})
+
+	AfterEach(func() {
+		backend.Close()
+	})
+
+	Context("listing", func() {
+		It("should fail when the DomainJobs feature gate is disabled", func() {
+			createVMI(v1.Running)
+			newApp().DomainJobsRequestHandler(request, response)
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+			Expect(backend.ReceivedRequests()).To(BeEmpty())
+		})
+
+		It("should fail when the VMI is not running", func() {
+			createVMI(v1.Scheduled)
+			newApp(featuregate.DomainJobs).DomainJobsRequestHandler(request, response)
+			Expect(response.Error()).To(MatchError(ContainSubstring(vmiNotRunning)))
+			Expect(backend.ReceivedRequests()).To(BeEmpty())
+		})
+
+		It("should return the domain jobs reported by virt-handler", func() {
+			jobs := v1.VirtualMachineInstanceDomainJobList{
+				Items: []v1.VirtualMachineInstanceDomainJob{
+					{Type: v1.DomainJobTypeDomain, Operation: "MigrationOut", Processed: 512, Total: 1024},
+					{Type: v1.DomainJobTypeBlock, Operation: "Copy", Disk: "vda", Processed: 10, Total: 20},
+				},
+			}
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, domainJobsPath),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, jobs),
+				),
+			)
+			createVMI(v1.Running)
+			response.SetRequestAccepts(restful.MIME_JSON)
+
+			newApp(featuregate.DomainJobs).DomainJobsRequestHandler(request, response)
+			Expect(response.Error()).ToNot(HaveOccurred())
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+
+			result := v1.VirtualMachineInstanceDomainJobList{}
+			Expect(json.NewDecoder(recorder.Body).Decode(&result)).To(Succeed())
+			Expect(result.Items).To(Equal(jobs.Items))
+		})
+	})
+
+	Context("cancelling", func() {
+		It("should fail when the DomainJobs feature gate is disabled", func() {
+			createVMI(v1.Running)
+			newCancelDomainJobBody(&v1.CancelDomainJobOptions{Type: v1.DomainJobTypeDomain})
+			newApp().CancelDomainJobRequestHandler(request, response)
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+			Expect(backend.ReceivedRequests()).To(BeEmpty())
+		})
+
+		DescribeTable("should reject invalid options", func(opts *v1.CancelDomainJobOptions) {
+			createVMI(v1.Running)
+			newCancelDomainJobBody(opts)
+			newApp(featuregate.DomainJobs).CancelDomainJobRequestHandler(request, response)
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+			Expect(backend.ReceivedRequests()).To(BeEmpty())
+		},
+			Entry("without a type", &v1.CancelDomainJobOptions{}),
+			Entry("with an unknown type", &v1.CancelDomainJobOptions{Type: "Unknown"}),
+			Entry("with a disk for a Domain job", &v1.CancelDomainJobOptions{Type: v1.DomainJobTypeDomain, Disk: "vda"}),
+			Entry("without a disk for a Block job", &v1.CancelDomainJobOptions{Type: v1.DomainJobTypeBlock}),
+		)
+
+		It("should fail when the VMI is not running", func() {
+			createVMI(v1.Scheduled)
+			newCancelDomainJobBody(&v1.CancelDomainJobOptions{Type: v1.DomainJobTypeDomain})
+			newApp(featuregate.DomainJobs).CancelDomainJobRequestHandler(request, response)
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+			Expect(backend.ReceivedRequests()).To(BeEmpty())
+		})
+
+		It("should forward the options to virt-handler", func() {
+			opts := &v1.CancelDomainJobOptions{Type: v1.DomainJobTypeBlock, Disk: "vda"}
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPut, cancelDomainJobPath),
+					func(_ http.ResponseWriter, r *http.Request) {
+						received := &v1.CancelDomainJobOptions{}
+						Expect(json.NewDecoder(r.Body).Decode(received)).To(Succeed())
+						Expect(received).To(Equal(opts))
+					},
+					ghttp.RespondWith(http.StatusAccepted, nil),
+				),
+			)
+			createVMI(v1.Running)
+			newCancelDomainJobBody(opts)
+
+			newApp(featuregate.DomainJobs).CancelDomainJobRequestHandler(request, response)
+			Expect(response.Error()).ToNot(HaveOccurred())
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			Expect(backend.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+})
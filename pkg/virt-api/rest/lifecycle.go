@@ -41,43 +41,49 @@ import (
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/controller"
 	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/util/migrations"
 )
 
 func (app *SubresourceAPIApp) StartVMRequestHandler(request *restful.Request, response *restful.Response) {
 	name := request.PathParameter("name")
 	namespace := request.PathParameter("namespace")
 
-	vm, statusErr := app.fetchVirtualMachine(name, namespace)
-	if statusErr != nil {
+	bodyStruct := &v1.StartOptions{}
+	if request.Request.Body != nil {
+		if err := decodeBody(request, bodyStruct); err != nil {
+			writeError(err, response)
+			return
+		}
+	}
+
+	if statusErr := app.startVM(name, namespace, bodyStruct); statusErr != nil {
 		writeError(statusErr, response)
 		return
 	}
 
+	response.WriteHeader(http.StatusAccepted)
+}
+
+func (app *SubresourceAPIApp) startVM(name, namespace string, bodyStruct *v1.StartOptions) *errors.StatusError {
+	vm, statusErr := app.fetchVirtualMachine(name, namespace)
+	if statusErr != nil {
+		return statusErr
+	}
+
 	vmi, err := app.virtCli.VirtualMachineInstance(namespace).Get(context.Background(), name, metav1.GetOptions{})
 	if err != nil && !errors.IsNotFound(err) {
-		writeError(errors.NewInternalError(err), response)
-		return
+		return errors.NewInternalError(err)
 	}
 
 	if vmi != nil && !vmi.IsFinal() && vmi.Status.Phase != v1.Unknown && vmi.Status.Phase != v1.VmPhaseUnset {
-		writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf("VM is already running")), response)
-		return
+		return errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf("VM is already running"))
 	}
 	if controller.NewVirtualMachineConditionManager().HasConditionWithStatus(vm, v1.VirtualMachineManualRecoveryRequired, k8sv1.ConditionTrue) {
-		writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf(volumeMigrationManualRecoveryRequiredErr)), response)
-		return
+		return errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf(volumeMigrationManualRecoveryRequiredErr))
 	}
 
-	startPaused := false
+	startPaused := bodyStruct.Paused
 	startChangeRequestData := make(map[string]string)
-	bodyStruct := &v1.StartOptions{}
-	if request.Request.Body != nil {
-		if err := decodeBody(request, bodyStruct); err != nil {
-			writeError(err, response)
-			return
-		}
-		startPaused = bodyStruct.Paused
-	}
 	if startPaused {
 		startChangeRequestData[v1.StartRequestDataPausedKey] = v1.StartRequestDataPausedTrue
 	}
@@ -86,8 +92,7 @@ func (app *SubresourceAPIApp) StartVMRequestHandler(request *restful.Request, re
 
 	runStrategy, err := vm.RunStrategy()
 	if err != nil {
-		writeError(errors.NewInternalError(err), response)
-		return
+		return errors.NewInternalError(err)
 	}
 	// RunStrategyHalted         -> spec.running = true / send start request for paused start
 	// RunStrategyManual         -> send start request
@@ -105,16 +110,14 @@ func (app *SubresourceAPIApp) StartVMRequestHandler(request *restful.Request, re
 				Data:   startChangeRequestData,
 			})
 			if err != nil {
-				writeError(errors.NewInternalError(err), response)
-				return
+				return errors.NewInternalError(err)
 			}
 			log.Log.Object(vm).V(4).Infof(patchingVMStatusFmt, string(patchBytes))
 			_, patchErr = app.virtCli.VirtualMachine(vm.Namespace).PatchStatus(context.Background(), vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{DryRun: bodyStruct.DryRun})
 		} else {
 			patchBytes, err := getRunningPatch(vm, true)
 			if err != nil {
-				writeError(errors.NewInternalError(err), response)
-				return
+				return errors.NewInternalError(err)
 			}
 			log.Log.Object(vm).V(4).Infof(patchingVMFmt, string(patchBytes))
 			_, patchErr = app.virtCli.VirtualMachine(namespace).Patch(context.Background(), vm.GetName(), types.JSONPatchType, patchBytes, metav1.PatchOptions{DryRun: bodyStruct.DryRun})
@@ -126,8 +129,7 @@ func (app *SubresourceAPIApp) StartVMRequestHandler(request *restful.Request, re
 			(runStrategy == v1.RunStrategyManual && vmi != nil && vmi.IsFinal()) {
 			needsRestart = true
 		} else if runStrategy == v1.RunStrategyRerunOnFailure && vmi != nil && vmi.Status.Phase == v1.Failed {
-			writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf("%v does not support starting VM from failed state", v1.RunStrategyRerunOnFailure)), response)
-			return
+			return errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf("%v does not support starting VM from failed state", v1.RunStrategyRerunOnFailure))
 		}
 
 		var patchBytes []byte
@@ -140,35 +142,25 @@ func (app *SubresourceAPIApp) StartVMRequestHandler(request *restful.Request, re
 				v1.VirtualMachineStateChangeRequest{Action: v1.StartRequest, Data: startChangeRequestData})
 		}
 		if err != nil {
-			writeError(errors.NewInternalError(err), response)
-			return
+			return errors.NewInternalError(err)
 		}
 		log.Log.Object(vm).V(4).Infof(patchingVMStatusFmt, string(patchBytes))
 		_, patchErr = app.virtCli.VirtualMachine(vm.Namespace).PatchStatus(context.Background(), vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{DryRun: bodyStruct.DryRun})
 	case v1.RunStrategyAlways, v1.RunStrategyOnce:
-		writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf("%v does not support manual start requests", runStrategy)), response)
-		return
+		return errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf("%v does not support manual start requests", runStrategy))
 	}
 
 	if patchErr != nil {
 		if strings.Contains(patchErr.Error(), jsonpatchTestErr) {
-			writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, patchErr), response)
-		} else {
-			writeError(errors.NewInternalError(patchErr), response)
+			return errors.NewConflict(v1.Resource("virtualmachine"), name, patchErr)
 		}
-		return
+		return errors.NewInternalError(patchErr)
 	}
 
-	response.WriteHeader(http.StatusAccepted)
+	return nil
 }
 
 func (app *SubresourceAPIApp) StopVMRequestHandler(request *restful.Request, response *restful.Response) {
-	// RunStrategyHalted         -> force stop if grace period in request is shorter than before, otherwise doesn't make sense
-	// RunStrategyManual         -> send stop request
-	// RunStrategyAlways         -> spec.running = false
-	// RunStrategyRerunOnFailure -> send stop request
-	// RunStrategyOnce           -> spec.running = false
-
 	name := request.PathParameter("name")
 	namespace := request.PathParameter("namespace")
 
@@ -180,16 +172,29 @@ func (app *SubresourceAPIApp) StopVMRequestHandler(request *restful.Request, res
 		}
 	}
 
-	vm, statusErr := app.fetchVirtualMachine(name, namespace)
-	if statusErr != nil {
+	if statusErr := app.stopVM(name, namespace, bodyStruct); statusErr != nil {
 		writeError(statusErr, response)
 		return
 	}
 
+	response.WriteHeader(http.StatusAccepted)
+}
+
+func (app *SubresourceAPIApp) stopVM(name, namespace string, bodyStruct *v1.StopOptions) *errors.StatusError {
+	// RunStrategyHalted         -> force stop if grace period in request is shorter than before, otherwise doesn't make sense
+	// RunStrategyManual         -> send stop request
+	// RunStrategyAlways         -> spec.running = false
+	// RunStrategyRerunOnFailure -> send stop request
+	// RunStrategyOnce           -> spec.running = false
+
+	vm, statusErr := app.fetchVirtualMachine(name, namespace)
+	if statusErr != nil {
+		return statusErr
+	}
+
 	runStrategy, err := vm.RunStrategy()
 	if err != nil {
-		writeError(errors.NewInternalError(err), response)
-		return
+		return errors.NewInternalError(err)
 	}
 
 	hasVMI := true
@@ -197,8 +202,7 @@ func (app *SubresourceAPIApp) StopVMRequestHandler(request *restful.Request, res
 	if err != nil && errors.IsNotFound(err) {
 		hasVMI = false
 	} else if err != nil {
-		writeError(errors.NewInternalError(err), response)
-		return
+		return errors.NewInternalError(err)
 	}
 
 	var oldGracePeriodSeconds int64
@@ -216,49 +220,45 @@ func (app *SubresourceAPIApp) StopVMRequestHandler(request *restful.Request, res
 		patchSet.AddOption(patch.WithReplace("/spec/terminationGracePeriodSeconds", *bodyStruct.GracePeriod))
 		patchBytes, err := patchSet.GeneratePayload()
 		if err != nil {
-			writeError(errors.NewInternalError(err), response)
-			return
+			return errors.NewInternalError(err)
 		}
 
 		log.Log.Object(vmi).V(2).Infof("Patching VMI: %s", string(patchBytes))
 		_, err = app.virtCli.VirtualMachineInstance(namespace).Patch(context.Background(), vmi.GetName(), types.JSONPatchType, patchBytes, metav1.PatchOptions{DryRun: bodyStruct.DryRun})
 		if err != nil {
-			writeError(errors.NewInternalError(err), response)
-			return
+			return errors.NewInternalError(err)
 		}
 	}
 
 	switch runStrategy {
 	case v1.RunStrategyHalted:
 		if !hasVMI || vmi.IsFinal() {
-			writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf(vmNotRunning)), response)
-			return
+			return errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf(vmNotRunning))
 		}
 		if bodyStruct.GracePeriod == nil || (vmi.Spec.TerminationGracePeriodSeconds != nil && *bodyStruct.GracePeriod >= oldGracePeriodSeconds) {
-			writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf("%v only supports manual stop requests with a shorter graceperiod", v1.RunStrategyHalted)), response)
-			return
+			return errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf("%v only supports manual stop requests with a shorter graceperiod", v1.RunStrategyHalted))
 		}
 		// same behavior as RunStrategyManual
-		patchErr, err = app.patchVMStatusStopped(vmi, vm, response, bodyStruct)
-		if err != nil {
-			return
+		var statusErr *errors.StatusError
+		patchErr, statusErr = app.patchVMStatusStopped(vmi, vm, bodyStruct)
+		if statusErr != nil {
+			return statusErr
 		}
 	case v1.RunStrategyRerunOnFailure, v1.RunStrategyManual:
 		if !hasVMI || vmi.IsFinal() {
-			writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf(vmNotRunning)), response)
-			return
+			return errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf(vmNotRunning))
 		}
 		// pass the buck and ask virt-controller to stop the VM. this way the
 		// VM will retain RunStrategy = manual
-		patchErr, err = app.patchVMStatusStopped(vmi, vm, response, bodyStruct)
-		if err != nil {
-			return
+		var statusErr *errors.StatusError
+		patchErr, statusErr = app.patchVMStatusStopped(vmi, vm, bodyStruct)
+		if statusErr != nil {
+			return statusErr
 		}
 	case v1.RunStrategyAlways, v1.RunStrategyOnce:
 		patchBytes, err := getRunningPatch(vm, false)
 		if err != nil {
-			writeError(errors.NewInternalError(err), response)
-			return
+			return errors.NewInternalError(err)
 		}
 		log.Log.Object(vm).V(4).Infof(patchingVMFmt, string(patchBytes))
 		_, patchErr = app.virtCli.VirtualMachine(namespace).Patch(context.Background(), vm.GetName(), types.JSONPatchType, patchBytes, metav1.PatchOptions{DryRun: bodyStruct.DryRun})
@@ -266,14 +266,12 @@ func (app *SubresourceAPIApp) StopVMRequestHandler(request *restful.Request, res
 
 	if patchErr != nil {
 		if strings.Contains(patchErr.Error(), jsonpatchTestErr) {
-			writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, patchErr), response)
-		} else {
-			writeError(errors.NewInternalError(patchErr), response)
+			return errors.NewConflict(v1.Resource("virtualmachine"), name, patchErr)
 		}
-		return
+		return errors.NewInternalError(patchErr)
 	}
 
-	response.WriteHeader(http.StatusAccepted)
+	return nil
 }
 
 func (app *SubresourceAPIApp) PauseVMIRequestHandler(request *restful.Request, response *restful.Response) {
@@ -412,80 +410,78 @@ func (app *SubresourceAPIApp) ResetVMIRequestHandler(request *restful.Request, r
 }
 
 func (app *SubresourceAPIApp) RestartVMRequestHandler(request *restful.Request, response *restful.Response) {
-	// RunStrategyHalted         -> doesn't make sense
-	// RunStrategyManual         -> send restart request
-	// RunStrategyAlways         -> send restart request
-	// RunStrategyRerunOnFailure -> send restart request
-	// RunStrategyOnce           -> doesn't make sense
 	name := request.PathParameter("name")
 	namespace := request.PathParameter("namespace")
 
 	bodyStruct := &v1.RestartOptions{}
-
 	if request.Request.Body != nil {
 		if err := decodeBody(request, bodyStruct); err != nil {
 			writeError(err, response)
 			return
 		}
 	}
+
+	if statusErr := app.restartVM(name, namespace, bodyStruct); statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+func (app *SubresourceAPIApp) restartVM(name, namespace string, bodyStruct *v1.RestartOptions) *errors.StatusError {
+	// RunStrategyHalted         -> doesn't make sense
+	// RunStrategyManual         -> send restart request
+	// RunStrategyAlways         -> send restart request
+	// RunStrategyRerunOnFailure -> send restart request
+	// RunStrategyOnce           -> doesn't make sense
 	if bodyStruct.GracePeriodSeconds != nil {
 		if *bodyStruct.GracePeriodSeconds > 0 {
-			writeError(errors.NewBadRequest(fmt.Sprintf("For force restart, only gracePeriod=0 is supported for now")), response)
-			return
+			return errors.NewBadRequest(fmt.Sprintf("For force restart, only gracePeriod=0 is supported for now"))
 		} else if *bodyStruct.GracePeriodSeconds < 0 {
-			writeError(errors.NewBadRequest(fmt.Sprintf("gracePeriod has to be greater or equal to 0")), response)
-			return
+			return errors.NewBadRequest(fmt.Sprintf("gracePeriod has to be greater or equal to 0"))
 		}
 	}
 
 	vm, statusErr := app.fetchVirtualMachine(name, namespace)
 	if statusErr != nil {
-		writeError(statusErr, response)
-		return
+		return statusErr
 	}
 	if controller.NewVirtualMachineConditionManager().HasConditionWithStatus(vm,
 		v1.VirtualMachineConditionType(v1.VirtualMachineInstanceVolumesChange), k8sv1.ConditionTrue) {
-		writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf(volumeMigrationManualRecoveryRequiredErr)), response)
-		return
+		return errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf(volumeMigrationManualRecoveryRequiredErr))
 	}
 
 	runStrategy, err := vm.RunStrategy()
 	if err != nil {
-		writeError(errors.NewInternalError(err), response)
-		return
+		return errors.NewInternalError(err)
 	}
 	if runStrategy == v1.RunStrategyHalted || runStrategy == v1.RunStrategyOnce {
-		writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf("RunStategy %v does not support manual restart requests", runStrategy)), response)
-		return
+		return errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf("RunStategy %v does not support manual restart requests", runStrategy))
 	}
 
 	vmi, err := app.virtCli.VirtualMachineInstance(namespace).Get(context.Background(), name, metav1.GetOptions{})
 	if err != nil {
 		if !errors.IsNotFound(err) {
-			writeError(errors.NewInternalError(err), response)
-			return
+			return errors.NewInternalError(err)
 		}
-		writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf("VM is not running: %v", v1.RunStrategyHalted)), response)
-		return
+		return errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf("VM is not running: %v", v1.RunStrategyHalted))
 	}
 
 	patchBytes, err := getChangeRequestJson(vm,
 		v1.VirtualMachineStateChangeRequest{Action: v1.StopRequest, UID: &vmi.UID},
 		v1.VirtualMachineStateChangeRequest{Action: v1.StartRequest})
 	if err != nil {
-		writeError(errors.NewInternalError(err), response)
-		return
+		return errors.NewInternalError(err)
 	}
 
 	log.Log.Object(vm).V(4).Infof(patchingVMFmt, string(patchBytes))
 	_, err = app.virtCli.VirtualMachine(vm.Namespace).PatchStatus(context.Background(), vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{DryRun: bodyStruct.DryRun})
 	if err != nil {
 		if strings.Contains(err.Error(), jsonpatchTestErr) {
-			writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, err), response)
-		} else {
-			writeError(errors.NewInternalError(err), response)
+			return errors.NewConflict(v1.Resource("virtualmachine"), name, err)
 		}
-		return
+		return errors.NewInternalError(err)
 	}
 
 	// Only force restart with GracePeriodSeconds=0 is supported for now
@@ -494,25 +490,22 @@ func (app *SubresourceAPIApp) RestartVMRequestHandler(request *restful.Request,
 		if *bodyStruct.GracePeriodSeconds == 0 {
 			vmiPodname, err := app.findPod(namespace, vmi)
 			if err != nil {
-				writeError(errors.NewInternalError(err), response)
-				return
+				return errors.NewInternalError(err)
 			}
 			if vmiPodname == "" {
-				response.WriteHeader(http.StatusAccepted)
-				return
+				return nil
 			}
 			// set terminationGracePeriod to 1 (which is the shorted safe restart period) and delete the VMI pod to trigger a swift restart.
 			err = app.virtCli.CoreV1().Pods(namespace).Delete(context.Background(), vmiPodname, metav1.DeleteOptions{GracePeriodSeconds: pointer.P(int64(1))})
 			if err != nil {
 				if !errors.IsNotFound(err) {
-					writeError(errors.NewInternalError(err), response)
-					return
+					return errors.NewInternalError(err)
 				}
 			}
 		}
 	}
 
-	response.WriteHeader(http.StatusAccepted)
+	return nil
 }
 
 func (app *SubresourceAPIApp) SoftRebootVMIRequestHandler(request *restful.Request, response *restful.Response) {
@@ -540,6 +533,24 @@ func (app *SubresourceAPIApp) SoftRebootVMIRequestHandler(request *restful.Reque
 	app.putRequestHandler(request, response, validate, getURL, false)
 }
 
+func (app *SubresourceAPIApp) NetworkResyncVMIRequestHandler(request *restful.Request, response *restful.Response) {
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if vmi.Status.Phase != v1.Running {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+		}
+		if migrations.IsMigrating(vmi) {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("VMI is migrating"))
+		}
+		return nil
+	}
+
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.NetworkResyncURI(vmi)
+	}
+
+	app.putRequestHandler(request, response, validate, getURL, false)
+}
+
 func (app *SubresourceAPIApp) MigrateVMRequestHandler(request *restful.Request, response *restful.Response) {
 	name := request.PathParameter("name")
 	namespace := request.PathParameter("namespace")
@@ -551,21 +562,28 @@ func (app *SubresourceAPIApp) MigrateVMRequestHandler(request *restful.Request,
 			return
 		}
 	}
-	_, err := app.fetchVirtualMachine(name, namespace)
-	if err != nil {
-		writeError(err, response)
+
+	if statusErr := app.migrateVM(name, namespace, bodyStruct); statusErr != nil {
+		writeError(statusErr, response)
 		return
 	}
 
-	vmi, err := app.FetchVirtualMachineInstance(namespace, name)
-	if err != nil {
-		writeError(err, response)
-		return
+	response.WriteHeader(http.StatusAccepted)
+}
+
+func (app *SubresourceAPIApp) migrateVM(name, namespace string, bodyStruct *v1.MigrateOptions) *errors.StatusError {
+	_, statusErr := app.fetchVirtualMachine(name, namespace)
+	if statusErr != nil {
+		return statusErr
+	}
+
+	vmi, statusErr := app.FetchVirtualMachineInstance(namespace, name)
+	if statusErr != nil {
+		return statusErr
 	}
 
 	if vmi.Status.Phase != v1.Running {
-		writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf(vmNotRunning)), response)
-		return
+		return errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf(vmNotRunning))
 	}
 
 	createMigrationJob := func() *errors.StatusError {
@@ -584,12 +602,11 @@ func (app *SubresourceAPIApp) MigrateVMRequestHandler(request *restful.Request,
 		return nil
 	}
 
-	if err = createMigrationJob(); err != nil {
-		writeError(err, response)
-		return
+	if statusErr = createMigrationJob(); statusErr != nil {
+		return statusErr
 	}
 
-	response.WriteHeader(http.StatusAccepted)
+	return nil
 }
 
 func (app *SubresourceAPIApp) findPod(namespace string, vmi *v1.VirtualMachineInstance) (string, error) {
@@ -623,12 +640,11 @@ func (app *SubresourceAPIApp) findPod(namespace string, vmi *v1.VirtualMachineIn
 	return "", nil
 }
 
-func (app *SubresourceAPIApp) patchVMStatusStopped(vmi *v1.VirtualMachineInstance, vm *v1.VirtualMachine, response *restful.Response, bodyStruct *v1.StopOptions) (error, error) {
+func (app *SubresourceAPIApp) patchVMStatusStopped(vmi *v1.VirtualMachineInstance, vm *v1.VirtualMachine, bodyStruct *v1.StopOptions) (error, *errors.StatusError) {
 	patchBytes, err := getChangeRequestJson(vm,
 		v1.VirtualMachineStateChangeRequest{Action: v1.StopRequest, UID: &vmi.UID})
 	if err != nil {
-		writeError(errors.NewInternalError(err), response)
-		return nil, err
+		return nil, errors.NewInternalError(err)
 	}
 	log.Log.Object(vm).V(4).Infof(patchingVMStatusFmt, string(patchBytes))
 	_, err = app.virtCli.VirtualMachine(vm.Namespace).PatchStatus(context.Background(), vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{DryRun: bodyStruct.DryRun})
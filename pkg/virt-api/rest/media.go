@@ -0,0 +1,182 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+)
+
+const (
+	changeMediaNotEnabledError = "Enable DeclarativeHotplugVolumes feature gate to use this API."
+)
+
+// VMChangeMediaRequestHandler handles the subresource for inserting and ejecting the media of a CD-ROM disk.
+// The media is swapped in the VM template, the running VMI follows through the declarative volume hotplug.
+func (app *SubresourceAPIApp) VMChangeMediaRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if !app.clusterConfig.DeclarativeHotplugVolumesEnabled() {
+		writeError(errors.NewBadRequest(changeMediaNotEnabledError), response)
+		return
+	}
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body, a CD-ROM disk name is expected as the request body"), response)
+		return
+	}
+
+	opts := &v1.ChangeMediaOptions{}
+	defer request.Request.Body.Close()
+	if err := decodeBody(request, opts); err != nil {
+		writeError(err, response)
+		return
+	}
+
+	if opts.Name == "" {
+		writeError(errors.NewBadRequest("ChangeMediaOptions requires name to be set"), response)
+		return
+	}
+	if opts.VolumeSource != nil {
+		if opts.VolumeSource.DataVolume != nil {
+			opts.VolumeSource.DataVolume.Hotpluggable = true
+		} else if opts.VolumeSource.PersistentVolumeClaim != nil {
+			opts.VolumeSource.PersistentVolumeClaim.Hotpluggable = true
+		} else {
+			writeError(errors.NewBadRequest("ChangeMediaOptions requires VolumeSource to be a DataVolume or a PersistentVolumeClaim"), response)
+			return
+		}
+	}
+
+	if err := app.vmChangeMediaPatch(name, namespace, opts); err != nil {
+		writeError(err, response)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+func (app *SubresourceAPIApp) vmChangeMediaPatch(name, namespace string, opts *v1.ChangeMediaOptions) *errors.StatusError {
+	vm, statErr := app.fetchVirtualMachine(name, namespace)
+	if statErr != nil {
+		return statErr
+	}
+
+	patchBytes, err := generateChangeMediaPatch(&vm.Spec.Template.Spec, opts)
+	if err != nil {
+		return errors.NewConflict(v1.Resource("virtualmachine"), name, err)
+	}
+
+	var dryRunOption []string
+	if opts.DryRun != nil && opts.DryRun[0] == metav1.DryRunAll {
+		dryRunOption = opts.DryRun
+	}
+	log.Log.Object(vm).V(4).Infof(patchingVMFmt, string(patchBytes))
+	if _, err := app.virtCli.VirtualMachine(vm.Namespace).Patch(context.Background(), vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{DryRun: dryRunOption}); err != nil {
+		log.Log.Object(vm).Errorf("unable to patch vm: %v", err)
+		if errors.IsInvalid(err) {
+			if statErr, ok := err.(*errors.StatusError); ok {
+				return statErr
+			}
+		}
+		return errors.NewInternalError(fmt.Errorf("unable to patch vm: %v", err))
+	}
+	return nil
+}
+
+// generateChangeMediaPatch replaces, adds or removes the volume backing the CD-ROM disk,
+// the disk itself stays in place so the guest keeps seeing the same drive.
+func generateChangeMediaPatch(vmiSpec *v1.VirtualMachineInstanceSpec, opts *v1.ChangeMediaOptions) ([]byte, error) {
+	if err := verifyCDRomDisk(vmiSpec.Domain.Devices.Disks, opts.Name); err != nil {
+		return nil, err
+	}
+
+	var volumes []v1.Volume
+	var current *v1.Volume
+	for i, volume := range vmiSpec.Volumes {
+		if volume.Name == opts.Name {
+			current = &vmiSpec.Volumes[i]
+			continue
+		}
+		if opts.VolumeSource != nil && volumeSourceExists(volume, volumeSourceName(opts.VolumeSource)) {
+			return nil, fmt.Errorf("Unable to insert volume source [%s] because it is already used by volume [%s]", volumeSourceName(opts.VolumeSource), volume.Name)
+		}
+		volumes = append(volumes, volume)
+	}
+
+	if current != nil && !volumeHotpluggable(*current) {
+		return nil, fmt.Errorf("Unable to change media of disk [%s] because its volume is not hotpluggable", opts.Name)
+	}
+
+	if opts.VolumeSource == nil {
+		if current == nil {
+			return nil, fmt.Errorf("Unable to eject media of disk [%s] because it is empty", opts.Name)
+		}
+	} else {
+		newVolume := v1.Volume{Name: opts.Name}
+		if opts.VolumeSource.DataVolume != nil {
+			newVolume.VolumeSource.DataVolume = opts.VolumeSource.DataVolume.DeepCopy()
+		} else {
+			newVolume.VolumeSource.PersistentVolumeClaim = opts.VolumeSource.PersistentVolumeClaim.DeepCopy()
+		}
+		volumes = append(volumes, newVolume)
+	}
+
+	const volumePath = "/spec/template/spec/volumes"
+	patchSet := patch.New(patch.WithTest(volumePath, vmiSpec.Volumes))
+	if len(vmiSpec.Volumes) > 0 {
+		patchSet.AddOption(patch.WithReplace(volumePath, volumes))
+	} else {
+		patchSet.AddOption(patch.WithAdd(volumePath, volumes))
+	}
+	return patchSet.GeneratePayload()
+}
+
+// verifyCDRomDisk ensures the named disk is a CD-ROM on a bus supporting media changes
+func verifyCDRomDisk(disks []v1.Disk, name string) error {
+	for _, disk := range disks {
+		if disk.Name != name {
+			continue
+		}
+		if disk.CDRom == nil {
+			return fmt.Errorf("Unable to change media of disk [%s] because it is not a CD-ROM", name)
+		}
+		switch disk.CDRom.Bus {
+		case "", v1.DiskBusSATA, v1.DiskBusSCSI:
+			return nil
+		default:
+			return fmt.Errorf("Unable to change media of disk [%s] because bus %s does not support it, use sata or scsi", name, disk.CDRom.Bus)
+		}
+	}
+	return fmt.Errorf("Unable to change media of disk [%s] because it does not exist", name)
+}
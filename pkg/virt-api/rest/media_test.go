@@ -0,0 +1,227 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("Change Media Subresource api", func() {
+	const (
+		emptyCDRom     = "empty-cdrom"
+		insertedCDRom  = "inserted-cdrom"
+		coldplugCDRom  = "coldplug-cdrom"
+		virtioCDRom    = "virtio-cdrom"
+		rootDisk       = "rootdisk"
+		insertedClaim  = "inserted-iso"
+		installerClaim = "installer-iso"
+	)
+
+	var (
+		recorder   *httptest.ResponseRecorder
+		request    *restful.Request
+		response   *restful.Response
+		virtClient *kubecli.MockKubevirtClient
+		vmClient   *kubecli.MockVirtualMachineInterface
+		vm         *v1.VirtualMachine
+	)
+
+	newApp := func(featureGates ...string) *SubresourceAPIApp {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{
+				FeatureGates: featureGates,
+			},
+		})
+		return NewSubresourceAPIApp(virtClient, 0, &tls.Config{InsecureSkipVerify: true}, config)
+	}
+
+	newChangeMediaBody := func(opts *v1.ChangeMediaOptions) {
+		optsJson, err := json.Marshal(opts)
+		Expect(err).ToNot(HaveOccurred())
+		request.Request.Body = &readCloserWrapper{bytes.NewReader(optsJson)}
+	}
+
+	hotplugPVCVolume := func(name, claimName string) v1.Volume {
+		return v1.Volume{
+			Name: name,
+			VolumeSource: v1.VolumeSource{
+				PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+					PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: claimName},
+					Hotpluggable:                      true,
+				},
+			},
+		}
+	}
+
+	// expectPatchedVolumes captures the volumes the VM template is patched with
+	expectPatchedVolumes := func(dryRun []string) *[]v1.Volume {
+		var volumes []v1.Volume
+		vmClient.EXPECT().Patch(context.Background(), vm.Name, types.JSONPatchType, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, _ types.PatchType, body []byte, opts metav1.PatchOptions, _ ...string) (*v1.VirtualMachine, error) {
+				Expect(opts.DryRun).To(Equal(dryRun))
+				var ops []struct {
+					Op    string      `json:"op"`
+					Path  string      `json:"path"`
+					Value []v1.Volume `json:"value"`
+				}
+				Expect(json.Unmarshal(body, &ops)).To(Succeed())
+				Expect(ops).To(HaveLen(2))
+				Expect(ops[0].Op).To(Equal("test"))
+				Expect(ops[1].Op).To(Equal("replace"))
+				Expect(ops[1].Path).To(Equal("/spec/template/spec/volumes"))
+				volumes = ops[1].Value
+				return vm, nil
+			})
+		return &volumes
+	}
+
+	BeforeEach(func() {
+		request = restful.NewRequest(&http.Request{})
+		request.PathParameters()["name"] = testVMName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
+		vmClient = kubecli.NewMockVirtualMachineInterface(ctrl)
+		virtClient.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(vmClient).AnyTimes()
+
+		vm = libvmi.NewVirtualMachine(libvmi.New(
+			libvmi.WithName(testVMName),
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmi.WithPersistentVolumeClaim(rootDisk, "root-pvc"),
+			libvmi.WithEmptyCDRom(v1.DiskBusSATA, emptyCDRom),
+			libvmi.WithCDRomAndVolume(v1.DiskBusSCSI, hotplugPVCVolume(insertedCDRom, insertedClaim)),
+			libvmi.WithCDRom(coldplugCDRom, v1.DiskBusSATA, "coldplug-iso"),
+			libvmi.WithEmptyCDRom(v1.DiskBusVirtio, virtioCDRom),
+		))
+		vmClient.EXPECT().Get(context.Background(), testVMName, metav1.GetOptions{}).Return(vm, nil).AnyTimes()
+	})
+
+	It("should fail when the DeclarativeHotplugVolumes feature gate is disabled", func() {
+		newChangeMediaBody(&v1.ChangeMediaOptions{Name: emptyCDRom})
+		newApp().VMChangeMediaRequestHandler(request, response)
+		ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+	})
+
+	DescribeTable("should reject invalid options", func(opts *v1.ChangeMediaOptions) {
+		newChangeMediaBody(opts)
+		newApp(featuregate.DeclarativeHotplugVolumesGate).VMChangeMediaRequestHandler(request, response)
+		ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+	},
+		Entry("without a disk name", &v1.ChangeMediaOptions{}),
+		Entry("with an empty volume source", &v1.ChangeMediaOptions{
+			Name:         emptyCDRom,
+			VolumeSource: &v1.HotplugVolumeSource{},
+		}),
+	)
+
+	It("should insert a DataVolume into an empty CD-ROM", func() {
+		volumes := expectPatchedVolumes(nil)
+		newChangeMediaBody(&v1.ChangeMediaOptions{
+			Name: emptyCDRom,
+			VolumeSource: &v1.HotplugVolumeSource{
+				DataVolume: &v1.DataVolumeSource{Name: installerClaim},
+			},
+		})
+		newApp(featuregate.DeclarativeHotplugVolumesGate).VMChangeMediaRequestHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+		Expect(*volumes).To(HaveLen(len(vm.Spec.Template.Spec.Volumes) + 1))
+		Expect(*volumes).To(ContainElement(v1.Volume{
+			Name: emptyCDRom,
+			VolumeSource: v1.VolumeSource{
+				DataVolume: &v1.DataVolumeSource{Name: installerClaim, Hotpluggable: true},
+			},
+		}))
+	})
+
+	It("should swap the media of a CD-ROM and propagate dry run", func() {
+		volumes := expectPatchedVolumes([]string{metav1.DryRunAll})
+		newChangeMediaBody(&v1.ChangeMediaOptions{
+			Name: insertedCDRom,
+			VolumeSource: &v1.HotplugVolumeSource{
+				PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+					PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: installerClaim},
+				},
+			},
+			DryRun: []string{metav1.DryRunAll},
+		})
+		newApp(featuregate.DeclarativeHotplugVolumesGate).VMChangeMediaRequestHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+		Expect(*volumes).To(HaveLen(len(vm.Spec.Template.Spec.Volumes)))
+		Expect(*volumes).To(ContainElement(hotplugPVCVolume(insertedCDRom, installerClaim)))
+		Expect(*volumes).ToNot(ContainElement(hotplugPVCVolume(insertedCDRom, insertedClaim)))
+	})
+
+	It("should eject the media of a CD-ROM", func() {
+		volumes := expectPatchedVolumes(nil)
+		newChangeMediaBody(&v1.ChangeMediaOptions{Name: insertedCDRom})
+		newApp(featuregate.DeclarativeHotplugVolumesGate).VMChangeMediaRequestHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+		Expect(*volumes).To(HaveLen(len(vm.Spec.Template.Spec.Volumes) - 1))
+		for _, volume := range *volumes {
+			Expect(volume.Name).ToNot(Equal(insertedCDRom))
+		}
+	})
+
+	DescribeTable("should refuse to change the media", func(opts *v1.ChangeMediaOptions) {
+		newChangeMediaBody(opts)
+		newApp(featuregate.DeclarativeHotplugVolumesGate).VMChangeMediaRequestHandler(request, response)
+		ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+	},
+		Entry("of a missing disk", &v1.ChangeMediaOptions{Name: "missing"}),
+		Entry("of a disk which is not a CD-ROM", &v1.ChangeMediaOptions{Name: rootDisk}),
+		Entry("of a CD-ROM on the virtio bus", &v1.ChangeMediaOptions{
+			Name: virtioCDRom,
+			VolumeSource: &v1.HotplugVolumeSource{
+				DataVolume: &v1.DataVolumeSource{Name: installerClaim},
+			},
+		}),
+		Entry("of a CD-ROM with a volume which is not hotpluggable", &v1.ChangeMediaOptions{Name: coldplugCDRom}),
+		Entry("when ejecting an empty CD-ROM", &v1.ChangeMediaOptions{Name: emptyCDRom}),
+		Entry("when the media is already used by another volume", &v1.ChangeMediaOptions{
+			Name: emptyCDRom,
+			VolumeSource: &v1.HotplugVolumeSource{
+				DataVolume: &v1.DataVolumeSource{Name: insertedClaim},
+			},
+		}),
+	)
+})
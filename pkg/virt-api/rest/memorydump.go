@@ -22,6 +22,7 @@ package rest
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/emicklei/go-restful/v3"
@@ -32,6 +33,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
@@ -47,6 +49,21 @@ const (
 	memoryDumpNameConflictErr = "can't request memory dump for pvc [%s] while pvc [%s] is still associated as the memory dump pvc"
 )
 
+var (
+	// memoryDumpRequestHeaders are passed to virt-handler to allow resuming downloads
+	memoryDumpRequestHeaders = []string{"Range", "If-Range"}
+	// memoryDumpResponseHeaders are passed back from virt-handler to the client
+	memoryDumpResponseHeaders = []string{
+		"Accept-Ranges",
+		"Content-Disposition",
+		"Content-Length",
+		"Content-Range",
+		"Content-Type",
+		"Last-Modified",
+		"Retry-After",
+	}
+)
+
 func (app *SubresourceAPIApp) fetchPersistentVolumeClaim(name string, namespace string) (*k8sv1.PersistentVolumeClaim, *errors.StatusError) {
 	pvc, err := app.virtCli.CoreV1().PersistentVolumeClaims(namespace).Get(context.TODO(), name, metav1.GetOptions{})
 	if err != nil {
@@ -263,3 +280,57 @@ func generateVMMemoryDumpRequestPatch(vm *v1.VirtualMachine, memoryDumpReq *v1.V
 
 	return patchSet.GeneratePayload()
 }
+
+// MemoryDumpVMIRequestHandler proxies memory dump requests of a VMI to virt-handler.
+// PUT takes a new memory dump into the ephemeral storage of the virt-launcher pod,
+// GET streams it back to the client and DELETE removes it. No PVC is required.
+func (app *SubresourceAPIApp) MemoryDumpVMIRequestHandler(request *restful.Request, response *restful.Response) {
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if !vmi.IsRunning() {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+		}
+		return nil
+	}
+
+	vmi, statErr := app.fetchAndValidateVirtualMachineInstance(request.PathParameter("namespace"), request.PathParameter("name"), validate)
+	if statErr != nil {
+		writeError(statErr, response)
+		return
+	}
+
+	conn := kubecli.NewVirtHandlerClient(app.virtCli, app.handlerStreamHttpClient).Port(app.consoleServerPort).ForNode(vmi.Status.NodeName)
+	url, err := conn.MemoryDumpURI(vmi)
+	if err != nil {
+		writeError(errors.NewBadRequest(err.Error()), response)
+		return
+	}
+
+	handlerRequest, err := http.NewRequestWithContext(request.Request.Context(), request.Request.Method, url, nil)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	for _, header := range memoryDumpRequestHeaders {
+		if value := request.HeaderParameter(header); value != "" {
+			handlerRequest.Header.Set(header, value)
+		}
+	}
+
+	handlerResponse, err := conn.Do(handlerRequest)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to proxy memory dump request")
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	defer handlerResponse.Body.Close()
+
+	for _, header := range memoryDumpResponseHeaders {
+		if value := handlerResponse.Header.Get(header); value != "" {
+			response.AddHeader(header, value)
+		}
+	}
+	response.WriteHeader(handlerResponse.StatusCode)
+	if _, err := io.Copy(response, handlerResponse.Body); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to stream memory dump")
+	}
+}
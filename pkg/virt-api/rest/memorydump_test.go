@@ -69,6 +69,8 @@ var _ = Describe("Memory dump Subresource api", func() {
 	var (
 		request    *restful.Request
 		response   *restful.Response
+		recorder   *httptest.ResponseRecorder
+		backend    *ghttp.Server
 		kubeClient *fake.Clientset
 		virtClient *kubecli.MockKubevirtClient
 		vmClient   *kubecli.MockVirtualMachineInterface
@@ -125,10 +127,10 @@ var _ = Describe("Memory dump Subresource api", func() {
 		request = restful.NewRequest(&http.Request{})
 		request.PathParameters()["name"] = testVMName
 		request.PathParameters()["namespace"] = metav1.NamespaceDefault
-		recorder := httptest.NewRecorder()
+		recorder = httptest.NewRecorder()
 		response = restful.NewResponse(recorder)
 
-		backend := ghttp.NewTLSServer()
+		backend = ghttp.NewTLSServer()
 		backendAddr := strings.Split(backend.Addr(), ":")
 		backendPort, err := strconv.Atoi(backendAddr[1])
 		Expect(err).ToNot(HaveOccurred())
@@ -468,4 +470,106 @@ var _ = Describe("Memory dump Subresource api", func() {
 			),
 			false, true),
 	)
+
+	Context("VMI memory dump stream", func() {
+		const memoryDumpURL = "/v1/namespaces/default/virtualmachineinstances/testvmi/memorydump"
+
+		expectVMI := func(phase v1.VirtualMachineInstancePhase) {
+			request.PathParameters()["name"] = testVMIName
+			vmi := libvmi.New(
+				libvmi.WithName(testVMIName),
+				libvmi.WithNamespace(metav1.NamespaceDefault),
+				libvmistatus.WithStatus(libvmistatus.New(libvmistatus.WithPhase(phase))),
+			)
+			vmiClient.EXPECT().Get(context.Background(), testVMIName, metav1.GetOptions{}).Return(vmi, nil)
+		}
+
+		expectHandlerPod := func() {
+			pod := k8sv1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "virt-handler",
+					Labels: map[string]string{v1.AppLabel: "virt-handler"},
+				},
+				Status: k8sv1.PodStatus{
+					Phase: k8sv1.PodRunning,
+					PodIP: strings.Split(backend.Addr(), ":")[0],
+				},
+			}
+			kubeClient.Fake.PrependReactor("list", "pods", func(action testing.Action) (bool, runtime.Object, error) {
+				return true, &k8sv1.PodList{Items: []k8sv1.Pod{pod}}, nil
+			})
+		}
+
+		DescribeTable("should proxy the request to virt-handler", func(method string, statusCode int) {
+			request.Request.Method = method
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(method, memoryDumpURL),
+					ghttp.RespondWith(statusCode, ""),
+				),
+			)
+			expectVMI(v1.Running)
+			expectHandlerPod()
+
+			app.MemoryDumpVMIRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(statusCode))
+		},
+			Entry("when starting a memory dump", http.MethodPut, http.StatusAccepted),
+			Entry("when removing the memory dump", http.MethodDelete, http.StatusOK),
+			Entry("when no memory dump exists", http.MethodGet, http.StatusNotFound),
+		)
+
+		It("should stream the memory dump and forward range headers", func() {
+			request.Request.Method = http.MethodGet
+			request.Request.Header = http.Header{"Range": []string{"bytes=4-"}}
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, memoryDumpURL),
+					ghttp.VerifyHeaderKV("Range", "bytes=4-"),
+					ghttp.RespondWith(http.StatusPartialContent, "dump", http.Header{
+						"Content-Range": []string{"bytes 4-7/8"},
+						"Content-Type":  []string{"application/octet-stream"},
+						"X-Internal":    []string{"dropped"},
+					}),
+				),
+			)
+			expectVMI(v1.Running)
+			expectHandlerPod()
+
+			app.MemoryDumpVMIRequestHandler(request, response)
+
+			Expect(recorder.Code).To(Equal(http.StatusPartialContent))
+			Expect(recorder.Body.String()).To(Equal("dump"))
+			Expect(recorder.Header().Get("Content-Range")).To(Equal("bytes 4-7/8"))
+			Expect(recorder.Header().Get("Content-Type")).To(Equal("application/octet-stream"))
+			Expect(recorder.Header().Get("X-Internal")).To(BeEmpty())
+		})
+
+		It("should pass through the retry hint while the memory dump is in progress", func() {
+			request.Request.Method = http.MethodGet
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, memoryDumpURL),
+					ghttp.RespondWith(http.StatusConflict, "", http.Header{"Retry-After": []string{"5"}}),
+				),
+			)
+			expectVMI(v1.Running)
+			expectHandlerPod()
+
+			app.MemoryDumpVMIRequestHandler(request, response)
+
+			Expect(recorder.Code).To(Equal(http.StatusConflict))
+			Expect(recorder.Header().Get("Retry-After")).To(Equal("5"))
+		})
+
+		It("should fail if the VMI is not running", func() {
+			request.Request.Method = http.MethodGet
+			expectVMI(v1.Succeeded)
+
+			app.MemoryDumpVMIRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusConflict))
+		})
+	})
 })
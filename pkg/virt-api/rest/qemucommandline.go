@@ -0,0 +1,55 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"fmt"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+)
+
+const qemuCommandLineNotEnabledError = "Enable QemuCommandLine feature gate to use this API."
+
+// QemuCommandLineRequestHandler handles the subresource returning the command line the QEMU process of a VMI
+// was started with, so that its device and netdev arguments can be debugged without accessing the node.
+func (app *SubresourceAPIApp) QemuCommandLineRequestHandler(request *restful.Request, response *restful.Response) {
+	if !app.clusterConfig.QemuCommandLineEnabled() {
+		writeError(errors.NewBadRequest(qemuCommandLineNotEnabledError), response)
+		return
+	}
+
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if vmi.Status.Phase != v1.Running {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+		}
+		return nil
+	}
+
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.QemuCommandLineURI(vmi)
+	}
+
+	app.httpGetRequestHandler(request, response, validate, getURL, v1.VirtualMachineInstanceQemuCommandLine{})
+}
@@ -0,0 +1,160 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+
+	"github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	"go.uber.org/mock/gomock"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("QEMU Command Line Subresource", func() {
+	const (
+		nodeName            = "mynode"
+		qemuCommandLinePath = "/v1/namespaces/default/virtualmachineinstances/testvmi/qemucommandline"
+	)
+
+	var (
+		backend     *ghttp.Server
+		backendPort int
+		recorder    *httptest.ResponseRecorder
+		request     *restful.Request
+		response    *restful.Response
+		virtClient  *kubecli.MockKubevirtClient
+	)
+
+	newApp := func(featureGates ...string) *SubresourceAPIApp {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{
+				FeatureGates: featureGates,
+			},
+		})
+		return NewSubresourceAPIApp(virtClient, backendPort, &tls.Config{InsecureSkipVerify: true}, config)
+	}
+
+	createVMI := func(phase v1.VirtualMachineInstancePhase) {
+		vmi := libvmi.New(
+			libvmi.WithName(testVMIName),
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmistatus.WithStatus(libvmistatus.New(
+				libvmistatus.WithPhase(phase),
+				libvmistatus.WithNodeName(nodeName),
+			)),
+		)
+		_, err := virtClient.VirtualMachineInstance(metav1.NamespaceDefault).Create(context.Background(), vmi, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		request = restful.NewRequest(&http.Request{Header: http.Header{}})
+		request.PathParameters()["name"] = testVMIName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+
+		backend = ghttp.NewTLSServer()
+		backendAddr := strings.Split(backend.Addr(), ":")
+		var err error
+		backendPort, err = strconv.Atoi(backendAddr[1])
+		Expect(err).ToNot(HaveOccurred())
+
+		kubeClient := fake.NewSimpleClientset(&k8sv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "virt-handler",
+				Namespace: "kubevirt",
+				Labels:    map[string]string{v1.AppLabel: "virt-handler"},
+			},
+			Spec: k8sv1.PodSpec{
+				NodeName: nodeName,
+			},
+			Status: k8sv1.PodStatus{
+				Phase: k8sv1.PodRunning,
+				PodIP: backendAddr[0],
+			},
+		})
+		kubevirtClient := kubevirtfake.NewSimpleClientset()
+
+		virtClient = kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(kubevirtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault)).AnyTimes()
+	})
+
+	AfterEach(func() {
+		backend.Close()
+	})
+
+	It("should fail when the QemuCommandLine feature gate is disabled", func() {
+		createVMI(v1.Running)
+		newApp().QemuCommandLineRequestHandler(request, response)
+		ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		Expect(backend.ReceivedRequests()).To(BeEmpty())
+	})
+
+	It("should fail when the VMI is not running", func() {
+		createVMI(v1.Scheduled)
+		newApp(featuregate.QemuCommandLine).QemuCommandLineRequestHandler(request, response)
+		Expect(response.Error()).To(MatchError(ContainSubstring(vmiNotRunning)))
+		Expect(backend.ReceivedRequests()).To(BeEmpty())
+	})
+
+	It("should return the command line reported by virt-handler", func() {
+		commandLine := v1.VirtualMachineInstanceQemuCommandLine{
+			Args: []string{"/usr/libexec/qemu-kvm", "-name", "guest=default_testvmi", "-netdev", `{"type":"vhost-vdpa","vhostdev":"/dev/vhost-vdpa-0","id":"hostua-default"}`},
+		}
+		backend.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, qemuCommandLinePath),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, commandLine),
+			),
+		)
+		createVMI(v1.Running)
+		response.SetRequestAccepts(restful.MIME_JSON)
+
+		newApp(featuregate.QemuCommandLine).QemuCommandLineRequestHandler(request, response)
+		Expect(response.Error()).ToNot(HaveOccurred())
+		Expect(response.StatusCode()).To(Equal(http.StatusOK))
+
+		result := v1.VirtualMachineInstanceQemuCommandLine{}
+		Expect(json.NewDecoder(recorder.Body).Decode(&result)).To(Succeed())
+		Expect(result.Args).To(Equal(commandLine.Args))
+	})
+})
@@ -0,0 +1,175 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package rest
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"golang.org/x/time/rate"
+	"k8s.io/apimachinery/pkg/api/equality"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	laneInteractive = "interactive"
+	laneMigration   = "migration"
+	laneLifecycle   = "lifecycle"
+
+	// Idle buckets are pruned once the limiter tracks more buckets than this
+	maxTrackedRateLimitBuckets = 4096
+
+	// URL example
+	// /apis/subresources.kubevirt.io/v1/namespaces/default/virtualmachineinstances/testvmi/console
+	subresourcePathMinParts = 9
+)
+
+var interactiveSubresources = map[string]struct{}{
+	"console":     {},
+	"vnc":         {},
+	"usbredir":    {},
+	"portforward": {},
+	"vsock":       {},
+	"channel":     {},
+	"spice":       {},
+}
+
+var migrationSubresources = map[string]struct{}{
+	"migrate":  {},
+	"evacuate": {},
+}
+
+type rateLimitsSource interface {
+	GetSubresourceRateLimits() *v1.SubresourceRateLimits
+}
+
+// SubresourceRateLimiter throttles VM and VMI subresource calls per user and per namespace.
+// Interactive sessions are never throttled, and migration related calls are accounted
+// apart from the other lifecycle calls, so bulk automation cannot starve either of them.
+type SubresourceRateLimiter struct {
+	limitsSource rateLimitsSource
+	userHeaders  func() []string
+
+	lock    sync.Mutex
+	limits  *v1.SubresourceRateLimits
+	buckets map[string]*rate.Limiter
+}
+
+func NewSubresourceRateLimiter(limitsSource rateLimitsSource, userHeaders func() []string) *SubresourceRateLimiter {
+	return &SubresourceRateLimiter{
+		limitsSource: limitsSource,
+		userHeaders:  userHeaders,
+		buckets:      map[string]*rate.Limiter{},
+	}
+}
+
+func (l *SubresourceRateLimiter) Filter(req *restful.Request, resp *restful.Response, chain *restful.FilterChain) {
+	if !l.allow(req.Request) {
+		resp.AddHeader("Retry-After", "1")
+		resp.WriteErrorString(http.StatusTooManyRequests, "too many subresource requests, please retry later")
+		return
+	}
+	chain.ProcessFilter(req, resp)
+}
+
+func (l *SubresourceRateLimiter) allow(req *http.Request) bool {
+	limits := l.limitsSource.GetSubresourceRateLimits()
+	if limits == nil {
+		return true
+	}
+
+	namespace, lane := classifySubresourceRequest(req.URL.Path)
+	if lane == laneInteractive {
+		return true
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if !equality.Semantic.DeepEqual(limits, l.limits) {
+		l.limits = limits.DeepCopy()
+		l.buckets = map[string]*rate.Limiter{}
+	}
+	if len(l.buckets) > maxTrackedRateLimitBuckets {
+		l.pruneIdleBuckets()
+	}
+
+	if user := l.userName(req.Header); user != "" && !l.take(lane+"/user/"+user, limits.PerUser) {
+		log.Log.V(3).Infof("throttling %s subresource call of user %s", lane, user)
+		return false
+	}
+	if !l.take(lane+"/namespace/"+namespace, limits.PerNamespace) {
+		log.Log.V(3).Infof("throttling %s subresource call in namespace %s", lane, namespace)
+		return false
+	}
+	return true
+}
+
+func (l *SubresourceRateLimiter) take(key string, limit *v1.TokenBucketRateLimiter) bool {
+	if limit == nil || limit.QPS <= 0 {
+		return true
+	}
+	bucket, exists := l.buckets[key]
+	if !exists {
+		bucket = rate.NewLimiter(rate.Limit(limit.QPS), max(limit.Burst, 1))
+		l.buckets[key] = bucket
+	}
+	return bucket.Allow()
+}
+
+// pruneIdleBuckets drops the buckets which refilled completely, tracking them is equivalent to starting over
+func (l *SubresourceRateLimiter) pruneIdleBuckets() {
+	for key, bucket := range l.buckets {
+		if bucket.Tokens() >= float64(bucket.Burst()) {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+func (l *SubresourceRateLimiter) userName(header http.Header) string {
+	for _, key := range l.userHeaders() {
+		if user, ok := header[key]; ok && len(user) > 0 {
+			return user[0]
+		}
+	}
+	return ""
+}
+
+// classifySubresourceRequest returns the namespace targeted by a subresource request and its lane.
+// Requests which do not target a VM or VMI subresource are considered interactive.
+func classifySubresourceRequest(path string) (string, string) {
+	pathSplit := strings.Split(path, "/")
+	if len(pathSplit) < subresourcePathMinParts || pathSplit[2] != v1.SubresourceGroupName || pathSplit[4] != "namespaces" {
+		return "", laneInteractive
+	}
+	namespace := pathSplit[5]
+	subresource := pathSplit[8]
+
+	if _, isInteractive := interactiveSubresources[subresource]; isInteractive {
+		return namespace, laneInteractive
+	}
+	if _, isMigration := migrationSubresources[subresource]; isMigration {
+		return namespace, laneMigration
+	}
+	return namespace, laneLifecycle
+}
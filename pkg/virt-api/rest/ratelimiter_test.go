@@ -0,0 +1,152 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	restful "github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+type fakeRateLimitsSource struct {
+	limits *v1.SubresourceRateLimits
+}
+
+func (f *fakeRateLimitsSource) GetSubresourceRateLimits() *v1.SubresourceRateLimits {
+	return f.limits
+}
+
+var _ = Describe("Subresource rate limiter", func() {
+	const userHeader = "X-Remote-User"
+
+	var source *fakeRateLimitsSource
+	var limiter *SubresourceRateLimiter
+
+	newRequest := func(user, namespace, kind, subresource string) *http.Request {
+		req := httptest.NewRequest(http.MethodPut,
+			"/apis/subresources.kubevirt.io/v1/namespaces/"+namespace+"/"+kind+"/testvm/"+subresource, nil)
+		if user != "" {
+			req.Header.Set(userHeader, user)
+		}
+		return req
+	}
+
+	singleShot := func() *v1.TokenBucketRateLimiter {
+		return &v1.TokenBucketRateLimiter{QPS: 0.0001, Burst: 1}
+	}
+
+	BeforeEach(func() {
+		source = &fakeRateLimitsSource{}
+		limiter = NewSubresourceRateLimiter(source, func() []string { return []string{userHeader} })
+	})
+
+	It("should allow every request when no limits are configured", func() {
+		for i := 0; i < 10; i++ {
+			Expect(limiter.allow(newRequest("alice", "default", "virtualmachines", "restart"))).To(BeTrue())
+		}
+	})
+
+	It("should throttle a user once the burst is consumed", func() {
+		source.limits = &v1.SubresourceRateLimits{PerUser: singleShot()}
+
+		Expect(limiter.allow(newRequest("alice", "default", "virtualmachines", "restart"))).To(BeTrue())
+		Expect(limiter.allow(newRequest("alice", "other", "virtualmachines", "stop"))).To(BeFalse())
+		Expect(limiter.allow(newRequest("bob", "default", "virtualmachines", "restart"))).To(BeTrue())
+	})
+
+	It("should throttle a namespace once the burst is consumed", func() {
+		source.limits = &v1.SubresourceRateLimits{PerNamespace: singleShot()}
+
+		Expect(limiter.allow(newRequest("alice", "default", "virtualmachineinstances", "pause"))).To(BeTrue())
+		Expect(limiter.allow(newRequest("bob", "default", "virtualmachineinstances", "unpause"))).To(BeFalse())
+		Expect(limiter.allow(newRequest("bob", "other", "virtualmachineinstances", "unpause"))).To(BeTrue())
+	})
+
+	It("should never throttle interactive subresources", func() {
+		source.limits = &v1.SubresourceRateLimits{PerUser: singleShot(), PerNamespace: singleShot()}
+
+		for i := 0; i < 10; i++ {
+			Expect(limiter.allow(newRequest("alice", "default", "virtualmachineinstances", "console"))).To(BeTrue())
+			Expect(limiter.allow(newRequest("alice", "default", "virtualmachineinstances", "vnc"))).To(BeTrue())
+		}
+	})
+
+	It("should never throttle requests which do not target a subresource", func() {
+		source.limits = &v1.SubresourceRateLimits{PerUser: singleShot(), PerNamespace: singleShot()}
+
+		for i := 0; i < 10; i++ {
+			Expect(limiter.allow(httptest.NewRequest(http.MethodGet, "/apis/subresources.kubevirt.io/v1/healthz", nil))).To(BeTrue())
+		}
+	})
+
+	It("should account migrations apart from other lifecycle calls", func() {
+		source.limits = &v1.SubresourceRateLimits{PerUser: singleShot()}
+
+		Expect(limiter.allow(newRequest("alice", "default", "virtualmachines", "start"))).To(BeTrue())
+		Expect(limiter.allow(newRequest("alice", "default", "virtualmachines", "start"))).To(BeFalse())
+		Expect(limiter.allow(newRequest("alice", "default", "virtualmachines", "migrate"))).To(BeTrue())
+		Expect(limiter.allow(newRequest("alice", "default", "virtualmachineinstances", "evacuate"))).To(BeFalse())
+	})
+
+	It("should not throttle when the QPS is not positive", func() {
+		source.limits = &v1.SubresourceRateLimits{PerUser: &v1.TokenBucketRateLimiter{QPS: 0, Burst: 1}}
+
+		for i := 0; i < 10; i++ {
+			Expect(limiter.allow(newRequest("alice", "default", "virtualmachines", "restart"))).To(BeTrue())
+		}
+	})
+
+	It("should reset the buckets when the limits change", func() {
+		source.limits = &v1.SubresourceRateLimits{PerUser: singleShot()}
+
+		Expect(limiter.allow(newRequest("alice", "default", "virtualmachines", "restart"))).To(BeTrue())
+		Expect(limiter.allow(newRequest("alice", "default", "virtualmachines", "restart"))).To(BeFalse())
+
+		source.limits = &v1.SubresourceRateLimits{PerUser: &v1.TokenBucketRateLimiter{QPS: 0.0001, Burst: 2}}
+		Expect(limiter.allow(newRequest("alice", "default", "virtualmachines", "restart"))).To(BeTrue())
+		Expect(limiter.allow(newRequest("alice", "default", "virtualmachines", "restart"))).To(BeTrue())
+		Expect(limiter.allow(newRequest("alice", "default", "virtualmachines", "restart"))).To(BeFalse())
+	})
+
+	It("should reply with too many requests and a retry hint when throttling", func() {
+		source.limits = &v1.SubresourceRateLimits{PerUser: singleShot()}
+
+		serve := func() *httptest.ResponseRecorder {
+			recorder := httptest.NewRecorder()
+			request := restful.NewRequest(newRequest("alice", "default", "virtualmachines", "restart"))
+			response := restful.NewResponse(recorder)
+			chain := &restful.FilterChain{Target: func(_ *restful.Request, resp *restful.Response) {
+				resp.WriteHeader(http.StatusAccepted)
+			}}
+			limiter.Filter(request, response, chain)
+			return recorder
+		}
+
+		Expect(serve().Code).To(Equal(http.StatusAccepted))
+		recorder := serve()
+		Expect(recorder.Code).To(Equal(http.StatusTooManyRequests))
+		Expect(recorder.Header().Get("Retry-After")).To(Equal("1"))
+	})
+})
@@ -29,6 +29,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
@@ -36,6 +37,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/controller"
 	kutil "kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 )
 
@@ -82,7 +84,7 @@ func (app *SubresourceAPIApp) SEVQueryLaunchMeasurementHandler(request *restful.
 		return conn.SEVQueryLaunchMeasurementURI(vmi)
 	}
 
-	app.httpGetRequestHandler(request, response, validateVMIForSEVAttestation, getURL, v1.SEVMeasurementInfo{})
+	app.httpGetRequestHandler(request, response, validateVMIForLaunchMeasurement, getURL, v1.SEVMeasurementInfo{})
 }
 
 func (app *SubresourceAPIApp) SEVSetupSessionHandler(request *restful.Request, response *restful.Response) {
@@ -153,6 +155,81 @@ func (app *SubresourceAPIApp) SEVSetupSessionHandler(request *restful.Request, r
 	response.WriteHeader(http.StatusAccepted)
 }
 
+func (app *SubresourceAPIApp) SEVSetupSNPSessionHandler(request *restful.Request, response *restful.Response) {
+	if !app.ensureSEVEnabled(response) {
+		return
+	}
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body: SEV-SNP launch parameters are required"), response)
+		return
+	}
+
+	opts := &v1.SEVSNPSessionOptions{}
+	if err := decodeBody(request, opts); err != nil {
+		writeError(err, response)
+		return
+	}
+
+	if opts.IDBlock == "" {
+		writeError(errors.NewBadRequest("ID block is required"), response)
+		return
+	}
+
+	if opts.IDAuth == "" {
+		writeError(errors.NewBadRequest("ID authentication information is required"), response)
+		return
+	}
+
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if !vmi.IsScheduled() {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("VMI is not in %s phase", v1.Scheduled))
+		}
+		if !kutil.IsSEVSNPAttestationRequested(vmi) {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNoAttestationErr))
+		}
+		snp := vmi.Spec.Domain.LaunchSecurity.SNP
+		if snp.IDBlock != "" || snp.IDAuth != "" {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("Session already defined"))
+		}
+		return nil
+	}
+
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+	vmi, statusError := app.fetchAndValidateVirtualMachineInstance(namespace, name, validate)
+	if statusError != nil {
+		writeError(statusError, response)
+		return
+	}
+
+	oldSNP := vmi.Spec.Domain.LaunchSecurity.SNP
+	newSNP := oldSNP.DeepCopy()
+	newSNP.IDBlock = opts.IDBlock
+	newSNP.IDAuth = opts.IDAuth
+	if opts.HostData != "" {
+		newSNP.HostData = opts.HostData
+	}
+	if causes := webhooks.ValidateSEVSNPLaunchParameters(k8sfield.NewPath("spec", "domain", "launchSecurity", "snp"), newSNP); len(causes) > 0 {
+		writeError(errors.NewBadRequest(causes[0].Message), response)
+		return
+	}
+	patch, err := patch.GenerateTestReplacePatch("/spec/domain/launchSecurity/snp", oldSNP, newSNP)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	log.Log.Object(vmi).Infof("Patching vmi: %s", string(patch))
+	if _, err := app.virtCli.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, patch, metav1.PatchOptions{}); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to patch vmi")
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
 func (app *SubresourceAPIApp) SEVInjectLaunchSecretHandler(request *restful.Request, response *restful.Response) {
 	if !app.ensureSEVEnabled(response) {
 		return
@@ -163,11 +240,19 @@ func (app *SubresourceAPIApp) SEVInjectLaunchSecretHandler(request *restful.Requ
 		return
 	}
 
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if kutil.IsSEVSNPVMI(vmi) {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name,
+				fmt.Errorf("Launch secret injection is not supported with SEV-SNP, secrets are released to the guest on the verification of its attestation report"))
+		}
+		return validateVMIForSEVAttestation(vmi)
+	}
+
 	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
 		return conn.SEVInjectLaunchSecretURI(vmi)
 	}
 
-	app.putRequestHandler(request, response, validateVMIForSEVAttestation, getURL, false)
+	app.putRequestHandler(request, response, validate, getURL, false)
 }
 
 // Validate a VMI for SEV attestation: Running, Paused and with Attestation requested.
@@ -184,3 +269,15 @@ func validateVMIForSEVAttestation(vmi *v1.VirtualMachineInstance) *errors.Status
 	}
 	return nil
 }
+
+// Validate a VMI for the launch measurement query: a SEV-SNP guest is not paused, as its
+// measurement is enforced on launch through its ID block.
+func validateVMIForLaunchMeasurement(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+	if !kutil.IsSEVSNPAttestationRequested(vmi) {
+		return validateVMIForSEVAttestation(vmi)
+	}
+	if !vmi.IsRunning() {
+		return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+	}
+	return nil
+}
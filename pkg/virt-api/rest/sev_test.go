@@ -23,6 +23,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
+	"encoding/base64"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -234,6 +235,80 @@ var _ = Describe("SEV Subresources", func() {
 		})))
 	})
 
+	It("Should allow to query launch measurement when a SEV-SNP VMI is running", func() {
+		backend.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/v1/namespaces/default/virtualmachineinstances/testvmi/sev/querylaunchmeasurement"),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, v1.SEVMeasurementInfo{}),
+			),
+		)
+		response.SetRequestAccepts(restful.MIME_JSON)
+
+		createVMI(Running, UnPaused, []libvmi.Option{libvmi.WithSEV(false, true), libvmi.WithSEVSNPAttestation()}, nil)
+		app.SEVQueryLaunchMeasurementHandler(request, response)
+		Expect(response.Error()).ToNot(HaveOccurred())
+		Expect(response.StatusCode()).To(Equal(http.StatusOK))
+	})
+
+	It("Should allow to setup SEV-SNP launch parameters for a scheduled VMI", func() {
+		snpSessionOptions := &v1.SEVSNPSessionOptions{
+			IDBlock:  base64.StdEncoding.EncodeToString(make([]byte, 96)),
+			IDAuth:   base64.StdEncoding.EncodeToString(make([]byte, 4096)),
+			HostData: base64.StdEncoding.EncodeToString(make([]byte, 32)),
+		}
+		body, err := json.Marshal(snpSessionOptions)
+		Expect(err).ToNot(HaveOccurred())
+		request.Request.Body = &readCloserWrapper{bytes.NewReader(body)}
+
+		createVMI(NotRunning, UnPaused, []libvmi.Option{libvmi.WithSEV(false, true), libvmi.WithSEVSNPAttestation()}, []libvmistatus.Option{libvmistatus.WithPhase(v1.Scheduled)})
+
+		app.SEVSetupSNPSessionHandler(request, response)
+		Expect(response.Error()).ToNot(HaveOccurred())
+		Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+		updatedVMI, err := virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), testVMIName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(updatedVMI.Spec.Domain.LaunchSecurity.SNP).To(gstruct.PointTo(gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+			"IDBlock":  Equal(snpSessionOptions.IDBlock),
+			"IDAuth":   Equal(snpSessionOptions.IDAuth),
+			"HostData": Equal(snpSessionOptions.HostData),
+		})))
+	})
+
+	DescribeTable("Should fail to setup SEV-SNP launch parameters",
+		func(snpSessionOptions *v1.SEVSNPSessionOptions, expectedStatus int, option ...libvmi.Option) {
+			body, err := json.Marshal(snpSessionOptions)
+			Expect(err).ToNot(HaveOccurred())
+			request.Request.Body = &readCloserWrapper{bytes.NewReader(body)}
+
+			createVMI(NotRunning, UnPaused, append([]libvmi.Option{libvmi.WithSEV(false, true)}, option...), []libvmistatus.Option{libvmistatus.WithPhase(v1.Scheduled)})
+
+			app.SEVSetupSNPSessionHandler(request, response)
+			Expect(response.StatusCode()).To(Equal(expectedStatus))
+		},
+		Entry("when the ID authentication information is missing", &v1.SEVSNPSessionOptions{
+			IDBlock: base64.StdEncoding.EncodeToString(make([]byte, 96)),
+		}, http.StatusBadRequest, libvmi.WithSEVSNPAttestation()),
+		Entry("when the ID block has the wrong size", &v1.SEVSNPSessionOptions{
+			IDBlock: base64.StdEncoding.EncodeToString(make([]byte, 64)),
+			IDAuth:  base64.StdEncoding.EncodeToString(make([]byte, 4096)),
+		}, http.StatusBadRequest, libvmi.WithSEVSNPAttestation()),
+		Entry("when attestation is not requested", &v1.SEVSNPSessionOptions{
+			IDBlock: base64.StdEncoding.EncodeToString(make([]byte, 96)),
+			IDAuth:  base64.StdEncoding.EncodeToString(make([]byte, 4096)),
+		}, http.StatusConflict),
+	)
+
+	It("Should fail to inject a launch secret into a SEV-SNP VMI", func() {
+		body, err := json.Marshal(&v1.SEVSecretOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		request.Request.Body = &readCloserWrapper{bytes.NewReader(body)}
+
+		createVMI(Running, Paused, []libvmi.Option{libvmi.WithSEV(false, true), libvmi.WithSEVSNPAttestation()}, nil)
+
+		app.SEVInjectLaunchSecretHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusConflict))
+	})
+
 	It("Should allow to inject SEV launch secret into a paused VMI", func() {
 		backend.AppendHandlers(
 			ghttp.CombineHandlers(
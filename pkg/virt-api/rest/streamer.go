@@ -28,11 +28,13 @@ import (
 	restful "github.com/emicklei/go-restful/v3"
 	"github.com/gorilla/websocket"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/uuid"
 
 	v1 "kubevirt.io/api/core/v1"
 	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
 	"kubevirt.io/client-go/log"
 
+	"kubevirt.io/kubevirt/pkg/virt-api/consolerecording"
 	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
 )
 
@@ -50,6 +52,9 @@ type Streamer struct {
 	dialer          *DirectDialer
 	keepAliveClient func(ctx context.Context, conn *websocket.Conn, cancel func())
 
+	recorder    *consolerecording.Recorder
+	sessionType v1.ConsoleSessionType
+
 	streamToClient streamFunc
 	streamToServer streamFunc
 }
@@ -89,6 +94,13 @@ func NewWebsocketStreamer(fetch vmiFetcher, validate validator, dial dialer) *St
 	}
 }
 
+// WithRecording records the sessions of the given type selected by the console recording policies
+func (s *Streamer) WithRecording(recorder *consolerecording.Recorder, sessionType v1.ConsoleSessionType) *Streamer {
+	s.recorder = recorder
+	s.sessionType = sessionType
+	return s
+}
+
 func (s *Streamer) Handle(request *restful.Request, response *restful.Response) error {
 	namespace := request.PathParameter(definitions.NamespaceParamName)
 	name := request.PathParameter(definitions.NameParamName)
@@ -99,6 +111,27 @@ func (s *Streamer) Handle(request *restful.Request, response *restful.Response)
 		return statusErr
 	}
 
+	if s.recorder != nil {
+		session, err := s.recorder.Start(consolerecording.SessionInfo{
+			ID:        string(uuid.NewUUID()),
+			Type:      s.sessionType,
+			Namespace: namespace,
+			Name:      name,
+			User:      request.HeaderParameter(userHeader),
+			StartTime: time.Now(),
+		})
+		if err != nil {
+			serverConn.Close()
+			statusErr = errors.NewServiceUnavailable(err.Error())
+			writeError(statusErr, response)
+			return statusErr
+		}
+		if session != nil {
+			defer session.Close()
+			serverConn = session.Conn(serverConn)
+		}
+	}
+
 	clientConn, err := clientConnectionUpgrade(request, response)
 	if err != nil {
 		writeError(errors.NewBadRequest(err.Error()), response)
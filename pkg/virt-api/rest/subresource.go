@@ -28,6 +28,7 @@ import (
 	"time"
 
 	"github.com/emicklei/go-restful/v3"
+	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 
 	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -41,6 +42,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/instancetype/expand"
 	"kubevirt.io/kubevirt/pkg/instancetype/find"
 	preferenceFind "kubevirt.io/kubevirt/pkg/instancetype/preference/find"
+	"kubevirt.io/kubevirt/pkg/virt-api/consolerecording"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
@@ -73,18 +75,24 @@ type SubresourceAPIApp struct {
 	clusterConfig           *virtconfig.ClusterConfig
 	instancetypeExpander    instancetypeVMExpander
 	handlerHttpClient       *http.Client
+	handlerStreamHttpClient *http.Client
+	consoleRecorder         *consolerecording.Recorder
 }
 
 func NewSubresourceAPIApp(virtCli kubecli.KubevirtClient, consoleServerPort int, tlsConfiguration *tls.Config, clusterConfig *virtconfig.ClusterConfig) *SubresourceAPIApp {
 	// When this method is called from tools/openapispec.go when running 'make generate',
 	// the virtCli is nil, and accessing GeneratedKubeVirtClient() would cause nil dereference.
 	var instancetypeExpander instancetypeVMExpander
+	var consoleRecorder *consolerecording.Recorder
 	if virtCli != nil {
 		instancetypeExpander = expand.New(
 			clusterConfig,
 			find.NewSpecFinder(nil, nil, nil, virtCli),
 			preferenceFind.NewSpecFinder(nil, nil, nil, virtCli),
 		)
+		consoleRecorder = consolerecording.NewRecorder(clusterConfig.GetConsoleRecordingConfiguration, func(name string) (*k8sv1.Namespace, error) {
+			return virtCli.CoreV1().Namespaces().Get(context.Background(), name, k8smetav1.GetOptions{})
+		})
 	}
 
 	httpClient := &http.Client{
@@ -93,6 +101,14 @@ func NewSubresourceAPIApp(virtCli kubecli.KubevirtClient, consoleServerPort int,
 		},
 		Timeout: 10 * time.Second,
 	}
+	// Streamed responses like memory dumps can take much longer than regular
+	// requests, only bound the time until virt-handler starts responding
+	streamHttpClient := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig:       tlsConfiguration,
+			ResponseHeaderTimeout: 30 * time.Second,
+		},
+	}
 
 	return &SubresourceAPIApp{
 		virtCli:                 virtCli,
@@ -102,6 +118,8 @@ func NewSubresourceAPIApp(virtCli kubecli.KubevirtClient, consoleServerPort int,
 		clusterConfig:           clusterConfig,
 		instancetypeExpander:    instancetypeExpander,
 		handlerHttpClient:       httpClient,
+		handlerStreamHttpClient: streamHttpClient,
+		consoleRecorder:         consoleRecorder,
 	}
 }
 
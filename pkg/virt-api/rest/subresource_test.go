@@ -1254,6 +1254,43 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 		})
 	})
 
+	Context("NetworkResync", func() {
+		It("Should resync the network of a running VMI", func() {
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/v1/namespaces/default/virtualmachineinstances/testvmi/networkresync"),
+					ghttp.RespondWith(http.StatusOK, ""),
+				),
+			)
+
+			expectVMI(Running, UnPaused)
+
+			app.NetworkResyncVMIRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+		})
+
+		It("Should fail to resync the network of a not running VMI", func() {
+			expectVMI(NotRunning, UnPaused)
+
+			app.NetworkResyncVMIRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+
+		It("Should fail to resync the network of a migrating VMI", func() {
+			expectVMI(Running, UnPaused, func(vmi *v1.VirtualMachineInstance) {
+				vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+					StartTimestamp: pointer.P(k8smetav1.Now()),
+				}
+			})
+
+			app.NetworkResyncVMIRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+	})
+
 	Context("Pausing", func() {
 		DescribeTable("Should pause a running, not paused VMI according to options", func(pauseOptions *v1.PauseOptions, matchExpectation gomegatypes.GomegaMatcher) {
 
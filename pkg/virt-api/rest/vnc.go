@@ -58,7 +58,7 @@ func (app *SubresourceAPIApp) VNCRequestHandler(request *restful.Request, respon
 		app.virtHandlerDialer(func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
 			return conn.VNCURI(vmi, preserveSessionParam)
 		}),
-	)
+	).WithRecording(app.consoleRecorder, v1.ConsoleSessionVNC)
 
 	streamer.Handle(request, response)
 }
@@ -20,6 +20,7 @@
 package webhooks
 
 import (
+	"encoding/base64"
 	"fmt"
 	"slices"
 
@@ -76,6 +77,13 @@ func isOnlyI6300ESBWatchdog(watchdog *v1.Watchdog) bool {
 	return watchdog.WatchdogDevice.I6300ESB != nil && watchdog.WatchdogDevice.Diag288 == nil
 }
 
+const (
+	// Sizes of the SEV-SNP launch parameters as defined in AMD SEV-SNP API specification
+	sevSNPIDBlockSize  = 96
+	sevSNPIDAuthSize   = 4096
+	sevSNPHostDataSize = 32
+)
+
 func ValidateLaunchSecurityAmd64(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	launchSecurity := spec.Domain.LaunchSecurity
@@ -160,6 +168,10 @@ func ValidateLaunchSecurityAmd64(field *k8sfield.Path, spec *v1.VirtualMachineIn
 			}
 		}
 
+		if launchSecurity.SNP != nil {
+			causes = append(causes, ValidateSEVSNPLaunchParameters(field.Child("launchSecurity", "snp"), launchSecurity.SNP)...)
+		}
+
 		for _, iface := range spec.Domain.Devices.Interfaces {
 			if iface.BootOrder != nil {
 				causes = append(causes, metav1.StatusCause{
@@ -173,3 +185,46 @@ func ValidateLaunchSecurityAmd64(field *k8sfield.Path, spec *v1.VirtualMachineIn
 
 	return causes
 }
+
+// ValidateSEVSNPLaunchParameters validates the launch parameters the guest owner provides for a SEV-SNP guest
+func ValidateSEVSNPLaunchParameters(field *k8sfield.Path, snp *v1.SEVSNP) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if (snp.IDBlock == "") != (snp.IDAuth == "") {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "SEV-SNP ID block and ID authentication information must be provided together",
+			Field:   field.String(),
+		})
+	}
+
+	for _, param := range []struct {
+		name  string
+		value string
+		size  int
+	}{
+		{"idBlock", snp.IDBlock, sevSNPIDBlockSize},
+		{"idAuth", snp.IDAuth, sevSNPIDAuthSize},
+		{"hostData", snp.HostData, sevSNPHostDataSize},
+	} {
+		if param.value == "" {
+			continue
+		}
+		decoded, err := base64.StdEncoding.DecodeString(param.value)
+		if err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s is not base64 encoded: %v", param.name, err),
+				Field:   field.Child(param.name).String(),
+			})
+		} else if len(decoded) != param.size {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be %d bytes long, got %d", param.name, param.size, len(decoded)),
+				Field:   field.Child(param.name).String(),
+			})
+		}
+	}
+
+	return causes
+}
@@ -35,6 +35,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/defaults"
+	"kubevirt.io/kubevirt/pkg/network/macallocator"
 	kvpointer "kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/util"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
@@ -58,6 +59,12 @@ func ApplyNewVMIMutations(newVMI *v1.VirtualMachineInstance, clusterConfig *virt
 		return err
 	}
 
+	if err := allocateMACAddresses(clusterConfig.GetMacGenerationPolicy(), newVMI); err != nil {
+		return err
+	}
+
+	setDefaultNetworkInterfaceMultiQueuePolicy(clusterConfig.GetNetworkInterfaceMultiQueuePolicy(), newVMI)
+
 	if newVMI.Spec.Domain.CPU.IsolateEmulatorThread {
 		_, emulatorThreadCompleteToEvenParityAnnotationExists := clusterConfig.GetConfigFromKubeVirtCR().Annotations[v1.EmulatorThreadCompleteToEvenParity]
 		if emulatorThreadCompleteToEvenParityAnnotationExists && clusterConfig.AlignCPUsEnabled() {
@@ -180,3 +187,27 @@ func (mutator *VMIsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1
 func markAsNonroot(vmi *v1.VirtualMachineInstance) {
 	vmi.Status.RuntimeUser = 107
 }
+
+// allocateMACAddresses covers the standalone VMIs, as virt-controller allocates the MAC addresses of the VMIs of VMs
+// before creating them. The external allocator is left to virt-controller, to keep its webhook out of the admission.
+func allocateMACAddresses(policy *v1.MacGenerationPolicy, vmi *v1.VirtualMachineInstance) error {
+	if policy == nil || policy.Allocator == v1.MacAllocatorExternal {
+		return nil
+	}
+	allocator, err := macallocator.New(policy)
+	if err != nil {
+		return err
+	}
+	return macallocator.AllocateMACAddresses(allocator, vmi)
+}
+
+// setDefaultNetworkInterfaceMultiQueuePolicy persists the cluster wide policy on the multi-queue VMIs which do not
+// specify their own, so the converter and virt-handler agree on the number of queues for the VMI lifetime.
+func setDefaultNetworkInterfaceMultiQueuePolicy(policy *v1.NetworkQueuesPolicy, vmi *v1.VirtualMachineInstance) {
+	devices := &vmi.Spec.Domain.Devices
+	if policy == nil || devices.NetworkInterfaceMultiQueuePolicy != nil ||
+		devices.NetworkInterfaceMultiQueue == nil || !*devices.NetworkInterfaceMultiQueue {
+		return
+	}
+	devices.NetworkInterfaceMultiQueuePolicy = policy.DeepCopy()
+}
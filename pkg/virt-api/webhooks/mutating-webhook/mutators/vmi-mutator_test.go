@@ -41,6 +41,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/network/macallocator"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
@@ -496,6 +497,77 @@ var _ = Describe("VirtualMachineInstance Mutator", func() {
 		}))
 	})
 
+	DescribeTable("should set MAC addresses on interfaces without one", func(macGeneration *v1.MacGenerationPolicy, expectedMACPrefix string) {
+		testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{
+					NetworkConfiguration: &v1.NetworkConfiguration{
+						MacGeneration: macGeneration,
+					},
+				},
+			},
+		})
+		vmi.Name = "testvmi"
+		vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{libvmi.InterfaceDeviceWithBridgeBinding("net1")}
+		vmi.Spec.Networks = []v1.Network{*libvmi.MultusNetwork("net1", "nad1")}
+
+		_, vmiSpec, _ := getMetaSpecStatusFromAdmit()
+		Expect(vmiSpec.Domain.Devices.Interfaces[0].MacAddress).To(HavePrefix(expectedMACPrefix))
+	},
+		Entry("using the default OUI when not specified", &v1.MacGenerationPolicy{}, macallocator.DefaultOUI+":"),
+		Entry("using the configured OUI", &v1.MacGenerationPolicy{OUI: "0a:bc:de"}, "0a:bc:de:"),
+		Entry("using the random allocator",
+			&v1.MacGenerationPolicy{Allocator: v1.MacAllocatorRandom, OUI: "0a:bc:de"}, "0a:bc:de:"),
+	)
+
+	DescribeTable("should not set MAC addresses", func(macGeneration *v1.MacGenerationPolicy) {
+		testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{
+					NetworkConfiguration: &v1.NetworkConfiguration{
+						MacGeneration: macGeneration,
+					},
+				},
+			},
+		})
+		vmi.Name = "testvmi"
+		vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{libvmi.InterfaceDeviceWithBridgeBinding("net1")}
+		vmi.Spec.Networks = []v1.Network{*libvmi.MultusNetwork("net1", "nad1")}
+
+		_, vmiSpec, _ := getMetaSpecStatusFromAdmit()
+		Expect(vmiSpec.Domain.Devices.Interfaces[0].MacAddress).To(BeEmpty())
+	},
+		Entry("when the MAC generation policy is not set", nil),
+		Entry("when the MAC addresses are allocated by an external allocator", &v1.MacGenerationPolicy{
+			Allocator: v1.MacAllocatorExternal,
+			External:  &v1.ExternalMacAllocator{WebhookURL: "http://allocator.example"},
+		}),
+	)
+
+	DescribeTable("should apply the cluster network interface multi-queue policy", func(multiQueue *bool, vmiPolicy, expectedPolicy *v1.NetworkQueuesPolicy) {
+		testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{
+					NetworkConfiguration: &v1.NetworkConfiguration{
+						NetworkInterfaceMultiQueuePolicy: &v1.NetworkQueuesPolicy{VCPUsPerQueue: pointer.P(uint32(2))},
+					},
+				},
+			},
+		})
+		vmi.Spec.Domain.Devices.NetworkInterfaceMultiQueue = multiQueue
+		vmi.Spec.Domain.Devices.NetworkInterfaceMultiQueuePolicy = vmiPolicy
+
+		_, vmiSpec, _ := getMetaSpecStatusFromAdmit()
+		Expect(vmiSpec.Domain.Devices.NetworkInterfaceMultiQueuePolicy).To(Equal(expectedPolicy))
+	},
+		Entry("when multi-queue is enabled without a VMI policy",
+			pointer.P(true), nil, &v1.NetworkQueuesPolicy{VCPUsPerQueue: pointer.P(uint32(2))}),
+		Entry("but not override the VMI policy",
+			pointer.P(true), &v1.NetworkQueuesPolicy{MaxQueues: pointer.P(uint32(8))}, &v1.NetworkQueuesPolicy{MaxQueues: pointer.P(uint32(8))}),
+		Entry("but not when multi-queue is not set", nil, nil, nil),
+		Entry("but not when multi-queue is disabled", pointer.P(false), nil, nil),
+	)
+
 	DescribeTable("should not add the default interfaces if", func(interfaces []v1.Interface, networks []v1.Network) {
 		vmi.Spec.Domain.Devices.Interfaces = append([]v1.Interface{}, interfaces...)
 		vmi.Spec.Networks = append([]v1.Network{}, networks...)
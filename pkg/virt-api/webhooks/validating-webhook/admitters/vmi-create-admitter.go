@@ -34,6 +34,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/cache"
 
 	v1 "kubevirt.io/api/core/v1"
 
@@ -96,6 +97,7 @@ type VMICreateAdmitter struct {
 	ClusterConfig           *virtconfig.ClusterConfig
 	SpecValidators          []SpecValidator
 	KubeVirtServiceAccounts map[string]struct{}
+	NamespaceInformer       cache.SharedIndexInformer
 }
 
 func (admitter *VMICreateAdmitter) Admit(_ context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
@@ -127,6 +129,10 @@ func (admitter *VMICreateAdmitter) Admit(_ context.Context, ar *admissionv1.Admi
 	causes = append(causes, ValidateVirtualMachineInstanceMetadata(k8sfield.NewPath("metadata"), &vmi.ObjectMeta, admitter.ClusterConfig, isKubeVirtServiceAccount)...)
 	causes = append(causes, webhooks.ValidateVirtualMachineInstanceHyperv(k8sfield.NewPath("spec").Child("domain").Child("features").Child("hyperv"), &vmi.Spec)...)
 	causes = append(causes, ValidateVirtualMachineInstancePerArch(k8sfield.NewPath("spec"), &vmi.Spec)...)
+	causes = append(causes, netadmitter.ValidatePromiscuousInterfacesPolicy(
+		k8sfield.NewPath("spec"), &vmi.Spec, admitter.namespaceLabels(ar.Request.Namespace),
+		admitter.ClusterConfig.GetPromiscuousInterfacesNamespaceLabelSelector(),
+	)...)
 	if len(causes) > 0 {
 		return webhookutils.ToAdmissionResponse(causes)
 	}
@@ -137,6 +143,20 @@ func (admitter *VMICreateAdmitter) Admit(_ context.Context, ar *admissionv1.Admi
 	}
 }
 
+func (admitter *VMICreateAdmitter) namespaceLabels(namespace string) map[string]string {
+	if admitter.NamespaceInformer == nil {
+		return nil
+	}
+	obj, exists, err := admitter.NamespaceInformer.GetStore().GetByKey(namespace)
+	if err != nil || !exists {
+		return nil
+	}
+	if ns, ok := obj.(*k8sv1.Namespace); ok {
+		return ns.Labels
+	}
+	return nil
+}
+
 func warnDeprecatedAPIs(spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []string {
 	var warnings []string
 	for _, fg := range config.GetConfig().DeveloperConfiguration.FeatureGates {
@@ -183,6 +203,7 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateGuestMemoryLimit(field, spec, config)...)
 	causes = append(causes, validateEmulatedMachine(field, spec, config)...)
 	causes = append(causes, validateFirmwareACPI(field.Child("acpi"), spec)...)
+	causes = append(causes, validateKernelBootVolumeRef(field.Child("domain", "firmware", "kernelBoot", "volume"), spec)...)
 	causes = append(causes, validateCPURequestNotNegative(field, spec)...)
 	causes = append(causes, validateCPULimitNotNegative(field, spec)...)
 	causes = append(causes, validateCpuRequestDoesNotExceedLimit(field, spec)...)
@@ -217,6 +238,7 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateDomainSpec(field.Child("domain"), &spec.Domain)...)
 	causes = append(causes, validateVolumes(field.Child("volumes"), spec.Volumes, config)...)
 	causes = append(causes, storageadmitters.ValidateContainerDisks(field, spec)...)
+	causes = append(causes, storageadmitters.ValidateEncryptedDisks(field, spec)...)
 	causes = append(causes, storageadmitters.ValidateUtilityVolumesNotPresentOnCreation(field, spec)...)
 
 	causes = append(causes, validateAccessCredentials(field.Child("accessCredentials"), spec.AccessCredentials, spec.Volumes)...)
@@ -236,8 +258,12 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateFilesystemsWithVirtIOFSEnabled(field, spec, config)...)
 	causes = append(causes, validateVideoConfig(field, spec, config)...)
 	causes = append(causes, validatePanicDevices(field, spec, config)...)
+	causes = append(causes, validateVirtioChannels(field, spec, config)...)
+	causes = append(causes, validateSpiceDevice(field, spec, config)...)
+	causes = append(causes, validateIOMMUGroupPassthrough(field, spec, config)...)
 	causes = append(causes, validateRebootPolicy(field, spec, config)...)
 	causes = append(causes, validateReservedOverheadMemlock(field, spec, config)...)
+	causes = append(causes, validateMemorySwap(field, spec, config)...)
 
 	return causes
 }
@@ -448,6 +474,16 @@ func validateProbe(field *k8sfield.Path, probe *v1.Probe) []metav1.StatusCause {
 	if probe.GuestAgentPing != nil {
 		numHandlers++
 	}
+	if probe.GuestAgentExec != nil {
+		numHandlers++
+		if len(probe.GuestAgentExec.Command) < 1 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf("%s must not be empty", field.Child("guestAgentExec", "command").String()),
+				Field:   field.Child("guestAgentExec", "command").String(),
+			})
+		}
+	}
 
 	if numHandlers > 1 {
 		causes = append(causes, metav1.StatusCause{
@@ -1276,6 +1312,63 @@ func ValidateVirtualMachineInstanceMetadata(field *k8sfield.Path, metadata *meta
 		})
 	}
 
+	if annotations[hooks.HookSidecarListAnnotationName] != "" {
+		causes = append(causes, validateHookSidecarScripts(field.Child("annotations", hooks.HookSidecarListAnnotationName), metadata)...)
+	}
+
+	return causes
+}
+
+func validateHookSidecarScripts(field *k8sfield.Path, metadata *metav1.ObjectMeta) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	// Malformed annotations are reported by virt-controller when rendering the launcher pod
+	hookSidecarList, err := hooks.UnmarshalHookSidecarList(&v1.VirtualMachineInstance{ObjectMeta: *metadata})
+	if err != nil {
+		return nil
+	}
+
+	for i, hookSidecar := range hookSidecarList {
+		script := hookSidecar.Script
+		if script == nil {
+			continue
+		}
+		scriptField := field.Index(i).Child("script")
+
+		if hookSidecar.ConfigMap != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s and configMap are mutually exclusive", scriptField.String()),
+				Field:   scriptField.String(),
+			})
+		}
+		if script.ConfigMapName == "" || script.Key == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf("%s must be defined with a configMapName and a key", scriptField.String()),
+				Field:   scriptField.String(),
+			})
+		}
+		if script.Hook != hooks.OnDefineDomainScriptHook && script.Hook != hooks.PreCloudInitIsoScriptHook {
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%s hook %q is not supported, supported hooks are %s and %s", scriptField.String(), script.Hook,
+					hooks.OnDefineDomainScriptHook, hooks.PreCloudInitIsoScriptHook),
+				Field: scriptField.Child("hook").String(),
+			})
+		}
+		switch script.Interpreter {
+		case "", hooks.ScriptInterpreterShell, hooks.ScriptInterpreterPython:
+		default:
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%s interpreter %q is not supported, supported interpreters are %s and %s", scriptField.String(), script.Interpreter,
+					hooks.ScriptInterpreterShell, hooks.ScriptInterpreterPython),
+				Field: scriptField.Child("interpreter").String(),
+			})
+		}
+	}
+
 	return causes
 }
 
@@ -1907,14 +2000,14 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 	return causes
 }
 
-// Rejects kernel boot defined with initrd/kernel path but without an image
+// Rejects kernel boot defined with initrd/kernel path but without an image or a volume
 func validateKernelBoot(field *k8sfield.Path, kernelBoot *v1.KernelBoot) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if kernelBoot == nil {
 		return causes
 	}
 
-	if kernelBoot.Container == nil {
+	if kernelBoot.Container == nil && kernelBoot.Volume == nil {
 		if kernelBoot.KernelArgs != "" {
 			causes = append(causes, metav1.StatusCause{
 				Type:    metav1.CauseTypeFieldValueInvalid,
@@ -1925,6 +2018,18 @@ func validateKernelBoot(field *k8sfield.Path, kernelBoot *v1.KernelBoot) []metav
 		return causes
 	}
 
+	if kernelBoot.Container != nil && kernelBoot.Volume != nil {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s and %s are mutually exclusive", field.Child("container"), field.Child("volume")),
+			Field:   field.String(),
+		})
+	}
+
+	if kernelBoot.Volume != nil {
+		return validateKernelBootVolume(field.Child("volume"), kernelBoot.Volume)
+	}
+
 	container := kernelBoot.Container
 	containerField := field.Child("container")
 
@@ -1954,6 +2059,93 @@ func validateKernelBoot(field *k8sfield.Path, kernelBoot *v1.KernelBoot) []metav
 	return causes
 }
 
+func validateKernelBootVolume(field *k8sfield.Path, volume *v1.KernelBootVolume) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if volume.Name == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf("%s must be defined with a volume name", field),
+			Field:   field.Child("name").String(),
+		})
+	}
+
+	if volume.InitrdPath == "" && volume.KernelPath == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf("%s must be defined with at least one of the following: kernelPath, initrdPath", field),
+			Field:   field.String(),
+		})
+	}
+
+	if volume.KernelPath != "" {
+		causes = append(causes, storageadmitters.ValidatePath(field.Child("kernelPath"), volume.KernelPath)...)
+	}
+	if volume.InitrdPath != "" {
+		causes = append(causes, storageadmitters.ValidatePath(field.Child("initrdPath"), volume.InitrdPath)...)
+	}
+
+	return causes
+}
+
+// Rejects kernel boot volumes which do not refer to a PVC or DataVolume volume dedicated to the kernel artifacts
+func validateKernelBootVolumeRef(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	firmware := spec.Domain.Firmware
+	if firmware == nil || firmware.KernelBoot == nil || firmware.KernelBoot.Volume == nil || firmware.KernelBoot.Volume.Name == "" {
+		return nil
+	}
+
+	name := firmware.KernelBoot.Volume.Name
+	nameField := field.Child("name")
+
+	var volume *v1.Volume
+	for i := range spec.Volumes {
+		if spec.Volumes[i].Name == name {
+			volume = &spec.Volumes[i]
+			break
+		}
+	}
+	if volume == nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf(nameOfTypeNotFoundMessagePattern, nameField.String(), name),
+			Field:   nameField.String(),
+		}}
+	}
+
+	switch {
+	case volume.PersistentVolumeClaim != nil && !volume.PersistentVolumeClaim.Hotpluggable,
+		volume.DataVolume != nil && !volume.DataVolume.Hotpluggable:
+	default:
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s can only refer to a non hotpluggable PersistentVolumeClaim or DataVolume volume", nameField.String()),
+			Field:   nameField.String(),
+		}}
+	}
+
+	for _, disk := range spec.Domain.Devices.Disks {
+		if disk.Name == name {
+			return []metav1.StatusCause{{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("volume %s holding kernel boot artifacts cannot be used by a disk", name),
+				Field:   nameField.String(),
+			}}
+		}
+	}
+	for _, fs := range spec.Domain.Devices.Filesystems {
+		if fs.Name == name {
+			return []metav1.StatusCause{{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("volume %s holding kernel boot artifacts cannot be used by a filesystem", name),
+				Field:   nameField.String(),
+			}}
+		}
+	}
+
+	return nil
+}
+
 // validateSpecAffinity is function that validate spec.affinity
 // instead of bring in the whole kubernetes lib we simply copy it from kubernetes/pkg/apis/core/validation/validation.go
 func validateSpecAffinity(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
@@ -2123,6 +2315,89 @@ func validatePanicDevices(field *k8sfield.Path, spec *v1.VirtualMachineInstanceS
 	return causes
 }
 
+func validateSpiceDevice(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if spec.Domain.Devices.Spice == nil {
+		return causes
+	}
+	spiceField := field.Child("domain", "devices", "spice")
+	if !config.SpiceDisplayEnabled() {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "SpiceDisplay feature gate is not enabled in kubevirt-config",
+			Field:   spiceField.String(),
+		})
+	}
+
+	arch := spec.Architecture
+	if arch == "" {
+		arch = config.GetDefaultArchitecture()
+	}
+	if arch == "s390x" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("SPICE is not supported on %s architecture", arch),
+			Field:   spiceField.String(),
+		})
+	}
+
+	if autoattach := spec.Domain.Devices.AutoattachGraphicsDevice; autoattach != nil && !*autoattach {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s requires a graphics device, it cannot be used with autoattachGraphicsDevice set to false", spiceField.String()),
+			Field:   spiceField.String(),
+		})
+	}
+
+	return causes
+}
+
+func validateVirtioChannels(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	channels := spec.Domain.Devices.VirtioChannels
+	if len(channels) == 0 {
+		return causes
+	}
+	channelsField := field.Child("domain", "devices", "virtioChannels")
+	if !config.VirtioChannelsEnabled() {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "VirtioChannels feature gate is not enabled in kubevirt-config",
+			Field:   channelsField.String(),
+		})
+	}
+
+	if len(channels) > v1.VirtioChannelsMaxNumberOf {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("a maximum of %d virtio channels is supported", v1.VirtioChannelsMaxNumberOf),
+			Field:   channelsField.String(),
+		})
+	}
+
+	names := map[string]struct{}{}
+	for idx, channel := range channels {
+		nameField := channelsField.Index(idx).Child("name")
+		if errors := validation.IsDNS1123Label(channel.Name); len(errors) != 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s does not conform to the kubernetes DNS_LABEL rules : %s", nameField.String(), strings.Join(errors, ", ")),
+				Field:   nameField.String(),
+			})
+		}
+		if _, exists := names[channel.Name]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("virtio channel %s is defined more than once", channel.Name),
+				Field:   nameField.String(),
+			})
+		}
+		names[channel.Name] = struct{}{}
+	}
+
+	return causes
+}
+
 func validateRebootPolicy(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 
@@ -2160,3 +2435,112 @@ func validateReservedOverheadMemlock(field *k8sfield.Path, spec *v1.VirtualMachi
 
 	return causes
 }
+
+func validateMemorySwap(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if spec.Domain.Memory == nil || spec.Domain.Memory.Swap == nil {
+		return causes
+	}
+	swapField := field.Child("domain", "memory", "swap")
+	swap := spec.Domain.Memory.Swap
+
+	if !config.NodeSwapEnabled() {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "NodeSwap feature gate is not enabled in kubevirt-config",
+			Field:   swapField.String(),
+		})
+		return causes
+	}
+
+	switch swap.Policy {
+	case "", v1.MemorySwapPolicySwap, v1.MemorySwapPolicyZswap:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s is not supported, use %s or %s", swap.Policy, v1.MemorySwapPolicySwap, v1.MemorySwapPolicyZswap),
+			Field:   swapField.Child("policy").String(),
+		})
+	}
+
+	if swap.Limit != nil && swap.Limit.Sign() < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must not be negative", swapField.Child("limit").String()),
+			Field:   swapField.Child("limit").String(),
+		})
+	}
+
+	if spec.Domain.Memory.Hugepages != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "hugepages cannot be swapped out, swap cannot be used with hugepages",
+			Field:   swapField.String(),
+		})
+	}
+
+	return causes
+}
+
+func validateIOMMUGroupPassthrough(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	devicesField := field.Child("domain", "devices")
+	for i, gpu := range spec.Domain.Devices.GPUs {
+		if gpu.AttachIOMMUGroup {
+			causes = append(causes, validateIOMMUGroupDevice(devicesField.Child("gpus").Index(i), gpu.DeviceName, gpu.ClaimRequest, config)...)
+			if gpu.VirtualGPUOptions != nil {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: "attachIOMMUGroup cannot be used with virtualGPUOptions, mediated devices do not own an IOMMU group",
+					Field:   devicesField.Child("gpus").Index(i).Child("attachIOMMUGroup").String(),
+				})
+			}
+		}
+	}
+	for i, hostDevice := range spec.Domain.Devices.HostDevices {
+		if hostDevice.AttachIOMMUGroup {
+			causes = append(causes, validateIOMMUGroupDevice(devicesField.Child("hostDevices").Index(i), hostDevice.DeviceName, hostDevice.ClaimRequest, config)...)
+		}
+	}
+	return causes
+}
+
+func validateIOMMUGroupDevice(deviceField *k8sfield.Path, deviceName string, claimRequest *v1.ClaimRequest, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	attachField := deviceField.Child("attachIOMMUGroup").String()
+	if !config.IOMMUGroupPassthroughEnabled() {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "IOMMUGroupPassthrough feature gate is not enabled in kubevirt-config",
+			Field:   attachField,
+		}}
+	}
+	if claimRequest != nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "attachIOMMUGroup is only supported for devices provisioned by device plugins",
+			Field:   attachField,
+		}}
+	}
+	if permittedHostDevices := config.GetPermittedHostDevices(); permittedHostDevices != nil {
+		for _, mdev := range permittedHostDevices.MediatedDevices {
+			if mdev.ResourceName == deviceName {
+				return []metav1.StatusCause{{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("attachIOMMUGroup requires a PCI device, %s is a mediated device", deviceName),
+					Field:   attachField,
+				}}
+			}
+		}
+		for _, usb := range permittedHostDevices.USB {
+			if usb.ResourceName == deviceName {
+				return []metav1.StatusCause{{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("attachIOMMUGroup requires a PCI device, %s is a USB device", deviceName),
+					Field:   attachField,
+				}}
+			}
+		}
+	}
+	return nil
+}
@@ -21,6 +21,7 @@ package admitters
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"runtime"
@@ -123,6 +124,61 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 		Expect(resp.Result.Details.Causes).To(Equal(expectedStatusCauses))
 	})
 
+	Context("with promiscuous interfaces", func() {
+		const approvedNamespace = "approved"
+
+		newPromiscuousVMI := func() *v1.VirtualMachineInstance {
+			iface := *v1.DefaultBridgeNetworkInterface()
+			iface.Promiscuous = true
+			return newBaseVmi(libvmi.WithInterface(iface), libvmi.WithNetwork(v1.DefaultPodNetwork()))
+		}
+
+		newAdmitter := func() *VMICreateAdmitter {
+			namespaceInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Namespace{})
+			Expect(namespaceInformer.GetStore().Add(&k8sv1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Name: approvedNamespace, Labels: map[string]string{"tenant": "approved"}},
+			})).To(Succeed())
+			return &VMICreateAdmitter{
+				ClusterConfig:           config,
+				KubeVirtServiceAccounts: kubeVirtServiceAccounts,
+				NamespaceInformer:       namespaceInformer,
+			}
+		}
+
+		BeforeEach(func() {
+			kvConfig := kv.DeepCopy()
+			kvConfig.Spec.Configuration.NetworkConfiguration = &v1.NetworkConfiguration{
+				PromiscuousInterfacesNamespaceLabelSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"tenant": "approved"},
+				},
+			}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+		})
+
+		It("should allow them in a namespace selected by the policy", func() {
+			ar, err := newAdmissionReviewForVMICreation(newPromiscuousVMI())
+			Expect(err).ToNot(HaveOccurred())
+			ar.Request.Namespace = approvedNamespace
+
+			resp := newAdmitter().Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should reject them in a namespace not selected by the policy", func() {
+			ar, err := newAdmissionReviewForVMICreation(newPromiscuousVMI())
+			Expect(err).ToNot(HaveOccurred())
+			ar.Request.Namespace = "other"
+
+			resp := newAdmitter().Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(ConsistOf(metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "promiscuous interface default is not allowed in this namespace",
+				Field:   "spec.domain.devices.interfaces[0].promiscuous",
+			}))
+		})
+	})
+
 	It("should reject invalid VirtualMachineInstance spec on create", func() {
 		vmi := newBaseVmi()
 		vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
@@ -312,6 +368,46 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			resp := vmiCreateAdmitter.Admit(context.Background(), ar)
 			Expect(resp.Allowed).To(BeTrue())
 		})
+		It("should accept guest agent exec probes without a Pod Network", func() {
+			vmi := newBaseVmi(
+				libvmi.WithAutoAttachPodInterface(false),
+				withReadinessProbe(&v1.Probe{
+					InitialDelaySeconds: 2,
+					Handler: v1.Handler{
+						GuestAgentExec: &v1.GuestAgentExec{Command: []string{"systemctl", "is-active", "httpd"}},
+					},
+				}),
+				withLivenessProbe(&v1.Probe{
+					InitialDelaySeconds: 2,
+					Handler: v1.Handler{
+						GuestAgentExec: &v1.GuestAgentExec{Command: []string{"pgrep", "httpd"}, SuccessExitCodes: []int32{0}},
+					},
+				}),
+			)
+
+			ar, err := newAdmissionReviewForVMICreation(vmi)
+			Expect(err).ToNot(HaveOccurred())
+
+			resp := vmiCreateAdmitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+		It("should reject guest agent exec probes without a command", func() {
+			vmi := newBaseVmi(
+				withReadinessProbe(&v1.Probe{
+					InitialDelaySeconds: 2,
+					Handler: v1.Handler{
+						GuestAgentExec: &v1.GuestAgentExec{Command: []string{}},
+					},
+				}),
+			)
+
+			ar, err := newAdmissionReviewForVMICreation(vmi)
+			Expect(err).ToNot(HaveOccurred())
+
+			resp := vmiCreateAdmitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).To(Equal(`spec.readinessProbe.guestAgentExec.command must not be empty`))
+		})
 		It("should reject properly configured network-based readiness and liveness probes if no Pod Network is present", func() {
 			vmi := newBaseVmi(
 				libvmi.WithAutoAttachPodInterface(false),
@@ -477,6 +573,34 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 				map[string]string{hooks.HookSidecarListAnnotationName: "[{'image': 'fake-image'}]"},
 				featuregate.SidecarGate,
 			),
+			Entry("with a script hook and sidecar feature gate enabled",
+				map[string]string{hooks.HookSidecarListAnnotationName: `[{"script": {"configMapName": "cm", "key": "hook.py", "hook": "onDefineDomain", "interpreter": "python3"}}]`},
+				featuregate.SidecarGate,
+			),
+		)
+
+		DescribeTable("should reject invalid script hooks", func(hookSidecars, expectedField string) {
+			enableFeatureGates(featuregate.SidecarGate)
+			vmi := newBaseVmi()
+			vmi.Annotations = map[string]string{hooks.HookSidecarListAnnotationName: hookSidecars}
+
+			ar, err := newAdmissionReviewForVMICreation(vmi)
+			Expect(err).ToNot(HaveOccurred())
+			ar.Request.UserInfo = authv1.UserInfo{Username: "fake-account"}
+
+			resp := vmiCreateAdmitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(fmt.Sprintf("metadata.annotations.%s[0].script%s", hooks.HookSidecarListAnnotationName, expectedField)))
+		},
+			Entry("with an unsupported hook",
+				`[{"script": {"configMapName": "cm", "key": "hook.sh", "hook": "onShutdown"}}]`, ".hook"),
+			Entry("with an unsupported interpreter",
+				`[{"script": {"configMapName": "cm", "key": "hook.rb", "hook": "onDefineDomain", "interpreter": "ruby"}}]`, ".interpreter"),
+			Entry("without a ConfigMap key",
+				`[{"script": {"configMapName": "cm", "hook": "onDefineDomain"}}]`, ""),
+			Entry("with a configMap hook as well",
+				`[{"configMap": {"name": "cm", "key": "hook.sh", "hookPath": "/usr/bin/onDefineDomain"}, "script": {"configMapName": "cm", "key": "hook.sh", "hook": "onDefineDomain"}}]`, ""),
 		)
 	})
 
@@ -1471,6 +1595,159 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			})
 		})
 
+		Context("with a SPICE device defined", func() {
+			It("should fail when SpiceDisplay featuregate is disabled", func() {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Devices.Spice = &v1.SpiceDevice{}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.spice"))
+				Expect(causes[0].Message).To(Equal("SpiceDisplay feature gate is not enabled in kubevirt-config"))
+			})
+
+			It("should allow a SPICE device with audio and USB redirection", func() {
+				enableFeatureGates(featuregate.SpiceDisplay)
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Devices.Spice = &v1.SpiceDevice{Audio: true, USBRedirection: true}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			})
+
+			It("should reject a SPICE device on s390x architecture", func() {
+				enableFeatureGates(featuregate.SpiceDisplay)
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Architecture = "s390x"
+				vmi.Spec.Domain.Devices.Spice = &v1.SpiceDevice{}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.spice"))
+				Expect(causes[0].Message).To(Equal("SPICE is not supported on s390x architecture"))
+			})
+
+			It("should reject a SPICE device when graphics autoattach is disabled", func() {
+				enableFeatureGates(featuregate.SpiceDisplay)
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Devices.AutoattachGraphicsDevice = pointer.P(false)
+				vmi.Spec.Domain.Devices.Spice = &v1.SpiceDevice{}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.spice"))
+			})
+		})
+
+		Context("with IOMMU group passthrough requested", func() {
+			newVMIWithGPU := func(gpu v1.GPU) *v1.VirtualMachineInstance {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Devices.GPUs = []v1.GPU{gpu}
+				return vmi
+			}
+
+			It("should fail when IOMMUGroupPassthrough featuregate is disabled", func() {
+				vmi := newVMIWithGPU(v1.GPU{Name: "gpu1", DeviceName: "nvidia.com/GA102", AttachIOMMUGroup: true})
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.gpus[0].attachIOMMUGroup"))
+				Expect(causes[0].Message).To(Equal("IOMMUGroupPassthrough feature gate is not enabled in kubevirt-config"))
+			})
+
+			It("should allow a PCI GPU requesting its IOMMU group", func() {
+				enableFeatureGates(featuregate.IOMMUGroupPassthrough)
+				vmi := newVMIWithGPU(v1.GPU{Name: "gpu1", DeviceName: "nvidia.com/GA102", AttachIOMMUGroup: true})
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			})
+
+			It("should allow a PCI host device requesting its IOMMU group", func() {
+				enableFeatureGates(featuregate.IOMMUGroupPassthrough, featuregate.HostDevicesGate)
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Devices.HostDevices = []v1.HostDevice{{Name: "nic1", DeviceName: "intel.com/X710", AttachIOMMUGroup: true}}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			})
+
+			It("should reject a GPU with virtual GPU options requesting its IOMMU group", func() {
+				enableFeatureGates(featuregate.IOMMUGroupPassthrough)
+				vmi := newVMIWithGPU(v1.GPU{Name: "gpu1", DeviceName: "nvidia.com/GRID_T4-1Q", AttachIOMMUGroup: true, VirtualGPUOptions: &v1.VGPUOptions{}})
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.gpus[0].attachIOMMUGroup"))
+			})
+
+			It("should reject a DRA provisioned GPU requesting its IOMMU group", func() {
+				enableFeatureGates(featuregate.IOMMUGroupPassthrough)
+				vmi := newVMIWithGPU(v1.GPU{Name: "gpu1", AttachIOMMUGroup: true, ClaimRequest: &v1.ClaimRequest{ClaimName: pointer.P("claim"), RequestName: pointer.P("gpu")}})
+				causes := validateIOMMUGroupPassthrough(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Message).To(Equal("attachIOMMUGroup is only supported for devices provisioned by device plugins"))
+			})
+
+			It("should reject a permitted mediated device requesting its IOMMU group", func() {
+				kvConfig := kv.DeepCopy()
+				kvConfig.Spec.Configuration.DeveloperConfiguration.FeatureGates = []string{featuregate.IOMMUGroupPassthrough}
+				kvConfig.Spec.Configuration.PermittedHostDevices = &v1.PermittedHostDevices{
+					MediatedDevices: []v1.MediatedHostDevice{{MDEVNameSelector: "GRID T4-1Q", ResourceName: "nvidia.com/GRID_T4-1Q"}},
+				}
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+
+				vmi := newVMIWithGPU(v1.GPU{Name: "gpu1", DeviceName: "nvidia.com/GRID_T4-1Q", AttachIOMMUGroup: true})
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Message).To(Equal("attachIOMMUGroup requires a PCI device, nvidia.com/GRID_T4-1Q is a mediated device"))
+			})
+		})
+
+		Context("with virtio channels defined", func() {
+			It("should fail when VirtioChannels featuregate is disabled", func() {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Devices.VirtioChannels = []v1.VirtioChannel{{Name: "agent"}}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.virtioChannels"))
+				Expect(causes[0].Message).To(Equal("VirtioChannels feature gate is not enabled in kubevirt-config"))
+			})
+
+			It("should allow uniquely named virtio channels", func() {
+				enableFeatureGates(featuregate.VirtioChannels)
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Devices.VirtioChannels = []v1.VirtioChannel{{Name: "agent"}, {Name: "oob-mgmt"}}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			})
+
+			It("should reject a virtio channel with an invalid name", func() {
+				enableFeatureGates(featuregate.VirtioChannels)
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Devices.VirtioChannels = []v1.VirtioChannel{{Name: "Bad_Name"}}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.virtioChannels[0].name"))
+			})
+
+			It("should reject virtio channels with duplicate names", func() {
+				enableFeatureGates(featuregate.VirtioChannels)
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Devices.VirtioChannels = []v1.VirtioChannel{{Name: "agent"}, {Name: "agent"}}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Type).To(Equal(metav1.CauseTypeFieldValueDuplicate))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.virtioChannels[1].name"))
+				Expect(causes[0].Message).To(Equal("virtio channel agent is defined more than once"))
+			})
+
+			It("should reject more virtio channels than supported", func() {
+				enableFeatureGates(featuregate.VirtioChannels)
+				vmi := api.NewMinimalVMI("testvm")
+				for i := 0; i <= v1.VirtioChannelsMaxNumberOf; i++ {
+					vmi.Spec.Domain.Devices.VirtioChannels = append(vmi.Spec.Domain.Devices.VirtioChannels,
+						v1.VirtioChannel{Name: fmt.Sprintf("channel%d", i)})
+				}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.virtioChannels"))
+				Expect(causes[0].Message).To(Equal(fmt.Sprintf("a maximum of %d virtio channels is supported", v1.VirtioChannelsMaxNumberOf)))
+			})
+		})
+
 		Context("with kernel boot defined", func() {
 
 			createKernelBoot := func(kernelArgs, initrdPath, kernelPath, image string) *v1.KernelBoot {
@@ -1535,6 +1812,58 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 					createKernelBoot(validKernelArgs, invalidInitrd, validKernel, validImage), false),
 				Entry("with kernel args, with container that has initrd and kernel defined but without image - should reject",
 					createKernelBoot(validKernelArgs, validInitrd, validKernel, withoutImage), false),
+				Entry("with kernel args, with volume that has name & kernel & initrd defined - should approve",
+					&v1.KernelBoot{KernelArgs: validKernelArgs, Volume: &v1.KernelBootVolume{Name: "kernel", KernelPath: validKernel, InitrdPath: validInitrd}}, true),
+				Entry("with volume that has name & kernel defined - should approve",
+					&v1.KernelBoot{Volume: &v1.KernelBootVolume{Name: "kernel", KernelPath: validKernel}}, true),
+				Entry("with volume that has only name defined - should reject",
+					&v1.KernelBoot{Volume: &v1.KernelBootVolume{Name: "kernel"}}, false),
+				Entry("with volume that has kernel defined but without name - should reject",
+					&v1.KernelBoot{Volume: &v1.KernelBootVolume{KernelPath: validKernel}}, false),
+				Entry("with volume that has an invalid kernel path - should reject",
+					&v1.KernelBoot{Volume: &v1.KernelBootVolume{Name: "kernel", KernelPath: invalidKernel}}, false),
+				Entry("with both container and volume - should reject",
+					&v1.KernelBoot{
+						Container: &v1.KernelBootContainer{Image: validImage, KernelPath: validKernel},
+						Volume:    &v1.KernelBootVolume{Name: "kernel", KernelPath: validKernel},
+					}, false),
+			)
+
+			DescribeTable("should validate the volume holding kernel boot artifacts", func(volume v1.Volume, devices v1.Devices, expectedMessage string) {
+				vmi := libvmi.New(libvmi.WithKernelBootVolume("kernel", "/vmlinuz", "", ""))
+				vmi.Spec.Volumes = []v1.Volume{volume}
+				vmi.Spec.Domain.Devices = devices
+
+				causes := validateKernelBootVolumeRef(k8sfield.NewPath("fake"), &vmi.Spec)
+				if expectedMessage == "" {
+					Expect(causes).To(BeEmpty())
+				} else {
+					Expect(causes).To(HaveLen(1))
+					Expect(causes[0].Field).To(Equal("fake.name"))
+					Expect(causes[0].Message).To(ContainSubstring(expectedMessage))
+				}
+			},
+				Entry("should accept a PVC volume",
+					v1.Volume{Name: "kernel", VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{}}},
+					v1.Devices{}, ""),
+				Entry("should accept a DataVolume volume",
+					v1.Volume{Name: "kernel", VolumeSource: v1.VolumeSource{DataVolume: &v1.DataVolumeSource{Name: "dv"}}},
+					v1.Devices{}, ""),
+				Entry("should reject a missing volume",
+					v1.Volume{Name: "other", VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{}}},
+					v1.Devices{}, "not found"),
+				Entry("should reject a volume of unsupported type",
+					v1.Volume{Name: "kernel", VolumeSource: v1.VolumeSource{ContainerDisk: testutils.NewFakeContainerDiskSource()}},
+					v1.Devices{}, "can only refer to a non hotpluggable PersistentVolumeClaim or DataVolume volume"),
+				Entry("should reject a hotpluggable volume",
+					v1.Volume{Name: "kernel", VolumeSource: v1.VolumeSource{DataVolume: &v1.DataVolumeSource{Name: "dv", Hotpluggable: true}}},
+					v1.Devices{}, "can only refer to a non hotpluggable PersistentVolumeClaim or DataVolume volume"),
+				Entry("should reject a volume used by a disk",
+					v1.Volume{Name: "kernel", VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{}}},
+					v1.Devices{Disks: []v1.Disk{{Name: "kernel"}}}, "cannot be used by a disk"),
+				Entry("should reject a volume used by a filesystem",
+					v1.Volume{Name: "kernel", VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{}}},
+					v1.Devices{Filesystems: []v1.Filesystem{{Name: "kernel", Virtiofs: &v1.FilesystemVirtiofs{}}}}, "cannot be used by a filesystem"),
 			)
 		})
 
@@ -1571,6 +1900,47 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes[0].Message).To(Equal("Reserved overhead memlock feature gate is not enabled in kubevirt-config"))
 		})
 
+		Context("with memory swap", func() {
+			var vmi *v1.VirtualMachineInstance
+
+			BeforeEach(func() {
+				vmi = api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Memory = &v1.Memory{Swap: &v1.MemorySwap{}}
+			})
+
+			It("should reject swap when feature gate is disabled", func() {
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.memory.swap"))
+				Expect(causes[0].Message).To(Equal("NodeSwap feature gate is not enabled in kubevirt-config"))
+			})
+
+			DescribeTable("should accept swap", func(swap *v1.MemorySwap) {
+				enableFeatureGates(featuregate.NodeSwap)
+				vmi.Spec.Domain.Memory.Swap = swap
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			},
+				Entry("with defaults", &v1.MemorySwap{}),
+				Entry("with the Swap policy and a limit", &v1.MemorySwap{Policy: v1.MemorySwapPolicySwap, Limit: pointer.P(resource.MustParse("1Gi"))}),
+				Entry("with the Zswap policy", &v1.MemorySwap{Policy: v1.MemorySwapPolicyZswap}),
+			)
+
+			DescribeTable("should reject swap", func(memory *v1.Memory, expectedField string) {
+				enableFeatureGates(featuregate.NodeSwap)
+				vmi.Spec.Domain.Memory = memory
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal(expectedField))
+			},
+				Entry("with an unknown policy", &v1.Memory{Swap: &v1.MemorySwap{Policy: "Disk"}}, "fake.domain.memory.swap.policy"),
+				Entry("with a negative limit", &v1.Memory{Swap: &v1.MemorySwap{Limit: pointer.P(resource.MustParse("-1Gi"))}}, "fake.domain.memory.swap.limit"),
+				Entry("with hugepages", &v1.Memory{Swap: &v1.MemorySwap{}, Hugepages: &v1.Hugepages{PageSize: "2Mi"}}, "fake.domain.memory.swap"),
+			)
+		})
+
 	})
 
 	Context("with cpu pinning", func() {
@@ -2732,6 +3102,36 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
 				Expect(causes).To(BeEmpty())
 			})
+
+			It("should accept attestation with the launch parameters of the guest owner", func() {
+				vmi.Spec.Domain.LaunchSecurity.SNP = &v1.SEVSNP{
+					Attestation: &v1.SEVAttestation{},
+					IDBlock:     base64.StdEncoding.EncodeToString(make([]byte, 96)),
+					IDAuth:      base64.StdEncoding.EncodeToString(make([]byte, 4096)),
+					HostData:    base64.StdEncoding.EncodeToString(make([]byte, 32)),
+				}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			})
+
+			DescribeTable("should reject invalid launch parameters", func(snp *v1.SEVSNP, field string) {
+				vmi.Spec.Domain.LaunchSecurity.SNP = snp
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Type).To(Equal(metav1.CauseTypeFieldValueInvalid))
+				Expect(causes[0].Field).To(Equal(field))
+			},
+				Entry("with an ID block without its authentication information", &v1.SEVSNP{
+					IDBlock: base64.StdEncoding.EncodeToString(make([]byte, 96)),
+				}, "fake.launchSecurity.snp"),
+				Entry("with an ID block of the wrong size", &v1.SEVSNP{
+					IDBlock: base64.StdEncoding.EncodeToString(make([]byte, 64)),
+					IDAuth:  base64.StdEncoding.EncodeToString(make([]byte, 4096)),
+				}, "fake.launchSecurity.snp.idBlock"),
+				Entry("with host data which is not base64 encoded", &v1.SEVSNP{
+					HostData: "not base64",
+				}, "fake.launchSecurity.snp.hostData"),
+			)
 		})
 	})
 
@@ -29,6 +29,7 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 
+	hostdevicelu "kubevirt.io/kubevirt/pkg/liveupdate/hostdevice"
 	storageadmitters "kubevirt.io/kubevirt/pkg/storage/admitters"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -176,6 +177,14 @@ func admitHotplug(
 		return response
 	}
 
+	if response := admitHotplugHostDevices(oldVMI.Spec.Domain.Devices.HostDevices, newVMI.Spec.Domain.Devices.HostDevices, oldVMI.Status.HostDeviceStatuses, clusterConfig); response != nil {
+		return response
+	}
+
+	if response := admitMetadataServiceUpdate(oldVMI.Spec.MetadataService, newVMI.Spec.MetadataService); response != nil {
+		return response
+	}
+
 	if response := storageadmitters.AdmitUtilityVolumes(&newVMI.Spec, &oldVMI.Spec, oldVMI.Status.VolumeStatus, clusterConfig); response != nil {
 		return response
 	}
@@ -225,6 +234,48 @@ func admitHotplugMemory(oldMemory, newMemory *v1.Memory) *admissionv1.AdmissionR
 	return nil
 }
 
+func admitHotplugHostDevices(oldHostDevices, newHostDevices []v1.HostDevice, statuses []v1.HostDeviceStatus, clusterConfig *virtconfig.ClusterConfig) *admissionv1.AdmissionResponse {
+	if equality.Semantic.DeepEqual(oldHostDevices, newHostDevices) {
+		return nil
+	}
+
+	if !clusterConfig.HotplugHostDevicesEnabled() {
+		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: "host devices cannot be changed, HotplugHostDevices feature gate is not enabled",
+			},
+		})
+	}
+
+	if err := hostdevicelu.ValidateLiveUpdateHostDevices(oldHostDevices, newHostDevices, statuses, clusterConfig.GetPermittedHostDevices()); err != nil {
+		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: err.Error(),
+				Field:   "spec.domain.devices.hostDevices",
+			},
+		})
+	}
+
+	return nil
+}
+
+// admitMetadataServiceUpdate allows the metadata service data to be updated, but not the service to be enabled or disabled.
+func admitMetadataServiceUpdate(oldMetadataService, newMetadataService *v1.MetadataService) *admissionv1.AdmissionResponse {
+	if (oldMetadataService == nil) != (newMetadataService == nil) {
+		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: "metadata service cannot be enabled or disabled on a running VMI",
+				Field:   "spec.metadataService",
+			},
+		})
+	}
+
+	return nil
+}
+
 func hasRequestOriginatedFromVirtHandler(requestUsername string, kubeVirtServiceAccounts map[string]struct{}) bool {
 	if _, isKubeVirtServiceAccount := kubeVirtServiceAccounts[requestUsername]; isKubeVirtServiceAccount {
 		return strings.HasSuffix(requestUsername, components.HandlerServiceAccountName)
@@ -519,4 +519,85 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 		Expect(resp.Allowed).To(BeFalse())
 	})
 
+	DescribeTable("Updates in host devices", func(newHostDevices []v1.HostDevice, hotplugged bool, featureGate string, expected types.GomegaMatcher) {
+		kvConfig := kv.DeepCopy()
+		kvConfig.Spec.Configuration.DeveloperConfiguration.FeatureGates = []string{featureGate}
+		kvConfig.Spec.Configuration.PermittedHostDevices = &v1.PermittedHostDevices{
+			PciHostDevices: []v1.PciHostDevice{{PCIVendorSelector: "8086:1572", ResourceName: "example.org/nic"}},
+		}
+		testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+
+		vmi := api.NewMinimalVMI("testvmi")
+		vmi.Spec.Domain.CPU = &v1.CPU{}
+		vmi.Spec.Domain.Devices.HostDevices = []v1.HostDevice{{Name: "nic1", DeviceName: "example.org/nic"}}
+		vmi.Status.HostDeviceStatuses = []v1.HostDeviceStatus{{Name: "nic1", Phase: v1.HostDeviceReady}}
+		if hotplugged {
+			vmi.Status.HostDeviceStatuses[0].HotplugHostDevice = &v1.HotplugHostDeviceStatus{}
+		}
+		updateVmi := vmi.DeepCopy()
+		updateVmi.Spec.Domain.Devices.HostDevices = newHostDevices
+
+		newVMIBytes, _ := json.Marshal(&updateVmi)
+		oldVMIBytes, _ := json.Marshal(&vmi)
+		ar := &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				UserInfo: authv1.UserInfo{Username: "system:serviceaccount:kubevirt:" + components.ControllerServiceAccountName},
+				Resource: webhooks.VirtualMachineInstanceGroupVersionResource,
+				Object: runtime.RawExtension{
+					Raw: newVMIBytes,
+				},
+				OldObject: runtime.RawExtension{
+					Raw: oldVMIBytes,
+				},
+				Operation: admissionv1.Update,
+			},
+		}
+		resp := vmiUpdateAdmitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(expected)
+	},
+		Entry("allow adding a permitted PCI host device",
+			[]v1.HostDevice{{Name: "nic1", DeviceName: "example.org/nic"}, {Name: "nic2", DeviceName: "example.org/nic"}},
+			false, featuregate.HotplugHostDevices, BeTrue()),
+		Entry("allow removing a hotplugged PCI host device", nil, true, featuregate.HotplugHostDevices, BeTrue()),
+		Entry("deny removing a PCI host device the VMI started with", nil, false, featuregate.HotplugHostDevices, BeFalse()),
+		Entry("deny adding a host device which is not a permitted PCI host device",
+			[]v1.HostDevice{{Name: "nic1", DeviceName: "example.org/nic"}, {Name: "vgpu", DeviceName: "example.org/vgpu"}},
+			false, featuregate.HotplugHostDevices, BeFalse()),
+		Entry("deny adding a host device when the feature gate is disabled",
+			[]v1.HostDevice{{Name: "nic1", DeviceName: "example.org/nic"}, {Name: "nic2", DeviceName: "example.org/nic"}},
+			false, "", BeFalse()),
+	)
+
+	DescribeTable("Updates in the metadata service", func(oldMetadataService, newMetadataService *v1.MetadataService, expected types.GomegaMatcher) {
+		vmi := api.NewMinimalVMI("testvmi")
+		vmi.Spec.Domain.CPU = &v1.CPU{}
+		vmi.Spec.MetadataService = oldMetadataService
+		updateVmi := vmi.DeepCopy()
+		updateVmi.Spec.MetadataService = newMetadataService
+
+		newVMIBytes, _ := json.Marshal(&updateVmi)
+		oldVMIBytes, _ := json.Marshal(&vmi)
+		ar := &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				UserInfo: authv1.UserInfo{Username: "system:serviceaccount:kubevirt:" + components.ControllerServiceAccountName},
+				Resource: webhooks.VirtualMachineInstanceGroupVersionResource,
+				Object: runtime.RawExtension{
+					Raw: newVMIBytes,
+				},
+				OldObject: runtime.RawExtension{
+					Raw: oldVMIBytes,
+				},
+				Operation: admissionv1.Update,
+			},
+		}
+		resp := vmiUpdateAdmitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(expected)
+	},
+		Entry("allow updating the data",
+			&v1.MetadataService{Data: map[string]string{"role": "primary"}},
+			&v1.MetadataService{Data: map[string]string{"role": "replica"}},
+			BeTrue()),
+		Entry("deny enabling the service", nil, &v1.MetadataService{}, BeFalse()),
+		Entry("deny disabling the service", &v1.MetadataService{}, nil, BeFalse()),
+	)
 })
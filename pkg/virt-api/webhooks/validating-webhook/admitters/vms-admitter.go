@@ -223,6 +223,7 @@ func ValidateVirtualMachineSpec(field *k8sfield.Path, spec *v1.VirtualMachineSpe
 	causes = append(causes, storageadmitters.ValidateDataVolumeTemplate(field, spec)...)
 	causes = append(causes, validateRunStrategy(field, spec, config)...)
 	causes = append(causes, validateLiveUpdateFeatures(field, spec, config)...)
+	causes = append(causes, validateCPUAutoscaling(field, spec, config)...)
 
 	return causes
 }
@@ -309,6 +310,32 @@ func validateLiveUpdateCPU(field *k8sfield.Path, domain *v1.DomainSpec) (causes
 	return causes
 }
 
+func validateCPUAutoscaling(field *k8sfield.Path, spec *v1.VirtualMachineSpec, config *virtconfig.ClusterConfig) (causes []metav1.StatusCause) {
+	if spec.CPUAutoscaling == nil {
+		return causes
+	}
+
+	if !config.VCPUAutoscalingEnabled() {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt resource", featuregate.VCPUAutoscaling),
+			Field:   field.Child("cpuAutoscaling").String(),
+		})
+	}
+
+	switch spec.CPUAutoscaling.Policy {
+	case "", v1.CPUAutoscalingPolicyRecommend, v1.CPUAutoscalingPolicyAuto:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("Invalid CPU autoscaling policy (%s)", spec.CPUAutoscaling.Policy),
+			Field:   field.Child("cpuAutoscaling", "policy").String(),
+		})
+	}
+
+	return causes
+}
+
 func (admitter *VMsAdmitter) validateVolumeRequests(ctx context.Context, vm *v1.VirtualMachine) ([]metav1.StatusCause, error) {
 	if len(vm.Status.VolumeRequests) == 0 {
 		return nil, nil
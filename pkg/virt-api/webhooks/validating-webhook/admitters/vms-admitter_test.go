@@ -1618,6 +1618,34 @@ var _ = Describe("Validating VM Admitter", func() {
 			Entry("reject invalid runstrategy", v1.VirtualMachineRunStrategy("invalid"), "", false),
 		)
 	})
+
+	Context("CPU autoscaling", func() {
+		AfterEach(func() {
+			disableFeatureGates()
+		})
+
+		DescribeTable("validate should", func(policy v1.CPUAutoscalingPolicy, featureGate string, accepted bool) {
+			vmi := api.NewMinimalVMI("testvmi")
+			vm := &v1.VirtualMachine{
+				Spec: v1.VirtualMachineSpec{
+					RunStrategy:    pointer.P(v1.RunStrategyAlways),
+					CPUAutoscaling: &v1.CPUAutoscaling{Policy: policy},
+					Template: &v1.VirtualMachineInstanceTemplateSpec{
+						Spec: vmi.Spec,
+					},
+				},
+			}
+			enableFeatureGate(featureGate)
+			resp := admitVm(vmsAdmitter, vm)
+			Expect(resp.Allowed).To(Equal(accepted))
+		},
+			Entry("allow the default policy", v1.CPUAutoscalingPolicy(""), featuregate.VCPUAutoscaling, true),
+			Entry("allow the Recommend policy", v1.CPUAutoscalingPolicyRecommend, featuregate.VCPUAutoscaling, true),
+			Entry("allow the Auto policy", v1.CPUAutoscalingPolicyAuto, featuregate.VCPUAutoscaling, true),
+			Entry("reject an invalid policy", v1.CPUAutoscalingPolicy("invalid"), featuregate.VCPUAutoscaling, false),
+			Entry("reject CPU autoscaling, if feature gate not enabled", v1.CPUAutoscalingPolicyAuto, "", false),
+		)
+	})
 })
 
 func admitVm(admitter *VMsAdmitter, vm *v1.VirtualMachine) *admissionv1.AdmissionResponse {
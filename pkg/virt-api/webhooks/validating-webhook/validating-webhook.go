@@ -38,12 +38,14 @@ func ServeVMICreate(
 	req *http.Request,
 	clusterConfig *virtconfig.ClusterConfig,
 	kubeVirtServiceAccounts map[string]struct{},
+	informers *webhooks.Informers,
 	specValidators ...admitters.SpecValidator,
 ) {
 	validating_webhooks.Serve(resp, req, &admitters.VMICreateAdmitter{
 		ClusterConfig:           clusterConfig,
 		KubeVirtServiceAccounts: kubeVirtServiceAccounts,
 		SpecValidators:          specValidators,
+		NamespaceInformer:       informers.NamespaceInformer,
 	})
 }
 
@@ -41,6 +41,8 @@ const (
 	NodeDrainTaintDefaultKey = "kubevirt.io/drain"
 	CdiGroupName             = "cdi.kubevirt.io"
 	MonitoringGroupName      = "monitoring.coreos.com"
+	NetworkGroupName         = "k8s.cni.cncf.io"
+	CertManagerGroupName     = "cert-manager.io"
 )
 
 type ConfigModifiedFn func()
@@ -136,11 +138,20 @@ func isPrometheusRules(crd *extv1.CustomResourceDefinition) bool {
 	return crd.Spec.Names.Kind == "PrometheusRule" && crd.Spec.Group == MonitoringGroupName
 }
 
+func isCertManagerCrd(crd *extv1.CustomResourceDefinition) bool {
+	return (crd.Spec.Names.Kind == "Certificate" || crd.Spec.Names.Kind == "Issuer") && crd.Spec.Group == CertManagerGroupName
+}
+
+func isNetworkAttachmentDefinitionCrd(crd *extv1.CustomResourceDefinition) bool {
+	return crd.Spec.Names.Kind == "NetworkAttachmentDefinition" && crd.Spec.Group == NetworkGroupName
+}
+
 func (c *ClusterConfig) crdAddedDeleted(obj interface{}) {
 	go c.GetConfig()
 	crd := obj.(*extv1.CustomResourceDefinition)
 	if !isDataVolumeCrd(crd) && !isDataSourceCrd(crd) &&
-		!isServiceMonitor(crd) && !isPrometheusRules(crd) {
+		!isServiceMonitor(crd) && !isPrometheusRules(crd) &&
+		!isNetworkAttachmentDefinitionCrd(crd) && !isCertManagerCrd(crd) {
 		return
 	}
 
@@ -442,6 +453,35 @@ func (c *ClusterConfig) HasPrometheusRuleAPI() bool {
 	return false
 }
 
+// HasCertManagerAPI tells whether both the cert-manager Certificate and Issuer CRDs are installed
+func (c *ClusterConfig) HasCertManagerAPI() bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	kinds := map[string]bool{}
+	for _, obj := range c.crdStore.List() {
+		if crd, ok := obj.(*extv1.CustomResourceDefinition); ok && crd.DeletionTimestamp == nil && isCertManagerCrd(crd) {
+			kinds[crd.Spec.Names.Kind] = true
+		}
+	}
+	return kinds["Certificate"] && kinds["Issuer"]
+}
+
+func (c *ClusterConfig) HasNetworkAttachmentDefinitionAPI() bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	objects := c.crdStore.List()
+	for _, obj := range objects {
+		if crd, ok := obj.(*extv1.CustomResourceDefinition); ok && crd.DeletionTimestamp == nil {
+			if isNetworkAttachmentDefinitionCrd(crd) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func parseNodeSelectors(str string) (map[string]string, error) {
 	nodeSelectors := make(map[string]string)
 	for _, s := range strings.Split(strings.TrimSpace(str), "\n") {
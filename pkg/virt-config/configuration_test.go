@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"strings"
 	"sync"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -930,6 +931,40 @@ var _ = Describe("test configuration", func() {
 
 			Expect(cfg.HasPrometheusRuleAPI()).To(BeFalse())
 		})
+
+		It("returns true when both the cert-manager Certificate and Issuer CRDs exist", func() {
+			var crds []interface{}
+			for _, kind := range []string{"Certificate", "Issuer"} {
+				crds = append(crds, &extv1.CustomResourceDefinition{
+					ObjectMeta: metav1.ObjectMeta{Name: kind},
+					Spec: extv1.CustomResourceDefinitionSpec{
+						Group: virtconfig.CertManagerGroupName,
+						Names: extv1.CustomResourceDefinitionNames{Kind: kind},
+					},
+				})
+			}
+			Expect(crdInformer.GetStore().Replace(crds, "1")).To(Succeed())
+
+			Expect(cfg.HasCertManagerAPI()).To(BeTrue())
+		})
+
+		It("returns false for cert-manager when the Issuer CRD is missing", func() {
+			addCustomResourceDefinition(crdInformer, virtconfig.CertManagerGroupName, "Certificate")
+
+			Expect(cfg.HasCertManagerAPI()).To(BeFalse())
+		})
+
+		It("returns true for a NetworkAttachmentDefinition CRD", func() {
+			addCustomResourceDefinition(crdInformer, virtconfig.NetworkGroupName, "NetworkAttachmentDefinition")
+
+			Expect(cfg.HasNetworkAttachmentDefinitionAPI()).To(BeTrue())
+		})
+
+		It("returns false for NetworkAttachmentDefinition when group is wrong even if kind matches", func() {
+			addCustomResourceDefinition(crdInformer, "not.cncf.io", "NetworkAttachmentDefinition")
+
+			Expect(cfg.HasNetworkAttachmentDefinitionAPI()).To(BeFalse())
+		})
 	})
 
 	DescribeTable("when TDX configuration", func(confidentialCompute *v1.ConfidentialComputeConfiguration, expectedEnforced bool, expectedSocketPath string) {
@@ -970,6 +1005,64 @@ var _ = Describe("test configuration", func() {
 		),
 	)
 
+	DescribeTable("when load aware rebalancing configuration", func(config *v1.LoadAwareRebalancingConfiguration, expected *v1.LoadAwareRebalancingConfiguration) {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			LoadAwareRebalancing: config,
+		})
+		Expect(clusterConfig.GetLoadAwareRebalancingConfiguration()).To(Equal(expected))
+	},
+		Entry("is nil, should return defaults",
+			nil,
+			&v1.LoadAwareRebalancingConfiguration{
+				CPUUtilizationThreshold: pointer.P(virtconfig.DefaultLoadRebalancingCPUUtilizationThreshold),
+				CPUStealThreshold:       pointer.P(virtconfig.DefaultLoadRebalancingCPUStealThreshold),
+				Cooldown:                &metav1.Duration{Duration: virtconfig.DefaultLoadRebalancingCooldown},
+				MaxParallelMigrations:   pointer.P(virtconfig.DefaultLoadRebalancingMaxParallelMigrations),
+			},
+		),
+		Entry("is partial, should fill missing fields with defaults",
+			&v1.LoadAwareRebalancingConfiguration{
+				CPUStealThreshold: pointer.P(uint32(20)),
+				Cooldown:          &metav1.Duration{Duration: time.Hour},
+			},
+			&v1.LoadAwareRebalancingConfiguration{
+				CPUUtilizationThreshold: pointer.P(virtconfig.DefaultLoadRebalancingCPUUtilizationThreshold),
+				CPUStealThreshold:       pointer.P(uint32(20)),
+				Cooldown:                &metav1.Duration{Duration: time.Hour},
+				MaxParallelMigrations:   pointer.P(virtconfig.DefaultLoadRebalancingMaxParallelMigrations),
+			},
+		),
+	)
+
+	DescribeTable("when vCPU autoscaling configuration", func(config *v1.VCPUAutoscalingConfiguration, expected *v1.VCPUAutoscalingConfiguration) {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			VCPUAutoscaling: config,
+		})
+		Expect(clusterConfig.GetVCPUAutoscalingConfiguration()).To(Equal(expected))
+	},
+		Entry("is nil, should return defaults",
+			nil,
+			&v1.VCPUAutoscalingConfiguration{
+				CPUUtilizationThreshold: pointer.P(virtconfig.DefaultVCPUAutoscalingCPUUtilizationThreshold),
+				CPUStealThreshold:       pointer.P(virtconfig.DefaultVCPUAutoscalingCPUStealThreshold),
+				Window:                  &metav1.Duration{Duration: virtconfig.DefaultVCPUAutoscalingWindow},
+				Cooldown:                &metav1.Duration{Duration: virtconfig.DefaultVCPUAutoscalingCooldown},
+			},
+		),
+		Entry("is partial, should fill missing fields with defaults",
+			&v1.VCPUAutoscalingConfiguration{
+				CPUUtilizationThreshold: pointer.P(uint32(75)),
+				Window:                  &metav1.Duration{Duration: time.Minute},
+			},
+			&v1.VCPUAutoscalingConfiguration{
+				CPUUtilizationThreshold: pointer.P(uint32(75)),
+				CPUStealThreshold:       pointer.P(virtconfig.DefaultVCPUAutoscalingCPUStealThreshold),
+				Window:                  &metav1.Duration{Duration: time.Minute},
+				Cooldown:                &metav1.Duration{Duration: virtconfig.DefaultVCPUAutoscalingCooldown},
+			},
+		),
+	)
+
 	Context("GetHypervisor", func() {
 		var KvmHypervisorConfig = v1.HypervisorConfiguration{
 			Name: v1.KvmHypervisorName,
@@ -45,6 +45,12 @@ func (config *ClusterConfig) isFeatureGateEnabled(featureGate string) bool {
 	return false
 }
 
+// IsFeatureGateEnabled reports whether the named feature gate is enabled, e.g. when it is
+// referenced by external components such as network binding plugins.
+func (config *ClusterConfig) IsFeatureGateEnabled(featureGate string) bool {
+	return config.isFeatureGateEnabled(featureGate)
+}
+
 func (config *ClusterConfig) CPUManagerEnabled() bool {
 	return config.isFeatureGateEnabled(featuregate.CPUManager)
 }
@@ -248,3 +254,107 @@ func (config *ClusterConfig) LiveUpdateNADRefEnabled() bool {
 func (config *ClusterConfig) VGPULiveMigrationEnabled() bool {
 	return config.isFeatureGateEnabled(featuregate.VGPULiveMigration)
 }
+
+func (config *ClusterConfig) NativeMultiNetworkEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.NativeMultiNetwork)
+}
+
+func (config *ClusterConfig) SecondaryNetworkEndpointsEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.SecondaryNetworkEndpoints)
+}
+
+func (config *ClusterConfig) InterfaceFirewallEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.InterfaceFirewall)
+}
+
+func (config *ClusterConfig) VirtioChannelsEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.VirtioChannels)
+}
+
+func (config *ClusterConfig) SpiceDisplayEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.SpiceDisplay)
+}
+
+func (config *ClusterConfig) IOMMUGroupPassthroughEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.IOMMUGroupPassthrough)
+}
+
+func (config *ClusterConfig) HostDeviceDriverBindingEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.HostDeviceDriverBinding)
+}
+
+func (config *ClusterConfig) HotplugHostDevicesEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.HotplugHostDevices)
+}
+
+func (config *ClusterConfig) InterfaceOffloadsEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.InterfaceOffloads)
+}
+
+func (config *ClusterConfig) IPv6RouterAdvertisementEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.IPv6RouterAdvertisement)
+}
+
+func (config *ClusterConfig) MasqueradePortsEnforcementEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.MasqueradePortsEnforcement)
+}
+
+func (config *ClusterConfig) NetworkEmulationEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.NetworkEmulation)
+}
+
+func (config *ClusterConfig) MigrationBasedPreemptionEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.MigrationBasedPreemption)
+}
+
+func (config *ClusterConfig) LoadAwareRebalancingEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.LoadAwareRebalancing)
+}
+
+func (config *ClusterConfig) NodeSwapEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.NodeSwap)
+}
+
+func (config *ClusterConfig) VCPUAutoscalingEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.VCPUAutoscaling)
+}
+
+func (config *ClusterConfig) InterfaceMirroringEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.InterfaceMirroring)
+}
+
+func (config *ClusterConfig) MetadataServiceEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.MetadataService)
+}
+
+func (config *ClusterConfig) ContainerDiskPrePullEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.ContainerDiskPrePull)
+}
+
+func (config *ClusterConfig) HostFeatureSchedulingEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.HostFeatureScheduling)
+}
+
+func (config *ClusterConfig) DebugAttachEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.DebugAttach)
+}
+
+func (config *ClusterConfig) DomainJobsEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.DomainJobs)
+}
+
+func (config *ClusterConfig) VirtControllerShardingEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.VirtControllerSharding)
+}
+
+func (config *ClusterConfig) ScopedNetworkInfoEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.ScopedNetworkInfo)
+}
+
+func (config *ClusterConfig) QemuCommandLineEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.QemuCommandLine)
+}
+
+func (config *ClusterConfig) InterfaceInterruptsEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.InterfaceInterrupts)
+}
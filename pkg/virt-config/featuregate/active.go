@@ -236,6 +236,161 @@ const (
 	// The VGPULiveMigration fg enables the vGPU hook to run for vGPU live migrations, allowing the
 	// target XML's mdev UUID to be mutated.
 	VGPULiveMigration = "VGPULiveMigration"
+
+	// NativeMultiNetwork enables connecting VMs to secondary networks provided by the
+	// Kubernetes native multi-network API (PodNetwork objects), in addition to Multus.
+	// Owner: SIG network
+	// Alpha: v1.8.0
+	NativeMultiNetwork = "NativeMultiNetwork"
+
+	// SecondaryNetworkEndpoints enables publishing the VMIs secondary network IP addresses
+	// as the EndpointSlices of selector-less Services, so they are reachable through service discovery.
+	// Owner: SIG network
+	// Alpha: v1.8.0
+	SecondaryNetworkEndpoints = "SecondaryNetworkEndpoints"
+
+	// InterfaceFirewall enables filtering the incoming traffic of masquerade interfaces
+	// using the allow rules specified on the VMI interface.
+	// Owner: SIG network
+	// Alpha: v1.8.0
+	InterfaceFirewall = "InterfaceFirewall"
+
+	// VirtioChannels enables attaching named virtio-serial channels to VMIs,
+	// each one reachable through the VMI channel subresource.
+	// Owner: sig-compute
+	// Alpha: v1.8.0
+	VirtioChannels = "VirtioChannels"
+
+	// SpiceDisplay enables attaching a SPICE graphics device to VMIs, with optional
+	// audio and USB redirection, reachable through the VMI spice subresource.
+	// Owner: sig-compute
+	// Alpha: v1.8.0
+	SpiceDisplay = "SpiceDisplay"
+
+	// IOMMUGroupPassthrough enables passing through all the devices sharing the IOMMU group
+	// of an allocated PCI GPU or host device, validating that none of them is in use by the host.
+	// Owner: sig-compute
+	// Alpha: v1.8.0
+	IOMMUGroupPassthrough = "IOMMUGroupPassthrough"
+
+	// HostDeviceDriverBinding enables virt-handler to bind permitted PCI host devices
+	// to vfio-pci on allocation and to return them to their host driver on release.
+	// Owner: sig-compute
+	// Alpha: v1.8.0
+	HostDeviceDriverBinding = "HostDeviceDriverBinding"
+
+	// HotplugHostDevices allows PCI host devices to be added to and removed from running VMs.
+	// Owner: sig-compute
+	// Alpha: v1.8.0
+	HotplugHostDevices = "HotplugHostDevices"
+
+	// InterfaceOffloads enables toggling the host offloads of virtio interfaces.
+	// Owner: SIG network
+	// Alpha: v1.8.0
+	InterfaceOffloads = "InterfaceOffloads"
+
+	// IPv6RouterAdvertisement enables the virt-launcher pod to send IPv6 router advertisements
+	// to the guest of masquerade interfaces.
+	// Owner: SIG network
+	// Alpha: v1.8.0
+	IPv6RouterAdvertisement = "IPv6RouterAdvertisement"
+
+	// MasqueradePortsEnforcement enables exposing only the listed ports of masquerade interfaces,
+	// and updating them while the VM is running.
+	// Owner: SIG network
+	// Alpha: v1.8.0
+	MasqueradePortsEnforcement = "MasqueradePortsEnforcement"
+
+	// NetworkEmulation enables adding delay, jitter and packet loss to the traffic of interfaces,
+	// for chaos testing of the guest workloads.
+	// Owner: SIG network
+	// Alpha: v1.8.0
+	NetworkEmulation = "NetworkEmulation"
+
+	// MigrationBasedPreemption enables live migrating lower priority VMIs off a node to make
+	// room for an unschedulable higher priority VMI which is not allowed to preempt.
+	// Owner: sig-compute
+	// Alpha: v1.8.0
+	MigrationBasedPreemption = "MigrationBasedPreemption"
+
+	// LoadAwareRebalancing enables live migrating VMIs off nodes whose CPU utilization or
+	// vCPU steal time, as reported by virt-handler, exceed the configured thresholds.
+	// Owner: sig-compute
+	// Alpha: v1.8.0
+	LoadAwareRebalancing = "LoadAwareRebalancing"
+
+	// NodeSwap enables VMIs to use the swap of the node, by setting the swap limit of the
+	// cgroup of their virt-launcher pod.
+	// Owner: sig-compute
+	// Alpha: v1.8.0
+	NodeSwap = "NodeSwap"
+
+	// VCPUAutoscaling enables recommending, and for the VirtualMachines opting in hotplugging,
+	// more CPU sockets when the vCPU utilization or steal time reported by virt-handler stays high.
+	// Owner: sig-compute
+	// Alpha: v1.8.0
+	VCPUAutoscaling = "VCPUAutoscaling"
+
+	// InterfaceMirroring enables mirroring the traffic of interfaces to a monitoring network,
+	// for inspection by IDS or monitoring appliances.
+	// Owner: SIG network
+	// Alpha: v1.8.0
+	InterfaceMirroring = "InterfaceMirroring"
+
+	// MetadataService enables serving the instance identity, network configuration and user provided
+	// data to the guests which opt in, on the link-local metadata endpoint of the virt-launcher pod.
+	// Owner: SIG network
+	// Alpha: v1.8.0
+	MetadataService = "MetadataService"
+
+	// ContainerDiskPrePull enables keeping the container disk images selected in the KubeVirt
+	// configuration pulled, and cached, on the nodes ahead of VM creation.
+	// Owner: sig-storage
+	// Alpha: v1.8.0
+	ContainerDiskPrePull = "ContainerDiskPrePull"
+
+	// HostFeatureScheduling enables labelling the nodes with the host features detected by virt-handler
+	// (nested virtualization, IOMMU, vhost-vdpa and hugepage sizes), and requiring those labels for VMs
+	// which depend on the features.
+	// Owner: sig-compute
+	// Alpha: v1.8.0
+	HostFeatureScheduling = "HostFeatureScheduling"
+
+	// DebugAttach enables the debugattach VMI subresource, attaching a time-limited debug container
+	// to the virt-launcher pod.
+	// Owner: sig-compute
+	// Alpha: v1.8.0
+	DebugAttach = "DebugAttach"
+
+	// DomainJobs enables the domainjobs and canceldomainjob VMI subresources, listing and cancelling
+	// the libvirt jobs in progress on the domain, e.g. a stuck migration or block copy.
+	// Owner: sig-compute
+	// Alpha: v1.8.0
+	DomainJobs = "DomainJobs"
+
+	// VirtControllerSharding enables splitting the namespaces into shards, reconciled by several active
+	// virt-controller instances instead of the single leader.
+	// Owner: sig-scale
+	// Alpha: v1.8.0
+	VirtControllerSharding = "VirtControllerSharding"
+
+	// ScopedNetworkInfo mounts in each network binding plugin sidecar a network-info downward API volume
+	// holding the networks of the interfaces bound to the plugin only, instead of the networks of all
+	// the VMI interfaces.
+	// Owner: sig-network
+	// Alpha: v1.8.0
+	ScopedNetworkInfo = "ScopedNetworkInfo"
+
+	// QemuCommandLine enables the qemucommandline VMI subresource, returning the command line the QEMU
+	// process of the VMI was started with, with its secrets redacted.
+	// Owner: sig-compute
+	// Alpha: v1.8.0
+	QemuCommandLine = "QemuCommandLine"
+
+	// InterfaceInterrupts enables configuring the interrupt mode and MSI-X vectors of virtio interfaces.
+	// Owner: SIG network
+	// Alpha: v1.8.0
+	InterfaceInterrupts = "InterfaceInterrupts"
 )
 
 func init() {
@@ -283,4 +438,30 @@ func init() {
 	RegisterFeatureGate(FeatureGate{Name: OptOutRoleAggregation, State: Alpha})
 	RegisterFeatureGate(FeatureGate{Name: LiveUpdateNADRef, State: Beta})
 	RegisterFeatureGate(FeatureGate{Name: VGPULiveMigration, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: NativeMultiNetwork, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: SecondaryNetworkEndpoints, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: InterfaceFirewall, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: VirtioChannels, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: SpiceDisplay, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: IOMMUGroupPassthrough, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: HostDeviceDriverBinding, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: HotplugHostDevices, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: InterfaceOffloads, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: IPv6RouterAdvertisement, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: MasqueradePortsEnforcement, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: NetworkEmulation, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: MigrationBasedPreemption, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: LoadAwareRebalancing, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: NodeSwap, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: VCPUAutoscaling, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: InterfaceMirroring, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: MetadataService, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: ContainerDiskPrePull, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: HostFeatureScheduling, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: DebugAttach, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: DomainJobs, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: VirtControllerSharding, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: ScopedNetworkInfo, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: QemuCommandLine, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: InterfaceInterrupts, State: Alpha})
 }
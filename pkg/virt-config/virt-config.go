@@ -24,13 +24,17 @@ package virtconfig
 */
 
 import (
+	"time"
+
 	"kubevirt.io/client-go/log"
 
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 )
 
@@ -79,6 +83,16 @@ const (
 	DefaultTDXAttestationEnforced                   = false
 	DefaultQGSSocketPath                            = "/var/run/tdx-qgs/qgs.socket"
 
+	DefaultLoadRebalancingCPUUtilizationThreshold uint32 = 85
+	DefaultLoadRebalancingCPUStealThreshold       uint32 = 10
+	DefaultLoadRebalancingCooldown                       = 10 * time.Minute
+	DefaultLoadRebalancingMaxParallelMigrations   uint32 = 2
+
+	DefaultVCPUAutoscalingCPUUtilizationThreshold uint32 = 90
+	DefaultVCPUAutoscalingCPUStealThreshold       uint32 = 20
+	DefaultVCPUAutoscalingWindow                         = 10 * time.Minute
+	DefaultVCPUAutoscalingCooldown                       = 30 * time.Minute
+
 	// Default REST configuration settings
 	DefaultVirtHandlerQPS         float32 = 50
 	DefaultVirtHandlerBurst               = 100
@@ -475,6 +489,111 @@ func (c *ClusterConfig) GetNetworkBindings() map[string]v1.InterfaceBindingPlugi
 	return nil
 }
 
+func (c *ClusterConfig) GetSubresourceRateLimits() *v1.SubresourceRateLimits {
+	return c.GetConfig().SubresourceRateLimits
+}
+
+// GetLoadAwareRebalancingConfiguration returns the load aware rebalancing configuration with
+// the unset fields filled with their defaults.
+func (c *ClusterConfig) GetLoadAwareRebalancingConfiguration() *v1.LoadAwareRebalancingConfiguration {
+	config := &v1.LoadAwareRebalancingConfiguration{}
+	if c.GetConfig().LoadAwareRebalancing != nil {
+		config = c.GetConfig().LoadAwareRebalancing.DeepCopy()
+	}
+	if config.CPUUtilizationThreshold == nil {
+		config.CPUUtilizationThreshold = pointer.P(DefaultLoadRebalancingCPUUtilizationThreshold)
+	}
+	if config.CPUStealThreshold == nil {
+		config.CPUStealThreshold = pointer.P(DefaultLoadRebalancingCPUStealThreshold)
+	}
+	if config.Cooldown == nil {
+		config.Cooldown = &metav1.Duration{Duration: DefaultLoadRebalancingCooldown}
+	}
+	if config.MaxParallelMigrations == nil {
+		config.MaxParallelMigrations = pointer.P(DefaultLoadRebalancingMaxParallelMigrations)
+	}
+	return config
+}
+
+// GetVCPUAutoscalingConfiguration returns the vCPU autoscaling configuration with the unset
+// fields filled with their defaults.
+func (c *ClusterConfig) GetVCPUAutoscalingConfiguration() *v1.VCPUAutoscalingConfiguration {
+	config := &v1.VCPUAutoscalingConfiguration{}
+	if c.GetConfig().VCPUAutoscaling != nil {
+		config = c.GetConfig().VCPUAutoscaling.DeepCopy()
+	}
+	if config.CPUUtilizationThreshold == nil {
+		config.CPUUtilizationThreshold = pointer.P(DefaultVCPUAutoscalingCPUUtilizationThreshold)
+	}
+	if config.CPUStealThreshold == nil {
+		config.CPUStealThreshold = pointer.P(DefaultVCPUAutoscalingCPUStealThreshold)
+	}
+	if config.Window == nil {
+		config.Window = &metav1.Duration{Duration: DefaultVCPUAutoscalingWindow}
+	}
+	if config.Cooldown == nil {
+		config.Cooldown = &metav1.Duration{Duration: DefaultVCPUAutoscalingCooldown}
+	}
+	return config
+}
+
+func (c *ClusterConfig) GetConsoleRecordingConfiguration() *v1.ConsoleRecordingConfiguration {
+	return c.GetConfig().ConsoleRecording
+}
+
+func (c *ClusterConfig) GetContainerDiskPrePullConfiguration() *v1.ContainerDiskPrePullConfiguration {
+	return c.GetConfig().ContainerDiskPrePull
+}
+
+// GetVirtControllerShards returns the number of namespace shards virt-controller reconciles in parallel,
+// which is 1 unless the VirtControllerSharding feature gate is enabled
+func (c *ClusterConfig) GetVirtControllerShards() uint32 {
+	if !c.VirtControllerShardingEnabled() {
+		return 1
+	}
+	sharding := c.GetConfig().VirtControllerSharding
+	if sharding == nil || sharding.Shards == nil || *sharding.Shards == 0 {
+		return 1
+	}
+	return *sharding.Shards
+}
+
+func (c *ClusterConfig) GetVMIStatusUpdatesConfiguration() *v1.VMIStatusUpdatesConfiguration {
+	return c.GetConfig().VMIStatusUpdates
+}
+
+func (c *ClusterConfig) GetMacGenerationPolicy() *v1.MacGenerationPolicy {
+	networkConfig := c.GetConfig().NetworkConfiguration
+	if networkConfig != nil {
+		return networkConfig.MacGeneration
+	}
+	return nil
+}
+
+func (c *ClusterConfig) GetNetworkInterfaceMultiQueuePolicy() *v1.NetworkQueuesPolicy {
+	networkConfig := c.GetConfig().NetworkConfiguration
+	if networkConfig != nil {
+		return networkConfig.NetworkInterfaceMultiQueuePolicy
+	}
+	return nil
+}
+
+func (c *ClusterConfig) GetInterfaceEventsWebhookURL() string {
+	networkConfig := c.GetConfig().NetworkConfiguration
+	if networkConfig != nil && networkConfig.InterfaceEvents != nil {
+		return networkConfig.InterfaceEvents.WebhookURL
+	}
+	return ""
+}
+
+func (c *ClusterConfig) GetPromiscuousInterfacesNamespaceLabelSelector() *metav1.LabelSelector {
+	networkConfig := c.GetConfig().NetworkConfiguration
+	if networkConfig != nil {
+		return networkConfig.PromiscuousInterfacesNamespaceLabelSelector
+	}
+	return nil
+}
+
 func (config *ClusterConfig) VGADisplayForEFIGuestsEnabled() bool {
 	VGADisplayForEFIGuestsAnnotationExists := false
 	kv := config.GetConfigFromKubeVirtCR()
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/topology"
@@ -13,6 +14,7 @@ import (
 
 type NodeSelectorRenderer struct {
 	cpuFeatureLabels       []string
+	hostFeatureLabels      []string
 	cpuModelLabel          string
 	machineTypeLabel       string
 	hasDedicatedCPU        bool
@@ -70,6 +72,10 @@ func (nsr *NodeSelectorRenderer) Render() map[string]string {
 		nsr.enableSelectorLabel(cpuFeatureLabel)
 	}
 
+	for _, hostFeatureLabel := range nsr.hostFeatureLabels {
+		nsr.enableSelectorLabel(hostFeatureLabel)
+	}
+
 	if nsr.isManualTSCFrequencyRequired() {
 		nsr.enableSelectorLabel(topology.ToTSCSchedulableLabel(*nsr.tscFrequency))
 	}
@@ -157,6 +163,12 @@ func WithModelAndFeatureLabels(modelLabel string, cpuFeatureLabels ...string) No
 	}
 }
 
+func WithHostFeatureLabels(hostFeatureLabels ...string) NodeSelectorRendererOption {
+	return func(renderer *NodeSelectorRenderer) {
+		renderer.hostFeatureLabels = hostFeatureLabels
+	}
+}
+
 func WithMachineType(machineType string) NodeSelectorRendererOption {
 	return func(renderer *NodeSelectorRenderer) {
 		machineTypeLabelKey := v1.SupportedMachineTypeLabel + machineType
@@ -195,6 +207,52 @@ func CPUFeatureLabelsFromCPUFeatures(vmi *v1.VirtualMachineInstance) []string {
 	return labels
 }
 
+// HostFeatureLabelsFromVMI returns the labels of the host features the VMI depends on,
+// as set on the nodes by the virt-handler node labeller.
+func HostFeatureLabelsFromVMI(vmi *v1.VirtualMachineInstance) []string {
+	var labels []string
+
+	if requiresNestedVirtualization(vmi) {
+		labels = append(labels, v1.NestedVirtualizationHostFeatureLabel)
+	}
+
+	if len(vmi.Spec.Domain.Devices.HostDevices) > 0 || hasSRIOVInterface(vmi) {
+		labels = append(labels, v1.IOMMUHostFeatureLabel)
+	}
+
+	if memory := vmi.Spec.Domain.Memory; memory != nil && memory.Hugepages != nil {
+		if pageSize, err := resource.ParseQuantity(memory.Hugepages.PageSize); err == nil {
+			labels = append(labels, v1.HugepagesHostFeatureLabel+pageSize.String())
+		}
+	}
+
+	return labels
+}
+
+func requiresNestedVirtualization(vmi *v1.VirtualMachineInstance) bool {
+	if vmi.Spec.Domain.CPU == nil {
+		return false
+	}
+	for _, feature := range vmi.Spec.Domain.CPU.Features {
+		if feature.Name != "vmx" && feature.Name != "svm" {
+			continue
+		}
+		if feature.Policy == "" || feature.Policy == "require" || feature.Policy == "force" {
+			return true
+		}
+	}
+	return false
+}
+
+func hasSRIOVInterface(vmi *v1.VirtualMachineInstance) bool {
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		if iface.SRIOV != nil {
+			return true
+		}
+	}
+	return false
+}
+
 func hypervNodeSelectors(vmiFeatures *v1.Features) map[string]string {
 	nodeSelectors := make(map[string]string)
 	if vmiFeatures == nil || vmiFeatures.Hyperv == nil {
@@ -8,6 +8,8 @@ import (
 	"github.com/onsi/gomega/types"
 
 	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
 )
 
 var _ = Describe("Node Selector Renderer", func() {
@@ -92,6 +94,25 @@ var _ = Describe("Node Selector Renderer", func() {
 				})
 			})
 
+			When("host features are required", func() {
+				BeforeEach(func() {
+					nsr = NewNodeSelectorRenderer(
+						emptySelectors(),
+						emptySelectors(),
+						"",
+						WithHostFeatureLabels(v1.IOMMUHostFeatureLabel, v1.HugepagesHostFeatureLabel+"1Gi"))
+				})
+
+				It("requires the node to feature those host features", func() {
+					Expect(nsr.Render()).To(
+						Equal(map[string]string{
+							"kubevirt.io/schedulable":                     "true",
+							"host-feature.node.kubevirt.io/iommu":         "true",
+							"host-feature.node.kubevirt.io/hugepages-1Gi": "true",
+						}))
+				})
+			})
+
 			When("architecture set on VMI", func() {
 
 				BeforeEach(func() {
@@ -144,6 +165,29 @@ var _ = Describe("Node Selector Renderer", func() {
 	})
 })
 
+var _ = DescribeTable("Host feature labels of a VMI", func(vmi *v1.VirtualMachineInstance, expected []string) {
+	Expect(HostFeatureLabelsFromVMI(vmi)).To(Equal(expected))
+},
+	Entry("with no special requirement", libvmi.New(), nil),
+	Entry("with a required vmx CPU feature", libvmi.New(
+		libvmi.WithCPUFeature("vmx", "require"),
+	), []string{v1.NestedVirtualizationHostFeatureLabel}),
+	Entry("with a disabled svm CPU feature", libvmi.New(
+		libvmi.WithCPUFeature("svm", "disable"),
+	), nil),
+	Entry("with a host device", libvmi.New(
+		func(vmi *v1.VirtualMachineInstance) {
+			vmi.Spec.Domain.Devices.HostDevices = []v1.HostDevice{{Name: "hostdev", DeviceName: "vendor.com/device"}}
+		},
+	), []string{v1.IOMMUHostFeatureLabel}),
+	Entry("with an SR-IOV interface", libvmi.New(
+		libvmi.WithInterface(v1.Interface{Name: "sriov", InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}}}),
+	), []string{v1.IOMMUHostFeatureLabel}),
+	Entry("with hugepages", libvmi.New(
+		libvmi.WithHugepages("2048Ki"),
+	), []string{v1.HugepagesHostFeatureLabel + "2Mi"}),
+)
+
 func hypervFeatures() *v1.Features {
 	return &v1.Features{Hyperv: &v1.FeatureHyperv{EVMCS: &v1.FeatureState{}}}
 }
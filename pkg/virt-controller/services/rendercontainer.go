@@ -243,6 +243,11 @@ func updateReadinessProbe(vmi *v1.VirtualMachineInstance, computeProbe *k8sv1.Pr
 		computeProbe.InitialDelaySeconds = computeProbe.InitialDelaySeconds + LibvirtStartupDelay
 		return
 	}
+	if vmi.Spec.ReadinessProbe.GuestAgentExec != nil {
+		wrapGuestAgentExecWithVirtProbe(vmi, vmi.Spec.ReadinessProbe.GuestAgentExec, computeProbe)
+		computeProbe.InitialDelaySeconds = computeProbe.InitialDelaySeconds + LibvirtStartupDelay
+		return
+	}
 	wrapExecProbeWithVirtProbe(vmi, computeProbe)
 	computeProbe.InitialDelaySeconds = computeProbe.InitialDelaySeconds + LibvirtStartupDelay
 }
@@ -253,6 +258,11 @@ func updateLivenessProbe(vmi *v1.VirtualMachineInstance, computeProbe *k8sv1.Pro
 		computeProbe.InitialDelaySeconds = computeProbe.InitialDelaySeconds + LibvirtStartupDelay
 		return
 	}
+	if vmi.Spec.LivenessProbe.GuestAgentExec != nil {
+		wrapGuestAgentExecWithVirtProbe(vmi, vmi.Spec.LivenessProbe.GuestAgentExec, computeProbe)
+		computeProbe.InitialDelaySeconds = computeProbe.InitialDelaySeconds + LibvirtStartupDelay
+		return
+	}
 	wrapExecProbeWithVirtProbe(vmi, computeProbe)
 	computeProbe.InitialDelaySeconds = computeProbe.InitialDelaySeconds + LibvirtStartupDelay
 }
@@ -290,5 +300,19 @@ func requiredCapabilities(vmi *v1.VirtualMachineInstance) []k8sv1.Capability {
 		capabilities = append(capabilities, CAP_SYS_NICE)
 	}
 
+	if hasRouterAdvertisement(vmi) {
+		// add a CAP_NET_RAW capability to allow sending ICMPv6 router advertisements
+		capabilities = append(capabilities, CAP_NET_RAW)
+	}
+
 	return capabilities
 }
+
+func hasRouterAdvertisement(vmi *v1.VirtualMachineInstance) bool {
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		if iface.RouterAdvertisement != nil {
+			return true
+		}
+	}
+	return false
+}
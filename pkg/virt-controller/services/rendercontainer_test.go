@@ -228,6 +228,43 @@ var _ = Describe("Container spec renderer", func() {
 			})
 		})
 
+		Context("guest agent exec probe", func() {
+			It("should run the guest command through virt-probe", func() {
+				probe := dummyProbe()
+				probe.Handler = v1.Handler{
+					GuestAgentExec: &v1.GuestAgentExec{Command: []string{"systemctl", "is-active", "httpd"}},
+				}
+				specRenderer = NewContainerSpecRenderer(containerName, img, pullPolicy, WithReadinessProbe(
+					vmiWithReadinessProbe(probe)))
+				readinessProbe := specRenderer.Render(exampleCommand).ReadinessProbe
+				Expect(readinessProbe.Exec.Command).To(HaveExactElements(
+					"virt-probe",
+					"--domainName", "_",
+					"--timeoutSeconds", strconv.FormatInt(int64(dummyProbe().TimeoutSeconds), 10),
+					"--command", "systemctl",
+					"--",
+					"is-active", "httpd"))
+				Expect(readinessProbe.TimeoutSeconds).To(Equal(dummyProbe().TimeoutSeconds + 1))
+				Expect(readinessProbe.InitialDelaySeconds).To(Equal(dummyProbe().InitialDelaySeconds + LibvirtStartupDelay))
+			})
+
+			It("should pass the success exit codes to virt-probe", func() {
+				probe := dummyProbe()
+				probe.Handler = v1.Handler{
+					GuestAgentExec: &v1.GuestAgentExec{Command: []string{"check-app"}, SuccessExitCodes: []int32{0, 3}},
+				}
+				specRenderer = NewContainerSpecRenderer(containerName, img, pullPolicy, WithLivelinessProbe(
+					vmiWithLivenessProbe(probe)))
+				Expect(specRenderer.Render(exampleCommand).LivenessProbe.Exec.Command).To(HaveExactElements(
+					"virt-probe",
+					"--domainName", "_",
+					"--timeoutSeconds", strconv.FormatInt(int64(dummyProbe().TimeoutSeconds), 10),
+					"--command", "check-app",
+					"--successExitCodes", "0,3",
+					"--"))
+			})
+		})
+
 		Context("pre-wrapped liveness exec probe", func() {
 			It("should avoid wrapping the liveness exec probe a second time", func() {
 				var expectedExecCmd = []string{"virt-probe", "--", "dummy-cli"}
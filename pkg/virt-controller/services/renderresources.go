@@ -298,6 +298,32 @@ func WithMemoryOverhead(guestResourceSpec v1.ResourceRequirements, memoryOverhea
 	}
 }
 
+// WithCPUOverhead adds the overhead to the CPU requests, and to the CPU limits when there are
+func WithCPUOverhead(cpuOverhead resource.Quantity) ResourceRendererOption {
+	return func(renderer *ResourceRenderer) {
+		if cpuOverhead.IsZero() {
+			return
+		}
+		addCPUOverhead(renderer.vmRequests, renderer.calculatedRequests, cpuOverhead, true)
+		addCPUOverhead(renderer.vmLimits, renderer.calculatedLimits, cpuOverhead, false)
+	}
+}
+
+// addCPUOverhead adds the overhead to the user specified CPU, which takes precedence over the calculated one
+func addCPUOverhead(vmResources, calculatedResources k8sv1.ResourceList, cpuOverhead resource.Quantity, addIfMissing bool) {
+	if cpu, exists := vmResources[k8sv1.ResourceCPU]; exists {
+		cpu.Add(cpuOverhead)
+		vmResources[k8sv1.ResourceCPU] = cpu
+		return
+	}
+	cpu, exists := calculatedResources[k8sv1.ResourceCPU]
+	if !exists && !addIfMissing {
+		return
+	}
+	cpu.Add(cpuOverhead)
+	calculatedResources[k8sv1.ResourceCPU] = cpu
+}
+
 func WithAutoMemoryLimits(namespace string, namespaceStore cache.Store) ResourceRendererOption {
 	return func(renderer *ResourceRenderer) {
 		requestRatio := getMemoryLimitsRatio(namespace, namespaceStore)
@@ -532,7 +558,11 @@ func validatePermittedHostDevices(spec *v1.VirtualMachineInstanceSpec, config *v
 	return nil
 }
 
-func sidecarResources(vmi *v1.VirtualMachineInstance, config *virtconfig.ClusterConfig) k8sv1.ResourceRequirements {
+func sidecarResources(
+	vmi *v1.VirtualMachineInstance,
+	config *virtconfig.ClusterConfig,
+	pluginResources *v1.ResourceRequirementsWithoutClaims,
+) k8sv1.ResourceRequirements {
 	resources := k8sv1.ResourceRequirements{
 		Requests: k8sv1.ResourceList{},
 		Limits:   k8sv1.ResourceList{},
@@ -565,9 +595,28 @@ func sidecarResources(vmi *v1.VirtualMachineInstance, config *virtconfig.Cluster
 			resources.Limits[k8sv1.ResourceMemory] = *limMem
 		}
 	}
+	if pluginResources != nil {
+		applyPluginSidecarResources(&resources, pluginResources, vmi.IsCPUDedicated() || vmi.WantsToHaveQOSGuaranteed())
+	}
 	return resources
 }
 
+// applyPluginSidecarResources overrides the sidecar CPU and memory with the resources of the plugin registration.
+func applyPluginSidecarResources(resources *k8sv1.ResourceRequirements, pluginResources *v1.ResourceRequirementsWithoutClaims, guaranteed bool) {
+	for _, name := range []k8sv1.ResourceName{k8sv1.ResourceCPU, k8sv1.ResourceMemory} {
+		if request, exists := pluginResources.Requests[name]; exists {
+			resources.Requests[name] = request
+		}
+		if limit, exists := pluginResources.Limits[name]; exists {
+			resources.Limits[name] = limit
+		}
+		// A guaranteed QoS requires the requests to match the limits
+		if guaranteed {
+			resources.Requests[name] = resources.Limits[name]
+		}
+	}
+}
+
 func initContainerResourceRequirementsForVMI(vmi *v1.VirtualMachineInstance, containerType v1.SupportContainerType, config *virtconfig.ClusterConfig) k8sv1.ResourceRequirements {
 	if vmi.IsCPUDedicated() || vmi.WantsToHaveQOSGuaranteed() {
 		return k8sv1.ResourceRequirements{
@@ -88,6 +88,40 @@ var _ = Describe("Resource pod spec renderer", func() {
 		})
 	})
 
+	Context("WithCPUOverhead option", func() {
+		const numCPUs = 5
+		cpuOverhead := resource.MustParse("200m")
+		var vmi *v1.VirtualMachineInstance
+		BeforeEach(func() {
+			vmi = libvmi.New(libvmi.WithCPUCount(numCPUs, 0, 0))
+		})
+
+		It("adds the overhead to the calculated CPU requests", func() {
+			rr = NewResourceRenderer(nil, nil, WithoutDedicatedCPU(vmi, 10, false), WithCPUOverhead(cpuOverhead))
+			Expect(rr.Requests()).To(HaveKeyWithValue(kubev1.ResourceCPU, addResources(resource.MustParse("500m"), cpuOverhead)))
+			Expect(rr.Limits()).To(BeEmpty())
+		})
+
+		It("adds the overhead to the calculated CPU limits", func() {
+			rr = NewResourceRenderer(nil, nil, WithoutDedicatedCPU(vmi, 10, true), WithCPUOverhead(cpuOverhead))
+			Expect(rr.Requests()).To(HaveKeyWithValue(kubev1.ResourceCPU, addResources(resource.MustParse("500m"), cpuOverhead)))
+			Expect(rr.Limits()).To(HaveKeyWithValue(kubev1.ResourceCPU, addResources(resource.MustParse("5"), cpuOverhead)))
+		})
+
+		It("adds the overhead to the user specified CPU", func() {
+			userSpecifiedCPU := kubev1.ResourceList{kubev1.ResourceCPU: resource.MustParse("2")}
+			rr = NewResourceRenderer(userSpecifiedCPU, userSpecifiedCPU, WithoutDedicatedCPU(vmi, 10, false), WithCPUOverhead(cpuOverhead))
+			Expect(rr.Requests()).To(HaveKeyWithValue(kubev1.ResourceCPU, addResources(resource.MustParse("2"), cpuOverhead)))
+			Expect(rr.Limits()).To(HaveKeyWithValue(kubev1.ResourceCPU, addResources(resource.MustParse("2"), cpuOverhead)))
+		})
+
+		It("keeps the CPU untouched without overhead", func() {
+			rr = NewResourceRenderer(nil, nil, WithoutDedicatedCPU(vmi, 10, false), WithCPUOverhead(resource.Quantity{}))
+			Expect(rr.Requests()).To(HaveKeyWithValue(kubev1.ResourceCPU, resource.MustParse("500m")))
+			Expect(rr.Limits()).To(BeEmpty())
+		})
+	})
+
 	Context("WithMemoryOverhead option", func() {
 		baseMemory := resource.MustParse("64M")
 		memOverhead := resource.MustParse("128M")
@@ -528,6 +528,20 @@ func withHotplugSupport(hotplugDiskDir string) VolumeRendererOption {
 	}
 }
 
+// pluginNetworkInfoVolume projects the network-info of the interfaces bound to the binding plugin,
+// mounted in the plugin sidecar instead of the network-info shared with the compute container.
+func pluginNetworkInfoVolume(pluginName string) k8sv1.Volume {
+	return downwardAPIDirVolume(
+		downwardapi.PluginNetworkInfoVolumeName(pluginName),
+		downwardapi.NetworkInfoVolumePath,
+		fmt.Sprintf("metadata.annotations['%s']", downwardapi.PluginNetworkInfoAnnot(pluginName)),
+	)
+}
+
+func hasPluginNetworkInfo(sidecar hooks.HookSidecar) bool {
+	return sidecar.DownwardAPI == v1.DeviceInfo && sidecar.PluginName != ""
+}
+
 func withNetworkDeviceInfoMapAnnotation() VolumeRendererOption {
 	return func(renderer *VolumeRenderer) error {
 		renderer.podVolumes = append(renderer.podVolumes,
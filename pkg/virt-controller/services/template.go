@@ -21,6 +21,7 @@ package services
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"maps"
 	"math/rand"
@@ -55,8 +56,10 @@ import (
 	"kubevirt.io/kubevirt/pkg/network/downwardapi"
 	"kubevirt.io/kubevirt/pkg/network/istio"
 	"kubevirt.io/kubevirt/pkg/network/multus"
+	"kubevirt.io/kubevirt/pkg/network/netbinding"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
+	"kubevirt.io/kubevirt/pkg/storage/encryption"
 	"kubevirt.io/kubevirt/pkg/storage/reservation"
 	"kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/util"
@@ -76,6 +79,12 @@ const (
 	virtBinDir       = "virt-bin-share-dir"
 	hotplugDisk      = "hotplug-disk"
 	virtExporter     = "virt-exporter"
+
+	hotplugHostDevices = "hotplug-hostdevices"
+	// HotplugHostDevice is the app label value of the attachment pods of hotplugged host devices
+	HotplugHostDevice = "hotplug-hostdevice"
+	// HotplugHostDeviceAnnotation holds the name of the host device an attachment pod was created for
+	HotplugHostDeviceAnnotation = "kubevirt.io/hotplug-hostdevice"
 )
 
 const K8sDevicePrefix = "devices.kubevirt.io"
@@ -92,11 +101,19 @@ const debugLogs = "debugLogs"
 const logVerbosity = "logVerbosity"
 const virtiofsDebugLogs = "virtiofsdDebugLogs"
 
+// The annotations tuning the wait of virt-launcher for the network-info of the downward API
+const (
+	networkInfoPollIntervalAnnotation = "kubevirt.io/network-info-poll-interval"
+	networkInfoTimeoutAnnotation      = "kubevirt.io/network-info-timeout"
+	networkInfoPollBackoffAnnotation  = "kubevirt.io/network-info-poll-backoff"
+)
+
 const qemuTimeoutJitterRange = 120
 
 const (
 	CAP_NET_BIND_SERVICE = "NET_BIND_SERVICE"
 	CAP_SYS_NICE         = "SYS_NICE"
+	CAP_NET_RAW          = "NET_RAW"
 )
 
 // LibvirtStartupDelay is added to custom liveness and readiness probes initial delay value.
@@ -120,6 +137,10 @@ type netMemoryCalculator interface {
 	Calculate(vmi *v1.VirtualMachineInstance, registeredPlugins map[string]v1.InterfaceBindingPlugin) resource.Quantity
 }
 
+type netCPUCalculator interface {
+	Calculate(vmi *v1.VirtualMachineInstance, registeredPlugins map[string]v1.InterfaceBindingPlugin) resource.Quantity
+}
+
 type annotationsGenerator interface {
 	Generate(vmi *v1.VirtualMachineInstance) (map[string]string, error)
 }
@@ -146,8 +167,10 @@ type TemplateService struct {
 
 	sidecarCreators               []SidecarCreatorFunc
 	netMemoryCalculator           netMemoryCalculator
+	netCPUCalculator              netCPUCalculator
 	annotationsGenerators         []annotationsGenerator
 	netTargetAnnotationsGenerator targetAnnotationsGenerator
+	netAttachDefGetter            multus.NetAttachDefGetter
 	launcherHypervisorResources   hypervisor.LauncherHypervisorResources
 }
 
@@ -362,10 +385,11 @@ func (t *TemplateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 	var networkToResourceMap map[string]string
 	if !t.clusterConfig.ExternalNetResourceInjectionEnabled() {
 		var err error
-		networkToResourceMap, err = multus.NetworkToResource(t.virtClient, vmi)
+		networkToResourceMap, err = multus.NetworkToResource(t.netAttachDefGetter, vmi)
 		if err != nil {
 			return nil, err
 		}
+		maps.Copy(networkToResourceMap, netbinding.PodNetworkToResource(vmi, t.clusterConfig.GetNetworkBindings()))
 	}
 	resourceRenderer, err := t.newResourceRenderer(vmi, networkToResourceMap, memoryOverhead)
 	if err != nil {
@@ -383,6 +407,7 @@ func (t *TemplateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 		}
 		requestedHookSidecarList = append(requestedHookSidecarList, sidecars...)
 	}
+	hookSidecarPlugins := hookSidecarPluginsByContainer(requestedHookSidecarList)
 
 	var command []string
 	if tempPod {
@@ -421,6 +446,9 @@ func (t *TemplateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 		if t.clusterConfig.VGPULiveMigrationEnabled() {
 			command = append(command, "--vgpu-dedicated-hook")
 		}
+		if len(hookSidecarPlugins) > 0 {
+			command = append(command, "--hook-sidecar-plugins", formatHookSidecarPlugins(hookSidecarPlugins))
+		}
 		if customDebugFilters, exists := vmi.Annotations[v1.CustomLibvirtLogFiltersAnnotation]; exists {
 			log.Log.Object(vmi).Infof("Applying custom debug filters for vmi %s: %s", vmi.Name, customDebugFilters)
 			command = append(command, "--libvirt-log-filters", customDebugFilters)
@@ -471,6 +499,7 @@ func (t *TemplateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 	if labelValue, ok := vmi.Labels[virtiofsDebugLogs]; (ok && strings.EqualFold(labelValue, "true")) || virtLauncherLogVerbosity > util.EXT_LOG_VERBOSITY_THRESHOLD {
 		compute.Env = append(compute.Env, k8sv1.EnvVar{Name: util.ENV_VAR_VIRTIOFSD_DEBUG_LOGS, Value: "1"})
 	}
+	compute.Env = append(compute.Env, networkInfoWaitEnvVars(vmi)...)
 
 	compute.Env = append(compute.Env, k8sv1.EnvVar{
 		Name: ENV_VAR_POD_NAME,
@@ -480,6 +509,7 @@ func (t *TemplateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 			},
 		},
 	})
+	compute.Env = append(compute.Env, encryption.EnvVars(vmi)...)
 
 	// Make sure the compute container is always the first since the mutating webhook shipped with the sriov operator
 	// for adding the requested resources to the pod will add them to the first container of the list
@@ -501,9 +531,14 @@ func (t *TemplateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 	}
 
 	var sidecarVolumes []k8sv1.Volume
+	scopedNetworkInfo := t.clusterConfig.ScopedNetworkInfoEnabled()
 	for i, requestedHookSidecar := range requestedHookSidecarList {
 		sidecarContainer := newSidecarContainerRenderer(
-			sidecarContainerName(i), vmi, sidecarResources(vmi, t.clusterConfig), requestedHookSidecar, userId).Render(requestedHookSidecar.Command)
+			sidecarContainerName(i), vmi, sidecarResources(vmi, t.clusterConfig, requestedHookSidecar.Resources), requestedHookSidecar, userId, scopedNetworkInfo).Render(requestedHookSidecar.Command)
+
+		if scopedNetworkInfo && hasPluginNetworkInfo(requestedHookSidecar) {
+			sidecarVolumes = append(sidecarVolumes, pluginNetworkInfoVolume(requestedHookSidecar.PluginName))
+		}
 
 		if requestedHookSidecar.ConfigMap != nil {
 			cm, err := t.virtClient.CoreV1().ConfigMaps(vmi.Namespace).Get(context.TODO(), requestedHookSidecar.ConfigMap.Name, metav1.GetOptions{})
@@ -522,6 +557,23 @@ func (t *TemplateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 			}
 			sidecarVolumes = append(sidecarVolumes, vol)
 		}
+		if requestedHookSidecar.Script != nil {
+			cm, err := t.virtClient.CoreV1().ConfigMaps(vmi.Namespace).Get(context.TODO(), requestedHookSidecar.Script.ConfigMapName, metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			if _, exists := cm.Data[requestedHookSidecar.Script.Key]; !exists {
+				return nil, fmt.Errorf("ConfigMap %s does not contain the hook script key %s", cm.Name, requestedHookSidecar.Script.Key)
+			}
+			sidecarVolumes = append(sidecarVolumes, k8sv1.Volume{
+				Name: sidecarScriptVolumeName(sidecarContainerName(i)),
+				VolumeSource: k8sv1.VolumeSource{
+					ConfigMap: &k8sv1.ConfigMapVolumeSource{
+						LocalObjectReference: k8sv1.LocalObjectReference{Name: cm.Name},
+					},
+				},
+			})
+		}
 		if requestedHookSidecar.PVC != nil {
 			volumeSource := k8sv1.VolumeSource{
 				PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
@@ -553,6 +605,14 @@ func (t *TemplateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 		podAnnotations[v1.EphemeralProvisioningObject] = "true"
 	}
 
+	if len(hookSidecarPlugins) > 0 {
+		hookSidecarPluginsJSON, err := json.Marshal(hookSidecarPlugins)
+		if err != nil {
+			return nil, err
+		}
+		podAnnotations[hooks.HookSidecarPluginsAnnotationName] = string(hookSidecarPluginsJSON)
+	}
+
 	if t.clusterConfig.VmiMemoryOverheadReportEnabled() {
 		podAnnotations[v1.MemoryOverheadAnnotationBytes] = strconv.FormatInt(memoryOverhead.Value(), 10)
 	}
@@ -737,6 +797,10 @@ func (t *TemplateService) newNodeSelectorRenderer(vmi *v1.VirtualMachineInstance
 		opts = append(opts, WithTSCTimer(vmi.Status.TopologyHints.TSCFrequency))
 	}
 
+	if t.clusterConfig.HostFeatureSchedulingEnabled() {
+		opts = append(opts, WithHostFeatureLabels(HostFeatureLabelsFromVMI(vmi)...))
+	}
+
 	if vmi.IsRealtimeEnabled() {
 		log.Log.V(4).Info("Add realtime node label selector")
 		opts = append(opts, WithRealtime())
@@ -780,10 +844,10 @@ func initContainerVolumeMount() k8sv1.VolumeMount {
 	}
 }
 
-func newSidecarContainerRenderer(sidecarName string, vmiSpec *v1.VirtualMachineInstance, resources k8sv1.ResourceRequirements, requestedHookSidecar hooks.HookSidecar, userId int64) *ContainerSpecRenderer {
+func newSidecarContainerRenderer(sidecarName string, vmiSpec *v1.VirtualMachineInstance, resources k8sv1.ResourceRequirements, requestedHookSidecar hooks.HookSidecar, userId int64, scopedNetworkInfo bool) *ContainerSpecRenderer {
 	sidecarOpts := []Option{
 		WithResourceRequirements(resources),
-		WithArgs(requestedHookSidecar.Args),
+		WithArgs(sidecarArgs(requestedHookSidecar)),
 		WithExtraEnvVars([]k8sv1.EnvVar{
 			k8sv1.EnvVar{
 				Name:  hooks.ContainerNameEnvVar,
@@ -793,12 +857,17 @@ func newSidecarContainerRenderer(sidecarName string, vmiSpec *v1.VirtualMachineI
 
 	var mounts []k8sv1.VolumeMount
 	mounts = append(mounts, sidecarVolumeMount(sidecarName))
-	if requestedHookSidecar.DownwardAPI == v1.DeviceInfo {
+	if scopedNetworkInfo && hasPluginNetworkInfo(requestedHookSidecar) {
+		mounts = append(mounts, mountPath(downwardapi.PluginNetworkInfoVolumeName(requestedHookSidecar.PluginName), downwardapi.MountPath))
+	} else if requestedHookSidecar.DownwardAPI == v1.DeviceInfo {
 		mounts = append(mounts, mountPath(downwardapi.NetworkInfoVolumeName, downwardapi.MountPath))
 	}
 	if requestedHookSidecar.ConfigMap != nil {
 		mounts = append(mounts, configMapVolumeMount(*requestedHookSidecar.ConfigMap))
 	}
+	if requestedHookSidecar.Script != nil {
+		mounts = append(mounts, scriptVolumeMount(sidecarName, *requestedHookSidecar.Script))
+	}
 	if requestedHookSidecar.PVC != nil {
 		mounts = append(mounts, pvcVolumeMount(*requestedHookSidecar.PVC))
 	}
@@ -950,6 +1019,40 @@ func configMapVolumeMount(v hooks.ConfigMap) k8sv1.VolumeMount {
 	}
 }
 
+func scriptVolumeMount(sidecarName string, v hooks.Script) k8sv1.VolumeMount {
+	return k8sv1.VolumeMount{
+		Name:      sidecarScriptVolumeName(sidecarName),
+		MountPath: hooks.ScriptHookPath,
+		SubPath:   v.Key,
+		ReadOnly:  true,
+	}
+}
+
+func sidecarScriptVolumeName(sidecarName string) string {
+	return sidecarName + "-script"
+}
+
+// sidecarArgs tells the sidecar-shim which hook the script of a script hook implements
+// and how to run it
+func sidecarArgs(requestedHookSidecar hooks.HookSidecar) []string {
+	script := requestedHookSidecar.Script
+	if script == nil {
+		return requestedHookSidecar.Args
+	}
+
+	args := []string{"--version", hooks.ScriptHookDefaultVersion}
+	if len(requestedHookSidecar.Args) > 0 {
+		args = append([]string{}, requestedHookSidecar.Args...)
+	}
+
+	interpreter := script.Interpreter
+	if interpreter == "" {
+		interpreter = hooks.ScriptInterpreterShell
+	}
+
+	return append(args, "--script-hook", script.Hook, "--script-interpreter", string(interpreter))
+}
+
 func pvcVolumeMount(v hooks.PVC) k8sv1.VolumeMount {
 	return k8sv1.VolumeMount{
 		Name:      v.Name,
@@ -964,10 +1067,44 @@ func gracePeriodInSeconds(vmi *v1.VirtualMachineInstance) int64 {
 	return v1.DefaultGracePeriodSeconds
 }
 
+// networkInfoWaitEnvVars passes the network-info wait tuning of the VMI to virt-launcher
+func networkInfoWaitEnvVars(vmi *v1.VirtualMachineInstance) []k8sv1.EnvVar {
+	var envVars []k8sv1.EnvVar
+	for _, tunable := range []struct{ annotation, envVarName string }{
+		{networkInfoPollIntervalAnnotation, util.ENV_VAR_NETWORK_INFO_POLL_INTERVAL},
+		{networkInfoTimeoutAnnotation, util.ENV_VAR_NETWORK_INFO_TIMEOUT},
+		{networkInfoPollBackoffAnnotation, util.ENV_VAR_NETWORK_INFO_POLL_BACKOFF},
+	} {
+		if value, ok := vmi.Annotations[tunable.annotation]; ok {
+			envVars = append(envVars, k8sv1.EnvVar{Name: tunable.envVarName, Value: value})
+		}
+	}
+	return envVars
+}
+
 func sidecarContainerName(i int) string {
 	return fmt.Sprintf("hook-sidecar-%d", i)
 }
 
+// hookSidecarPluginsByContainer returns the plugin names of the hook sidecars running a plugin, by sidecar container name
+func hookSidecarPluginsByContainer(requestedHookSidecarList hooks.HookSidecarList) map[string]string {
+	plugins := map[string]string{}
+	for i, requestedHookSidecar := range requestedHookSidecarList {
+		if requestedHookSidecar.PluginName != "" {
+			plugins[sidecarContainerName(i)] = requestedHookSidecar.PluginName
+		}
+	}
+	return plugins
+}
+
+func formatHookSidecarPlugins(plugins map[string]string) string {
+	var pairs []string
+	for _, containerName := range slices.Sorted(maps.Keys(plugins)) {
+		pairs = append(pairs, containerName+"="+plugins[containerName])
+	}
+	return strings.Join(pairs, ",")
+}
+
 func (t *TemplateService) RenderHotplugAttachmentPodTemplate(volumes []*v1.Volume, ownerPod *k8sv1.Pod, vmi *v1.VirtualMachineInstance, claimMap map[string]*k8sv1.PersistentVolumeClaim) (*k8sv1.Pod, error) {
 	zero := int64(0)
 	runUser := int64(util.NonRootUID)
@@ -1222,6 +1359,103 @@ func (t *TemplateService) RenderHotplugAttachmentTriggerPodTemplate(volume *v1.V
 	return pod, nil
 }
 
+// RenderHotplugHostDeviceAttachmentPodTemplate renders a pod requesting the resource of a hotplugged host device on
+// the node of the virt-launcher pod. The pod reports the PCI address the device plugin allocated to it, so that
+// virt-handler can expose the device to the virt-launcher pod.
+func (t *TemplateService) RenderHotplugHostDeviceAttachmentPodTemplate(hostDevice *v1.HostDevice, ownerPod *k8sv1.Pod, vmi *v1.VirtualMachineInstance) (*k8sv1.Pod, error) {
+	zero := int64(0)
+	runUser := int64(util.NonRootUID)
+	pciAddressEnvVar := util.ResourceNameToEnvVar(v1.PCIResourcePrefix, hostDevice.DeviceName)
+	command := []string{"/bin/sh", "-c",
+		fmt.Sprintf("echo \"${%s}\" > /path/pci-address && /usr/bin/container-disk --copy-path /path/hp", pciAddressEnvVar)}
+
+	tolerations := append(hotplugPodTolerations(), ownerPod.Spec.Tolerations...)
+
+	// Remove duplicates
+	sort.Slice(tolerations, func(i, j int) bool {
+		return tolerations[i].Key < tolerations[j].Key
+	})
+	tolerations = slices.Compact(tolerations)
+
+	resources := hotplugContainerResourceRequirementsForVMI(t.clusterConfig)
+	resources.Limits[k8sv1.ResourceName(hostDevice.DeviceName)] = *resource.NewQuantity(1, resource.DecimalSI)
+	resources.Requests[k8sv1.ResourceName(hostDevice.DeviceName)] = *resource.NewQuantity(1, resource.DecimalSI)
+
+	pod := &k8sv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "hp-hostdevice-",
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(ownerPod, schema.GroupVersionKind{
+					Group:   k8sv1.SchemeGroupVersion.Group,
+					Version: k8sv1.SchemeGroupVersion.Version,
+					Kind:    "Pod",
+				}),
+			},
+			Labels: map[string]string{
+				v1.AppLabel: HotplugHostDevice,
+			},
+			Annotations: map[string]string{
+				HotplugHostDeviceAnnotation: hostDevice.Name,
+			},
+		},
+		Spec: k8sv1.PodSpec{
+			Containers: []k8sv1.Container{
+				{
+					Name:      HotplugHostDevice,
+					Image:     t.launcherImage,
+					Command:   command,
+					Resources: resources,
+					SecurityContext: &k8sv1.SecurityContext{
+						AllowPrivilegeEscalation: pointer.P(false),
+						RunAsNonRoot:             pointer.P(true),
+						RunAsUser:                &runUser,
+						SeccompProfile: &k8sv1.SeccompProfile{
+							Type: k8sv1.SeccompProfileTypeRuntimeDefault,
+						},
+						Capabilities: &k8sv1.Capabilities{
+							Drop: []k8sv1.Capability{"ALL"},
+						},
+						SELinuxOptions: &k8sv1.SELinuxOptions{
+							Level: "s0",
+						},
+					},
+					VolumeMounts: []k8sv1.VolumeMount{
+						{
+							Name:      hotplugHostDevices,
+							MountPath: "/path",
+						},
+					},
+				},
+			},
+			Affinity: &k8sv1.Affinity{
+				NodeAffinity: &k8sv1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &k8sv1.NodeSelector{
+						NodeSelectorTerms: []k8sv1.NodeSelectorTerm{
+							{
+								MatchExpressions: []k8sv1.NodeSelectorRequirement{
+									{
+										Key:      k8sv1.LabelHostname,
+										Operator: k8sv1.NodeSelectorOpIn,
+										Values:   []string{ownerPod.Spec.NodeName},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Tolerations:                   tolerations,
+			Volumes:                       []k8sv1.Volume{emptyDirVolume(hotplugHostDevices)},
+			TerminationGracePeriodSeconds: &zero,
+		},
+	}
+
+	if err := matchSELinuxLevelOfVMI(pod, vmi); err != nil {
+		return nil, err
+	}
+	return pod, nil
+}
+
 func (t *TemplateService) RenderExporterManifest(vmExport *exportv1.VirtualMachineExport, namePrefix string) *k8sv1.Pod {
 	exporterPod := &k8sv1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
@@ -1324,6 +1558,7 @@ func NewTemplateService(launcherImage string,
 		resourceQuotaStore:          resourceQuotaStore,
 		namespaceStore:              namespaceStore,
 		launcherHypervisorResources: hypervisor.NewLauncherHypervisorResources(clusterConfig.GetHypervisor().Name),
+		netAttachDefGetter:          multus.NewNetAttachDefClientGetter(virtClient),
 	}
 
 	for _, opt := range opts {
@@ -1364,6 +1599,32 @@ func wrapGuestAgentPingWithVirtProbe(vmi *v1.VirtualMachineInstance, probe *k8sv
 	return
 }
 
+func wrapGuestAgentExecWithVirtProbe(vmi *v1.VirtualMachineInstance, guestAgentExec *v1.GuestAgentExec, probe *k8sv1.Probe) {
+	if len(guestAgentExec.Command) < 1 {
+		return
+	}
+
+	execCommand := []string{
+		"virt-probe",
+		"--domainName", api.VMINamespaceKeyFunc(vmi),
+		"--timeoutSeconds", strconv.FormatInt(int64(probe.TimeoutSeconds), 10),
+		"--command", guestAgentExec.Command[0],
+	}
+	if len(guestAgentExec.SuccessExitCodes) > 0 {
+		exitCodes := make([]string, 0, len(guestAgentExec.SuccessExitCodes))
+		for _, exitCode := range guestAgentExec.SuccessExitCodes {
+			exitCodes = append(exitCodes, strconv.FormatInt(int64(exitCode), 10))
+		}
+		execCommand = append(execCommand, "--successExitCodes", strings.Join(exitCodes, ","))
+	}
+	execCommand = append(execCommand, "--")
+	execCommand = append(execCommand, guestAgentExec.Command[1:]...)
+
+	probe.ProbeHandler.Exec = &k8sv1.ExecAction{Command: execCommand}
+	// we add 1s to the pod probe to compensate for the additional steps in probing
+	probe.TimeoutSeconds += 1
+}
+
 func alignPodMultiCategorySecurity(pod *k8sv1.Pod, selinuxType string, dockerSELinuxMCSWorkaround bool) {
 	if selinuxType == "" && !dockerSELinuxMCSWorkaround {
 		// No SELinux type and no docker workaround, nothing to do
@@ -1540,6 +1801,8 @@ func (t *TemplateService) VMIResourcePredicates(vmi *v1.VirtualMachineInstance,
 			NewVMIResourceRule(emptyMemoryRequest, WithMemoryRequests(vmi.Spec.Domain.Memory, t.clusterConfig.GetMemoryOvercommit())),
 			NewVMIResourceRule(doesVMIRequireDedicatedCPU, WithCPUPinning(vmi, vmi.Annotations, additionalCPUs)),
 			NewVMIResourceRule(not(doesVMIRequireDedicatedCPU), WithoutDedicatedCPU(vmi, t.clusterConfig.GetCPUAllocationRatio(), withCPULimits)),
+			// Dedicated CPUs are whole, they are left out of the fractional overhead of the binding plugins
+			NewVMIResourceRule(not(doesVMIRequireDedicatedCPU), WithCPUOverhead(t.calculateNetCPUOverhead(vmi))),
 			NewVMIResourceRule(hasHugePages, WithHugePages(vmi.Spec.Domain.Memory, memoryOverhead)),
 			NewVMIResourceRule(not(hasHugePages), WithMemoryOverhead(vmi.Spec.Domain.Resources, memoryOverhead)),
 			NewVMIResourceRule(t.doesVMIRequireAutoMemoryLimits, WithAutoMemoryLimits(vmi.Namespace, t.namespaceStore)),
@@ -1581,6 +1844,13 @@ func CalculateMemoryOverhead(clusterConfig *virtconfig.ClusterConfig, netMemoryC
 	return memoryOverhead
 }
 
+func (t *TemplateService) calculateNetCPUOverhead(vmi *v1.VirtualMachineInstance) resource.Quantity {
+	if t.netCPUCalculator == nil {
+		return resource.Quantity{}
+	}
+	return t.netCPUCalculator.Calculate(vmi, t.clusterConfig.GetNetworkBindings())
+}
+
 func (t *TemplateService) doesVMIRequireAutoMemoryLimits(vmi *v1.VirtualMachineInstance) bool {
 	return t.doesVMIRequireAutoResourceLimits(vmi, k8sv1.ResourceMemory)
 }
@@ -1641,6 +1911,12 @@ func WithNetMemoryCalculator(netMemoryCalculator netMemoryCalculator) templateSe
 	}
 }
 
+func WithNetCPUCalculator(netCPUCalculator netCPUCalculator) templateServiceOption {
+	return func(service *TemplateService) {
+		service.netCPUCalculator = netCPUCalculator
+	}
+}
+
 func WithAnnotationsGenerators(generators ...annotationsGenerator) templateServiceOption {
 	return func(service *TemplateService) {
 		service.annotationsGenerators = append(service.annotationsGenerators, generators...)
@@ -1653,6 +1929,12 @@ func WithNetTargetAnnotationsGenerator(generator targetAnnotationsGenerator) tem
 	}
 }
 
+func WithNetAttachDefGetter(getter multus.NetAttachDefGetter) templateServiceOption {
+	return func(service *TemplateService) {
+		service.netAttachDefGetter = getter
+	}
+}
+
 func hasHugePages(vmi *v1.VirtualMachineInstance) bool {
 	return vmi.Spec.Domain.Memory != nil && vmi.Spec.Domain.Memory.Hugepages != nil
 }
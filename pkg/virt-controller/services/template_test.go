@@ -57,6 +57,7 @@ import (
 	containerdisk "kubevirt.io/kubevirt/pkg/container-disk"
 	"kubevirt.io/kubevirt/pkg/hooks"
 	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/network/downwardapi"
 	"kubevirt.io/kubevirt/pkg/network/istio"
 	"kubevirt.io/kubevirt/pkg/network/multus"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
@@ -719,6 +720,29 @@ var _ = Describe("Template", func() {
 			})
 		})
 
+		It("should pass the network-info wait annotations to the compute container", func() {
+			config, kvStore, svc = configFactory(defaultArch)
+			vmi := v1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "testvmi",
+					Namespace: "default",
+					UID:       "1234",
+					Annotations: map[string]string{
+						networkInfoTimeoutAnnotation:     "10s",
+						networkInfoPollBackoffAnnotation: "true",
+					},
+				},
+			}
+
+			pod, err := svc.RenderLaunchManifest(&vmi)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pod.Spec.Containers[0].Env).To(ContainElements(
+				k8sv1.EnvVar{Name: util.ENV_VAR_NETWORK_INFO_TIMEOUT, Value: "10s"},
+				k8sv1.EnvVar{Name: util.ENV_VAR_NETWORK_INFO_POLL_BACKOFF, Value: "true"},
+			))
+			Expect(pod.Spec.Containers[0].Env).ToNot(ContainElement(HaveField("Name", util.ENV_VAR_NETWORK_INFO_POLL_INTERVAL)))
+		})
+
 		Context("with access credentials", func() {
 			It("should add volume with secret referenced by cloud-init user secret ref", func() {
 				config, kvStore, svc = configFactory(defaultArch)
@@ -1681,7 +1705,7 @@ var _ = Describe("Template", func() {
 						DedicatedCPUPlacement: true,
 					}
 				}
-				res := sidecarResources(&vmi, clusterConfig)
+				res := sidecarResources(&vmi, clusterConfig, nil)
 				Expect(res.Requests).To(BeEquivalentTo(expectedReq))
 				Expect(res.Limits).To(BeEquivalentTo(expectedLim))
 			},
@@ -1731,6 +1755,67 @@ var _ = Describe("Template", func() {
 					}, true),
 			)
 
+			DescribeTable("should apply the sidecar resources of the plugin registration", func(pluginResources *v1.ResourceRequirementsWithoutClaims, expectedReq, expectedLim k8sv1.ResourceList, dedicatedCpu bool) {
+				kvConfig := &v1.KubeVirtConfiguration{
+					SupportContainerResources: []v1.SupportContainerResources{{
+						Type: v1.SideCar,
+						Resources: v1.ResourceRequirementsWithoutClaims{
+							Requests: k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("50m")},
+							Limits:   k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse("40M")},
+						},
+					}},
+				}
+				clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(kvConfig)
+
+				vmi := v1.VirtualMachineInstance{}
+				if dedicatedCpu {
+					vmi.Spec.Domain.CPU = &v1.CPU{DedicatedCPUPlacement: true}
+				}
+				res := sidecarResources(&vmi, clusterConfig, pluginResources)
+				Expect(res.Requests).To(BeEquivalentTo(expectedReq))
+				Expect(res.Limits).To(BeEquivalentTo(expectedLim))
+			},
+				Entry("without plugin resources, should return the support container values", nil,
+					k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("50m")},
+					k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse("40M")},
+					false),
+				Entry("no dedicated cpu, should override the support container values", &v1.ResourceRequirementsWithoutClaims{
+					Requests: k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("20m")},
+					Limits: k8sv1.ResourceList{
+						k8sv1.ResourceCPU:    resource.MustParse("500m"),
+						k8sv1.ResourceMemory: resource.MustParse("128M"),
+					},
+				},
+					k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("20m")},
+					k8sv1.ResourceList{
+						k8sv1.ResourceCPU:    resource.MustParse("500m"),
+						k8sv1.ResourceMemory: resource.MustParse("128M"),
+					},
+					false),
+				Entry("dedicated cpu, should set the requests to the plugin limits", &v1.ResourceRequirementsWithoutClaims{
+					Requests: k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("20m")},
+					Limits: k8sv1.ResourceList{
+						k8sv1.ResourceCPU:    resource.MustParse("500m"),
+						k8sv1.ResourceMemory: resource.MustParse("128M"),
+					},
+				},
+					k8sv1.ResourceList{
+						k8sv1.ResourceCPU:    resource.MustParse("500m"),
+						k8sv1.ResourceMemory: resource.MustParse("128M"),
+					},
+					k8sv1.ResourceList{
+						k8sv1.ResourceCPU:    resource.MustParse("500m"),
+						k8sv1.ResourceMemory: resource.MustParse("128M"),
+					},
+					true),
+				Entry("should ignore resources other than cpu and memory", &v1.ResourceRequirementsWithoutClaims{
+					Limits: k8sv1.ResourceList{k8sv1.ResourceEphemeralStorage: resource.MustParse("1G")},
+				},
+					k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("50m")},
+					k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse("40M")},
+					false),
+			)
+
 			DescribeTable("when isolateEmulatorThread requested", func(
 				annotations map[string]string, requestedCores uint32, expectedCPULimits string) {
 				config, kvStore, svc = configFactory(defaultArch)
@@ -3075,6 +3160,38 @@ var _ = Describe("Template", func() {
 			}))
 		})
 
+		It("should report the plugins of the hook sidecars", func() {
+			config, _, _ := testutils.NewFakeClusterConfigUsingKVWithCPUArch(kv, defaultArch)
+			pluginSidecarCreator := func(_ *v1.VirtualMachineInstance, _ *v1.KubeVirtConfiguration) (hooks.HookSidecarList, error) {
+				return hooks.HookSidecarList{{Image: "user-hook"}, {Image: "plugin-sidecar", PluginName: "plugin1"}}, nil
+			}
+			svc = NewTemplateService("kubevirt/virt-launcher",
+				240,
+				"/var/run/kubevirt",
+				"/var/run/kubevirt-ephemeral-disks",
+				"/var/run/kubevirt/container-disks",
+				v1.HotplugDiskDir,
+				"pull-secret-1",
+				pvcCache,
+				virtClient,
+				config,
+				qemuGid,
+				"kubevirt/vmexport",
+				resourceQuotaStore,
+				namespaceStore,
+				WithSidecarCreator(pluginSidecarCreator),
+				WithNetMemoryCalculator(&stubNetMemoryCalculator{}),
+			)
+			vmi := v1.VirtualMachineInstance{ObjectMeta: metav1.ObjectMeta{
+				Name: "testvmi", Namespace: "default", UID: "1234",
+			}}
+			pod, err := svc.RenderLaunchManifest(&vmi)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(pod.Annotations).To(HaveKeyWithValue(hooks.HookSidecarPluginsAnnotationName, `{"hook-sidecar-1":"plugin1"}`))
+			Expect(pod.Spec.Containers[0].Command).To(ContainElements("--hook-sidecar-plugins", "hook-sidecar-1=plugin1"))
+		})
+
 		Context("with pod networking", func() {
 			It("Should require tun device by default", func() {
 				config, kvStore, svc = configFactory(defaultArch)
@@ -4382,6 +4499,25 @@ var _ = Describe("Template", func() {
 				vmi.Status.RuntimeUser = uint64(nonRootUser)
 				return vmi
 			}, "compute", []k8sv1.Capability{CAP_NET_BIND_SERVICE}, []k8sv1.Capability{"ALL"}),
+			Entry("on a root virt-launcher with router advertisements", func() *v1.VirtualMachineInstance {
+				vmi := api.NewMinimalVMI("fake-vmi")
+				vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+					Name:                   "default",
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+					RouterAdvertisement:    &v1.InterfaceRouterAdvertisement{},
+				}}
+				return vmi
+			}, "compute", []k8sv1.Capability{CAP_NET_BIND_SERVICE, CAP_SYS_NICE, CAP_NET_RAW}, nil),
+			Entry("on a non-root virt-launcher with router advertisements", func() *v1.VirtualMachineInstance {
+				vmi := api.NewMinimalVMI("fake-vmi")
+				vmi.Status.RuntimeUser = uint64(nonRootUser)
+				vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+					Name:                   "default",
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+					RouterAdvertisement:    &v1.InterfaceRouterAdvertisement{},
+				}}
+				return vmi
+			}, "compute", []k8sv1.Capability{CAP_NET_BIND_SERVICE, CAP_NET_RAW}, []k8sv1.Capability{"ALL"}),
 			Entry("on a sidecar container", func() *v1.VirtualMachineInstance {
 				vmi := api.NewMinimalVMI("fake-vmi")
 				vmi.Status.RuntimeUser = uint64(nonRootUser)
@@ -4461,6 +4597,28 @@ var _ = Describe("Template", func() {
 			}))
 		})
 
+		It("should request the host device resource when rendering host device attachment pods", func() {
+			vmi := api.NewMinimalVMI("fake-vmi")
+			ownerPod, err := svc.RenderLaunchManifest(vmi)
+			Expect(err).ToNot(HaveOccurred())
+			ownerPod.Spec.NodeName = "node01"
+
+			vmi.Status.SelinuxContext = "test_u:test_r:test_t:s0"
+			hostDevice := &v1.HostDevice{Name: "nic1", DeviceName: "vendor.com/nic"}
+			pod, err := svc.RenderHotplugHostDeviceAttachmentPodTemplate(hostDevice, ownerPod, vmi)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(pod.Annotations).To(HaveKeyWithValue(HotplugHostDeviceAnnotation, "nic1"))
+			Expect(pod.Labels).To(HaveKeyWithValue(v1.AppLabel, HotplugHostDevice))
+			Expect(metav1.IsControlledBy(pod, ownerPod)).To(BeTrue())
+			container := pod.Spec.Containers[0]
+			Expect(container.Resources.Limits.Name("vendor.com/nic", resource.DecimalSI).Value()).To(Equal(int64(1)))
+			Expect(container.Resources.Requests.Name("vendor.com/nic", resource.DecimalSI).Value()).To(Equal(int64(1)))
+			Expect(container.Command[2]).To(ContainSubstring("${PCI_RESOURCE_VENDOR_COM_NIC}"))
+			Expect(pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions[0].Values).
+				To(ConsistOf("node01"))
+		})
+
 		It("should compute the correct tolerations when rendering hotplug attachment pods", func() {
 			vmi := api.NewMinimalVMI("fake-vmi")
 			duplicateToleration := []k8sv1.Toleration{{Key: "test", Operator: k8sv1.TolerationOpExists, Effect: k8sv1.TaintEffectNoSchedule},
@@ -4874,6 +5032,62 @@ var _ = Describe("Template", func() {
 			})
 		})
 
+		Context("with script hook in VMI annotations for sidecar", func() {
+			var vmi *v1.VirtualMachineInstance
+
+			BeforeEach(func() {
+				vmi = api.NewMinimalVMI("script-sidecar-test")
+				vmi.Annotations = map[string]string{
+					hooks.HookSidecarListAnnotationName: `[{"script": {"configMapName": "test-cm", "key": "hook.py",
+"hook": "onDefineDomain", "interpreter": "python3"}}]`,
+				}
+				k8sClient := k8sfake.NewSimpleClientset()
+				k8sClient.Fake.PrependReactor("get", "configmaps", func(action testing.Action) (handled bool, obj k8sruntime.Object, err error) {
+					cm := k8sv1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "test-cm",
+						},
+						Data: map[string]string{"hook.py": "some-script"},
+					}
+					return true, &cm, nil
+				})
+				virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+			})
+
+			It("should mount the script in the sidecar and run it with the requested interpreter", func() {
+				config, kvStore, svc = configFactory(defaultArch)
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(pod.Spec.Volumes).To(ContainElement(k8sv1.Volume{
+					Name: "hook-sidecar-0-script",
+					VolumeSource: k8sv1.VolumeSource{
+						ConfigMap: &k8sv1.ConfigMapVolumeSource{
+							LocalObjectReference: k8sv1.LocalObjectReference{Name: "test-cm"},
+						},
+					},
+				}))
+				Expect(pod.Spec.Containers[1].VolumeMounts).To(ContainElement(k8sv1.VolumeMount{
+					Name:      "hook-sidecar-0-script",
+					MountPath: hooks.ScriptHookPath,
+					SubPath:   "hook.py",
+					ReadOnly:  true,
+				}))
+				Expect(pod.Spec.Containers[1].Args).To(Equal([]string{
+					"--version", hooks.ScriptHookDefaultVersion,
+					"--script-hook", "onDefineDomain",
+					"--script-interpreter", "python3",
+				}))
+			})
+
+			It("should fail when the ConfigMap does not contain the script", func() {
+				vmi.Annotations[hooks.HookSidecarListAnnotationName] = `[{"script": {"configMapName": "test-cm", "key": "missing.sh", "hook": "onDefineDomain"}}]`
+				config, kvStore, svc = configFactory(defaultArch)
+				_, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).To(MatchError(ContainSubstring("does not contain the hook script key missing.sh")))
+			})
+		})
+
 		Context("with pvc in VMI annotations for sidecar", func() {
 			var vmi *v1.VirtualMachineInstance
 			const (
@@ -5115,6 +5329,33 @@ var _ = Describe("Template", func() {
 				),
 			)
 		})
+
+		Context("with encrypted disks", func() {
+			It("should expose the passphrase Secret key to the compute container", func() {
+				Expect(pvcCache.Add(&k8sv1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{Name: "luks-pvc", Namespace: metav1.NamespaceDefault},
+				})).To(Succeed())
+
+				config, kvStore, svc = configFactory(defaultArch)
+				vmi := libvmi.New(
+					libvmi.WithNamespace(metav1.NamespaceDefault),
+					libvmi.WithPersistentVolumeClaim("luks-disk", "luks-pvc"),
+				)
+				vmi.Spec.Domain.Devices.Disks[0].Encryption = &v1.DiskEncryption{SecretName: "luks-secret"}
+
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pod.Spec.Containers[0].Env).To(ContainElement(k8sv1.EnvVar{
+					Name: "KUBEVIRT_DISK_PASSPHRASE_LUKS_DISK",
+					ValueFrom: &k8sv1.EnvVarSource{
+						SecretKeyRef: &k8sv1.SecretKeySelector{
+							LocalObjectReference: k8sv1.LocalObjectReference{Name: "luks-secret"},
+							Key:                  "passphrase",
+						},
+					},
+				}))
+			})
+		})
 	})
 
 	Describe("ServiceAccountName", func() {
@@ -5691,6 +5932,63 @@ var _ = Describe("Template", func() {
 				},
 			),
 		)
+		DescribeTable("binding plugin sidecar network-info mount", func(scopedNetworkInfo bool, expectedVolumeName, expectedAnnotation string) {
+			kvConfig := kv.DeepCopy()
+			kvConfig.Spec.Configuration.NetworkConfiguration = &v1.NetworkConfiguration{Binding: map[string]v1.InterfaceBindingPlugin{
+				deviceInfoPlugin: {DownwardAPI: v1.DeviceInfo},
+			}}
+			if scopedNetworkInfo {
+				kvConfig.Spec.Configuration.DeveloperConfiguration.FeatureGates = []string{featuregate.ScopedNetworkInfo}
+			}
+			config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&kvConfig.Spec.Configuration)
+
+			pluginSidecarCreator := func(_ *v1.VirtualMachineInstance, _ *v1.KubeVirtConfiguration) (hooks.HookSidecarList, error) {
+				return hooks.HookSidecarList{{Image: "plugin-sidecar", PluginName: deviceInfoPlugin, DownwardAPI: v1.DeviceInfo}}, nil
+			}
+			svc = NewTemplateService("kubevirt/virt-launcher",
+				240,
+				"/var/run/kubevirt",
+				"/var/run/kubevirt-ephemeral-disks",
+				"/var/run/kubevirt/container-disks",
+				v1.HotplugDiskDir,
+				"pull-secret-1",
+				pvcCache,
+				virtClient,
+				config,
+				qemuGid,
+				"kubevirt/vmexport",
+				resourceQuotaStore,
+				namespaceStore,
+				WithSidecarCreator(pluginSidecarCreator),
+				WithNetMemoryCalculator(&stubNetMemoryCalculator{}),
+			)
+
+			vmi := libvmi.New(libvmi.WithNamespace("default"),
+				libvmi.WithNetwork(libvmi.MultusNetwork("network1", "default/default")),
+				libvmi.WithInterface(libvmi.InterfaceWithBindingPlugin("network1", v1.PluginBinding{Name: deviceInfoPlugin})),
+			)
+			pod, err := svc.RenderLaunchManifest(vmi)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(pod.Spec.Volumes).To(ContainElement(k8sv1.Volume{
+				Name: expectedVolumeName,
+				VolumeSource: k8sv1.VolumeSource{DownwardAPI: &k8sv1.DownwardAPIVolumeSource{Items: []k8sv1.DownwardAPIVolumeFile{{
+					Path:     "network-info",
+					FieldRef: &k8sv1.ObjectFieldSelector{FieldPath: fmt.Sprintf("metadata.annotations['%s']", expectedAnnotation)},
+				}}}},
+			}))
+
+			Expect(pod.Spec.Containers[0].Name).To(Equal("compute"))
+			Expect(pod.Spec.Containers[0].VolumeMounts).To(ContainElement(networkInfoAnnotVolumeMount()))
+			Expect(pod.Spec.Containers[0].VolumeMounts).ToNot(ContainElement(HaveField("Name", downwardapi.PluginNetworkInfoVolumeName(deviceInfoPlugin))),
+				"compute should not mount the network-info of the binding plugin")
+
+			Expect(pod.Spec.Containers[1].VolumeMounts).To(ContainElement(k8sv1.VolumeMount{Name: expectedVolumeName, MountPath: "/etc/podinfo"}))
+		},
+			Entry("should mount the shared network-info", false, "network-info-annotation", "kubevirt.io/network-info"),
+			Entry("should mount the network-info of the plugin when scoped network info is enabled", true,
+				downwardapi.PluginNetworkInfoVolumeName(deviceInfoPlugin), downwardapi.PluginNetworkInfoAnnot(deviceInfoPlugin)),
+		)
 	})
 
 	Context("Network binding plugin", func() {
@@ -5745,6 +6043,93 @@ var _ = Describe("Template", func() {
 
 			Expect(netBindingPluginMemoryOverheadCalculator.calculatedMemoryOverhead).To(BeTrue())
 		})
+
+		DescribeTable("Should add the CPU overhead of the binding plugins to the compute container",
+			func(vmiOptions []libvmi.Option, expectedCPURequest string) {
+				config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&kv.Spec.Configuration)
+				svc = NewTemplateService("kubevirt/virt-launcher",
+					240,
+					"/var/run/kubevirt",
+					"/var/run/kubevirt-ephemeral-disks",
+					"/var/run/kubevirt/container-disks",
+					v1.HotplugDiskDir,
+					"pull-secret-1",
+					pvcCache,
+					virtClient,
+					config,
+					qemuGid,
+					"kubevirt/vmexport",
+					resourceQuotaStore,
+					namespaceStore,
+					WithSidecarCreator(testSidecarCreator),
+					WithNetCPUCalculator(stubNetCPUCalculator{cpuOverhead: resource.MustParse("300m")}),
+				)
+
+				vmi := libvmi.New(append([]libvmi.Option{libvmi.WithNamespace("default")}, vmiOptions...)...)
+
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).ToNot(HaveOccurred())
+
+				cpuRequest := pod.Spec.Containers[0].Resources.Requests[k8sv1.ResourceCPU]
+				Expect(cpuRequest.String()).To(Equal(expectedCPURequest))
+			},
+			Entry("on top of the CPU requested for the vCPUs", []libvmi.Option{libvmi.WithCPUCount(2, 0, 0)}, "500m"),
+			Entry("but not on top of dedicated CPUs",
+				[]libvmi.Option{
+					libvmi.WithCPUCount(2, 0, 0),
+					libvmi.WithDedicatedCPUPlacement(),
+					libvmi.WithMemoryRequest("1Gi"),
+				},
+				"2",
+			),
+		)
+
+		It("Should request the device plugin resource of the binding plugin handling the pod network", func() {
+			const (
+				pluginName   = "vdpa"
+				resourceName = "vendor.com/vdpa"
+			)
+
+			kvConfig := kv.DeepCopy()
+			kvConfig.Spec.Configuration.NetworkConfiguration = &v1.NetworkConfiguration{
+				Binding: map[string]v1.InterfaceBindingPlugin{
+					pluginName: {DownwardAPI: v1.DeviceInfo, ResourceName: resourceName},
+				},
+			}
+
+			config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&kvConfig.Spec.Configuration)
+
+			svc = NewTemplateService("kubevirt/virt-launcher",
+				240,
+				"/var/run/kubevirt",
+				"/var/run/kubevirt-ephemeral-disks",
+				"/var/run/kubevirt/container-disks",
+				v1.HotplugDiskDir,
+				"pull-secret-1",
+				pvcCache,
+				virtClient,
+				config,
+				qemuGid,
+				"kubevirt/vmexport",
+				resourceQuotaStore,
+				namespaceStore,
+				WithSidecarCreator(testSidecarCreator),
+			)
+
+			vmi := libvmi.New(
+				libvmi.WithNamespace("default"),
+				libvmi.WithInterface(libvmi.InterfaceWithBindingPlugin(v1.DefaultPodNetwork().Name, v1.PluginBinding{Name: pluginName})),
+				libvmi.WithNetwork(v1.DefaultPodNetwork()),
+			)
+
+			pod, err := svc.RenderLaunchManifest(vmi)
+			Expect(err).ToNot(HaveOccurred())
+
+			requested := pod.Spec.Containers[0].Resources.Requests[k8sv1.ResourceName(resourceName)]
+			Expect(requested.Value()).To(Equal(int64(1)))
+			limited := pod.Spec.Containers[0].Resources.Limits[k8sv1.ResourceName(resourceName)]
+			Expect(limited.Value()).To(Equal(int64(1)))
+		})
 	})
 
 	Context("Custom annotations Generation", func() {
@@ -6161,6 +6546,14 @@ func (smc *stubNetMemoryCalculator) Calculate(_ *v1.VirtualMachineInstance, _ ma
 	return resource.Quantity{}
 }
 
+type stubNetCPUCalculator struct {
+	cpuOverhead resource.Quantity
+}
+
+func (scc stubNetCPUCalculator) Calculate(_ *v1.VirtualMachineInstance, _ map[string]v1.InterfaceBindingPlugin) resource.Quantity {
+	return scc.cpuOverhead
+}
+
 type stubAnnotationsGenerator struct {
 	annotations   map[string]string
 	generationErr error
@@ -0,0 +1,143 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package sharding
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-controller/leaderelectionconfig"
+)
+
+const (
+	shardPending int32 = iota
+	shardAcquired
+	shardAbandoned
+)
+
+// LockFunc returns the resource lock with the given name
+type LockFunc func(name string) (resourcelock.Interface, error)
+
+// Elector competes for the shard leases, until it holds one of them
+type Elector struct {
+	assignment *Assignment
+	identity   string
+	newLock    LockFunc
+	config     leaderelectionconfig.Configuration
+	onAcquired func(ctx context.Context, shard uint32)
+}
+
+// NewElector returns an elector recording the shard it holds in the assignment.
+// onAcquired is called once the shard lease is acquired, with a context cancelled when the lease is lost.
+func NewElector(assignment *Assignment, identity string, newLock LockFunc, config leaderelectionconfig.Configuration, onAcquired func(ctx context.Context, shard uint32)) *Elector {
+	return &Elector{
+		assignment: assignment,
+		identity:   identity,
+		newLock:    newLock,
+		config:     config,
+		onAcquired: onAcquired,
+	}
+}
+
+// Run cycles through the shards, starting with the one the identity hashes to, and competes for
+// every shard lease in turn until one is acquired. Instances left without a shard keep cycling,
+// so that they take over the shard of a failed instance. Run returns when the context is done,
+// or when the acquired shard lease is lost.
+func (e *Elector) Run(ctx context.Context) {
+	shards := e.assignment.Shards()
+	first := ShardOf(e.identity, shards)
+	for i := uint32(0); ctx.Err() == nil; i++ {
+		if e.runShard(ctx, (first+i)%shards) {
+			return
+		}
+	}
+}
+
+// runShard competes for the lease of the shard long enough to take over an expired lease.
+// It returns true if the lease was acquired, once it is lost.
+func (e *Elector) runShard(ctx context.Context, shard uint32) bool {
+	lock, err := e.newLock(LeaseName(shard))
+	if err != nil {
+		log.Log.Reason(err).Errorf("Failed to create the lock of shard %d", shard)
+		e.wait(ctx, e.config.RetryPeriod.Duration)
+		return false
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var state atomic.Int32
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		LeaseDuration:   e.config.LeaseDuration.Duration,
+		RenewDeadline:   e.config.RenewDeadline.Duration,
+		RetryPeriod:     e.config.RetryPeriod.Duration,
+		ReleaseOnCancel: true,
+		Name:            LeaseName(shard),
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(ctx context.Context) {
+				if !state.CompareAndSwap(shardPending, shardAcquired) {
+					return
+				}
+				e.assignment.acquire(shard)
+				log.Log.Infof("Acquired namespace shard %d out of %d", shard, e.assignment.Shards())
+				e.onAcquired(ctx, shard)
+			},
+			OnStoppedLeading: func() {},
+		},
+	})
+	if err != nil {
+		log.Log.Reason(err).Errorf("Failed to create the elector of shard %d", shard)
+		e.wait(ctx, e.config.RetryPeriod.Duration)
+		return false
+	}
+
+	// The lease of a failed holder is taken over once it was observed unrenewed for the lease
+	// duration, and the attempts to acquire it are spaced by up to twice the jittered retry period.
+	timeout := time.AfterFunc(e.config.LeaseDuration.Duration+3*e.config.RetryPeriod.Duration, func() {
+		if state.CompareAndSwap(shardPending, shardAbandoned) {
+			cancel()
+		}
+	})
+	defer timeout.Stop()
+
+	elector.Run(ctx)
+
+	// OnStartedLeading runs asynchronously, make sure it does not record a lease which is already gone
+	if state.Swap(shardAbandoned) != shardAcquired {
+		return false
+	}
+	e.assignment.release()
+	log.Log.Infof("Released namespace shard %d", shard)
+	return true
+}
+
+func (e *Elector) wait(ctx context.Context, d time.Duration) {
+	select {
+	case <-ctx.Done():
+	case <-time.After(d):
+	}
+}
@@ -0,0 +1,127 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package sharding
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+
+	"kubevirt.io/kubevirt/pkg/virt-controller/leaderelectionconfig"
+)
+
+var _ = Describe("Elector", func() {
+	const (
+		namespace = "kubevirt"
+		shards    = 2
+	)
+
+	var client kubernetes.Interface
+
+	// Leases record their duration in seconds
+	config := leaderelectionconfig.Configuration{
+		LeaseDuration: metav1.Duration{Duration: 2 * time.Second},
+		RenewDeadline: metav1.Duration{Duration: 1500 * time.Millisecond},
+		RetryPeriod:   metav1.Duration{Duration: 200 * time.Millisecond},
+	}
+
+	type instance struct {
+		assignment *Assignment
+		acquired   chan uint32
+		cancel     context.CancelFunc
+		done       chan struct{}
+	}
+
+	startInstance := func(identity string) *instance {
+		i := &instance{
+			assignment: NewAssignment(shards),
+			acquired:   make(chan uint32, 1),
+			done:       make(chan struct{}),
+		}
+		newLock := func(name string) (resourcelock.Interface, error) {
+			return resourcelock.New(resourcelock.LeasesResourceLock, namespace, name,
+				client.CoreV1(), client.CoordinationV1(), resourcelock.ResourceLockConfig{Identity: identity})
+		}
+		elector := NewElector(i.assignment, identity, newLock, config, func(_ context.Context, shard uint32) {
+			i.acquired <- shard
+		})
+
+		var ctx context.Context
+		ctx, i.cancel = context.WithCancel(context.Background())
+		go func() {
+			defer GinkgoRecover()
+			defer close(i.done)
+			elector.Run(ctx)
+		}()
+		DeferCleanup(func() {
+			i.cancel()
+			Eventually(i.done).Should(BeClosed())
+		})
+		return i
+	}
+
+	BeforeEach(func() {
+		client = fake.NewSimpleClientset()
+	})
+
+	It("should spread the instances over the shards", func() {
+		first := startInstance("virt-controller-a")
+		second := startInstance("virt-controller-b")
+
+		var firstShard, secondShard uint32
+		Eventually(first.acquired, 5*time.Second).Should(Receive(&firstShard))
+		Eventually(second.acquired, 5*time.Second).Should(Receive(&secondShard))
+		Expect(firstShard).ToNot(Equal(secondShard))
+
+		shard, held := first.assignment.Shard()
+		Expect(held).To(BeTrue())
+		Expect(shard).To(Equal(firstShard))
+
+		for _, s := range []uint32{0, 1} {
+			lease, err := client.CoordinationV1().Leases(namespace).Get(context.Background(), LeaseName(s), metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lease.Spec.HolderIdentity).ToNot(BeNil())
+		}
+	})
+
+	It("should let a spare instance take over the shard of a stopped instance", func() {
+		first := startInstance("virt-controller-a")
+		second := startInstance("virt-controller-b")
+		var firstShard uint32
+		Eventually(first.acquired, 5*time.Second).Should(Receive(&firstShard))
+		Eventually(second.acquired, 5*time.Second).Should(Receive())
+
+		spare := startInstance("virt-controller-c")
+		Consistently(spare.acquired, 3*time.Second).ShouldNot(Receive())
+
+		first.cancel()
+		Eventually(first.done).Should(BeClosed())
+		_, held := first.assignment.Shard()
+		Expect(held).To(BeFalse())
+
+		Eventually(spare.acquired, 10*time.Second).Should(Receive(Equal(firstShard)))
+	})
+})
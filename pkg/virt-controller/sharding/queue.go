@@ -0,0 +1,106 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package sharding
+
+import (
+	"time"
+
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/controller/priorityqueue"
+)
+
+type queue struct {
+	workqueue.TypedRateLimitingInterface[string]
+	assignment *Assignment
+}
+
+// NewQueue wraps the work queue of a controller, dropping the keys of the namespaces
+// which do not belong to the shard held by the instance
+func NewQueue(q workqueue.TypedRateLimitingInterface[string], assignment *Assignment) workqueue.TypedRateLimitingInterface[string] {
+	return &queue{TypedRateLimitingInterface: q, assignment: assignment}
+}
+
+func (q *queue) Add(key string) {
+	if q.assignment.OwnsKey(key) {
+		q.TypedRateLimitingInterface.Add(key)
+	}
+}
+
+func (q *queue) AddAfter(key string, duration time.Duration) {
+	if q.assignment.OwnsKey(key) {
+		q.TypedRateLimitingInterface.AddAfter(key, duration)
+	}
+}
+
+func (q *queue) AddRateLimited(key string) {
+	if q.assignment.OwnsKey(key) {
+		q.TypedRateLimitingInterface.AddRateLimited(key)
+	}
+}
+
+type priorityQueue struct {
+	priorityqueue.PriorityQueue[string]
+	assignment *Assignment
+}
+
+// NewPriorityQueue wraps the priority queue of a controller, dropping the keys of the namespaces
+// which do not belong to the shard held by the instance
+func NewPriorityQueue(q priorityqueue.PriorityQueue[string], assignment *Assignment) priorityqueue.PriorityQueue[string] {
+	return &priorityQueue{PriorityQueue: q, assignment: assignment}
+}
+
+func (q *priorityQueue) Add(key string) {
+	if q.assignment.OwnsKey(key) {
+		q.PriorityQueue.Add(key)
+	}
+}
+
+func (q *priorityQueue) AddAfter(key string, duration time.Duration) {
+	if q.assignment.OwnsKey(key) {
+		q.PriorityQueue.AddAfter(key, duration)
+	}
+}
+
+func (q *priorityQueue) AddRateLimited(key string) {
+	if q.assignment.OwnsKey(key) {
+		q.PriorityQueue.AddRateLimited(key)
+	}
+}
+
+func (q *priorityQueue) AddWithOpts(o priorityqueue.AddOpts, keys ...string) {
+	owned := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if q.assignment.OwnsKey(key) {
+			owned = append(owned, key)
+		}
+	}
+	if len(owned) > 0 {
+		q.PriorityQueue.AddWithOpts(o, owned...)
+	}
+}
+
+// EnqueueAll adds the keys of all the objects of the store to the queue. Sharded queues only keep the keys
+// of the acquired shard, whose changes were dropped while another instance was holding it.
+func EnqueueAll(q interface{ Add(key string) }, store cache.Store) {
+	for _, key := range store.ListKeys() {
+		q.Add(key)
+	}
+}
@@ -0,0 +1,110 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Package sharding splits the namespaces between several active virt-controller instances.
+// Every namespace belongs to the shard selected by the hash of its name, and every shard is
+// reconciled by the single instance holding the shard lease.
+package sharding
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sync/atomic"
+
+	"k8s.io/client-go/tools/cache"
+
+	"kubevirt.io/kubevirt/pkg/virt-controller/leaderelectionconfig"
+)
+
+const noShard = -1
+
+// ShardOf returns the shard the name belongs to, out of the given number of shards
+func ShardOf(name string, shards uint32) uint32 {
+	if shards <= 1 {
+		return 0
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(name))
+	return h.Sum32() % shards
+}
+
+// LeaseName returns the name of the lease guarding the shard
+func LeaseName(shard uint32) string {
+	return fmt.Sprintf("%s-shard-%d", leaderelectionconfig.DefaultLeaseName, shard)
+}
+
+// Assignment tracks the shard held by the virt-controller instance
+type Assignment struct {
+	shards uint32
+	shard  atomic.Int64
+}
+
+// NewAssignment returns an assignment holding no shard yet. With less than two shards,
+// sharding is disabled and every namespace is owned.
+func NewAssignment(shards uint32) *Assignment {
+	a := &Assignment{shards: shards}
+	a.shard.Store(noShard)
+	return a
+}
+
+// Shards returns the number of shards the namespaces are split into
+func (a *Assignment) Shards() uint32 {
+	return a.shards
+}
+
+// Sharded tells whether the namespaces are split between several instances
+func (a *Assignment) Sharded() bool {
+	return a.shards > 1
+}
+
+// Shard returns the shard held by the instance, if any
+func (a *Assignment) Shard() (uint32, bool) {
+	shard := a.shard.Load()
+	if shard == noShard {
+		return 0, false
+	}
+	return uint32(shard), true
+}
+
+func (a *Assignment) acquire(shard uint32) {
+	a.shard.Store(int64(shard))
+}
+
+func (a *Assignment) release() {
+	a.shard.Store(noShard)
+}
+
+// OwnsNamespace tells whether the namespace belongs to the shard held by the instance
+func (a *Assignment) OwnsNamespace(namespace string) bool {
+	if !a.Sharded() {
+		return true
+	}
+	shard, held := a.Shard()
+	return held && ShardOf(namespace, a.shards) == shard
+}
+
+// OwnsKey tells whether the namespace of the cache key belongs to the shard held by the instance.
+// Keys of cluster scoped objects are always owned.
+func (a *Assignment) OwnsKey(key string) bool {
+	namespace, _, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil || namespace == "" {
+		return true
+	}
+	return a.OwnsNamespace(namespace)
+}
@@ -0,0 +1,164 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package sharding
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/controller/priorityqueue"
+)
+
+var _ = Describe("Sharding", func() {
+	// namespaceOfShard returns a namespace belonging to the shard
+	namespaceOfShard := func(shard, shards uint32) string {
+		for i := 0; ; i++ {
+			namespace := fmt.Sprintf("namespace-%d", i)
+			if ShardOf(namespace, shards) == shard {
+				return namespace
+			}
+		}
+	}
+
+	Context("ShardOf", func() {
+		It("should always return the same shard for a name", func() {
+			Expect(ShardOf("default", 4)).To(Equal(ShardOf("default", 4)))
+		})
+
+		It("should return the first shard when sharding is disabled", func() {
+			Expect(ShardOf("default", 0)).To(BeZero())
+			Expect(ShardOf("default", 1)).To(BeZero())
+		})
+
+		It("should spread the namespaces over all the shards", func() {
+			const shards = 4
+			counts := make([]int, shards)
+			for i := 0; i < 1000; i++ {
+				shard := ShardOf(fmt.Sprintf("namespace-%d", i), shards)
+				Expect(shard).To(BeNumerically("<", shards))
+				counts[shard]++
+			}
+			for _, count := range counts {
+				Expect(count).To(BeNumerically("~", 250, 50))
+			}
+		})
+	})
+
+	Context("Assignment", func() {
+		It("should own all the namespaces when sharding is disabled", func() {
+			assignment := NewAssignment(1)
+			Expect(assignment.Sharded()).To(BeFalse())
+			Expect(assignment.OwnsNamespace("default")).To(BeTrue())
+			Expect(assignment.OwnsKey("default/vmi")).To(BeTrue())
+		})
+
+		It("should not own any namespace before a shard is acquired", func() {
+			assignment := NewAssignment(2)
+			Expect(assignment.Sharded()).To(BeTrue())
+			Expect(assignment.OwnsNamespace(namespaceOfShard(0, 2))).To(BeFalse())
+			Expect(assignment.OwnsNamespace(namespaceOfShard(1, 2))).To(BeFalse())
+		})
+
+		It("should only own the namespaces of the acquired shard", func() {
+			assignment := NewAssignment(2)
+			assignment.acquire(1)
+			shard, held := assignment.Shard()
+			Expect(held).To(BeTrue())
+			Expect(shard).To(BeEquivalentTo(1))
+			Expect(assignment.OwnsNamespace(namespaceOfShard(1, 2))).To(BeTrue())
+			Expect(assignment.OwnsKey(namespaceOfShard(1, 2) + "/vmi")).To(BeTrue())
+			Expect(assignment.OwnsNamespace(namespaceOfShard(0, 2))).To(BeFalse())
+			Expect(assignment.OwnsKey(namespaceOfShard(0, 2) + "/vmi")).To(BeFalse())
+
+			assignment.release()
+			_, held = assignment.Shard()
+			Expect(held).To(BeFalse())
+			Expect(assignment.OwnsNamespace(namespaceOfShard(1, 2))).To(BeFalse())
+		})
+
+		It("should own the keys of cluster scoped objects", func() {
+			assignment := NewAssignment(2)
+			Expect(assignment.OwnsKey("node01")).To(BeTrue())
+		})
+	})
+
+	Context("queues", func() {
+		var (
+			assignment *Assignment
+			owned      string
+			notOwned   string
+		)
+
+		BeforeEach(func() {
+			assignment = NewAssignment(2)
+			assignment.acquire(0)
+			owned = namespaceOfShard(0, 2) + "/vmi"
+			notOwned = namespaceOfShard(1, 2) + "/vmi"
+		})
+
+		It("should drop the keys of the namespaces of other shards", func() {
+			q := NewQueue(workqueue.NewTypedRateLimitingQueue[string](workqueue.DefaultTypedControllerRateLimiter[string]()), assignment)
+			DeferCleanup(q.ShutDown)
+
+			q.Add(notOwned)
+			q.AddRateLimited(notOwned)
+			q.Add(owned)
+			Expect(q.Len()).To(Equal(1))
+			key, _ := q.Get()
+			Expect(key).To(Equal(owned))
+		})
+
+		It("should drop the keys of the namespaces of other shards from the priority queue", func() {
+			q := NewPriorityQueue(priorityqueue.New[string]("test"), assignment)
+			DeferCleanup(q.ShutDown)
+
+			q.Add(notOwned)
+			q.AddWithOpts(priorityqueue.AddOpts{}, notOwned, owned)
+			Eventually(q.Len).Should(Equal(1))
+			key, _ := q.Get()
+			Expect(key).To(Equal(owned))
+		})
+
+		It("should enqueue the keys dropped before the shard was handed over", func() {
+			assignment = NewAssignment(2)
+			q := NewQueue(workqueue.NewTypedRateLimitingQueue[string](workqueue.DefaultTypedControllerRateLimiter[string]()), assignment)
+			DeferCleanup(q.ShutDown)
+
+			store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+			for _, key := range []string{owned, notOwned} {
+				namespace, name, err := cache.SplitMetaNamespaceKey(key)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(store.Add(&metav1.ObjectMeta{Namespace: namespace, Name: name})).To(Succeed())
+				q.Add(key)
+			}
+			Expect(q.Len()).To(BeZero())
+
+			assignment.acquire(0)
+			EnqueueAll(q, store)
+			Expect(q.Len()).To(Equal(1))
+			key, _ := q.Get()
+			Expect(key).To(Equal(owned))
+		})
+	})
+})
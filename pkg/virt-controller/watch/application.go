@@ -93,13 +93,20 @@ import (
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-controller/leaderelectionconfig"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
+	"kubevirt.io/kubevirt/pkg/virt-controller/sharding"
+	containerdiskprepull "kubevirt.io/kubevirt/pkg/virt-controller/watch/containerdisk-prepull"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/cpuautoscaling"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/disruptionbudget"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/evacuation"
+	networkbindingstatus "kubevirt.io/kubevirt/pkg/virt-controller/watch/network-binding-status"
+	networkendpoints "kubevirt.io/kubevirt/pkg/virt-controller/watch/network-endpoints"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/rebalance"
 	workloadupdater "kubevirt.io/kubevirt/pkg/virt-controller/watch/workload-updater"
 
 	netadmitter "kubevirt.io/kubevirt/pkg/network/admitter"
 	netcontrollers "kubevirt.io/kubevirt/pkg/network/controllers"
 	netmigration "kubevirt.io/kubevirt/pkg/network/migration"
+	"kubevirt.io/kubevirt/pkg/network/multus"
 	"kubevirt.io/kubevirt/pkg/network/netbinding"
 	netannotations "kubevirt.io/kubevirt/pkg/network/pod/annotations"
 	storageannotations "kubevirt.io/kubevirt/pkg/storage/pod/annotations"
@@ -193,6 +200,20 @@ type VirtControllerApp struct {
 
 	workloadUpdateController *workloadupdater.WorkloadUpdateController
 
+	networkBindingStatusController *networkbindingstatus.Controller
+
+	networkEndpointsController            *networkendpoints.Controller
+	secondaryNetworkServiceInformer       cache.SharedIndexInformer
+	secondaryNetworkEndpointSliceInformer cache.SharedIndexInformer
+	netAttachDefInformer                  cache.SharedIndexInformer
+
+	rebalanceController     *rebalance.Controller
+	loadRebalanceController *rebalance.LoadController
+
+	cpuAutoscalingController *cpuautoscaling.Controller
+
+	containerDiskPrePullController *containerdiskprepull.Controller
+
 	caExportConfigMapInformer    cache.SharedIndexInformer
 	caBackupConfigMapInformer    cache.SharedIndexInformer
 	exportRouteConfigMapInformer cache.SharedInformer
@@ -247,10 +268,14 @@ type VirtControllerApp struct {
 
 	// indicates if controllers were started with or without CDI/DataVolume support
 	hasCDI bool
+	// indicates if controllers were started with or without watching NetworkAttachmentDefinitions
+	watchNetAttachDefs bool
 	// indicates if controllers were started with or without DRA support
 	isDRAEnabled bool
 	// the channel used to trigger re-initialization.
 	reInitChan chan string
+	// the namespace shard held by the instance, when the namespaces are split between several instances
+	shardAssignment *sharding.Assignment
 
 	// number of threads for each controller
 	nodeControllerThreads             int
@@ -270,6 +295,9 @@ type VirtControllerApp struct {
 	additionalLauncherAnnotationsSync []string
 	additionalLauncherLabelsSync      []string
 	backupControllerThreads           int
+	networkEndpointsControllerThreads int
+	rebalanceControllerThreads        int
+	cpuAutoscalingControllerThreads   int
 
 	promCertFilePath string
 	promKeyFilePath  string
@@ -356,7 +384,9 @@ func Execute() {
 
 	app.reInitChan = make(chan string, 10)
 	app.hasCDI = app.clusterConfig.HasDataVolumeAPI()
+	app.watchNetAttachDefs = app.shouldWatchNetAttachDefs()
 	app.isDRAEnabled = app.clusterConfig.GPUsWithDRAGateEnabled() || app.clusterConfig.HostDevicesWithDRAEnabled()
+	app.shardAssignment = sharding.NewAssignment(app.clusterConfig.GetVirtControllerShards())
 	app.clusterConfig.SetConfigModifiedCallback(app.configModificationCallback)
 	app.clusterConfig.SetConfigModifiedCallback(app.shouldChangeLogVerbosity)
 	app.clusterConfig.SetConfigModifiedCallback(app.shouldChangeRateLimiter)
@@ -408,8 +438,16 @@ func Execute() {
 	app.unmanagedSecretInformer = app.informerFactory.UnmanagedSecrets()
 	app.allPodInformer = app.informerFactory.Pod()
 	app.exportServiceInformer = app.informerFactory.ExportService()
+	app.secondaryNetworkServiceInformer = app.informerFactory.SecondaryNetworkService()
+	app.secondaryNetworkEndpointSliceInformer = app.informerFactory.SecondaryNetworkEndpointSlice()
 	app.resourceQuotaInformer = app.informerFactory.ResourceQuota()
 
+	if app.watchNetAttachDefs {
+		app.netAttachDefInformer = app.informerFactory.NetworkAttachmentDefinition()
+	} else {
+		app.netAttachDefInformer = app.informerFactory.DummyNetworkAttachmentDefinition()
+	}
+
 	if app.hasCDI {
 		app.dataVolumeInformer = app.informerFactory.DataVolume()
 		app.cdiInformer = app.informerFactory.CDI()
@@ -487,8 +525,16 @@ func Execute() {
 	app.initRestoreController()
 	app.initExportController()
 	app.initWorkloadUpdaterController()
+	app.initNetworkBindingStatusController()
+	app.initNetworkEndpointsController()
+	app.initRebalanceController()
+	app.initCPUAutoscalingController()
+	app.initContainerDiskPrePullController()
 	app.initCloneController()
 	app.initBackupController()
+	if app.shardAssignment.Sharded() {
+		app.shardQueues()
+	}
 	go app.Run()
 
 	<-app.reInitChan
@@ -508,6 +554,16 @@ func (vca *VirtControllerApp) configModificationCallback() {
 		vca.reInitChan <- "reinit"
 		return
 	}
+	newWatchNetAttachDefs := vca.shouldWatchNetAttachDefs()
+	if newWatchNetAttachDefs != vca.watchNetAttachDefs {
+		if newWatchNetAttachDefs {
+			log.Log.Infof("Reinitialize virt-controller, network attachment definitions are to be watched")
+		} else {
+			log.Log.Infof("Reinitialize virt-controller, network attachment definitions are no longer to be watched")
+		}
+		vca.reInitChan <- "reinit"
+		return
+	}
 	newIsDRAEnabled := vca.clusterConfig.GPUsWithDRAGateEnabled() || vca.clusterConfig.HostDevicesWithDRAEnabled()
 	if newIsDRAEnabled != vca.isDRAEnabled {
 		if newIsDRAEnabled {
@@ -518,6 +574,18 @@ func (vca *VirtControllerApp) configModificationCallback() {
 		vca.reInitChan <- "reinit"
 		return
 	}
+	newShards := vca.clusterConfig.GetVirtControllerShards()
+	if newShards != vca.shardAssignment.Shards() {
+		log.Log.Infof("Reinitialize virt-controller, the number of namespace shards changed to %d", newShards)
+		vca.reInitChan <- "reinit"
+		return
+	}
+}
+
+// NetworkAttachmentDefinitions are read only when the network resources are not injected externally,
+// in which case virt-controller is granted the permissions to watch them.
+func (vca *VirtControllerApp) shouldWatchNetAttachDefs() bool {
+	return vca.clusterConfig.HasNetworkAttachmentDefinitionAPI() && !vca.clusterConfig.ExternalNetResourceInjectionEnabled()
 }
 
 // Update virt-controller rate limiter
@@ -567,6 +635,10 @@ func (vca *VirtControllerApp) Run() {
 		golog.Fatal(err)
 	}
 
+	if vca.shardAssignment.Sharded() {
+		go vca.runShardElector()
+	}
+
 	metrics.SetVirtControllerReady()
 	vca.leaderElector.Run(vca.ctx)
 	metrics.SetVirtControllerNotReady()
@@ -605,11 +677,11 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 		go vca.evacuationController.Run(vca.evacuationControllerThreads, stop)
 		go vca.disruptionBudgetController.Run(vca.disruptionBudgetControllerThreads, stop)
 		go vca.nodeController.Run(vca.nodeControllerThreads, stop)
-		go vca.vmiController.Run(vca.vmiControllerThreads, stop)
-		go vca.rsController.Run(vca.rsControllerThreads, stop)
+		// With sharding, the namespaced workloads are reconciled by the shard holders
+		if !vca.shardAssignment.Sharded() {
+			vca.runNamespacedControllers(stop)
+		}
 		go vca.poolController.Run(vca.poolControllerThreads, stop)
-		go vca.vmController.Run(vca.vmControllerThreads, stop)
-		go vca.migrationController.Run(vca.migrationControllerThreads, stop)
 		go func() {
 			if err := vca.snapshotController.Run(vca.snapshotControllerThreads, stop); err != nil {
 				log.Log.Warningf("error running the snapshot controller: %v", err)
@@ -626,6 +698,12 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 			}
 		}()
 		go vca.workloadUpdateController.Run(stop)
+		go vca.networkBindingStatusController.Run(stop)
+		go vca.networkEndpointsController.Run(vca.networkEndpointsControllerThreads, stop)
+		go vca.rebalanceController.Run(vca.rebalanceControllerThreads, stop)
+		go vca.loadRebalanceController.Run(vca.rebalanceControllerThreads, stop)
+		go vca.cpuAutoscalingController.Run(vca.cpuAutoscalingControllerThreads, stop)
+		go vca.containerDiskPrePullController.Run(stop)
 		go vca.nodeTopologyUpdater.Run(vca.nodeTopologyUpdatePeriod, stop)
 		go func() {
 			if err := vca.vmCloneController.Run(vca.cloneControllerThreads, stop); err != nil {
@@ -644,6 +722,76 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 	}
 }
 
+func (vca *VirtControllerApp) runNamespacedControllers(stop <-chan struct{}) {
+	go vca.vmiController.Run(vca.vmiControllerThreads, stop)
+	go vca.rsController.Run(vca.rsControllerThreads, stop)
+	go vca.vmController.Run(vca.vmControllerThreads, stop)
+	go vca.migrationController.Run(vca.migrationControllerThreads, stop)
+}
+
+// shardQueues makes the controllers of the namespaced workloads drop the keys
+// of the namespaces outside of the shard held by the instance
+func (vca *VirtControllerApp) shardQueues() {
+	vca.vmiController.Queue = sharding.NewQueue(vca.vmiController.Queue, vca.shardAssignment)
+	vca.rsController.Queue = sharding.NewQueue(vca.rsController.Queue, vca.shardAssignment)
+	vca.vmController.Queue = sharding.NewQueue(vca.vmController.Queue, vca.shardAssignment)
+	vca.migrationController.Queue = sharding.NewPriorityQueue(vca.migrationController.Queue, vca.shardAssignment)
+}
+
+func (vca *VirtControllerApp) onShardAcquired(ctx context.Context, shard uint32) {
+	stop := ctx.Done()
+	vca.informerFactory.Start(stop)
+
+	golog.Printf("STARTING controllers of namespace shard %d out of %d with following threads : "+
+		"vmi %d, replicaset %d, vm %d, migration %d",
+		shard, vca.shardAssignment.Shards(), vca.vmiControllerThreads, vca.rsControllerThreads,
+		vca.vmControllerThreads, vca.migrationControllerThreads)
+
+	// The changes of the shard were dropped until now, so all its objects are reconciled once acquired
+	cache.WaitForCacheSync(stop, vca.vmiInformer.HasSynced, vca.rsInformer.HasSynced, vca.vmInformer.HasSynced, vca.migrationInformer.HasSynced)
+	sharding.EnqueueAll(vca.vmiController.Queue, vca.vmiInformer.GetStore())
+	sharding.EnqueueAll(vca.rsController.Queue, vca.rsInformer.GetStore())
+	sharding.EnqueueAll(vca.vmController.Queue, vca.vmInformer.GetStore())
+	sharding.EnqueueAll(vca.migrationController.Queue, vca.migrationInformer.GetStore())
+
+	vca.runNamespacedControllers(stop)
+}
+
+// runShardElector competes for a namespace shard, independently of the leader election
+// which keeps running the cluster wide controllers
+func (vca *VirtControllerApp) runShardElector() {
+	clientConfig, err := kubecli.GetKubevirtClientConfig()
+	if err != nil {
+		golog.Fatal(err)
+	}
+	clientConfig.RateLimiter =
+		flowcontrol.NewTokenBucketRateLimiter(
+			virtconfig.DefaultVirtControllerQPS,
+			virtconfig.DefaultVirtControllerBurst)
+	clientSet, err := kubecli.GetKubevirtClientFromRESTConfig(clientConfig)
+	if err != nil {
+		golog.Fatal(err)
+	}
+
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, leaderelectionconfig.DefaultLeaseName)
+	newLock := func(name string) (resourcelock.Interface, error) {
+		return resourcelock.New(vca.LeaderElection.ResourceLock,
+			vca.kubevirtNamespace,
+			name,
+			clientSet.CoreV1(),
+			clientSet.CoordinationV1(),
+			resourcelock.ResourceLockConfig{
+				Identity:      vca.host,
+				EventRecorder: recorder,
+			})
+	}
+
+	sharding.NewElector(vca.shardAssignment, vca.host, newLock, vca.LeaderElection, vca.onShardAcquired).Run(vca.ctx)
+	if vca.ctx.Err() == nil {
+		golog.Fatal("namespace shard lost")
+	}
+}
+
 func (vca *VirtControllerApp) newRecorder(namespace string, componentName string) record.EventRecorder {
 	eventBroadcaster := record.NewBroadcaster()
 	eventBroadcaster.StartRecordingToSink(&k8coresv1.EventSinkImpl{Interface: vca.clientSet.CoreV1().Events(namespace)})
@@ -663,6 +811,11 @@ func (vca *VirtControllerApp) initCommon() {
 	netAnnotationsGenerator := netannotations.NewGenerator(vca.clusterConfig)
 	storageAnnotationsGenerator := storageannotations.NewGenerator(vca.clusterConfig)
 
+	netAttachDefCache, err := multus.NewNetAttachDefCache(vca.netAttachDefInformer, multus.NewNetAttachDefClientGetter(virtClient))
+	if err != nil {
+		golog.Fatal(err)
+	}
+
 	vca.templateService = services.NewTemplateService(vca.launcherImage,
 		vca.launcherQemuTimeout,
 		vca.virtShareDir,
@@ -683,8 +836,10 @@ func (vca *VirtControllerApp) initCommon() {
 			}),
 		services.WithSidecarCreator(netbinding.NetBindingPluginSidecarList),
 		services.WithNetMemoryCalculator(netresources.MemoryCalculator{}),
+		services.WithNetCPUCalculator(netresources.CPUCalculator{}),
 		services.WithAnnotationsGenerators(netAnnotationsGenerator, storageannotations.Generator{}),
 		services.WithNetTargetAnnotationsGenerator(netAnnotationsGenerator),
+		services.WithNetAttachDefGetter(netAttachDefCache),
 	)
 
 	topologyHinter := topology.NewTopologyHinter(vca.nodeInformer.GetStore(), vca.vmiInformer.GetStore(), vca.clusterConfig)
@@ -851,6 +1006,96 @@ func (vca *VirtControllerApp) initWorkloadUpdaterController() {
 	}
 }
 
+func (vca *VirtControllerApp) initNetworkBindingStatusController() {
+	var err error
+	vca.networkBindingStatusController, err = networkbindingstatus.NewController(
+		vca.vmiInformer,
+		vca.nodeInformer,
+		vca.kubeVirtInformer,
+		vca.clientSet,
+		vca.clusterConfig,
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initNetworkEndpointsController() {
+	var err error
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "network-endpoints-controller")
+	vca.networkEndpointsController, err = networkendpoints.NewController(
+		vca.secondaryNetworkServiceInformer,
+		vca.secondaryNetworkEndpointSliceInformer,
+		vca.vmiInformer,
+		vca.clientSet,
+		recorder,
+		vca.clusterConfig,
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initRebalanceController() {
+	var err error
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "rebalance-controller")
+	vca.rebalanceController, err = rebalance.NewController(
+		vca.vmiInformer,
+		vca.kvPodInformer,
+		vca.allPodInformer,
+		vca.migrationInformer,
+		vca.nodeInformer,
+		vca.clientSet,
+		recorder,
+		vca.clusterConfig,
+	)
+	if err != nil {
+		panic(err)
+	}
+	vca.loadRebalanceController, err = rebalance.NewLoadController(
+		vca.vmiInformer,
+		vca.kvPodInformer,
+		vca.migrationInformer,
+		vca.nodeInformer,
+		vca.clientSet,
+		recorder,
+		vca.clusterConfig,
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initCPUAutoscalingController() {
+	var err error
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "cpu-autoscaling-controller")
+	vca.cpuAutoscalingController, err = cpuautoscaling.NewController(
+		vca.vmInformer,
+		vca.vmiInformer,
+		vca.nodeInformer,
+		vca.clientSet,
+		recorder,
+		vca.clusterConfig,
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initContainerDiskPrePullController() {
+	var err error
+	vca.containerDiskPrePullController, err = containerdiskprepull.NewController(
+		vca.vmInformer,
+		vca.kubeVirtInformer,
+		vca.clientSet,
+		vca.launcherImage,
+		vca.clusterConfig,
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
 func (vca *VirtControllerApp) initEvacuationController() {
 	var err error
 	recorder := vca.newRecorder(k8sv1.NamespaceAll, "evacuation-controller")
@@ -1086,6 +1331,15 @@ func (vca *VirtControllerApp) AddFlags() {
 	flag.IntVar(&vca.cloneControllerThreads, "clone-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for clone controller")
 
+	flag.IntVar(&vca.networkEndpointsControllerThreads, "network-endpoints-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for secondary network endpoints controller")
+
+	flag.IntVar(&vca.rebalanceControllerThreads, "rebalance-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for each of the rebalance controllers")
+
+	flag.IntVar(&vca.cpuAutoscalingControllerThreads, "cpu-autoscaling-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for CPU autoscaling controller")
+
 	flag.StringSliceVar(&vca.additionalLauncherAnnotationsSync, "additional-launcher-annotations-sync", []string{},
 		"Comma separated list of annotation keys which if present on the VM template and so VMI, will be sync to the virt-launcher pod. Note, it is unidirectional from VM.spec.template.metadata -> VMI and VMI -> virt-launcher pod")
 
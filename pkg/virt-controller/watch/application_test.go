@@ -54,13 +54,16 @@ import (
 	"kubevirt.io/kubevirt/pkg/controller"
 	instancetypecontroller "kubevirt.io/kubevirt/pkg/instancetype/controller/vm"
 	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/rest"
 	backup "kubevirt.io/kubevirt/pkg/storage/cbt"
 	"kubevirt.io/kubevirt/pkg/storage/export/export"
 	"kubevirt.io/kubevirt/pkg/storage/snapshot"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
+	"kubevirt.io/kubevirt/pkg/virt-controller/sharding"
 	clonecontroller "kubevirt.io/kubevirt/pkg/virt-controller/watch/clone"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/disruptionbudget"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/evacuation"
@@ -283,6 +286,7 @@ var _ = Describe("Application", func() {
 		)
 
 		app.readyChan = make(chan bool)
+		app.shardAssignment = sharding.NewAssignment(1)
 
 		By("Invoking callback")
 		go app.onStartedLeading()(ctx)
@@ -320,6 +324,7 @@ var _ = Describe("Application", func() {
 			app.clusterConfig = clusterConfig
 			app.reInitChan = make(chan string, 10)
 			app.hasCDI = hasCDIAtInit
+			app.shardAssignment = sharding.NewAssignment(1)
 
 			app.clusterConfig.SetConfigModifiedCallback(app.configModificationCallback)
 
@@ -343,6 +348,35 @@ var _ = Describe("Application", func() {
 			Entry("not when nothing changed and cdi exists", true, true, false, false),
 			Entry("not when nothing changed and does not exist", false, false, true, false),
 		)
+
+		DescribeTable("Re-trigger initialization when the number of namespace shards", func(featureGates []string, shards uint32, expectReInit bool) {
+			app := VirtControllerApp{}
+
+			clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+				DeveloperConfiguration: &v1.DeveloperConfiguration{
+					FeatureGates: featureGates,
+				},
+				VirtControllerSharding: &v1.VirtControllerShardingConfiguration{
+					Shards: pointer.P(shards),
+				},
+			})
+			app.clusterConfig = clusterConfig
+			app.reInitChan = make(chan string, 10)
+			app.hasCDI = clusterConfig.HasDataVolumeAPI()
+			app.shardAssignment = sharding.NewAssignment(2)
+
+			app.clusterConfig.SetConfigModifiedCallback(app.configModificationCallback)
+
+			if expectReInit {
+				Eventually(app.reInitChan).Should(Receive())
+			} else {
+				Consistently(app.reInitChan).ShouldNot(Receive())
+			}
+		},
+			Entry("changes", []string{featuregate.VirtControllerSharding}, uint32(3), true),
+			Entry("drops to one when the feature gate is disabled", nil, uint32(2), true),
+			Entry("not when it did not change", []string{featuregate.VirtControllerSharding}, uint32(2), false),
+		)
 	})
 
 	Describe("Readiness probe", func() {
@@ -0,0 +1,270 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package containerdiskprepull
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+// ensures the DaemonSet is not reconciled more than once every 5 seconds,
+// since every VirtualMachine change triggers a reconciliation
+const defaultThrottleInterval = 5 * time.Second
+
+type clusterConfigurer interface {
+	ContainerDiskPrePullEnabled() bool
+	GetContainerDiskPrePullConfiguration() *v1.ContainerDiskPrePullConfiguration
+	GetImagePullPolicy() k8sv1.PullPolicy
+}
+
+// Controller keeps the container disk images selected in the KubeVirt configuration
+// cached on the nodes, by running a DaemonSet using all of them.
+type Controller struct {
+	clientset     kubecli.KubevirtClient
+	queue         workqueue.TypedRateLimitingInterface[string]
+	vmStore       cache.Store
+	kubeVirtStore cache.Store
+	launcherImage string
+	clusterConfig clusterConfigurer
+
+	hasSynced func() bool
+}
+
+func NewController(
+	vmInformer cache.SharedIndexInformer,
+	kubeVirtInformer cache.SharedIndexInformer,
+	clientset kubecli.KubevirtClient,
+	launcherImage string,
+	clusterConfig clusterConfigurer,
+) (*Controller, error) {
+	rl := workqueue.NewTypedMaxOfRateLimiter[string](
+		workqueue.NewTypedItemExponentialFailureRateLimiter[string](defaultThrottleInterval, 300*time.Second),
+		&workqueue.TypedBucketRateLimiter[string]{Limiter: rate.NewLimiter(rate.Every(defaultThrottleInterval), 1)},
+	)
+
+	c := &Controller{
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			rl,
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-containerdisk-prepull"},
+		),
+		vmStore:       vmInformer.GetStore(),
+		kubeVirtStore: kubeVirtInformer.GetStore(),
+		clientset:     clientset,
+		launcherImage: launcherImage,
+		clusterConfig: clusterConfig,
+		hasSynced: func() bool {
+			return vmInformer.HasSynced() && kubeVirtInformer.HasSynced()
+		},
+	}
+
+	enqueueFuncs := cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(_ interface{}) { c.enqueueKubeVirt() },
+		DeleteFunc: func(_ interface{}) { c.enqueueKubeVirt() },
+		UpdateFunc: func(_, _ interface{}) { c.enqueueKubeVirt() },
+	}
+
+	if _, err := vmInformer.AddEventHandler(enqueueFuncs); err != nil {
+		return nil, err
+	}
+	if _, err := kubeVirtInformer.AddEventHandler(enqueueFuncs); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Controller) enqueueKubeVirt() {
+	kvs := c.kubeVirtStore.List()
+	if len(kvs) != 1 {
+		return
+	}
+	key, err := controller.KeyFunc(kvs[0])
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to extract key from KubeVirt.")
+		return
+	}
+	c.queue.AddAfter(key, defaultThrottleInterval)
+}
+
+func (c *Controller) Run(stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.queue.ShutDown()
+	log.Log.Info("Starting containerdisk pre-pull controller.")
+
+	// The queue keys off the KubeVirt install object, of which only one exists.
+	threadiness := 1
+
+	cache.WaitForCacheSync(stopCh, c.hasSynced)
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping containerdisk pre-pull controller.")
+}
+
+func (c *Controller) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *Controller) Execute() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.execute(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing containerdisk pre-pull for KubeVirt %v", key)
+		c.queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed containerdisk pre-pull for KubeVirt %v", key)
+		c.queue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) execute(key string) error {
+	obj, exists, err := c.kubeVirtStore.GetByKey(key)
+	if err != nil {
+		return err
+	} else if !exists {
+		return nil
+	}
+	kv := obj.(*v1.KubeVirt)
+
+	if kv.Status.Phase != v1.KubeVirtPhaseDeployed {
+		return nil
+	}
+
+	images, err := c.desiredImages()
+	if err != nil {
+		return err
+	}
+
+	dsClient := c.clientset.AppsV1().DaemonSets(kv.Namespace)
+	current, err := dsClient.Get(context.Background(), DaemonSetName, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		current = nil
+	} else if err != nil {
+		return err
+	}
+
+	if len(images) == 0 {
+		if current == nil {
+			return nil
+		}
+		err = dsClient.Delete(context.Background(), DaemonSetName, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("unable to delete the containerdisk pre-pull DaemonSet: %v", err)
+		}
+		return nil
+	}
+
+	var nodeSelector map[string]string
+	if config := c.clusterConfig.GetContainerDiskPrePullConfiguration(); config != nil {
+		nodeSelector = config.NodeSelector
+	}
+	desired, err := newDaemonSet(kv, c.launcherImage, c.clusterConfig.GetImagePullPolicy(), nodeSelector, images)
+	if err != nil {
+		return err
+	}
+
+	if current == nil {
+		if _, err = dsClient.Create(context.Background(), desired, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("unable to create the containerdisk pre-pull DaemonSet: %v", err)
+		}
+		return nil
+	}
+
+	if current.Annotations[TemplateHashAnnotation] == desired.Annotations[TemplateHashAnnotation] {
+		return nil
+	}
+	updated := current.DeepCopy()
+	updated.Labels = desired.Labels
+	updated.Annotations = desired.Annotations
+	updated.OwnerReferences = desired.OwnerReferences
+	updated.Spec = desired.Spec
+	if _, err = dsClient.Update(context.Background(), updated, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("unable to update the containerdisk pre-pull DaemonSet: %v", err)
+	}
+	return nil
+}
+
+// desiredImages returns the sorted list of images to keep cached, an empty list
+// means that nothing should be pulled ahead of time.
+func (c *Controller) desiredImages() ([]string, error) {
+	config := c.clusterConfig.GetContainerDiskPrePullConfiguration()
+	if !c.clusterConfig.ContainerDiskPrePullEnabled() || config == nil {
+		return nil, nil
+	}
+
+	images := sets.New[string](config.Images...)
+	if config.VirtualMachineSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(config.VirtualMachineSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid containerdisk pre-pull VirtualMachine selector: %v", err)
+		}
+		for _, obj := range c.vmStore.List() {
+			vm := obj.(*v1.VirtualMachine)
+			if !selector.Matches(labels.Set(vm.Labels)) {
+				continue
+			}
+			images.Insert(vmContainerDiskImages(vm)...)
+		}
+	}
+	return sets.List(images), nil
+}
+
+// vmContainerDiskImages returns the container disk images of the VM template which
+// can be pulled without the image pull secrets living in the VM namespace.
+func vmContainerDiskImages(vm *v1.VirtualMachine) []string {
+	if vm.Spec.Template == nil {
+		return nil
+	}
+	var images []string
+	for _, volume := range vm.Spec.Template.Spec.Volumes {
+		if volume.ContainerDisk == nil || volume.ContainerDisk.Image == "" || volume.ContainerDisk.ImagePullSecret != "" {
+			continue
+		}
+		images = append(images, volume.ContainerDisk.Image)
+	}
+	return images
+}
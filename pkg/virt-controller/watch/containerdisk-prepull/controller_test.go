@@ -0,0 +1,213 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package containerdiskprepull
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	appsv1 "k8s.io/api/apps/v1"
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("Containerdisk pre-pull controller", func() {
+	const (
+		launcherImage = "virt-launcher:latest"
+		cirrosImage   = "registry:5000/cirros:latest"
+		fedoraImage   = "registry:5000/fedora:latest"
+		alpineImage   = "registry:5000/alpine:latest"
+	)
+
+	var (
+		k8sClient        *k8sfake.Clientset
+		virtClient       *kubecli.MockKubevirtClient
+		vmInformer       cache.SharedIndexInformer
+		kubeVirtInformer cache.SharedIndexInformer
+	)
+
+	newController := func(prePull *v1.ContainerDiskPrePullConfiguration) *Controller {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{
+				FeatureGates: []string{featuregate.ContainerDiskPrePull},
+			},
+			ContainerDiskPrePull: prePull,
+		})
+		c, err := NewController(vmInformer, kubeVirtInformer, virtClient, launcherImage, config)
+		Expect(err).ToNot(HaveOccurred())
+		return c
+	}
+
+	newKubeVirt := func(phase v1.KubeVirtPhase) *v1.KubeVirt {
+		return &v1.KubeVirt{
+			ObjectMeta: metav1.ObjectMeta{Name: "kubevirt", Namespace: k8sv1.NamespaceDefault},
+			Status:     v1.KubeVirtStatus{Phase: phase},
+		}
+	}
+
+	addVM := func(labels map[string]string, opts ...libvmi.Option) {
+		vm := libvmi.NewVirtualMachine(libvmi.New(opts...), libvmi.WithLabels(labels))
+		Expect(vmInformer.GetStore().Add(vm)).To(Succeed())
+	}
+
+	execute := func(c *Controller) {
+		Expect(c.execute(k8sv1.NamespaceDefault + "/kubevirt")).To(Succeed())
+	}
+
+	getDaemonSet := func() (*appsv1.DaemonSet, error) {
+		return k8sClient.AppsV1().DaemonSets(k8sv1.NamespaceDefault).Get(context.Background(), DaemonSetName, metav1.GetOptions{})
+	}
+
+	containerImages := func(ds *appsv1.DaemonSet) []string {
+		var images []string
+		for _, container := range ds.Spec.Template.Spec.Containers {
+			images = append(images, container.Image)
+		}
+		return images
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
+		k8sClient = k8sfake.NewSimpleClientset()
+		virtClient.EXPECT().AppsV1().Return(k8sClient.AppsV1()).AnyTimes()
+
+		vmInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachine{})
+		kubeVirtInformer, _ = testutils.NewFakeInformerFor(&v1.KubeVirt{})
+		Expect(kubeVirtInformer.GetStore().Add(newKubeVirt(v1.KubeVirtPhaseDeployed))).To(Succeed())
+	})
+
+	It("should pull the listed images and the ones used by the selected VirtualMachines", func() {
+		c := newController(&v1.ContainerDiskPrePullConfiguration{
+			Images: []string{fedoraImage},
+			VirtualMachineSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"prepull": "true"},
+			},
+		})
+		addVM(map[string]string{"prepull": "true"}, libvmi.WithContainerDisk("disk0", cirrosImage))
+		addVM(map[string]string{"prepull": "true"}, libvmi.WithContainerDisk("disk0", fedoraImage))
+		addVM(map[string]string{"prepull": "false"}, libvmi.WithContainerDisk("disk0", alpineImage))
+
+		execute(c)
+
+		ds, err := getDaemonSet()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(containerImages(ds)).To(Equal([]string{cirrosImage, fedoraImage}))
+		Expect(ds.Spec.Template.Spec.NodeSelector).To(Equal(map[string]string{v1.NodeSchedulable: "true"}))
+		Expect(ds.Spec.Template.Spec.InitContainers).To(HaveLen(1))
+		Expect(ds.Spec.Template.Spec.InitContainers[0].Image).To(Equal(launcherImage))
+		Expect(ds.OwnerReferences).To(HaveLen(1))
+		Expect(ds.OwnerReferences[0].Kind).To(Equal("KubeVirt"))
+		for _, container := range ds.Spec.Template.Spec.Containers {
+			Expect(container.Command).To(Equal([]string{"/usr/bin/container-disk"}))
+			Expect(container.ImagePullPolicy).To(Equal(k8sv1.PullIfNotPresent))
+		}
+	})
+
+	It("should skip VirtualMachine images requiring a pull secret", func() {
+		c := newController(&v1.ContainerDiskPrePullConfiguration{
+			VirtualMachineSelector: &metav1.LabelSelector{},
+		})
+		addVM(nil, libvmi.WithContainerDisk("disk0", cirrosImage))
+		vmi := libvmi.New(libvmi.WithContainerDisk("disk0", alpineImage))
+		vmi.Spec.Volumes[0].ContainerDisk.ImagePullSecret = "registry-secret"
+		Expect(vmInformer.GetStore().Add(libvmi.NewVirtualMachine(vmi))).To(Succeed())
+
+		execute(c)
+
+		ds, err := getDaemonSet()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(containerImages(ds)).To(Equal([]string{cirrosImage}))
+	})
+
+	It("should use the configured node selector", func() {
+		nodeSelector := map[string]string{"node-role.kubernetes.io/worker": ""}
+		c := newController(&v1.ContainerDiskPrePullConfiguration{
+			Images:       []string{cirrosImage},
+			NodeSelector: nodeSelector,
+		})
+
+		execute(c)
+
+		ds, err := getDaemonSet()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ds.Spec.Template.Spec.NodeSelector).To(Equal(nodeSelector))
+	})
+
+	It("should update the DaemonSet only when the images change", func() {
+		execute(newController(&v1.ContainerDiskPrePullConfiguration{Images: []string{cirrosImage}}))
+		k8sClient.ClearActions()
+
+		execute(newController(&v1.ContainerDiskPrePullConfiguration{Images: []string{cirrosImage}}))
+		Expect(k8sClient.Actions()).To(HaveLen(1))
+		Expect(k8sClient.Actions()[0].GetVerb()).To(Equal("get"))
+
+		execute(newController(&v1.ContainerDiskPrePullConfiguration{Images: []string{cirrosImage, fedoraImage}}))
+		ds, err := getDaemonSet()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(containerImages(ds)).To(Equal([]string{cirrosImage, fedoraImage}))
+	})
+
+	It("should delete the DaemonSet when no image is selected anymore", func() {
+		execute(newController(&v1.ContainerDiskPrePullConfiguration{Images: []string{cirrosImage}}))
+		_, err := getDaemonSet()
+		Expect(err).ToNot(HaveOccurred())
+
+		execute(newController(nil))
+
+		_, err = getDaemonSet()
+		Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should not pull anything when the feature gate is disabled", func() {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			ContainerDiskPrePull: &v1.ContainerDiskPrePullConfiguration{Images: []string{cirrosImage}},
+		})
+		c, err := NewController(vmInformer, kubeVirtInformer, virtClient, launcherImage, config)
+		Expect(err).ToNot(HaveOccurred())
+
+		execute(c)
+
+		_, err = getDaemonSet()
+		Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should not reconcile before KubeVirt is deployed", func() {
+		Expect(kubeVirtInformer.GetStore().Update(newKubeVirt(v1.KubeVirtPhaseDeploying))).To(Succeed())
+		c := newController(&v1.ContainerDiskPrePullConfiguration{Images: []string{cirrosImage}})
+
+		execute(c)
+
+		Expect(k8sClient.Actions()).To(BeEmpty())
+	})
+})
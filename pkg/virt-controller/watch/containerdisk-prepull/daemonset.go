@@ -0,0 +1,199 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package containerdiskprepull
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	appsv1 "k8s.io/api/apps/v1"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/util"
+)
+
+const (
+	// DaemonSetName is the name of the DaemonSet keeping the container disk images cached on the nodes
+	DaemonSetName = "virt-containerdisk-prepull"
+	// TemplateHashAnnotation holds the hash of the pod template the DaemonSet was last rendered with
+	TemplateHashAnnotation = "kubevirt.io/containerdisk-prepull-template-hash"
+
+	binaryVolumeName  = "container-disk-binary"
+	socketsVolumeName = "prepull-sockets"
+	binaryDir         = "/usr/bin"
+	binaryInitDir     = "/init/usr/bin"
+	socketsDir        = "/var/run/kubevirt-prepull"
+
+	// At most one tenth of the nodes restart their pull pod at once when the image list
+	// changes, so the registry is not hit by every node at the same time.
+	maxUnavailableNodes = "10%"
+)
+
+// newDaemonSet renders the DaemonSet pinning the given images on the nodes. Every image runs
+// the container disk binary as a placeholder process, so the kubelet never garbage collects it.
+func newDaemonSet(kv *v1.KubeVirt, launcherImage string, pullPolicy k8sv1.PullPolicy, nodeSelector map[string]string, images []string) (*appsv1.DaemonSet, error) {
+	labels := map[string]string{
+		v1.AppLabel: DaemonSetName,
+	}
+	if len(nodeSelector) == 0 {
+		nodeSelector = map[string]string{v1.NodeSchedulable: "true"}
+	}
+
+	template := k8sv1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: labels,
+		},
+		Spec: k8sv1.PodSpec{
+			AutomountServiceAccountToken: pointer.P(false),
+			NodeSelector:                 nodeSelector,
+			SecurityContext: &k8sv1.PodSecurityContext{
+				RunAsNonRoot: pointer.P(true),
+				RunAsUser:    pointer.P(int64(util.NonRootUID)),
+				SeccompProfile: &k8sv1.SeccompProfile{
+					Type: k8sv1.SeccompProfileTypeRuntimeDefault,
+				},
+			},
+			InitContainers: []k8sv1.Container{{
+				Name:            binaryVolumeName,
+				Image:           launcherImage,
+				ImagePullPolicy: pullPolicy,
+				Command: []string{"/usr/bin/cp", "--preserve=all",
+					filepath.Join(binaryDir, "container-disk"),
+					filepath.Join(binaryInitDir, "container-disk"),
+				},
+				VolumeMounts: []k8sv1.VolumeMount{{
+					Name:      binaryVolumeName,
+					MountPath: binaryInitDir,
+				}},
+				Resources:       resourceRequirements(),
+				SecurityContext: containerSecurityContext(),
+			}},
+			Volumes: []k8sv1.Volume{
+				emptyDirVolume(binaryVolumeName),
+				emptyDirVolume(socketsVolumeName),
+			},
+		},
+	}
+	for i, image := range images {
+		name := fmt.Sprintf("image-%d", i)
+		template.Spec.Containers = append(template.Spec.Containers, k8sv1.Container{
+			Name:            name,
+			Image:           image,
+			ImagePullPolicy: k8sv1.PullIfNotPresent,
+			Command:         []string{filepath.Join(binaryDir, "container-disk")},
+			Args:            []string{"--copy-path", filepath.Join(socketsDir, name)},
+			VolumeMounts: []k8sv1.VolumeMount{
+				{
+					Name:      binaryVolumeName,
+					MountPath: binaryDir,
+				},
+				{
+					Name:      socketsVolumeName,
+					MountPath: socketsDir,
+				},
+			},
+			Resources:       resourceRequirements(),
+			SecurityContext: containerSecurityContext(),
+		})
+	}
+
+	hash, err := templateHash(&template)
+	if err != nil {
+		return nil, err
+	}
+
+	// The DaemonSet goes away with the KubeVirt install. Owner deletion is not blocked,
+	// as virt-controller may not update the KubeVirt finalizers.
+	ownerRef := metav1.NewControllerRef(kv, v1.KubeVirtGroupVersionKind)
+	ownerRef.BlockOwnerDeletion = pointer.P(false)
+
+	return &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      DaemonSetName,
+			Namespace: kv.Namespace,
+			Labels:    labels,
+			Annotations: map[string]string{
+				TemplateHashAnnotation: hash,
+			},
+			OwnerReferences: []metav1.OwnerReference{*ownerRef},
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labels,
+			},
+			Template: template,
+			UpdateStrategy: appsv1.DaemonSetUpdateStrategy{
+				Type: appsv1.RollingUpdateDaemonSetStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateDaemonSet{
+					MaxUnavailable: pointer.P(intstr.FromString(maxUnavailableNodes)),
+				},
+			},
+		},
+	}, nil
+}
+
+func templateHash(template *k8sv1.PodTemplateSpec) (string, error) {
+	data, err := json.Marshal(template)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func emptyDirVolume(name string) k8sv1.Volume {
+	return k8sv1.Volume{
+		Name: name,
+		VolumeSource: k8sv1.VolumeSource{
+			EmptyDir: &k8sv1.EmptyDirVolumeSource{},
+		},
+	}
+}
+
+func resourceRequirements() k8sv1.ResourceRequirements {
+	return k8sv1.ResourceRequirements{
+		Requests: k8sv1.ResourceList{
+			k8sv1.ResourceCPU:    resource.MustParse("1m"),
+			k8sv1.ResourceMemory: resource.MustParse("1M"),
+		},
+		Limits: k8sv1.ResourceList{
+			k8sv1.ResourceCPU:    resource.MustParse("10m"),
+			k8sv1.ResourceMemory: resource.MustParse("40M"),
+		},
+	}
+}
+
+func containerSecurityContext() *k8sv1.SecurityContext {
+	return &k8sv1.SecurityContext{
+		AllowPrivilegeEscalation: pointer.P(false),
+		Capabilities: &k8sv1.Capabilities{
+			Drop: []k8sv1.Capability{"ALL"},
+		},
+	}
+}
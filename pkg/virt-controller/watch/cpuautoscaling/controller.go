@@ -0,0 +1,366 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cpuautoscaling
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/util/migrations"
+	"kubevirt.io/kubevirt/pkg/util/nodes"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	// staleLoadTimeout is the age of the last heartbeat after which the load reported on a node
+	// is ignored. virt-handler refreshes it every one to two minutes.
+	staleLoadTimeout = 5 * time.Minute
+
+	socketsPath        = "/spec/template/spec/domain/cpu/sockets"
+	recommendationPath = "/status/cpuRecommendation"
+
+	SuccessfulScaleUpReason = "SuccessfulCPUScaleUp"
+	FailedScaleUpReason     = "FailedCPUScaleUp"
+)
+
+// Controller recommends more CPU sockets for the VirtualMachines opting in to CPU autoscaling
+// whose vCPU load, as reported by virt-handler, stays above the thresholds for a whole window.
+// With the Auto policy, and the LiveUpdate rollout strategy, it also bumps the sockets of the
+// VirtualMachine template by one, which the VirtualMachine controller then hotplugs into the
+// running VirtualMachineInstance. Sockets are never removed.
+type Controller struct {
+	clientset     kubecli.KubevirtClient
+	queue         workqueue.TypedRateLimitingInterface[string]
+	vmStore       cache.Store
+	vmiIndexer    cache.Indexer
+	nodeStore     cache.Store
+	recorder      record.EventRecorder
+	clusterConfig *virtconfig.ClusterConfig
+
+	hasSynced func() bool
+	now       func() time.Time
+}
+
+func NewController(
+	vmInformer cache.SharedIndexInformer,
+	vmiInformer cache.SharedIndexInformer,
+	nodeInformer cache.SharedIndexInformer,
+	clientset kubecli.KubevirtClient,
+	recorder record.EventRecorder,
+	clusterConfig *virtconfig.ClusterConfig,
+) (*Controller, error) {
+	c := &Controller{
+		clientset: clientset,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-cpu-autoscaling"},
+		),
+		vmStore:       vmInformer.GetStore(),
+		vmiIndexer:    vmiInformer.GetIndexer(),
+		nodeStore:     nodeInformer.GetStore(),
+		recorder:      recorder,
+		clusterConfig: clusterConfig,
+		hasSynced: func() bool {
+			return vmInformer.HasSynced() && vmiInformer.HasSynced() && nodeInformer.HasSynced()
+		},
+		now: time.Now,
+	}
+
+	if _, err := vmInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueVM,
+		UpdateFunc: func(_, newObj interface{}) { c.enqueueVM(newObj) },
+	}); err != nil {
+		return nil, err
+	}
+	if _, err := nodeInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueNodeVMs,
+		UpdateFunc: func(_, newObj interface{}) { c.enqueueNodeVMs(newObj) },
+	}); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Controller) enqueueVM(obj interface{}) {
+	vm, ok := obj.(*v1.VirtualMachine)
+	if !ok || (vm.Spec.CPUAutoscaling == nil && vm.Status.CPURecommendation == nil) {
+		return
+	}
+	c.queue.Add(controller.NamespacedKey(vm.Namespace, vm.Name))
+}
+
+// enqueueNodeVMs enqueues the VirtualMachines whose VMI load is reported on the node, on
+// every heartbeat of virt-handler.
+func (c *Controller) enqueueNodeVMs(obj interface{}) {
+	node, ok := obj.(*k8sv1.Node)
+	if !ok {
+		return
+	}
+	loads, err := nodes.VMIVCPULoads(node)
+	if err != nil {
+		log.Log.Reason(err).Warningf("Failed to read the vCPU load of the VMIs on node %s", node.Name)
+		return
+	}
+	for key := range loads {
+		obj, exists, err := c.vmStore.GetByKey(key)
+		if err != nil || !exists {
+			continue
+		}
+		c.enqueueVM(obj)
+	}
+}
+
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.queue.ShutDown()
+	log.Log.Info("Starting CPU autoscaling controller.")
+
+	cache.WaitForCacheSync(stopCh, c.hasSynced)
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping CPU autoscaling controller.")
+}
+
+func (c *Controller) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *Controller) Execute() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.execute(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing VirtualMachine %v", key)
+		c.queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed VirtualMachine %v", key)
+		c.queue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) execute(key string) error {
+	if !c.clusterConfig.VCPUAutoscalingEnabled() {
+		return nil
+	}
+
+	obj, exists, err := c.vmStore.GetByKey(key)
+	if err != nil || !exists {
+		return err
+	}
+	vm := obj.(*v1.VirtualMachine)
+
+	vmi, err := c.runningVMI(key)
+	if err != nil {
+		return err
+	}
+	if vm.Spec.CPUAutoscaling == nil || vmi == nil {
+		return c.updateRecommendation(vm, nil)
+	}
+
+	recommendation := c.recommend(vm, vmi)
+	if recommendation.Sockets > vmi.Spec.Domain.CPU.Sockets && c.canScaleUp(vm, vmi, recommendation) {
+		if err := c.scaleUp(vm, vmi.Spec.Domain.CPU.Sockets, recommendation.Sockets); err != nil {
+			return err
+		}
+		recommendation.LastScaleTime = pointer.P(metav1.NewTime(c.now()))
+		recommendation.OverloadedSince = nil
+	}
+	return c.updateRecommendation(vm, recommendation)
+}
+
+// runningVMI returns the running VMI of the VirtualMachine, if it has CPU sockets to hotplug.
+func (c *Controller) runningVMI(key string) (*v1.VirtualMachineInstance, error) {
+	obj, exists, err := c.vmiIndexer.GetByKey(key)
+	if err != nil || !exists {
+		return nil, err
+	}
+	vmi := obj.(*v1.VirtualMachineInstance)
+	if vmi.Status.Phase != v1.Running || vmi.DeletionTimestamp != nil || vmi.Status.NodeName == "" ||
+		vmi.Spec.Domain.CPU == nil || vmi.Spec.Domain.CPU.MaxSockets == 0 {
+		return nil, nil
+	}
+	return vmi, nil
+}
+
+// recommend returns the recommendation of the VirtualMachine given the current vCPU load of
+// its VMI. One more socket is recommended once the load stayed above the thresholds for the
+// whole window, as long as the VMI did not reach its maximum number of sockets.
+func (c *Controller) recommend(vm *v1.VirtualMachine, vmi *v1.VirtualMachineInstance) *v1.VirtualMachineCPURecommendation {
+	recommendation := &v1.VirtualMachineCPURecommendation{}
+	if vm.Status.CPURecommendation != nil {
+		recommendation = vm.Status.CPURecommendation.DeepCopy()
+	}
+	sockets := vmi.Spec.Domain.CPU.Sockets
+	recommendation.Sockets = sockets
+	recommendation.Reason = ""
+
+	load := c.vmiVCPULoad(vmi)
+	if load == nil {
+		// Keep the window running until fresh load is reported
+		return recommendation
+	}
+
+	config := c.clusterConfig.GetVCPUAutoscalingConfiguration()
+	if load.Utilization <= *config.CPUUtilizationThreshold && load.Steal <= *config.CPUStealThreshold {
+		recommendation.OverloadedSince = nil
+		return recommendation
+	}
+
+	now := c.now()
+	if recommendation.OverloadedSince == nil {
+		recommendation.OverloadedSince = pointer.P(metav1.NewTime(now))
+	}
+	if now.Sub(recommendation.OverloadedSince.Time) < config.Window.Duration {
+		return recommendation
+	}
+	if sockets >= vmi.Spec.Domain.CPU.MaxSockets {
+		recommendation.Reason = fmt.Sprintf("vCPU utilization of %d%% and steal time of %d%% are above the thresholds, but the maximum of %d sockets is reached",
+			load.Utilization, load.Steal, vmi.Spec.Domain.CPU.MaxSockets)
+		return recommendation
+	}
+	recommendation.Sockets = sockets + 1
+	recommendation.Reason = fmt.Sprintf("vCPU utilization of %d%% and steal time of %d%% are above the thresholds since %s",
+		load.Utilization, load.Steal, recommendation.OverloadedSince.UTC().Format(time.RFC3339))
+	return recommendation
+}
+
+// vmiVCPULoad returns the vCPU load of the VMI reported by virt-handler on its node, or nil
+// if it is missing or stale.
+func (c *Controller) vmiVCPULoad(vmi *v1.VirtualMachineInstance) *nodes.VCPULoad {
+	obj, exists, err := c.nodeStore.GetByKey(vmi.Status.NodeName)
+	if err != nil || !exists {
+		return nil
+	}
+	node := obj.(*k8sv1.Node)
+
+	lastHeartBeat, exists := node.Annotations[v1.VirtHandlerHeartbeat]
+	if !exists {
+		return nil
+	}
+	timestamp := metav1.Time{}
+	if err := json.Unmarshal([]byte(`"`+lastHeartBeat+`"`), &timestamp); err != nil ||
+		timestamp.Time.Before(c.now().Add(-staleLoadTimeout)) {
+		return nil
+	}
+	loads, err := nodes.VMIVCPULoads(node)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Warningf("Failed to read the vCPU load of the VMIs on node %s", node.Name)
+		return nil
+	}
+	load, exists := loads[controller.NamespacedKey(vmi.Namespace, vmi.Name)]
+	if !exists {
+		return nil
+	}
+	return &load
+}
+
+// canScaleUp tells whether the recommended sockets can be hotplugged into the VMI right away.
+func (c *Controller) canScaleUp(vm *v1.VirtualMachine, vmi *v1.VirtualMachineInstance, recommendation *v1.VirtualMachineCPURecommendation) bool {
+	if vm.Spec.CPUAutoscaling.Policy != v1.CPUAutoscalingPolicyAuto || !c.clusterConfig.IsVMRolloutStrategyLiveUpdate() {
+		return false
+	}
+	// The sockets of the instance type can not be changed in the VirtualMachine template
+	if vm.Spec.Instancetype != nil {
+		return false
+	}
+	// Wait for a pending CPU change to be rolled out
+	templateCPU := vm.Spec.Template.Spec.Domain.CPU
+	if templateCPU == nil || templateCPU.Sockets != vmi.Spec.Domain.CPU.Sockets {
+		return false
+	}
+	if migrations.IsMigrating(vmi) ||
+		controller.NewVirtualMachineInstanceConditionManager().HasConditionWithStatus(vmi, v1.VirtualMachineInstanceVCPUChange, k8sv1.ConditionTrue) {
+		return false
+	}
+	if recommendation.LastScaleTime != nil {
+		cooldown := c.clusterConfig.GetVCPUAutoscalingConfiguration().Cooldown.Duration
+		if remaining := recommendation.LastScaleTime.Add(cooldown).Sub(c.now()); remaining > 0 {
+			c.queue.AddAfter(controller.NamespacedKey(vm.Namespace, vm.Name), remaining)
+			return false
+		}
+	}
+	return true
+}
+
+func (c *Controller) scaleUp(vm *v1.VirtualMachine, currentSockets, sockets uint32) error {
+	patchBytes, err := patch.New(
+		patch.WithTest(socketsPath, currentSockets),
+		patch.WithReplace(socketsPath, sockets),
+	).GeneratePayload()
+	if err != nil {
+		return err
+	}
+
+	log.Log.Object(vm).Infof("Scaling up the CPU sockets from %d to %d", currentSockets, sockets)
+	if _, err := c.clientset.VirtualMachine(vm.Namespace).Patch(context.Background(), vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{}); err != nil {
+		c.recorder.Eventf(vm, k8sv1.EventTypeWarning, FailedScaleUpReason, "Error scaling up the CPU sockets to %d: %v", sockets, err)
+		return fmt.Errorf("failed to scale up the CPU sockets: %v", err)
+	}
+	c.recorder.Eventf(vm, k8sv1.EventTypeNormal, SuccessfulScaleUpReason, "Scaled up the CPU sockets from %d to %d", currentSockets, sockets)
+	return nil
+}
+
+// updateRecommendation patches the status of the VirtualMachine, so that it does not conflict
+// with the scale up of its template.
+func (c *Controller) updateRecommendation(vm *v1.VirtualMachine, recommendation *v1.VirtualMachineCPURecommendation) error {
+	if equality.Semantic.DeepEqual(vm.Status.CPURecommendation, recommendation) {
+		return nil
+	}
+	patchSet := patch.New()
+	if recommendation == nil {
+		patchSet.AddOption(patch.WithRemove(recommendationPath))
+	} else {
+		patchSet.AddOption(patch.WithAdd(recommendationPath, recommendation))
+	}
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = c.clientset.VirtualMachine(vm.Namespace).Patch(context.Background(), vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{}, "status")
+	return err
+}
@@ -0,0 +1,248 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cpuautoscaling
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/util/nodes"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("CPU autoscaling controller", func() {
+	const (
+		nodeName = "node01"
+		vmName   = "testvm"
+	)
+
+	var (
+		virtClient     *kubecli.MockKubevirtClient
+		fakeVirtClient *kubevirtfake.Clientset
+		recorder       *record.FakeRecorder
+		vmInformer     cache.SharedIndexInformer
+		vmiInformer    cache.SharedIndexInformer
+		nodeInformer   cache.SharedIndexInformer
+		vmi            *v1.VirtualMachineInstance
+	)
+
+	newController := func(featureGates ...string) *Controller {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+		})
+		c, err := NewController(vmInformer, vmiInformer, nodeInformer, virtClient, recorder, config)
+		Expect(err).ToNot(HaveOccurred())
+		return c
+	}
+
+	addNode := func(utilization, steal uint32, heartbeat time.Time) {
+		loads, err := json.Marshal(map[string]nodes.VCPULoad{
+			controller.NamespacedKey(k8sv1.NamespaceDefault, vmName): {Utilization: utilization, Steal: steal},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(nodeInformer.GetStore().Add(&k8sv1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: nodeName,
+				Annotations: map[string]string{
+					v1.VirtHandlerHeartbeat:      heartbeat.UTC().Format(time.RFC3339),
+					v1.NodeVMIVCPULoadAnnotation: string(loads),
+				},
+			},
+		})).To(Succeed())
+	}
+
+	addVM := func(policy v1.CPUAutoscalingPolicy, recommendation *v1.VirtualMachineCPURecommendation) *v1.VirtualMachine {
+		vm := libvmi.NewVirtualMachine(vmi.DeepCopy(), libvmi.WithRunStrategy(v1.RunStrategyAlways))
+		vm.Spec.CPUAutoscaling = &v1.CPUAutoscaling{Policy: policy}
+		vm.Status.CPURecommendation = recommendation
+		Expect(vmInformer.GetStore().Add(vm)).To(Succeed())
+		_, err := fakeVirtClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.Background(), vm, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return vm
+	}
+
+	getVM := func() *v1.VirtualMachine {
+		vm, err := fakeVirtClient.KubevirtV1().VirtualMachines(k8sv1.NamespaceDefault).Get(context.Background(), vmName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return vm
+	}
+
+	overloadedSince := func(d time.Duration) *v1.VirtualMachineCPURecommendation {
+		return &v1.VirtualMachineCPURecommendation{
+			Sockets:         2,
+			OverloadedSince: pointer.P(metav1.NewTime(time.Now().Add(-d))),
+		}
+	}
+
+	BeforeEach(func() {
+		virtClient = kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		fakeVirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachine(k8sv1.NamespaceDefault).
+			Return(fakeVirtClient.KubevirtV1().VirtualMachines(k8sv1.NamespaceDefault)).AnyTimes()
+		recorder = record.NewFakeRecorder(10)
+
+		vmInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachine{})
+		vmiInformer, _ = testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachineInstance{}, controller.GetVMIInformerIndexers())
+		nodeInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Node{})
+
+		vmi = libvmi.New(
+			libvmi.WithName(vmName),
+			libvmi.WithNamespace(k8sv1.NamespaceDefault),
+			libvmi.WithCPUCount(1, 1, 2),
+		)
+		vmi.Spec.Domain.CPU.MaxSockets = 4
+		vmi.Status.Phase = v1.Running
+		vmi.Status.NodeName = nodeName
+		Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+	})
+
+	It("should start the window when the vCPU load goes above the thresholds", func() {
+		c := newController(featuregate.VCPUAutoscaling)
+		addNode(95, 0, time.Now())
+		addVM(v1.CPUAutoscalingPolicyRecommend, nil)
+
+		Expect(c.execute(controller.NamespacedKey(k8sv1.NamespaceDefault, vmName))).To(Succeed())
+
+		recommendation := getVM().Status.CPURecommendation
+		Expect(recommendation).ToNot(BeNil())
+		Expect(recommendation.Sockets).To(Equal(uint32(2)))
+		Expect(recommendation.OverloadedSince).ToNot(BeNil())
+		Expect(recommendation.Reason).To(BeEmpty())
+	})
+
+	It("should reset the window when the vCPU load goes below the thresholds", func() {
+		c := newController(featuregate.VCPUAutoscaling)
+		addNode(50, 5, time.Now())
+		addVM(v1.CPUAutoscalingPolicyRecommend, overloadedSince(5*time.Minute))
+
+		Expect(c.execute(controller.NamespacedKey(k8sv1.NamespaceDefault, vmName))).To(Succeed())
+
+		Expect(getVM().Status.CPURecommendation).To(Equal(&v1.VirtualMachineCPURecommendation{Sockets: 2}))
+	})
+
+	DescribeTable("should recommend one more socket when the vCPU load stays above the thresholds for the window", func(utilization, steal uint32) {
+		c := newController(featuregate.VCPUAutoscaling)
+		addNode(utilization, steal, time.Now())
+		addVM(v1.CPUAutoscalingPolicyRecommend, overloadedSince(15*time.Minute))
+
+		Expect(c.execute(controller.NamespacedKey(k8sv1.NamespaceDefault, vmName))).To(Succeed())
+
+		vm := getVM()
+		Expect(vm.Status.CPURecommendation.Sockets).To(Equal(uint32(3)))
+		Expect(vm.Status.CPURecommendation.Reason).ToNot(BeEmpty())
+		Expect(vm.Spec.Template.Spec.Domain.CPU.Sockets).To(Equal(uint32(2)))
+	},
+		Entry("with the vCPU utilization above the threshold", uint32(95), uint32(0)),
+		Entry("with the vCPU steal time above the threshold", uint32(50), uint32(30)),
+	)
+
+	It("should hotplug the recommended socket with the Auto policy", func() {
+		c := newController(featuregate.VCPUAutoscaling)
+		addNode(95, 0, time.Now())
+		addVM(v1.CPUAutoscalingPolicyAuto, overloadedSince(15*time.Minute))
+
+		Expect(c.execute(controller.NamespacedKey(k8sv1.NamespaceDefault, vmName))).To(Succeed())
+
+		vm := getVM()
+		Expect(vm.Spec.Template.Spec.Domain.CPU.Sockets).To(Equal(uint32(3)))
+		Expect(vm.Status.CPURecommendation.Sockets).To(Equal(uint32(3)))
+		Expect(vm.Status.CPURecommendation.LastScaleTime).ToNot(BeNil())
+		Expect(vm.Status.CPURecommendation.OverloadedSince).To(BeNil())
+		testutils.ExpectEvent(recorder, SuccessfulScaleUpReason)
+	})
+
+	DescribeTable("should not hotplug any socket with the Auto policy", func(setup func(vm *v1.VirtualMachine), expectedSockets uint32) {
+		c := newController(featuregate.VCPUAutoscaling)
+		addNode(95, 0, time.Now())
+		vm := addVM(v1.CPUAutoscalingPolicyAuto, overloadedSince(15*time.Minute))
+		setup(vm)
+
+		Expect(c.execute(controller.NamespacedKey(k8sv1.NamespaceDefault, vmName))).To(Succeed())
+
+		vm = getVM()
+		Expect(vm.Spec.Template.Spec.Domain.CPU.Sockets).To(Equal(uint32(2)))
+		Expect(vm.Status.CPURecommendation.Sockets).To(Equal(expectedSockets))
+		Expect(recorder.Events).To(BeEmpty())
+	},
+		Entry("when the VMI reached its maximum number of sockets", func(_ *v1.VirtualMachine) {
+			vmi.Spec.Domain.CPU.MaxSockets = 2
+		}, uint32(2)),
+		Entry("when the VirtualMachine is in cooldown", func(vm *v1.VirtualMachine) {
+			vm.Status.CPURecommendation.LastScaleTime = pointer.P(metav1.NewTime(time.Now().Add(-time.Minute)))
+		}, uint32(3)),
+		Entry("when a CPU change is being rolled out", func(_ *v1.VirtualMachine) {
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+				Type:   v1.VirtualMachineInstanceVCPUChange,
+				Status: k8sv1.ConditionTrue,
+			}}
+		}, uint32(3)),
+		Entry("when the VirtualMachine uses an instance type", func(vm *v1.VirtualMachine) {
+			vm.Spec.Instancetype = &v1.InstancetypeMatcher{Name: "instancetype"}
+		}, uint32(3)),
+	)
+
+	DescribeTable("should leave the recommendation alone", func(setup func(), featureGates ...string) {
+		c := newController(featureGates...)
+		setup()
+		addVM(v1.CPUAutoscalingPolicyAuto, overloadedSince(15*time.Minute))
+
+		Expect(c.execute(controller.NamespacedKey(k8sv1.NamespaceDefault, vmName))).To(Succeed())
+
+		vm := getVM()
+		Expect(vm.Status.CPURecommendation.Sockets).To(Equal(uint32(2)))
+		Expect(vm.Spec.Template.Spec.Domain.CPU.Sockets).To(Equal(uint32(2)))
+	},
+		Entry("when the feature gate is disabled", func() {
+			addNode(95, 30, time.Now())
+		}),
+		Entry("when the load of the VMI is stale", func() {
+			addNode(95, 30, time.Now().Add(-time.Hour))
+		}, featuregate.VCPUAutoscaling),
+	)
+
+	It("should remove the recommendation when the VirtualMachine opts out", func() {
+		c := newController(featuregate.VCPUAutoscaling)
+		addNode(95, 0, time.Now())
+		vm := addVM(v1.CPUAutoscalingPolicyRecommend, overloadedSince(15*time.Minute))
+		vm.Spec.CPUAutoscaling = nil
+
+		Expect(c.execute(controller.NamespacedKey(k8sv1.NamespaceDefault, vmName))).To(Succeed())
+
+		Expect(getVM().Status.CPURecommendation).To(BeNil())
+	})
+})
@@ -937,6 +937,35 @@ func createDecentralizedMigrationPodAntiAffinity(templatePod *k8sv1.Pod, vmi *vi
 	)
 }
 
+// excludeNodes keeps the migration target pod off the given nodes, whichever term of its required
+// node affinity it is scheduled by.
+func excludeNodes(templatePod *k8sv1.Pod, nodeNames []string) {
+	requirement := k8sv1.NodeSelectorRequirement{
+		Key:      k8sv1.LabelHostname,
+		Operator: k8sv1.NodeSelectorOpNotIn,
+		Values:   nodeNames,
+	}
+
+	if templatePod.Spec.Affinity == nil {
+		templatePod.Spec.Affinity = &k8sv1.Affinity{}
+	}
+	if templatePod.Spec.Affinity.NodeAffinity == nil {
+		templatePod.Spec.Affinity.NodeAffinity = &k8sv1.NodeAffinity{}
+	}
+	nodeAffinity := templatePod.Spec.Affinity.NodeAffinity
+	if nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &k8sv1.NodeSelector{}
+	}
+	terms := nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	if len(terms) == 0 {
+		terms = []k8sv1.NodeSelectorTerm{{}}
+	}
+	for i := range terms {
+		terms[i].MatchExpressions = append(terms[i].MatchExpressions, requirement)
+	}
+	nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms = terms
+}
+
 func (c *Controller) createTargetPod(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance, sourcePod *k8sv1.Pod) error {
 	if !c.pvcExpectations.SatisfiedExpectations(controller.MigrationKey(migration)) {
 		// Give time to the PVC informer to update itself
@@ -956,6 +985,10 @@ func (c *Controller) createTargetPod(migration *virtv1.VirtualMachineInstanceMig
 		createMigrationPodAntiAffinityRule(templatePod, vmi)
 	}
 
+	if excludedNodes, ok := migration.Annotations[virtv1.LoadRebalanceExcludedNodesAnnotation]; ok && excludedNodes != "" {
+		excludeNodes(templatePod, strings.Split(excludedNodes, ","))
+	}
+
 	nodeSelector := make(map[string]string)
 	maps.Copy(nodeSelector, migration.Spec.AddedNodeSelector)
 	maps.Copy(nodeSelector, templatePod.Spec.NodeSelector)
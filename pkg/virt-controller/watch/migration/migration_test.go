@@ -1021,6 +1021,43 @@ var _ = Describe("Migration watcher", func() {
 
 		})
 
+		It("should keep the target pod of a load rebalancing migration off the excluded nodes", func() {
+			vmi := newVirtualMachine("testvmi", v1.Running)
+			vmi.Spec.Affinity = &k8sv1.Affinity{
+				NodeAffinity: &k8sv1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &k8sv1.NodeSelector{
+						NodeSelectorTerms: []k8sv1.NodeSelectorTerm{
+							{MatchExpressions: []k8sv1.NodeSelectorRequirement{{Key: "zone", Operator: k8sv1.NodeSelectorOpIn, Values: []string{"a"}}}},
+							{MatchExpressions: []k8sv1.NodeSelectorRequirement{{Key: "zone", Operator: k8sv1.NodeSelectorOpIn, Values: []string{"b"}}}},
+						},
+					},
+				},
+			}
+			migration := newMigration("testmigration", vmi.Name, v1.MigrationPending)
+			migration.Annotations[v1.LoadRebalanceMigrationAnnotation] = vmi.Status.NodeName
+			migration.Annotations[v1.LoadRebalanceExcludedNodesAnnotation] = "hot01,hot02"
+
+			addNode(newNode(vmi.Status.NodeName))
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, virtcontroller.SuccessfulCreatePodReason)
+			targetPod, err := getTargetPod(kubeClient, vmi.Namespace, vmi.UID, migration.UID)
+			Expect(err).ToNot(HaveOccurred())
+			terms := targetPod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+			Expect(terms).To(HaveLen(2))
+			for _, term := range terms {
+				Expect(term.MatchExpressions).To(ContainElement(k8sv1.NodeSelectorRequirement{
+					Key:      k8sv1.LabelHostname,
+					Operator: k8sv1.NodeSelectorOpNotIn,
+					Values:   []string{"hot01", "hot02"},
+				}))
+			}
+		})
+
 		It("should place migration in scheduling state if pod exists", func() {
 			vmi := newVirtualMachine("testvmi", v1.Running)
 			migration := newMigration("testmigration", vmi.Name, v1.MigrationPending)
@@ -0,0 +1,244 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package networkbindingstatus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+// ensures the status is not recalculated more than once every 5 seconds
+const defaultThrottleInterval = 5 * time.Second
+
+type clusterConfigurer interface {
+	GetNetworkBindings() map[string]v1.InterfaceBindingPlugin
+	IsFeatureGateEnabled(featureGate string) bool
+}
+
+// Controller reports the usage and the prerequisites fulfillment of the
+// registered network binding plugins in the KubeVirt CR status.
+type Controller struct {
+	clientset     kubecli.KubevirtClient
+	queue         workqueue.TypedRateLimitingInterface[string]
+	vmiStore      cache.Store
+	nodeStore     cache.Store
+	kubeVirtStore cache.Store
+	clusterConfig clusterConfigurer
+
+	hasSynced func() bool
+}
+
+func NewController(
+	vmiInformer cache.SharedIndexInformer,
+	nodeInformer cache.SharedIndexInformer,
+	kubeVirtInformer cache.SharedIndexInformer,
+	clientset kubecli.KubevirtClient,
+	clusterConfig clusterConfigurer,
+) (*Controller, error) {
+	rl := workqueue.NewTypedMaxOfRateLimiter[string](
+		workqueue.NewTypedItemExponentialFailureRateLimiter[string](defaultThrottleInterval, 300*time.Second),
+		&workqueue.TypedBucketRateLimiter[string]{Limiter: rate.NewLimiter(rate.Every(defaultThrottleInterval), 1)},
+	)
+
+	c := &Controller{
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			rl,
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-network-binding-status"},
+		),
+		vmiStore:      vmiInformer.GetStore(),
+		nodeStore:     nodeInformer.GetStore(),
+		kubeVirtStore: kubeVirtInformer.GetStore(),
+		clientset:     clientset,
+		clusterConfig: clusterConfig,
+		hasSynced: func() bool {
+			return vmiInformer.HasSynced() && nodeInformer.HasSynced() && kubeVirtInformer.HasSynced()
+		},
+	}
+
+	enqueueFuncs := cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(_ interface{}) { c.enqueueKubeVirt() },
+		DeleteFunc: func(_ interface{}) { c.enqueueKubeVirt() },
+		UpdateFunc: func(_, _ interface{}) { c.enqueueKubeVirt() },
+	}
+
+	if _, err := vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(_ interface{}) { c.enqueueKubeVirt() },
+		DeleteFunc: func(_ interface{}) { c.enqueueKubeVirt() },
+		UpdateFunc: c.updateVMI,
+	}); err != nil {
+		return nil, err
+	}
+	if _, err := nodeInformer.AddEventHandler(enqueueFuncs); err != nil {
+		return nil, err
+	}
+	if _, err := kubeVirtInformer.AddEventHandler(enqueueFuncs); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// updateVMI enqueues the KubeVirt CR when the VMI update may change the plugins usage,
+// i.e. when interfaces are hot plugged or unplugged, or when the VMI reaches a final phase.
+func (c *Controller) updateVMI(oldObj, newObj interface{}) {
+	oldVMI := oldObj.(*v1.VirtualMachineInstance)
+	newVMI := newObj.(*v1.VirtualMachineInstance)
+	if oldVMI.IsFinal() != newVMI.IsFinal() ||
+		!equality.Semantic.DeepEqual(oldVMI.Spec.Domain.Devices.Interfaces, newVMI.Spec.Domain.Devices.Interfaces) {
+		c.enqueueKubeVirt()
+	}
+}
+
+func (c *Controller) enqueueKubeVirt() {
+	kvs := c.kubeVirtStore.List()
+	if len(kvs) != 1 {
+		return
+	}
+	key, err := controller.KeyFunc(kvs[0])
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to extract key from KubeVirt.")
+		return
+	}
+	c.queue.AddAfter(key, defaultThrottleInterval)
+}
+
+func (c *Controller) Run(stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.queue.ShutDown()
+	log.Log.Info("Starting network binding status controller.")
+
+	// The queue keys off the KubeVirt install object, of which only one exists.
+	threadiness := 1
+
+	cache.WaitForCacheSync(stopCh, c.hasSynced)
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping network binding status controller.")
+}
+
+func (c *Controller) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *Controller) Execute() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.execute(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing network binding status for KubeVirt %v", key)
+		c.queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed network binding status for KubeVirt %v", key)
+		c.queue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) execute(key string) error {
+	obj, exists, err := c.kubeVirtStore.GetByKey(key)
+	if err != nil {
+		return err
+	} else if !exists {
+		return nil
+	}
+	kv := obj.(*v1.KubeVirt)
+
+	if kv.Status.Phase != v1.KubeVirtPhaseDeployed {
+		return nil
+	}
+
+	bindingStatuses := CalculateBindingStatuses(
+		c.clusterConfig.GetNetworkBindings(),
+		c.clusterConfig.IsFeatureGateEnabled,
+		listVMIs(c.vmiStore),
+		listNodes(c.nodeStore),
+	)
+	if equality.Semantic.DeepEqual(kv.Status.NetworkBindings, bindingStatuses) {
+		return nil
+	}
+
+	const statusPath = "/status/networkBindings"
+	patchSet := patch.New()
+	switch {
+	case kv.Status.NetworkBindings == nil:
+		patchSet.AddOption(patch.WithAdd(statusPath, bindingStatuses))
+	case bindingStatuses == nil:
+		patchSet.AddOption(
+			patch.WithTest(statusPath, kv.Status.NetworkBindings),
+			patch.WithRemove(statusPath),
+		)
+	default:
+		patchSet.AddOption(
+			patch.WithTest(statusPath, kv.Status.NetworkBindings),
+			patch.WithReplace(statusPath, bindingStatuses),
+		)
+	}
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = c.clientset.KubeVirt(kv.Namespace).PatchStatus(context.Background(), kv.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("unable to patch kubevirt obj status to update the networkBindings: %v", err)
+	}
+	return nil
+}
+
+func listVMIs(store cache.Store) []*v1.VirtualMachineInstance {
+	var vmis []*v1.VirtualMachineInstance
+	for _, obj := range store.List() {
+		vmis = append(vmis, obj.(*v1.VirtualMachineInstance))
+	}
+	return vmis
+}
+
+func listNodes(store cache.Store) []*k8sv1.Node {
+	var nodes []*k8sv1.Node
+	for _, obj := range store.List() {
+		nodes = append(nodes, obj.(*k8sv1.Node))
+	}
+	return nodes
+}
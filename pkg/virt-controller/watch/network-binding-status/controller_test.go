@@ -0,0 +1,174 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package networkbindingstatus
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Network binding status controller", func() {
+	const pluginName = "myplugin"
+
+	var (
+		fakeVirtClient   *kubevirtfake.Clientset
+		virtClient       *kubecli.MockKubevirtClient
+		vmiInformer      cache.SharedIndexInformer
+		nodeInformer     cache.SharedIndexInformer
+		kubeVirtInformer cache.SharedIndexInformer
+	)
+
+	newController := func(kvConfig *v1.KubeVirtConfiguration) *Controller {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(kvConfig)
+		c, err := NewController(vmiInformer, nodeInformer, kubeVirtInformer, virtClient, config)
+		Expect(err).ToNot(HaveOccurred())
+		return c
+	}
+
+	addKubeVirt := func(kv *v1.KubeVirt) {
+		Expect(kubeVirtInformer.GetStore().Add(kv)).To(Succeed())
+		_, err := fakeVirtClient.KubevirtV1().KubeVirts(kv.Namespace).Create(context.Background(), kv, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	newKubeVirt := func() *v1.KubeVirt {
+		return &v1.KubeVirt{
+			ObjectMeta: metav1.ObjectMeta{Name: "kubevirt", Namespace: k8sv1.NamespaceDefault},
+			Status:     v1.KubeVirtStatus{Phase: v1.KubeVirtPhaseDeployed},
+		}
+	}
+
+	kvConfigWithBinding := func() *v1.KubeVirtConfiguration {
+		return &v1.KubeVirtConfiguration{
+			NetworkConfiguration: &v1.NetworkConfiguration{
+				Binding: map[string]v1.InterfaceBindingPlugin{
+					pluginName: {Prerequisites: &v1.InterfaceBindingPrerequisites{FeatureGates: []string{"SomeFeature"}}},
+				},
+			},
+		}
+	}
+
+	execute := func(c *Controller) {
+		Expect(c.execute(k8sv1.NamespaceDefault + "/kubevirt")).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
+		fakeVirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().KubeVirt(k8sv1.NamespaceDefault).Return(fakeVirtClient.KubevirtV1().KubeVirts(k8sv1.NamespaceDefault)).AnyTimes()
+
+		vmiInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		nodeInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Node{})
+		kubeVirtInformer, _ = testutils.NewFakeInformerFor(&v1.KubeVirt{})
+	})
+
+	It("should report the binding plugins status on the KubeVirt CR", func() {
+		c := newController(kvConfigWithBinding())
+		addKubeVirt(newKubeVirt())
+		vmi := libvmi.New(libvmi.WithInterface(libvmi.InterfaceWithBindingPlugin("net1", v1.PluginBinding{Name: pluginName})))
+		vmi.Status.Phase = v1.Running
+		Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+		Expect(nodeInformer.GetStore().Add(&k8sv1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node01"}})).To(Succeed())
+
+		execute(c)
+
+		kv, err := fakeVirtClient.KubevirtV1().KubeVirts(k8sv1.NamespaceDefault).Get(context.Background(), "kubevirt", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kv.Status.NetworkBindings).To(Equal([]v1.NetworkBindingPluginStatus{{
+			Name:                     pluginName,
+			VirtualMachineInstances:  1,
+			PrerequisitesSatisfied:   false,
+			UnsatisfiedPrerequisites: []string{`feature gate "SomeFeature" is not enabled`},
+		}}))
+	})
+
+	It("should not patch the KubeVirt CR when the status is up to date", func() {
+		c := newController(&v1.KubeVirtConfiguration{
+			NetworkConfiguration: &v1.NetworkConfiguration{
+				Binding: map[string]v1.InterfaceBindingPlugin{pluginName: {}},
+			},
+		})
+		kv := newKubeVirt()
+		kv.Status.NetworkBindings = []v1.NetworkBindingPluginStatus{{Name: pluginName, PrerequisitesSatisfied: true}}
+		addKubeVirt(kv)
+		fakeVirtClient.ClearActions()
+
+		execute(c)
+
+		Expect(fakeVirtClient.Actions()).To(BeEmpty())
+	})
+
+	DescribeTable("should recalculate the status on VMI updates changing the plugins usage", func(updateVMI func(*v1.VirtualMachineInstance), expectEnqueue bool) {
+		c := newController(kvConfigWithBinding())
+		addKubeVirt(newKubeVirt())
+		oldVMI := libvmi.New(libvmi.WithInterface(libvmi.InterfaceWithBindingPlugin("net1", v1.PluginBinding{Name: pluginName})))
+		oldVMI.Status.Phase = v1.Running
+		newVMI := oldVMI.DeepCopy()
+		updateVMI(newVMI)
+		mockQueue := testutils.NewMockWorkQueue(c.queue)
+		c.queue = mockQueue
+
+		c.updateVMI(oldVMI, newVMI)
+
+		if expectEnqueue {
+			Expect(mockQueue.GetAddAfterEnqueueCount()).To(Equal(1))
+		} else {
+			Expect(mockQueue.GetAddAfterEnqueueCount()).To(BeZero())
+		}
+	},
+		Entry("when an interface is hot plugged", func(vmi *v1.VirtualMachineInstance) {
+			vmi.Spec.Domain.Devices.Interfaces = append(vmi.Spec.Domain.Devices.Interfaces,
+				libvmi.InterfaceWithBindingPlugin("net2", v1.PluginBinding{Name: pluginName}))
+		}, true),
+		Entry("when the VMI reaches a final phase", func(vmi *v1.VirtualMachineInstance) {
+			vmi.Status.Phase = v1.Succeeded
+		}, true),
+		Entry("but not when the interfaces and phase are unchanged", func(vmi *v1.VirtualMachineInstance) {
+			vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{Type: v1.VirtualMachineInstanceReady})
+		}, false),
+	)
+
+	It("should not report the status before KubeVirt is deployed", func() {
+		c := newController(kvConfigWithBinding())
+		kv := newKubeVirt()
+		kv.Status.Phase = v1.KubeVirtPhaseDeploying
+		addKubeVirt(kv)
+		fakeVirtClient.ClearActions()
+
+		execute(c)
+
+		Expect(fakeVirtClient.Actions()).To(BeEmpty())
+	})
+})
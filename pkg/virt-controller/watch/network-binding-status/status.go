@@ -0,0 +1,112 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package networkbindingstatus
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	k8sv1 "k8s.io/api/core/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// CalculateBindingStatuses returns the status of each registered binding plugin, sorted by name.
+// A binding plugin is considered in use by every VMI which is not in a final phase and has
+// at least one interface referencing it.
+func CalculateBindingStatuses(
+	bindings map[string]v1.InterfaceBindingPlugin,
+	isFeatureGateEnabled func(string) bool,
+	vmis []*v1.VirtualMachineInstance,
+	nodes []*k8sv1.Node,
+) []v1.NetworkBindingPluginStatus {
+	if len(bindings) == 0 {
+		return nil
+	}
+
+	usage := countVMIsPerBinding(vmis)
+
+	var statuses []v1.NetworkBindingPluginStatus
+	for _, name := range slices.Sorted(maps.Keys(bindings)) {
+		unsatisfied := unsatisfiedPrerequisites(bindings[name].Prerequisites, isFeatureGateEnabled, nodes)
+		statuses = append(statuses, v1.NetworkBindingPluginStatus{
+			Name:                     name,
+			VirtualMachineInstances:  usage[name],
+			PrerequisitesSatisfied:   len(unsatisfied) == 0,
+			UnsatisfiedPrerequisites: unsatisfied,
+		})
+	}
+	return statuses
+}
+
+func countVMIsPerBinding(vmis []*v1.VirtualMachineInstance) map[string]int {
+	usage := map[string]int{}
+	for _, vmi := range vmis {
+		if vmi.IsFinal() {
+			continue
+		}
+		bindingsInUse := map[string]struct{}{}
+		for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+			if iface.Binding != nil {
+				bindingsInUse[iface.Binding.Name] = struct{}{}
+			}
+		}
+		for name := range bindingsInUse {
+			usage[name]++
+		}
+	}
+	return usage
+}
+
+func unsatisfiedPrerequisites(
+	prerequisites *v1.InterfaceBindingPrerequisites,
+	isFeatureGateEnabled func(string) bool,
+	nodes []*k8sv1.Node,
+) []string {
+	if prerequisites == nil {
+		return nil
+	}
+
+	var unsatisfied []string
+	for _, featureGate := range prerequisites.FeatureGates {
+		if !isFeatureGateEnabled(featureGate) {
+			unsatisfied = append(unsatisfied, fmt.Sprintf("feature gate %q is not enabled", featureGate))
+		}
+	}
+	for _, resource := range prerequisites.DeviceResources {
+		if !isResourceAdvertised(k8sv1.ResourceName(resource), nodes) {
+			unsatisfied = append(unsatisfied, fmt.Sprintf("device resource %q is not advertised by any schedulable node", resource))
+		}
+	}
+	return unsatisfied
+}
+
+func isResourceAdvertised(resource k8sv1.ResourceName, nodes []*k8sv1.Node) bool {
+	for _, node := range nodes {
+		if node.Spec.Unschedulable {
+			continue
+		}
+		if quantity, exists := node.Status.Allocatable[resource]; exists && !quantity.IsZero() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,150 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package networkbindingstatus_test
+
+import (
+	"slices"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	networkbindingstatus "kubevirt.io/kubevirt/pkg/virt-controller/watch/network-binding-status"
+)
+
+var _ = Describe("Network binding plugin status", func() {
+	const (
+		pluginA      = "plugin-a"
+		pluginB      = "plugin-b"
+		featureGate  = "SomeFeature"
+		resourceName = "example.com/device"
+	)
+
+	featureGatesEnabled := func(enabled ...string) func(string) bool {
+		return func(name string) bool {
+			return slices.Contains(enabled, name)
+		}
+	}
+
+	newVMIWithBindings := func(phase v1.VirtualMachineInstancePhase, bindingNames ...string) *v1.VirtualMachineInstance {
+		var opts []libvmi.Option
+		for _, name := range bindingNames {
+			opts = append(opts,
+				libvmi.WithInterface(libvmi.InterfaceWithBindingPlugin(name+"-net", v1.PluginBinding{Name: name})),
+				libvmi.WithNetwork(libvmi.MultusNetwork(name+"-net", "nad")),
+			)
+		}
+		vmi := libvmi.New(opts...)
+		vmi.Status.Phase = phase
+		return vmi
+	}
+
+	newNode := func(unschedulable bool, allocatable k8sv1.ResourceList) *k8sv1.Node {
+		return &k8sv1.Node{
+			Spec:   k8sv1.NodeSpec{Unschedulable: unschedulable},
+			Status: k8sv1.NodeStatus{Allocatable: allocatable},
+		}
+	}
+
+	It("reports nothing when no binding plugin is registered", func() {
+		Expect(networkbindingstatus.CalculateBindingStatuses(nil, featureGatesEnabled(), nil, nil)).To(BeNil())
+	})
+
+	It("counts the active VMIs using each binding plugin", func() {
+		bindings := map[string]v1.InterfaceBindingPlugin{pluginB: {}, pluginA: {}}
+		vmis := []*v1.VirtualMachineInstance{
+			newVMIWithBindings(v1.Running, pluginA),
+			newVMIWithBindings(v1.Scheduling, pluginA, pluginB),
+			newVMIWithBindings(v1.Succeeded, pluginB),
+			newVMIWithBindings(v1.Running),
+		}
+
+		Expect(networkbindingstatus.CalculateBindingStatuses(bindings, featureGatesEnabled(), vmis, nil)).To(Equal(
+			[]v1.NetworkBindingPluginStatus{
+				{Name: pluginA, VirtualMachineInstances: 2, PrerequisitesSatisfied: true},
+				{Name: pluginB, VirtualMachineInstances: 1, PrerequisitesSatisfied: true},
+			},
+		))
+	})
+
+	It("counts a VMI once when several of its interfaces use the same binding plugin", func() {
+		bindings := map[string]v1.InterfaceBindingPlugin{pluginA: {}}
+		vmi := libvmi.New(
+			libvmi.WithInterface(libvmi.InterfaceWithBindingPlugin("net1", v1.PluginBinding{Name: pluginA})),
+			libvmi.WithInterface(libvmi.InterfaceWithBindingPlugin("net2", v1.PluginBinding{Name: pluginA})),
+		)
+
+		statuses := networkbindingstatus.CalculateBindingStatuses(bindings, featureGatesEnabled(), []*v1.VirtualMachineInstance{vmi}, nil)
+		Expect(statuses).To(HaveLen(1))
+		Expect(statuses[0].VirtualMachineInstances).To(Equal(1))
+	})
+
+	DescribeTable("reports the prerequisites fulfillment",
+		func(enabledFeatureGates []string, nodes []*k8sv1.Node, expectedUnsatisfied []string) {
+			bindings := map[string]v1.InterfaceBindingPlugin{pluginA: {
+				Prerequisites: &v1.InterfaceBindingPrerequisites{
+					FeatureGates:    []string{featureGate},
+					DeviceResources: []string{resourceName},
+				},
+			}}
+
+			statuses := networkbindingstatus.CalculateBindingStatuses(bindings, featureGatesEnabled(enabledFeatureGates...), nil, nodes)
+
+			Expect(statuses).To(Equal([]v1.NetworkBindingPluginStatus{{
+				Name:                     pluginA,
+				PrerequisitesSatisfied:   len(expectedUnsatisfied) == 0,
+				UnsatisfiedPrerequisites: expectedUnsatisfied,
+			}}))
+		},
+		Entry("when all prerequisites are satisfied",
+			[]string{featureGate},
+			[]*k8sv1.Node{newNode(false, k8sv1.ResourceList{resourceName: resource.MustParse("1")})},
+			nil,
+		),
+		Entry("when the feature gate is disabled",
+			nil,
+			[]*k8sv1.Node{newNode(false, k8sv1.ResourceList{resourceName: resource.MustParse("1")})},
+			[]string{`feature gate "SomeFeature" is not enabled`},
+		),
+		Entry("when the device resource is advertised only by an unschedulable node",
+			[]string{featureGate},
+			[]*k8sv1.Node{newNode(true, k8sv1.ResourceList{resourceName: resource.MustParse("1")})},
+			[]string{`device resource "example.com/device" is not advertised by any schedulable node`},
+		),
+		Entry("when no node has the device resource allocatable",
+			[]string{featureGate},
+			[]*k8sv1.Node{newNode(false, k8sv1.ResourceList{resourceName: resource.MustParse("0")})},
+			[]string{`device resource "example.com/device" is not advertised by any schedulable node`},
+		),
+		Entry("when no prerequisite is satisfied",
+			nil,
+			nil,
+			[]string{
+				`feature gate "SomeFeature" is not enabled`,
+				`device resource "example.com/device" is not advertised by any schedulable node`,
+			},
+		),
+	)
+})
@@ -0,0 +1,304 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package networkendpoints
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+const (
+	// InvalidSelectorReason is the reason of the event emitted when the VMI selector of a Service is invalid.
+	InvalidSelectorReason = "InvalidSecondaryNetworkEndpointsSelector"
+	// SelectorConflictReason is the reason of the event emitted when a Service has both a pod selector
+	// and the secondary network endpoints label.
+	SelectorConflictReason = "SecondaryNetworkEndpointsSelectorConflict"
+)
+
+type clusterConfigurer interface {
+	SecondaryNetworkEndpointsEnabled() bool
+}
+
+// Controller publishes the IP addresses VMIs report on a secondary network as the
+// EndpointSlices of the Services marked with the v1.SecondaryNetworkEndpointsLabel.
+type Controller struct {
+	clientset          kubecli.KubevirtClient
+	queue              workqueue.TypedRateLimitingInterface[string]
+	serviceIndexer     cache.Indexer
+	endpointSliceStore cache.Store
+	vmiIndexer         cache.Indexer
+	recorder           record.EventRecorder
+	clusterConfig      clusterConfigurer
+
+	hasSynced func() bool
+}
+
+func NewController(
+	serviceInformer cache.SharedIndexInformer,
+	endpointSliceInformer cache.SharedIndexInformer,
+	vmiInformer cache.SharedIndexInformer,
+	clientset kubecli.KubevirtClient,
+	recorder record.EventRecorder,
+	clusterConfig clusterConfigurer,
+) (*Controller, error) {
+	c := &Controller{
+		clientset: clientset,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-network-endpoints"},
+		),
+		serviceIndexer:     serviceInformer.GetIndexer(),
+		endpointSliceStore: endpointSliceInformer.GetStore(),
+		vmiIndexer:         vmiInformer.GetIndexer(),
+		recorder:           recorder,
+		clusterConfig:      clusterConfig,
+		hasSynced: func() bool {
+			return serviceInformer.HasSynced() && endpointSliceInformer.HasSynced() && vmiInformer.HasSynced()
+		},
+	}
+
+	if _, err := serviceInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueService,
+		UpdateFunc: func(_, newObj interface{}) { c.enqueueService(newObj) },
+		DeleteFunc: c.enqueueService,
+	}); err != nil {
+		return nil, err
+	}
+	if _, err := endpointSliceInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(_, newObj interface{}) { c.enqueueEndpointSliceService(newObj) },
+		DeleteFunc: c.enqueueEndpointSliceService,
+	}); err != nil {
+		return nil, err
+	}
+	if _, err := vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueVMIServices,
+		UpdateFunc: func(_, newObj interface{}) { c.enqueueVMIServices(newObj) },
+		DeleteFunc: c.enqueueVMIServices,
+	}); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Controller) enqueueService(obj interface{}) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to extract key from Service.")
+		return
+	}
+	c.queue.Add(key)
+}
+
+func (c *Controller) enqueueEndpointSliceService(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	endpointSlice, ok := obj.(*discoveryv1.EndpointSlice)
+	if !ok {
+		return
+	}
+	if serviceName := endpointSlice.Labels[discoveryv1.LabelServiceName]; serviceName != "" {
+		c.queue.Add(controller.NamespacedKey(endpointSlice.Namespace, serviceName))
+	}
+}
+
+// enqueueVMIServices enqueues all the Services of the VMI namespace, as the VMI labels
+// or the VMI reported IP addresses may have changed.
+func (c *Controller) enqueueVMIServices(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	vmi, ok := obj.(*v1.VirtualMachineInstance)
+	if !ok {
+		return
+	}
+	services, err := c.serviceIndexer.ByIndex(cache.NamespaceIndex, vmi.Namespace)
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to list Services.")
+		return
+	}
+	for _, service := range services {
+		c.enqueueService(service)
+	}
+}
+
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.queue.ShutDown()
+	log.Log.Info("Starting network endpoints controller.")
+
+	cache.WaitForCacheSync(stopCh, c.hasSynced)
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping network endpoints controller.")
+}
+
+func (c *Controller) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *Controller) Execute() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.execute(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing network endpoints of Service %v", key)
+		c.queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed network endpoints of Service %v", key)
+		c.queue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) execute(key string) error {
+	if !c.clusterConfig.SecondaryNetworkEndpointsEnabled() {
+		return nil
+	}
+
+	obj, exists, err := c.serviceIndexer.GetByKey(key)
+	if err != nil {
+		return err
+	} else if !exists {
+		// The endpoint slices are garbage collected through their owner reference
+		return nil
+	}
+	service := obj.(*k8sv1.Service)
+
+	networkName := service.Labels[v1.SecondaryNetworkEndpointsLabel]
+	if networkName == "" || service.DeletionTimestamp != nil {
+		return nil
+	}
+
+	if len(service.Spec.Selector) > 0 {
+		c.recorder.Eventf(service, k8sv1.EventTypeWarning, SelectorConflictReason,
+			"Service has a pod selector, its endpoints are not managed by KubeVirt")
+		return nil
+	}
+
+	selector, err := labels.Parse(service.Annotations[v1.SecondaryNetworkEndpointsSelectorAnnotation])
+	if err != nil {
+		c.recorder.Eventf(service, k8sv1.EventTypeWarning, InvalidSelectorReason,
+			"Invalid VMI selector in annotation %s: %v", v1.SecondaryNetworkEndpointsSelectorAnnotation, err)
+		return nil
+	}
+
+	vmis, err := c.listVMIs(service.Namespace, selector)
+	if err != nil {
+		return err
+	}
+
+	return c.syncEndpointSlices(service, DesiredEndpointSlices(service, networkName, vmis))
+}
+
+func (c *Controller) listVMIs(namespace string, selector labels.Selector) ([]*v1.VirtualMachineInstance, error) {
+	objs, err := c.vmiIndexer.ByIndex(cache.NamespaceIndex, namespace)
+	if err != nil {
+		return nil, err
+	}
+	var vmis []*v1.VirtualMachineInstance
+	for _, obj := range objs {
+		vmi := obj.(*v1.VirtualMachineInstance)
+		if selector.Matches(labels.Set(vmi.Labels)) {
+			vmis = append(vmis, vmi)
+		}
+	}
+	return vmis, nil
+}
+
+func (c *Controller) syncEndpointSlices(service *k8sv1.Service, desired []*discoveryv1.EndpointSlice) error {
+	desiredByName := map[string]*discoveryv1.EndpointSlice{}
+	for _, endpointSlice := range desired {
+		desiredByName[endpointSlice.Name] = endpointSlice
+	}
+
+	endpointSlices := c.clientset.DiscoveryV1().EndpointSlices(service.Namespace)
+	for _, addressType := range []discoveryv1.AddressType{discoveryv1.AddressTypeIPv4, discoveryv1.AddressTypeIPv6} {
+		name := EndpointSliceName(service.Name, addressType)
+		current, err := c.currentEndpointSlice(service.Namespace, name)
+		if err != nil {
+			return err
+		}
+		want, isDesired := desiredByName[name]
+
+		switch {
+		case current == nil && isDesired:
+			if _, err := endpointSlices.Create(context.Background(), want, metav1.CreateOptions{}); err != nil && !k8serrors.IsAlreadyExists(err) {
+				return fmt.Errorf("failed to create EndpointSlice %s: %v", name, err)
+			}
+		case current != nil && !isDesired:
+			if err := endpointSlices.Delete(context.Background(), name, metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+				return fmt.Errorf("failed to delete EndpointSlice %s: %v", name, err)
+			}
+		case current != nil && isDesired && !endpointSliceEqual(current, want):
+			updated := current.DeepCopy()
+			updated.Labels = want.Labels
+			updated.OwnerReferences = want.OwnerReferences
+			updated.Endpoints = want.Endpoints
+			updated.Ports = want.Ports
+			if _, err := endpointSlices.Update(context.Background(), updated, metav1.UpdateOptions{}); err != nil {
+				return fmt.Errorf("failed to update EndpointSlice %s: %v", name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Controller) currentEndpointSlice(namespace, name string) (*discoveryv1.EndpointSlice, error) {
+	obj, exists, err := c.endpointSliceStore.GetByKey(controller.NamespacedKey(namespace, name))
+	if err != nil || !exists {
+		return nil, err
+	}
+	return obj.(*discoveryv1.EndpointSlice), nil
+}
+
+func endpointSliceEqual(current, desired *discoveryv1.EndpointSlice) bool {
+	return equality.Semantic.DeepEqual(current.Labels, desired.Labels) &&
+		equality.Semantic.DeepEqual(current.OwnerReferences, desired.OwnerReferences) &&
+		equality.Semantic.DeepEqual(current.Endpoints, desired.Endpoints) &&
+		equality.Semantic.DeepEqual(current.Ports, desired.Ports)
+}
@@ -0,0 +1,184 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package networkendpoints
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("Network endpoints controller", func() {
+	const (
+		namespace   = "ns"
+		serviceName = "svc"
+		networkName = "blue"
+		serviceKey  = namespace + "/" + serviceName
+	)
+
+	var (
+		k8sClient             *k8sfake.Clientset
+		virtClient            *kubecli.MockKubevirtClient
+		recorder              *record.FakeRecorder
+		serviceInformer       cache.SharedIndexInformer
+		endpointSliceInformer cache.SharedIndexInformer
+		vmiInformer           cache.SharedIndexInformer
+	)
+
+	newController := func(featureGates ...string) *Controller {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+		})
+		c, err := NewController(serviceInformer, endpointSliceInformer, vmiInformer, virtClient, recorder, config)
+		Expect(err).ToNot(HaveOccurred())
+		return c
+	}
+
+	newService := func(selector string) *k8sv1.Service {
+		return &k8sv1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        serviceName,
+				Namespace:   namespace,
+				Labels:      map[string]string{v1.SecondaryNetworkEndpointsLabel: networkName},
+				Annotations: map[string]string{v1.SecondaryNetworkEndpointsSelectorAnnotation: selector},
+			},
+			Spec: k8sv1.ServiceSpec{Ports: []k8sv1.ServicePort{{Name: "http", Protocol: k8sv1.ProtocolTCP, Port: 80}}},
+		}
+	}
+
+	newVMI := func(name, app, ip string) *v1.VirtualMachineInstance {
+		vmi := libvmi.New(libvmi.WithName(name), libvmi.WithNamespace(namespace), libvmi.WithLabel("app", app))
+		vmi.Status.Phase = v1.Running
+		vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{{Name: networkName, IPs: []string{ip}}}
+		return vmi
+	}
+
+	addEndpointSlice := func(endpointSlice *discoveryv1.EndpointSlice) {
+		Expect(endpointSliceInformer.GetStore().Add(endpointSlice)).To(Succeed())
+		_, err := k8sClient.DiscoveryV1().EndpointSlices(namespace).Create(context.Background(), endpointSlice, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	listEndpointSlices := func() []discoveryv1.EndpointSlice {
+		endpointSlices, err := k8sClient.DiscoveryV1().EndpointSlices(namespace).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return endpointSlices.Items
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
+		k8sClient = k8sfake.NewSimpleClientset()
+		virtClient.EXPECT().DiscoveryV1().Return(k8sClient.DiscoveryV1()).AnyTimes()
+		recorder = record.NewFakeRecorder(10)
+
+		serviceInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Service{})
+		endpointSliceInformer, _ = testutils.NewFakeInformerFor(&discoveryv1.EndpointSlice{})
+		vmiInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+	})
+
+	It("should create the endpoint slice of the VMIs matching the selector", func() {
+		c := newController(featuregate.SecondaryNetworkEndpoints)
+		Expect(serviceInformer.GetStore().Add(newService("app=web"))).To(Succeed())
+		Expect(vmiInformer.GetStore().Add(newVMI("vmi-web", "web", "192.168.1.10"))).To(Succeed())
+		Expect(vmiInformer.GetStore().Add(newVMI("vmi-db", "db", "192.168.1.20"))).To(Succeed())
+
+		Expect(c.execute(serviceKey)).To(Succeed())
+
+		endpointSlices := listEndpointSlices()
+		Expect(endpointSlices).To(HaveLen(1))
+		Expect(endpointSlices[0].Name).To(Equal("svc-secondary-ipv4"))
+		Expect(endpointSlices[0].Endpoints).To(ConsistOf(HaveField("Addresses", ConsistOf("192.168.1.10"))))
+	})
+
+	It("should update an outdated endpoint slice", func() {
+		c := newController(featuregate.SecondaryNetworkEndpoints)
+		service := newService("app=web")
+		Expect(serviceInformer.GetStore().Add(service)).To(Succeed())
+		addEndpointSlice(DesiredEndpointSlices(service, networkName, []*v1.VirtualMachineInstance{newVMI("vmi-web", "web", "192.168.1.10")})[0])
+		Expect(vmiInformer.GetStore().Add(newVMI("vmi-web", "web", "192.168.1.11"))).To(Succeed())
+
+		Expect(c.execute(serviceKey)).To(Succeed())
+
+		endpointSlices := listEndpointSlices()
+		Expect(endpointSlices).To(HaveLen(1))
+		Expect(endpointSlices[0].Endpoints).To(ConsistOf(HaveField("Addresses", ConsistOf("192.168.1.11"))))
+	})
+
+	It("should delete the endpoint slice when no VMI matches the selector anymore", func() {
+		c := newController(featuregate.SecondaryNetworkEndpoints)
+		service := newService("app=web")
+		Expect(serviceInformer.GetStore().Add(service)).To(Succeed())
+		addEndpointSlice(DesiredEndpointSlices(service, networkName, []*v1.VirtualMachineInstance{newVMI("vmi-web", "web", "192.168.1.10")})[0])
+
+		Expect(c.execute(serviceKey)).To(Succeed())
+
+		Expect(listEndpointSlices()).To(BeEmpty())
+	})
+
+	It("should not manage endpoint slices when the feature gate is disabled", func() {
+		c := newController()
+		Expect(serviceInformer.GetStore().Add(newService("app=web"))).To(Succeed())
+		Expect(vmiInformer.GetStore().Add(newVMI("vmi-web", "web", "192.168.1.10"))).To(Succeed())
+
+		Expect(c.execute(serviceKey)).To(Succeed())
+
+		Expect(listEndpointSlices()).To(BeEmpty())
+	})
+
+	It("should emit a warning event when the selector is invalid", func() {
+		c := newController(featuregate.SecondaryNetworkEndpoints)
+		Expect(serviceInformer.GetStore().Add(newService("app in (web"))).To(Succeed())
+
+		Expect(c.execute(serviceKey)).To(Succeed())
+
+		Expect(recorder.Events).To(Receive(ContainSubstring(InvalidSelectorReason)))
+		Expect(listEndpointSlices()).To(BeEmpty())
+	})
+
+	It("should emit a warning event when the Service has a pod selector", func() {
+		c := newController(featuregate.SecondaryNetworkEndpoints)
+		service := newService("app=web")
+		service.Spec.Selector = map[string]string{"app": "web"}
+		Expect(serviceInformer.GetStore().Add(service)).To(Succeed())
+		Expect(vmiInformer.GetStore().Add(newVMI("vmi-web", "web", "192.168.1.10"))).To(Succeed())
+
+		Expect(c.execute(serviceKey)).To(Succeed())
+
+		Expect(recorder.Events).To(Receive(ContainSubstring(SelectorConflictReason)))
+		Expect(listEndpointSlices()).To(BeEmpty())
+	})
+})
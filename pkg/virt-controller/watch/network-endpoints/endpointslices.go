@@ -0,0 +1,140 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package networkendpoints
+
+import (
+	"net"
+	"sort"
+
+	k8sv1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	netutils "k8s.io/utils/net"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+// DesiredEndpointSlices returns the endpoint slices publishing the IP addresses reported by the given VMIs
+// on the named secondary network, one per address family having at least one address.
+// Guest ports are taken from the Service ports target port, falling back to the Service port when
+// the target port is not a number.
+func DesiredEndpointSlices(service *k8sv1.Service, networkName string, vmis []*v1.VirtualMachineInstance) []*discoveryv1.EndpointSlice {
+	endpointsByFamily := map[discoveryv1.AddressType][]discoveryv1.Endpoint{}
+
+	sort.Slice(vmis, func(i, j int) bool { return vmis[i].Name < vmis[j].Name })
+	for _, vmi := range vmis {
+		if vmi.IsFinal() || vmi.DeletionTimestamp != nil {
+			continue
+		}
+		for addressType, addresses := range secondaryNetworkAddresses(vmi, networkName) {
+			endpointsByFamily[addressType] = append(endpointsByFamily[addressType], discoveryv1.Endpoint{
+				Addresses: addresses,
+				Conditions: discoveryv1.EndpointConditions{
+					Ready: pointer.P(vmi.IsRunning()),
+				},
+				NodeName: nodeName(vmi),
+				TargetRef: &k8sv1.ObjectReference{
+					Kind:      v1.VirtualMachineInstanceGroupVersionKind.Kind,
+					Namespace: vmi.Namespace,
+					Name:      vmi.Name,
+					UID:       vmi.UID,
+				},
+			})
+		}
+	}
+
+	var endpointSlices []*discoveryv1.EndpointSlice
+	for _, addressType := range []discoveryv1.AddressType{discoveryv1.AddressTypeIPv4, discoveryv1.AddressTypeIPv6} {
+		endpoints, exist := endpointsByFamily[addressType]
+		if !exist {
+			continue
+		}
+		endpointSlices = append(endpointSlices, &discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      EndpointSliceName(service.Name, addressType),
+				Namespace: service.Namespace,
+				Labels: map[string]string{
+					discoveryv1.LabelServiceName: service.Name,
+					discoveryv1.LabelManagedBy:   controller.SecondaryNetworkEndpointSliceManager,
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(service, k8sv1.SchemeGroupVersion.WithKind("Service")),
+				},
+			},
+			AddressType: addressType,
+			Endpoints:   endpoints,
+			Ports:       endpointPorts(service.Spec.Ports),
+		})
+	}
+	return endpointSlices
+}
+
+// EndpointSliceName returns the name of the endpoint slice of the given Service and address family.
+func EndpointSliceName(serviceName string, addressType discoveryv1.AddressType) string {
+	if addressType == discoveryv1.AddressTypeIPv6 {
+		return serviceName + "-secondary-ipv6"
+	}
+	return serviceName + "-secondary-ipv4"
+}
+
+func secondaryNetworkAddresses(vmi *v1.VirtualMachineInstance, networkName string) map[discoveryv1.AddressType][]string {
+	addresses := map[discoveryv1.AddressType][]string{}
+	for _, ifaceStatus := range vmi.Status.Interfaces {
+		if ifaceStatus.Name != networkName {
+			continue
+		}
+		for _, ip := range ifaceStatus.IPs {
+			switch {
+			case netutils.IsIPv4String(ip):
+				addresses[discoveryv1.AddressTypeIPv4] = append(addresses[discoveryv1.AddressTypeIPv4], ip)
+			case netutils.IsIPv6String(ip) && !net.ParseIP(ip).IsLinkLocalUnicast():
+				addresses[discoveryv1.AddressTypeIPv6] = append(addresses[discoveryv1.AddressTypeIPv6], ip)
+			}
+		}
+	}
+	return addresses
+}
+
+func nodeName(vmi *v1.VirtualMachineInstance) *string {
+	if vmi.Status.NodeName == "" {
+		return nil
+	}
+	return pointer.P(vmi.Status.NodeName)
+}
+
+func endpointPorts(servicePorts []k8sv1.ServicePort) []discoveryv1.EndpointPort {
+	var ports []discoveryv1.EndpointPort
+	for _, servicePort := range servicePorts {
+		port := servicePort.Port
+		if targetPort := servicePort.TargetPort.IntValue(); targetPort != 0 {
+			port = int32(targetPort)
+		}
+		ports = append(ports, discoveryv1.EndpointPort{
+			Name:        pointer.P(servicePort.Name),
+			Protocol:    pointer.P(servicePort.Protocol),
+			Port:        pointer.P(port),
+			AppProtocol: servicePort.AppProtocol,
+		})
+	}
+	return ports
+}
@@ -0,0 +1,152 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package networkendpoints_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	networkendpoints "kubevirt.io/kubevirt/pkg/virt-controller/watch/network-endpoints"
+)
+
+var _ = Describe("Secondary network endpoint slices", func() {
+	const (
+		networkName = "blue"
+		nodeName    = "node01"
+	)
+
+	newService := func(ports ...k8sv1.ServicePort) *k8sv1.Service {
+		return &k8sv1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: "ns", UID: "svc-uid"},
+			Spec:       k8sv1.ServiceSpec{Ports: ports},
+		}
+	}
+
+	newVMI := func(name string, phase v1.VirtualMachineInstancePhase, ips ...string) *v1.VirtualMachineInstance {
+		vmi := libvmi.New(libvmi.WithName(name), libvmi.WithNamespace("ns"))
+		vmi.Status.Phase = phase
+		vmi.Status.NodeName = nodeName
+		vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{
+			{Name: v1.DefaultPodNetwork().Name, IPs: []string{"10.244.0.10"}},
+			{Name: networkName, IPs: ips},
+		}
+		return vmi
+	}
+
+	addresses := func(endpointSlice *discoveryv1.EndpointSlice) []string {
+		var addrs []string
+		for _, endpoint := range endpointSlice.Endpoints {
+			addrs = append(addrs, endpoint.Addresses...)
+		}
+		return addrs
+	}
+
+	It("should split the addresses by family and skip link-local IPv6 addresses", func() {
+		vmi := newVMI("vmi", v1.Running, "192.168.1.10", "fd10::10", "fe80::1")
+
+		endpointSlices := networkendpoints.DesiredEndpointSlices(newService(), networkName, []*v1.VirtualMachineInstance{vmi})
+
+		Expect(endpointSlices).To(HaveLen(2))
+		Expect(endpointSlices[0].Name).To(Equal("svc-secondary-ipv4"))
+		Expect(endpointSlices[0].AddressType).To(Equal(discoveryv1.AddressTypeIPv4))
+		Expect(addresses(endpointSlices[0])).To(ConsistOf("192.168.1.10"))
+		Expect(endpointSlices[1].Name).To(Equal("svc-secondary-ipv6"))
+		Expect(endpointSlices[1].AddressType).To(Equal(discoveryv1.AddressTypeIPv6))
+		Expect(addresses(endpointSlices[1])).To(ConsistOf("fd10::10"))
+	})
+
+	It("should label the endpoint slices and reference the Service and the VMIs", func() {
+		vmi := newVMI("vmi", v1.Running, "192.168.1.10")
+
+		endpointSlices := networkendpoints.DesiredEndpointSlices(newService(), networkName, []*v1.VirtualMachineInstance{vmi})
+
+		Expect(endpointSlices).To(HaveLen(1))
+		endpointSlice := endpointSlices[0]
+		Expect(endpointSlice.Labels).To(Equal(map[string]string{
+			discoveryv1.LabelServiceName: "svc",
+			discoveryv1.LabelManagedBy:   controller.SecondaryNetworkEndpointSliceManager,
+		}))
+		Expect(endpointSlice.OwnerReferences).To(ConsistOf(HaveField("UID", BeEquivalentTo("svc-uid"))))
+		Expect(endpointSlice.Endpoints).To(ConsistOf(discoveryv1.Endpoint{
+			Addresses:  []string{"192.168.1.10"},
+			Conditions: discoveryv1.EndpointConditions{Ready: pointer.P(true)},
+			NodeName:   pointer.P(nodeName),
+			TargetRef: &k8sv1.ObjectReference{
+				Kind:      v1.VirtualMachineInstanceGroupVersionKind.Kind,
+				Namespace: vmi.Namespace,
+				Name:      vmi.Name,
+				UID:       vmi.UID,
+			},
+		}))
+	})
+
+	It("should publish non running VMIs as not ready and skip final VMIs", func() {
+		vmis := []*v1.VirtualMachineInstance{
+			newVMI("vmi-b", v1.Scheduled, "192.168.1.11"),
+			newVMI("vmi-a", v1.Running, "192.168.1.10"),
+			newVMI("vmi-c", v1.Succeeded, "192.168.1.12"),
+		}
+
+		endpointSlices := networkendpoints.DesiredEndpointSlices(newService(), networkName, vmis)
+
+		Expect(endpointSlices).To(HaveLen(1))
+		Expect(endpointSlices[0].Endpoints).To(HaveLen(2))
+		Expect(endpointSlices[0].Endpoints[0].Addresses).To(ConsistOf("192.168.1.10"))
+		Expect(endpointSlices[0].Endpoints[0].Conditions.Ready).To(HaveValue(BeTrue()))
+		Expect(endpointSlices[0].Endpoints[1].Addresses).To(ConsistOf("192.168.1.11"))
+		Expect(endpointSlices[0].Endpoints[1].Conditions.Ready).To(HaveValue(BeFalse()))
+	})
+
+	It("should not publish endpoint slices when no VMI reports an address on the network", func() {
+		vmi := newVMI("vmi", v1.Running)
+
+		Expect(networkendpoints.DesiredEndpointSlices(newService(), networkName, []*v1.VirtualMachineInstance{vmi})).To(BeEmpty())
+	})
+
+	DescribeTable("should set the endpoint port", func(servicePort k8sv1.ServicePort, expectedPort int32) {
+		vmi := newVMI("vmi", v1.Running, "192.168.1.10")
+
+		endpointSlices := networkendpoints.DesiredEndpointSlices(newService(servicePort), networkName, []*v1.VirtualMachineInstance{vmi})
+
+		Expect(endpointSlices).To(HaveLen(1))
+		Expect(endpointSlices[0].Ports).To(ConsistOf(discoveryv1.EndpointPort{
+			Name:     pointer.P(servicePort.Name),
+			Protocol: pointer.P(servicePort.Protocol),
+			Port:     pointer.P(expectedPort),
+		}))
+	},
+		Entry("from the numeric target port",
+			k8sv1.ServicePort{Name: "http", Protocol: k8sv1.ProtocolTCP, Port: 80, TargetPort: intstr.FromInt32(8080)}, int32(8080)),
+		Entry("from the service port when the target port is named",
+			k8sv1.ServicePort{Name: "http", Protocol: k8sv1.ProtocolTCP, Port: 80, TargetPort: intstr.FromString("web")}, int32(80)),
+		Entry("from the service port when the target port is not set",
+			k8sv1.ServicePort{Name: "dns", Protocol: k8sv1.ProtocolUDP, Port: 53}, int32(53)),
+	)
+})
@@ -0,0 +1,429 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rebalance
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	migrationutils "kubevirt.io/kubevirt/pkg/util/migrations"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	// SuccessfulCreateVirtualMachineInstanceMigrationReason is added in an event if creating a rebalancing migration succeeded.
+	SuccessfulCreateVirtualMachineInstanceMigrationReason = "SuccessfulCreate"
+	// FailedCreateVirtualMachineInstanceMigrationReason is added in an event if creating a rebalancing migration failed.
+	FailedCreateVirtualMachineInstanceMigrationReason = "FailedCreate"
+	// NoPreemptionVictimsReason is added in an event if no lower priority VMI can be migrated to free capacity.
+	NoPreemptionVictimsReason = "NoPreemptionVictims"
+	// PreemptionAttemptsExceededReason is added in an event if migrating VMIs repeatedly did not free capacity.
+	PreemptionAttemptsExceededReason = "PreemptionAttemptsExceeded"
+
+	retryInterval = time.Minute
+	// maxPreemptionAttempts limits how many rounds of migrations are started for a single preemptor,
+	// as the capacity freed by the migrations may be taken by other pods.
+	maxPreemptionAttempts = 3
+)
+
+// Controller frees capacity for unschedulable VMIs which are not allowed to preempt, by live
+// migrating lower priority VMIs off a node instead of having the scheduler evict them.
+// The VMIs opt in through a PriorityClass with a Never preemption policy.
+type Controller struct {
+	clientset             kubecli.KubevirtClient
+	queue                 workqueue.TypedRateLimitingInterface[string]
+	vmiIndexer            cache.Indexer
+	podIndexer            cache.Indexer
+	allPodStore           cache.Store
+	migrationIndexer      cache.Indexer
+	nodeStore             cache.Store
+	recorder              record.EventRecorder
+	migrationExpectations *controller.UIDTrackingControllerExpectations
+	clusterConfig         *virtconfig.ClusterConfig
+
+	attemptsLock sync.Mutex
+	attempts     map[string]int
+
+	hasSynced func() bool
+}
+
+func NewController(
+	vmiInformer cache.SharedIndexInformer,
+	podInformer cache.SharedIndexInformer,
+	allPodInformer cache.SharedIndexInformer,
+	migrationInformer cache.SharedIndexInformer,
+	nodeInformer cache.SharedIndexInformer,
+	clientset kubecli.KubevirtClient,
+	recorder record.EventRecorder,
+	clusterConfig *virtconfig.ClusterConfig,
+) (*Controller, error) {
+	c := &Controller{
+		clientset: clientset,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-rebalance"},
+		),
+		vmiIndexer:            vmiInformer.GetIndexer(),
+		podIndexer:            podInformer.GetIndexer(),
+		allPodStore:           allPodInformer.GetStore(),
+		migrationIndexer:      migrationInformer.GetIndexer(),
+		nodeStore:             nodeInformer.GetStore(),
+		recorder:              recorder,
+		migrationExpectations: controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
+		clusterConfig:         clusterConfig,
+		attempts:              map[string]int{},
+		hasSynced: func() bool {
+			return vmiInformer.HasSynced() && podInformer.HasSynced() && allPodInformer.HasSynced() &&
+				migrationInformer.HasSynced() && nodeInformer.HasSynced()
+		},
+	}
+
+	if _, err := vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueVMI,
+		UpdateFunc: func(_, newObj interface{}) { c.enqueueVMI(newObj) },
+	}); err != nil {
+		return nil, err
+	}
+	if _, err := podInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueuePodOwner,
+		UpdateFunc: func(_, newObj interface{}) { c.enqueuePodOwner(newObj) },
+	}); err != nil {
+		return nil, err
+	}
+	if _, err := migrationInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.addMigration,
+		UpdateFunc: func(_, newObj interface{}) { c.enqueueMigrationPreemptor(newObj) },
+		DeleteFunc: c.enqueueMigrationPreemptor,
+	}); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Controller) enqueueVMI(obj interface{}) {
+	vmi, ok := obj.(*v1.VirtualMachineInstance)
+	if !ok || vmi.Status.Phase != v1.Scheduling {
+		return
+	}
+	key, err := controller.KeyFunc(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to extract key from VirtualMachineInstance.")
+		return
+	}
+	c.queue.Add(key)
+}
+
+func (c *Controller) enqueuePodOwner(obj interface{}) {
+	pod, ok := obj.(*k8sv1.Pod)
+	if !ok || pod.Status.Phase != k8sv1.PodPending {
+		return
+	}
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil || owner.Kind != v1.VirtualMachineInstanceGroupVersionKind.Kind {
+		return
+	}
+	c.queue.Add(controller.NamespacedKey(pod.Namespace, owner.Name))
+}
+
+func (c *Controller) addMigration(obj interface{}) {
+	migration, ok := obj.(*v1.VirtualMachineInstanceMigration)
+	if !ok {
+		return
+	}
+	if key, ok := migration.Annotations[v1.PreemptionMigrationAnnotation]; ok {
+		c.migrationExpectations.CreationObserved(key)
+		c.queue.Add(key)
+	}
+}
+
+func (c *Controller) enqueueMigrationPreemptor(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	migration, ok := obj.(*v1.VirtualMachineInstanceMigration)
+	if !ok {
+		return
+	}
+	if key, ok := migration.Annotations[v1.PreemptionMigrationAnnotation]; ok {
+		c.queue.Add(key)
+	}
+}
+
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.queue.ShutDown()
+	log.Log.Info("Starting rebalance controller.")
+
+	cache.WaitForCacheSync(stopCh, c.hasSynced)
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping rebalance controller.")
+}
+
+func (c *Controller) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *Controller) Execute() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.execute(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing VirtualMachineInstance %v", key)
+		c.queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed VirtualMachineInstance %v", key)
+		c.queue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) execute(key string) error {
+	if !c.clusterConfig.MigrationBasedPreemptionEnabled() {
+		return nil
+	}
+
+	obj, exists, err := c.vmiIndexer.GetByKey(key)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		c.migrationExpectations.DeleteExpectations(key)
+		c.resetAttempts(key)
+		return nil
+	}
+	if !c.migrationExpectations.SatisfiedExpectations(key) {
+		return nil
+	}
+
+	vmi := obj.(*v1.VirtualMachineInstance)
+	if vmi.DeletionTimestamp != nil || vmi.Status.Phase != v1.Scheduling {
+		c.resetAttempts(key)
+		return nil
+	}
+
+	pod, err := controller.CurrentVMIPod(vmi, c.podIndexer)
+	if err != nil {
+		return err
+	}
+	if pod == nil || !isWaitingForCapacity(pod) {
+		return nil
+	}
+
+	migrations := migrationutils.ListUnfinishedMigrations(c.migrationIndexer)
+	if hasPreemptionMigrations(key, migrations) {
+		// Wait for the capacity freed so far to be used before freeing more
+		return nil
+	}
+
+	candidates, err := c.listCandidates(migrations)
+	if err != nil {
+		return err
+	}
+
+	if c.attemptsOf(key) >= maxPreemptionAttempts {
+		c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, PreemptionAttemptsExceededReason,
+			"Migrated lower priority VirtualMachineInstances %d times without freeing enough capacity", maxPreemptionAttempts)
+		return nil
+	}
+
+	victims := SelectVictims(pod, c.listNodes(), c.listPods(), candidates)
+	if len(victims) == 0 {
+		c.recorder.Eventf(vmi, k8sv1.EventTypeNormal, NoPreemptionVictimsReason,
+			"No lower priority VirtualMachineInstance can be migrated to free capacity")
+		c.queue.AddAfter(key, retryInterval)
+		return nil
+	}
+
+	return c.migrateVictims(key, vmi, victims)
+}
+
+// isWaitingForCapacity tells whether the pod is not allowed to preempt and failed to be
+// scheduled because of lacking cpu or memory.
+func isWaitingForCapacity(pod *k8sv1.Pod) bool {
+	if pod.Status.Phase != k8sv1.PodPending || pod.Spec.NodeName != "" || pod.Spec.Priority == nil {
+		return false
+	}
+	if pod.Spec.PreemptionPolicy == nil || *pod.Spec.PreemptionPolicy != k8sv1.PreemptNever {
+		return false
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == k8sv1.PodScheduled &&
+			condition.Status == k8sv1.ConditionFalse &&
+			condition.Reason == k8sv1.PodReasonUnschedulable {
+			return strings.Contains(condition.Message, "Insufficient cpu") ||
+				strings.Contains(condition.Message, "Insufficient memory")
+		}
+	}
+	return false
+}
+
+func hasPreemptionMigrations(key string, migrations []*v1.VirtualMachineInstanceMigration) bool {
+	for _, migration := range migrations {
+		if migration.Annotations[v1.PreemptionMigrationAnnotation] == key {
+			return true
+		}
+	}
+	return false
+}
+
+// listCandidates returns the running VMIs which can be live migrated right away.
+func (c *Controller) listCandidates(migrations []*v1.VirtualMachineInstanceMigration) ([]Candidate, error) {
+	migrating := map[string]bool{}
+	for _, migration := range migrations {
+		migrating[controller.NamespacedKey(migration.Namespace, migration.Spec.VMIName)] = true
+	}
+
+	var candidates []Candidate
+	for _, obj := range c.vmiIndexer.List() {
+		vmi := obj.(*v1.VirtualMachineInstance)
+		if vmi.Status.Phase != v1.Running || vmi.Status.NodeName == "" || vmi.DeletionTimestamp != nil {
+			continue
+		}
+		if migrating[controller.NamespacedKey(vmi.Namespace, vmi.Name)] || migrationutils.IsMigrating(vmi) {
+			continue
+		}
+		if !migrationutils.VMIMigratableOnEviction(c.clusterConfig, vmi) ||
+			!controller.NewVirtualMachineInstanceConditionManager().HasConditionWithStatus(vmi, v1.VirtualMachineInstanceIsMigratable, k8sv1.ConditionTrue) {
+			continue
+		}
+		if controller.VMIActivePodsCount(vmi, c.podIndexer) > 1 {
+			// waiting on target/source pods from a previous migration to terminate
+			continue
+		}
+		pod, err := controller.CurrentVMIPod(vmi, c.podIndexer)
+		if err != nil {
+			return nil, err
+		}
+		if pod != nil {
+			candidates = append(candidates, Candidate{VMI: vmi, Pod: pod})
+		}
+	}
+	return candidates, nil
+}
+
+func (c *Controller) listNodes() []*k8sv1.Node {
+	var nodes []*k8sv1.Node
+	for _, obj := range c.nodeStore.List() {
+		nodes = append(nodes, obj.(*k8sv1.Node))
+	}
+	return nodes
+}
+
+func (c *Controller) listPods() []*k8sv1.Pod {
+	var pods []*k8sv1.Pod
+	for _, obj := range c.allPodStore.List() {
+		pods = append(pods, obj.(*k8sv1.Pod))
+	}
+	return pods
+}
+
+func (c *Controller) attemptsOf(key string) int {
+	c.attemptsLock.Lock()
+	defer c.attemptsLock.Unlock()
+	return c.attempts[key]
+}
+
+func (c *Controller) resetAttempts(key string) {
+	c.attemptsLock.Lock()
+	defer c.attemptsLock.Unlock()
+	delete(c.attempts, key)
+}
+
+func (c *Controller) migrateVictims(key string, preemptor *v1.VirtualMachineInstance, victims []Candidate) error {
+	log.Log.Object(preemptor).Infof("Migrating %d lower priority VirtualMachineInstances off node %s to free capacity",
+		len(victims), victims[0].VMI.Status.NodeName)
+
+	wg := &sync.WaitGroup{}
+	wg.Add(len(victims))
+	errChan := make(chan error, len(victims))
+
+	c.attemptsLock.Lock()
+	c.attempts[key]++
+	c.attemptsLock.Unlock()
+
+	c.migrationExpectations.ExpectCreations(key, len(victims))
+	for _, victim := range victims {
+		go func(vmi *v1.VirtualMachineInstance) {
+			defer wg.Done()
+			migration, err := c.clientset.VirtualMachineInstanceMigration(vmi.Namespace).Create(context.Background(),
+				NewPreemptionMigration(vmi, key, c.clusterConfig), metav1.CreateOptions{})
+			if err != nil {
+				c.migrationExpectations.CreationObserved(key)
+				c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, FailedCreateVirtualMachineInstanceMigrationReason, "Error creating a Migration: %v", err)
+				errChan <- err
+				return
+			}
+			c.recorder.Eventf(vmi, k8sv1.EventTypeNormal, SuccessfulCreateVirtualMachineInstanceMigrationReason,
+				"Created Migration %s to free capacity for VirtualMachineInstance %s", migration.Name, key)
+		}(victim.VMI)
+	}
+	wg.Wait()
+
+	select {
+	case err := <-errChan:
+		return fmt.Errorf("failed to create preemption migration: %v", err)
+	default:
+	}
+	return nil
+}
+
+func NewPreemptionMigration(vmi *v1.VirtualMachineInstance, preemptorKey string, config *virtconfig.ClusterConfig) *v1.VirtualMachineInstanceMigration {
+	migration := &v1.VirtualMachineInstanceMigration{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				v1.PreemptionMigrationAnnotation: preemptorKey,
+			},
+			GenerateName: "kubevirt-preemption-",
+		},
+		Spec: v1.VirtualMachineInstanceMigrationSpec{
+			VMIName: vmi.Name,
+		},
+	}
+	if config.MigrationPriorityQueueEnabled() {
+		migration.Spec.Priority = pointer.P(v1.PrioritySystemMaintenance)
+	}
+	return migration
+}
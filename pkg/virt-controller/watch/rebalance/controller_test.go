@@ -0,0 +1,241 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rebalance
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("Rebalance controller", func() {
+	const (
+		nodeName     = "node01"
+		preemptorKey = k8sv1.NamespaceDefault + "/preemptor"
+	)
+
+	var (
+		virtClient        *kubecli.MockKubevirtClient
+		fakeVirtClient    *kubevirtfake.Clientset
+		recorder          *record.FakeRecorder
+		vmiInformer       cache.SharedIndexInformer
+		podInformer       cache.SharedIndexInformer
+		allPodInformer    cache.SharedIndexInformer
+		migrationInformer cache.SharedIndexInformer
+		nodeInformer      cache.SharedIndexInformer
+	)
+
+	newController := func(featureGates ...string) *Controller {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+		})
+		c, err := NewController(vmiInformer, podInformer, allPodInformer, migrationInformer, nodeInformer, virtClient, recorder, config)
+		Expect(err).ToNot(HaveOccurred())
+		return c
+	}
+
+	newPod := func(vmi *v1.VirtualMachineInstance, priority int32, memory string) *k8sv1.Pod {
+		return &k8sv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "virt-launcher-" + vmi.Name,
+				Namespace:       vmi.Namespace,
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(vmi, v1.VirtualMachineInstanceGroupVersionKind)},
+			},
+			Spec: k8sv1.PodSpec{
+				NodeName: vmi.Status.NodeName,
+				Priority: pointer.P(priority),
+				Containers: []k8sv1.Container{{
+					Resources: k8sv1.ResourceRequirements{Requests: k8sv1.ResourceList{
+						k8sv1.ResourceMemory: resource.MustParse(memory),
+					}},
+				}},
+			},
+			Status: k8sv1.PodStatus{Phase: k8sv1.PodRunning},
+		}
+	}
+
+	addPreemptor := func(preemptionPolicy k8sv1.PreemptionPolicy) {
+		vmi := libvmi.New(libvmi.WithName("preemptor"), libvmi.WithNamespace(k8sv1.NamespaceDefault))
+		vmi.UID = "preemptor-uid"
+		vmi.Status.Phase = v1.Scheduling
+		pod := newPod(vmi, 1000, "2Gi")
+		pod.Spec.PreemptionPolicy = pointer.P(preemptionPolicy)
+		pod.Status = k8sv1.PodStatus{
+			Phase: k8sv1.PodPending,
+			Conditions: []k8sv1.PodCondition{{
+				Type:    k8sv1.PodScheduled,
+				Status:  k8sv1.ConditionFalse,
+				Reason:  k8sv1.PodReasonUnschedulable,
+				Message: "0/1 nodes are available: 1 Insufficient memory.",
+			}},
+		}
+		Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+		Expect(podInformer.GetStore().Add(pod)).To(Succeed())
+		Expect(allPodInformer.GetStore().Add(pod)).To(Succeed())
+	}
+
+	addRunningVMI := func(name string, priority int32, memory string) {
+		vmi := libvmi.New(
+			libvmi.WithName(name),
+			libvmi.WithNamespace(k8sv1.NamespaceDefault),
+			libvmi.WithEvictionStrategy(v1.EvictionStrategyLiveMigrate),
+		)
+		vmi.UID = types.UID(name + "-uid")
+		vmi.Status.Phase = v1.Running
+		vmi.Status.NodeName = nodeName
+		vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+			Type:   v1.VirtualMachineInstanceIsMigratable,
+			Status: k8sv1.ConditionTrue,
+		}}
+		pod := newPod(vmi, priority, memory)
+		Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+		Expect(podInformer.GetStore().Add(pod)).To(Succeed())
+		Expect(allPodInformer.GetStore().Add(pod)).To(Succeed())
+	}
+
+	listMigrations := func() []v1.VirtualMachineInstanceMigration {
+		migrations, err := fakeVirtClient.KubevirtV1().VirtualMachineInstanceMigrations(k8sv1.NamespaceDefault).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return migrations.Items
+	}
+
+	BeforeEach(func() {
+		virtClient = kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		fakeVirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineInstanceMigration(k8sv1.NamespaceDefault).
+			Return(fakeVirtClient.KubevirtV1().VirtualMachineInstanceMigrations(k8sv1.NamespaceDefault)).AnyTimes()
+		recorder = record.NewFakeRecorder(10)
+
+		vmiInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		podInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Pod{})
+		allPodInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Pod{})
+		migrationInformer, _ = testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachineInstanceMigration{}, controller.GetVirtualMachineInstanceMigrationInformerIndexers())
+		nodeInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Node{})
+		Expect(nodeInformer.GetStore().Add(&k8sv1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: nodeName},
+			Status: k8sv1.NodeStatus{Allocatable: k8sv1.ResourceList{
+				k8sv1.ResourceMemory: resource.MustParse("6Gi"),
+			}},
+		})).To(Succeed())
+	})
+
+	It("should migrate lower priority VMIs to free capacity for the preemptor", func() {
+		c := newController(featuregate.MigrationBasedPreemption)
+		addPreemptor(k8sv1.PreemptNever)
+		addRunningVMI("low", 10, "2Gi")
+		addRunningVMI("high", 2000, "4Gi")
+
+		Expect(c.execute(preemptorKey)).To(Succeed())
+
+		migrations := listMigrations()
+		Expect(migrations).To(HaveLen(1))
+		Expect(migrations[0].Spec.VMIName).To(Equal("low"))
+		Expect(migrations[0].Annotations).To(HaveKeyWithValue(v1.PreemptionMigrationAnnotation, preemptorKey))
+		Expect(c.migrationExpectations.SatisfiedExpectations(preemptorKey)).To(BeFalse())
+		testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+	})
+
+	It("should wait for the preemption migrations of the preemptor to finish", func() {
+		c := newController(featuregate.MigrationBasedPreemption)
+		addPreemptor(k8sv1.PreemptNever)
+		addRunningVMI("low", 10, "2Gi")
+		addRunningVMI("other", 10, "2Gi")
+		migration := NewPreemptionMigration(libvmi.New(libvmi.WithName("other")), preemptorKey, c.clusterConfig)
+		migration.Name = "running-preemption"
+		migration.Namespace = k8sv1.NamespaceDefault
+		Expect(migrationInformer.GetStore().Add(migration)).To(Succeed())
+
+		Expect(c.execute(preemptorKey)).To(Succeed())
+		Expect(listMigrations()).To(BeEmpty())
+	})
+
+	It("should report when no VMI can be migrated to free capacity", func() {
+		c := newController(featuregate.MigrationBasedPreemption)
+		addPreemptor(k8sv1.PreemptNever)
+		addRunningVMI("high", 2000, "4Gi")
+
+		Expect(c.execute(preemptorKey)).To(Succeed())
+		Expect(listMigrations()).To(BeEmpty())
+		testutils.ExpectEvent(recorder, NoPreemptionVictimsReason)
+	})
+
+	It("should stop migrating VMIs after repeated attempts for the same preemptor", func() {
+		c := newController(featuregate.MigrationBasedPreemption)
+		addPreemptor(k8sv1.PreemptNever)
+		addRunningVMI("low", 10, "2Gi")
+		c.attempts[preemptorKey] = maxPreemptionAttempts
+
+		Expect(c.execute(preemptorKey)).To(Succeed())
+		Expect(listMigrations()).To(BeEmpty())
+		testutils.ExpectEvent(recorder, PreemptionAttemptsExceededReason)
+	})
+
+	It("should count the attempts until the preemptor is scheduled", func() {
+		c := newController(featuregate.MigrationBasedPreemption)
+		addPreemptor(k8sv1.PreemptNever)
+		addRunningVMI("low", 10, "2Gi")
+		addRunningVMI("high", 2000, "4Gi")
+
+		Expect(c.execute(preemptorKey)).To(Succeed())
+		Expect(c.attemptsOf(preemptorKey)).To(Equal(1))
+		testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+
+		obj, _, err := vmiInformer.GetStore().GetByKey(preemptorKey)
+		Expect(err).ToNot(HaveOccurred())
+		vmi := obj.(*v1.VirtualMachineInstance).DeepCopy()
+		vmi.Status.Phase = v1.Scheduled
+		Expect(vmiInformer.GetStore().Update(vmi)).To(Succeed())
+		c.migrationExpectations.CreationObserved(preemptorKey)
+
+		Expect(c.execute(preemptorKey)).To(Succeed())
+		Expect(c.attemptsOf(preemptorKey)).To(BeZero())
+	})
+
+	DescribeTable("should not migrate VMIs", func(preemptionPolicy k8sv1.PreemptionPolicy, featureGates ...string) {
+		c := newController(featureGates...)
+		addPreemptor(preemptionPolicy)
+		addRunningVMI("low", 10, "2Gi")
+
+		Expect(c.execute(preemptorKey)).To(Succeed())
+		Expect(listMigrations()).To(BeEmpty())
+	},
+		Entry("when the feature gate is disabled", k8sv1.PreemptNever),
+		Entry("when the preemptor is allowed to preempt", k8sv1.PreemptLowerPriority, featuregate.MigrationBasedPreemption),
+	)
+})
@@ -0,0 +1,427 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rebalance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	migrationutils "kubevirt.io/kubevirt/pkg/util/migrations"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/descheduler"
+)
+
+// staleLoadTimeout is the age of the last heartbeat after which the load reported on a node
+// is ignored. virt-handler refreshes it every one to two minutes.
+const staleLoadTimeout = 5 * time.Minute
+
+// LoadController relieves the nodes whose CPUs are overloaded, as reported by virt-handler,
+// by live migrating their VMIs one at a time. The migrations are budgeted cluster-wide, and
+// a cooldown applies to both the nodes and the VMIs, so that the load settles in between.
+// Like the descheduler, it only considers the VMIs which would be migrated on eviction, and
+// it leaves alone the pods the descheduler is told not to evict or is already evicting.
+type LoadController struct {
+	clientset             kubecli.KubevirtClient
+	queue                 workqueue.TypedRateLimitingInterface[string]
+	vmiIndexer            cache.Indexer
+	podIndexer            cache.Indexer
+	migrationIndexer      cache.Indexer
+	nodeStore             cache.Store
+	recorder              record.EventRecorder
+	migrationExpectations *controller.UIDTrackingControllerExpectations
+	clusterConfig         *virtconfig.ClusterConfig
+
+	hasSynced func() bool
+}
+
+type cpuLoad struct {
+	utilization uint32
+	steal       uint32
+}
+
+func NewLoadController(
+	vmiInformer cache.SharedIndexInformer,
+	podInformer cache.SharedIndexInformer,
+	migrationInformer cache.SharedIndexInformer,
+	nodeInformer cache.SharedIndexInformer,
+	clientset kubecli.KubevirtClient,
+	recorder record.EventRecorder,
+	clusterConfig *virtconfig.ClusterConfig,
+) (*LoadController, error) {
+	c := &LoadController{
+		clientset: clientset,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-load-rebalance"},
+		),
+		vmiIndexer:            vmiInformer.GetIndexer(),
+		podIndexer:            podInformer.GetIndexer(),
+		migrationIndexer:      migrationInformer.GetIndexer(),
+		nodeStore:             nodeInformer.GetStore(),
+		recorder:              recorder,
+		migrationExpectations: controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
+		clusterConfig:         clusterConfig,
+		hasSynced: func() bool {
+			return vmiInformer.HasSynced() && podInformer.HasSynced() && migrationInformer.HasSynced() && nodeInformer.HasSynced()
+		},
+	}
+
+	if _, err := nodeInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueNode,
+		UpdateFunc: func(_, newObj interface{}) { c.enqueueNode(newObj) },
+	}); err != nil {
+		return nil, err
+	}
+	if _, err := migrationInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.addMigration,
+		UpdateFunc: func(_, newObj interface{}) { c.enqueueMigrationSourceNode(newObj) },
+		DeleteFunc: c.enqueueMigrationSourceNode,
+	}); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *LoadController) enqueueNode(obj interface{}) {
+	if node, ok := obj.(*k8sv1.Node); ok {
+		c.queue.Add(node.Name)
+	}
+}
+
+func (c *LoadController) addMigration(obj interface{}) {
+	migration, ok := obj.(*v1.VirtualMachineInstanceMigration)
+	if !ok {
+		return
+	}
+	if nodeName, ok := migration.Annotations[v1.LoadRebalanceMigrationAnnotation]; ok {
+		c.migrationExpectations.CreationObserved(nodeName)
+		c.queue.Add(nodeName)
+	}
+}
+
+func (c *LoadController) enqueueMigrationSourceNode(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	migration, ok := obj.(*v1.VirtualMachineInstanceMigration)
+	if !ok {
+		return
+	}
+	if nodeName, ok := migration.Annotations[v1.LoadRebalanceMigrationAnnotation]; ok {
+		c.queue.Add(nodeName)
+	}
+}
+
+func (c *LoadController) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.queue.ShutDown()
+	log.Log.Info("Starting load rebalance controller.")
+
+	cache.WaitForCacheSync(stopCh, c.hasSynced)
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping load rebalance controller.")
+}
+
+func (c *LoadController) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *LoadController) Execute() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.execute(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing node %v", key)
+		c.queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed node %v", key)
+		c.queue.Forget(key)
+	}
+	return true
+}
+
+func (c *LoadController) execute(nodeName string) error {
+	if !c.clusterConfig.LoadAwareRebalancingEnabled() {
+		return nil
+	}
+
+	obj, exists, err := c.nodeStore.GetByKey(nodeName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		c.migrationExpectations.DeleteExpectations(nodeName)
+		return nil
+	}
+	if !c.migrationExpectations.SatisfiedExpectations(nodeName) {
+		return nil
+	}
+
+	node := obj.(*k8sv1.Node)
+	config := c.clusterConfig.GetLoadAwareRebalancingConfiguration()
+	if load := nodeCPULoad(node); load == nil || !isOverloaded(load, config) {
+		return nil
+	}
+
+	migrations := migrationutils.ListUnfinishedMigrations(c.migrationIndexer)
+	running := 0
+	for _, migration := range migrations {
+		sourceNode, isRebalance := migration.Annotations[v1.LoadRebalanceMigrationAnnotation]
+		if !isRebalance {
+			continue
+		}
+		if sourceNode == nodeName {
+			// Wait for the migration to complete and the load to settle
+			return nil
+		}
+		running++
+	}
+	if running >= int(*config.MaxParallelMigrations) {
+		log.Log.V(4).Infof("Rebalancing migration budget exhausted, delaying the rebalancing of node %s", nodeName)
+		c.queue.AddAfter(nodeName, retryInterval)
+		return nil
+	}
+
+	if remaining := c.nodeCooldown(nodeName, config.Cooldown.Duration); remaining > 0 {
+		c.queue.AddAfter(nodeName, remaining)
+		return nil
+	}
+
+	overloadedNodes, hasRelievingNode := c.classifyNodes(nodeName, config)
+	if !hasRelievingNode {
+		log.Log.V(4).Infof("No node can take the load of overloaded node %s", nodeName)
+		return nil
+	}
+
+	candidate, err := c.selectCandidate(nodeName, migrations, config.Cooldown.Duration)
+	if err != nil {
+		return err
+	}
+	if candidate == nil {
+		log.Log.V(4).Infof("No VirtualMachineInstance can be migrated off overloaded node %s", nodeName)
+		return nil
+	}
+
+	return c.migrate(nodeName, overloadedNodes, candidate.VMI)
+}
+
+// nodeCPULoad returns the CPU load reported by virt-handler on the node, or nil if it is
+// missing or stale.
+func nodeCPULoad(node *k8sv1.Node) *cpuLoad {
+	lastHeartBeat, exists := node.Annotations[v1.VirtHandlerHeartbeat]
+	if !exists {
+		return nil
+	}
+	timestamp := metav1.Time{}
+	if err := json.Unmarshal([]byte(`"`+lastHeartBeat+`"`), &timestamp); err != nil ||
+		timestamp.Time.Before(time.Now().Add(-staleLoadTimeout)) {
+		return nil
+	}
+	utilization, err := strconv.ParseUint(node.Annotations[v1.NodeCPUUtilizationAnnotation], 10, 32)
+	if err != nil {
+		return nil
+	}
+	steal, err := strconv.ParseUint(node.Annotations[v1.NodeVCPUStealAnnotation], 10, 32)
+	if err != nil {
+		return nil
+	}
+	return &cpuLoad{utilization: uint32(utilization), steal: uint32(steal)}
+}
+
+func isOverloaded(load *cpuLoad, config *v1.LoadAwareRebalancingConfiguration) bool {
+	return load.utilization > *config.CPUUtilizationThreshold || load.steal > *config.CPUStealThreshold
+}
+
+// nodeCooldown returns how long to wait before migrating another VMI off the node.
+func (c *LoadController) nodeCooldown(nodeName string, cooldown time.Duration) time.Duration {
+	var remaining time.Duration
+	for _, obj := range c.migrationIndexer.List() {
+		migration := obj.(*v1.VirtualMachineInstanceMigration)
+		if migration.Annotations[v1.LoadRebalanceMigrationAnnotation] != nodeName {
+			continue
+		}
+		if left := time.Until(migration.CreationTimestamp.Add(cooldown)); left > remaining {
+			remaining = left
+		}
+	}
+	return remaining
+}
+
+// classifyNodes returns the other nodes which are overloaded, and whether another schedulable node
+// is below the thresholds, so that migrating a VMI off the overloaded node can help.
+func (c *LoadController) classifyNodes(nodeName string, config *v1.LoadAwareRebalancingConfiguration) ([]string, bool) {
+	var overloaded []string
+	hasRelievingNode := false
+	for _, obj := range c.nodeStore.List() {
+		node := obj.(*k8sv1.Node)
+		if node.Name == nodeName {
+			continue
+		}
+		load := nodeCPULoad(node)
+		if load == nil {
+			continue
+		}
+		if isOverloaded(load, config) {
+			overloaded = append(overloaded, node.Name)
+		} else if !node.Spec.Unschedulable && node.Labels[v1.NodeSchedulable] == "true" {
+			hasRelievingNode = true
+		}
+	}
+	sort.Strings(overloaded)
+	return overloaded, hasRelievingNode
+}
+
+// selectCandidate returns the VMI of the node which relieves it the most, that is the one
+// requesting the most CPU and, among those, the cheapest to migrate.
+func (c *LoadController) selectCandidate(nodeName string, migrations []*v1.VirtualMachineInstanceMigration, cooldown time.Duration) (*Candidate, error) {
+	migrating := map[string]bool{}
+	for _, migration := range migrations {
+		migrating[controller.NamespacedKey(migration.Namespace, migration.Spec.VMIName)] = true
+	}
+	recentlyRebalanced := map[string]bool{}
+	for _, obj := range c.migrationIndexer.List() {
+		migration := obj.(*v1.VirtualMachineInstanceMigration)
+		if _, isRebalance := migration.Annotations[v1.LoadRebalanceMigrationAnnotation]; isRebalance &&
+			time.Since(migration.CreationTimestamp.Time) < cooldown {
+			recentlyRebalanced[controller.NamespacedKey(migration.Namespace, migration.Spec.VMIName)] = true
+		}
+	}
+
+	objs, err := c.vmiIndexer.ByIndex("node", nodeName)
+	if err != nil {
+		return nil, err
+	}
+	var candidates []Candidate
+	for _, obj := range objs {
+		vmi := obj.(*v1.VirtualMachineInstance)
+		key := controller.NamespacedKey(vmi.Namespace, vmi.Name)
+		if vmi.Status.Phase != v1.Running || vmi.DeletionTimestamp != nil {
+			continue
+		}
+		if migrating[key] || recentlyRebalanced[key] || migrationutils.IsMigrating(vmi) {
+			continue
+		}
+		if !migrationutils.VMIMigratableOnEviction(c.clusterConfig, vmi) ||
+			!controller.NewVirtualMachineInstanceConditionManager().HasConditionWithStatus(vmi, v1.VirtualMachineInstanceIsMigratable, k8sv1.ConditionTrue) {
+			continue
+		}
+		if controller.VMIActivePodsCount(vmi, c.podIndexer) > 1 {
+			continue
+		}
+		pod, err := controller.CurrentVMIPod(vmi, c.podIndexer)
+		if err != nil {
+			return nil, err
+		}
+		if pod == nil || !isDeschedulable(pod) {
+			continue
+		}
+		candidates = append(candidates, Candidate{VMI: vmi, Pod: pod})
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		requestsI, requestsJ := podRequests(candidates[i].Pod), podRequests(candidates[j].Pod)
+		if cmp := requestsI.Cpu().Cmp(*requestsJ.Cpu()); cmp != 0 {
+			return cmp > 0
+		}
+		if cmp := requestsI.Memory().Cmp(*requestsJ.Memory()); cmp != 0 {
+			return cmp < 0
+		}
+		return controller.NamespacedKey(candidates[i].VMI.Namespace, candidates[i].VMI.Name) <
+			controller.NamespacedKey(candidates[j].VMI.Namespace, candidates[j].VMI.Name)
+	})
+	return &candidates[0], nil
+}
+
+// isDeschedulable tells whether the descheduler would consider the pod, and is not already evicting it.
+func isDeschedulable(pod *k8sv1.Pod) bool {
+	_, preferNoEviction := pod.Annotations[descheduler.EvictPodAnnotationKeyAlphaPreferNoEviction]
+	_, evictionInProgress := pod.Annotations[descheduler.EvictionInProgressAnnotation]
+	return !preferNoEviction && !evictionInProgress
+}
+
+func (c *LoadController) migrate(nodeName string, overloadedNodes []string, vmi *v1.VirtualMachineInstance) error {
+	log.Log.Object(vmi).Infof("Migrating VirtualMachineInstance off overloaded node %s", nodeName)
+
+	c.migrationExpectations.ExpectCreations(nodeName, 1)
+	migration, err := c.clientset.VirtualMachineInstanceMigration(vmi.Namespace).Create(context.Background(),
+		NewLoadRebalanceMigration(vmi, nodeName, overloadedNodes, c.clusterConfig), metav1.CreateOptions{})
+	if err != nil {
+		c.migrationExpectations.CreationObserved(nodeName)
+		c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, FailedCreateVirtualMachineInstanceMigrationReason, "Error creating a Migration: %v", err)
+		return fmt.Errorf("failed to create rebalance migration: %v", err)
+	}
+	c.recorder.Eventf(vmi, k8sv1.EventTypeNormal, SuccessfulCreateVirtualMachineInstanceMigrationReason,
+		"Created Migration %s to relieve overloaded node %s", migration.Name, nodeName)
+	return nil
+}
+
+// NewLoadRebalanceMigration returns a migration of the VMI off the overloaded node, whose target
+// avoids the other overloaded nodes.
+func NewLoadRebalanceMigration(vmi *v1.VirtualMachineInstance, nodeName string, overloadedNodes []string, config *virtconfig.ClusterConfig) *v1.VirtualMachineInstanceMigration {
+	migration := &v1.VirtualMachineInstanceMigration{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				v1.LoadRebalanceMigrationAnnotation: nodeName,
+			},
+			GenerateName: "kubevirt-rebalance-",
+		},
+		Spec: v1.VirtualMachineInstanceMigrationSpec{
+			VMIName: vmi.Name,
+		},
+	}
+	if len(overloadedNodes) > 0 {
+		migration.Annotations[v1.LoadRebalanceExcludedNodesAnnotation] = strings.Join(overloadedNodes, ",")
+	}
+	if config.MigrationPriorityQueueEnabled() {
+		migration.Spec.Priority = pointer.P(v1.PrioritySystemMaintenance)
+	}
+	return migration
+}
@@ -0,0 +1,247 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rebalance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/descheduler"
+)
+
+var _ = Describe("Load rebalance controller", func() {
+	const (
+		hotNode  = "hot"
+		coolNode = "cool"
+	)
+
+	var (
+		virtClient        *kubecli.MockKubevirtClient
+		fakeVirtClient    *kubevirtfake.Clientset
+		recorder          *record.FakeRecorder
+		vmiInformer       cache.SharedIndexInformer
+		podInformer       cache.SharedIndexInformer
+		migrationInformer cache.SharedIndexInformer
+		nodeInformer      cache.SharedIndexInformer
+	)
+
+	newController := func(featureGates ...string) *LoadController {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+		})
+		c, err := NewLoadController(vmiInformer, podInformer, migrationInformer, nodeInformer, virtClient, recorder, config)
+		Expect(err).ToNot(HaveOccurred())
+		return c
+	}
+
+	addNode := func(name string, utilization, steal int, heartbeat time.Time) {
+		Expect(nodeInformer.GetStore().Add(&k8sv1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{v1.NodeSchedulable: "true"},
+				Annotations: map[string]string{
+					v1.VirtHandlerHeartbeat:         heartbeat.UTC().Format(time.RFC3339),
+					v1.NodeCPUUtilizationAnnotation: fmt.Sprint(utilization),
+					v1.NodeVCPUStealAnnotation:      fmt.Sprint(steal),
+				},
+			},
+		})).To(Succeed())
+	}
+
+	addRunningVMI := func(name, cpu, memory string, podAnnotations map[string]string) {
+		vmi := libvmi.New(
+			libvmi.WithName(name),
+			libvmi.WithNamespace(k8sv1.NamespaceDefault),
+			libvmi.WithEvictionStrategy(v1.EvictionStrategyLiveMigrate),
+		)
+		vmi.UID = types.UID(name + "-uid")
+		vmi.Status.Phase = v1.Running
+		vmi.Status.NodeName = hotNode
+		vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+			Type:   v1.VirtualMachineInstanceIsMigratable,
+			Status: k8sv1.ConditionTrue,
+		}}
+		Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+		Expect(podInformer.GetStore().Add(&k8sv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "virt-launcher-" + name,
+				Namespace:       vmi.Namespace,
+				Annotations:     podAnnotations,
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(vmi, v1.VirtualMachineInstanceGroupVersionKind)},
+			},
+			Spec: k8sv1.PodSpec{
+				NodeName: hotNode,
+				Containers: []k8sv1.Container{{
+					Resources: k8sv1.ResourceRequirements{Requests: k8sv1.ResourceList{
+						k8sv1.ResourceCPU:    resource.MustParse(cpu),
+						k8sv1.ResourceMemory: resource.MustParse(memory),
+					}},
+				}},
+			},
+			Status: k8sv1.PodStatus{Phase: k8sv1.PodRunning},
+		})).To(Succeed())
+	}
+
+	addRebalanceMigration := func(name, vmiName, nodeName string, phase v1.VirtualMachineInstanceMigrationPhase, created time.Time) {
+		Expect(migrationInformer.GetStore().Add(&v1.VirtualMachineInstanceMigration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         k8sv1.NamespaceDefault,
+				Annotations:       map[string]string{v1.LoadRebalanceMigrationAnnotation: nodeName},
+				CreationTimestamp: metav1.NewTime(created),
+			},
+			Spec:   v1.VirtualMachineInstanceMigrationSpec{VMIName: vmiName},
+			Status: v1.VirtualMachineInstanceMigrationStatus{Phase: phase},
+		})).To(Succeed())
+	}
+
+	listMigrations := func() []v1.VirtualMachineInstanceMigration {
+		migrations, err := fakeVirtClient.KubevirtV1().VirtualMachineInstanceMigrations(k8sv1.NamespaceDefault).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return migrations.Items
+	}
+
+	BeforeEach(func() {
+		virtClient = kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		fakeVirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineInstanceMigration(k8sv1.NamespaceDefault).
+			Return(fakeVirtClient.KubevirtV1().VirtualMachineInstanceMigrations(k8sv1.NamespaceDefault)).AnyTimes()
+		recorder = record.NewFakeRecorder(10)
+
+		vmiInformer, _ = testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachineInstance{}, controller.GetVMIInformerIndexers())
+		podInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Pod{})
+		migrationInformer, _ = testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachineInstanceMigration{}, controller.GetVirtualMachineInstanceMigrationInformerIndexers())
+		nodeInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Node{})
+
+		addNode(coolNode, 20, 0, time.Now())
+		addRunningVMI("small", "1", "1Gi", nil)
+		addRunningVMI("large", "4", "8Gi", nil)
+		addRunningVMI("large-light", "4", "2Gi", nil)
+	})
+
+	DescribeTable("should migrate the VMI relieving the node the most when", func(utilization, steal int) {
+		c := newController(featuregate.LoadAwareRebalancing)
+		addNode(hotNode, utilization, steal, time.Now())
+
+		Expect(c.execute(hotNode)).To(Succeed())
+
+		migrations := listMigrations()
+		Expect(migrations).To(HaveLen(1))
+		Expect(migrations[0].Spec.VMIName).To(Equal("large-light"))
+		Expect(migrations[0].Annotations).To(HaveKeyWithValue(v1.LoadRebalanceMigrationAnnotation, hotNode))
+		Expect(c.migrationExpectations.SatisfiedExpectations(hotNode)).To(BeFalse())
+		testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+	},
+		Entry("the CPU utilization exceeds the threshold", 95, 0),
+		Entry("the vCPU steal exceeds the threshold", 50, 30),
+	)
+
+	It("should keep the migration target off the other overloaded nodes", func() {
+		c := newController(featuregate.LoadAwareRebalancing)
+		addNode(hotNode, 95, 0, time.Now())
+		addNode("warm", 50, 40, time.Now())
+		addNode("burning", 99, 0, time.Now())
+
+		Expect(c.execute(hotNode)).To(Succeed())
+
+		migrations := listMigrations()
+		Expect(migrations).To(HaveLen(1))
+		Expect(migrations[0].Annotations).To(HaveKeyWithValue(v1.LoadRebalanceExcludedNodesAnnotation, "burning,warm"))
+	})
+
+	It("should not exclude any node when no other node is overloaded", func() {
+		c := newController(featuregate.LoadAwareRebalancing)
+		addNode(hotNode, 95, 0, time.Now())
+
+		Expect(c.execute(hotNode)).To(Succeed())
+
+		migrations := listMigrations()
+		Expect(migrations).To(HaveLen(1))
+		Expect(migrations[0].Annotations).ToNot(HaveKey(v1.LoadRebalanceExcludedNodesAnnotation))
+	})
+
+	It("should skip the VMIs in cooldown and the pods the descheduler should not evict", func() {
+		c := newController(featuregate.LoadAwareRebalancing)
+		addNode(hotNode, 95, 0, time.Now())
+		addRunningVMI("large-light", "4", "2Gi", map[string]string{descheduler.EvictPodAnnotationKeyAlphaPreferNoEviction: ""})
+		addRebalanceMigration("previous", "large", coolNode, v1.MigrationSucceeded, time.Now().Add(-time.Minute))
+
+		Expect(c.execute(hotNode)).To(Succeed())
+
+		migrations := listMigrations()
+		Expect(migrations).To(HaveLen(1))
+		Expect(migrations[0].Spec.VMIName).To(Equal("small"))
+	})
+
+	DescribeTable("should not migrate any VMI", func(setup func(), featureGates ...string) {
+		c := newController(featureGates...)
+		setup()
+
+		Expect(c.execute(hotNode)).To(Succeed())
+		Expect(listMigrations()).To(BeEmpty())
+	},
+		Entry("when the feature gate is disabled", func() {
+			addNode(hotNode, 95, 30, time.Now())
+		}),
+		Entry("when the node is below the thresholds", func() {
+			addNode(hotNode, 80, 5, time.Now())
+		}, featuregate.LoadAwareRebalancing),
+		Entry("when the load of the node is stale", func() {
+			addNode(hotNode, 95, 30, time.Now().Add(-time.Hour))
+		}, featuregate.LoadAwareRebalancing),
+		Entry("when a rebalancing migration off the node is running", func() {
+			addNode(hotNode, 95, 30, time.Now())
+			addRebalanceMigration("running", "small", hotNode, v1.MigrationRunning, time.Now().Add(-time.Hour))
+		}, featuregate.LoadAwareRebalancing),
+		Entry("when the node is in cooldown", func() {
+			addNode(hotNode, 95, 30, time.Now())
+			addRebalanceMigration("previous", "other", hotNode, v1.MigrationSucceeded, time.Now().Add(-time.Minute))
+		}, featuregate.LoadAwareRebalancing),
+		Entry("when the migration budget is exhausted", func() {
+			addNode(hotNode, 95, 30, time.Now())
+			addRebalanceMigration("first", "other", "node01", v1.MigrationRunning, time.Now())
+			addRebalanceMigration("second", "another", "node02", v1.MigrationScheduling, time.Now())
+		}, featuregate.LoadAwareRebalancing),
+		Entry("when no other node can take the load", func() {
+			addNode(hotNode, 95, 30, time.Now())
+			addNode(coolNode, 90, 0, time.Now())
+		}, featuregate.LoadAwareRebalancing),
+	)
+})
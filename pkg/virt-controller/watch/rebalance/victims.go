@@ -0,0 +1,293 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rebalance
+
+import (
+	"fmt"
+	"sort"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const metadataNameField = "metadata.name"
+
+// Candidate is a running VMI which may be live migrated to free capacity for a higher priority VMI.
+type Candidate struct {
+	VMI *v1.VirtualMachineInstance
+	Pod *k8sv1.Pod
+}
+
+// SelectVictims picks the node on which live migrating away the fewest candidates of a priority
+// lower than the one of the preemptor pod frees the resources the preemptor lacks there.
+// The candidates migrated first are the ones of the lowest priority. Like the scheduler, only the
+// nodes matching the node selector, the required node affinity and tolerating the taints of the
+// preemptor are considered, and the free capacity of a node is its allocatable resources minus the
+// requests of the pods bound to it. It returns nil when no node qualifies or when a node already
+// fits the preemptor.
+func SelectVictims(preemptor *k8sv1.Pod, nodes []*k8sv1.Node, pods []*k8sv1.Pod, candidates []Candidate) []Candidate {
+	preemptorPriority := podPriority(preemptor)
+	requests := podRequests(preemptor)
+
+	usedByNode := map[string]k8sv1.ResourceList{}
+	for _, pod := range pods {
+		if pod.Spec.NodeName == "" || pod.Status.Phase == k8sv1.PodSucceeded || pod.Status.Phase == k8sv1.PodFailed {
+			continue
+		}
+		if _, ok := usedByNode[pod.Spec.NodeName]; !ok {
+			usedByNode[pod.Spec.NodeName] = k8sv1.ResourceList{}
+		}
+		addResources(usedByNode[pod.Spec.NodeName], podRequests(pod))
+	}
+
+	missingByNode := map[string]k8sv1.ResourceList{}
+	for _, node := range nodes {
+		if !isFeasibleNode(preemptor, node) {
+			continue
+		}
+		missing, fits := missingResources(node.Status.Allocatable, usedByNode[node.Name], requests)
+		if !fits {
+			continue
+		}
+		if len(missing) == 0 {
+			// The scheduler can place the preemptor without migrating anything
+			return nil
+		}
+		missingByNode[node.Name] = missing
+	}
+
+	candidatesByNode := map[string][]Candidate{}
+	for _, candidate := range candidates {
+		nodeName := candidate.VMI.Status.NodeName
+		if _, ok := missingByNode[nodeName]; ok && podPriority(candidate.Pod) < preemptorPriority {
+			candidatesByNode[nodeName] = append(candidatesByNode[nodeName], candidate)
+		}
+	}
+
+	var selected []Candidate
+	var selectedNode string
+	for nodeName, nodeCandidates := range candidatesByNode {
+		victims := victimsOnNode(nodeCandidates, missingByNode[nodeName])
+		if victims == nil {
+			continue
+		}
+		if selected == nil || isBetterSelection(victims, nodeName, selected, selectedNode) {
+			selected = victims
+			selectedNode = nodeName
+		}
+	}
+	return selected
+}
+
+// isFeasibleNode tells whether the scheduler may place the pod on the node, leaving the resources aside.
+func isFeasibleNode(pod *k8sv1.Pod, node *k8sv1.Node) bool {
+	if node.Spec.Unschedulable {
+		return false
+	}
+	if !labels.SelectorFromSet(pod.Spec.NodeSelector).Matches(labels.Set(node.Labels)) {
+		return false
+	}
+	return matchesRequiredNodeAffinity(pod, node) && toleratesTaints(pod, node)
+}
+
+func matchesRequiredNodeAffinity(pod *k8sv1.Pod, node *k8sv1.Node) bool {
+	affinity := pod.Spec.Affinity
+	if affinity == nil || affinity.NodeAffinity == nil || affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		return true
+	}
+	for _, term := range affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+		if matchesNodeSelectorTerm(term, node) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesNodeSelectorTerm(term k8sv1.NodeSelectorTerm, node *k8sv1.Node) bool {
+	if len(term.MatchExpressions) == 0 && len(term.MatchFields) == 0 {
+		return false
+	}
+	labelSelector, err := nodeSelectorRequirementsAsSelector(term.MatchExpressions)
+	if err != nil || !labelSelector.Matches(labels.Set(node.Labels)) {
+		return false
+	}
+	fieldSelector, err := nodeSelectorRequirementsAsSelector(term.MatchFields)
+	if err != nil || !fieldSelector.Matches(labels.Set{metadataNameField: node.Name}) {
+		return false
+	}
+	return true
+}
+
+func nodeSelectorRequirementsAsSelector(requirements []k8sv1.NodeSelectorRequirement) (labels.Selector, error) {
+	selector := labels.NewSelector()
+	for _, requirement := range requirements {
+		var op selection.Operator
+		switch requirement.Operator {
+		case k8sv1.NodeSelectorOpIn:
+			op = selection.In
+		case k8sv1.NodeSelectorOpNotIn:
+			op = selection.NotIn
+		case k8sv1.NodeSelectorOpExists:
+			op = selection.Exists
+		case k8sv1.NodeSelectorOpDoesNotExist:
+			op = selection.DoesNotExist
+		case k8sv1.NodeSelectorOpGt:
+			op = selection.GreaterThan
+		case k8sv1.NodeSelectorOpLt:
+			op = selection.LessThan
+		default:
+			return nil, fmt.Errorf("%q is not a valid node selector operator", requirement.Operator)
+		}
+		r, err := labels.NewRequirement(requirement.Key, op, requirement.Values)
+		if err != nil {
+			return nil, err
+		}
+		selector = selector.Add(*r)
+	}
+	return selector, nil
+}
+
+func toleratesTaints(pod *k8sv1.Pod, node *k8sv1.Node) bool {
+	for i := range node.Spec.Taints {
+		taint := &node.Spec.Taints[i]
+		if taint.Effect != k8sv1.TaintEffectNoSchedule && taint.Effect != k8sv1.TaintEffectNoExecute {
+			continue
+		}
+		tolerated := false
+		for j := range pod.Spec.Tolerations {
+			if pod.Spec.Tolerations[j].ToleratesTaint(taint) {
+				tolerated = true
+				break
+			}
+		}
+		if !tolerated {
+			return false
+		}
+	}
+	return true
+}
+
+// missingResources returns the part of the requests which is not free on a node. It reports the
+// node does not fit when its allocatable resources are lower than the requests, e.g. for devices
+// or hugepages the node does not provide at all.
+func missingResources(allocatable, used, requests k8sv1.ResourceList) (k8sv1.ResourceList, bool) {
+	missing := k8sv1.ResourceList{}
+	for name, requested := range requests {
+		capacity := allocatable[name]
+		if capacity.Cmp(requested) < 0 {
+			return nil, false
+		}
+		free := capacity.DeepCopy()
+		free.Sub(used[name])
+		if free.Cmp(requested) < 0 {
+			lacking := requested.DeepCopy()
+			lacking.Sub(free)
+			missing[name] = lacking
+		}
+	}
+	return missing, true
+}
+
+// victimsOnNode returns the lowest priority candidates, the largest first among equal priorities,
+// whose resources cover the missing ones, or nil if all the candidates together do not cover them.
+func victimsOnNode(candidates []Candidate, missing k8sv1.ResourceList) []Candidate {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		pi, pj := podPriority(candidates[i].Pod), podPriority(candidates[j].Pod)
+		if pi != pj {
+			return pi < pj
+		}
+		mi, mj := podRequests(candidates[i].Pod)[k8sv1.ResourceMemory], podRequests(candidates[j].Pod)[k8sv1.ResourceMemory]
+		return mi.Cmp(mj) > 0
+	})
+
+	freed := k8sv1.ResourceList{}
+	for i, candidate := range candidates {
+		addResources(freed, podRequests(candidate.Pod))
+		if covers(freed, missing) {
+			return candidates[:i+1]
+		}
+	}
+	return nil
+}
+
+func isBetterSelection(victims []Candidate, nodeName string, selected []Candidate, selectedNode string) bool {
+	if len(victims) != len(selected) {
+		return len(victims) < len(selected)
+	}
+	if maxPriority(victims) != maxPriority(selected) {
+		return maxPriority(victims) < maxPriority(selected)
+	}
+	return nodeName < selectedNode
+}
+
+func maxPriority(candidates []Candidate) int32 {
+	var highest int32
+	for i, candidate := range candidates {
+		if priority := podPriority(candidate.Pod); i == 0 || priority > highest {
+			highest = priority
+		}
+	}
+	return highest
+}
+
+func podPriority(pod *k8sv1.Pod) int32 {
+	if pod.Spec.Priority == nil {
+		return 0
+	}
+	return *pod.Spec.Priority
+}
+
+func podRequests(pod *k8sv1.Pod) k8sv1.ResourceList {
+	requests := k8sv1.ResourceList{}
+	for _, container := range pod.Spec.Containers {
+		addResources(requests, container.Resources.Requests)
+	}
+	// Init containers run one at a time, before the other containers
+	for _, container := range pod.Spec.InitContainers {
+		for name, quantity := range container.Resources.Requests {
+			if current, ok := requests[name]; !ok || quantity.Cmp(current) > 0 {
+				requests[name] = quantity.DeepCopy()
+			}
+		}
+	}
+	addResources(requests, pod.Spec.Overhead)
+	return requests
+}
+
+func addResources(total, resources k8sv1.ResourceList) {
+	for name, quantity := range resources {
+		sum := total[name]
+		sum.Add(quantity)
+		total[name] = sum
+	}
+}
+
+func covers(freed, requests k8sv1.ResourceList) bool {
+	for name, requested := range requests {
+		available := freed[name]
+		if available.Cmp(requested) < 0 {
+			return false
+		}
+	}
+	return true
+}
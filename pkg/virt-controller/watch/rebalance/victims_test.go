@@ -0,0 +1,280 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rebalance_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/rebalance"
+)
+
+var _ = Describe("SelectVictims", func() {
+	newPod := func(priority int32, memory string) *k8sv1.Pod {
+		return &k8sv1.Pod{
+			Spec: k8sv1.PodSpec{
+				Priority: pointer.P(priority),
+				Containers: []k8sv1.Container{{
+					Resources: k8sv1.ResourceRequirements{Requests: k8sv1.ResourceList{
+						k8sv1.ResourceCPU:    resource.MustParse("1"),
+						k8sv1.ResourceMemory: resource.MustParse(memory),
+					}},
+				}},
+			},
+		}
+	}
+
+	newNode := func(name, memory string, nodeLabels map[string]string) *k8sv1.Node {
+		return &k8sv1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: nodeLabels},
+			Status: k8sv1.NodeStatus{Allocatable: k8sv1.ResourceList{
+				k8sv1.ResourceCPU:    resource.MustParse("8"),
+				k8sv1.ResourceMemory: resource.MustParse(memory),
+			}},
+		}
+	}
+
+	newCandidate := func(name, nodeName string, priority int32, memory string) rebalance.Candidate {
+		vmi := libvmi.New(libvmi.WithName(name))
+		vmi.Status.NodeName = nodeName
+		pod := newPod(priority, memory)
+		pod.Spec.NodeName = nodeName
+		return rebalance.Candidate{VMI: vmi, Pod: pod}
+	}
+
+	podsOf := func(candidates []rebalance.Candidate) []*k8sv1.Pod {
+		var pods []*k8sv1.Pod
+		for _, candidate := range candidates {
+			pods = append(pods, candidate.Pod)
+		}
+		return pods
+	}
+
+	selectVictims := func(preemptor *k8sv1.Pod, nodes []*k8sv1.Node, candidates []rebalance.Candidate) []rebalance.Candidate {
+		return rebalance.SelectVictims(preemptor, nodes, podsOf(candidates), candidates)
+	}
+
+	victimNames := func(victims []rebalance.Candidate) []string {
+		var names []string
+		for _, victim := range victims {
+			names = append(names, victim.VMI.Name)
+		}
+		return names
+	}
+
+	It("should pick the node requiring the fewest migrations", func() {
+		nodes := []*k8sv1.Node{newNode("node01", "2Gi", nil), newNode("node02", "4Gi", nil)}
+		candidates := []rebalance.Candidate{
+			newCandidate("small-1", "node01", 10, "1Gi"),
+			newCandidate("small-2", "node01", 10, "1Gi"),
+			newCandidate("large", "node02", 10, "4Gi"),
+		}
+
+		victims := selectVictims(newPod(100, "2Gi"), nodes, candidates)
+		Expect(victimNames(victims)).To(ConsistOf("large"))
+	})
+
+	It("should migrate the lowest priority candidates first", func() {
+		nodes := []*k8sv1.Node{newNode("node01", "4Gi", nil)}
+		candidates := []rebalance.Candidate{
+			newCandidate("medium", "node01", 50, "2Gi"),
+			newCandidate("low", "node01", 10, "2Gi"),
+		}
+
+		victims := selectVictims(newPod(100, "2Gi"), nodes, candidates)
+		Expect(victimNames(victims)).To(ConsistOf("low"))
+	})
+
+	It("should not migrate candidates of an equal or higher priority", func() {
+		nodes := []*k8sv1.Node{newNode("node01", "8Gi", nil)}
+		candidates := []rebalance.Candidate{
+			newCandidate("equal", "node01", 100, "4Gi"),
+			newCandidate("higher", "node01", 200, "4Gi"),
+		}
+
+		Expect(selectVictims(newPod(100, "2Gi"), nodes, candidates)).To(BeEmpty())
+	})
+
+	It("should not select a node on which the candidates do not free enough resources", func() {
+		nodes := []*k8sv1.Node{newNode("node01", "2Gi", nil)}
+		candidates := []rebalance.Candidate{
+			newCandidate("small", "node01", 10, "1Gi"),
+		}
+		other := newPod(1000, "1Gi")
+		other.Spec.NodeName = "node01"
+
+		victims := rebalance.SelectVictims(newPod(100, "2Gi"), nodes, append(podsOf(candidates), other), candidates)
+		Expect(victims).To(BeEmpty())
+	})
+
+	It("should only migrate what the free capacity of the node lacks", func() {
+		nodes := []*k8sv1.Node{newNode("node01", "5Gi", nil)}
+		candidates := []rebalance.Candidate{
+			newCandidate("low", "node01", 10, "2Gi"),
+			newCandidate("lowest", "node01", 5, "2Gi"),
+		}
+
+		victims := selectVictims(newPod(100, "2Gi"), nodes, candidates)
+		Expect(victimNames(victims)).To(ConsistOf("lowest"))
+	})
+
+	It("should not migrate anything when a node already fits the preemptor", func() {
+		nodes := []*k8sv1.Node{newNode("node01", "4Gi", nil), newNode("node02", "2Gi", nil)}
+		candidates := []rebalance.Candidate{
+			newCandidate("vmi", "node01", 10, "4Gi"),
+		}
+
+		Expect(selectVictims(newPod(100, "2Gi"), nodes, candidates)).To(BeEmpty())
+	})
+
+	It("should ignore the pods which terminated", func() {
+		nodes := []*k8sv1.Node{newNode("node01", "4Gi", nil)}
+		candidates := []rebalance.Candidate{
+			newCandidate("vmi", "node01", 10, "2Gi"),
+		}
+		completed := newPod(1000, "2Gi")
+		completed.Spec.NodeName = "node01"
+		completed.Status.Phase = k8sv1.PodSucceeded
+
+		victims := rebalance.SelectVictims(newPod(100, "2Gi"), nodes, append(podsOf(candidates), completed), candidates)
+		Expect(victims).To(BeEmpty())
+	})
+
+	It("should only consider the nodes matching the preemptor node selector", func() {
+		nodes := []*k8sv1.Node{
+			newNode("node01", "2Gi", map[string]string{"gpu": "true"}),
+			newNode("node02", "4Gi", nil),
+		}
+		candidates := []rebalance.Candidate{
+			newCandidate("on-gpu-node", "node01", 10, "2Gi"),
+			newCandidate("on-plain-node", "node02", 10, "4Gi"),
+		}
+		preemptor := newPod(100, "2Gi")
+		preemptor.Spec.NodeSelector = map[string]string{"gpu": "true"}
+
+		victims := selectVictims(preemptor, nodes, candidates)
+		Expect(victimNames(victims)).To(ConsistOf("on-gpu-node"))
+	})
+
+	It("should only consider the nodes matching the preemptor required node affinity", func() {
+		nodes := []*k8sv1.Node{
+			newNode("node01", "4Gi", map[string]string{"zone": "a"}),
+			newNode("node02", "2Gi", map[string]string{"zone": "b"}),
+		}
+		candidates := []rebalance.Candidate{
+			newCandidate("in-zone-a", "node01", 10, "4Gi"),
+			newCandidate("in-zone-b", "node02", 10, "2Gi"),
+		}
+		preemptor := newPod(100, "2Gi")
+		preemptor.Spec.Affinity = &k8sv1.Affinity{NodeAffinity: &k8sv1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &k8sv1.NodeSelector{
+				NodeSelectorTerms: []k8sv1.NodeSelectorTerm{{
+					MatchExpressions: []k8sv1.NodeSelectorRequirement{{
+						Key:      "zone",
+						Operator: k8sv1.NodeSelectorOpIn,
+						Values:   []string{"a"},
+					}},
+				}},
+			},
+		}}
+
+		victims := selectVictims(preemptor, nodes, candidates)
+		Expect(victimNames(victims)).To(ConsistOf("in-zone-a"))
+	})
+
+	It("should skip the nodes with taints the preemptor does not tolerate", func() {
+		tainted := newNode("node01", "2Gi", nil)
+		tainted.Spec.Taints = []k8sv1.Taint{{Key: "dedicated", Value: "infra", Effect: k8sv1.TaintEffectNoSchedule}}
+		nodes := []*k8sv1.Node{tainted, newNode("node02", "4Gi", nil)}
+		candidates := []rebalance.Candidate{
+			newCandidate("on-tainted-node", "node01", 10, "2Gi"),
+			newCandidate("on-plain-node", "node02", 10, "4Gi"),
+		}
+
+		preemptor := newPod(100, "2Gi")
+		Expect(victimNames(selectVictims(preemptor, nodes, candidates))).To(ConsistOf("on-plain-node"))
+
+		preemptor.Spec.Tolerations = []k8sv1.Toleration{{
+			Key:      "dedicated",
+			Operator: k8sv1.TolerationOpEqual,
+			Value:    "infra",
+			Effect:   k8sv1.TaintEffectNoSchedule,
+		}}
+		Expect(victimNames(selectVictims(preemptor, nodes, candidates))).To(ConsistOf("on-tainted-node"))
+	})
+
+	It("should skip the nodes not providing the devices the preemptor requests", func() {
+		const gpu k8sv1.ResourceName = "nvidia.com/gpu"
+		withGPU := newNode("node01", "4Gi", nil)
+		withGPU.Status.Allocatable[gpu] = resource.MustParse("1")
+		nodes := []*k8sv1.Node{withGPU, newNode("node02", "2Gi", nil)}
+		candidates := []rebalance.Candidate{
+			newCandidate("on-gpu-node", "node01", 10, "4Gi"),
+			newCandidate("on-plain-node", "node02", 10, "2Gi"),
+		}
+		preemptor := newPod(100, "2Gi")
+		preemptor.Spec.Containers[0].Resources.Requests[gpu] = resource.MustParse("1")
+
+		victims := selectVictims(preemptor, nodes, candidates)
+		Expect(victimNames(victims)).To(ConsistOf("on-gpu-node"))
+	})
+
+	It("should free the hugepages the preemptor requests", func() {
+		const hugepages k8sv1.ResourceName = "hugepages-2Mi"
+		node := newNode("node01", "8Gi", nil)
+		node.Status.Allocatable[hugepages] = resource.MustParse("2Gi")
+		withHugepages := newCandidate("with-hugepages", "node01", 10, "1Gi")
+		withHugepages.Pod.Spec.Containers[0].Resources.Requests[hugepages] = resource.MustParse("2Gi")
+		candidates := []rebalance.Candidate{
+			newCandidate("without-hugepages", "node01", 5, "1Gi"),
+			withHugepages,
+		}
+		preemptor := newPod(100, "1Gi")
+		preemptor.Spec.Containers[0].Resources.Requests[hugepages] = resource.MustParse("1Gi")
+
+		victims := selectVictims(preemptor, []*k8sv1.Node{node}, candidates)
+		Expect(victimNames(victims)).To(ConsistOf("without-hugepages", "with-hugepages"))
+	})
+
+	It("should skip unschedulable nodes", func() {
+		node := newNode("node01", "4Gi", nil)
+		node.Spec.Unschedulable = true
+		candidates := []rebalance.Candidate{
+			newCandidate("vmi", "node01", 10, "4Gi"),
+		}
+
+		Expect(selectVictims(newPod(100, "2Gi"), []*k8sv1.Node{node}, candidates)).To(BeEmpty())
+	})
+
+	It("should treat pods without priority as priority zero", func() {
+		nodes := []*k8sv1.Node{newNode("node01", "4Gi", nil)}
+		candidate := newCandidate("vmi", "node01", 0, "4Gi")
+		candidate.Pod.Spec.Priority = nil
+
+		victims := selectVictims(newPod(1, "2Gi"), nodes, []rebalance.Candidate{candidate})
+		Expect(victimNames(victims)).To(ConsistOf("vmi"))
+	})
+})
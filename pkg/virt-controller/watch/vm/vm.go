@@ -33,10 +33,12 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/instancetype/revision"
 	"kubevirt.io/kubevirt/pkg/libvmi"
+	hostdevicelu "kubevirt.io/kubevirt/pkg/liveupdate/hostdevice"
 	"kubevirt.io/kubevirt/pkg/liveupdate/memory"
 	"kubevirt.io/kubevirt/pkg/pointer"
 
 	netadmitter "kubevirt.io/kubevirt/pkg/network/admitter"
+	"kubevirt.io/kubevirt/pkg/network/macallocator"
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
 	netvmliveupdate "kubevirt.io/kubevirt/pkg/network/vmliveupdate"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/common"
@@ -126,6 +128,8 @@ const (
 	volumesUpdateErrorReason           = "VolumesUpdateError"
 	tolerationsChangeErrorReason       = "TolerationsChangeError"
 	annotationsLabelsChangeErrorReason = "AnnotationsLabelsChangeError"
+	hotplugHostDevicesErrorReason      = "HotPlugHostDevicesError"
+	metadataServiceChangeErrorReason   = "MetadataServiceChangeError"
 )
 
 const defaultMaxCrashLoopBackoffDelaySeconds = 300
@@ -771,6 +775,96 @@ func (c *Controller) handleTolerationsChangeRequest(vm *virtv1.VirtualMachine, v
 	return nil
 }
 
+// handleMetadataServiceChangeRequest propagates the metadata service data of the VM template to the running VMI,
+// where virt-launcher serves it to the guest. Enabling or disabling the service requires a restart.
+func (c *Controller) handleMetadataServiceChangeRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	if vmi == nil || vmi.DeletionTimestamp != nil {
+		return nil
+	}
+
+	desiredMetadataService := vm.Spec.Template.Spec.MetadataService
+	if desiredMetadataService == nil || vmi.Spec.MetadataService == nil ||
+		equality.Semantic.DeepEqual(desiredMetadataService, vmi.Spec.MetadataService) {
+		return nil
+	}
+
+	const metadataServicePath = "/spec/metadataService"
+	patchset := patch.New(
+		patch.WithTest(metadataServicePath, vmi.Spec.MetadataService),
+		patch.WithReplace(metadataServicePath, desiredMetadataService),
+	)
+	generatedPatch, err := patchset.GeneratePayload()
+	if err != nil {
+		return err
+	}
+
+	if _, err = c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, generatedPatch, metav1.PatchOptions{}); err != nil {
+		log.Log.Object(vmi).Errorf("unable to patch vmi to update the metadata service: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (c *Controller) handleHostDevicesChangeRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	if !c.clusterConfig.HotplugHostDevicesEnabled() || vmi == nil || vmi.DeletionTimestamp != nil || !vmi.IsRunning() {
+		return nil
+	}
+
+	desiredHostDevices := vm.Spec.Template.Spec.Domain.Devices.HostDevices
+	if equality.Semantic.DeepEqual(desiredHostDevices, vmi.Spec.Domain.Devices.HostDevices) {
+		return nil
+	}
+
+	if err := hostdevicelu.ValidateLiveUpdateHostDevices(vmi.Spec.Domain.Devices.HostDevices, desiredHostDevices, vmi.Status.HostDeviceStatuses, c.clusterConfig.GetPermittedHostDevices()); err != nil {
+		setRestartRequired(vm, fmt.Sprintf("host devices hotplug not supported, %s", err.Error()))
+		return nil
+	}
+
+	if migrations.IsMigrating(vmi) {
+		return fmt.Errorf("host devices should not be changed during VMI migration")
+	}
+
+	if err := c.vmiHostDevicesPatch(desiredHostDevices, vmi); err != nil {
+		log.Log.Object(vmi).Errorf("unable to patch vmi to update host devices: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func hostDeviceStatuses(vmi *virtv1.VirtualMachineInstance) []virtv1.HostDeviceStatus {
+	if vmi == nil {
+		return nil
+	}
+	return vmi.Status.HostDeviceStatuses
+}
+
+func (c *Controller) vmiHostDevicesPatch(hostDevices []virtv1.HostDevice, vmi *virtv1.VirtualMachineInstance) error {
+	const hostDevicesPath = "/spec/domain/devices/hostDevices"
+	patchset := patch.New()
+
+	if len(hostDevices) > 0 {
+		if vmi.Spec.Domain.Devices.HostDevices == nil {
+			patchset.AddOption(patch.WithAdd(hostDevicesPath, hostDevices))
+		} else {
+			patchset.AddOption(
+				patch.WithTest(hostDevicesPath, vmi.Spec.Domain.Devices.HostDevices),
+				patch.WithReplace(hostDevicesPath, hostDevices))
+		}
+	} else {
+		patchset.AddOption(patch.WithRemove(hostDevicesPath))
+	}
+
+	generatedPatch, err := patchset.GeneratePayload()
+	if err != nil {
+		return err
+	}
+
+	_, err = c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, generatedPatch, metav1.PatchOptions{})
+	return err
+}
+
 func (c *Controller) handleAffinityChangeRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
 	if vmi == nil || vmi.DeletionTimestamp != nil {
 		return nil
@@ -1318,6 +1412,12 @@ func (c *Controller) startVMI(vm *virtv1.VirtualMachine) (*virtv1.VirtualMachine
 		return vm, err
 	}
 
+	if err = allocateMACAddresses(c.clusterConfig.GetMacGenerationPolicy(), vmi); err != nil {
+		log.Log.Object(vm).Reason(err).Error("Failed to allocate the MAC addresses of the VirtualMachineInstance")
+		c.recorder.Eventf(vm, k8score.EventTypeWarning, common.FailedCreateVirtualMachineReason, "Error creating virtual machine instance: %v", err)
+		return vm, err
+	}
+
 	netValidator := netadmitter.NewValidator(k8sfield.NewPath("spec"), &vmi.Spec, c.clusterConfig)
 	var validateErrors []error
 	for _, cause := range netValidator.ValidateCreation() {
@@ -1341,6 +1441,20 @@ func (c *Controller) startVMI(vm *virtv1.VirtualMachine) (*virtv1.VirtualMachine
 	return vm, nil
 }
 
+// allocateMACAddresses sets the MAC addresses of the interfaces which do not specify one, using the allocator of the
+// cluster MAC generation policy. Doing it before creating the VMI spares external allocators from having to mutate
+// the VMI in an admission webhook.
+func allocateMACAddresses(policy *virtv1.MacGenerationPolicy, vmi *virtv1.VirtualMachineInstance) error {
+	if policy == nil {
+		return nil
+	}
+	allocator, err := macallocator.New(policy)
+	if err != nil {
+		return err
+	}
+	return macallocator.AllocateMACAddresses(allocator, vmi)
+}
+
 // Follows the template used in createVMRevision for the Data.Raw value
 type VirtualMachineRevisionData struct {
 	Spec virtv1.VirtualMachineSpec `json:"spec"`
@@ -1475,6 +1589,20 @@ func syncStartFailureStatus(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachin
 	}
 }
 
+// syncGuestOSInfo records the guest OS information and drivers reported for the VMI on the VM,
+// so that they remain available while the VM is stopped
+func syncGuestOSInfo(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
+	if vmi == nil {
+		return
+	}
+	if vmi.Status.GuestOSInfo.Name != "" {
+		vm.Status.GuestOSInfo = vmi.Status.GuestOSInfo.DeepCopy()
+	}
+	if len(vmi.Status.GuestDrivers) > 0 {
+		vm.Status.GuestDrivers = append([]virtv1.VirtualMachineInstanceGuestOSDriver{}, vmi.Status.GuestDrivers...)
+	}
+}
+
 func syncVolumeMigration(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
 	if vm.Status.VolumeUpdateState == nil || vm.Status.VolumeUpdateState.VolumeMigrationState == nil {
 		return
@@ -2288,6 +2416,7 @@ func (c *Controller) updateStatus(vm, vmOrig *virtv1.VirtualMachine, vmi *virtv1
 	}
 
 	syncStartFailureStatus(vm, vmi)
+	syncGuestOSInfo(vm, vmi)
 	// On a successful migration, the volume change condition is removed and we need to detect the removal before the synchronization of the VMI
 	// condition to the VM
 	syncVolumeMigration(vm, vmi)
@@ -2831,6 +2960,20 @@ func (c *Controller) syncRestartRequired(lastSeenVMSpec *virtv1.VirtualMachineSp
 		lastSeenVM.Spec.Template.Spec.NodeSelector = currentVM.Spec.Template.Spec.NodeSelector
 		lastSeenVM.Spec.Template.Spec.Affinity = currentVM.Spec.Template.Spec.Affinity
 		lastSeenVM.Spec.Template.Spec.Tolerations = currentVM.Spec.Template.Spec.Tolerations
+
+		if c.clusterConfig.HotplugHostDevicesEnabled() &&
+			hostdevicelu.ValidateLiveUpdateHostDevices(
+				lastSeenVM.Spec.Template.Spec.Domain.Devices.HostDevices,
+				currentVM.Spec.Template.Spec.Domain.Devices.HostDevices,
+				hostDeviceStatuses(vmi),
+				c.clusterConfig.GetPermittedHostDevices(),
+			) == nil {
+			lastSeenVM.Spec.Template.Spec.Domain.Devices.HostDevices = currentVM.Spec.Template.Spec.Domain.Devices.HostDevices
+		}
+
+		if lastSeenVM.Spec.Template.Spec.MetadataService != nil && currentVM.Spec.Template.Spec.MetadataService != nil {
+			lastSeenVM.Spec.Template.Spec.MetadataService = currentVM.Spec.Template.Spec.MetadataService
+		}
 	}
 
 	if !netvmliveupdate.IsRestartRequired(currentVM, vmi, c.clusterConfig) {
@@ -3093,6 +3236,14 @@ func (c *Controller) sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineI
 			return vm, vmi, common.NewSyncError(fmt.Errorf("error encountered while handling memory hotplug requests: %v", err), hotplugMemoryErrorReason), nil
 		}
 
+		if err := c.handleHostDevicesChangeRequest(vmCopy, vmi); err != nil {
+			return vm, vmi, common.NewSyncError(fmt.Errorf("error encountered while handling host devices hotplug requests: %v", err), hotplugHostDevicesErrorReason), nil
+		}
+
+		if err := c.handleMetadataServiceChangeRequest(vmCopy, vmi); err != nil {
+			return vm, vmi, common.NewSyncError(fmt.Errorf("error encountered while handling metadata service change request: %v", err), metadataServiceChangeErrorReason), nil
+		}
+
 		if isWaitAsReceiverRunStrategy(vm) {
 			if err := c.handleWaitAsReceiverVolumeInfo(vmCopy, vmi); err != nil {
 				return vm, vmi, common.NewSyncError(fmt.Errorf("error encountered while handling wait as receiver volume migration requests: %v", err), volumesUpdateErrorReason), nil
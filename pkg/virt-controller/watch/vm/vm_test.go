@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"time"
 
@@ -44,6 +46,7 @@ import (
 	instancetypecontroller "kubevirt.io/kubevirt/pkg/instancetype/controller/vm"
 	"kubevirt.io/kubevirt/pkg/instancetype/revision"
 	"kubevirt.io/kubevirt/pkg/libdv"
+	"kubevirt.io/kubevirt/pkg/network/macallocator"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/storage/cbt"
 	"kubevirt.io/kubevirt/pkg/testutils"
@@ -1912,6 +1915,87 @@ var _ = Describe("VirtualMachine", func() {
 			Expect(vmi.Status.VirtualMachineRevisionName).To(Equal(vmRevision.Name))
 		})
 
+		Context("with a MAC generation policy", func() {
+			setMacGenerationPolicy := func(policy *v1.MacGenerationPolicy) {
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+					Spec: v1.KubeVirtSpec{
+						Configuration: v1.KubeVirtConfiguration{
+							NetworkConfiguration: &v1.NetworkConfiguration{
+								MacGeneration: policy,
+							},
+						},
+					},
+				})
+			}
+
+			createVM := func() *v1.VirtualMachine {
+				vm, _ := watchtesting.DefaultVirtualMachine(true)
+				vm.Spec.Template.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+				vm.Spec.Template.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).To(Succeed())
+				addVirtualMachine(vm)
+				return vm
+			}
+
+			It("should create VMI with the allocated MAC addresses", func() {
+				setMacGenerationPolicy(&v1.MacGenerationPolicy{OUI: "0a:bc:de"})
+				vm := createVM()
+
+				sanityExecute(vm)
+
+				testutils.ExpectEvent(recorder, common.SuccessfulCreateVirtualMachineReason)
+				vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vmi.Spec.Domain.Devices.Interfaces).To(HaveLen(1))
+				Expect(vmi.Spec.Domain.Devices.Interfaces[0].MacAddress).To(HavePrefix("0a:bc:de:"))
+			})
+
+			It("should create VMI with the MAC addresses allocated by the external allocator", func() {
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					defer GinkgoRecover()
+					var request macallocator.AllocationRequest
+					Expect(json.NewDecoder(r.Body).Decode(&request)).To(Succeed())
+					Expect(request.VMUID).To(Equal(string(vmUID)))
+					Expect(json.NewEncoder(w).Encode(macallocator.AllocationResponse{
+						MACAddresses: map[string]string{"default": "02:00:00:00:00:0a"},
+					})).To(Succeed())
+				}))
+				DeferCleanup(server.Close)
+				setMacGenerationPolicy(&v1.MacGenerationPolicy{
+					Allocator: v1.MacAllocatorExternal,
+					External:  &v1.ExternalMacAllocator{WebhookURL: server.URL},
+				})
+				vm := createVM()
+
+				sanityExecute(vm)
+
+				testutils.ExpectEvent(recorder, common.SuccessfulCreateVirtualMachineReason)
+				vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vmi.Spec.Domain.Devices.Interfaces[0].MacAddress).To(Equal("02:00:00:00:00:0a"))
+			})
+
+			It("should not create VMI when the external allocator fails", func() {
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusServiceUnavailable)
+				}))
+				DeferCleanup(server.Close)
+				setMacGenerationPolicy(&v1.MacGenerationPolicy{
+					Allocator: v1.MacAllocatorExternal,
+					External:  &v1.ExternalMacAllocator{WebhookURL: server.URL},
+				})
+				vm := createVM()
+
+				sanityExecute(vm)
+
+				testutils.ExpectEvent(recorder, common.FailedCreateVirtualMachineReason)
+				_, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+			})
+		})
+
 		It("should delete older vmRevision and create VMI with new one", func() {
 			vm, _ := watchtesting.DefaultVirtualMachine(true)
 			vm.Generation = 1
@@ -2396,6 +2480,47 @@ var _ = Describe("VirtualMachine", func() {
 			Expect(vm.Status.Ready).To(BeTrue())
 		})
 
+		It("should record the guest OS information reported for the vmi", func() {
+			vm, vmi := watchtesting.DefaultVirtualMachine(true)
+			watchtesting.MarkAsReady(vmi)
+			vmi.Status.GuestOSInfo = v1.VirtualMachineInstanceGuestOSInfo{
+				Name:          "Microsoft Windows",
+				KernelRelease: "20348",
+			}
+			vmi.Status.GuestDrivers = []v1.VirtualMachineInstanceGuestOSDriver{
+				{Name: "Red Hat VirtIO Ethernet Adapter", Version: "100.85.104.20800", DeviceID: "1af4:1041"},
+			}
+
+			vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+			Expect(err).To(Succeed())
+			addVirtualMachine(vm)
+			controller.vmiIndexer.Add(vmi)
+
+			sanityExecute(vm)
+
+			vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+			Expect(err).To(Succeed())
+			Expect(vm.Status.GuestOSInfo).To(gstruct.PointTo(Equal(vmi.Status.GuestOSInfo)))
+			Expect(vm.Status.GuestDrivers).To(Equal(vmi.Status.GuestDrivers))
+		})
+
+		It("should keep the guest OS information when the vmi does not report any", func() {
+			vm, vmi := watchtesting.DefaultVirtualMachine(true)
+			watchtesting.MarkAsReady(vmi)
+			vm.Status.GuestOSInfo = &v1.VirtualMachineInstanceGuestOSInfo{Name: "Fedora Linux"}
+
+			vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+			Expect(err).To(Succeed())
+			addVirtualMachine(vm)
+			controller.vmiIndexer.Add(vmi)
+
+			sanityExecute(vm)
+
+			vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+			Expect(err).To(Succeed())
+			Expect(vm.Status.GuestOSInfo).To(gstruct.PointTo(HaveField("Name", "Fedora Linux")))
+		})
+
 		It("should have stable firmware UUIDs", func() {
 			vm1, _ := watchtesting.DefaultVirtualMachineWithNames(true, "testvm1", "testvmi1")
 			vmi1 := SetupVMIFromVM(vm1)
@@ -5725,6 +5850,164 @@ var _ = Describe("VirtualMachine", func() {
 				)
 			})
 
+			Context("Host devices", func() {
+				const nicResource = "example.org/nic"
+
+				nic := func(name string) v1.HostDevice {
+					return v1.HostDevice{Name: name, DeviceName: nicResource}
+				}
+
+				updateClusterConfig := func(featureGates ...string) {
+					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+						Spec: v1.KubeVirtSpec{
+							Configuration: v1.KubeVirtConfiguration{
+								VMRolloutStrategy: &liveUpdate,
+								DeveloperConfiguration: &v1.DeveloperConfiguration{
+									FeatureGates: featureGates,
+								},
+								PermittedHostDevices: &v1.PermittedHostDevices{
+									PciHostDevices: []v1.PciHostDevice{{PCIVendorSelector: "8086:1572", ResourceName: nicResource}},
+								},
+							},
+						},
+					})
+				}
+
+				runningVMIWithHostDevices := func(vmi *v1.VirtualMachineInstance, hostDevices []v1.HostDevice) *v1.VirtualMachineInstance {
+					vmi.Status.Phase = v1.Running
+					vmi.Spec.Domain.Devices.HostDevices = hostDevices
+					for _, hostDevice := range hostDevices {
+						vmi.Status.HostDeviceStatuses = append(vmi.Status.HostDeviceStatuses, v1.HostDeviceStatus{
+							Name:              hostDevice.Name,
+							Phase:             v1.HostDeviceReady,
+							HotplugHostDevice: &v1.HotplugHostDeviceStatus{},
+						})
+					}
+					vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					return vmi
+				}
+
+				DescribeTable("should be live-updated", func(existingHostDevices, updatedHostDevices []v1.HostDevice) {
+					updateClusterConfig(featuregate.HotplugHostDevices)
+
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.Devices.HostDevices = updatedHostDevices
+					vmi = runningVMIWithHostDevices(vmi, existingHostDevices)
+
+					Expect(controller.handleHostDevicesChangeRequest(vm, vmi)).To(Succeed())
+
+					Expect(kvtesting.FilterActions(&virtFakeClient.Fake, "patch", "virtualmachineinstances")).To(HaveLen(1))
+					vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(vmi.Spec.Domain.Devices.HostDevices).To(Equal(updatedHostDevices))
+				},
+					Entry("when adding a host device from an empty set", nil, []v1.HostDevice{nic("nic1")}),
+					Entry("when adding a host device", []v1.HostDevice{nic("nic1")}, []v1.HostDevice{nic("nic1"), nic("nic2")}),
+					Entry("when removing a host device", []v1.HostDevice{nic("nic1"), nic("nic2")}, []v1.HostDevice{nic("nic2")}),
+					Entry("when removing all host devices", []v1.HostDevice{nic("nic1")}, nil),
+				)
+
+				It("should not be live-updated when the feature gate is disabled", func() {
+					updateClusterConfig()
+
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.Devices.HostDevices = []v1.HostDevice{nic("nic1")}
+					vmi = runningVMIWithHostDevices(vmi, nil)
+
+					Expect(controller.handleHostDevicesChangeRequest(vm, vmi)).To(Succeed())
+					Expect(kvtesting.FilterActions(&virtFakeClient.Fake, "patch", "virtualmachineinstances")).To(BeEmpty())
+				})
+
+				It("should set a restartRequired condition when a host device cannot be hotplugged", func() {
+					updateClusterConfig(featuregate.HotplugHostDevices)
+
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.Devices.HostDevices = []v1.HostDevice{{Name: "vgpu", DeviceName: "example.org/vgpu"}}
+					vmi = runningVMIWithHostDevices(vmi, nil)
+
+					Expect(controller.handleHostDevicesChangeRequest(vm, vmi)).To(Succeed())
+					Expect(kvtesting.FilterActions(&virtFakeClient.Fake, "patch", "virtualmachineinstances")).To(BeEmpty())
+
+					cond := virtcontroller.NewVirtualMachineConditionManager().GetCondition(vm, v1.VirtualMachineRestartRequired)
+					Expect(cond).ToNot(BeNil())
+					Expect(cond.Message).To(ContainSubstring("host devices hotplug not supported, host device vgpu is not a permitted PCI host device"))
+				})
+
+				It("should set a restartRequired condition when removing a host device the VM started with", func() {
+					updateClusterConfig(featuregate.HotplugHostDevices)
+
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.Devices.HostDevices = nil
+					vmi.Status.Phase = v1.Running
+					vmi.Spec.Domain.Devices.HostDevices = []v1.HostDevice{nic("nic1")}
+					vmi.Status.HostDeviceStatuses = []v1.HostDeviceStatus{{Name: "nic1", Phase: v1.HostDeviceReady}}
+					vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(controller.handleHostDevicesChangeRequest(vm, vmi)).To(Succeed())
+					Expect(kvtesting.FilterActions(&virtFakeClient.Fake, "patch", "virtualmachineinstances")).To(BeEmpty())
+
+					cond := virtcontroller.NewVirtualMachineConditionManager().GetCondition(vm, v1.VirtualMachineRestartRequired)
+					Expect(cond).ToNot(BeNil())
+					Expect(cond.Message).To(ContainSubstring("host device nic1 was not hotplugged, only hotplugged host devices can be removed"))
+				})
+			})
+
+			Context("Metadata service", func() {
+				BeforeEach(func() {
+					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+						Spec: v1.KubeVirtSpec{
+							Configuration: v1.KubeVirtConfiguration{
+								VMRolloutStrategy: &liveUpdate,
+							},
+						},
+					})
+				})
+
+				It("should live-update the data served to the guest", func() {
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.MetadataService = &v1.MetadataService{Data: map[string]string{"role": "replica"}}
+					vmi.Spec.MetadataService = &v1.MetadataService{Data: map[string]string{"role": "primary"}}
+					vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(controller.handleMetadataServiceChangeRequest(vm, vmi)).To(Succeed())
+
+					Expect(kvtesting.FilterActions(&virtFakeClient.Fake, "patch", "virtualmachineinstances")).To(HaveLen(1))
+					vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(vmi.Spec.MetadataService).To(Equal(vm.Spec.Template.Spec.MetadataService))
+				})
+
+				It("should not enable the service on a running VMI", func() {
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.MetadataService = &v1.MetadataService{Data: map[string]string{"role": "replica"}}
+					vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(controller.handleMetadataServiceChangeRequest(vm, vmi)).To(Succeed())
+					Expect(kvtesting.FilterActions(&virtFakeClient.Fake, "patch", "virtualmachineinstances")).To(BeEmpty())
+				})
+
+				DescribeTable("should require a restart", func(lastSeen, updated *v1.MetadataService, expectRestartRequired bool) {
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.MetadataService = lastSeen
+					lastSeenVMSpec := vm.Spec.DeepCopy()
+					vm.Spec.Template.Spec.MetadataService = updated
+
+					Expect(controller.syncRestartRequired(lastSeenVMSpec, vm, vmi)).To(Equal(expectRestartRequired))
+				},
+					Entry("not when the data changes",
+						&v1.MetadataService{Data: map[string]string{"role": "primary"}},
+						&v1.MetadataService{Data: map[string]string{"role": "replica"}},
+						false,
+					),
+					Entry("when the service is enabled", nil, &v1.MetadataService{}, true),
+					Entry("when the service is disabled", &v1.MetadataService{}, nil, true),
+				)
+			})
+
 			Context("Affinity", func() {
 				It("should be live-updated", func() {
 					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
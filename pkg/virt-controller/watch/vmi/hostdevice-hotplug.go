@@ -0,0 +1,243 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmi
+
+import (
+	"fmt"
+
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/virt-controller/services"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/common"
+)
+
+func isHostDeviceAttachmentPod(pod *k8sv1.Pod) bool {
+	return pod.Labels[v1.AppLabel] == services.HotplugHostDevice
+}
+
+// volumeAttachmentPods returns the hotplug volume attachment pods of the virt-launcher pod
+func (c *Controller) volumeAttachmentPods(virtlauncherPod *k8sv1.Pod) ([]*k8sv1.Pod, error) {
+	attachmentPods, err := controller.AttachmentPods(virtlauncherPod, c.podIndexer)
+	if err != nil {
+		return nil, err
+	}
+	volumeAttachmentPods := []*k8sv1.Pod{}
+	for _, pod := range attachmentPods {
+		if !isHostDeviceAttachmentPod(pod) {
+			volumeAttachmentPods = append(volumeAttachmentPods, pod)
+		}
+	}
+	return volumeAttachmentPods, nil
+}
+
+// hostDeviceAttachmentPods returns the hotplug host device attachment pods of the virt-launcher pod by host device name
+func (c *Controller) hostDeviceAttachmentPods(virtlauncherPod *k8sv1.Pod) (map[string]*k8sv1.Pod, error) {
+	attachmentPods, err := controller.AttachmentPods(virtlauncherPod, c.podIndexer)
+	if err != nil {
+		return nil, err
+	}
+	hostDevicePods := make(map[string]*k8sv1.Pod)
+	for _, pod := range attachmentPods {
+		if isHostDeviceAttachmentPod(pod) {
+			hostDevicePods[pod.Annotations[services.HotplugHostDeviceAnnotation]] = pod
+		}
+	}
+	return hostDevicePods, nil
+}
+
+// launcherHostDeviceResources returns the number of devices of every resource the virt-launcher pod was started with
+func launcherHostDeviceResources(virtlauncherPod *k8sv1.Pod) map[string]int64 {
+	resources := make(map[string]int64)
+	for _, container := range virtlauncherPod.Spec.Containers {
+		if container.Name != "compute" {
+			continue
+		}
+		for name, quantity := range container.Resources.Limits {
+			resources[string(name)] = quantity.Value()
+		}
+	}
+	return resources
+}
+
+// updateHostDeviceStatus tracks the host devices of the VMI. The ones the virt-launcher pod was started with are
+// ready right away, while the ones added to or removed from the running VMI go through the hotplug phases.
+func (c *Controller) updateHostDeviceStatus(vmi *v1.VirtualMachineInstance, virtlauncherPod *k8sv1.Pod) error {
+	attachmentPods, err := c.hostDeviceAttachmentPods(virtlauncherPod)
+	if err != nil {
+		return err
+	}
+
+	oldStatusMap := make(map[string]v1.HostDeviceStatus)
+	for _, status := range vmi.Status.HostDeviceStatuses {
+		oldStatusMap[status.Name] = status
+	}
+
+	// Host devices without a status are covered by the devices the virt-launcher pod requested, as long as the
+	// existing boot devices did not use them all.
+	bootResources := launcherHostDeviceResources(virtlauncherPod)
+	for _, hostDevice := range vmi.Spec.Domain.Devices.HostDevices {
+		if status, exists := oldStatusMap[hostDevice.Name]; exists && status.HotplugHostDevice == nil {
+			bootResources[hostDevice.DeviceName]--
+		}
+	}
+
+	newStatus := make([]v1.HostDeviceStatus, 0)
+	for _, hostDevice := range vmi.Spec.Domain.Devices.HostDevices {
+		status, exists := oldStatusMap[hostDevice.Name]
+		delete(oldStatusMap, hostDevice.Name)
+		switch {
+		case !exists && bootResources[hostDevice.DeviceName] > 0:
+			bootResources[hostDevice.DeviceName]--
+			status = v1.HostDeviceStatus{
+				Name:  hostDevice.Name,
+				Phase: v1.HostDeviceReady,
+			}
+		case !exists:
+			if !c.clusterConfig.HotplugHostDevicesEnabled() || hostDevice.DeviceName == "" {
+				continue
+			}
+			status = v1.HostDeviceStatus{
+				Name:              hostDevice.Name,
+				Phase:             v1.HostDevicePending,
+				Reason:            controller.HostDevicePendingReason,
+				Message:           fmt.Sprintf("Waiting for host device %s to be allocated", hostDevice.Name),
+				HotplugHostDevice: &v1.HotplugHostDeviceStatus{},
+			}
+		}
+		if status.HotplugHostDevice != nil {
+			c.processHotplugHostDeviceStatus(vmi, &status, attachmentPods[hostDevice.Name])
+		}
+		newStatus = append(newStatus, status)
+	}
+
+	// The remaining statuses belong to host devices removed from the spec
+	for _, oldStatus := range vmi.Status.HostDeviceStatuses {
+		status, removed := oldStatusMap[oldStatus.Name]
+		if !removed || status.HotplugHostDevice == nil {
+			continue
+		}
+		switch status.Phase {
+		case v1.HostDeviceDetached:
+			if _, exists := attachmentPods[status.Name]; !exists {
+				continue
+			}
+		case v1.HostDevicePending, v1.HostDeviceAllocated, v1.HostDeviceFailed:
+			// virt-launcher never got the device, there is nothing to detach from the domain
+			status.Phase = v1.HostDeviceDetached
+			status.Reason = controller.HostDeviceDetachingReason
+			status.Message = fmt.Sprintf("Host device %s has been removed before being attached", status.Name)
+		case v1.HostDeviceMountedToPod, v1.HostDeviceReady:
+			status.Phase = v1.HostDeviceDetaching
+			status.Reason = controller.HostDeviceDetachingReason
+			status.Message = fmt.Sprintf("Detaching host device %s", status.Name)
+			c.recorder.Event(vmi, k8sv1.EventTypeNormal, status.Reason, status.Message)
+		}
+		newStatus = append(newStatus, status)
+	}
+
+	if len(newStatus) == 0 {
+		newStatus = nil
+	}
+	vmi.Status.HostDeviceStatuses = newStatus
+	return nil
+}
+
+func (c *Controller) processHotplugHostDeviceStatus(vmi *v1.VirtualMachineInstance, status *v1.HostDeviceStatus, attachmentPod *k8sv1.Pod) {
+	if attachmentPod == nil {
+		if status.Phase == v1.HostDevicePending {
+			status.HotplugHostDevice.AttachPodName = ""
+			status.HotplugHostDevice.AttachPodUID = ""
+		}
+		return
+	}
+
+	status.HotplugHostDevice.AttachPodName = attachmentPod.Name
+	if attachmentPod.Status.Phase != k8sv1.PodRunning {
+		return
+	}
+	status.HotplugHostDevice.AttachPodUID = attachmentPod.UID
+	if status.Phase == v1.HostDevicePending {
+		status.Phase = v1.HostDeviceAllocated
+		status.Reason = controller.HostDeviceAllocatedReason
+		status.Message = fmt.Sprintf("Host device %s has been allocated to attachment pod %s", status.Name, attachmentPod.Name)
+		c.recorder.Event(vmi, k8sv1.EventTypeNormal, status.Reason, status.Message)
+	}
+}
+
+// handleHotplugHostDevices creates an attachment pod for every pending hotplugged host device, so that the device
+// plugin allocates a device for it on the node of the VMI, and deletes the attachment pods of detached devices.
+func (c *Controller) handleHotplugHostDevices(vmi *v1.VirtualMachineInstance, virtlauncherPod *k8sv1.Pod) common.SyncError {
+	attachmentPods, err := c.hostDeviceAttachmentPods(virtlauncherPod)
+	if err != nil {
+		return common.NewSyncError(fmt.Errorf("failed to get host device attachment pods: %v", err), controller.FailedHotplugSyncReason)
+	}
+
+	statuses := make(map[string]v1.HostDeviceStatus)
+	for _, status := range vmi.Status.HostDeviceStatuses {
+		statuses[status.Name] = status
+	}
+
+	for i := range vmi.Spec.Domain.Devices.HostDevices {
+		hostDevice := &vmi.Spec.Domain.Devices.HostDevices[i]
+		status, exists := statuses[hostDevice.Name]
+		if !exists || status.HotplugHostDevice == nil || status.Phase != v1.HostDevicePending {
+			continue
+		}
+		if _, exists := attachmentPods[hostDevice.Name]; exists {
+			continue
+		}
+		if err := c.createHostDeviceAttachmentPod(vmi, virtlauncherPod, hostDevice); err != nil {
+			return err
+		}
+	}
+
+	for name, attachmentPod := range attachmentPods {
+		status, exists := statuses[name]
+		if exists && status.Phase != v1.HostDeviceDetached {
+			continue
+		}
+		if err := c.deleteAttachmentPod(vmi, attachmentPod); err != nil && !k8serrors.IsNotFound(err) {
+			return common.NewSyncError(fmt.Errorf("failed to delete attachment pod %s: %v", attachmentPod.Name, err), controller.FailedDeletePodReason)
+		}
+	}
+	return nil
+}
+
+func (c *Controller) createHostDeviceAttachmentPod(vmi *v1.VirtualMachineInstance, virtlauncherPod *k8sv1.Pod, hostDevice *v1.HostDevice) common.SyncError {
+	attachmentPodTemplate, err := c.templateService.RenderHotplugHostDeviceAttachmentPodTemplate(hostDevice, virtlauncherPod, vmi)
+	if err != nil {
+		return common.NewSyncError(fmt.Errorf("failed to render host device attachment pod: %v", err), controller.FailedCreatePodReason)
+	}
+
+	vmiKey := controller.VirtualMachineInstanceKey(vmi)
+	pod, err := c.createPod(vmiKey, vmi.Namespace, attachmentPodTemplate)
+	if err != nil {
+		c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, controller.FailedCreatePodReason, "Error creating attachment pod for host device %s: %v", hostDevice.Name, err)
+		return common.NewSyncError(fmt.Errorf("failed to create attachment pod for host device %s: %v", hostDevice.Name, err), controller.FailedCreatePodReason)
+	}
+	log.Log.Object(vmi).V(3).Infof("Created attachment pod %s for host device %s", pod.Name, hostDevice.Name)
+	c.recorder.Eventf(vmi, k8sv1.EventTypeNormal, controller.SuccessfulCreatePodReason, "Created attachment pod %s for host device %s", pod.Name, hostDevice.Name)
+	return nil
+}
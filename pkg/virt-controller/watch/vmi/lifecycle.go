@@ -228,7 +228,7 @@ func (c *Controller) sync(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod, da
 		pod = patchedPod
 
 		hotplugVolumes := storagetypes.GetHotplugVolumes(vmi, pod)
-		hotplugAttachmentPods, err := controller.AttachmentPods(pod, c.podIndexer)
+		hotplugAttachmentPods, err := c.volumeAttachmentPods(pod)
 		if err != nil {
 			return common.NewSyncError(fmt.Errorf("failed to get attachment pods: %v", err), controller.FailedHotplugSyncReason), pod
 		}
@@ -247,6 +247,12 @@ func (c *Controller) sync(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod, da
 				}
 			}
 		}
+
+		if pod.DeletionTimestamp == nil && vmi.IsRunning() {
+			if syncErr := c.handleHotplugHostDevices(vmi, pod); syncErr != nil {
+				return syncErr, pod
+			}
+		}
 	}
 	return nil, pod
 }
@@ -395,6 +401,8 @@ func (c *Controller) updateStatus(vmi *virtv1.VirtualMachineInstance, pod *k8sv1
 				syncErr = imageErr
 			}
 
+			setPodBootPhaseTimestamps(vmiCopy, pod)
+
 			if controller.IsPodReady(pod) && vmi.DeletionTimestamp == nil {
 				// fail vmi creation if CPU pinning has been requested but the Pod QOS is not Guaranteed
 				podQosClass := pod.Status.QOSClass
@@ -487,6 +495,11 @@ func (c *Controller) updateStatus(vmi *virtv1.VirtualMachineInstance, pod *k8sv1
 			return err
 		}
 
+		// Host devices
+		if err := c.updateHostDeviceStatus(vmiCopy, pod); err != nil {
+			return err
+		}
+
 		// Network
 		if err := c.updateNetworkStatus(vmiCopy, pod); err != nil {
 			log.Log.Errorf("failed to update the interface status: %v", err)
@@ -634,6 +647,17 @@ func prepareVMIPatch(oldVMI, newVMI *virtv1.VirtualMachineInstance) *patch.Patch
 		}
 		log.Log.V(3).Object(oldVMI).Infof("Patching Volume Status")
 	}
+	if !equality.Semantic.DeepEqual(newVMI.Status.HostDeviceStatuses, oldVMI.Status.HostDeviceStatuses) {
+		if oldVMI.Status.HostDeviceStatuses == nil {
+			patchSet.AddOption(patch.WithAdd("/status/hostDeviceStatuses", newVMI.Status.HostDeviceStatuses))
+		} else {
+			patchSet.AddOption(
+				patch.WithTest("/status/hostDeviceStatuses", oldVMI.Status.HostDeviceStatuses),
+				patch.WithReplace("/status/hostDeviceStatuses", newVMI.Status.HostDeviceStatuses),
+			)
+		}
+		log.Log.V(3).Object(oldVMI).Infof("Patching host device statuses")
+	}
 	// We don't own the object anymore, so patch instead of update
 	vmiConditions := controller.NewVirtualMachineInstanceConditionManager()
 	if !vmiConditions.ConditionsEqual(oldVMI, newVMI) {
@@ -932,6 +956,26 @@ func (c *Controller) syncPausedConditionToPod(vmi *virtv1.VirtualMachineInstance
 
 // checkForContainerImageError checks if an error has occured while handling the image of any of the pod's containers
 // (including init containers), and returns a syncErr with the details of the error, or nil otherwise.
+// podBootPhases maps the virt-launcher pod conditions to the boot phases they mark
+var podBootPhases = []struct {
+	condition k8sv1.PodConditionType
+	phase     virtv1.VirtualMachineInstanceBootPhase
+}{
+	{k8sv1.PodScheduled, virtv1.BootPhasePodScheduled},
+	{k8sv1.PodReadyToStartContainers, virtv1.BootPhaseNetworkReady},
+	{k8sv1.PodReady, virtv1.BootPhaseLauncherReady},
+}
+
+func setPodBootPhaseTimestamps(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod) {
+	for _, podBootPhase := range podBootPhases {
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == podBootPhase.condition && condition.Status == k8sv1.ConditionTrue {
+				controller.SetVMIBootPhaseTimestamp(&vmi.Status, podBootPhase.phase, condition.LastTransitionTime)
+			}
+		}
+	}
+}
+
 func checkForContainerImageError(pod *k8sv1.Pod) common.SyncError {
 	containerStatuses := append(append([]k8sv1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
 	for _, containerStatus := range containerStatuses {
@@ -253,7 +253,7 @@ func (c *Controller) updateVolumeStatus(vmi *virtv1.VirtualMachineInstance, virt
 		hotplugVolumesMap[volume.Name] = volume
 	}
 
-	attachmentPods, err := controller.AttachmentPods(virtlauncherPod, c.podIndexer)
+	attachmentPods, err := c.volumeAttachmentPods(virtlauncherPod)
 	if err != nil {
 		return err
 	}
@@ -67,6 +67,7 @@ import (
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/common"
 	watchtesting "kubevirt.io/kubevirt/pkg/virt-controller/watch/testing"
@@ -1459,6 +1460,31 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 				}, {Name: "istio-proxy", State: k8sv1.ContainerState{Running: &k8sv1.ContainerStateRunning{}}, Ready: false}},
 			),
 		)
+		It("should record the boot phases reached by the pod when handing it over to virt-handler", func() {
+			vmi := newPendingVirtualMachine("testvmi")
+			vmi.Status.Phase = virtv1.Scheduling
+			pod := newPodForVirtualMachine(vmi, k8sv1.PodRunning)
+			scheduled := metav1.NewTime(time.Now().Add(-time.Minute).Truncate(time.Second))
+			sandboxReady := metav1.NewTime(scheduled.Add(10 * time.Second))
+			pod.Status.Conditions = []k8sv1.PodCondition{
+				{Type: k8sv1.PodScheduled, Status: k8sv1.ConditionTrue, LastTransitionTime: scheduled},
+				{Type: k8sv1.PodReadyToStartContainers, Status: k8sv1.ConditionTrue, LastTransitionTime: sandboxReady},
+				{Type: k8sv1.PodReady, Status: k8sv1.ConditionFalse},
+			}
+
+			addVirtualMachine(vmi)
+			addPod(pod)
+
+			sanityExecute()
+
+			updatedVMI, err := virtClientset.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVMI.Status.BootPhaseTimestamps).To(Equal([]virtv1.VirtualMachineInstanceBootPhaseTimestamp{
+				{Phase: virtv1.BootPhasePodScheduled, Timestamp: scheduled},
+				{Phase: virtv1.BootPhaseNetworkReady, Timestamp: sandboxReady},
+			}))
+		})
+
 		DescribeTable("should not hand over pod to virt-handler if pod is ready and running", func(containerStatus []k8sv1.ContainerStatus) {
 			vmi := newPendingVirtualMachine("testvmi")
 			setReadyCondition(vmi, k8sv1.ConditionFalse, virtv1.GuestNotRunningReason)
@@ -4181,6 +4207,168 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 		)
 	})
 
+	Context("hotplug host device", func() {
+		const (
+			bootDeviceName    = "boot-gpu"
+			hotplugDeviceName = "hotplug-nic"
+			gpuResource       = "vendor.com/gpu"
+			nicResource       = "vendor.com/nic"
+		)
+
+		var (
+			vmi             *virtv1.VirtualMachineInstance
+			virtlauncherPod *k8sv1.Pod
+		)
+
+		enableHotplugHostDevices := func() {
+			kvCR := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+			kvCR.Spec.Configuration.DeveloperConfiguration.FeatureGates = []string{featuregate.HotplugHostDevices}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvCR)
+		}
+
+		newHostDeviceAttachmentPod := func(name, uid, hostDeviceName string, phase k8sv1.PodPhase) *k8sv1.Pod {
+			pod := newPodForVirtlauncher(virtlauncherPod, name, uid, phase)
+			pod.Labels = map[string]string{virtv1.AppLabel: services.HotplugHostDevice}
+			pod.Annotations = map[string]string{services.HotplugHostDeviceAnnotation: hostDeviceName}
+			return pod
+		}
+
+		hotplugStatus := func(name string, phase virtv1.HostDevicePhase) virtv1.HostDeviceStatus {
+			return virtv1.HostDeviceStatus{
+				Name:              name,
+				Phase:             phase,
+				HotplugHostDevice: &virtv1.HotplugHostDeviceStatus{},
+			}
+		}
+
+		BeforeEach(func() {
+			vmi = newPendingVirtualMachine("testvmi")
+			vmi.Status.Phase = virtv1.Running
+			vmi.Spec.Domain.Devices.HostDevices = []virtv1.HostDevice{
+				{Name: bootDeviceName, DeviceName: gpuResource},
+				{Name: hotplugDeviceName, DeviceName: nicResource},
+			}
+			virtlauncherPod = newPodForVirtualMachine(vmi, k8sv1.PodRunning)
+			virtlauncherPod.Spec.Containers = []k8sv1.Container{{
+				Name: "compute",
+				Resources: k8sv1.ResourceRequirements{
+					Limits: k8sv1.ResourceList{gpuResource: resource.MustParse("1")},
+				},
+			}}
+		})
+
+		It("should report the host devices the virt-launcher pod was started with as ready", func() {
+			vmi.Spec.Domain.Devices.HostDevices = vmi.Spec.Domain.Devices.HostDevices[:1]
+			Expect(controller.updateHostDeviceStatus(vmi, virtlauncherPod)).To(Succeed())
+			Expect(vmi.Status.HostDeviceStatuses).To(Equal([]virtv1.HostDeviceStatus{
+				{Name: bootDeviceName, Phase: virtv1.HostDeviceReady},
+			}))
+		})
+
+		It("should not track hotplugged host devices given the feature gate is disabled", func() {
+			Expect(controller.updateHostDeviceStatus(vmi, virtlauncherPod)).To(Succeed())
+			Expect(vmi.Status.HostDeviceStatuses).To(HaveLen(1))
+			Expect(vmi.Status.HostDeviceStatuses[0].Name).To(Equal(bootDeviceName))
+		})
+
+		It("should mark new host devices as pending given the feature gate is enabled", func() {
+			enableHotplugHostDevices()
+			vmi.Status.HostDeviceStatuses = []virtv1.HostDeviceStatus{{Name: bootDeviceName, Phase: virtv1.HostDeviceReady}}
+
+			Expect(controller.updateHostDeviceStatus(vmi, virtlauncherPod)).To(Succeed())
+			Expect(vmi.Status.HostDeviceStatuses).To(HaveLen(2))
+			Expect(vmi.Status.HostDeviceStatuses[1].Name).To(Equal(hotplugDeviceName))
+			Expect(vmi.Status.HostDeviceStatuses[1].Phase).To(Equal(virtv1.HostDevicePending))
+			Expect(vmi.Status.HostDeviceStatuses[1].HotplugHostDevice).ToNot(BeNil())
+		})
+
+		It("should mark host devices as allocated once their attachment pod is running", func() {
+			addPod(virtlauncherPod)
+			addPod(newHostDeviceAttachmentPod("hp-hostdevice-abcd", "abcd", hotplugDeviceName, k8sv1.PodRunning))
+			vmi.Status.HostDeviceStatuses = []virtv1.HostDeviceStatus{
+				{Name: bootDeviceName, Phase: virtv1.HostDeviceReady},
+				hotplugStatus(hotplugDeviceName, virtv1.HostDevicePending),
+			}
+
+			Expect(controller.updateHostDeviceStatus(vmi, virtlauncherPod)).To(Succeed())
+			status := vmi.Status.HostDeviceStatuses[1]
+			Expect(status.Phase).To(Equal(virtv1.HostDeviceAllocated))
+			Expect(status.HotplugHostDevice.AttachPodName).To(Equal("hp-hostdevice-abcd"))
+			Expect(status.HotplugHostDevice.AttachPodUID).To(Equal(types.UID("abcd")))
+			testutils.ExpectEvent(recorder, kvcontroller.HostDeviceAllocatedReason)
+		})
+
+		DescribeTable("should detach host devices removed from the spec", func(phase, expectedPhase virtv1.HostDevicePhase, expectedEvents ...string) {
+			vmi.Spec.Domain.Devices.HostDevices = vmi.Spec.Domain.Devices.HostDevices[:1]
+			vmi.Status.HostDeviceStatuses = []virtv1.HostDeviceStatus{
+				{Name: bootDeviceName, Phase: virtv1.HostDeviceReady},
+				hotplugStatus(hotplugDeviceName, phase),
+			}
+
+			Expect(controller.updateHostDeviceStatus(vmi, virtlauncherPod)).To(Succeed())
+			Expect(vmi.Status.HostDeviceStatuses).To(HaveLen(2))
+			Expect(vmi.Status.HostDeviceStatuses[1].Phase).To(Equal(expectedPhase))
+			testutils.ExpectEvents(recorder, expectedEvents...)
+		},
+			Entry("which are attached to the domain", virtv1.HostDeviceReady, virtv1.HostDeviceDetaching, kvcontroller.HostDeviceDetachingReason),
+			Entry("which were not allocated yet", virtv1.HostDevicePending, virtv1.HostDeviceDetached),
+		)
+
+		It("should drop the status of detached host devices once their attachment pod is gone", func() {
+			vmi.Spec.Domain.Devices.HostDevices = vmi.Spec.Domain.Devices.HostDevices[:1]
+			vmi.Status.HostDeviceStatuses = []virtv1.HostDeviceStatus{
+				{Name: bootDeviceName, Phase: virtv1.HostDeviceReady},
+				hotplugStatus(hotplugDeviceName, virtv1.HostDeviceDetached),
+			}
+
+			Expect(controller.updateHostDeviceStatus(vmi, virtlauncherPod)).To(Succeed())
+			Expect(vmi.Status.HostDeviceStatuses).To(Equal([]virtv1.HostDeviceStatus{
+				{Name: bootDeviceName, Phase: virtv1.HostDeviceReady},
+			}))
+		})
+
+		It("should create an attachment pod for pending host devices", func() {
+			addPod(virtlauncherPod)
+			vmi.Status.SelinuxContext = "system_u:system_r:container_file_t:s0:c1,c2"
+			vmi.Status.HostDeviceStatuses = []virtv1.HostDeviceStatus{
+				{Name: bootDeviceName, Phase: virtv1.HostDeviceReady},
+				hotplugStatus(hotplugDeviceName, virtv1.HostDevicePending),
+			}
+
+			Expect(controller.handleHotplugHostDevices(vmi, virtlauncherPod)).To(BeNil())
+			testutils.ExpectEvent(recorder, kvcontroller.SuccessfulCreatePodReason)
+			pods, err := kubeClient.CoreV1().Pods(vmi.Namespace).List(context.Background(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pods.Items).To(ContainElement(
+				HaveField("Annotations", HaveKeyWithValue(services.HotplugHostDeviceAnnotation, hotplugDeviceName)),
+			))
+		})
+
+		It("should delete the attachment pod of detached host devices", func() {
+			addPod(virtlauncherPod)
+			attachmentPod := newHostDeviceAttachmentPod("hp-hostdevice-abcd", "abcd", hotplugDeviceName, k8sv1.PodRunning)
+			addPod(attachmentPod)
+			vmi.Spec.Domain.Devices.HostDevices = vmi.Spec.Domain.Devices.HostDevices[:1]
+			vmi.Status.HostDeviceStatuses = []virtv1.HostDeviceStatus{
+				{Name: bootDeviceName, Phase: virtv1.HostDeviceReady},
+				hotplugStatus(hotplugDeviceName, virtv1.HostDeviceDetached),
+			}
+
+			Expect(controller.handleHotplugHostDevices(vmi, virtlauncherPod)).To(BeNil())
+			testutils.ExpectEvent(recorder, kvcontroller.SuccessfulDeletePodReason)
+			expectPodDoesNotExist(attachmentPod.Namespace, attachmentPod.Name)
+		})
+
+		It("should not treat host device attachment pods as volume attachment pods", func() {
+			addPod(virtlauncherPod)
+			volumeAttachmentPod := newPodForVirtlauncher(virtlauncherPod, "hp-volume-abcd", "abcd", k8sv1.PodRunning)
+			addPod(volumeAttachmentPod)
+			addPod(newHostDeviceAttachmentPod("hp-hostdevice-efgh", "efgh", hotplugDeviceName, k8sv1.PodRunning))
+
+			Expect(controller.volumeAttachmentPods(virtlauncherPod)).To(ConsistOf(volumeAttachmentPod))
+		})
+	})
+
 	Context("topology hints", func() {
 
 		getVmiWithInvTsc := func() *virtv1.VirtualMachineInstance {
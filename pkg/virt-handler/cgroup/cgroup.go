@@ -73,6 +73,10 @@ type Manager interface {
 
 	// Get list of threads attached to cgroup
 	GetCgroupThreads() ([]int, error)
+
+	// SetMemorySwap sets the maximum amount of swap in bytes, a negative value removes the limit.
+	// Without zswap writeback swapped out memory is only kept compressed in memory by zswap.
+	SetMemorySwap(limit int64, zswapWriteback bool) error
 }
 
 // This is here so that mockgen would create a mock out of it. That way we would have a mocked runc manager.
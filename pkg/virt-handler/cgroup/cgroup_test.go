@@ -197,6 +197,63 @@ var _ = Describe("cgroup manager", func() {
 			},
 		),
 	)
+
+	Context("memory swap", func() {
+		var scopeDir, containerDir string
+
+		readFile := func(dir, file string) string {
+			content, err := os.ReadFile(path.Join(dir, file))
+			Expect(err).ToNot(HaveOccurred())
+			return string(content)
+		}
+
+		BeforeEach(func() {
+			// emulate the cgroup filesystem with regular files
+			runc_cgroups.TestMode = true
+			DeferCleanup(func() { runc_cgroups.TestMode = false })
+
+			scopeDir = path.Join(GinkgoT().TempDir(), "crio-456.scope")
+			containerDir = path.Join(scopeDir, "container")
+			Expect(os.MkdirAll(containerDir, 0755)).To(Succeed())
+			for _, dir := range []string{scopeDir, containerDir} {
+				for _, file := range []string{"memory.swap.max", "memory.zswap.writeback"} {
+					Expect(os.WriteFile(path.Join(dir, file), nil, 0644)).To(Succeed())
+				}
+			}
+		})
+
+		DescribeTable("should set the swap limit", func(limit int64, expected string) {
+			v2DirPath = scopeDir
+			manager, err := newMockManager(V2)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(manager.SetMemorySwap(limit, true)).To(Succeed())
+			Expect(readFile(scopeDir, "memory.swap.max")).To(Equal(expected))
+			Expect(readFile(scopeDir, "memory.zswap.writeback")).To(BeEmpty())
+		},
+			Entry("to a number of bytes", int64(1073741824), "1073741824"),
+			Entry("to no limit", int64(-1), "max"),
+		)
+
+		It("should set the parent cgroup and disable zswap writeback with crun", func() {
+			v2DirPath = containerDir
+			manager, err := newMockManager(V2)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(manager.SetMemorySwap(-1, false)).To(Succeed())
+			for _, dir := range []string{scopeDir, containerDir} {
+				Expect(readFile(dir, "memory.swap.max")).To(Equal("max"))
+				Expect(readFile(dir, "memory.zswap.writeback")).To(Equal("0"))
+			}
+		})
+
+		It("should not be supported with cgroup v1", func() {
+			manager, err := newMockManager(V1)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(manager.SetMemorySwap(-1, true)).To(HaveOccurred())
+		})
+	})
 })
 
 var _ = Describe("GetMiscCapacity", func() {
@@ -224,3 +224,7 @@ func (v *v1Manager) GetCgroupThreads() ([]int, error) {
 func (v *v1Manager) SetCpuSet(subcgroup string, cpulist []int) error {
 	return setCpuSetHelper(v, subcgroup, cpulist)
 }
+
+func (v *v1Manager) SetMemorySwap(_ int64, _ bool) error {
+	return fmt.Errorf("setting the memory swap is only supported with cgroup v2")
+}
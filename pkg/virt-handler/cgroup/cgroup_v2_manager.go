@@ -181,3 +181,31 @@ func (v *v2Manager) GetCgroupThreads() ([]int, error) {
 func (v *v2Manager) SetCpuSet(subcgroup string, cpulist []int) error {
 	return setCpuSetHelper(v, subcgroup, cpulist)
 }
+
+func (v *v2Manager) SetMemorySwap(limit int64, zswapWriteback bool) error {
+	swapMax := "max"
+	if limit >= 0 {
+		swapMax = strconv.FormatInt(limit, 10)
+	}
+
+	// With crun the parent cgroup is limited as well, it has to be
+	// updated first so that it does not cap the container cgroup
+	dirPaths := []string{v.dirPath}
+	if targetDir, parentPath := filepath.Base(v.dirPath), path.Dir(v.dirPath); targetDir == "container" && strings.HasSuffix(parentPath, ".scope") {
+		dirPaths = []string{parentPath, v.dirPath}
+	}
+
+	for _, dirPath := range dirPaths {
+		if err := runc_cgroups.WriteFile(dirPath, "memory.swap.max", swapMax); err != nil {
+			return err
+		}
+		// memory.zswap.writeback is only available on recent kernels, it is
+		// left untouched unless writeback has to be disabled
+		if !zswapWriteback {
+			if err := runc_cgroups.WriteFile(dirPath, "memory.zswap.writeback", "0"); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -156,6 +156,20 @@ func (mr *MockManagerMockRecorder) SetCpuSet(subcgroup, cpulist any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCpuSet", reflect.TypeOf((*MockManager)(nil).SetCpuSet), subcgroup, cpulist)
 }
 
+// SetMemorySwap mocks base method.
+func (m *MockManager) SetMemorySwap(limit int64, zswapWriteback bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMemorySwap", limit, zswapWriteback)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMemorySwap indicates an expected call of SetMemorySwap.
+func (mr *MockManagerMockRecorder) SetMemorySwap(limit, zswapWriteback any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMemorySwap", reflect.TypeOf((*MockManager)(nil).SetMemorySwap), limit, zswapWriteback)
+}
+
 // MockruncManager is a mock of runcManager interface.
 type MockruncManager struct {
 	ctrl     *gomock.Controller
@@ -114,6 +114,9 @@ type LauncherClient interface {
 	GetScreenshot(*v1.VirtualMachineInstance) (*cmdv1.ScreenshotResponse, error)
 	VirtualMachineBackup(vmi *v1.VirtualMachineInstance, options *backupv1.BackupOptions) error
 	RedefineCheckpoint(vmi *v1.VirtualMachineInstance, checkpoint *backupv1.BackupCheckpoint) (checkpointInvalid bool, err error)
+	GetDomainJobs(vmi *v1.VirtualMachineInstance) (v1.VirtualMachineInstanceDomainJobList, error)
+	CancelDomainJob(vmi *v1.VirtualMachineInstance, options *v1.CancelDomainJobOptions) error
+	GetQemuCommandLine(vmi *v1.VirtualMachineInstance) (v1.VirtualMachineInstanceQemuCommandLine, error)
 }
 
 type VirtLauncherClient struct {
@@ -176,6 +179,11 @@ func SocketDirectoryOnHost(podUID string) string {
 	return filepath.Clean(fmt.Sprintf("/%s/%s/volumes/kubernetes.io~empty-dir/sockets", podsBaseDir, podUID))
 }
 
+// PrivateDirectoryOnHost returns the host path of the private emptyDir of the virt-launcher pod
+func PrivateDirectoryOnHost(podUID string) string {
+	return filepath.Clean(fmt.Sprintf("/%s/%s/volumes/kubernetes.io~empty-dir/private", podsBaseDir, podUID))
+}
+
 func SocketFilePathOnHost(podUID string) string {
 	return filepath.Clean(fmt.Sprintf("%s/%s", SocketDirectoryOnHost(podUID), StandardLauncherSocketFileName))
 }
@@ -836,3 +844,91 @@ func (c *VirtLauncherClient) RedefineCheckpoint(vmi *v1.VirtualMachineInstance,
 
 	return false, nil
 }
+
+// GetDomainJobs returns the libvirt jobs in progress on the domain
+func (c *VirtLauncherClient) GetDomainJobs(vmi *v1.VirtualMachineInstance) (v1.VirtualMachineInstanceDomainJobList, error) {
+	var jobs []v1.VirtualMachineInstanceDomainJob
+
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return v1.VirtualMachineInstanceDomainJobList{}, err
+	}
+
+	request := &cmdv1.VMIRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shortTimeout)
+	defer cancel()
+
+	jobsResponse, err := c.v1client.GetDomainJobs(ctx, request)
+	if err = handleError(err, "GetDomainJobs", jobsResponse.GetResponse()); err != nil || jobsResponse == nil {
+		return v1.VirtualMachineInstanceDomainJobList{}, err
+	}
+
+	if jobsResponse.GetDomainJobsResponse() != "" {
+		if err := json.Unmarshal([]byte(jobsResponse.GetDomainJobsResponse()), &jobs); err != nil {
+			log.Log.Reason(err).Error("error unmarshalling domain jobs response")
+			return v1.VirtualMachineInstanceDomainJobList{}, err
+		}
+	}
+
+	return v1.VirtualMachineInstanceDomainJobList{
+		Items: jobs,
+	}, nil
+}
+
+// CancelDomainJob cancels a libvirt job in progress on the domain
+func (c *VirtLauncherClient) CancelDomainJob(vmi *v1.VirtualMachineInstance, options *v1.CancelDomainJobOptions) error {
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return err
+	}
+
+	optionsJson, err := json.Marshal(options)
+	if err != nil {
+		return err
+	}
+
+	request := &cmdv1.CancelDomainJobRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+		Options: optionsJson,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shortTimeout)
+	defer cancel()
+
+	response, err := c.v1client.CancelDomainJob(ctx, request)
+
+	return handleError(err, "CancelDomainJob", response)
+}
+
+// GetQemuCommandLine returns the command line of the QEMU process, with its secrets redacted
+func (c *VirtLauncherClient) GetQemuCommandLine(vmi *v1.VirtualMachineInstance) (v1.VirtualMachineInstanceQemuCommandLine, error) {
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return v1.VirtualMachineInstanceQemuCommandLine{}, err
+	}
+
+	request := &cmdv1.VMIRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shortTimeout)
+	defer cancel()
+
+	commandLineResponse, err := c.v1client.GetQemuCommandLine(ctx, request)
+	if err = handleError(err, "GetQemuCommandLine", commandLineResponse.GetResponse()); err != nil || commandLineResponse == nil {
+		return v1.VirtualMachineInstanceQemuCommandLine{}, err
+	}
+
+	return v1.VirtualMachineInstanceQemuCommandLine{
+		Args: commandLineResponse.GetArgs(),
+	}, nil
+}
@@ -37,6 +37,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
+	"kubevirt.io/kubevirt/pkg/network/bindingplugin/errcode"
 )
 
 var _ = Describe("Virt remote commands", func() {
@@ -219,6 +220,30 @@ var _ = Describe("Virt remote commands", func() {
 				err := client.GuestPing(testDomainName, testTimeoutSeconds)
 				Expect(err).ToNot(HaveOccurred())
 			})
+			It("returns the interface which failed to be hot plugged", func() {
+				mockCmdClient.EXPECT().SyncVirtualMachine(gomock.Any(), gomock.Any()).Return(&cmdv1.Response{
+					Message:                `failed to hot plug interface "foonet": boom`,
+					HotplugFailedInterface: "foonet",
+				}, nil)
+				err := client.SyncVirtualMachine(api.NewMinimalVMI("testvmi"), &cmdv1.VirtualMachineOptions{})
+				var hotplugErr *InterfaceHotplugError
+				Expect(errors.As(err, &hotplugErr)).To(BeTrue())
+				Expect(hotplugErr.Interface).To(Equal("foonet"))
+				Expect(err.Error()).To(ContainSubstring("boom"))
+			})
+			It("returns the sidecar which failed its readiness check", func() {
+				mockCmdClient.EXPECT().SyncVirtualMachine(gomock.Any(), gomock.Any()).Return(&cmdv1.Response{
+					Message:           "binding plugin failed its readiness check in sidecar hook-sidecar-0: boom",
+					NotReadySidecar:   "hook-sidecar-0",
+					NotReadyErrorCode: string(errcode.UnsupportedModel),
+				}, nil)
+				err := client.SyncVirtualMachine(api.NewMinimalVMI("testvmi"), &cmdv1.VirtualMachineOptions{})
+				var notReadyErr *SidecarNotReadyError
+				Expect(errors.As(err, &notReadyErr)).To(BeTrue())
+				Expect(notReadyErr.Sidecar).To(Equal("hook-sidecar-0"))
+				Expect(notReadyErr.Code).To(Equal(errcode.UnsupportedModel))
+				Expect(err.Error()).To(ContainSubstring("boom"))
+			})
 		})
 	})
 })
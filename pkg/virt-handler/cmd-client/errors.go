@@ -31,6 +31,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
+	"kubevirt.io/kubevirt/pkg/network/bindingplugin/errcode"
 )
 
 func IsUnimplemented(err error) bool {
@@ -51,11 +52,42 @@ func handleError(err error, cmdName string, response *cmdv1.Response) error {
 		msg := fmt.Sprintf("unknown error encountered sending command %s: %s", cmdName, err.Error())
 		return fmt.Errorf("%s", msg)
 	} else if response != nil && !response.Success {
-		return fmt.Errorf("server error. command %s failed: %q", cmdName, response.Message)
+		err := fmt.Errorf("server error. command %s failed: %q", cmdName, response.Message)
+		if response.HotplugFailedInterface != "" {
+			return &InterfaceHotplugError{Interface: response.HotplugFailedInterface, Err: err}
+		}
+		if response.NotReadySidecar != "" {
+			return &SidecarNotReadyError{
+				Sidecar: response.NotReadySidecar,
+				Code:    errcode.Code(response.NotReadyErrorCode),
+				Err:     err,
+			}
+		}
+		return err
 	}
 	return nil
 }
 
+// InterfaceHotplugError is returned by the commands which failed to hot plug an interface into the domain
+type InterfaceHotplugError struct {
+	Interface string
+	Err       error
+}
+
+func (e *InterfaceHotplugError) Error() string { return e.Err.Error() }
+func (e *InterfaceHotplugError) Unwrap() error { return e.Err }
+
+// SidecarNotReadyError is returned by the commands which failed to start the domain because a sidecar
+// failed its readiness check. The code is empty when the sidecar did not classify its failure.
+type SidecarNotReadyError struct {
+	Sidecar string
+	Code    errcode.Code
+	Err     error
+}
+
+func (e *SidecarNotReadyError) Error() string { return e.Err.Error() }
+func (e *SidecarNotReadyError) Unwrap() error { return e.Err }
+
 func IsDisconnected(err error) bool {
 	if err == nil {
 		return false
@@ -45,6 +45,20 @@ func (m *MockLauncherClient) EXPECT() *MockLauncherClientMockRecorder {
 	return m.recorder
 }
 
+// CancelDomainJob mocks base method.
+func (m *MockLauncherClient) CancelDomainJob(vmi *v1.VirtualMachineInstance, options *v1.CancelDomainJobOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelDomainJob", vmi, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelDomainJob indicates an expected call of CancelDomainJob.
+func (mr *MockLauncherClientMockRecorder) CancelDomainJob(vmi, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDomainJob", reflect.TypeOf((*MockLauncherClient)(nil).CancelDomainJob), vmi, options)
+}
+
 // CancelVirtualMachineMigration mocks base method.
 func (m *MockLauncherClient) CancelVirtualMachineMigration(vmi *v1.VirtualMachineInstance) error {
 	m.ctrl.T.Helper()
@@ -160,6 +174,21 @@ func (mr *MockLauncherClientMockRecorder) GetDomainDirtyRateStats() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainDirtyRateStats", reflect.TypeOf((*MockLauncherClient)(nil).GetDomainDirtyRateStats))
 }
 
+// GetDomainJobs mocks base method.
+func (m *MockLauncherClient) GetDomainJobs(vmi *v1.VirtualMachineInstance) (v1.VirtualMachineInstanceDomainJobList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDomainJobs", vmi)
+	ret0, _ := ret[0].(v1.VirtualMachineInstanceDomainJobList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDomainJobs indicates an expected call of GetDomainJobs.
+func (mr *MockLauncherClientMockRecorder) GetDomainJobs(vmi any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainJobs", reflect.TypeOf((*MockLauncherClient)(nil).GetDomainJobs), vmi)
+}
+
 // GetDomainStats mocks base method.
 func (m *MockLauncherClient) GetDomainStats() (*stats.DomainStats, bool, error) {
 	m.ctrl.T.Helper()
@@ -221,6 +250,21 @@ func (mr *MockLauncherClientMockRecorder) GetLaunchMeasurement(arg0 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLaunchMeasurement", reflect.TypeOf((*MockLauncherClient)(nil).GetLaunchMeasurement), arg0)
 }
 
+// GetQemuCommandLine mocks base method.
+func (m *MockLauncherClient) GetQemuCommandLine(vmi *v1.VirtualMachineInstance) (v1.VirtualMachineInstanceQemuCommandLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQemuCommandLine", vmi)
+	ret0, _ := ret[0].(v1.VirtualMachineInstanceQemuCommandLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQemuCommandLine indicates an expected call of GetQemuCommandLine.
+func (mr *MockLauncherClientMockRecorder) GetQemuCommandLine(vmi any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQemuCommandLine", reflect.TypeOf((*MockLauncherClient)(nil).GetQemuCommandLine), vmi)
+}
+
 // GetQemuVersion mocks base method.
 func (m *MockLauncherClient) GetQemuVersion() (string, error) {
 	m.ctrl.T.Helper()
@@ -29,6 +29,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
@@ -42,6 +43,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/safepath"
 	"kubevirt.io/kubevirt/pkg/util"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-handler/cgroup"
 	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
 	launcherclients "kubevirt.io/kubevirt/pkg/virt-handler/launcher-clients"
 	migrationproxy "kubevirt.io/kubevirt/pkg/virt-handler/migration-proxy"
@@ -65,6 +67,12 @@ const (
 	VolumeMountedToPodReason = "VolumeMountedToPod"
 	//VolumeUnplugged is the reason set when the volume is completely unplugged from the VMI
 	VolumeUnplugged = "VolumeUnplugged"
+	//HostDeviceMountedToPodReason is the reason set when the host device is mounted to the virtlauncher pod
+	HostDeviceMountedToPodReason = "HostDeviceMountedToPod"
+	//HostDeviceReadyReason is the reason set when the host device is attached to the domain
+	HostDeviceReadyReason = "HostDeviceReady"
+	//HostDeviceDetachedReason is the reason set when the host device is detached from the domain
+	HostDeviceDetachedReason = "HostDeviceDetached"
 	//VMIDefined is the reason set when a VMI is defined
 	VMIDefined = "VirtualMachineInstance defined."
 	//VMIStarted is the reason set when a VMI is started
@@ -112,6 +120,7 @@ type BaseController struct {
 	hasSynced                   func() bool
 	hypervisorNodeInfo          hypervisor.HypervisorNodeInformation
 	hypervisorRuntime           hypervisor.VirtRuntime
+	statusDebouncer             *statusDebouncer
 }
 
 func NewBaseController(
@@ -149,11 +158,28 @@ func NewBaseController(
 		hasSynced:                   func() bool { return domainInformer.HasSynced() && vmiInformer.HasSynced() },
 		hypervisorNodeInfo:          hypervisorNodeInfo,
 		hypervisorRuntime:           hypervisorRuntime,
+		statusDebouncer:             newStatusDebouncer(clock.RealClock{}),
 	}
 
 	return c, nil
 }
 
+// holdBackStatusUpdate tells whether the write of the VMI status can be held back, to be aggregated
+// with the later changes. If so, the VMI is re-enqueued for when the write is due.
+func (c *BaseController) holdBackStatusUpdate(oldStatus *v1.VirtualMachineInstanceStatus, vmi *v1.VirtualMachineInstance) bool {
+	delay := c.statusDebouncer.delay(c.clusterConfig.GetVMIStatusUpdatesConfiguration(), vmi.UID, oldStatus, &vmi.Status)
+	if delay == 0 {
+		return false
+	}
+	c.logger.Object(vmi).V(4).Infof("Holding back the status update for %v", delay)
+	c.queue.AddAfter(controller.VirtualMachineInstanceKey(vmi), delay)
+	return true
+}
+
+func (c *BaseController) statusUpdated(vmi *v1.VirtualMachineInstance) {
+	c.statusDebouncer.updated(c.clusterConfig.GetVMIStatusUpdatesConfiguration(), vmi.UID)
+}
+
 func (c *BaseController) getVMIFromCache(key string) (vmi *v1.VirtualMachineInstance, exists bool, err error) {
 	obj, exists, err := c.vmiStore.GetByKey(key)
 	if err != nil {
@@ -256,6 +282,25 @@ func (c *BaseController) configureVirtioFS(vmi *v1.VirtualMachineInstance, isola
 	return nil
 }
 
+// configureMemorySwap allows the launcher pod to use node swap according to the VMI swap policy.
+func (c *BaseController) configureMemorySwap(vmi *v1.VirtualMachineInstance, cgroupManager cgroup.Manager) error {
+	if vmi.Spec.Domain.Memory == nil || vmi.Spec.Domain.Memory.Swap == nil || !c.clusterConfig.NodeSwapEnabled() {
+		return nil
+	}
+
+	swap := vmi.Spec.Domain.Memory.Swap
+	limit := int64(-1)
+	if swap.Limit != nil {
+		limit = swap.Limit.Value()
+	}
+	zswapWriteback := swap.Policy != v1.MemorySwapPolicyZswap
+
+	if err := cgroupManager.SetMemorySwap(limit, zswapWriteback); err != nil {
+		return fmt.Errorf("failed to configure memory swap: %v", err)
+	}
+	return nil
+}
+
 func (c *BaseController) setupDevicesOwnerships(vmi *v1.VirtualMachineInstance, recorder record.EventRecorder) error {
 	isolationRes, err := c.podIsolationDetector.Detect(vmi)
 	if err != nil {
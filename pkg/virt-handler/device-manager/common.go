@@ -44,6 +44,9 @@ import (
 
 type DeviceHandler interface {
 	GetDeviceIOMMUGroup(basepath string, pciAddress string) (string, error)
+	GetIOMMUGroupDevices(iommuGroup string) ([]string, error)
+	HasVDPADevices(pciAddress string) (bool, error)
+	BindDeviceDriver(pciAddress string, driver string) error
 	GetDeviceDriver(basepath string, pciAddress string) (string, error)
 	GetDeviceNumaNode(basepath string, pciAddress string) (numaNode int)
 	GetDevicePCIID(basepath string, pciAddress string) (string, error)
@@ -70,6 +73,30 @@ func (h *DeviceUtilsHandler) GetDeviceIOMMUGroup(basepath string, pciAddress str
 	return iommuGroup, nil
 }
 
+// GetIOMMUGroupDevices lists the PCI addresses of the devices in an IOMMU group
+// e.g. /sys/kernel/iommu_groups/45/devices/0000:65:00.0
+func (h *DeviceUtilsHandler) GetIOMMUGroupDevices(iommuGroup string) ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(iommuGroupsBasePath, iommuGroup, "devices"))
+	if err != nil {
+		return nil, err
+	}
+	var pciAddresses []string
+	for _, entry := range entries {
+		pciAddresses = append(pciAddresses, entry.Name())
+	}
+	return pciAddresses, nil
+}
+
+// HasVDPADevices reports whether vDPA devices were created on top of a PCI device, e.g. to be used through vhost-vdpa
+// e.g. /sys/bus/pci/devices/0000:65:00.2/vdpa0
+func (h *DeviceUtilsHandler) HasVDPADevices(pciAddress string) (bool, error) {
+	vdpaDevices, err := filepath.Glob(filepath.Join(pciBasePath, pciAddress, "vdpa*"))
+	if err != nil {
+		return false, err
+	}
+	return len(vdpaDevices) > 0, nil
+}
+
 // gets device driver
 func (h *DeviceUtilsHandler) GetDeviceDriver(basepath string, pciAddress string) (string, error) {
 	driverLink := filepath.Join(basepath, pciAddress, "driver")
@@ -169,6 +196,19 @@ func (h *DeviceUtilsHandler) RemoveMDEVType(mdevUUID string) error {
 	return nil
 }
 
+// BindDeviceDriver binds a PCI device to the given driver, or back to its default host driver when empty
+func (h *DeviceUtilsHandler) BindDeviceDriver(pciAddress string, driver string) error {
+	_, err := virt_chroot.BindPCIDriver(pciAddress, driver).Output()
+	if err != nil {
+		if e, ok := err.(*exec.ExitError); ok && len(e.Stderr) > 0 {
+			return fmt.Errorf("failed to bind PCI device %s to driver %q, err: %v", pciAddress, driver, string(e.Stderr))
+		}
+		return fmt.Errorf("failed to bind PCI device %s to driver %q: %v", pciAddress, driver, err)
+	}
+	log.Log.Infof("Successfully bound PCI device %s to driver %q", pciAddress, driver)
+	return nil
+}
+
 func (h *DeviceUtilsHandler) ReadMDEVAvailableInstances(mdevType string, parentID string) (int, error) {
 	var lines []string
 	path := filepath.Join(mdevClassBusPath, parentID, "mdev_supported_types", mdevType, "available_instances")
@@ -140,6 +140,7 @@ type DeviceController struct {
 	nodeStore                cache.Store
 	mdevRefreshWG            *sync.WaitGroup
 	lastTDXAttestationConfig *tdxConfigState
+	pciDevicesUsage          PCIDevicesUsage
 }
 
 type tdxConfigState struct {
@@ -154,6 +155,7 @@ func NewDeviceController(
 	permanentPlugins []Device,
 	clusterConfig *virtconfig.ClusterConfig,
 	nodeStore cache.Store,
+	pciDevicesUsage PCIDevicesUsage,
 ) *DeviceController {
 	permanentPluginsMap := make(map[string]Device, len(permanentPlugins))
 	for i := range permanentPlugins {
@@ -171,6 +173,7 @@ func NewDeviceController(
 		mdevTypesManager: NewMDEVTypesManager(),
 		nodeStore:        nodeStore,
 		mdevRefreshWG:    &sync.WaitGroup{},
+		pciDevicesUsage:  pciDevicesUsage,
 	}
 
 	return controller
@@ -251,6 +254,7 @@ func (c *DeviceController) updatePermittedHostDevicePlugins() []Device {
 
 	if len(hostDevs.PciHostDevices) != 0 {
 		supportedPCIDeviceMap := make(map[string]string)
+		managedDriverPCIIDs := make(map[string]bool)
 		for _, pciDev := range hostDevs.PciHostDevices {
 			log.Log.V(4).Infof("Permitted PCI device in the cluster, ID: %s, resourceName: %s, externalProvider: %t",
 				strings.ToLower(pciDev.PCIVendorSelector),
@@ -259,12 +263,17 @@ func (c *DeviceController) updatePermittedHostDevicePlugins() []Device {
 			// do not add a device plugin for this resource if it's being provided via an external device plugin
 			if !pciDev.ExternalResourceProvider {
 				supportedPCIDeviceMap[strings.ToLower(pciDev.PCIVendorSelector)] = pciDev.ResourceName
+				if pciDev.ManageDriverBinding && c.virtConfig.HostDeviceDriverBindingEnabled() {
+					managedDriverPCIIDs[strings.ToLower(pciDev.PCIVendorSelector)] = true
+				}
 			}
 		}
-		for pciResourceName, pciDevices := range discoverPermittedHostPCIDevices(supportedPCIDeviceMap) {
+		pciDevicesMap := discoverPermittedHostPCIDevices(supportedPCIDeviceMap, managedDriverPCIIDs)
+		dropSharedIOMMUGroups(pciDevicesMap)
+		for pciResourceName, pciDevices := range pciDevicesMap {
 			log.Log.V(4).Infof("Discovered PCIs %d devices on the node for the resource: %s", len(pciDevices), pciResourceName)
 			// add a device plugin only for new devices
-			permittedDevices = append(permittedDevices, NewPCIDevicePlugin(pciDevices, pciResourceName))
+			permittedDevices = append(permittedDevices, NewPCIDevicePlugin(pciDevices, pciResourceName, c.virtConfig.IOMMUGroupPassthroughEnabled, c.pciDevicesUsage))
 		}
 	}
 	if len(hostDevs.MediatedDevices) != 0 {
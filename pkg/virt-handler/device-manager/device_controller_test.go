@@ -150,7 +150,7 @@ var _ = Describe("Device Controller", func() {
 	Context("Basic Tests", func() {
 		It("Should indicate if node has device", func() {
 			var noDevices []Device
-			deviceController := NewDeviceController(host, maxDevices, permissions, noDevices, fakeConfigMap, fakeNodeStore)
+			deviceController := NewDeviceController(host, maxDevices, permissions, noDevices, fakeConfigMap, fakeNodeStore, nil)
 			devicePath := path.Join(workDir, "fake-device")
 			res := deviceController.NodeHasDevice(devicePath)
 			Expect(res).To(BeFalse())
@@ -189,7 +189,7 @@ var _ = Describe("Device Controller", func() {
 
 		It("should start the device plugin immediately without delays", func() {
 			initialDevices := []Device{plugin2}
-			deviceController := NewDeviceController(host, maxDevices, permissions, initialDevices, fakeConfigMap, fakeNodeStore)
+			deviceController := NewDeviceController(host, maxDevices, permissions, initialDevices, fakeConfigMap, fakeNodeStore, nil)
 			deviceController.backoff = []time.Duration{10 * time.Millisecond, 10 * time.Second}
 
 			runDeviceController(deviceController)
@@ -205,7 +205,7 @@ var _ = Describe("Device Controller", func() {
 			plugin2.Error = fmt.Errorf("failing")
 			initialDevices := []Device{plugin2}
 
-			deviceController := NewDeviceController(host, maxDevices, permissions, initialDevices, fakeConfigMap, fakeNodeStore)
+			deviceController := NewDeviceController(host, maxDevices, permissions, initialDevices, fakeConfigMap, fakeNodeStore, nil)
 			deviceController.backoff = []time.Duration{10 * time.Millisecond, 300 * time.Millisecond}
 
 			runDeviceController(deviceController)
@@ -218,7 +218,7 @@ var _ = Describe("Device Controller", func() {
 
 		It("Should not block on other plugins", func() {
 			initialDevices := []Device{plugin1, plugin2}
-			deviceController := NewDeviceController(host, maxDevices, permissions, initialDevices, fakeConfigMap, fakeNodeStore)
+			deviceController := NewDeviceController(host, maxDevices, permissions, initialDevices, fakeConfigMap, fakeNodeStore, nil)
 
 			runDeviceController(deviceController)
 
@@ -238,7 +238,7 @@ var _ = Describe("Device Controller", func() {
 			emptyConfigMap, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
 			Expect(emptyConfigMap.GetPermittedHostDevices()).To(BeNil())
 
-			deviceController := NewDeviceController(host, maxDevices, permissions, []Device{}, emptyConfigMap, fakeNodeStore)
+			deviceController := NewDeviceController(host, maxDevices, permissions, []Device{}, emptyConfigMap, fakeNodeStore, nil)
 
 			deviceController.startDevice(deviceName1, plugin1)
 			deviceController.startDevice(deviceName2, plugin2)
@@ -259,7 +259,7 @@ var _ = Describe("Device Controller", func() {
 			Expect(emptyConfigMap.GetPermittedHostDevices()).To(BeNil())
 
 			permanentPlugins := []Device{plugin1, plugin2}
-			deviceController := NewDeviceController(host, maxDevices, permissions, permanentPlugins, emptyConfigMap, fakeNodeStore)
+			deviceController := NewDeviceController(host, maxDevices, permissions, permanentPlugins, emptyConfigMap, fakeNodeStore, nil)
 
 			runDeviceController(deviceController)
 
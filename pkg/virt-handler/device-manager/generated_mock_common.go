@@ -39,6 +39,20 @@ func (m *MockDeviceHandler) EXPECT() *MockDeviceHandlerMockRecorder {
 	return m.recorder
 }
 
+// BindDeviceDriver mocks base method.
+func (m *MockDeviceHandler) BindDeviceDriver(pciAddress, driver string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BindDeviceDriver", pciAddress, driver)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BindDeviceDriver indicates an expected call of BindDeviceDriver.
+func (mr *MockDeviceHandlerMockRecorder) BindDeviceDriver(pciAddress, driver any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BindDeviceDriver", reflect.TypeOf((*MockDeviceHandler)(nil).BindDeviceDriver), pciAddress, driver)
+}
+
 // CreateMDEVType mocks base method.
 func (m *MockDeviceHandler) CreateMDEVType(mdevType, parentID string) error {
 	m.ctrl.T.Helper()
@@ -112,6 +126,21 @@ func (mr *MockDeviceHandlerMockRecorder) GetDevicePCIID(basepath, pciAddress any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDevicePCIID", reflect.TypeOf((*MockDeviceHandler)(nil).GetDevicePCIID), basepath, pciAddress)
 }
 
+// GetIOMMUGroupDevices mocks base method.
+func (m *MockDeviceHandler) GetIOMMUGroupDevices(iommuGroup string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIOMMUGroupDevices", iommuGroup)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIOMMUGroupDevices indicates an expected call of GetIOMMUGroupDevices.
+func (mr *MockDeviceHandlerMockRecorder) GetIOMMUGroupDevices(iommuGroup any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIOMMUGroupDevices", reflect.TypeOf((*MockDeviceHandler)(nil).GetIOMMUGroupDevices), iommuGroup)
+}
+
 // GetMdevParentPCIAddr mocks base method.
 func (m *MockDeviceHandler) GetMdevParentPCIAddr(mdevUUID string) (string, error) {
 	m.ctrl.T.Helper()
@@ -127,6 +156,21 @@ func (mr *MockDeviceHandlerMockRecorder) GetMdevParentPCIAddr(mdevUUID any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMdevParentPCIAddr", reflect.TypeOf((*MockDeviceHandler)(nil).GetMdevParentPCIAddr), mdevUUID)
 }
 
+// HasVDPADevices mocks base method.
+func (m *MockDeviceHandler) HasVDPADevices(pciAddress string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasVDPADevices", pciAddress)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasVDPADevices indicates an expected call of HasVDPADevices.
+func (mr *MockDeviceHandlerMockRecorder) HasVDPADevices(pciAddress any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasVDPADevices", reflect.TypeOf((*MockDeviceHandler)(nil).HasVDPADevices), pciAddress)
+}
+
 // ReadMDEVAvailableInstances mocks base method.
 func (m *MockDeviceHandler) ReadMDEVAvailableInstances(mdevType, parentID string) (int, error) {
 	m.ctrl.T.Helper()
@@ -26,8 +26,10 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/fsnotify/fsnotify"
 	"google.golang.org/grpc"
@@ -40,22 +42,52 @@ import (
 )
 
 const (
-	vfioDevicePath = "/dev/vfio/"
-	vfioMount      = "/dev/vfio/vfio"
-	pciBasePath    = "/sys/bus/pci/devices"
+	vfioDevicePath      = "/dev/vfio/"
+	vfioMount           = "/dev/vfio/vfio"
+	pciBasePath         = "/sys/bus/pci/devices"
+	iommuGroupsBasePath = "/sys/kernel/iommu_groups"
+	vfioDriver          = "vfio-pci"
+
+	// Managed devices are returned to their host driver once no VMI on the node uses them. A freshly
+	// allocated device is kept for a while, as its VMI may not report its pod on the node yet.
+	managedDriverAllocationSettlePeriod = 30 * time.Second
+	managedDriverReleaseInterval        = 30 * time.Second
 )
 
+// Host drivers which do not prevent VFIO from taking over the IOMMU group of a device,
+// e.g. PCIe bridges bound to pcieport are commonly part of the group of a GPU.
+var iommuGroupViableDrivers = map[string]struct{}{
+	vfioDriver: {},
+	"pci-stub": {},
+	"pcieport": {},
+}
+
 type PCIDevice struct {
-	pciID      string
-	driver     string
-	pciAddress string
-	iommuGroup string
-	numaNode   int
+	pciID         string
+	driver        string
+	pciAddress    string
+	iommuGroup    string
+	numaNode      int
+	managedDriver bool
+}
+
+// PCIDevicesUsage reports the PCI devices used by the VMIs on the node
+type PCIDevicesUsage interface {
+	// PCIAddressesInUse returns the PCI addresses in use, and false when the usage of some VMI is not known yet
+	PCIAddressesInUse() (map[string]struct{}, bool)
 }
 
 type PCIDevicePlugin struct {
 	*DevicePluginBase
 	iommuToPCIMap map[string]string
+	// iommuGroupPassthroughEnabled reports whether the devices sharing the IOMMU group of an allocated device are exposed
+	iommuGroupPassthroughEnabled func() bool
+	// managedDevices holds the IOMMU groups of the devices whose driver binding is managed by the plugin
+	managedDevices map[string]struct{}
+	// boundDevices holds the IOMMU groups of the managed devices bound to vfio-pci, with their allocation time
+	boundDevices map[string]time.Time
+	bindingLock  sync.Mutex
+	usage        PCIDevicesUsage
 }
 
 func (dpi *PCIDevicePlugin) Start(stop <-chan struct{}) (err error) {
@@ -97,6 +129,10 @@ func (dpi *PCIDevicePlugin) Start(stop <-chan struct{}) (err error) {
 		errChan <- dpi.healthCheck()
 	}()
 
+	if len(dpi.managedDevices) > 0 && dpi.usage != nil {
+		go dpi.releaseUnusedDevicesLoop()
+	}
+
 	dpi.setInitialized(true)
 	logger.Infof("%s device plugin started", dpi.resourceName)
 	err = <-errChan
@@ -104,7 +140,7 @@ func (dpi *PCIDevicePlugin) Start(stop <-chan struct{}) (err error) {
 	return err
 }
 
-func NewPCIDevicePlugin(pciDevices []*PCIDevice, resourceName string) *PCIDevicePlugin {
+func NewPCIDevicePlugin(pciDevices []*PCIDevice, resourceName string, iommuGroupPassthroughEnabled func() bool, usage PCIDevicesUsage) *PCIDevicePlugin {
 	serverSock := SocketPath(strings.Replace(resourceName, "/", "-", -1))
 	iommuToPCIMap := make(map[string]string)
 
@@ -123,7 +159,21 @@ func NewPCIDevicePlugin(pciDevices []*PCIDevice, resourceName string) *PCIDevice
 			done:         make(chan struct{}),
 			deregistered: make(chan struct{}),
 		},
-		iommuToPCIMap: iommuToPCIMap,
+		iommuToPCIMap:                iommuToPCIMap,
+		iommuGroupPassthroughEnabled: iommuGroupPassthroughEnabled,
+		managedDevices:               make(map[string]struct{}),
+		boundDevices:                 make(map[string]time.Time),
+		usage:                        usage,
+	}
+	for _, pciDevice := range pciDevices {
+		if !pciDevice.managedDriver {
+			continue
+		}
+		dpi.managedDevices[pciDevice.iommuGroup] = struct{}{}
+		if pciDevice.driver == vfioDriver {
+			// the device may be in use since before a restart, give it the same chance as a fresh allocation
+			dpi.boundDevices[pciDevice.iommuGroup] = time.Now()
+		}
 	}
 	return dpi
 }
@@ -150,7 +200,9 @@ func constructDPIdevices(pciDevices []*PCIDevice, iommuToPCIMap map[string]strin
 
 func (dpi *PCIDevicePlugin) Allocate(_ context.Context, r *pluginapi.AllocateRequest) (*pluginapi.AllocateResponse, error) {
 	resourceNameEnvVar := util.ResourceNameToEnvVar(v1.PCIResourcePrefix, dpi.resourceName)
+	iommuGroupEnvVar := util.ResourceNameToEnvVar(v1.PCIIOMMUGroupResourcePrefix, dpi.resourceName)
 	allocatedDevices := []string{}
+	allocatedIOMMUGroups := []string{}
 	resp := new(pluginapi.AllocateResponse)
 	containerResponse := new(pluginapi.ContainerAllocateResponse)
 
@@ -162,12 +214,25 @@ func (dpi *PCIDevicePlugin) Allocate(_ context.Context, r *pluginapi.AllocateReq
 			if !exist {
 				continue
 			}
+			if err := dpi.bindManagedDevice(devID, devPCIAddress); err != nil {
+				return nil, err
+			}
+			if dpi.iommuGroupPassthroughEnabled() {
+				groupDevices, err := iommuGroupDevices(devID, devPCIAddress)
+				if err != nil {
+					return nil, err
+				}
+				if len(groupDevices) > 0 {
+					allocatedIOMMUGroups = append(allocatedIOMMUGroups, devPCIAddress+"="+strings.Join(groupDevices, ";"))
+				}
+			}
 			allocatedDevices = append(allocatedDevices, devPCIAddress)
 			deviceSpecs = append(deviceSpecs, formatVFIODeviceSpecs(devID)...)
 		}
 		containerResponse.Devices = deviceSpecs
 		envVar := make(map[string]string)
 		envVar[resourceNameEnvVar] = strings.Join(allocatedDevices, ",")
+		envVar[iommuGroupEnvVar] = strings.Join(allocatedIOMMUGroups, ",")
 
 		containerResponse.Envs = envVar
 		resp.ContainerResponses = append(resp.ContainerResponses, containerResponse)
@@ -175,6 +240,138 @@ func (dpi *PCIDevicePlugin) Allocate(_ context.Context, r *pluginapi.AllocateReq
 	return resp, nil
 }
 
+// bindManagedDevice binds an allocated device to vfio-pci, when its driver binding is managed by the plugin
+func (dpi *PCIDevicePlugin) bindManagedDevice(iommuGroup string, pciAddress string) error {
+	if _, managed := dpi.managedDevices[iommuGroup]; !managed {
+		return nil
+	}
+
+	dpi.bindingLock.Lock()
+	defer dpi.bindingLock.Unlock()
+
+	if _, bound := dpi.boundDevices[iommuGroup]; !bound {
+		// vDPA devices are used through their host driver, rebinding would tear them down under their users
+		hasVDPADevices, err := handler.HasVDPADevices(pciAddress)
+		if err != nil {
+			return err
+		}
+		if hasVDPADevices {
+			return fmt.Errorf("PCI device %s is in use by vDPA devices and cannot be bound to %s", pciAddress, vfioDriver)
+		}
+		if err := handler.BindDeviceDriver(pciAddress, vfioDriver); err != nil {
+			return err
+		}
+	}
+	dpi.boundDevices[iommuGroup] = time.Now()
+	return nil
+}
+
+func (dpi *PCIDevicePlugin) releaseUnusedDevicesLoop() {
+	ticker := time.NewTicker(managedDriverReleaseInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-dpi.stop:
+			return
+		case now := <-ticker.C:
+			dpi.releaseUnusedDevices(now)
+		}
+	}
+}
+
+// releaseUnusedDevices returns the managed devices which are no longer used by any VMI to their host driver.
+// Nothing is released while a VMI on the node is starting, as the devices allocated to it are not known yet.
+func (dpi *PCIDevicePlugin) releaseUnusedDevices(now time.Time) {
+	dpi.bindingLock.Lock()
+	defer dpi.bindingLock.Unlock()
+
+	if len(dpi.boundDevices) == 0 {
+		return
+	}
+	inUse, known := dpi.usage.PCIAddressesInUse()
+	if !known {
+		return
+	}
+	for iommuGroup, allocated := range dpi.boundDevices {
+		if now.Sub(allocated) < managedDriverAllocationSettlePeriod {
+			continue
+		}
+		if _, used := inUse[dpi.iommuToPCIMap[iommuGroup]]; used {
+			continue
+		}
+		if err := handler.BindDeviceDriver(dpi.iommuToPCIMap[iommuGroup], ""); err != nil {
+			log.DefaultLogger().Reason(err).Errorf("failed to return PCI device %s to its host driver", dpi.iommuToPCIMap[iommuGroup])
+			continue
+		}
+		delete(dpi.boundDevices, iommuGroup)
+	}
+}
+
+// iommuGroupDevices returns the other devices of the IOMMU group of an allocated device which
+// can be passed through along with it. VFIO refuses to open a group unless all of its devices
+// are released by the host, so the allocation fails early when any of them is still in use.
+func iommuGroupDevices(iommuGroup string, pciAddress string) ([]string, error) {
+	groupDevices, err := handler.GetIOMMUGroupDevices(iommuGroup)
+	if err != nil {
+		log.DefaultLogger().Reason(err).Warningf("failed to list the devices of IOMMU group %s", iommuGroup)
+		return nil, nil
+	}
+
+	var assignable, conflicting []string
+	for _, groupDevice := range groupDevices {
+		if groupDevice == pciAddress {
+			continue
+		}
+		driver, err := handler.GetDeviceDriver(pciBasePath, groupDevice)
+		if errors.Is(err, os.ErrNotExist) {
+			// the device is not bound to any driver
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to get the driver of device %s in IOMMU group %s: %v", groupDevice, iommuGroup, err)
+		}
+		if driver == vfioDriver {
+			assignable = append(assignable, groupDevice)
+		} else if _, viable := iommuGroupViableDrivers[driver]; !viable {
+			conflicting = append(conflicting, fmt.Sprintf("%s (%s)", groupDevice, driver))
+		}
+	}
+	if len(conflicting) > 0 {
+		return nil, fmt.Errorf("IOMMU group %s of PCI device %s is not viable, devices in use by host drivers: %s",
+			iommuGroup, pciAddress, strings.Join(conflicting, ", "))
+	}
+	return assignable, nil
+}
+
+// dropSharedIOMMUGroups keeps a single permitted device per IOMMU group across all the PCI resources.
+// The devices are allocated by IOMMU group, and VFIO lets only one VM open a group, so advertising
+// the devices sharing a group under several resources would let them be allocated to different VMs.
+func dropSharedIOMMUGroups(pciDevicesMap map[string][]*PCIDevice) {
+	resourceNames := make([]string, 0, len(pciDevicesMap))
+	for resourceName := range pciDevicesMap {
+		resourceNames = append(resourceNames, resourceName)
+	}
+	sort.Strings(resourceNames)
+
+	owners := make(map[string]string)
+	for _, resourceName := range resourceNames {
+		var pciDevices []*PCIDevice
+		for _, pciDevice := range pciDevicesMap[resourceName] {
+			if owner, shared := owners[pciDevice.iommuGroup]; shared {
+				log.DefaultLogger().Warningf("PCI device %s of resource %s is not advertised, its IOMMU group %s is already advertised by resource %s",
+					pciDevice.pciAddress, resourceName, pciDevice.iommuGroup, owner)
+				continue
+			}
+			owners[pciDevice.iommuGroup] = resourceName
+			pciDevices = append(pciDevices, pciDevice)
+		}
+		if len(pciDevices) == 0 {
+			delete(pciDevicesMap, resourceName)
+			continue
+		}
+		pciDevicesMap[resourceName] = pciDevices
+	}
+}
+
 func (dpi *PCIDevicePlugin) healthCheck() error {
 	logger := log.DefaultLogger()
 	monitoredDevices := make(map[string]string)
@@ -204,6 +401,12 @@ func (dpi *PCIDevicePlugin) healthCheck() error {
 	// probe all devices
 	for _, dev := range dpi.devs {
 		vfioDevice := filepath.Join(devicePath, dev.ID)
+		if _, managed := dpi.managedDevices[dev.ID]; managed {
+			// the VFIO group of a managed device only exists while it is bound to vfio-pci,
+			// its events are caught by watching the device root path
+			monitoredDevices[vfioDevice] = dev.ID
+			continue
+		}
 		err = watcher.Add(vfioDevice)
 		if err != nil {
 			return fmt.Errorf("failed to add the device %s to the watcher: %v", vfioDevice, err)
@@ -239,6 +442,10 @@ func (dpi *PCIDevicePlugin) healthCheck() error {
 						Health: pluginapi.Healthy,
 					}
 				} else if (event.Op == fsnotify.Remove) || (event.Op == fsnotify.Rename) {
+					if dpi.isReleasedManagedDevice(monDevId) {
+						logger.V(4).Infof("managed device %s of %s was returned to its host driver", monDevId, dpi.resourceName)
+						continue
+					}
 					logger.Infof("monitored device %s disappeared", dpi.resourceName)
 					dpi.health <- deviceHealth{
 						DevId:  monDevId,
@@ -253,7 +460,18 @@ func (dpi *PCIDevicePlugin) healthCheck() error {
 	}
 }
 
-func discoverPermittedHostPCIDevices(supportedPCIDeviceMap map[string]string) map[string][]*PCIDevice {
+// isReleasedManagedDevice reports whether a managed device is not expected to be bound to vfio-pci
+func (dpi *PCIDevicePlugin) isReleasedManagedDevice(iommuGroup string) bool {
+	if _, managed := dpi.managedDevices[iommuGroup]; !managed {
+		return false
+	}
+	dpi.bindingLock.Lock()
+	defer dpi.bindingLock.Unlock()
+	_, bound := dpi.boundDevices[iommuGroup]
+	return !bound
+}
+
+func discoverPermittedHostPCIDevices(supportedPCIDeviceMap map[string]string, managedDriverPCIIDs map[string]bool) map[string][]*PCIDevice {
 	pciDevicesMap := make(map[string][]*PCIDevice)
 	err := filepath.Walk(pciBasePath, func(path string, info os.FileInfo, err error) error {
 		if info.IsDir() {
@@ -265,15 +483,17 @@ func discoverPermittedHostPCIDevices(supportedPCIDeviceMap map[string]string) ma
 			return nil
 		}
 		if resourceName, supported := supportedPCIDeviceMap[pciID]; supported {
-			// check device driver
+			// check device driver, unless its binding is managed
+			managedDriver := managedDriverPCIIDs[pciID]
 			driver, err := handler.GetDeviceDriver(pciBasePath, info.Name())
-			if err != nil || driver != "vfio-pci" {
+			if !managedDriver && (err != nil || driver != vfioDriver) {
 				return nil
 			}
 
 			pcidev := &PCIDevice{
-				pciID:      pciID,
-				pciAddress: info.Name(),
+				pciID:         pciID,
+				pciAddress:    info.Name(),
+				managedDriver: managedDriver,
 			}
 			iommuGroup, err := handler.GetDeviceIOMMUGroup(pciBasePath, info.Name())
 			if err != nil {
@@ -20,9 +20,11 @@
 package device_manager
 
 import (
+	"context"
 	"errors"
 	"os"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -37,6 +39,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+	pluginapi "kubevirt.io/kubevirt/pkg/virt-handler/device-manager/deviceplugin/v1beta1"
 )
 
 const (
@@ -99,7 +102,7 @@ pciHostDevices:
 		}
 		// discoverPermittedHostPCIDevices() will walk real PCI devices wherever the tests are running
 		// It's assumed here that it will find a PCI device at 0000:00:00.0
-		devices := discoverPermittedHostPCIDevices(supportedPCIDeviceMap)
+		devices := discoverPermittedHostPCIDevices(supportedPCIDeviceMap, nil)
 		Expect(devices).To(HaveLen(1), "only one PCI device is expected to be found")
 		Expect(devices[fakeName]).To(HaveLen(1), "only one PCI device is expected to be found")
 		Expect(devices[fakeName][0].pciID).To(Equal(fakeID))
@@ -120,7 +123,7 @@ pciHostDevices:
 		}
 		// discoverPermittedHostPCIDevices() will walk real PCI devices wherever the tests are running
 		// It's assumed here that it will find a PCI device at 0000:00:00.0
-		pciDevices := discoverPermittedHostPCIDevices(supportedPCIDeviceMap)
+		pciDevices := discoverPermittedHostPCIDevices(supportedPCIDeviceMap, nil)
 		devs := constructDPIdevices(pciDevices[fakeName], iommuToPCIMap)
 		Expect(devs[0].ID).To(Equal(fakeIommuGroup))
 		Expect(devs[0].Topology.Nodes[0].ID).To(Equal(int64(fakeNumaNode)))
@@ -145,7 +148,7 @@ pciHostDevices:
 
 		By("creating an empty device controller")
 		var noDevices []Device
-		deviceController := NewDeviceController("master", 100, "rw", noDevices, fakeClusterConfig, fakeNodeStore)
+		deviceController := NewDeviceController("master", 100, "rw", noDevices, fakeClusterConfig, fakeNodeStore, nil)
 
 		By("adding a host device to the cluster config")
 		kvConfig := kv.DeepCopy()
@@ -189,3 +192,237 @@ pciHostDevices:
 		Ω(disabledDevicePlugins).Should(HaveKey(fakeName))
 	})
 })
+
+var _ = Describe("PCI Device Plugin", func() {
+	const (
+		gpuAddress    = "0000:01:00.0"
+		audioAddress  = "0000:01:00.1"
+		bridgeAddress = "0000:00:01.0"
+		gpuIommuGroup = "1"
+	)
+
+	var mockPCI *MockDeviceHandler
+	var dpi *PCIDevicePlugin
+	var iommuGroupPassthrough bool
+
+	iommuGroupPassthroughEnabled := func() bool { return iommuGroupPassthrough }
+
+	allocate := func() (*pluginapi.AllocateResponse, error) {
+		return dpi.Allocate(context.Background(), &pluginapi.AllocateRequest{
+			ContainerRequests: []*pluginapi.ContainerAllocateRequest{{DevicesIDs: []string{gpuIommuGroup}}},
+		})
+	}
+
+	BeforeEach(func() {
+		mockPCI = NewMockDeviceHandler(gomock.NewController(GinkgoT()))
+		handler = mockPCI
+		DeferCleanup(func() {
+			handler = &DeviceUtilsHandler{}
+		})
+		iommuGroupPassthrough = true
+		dpi = NewPCIDevicePlugin([]*PCIDevice{{pciID: fakeID, pciAddress: gpuAddress, iommuGroup: gpuIommuGroup}}, fakeName, iommuGroupPassthroughEnabled, nil)
+	})
+
+	It("should not expose the IOMMU group of the allocated device when the passthrough is disabled", func() {
+		iommuGroupPassthrough = false
+		mockPCI.EXPECT().GetIOMMUGroupDevices(gomock.Any()).Times(0)
+
+		resp, err := allocate()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.ContainerResponses[0].Envs).To(Equal(map[string]string{
+			"PCI_RESOURCE_EXAMPLE_ORG_DEADBEEF":             gpuAddress,
+			"PCI_IOMMU_GROUP_RESOURCE_EXAMPLE_ORG_DEADBEEF": "",
+		}))
+	})
+
+	It("should expose the devices sharing the IOMMU group of the allocated device", func() {
+		mockPCI.EXPECT().GetIOMMUGroupDevices(gpuIommuGroup).Return([]string{bridgeAddress, gpuAddress, audioAddress}, nil)
+		mockPCI.EXPECT().GetDeviceDriver(pciBasePath, bridgeAddress).Return("pcieport", nil)
+		mockPCI.EXPECT().GetDeviceDriver(pciBasePath, audioAddress).Return(fakeDriver, nil)
+
+		resp, err := allocate()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.ContainerResponses).To(HaveLen(1))
+		Expect(resp.ContainerResponses[0].Envs).To(Equal(map[string]string{
+			"PCI_RESOURCE_EXAMPLE_ORG_DEADBEEF":             gpuAddress,
+			"PCI_IOMMU_GROUP_RESOURCE_EXAMPLE_ORG_DEADBEEF": gpuAddress + "=" + audioAddress,
+		}))
+	})
+
+	It("should ignore unbound devices in the IOMMU group", func() {
+		mockPCI.EXPECT().GetIOMMUGroupDevices(gpuIommuGroup).Return([]string{gpuAddress, audioAddress}, nil)
+		mockPCI.EXPECT().GetDeviceDriver(pciBasePath, audioAddress).Return("", os.ErrNotExist)
+
+		resp, err := allocate()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.ContainerResponses[0].Envs).To(HaveKeyWithValue("PCI_IOMMU_GROUP_RESOURCE_EXAMPLE_ORG_DEADBEEF", ""))
+	})
+
+	It("should fail listing the devices of the IOMMU group in use by host drivers", func() {
+		mockPCI.EXPECT().GetIOMMUGroupDevices(gpuIommuGroup).Return([]string{bridgeAddress, gpuAddress, audioAddress}, nil)
+		mockPCI.EXPECT().GetDeviceDriver(pciBasePath, bridgeAddress).Return("pcieport", nil)
+		mockPCI.EXPECT().GetDeviceDriver(pciBasePath, audioAddress).Return("snd_hda_intel", nil)
+
+		_, err := allocate()
+		Expect(err).To(MatchError("IOMMU group 1 of PCI device 0000:01:00.0 is not viable, devices in use by host drivers: 0000:01:00.1 (snd_hda_intel)"))
+	})
+
+	It("should allocate the device when its IOMMU group cannot be listed", func() {
+		mockPCI.EXPECT().GetIOMMUGroupDevices(gpuIommuGroup).Return(nil, os.ErrNotExist)
+
+		resp, err := allocate()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.ContainerResponses[0].Envs).To(HaveKeyWithValue("PCI_RESOURCE_EXAMPLE_ORG_DEADBEEF", gpuAddress))
+	})
+
+	It("should never bind devices whose driver binding is not managed", func() {
+		mockPCI.EXPECT().GetIOMMUGroupDevices(gpuIommuGroup).Return([]string{gpuAddress}, nil)
+		mockPCI.EXPECT().BindDeviceDriver(gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := allocate()
+		Expect(err).ToNot(HaveOccurred())
+		dpi.releaseUnusedDevices(time.Now().Add(managedDriverAllocationSettlePeriod))
+	})
+
+	Context("with a managed driver binding", func() {
+		var usage *fakePCIDevicesUsage
+
+		BeforeEach(func() {
+			usage = &fakePCIDevicesUsage{inUse: map[string]struct{}{}, known: true}
+			dpi = NewPCIDevicePlugin([]*PCIDevice{
+				{pciID: fakeID, pciAddress: gpuAddress, iommuGroup: gpuIommuGroup, driver: "nvidia", managedDriver: true},
+			}, fakeName, iommuGroupPassthroughEnabled, usage)
+		})
+
+		It("should bind the device to vfio-pci once allocated", func() {
+			mockPCI.EXPECT().HasVDPADevices(gpuAddress).Return(false, nil)
+			mockPCI.EXPECT().BindDeviceDriver(gpuAddress, vfioDriver).Return(nil).Times(1)
+			mockPCI.EXPECT().GetIOMMUGroupDevices(gpuIommuGroup).Return([]string{gpuAddress}, nil).Times(2)
+
+			_, err := allocate()
+			Expect(err).ToNot(HaveOccurred())
+			_, err = allocate()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should fail the allocation when the device cannot be bound", func() {
+			mockPCI.EXPECT().HasVDPADevices(gpuAddress).Return(false, nil)
+			mockPCI.EXPECT().BindDeviceDriver(gpuAddress, vfioDriver).Return(errors.New("bind failure"))
+
+			_, err := allocate()
+			Expect(err).To(MatchError("bind failure"))
+		})
+
+		It("should fail the allocation when the device is in use by vDPA devices", func() {
+			mockPCI.EXPECT().HasVDPADevices(gpuAddress).Return(true, nil)
+			mockPCI.EXPECT().BindDeviceDriver(gomock.Any(), gomock.Any()).Times(0)
+
+			_, err := allocate()
+			Expect(err).To(MatchError("PCI device 0000:01:00.0 is in use by vDPA devices and cannot be bound to vfio-pci"))
+		})
+
+		It("should consider a device found bound to vfio-pci as allocated", func() {
+			dpi = NewPCIDevicePlugin([]*PCIDevice{
+				{pciID: fakeID, pciAddress: gpuAddress, iommuGroup: gpuIommuGroup, driver: vfioDriver, managedDriver: true},
+			}, fakeName, iommuGroupPassthroughEnabled, usage)
+			Expect(dpi.boundDevices).To(HaveKey(gpuIommuGroup))
+			Expect(dpi.isReleasedManagedDevice(gpuIommuGroup)).To(BeFalse())
+		})
+
+		It("should expect the VFIO group of an unbound device to be missing", func() {
+			Expect(dpi.isReleasedManagedDevice(gpuIommuGroup)).To(BeTrue())
+		})
+
+		Context("once allocated", func() {
+			var allocated time.Time
+
+			BeforeEach(func() {
+				mockPCI.EXPECT().HasVDPADevices(gpuAddress).Return(false, nil)
+				mockPCI.EXPECT().BindDeviceDriver(gpuAddress, vfioDriver).Return(nil)
+				mockPCI.EXPECT().GetIOMMUGroupDevices(gpuIommuGroup).Return([]string{gpuAddress}, nil)
+				_, err := allocate()
+				Expect(err).ToNot(HaveOccurred())
+				allocated = dpi.boundDevices[gpuIommuGroup]
+			})
+
+			It("should keep the device bound until its VMI reports its pod", func() {
+				mockPCI.EXPECT().BindDeviceDriver(gomock.Any(), gomock.Any()).Times(0)
+
+				dpi.releaseUnusedDevices(allocated.Add(managedDriverAllocationSettlePeriod / 2))
+				Expect(dpi.boundDevices).To(HaveKey(gpuIommuGroup))
+				Expect(dpi.isReleasedManagedDevice(gpuIommuGroup)).To(BeFalse())
+			})
+
+			It("should keep the device bound while used by a domain", func() {
+				mockPCI.EXPECT().BindDeviceDriver(gomock.Any(), gomock.Any()).Times(0)
+				usage.inUse[gpuAddress] = struct{}{}
+
+				dpi.releaseUnusedDevices(allocated.Add(managedDriverAllocationSettlePeriod))
+				Expect(dpi.boundDevices).To(HaveKey(gpuIommuGroup))
+			})
+
+			It("should keep the device bound while a VMI on the node has no domain yet", func() {
+				mockPCI.EXPECT().BindDeviceDriver(gomock.Any(), gomock.Any()).Times(0)
+				usage.known = false
+
+				dpi.releaseUnusedDevices(allocated.Add(managedDriverAllocationSettlePeriod))
+				Expect(dpi.boundDevices).To(HaveKey(gpuIommuGroup))
+			})
+
+			It("should return the unused device to its host driver", func() {
+				mockPCI.EXPECT().BindDeviceDriver(gpuAddress, "").Return(nil)
+
+				dpi.releaseUnusedDevices(allocated.Add(managedDriverAllocationSettlePeriod))
+				Expect(dpi.boundDevices).To(BeEmpty())
+				Expect(dpi.isReleasedManagedDevice(gpuIommuGroup)).To(BeTrue())
+			})
+
+			It("should retry returning the device when it fails", func() {
+				mockPCI.EXPECT().BindDeviceDriver(gpuAddress, "").Return(errors.New("unbind failure"))
+
+				dpi.releaseUnusedDevices(allocated.Add(managedDriverAllocationSettlePeriod))
+				Expect(dpi.boundDevices).To(HaveKey(gpuIommuGroup))
+			})
+		})
+	})
+})
+
+type fakePCIDevicesUsage struct {
+	inUse map[string]struct{}
+	known bool
+}
+
+func (u *fakePCIDevicesUsage) PCIAddressesInUse() (map[string]struct{}, bool) {
+	return u.inUse, u.known
+}
+
+var _ = Describe("PCI devices sharing an IOMMU group", func() {
+	It("should be advertised by a single resource", func() {
+		gpu := &PCIDevice{pciAddress: "0000:01:00.0", iommuGroup: "1"}
+		audio := &PCIDevice{pciAddress: "0000:01:00.1", iommuGroup: "1"}
+		nic := &PCIDevice{pciAddress: "0000:02:00.0", iommuGroup: "2"}
+		pciDevicesMap := map[string][]*PCIDevice{
+			"example.org/gpu":   {gpu, nic},
+			"example.org/audio": {audio},
+		}
+
+		dropSharedIOMMUGroups(pciDevicesMap)
+		Expect(pciDevicesMap).To(Equal(map[string][]*PCIDevice{
+			"example.org/audio": {audio},
+			"example.org/gpu":   {nic},
+		}))
+	})
+
+	It("should drop a resource left without devices", func() {
+		gpu := &PCIDevice{pciAddress: "0000:01:00.0", iommuGroup: "1"}
+		audio := &PCIDevice{pciAddress: "0000:01:00.1", iommuGroup: "1"}
+		pciDevicesMap := map[string][]*PCIDevice{
+			"example.org/audio": {audio},
+			"example.org/gpu":   {gpu},
+		}
+
+		dropSharedIOMMUGroups(pciDevicesMap)
+		Expect(pciDevicesMap).To(HaveLen(1))
+		Expect(pciDevicesMap).To(HaveKey("example.org/audio"))
+	})
+})
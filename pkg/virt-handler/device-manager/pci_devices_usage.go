@@ -0,0 +1,89 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package device_manager
+
+import (
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/util/hardware"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// VMIPCIDevicesUsage tracks the PCI devices used by the VMIs on a node through the host devices of their domains.
+// The pods of migration targets are accounted too, as they are listed among the active pods of the VMI.
+type VMIPCIDevicesUsage struct {
+	host        string
+	vmiStore    cache.Store
+	domainStore cache.Store
+}
+
+func NewVMIPCIDevicesUsage(host string, vmiStore cache.Store, domainStore cache.Store) *VMIPCIDevicesUsage {
+	return &VMIPCIDevicesUsage{
+		host:        host,
+		vmiStore:    vmiStore,
+		domainStore: domainStore,
+	}
+}
+
+// PCIAddressesInUse returns the PCI addresses of the host devices of the domains on the node.
+// The usage is not known while a VMI having a pod on the node has no domain yet.
+func (u *VMIPCIDevicesUsage) PCIAddressesInUse() (map[string]struct{}, bool) {
+	for _, obj := range u.vmiStore.List() {
+		vmi := obj.(*v1.VirtualMachineInstance)
+		if vmi.IsFinal() || !u.hasPodOnNode(vmi) {
+			continue
+		}
+		key, err := cache.MetaNamespaceKeyFunc(vmi)
+		if err != nil {
+			return nil, false
+		}
+		if _, exists, err := u.domainStore.GetByKey(key); err != nil || !exists {
+			log.Log.Object(vmi).V(4).Info("the VMI has no domain yet, its PCI devices are unknown")
+			return nil, false
+		}
+	}
+
+	inUse := make(map[string]struct{})
+	for _, obj := range u.domainStore.List() {
+		domain := obj.(*api.Domain)
+		for _, hostDevice := range domain.Spec.Devices.HostDevices {
+			if hostDevice.Type != api.HostDevicePCI || hostDevice.Source.Address == nil {
+				continue
+			}
+			inUse[hardware.PCIAddressToString(hostDevice.Source.Address)] = struct{}{}
+		}
+	}
+	return inUse, true
+}
+
+func (u *VMIPCIDevicesUsage) hasPodOnNode(vmi *v1.VirtualMachineInstance) bool {
+	if vmi.Status.NodeName == u.host {
+		return true
+	}
+	for _, nodeName := range vmi.Status.ActivePods {
+		if nodeName == u.host {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package device_manager
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+var _ = Describe("VMI PCI devices usage", func() {
+	const host = "node01"
+
+	var vmiStore, domainStore cache.Store
+	var usage *VMIPCIDevicesUsage
+
+	newVMI := func(name string, phase v1.VirtualMachineInstancePhase, nodeName string, activePods map[string]string) *v1.VirtualMachineInstance {
+		vmi := &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Namespace: k8sv1.NamespaceDefault, Name: name},
+			Status:     v1.VirtualMachineInstanceStatus{Phase: phase, NodeName: nodeName},
+		}
+		for podUID, podNode := range activePods {
+			if vmi.Status.ActivePods == nil {
+				vmi.Status.ActivePods = map[types.UID]string{}
+			}
+			vmi.Status.ActivePods[types.UID(podUID)] = podNode
+		}
+		return vmi
+	}
+
+	newDomain := func(name string, hostDevices ...api.HostDevice) *api.Domain {
+		domain := api.NewMinimalDomainWithNS(k8sv1.NamespaceDefault, name)
+		domain.Spec.Devices.HostDevices = hostDevices
+		return domain
+	}
+
+	pciHostDevice := func(bus string) api.HostDevice {
+		return api.HostDevice{
+			Type: api.HostDevicePCI,
+			Source: api.HostDeviceSource{
+				Address: &api.Address{Type: api.AddressPCI, Domain: "0x0000", Bus: bus, Slot: "0x00", Function: "0x0"},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		vmiStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+		domainStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+		usage = NewVMIPCIDevicesUsage(host, vmiStore, domainStore)
+	})
+
+	It("should report the PCI host devices of the domains", func() {
+		Expect(vmiStore.Add(newVMI("vmi1", v1.Running, host, nil))).To(Succeed())
+		Expect(domainStore.Add(newDomain("vmi1", pciHostDevice("0x65"), pciHostDevice("0x66")))).To(Succeed())
+		Expect(domainStore.Add(newDomain("vmi2", api.HostDevice{Type: api.HostDeviceMDev}))).To(Succeed())
+
+		inUse, known := usage.PCIAddressesInUse()
+		Expect(known).To(BeTrue())
+		Expect(inUse).To(Equal(map[string]struct{}{"0000:65:00.0": {}, "0000:66:00.0": {}}))
+	})
+
+	It("should ignore the VMIs without pods on the node", func() {
+		Expect(vmiStore.Add(newVMI("final", v1.Succeeded, host, nil))).To(Succeed())
+		Expect(vmiStore.Add(newVMI("remote", v1.Running, "node02", map[string]string{"pod": "node02"}))).To(Succeed())
+
+		inUse, known := usage.PCIAddressesInUse()
+		Expect(known).To(BeTrue())
+		Expect(inUse).To(BeEmpty())
+	})
+
+	DescribeTable("should not know the usage while a VMI on the node has no domain", func(vmi *v1.VirtualMachineInstance) {
+		Expect(vmiStore.Add(vmi)).To(Succeed())
+
+		_, known := usage.PCIAddressesInUse()
+		Expect(known).To(BeFalse())
+	},
+		Entry("when the VMI is scheduled", newVMI("vmi1", v1.Scheduled, host, nil)),
+		Entry("when the VMI pod is not reported as scheduled yet", newVMI("vmi1", v1.Scheduling, "", map[string]string{"pod": host})),
+		Entry("when the VMI migrates to the node", newVMI("vmi1", v1.Running, "node02", map[string]string{"source": "node02", "target": host})),
+	)
+})
@@ -21,6 +21,12 @@ package virthandler
 
 import v1 "kubevirt.io/api/core/v1"
 
+// virtioNetDeviceIDs are the PCI IDs of the transitional and modern virtio-net devices
+var virtioNetDeviceIDs = map[string]bool{
+	"1af4:1000": true,
+	"1af4:1041": true,
+}
+
 var requiredGuestAgentCommands = []string{
 	"guest-ping",
 	"guest-get-time",
@@ -103,3 +109,27 @@ func sshRelatedCommandsSupported(availableCmdsMap map[string]bool) bool {
 	return guestAgentCommandSubsetSupported(sshRelatedGuestAgentCommands, availableCmdsMap) ||
 		guestAgentCommandSubsetSupported(oldSSHRelatedGuestAgentCommands, availableCmdsMap)
 }
+
+// countVirtioNetDrivers returns the number of virtio network interfaces of the VMI and the number of
+// virtio-net devices the guest reports a driver for.
+// Guests which do not report their drivers at all are considered to report all of them.
+func countVirtioNetDrivers(vmi *v1.VirtualMachineInstance) (interfaces int, drivers int) {
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		if iface.State == v1.InterfaceStateAbsent || iface.SRIOV != nil {
+			continue
+		}
+		if iface.Model == "" || iface.Model == v1.VirtIO {
+			interfaces++
+		}
+	}
+
+	if len(vmi.Status.GuestDrivers) == 0 {
+		return interfaces, interfaces
+	}
+	for _, driver := range vmi.Status.GuestDrivers {
+		if virtioNetDeviceIDs[driver.DeviceID] {
+			drivers++
+		}
+	}
+	return interfaces, drivers
+}
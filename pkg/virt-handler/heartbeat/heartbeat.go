@@ -37,6 +37,7 @@ import (
 
 	virtwait "kubevirt.io/kubevirt/pkg/apimachinery/wait"
 	virtutil "kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/util/nodes"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	device_manager "kubevirt.io/kubevirt/pkg/virt-handler/device-manager"
 )
@@ -51,6 +52,7 @@ type HeartBeat struct {
 	cpuManagerPaths           []string
 	devicePluginPollIntervall time.Duration
 	devicePluginWaitTimeout   time.Duration
+	loadSampler               *loadSampler
 }
 
 func NewHeartBeat(clientset k8scli.CoreV1Interface, deviceManager device_manager.DeviceControllerInterface, clusterConfig *virtconfig.ClusterConfig, host string) *HeartBeat {
@@ -65,6 +67,7 @@ func NewHeartBeat(clientset k8scli.CoreV1Interface, deviceManager device_manager
 		cpuManagerPaths:           []string{cpuManagerPath, cpuManagerOS3Path},
 		devicePluginPollIntervall: 1 * time.Second,
 		devicePluginWaitTimeout:   10 * time.Second,
+		loadSampler:               newLoadSampler("/proc"),
 	}
 }
 
@@ -157,11 +160,12 @@ func (h *HeartBeat) do() {
 		cpuManagerEnabled = h.isCPUManagerEnabled(h.cpuManagerPaths)
 	}
 
-	data = []byte(fmt.Sprintf(`{"metadata": { "labels": {"%s": "%s", "%s": "%t", "%s": "%t"}, "annotations": {"%s": %s}}}`,
+	data = []byte(fmt.Sprintf(`{"metadata": { "labels": {"%s": "%s", "%s": "%t", "%s": "%t"}, "annotations": {"%s": %s, %s}}}`,
 		v1.NodeSchedulable, kubevirtSchedulable,
 		v1.DeprecatedCPUManager, cpuManagerEnabled,
 		v1.CPUManager, cpuManagerEnabled,
 		v1.VirtHandlerHeartbeat, string(now),
+		h.loadAnnotations(),
 	))
 	_, err = h.clientset.Nodes().Patch(context.Background(), h.host, types.StrategicMergePatchType, data, metav1.PatchOptions{})
 	if err != nil {
@@ -180,6 +184,52 @@ func (h *HeartBeat) do() {
 	log.DefaultLogger().V(4).Infof("Heartbeat sent")
 }
 
+// loadAnnotations returns the node annotations holding the CPU load since the last heartbeat.
+// They are removed when the load is unknown, so that stale values are never acted upon.
+func (h *HeartBeat) loadAnnotations() string {
+	loadAwareRebalancing := h.clusterConfig.LoadAwareRebalancingEnabled()
+	vcpuAutoscaling := h.clusterConfig.VCPUAutoscalingEnabled()
+
+	var load *nodeLoad
+	if loadAwareRebalancing || vcpuAutoscaling {
+		var err error
+		load, err = h.loadSampler.sample()
+		if err != nil {
+			log.DefaultLogger().Reason(err).Warningf("Failed to sample the CPU load of host %s", h.host)
+		}
+	}
+
+	annotations := fmt.Sprintf(`"%s": null, "%s": null`, v1.NodeCPUUtilizationAnnotation, v1.NodeVCPUStealAnnotation)
+	if load != nil && loadAwareRebalancing {
+		annotations = fmt.Sprintf(`"%s": "%d", "%s": "%d"`,
+			v1.NodeCPUUtilizationAnnotation, load.cpuUtilization,
+			v1.NodeVCPUStealAnnotation, load.vcpuSteal,
+		)
+	}
+
+	vmiLoads := "null"
+	if load != nil && vcpuAutoscaling {
+		vmiLoads = vmiLoadsAnnotationValue(load.vmis)
+	}
+	return fmt.Sprintf(`%s, "%s": %s`, annotations, v1.NodeVMIVCPULoadAnnotation, vmiLoads)
+}
+
+// vmiLoadsAnnotationValue returns the JSON string holding the vCPU load of the VMIs of the node.
+func vmiLoadsAnnotationValue(vmis map[string]nodes.VCPULoad) string {
+	if vmis == nil {
+		vmis = map[string]nodes.VCPULoad{}
+	}
+	loads, err := json.Marshal(vmis)
+	if err != nil {
+		return "null"
+	}
+	value, err := json.Marshal(string(loads))
+	if err != nil {
+		return "null"
+	}
+	return string(value)
+}
+
 func (h *HeartBeat) isCPUManagerEnabled(cpuManagerPaths []string) bool {
 	var cpuManagerOptions map[string]interface{}
 	cpuManagerPath, err := detectCPUManagerFile(cpuManagerPaths)
@@ -21,6 +21,9 @@ package heartbeat
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"path/filepath"
 	"sync"
 	"time"
 
@@ -181,6 +184,70 @@ var _ = Describe("Heartbeat", func() {
 			"true",
 		),
 	)
+
+	Context("with LoadAwareRebalancing featuregate", func() {
+		var procPath string
+
+		writeCPUTimes := func(busy, idle uint64) {
+			Expect(os.WriteFile(filepath.Join(procPath, "stat"), []byte(fmt.Sprintf("cpu  %d 0 0 %d 0 0 0 0 0 0\n", busy, idle)), 0o644)).To(Succeed())
+		}
+
+		getAnnotations := func() map[string]string {
+			node, err := fakeClient.CoreV1().Nodes().Get(context.Background(), "mynode", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			return node.Annotations
+		}
+
+		BeforeEach(func() {
+			procPath = GinkgoT().TempDir()
+		})
+
+		It("should publish the CPU load of the node since the previous heartbeat", func() {
+			heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController(true), config(featuregate.LoadAwareRebalancing), "mynode")
+			heartbeat.loadSampler = newLoadSampler(procPath)
+
+			writeCPUTimes(100, 100)
+			heartbeat.do()
+			Expect(getAnnotations()).ToNot(HaveKey(virtv1.NodeCPUUtilizationAnnotation))
+
+			writeCPUTimes(160, 140)
+			heartbeat.do()
+			Expect(getAnnotations()).To(And(
+				HaveKeyWithValue(virtv1.NodeCPUUtilizationAnnotation, "60"),
+				HaveKeyWithValue(virtv1.NodeVCPUStealAnnotation, "0"),
+			))
+		})
+
+		It("should remove the CPU load of the node when the featuregate is disabled", func() {
+			node.Annotations = map[string]string{
+				virtv1.NodeCPUUtilizationAnnotation: "60",
+				virtv1.NodeVCPUStealAnnotation:      "0",
+			}
+			fakeClient = fake.NewSimpleClientset(node)
+			heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController(true), config(), "mynode")
+			heartbeat.loadSampler = newLoadSampler(procPath)
+
+			heartbeat.do()
+			Expect(getAnnotations()).ToNot(Or(
+				HaveKey(virtv1.NodeCPUUtilizationAnnotation),
+				HaveKey(virtv1.NodeVCPUStealAnnotation),
+			))
+		})
+
+		It("should publish the vCPU load of the VMIs with the VCPUAutoscaling featuregate", func() {
+			heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController(true), config(featuregate.VCPUAutoscaling), "mynode")
+			heartbeat.loadSampler = newLoadSampler(procPath)
+
+			writeCPUTimes(100, 100)
+			heartbeat.do()
+			Expect(getAnnotations()).ToNot(HaveKey(virtv1.NodeVMIVCPULoadAnnotation))
+
+			writeCPUTimes(160, 140)
+			heartbeat.do()
+			Expect(getAnnotations()).To(HaveKeyWithValue(virtv1.NodeVMIVCPULoadAnnotation, "{}"))
+			Expect(getAnnotations()).ToNot(HaveKey(virtv1.NodeCPUUtilizationAnnotation))
+		})
+	})
 })
 
 type fakeDeviceController struct {
@@ -0,0 +1,278 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package heartbeat
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"kubevirt.io/kubevirt/pkg/util/nodes"
+)
+
+// vcpuThreadSuffix is the suffix QEMU gives to the name of its vCPU threads, e.g. "CPU 0/KVM"
+const vcpuThreadSuffix = "/KVM"
+
+// nodeLoad is the CPU load of the node between two consecutive samples, in percent
+type nodeLoad struct {
+	cpuUtilization uint64
+	vcpuSteal      uint64
+	// vCPU load of the VMIs running on the node, keyed by namespace/name
+	vmis map[string]nodes.VCPULoad
+}
+
+type cpuTimes struct {
+	time  time.Time
+	busy  uint64
+	total uint64
+	// schedstat of the vCPU threads, keyed by pid/tid
+	vcpus map[string]schedStat
+	// namespace/name of the VMI run by each QEMU process, keyed by pid
+	vmis map[string]string
+}
+
+type vmiSchedStat struct {
+	schedStat
+	vcpus uint64
+}
+
+type schedStat struct {
+	runTime  uint64
+	runDelay uint64
+}
+
+// loadSampler computes the CPU utilization of the node from /proc/stat, and the vCPU steal
+// time from the run delay the scheduler accounts to the vCPU threads of QEMU. The latter is
+// what KVM reports to the guests as steal time.
+type loadSampler struct {
+	procPath string
+	now      func() time.Time
+	last     *cpuTimes
+}
+
+func newLoadSampler(procPath string) *loadSampler {
+	return &loadSampler{procPath: procPath, now: time.Now}
+}
+
+// sample returns the load since the previous sample, or nil on the first call and on errors.
+func (s *loadSampler) sample() (*nodeLoad, error) {
+	current, err := s.read()
+	if err != nil {
+		s.last = nil
+		return nil, err
+	}
+	last := s.last
+	s.last = current
+	if last == nil || current.total <= last.total || current.busy < last.busy {
+		return nil, nil
+	}
+
+	load := &nodeLoad{
+		cpuUtilization: 100 * (current.busy - last.busy) / (current.total - last.total),
+	}
+
+	var runTime, runDelay uint64
+	vmis := map[string]*vmiSchedStat{}
+	for thread, stat := range current.vcpus {
+		previous, exists := last.vcpus[thread]
+		if !exists || stat.runTime < previous.runTime || stat.runDelay < previous.runDelay {
+			continue
+		}
+		runTime += stat.runTime - previous.runTime
+		runDelay += stat.runDelay - previous.runDelay
+
+		vmi := current.vmis[strings.Split(thread, "/")[0]]
+		if vmi == "" {
+			continue
+		}
+		if vmis[vmi] == nil {
+			vmis[vmi] = &vmiSchedStat{}
+		}
+		vmis[vmi].runTime += stat.runTime - previous.runTime
+		vmis[vmi].runDelay += stat.runDelay - previous.runDelay
+		vmis[vmi].vcpus++
+	}
+	if runTime+runDelay > 0 {
+		load.vcpuSteal = 100 * runDelay / (runTime + runDelay)
+	}
+
+	if elapsed := current.time.Sub(last.time); elapsed > 0 && len(vmis) > 0 {
+		load.vmis = map[string]nodes.VCPULoad{}
+		for vmi, stat := range vmis {
+			load.vmis[vmi] = stat.load(uint64(elapsed.Nanoseconds()))
+		}
+	}
+	return load, nil
+}
+
+// load returns the share of the elapsed time the vCPUs of the VMI ran, and the share of
+// their runnable time they waited for a physical CPU.
+func (s *vmiSchedStat) load(elapsed uint64) nodes.VCPULoad {
+	load := nodes.VCPULoad{
+		Utilization: uint32(min(100, 100*s.runTime/(elapsed*s.vcpus))),
+	}
+	if s.runTime+s.runDelay > 0 {
+		load.Steal = uint32(100 * s.runDelay / (s.runTime + s.runDelay))
+	}
+	return load
+}
+
+func (s *loadSampler) read() (*cpuTimes, error) {
+	times, err := readCPUTimes(filepath.Join(s.procPath, "stat"))
+	if err != nil {
+		return nil, err
+	}
+	times.time = s.now()
+	times.vcpus, times.vmis, err = s.readVCPUSchedStats()
+	if err != nil {
+		return nil, err
+	}
+	return times, nil
+}
+
+// readCPUTimes reads the aggregated CPU line of /proc/stat:
+// cpu user nice system idle iowait irq softirq steal guest guest_nice
+func readCPUTimes(path string) (*cpuTimes, error) {
+	// #nosec No risk for path injection. path is composed of static values
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 9 || fields[0] != "cpu" {
+			continue
+		}
+		times := &cpuTimes{}
+		// guest time is already accounted in user time
+		for i, field := range fields[1:9] {
+			value, err := strconv.ParseUint(field, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %s: %v", path, err)
+			}
+			times.total += value
+			// idle and iowait
+			if i != 3 && i != 4 {
+				times.busy += value
+			}
+		}
+		return times, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("no cpu line found in %s", path)
+}
+
+func (s *loadSampler) readVCPUSchedStats() (map[string]schedStat, map[string]string, error) {
+	stats := map[string]schedStat{}
+	vmis := map[string]string{}
+	taskDirs, err := filepath.Glob(filepath.Join(s.procPath, "[0-9]*", "task", "[0-9]*"))
+	if err != nil {
+		return nil, nil, err
+	}
+	qemuProcesses := map[string]bool{}
+	for _, taskDir := range taskDirs {
+		pidDir := filepath.Dir(filepath.Dir(taskDir))
+		isQEMU, checked := qemuProcesses[pidDir]
+		if !checked {
+			isQEMU = strings.HasPrefix(readComm(pidDir), "qemu")
+			qemuProcesses[pidDir] = isQEMU
+			if isQEMU {
+				if vmi := readGuestName(pidDir); vmi != "" {
+					vmis[filepath.Base(pidDir)] = vmi
+				}
+			}
+		}
+		if !isQEMU || !strings.HasSuffix(readComm(taskDir), vcpuThreadSuffix) {
+			continue
+		}
+		// processes and threads come and go, missing ones are skipped
+		stat, err := readSchedStat(filepath.Join(taskDir, "schedstat"))
+		if err != nil {
+			continue
+		}
+		stats[filepath.Base(pidDir)+"/"+filepath.Base(taskDir)] = stat
+	}
+	return stats, vmis, nil
+}
+
+// readGuestName returns the namespace/name of the VMI run by a QEMU process, from the
+// guest name of its command line, e.g. "-name guest=namespace_name,debug-threads=on".
+// Neither namespaces nor names can contain an underscore, so the first one is the separator.
+func readGuestName(dir string) string {
+	// #nosec No risk for path injection. dir is a process directory in procfs
+	cmdline, err := os.ReadFile(filepath.Join(dir, "cmdline"))
+	if err != nil {
+		return ""
+	}
+	args := strings.Split(string(cmdline), "\x00")
+	for i := 0; i < len(args)-1; i++ {
+		if args[i] != "-name" {
+			continue
+		}
+		for _, option := range strings.Split(args[i+1], ",") {
+			if guest, found := strings.CutPrefix(option, "guest="); found {
+				if namespace, name, found := strings.Cut(guest, "_"); found {
+					return namespace + "/" + name
+				}
+			}
+		}
+	}
+	return ""
+}
+
+func readComm(dir string) string {
+	// #nosec No risk for path injection. dir is a process directory in procfs
+	comm, err := os.ReadFile(filepath.Join(dir, "comm"))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(comm))
+}
+
+// readSchedStat reads the time spent on a CPU and the time spent waiting on a runqueue, in ns
+func readSchedStat(path string) (schedStat, error) {
+	// #nosec No risk for path injection. path is a thread file in procfs
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return schedStat{}, err
+	}
+	fields := strings.Fields(string(content))
+	if len(fields) < 2 {
+		return schedStat{}, fmt.Errorf("unexpected content in %s", path)
+	}
+	runTime, err := strconv.ParseUint(fields[0], 10, 64)
+	if err != nil {
+		return schedStat{}, err
+	}
+	runDelay, err := strconv.ParseUint(fields[1], 10, 64)
+	if err != nil {
+		return schedStat{}, err
+	}
+	return schedStat{runTime: runTime, runDelay: runDelay}, nil
+}
@@ -0,0 +1,153 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package heartbeat
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/util/nodes"
+)
+
+var _ = Describe("Load sampler", func() {
+	var (
+		procPath string
+		sampler  *loadSampler
+	)
+
+	writeFile := func(path, content string) {
+		Expect(os.MkdirAll(filepath.Dir(path), 0o755)).To(Succeed())
+		Expect(os.WriteFile(path, []byte(content), 0o644)).To(Succeed())
+	}
+
+	// writeCPUTimes writes /proc/stat with the given busy and idle jiffies
+	writeCPUTimes := func(busy, idle uint64) {
+		writeFile(filepath.Join(procPath, "stat"), fmt.Sprintf(
+			"cpu  %d 0 0 %d 0 0 0 0 0 0\ncpu0 %d 0 0 %d 0 0 0 0 0 0\nintr 0\n", busy, idle, busy, idle))
+	}
+
+	writeThread := func(pid, tid int, comm string, runTime, runDelay uint64) {
+		taskDir := filepath.Join(procPath, fmt.Sprint(pid), "task", fmt.Sprint(tid))
+		writeFile(filepath.Join(taskDir, "comm"), comm+"\n")
+		writeFile(filepath.Join(taskDir, "schedstat"), fmt.Sprintf("%d %d 10\n", runTime, runDelay))
+	}
+
+	writeProcess := func(pid int, comm string) {
+		writeFile(filepath.Join(procPath, fmt.Sprint(pid), "comm"), comm+"\n")
+	}
+
+	writeGuestName := func(pid int, guest string) {
+		writeFile(filepath.Join(procPath, fmt.Sprint(pid), "cmdline"),
+			"/usr/libexec/qemu-kvm\x00-name\x00guest="+guest+",debug-threads=on\x00-S\x00")
+	}
+
+	BeforeEach(func() {
+		procPath = GinkgoT().TempDir()
+		sampler = newLoadSampler(procPath)
+	})
+
+	It("should not report any load on the first sample", func() {
+		writeCPUTimes(100, 100)
+
+		Expect(sampler.sample()).To(BeNil())
+	})
+
+	It("should report the CPU utilization and vCPU steal since the previous sample", func() {
+		writeProcess(10, "qemu-kvm")
+		writeThread(10, 10, "qemu-kvm", 1000, 1000)
+		writeThread(10, 11, "CPU 0/KVM", 1000, 0)
+		writeThread(10, 12, "CPU 1/KVM", 1000, 0)
+		writeProcess(20, "virt-handler")
+		writeThread(20, 21, "fake/KVM", 1000, 0)
+		writeCPUTimes(100, 100)
+		Expect(sampler.sample()).To(BeNil())
+
+		writeThread(10, 10, "qemu-kvm", 1000, 9000)
+		writeThread(10, 11, "CPU 0/KVM", 2000, 500)
+		writeThread(10, 12, "CPU 1/KVM", 1500, 500)
+		writeThread(20, 21, "fake/KVM", 1000, 9000)
+		writeCPUTimes(190, 110)
+
+		Expect(sampler.sample()).To(Equal(&nodeLoad{cpuUtilization: 90, vcpuSteal: 40}))
+	})
+
+	It("should ignore vCPU threads which were not running at the previous sample", func() {
+		writeProcess(10, "qemu-kvm")
+		writeThread(10, 11, "CPU 0/KVM", 1000, 0)
+		writeCPUTimes(100, 100)
+		Expect(sampler.sample()).To(BeNil())
+
+		writeThread(10, 11, "CPU 0/KVM", 2000, 0)
+		writeThread(10, 12, "CPU 1/KVM", 1000, 5000)
+		writeCPUTimes(150, 150)
+
+		Expect(sampler.sample()).To(Equal(&nodeLoad{cpuUtilization: 50, vcpuSteal: 0}))
+	})
+
+	It("should report the vCPU load of the VMIs since the previous sample", func() {
+		now := time.Now()
+		sampler.now = func() time.Time { return now }
+
+		writeProcess(10, "qemu-kvm")
+		writeGuestName(10, "default_vmi-a")
+		writeThread(10, 11, "CPU 0/KVM", 0, 0)
+		writeThread(10, 12, "CPU 1/KVM", 0, 0)
+		writeProcess(20, "qemu-kvm")
+		writeGuestName(20, "other_vmi-b")
+		writeThread(20, 21, "CPU 0/KVM", 0, 0)
+		writeProcess(30, "qemu-kvm")
+		writeThread(30, 31, "CPU 0/KVM", 0, 0)
+		writeCPUTimes(100, 100)
+		Expect(sampler.sample()).To(BeNil())
+
+		now = now.Add(time.Second)
+		// 1.5s of 2s of vCPU time, 0.5s waiting
+		writeThread(10, 11, "CPU 0/KVM", 1000000000, 250000000)
+		writeThread(10, 12, "CPU 1/KVM", 500000000, 250000000)
+		// more run time than elapsed time is capped
+		writeThread(20, 21, "CPU 0/KVM", 2000000000, 0)
+		writeThread(30, 31, "CPU 0/KVM", 1000000000, 0)
+		writeCPUTimes(150, 150)
+
+		load, err := sampler.sample()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(load.vmis).To(Equal(map[string]nodes.VCPULoad{
+			"default/vmi-a": {Utilization: 75, Steal: 25},
+			"other/vmi-b":   {Utilization: 100, Steal: 0},
+		}))
+	})
+
+	It("should fail and start over when /proc/stat cannot be read", func() {
+		writeCPUTimes(100, 100)
+		Expect(sampler.sample()).To(BeNil())
+
+		Expect(os.Remove(filepath.Join(procPath, "stat"))).To(Succeed())
+		_, err := sampler.sample()
+		Expect(err).To(HaveOccurred())
+
+		writeCPUTimes(200, 200)
+		Expect(sampler.sample()).To(BeNil())
+	})
+})
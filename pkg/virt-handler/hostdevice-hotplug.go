@@ -0,0 +1,140 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	"fmt"
+	"strings"
+
+	k8sv1 "k8s.io/api/core/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-handler/cgroup"
+	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// genericHostDeviceAliasPrefix is the alias prefix virt-launcher gives to the domain host devices of generic host devices
+const genericHostDeviceAliasPrefix = "hostdevice-"
+
+// hotplugHostDevices exposes the host devices allocated to attachment pods to the virt-launcher pod, and asks
+// virt-launcher to attach the mounted host devices and detach the removed ones.
+func (c *VirtualMachineController) hotplugHostDevices(vmi *v1.VirtualMachineInstance, res isolation.IsolationResult, cgroupManager cgroup.Manager) error {
+	const errMsgPrefix = "failed to hot-plug host devices"
+
+	mounted, err := c.hostDeviceMounter.Mount(vmi, res, cgroupManager)
+	if err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+
+	needsHotplug := false
+	for i := range vmi.Status.HostDeviceStatuses {
+		status := &vmi.Status.HostDeviceStatuses[i]
+		if status.HotplugHostDevice == nil {
+			continue
+		}
+		if pciAddress, isMounted := mounted[status.Name]; isMounted && status.Phase == v1.HostDeviceAllocated {
+			status.HotplugHostDevice.PCIAddress = pciAddress
+			status.Phase = v1.HostDeviceMountedToPod
+			status.Reason = HostDeviceMountedToPodReason
+			status.Message = fmt.Sprintf("Host device %s has been mounted in virt-launcher pod", status.Name)
+			c.recorder.Event(vmi, k8sv1.EventTypeNormal, status.Reason, status.Message)
+		}
+		if status.Phase == v1.HostDeviceMountedToPod || status.Phase == v1.HostDeviceDetaching {
+			needsHotplug = true
+		}
+	}
+	if !needsHotplug {
+		return nil
+	}
+
+	client, err := c.launcherClients.GetVerifiedLauncherClient(vmi)
+	if err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+
+	c.logger.V(3).Object(vmi).Info("sending hot-plug host-devices command")
+	if err := client.HotplugHostDevices(vmi); err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+	return nil
+}
+
+// updateHostDeviceStatusesFromDomain reports the hotplugged host devices attached to the domain as ready, and the
+// ones which are gone from the domain while detaching as detached.
+func (c *VirtualMachineController) updateHostDeviceStatusesFromDomain(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	attached := domainGenericHostDevices(domain)
+	for i := range vmi.Status.HostDeviceStatuses {
+		status := &vmi.Status.HostDeviceStatuses[i]
+		if status.HotplugHostDevice == nil {
+			continue
+		}
+		_, isAttached := attached[status.Name]
+		switch {
+		case status.Phase == v1.HostDeviceMountedToPod && isAttached:
+			status.Phase = v1.HostDeviceReady
+			status.Reason = HostDeviceReadyReason
+			status.Message = fmt.Sprintf("Successfully attach hotplugged host device %s to VM", status.Name)
+		case status.Phase == v1.HostDeviceDetaching && !isAttached && domain != nil:
+			status.Phase = v1.HostDeviceDetached
+			status.Reason = HostDeviceDetachedReason
+			status.Message = fmt.Sprintf("Host device %s has been detached from VM", status.Name)
+		default:
+			continue
+		}
+		c.recorder.Event(vmi, k8sv1.EventTypeNormal, status.Reason, status.Message)
+	}
+}
+
+// detachedHostDevices returns the names of the hotplugged host devices which are being detached and are gone from the domain
+func detachedHostDevices(vmi *v1.VirtualMachineInstance, domain *api.Domain) []string {
+	if domain == nil {
+		return nil
+	}
+	attached := domainGenericHostDevices(domain)
+
+	var names []string
+	for _, status := range vmi.Status.HostDeviceStatuses {
+		if status.HotplugHostDevice == nil || status.Phase != v1.HostDeviceDetaching {
+			continue
+		}
+		if _, isAttached := attached[status.Name]; !isAttached {
+			names = append(names, status.Name)
+		}
+	}
+	return names
+}
+
+func domainGenericHostDevices(domain *api.Domain) map[string]struct{} {
+	hostDevices := make(map[string]struct{})
+	if domain == nil {
+		return hostDevices
+	}
+	for _, hostDevice := range domain.Spec.Devices.HostDevices {
+		if hostDevice.Alias == nil {
+			continue
+		}
+		if name, found := strings.CutPrefix(hostDevice.Alias.GetName(), genericHostDeviceAliasPrefix); found {
+			hostDevices[name] = struct{}{}
+		}
+	}
+	return hostDevices
+}
@@ -0,0 +1,273 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package hotplug_hostdevice
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/opencontainers/runc/libcontainer/configs"
+	"github.com/opencontainers/runc/libcontainer/devices"
+	"golang.org/x/sys/unix"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
+	"kubevirt.io/kubevirt/pkg/safepath"
+	"kubevirt.io/kubevirt/pkg/virt-handler/cgroup"
+	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
+)
+
+const (
+	vfioDir             = "vfio"
+	vfioContainerDevice = "vfio"
+	pciBasePath         = "/sys/bus/pci/devices"
+)
+
+var (
+	nodeIsolationResult = func() isolation.IsolationResult {
+		return isolation.NodeIsolationResult()
+	}
+
+	// pciAddressPath is the file the attachment pod writes the PCI address the device plugin allocated to it into
+	pciAddressPath = func(podUID types.UID, kubeletPodsDir string) (*safepath.Path, error) {
+		return safepath.JoinAndResolveWithRelativeRoot("/proc/1/root", kubeletPodsDir,
+			fmt.Sprintf("/%s/volumes/kubernetes.io~empty-dir/hotplug-hostdevices/pci-address", string(podUID)))
+	}
+
+	pciDeviceIOMMUGroup = func(pciAddress string) (string, error) {
+		groupPath, err := filepath.EvalSymlinks(filepath.Join(pciBasePath, pciAddress, "iommu_group"))
+		if err != nil {
+			return "", err
+		}
+		return filepath.Base(groupPath), nil
+	}
+
+	hostVFIODevice = func(deviceName string) (uint64, error) {
+		devicePath, err := isolation.SafeJoin(nodeIsolationResult(), "dev", vfioDir, deviceName)
+		if err != nil {
+			return 0, err
+		}
+		info, err := safepath.StatAtNoFollow(devicePath)
+		if err != nil {
+			return 0, err
+		}
+		if info.Mode()&os.ModeCharDevice == 0 {
+			return 0, fmt.Errorf("%v is not a character device", devicePath)
+		}
+		return info.Sys().(*syscall.Stat_t).Rdev, nil
+	}
+
+	mknodCommand = func(basePath *safepath.Path, deviceName string, dev uint64) error {
+		return safepath.MknodAtNoFollow(basePath, deviceName, 0666|syscall.S_IFCHR, dev)
+	}
+)
+
+// HostDeviceMounter is the interface used to give a running virt-launcher pod access to the VFIO groups of the
+// host devices allocated to hotplug attachment pods, and to revoke it once they got detached.
+type HostDeviceMounter interface {
+	// Mount exposes the VFIO group of every allocated hotplug host device to the virt-launcher pod, and returns
+	// the PCI address of every mounted host device by name.
+	Mount(vmi *v1.VirtualMachineInstance, res isolation.IsolationResult, cgroupManager cgroup.Manager) (map[string]string, error)
+	// Unmount revokes the access of the virt-launcher pod to the VFIO group of the given hotplug host devices
+	Unmount(vmi *v1.VirtualMachineInstance, hostDeviceNames []string, cgroupManager cgroup.Manager) error
+}
+
+type hostDeviceMounter struct {
+	kubeletPodsDir   string
+	ownershipManager diskutils.OwnershipManagerInterface
+}
+
+func NewHostDeviceMounter(kubeletPodsDir string) HostDeviceMounter {
+	return &hostDeviceMounter{
+		kubeletPodsDir:   kubeletPodsDir,
+		ownershipManager: diskutils.DefaultOwnershipManager,
+	}
+}
+
+func (m *hostDeviceMounter) Mount(vmi *v1.VirtualMachineInstance, res isolation.IsolationResult, cgroupManager cgroup.Manager) (map[string]string, error) {
+	mounted := make(map[string]string)
+	for _, status := range vmi.Status.HostDeviceStatuses {
+		if !isMountable(status) {
+			continue
+		}
+		pciAddress := status.HotplugHostDevice.PCIAddress
+		if pciAddress == "" {
+			var err error
+			pciAddress, err = m.readPCIAddress(status.HotplugHostDevice.AttachPodUID)
+			if errors.Is(err, os.ErrNotExist) {
+				// The attachment pod did not report its allocated device yet
+				log.Log.Object(vmi).V(3).Infof("PCI address of host device %s is not known yet", status.Name)
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+		}
+		if err := m.mountVFIOGroup(pciAddress, res, cgroupManager); err != nil {
+			return nil, fmt.Errorf("failed to mount host device %s: %v", status.Name, err)
+		}
+		mounted[status.Name] = pciAddress
+	}
+	return mounted, nil
+}
+
+func isMountable(status v1.HostDeviceStatus) bool {
+	if status.HotplugHostDevice == nil || status.HotplugHostDevice.AttachPodUID == "" {
+		return false
+	}
+	return status.Phase == v1.HostDeviceAllocated || status.Phase == v1.HostDeviceMountedToPod || status.Phase == v1.HostDeviceReady
+}
+
+func (m *hostDeviceMounter) readPCIAddress(podUID types.UID) (string, error) {
+	path, err := pciAddressPath(podUID, m.kubeletPodsDir)
+	if err != nil {
+		return "", err
+	}
+	var content []byte
+	err = path.ExecuteNoFollow(func(safePath string) error {
+		content, err = os.ReadFile(safePath)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	// The attachment pod reports every address of the resource, it only ever requests a single device
+	pciAddress, _, _ := strings.Cut(strings.TrimSpace(string(content)), ",")
+	if pciAddress == "" {
+		return "", fmt.Errorf("attachment pod %s reported no PCI address", podUID)
+	}
+	return pciAddress, nil
+}
+
+func (m *hostDeviceMounter) mountVFIOGroup(pciAddress string, res isolation.IsolationResult, cgroupManager cgroup.Manager) error {
+	group, err := pciDeviceIOMMUGroup(pciAddress)
+	if err != nil {
+		return err
+	}
+	vfioPath, err := launcherVFIOPath(res)
+	if err != nil {
+		return err
+	}
+
+	for _, deviceName := range []string{vfioContainerDevice, group} {
+		dev, err := hostVFIODevice(deviceName)
+		if err != nil {
+			return err
+		}
+		if err := updateCharDeviceRule(dev, true, cgroupManager); err != nil {
+			return err
+		}
+		devicePath, err := safepath.JoinNoFollow(vfioPath, deviceName)
+		if errors.Is(err, os.ErrNotExist) {
+			if err := mknodCommand(vfioPath, deviceName, dev); err != nil && !os.IsExist(err) {
+				return err
+			}
+			devicePath, err = safepath.JoinNoFollow(vfioPath, deviceName)
+		}
+		if err != nil {
+			return err
+		}
+		if deviceName == vfioContainerDevice {
+			if err := safepath.ChmodAtNoFollow(devicePath, 0666); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := m.ownershipManager.SetFileOwnership(devicePath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *hostDeviceMounter) Unmount(vmi *v1.VirtualMachineInstance, hostDeviceNames []string, cgroupManager cgroup.Manager) error {
+	unmounted := make(map[string]struct{}, len(hostDeviceNames))
+	for _, name := range hostDeviceNames {
+		unmounted[name] = struct{}{}
+	}
+
+	groupsInUse := make(map[string]struct{})
+	groupsToRevoke := make(map[string]struct{})
+	for _, status := range vmi.Status.HostDeviceStatuses {
+		if status.HotplugHostDevice == nil || status.HotplugHostDevice.PCIAddress == "" || status.Phase == v1.HostDeviceDetached {
+			continue
+		}
+		group, err := pciDeviceIOMMUGroup(status.HotplugHostDevice.PCIAddress)
+		if err != nil {
+			return err
+		}
+		if _, exists := unmounted[status.Name]; exists {
+			groupsToRevoke[group] = struct{}{}
+		} else {
+			groupsInUse[group] = struct{}{}
+		}
+	}
+
+	for group := range groupsToRevoke {
+		if _, inUse := groupsInUse[group]; inUse {
+			continue
+		}
+		dev, err := hostVFIODevice(group)
+		if err != nil {
+			return err
+		}
+		if err := updateCharDeviceRule(dev, false, cgroupManager); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func launcherVFIOPath(res isolation.IsolationResult) (*safepath.Path, error) {
+	devPath, err := isolation.SafeJoin(res, "dev")
+	if err != nil {
+		return nil, err
+	}
+	if err := safepath.MkdirAtNoFollow(devPath, vfioDir, 0755); err != nil && !os.IsExist(err) {
+		return nil, err
+	}
+	return safepath.JoinNoFollow(devPath, vfioDir)
+}
+
+func updateCharDeviceRule(dev uint64, allow bool, cgroupManager cgroup.Manager) error {
+	deviceRule := &devices.Rule{
+		Type:        devices.CharDevice,
+		Major:       int64(unix.Major(dev)),
+		Minor:       int64(unix.Minor(dev)),
+		Permissions: "rwm",
+		Allow:       allow,
+	}
+
+	if cgroupManager == nil {
+		return fmt.Errorf("failed to apply device rule %+v: cgroup manager is nil", *deviceRule)
+	}
+
+	if err := cgroupManager.Set(&configs.Resources{Devices: []*devices.Rule{deviceRule}}); err != nil {
+		log.Log.Errorf("cgroup %s had failed to set device rule. error: %v. rule: %+v", cgroupManager.GetCgroupVersion(), err, *deviceRule)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package hotplug_hostdevice
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/opencontainers/runc/libcontainer/configs"
+	"github.com/opencontainers/runc/libcontainer/devices"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/sys/unix"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
+	"kubevirt.io/kubevirt/pkg/safepath"
+	"kubevirt.io/kubevirt/pkg/virt-handler/cgroup"
+	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
+)
+
+var _ = Describe("Host device mounter", func() {
+	const (
+		attachPodUID = types.UID("attach-pod-uid")
+		pciAddress   = "0000:81:00.0"
+		iommuGroup   = "42"
+	)
+
+	var (
+		ctrl              *gomock.Controller
+		cgroupManagerMock *cgroup.MockManager
+		ownershipManager  *diskutils.MockOwnershipManagerInterface
+		isolationResult   *isolation.MockIsolationResult
+		podDir            string
+		launcherRoot      string
+		m                 *hostDeviceMounter
+		appliedRules      []*devices.Rule
+		vmi               *v1.VirtualMachineInstance
+	)
+
+	vfioContainerDev := unix.Mkdev(10, 196)
+	vfioGroupDev := unix.Mkdev(511, 42)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		cgroupManagerMock = cgroup.NewMockManager(ctrl)
+		cgroupManagerMock.EXPECT().GetCgroupVersion().AnyTimes()
+		appliedRules = nil
+		cgroupManagerMock.EXPECT().Set(gomock.Any()).DoAndReturn(func(r *configs.Resources) error {
+			appliedRules = append(appliedRules, r.Devices...)
+			return nil
+		}).AnyTimes()
+		ownershipManager = diskutils.NewMockOwnershipManagerInterface(ctrl)
+
+		podDir = GinkgoT().TempDir()
+		launcherRoot = GinkgoT().TempDir()
+		Expect(os.Mkdir(filepath.Join(launcherRoot, "dev"), 0755)).To(Succeed())
+		launcherRootPath, err := safepath.JoinAndResolveWithRelativeRoot(launcherRoot)
+		Expect(err).ToNot(HaveOccurred())
+		isolationResult = isolation.NewMockIsolationResult(ctrl)
+		isolationResult.EXPECT().MountRoot().Return(launcherRootPath, nil).AnyTimes()
+
+		pciAddressPath = func(podUID types.UID, _ string) (*safepath.Path, error) {
+			return safepath.JoinAndResolveWithRelativeRoot(podDir, string(podUID))
+		}
+		pciDeviceIOMMUGroup = func(_ string) (string, error) {
+			return iommuGroup, nil
+		}
+		hostVFIODevice = func(deviceName string) (uint64, error) {
+			if deviceName == vfioContainerDevice {
+				return vfioContainerDev, nil
+			}
+			return vfioGroupDev, nil
+		}
+		mknodCommand = func(basePath *safepath.Path, deviceName string, _ uint64) error {
+			return safepath.TouchAtNoFollow(basePath, deviceName, 0666)
+		}
+
+		m = &hostDeviceMounter{ownershipManager: ownershipManager}
+		vmi = &v1.VirtualMachineInstance{}
+		vmi.Status.HostDeviceStatuses = []v1.HostDeviceStatus{{
+			Name:              "dev1",
+			Phase:             v1.HostDeviceAllocated,
+			HotplugHostDevice: &v1.HotplugHostDeviceStatus{AttachPodUID: attachPodUID},
+		}}
+	})
+
+	expectRule := func(dev uint64, allow bool) *devices.Rule {
+		return &devices.Rule{
+			Type:        devices.CharDevice,
+			Major:       int64(unix.Major(dev)),
+			Minor:       int64(unix.Minor(dev)),
+			Permissions: "rwm",
+			Allow:       allow,
+		}
+	}
+
+	It("should skip host devices whose attachment pod did not report the PCI address yet", func() {
+		Expect(m.Mount(vmi, isolationResult, cgroupManagerMock)).To(BeEmpty())
+		Expect(appliedRules).To(BeEmpty())
+	})
+
+	It("should skip host devices which were not allocated yet", func() {
+		vmi.Status.HostDeviceStatuses[0].Phase = v1.HostDevicePending
+		Expect(os.WriteFile(filepath.Join(podDir, string(attachPodUID)), []byte(pciAddress), 0644)).To(Succeed())
+		Expect(m.Mount(vmi, isolationResult, cgroupManagerMock)).To(BeEmpty())
+	})
+
+	It("should expose the VFIO group of an allocated host device to the virt-launcher pod", func() {
+		Expect(os.WriteFile(filepath.Join(podDir, string(attachPodUID)), []byte(pciAddress+",\n"), 0644)).To(Succeed())
+		ownershipManager.EXPECT().SetFileOwnership(gomock.Any()).Return(nil)
+
+		Expect(m.Mount(vmi, isolationResult, cgroupManagerMock)).To(Equal(map[string]string{"dev1": pciAddress}))
+		Expect(appliedRules).To(Equal([]*devices.Rule{expectRule(vfioContainerDev, true), expectRule(vfioGroupDev, true)}))
+		Expect(filepath.Join(launcherRoot, "dev", "vfio", vfioContainerDevice)).To(BeAnExistingFile())
+		Expect(filepath.Join(launcherRoot, "dev", "vfio", iommuGroup)).To(BeAnExistingFile())
+	})
+
+	It("should revoke the VFIO group of a detached host device", func() {
+		vmi.Status.HostDeviceStatuses[0].Phase = v1.HostDeviceDetaching
+		vmi.Status.HostDeviceStatuses[0].HotplugHostDevice.PCIAddress = pciAddress
+
+		Expect(m.Unmount(vmi, []string{"dev1"}, cgroupManagerMock)).To(Succeed())
+		Expect(appliedRules).To(Equal([]*devices.Rule{expectRule(vfioGroupDev, false)}))
+	})
+
+	It("should not revoke a VFIO group still used by another host device", func() {
+		vmi.Status.HostDeviceStatuses[0].Phase = v1.HostDeviceDetaching
+		vmi.Status.HostDeviceStatuses[0].HotplugHostDevice.PCIAddress = pciAddress
+		vmi.Status.HostDeviceStatuses = append(vmi.Status.HostDeviceStatuses, v1.HostDeviceStatus{
+			Name:              "dev2",
+			Phase:             v1.HostDeviceReady,
+			HotplugHostDevice: &v1.HotplugHostDeviceStatus{AttachPodUID: "other-pod-uid", PCIAddress: "0000:81:00.1"},
+		})
+
+		Expect(m.Unmount(vmi, []string{"dev1"}, cgroupManagerMock)).To(Succeed())
+		Expect(appliedRules).To(BeEmpty())
+	})
+})
@@ -0,0 +1,119 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	goerror "errors"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/executor"
+	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
+	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+)
+
+// interfaceHotplugAttempts counts the failed attempts to hot plug the interfaces of the VMIs into their domain.
+// A failure is counted once per step of an exponential backoff, as virt-launcher retries the hotplug on every
+// sync: a transient failure is retried for a while before the attempts are exhausted.
+type interfaceHotplugAttempts struct {
+	lock       sync.Mutex
+	newBackoff func() executor.LimitedBackoff
+	attempts   map[types.UID]map[string]*interfaceHotplugAttempt
+}
+
+type interfaceHotplugAttempt struct {
+	backoff executor.LimitedBackoff
+	failed  int32
+}
+
+func newInterfaceHotplugAttempts(newBackoff func() executor.LimitedBackoff) *interfaceHotplugAttempts {
+	return &interfaceHotplugAttempts{
+		newBackoff: newBackoff,
+		attempts:   map[types.UID]map[string]*interfaceHotplugAttempt{},
+	}
+}
+
+// failed counts a failed attempt to hot plug the interface, unless the backoff of the previous one is not over
+func (a *interfaceHotplugAttempts) failed(vmiUID types.UID, ifaceName string) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if a.attempts[vmiUID] == nil {
+		a.attempts[vmiUID] = map[string]*interfaceHotplugAttempt{}
+	}
+	attempt, exists := a.attempts[vmiUID][ifaceName]
+	if !exists {
+		attempt = &interfaceHotplugAttempt{backoff: a.newBackoff()}
+		a.attempts[vmiUID][ifaceName] = attempt
+	}
+	if !attempt.backoff.Ready() {
+		return
+	}
+	attempt.backoff.Step()
+	attempt.failed++
+}
+
+// updateStatus reports the failed attempts in the status of the interfaces. The attempts of the interfaces
+// which are plugged into the domain, or no longer requested, are forgotten.
+func (a *interfaceHotplugAttempts) updateStatus(vmi *v1.VirtualMachineInstance) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	vmiAttempts := a.attempts[vmi.UID]
+	ifacesByName := netvmispec.IndexInterfaceSpecByName(vmi.Spec.Domain.Devices.Interfaces)
+	for ifaceName := range vmiAttempts {
+		iface, requested := ifacesByName[ifaceName]
+		ifaceStatus := netvmispec.LookupInterfaceStatusByName(vmi.Status.Interfaces, ifaceName)
+		pluggedIntoDomain := ifaceStatus != nil && netvmispec.ContainsInfoSource(ifaceStatus.InfoSource, netvmispec.InfoSourceDomain)
+		if !requested || iface.State == v1.InterfaceStateAbsent || pluggedIntoDomain {
+			delete(vmiAttempts, ifaceName)
+		}
+	}
+	if len(vmiAttempts) == 0 {
+		delete(a.attempts, vmi.UID)
+	}
+
+	for i := range vmi.Status.Interfaces {
+		vmi.Status.Interfaces[i].HotplugFailedAttempts = 0
+		if attempt, exists := vmiAttempts[vmi.Status.Interfaces[i].Name]; exists {
+			vmi.Status.Interfaces[i].HotplugFailedAttempts = attempt.failed
+		}
+	}
+}
+
+// forget drops the attempts counted for the interfaces of the VMI
+func (a *interfaceHotplugAttempts) forget(vmiUID types.UID) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	delete(a.attempts, vmiUID)
+}
+
+// updateInterfaceHotplugAttempts counts the failed attempt to hot plug an interface virt-launcher reported, and
+// reports the failed attempts in the status of the interfaces, from which virt-controller rolls the hotplug back.
+func (c *VirtualMachineController) updateInterfaceHotplugAttempts(vmi *v1.VirtualMachineInstance, syncError error) {
+	var hotplugErr *cmdclient.InterfaceHotplugError
+	if goerror.As(syncError, &hotplugErr) {
+		c.interfaceHotplugAttempts.failed(vmi.UID, hotplugErr.Interface)
+	}
+	c.interfaceHotplugAttempts.updateStatus(vmi)
+}
@@ -0,0 +1,115 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	clock "k8s.io/utils/clock/testing"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/executor"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+var _ = Describe("interface hotplug attempts", func() {
+	const netName = "blue"
+
+	var (
+		testsClock *clock.FakeClock
+		attempts   *interfaceHotplugAttempts
+		vmi        *v1.VirtualMachineInstance
+	)
+
+	BeforeEach(func() {
+		testsClock = clock.NewFakeClock(time.Time{})
+		attempts = newInterfaceHotplugAttempts(func() executor.LimitedBackoff {
+			backoff := executor.NewExponentialLimitedBackoffWithClock(executor.DefaultMaxStep, testsClock)
+			testsClock.Step(time.Nanosecond)
+			return backoff
+		})
+
+		vmi = libvmi.New(
+			libvmi.WithInterface(v1.Interface{Name: netName, InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}}),
+			libvmi.WithNetwork(&v1.Network{Name: netName, NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "blue-net"}}}),
+		)
+		vmi.UID = "1234"
+		vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{
+			{Name: netName, InfoSource: netvmispec.InfoSourceMultusStatus},
+		}
+	})
+
+	It("should report the first failed attempt", func() {
+		attempts.failed(vmi.UID, netName)
+		attempts.updateStatus(vmi)
+
+		Expect(vmi.Status.Interfaces[0].HotplugFailedAttempts).To(Equal(int32(1)))
+	})
+
+	It("should not count the failures before the backoff is over", func() {
+		attempts.failed(vmi.UID, netName)
+		attempts.failed(vmi.UID, netName)
+		testsClock.Step(executor.DefaultDuration)
+		attempts.failed(vmi.UID, netName)
+		attempts.updateStatus(vmi)
+
+		Expect(vmi.Status.Interfaces[0].HotplugFailedAttempts).To(Equal(int32(1)))
+	})
+
+	It("should count the failures once the backoff is over", func() {
+		attempts.failed(vmi.UID, netName)
+		testsClock.Step(executor.DefaultDuration + time.Nanosecond)
+		attempts.failed(vmi.UID, netName)
+		attempts.updateStatus(vmi)
+
+		Expect(vmi.Status.Interfaces[0].HotplugFailedAttempts).To(Equal(int32(2)))
+	})
+
+	It("should forget the attempts once the interface is plugged into the domain", func() {
+		attempts.failed(vmi.UID, netName)
+		vmi.Status.Interfaces[0].InfoSource = netvmispec.NewInfoSource(netvmispec.InfoSourceDomain, netvmispec.InfoSourceMultusStatus)
+		attempts.updateStatus(vmi)
+
+		Expect(vmi.Status.Interfaces[0].HotplugFailedAttempts).To(BeZero())
+		Expect(attempts.attempts).To(BeEmpty())
+	})
+
+	It("should forget the attempts once the interface is unplugged", func() {
+		attempts.failed(vmi.UID, netName)
+		vmi.Spec.Domain.Devices.Interfaces[0].State = v1.InterfaceStateAbsent
+		attempts.updateStatus(vmi)
+
+		Expect(vmi.Status.Interfaces[0].HotplugFailedAttempts).To(BeZero())
+		Expect(attempts.attempts).To(BeEmpty())
+	})
+
+	It("should forget the attempts of a VMI", func() {
+		attempts.failed(vmi.UID, netName)
+		attempts.forget(vmi.UID)
+		attempts.updateStatus(vmi)
+
+		Expect(vmi.Status.Interfaces[0].HotplugFailedAttempts).To(BeZero())
+	})
+})
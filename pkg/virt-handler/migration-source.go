@@ -209,6 +209,13 @@ func (c *MigrationSourceController) setMigrationProgressStatus(vmi *v1.VirtualMa
 	}
 
 	vmi.Status.MigrationState.Mode = migrationMetadata.Mode
+
+	if migrationMetadata.DiskTotal > 0 {
+		vmi.Status.MigrationState.DiskTransferProgress = &v1.MigrationDiskTransferProgress{
+			TotalBytes:     int64(migrationMetadata.DiskTotal),
+			ProcessedBytes: int64(migrationMetadata.DiskProcessed),
+		}
+	}
 }
 
 func (c *MigrationSourceController) updateStatus(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
@@ -344,7 +351,7 @@ func (c *MigrationSourceController) sync(vmi *v1.VirtualMachineInstance, domain
 	syncErr := c.processVMI(vmi, domain)
 
 	if syncErr != nil {
-		c.recorder.Event(vmi, k8sv1.EventTypeWarning, v1.SyncFailed.String(), syncErr.Error())
+		c.recorder.Event(vmi, k8sv1.EventTypeWarning, syncFailedEventReason(syncErr), syncErr.Error())
 		// `syncErr` will be propagated anyway, and it will be logged in `re-enqueueing`
 		// so there is no need to log it twice in hot path without increased verbosity.
 		c.logger.Object(vmi).Reason(syncErr).Error("Synchronizing the VirtualMachineInstance failed.")
@@ -363,7 +370,7 @@ func (c *MigrationSourceController) sync(vmi *v1.VirtualMachineInstance, domain
 	}
 
 	// update the VMI if necessary
-	if !equality.Semantic.DeepEqual(*oldStatus, vmi.Status) {
+	if !equality.Semantic.DeepEqual(*oldStatus, vmi.Status) && !c.holdBackStatusUpdate(oldStatus, vmi) {
 		key := controller.VirtualMachineInstanceKey(vmi)
 		c.vmiExpectations.SetExpectations(key, 1, 0)
 		_, err := c.clientset.VirtualMachineInstance(vmi.ObjectMeta.Namespace).Update(context.Background(), vmi, metav1.UpdateOptions{})
@@ -371,6 +378,7 @@ func (c *MigrationSourceController) sync(vmi *v1.VirtualMachineInstance, domain
 			c.vmiExpectations.SetExpectations(key, 0, 0)
 			return err
 		}
+		c.statusUpdated(vmi)
 	}
 
 	if syncErr != nil {
@@ -358,6 +358,41 @@ var _ = Describe("VirtualMachineInstance migration target", func() {
 				d.Spec.Metadata.KubeVirt.Migration.AbortStatus)))
 		})
 
+		It("should report the disk transfer progress of a storage migration", func() {
+			d := newDomainMigrationKubevirtMetadata("1234", nil, false, false, v1.MigrationPreCopy)
+			d.Spec.Metadata.KubeVirt.Migration.DiskTotal = 2048
+			d.Spec.Metadata.KubeVirt.Migration.DiskProcessed = 512
+			vmi := libvmi.New(libvmistatus.WithStatus(libvmistatus.New(
+				libvmistatus.WithMigrationState(v1.VirtualMachineInstanceMigrationState{
+					MigrationUID:      "1234",
+					SourceNode:        host,
+					TargetNodeAddress: "othernode",
+				}), libvmistatus.WithNodeName(host)),
+			))
+
+			controller.setMigrationProgressStatus(vmi, d)
+
+			Expect(vmi.Status.MigrationState.DiskTransferProgress).To(Equal(&v1.MigrationDiskTransferProgress{
+				TotalBytes:     2048,
+				ProcessedBytes: 512,
+			}))
+		})
+
+		It("should not report disk transfer progress without migrated disks", func() {
+			d := newDomainMigrationKubevirtMetadata("1234", nil, false, false, v1.MigrationPreCopy)
+			vmi := libvmi.New(libvmistatus.WithStatus(libvmistatus.New(
+				libvmistatus.WithMigrationState(v1.VirtualMachineInstanceMigrationState{
+					MigrationUID:      "1234",
+					SourceNode:        host,
+					TargetNodeAddress: "othernode",
+				}), libvmistatus.WithNodeName(host)),
+			))
+
+			controller.setMigrationProgressStatus(vmi, d)
+
+			Expect(vmi.Status.MigrationState.DiskTransferProgress).To(BeNil())
+		})
+
 		It("should send an event if the migration failed", func() {
 			d := newDomainMigrationKubevirtMetadata("1234", pointer.P(metav1.NewTime(time.Now())),
 				true, true, v1.MigrationPreCopy)
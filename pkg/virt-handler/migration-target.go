@@ -480,7 +480,7 @@ func (c *MigrationTargetController) sync(vmi *v1.VirtualMachineInstance, domain
 
 	syncErr := c.processVMI(vmi)
 	if syncErr != nil {
-		c.recorder.Event(vmi, k8sv1.EventTypeWarning, v1.SyncFailed.String(), syncErr.Error())
+		c.recorder.Event(vmi, k8sv1.EventTypeWarning, syncFailedEventReason(syncErr), syncErr.Error())
 		// `syncErr` will be propagated anyway, and it will be logged in `re-enqueueing`
 		// so there is no need to log it twice in hot path without increased verbosity.
 		c.logger.Object(vmi).Reason(syncErr).Error("Synchronizing the VirtualMachineInstance failed.")
@@ -763,6 +763,16 @@ func (c *MigrationTargetController) processVMI(vmi *v1.VirtualMachineInstance) e
 		return fmt.Errorf("failed to adjust resources on migration target: %w", err)
 	}
 
+	if vmi.Spec.Domain.Memory != nil && vmi.Spec.Domain.Memory.Swap != nil {
+		cgroupManager, err := getCgroupManager(vmi, c.host, c.hypervisorNodeInfo)
+		if err != nil {
+			return err
+		}
+		if err := c.configureMemorySwap(vmi, cgroupManager); err != nil {
+			return err
+		}
+	}
+
 	err = c.handleTargetMigrationProxy(vmi)
 	if err != nil {
 		return fmt.Errorf("failed to handle post sync migration proxy: %v", err)
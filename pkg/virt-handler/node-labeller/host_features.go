@@ -0,0 +1,111 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package nodelabeller
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	kubevirtv1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	sysfsPath = "/sys"
+
+	// paths relative to the sysfs mount
+	kvmIntelNestedPath  = "module/kvm_intel/parameters/nested"
+	kvmAMDNestedPath    = "module/kvm_amd/parameters/nested"
+	iommuGroupsPath     = "kernel/iommu_groups"
+	vhostVDPADriverPath = "bus/vdpa/drivers/vhost_vdpa"
+	hugepagesPath       = "kernel/mm/hugepages"
+
+	hugepagesDirPrefix = "hugepages-"
+	hugepagesDirSuffix = "kB"
+)
+
+// hostFeatureLabels returns the labels of the host features found under the given sysfs mount,
+// e.g. "host-feature.node.kubevirt.io/hugepages-2Mi": "true"
+func hostFeatureLabels(sysfs string) map[string]string {
+	labels := map[string]string{}
+
+	if isNestedVirtualizationEnabled(sysfs) {
+		labels[kubevirtv1.NestedVirtualizationHostFeatureLabel] = "true"
+	}
+	if hasIOMMUGroups(sysfs) {
+		labels[kubevirtv1.IOMMUHostFeatureLabel] = "true"
+	}
+	if _, err := os.Stat(filepath.Join(sysfs, vhostVDPADriverPath)); err == nil {
+		labels[kubevirtv1.VhostVDPAHostFeatureLabel] = "true"
+	}
+	for _, size := range hugepageSizes(sysfs) {
+		labels[kubevirtv1.HugepagesHostFeatureLabel+size] = "true"
+	}
+
+	return labels
+}
+
+func isNestedVirtualizationEnabled(sysfs string) bool {
+	for _, path := range []string{kvmIntelNestedPath, kvmAMDNestedPath} {
+		data, err := os.ReadFile(filepath.Join(sysfs, path))
+		if err != nil {
+			continue
+		}
+		// kvm_intel reports Y/N, kvm_amd reports 1/0
+		switch strings.TrimSpace(string(data)) {
+		case "Y", "y", "1":
+			return true
+		}
+	}
+	return false
+}
+
+// hasIOMMUGroups tells whether the IOMMU is enabled, as the kernel only creates
+// IOMMU groups when it is.
+func hasIOMMUGroups(sysfs string) bool {
+	entries, err := os.ReadDir(filepath.Join(sysfs, iommuGroupsPath))
+	return err == nil && len(entries) > 0
+}
+
+// hugepageSizes returns the hugepage sizes supported by the kernel, formatted
+// the same way as the VMI hugepages page size, e.g. 2Mi or 1Gi.
+func hugepageSizes(sysfs string) []string {
+	entries, err := os.ReadDir(filepath.Join(sysfs, hugepagesPath))
+	if err != nil {
+		return nil
+	}
+
+	var sizes []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if !strings.HasPrefix(name, hugepagesDirPrefix) || !strings.HasSuffix(name, hugepagesDirSuffix) {
+			continue
+		}
+		kiB, err := strconv.ParseInt(strings.TrimSuffix(strings.TrimPrefix(name, hugepagesDirPrefix), hugepagesDirSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		sizes = append(sizes, resource.NewQuantity(kiB*1024, resource.BinarySI).String())
+	}
+	return sizes
+}
@@ -0,0 +1,89 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package nodelabeller
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("Host feature labels", func() {
+	var sysfs string
+
+	mkdir := func(path string) {
+		Expect(os.MkdirAll(filepath.Join(sysfs, path), 0o755)).To(Succeed())
+	}
+
+	writeFile := func(path, content string) {
+		mkdir(filepath.Dir(path))
+		Expect(os.WriteFile(filepath.Join(sysfs, path), []byte(content), 0o644)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		sysfs = GinkgoT().TempDir()
+	})
+
+	It("should not report any feature on an empty sysfs", func() {
+		Expect(hostFeatureLabels(sysfs)).To(BeEmpty())
+	})
+
+	DescribeTable("should report nested virtualization", func(path, content string, expected bool) {
+		writeFile(path, content)
+		if expected {
+			Expect(hostFeatureLabels(sysfs)).To(HaveKeyWithValue(v1.NestedVirtualizationHostFeatureLabel, "true"))
+		} else {
+			Expect(hostFeatureLabels(sysfs)).ToNot(HaveKey(v1.NestedVirtualizationHostFeatureLabel))
+		}
+	},
+		Entry("when enabled in kvm_intel", kvmIntelNestedPath, "Y\n", true),
+		Entry("when enabled in kvm_amd", kvmAMDNestedPath, "1\n", true),
+		Entry("not when disabled in kvm_intel", kvmIntelNestedPath, "N\n", false),
+		Entry("not when disabled in kvm_amd", kvmAMDNestedPath, "0\n", false),
+	)
+
+	It("should report the IOMMU only when IOMMU groups exist", func() {
+		mkdir(iommuGroupsPath)
+		Expect(hostFeatureLabels(sysfs)).ToNot(HaveKey(v1.IOMMUHostFeatureLabel))
+
+		mkdir(filepath.Join(iommuGroupsPath, "0"))
+		Expect(hostFeatureLabels(sysfs)).To(HaveKeyWithValue(v1.IOMMUHostFeatureLabel, "true"))
+	})
+
+	It("should report vhost-vdpa when the driver is loaded", func() {
+		mkdir(vhostVDPADriverPath)
+		Expect(hostFeatureLabels(sysfs)).To(HaveKeyWithValue(v1.VhostVDPAHostFeatureLabel, "true"))
+	})
+
+	It("should report the supported hugepage sizes", func() {
+		mkdir(filepath.Join(hugepagesPath, "hugepages-2048kB"))
+		mkdir(filepath.Join(hugepagesPath, "hugepages-1048576kB"))
+		mkdir(filepath.Join(hugepagesPath, "unexpected"))
+
+		Expect(hostFeatureLabels(sysfs)).To(Equal(map[string]string{
+			v1.HugepagesHostFeatureLabel + "2Mi": "true",
+			v1.HugepagesHostFeatureLabel + "1Gi": "true",
+		}))
+	})
+})
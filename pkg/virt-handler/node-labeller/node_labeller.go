@@ -22,6 +22,7 @@ package nodelabeller
 import (
 	"context"
 	"fmt"
+	"maps"
 	"os/exec"
 	"runtime"
 	"strings"
@@ -61,6 +62,7 @@ var nodeLabellerLabels = []string{
 	kubevirtv1.HostModelRequiredFeaturesLabel,
 	kubevirtv1.NodeHostModelIsObsoleteLabel,
 	kubevirtv1.SupportedMachineTypeLabel,
+	kubevirtv1.HostFeatureLabel,
 }
 
 // NodeLabeller struct holds information needed to run node-labeller
@@ -77,6 +79,7 @@ type NodeLabeller struct {
 	supportedFeatures       []string
 	cpuModelVendor          string
 	volumePath              string
+	sysfsPath               string
 	domCapabilitiesFileName string
 	cpuCounter              *libvirtxml.CapsHostCPUCounter
 	supportedMachines       []libvirtxml.CapsGuestMachine
@@ -104,6 +107,7 @@ func newNodeLabeller(clusterConfig *virtconfig.ClusterConfig, nodeClient k8scli.
 			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-handler-node-labeller"},
 		),
 		volumePath:              volumePath,
+		sysfsPath:               sysfsPath,
 		domCapabilitiesFileName: "virsh_domcapabilities.xml",
 		cpuCounter:              cpuCounter,
 		supportedMachines:       supportedMachines,
@@ -309,6 +313,10 @@ func (n *NodeLabeller) prepareLabels(node *v1.Node) map[string]string {
 		newLabels[kubevirtv1.TDXLabel] = "true"
 	}
 
+	if n.clusterConfig.HostFeatureSchedulingEnabled() {
+		maps.Copy(newLabels, hostFeatureLabels(n.sysfsPath))
+	}
+
 	return newLabels
 }
 
@@ -24,10 +24,13 @@ package nodelabeller
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
 	k8sv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -40,6 +43,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 	"kubevirt.io/kubevirt/pkg/virt-handler/node-labeller/util"
 )
 
@@ -356,6 +360,41 @@ var _ = Describe("Node-labeller ", func() {
 		Expect(node.Labels).To(HaveKey("INeedToBeHere"))
 	})
 
+	DescribeTable("should label host features", func(featureGates []string, expected types.GomegaMatcher) {
+		sysfs := GinkgoT().TempDir()
+		Expect(os.MkdirAll(filepath.Join(sysfs, hugepagesPath, "hugepages-2048kB"), 0o755)).To(Succeed())
+
+		node := retrieveNode(kubeClient)
+		node.Labels[v1.IOMMUHostFeatureLabel] = "true"
+		_, err := kubeClient.CoreV1().Nodes().Update(context.Background(), node, metav1.UpdateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fakeNodeStore.Update(node)).To(Succeed())
+
+		initNodeLabeller(&v1.KubeVirt{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "kubevirt",
+				Namespace: "kubevirt",
+			},
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{
+					DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+				},
+			},
+		})
+		nlController.sysfsPath = sysfs
+
+		Expect(nlController.run()).To(Succeed())
+
+		node = retrieveNode(kubeClient)
+		Expect(node.Labels).ToNot(HaveKey(v1.IOMMUHostFeatureLabel), "stale host feature labels should be removed")
+		Expect(node.Labels).To(expected)
+	},
+		Entry("when HostFeatureScheduling is enabled", []string{featuregate.HostFeatureScheduling},
+			HaveKeyWithValue(v1.HugepagesHostFeatureLabel+"2Mi", "true")),
+		Entry("not when HostFeatureScheduling is disabled", nil,
+			Not(HaveKey(HavePrefix(v1.HostFeatureLabel)))),
+	)
+
 	DescribeTable("should add machine type labels", func(machines []libvirtxml.CapsGuestMachine, arch string) {
 		supportedMachines = machines
 
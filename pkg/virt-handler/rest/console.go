@@ -49,8 +49,10 @@ type ConsoleHandler struct {
 	podIsolationDetector isolation.PodIsolationDetector
 	serialStopChans      map[types.UID]chan struct{}
 	vncStopChans         map[types.UID]chan struct{}
+	channelStopChans     map[types.UID]chan struct{}
 	serialLock           *sync.Mutex
 	vncLock              *sync.Mutex
+	channelLock          *sync.Mutex
 	vmiStore             cache.Store
 	usbredir             map[types.UID]UsbredirHandlerVMI
 	usbredirLock         *sync.Mutex
@@ -66,8 +68,10 @@ func NewConsoleHandler(podIsolationDetector isolation.PodIsolationDetector, vmiS
 		podIsolationDetector: podIsolationDetector,
 		serialStopChans:      make(map[types.UID]chan struct{}),
 		vncStopChans:         make(map[types.UID]chan struct{}),
+		channelStopChans:     make(map[types.UID]chan struct{}),
 		serialLock:           &sync.Mutex{},
 		vncLock:              &sync.Mutex{},
+		channelLock:          &sync.Mutex{},
 		usbredirLock:         &sync.Mutex{},
 		vmiStore:             vmiStore,
 		usbredir:             make(map[types.UID]UsbredirHandlerVMI),
@@ -177,6 +181,28 @@ func (t *ConsoleHandler) VNCHandler(request *restful.Request, response *restful.
 	t.stream(vmi, request, response, unixSocketDialer(vmi, unixSocketPath), stopChn)
 }
 
+func (t *ConsoleHandler) SpiceHandler(request *restful.Request, response *restful.Response) {
+	vmi, code, err := getVMI(request, t.vmiStore)
+	if err != nil || vmi == nil {
+		log.Log.Reason(err).Error(failedRetrieveVMI)
+		response.WriteError(code, err)
+		return
+	}
+	if vmi.Spec.Domain.Devices.Spice == nil {
+		response.WriteError(http.StatusBadRequest, errors.New("VM doesn't have SPICE enabled"))
+		return
+	}
+	unixSocketPath, err := t.getUnixSocketPath(vmi, "virt-spice")
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed finding unix socket for SPICE display")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+	// SPICE clients open one connection per channel (main, display, inputs, usbredir, ...),
+	// so concurrent connections must not close each other.
+	t.stream(vmi, request, response, unixSocketDialer(vmi, unixSocketPath), make(chan struct{}))
+}
+
 func (t *ConsoleHandler) SerialHandler(request *restful.Request, response *restful.Response) {
 	vmi, code, err := getVMI(request, t.vmiStore)
 	if err != nil || vmi == nil {
@@ -196,6 +222,42 @@ func (t *ConsoleHandler) SerialHandler(request *restful.Request, response *restf
 	t.stream(vmi, request, response, unixSocketDialer(vmi, unixSocketPath), stopCh)
 }
 
+func (t *ConsoleHandler) ChannelHandler(request *restful.Request, response *restful.Response) {
+	vmi, code, err := getVMI(request, t.vmiStore)
+	if err != nil || vmi == nil {
+		log.Log.Reason(err).Error(failedRetrieveVMI)
+		response.WriteError(code, err)
+		return
+	}
+	channel := request.PathParameter("channel")
+	if !hasVirtioChannel(vmi, channel) {
+		err = fmt.Errorf("virtio channel %s is not defined on the VMI", channel)
+		log.Log.Object(vmi).Reason(err).Error("Failed finding virtio channel")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+	unixSocketPath, err := t.getUnixSocketPath(vmi, "virt-channel-"+channel)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed finding unix socket for virtio channel %s", channel)
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+	// Every channel of a VMI keeps its own single active connection
+	key := types.UID(string(vmi.GetUID()) + "/" + channel)
+	stopCh := newStopChan(key, t.channelLock, t.channelStopChans)
+	defer deleteStopChan(key, stopCh, t.channelLock, t.channelStopChans)
+	t.stream(vmi, request, response, unixSocketDialer(vmi, unixSocketPath), stopCh)
+}
+
+func hasVirtioChannel(vmi *v1.VirtualMachineInstance, name string) bool {
+	for _, channel := range vmi.Spec.Domain.Devices.VirtioChannels {
+		if channel.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 func (t *ConsoleHandler) VSOCKHandler(request *restful.Request, response *restful.Response) {
 	vmi, code, err := getVMI(request, t.vmiStore)
 	if err != nil || vmi == nil {
@@ -45,17 +45,23 @@ const (
 	failedConnectCmdClient = "Failed to connect cmd client"
 )
 
+type networkResyncer interface {
+	ResyncNetwork(vmi *v1.VirtualMachineInstance)
+}
+
 type LifecycleHandler struct {
-	recorder     record.EventRecorder
-	vmiStore     cache.Store
-	virtShareDir string
+	recorder        record.EventRecorder
+	vmiStore        cache.Store
+	virtShareDir    string
+	networkResyncer networkResyncer
 }
 
-func NewLifecycleHandler(recorder record.EventRecorder, vmiStore cache.Store, virtShareDir string) *LifecycleHandler {
+func NewLifecycleHandler(recorder record.EventRecorder, vmiStore cache.Store, virtShareDir string, networkResyncer networkResyncer) *LifecycleHandler {
 	return &LifecycleHandler{
-		recorder:     recorder,
-		vmiStore:     vmiStore,
-		virtShareDir: virtShareDir,
+		recorder:        recorder,
+		vmiStore:        vmiStore,
+		virtShareDir:    virtShareDir,
+		networkResyncer: networkResyncer,
 	}
 }
 
@@ -191,6 +197,23 @@ func (lh *LifecycleHandler) SoftRebootHandler(request *restful.Request, response
 	response.WriteHeader(http.StatusAccepted)
 }
 
+// NetworkResyncHandler triggers a new network setup round for a VMI running on this node.
+// Networks which have already been configured are left untouched; the ones which are still pending
+// (e.g. due to a transient CNI or device-plugin failure) are configured again and the missing
+// interfaces are plugged into the domain by virt-launcher.
+func (lh *LifecycleHandler) NetworkResyncHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	lh.networkResyncer.ResyncNetwork(vmi)
+
+	lh.recorder.Eventf(vmi, k8sv1.EventTypeNormal, "NetworkResync", "VirtualMachineInstance network resync requested")
+	response.WriteHeader(http.StatusAccepted)
+}
+
 func (lh *LifecycleHandler) GetGuestInfo(request *restful.Request, response *restful.Response) {
 	log.Log.Info("Retreiving guestinfo")
 	vmi, client, err := lh.getVMILauncherClient(request, response)
@@ -420,3 +443,78 @@ func (lh *LifecycleHandler) RedefineCheckpointHandler(request *restful.Request,
 
 	response.WriteHeader(http.StatusOK)
 }
+
+func (lh *LifecycleHandler) GetDomainJobs(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	log.Log.Object(vmi).Infof("Retrieving domain jobs from %s", vmi.Name)
+
+	jobList, err := client.GetDomainJobs(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to get domain jobs")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.WriteEntity(jobList)
+}
+
+func (lh *LifecycleHandler) GetQemuCommandLine(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	log.Log.Object(vmi).Infof("Retrieving the QEMU command line of %s", vmi.Name)
+
+	commandLine, err := client.GetQemuCommandLine(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to get the QEMU command line")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.WriteEntity(commandLine)
+}
+
+func (lh *LifecycleHandler) CancelDomainJobHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	if request.Request.Body == nil {
+		log.Log.Object(vmi).Error("Request with no body: domain job parameters are required")
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("failed to retrieve domain job parameters from request"))
+		return
+	}
+
+	opts := &v1.CancelDomainJobOptions{}
+	err = yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts)
+	switch err {
+	case io.EOF, nil:
+		break
+	default:
+		log.Log.Object(vmi).Reason(err).Error("Failed to decode domain job parameters")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+
+	log.Log.Object(vmi).Infof("Cancelling %s domain job %s", opts.Type, opts.Disk)
+
+	if err := client.CancelDomainJob(vmi, opts); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to cancel domain job")
+		response.WriteError(http.StatusInternalServerError, err)
+		lh.recorder.Eventf(vmi, k8sv1.EventTypeWarning, "CancelDomainJobError", "%s: %s", "Failed to cancel domain job", err.Error())
+		return
+	}
+
+	lh.recorder.Eventf(vmi, k8sv1.EventTypeNormal, "DomainJobCancelled", "Cancelled %s domain job %s", opts.Type, opts.Disk)
+	response.WriteHeader(http.StatusAccepted)
+}
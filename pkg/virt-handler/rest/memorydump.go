@@ -0,0 +1,238 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/safepath"
+	"kubevirt.io/kubevirt/pkg/util"
+	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const (
+	// memoryDumpStreamFilePrefix distinguishes dumps written to the virt-launcher
+	// private directory from dumps written to a memory dump PVC
+	memoryDumpStreamFilePrefix = "memorydump-stream-"
+	memoryDumpStreamRetryAfter = 5 * time.Second
+	memoryDumpStartTimeout     = 10 * time.Second
+)
+
+// MemoryDumpStartHandler dumps the guest memory into the private directory of the
+// virt-launcher pod, from where it can be downloaded with MemoryDumpDownloadHandler.
+func (lh *LifecycleHandler) MemoryDumpStartHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	memoryDump, err := getMemoryDumpMetadata(client)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to get memory dump state")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	if memoryDump != nil && !memoryDump.Completed {
+		response.WriteError(http.StatusConflict, fmt.Errorf("memory dump %s is still in progress", memoryDump.FileName))
+		return
+	}
+
+	fileName := memoryDumpStreamFileName(time.Now())
+	log.Log.Object(vmi).Infof("Requesting memory dump %s", fileName)
+	if err := client.VirtualMachineMemoryDump(vmi, filepath.Join(util.VirtPrivateDir, fileName)); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to request memory dump")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	// The dump is taken asynchronously, wait until it is reported to not serve
+	// a previous dump to a download request following right after this one.
+	err = wait.PollUntilContextTimeout(request.Request.Context(), time.Second/2, memoryDumpStartTimeout, true, func(_ context.Context) (bool, error) {
+		memoryDump, err := getMemoryDumpMetadata(client)
+		if err != nil {
+			return false, err
+		}
+		return memoryDump != nil && memoryDump.FileName == fileName, nil
+	})
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Memory dump did not start")
+		response.WriteError(http.StatusInternalServerError, fmt.Errorf("memory dump %s did not start: %v", fileName, err))
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+// MemoryDumpDownloadHandler streams the last memory dump taken by MemoryDumpStartHandler.
+// Range requests are supported to allow resuming interrupted downloads.
+func (lh *LifecycleHandler) MemoryDumpDownloadHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	memoryDump, code, err := getStreamMemoryDump(client)
+	if err != nil {
+		response.WriteError(code, err)
+		return
+	}
+	if !memoryDump.Completed {
+		response.AddHeader("Retry-After", strconv.Itoa(int(memoryDumpStreamRetryAfter.Seconds())))
+		response.WriteError(http.StatusConflict, fmt.Errorf("memory dump %s is still in progress", memoryDump.FileName))
+		return
+	}
+	if memoryDump.Failed {
+		response.WriteError(http.StatusInternalServerError, fmt.Errorf("memory dump %s failed: %s", memoryDump.FileName, memoryDump.FailureReason))
+		return
+	}
+
+	dumpPath, err := memoryDumpStreamPath(vmi, memoryDump.FileName)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to find memory dump")
+		response.WriteError(http.StatusNotFound, err)
+		return
+	}
+	safeFile, err := safepath.OpenAtNoFollow(dumpPath)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to open memory dump")
+		response.WriteError(http.StatusNotFound, err)
+		return
+	}
+	defer safeFile.Close()
+
+	file, err := os.Open(safeFile.SafePath())
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to open memory dump")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	if !info.Mode().IsRegular() {
+		response.WriteError(http.StatusInternalServerError, fmt.Errorf("memory dump %s is not a regular file", memoryDump.FileName))
+		return
+	}
+
+	log.Log.Object(vmi).Infof("Streaming memory dump %s", memoryDump.FileName)
+	response.AddHeader("Content-Type", "application/octet-stream")
+	response.AddHeader("Content-Disposition", fmt.Sprintf("attachment; filename=%q", memoryDump.FileName))
+	http.ServeContent(response.ResponseWriter, request.Request, memoryDump.FileName, info.ModTime(), file)
+}
+
+// MemoryDumpDeleteHandler removes the last memory dump taken by MemoryDumpStartHandler
+// to release the ephemeral storage of the virt-launcher pod.
+func (lh *LifecycleHandler) MemoryDumpDeleteHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	memoryDump, code, err := getStreamMemoryDump(client)
+	if err != nil {
+		response.WriteError(code, err)
+		return
+	}
+	if !memoryDump.Completed {
+		response.WriteError(http.StatusConflict, fmt.Errorf("memory dump %s is still in progress", memoryDump.FileName))
+		return
+	}
+
+	dumpPath, err := memoryDumpStreamPath(vmi, memoryDump.FileName)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			response.WriteHeader(http.StatusOK)
+			return
+		}
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	if err := safepath.UnlinkAtNoFollow(dumpPath); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to remove memory dump")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	log.Log.Object(vmi).Infof("Removed memory dump %s", memoryDump.FileName)
+	response.WriteHeader(http.StatusOK)
+}
+
+func memoryDumpStreamFileName(now time.Time) string {
+	return fmt.Sprintf("%s%s.memory.dump", memoryDumpStreamFilePrefix, now.UTC().Format("20060102-150405"))
+}
+
+func getMemoryDumpMetadata(client cmdclient.LauncherClient) (*api.MemoryDumpMetadata, error) {
+	domain, exists, err := client.GetDomain()
+	if err != nil {
+		return nil, err
+	}
+	if !exists || domain.Spec.Metadata.KubeVirt.MemoryDump == nil {
+		return nil, nil
+	}
+	return domain.Spec.Metadata.KubeVirt.MemoryDump, nil
+}
+
+// getStreamMemoryDump returns the state of the last memory dump if it was
+// taken by MemoryDumpStartHandler
+func getStreamMemoryDump(client cmdclient.LauncherClient) (*api.MemoryDumpMetadata, int, error) {
+	memoryDump, err := getMemoryDumpMetadata(client)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	if memoryDump == nil || !strings.HasPrefix(memoryDump.FileName, memoryDumpStreamFilePrefix) {
+		return nil, http.StatusNotFound, fmt.Errorf("no memory dump was requested")
+	}
+	return memoryDump, 0, nil
+}
+
+func memoryDumpStreamPath(vmi *v1.VirtualMachineInstance, fileName string) (*safepath.Path, error) {
+	privateDir, err := cmdclient.FindPodDirOnHost(vmi, cmdclient.PrivateDirectoryOnHost)
+	if err != nil {
+		return nil, err
+	}
+	privateDirPath, err := safepath.NewPathNoFollow(privateDir)
+	if err != nil {
+		return nil, err
+	}
+	return safepath.JoinNoFollow(privateDirPath, fileName)
+}
@@ -0,0 +1,125 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/cache"
+	"k8s.io/utils/clock"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// statusDebouncer holds back the writes of the VMI status carrying only changes of frequently
+// changing, informational fields, like the interfaces reported by the guest agent, so that
+// they are aggregated into fewer writes.
+// It remembers when the status of every VMI was last written, for as long as the longest
+// configured interval.
+type statusDebouncer struct {
+	clock       clock.Clock
+	lastUpdates *cache.Expiring
+}
+
+func newStatusDebouncer(clock clock.Clock) *statusDebouncer {
+	return &statusDebouncer{
+		clock:       clock,
+		lastUpdates: cache.NewExpiringWithClock(clock),
+	}
+}
+
+// delay returns how long the write of the new status should be held back, zero meaning it is due.
+// The write is due as soon as a field without interval changed, or the interval of one of the
+// changed fields elapsed since the last write.
+func (d *statusDebouncer) delay(config *v1.VMIStatusUpdatesConfiguration, uid types.UID, oldStatus, newStatus *v1.VirtualMachineInstanceStatus) time.Duration {
+	if config == nil {
+		return 0
+	}
+	lastUpdate, exists := d.lastUpdates.Get(uid)
+	if !exists {
+		return 0
+	}
+
+	oldStatus = oldStatus.DeepCopy()
+	newStatus = newStatus.DeepCopy()
+	var intervals []time.Duration
+	if !equality.Semantic.DeepEqual(oldStatus.Interfaces, newStatus.Interfaces) {
+		intervals = append(intervals, durationOf(config.InterfacesInterval))
+		oldStatus.Interfaces, newStatus.Interfaces = nil, nil
+	}
+	if !equality.Semantic.DeepEqual(oldStatus.GuestOSInfo, newStatus.GuestOSInfo) {
+		intervals = append(intervals, durationOf(config.GuestAgentInfoInterval))
+		oldStatus.GuestOSInfo, newStatus.GuestOSInfo = v1.VirtualMachineInstanceGuestOSInfo{}, v1.VirtualMachineInstanceGuestOSInfo{}
+	}
+	if !equality.Semantic.DeepEqual(migrationProgressOf(oldStatus), migrationProgressOf(newStatus)) {
+		intervals = append(intervals, durationOf(config.MigrationProgressInterval))
+		clearMigrationProgress(oldStatus)
+		clearMigrationProgress(newStatus)
+	}
+	if len(intervals) == 0 || !equality.Semantic.DeepEqual(*oldStatus, *newStatus) {
+		return 0
+	}
+
+	elapsed := d.clock.Since(lastUpdate.(time.Time))
+	delay := intervals[0] - elapsed
+	for _, interval := range intervals[1:] {
+		delay = min(delay, interval-elapsed)
+	}
+	return max(delay, 0)
+}
+
+// updated records that the status of the VMI was just written
+func (d *statusDebouncer) updated(config *v1.VMIStatusUpdatesConfiguration, uid types.UID) {
+	if config == nil {
+		return
+	}
+	ttl := max(
+		durationOf(config.InterfacesInterval),
+		durationOf(config.GuestAgentInfoInterval),
+		durationOf(config.MigrationProgressInterval),
+	)
+	if ttl <= 0 {
+		return
+	}
+	d.lastUpdates.Set(uid, d.clock.Now(), ttl)
+}
+
+func durationOf(d *metav1.Duration) time.Duration {
+	if d == nil {
+		return 0
+	}
+	return d.Duration
+}
+
+func migrationProgressOf(status *v1.VirtualMachineInstanceStatus) *v1.MigrationDiskTransferProgress {
+	if status.MigrationState == nil {
+		return nil
+	}
+	return status.MigrationState.DiskTransferProgress
+}
+
+func clearMigrationProgress(status *v1.VirtualMachineInstanceStatus) {
+	if status.MigrationState != nil {
+		status.MigrationState.DiskTransferProgress = nil
+	}
+}
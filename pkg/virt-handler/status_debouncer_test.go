@@ -0,0 +1,112 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("VMI status debouncer", func() {
+	const uid = types.UID("c4ab4ae0-db63-45d8-aa0f-fc53dc84bdab")
+
+	var (
+		clock     *clocktesting.FakeClock
+		debouncer *statusDebouncer
+		config    *v1.VMIStatusUpdatesConfiguration
+		oldStatus *v1.VirtualMachineInstanceStatus
+	)
+
+	BeforeEach(func() {
+		clock = clocktesting.NewFakeClock(time.Now())
+		debouncer = newStatusDebouncer(clock)
+		config = &v1.VMIStatusUpdatesConfiguration{
+			InterfacesInterval:        &metav1.Duration{Duration: 10 * time.Second},
+			GuestAgentInfoInterval:    &metav1.Duration{Duration: 30 * time.Second},
+			MigrationProgressInterval: &metav1.Duration{Duration: 5 * time.Second},
+		}
+		oldStatus = &v1.VirtualMachineInstanceStatus{
+			Phase:          v1.Running,
+			Interfaces:     []v1.VirtualMachineInstanceNetworkInterface{{Name: "default", IP: "10.0.0.1"}},
+			MigrationState: &v1.VirtualMachineInstanceMigrationState{},
+		}
+	})
+
+	withInterfaceIP := func(status *v1.VirtualMachineInstanceStatus, ip string) *v1.VirtualMachineInstanceStatus {
+		status = status.DeepCopy()
+		status.Interfaces[0].IP = ip
+		return status
+	}
+
+	It("should not hold back the first update of the status", func() {
+		Expect(debouncer.delay(config, uid, oldStatus, withInterfaceIP(oldStatus, "10.0.0.2"))).To(BeZero())
+	})
+
+	It("should not hold back updates when not configured", func() {
+		debouncer.updated(nil, uid)
+		Expect(debouncer.delay(nil, uid, oldStatus, withInterfaceIP(oldStatus, "10.0.0.2"))).To(BeZero())
+	})
+
+	It("should hold back interface changes until the interval elapsed since the last update", func() {
+		debouncer.updated(config, uid)
+		clock.Step(4 * time.Second)
+		Expect(debouncer.delay(config, uid, oldStatus, withInterfaceIP(oldStatus, "10.0.0.2"))).To(Equal(6 * time.Second))
+
+		clock.Step(6 * time.Second)
+		Expect(debouncer.delay(config, uid, oldStatus, withInterfaceIP(oldStatus, "10.0.0.2"))).To(BeZero())
+	})
+
+	It("should not hold back changes of other fields", func() {
+		debouncer.updated(config, uid)
+		newStatus := withInterfaceIP(oldStatus, "10.0.0.2")
+		newStatus.Phase = v1.Succeeded
+		Expect(debouncer.delay(config, uid, oldStatus, newStatus)).To(BeZero())
+	})
+
+	It("should write the aggregated changes once the shortest interval elapsed", func() {
+		debouncer.updated(config, uid)
+		newStatus := withInterfaceIP(oldStatus, "10.0.0.2")
+		newStatus.GuestOSInfo.Name = "Fedora"
+		newStatus.MigrationState.DiskTransferProgress = &v1.MigrationDiskTransferProgress{TotalBytes: 100, ProcessedBytes: 10}
+		Expect(debouncer.delay(config, uid, oldStatus, newStatus)).To(Equal(5 * time.Second))
+	})
+
+	It("should not hold back the changes of a field without interval", func() {
+		config.GuestAgentInfoInterval = nil
+		debouncer.updated(config, uid)
+		newStatus := withInterfaceIP(oldStatus, "10.0.0.2")
+		newStatus.GuestOSInfo.Name = "Fedora"
+		Expect(debouncer.delay(config, uid, oldStatus, newStatus)).To(BeZero())
+	})
+
+	It("should forget the last update once the longest interval elapsed", func() {
+		debouncer.updated(config, uid)
+		clock.Step(31 * time.Second)
+		_, exists := debouncer.lastUpdates.Get(uid)
+		Expect(exists).To(BeFalse())
+	})
+})
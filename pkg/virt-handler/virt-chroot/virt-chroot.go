@@ -113,6 +113,13 @@ func RemoveMDEVType(mdevUUID string) *exec.Cmd {
 	return exec.Command(binaryPath, args...)
 }
 
+// BindPCIDriver binds a PCI device to the given driver, or to its default host driver when empty
+func BindPCIDriver(pciAddress string, driver string) *exec.Cmd {
+	args := append(getBaseArgs(), "bind-pci-driver")
+	args = append(args, "--address", pciAddress, "--driver", driver)
+	return exec.Command(binaryPath, args...)
+}
+
 func trimProcPrefix(path *safepath.Path) string {
 	return strings.TrimPrefix(unsafepath.UnsafeAbsolute(path.Raw()), "/proc/1/root")
 }
@@ -56,8 +56,10 @@ import (
 	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
 	hotplugdisk "kubevirt.io/kubevirt/pkg/hotplug-disk"
 	"kubevirt.io/kubevirt/pkg/hypervisor"
+	"kubevirt.io/kubevirt/pkg/network/bindingplugin/errcode"
 	"kubevirt.io/kubevirt/pkg/network/domainspec"
 	neterrors "kubevirt.io/kubevirt/pkg/network/errors"
+	"kubevirt.io/kubevirt/pkg/network/ifaceevents"
 	netsetup "kubevirt.io/kubevirt/pkg/network/setup"
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
 	"kubevirt.io/kubevirt/pkg/safepath"
@@ -75,6 +77,7 @@ import (
 	deviceManager "kubevirt.io/kubevirt/pkg/virt-handler/device-manager"
 	"kubevirt.io/kubevirt/pkg/virt-handler/heartbeat"
 	hotplugvolume "kubevirt.io/kubevirt/pkg/virt-handler/hotplug-disk"
+	hotplughostdevice "kubevirt.io/kubevirt/pkg/virt-handler/hotplug-hostdevice"
 	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
 	launcherclients "kubevirt.io/kubevirt/pkg/virt-handler/launcher-clients"
 	migrationproxy "kubevirt.io/kubevirt/pkg/virt-handler/migration-proxy"
@@ -88,6 +91,14 @@ type netstat interface {
 	Teardown(vmi *v1.VirtualMachineInstance)
 }
 
+type interfaceEventsNotifier interface {
+	Run(workers int, stopCh <-chan struct{})
+	Notify(url string, events []ifaceevents.Event)
+}
+
+// interfaceEventsWorkers is the number of concurrent deliveries to the interface events webhook
+const interfaceEventsWorkers = 4
+
 type downwardMetricsManager interface {
 	Run(stopCh chan struct{})
 	StartServer(vmi *v1.VirtualMachineInstance, pid int) error
@@ -101,6 +112,7 @@ type VirtualMachineController struct {
 	containerDiskMounter     containerdisk.Mounter
 	downwardMetricsManager   downwardMetricsManager
 	hotplugVolumeMounter     hotplugvolume.VolumeMounter
+	hostDeviceMounter        hotplughostdevice.HostDeviceMounter
 	hostCpuModel             string
 	ioErrorRetryManager      *FailRetryManager
 	deviceManagerController  *deviceManager.DeviceController
@@ -108,10 +120,12 @@ type VirtualMachineController struct {
 	heartBeatInterval        time.Duration
 	netConf                  netconf
 	sriovHotplugExecutorPool *executor.RateLimitedExecutorPool
+	interfaceHotplugAttempts *interfaceHotplugAttempts
 	vmiExpectations          *controller.UIDTrackingControllerExpectations
 	vmiGlobalStore           cache.Store
 	multipathSocketMonitor   *multipathmonitor.MultipathSocketMonitor
 	cbtHandler               *CBTHandler
+	interfaceEventsNotifier  interfaceEventsNotifier
 }
 
 var getCgroupManager = func(vmi *v1.VirtualMachineInstance, host string, hypervisorNodeInfo hypervisor.HypervisorNodeInformation) (cgroup.Manager, error) {
@@ -180,6 +194,7 @@ func NewVirtualMachineController(
 		return nil, err
 	}
 
+	interfaceHotplugBackoffCreator := executor.NewExponentialLimitedBackoffCreator()
 	c := &VirtualMachineController{
 		BaseController:           baseCtrl,
 		capabilities:             capabilities,
@@ -187,15 +202,18 @@ func NewVirtualMachineController(
 		containerDiskMounter:     containerdisk.NewMounter(podIsolationDetector, containerDiskState, clusterConfig),
 		downwardMetricsManager:   downwardMetricsManager,
 		hotplugVolumeMounter:     hotplugvolume.NewVolumeMounter(hotplugState, kubeletPodsDir, host),
+		hostDeviceMounter:        hotplughostdevice.NewHostDeviceMounter(kubeletPodsDir),
 		hostCpuModel:             hostCpuModel,
 		ioErrorRetryManager:      NewFailRetryManager("io-error-retry", 10*time.Second, 3*time.Minute, 30*time.Second),
 		heartBeatInterval:        1 * time.Minute,
 		netConf:                  netConf,
 		sriovHotplugExecutorPool: executor.NewRateLimitedExecutorPool(executor.NewExponentialLimitedBackoffCreator()),
+		interfaceHotplugAttempts: newInterfaceHotplugAttempts(interfaceHotplugBackoffCreator.New),
 		vmiExpectations:          controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
 		vmiGlobalStore:           vmiGlobalStore,
 		multipathSocketMonitor:   multipathmonitor.NewMultipathSocketMonitor(),
 		cbtHandler:               cbtHandler,
+		interfaceEventsNotifier:  ifaceevents.NewWebhookNotifier(),
 	}
 
 	_, err = vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -234,7 +252,8 @@ func NewVirtualMachineController(
 		permissions,
 		deviceManager.PermanentHostDevicePlugins(c.hypervisorNodeInfo.GetHypervisorDevice(), maxDevices, permissions),
 		clusterConfig,
-		nodeStore)
+		nodeStore,
+		deviceManager.NewVMIPCIDevicesUsage(c.host, vmiGlobalStore, domainInformer.GetStore()))
 	c.heartBeat = heartbeat.NewHeartBeat(clientset.CoreV1(), c.deviceManagerController, clusterConfig, host)
 
 	return c, nil
@@ -248,6 +267,8 @@ func (c *VirtualMachineController) Run(threadiness int, stopCh chan struct{}) {
 
 	go c.downwardMetricsManager.Run(stopCh)
 
+	go c.interfaceEventsNotifier.Run(interfaceEventsWorkers, stopCh)
+
 	cache.WaitForCacheSync(stopCh, c.hasSynced)
 
 	// queue keys for previous Domains on the host that no longer exist
@@ -422,6 +443,19 @@ type vmiIrrecoverableError struct {
 
 func (e *vmiIrrecoverableError) Error() string { return e.msg }
 
+// syncFailedEventReason returns the reason of the event reporting the sync failure, which is
+// the one of the binding plugin error code when a binding plugin sidecar failed the sync.
+func syncFailedEventReason(syncErr error) string {
+	var notReadyErr *bindingPluginNotReadyError
+	if goerror.As(syncErr, &notReadyErr) && notReadyErr.err.Code != "" {
+		return errcode.EventReason(notReadyErr.err.Code)
+	}
+	if code, ok := errcode.CodeOf(syncErr); ok {
+		return errcode.EventReason(code)
+	}
+	return v1.SyncFailed.String()
+}
+
 func formatIrrecoverableErrorMessage(domain *api.Domain) string {
 	msg := "unknown reason"
 	if domainPausedFailedPostCopy(domain) {
@@ -445,6 +479,18 @@ func (c *VirtualMachineController) teardownNetwork(vmi *v1.VirtualMachineInstanc
 	c.netStat.Teardown(vmi)
 }
 
+// ResyncNetwork re-queues the VMI while dropping its retry backoff.
+// The cached pod interface data is dropped as well, so the following sync re-reads it
+// when reporting the interfaces status, re-runs the setup of networks which are not
+// configured yet and lets virt-launcher plug the interfaces which are missing from the domain.
+func (c *VirtualMachineController) ResyncNetwork(vmi *v1.VirtualMachineInstance) {
+	c.netStat.Teardown(vmi)
+
+	key := controller.VirtualMachineInstanceKey(vmi)
+	c.queue.Forget(key)
+	c.queue.Add(key)
+}
+
 func canUpdateToMounted(currentPhase v1.VolumePhase) bool {
 	return currentPhase == v1.VolumeBound || currentPhase == v1.VolumePending || currentPhase == v1.HotplugVolumeAttachedToNode
 }
@@ -682,6 +728,23 @@ func (c *VirtualMachineController) updateGuestInfoFromDomain(vmi *v1.VirtualMach
 	vmi.Status.GuestOSInfo.ID = domain.Status.OSInfo.Id
 }
 
+func (c *VirtualMachineController) updateGuestDriversFromDomain(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+
+	if domain == nil || len(domain.Status.GuestDrivers) == 0 {
+		return
+	}
+
+	guestDrivers := make([]v1.VirtualMachineInstanceGuestOSDriver, 0, len(domain.Status.GuestDrivers))
+	for _, driver := range domain.Status.GuestDrivers {
+		guestDrivers = append(guestDrivers, v1.VirtualMachineInstanceGuestOSDriver{
+			Name:     driver.Name,
+			Version:  driver.Version,
+			DeviceID: driver.DeviceID,
+		})
+	}
+	vmi.Status.GuestDrivers = guestDrivers
+}
+
 func (c *VirtualMachineController) updateAccessCredentialConditions(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
 
 	if domain == nil || domain.Spec.Metadata.KubeVirt.AccessCredential == nil {
@@ -777,6 +840,7 @@ func (c *VirtualMachineController) updateGuestAgentConditions(vmi *v1.VirtualMac
 			Status:        k8sv1.ConditionTrue,
 		}
 		vmi.Status.Conditions = append(vmi.Status.Conditions, agentCondition)
+		controller.SetVMIBootPhaseTimestamp(&vmi.Status, v1.BootPhaseGuestAgentConnected, agentCondition.LastProbeTime)
 	case !channelConnected:
 		condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceAgentConnected)
 	}
@@ -1026,7 +1090,9 @@ func (c *VirtualMachineController) updateVMIStatusFromDomain(vmi *v1.VirtualMach
 		c.logger.Reason(err).Errorf("couldn't find the SELinux context for %s", vmi.Name)
 	}
 	c.updateGuestInfoFromDomain(vmi, domain)
+	c.updateGuestDriversFromDomain(vmi, domain)
 	c.updateVolumeStatusesFromDomain(vmi, domain)
+	c.updateHostDeviceStatusesFromDomain(vmi, domain)
 	c.updateFSFreezeStatus(vmi, domain)
 	c.updateBackupStatus(vmi, domain)
 	c.updateMachineType(vmi, domain)
@@ -1048,10 +1114,34 @@ func (c *VirtualMachineController) updateVMIConditions(vmi *v1.VirtualMachineIns
 		return err
 	}
 	c.updatePausedConditions(vmi, domain, condManager)
+	c.updateGuestDriverConditions(vmi, condManager)
 
 	return nil
 }
 
+func (c *VirtualMachineController) updateGuestDriverConditions(vmi *v1.VirtualMachineInstance, condManager *controller.VirtualMachineInstanceConditionManager) {
+	interfaces, drivers := countVirtioNetDrivers(vmi)
+	if drivers >= interfaces {
+		condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceGuestDriverMissing)
+		return
+	}
+
+	message := fmt.Sprintf("The guest has a virtio-net driver for %d of its %d virtio network interfaces, "+
+		"install the virtio-net driver in the guest to get network connectivity", drivers, interfaces)
+	if condition := condManager.GetCondition(vmi, v1.VirtualMachineInstanceGuestDriverMissing); condition != nil && condition.Message == message {
+		return
+	}
+	condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceGuestDriverMissing)
+	vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+		Type:               v1.VirtualMachineInstanceGuestDriverMissing,
+		Status:             k8sv1.ConditionTrue,
+		LastProbeTime:      metav1.Now(),
+		LastTransitionTime: metav1.Now(),
+		Reason:             v1.VirtualMachineInstanceReasonVirtioNetDriverMissing,
+		Message:            message,
+	})
+}
+
 func (c *VirtualMachineController) updateVMIStatus(oldStatus *v1.VirtualMachineInstanceStatus, vmi *v1.VirtualMachineInstance, domain *api.Domain, syncError error) (err error) {
 	condManager := controller.NewVirtualMachineInstanceConditionManager()
 
@@ -1085,11 +1175,12 @@ func (c *VirtualMachineController) updateVMIStatus(oldStatus *v1.VirtualMachineI
 
 	// Handle sync error
 	c.handleSyncError(vmi, condManager, syncError)
+	c.updateInterfaceHotplugAttempts(vmi, syncError)
 
 	controller.SetVMIPhaseTransitionTimestamp(oldStatus, &vmi.Status)
 
-	// Only issue vmi update if status has changed
-	if !equality.Semantic.DeepEqual(*oldStatus, vmi.Status) {
+	// Only issue vmi update if status has changed, and the change is not held back to be aggregated
+	if !equality.Semantic.DeepEqual(*oldStatus, vmi.Status) && !c.holdBackStatusUpdate(oldStatus, vmi) {
 		key := controller.VirtualMachineInstanceKey(vmi)
 		c.vmiExpectations.SetExpectations(key, 1, 0)
 		_, err := c.clientset.VirtualMachineInstance(vmi.ObjectMeta.Namespace).Update(context.Background(), vmi, metav1.UpdateOptions{})
@@ -1097,6 +1188,8 @@ func (c *VirtualMachineController) updateVMIStatus(oldStatus *v1.VirtualMachineI
 			c.vmiExpectations.SetExpectations(key, 0, 0)
 			return err
 		}
+		c.statusUpdated(vmi)
+		c.notifyInterfaceEvents(oldStatus, vmi)
 	}
 
 	// Record an event on the VMI when the VMI's phase changes
@@ -1107,12 +1200,37 @@ func (c *VirtualMachineController) updateVMIStatus(oldStatus *v1.VirtualMachineI
 	return nil
 }
 
+// notifyInterfaceEvents lets the external SDN controllers know about the interfaces plugged to or unplugged from the VMI
+func (c *VirtualMachineController) notifyInterfaceEvents(oldStatus *v1.VirtualMachineInstanceStatus, vmi *v1.VirtualMachineInstance) {
+	webhookURL := c.clusterConfig.GetInterfaceEventsWebhookURL()
+	if webhookURL == "" {
+		return
+	}
+	c.interfaceEventsNotifier.Notify(webhookURL, ifaceevents.Collect(oldStatus, vmi, c.host, time.Now()))
+}
+
 type virtLauncherCriticalSecurebootError struct {
 	msg string
 }
 
 func (e *virtLauncherCriticalSecurebootError) Error() string { return e.msg }
 
+// bindingPluginNotReadyError is the fatal error a binding plugin sidecar reported before the domain was started
+type bindingPluginNotReadyError struct {
+	err *cmdclient.SidecarNotReadyError
+}
+
+func (e *bindingPluginNotReadyError) Error() string { return e.err.Error() }
+
+// conditionReason returns the reason of the failed synchronization condition, the one of the binding
+// plugin error code when the sidecar classified its failure
+func (e *bindingPluginNotReadyError) conditionReason() string {
+	if e.err.Code != "" {
+		return errcode.EventReason(e.err.Code)
+	}
+	return "BindingPluginNotReady"
+}
+
 func (c *VirtualMachineController) handleSyncError(vmi *v1.VirtualMachineInstance, condManager *controller.VirtualMachineInstanceConditionManager, syncError error) {
 	var criticalNetErr *neterrors.CriticalNetworkError
 	if goerror.As(syncError, &criticalNetErr) {
@@ -1128,6 +1246,12 @@ func (c *VirtualMachineController) handleSyncError(vmi *v1.VirtualMachineInstanc
 		c.logger.Errorf("virt-launcher reached an irrecoverable error. Updating VMI %s status to Failed", vmi.Name)
 		vmi.Status.Phase = v1.Failed
 	}
+	if notReadyErr, ok := syncError.(*bindingPluginNotReadyError); ok {
+		c.logger.Errorf("a binding plugin failed to configure the VMI. Updating VMI %s status to Failed", vmi.Name)
+		vmi.Status.Phase = v1.Failed
+		condManager.CheckFailure(vmi, syncError, notReadyErr.conditionReason())
+		return
+	}
 	condManager.CheckFailure(vmi, syncError, "Synchronizing with the Domain failed.")
 }
 
@@ -1177,13 +1301,21 @@ func (c *VirtualMachineController) calculatePausedCondition(vmi *v1.VirtualMachi
 			LastProbeTime:      now,
 			LastTransitionTime: now,
 			Reason:             "PausedIOError",
-			Message:            "VMI was paused, low-level IO error detected",
+			Message:            pausedIOErrorMessage(vmi),
 		})
 	default:
 		c.logger.Object(vmi).V(3).Infof("Domain is paused for unknown reason, %s", reason)
 	}
 }
 
+func pausedIOErrorMessage(vmi *v1.VirtualMachineInstance) string {
+	const msg = "VMI was paused, low-level IO error detected"
+	if stayPausedOnIOError(vmi) {
+		return msg + ", it stays paused until it is unpaused as requested by the disk error policy"
+	}
+	return msg
+}
+
 func newNonMigratableCondition(msg string, reason string) *v1.VirtualMachineInstanceCondition {
 	return &v1.VirtualMachineInstanceCondition{
 		Type:    v1.VirtualMachineInstanceIsMigratable,
@@ -1481,7 +1613,11 @@ func (c *VirtualMachineController) sync(key string,
 			shouldUpdate = true
 		}
 
-		if shouldDelay, delay := c.ioErrorRetryManager.ShouldDelay(string(vmi.UID), func() bool {
+		if isIOError(shouldUpdate, domainExists, domain) && stayPausedOnIOError(vmi) {
+			// syncing would resume the domain, leave it paused until the user unpauses it
+			shouldUpdate = false
+			c.logger.Object(vmi).V(3).Info("Keeping vmi paused on IO error as requested by the disk error policy")
+		} else if shouldDelay, delay := c.ioErrorRetryManager.ShouldDelay(string(vmi.UID), func() bool {
 			return isIOError(shouldUpdate, domainExists, domain)
 		}); shouldDelay {
 			shouldUpdate = false
@@ -1517,7 +1653,7 @@ func (c *VirtualMachineController) sync(key string,
 		c.logger.Object(vmi).V(3).Info("No update processing required")
 	}
 	if syncErr != nil && !vmi.IsFinal() {
-		c.recorder.Event(vmi, k8sv1.EventTypeWarning, v1.SyncFailed.String(), syncErr.Error())
+		c.recorder.Event(vmi, k8sv1.EventTypeWarning, syncFailedEventReason(syncErr), syncErr.Error())
 
 		// `syncErr` will be propagated anyway, and it will be logged in `re-enqueueing`
 		// so there is no need to log it twice in hot path without increased verbosity.
@@ -1567,6 +1703,7 @@ func (c *VirtualMachineController) processVmCleanup(vmi *v1.VirtualMachineInstan
 	c.teardownNetwork(vmi)
 
 	c.sriovHotplugExecutorPool.Delete(vmi.UID)
+	c.interfaceHotplugAttempts.forget(vmi.UID)
 
 	// Watch dog file and command client must be the last things removed here
 	c.launcherClients.CloseLauncherClient(vmi)
@@ -1884,6 +2021,8 @@ func (c *VirtualMachineController) vmUpdateHelperDefault(vmi *v1.VirtualMachineI
 		return nil
 	}
 
+	devicesReady := metav1.Now()
+
 	// Synchronize the VirtualMachineInstance state
 	err = c.syncVirtualMachine(client, vmi, preallocatedVolumes)
 	if err != nil {
@@ -1891,6 +2030,8 @@ func (c *VirtualMachineController) vmUpdateHelperDefault(vmi *v1.VirtualMachineI
 	}
 
 	if domain == nil {
+		controller.SetVMIBootPhaseTimestamp(&vmi.Status, v1.BootPhaseDevicesReady, devicesReady)
+		controller.SetVMIBootPhaseTimestamp(&vmi.Status, v1.BootPhaseDomainDefined, metav1.Now())
 		c.recorder.Event(vmi, k8sv1.EventTypeNormal, v1.Created.String(), VMIDefined)
 	}
 
@@ -1905,6 +2046,9 @@ func (c *VirtualMachineController) vmUpdateHelperDefault(vmi *v1.VirtualMachineI
 		if err := c.hotplugVolumeMounter.Unmount(vmi, cgroupManager); err != nil {
 			return err
 		}
+		if err := c.hostDeviceMounter.Unmount(vmi, detachedHostDevices(vmi, domain), cgroupManager); err != nil {
+			return err
+		}
 	}
 
 	return errors.NewAggregate(errorTolerantFeaturesError)
@@ -1946,6 +2090,11 @@ func (c *VirtualMachineController) handleRunningVMI(vmi *v1.VirtualMachineInstan
 		return err
 	}
 
+	if err := c.hotplugHostDevices(vmi, isolationRes, cgroupManager); err != nil {
+		c.recorder.Event(vmi, k8sv1.EventTypeWarning, "HotplugFailed", err.Error())
+		*errorTolerantFeaturesError = append(*errorTolerantFeaturesError, err)
+	}
+
 	if err := c.setupNetwork(vmi, netsetup.FilterNetsForLiveUpdate(vmi), c.netConf); err != nil {
 		c.recorder.Event(vmi, k8sv1.EventTypeWarning, "NicHotplug", err.Error())
 		*errorTolerantFeaturesError = append(*errorTolerantFeaturesError, err)
@@ -1999,6 +2148,10 @@ func (c *VirtualMachineController) handleStartingVMI(
 		return false, err
 	}
 
+	if err := c.configureMemorySwap(vmi, cgroupManager); err != nil {
+		return false, err
+	}
+
 	if c.shouldWaitForSEVAttestation(vmi) {
 		return false, nil
 	}
@@ -2021,6 +2174,11 @@ func (c *VirtualMachineController) shouldWaitForSEVAttestation(vmi *v1.VirtualMa
 		// Wait for the session parameters to be provided
 		return sev.Session == "" || sev.DHCert == ""
 	}
+	if util.IsSEVSNPAttestationRequested(vmi) {
+		snp := vmi.Spec.Domain.LaunchSecurity.SNP
+		// Wait for the ID block to be provided
+		return snp.IDBlock == "" || snp.IDAuth == ""
+	}
 	return false
 }
 
@@ -2036,6 +2194,10 @@ func (c *VirtualMachineController) syncVirtualMachine(client cmdclient.LauncherC
 		if strings.Contains(err.Error(), "EFI OVMF rom missing") {
 			return &virtLauncherCriticalSecurebootError{fmt.Sprintf("mismatch of Secure Boot setting and bootloaders: %v", err)}
 		}
+		var notReadyErr *cmdclient.SidecarNotReadyError
+		if goerror.As(err, &notReadyErr) {
+			return &bindingPluginNotReadyError{notReadyErr}
+		}
 	}
 
 	return err
@@ -2317,6 +2479,17 @@ func isIOError(shouldUpdate, domainExists bool, domain *api.Domain) bool {
 	return shouldUpdate && domainExists && domain.Status.Status == api.Paused && domain.Status.Reason == api.ReasonPausedIOError
 }
 
+// stayPausedOnIOError returns true if a disk of the VMI requests the VMI to stay paused on IO errors.
+// Since libvirt does not tell which disk failed, a single disk with the pause error policy is enough.
+func stayPausedOnIOError(vmi *v1.VirtualMachineInstance) bool {
+	for _, disk := range vmi.Spec.Domain.Devices.Disks {
+		if disk.ErrorPolicy != nil && *disk.ErrorPolicy == v1.DiskErrorPolicyPause {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *VirtualMachineController) updateMachineType(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
 	if domain == nil || vmi == nil {
 		return
@@ -57,7 +57,9 @@ import (
 	"kubevirt.io/kubevirt/pkg/hypervisor"
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/network/bindingplugin/errcode"
 	neterrors "kubevirt.io/kubevirt/pkg/network/errors"
+	"kubevirt.io/kubevirt/pkg/network/ifaceevents"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/safepath"
 	"kubevirt.io/kubevirt/pkg/testutils"
@@ -589,6 +591,27 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(mockQueue.NumRequeues("a/b/c/d/e")).To(Equal(1))
 		})
 
+		It("should enqueue the VMI without rate limiting on network resync", func() {
+			vmi := libvmi.New(libvmi.WithName("testvmi"), libvmi.WithNamespace(metav1.NamespaceDefault))
+			key := virtcontroller.VirtualMachineInstanceKey(vmi)
+			mockQueue.AddRateLimited(key)
+			Expect(mockQueue.NumRequeues(key)).To(Equal(1))
+
+			controller.ResyncNetwork(vmi)
+
+			Expect(mockQueue.NumRequeues(key)).To(BeZero())
+			Expect(mockQueue.Len()).To(Equal(1))
+		})
+
+		It("should drop the cached interfaces status of the VMI on network resync", func() {
+			vmi := libvmi.New(libvmi.WithName("testvmi"), libvmi.WithNamespace(metav1.NamespaceDefault))
+			vmi.UID = vmiTestUUID
+
+			controller.ResyncNetwork(vmi)
+
+			Expect(controller.netStat.(*netStatStub).tornDownVMIs).To(ConsistOf(vmiTestUUID))
+		})
+
 		It("should create the Domain if it sees the first time on a new VirtualMachineInstance", func() {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.UID = vmiTestUUID
@@ -611,6 +634,13 @@ var _ = Describe("VirtualMachineInstance", func() {
 			mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any(), mockCgroupManager).Return(nil)
 			sanityExecute()
 			testutils.ExpectEvent(recorder, VMIDefined)
+
+			updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVMI.Status.BootPhaseTimestamps).To(ConsistOf(
+				HaveField("Phase", v1.BootPhaseDevicesReady),
+				HaveField("Phase", v1.BootPhaseDomainDefined),
+			))
 		})
 
 		It("should update the qemu machine type on the VMI status", func() {
@@ -746,6 +776,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 					"Status": Equal(k8sv1.ConditionTrue)},
 				),
 			))
+			Expect(updatedVMI.Status.BootPhaseTimestamps).To(ConsistOf(HaveField("Phase", v1.BootPhaseGuestAgentConnected)))
 		})
 
 		It("should maintain unsupported user agent condition when it's already set", func() {
@@ -1072,6 +1103,53 @@ var _ = Describe("VirtualMachineInstance", func() {
 			}),
 		)
 
+		DescribeTable("when domain is paused on IO error", func(errorPolicy *v1.DiskErrorPolicy, expectSync bool, expectedMessage string) {
+			vmi := libvmi.New(
+				libvmi.WithNamespace(k8sv1.NamespaceDefault),
+				vmiWithResourceVersion("1"),
+				vmiWithUID(vmiTestUUID),
+				libvmistatus.WithStatus(libvmistatus.New(
+					libvmistatus.WithPhase(v1.Running),
+					libvmistatus.WithActivePod(podTestUUID, host),
+				)),
+			)
+			vmi.Spec.Domain.Devices.Disks = []v1.Disk{{Name: "disk0", ErrorPolicy: errorPolicy}}
+
+			domain := api.NewMinimalDomainWithUUID(vmi.Name, vmiTestUUID)
+			domain.Status.Status = api.Paused
+			domain.Status.Reason = api.ReasonPausedIOError
+
+			addVMI(vmi, domain)
+
+			if expectSync {
+				client.EXPECT().SyncVirtualMachine(gomock.Any(), gomock.Any())
+			}
+			mockHotplugVolumeMounter.EXPECT().Unmount(gomock.Any(), mockCgroupManager).Return(nil).AnyTimes()
+			mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any(), mockCgroupManager).Return(nil).AnyTimes()
+
+			sanityExecute()
+
+			updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVMI.Status.Conditions).To(ContainElement(
+				MatchFields(IgnoreExtras, Fields{
+					"Type":    Equal(v1.VirtualMachineInstancePaused),
+					"Status":  Equal(k8sv1.ConditionTrue),
+					"Reason":  Equal("PausedIOError"),
+					"Message": Equal(expectedMessage),
+				}),
+			))
+		},
+			Entry("should resume it without an error policy", nil, true,
+				"VMI was paused, low-level IO error detected"),
+			Entry("should resume it with the retry error policy", pointer.P(v1.DiskErrorPolicyRetry), true,
+				"VMI was paused, low-level IO error detected"),
+			Entry("should resume it with the stop error policy", pointer.P(v1.DiskErrorPolicyStop), true,
+				"VMI was paused, low-level IO error detected"),
+			Entry("should keep it paused with the pause error policy", pointer.P(v1.DiskErrorPolicyPause), false,
+				"VMI was paused, low-level IO error detected, it stays paused until it is unpaused as requested by the disk error policy"),
+		)
+
 		It("should move VirtualMachineInstance from Scheduled to Failed if watchdog file is missing", func() {
 			Expect(cmdclient.MarkSocketUnresponsive(sockFile)).To(Succeed())
 			vmi := api2.NewMinimalVMI("testvmi")
@@ -1380,6 +1458,52 @@ var _ = Describe("VirtualMachineInstance", func() {
 				testutils.ExpectEvent(recorder, v1.SyncFailed.String())
 			})
 
+			It("should report the binding plugin error code of a failed sync in the event reason", func() {
+				vmi := api2.NewMinimalVMI("testvmi")
+				vmi.UID = vmiTestUUID
+				vmi.Status.Phase = v1.Scheduled
+				createVMI(vmi)
+
+				mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any(), mockCgroupManager).Return(nil)
+				sidecarErr := errcode.Errorf(errcode.DeviceMissing, "no device for network %q", "blue")
+				client.EXPECT().SyncVirtualMachine(vmi, gomock.Any()).Return(
+					fmt.Errorf("server error. command SyncVMI failed: %q", sidecarErr.Error()))
+
+				controller.Execute()
+				testutils.ExpectEvent(recorder, "BindingPluginDeviceMissing")
+			})
+
+			It("should move VirtualMachineInstance to Failed if a binding plugin fails its readiness check", func() {
+				vmi := api2.NewMinimalVMI("testvmi")
+				vmi.UID = vmiTestUUID
+				vmi.Status.Phase = v1.Scheduled
+				vmi.Status.ActivePods = map[types.UID]string{podTestUUID: ""}
+				createVMI(vmi)
+
+				mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any(), mockCgroupManager).Return(nil)
+				sidecarErr := errcode.NotReady("hook-sidecar-0", errcode.DeviceMissing,
+					fmt.Errorf("vdpa device %q not found", "/dev/vhost-vdpa-0"))
+				client.EXPECT().SyncVirtualMachine(vmi, gomock.Any()).Return(&cmdclient.SidecarNotReadyError{
+					Sidecar: "hook-sidecar-0",
+					Code:    errcode.DeviceMissing,
+					Err:     fmt.Errorf("server error. command SyncVMI failed: %q", sidecarErr.Error()),
+				})
+
+				sanityExecute()
+
+				testutils.ExpectEvent(recorder, "BindingPluginDeviceMissing")
+				testutils.ExpectEvent(recorder, VMICrashed)
+				updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(updatedVMI.Status.Phase).To(Equal(v1.Failed))
+				Expect(updatedVMI.Status.Conditions).To(ContainElement(And(
+					HaveField("Type", v1.VirtualMachineInstanceSynchronized),
+					HaveField("Status", k8sv1.ConditionFalse),
+					HaveField("Reason", "BindingPluginDeviceMissing"),
+					HaveField("Message", ContainSubstring("/dev/vhost-vdpa-0")),
+				)))
+			})
+
 			It("should call unmountAll from processVmCleanup", func() {
 				vmi := api2.NewMinimalVMI("testvmi")
 				vmi.UID = vmiTestUUID
@@ -1751,6 +1875,62 @@ var _ = Describe("VirtualMachineInstance", func() {
 
 		})
 
+		Context("hotplug host device status", func() {
+			var vmi *v1.VirtualMachineInstance
+			var domain *api.Domain
+
+			BeforeEach(func() {
+				vmi = api2.NewMinimalVMI("testvmi")
+				vmi.UID = vmiTestUUID
+				vmi.Status.Phase = v1.Running
+				domain = api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+				domain.Status.Status = api.Running
+			})
+
+			hotplugStatus := func(name string, phase v1.HostDevicePhase) v1.HostDeviceStatus {
+				return v1.HostDeviceStatus{
+					Name:              name,
+					Phase:             phase,
+					HotplugHostDevice: &v1.HotplugHostDeviceStatus{AttachPodUID: "1234", PCIAddress: "0000:81:00.0"},
+				}
+			}
+
+			It("should mark a mounted host device ready once attached to the domain", func() {
+				vmi.Status.HostDeviceStatuses = []v1.HostDeviceStatus{
+					hotplugStatus("attached", v1.HostDeviceMountedToPod),
+					hotplugStatus("pending", v1.HostDeviceMountedToPod),
+				}
+				domain.Spec.Devices.HostDevices = []api.HostDevice{{Alias: api.NewUserDefinedAlias("hostdevice-attached")}}
+
+				controller.updateHostDeviceStatusesFromDomain(vmi, domain)
+				Expect(vmi.Status.HostDeviceStatuses[0].Phase).To(Equal(v1.HostDeviceReady))
+				Expect(vmi.Status.HostDeviceStatuses[1].Phase).To(Equal(v1.HostDeviceMountedToPod))
+				testutils.ExpectEvent(recorder, HostDeviceReadyReason)
+			})
+
+			It("should mark a detaching host device detached once gone from the domain", func() {
+				vmi.Status.HostDeviceStatuses = []v1.HostDeviceStatus{
+					hotplugStatus("detached", v1.HostDeviceDetaching),
+					hotplugStatus("detaching", v1.HostDeviceDetaching),
+				}
+				domain.Spec.Devices.HostDevices = []api.HostDevice{{Alias: api.NewUserDefinedAlias("hostdevice-detaching")}}
+
+				Expect(detachedHostDevices(vmi, domain)).To(Equal([]string{"detached"}))
+				controller.updateHostDeviceStatusesFromDomain(vmi, domain)
+				Expect(vmi.Status.HostDeviceStatuses[0].Phase).To(Equal(v1.HostDeviceDetached))
+				Expect(vmi.Status.HostDeviceStatuses[1].Phase).To(Equal(v1.HostDeviceDetaching))
+				testutils.ExpectEvent(recorder, HostDeviceDetachedReason)
+			})
+
+			It("should leave boot host devices alone", func() {
+				vmi.Status.HostDeviceStatuses = []v1.HostDeviceStatus{{Name: "boot", Phase: v1.HostDeviceReady}}
+
+				Expect(detachedHostDevices(vmi, domain)).To(BeEmpty())
+				controller.updateHostDeviceStatusesFromDomain(vmi, domain)
+				Expect(vmi.Status.HostDeviceStatuses[0].Phase).To(Equal(v1.HostDeviceReady))
+			})
+		})
+
 		It("should leave VirtualMachineInstance phase alone if not the current active node", func() {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.ObjectMeta.ResourceVersion = "1"
@@ -2696,6 +2876,45 @@ var _ = Describe("VirtualMachineInstance", func() {
 		})
 	})
 
+	Context("interface events", func() {
+		const webhookURL = "http://sdn-controller.example.com/events"
+
+		var notifier *interfaceEventsNotifierStub
+
+		BeforeEach(func() {
+			notifier = &interfaceEventsNotifierStub{}
+			controller.interfaceEventsNotifier = notifier
+		})
+
+		newVMIWithInterface := func() *v1.VirtualMachineInstance {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.Status.Phase = v1.Running
+			vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{{Name: "default", MAC: "02:00:00:00:00:01"}}
+			return vmi
+		}
+
+		It("should notify the configured webhook about plugged interfaces", func() {
+			kv := &v1.KubeVirtConfiguration{NetworkConfiguration: &v1.NetworkConfiguration{
+				InterfaceEvents: &v1.InterfaceEventsConfiguration{WebhookURL: webhookURL},
+			}}
+			controller.clusterConfig, _, _ = testutils.NewFakeClusterConfigUsingKVConfig(kv)
+
+			controller.notifyInterfaceEvents(&v1.VirtualMachineInstanceStatus{Phase: v1.Scheduled}, newVMIWithInterface())
+
+			Expect(notifier.url).To(Equal(webhookURL))
+			Expect(notifier.events).To(HaveLen(1))
+			Expect(notifier.events[0].Type).To(Equal(ifaceevents.TypeInterfaceConfigured))
+			Expect(notifier.events[0].Data.Node).To(Equal(host))
+		})
+
+		It("should not notify when no webhook is configured", func() {
+			controller.notifyInterfaceEvents(&v1.VirtualMachineInstanceStatus{Phase: v1.Scheduled}, newVMIWithInterface())
+
+			Expect(notifier.events).To(BeNil())
+		})
+	})
+
 	Context("VirtualMachineInstance controller gets informed about changes in a Domain", func() {
 		It("should update Guest OS Information in VMI status", func() {
 			vmi := api2.NewMinimalVMI("testvmi")
@@ -2729,6 +2948,9 @@ var _ = Describe("VirtualMachineInstance", func() {
 				KernelRelease: guestOSKernelRelease,
 				KernelVersion: guestOSKernelVersion,
 			}
+			domain.Status.GuestDrivers = []api.GuestDriver{
+				{Name: "Red Hat VirtIO Ethernet Adapter", Version: "100.85.104.20800", DeviceID: "1af4:1041"},
+			}
 
 			addVMI(vmi, domain)
 
@@ -2745,6 +2967,11 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(updatedVMI.Status.GuestOSInfo.Machine).To(Equal(domain.Status.OSInfo.Machine))
 			Expect(updatedVMI.Status.GuestOSInfo.KernelRelease).To(Equal(domain.Status.OSInfo.KernelRelease))
 			Expect(updatedVMI.Status.GuestOSInfo.KernelVersion).To(Equal(domain.Status.OSInfo.KernelVersion))
+			Expect(updatedVMI.Status.GuestDrivers).To(ConsistOf(v1.VirtualMachineInstanceGuestOSDriver{
+				Name:     "Red Hat VirtIO Ethernet Adapter",
+				Version:  "100.85.104.20800",
+				DeviceID: "1af4:1041",
+			}))
 		})
 
 		It("should update Guest FSFreeze Status in VMI status if fs frozen", func() {
@@ -3047,6 +3274,54 @@ var _ = Describe("VirtualMachineInstance", func() {
 		})
 	})
 
+	Context("Guest drivers", func() {
+		virtioNetDriver := v1.VirtualMachineInstanceGuestOSDriver{Name: "netkvm", DeviceID: "1af4:1041"}
+		balloonDriver := v1.VirtualMachineInstanceGuestOSDriver{Name: "balloon", DeviceID: "1af4:1045"}
+
+		newVMIWithDrivers := func(drivers ...v1.VirtualMachineInstanceGuestOSDriver) *v1.VirtualMachineInstance {
+			vmi := libvmi.New(
+				libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+				libvmi.WithInterface(libvmi.InterfaceDeviceWithBridgeBinding("red")),
+				libvmi.WithInterface(libvmi.InterfaceDeviceWithSRIOVBinding("blue")),
+			)
+			vmi.Status.GuestDrivers = drivers
+			return vmi
+		}
+
+		It("should add a condition when the guest has no virtio-net driver for some virtio interfaces", func() {
+			vmi := newVMIWithDrivers(balloonDriver, virtioNetDriver)
+
+			controller.updateGuestDriverConditions(vmi, virtcontroller.NewVirtualMachineInstanceConditionManager())
+
+			Expect(vmi.Status.Conditions).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Type":    Equal(v1.VirtualMachineInstanceGuestDriverMissing),
+				"Status":  Equal(k8sv1.ConditionTrue),
+				"Reason":  Equal(v1.VirtualMachineInstanceReasonVirtioNetDriverMissing),
+				"Message": ContainSubstring("virtio-net driver for 1 of its 2 virtio network interfaces"),
+			})))
+		})
+
+		DescribeTable("should not add a condition", func(vmi *v1.VirtualMachineInstance) {
+			controller.updateGuestDriverConditions(vmi, virtcontroller.NewVirtualMachineInstanceConditionManager())
+
+			Expect(vmi.Status.Conditions).To(BeEmpty())
+		},
+			Entry("when the guest has a virtio-net driver for every virtio interface", newVMIWithDrivers(virtioNetDriver, balloonDriver, virtioNetDriver)),
+			Entry("when the guest does not report its drivers", newVMIWithDrivers()),
+		)
+
+		It("should remove the condition once the drivers are installed", func() {
+			vmi := newVMIWithDrivers(balloonDriver)
+			condManager := virtcontroller.NewVirtualMachineInstanceConditionManager()
+			controller.updateGuestDriverConditions(vmi, condManager)
+			Expect(condManager.HasCondition(vmi, v1.VirtualMachineInstanceGuestDriverMissing)).To(BeTrue())
+
+			vmi.Status.GuestDrivers = append(vmi.Status.GuestDrivers, virtioNetDriver, virtioNetDriver)
+			controller.updateGuestDriverConditions(vmi, condManager)
+			Expect(vmi.Status.Conditions).To(BeEmpty())
+		})
+	})
+
 	Context("claimDeviceOwnership", func() {
 		var path string
 		BeforeEach(func() {
@@ -3109,6 +3384,56 @@ var _ = Describe("VirtualMachineInstance", func() {
 		)
 	})
 
+	Context("configureMemorySwap", func() {
+		setNodeSwapFeatureGate := func(enabled bool) {
+			featureGates := []string{}
+			if enabled {
+				featureGates = append(featureGates, featuregate.NodeSwap)
+			}
+			config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+				DeveloperConfiguration: &v1.DeveloperConfiguration{
+					FeatureGates: featureGates,
+				},
+			})
+			controller.clusterConfig = config
+		}
+
+		newVMIWithSwap := func(swap *v1.MemorySwap) *v1.VirtualMachineInstance {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Memory = &v1.Memory{Swap: swap}
+			return vmi
+		}
+
+		DescribeTable("should configure the launcher cgroup", func(swap *v1.MemorySwap, expectedLimit int64, expectedWriteback bool) {
+			setNodeSwapFeatureGate(true)
+			mockCgroupManager.EXPECT().SetMemorySwap(expectedLimit, expectedWriteback).Return(nil)
+
+			Expect(controller.configureMemorySwap(newVMIWithSwap(swap), mockCgroupManager)).To(Succeed())
+		},
+			Entry("without a limit", &v1.MemorySwap{Policy: v1.MemorySwapPolicySwap}, int64(-1), true),
+			Entry("with a limit", &v1.MemorySwap{Policy: v1.MemorySwapPolicySwap, Limit: pointer.P(resource.MustParse("1Gi"))}, int64(1073741824), true),
+			Entry("with zswap only", &v1.MemorySwap{Policy: v1.MemorySwapPolicyZswap}, int64(-1), false),
+		)
+
+		It("should not configure the launcher cgroup if the VMI does not request swap", func() {
+			setNodeSwapFeatureGate(true)
+			Expect(controller.configureMemorySwap(newVMIWithSwap(nil), mockCgroupManager)).To(Succeed())
+		})
+
+		It("should not configure the launcher cgroup if the feature gate is disabled", func() {
+			setNodeSwapFeatureGate(false)
+			Expect(controller.configureMemorySwap(newVMIWithSwap(&v1.MemorySwap{Policy: v1.MemorySwapPolicySwap}), mockCgroupManager)).To(Succeed())
+		})
+
+		It("should fail if the cgroup can not be configured", func() {
+			setNodeSwapFeatureGate(true)
+			mockCgroupManager.EXPECT().SetMemorySwap(int64(-1), true).Return(fmt.Errorf("not supported"))
+
+			err := controller.configureMemorySwap(newVMIWithSwap(&v1.MemorySwap{Policy: v1.MemorySwapPolicySwap}), mockCgroupManager)
+			Expect(err).To(MatchError(ContainSubstring("failed to configure memory swap")))
+		})
+	})
+
 	Context("on post-copy migration failure", func() {
 		It("should fail the VMI", func() {
 			By("Creating a migrating VMI with a domain in failed post-copy migration state")
@@ -3358,7 +3683,21 @@ func (nc *netConfStub) Teardown(_ *v1.VirtualMachineInstance) error {
 	return nil
 }
 
-type netStatStub struct{}
+type interfaceEventsNotifierStub struct {
+	url    string
+	events []ifaceevents.Event
+}
+
+func (n *interfaceEventsNotifierStub) Run(_ int, _ <-chan struct{}) {}
+
+func (n *interfaceEventsNotifierStub) Notify(url string, events []ifaceevents.Event) {
+	n.url = url
+	n.events = events
+}
+
+type netStatStub struct {
+	tornDownVMIs []types.UID
+}
 
 func (ns *netStatStub) UpdateStatus(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
 	if domain == nil || vmi == nil {
@@ -3380,7 +3719,9 @@ func (ns *netStatStub) UpdateStatus(vmi *v1.VirtualMachineInstance, domain *api.
 	return nil
 }
 
-func (ns *netStatStub) Teardown(_ *v1.VirtualMachineInstance) {}
+func (ns *netStatStub) Teardown(vmi *v1.VirtualMachineInstance) {
+	ns.tornDownVMIs = append(ns.tornDownVMIs, vmi.UID)
+}
 
 func newFakeManager() *fakeManager {
 	return &fakeManager{}
@@ -62,6 +62,30 @@ type AgentInfo struct {
 	SupportedCommands []v1.GuestAgentCommandInfo `json:"supported_commands,omitempty"`
 }
 
+// isCommandEnabled returns whether the guest agent reported the command as supported and enabled
+func (a AgentInfo) isCommandEnabled(command AgentCommand) bool {
+	for _, supportedCommand := range a.SupportedCommands {
+		if supportedCommand.Name == string(command) {
+			return supportedCommand.Enabled
+		}
+	}
+	return false
+}
+
+// Device of the guest, along with the driver handling it
+type Device struct {
+	DriverName    string   `json:"driver-name"`
+	DriverVersion string   `json:"driver-version,omitempty"`
+	ID            DeviceID `json:"id,omitempty"`
+}
+
+// DeviceID identifies the guest device, only PCI devices are reported by the agent
+type DeviceID struct {
+	Type     string `json:"type"`
+	VendorID uint16 `json:"vendor-id,omitempty"`
+	DeviceID uint16 `json:"device-id,omitempty"`
+}
+
 // parseFSFreezeStatus from the agent response
 func ParseFSFreezeStatus(agentReply string) (api.FSFreeze, error) {
 	response := stripAgentStringResponse(agentReply)
@@ -126,3 +150,28 @@ func parseAgent(agentReply string) (AgentInfo, error) {
 
 	return gaInfo, nil
 }
+
+// parseDevices gets the drivers of the guest devices from response
+func parseDevices(agentReply string) ([]api.GuestDriver, error) {
+	result := []Device{}
+	response := stripAgentResponse(agentReply)
+
+	err := json.Unmarshal([]byte(response), &result)
+	if err != nil {
+		return []api.GuestDriver{}, err
+	}
+
+	drivers := []api.GuestDriver{}
+	for _, device := range result {
+		driver := api.GuestDriver{
+			Name:    device.DriverName,
+			Version: device.DriverVersion,
+		}
+		if device.ID.Type == "pci" {
+			driver.DeviceID = fmt.Sprintf("%04x:%04x", device.ID.VendorID, device.ID.DeviceID)
+		}
+		drivers = append(drivers, driver)
+	}
+
+	return drivers, nil
+}
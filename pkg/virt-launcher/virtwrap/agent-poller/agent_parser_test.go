@@ -102,5 +102,40 @@ var _ = Describe("Qemu agent poller", func() {
 			}
 			Expect(parseFilesystem(jsonInput)).To(Equal(expectedFilesystem))
 		})
+
+		It("should parse Devices", func() {
+			jsonInput := `{
+                "return":[
+                    {
+                        "driver-name":"Red Hat VirtIO Ethernet Adapter",
+                        "driver-date":1583971200000000000,
+                        "driver-version":"100.85.104.20800",
+                        "id":{
+                            "type":"pci",
+                            "vendor-id":6900,
+                            "device-id":4161
+                        }
+                    },
+                    {
+                        "driver-name":"Some Other Driver",
+                        "id":{
+                            "type":"ccw"
+                        }
+                    }
+                ]
+            }`
+
+			expectedDrivers := []api.GuestDriver{
+				{
+					Name:     "Red Hat VirtIO Ethernet Adapter",
+					Version:  "100.85.104.20800",
+					DeviceID: "1af4:1041",
+				},
+				{
+					Name: "Some Other Driver",
+				},
+			}
+			Expect(parseDevices(jsonInput)).To(Equal(expectedDrivers))
+		})
 	})
 })
@@ -44,6 +44,7 @@ const (
 	GetFilesystem     AgentCommand = "guest-get-fsinfo"
 	GetAgent          AgentCommand = "guest-info"
 	GetFSFreezeStatus AgentCommand = "guest-fsfreeze-status"
+	GetDevices        AgentCommand = "guest-get-devices"
 
 	pollInitialInterval = 10 * time.Second
 
@@ -82,7 +83,7 @@ func (s *AsyncAgentStore) Store(key, value any) {
 
 	domainInfo := api.DomainGuestInfo{}
 	switch key {
-	case libvirt.DOMAIN_GUEST_INFO_OS, libvirt.DOMAIN_GUEST_INFO_INTERFACES, GetFSFreezeStatus:
+	case libvirt.DOMAIN_GUEST_INFO_OS, libvirt.DOMAIN_GUEST_INFO_INTERFACES, GetFSFreezeStatus, GetDevices:
 		updated := (oldData == nil) || !equality.Semantic.DeepEqual(oldData, value)
 		if !updated {
 			return
@@ -90,6 +91,7 @@ func (s *AsyncAgentStore) Store(key, value any) {
 		domainInfo.OSInfo = s.GetGuestOSInfo()
 		domainInfo.Interfaces = s.GetInterfaceStatus()
 		domainInfo.FSFreezeStatus = s.GetFSFreezeStatus()
+		domainInfo.Drivers = s.GetDrivers()
 
 		s.AgentUpdated <- AgentUpdatedEvent{
 			DomainInfo: domainInfo,
@@ -149,6 +151,16 @@ func (s *AsyncAgentStore) GetGuestOSInfo() *api.GuestOSInfo {
 	return nil
 }
 
+// GetDrivers returns the drivers of the guest devices
+func (s *AsyncAgentStore) GetDrivers() []api.GuestDriver {
+	data, ok := s.store.Load(GetDevices)
+	if ok {
+		return data.([]api.GuestDriver)
+	}
+
+	return nil
+}
+
 // GetGA returns guest agent record with its version if present
 func (s *AsyncAgentStore) GetGA() AgentInfo {
 	data, ok := s.store.Load(GetAgent)
@@ -303,7 +315,7 @@ func CreatePoller(
 			// Polling for QEMU agent commands
 			{
 				CallTick:      qemuAgentVersionInterval,
-				AgentCommands: []AgentCommand{GetAgent},
+				AgentCommands: []AgentCommand{GetAgent, GetDevices},
 			},
 			{
 				CallTick:      qemuAgentFileInterval,
@@ -413,6 +425,11 @@ func executeAgentCommands(commands []AgentCommand, agentPoller *AgentPoller) {
 	log.Log.V(repeatingLogLevel).Infof("Polling command: %v", commands)
 
 	for _, command := range commands {
+		if command == GetDevices && !agentPoller.agentStore.GetGA().isCommandEnabled(GetDevices) {
+			// the command is not implemented by all the guest agents, e.g. by the Linux ones
+			continue
+		}
+
 		cmdResult, err := agentPoller.Connection.QemuAgentCommand(`{"execute":"`+string(command)+`"}`, agentPoller.domainName)
 		if err != nil {
 			// skip the command on error, it is not vital
@@ -441,6 +458,13 @@ func executeAgentCommands(commands []AgentCommand, agentPoller *AgentPoller) {
 				continue
 			}
 			agentPoller.agentStore.Store(GetAgent, agent)
+		case GetDevices:
+			drivers, err := parseDevices(cmdResult)
+			if err != nil {
+				log.Log.Errorf("Cannot parse guest agent devices %s", err.Error())
+				continue
+			}
+			agentPoller.agentStore.Store(GetDevices, drivers)
 		}
 	}
 }
@@ -28,6 +28,8 @@ import (
 
 	"libvirt.org/go/libvirt"
 
+	v1 "kubevirt.io/api/core/v1"
+
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/testing"
 )
@@ -224,6 +226,58 @@ var _ = Describe("Qemu agent poller", func() {
 		})
 	})
 
+	Context("with guest devices", func() {
+		const devicesReply = `{"return":[{"driver-name":"Red Hat VirtIO Ethernet Adapter","id":{"type":"pci","vendor-id":6900,"device-id":4161}}]}`
+
+		var agentPoller *AgentPoller
+
+		BeforeEach(func() {
+			agentPoller = &AgentPoller{
+				Connection: mockLibvirt.VirtConnection,
+				domainName: "test-domain",
+				agentStore: &agentStore,
+			}
+		})
+
+		It("should fire an event with the drivers apart from the guest OS info", func() {
+			drivers := []api.GuestDriver{{Name: "Red Hat VirtIO Ethernet Adapter", DeviceID: "1af4:1041"}}
+			agentStore.Store(libvirt.DOMAIN_GUEST_INFO_OS, fakeInfo)
+			Expect(agentStore.AgentUpdated).To(Receive())
+
+			agentStore.Store(GetDevices, drivers)
+			Expect(agentStore.AgentUpdated).To(Receive(Equal(AgentUpdatedEvent{
+				DomainInfo: api.DomainGuestInfo{
+					OSInfo:  &fakeInfo,
+					Drivers: drivers,
+				},
+			})))
+			Expect(*agentStore.GetGuestOSInfo()).To(Equal(fakeInfo))
+		})
+
+		It("should not query the devices when the guest agent does not support it", func() {
+			agentStore.Store(GetAgent, AgentInfo{
+				SupportedCommands: []v1.GuestAgentCommandInfo{{Name: string(GetDevices), Enabled: false}},
+			})
+			mockLibvirt.ConnectionEXPECT().QemuAgentCommand(gomock.Any(), gomock.Any()).Times(0)
+
+			executeAgentCommands([]AgentCommand{GetDevices}, agentPoller)
+			Expect(agentStore.GetDrivers()).To(BeNil())
+		})
+
+		It("should store the devices when the guest agent supports it", func() {
+			agentStore.Store(GetAgent, AgentInfo{
+				SupportedCommands: []v1.GuestAgentCommandInfo{{Name: string(GetDevices), Enabled: true}},
+			})
+			mockLibvirt.ConnectionEXPECT().QemuAgentCommand(`{"execute":"guest-get-devices"}`, "test-domain").Return(devicesReply, nil)
+
+			executeAgentCommands([]AgentCommand{GetDevices}, agentPoller)
+			Expect(agentStore.GetDrivers()).To(ConsistOf(api.GuestDriver{
+				Name:     "Red Hat VirtIO Ethernet Adapter",
+				DeviceID: "1af4:1041",
+			}))
+		})
+	})
+
 	Context("PollerWorker", func() {
 		It("executes the agent commands at least once", func() {
 			const interval = 1
@@ -1025,7 +1025,9 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 	if in.Redirs != nil {
 		in, out := &in.Redirs, &out.Redirs
 		*out = make([]RedirectedDevice, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.SoundCards != nil {
 		in, out := &in.SoundCards, &out.SoundCards
@@ -1187,6 +1189,27 @@ func (in *DiskDriver) DeepCopy() *DiskDriver {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskEncryption) DeepCopyInto(out *DiskEncryption) {
+	*out = *in
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(DiskSecret)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskEncryption.
+func (in *DiskEncryption) DeepCopy() *DiskEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskIOThread) DeepCopyInto(out *DiskIOThread) {
 	*out = *in
@@ -1263,6 +1286,11 @@ func (in *DiskSource) DeepCopyInto(out *DiskSource) {
 		*out = new(DataStore)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(DiskEncryption)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1436,6 +1464,11 @@ func (in *DomainGuestInfo) DeepCopyInto(out *DomainGuestInfo) {
 		*out = new(FSFreeze)
 		**out = **in
 	}
+	if in.Drivers != nil {
+		in, out := &in.Drivers, &out.Drivers
+		*out = make([]GuestDriver, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1524,6 +1557,11 @@ func (in *DomainSpec) DeepCopyInto(out *DomainSpec) {
 		*out = new(Commandline)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.QEMUOverride != nil {
+		in, out := &in.QEMUOverride, &out.QEMUOverride
+		*out = new(QEMUOverride)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Metadata.DeepCopyInto(&out.Metadata)
 	if in.Features != nil {
 		in, out := &in.Features, &out.Features
@@ -1586,6 +1624,11 @@ func (in *DomainStatus) DeepCopyInto(out *DomainStatus) {
 	}
 	out.OSInfo = in.OSInfo
 	out.FSFreezeStatus = in.FSFreezeStatus
+	if in.GuestDrivers != nil {
+		in, out := &in.GuestDrivers, &out.GuestDrivers
+		*out = make([]GuestDriver, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2175,6 +2218,22 @@ func (in *GraphicsListen) DeepCopy() *GraphicsListen {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestDriver) DeepCopyInto(out *GuestDriver) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestDriver.
+func (in *GuestDriver) DeepCopy() *GuestDriver {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestDriver)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GuestOSInfo) DeepCopyInto(out *GuestOSInfo) {
 	*out = *in
@@ -2459,6 +2518,11 @@ func (in *InterfaceDriver) DeepCopyInto(out *InterfaceDriver) {
 		*out = new(uint)
 		**out = **in
 	}
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
+		*out = new(InterfaceDriverHost)
+		**out = **in
+	}
 	return
 }
 
@@ -2472,6 +2536,22 @@ func (in *InterfaceDriver) DeepCopy() *InterfaceDriver {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceDriverHost) DeepCopyInto(out *InterfaceDriverHost) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceDriverHost.
+func (in *InterfaceDriverHost) DeepCopy() *InterfaceDriverHost {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceDriverHost)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfacePortForward) DeepCopyInto(out *InterfacePortForward) {
 	*out = *in
@@ -3138,6 +3218,27 @@ func (in *NumaTuneMemory) DeepCopy() *NumaTuneMemory {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OEMStrings) DeepCopyInto(out *OEMStrings) {
+	*out = *in
+	if in.Entries != nil {
+		in, out := &in.Entries, &out.Entries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OEMStrings.
+func (in *OEMStrings) DeepCopy() *OEMStrings {
+	if in == nil {
+		return nil
+	}
+	out := new(OEMStrings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OS) DeepCopyInto(out *OS) {
 	*out = *in
@@ -3264,6 +3365,83 @@ func (in *PanicDevice) DeepCopy() *PanicDevice {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QEMUOverride) DeepCopyInto(out *QEMUOverride) {
+	*out = *in
+	if in.Devices != nil {
+		in, out := &in.Devices, &out.Devices
+		*out = make([]QEMUOverrideDevice, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QEMUOverride.
+func (in *QEMUOverride) DeepCopy() *QEMUOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(QEMUOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QEMUOverrideDevice) DeepCopyInto(out *QEMUOverrideDevice) {
+	*out = *in
+	in.Frontend.DeepCopyInto(&out.Frontend)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QEMUOverrideDevice.
+func (in *QEMUOverrideDevice) DeepCopy() *QEMUOverrideDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(QEMUOverrideDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QEMUOverrideFrontend) DeepCopyInto(out *QEMUOverrideFrontend) {
+	*out = *in
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = make([]QEMUOverrideProperty, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QEMUOverrideFrontend.
+func (in *QEMUOverrideFrontend) DeepCopy() *QEMUOverrideFrontend {
+	if in == nil {
+		return nil
+	}
+	out := new(QEMUOverrideFrontend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QEMUOverrideProperty) DeepCopyInto(out *QEMUOverrideProperty) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QEMUOverrideProperty.
+func (in *QEMUOverrideProperty) DeepCopy() *QEMUOverrideProperty {
+	if in == nil {
+		return nil
+	}
+	out := new(QEMUOverrideProperty)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QGS) DeepCopyInto(out *QGS) {
 	*out = *in
@@ -3299,7 +3477,11 @@ func (in *ReadOnly) DeepCopy() *ReadOnly {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RedirectedDevice) DeepCopyInto(out *RedirectedDevice) {
 	*out = *in
-	out.Source = in.Source
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(RedirectedDeviceSource)
+		**out = **in
+	}
 	return
 }
 
@@ -3762,6 +3944,11 @@ func (in *SysInfo) DeepCopyInto(out *SysInfo) {
 		*out = make([]Entry, len(*in))
 		copy(*out, *in)
 	}
+	if in.OEMStrings != nil {
+		in, out := &in.OEMStrings, &out.OEMStrings
+		*out = new(OEMStrings)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
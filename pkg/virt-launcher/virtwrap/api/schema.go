@@ -122,6 +122,7 @@ type DomainStatus struct {
 	Interfaces     []InterfaceStatus
 	OSInfo         GuestOSInfo
 	FSFreezeStatus FSFreeze
+	GuestDrivers   []GuestDriver
 }
 
 // GuestPanicInfo contains details about a guest panic event from QEMU
@@ -153,6 +154,13 @@ type GuestOSInfo struct {
 	Id            string
 }
 
+// GuestDriver is a driver the guest uses for one of its devices
+type GuestDriver struct {
+	Name     string
+	Version  string
+	DeviceID string
+}
+
 type InterfaceStatus struct {
 	Mac           string
 	Ip            string
@@ -199,6 +207,7 @@ type DomainGuestInfo struct {
 	Interfaces     []InterfaceStatus
 	OSInfo         *GuestOSInfo
 	FSFreezeStatus *FSFreeze
+	Drivers        []GuestDriver
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -227,6 +236,7 @@ type DomainSpec struct {
 	Clock          *Clock          `xml:"clock,omitempty"`
 	Resource       *Resource       `xml:"resource,omitempty"`
 	QEMUCmd        *Commandline    `xml:"qemu:commandline,omitempty"`
+	QEMUOverride   *QEMUOverride   `xml:"qemu:override,omitempty"`
 	Metadata       Metadata        `xml:"metadata,omitempty"`
 	Features       *Features       `xml:"features,omitempty"`
 	CPU            CPU             `xml:"cpu"`
@@ -442,6 +452,8 @@ type MigrationMetadata struct {
 	FailureReason  string           `xml:"failureReason,omitempty"`
 	AbortStatus    string           `xml:"abortStatus,omitempty"`
 	Mode           v1.MigrationMode `xml:"mode,omitempty"`
+	DiskTotal      uint64           `xml:"diskTotal,omitempty"`
+	DiskProcessed  uint64           `xml:"diskProcessed,omitempty"`
 }
 
 type BackupMetadata struct {
@@ -541,6 +553,27 @@ type Arg struct {
 	Value string `xml:"value,attr"`
 }
 
+// QEMUOverride overrides the properties of the QEMU devices generated by libvirt
+// https://libvirt.org/kbase/qemu-passthrough-security.html#overriding-properties-of-qemu-devices
+type QEMUOverride struct {
+	Devices []QEMUOverrideDevice `xml:"qemu:device"`
+}
+
+type QEMUOverrideDevice struct {
+	Alias    string               `xml:"alias,attr"`
+	Frontend QEMUOverrideFrontend `xml:"qemu:frontend"`
+}
+
+type QEMUOverrideFrontend struct {
+	Properties []QEMUOverrideProperty `xml:"qemu:property"`
+}
+
+type QEMUOverrideProperty struct {
+	Name  string `xml:"name,attr"`
+	Type  string `xml:"type,attr"`
+	Value string `xml:"value,attr,omitempty"`
+}
+
 type Resource struct {
 	Partition string `xml:"partition"`
 }
@@ -661,9 +694,9 @@ type TPMBackend struct {
 // RedirectedDevice describes a device to be redirected
 // See: https://libvirt.org/formatdomain.html#redirected-devices
 type RedirectedDevice struct {
-	Type   string                 `xml:"type,attr"`
-	Bus    string                 `xml:"bus,attr"`
-	Source RedirectedDeviceSource `xml:"source"`
+	Type   string                  `xml:"type,attr"`
+	Bus    string                  `xml:"bus,attr"`
+	Source *RedirectedDeviceSource `xml:"source,omitempty"`
 }
 
 type RedirectedDeviceSource struct {
@@ -827,6 +860,12 @@ type DiskSource struct {
 	Reservations  *Reservations   `xml:"reservations,omitempty"`
 	Slices        []Slice         `xml:"slices,omitempty"`
 	DataStore     *DataStore      `xml:"dataStore,omitempty"`
+	Encryption    *DiskEncryption `xml:"encryption,omitempty"`
+}
+
+type DiskEncryption struct {
+	Format string      `xml:"format,attr"`
+	Secret *DiskSecret `xml:"secret,omitempty"`
 }
 
 type DiskTarget struct {
@@ -995,9 +1034,18 @@ type ACPI struct {
 }
 
 type InterfaceDriver struct {
-	Name   string `xml:"name,attr"`
-	Queues *uint  `xml:"queues,attr,omitempty"`
-	IOMMU  string `xml:"iommu,attr,omitempty"`
+	Name   string               `xml:"name,attr"`
+	Queues *uint                `xml:"queues,attr,omitempty"`
+	IOMMU  string               `xml:"iommu,attr,omitempty"`
+	Host   *InterfaceDriverHost `xml:"host,omitempty"`
+}
+
+type InterfaceDriverHost struct {
+	CSum     string `xml:"csum,attr,omitempty"`
+	TSO4     string `xml:"tso4,attr,omitempty"`
+	TSO6     string `xml:"tso6,attr,omitempty"`
+	UFO      string `xml:"ufo,attr,omitempty"`
+	MrgRxBuf string `xml:"mrg_rxbuf,attr,omitempty"`
 }
 
 type LinkState struct {
@@ -1024,6 +1072,9 @@ type FilterRef struct {
 }
 
 type InterfaceSource struct {
+	// Type and Path are the socket of vhostuser interfaces
+	Type    string   `xml:"type,attr,omitempty"`
+	Path    string   `xml:"path,attr,omitempty"`
 	Network string   `xml:"network,attr,omitempty"`
 	Device  string   `xml:"dev,attr,omitempty"`
 	Bridge  string   `xml:"bridge,attr,omitempty"`
@@ -1170,11 +1221,16 @@ type BIOS struct {
 }
 
 type SysInfo struct {
-	Type      string  `xml:"type,attr"`
-	System    []Entry `xml:"system>entry"`
-	BIOS      []Entry `xml:"bios>entry"`
-	BaseBoard []Entry `xml:"baseBoard>entry"`
-	Chassis   []Entry `xml:"chassis>entry"`
+	Type       string      `xml:"type,attr"`
+	System     []Entry     `xml:"system>entry"`
+	BIOS       []Entry     `xml:"bios>entry"`
+	BaseBoard  []Entry     `xml:"baseBoard>entry"`
+	Chassis    []Entry     `xml:"chassis>entry"`
+	OEMStrings *OEMStrings `xml:"oemStrings,omitempty"`
+}
+
+type OEMStrings struct {
+	Entries []string `xml:"entry"`
 }
 
 type Entry struct {
@@ -1192,6 +1248,9 @@ type LaunchSecurity struct {
 	Cbitpos                string `xml:"cbitpos,omitempty"`
 	ReducedPhysBits        string `xml:"reducedPhysBits,omitempty"`
 	Policy                 string `xml:"policy,omitempty"`
+	IDBlock                string `xml:"idBlock,omitempty"`
+	IDAuth                 string `xml:"idAuth,omitempty"`
+	HostData               string `xml:"hostData,omitempty"`
 	QuoteGenerationService *QGS   `xml:"quoteGenerationService,omitempty"`
 }
 
@@ -1376,12 +1435,14 @@ type IOThreads struct {
 type SecretUsage struct {
 	Type   string `xml:"type,attr"`
 	Target string `xml:"target,omitempty"`
+	Volume string `xml:"volume,omitempty"`
 }
 
 type SecretSpec struct {
 	XMLName     xml.Name    `xml:"secret"`
 	Ephemeral   string      `xml:"ephemeral,attr"`
 	Private     string      `xml:"private,attr"`
+	UUID        string      `xml:"uuid,omitempty"`
 	Description string      `xml:"description,omitempty"`
 	Usage       SecretUsage `xml:"usage,omitempty"`
 }
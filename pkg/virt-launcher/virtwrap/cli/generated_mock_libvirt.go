@@ -73,6 +73,20 @@ func (mr *MockConnectionMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockConnection)(nil).Close))
 }
 
+// DefineSecret mocks base method.
+func (m *MockConnection) DefineSecret(xml string, value []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DefineSecret", xml, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DefineSecret indicates an expected call of DefineSecret.
+func (mr *MockConnectionMockRecorder) DefineSecret(xml, value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefineSecret", reflect.TypeOf((*MockConnection)(nil).DefineSecret), xml, value)
+}
+
 // DomainDefineXML mocks base method.
 func (m *MockConnection) DomainDefineXML(xml string) (VirDomain, error) {
 	m.ctrl.T.Helper()
@@ -496,6 +510,20 @@ func (mr *MockVirDomainMockRecorder) BackupBegin(backupXML, checkpointXML, flags
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackupBegin", reflect.TypeOf((*MockVirDomain)(nil).BackupBegin), backupXML, checkpointXML, flags)
 }
 
+// BlockJobAbort mocks base method.
+func (m *MockVirDomain) BlockJobAbort(disk string, flags libvirt.DomainBlockJobAbortFlags) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockJobAbort", disk, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockJobAbort indicates an expected call of BlockJobAbort.
+func (mr *MockVirDomainMockRecorder) BlockJobAbort(disk, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockJobAbort", reflect.TypeOf((*MockVirDomain)(nil).BlockJobAbort), disk, flags)
+}
+
 // BlockResize mocks base method.
 func (m *MockVirDomain) BlockResize(disk string, size uint64, flags libvirt.DomainBlockResizeFlags) error {
 	m.ctrl.T.Helper()
@@ -638,6 +666,21 @@ func (mr *MockVirDomainMockRecorder) GetBlockInfo(disk, flags any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockInfo", reflect.TypeOf((*MockVirDomain)(nil).GetBlockInfo), disk, flags)
 }
 
+// GetBlockJobInfo mocks base method.
+func (m *MockVirDomain) GetBlockJobInfo(disk string, flags libvirt.DomainBlockJobInfoFlags) (*libvirt.DomainBlockJobInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockJobInfo", disk, flags)
+	ret0, _ := ret[0].(*libvirt.DomainBlockJobInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockJobInfo indicates an expected call of GetBlockJobInfo.
+func (mr *MockVirDomainMockRecorder) GetBlockJobInfo(disk, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockJobInfo", reflect.TypeOf((*MockVirDomain)(nil).GetBlockJobInfo), disk, flags)
+}
+
 // GetDiskErrors mocks base method.
 func (m *MockVirDomain) GetDiskErrors(flags uint32) ([]libvirt.DomainDiskError, error) {
 	m.ctrl.T.Helper()
@@ -47,6 +47,7 @@ const ConnectionInterval = 500 * time.Millisecond
 type Connection interface {
 	LookupDomainByName(name string) (VirDomain, error)
 	DomainDefineXML(xml string) (VirDomain, error)
+	DefineSecret(xml string, value []byte) error
 	Close() (int, error)
 	DomainEventJobCompletedRegister(callback libvirt.DomainEventJobCompletedCallback) error
 	DomainEventLifecycleRegister(callback libvirt.DomainEventLifecycleCallback) error
@@ -248,6 +249,22 @@ func (l *LibvirtConnection) DomainDefineXML(xml string) (dom VirDomain, err erro
 	return
 }
 
+// DefineSecret defines the secret described by the XML and sets its value
+func (l *LibvirtConnection) DefineSecret(xml string, value []byte) error {
+	if err := l.reconnectIfNecessary(); err != nil {
+		return err
+	}
+
+	secret, err := l.Connect.SecretDefineXML(xml, 0)
+	if err != nil {
+		l.checkConnectionLost(err)
+		return err
+	}
+	defer secret.Free()
+
+	return secret.SetValue(value, 0)
+}
+
 func (l *LibvirtConnection) ListAllDomains(flags libvirt.ConnectListAllDomainsFlags) ([]VirDomain, error) {
 	if err := l.reconnectIfNecessary(); err != nil {
 		return nil, err
@@ -637,6 +654,8 @@ type VirDomain interface {
 	Resume() error
 	BlockResize(disk string, size uint64, flags libvirt.DomainBlockResizeFlags) error
 	GetBlockInfo(disk string, flags uint32) (*libvirt.DomainBlockInfo, error)
+	GetBlockJobInfo(disk string, flags libvirt.DomainBlockJobInfoFlags) (*libvirt.DomainBlockJobInfo, error)
+	BlockJobAbort(disk string, flags libvirt.DomainBlockJobAbortFlags) error
 	AttachDeviceFlags(xml string, flags libvirt.DomainDeviceModifyFlags) error
 	UpdateDeviceFlags(xml string, flags libvirt.DomainDeviceModifyFlags) error
 	DetachDeviceFlags(xml string, flags libvirt.DomainDeviceModifyFlags) error
@@ -35,6 +35,8 @@ import (
 	"kubevirt.io/client-go/log"
 
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
+	"kubevirt.io/kubevirt/pkg/network/bindingplugin/errcode"
+	neterrors "kubevirt.io/kubevirt/pkg/network/errors"
 	grpcutil "kubevirt.io/kubevirt/pkg/util/net/grpc"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap"
@@ -204,6 +206,15 @@ func (l *Launcher) SyncVirtualMachine(_ context.Context, request *cmdv1.VMIReque
 		log.Log.Object(vmi).Reason(err).Errorf("Failed to sync vmi")
 		response.Success = false
 		response.Message = getErrorMessage(err)
+		var hotplugErr *neterrors.InterfaceHotplugError
+		if errors.As(err, &hotplugErr) {
+			response.HotplugFailedInterface = hotplugErr.Interface
+		}
+		var notReadyErr *errcode.NotReadyError
+		if errors.As(err, &notReadyErr) {
+			response.NotReadySidecar = notReadyErr.Sidecar
+			response.NotReadyErrorCode = string(notReadyErr.Code)
+		}
 		return response, nil
 	}
 
@@ -953,3 +964,79 @@ func (l *Launcher) RedefineCheckpoint(_ context.Context, request *cmdv1.Redefine
 		},
 	}, nil
 }
+
+// GetDomainJobs returns the libvirt jobs running on the domain
+func (l *Launcher) GetDomainJobs(_ context.Context, request *cmdv1.VMIRequest) (*cmdv1.DomainJobsResponse, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	domainJobsResponse := &cmdv1.DomainJobsResponse{
+		Response: response,
+	}
+	if !response.Success {
+		return domainJobsResponse, nil
+	}
+
+	jobs, err := l.domainManager.GetDomainJobs(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to get domain jobs")
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return domainJobsResponse, nil
+	}
+
+	jJobs, err := json.Marshal(jobs)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to marshal domain jobs")
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return domainJobsResponse, nil
+	}
+	domainJobsResponse.DomainJobsResponse = string(jJobs)
+
+	return domainJobsResponse, nil
+}
+
+// GetQemuCommandLine returns the command line of the QEMU process, with its secrets redacted
+func (l *Launcher) GetQemuCommandLine(_ context.Context, request *cmdv1.VMIRequest) (*cmdv1.QemuCommandLineResponse, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	commandLineResponse := &cmdv1.QemuCommandLineResponse{
+		Response: response,
+	}
+	if !response.Success {
+		return commandLineResponse, nil
+	}
+
+	args, err := l.domainManager.GetQemuCommandLine(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to get the QEMU command line")
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return commandLineResponse, nil
+	}
+	commandLineResponse.Args = args
+
+	return commandLineResponse, nil
+}
+
+// CancelDomainJob aborts a libvirt job running on the domain
+func (l *Launcher) CancelDomainJob(_ context.Context, request *cmdv1.CancelDomainJobRequest) (*cmdv1.Response, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return response, nil
+	}
+
+	var options v1.CancelDomainJobOptions
+	if err := json.Unmarshal(request.Options, &options); err != nil {
+		response.Success = false
+		response.Message = "No valid cancel domain job options present in command server request"
+		return response, nil
+	}
+
+	if err := l.domainManager.CancelDomainJob(vmi, &options); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to cancel domain job")
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return response, nil
+	}
+
+	return response, nil
+}
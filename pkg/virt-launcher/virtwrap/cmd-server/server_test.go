@@ -37,6 +37,8 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/info"
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
+	"kubevirt.io/kubevirt/pkg/network/bindingplugin/errcode"
+	neterrors "kubevirt.io/kubevirt/pkg/network/errors"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent"
@@ -92,6 +94,29 @@ var _ = Describe("Virt remote commands", func() {
 			Expect(client.SyncVirtualMachine(vmi, &cmdv1.VirtualMachineOptions{})).To(Succeed())
 		})
 
+		It("should report the interface which failed to be hot plugged", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().SyncVMI(vmi, allowEmulation, &cmdv1.VirtualMachineOptions{}).
+				Return(nil, neterrors.CreateInterfaceHotplugError("foonet", errors.New("boom")))
+
+			err := client.SyncVirtualMachine(vmi, &cmdv1.VirtualMachineOptions{})
+			var hotplugErr *cmdclient.InterfaceHotplugError
+			Expect(errors.As(err, &hotplugErr)).To(BeTrue())
+			Expect(hotplugErr.Interface).To(Equal("foonet"))
+		})
+
+		It("should report the sidecar which failed its readiness check", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().SyncVMI(vmi, allowEmulation, &cmdv1.VirtualMachineOptions{}).
+				Return(nil, errcode.NotReady("hook-sidecar-0", errcode.DeviceMissing, errors.New("boom")))
+
+			err := client.SyncVirtualMachine(vmi, &cmdv1.VirtualMachineOptions{})
+			var notReadyErr *cmdclient.SidecarNotReadyError
+			Expect(errors.As(err, &notReadyErr)).To(BeTrue())
+			Expect(notReadyErr.Sidecar).To(Equal("hook-sidecar-0"))
+			Expect(notReadyErr.Code).To(Equal(errcode.DeviceMissing))
+		})
+
 		It("should kill a vmi", func() {
 			vmi := v1.NewVMIReferenceFromName("testvmi")
 			domainManager.EXPECT().KillVMI(vmi)
@@ -300,6 +325,22 @@ var _ = Describe("Virt remote commands", func() {
 			Expect(mockedQemuVersion).To(Equal(qemuVersion.GetVersion()))
 		})
 
+		It("should return the qemu command line", func() {
+			args := []string{"/usr/libexec/qemu-kvm", "-netdev", `{"type":"vhost-vdpa","vhostdev":"/dev/vhost-vdpa-0","id":"hostua-default"}`}
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().GetQemuCommandLine(vmi).Return(args, nil)
+			commandLine, err := client.GetQemuCommandLine(vmi)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(commandLine.Args).To(Equal(args))
+		})
+
+		It("should fail to return the qemu command line when the domain manager fails", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().GetQemuCommandLine(vmi).Return(nil, errors.New("no such file or directory"))
+			_, err := client.GetQemuCommandLine(vmi)
+			Expect(err).To(MatchError(ContainSubstring("no such file or directory")))
+		})
+
 		It("should return SEV platform info", func() {
 			sevPlatformInfo := &v1.SEVPlatformInfo{
 				PDH:       "AAABBBCCC",
@@ -54,6 +54,10 @@ func (converterAMD64) IsUSBNeeded(vmi *v1.VirtualMachineInstance) bool {
 		return true
 	}
 
+	if spice := vmi.Spec.Domain.Devices.Spice; spice != nil && spice.USBRedirection {
+		return true
+	}
+
 	if device.USBDevicesFound(vmi.Spec.Domain.Devices.HostDevices) {
 		return true
 	}
@@ -44,5 +44,12 @@ var _ = Describe("Arch Converter", func() {
 			ac := NewConverter("amd64")
 			Expect(ac.IsUSBNeeded(vmi)).To(BeTrue())
 		})
+
+		It("should require a USB controller on amd64 when SPICE USB redirection is enabled", func() {
+			vmi := libvmi.New()
+			vmi.Spec.Domain.Devices.Spice = &v1.SpiceDevice{USBRedirection: true}
+			ac := NewConverter("amd64")
+			Expect(ac.IsUSBNeeded(vmi)).To(BeTrue())
+		})
 	})
 })
@@ -20,9 +20,12 @@
 package compute
 
 import (
+	"fmt"
+
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/downwardmetrics"
+	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
@@ -35,6 +38,10 @@ func (c ChannelsDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, do
 		domain.Spec.Devices.Channels = append(domain.Spec.Devices.Channels, newDownwardMetricsChannel())
 	}
 
+	for _, channel := range vmi.Spec.Domain.Devices.VirtioChannels {
+		domain.Spec.Devices.Channels = append(domain.Spec.Devices.Channels, newVirtioChannel(vmi, channel.Name))
+	}
+
 	return nil
 }
 
@@ -62,3 +69,17 @@ func newDownwardMetricsChannel() api.Channel {
 		},
 	}
 }
+
+func newVirtioChannel(vmi *v1.VirtualMachineInstance, name string) api.Channel {
+	return api.Channel{
+		Type: "unix",
+		Source: &api.ChannelSource{
+			Mode: "bind",
+			Path: fmt.Sprintf("%s/%s/virt-channel-%s", util.VirtPrivateDir, vmi.ObjectMeta.UID, name),
+		},
+		Target: &api.ChannelTarget{
+			Type: v1.VirtIO,
+			Name: v1.VirtioChannelGuestNamePrefix + name,
+		},
+	}
+}
@@ -92,4 +92,38 @@ var _ = Describe("Channels Domain Configurator", func() {
 		}
 		Expect(domain).To(Equal(expectedDomain))
 	})
+	It("Should configure a bound unix channel for every virtio channel on the VMI", func() {
+		vmi := libvmi.New()
+		vmi.UID = "1234"
+		vmi.Spec.Domain.Devices.VirtioChannels = []v1.VirtioChannel{{Name: "agent"}, {Name: "oob"}}
+		var domain api.Domain
+
+		Expect(compute.ChannelsDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+
+		Expect(domain.Spec.Devices.Channels).To(HaveLen(3))
+		Expect(domain.Spec.Devices.Channels[1:]).To(Equal([]api.Channel{
+			{
+				Type: "unix",
+				Source: &api.ChannelSource{
+					Mode: "bind",
+					Path: "/var/run/kubevirt-private/1234/virt-channel-agent",
+				},
+				Target: &api.ChannelTarget{
+					Type: v1.VirtIO,
+					Name: "io.kubevirt.agent",
+				},
+			},
+			{
+				Type: "unix",
+				Source: &api.ChannelSource{
+					Mode: "bind",
+					Path: "/var/run/kubevirt-private/1234/virt-channel-oob",
+				},
+				Target: &api.ChannelTarget{
+					Type: v1.VirtIO,
+					Name: "io.kubevirt.oob",
+				},
+			},
+		}))
+	})
 })
@@ -61,6 +61,16 @@ func (g GraphicsDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, do
 		},
 	}
 
+	if vmi.Spec.Domain.Devices.Spice != nil {
+		domain.Spec.Devices.Graphics = append(domain.Spec.Devices.Graphics, api.Graphics{
+			Listen: &api.GraphicsListen{
+				Type:   "socket",
+				Socket: fmt.Sprintf("/var/run/kubevirt-private/%s/virt-spice", vmi.ObjectMeta.UID),
+			},
+			Type: "spice",
+		})
+	}
+
 	g.configureVideoDevice(vmi, domain)
 
 	return nil
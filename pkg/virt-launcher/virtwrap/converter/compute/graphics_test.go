@@ -84,6 +84,32 @@ var _ = Describe("Graphics Domain Configurator", func() {
 			Entry("arm64 when AutoattachGraphicsDevice is nil", "arm64", nil, newExpectedARM64VideoDevice()),
 			Entry("s390x when AutoattachGraphicsDevice is nil", "s390x", nil, newExpectedS390XVideoDevice()),
 		)
+
+		It("should configure SPICE next to VNC when a SPICE device is specified", func() {
+			vmi := libvmi.New(libvmi.WithUID("test-uid"))
+			vmi.Spec.Domain.Devices.Spice = &v1.SpiceDevice{}
+
+			domain := api.Domain{}
+			configurator := compute.NewGraphicsDomainConfigurator("amd64", false)
+			Expect(configurator.Configure(vmi, &domain)).To(Succeed())
+
+			Expect(domain.Spec.Devices.Graphics).To(Equal([]api.Graphics{
+				{
+					Type: "vnc",
+					Listen: &api.GraphicsListen{
+						Type:   "socket",
+						Socket: "/var/run/kubevirt-private/test-uid/virt-vnc",
+					},
+				},
+				{
+					Type: "spice",
+					Listen: &api.GraphicsListen{
+						Type:   "socket",
+						Socket: "/var/run/kubevirt-private/test-uid/virt-spice",
+					},
+				},
+			}))
+		})
 	})
 
 	Context("Video device configuration", func() {
@@ -68,6 +68,9 @@ func amd64LaunchSecurity(vmi *v1.VirtualMachineInstance) *api.LaunchSecurity {
 		}
 		// Use Default Policy
 		domain.Policy = "0x" + strconv.FormatUint(uint64(snpPolicyBits), 16)
+		domain.IDBlock = launchSec.SNP.IDBlock
+		domain.IDAuth = launchSec.SNP.IDAuth
+		domain.HostData = launchSec.SNP.HostData
 		return domain
 	} else if launchSec.SEV != nil {
 		sevPolicyBits := launchsecurity.SEVPolicyToBits(launchSec.SEV.Policy)
@@ -68,6 +68,25 @@ var _ = Describe("LaunchSecurity Domain Configurator", func() {
 		Expect(domain).To(Equal(expectedDomain))
 	})
 
+	It("should configure the SEV-SNP launch parameters of the guest owner on amd64", func() {
+		vmi := libvmi.New(libvmi.WithSEV(false, true), libvmi.WithSEVSNPAttestation())
+		vmi.Spec.Domain.LaunchSecurity.SNP.IDBlock = "aWQtYmxvY2s="
+		vmi.Spec.Domain.LaunchSecurity.SNP.IDAuth = "aWQtYXV0aA=="
+		vmi.Spec.Domain.LaunchSecurity.SNP.HostData = "aG9zdC1kYXRh"
+		var domain api.Domain
+
+		configurator := compute.NewLaunchSecurityDomainConfigurator("amd64")
+		Expect(configurator.Configure(vmi, &domain)).To(Succeed())
+
+		Expect(domain.Spec.LaunchSecurity).To(Equal(&api.LaunchSecurity{
+			Type:     "sev-snp",
+			Policy:   "0x30000",
+			IDBlock:  "aWQtYmxvY2s=",
+			IDAuth:   "aWQtYXV0aA==",
+			HostData: "aG9zdC1kYXRh",
+		}))
+	})
+
 	It("should configure LaunchSecurity when specified in the VMI on intel", func() {
 		vmi := libvmi.New(withTDX())
 		var domain api.Domain
@@ -28,6 +28,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/config"
 	containerdisk "kubevirt.io/kubevirt/pkg/container-disk"
+	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
@@ -140,6 +141,8 @@ func configureBIOS(firmware *v1.Firmware, domain *api.Domain) {
 func configureKernelBoot(vmi *v1.VirtualMachineInstance, firmware *v1.Firmware, domain *api.Domain) {
 	if util.HasKernelBootContainerImage(vmi) {
 		configureKernelBootContainer(vmi, firmware.KernelBoot, domain)
+	} else if util.HasKernelBootVolume(vmi) {
+		configureKernelBootVolume(vmi, firmware.KernelBoot, domain)
 	}
 
 	// Define custom command-line arguments even if kernel-boot container is not defined
@@ -165,6 +168,25 @@ func configureKernelBootContainer(vmi *v1.VirtualMachineInstance, kb *v1.KernelB
 	}
 }
 
+func configureKernelBootVolume(vmi *v1.VirtualMachineInstance, kb *v1.KernelBoot, domain *api.Domain) {
+	log.Log.Object(vmi).Infof("kernel boot from volume %s defined for VMI. Converting to domain XML", kb.Volume.Name)
+
+	// The PVC backing the volume is mounted in the launcher as any other filesystem PVC
+	volumeDir := hostdisk.GetMountedHostDiskDir(kb.Volume.Name)
+
+	if kb.Volume.KernelPath != "" {
+		kernelPath := filepath.Join(volumeDir, kb.Volume.KernelPath)
+		log.Log.Object(vmi).Infof("setting kernel path for kernel boot: %s", kernelPath)
+		domain.Spec.OS.Kernel = kernelPath
+	}
+
+	if kb.Volume.InitrdPath != "" {
+		initrdPath := filepath.Join(volumeDir, kb.Volume.InitrdPath)
+		log.Log.Object(vmi).Infof("setting initrd path for kernel boot: %s", initrdPath)
+		domain.Spec.OS.Initrd = initrdPath
+	}
+}
+
 func configureACPI(firmware *v1.Firmware, domain *api.Domain, volumes []v1.Volume) error {
 	if firmware.ACPI == nil {
 		return nil
@@ -108,6 +108,20 @@ var _ = Describe("OS Domain Configurator", func() {
 		Entry("kernel args are set when specified", libvmi.New(withKernelArgs("test-args")), "test-args"),
 	)
 
+	It("should boot the kernel and initrd stored on the kernel boot volume", func() {
+		vmi := libvmi.New(libvmi.WithKernelBootVolume("kernel-pvc", "/boot/vmlinuz", "/boot/initrd.img", "console=ttyS0"))
+		var domain api.Domain
+
+		Expect(compute.NewOSDomainConfigurator(!smbiosEnabled, nil).Configure(vmi, &domain)).To(Succeed())
+
+		expectedDomain := newDomainWithOS(api.OS{
+			Kernel:     "/var/run/kubevirt-private/vmi-disks/kernel-pvc/boot/vmlinuz",
+			Initrd:     "/var/run/kubevirt-private/vmi-disks/kernel-pvc/boot/initrd.img",
+			KernelArgs: "console=ttyS0",
+		})
+		Expect(domain).To(Equal(expectedDomain))
+	})
+
 	Context("EFI configuration", func() {
 		var efiConfig *compute.EFIConfiguration
 
@@ -32,6 +32,10 @@ type SoundDomainConfigurator struct{}
 func (s SoundDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
 	vmiSoundDevice := vmi.Spec.Domain.Devices.Sound
 	if vmiSoundDevice == nil {
+		// SPICE audio needs a sound card to stream from, libvirt picks the SPICE audio backend on its own
+		if spice := vmi.Spec.Domain.Devices.Spice; spice != nil && spice.Audio {
+			domain.Spec.Devices.SoundCards = []api.SoundCard{{Model: "ich9"}}
+		}
 		return nil
 	}
 
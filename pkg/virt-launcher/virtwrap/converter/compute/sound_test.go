@@ -71,6 +71,15 @@ var _ = Describe("Sound Domain Configurator", func() {
 		),
 	)
 
+	It("Should configure an ich9 sound device when SPICE audio is requested without sound", func() {
+		vmi := libvmi.New()
+		vmi.Spec.Domain.Devices.Spice = &v1.SpiceDevice{Audio: true}
+		var domain api.Domain
+
+		Expect(compute.SoundDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+		Expect(domain.Spec.Devices.SoundCards).To(Equal([]api.SoundCard{{Model: "ich9"}}))
+	})
+
 	It("should fail when an invalid model is specified", func() {
 		vmi := libvmi.New(withSound(v1.SoundDevice{Name: deviceName, Model: "invalid-model"}))
 		var domain api.Domain
@@ -30,6 +30,15 @@ import (
 type UsbRedirectDeviceDomainConfigurator struct{}
 
 func (_ UsbRedirectDeviceDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
+	if spice := vmi.Spec.Domain.Devices.Spice; spice != nil && spice.USBRedirection {
+		for i := 0; i < v1.SpiceUSBRedirectionMaxNumberOf; i++ {
+			domain.Spec.Devices.Redirs = append(domain.Spec.Devices.Redirs, api.RedirectedDevice{
+				Type: "spicevmc",
+				Bus:  "usb",
+			})
+		}
+	}
+
 	clientDevices := vmi.Spec.Domain.Devices.ClientPassthrough
 
 	// Default is to have USB Redirection disabled
@@ -46,12 +55,12 @@ func (_ UsbRedirectDeviceDomainConfigurator) Configure(vmi *v1.VirtualMachineIns
 		redirectDevices[i] = api.RedirectedDevice{
 			Type: "unix",
 			Bus:  "usb",
-			Source: api.RedirectedDeviceSource{
+			Source: &api.RedirectedDeviceSource{
 				Mode: "bind",
 				Path: path,
 			},
 		}
 	}
-	domain.Spec.Devices.Redirs = redirectDevices
+	domain.Spec.Devices.Redirs = append(domain.Spec.Devices.Redirs, redirectDevices...)
 	return nil
 }
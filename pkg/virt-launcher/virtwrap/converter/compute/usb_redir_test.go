@@ -68,7 +68,7 @@ var _ = Describe("USB Redirect Device Domain Configurator", func() {
 				expectedDomain.Spec.Devices.Redirs[i] = api.RedirectedDevice{
 					Type: "unix",
 					Bus:  "usb",
-					Source: api.RedirectedDeviceSource{
+					Source: &api.RedirectedDeviceSource{
 						Mode: "bind",
 						Path: path,
 					},
@@ -78,4 +78,30 @@ var _ = Describe("USB Redirect Device Domain Configurator", func() {
 			Expect(domain).To(Equal(expectedDomain))
 		})
 	})
+
+	Context("When SPICE USB redirection is enabled", func() {
+		It("should configure the maximum number of SPICE redirect devices", func() {
+			vmi := libvmi.New()
+			vmi.Spec.Domain.Devices.Spice = &v1.SpiceDevice{USBRedirection: true}
+			domain := api.Domain{}
+
+			Expect(compute.UsbRedirectDeviceDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+
+			Expect(domain.Spec.Devices.Redirs).To(HaveLen(v1.SpiceUSBRedirectionMaxNumberOf))
+			for _, redir := range domain.Spec.Devices.Redirs {
+				Expect(redir).To(Equal(api.RedirectedDevice{Type: "spicevmc", Bus: "usb"}))
+			}
+		})
+
+		It("should keep the ClientPassthrough redirect devices", func() {
+			vmi := libvmi.New()
+			vmi.Spec.Domain.Devices.Spice = &v1.SpiceDevice{USBRedirection: true}
+			vmi.Spec.Domain.Devices.ClientPassthrough = &v1.ClientPassthroughDevices{}
+			domain := api.Domain{}
+
+			Expect(compute.UsbRedirectDeviceDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+
+			Expect(domain.Spec.Devices.Redirs).To(HaveLen(v1.SpiceUSBRedirectionMaxNumberOf + v1.UsbClientPassthroughMaxNumberOf))
+		})
+	})
 })
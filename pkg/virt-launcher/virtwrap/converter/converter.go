@@ -51,6 +51,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/os/disk"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/safepath"
+	"kubevirt.io/kubevirt/pkg/storage/encryption"
 	"kubevirt.io/kubevirt/pkg/storage/reservation"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/util"
@@ -181,6 +182,19 @@ func setReservation(disk *api.Disk) {
 	}
 }
 
+func setDiskEncryption(vmi *v1.VirtualMachineInstance, diskDevice *v1.Disk, disk *api.Disk) {
+	if diskDevice.Encryption == nil {
+		return
+	}
+	disk.Source.Encryption = &api.DiskEncryption{
+		Format: "luks",
+		Secret: &api.DiskSecret{
+			Type: "passphrase",
+			UUID: encryption.SecretUUID(vmi.UID, diskDevice.Name),
+		},
+	}
+}
+
 func setErrorPolicy(diskDevice *v1.Disk, disk *api.Disk) error {
 	if diskDevice.ErrorPolicy == nil {
 		disk.Driver.ErrorPolicy = v1.DiskErrorPolicyStop
@@ -189,6 +203,9 @@ func setErrorPolicy(diskDevice *v1.Disk, disk *api.Disk) error {
 	switch *diskDevice.ErrorPolicy {
 	case v1.DiskErrorPolicyStop, v1.DiskErrorPolicyIgnore, v1.DiskErrorPolicyReport, v1.DiskErrorPolicyEnospace:
 		disk.Driver.ErrorPolicy = *diskDevice.ErrorPolicy
+	case v1.DiskErrorPolicyRetry, v1.DiskErrorPolicyPause:
+		// libvirt pauses the domain, virt-handler decides whether to resume it
+		disk.Driver.ErrorPolicy = v1.DiskErrorPolicyStop
 	default:
 		return fmt.Errorf("error policy %s not recognized", *diskDevice.ErrorPolicy)
 	}
@@ -1105,6 +1122,7 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 		if err := Convert_v1_BlockSize_To_api_BlockIO(&disk, &newDisk); err != nil {
 			return err
 		}
+		setDiskEncryption(vmi, &disk, &newDisk)
 
 		_, isPermVolume := c.PermanentVolumes[disk.Name]
 		// if len(c.PermanentVolumes) == 0, it means the vmi is not ready yet, add all disks
@@ -1122,6 +1140,10 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 		}
 	}
 
+	if err := device.ValidatePCIAddresses(&domain.Spec); err != nil {
+		return err
+	}
+
 	if c.Architecture.SupportPCIHole64Disabling() && shouldDisablePCIHole64(vmi) {
 		domain.Spec.Devices.Controllers = append(domain.Spec.Devices.Controllers,
 			api.Controller{
@@ -53,6 +53,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/os/disk"
 	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/storage/encryption"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/util/hardware"
@@ -1789,7 +1790,38 @@ var _ = Describe("Converter", func() {
 			Entry("ErrorPolicy equal to ignore", pointer.P(v1.DiskErrorPolicyIgnore), "ignore"),
 			Entry("ErrorPolicy equal to report", pointer.P(v1.DiskErrorPolicyReport), "report"),
 			Entry("ErrorPolicy equal to enospace", pointer.P(v1.DiskErrorPolicyEnospace), "enospace"),
+			Entry("ErrorPolicy equal to retry", pointer.P(v1.DiskErrorPolicyRetry), "stop"),
+			Entry("ErrorPolicy equal to pause", pointer.P(v1.DiskErrorPolicyPause), "stop"),
 		)
+		It("Should open encrypted disks with the libvirt secret of the disk", func() {
+			vmi.Spec.Domain.Devices.Disks[0] = v1.Disk{
+				Name: "mydisk",
+				DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{
+						Bus: v1.VirtIO,
+					},
+				},
+				Encryption: &v1.DiskEncryption{SecretName: "luks-secret"},
+			}
+			vmi.Spec.Volumes[0] = v1.Volume{
+				Name: "mydisk",
+				VolumeSource: v1.VolumeSource{
+					PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+						PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+							ClaimName: "testclaim",
+						},
+					},
+				},
+			}
+			domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+			Expect(domainSpec.Devices.Disks[0].Source.Encryption).To(Equal(&api.DiskEncryption{
+				Format: "luks",
+				Secret: &api.DiskSecret{
+					Type: "passphrase",
+					UUID: encryption.SecretUUID(vmi.UID, "mydisk"),
+				},
+			}))
+		})
 		DescribeTable("Should set the vmport by arch", func(arch string) {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 			c.Architecture = archconverter.NewConverter(arch)
@@ -3414,6 +3446,33 @@ var _ = Describe("Converter", func() {
 				"should be capped to the maximum number of queues on tap devices")
 		})
 
+		It("should assign queues according to the vCPUs per queue policy", func() {
+			vmi.Spec.Domain.CPU = &v1.CPU{
+				Cores:   8,
+				Sockets: 1,
+				Threads: 1,
+			}
+			vmi.Spec.Domain.Devices.NetworkInterfaceMultiQueuePolicy = &v1.NetworkQueuesPolicy{
+				VCPUsPerQueue: pointer.P(uint32(2)),
+			}
+			domain := vmiToDomain(vmi, &convertertypes.ConverterContext{Architecture: archconverter.NewConverter(runtime.GOARCH), AllowEmulation: true})
+			Expect(*(domain.Spec.Devices.Interfaces[0].Driver.Queues)).To(Equal(uint(4)),
+				"expected a queue pair for every two vCPUs")
+		})
+
+		It("should cap the number of queues to the policy maximum", func() {
+			vmi.Spec.Domain.CPU = &v1.CPU{
+				Cores:   64,
+				Sockets: 1,
+				Threads: 1,
+			}
+			vmi.Spec.Domain.Devices.NetworkInterfaceMultiQueuePolicy = &v1.NetworkQueuesPolicy{
+				MaxQueues: pointer.P(uint32(16)),
+			}
+			domain := vmiToDomain(vmi, &convertertypes.ConverterContext{Architecture: archconverter.NewConverter(runtime.GOARCH), AllowEmulation: true})
+			Expect(*(domain.Spec.Devices.Interfaces[0].Driver.Queues)).To(Equal(uint(16)),
+				"should be capped to the maximum number of queues of the policy")
+		})
 	})
 	Context("Realtime", func() {
 		var vmi *v1.VirtualMachineInstance
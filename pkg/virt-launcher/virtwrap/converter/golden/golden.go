@@ -0,0 +1,204 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Package golden renders the libvirt domain XML of a VirtualMachineInstance
+// without a running virt-launcher and compares it against stored golden files.
+// It allows network binding plugin and hook sidecar authors to validate the
+// domain they receive in their unit and e2e pipelines.
+package golden
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	ephemeraldisk "kubevirt.io/kubevirt/pkg/ephemeral-disk"
+	"kubevirt.io/kubevirt/pkg/network/domainspec"
+	"kubevirt.io/kubevirt/pkg/os/disk"
+	kutil "kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/arch"
+	convertertypes "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/types"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/efi"
+)
+
+const (
+	defaultOVMFPath         = "/usr/share/OVMF"
+	defaultEphemeralDiskDir = "/var/run/kubevirt-ephemeral-disks/disk-data"
+	// Container disks are not inspected, they are assumed to be qcow2 images
+	defaultContainerDiskFormat = "qcow2"
+)
+
+// Options tunes the node and cluster dependent parts of the rendered domain.
+type Options struct {
+	// Architecture overrides the VMI architecture, which defaults to the one of the host
+	Architecture string
+	// Hypervisor is the hypervisor the domain is rendered for, kvm by default
+	Hypervisor string
+	// OVMFPath is the directory the EFI roms are expected in
+	OVMFPath string
+	// CPUSet is the set of host CPUs pinned vCPUs are placed on
+	CPUSet []int
+	// NetworkBindings are the network binding plugins registered in the KubeVirt CR
+	NetworkBindings map[string]v1.InterfaceBindingPlugin
+}
+
+// RenderDomainXML converts the VMI to the domain virt-launcher would define,
+// without starting QEMU, and returns its indented XML.
+func RenderDomainXML(vmi *v1.VirtualMachineInstance, opts Options) ([]byte, error) {
+	vmi = vmi.DeepCopy()
+	if vmi.Spec.Domain.Firmware == nil {
+		vmi.Spec.Domain.Firmware = &v1.Firmware{}
+	}
+	if vmi.Spec.Domain.Firmware.UUID == "" {
+		// The defaults would pick a random firmware UUID, keep the rendered domain reproducible
+		vmi.Spec.Domain.Firmware.UUID = types.UID(uuid.NewSHA1(uuid.NameSpaceOID, []byte(vmi.Namespace+"/"+vmi.Name)).String())
+	}
+	v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+
+	c, err := newConverterContext(vmi, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	domain := &api.Domain{}
+	if err := converter.Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, domain, c); err != nil {
+		return nil, fmt.Errorf("failed to convert the VMI to a domain: %v", err)
+	}
+	api.NewDefaulter(c.Architecture.GetArchitecture()).SetObjectDefaults_Domain(domain)
+
+	domainXML, err := xml.MarshalIndent(domain.Spec, "", "\t")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the domain: %v", err)
+	}
+	return append(domainXML, '\n'), nil
+}
+
+// CompareWithGoldenFile compares the rendered domain XML with the golden file.
+// When update is set, the golden file is (re)written instead.
+func CompareWithGoldenFile(domainXML []byte, goldenFile string, update bool) error {
+	if update {
+		return os.WriteFile(goldenFile, domainXML, 0o644)
+	}
+
+	golden, err := os.ReadFile(goldenFile)
+	if err != nil {
+		return fmt.Errorf("failed to read the golden file: %v", err)
+	}
+	if diff := cmp.Diff(strings.Split(string(golden), "\n"), strings.Split(string(domainXML), "\n")); diff != "" {
+		return fmt.Errorf("domain XML does not match the golden file %s (-golden +rendered):\n%s", goldenFile, diff)
+	}
+	return nil
+}
+
+func newConverterContext(vmi *v1.VirtualMachineInstance, opts Options) (*convertertypes.ConverterContext, error) {
+	architecture := opts.Architecture
+	if architecture == "" {
+		architecture = vmi.Spec.Architecture
+	}
+	if architecture == "" {
+		architecture = runtime.GOARCH
+	}
+	hypervisorName := opts.Hypervisor
+	if hypervisorName == "" {
+		hypervisorName = v1.KvmHypervisorName
+	}
+	ovmfPath := opts.OVMFPath
+	if ovmfPath == "" {
+		ovmfPath = defaultOVMFPath
+	}
+
+	permanentVolumes := map[string]v1.VolumeStatus{}
+	hotplugVolumes := map[string]v1.VolumeStatus{}
+	for _, status := range vmi.Status.VolumeStatus {
+		if status.HotplugVolume != nil {
+			hotplugVolumes[status.Name] = status
+		} else {
+			permanentVolumes[status.Name] = status
+		}
+	}
+
+	disksInfo := map[string]*disk.DiskInfo{}
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.ContainerDisk != nil {
+			disksInfo[volume.Name] = &disk.DiskInfo{Format: defaultContainerDiskFormat}
+		}
+	}
+
+	efiConf, err := efiConfiguration(vmi, efi.StaticEFIEnvironment(architecture, ovmfPath))
+	if err != nil {
+		return nil, err
+	}
+
+	return &convertertypes.ConverterContext{
+		Architecture:                    arch.NewConverter(architecture),
+		VirtualMachine:                  vmi,
+		AllowEmulation:                  true,
+		HypervisorDeviceAvailable:       true,
+		CPUSet:                          opts.CPUSet,
+		IsBlockPVC:                      map[string]bool{},
+		IsBlockDV:                       map[string]bool{},
+		PermanentVolumes:                permanentVolumes,
+		HotplugVolumes:                  hotplugVolumes,
+		DisksInfo:                       disksInfo,
+		EFIConfiguration:                efiConf,
+		UseVirtioTransitional:           vmi.Spec.Domain.Devices.UseVirtioTransitional != nil && *vmi.Spec.Domain.Devices.UseVirtioTransitional,
+		EphemeraldiskCreator:            ephemeraldisk.NewEphemeralDiskCreator(defaultEphemeralDiskDir),
+		UseLaunchSecuritySEV:            kutil.IsSEVVMI(vmi),
+		UseLaunchSecurityTDX:            kutil.IsTDXVMI(vmi),
+		UseLaunchSecurityPV:             kutil.IsSecureExecutionVMI(vmi),
+		DomainAttachmentByInterfaceName: domainspec.DomainAttachmentByInterfaceName(vmi.Spec.Domain.Devices.Interfaces, opts.NetworkBindings),
+		HypervisorName:                  hypervisorName,
+	}, nil
+}
+
+func efiConfiguration(vmi *v1.VirtualMachineInstance, efiEnvironment *efi.EFIEnvironment) (*convertertypes.EFIConfiguration, error) {
+	if !vmi.IsBootloaderEFI() {
+		return nil, nil
+	}
+
+	secureBoot := vmi.Spec.Domain.Firmware.Bootloader.EFI.SecureBoot == nil || *vmi.Spec.Domain.Firmware.Bootloader.EFI.SecureBoot
+	vmType := efi.None
+	switch {
+	case kutil.IsSEVSNPVMI(vmi):
+		vmType = efi.SNP
+	case kutil.IsSEVVMI(vmi):
+		vmType = efi.SEV
+	case kutil.IsTDXVMI(vmi):
+		vmType = efi.TDX
+	}
+	if !efiEnvironment.Bootable(secureBoot, vmType) {
+		return nil, fmt.Errorf("EFI booting with SecureBoot=%v is not supported for this VMI", secureBoot)
+	}
+
+	return &convertertypes.EFIConfiguration{
+		EFICode:      efiEnvironment.EFICode(secureBoot, vmType),
+		EFIVars:      efiEnvironment.EFIVars(secureBoot, vmType),
+		SecureLoader: secureBoot,
+	}, nil
+}
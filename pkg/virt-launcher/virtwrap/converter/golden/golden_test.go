@@ -0,0 +1,112 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package golden_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/golden"
+)
+
+var _ = Describe("Domain XML golden files", func() {
+	const (
+		goldenFile  = "testdata/vmi-with-binding-plugin.xml"
+		bindingName = "mybinding"
+	)
+
+	var vmi *v1.VirtualMachineInstance
+	var opts golden.Options
+
+	BeforeEach(func() {
+		vmi = libvmi.New(
+			libvmi.WithName("testvmi"),
+			libvmi.WithNamespace("default"),
+			libvmi.WithArchitecture("amd64"),
+			libvmi.WithMemoryRequest("128Mi"),
+			libvmi.WithUefi(false),
+			libvmi.WithContainerDisk("disk0", "quay.io/containerdisks/fedora"),
+			libvmi.WithInterface(libvmi.InterfaceWithBindingPlugin("default", v1.PluginBinding{Name: bindingName})),
+			libvmi.WithNetwork(v1.DefaultPodNetwork()),
+		)
+		vmi.UID = "f4686d2c-6e8d-4335-b8fd-81bee22f4814"
+		vmi.Spec.Domain.Firmware.UUID = "5d307ca9-b3ef-428c-8861-06e72d69f223"
+		opts = golden.Options{
+			NetworkBindings: map[string]v1.InterfaceBindingPlugin{
+				bindingName: {DomainAttachmentType: v1.Tap},
+			},
+		}
+	})
+
+	It("should render the domain stored in the golden file", func() {
+		domainXML, err := golden.RenderDomainXML(vmi, opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(golden.CompareWithGoldenFile(domainXML, goldenFile, false)).To(Succeed())
+	})
+
+	It("should not modify the rendered VMI", func() {
+		origVMI := vmi.DeepCopy()
+		_, err := golden.RenderDomainXML(vmi, opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vmi).To(Equal(origVMI))
+	})
+
+	It("should render a reproducible firmware UUID", func() {
+		vmi.Spec.Domain.Firmware.UUID = ""
+		domainXML, err := golden.RenderDomainXML(vmi, opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(golden.RenderDomainXML(vmi, opts)).To(Equal(domainXML))
+	})
+
+	It("should report the difference with the golden file", func() {
+		vmi.Spec.Domain.Resources.Requests[k8sv1.ResourceMemory] = resource.MustParse("256Mi")
+		domainXML, err := golden.RenderDomainXML(vmi, opts)
+		Expect(err).ToNot(HaveOccurred())
+
+		err = golden.CompareWithGoldenFile(domainXML, goldenFile, false)
+		Expect(err).To(MatchError(ContainSubstring("does not match the golden file")))
+		Expect(err).To(MatchError(ContainSubstring("268435456")))
+	})
+
+	It("should update the golden file", func() {
+		updatedFile := filepath.Join(GinkgoT().TempDir(), "domain.xml")
+		domainXML, err := golden.RenderDomainXML(vmi, opts)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(golden.CompareWithGoldenFile(domainXML, updatedFile, true)).To(Succeed())
+		Expect(os.ReadFile(updatedFile)).To(Equal(domainXML))
+		Expect(golden.CompareWithGoldenFile(domainXML, updatedFile, false)).To(Succeed())
+	})
+
+	It("should fail when the golden file is missing", func() {
+		domainXML, err := golden.RenderDomainXML(vmi, opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(golden.CompareWithGoldenFile(domainXML, "testdata/missing.xml", false)).ToNot(Succeed())
+	})
+})
@@ -24,6 +24,7 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/network/domainspec"
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device"
@@ -84,6 +85,7 @@ func (d DomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain *ap
 	}
 
 	domain.Spec.Devices.Interfaces = domainInterfaces
+	domainspec.SetInterfaceInterrupts(&domain.Spec, nonAbsentIfaces)
 	return nil
 }
 
@@ -97,7 +99,7 @@ func (d DomainConfigurator) configureInterface(iface *v1.Interface, vmi *v1.Virt
 	if ifaceType == v1.VirtIO {
 		modelType = d.virtioModel
 
-		builderOptions = append(builderOptions, withDriver(newVirtioDriver(vmi, useLaunchSecurity)))
+		builderOptions = append(builderOptions, withDriver(newVirtioDriver(vmi, iface, useLaunchSecurity)))
 	}
 
 	if iface.PciAddress != "" {
@@ -210,11 +212,11 @@ func indexNetworksByName(networks []v1.Network) map[string]*v1.Network {
 	return netsByName
 }
 
-func newVirtioDriver(vmi *v1.VirtualMachineInstance, requiresIOMMU bool) *api.InterfaceDriver {
+func newVirtioDriver(vmi *v1.VirtualMachineInstance, iface *v1.Interface, requiresIOMMU bool) *api.InterfaceDriver {
 	var driver *api.InterfaceDriver
 	queueCount := uint(NetworkQueuesCapacity(vmi))
 
-	if queueCount > 0 || requiresIOMMU {
+	if queueCount > 0 || requiresIOMMU || iface.Offloads != nil {
 		driver = &api.InterfaceDriver{Name: "vhost"}
 		if queueCount > 0 {
 			driver.Queues = &queueCount
@@ -222,7 +224,33 @@ func newVirtioDriver(vmi *v1.VirtualMachineInstance, requiresIOMMU bool) *api.In
 		if requiresIOMMU {
 			driver.IOMMU = "on"
 		}
+		if iface.Offloads != nil {
+			driver.Host = newDriverHost(iface.Offloads)
+		}
 	}
 
 	return driver
 }
+
+func newDriverHost(offloads *v1.InterfaceOffloads) *api.InterfaceDriverHost {
+	tso := offloadState(offloads.TSO)
+	return &api.InterfaceDriverHost{
+		CSum:     offloadState(offloads.Checksum),
+		TSO4:     tso,
+		TSO6:     tso,
+		UFO:      offloadState(offloads.UFO),
+		MrgRxBuf: offloadState(offloads.MergeableRxBuffers),
+	}
+}
+
+// offloadState returns the libvirt state of the offload, or an empty string to keep the hypervisor default
+func offloadState(enabled *bool) string {
+	switch {
+	case enabled == nil:
+		return ""
+	case *enabled:
+		return "on"
+	default:
+		return "off"
+	}
+}
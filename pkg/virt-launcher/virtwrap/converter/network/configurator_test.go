@@ -223,6 +223,88 @@ var _ = Describe("Network Domain Configurator", func() {
 			newDomainInterface(network1Name, "e1000", withTypeEthernet()),
 		),
 	)
+
+	DescribeTable("offloads", func(offloads *v1.InterfaceOffloads, expectedInterface api.Interface) {
+		ifaceWithOffloads := libvmi.InterfaceDeviceWithBridgeBinding(network1Name)
+		ifaceWithOffloads.Offloads = offloads
+
+		vmi := libvmi.New(
+			libvmi.WithInterface(ifaceWithOffloads),
+			libvmi.WithNetwork(libvmi.MultusNetwork(network1Name, nad1Name)),
+		)
+
+		configurator := network.NewDomainConfigurator(
+			network.WithDomainAttachmentByInterfaceName(map[string]string{network1Name: string(v1.Tap)}),
+			network.WithUseLaunchSecuritySEV(false),
+			network.WithUseLaunchSecurityPV(false),
+			network.WithROMTuningSupport(false),
+			network.WithVirtioModel(virtioModel),
+		)
+
+		var domain api.Domain
+		Expect(configurator.Configure(vmi, &domain)).To(Succeed())
+
+		expectedDomain := newDomainWithIfaces([]api.Interface{expectedInterface})
+		Expect(domain).To(Equal(expectedDomain))
+	},
+		Entry(
+			"should not configure the host driver when not specified",
+			nil,
+			newDomainInterface(network1Name, virtioModel, withTypeEthernet()),
+		),
+		Entry(
+			"should disable all the specified offloads",
+			&v1.InterfaceOffloads{
+				TSO:                pointer.P(false),
+				UFO:                pointer.P(false),
+				MergeableRxBuffers: pointer.P(false),
+				Checksum:           pointer.P(false),
+			},
+			newDomainInterface(network1Name, virtioModel, withTypeEthernet(), withVHostDriverHost(&api.InterfaceDriverHost{
+				CSum:     "off",
+				TSO4:     "off",
+				TSO6:     "off",
+				UFO:      "off",
+				MrgRxBuf: "off",
+			})),
+		),
+		Entry(
+			"should keep the hypervisor defaults of the offloads which are not specified",
+			&v1.InterfaceOffloads{TSO: pointer.P(true), Checksum: pointer.P(false)},
+			newDomainInterface(network1Name, virtioModel, withTypeEthernet(), withVHostDriverHost(&api.InterfaceDriverHost{
+				CSum: "off",
+				TSO4: "on",
+				TSO6: "on",
+			})),
+		),
+	)
+
+	It("should override the interrupt vectors of the interface device", func() {
+		ifaceWithInterrupts := libvmi.InterfaceDeviceWithBridgeBinding(network1Name)
+		ifaceWithInterrupts.Interrupts = &v1.InterfaceInterrupts{Vectors: pointer.P(uint32(34))}
+
+		vmi := libvmi.New(
+			libvmi.WithInterface(ifaceWithInterrupts),
+			libvmi.WithNetwork(libvmi.MultusNetwork(network1Name, nad1Name)),
+		)
+
+		configurator := network.NewDomainConfigurator(
+			network.WithDomainAttachmentByInterfaceName(map[string]string{network1Name: string(v1.Tap)}),
+			network.WithVirtioModel(virtioModel),
+		)
+
+		var domain api.Domain
+		Expect(configurator.Configure(vmi, &domain)).To(Succeed())
+
+		expectedDomain := newDomainWithIfaces([]api.Interface{newDomainInterface(network1Name, virtioModel, withTypeEthernet())})
+		expectedDomain.Spec.QEMUOverride = &api.QEMUOverride{Devices: []api.QEMUOverrideDevice{{
+			Alias: api.UserAliasPrefix + network1Name,
+			Frontend: api.QEMUOverrideFrontend{
+				Properties: []api.QEMUOverrideProperty{{Name: "vectors", Type: "unsigned", Value: "34"}},
+			},
+		}}}
+		Expect(domain).To(Equal(expectedDomain))
+	})
 })
 
 func newDomainWithIfaces(interfaces []api.Interface) api.Domain {
@@ -262,6 +344,12 @@ func withVHostDriver(queues uint) option {
 	}
 }
 
+func withVHostDriverHost(host *api.InterfaceDriverHost) option {
+	return func(iface *api.Interface) {
+		iface.Driver = &api.InterfaceDriver{Name: "vhost", Host: host}
+	}
+}
+
 func withLinkState(state string) option {
 	return func(iface *api.Interface) {
 		iface.LinkState = &api.LinkState{State: state}
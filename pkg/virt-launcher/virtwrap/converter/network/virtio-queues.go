@@ -24,10 +24,11 @@ import (
 
 	"kubevirt.io/client-go/log"
 
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/vcpu"
 )
 
-const MultiQueueMaxQueues = uint32(256)
+const MultiQueueMaxQueues = vmispec.MaxNetworkQueues
 
 func NetworkQueuesCapacity(vmi *v1.VirtualMachineInstance) uint32 {
 	if !isTrue(vmi.Spec.Domain.Devices.NetworkInterfaceMultiQueue) {
@@ -35,11 +36,11 @@ func NetworkQueuesCapacity(vmi *v1.VirtualMachineInstance) uint32 {
 	}
 
 	cpuTopology := vcpu.GetCPUTopology(vmi)
-	queueNumber := vcpu.CalculateRequestedVCPUs(cpuTopology)
+	vcpus := vcpu.CalculateRequestedVCPUs(cpuTopology)
 
-	if queueNumber > MultiQueueMaxQueues {
-		log.Log.Infof("Capped the number of queues to be the current maximum of tap device queues: %d", MultiQueueMaxQueues)
-		queueNumber = MultiQueueMaxQueues
+	queueNumber := vmispec.NetworkQueues(vcpus, vmi.Spec.Domain.Devices.NetworkInterfaceMultiQueuePolicy)
+	if queueNumber < vcpus {
+		log.Log.Infof("Limited the number of network queues to %d for %d vCPUs", queueNumber, vcpus)
 	}
 	return queueNumber
 }
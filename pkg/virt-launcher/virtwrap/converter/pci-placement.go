@@ -5,94 +5,20 @@ import (
 	"strconv"
 
 	"k8s.io/utils/ptr"
-	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/util/hardware"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device"
 )
 
 const (
 	maxExpanderBusNr = 255
 )
 
-// iteratePCIAddresses invokes the callback function for each PCI device specified in the domain
-func iteratePCIAddresses(spec *api.DomainSpec, callback func(address *api.Address) (*api.Address, error)) (err error) {
-	fn := func(address *api.Address) (*api.Address, error) {
-		if address == nil || address.Type == "" || address.Type == api.AddressPCI {
-			return callback(address)
-		}
-		return address, nil
-	}
-	for i, iface := range spec.Devices.Interfaces {
-		spec.Devices.Interfaces[i].Address, err = fn(iface.Address)
-		if err != nil {
-			return err
-		}
-	}
-	for i, hostDev := range spec.Devices.HostDevices {
-		if hostDev.Type != api.HostDevicePCI {
-			continue
-		}
-		spec.Devices.HostDevices[i].Address, err = fn(hostDev.Address)
-		if err != nil {
-			return err
-		}
-	}
-	for i, controller := range spec.Devices.Controllers {
-		// pci-root, pcie-root and pcie-expander-bus devices can by definition not have a PCI address
-		if controller.Model == "pci-root" ||
-			controller.Model == api.ControllerModelPCIeRoot ||
-			controller.Model == api.ControllerModelPCIeExpanderBus {
-			continue
-		}
-		spec.Devices.Controllers[i].Address, err = fn(controller.Address)
-		if err != nil {
-			return err
-		}
-	}
-	for i, disk := range spec.Devices.Disks {
-		if disk.Target.Bus != v1.DiskBusVirtio {
-			continue
-		}
-		spec.Devices.Disks[i].Address, err = fn(disk.Address)
-		if err != nil {
-			return err
-		}
-	}
-	for i, input := range spec.Devices.Inputs {
-		if input.Bus != v1.VirtIO {
-			continue
-		}
-		spec.Devices.Inputs[i].Address, err = fn(input.Address)
-		if err != nil {
-			return err
-		}
-	}
-	for i, watchdog := range spec.Devices.Watchdogs {
-		spec.Devices.Watchdogs[i].Address, err = fn(watchdog.Address)
-		if err != nil {
-			return err
-		}
-	}
-	if spec.Devices.Rng != nil {
-		spec.Devices.Rng.Address, err = fn(spec.Devices.Rng.Address)
-		if err != nil {
-			return err
-		}
-	}
-	if spec.Devices.Ballooning != nil {
-		spec.Devices.Ballooning.Address, err = fn(spec.Devices.Ballooning.Address)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
 func CountPCIDevices(spec *api.DomainSpec) (count int, err error) {
-	err = iteratePCIAddresses(spec, func(address *api.Address) (*api.Address, error) {
+	err = device.IteratePCIAddresses(spec, func(address *api.Address) (*api.Address, error) {
 		count++
 		return address, nil
 	})
@@ -101,7 +27,7 @@ func CountPCIDevices(spec *api.DomainSpec) (count int, err error) {
 
 func PlacePCIDevicesOnRootComplex(spec *api.DomainSpec) (err error) {
 	assigner := newRootSlotAssigner()
-	return iteratePCIAddresses(spec, assigner.PlacePCIDeviceAtNextSlot)
+	return device.IteratePCIAddresses(spec, assigner.PlacePCIDeviceAtNextSlot)
 }
 
 func (p *pciRootSlotAssigner) nextSlot() (int, error) {
@@ -43,6 +43,12 @@ func NewUSBAddressPool(hostDevices []v1.HostDevice) *hostdevice.AddressPool {
 	return hostdevice.NewAddressPool(v1.USBResourcePrefix, extractResources(hostDevices))
 }
 
+// NewIOMMUGroupPool creates a pool of the devices sharing the IOMMU group of the allocated
+// PCI devices, based on the environment variables that describe the resource.
+func NewIOMMUGroupPool(hostDevices []v1.HostDevice) *hostdevice.IOMMUGroupPool {
+	return hostdevice.NewIOMMUGroupPool(extractResources(hostDevices))
+}
+
 func extractResources(hostDevices []v1.HostDevice) []string {
 	var resourceSet = make(map[string]struct{})
 	for _, hostDevice := range hostDevices {
@@ -36,8 +36,18 @@ const (
 )
 
 func CreateHostDevices(vmiHostDevices []v1.HostDevice) ([]api.HostDevice, error) {
-	return CreateHostDevicesFromPools(vmiHostDevices,
+	hostDevices, err := CreateHostDevicesFromPools(vmiHostDevices,
 		NewPCIAddressPool(vmiHostDevices), NewMDEVAddressPool(vmiHostDevices), NewUSBAddressPool(vmiHostDevices))
+	if err != nil {
+		return nil, err
+	}
+
+	iommuGroupHostDevices, err := hostdevice.CreateIOMMUGroupHostDevices(
+		createHostDevicesMetadata(vmiHostDevices), hostDevices, NewIOMMUGroupPool(vmiHostDevices))
+	if err != nil {
+		return nil, fmt.Errorf(failedCreateGenericHostDevicesFmt, err)
+	}
+	return append(hostDevices, iommuGroupHostDevices...), nil
 }
 
 func CreateHostDevicesFromPools(vmiHostDevices []v1.HostDevice, pciAddressPool, mdevAddressPool, usbAddressPool hostdevice.AddressPooler) ([]api.HostDevice, error) {
@@ -75,9 +85,10 @@ func createHostDevicesMetadata(vmiHostDevices []v1.HostDevice) []hostdevice.Host
 	var hostDevicesMetaData []hostdevice.HostDeviceMetaData
 	for _, dev := range vmiHostDevices {
 		hostDevicesMetaData = append(hostDevicesMetaData, hostdevice.HostDeviceMetaData{
-			AliasPrefix:  AliasPrefix,
-			Name:         dev.Name,
-			ResourceName: dev.DeviceName,
+			AliasPrefix:      AliasPrefix,
+			Name:             dev.Name,
+			ResourceName:     dev.DeviceName,
+			AttachIOMMUGroup: dev.AttachIOMMUGroup,
 		})
 	}
 	return hostDevicesMetaData
@@ -0,0 +1,107 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package generic
+
+import (
+	"fmt"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice"
+)
+
+// BootHostDevices returns the host devices which were allocated to the virt-launcher pod itself.
+// Hotplugged host devices are allocated to attachment pods, their addresses are not part of the pod environment.
+func BootHostDevices(vmi *v1.VirtualMachineInstance) []v1.HostDevice {
+	hotplugged := make(map[string]struct{})
+	for _, status := range vmi.Status.HostDeviceStatuses {
+		if status.HotplugHostDevice != nil {
+			hotplugged[status.Name] = struct{}{}
+		}
+	}
+
+	var hostDevices []v1.HostDevice
+	for _, hostDevice := range vmi.Spec.Domain.Devices.HostDevices {
+		if _, exists := hotplugged[hostDevice.Name]; !exists {
+			hostDevices = append(hostDevices, hostDevice)
+		}
+	}
+	return hostDevices
+}
+
+// CreateHotplugHostDevices creates the host-devices of the hotplugged host devices which were mounted to the
+// virt-launcher pod, using the PCI address virt-handler resolved from their attachment pod.
+func CreateHotplugHostDevices(vmi *v1.VirtualMachineInstance) ([]api.HostDevice, error) {
+	statuses := hotplugHostDeviceStatusesByName(vmi, v1.HostDeviceMountedToPod, v1.HostDeviceReady)
+
+	var hostDevices []api.HostDevice
+	for _, vmiHostDevice := range vmi.Spec.Domain.Devices.HostDevices {
+		status, exists := statuses[vmiHostDevice.Name]
+		if !exists || status.HotplugHostDevice.PCIAddress == "" {
+			continue
+		}
+		pciHostDevices, err := hostdevice.CreatePCIHostDevices(
+			createHostDevicesMetadata([]v1.HostDevice{vmiHostDevice}),
+			hotplugAddressPool{address: status.HotplugHostDevice.PCIAddress},
+		)
+		if err != nil {
+			return nil, fmt.Errorf(failedCreateGenericHostDevicesFmt, err)
+		}
+		hostDevices = append(hostDevices, pciHostDevices...)
+	}
+	return hostDevices, nil
+}
+
+// GetHostDevicesToDetach returns the domain host-devices of the hotplugged host devices which are being detached.
+func GetHostDevicesToDetach(vmi *v1.VirtualMachineInstance, domainSpec *api.DomainSpec) []api.HostDevice {
+	statuses := hotplugHostDeviceStatusesByName(vmi, v1.HostDeviceDetaching)
+
+	var hostDevices []api.HostDevice
+	for _, hostDevice := range hostdevice.FilterHostDevicesByAlias(domainSpec.Devices.HostDevices, AliasPrefix) {
+		if _, exists := statuses[hostDevice.Alias.GetName()[len(AliasPrefix):]]; exists {
+			hostDevices = append(hostDevices, hostDevice)
+		}
+	}
+	return hostDevices
+}
+
+func hotplugHostDeviceStatusesByName(vmi *v1.VirtualMachineInstance, phases ...v1.HostDevicePhase) map[string]v1.HostDeviceStatus {
+	statuses := make(map[string]v1.HostDeviceStatus)
+	for _, status := range vmi.Status.HostDeviceStatuses {
+		if status.HotplugHostDevice == nil {
+			continue
+		}
+		for _, phase := range phases {
+			if status.Phase == phase {
+				statuses[status.Name] = status
+			}
+		}
+	}
+	return statuses
+}
+
+type hotplugAddressPool struct {
+	address string
+}
+
+func (p hotplugAddressPool) Pop(_ string) (string, error) {
+	return p.address, nil
+}
@@ -0,0 +1,97 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package generic_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice/generic"
+)
+
+var _ = Describe("Generic HostDevice hotplug", func() {
+	var vmi *v1.VirtualMachineInstance
+
+	BeforeEach(func() {
+		vmi = &v1.VirtualMachineInstance{}
+		vmi.Spec.Domain.Devices.HostDevices = []v1.HostDevice{
+			{DeviceName: hostdevResource0, Name: hostdevName0},
+			{DeviceName: hostdevResource1, Name: hostdevName1},
+		}
+	})
+
+	hotplugStatus := func(name string, phase v1.HostDevicePhase, pciAddress string) v1.HostDeviceStatus {
+		return v1.HostDeviceStatus{
+			Name:              name,
+			Phase:             phase,
+			HotplugHostDevice: &v1.HotplugHostDeviceStatus{PCIAddress: pciAddress},
+		}
+	}
+
+	It("lists every host device as boot device given no hotplugged device", func() {
+		vmi.Status.HostDeviceStatuses = []v1.HostDeviceStatus{{Name: hostdevName0, Phase: v1.HostDeviceReady}}
+		Expect(generic.BootHostDevices(vmi)).To(Equal(vmi.Spec.Domain.Devices.HostDevices))
+	})
+
+	It("does not list hotplugged host devices as boot devices", func() {
+		vmi.Status.HostDeviceStatuses = []v1.HostDeviceStatus{hotplugStatus(hostdevName1, v1.HostDevicePending, "")}
+		Expect(generic.BootHostDevices(vmi)).To(Equal([]v1.HostDevice{{DeviceName: hostdevResource0, Name: hostdevName0}}))
+	})
+
+	It("creates the hotplugged host devices mounted to the pod", func() {
+		vmi.Status.HostDeviceStatuses = []v1.HostDeviceStatus{
+			hotplugStatus(hostdevName0, v1.HostDeviceAllocated, ""),
+			hotplugStatus(hostdevName1, v1.HostDeviceMountedToPod, hostdevPCIAddress1),
+		}
+		Expect(generic.CreateHotplugHostDevices(vmi)).To(Equal([]api.HostDevice{{
+			Alias: api.NewUserDefinedAlias(generic.AliasPrefix + hostdevName1),
+			Source: api.HostDeviceSource{
+				Address: &api.Address{Type: api.AddressPCI, Domain: "0x0000", Bus: "0x81", Slot: "0x01", Function: "0x1"},
+			},
+			Type:    api.HostDevicePCI,
+			Managed: "no",
+		}}))
+	})
+
+	It("fails to create a hotplugged host device given a bad PCI address", func() {
+		vmi.Status.HostDeviceStatuses = []v1.HostDeviceStatus{hotplugStatus(hostdevName0, v1.HostDeviceReady, "0bad0pci0address0")}
+		_, err := generic.CreateHotplugHostDevices(vmi)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("detaches only the hotplugged host devices which are detaching", func() {
+		vmi.Status.HostDeviceStatuses = []v1.HostDeviceStatus{
+			{Name: hostdevName0, Phase: v1.HostDeviceDetaching},
+			hotplugStatus(hostdevName1, v1.HostDeviceDetaching, hostdevPCIAddress1),
+		}
+		domainSpec := &api.DomainSpec{}
+		domainSpec.Devices.HostDevices = []api.HostDevice{
+			{Alias: api.NewUserDefinedAlias(generic.AliasPrefix + hostdevName0)},
+			{Alias: api.NewUserDefinedAlias(generic.AliasPrefix + hostdevName1)},
+			{Alias: api.NewUserDefinedAlias("sriov-" + hostdevName1)},
+		}
+		Expect(generic.GetHostDevicesToDetach(vmi, domainSpec)).To(Equal([]api.HostDevice{
+			{Alias: api.NewUserDefinedAlias(generic.AliasPrefix + hostdevName1)},
+		}))
+	})
+})
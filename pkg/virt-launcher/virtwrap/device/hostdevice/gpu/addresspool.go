@@ -37,6 +37,12 @@ func NewMDEVAddressPool(gpuDevices []v1.GPU) *hostdevice.AddressPool {
 	return hostdevice.NewAddressPool(v1.MDevResourcePrefix, extractResources(gpuDevices))
 }
 
+// NewIOMMUGroupPool creates a pool of the devices sharing the IOMMU group of the allocated
+// PCI devices, based on the environment variables that describe the resource.
+func NewIOMMUGroupPool(gpuDevices []v1.GPU) *hostdevice.IOMMUGroupPool {
+	return hostdevice.NewIOMMUGroupPool(extractResources(gpuDevices))
+}
+
 func extractResources(gpuDevices []v1.GPU) []string {
 	var resourceSet = make(map[string]struct{})
 	for _, gpuDevice := range gpuDevices {
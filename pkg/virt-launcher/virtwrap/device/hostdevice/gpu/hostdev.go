@@ -36,7 +36,17 @@ const (
 )
 
 func CreateHostDevices(vmiGPUs []v1.GPU) ([]api.HostDevice, error) {
-	return CreateHostDevicesFromPools(vmiGPUs, NewPCIAddressPool(vmiGPUs), NewMDEVAddressPool(vmiGPUs))
+	hostDevices, err := CreateHostDevicesFromPools(vmiGPUs, NewPCIAddressPool(vmiGPUs), NewMDEVAddressPool(vmiGPUs))
+	if err != nil {
+		return nil, err
+	}
+
+	iommuGroupHostDevices, err := hostdevice.CreateIOMMUGroupHostDevices(
+		createHostDevicesMetadata(vmiGPUs), hostDevices, NewIOMMUGroupPool(vmiGPUs))
+	if err != nil {
+		return nil, fmt.Errorf(failedCreateGPUHostDeviceFmt, err)
+	}
+	return append(hostDevices, iommuGroupHostDevices...), nil
 }
 
 func CreateHostDevicesFromPools(vmiGPUs []v1.GPU, pciAddressPool, mdevAddressPool hostdevice.AddressPooler) ([]api.HostDevice, error) {
@@ -70,6 +80,7 @@ func createHostDevicesMetadata(vmiGPUs []v1.GPU) []hostdevice.HostDeviceMetaData
 			Name:              dev.Name,
 			ResourceName:      dev.DeviceName,
 			VirtualGPUOptions: dev.VirtualGPUOptions,
+			AttachIOMMUGroup:  dev.AttachIOMMUGroup,
 		})
 	}
 	return hostDevicesMetaData
@@ -37,6 +37,8 @@ type HostDeviceMetaData struct {
 	Name              string
 	ResourceName      string
 	VirtualGPUOptions *v1.VGPUOptions
+	// AttachIOMMUGroup requests the devices sharing the IOMMU group of the allocated PCI device.
+	AttachIOMMUGroup bool
 	// DecorateHook is a function pointer that may be used to mutate the domain host-device
 	// with additional specific parameters. E.g. guest PCI address.
 	DecorateHook func(hostDevice *api.HostDevice) error
@@ -0,0 +1,119 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package hostdevice
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/util"
+	hwutil "kubevirt.io/kubevirt/pkg/util/hardware"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+type IOMMUGroupPooler interface {
+	// Devices returns the PCI addresses of the devices sharing the IOMMU group of the given address
+	Devices(address string) []string
+}
+
+type IOMMUGroupPool struct {
+	devicesByAddress map[string][]string
+}
+
+// NewIOMMUGroupPool creates an IOMMU group pool based on the provided list of resources and
+// the environment variables the PCI device plugin sets for them, in the form of
+// <address>=<group device>;<group device>,<address>=<group device>
+func NewIOMMUGroupPool(resources []string) *IOMMUGroupPool {
+	pool := &IOMMUGroupPool{
+		devicesByAddress: make(map[string][]string),
+	}
+	for _, resource := range resources {
+		envVarName := util.ResourceNameToEnvVar(v1.PCIIOMMUGroupResourcePrefix, resource)
+		groups, isSet := os.LookupEnv(envVarName)
+		if !isSet || groups == "" {
+			continue
+		}
+		for _, group := range strings.Split(strings.TrimSuffix(groups, ","), ",") {
+			address, devices, found := strings.Cut(group, "=")
+			if !found || devices == "" {
+				log.Log.Warningf("ignoring malformed IOMMU group %q of resource %s", group, resource)
+				continue
+			}
+			pool.devicesByAddress[address] = strings.Split(devices, ";")
+		}
+	}
+	return pool
+}
+
+func (p *IOMMUGroupPool) Devices(address string) []string {
+	return p.devicesByAddress[address]
+}
+
+// CreateIOMMUGroupHostDevices creates the host-devices sharing the IOMMU group of the PCI
+// host-devices which were created for metadata entries requesting their whole IOMMU group.
+// Group devices which were explicitly requested on their own are not created again.
+func CreateIOMMUGroupHostDevices(hostDevicesData []HostDeviceMetaData, pciHostDevices []api.HostDevice, iommuGroupPool IOMMUGroupPooler) ([]api.HostDevice, error) {
+	attachedAddresses := map[string]struct{}{}
+	for _, hostDevice := range pciHostDevices {
+		if hostDevice.Type == api.HostDevicePCI {
+			attachedAddresses[hwutil.PCIAddressToString(hostDevice.Source.Address)] = struct{}{}
+		}
+	}
+
+	var hostDevices []api.HostDevice
+	for _, hostDeviceData := range hostDevicesData {
+		if !hostDeviceData.AttachIOMMUGroup {
+			continue
+		}
+		hostDevice := lookupHostDeviceByAlias(pciHostDevices, hostDeviceData.AliasPrefix+hostDeviceData.Name)
+		if hostDevice == nil || hostDevice.Type != api.HostDevicePCI {
+			continue
+		}
+
+		for i, address := range iommuGroupPool.Devices(hwutil.PCIAddressToString(hostDevice.Source.Address)) {
+			if _, attached := attachedAddresses[address]; attached {
+				continue
+			}
+			attachedAddresses[address] = struct{}{}
+
+			groupDeviceData := hostDeviceData
+			groupDeviceData.Name = fmt.Sprintf("%s-iommu%d", hostDeviceData.Name, i)
+			groupHostDevice, err := createPCIHostDevice(groupDeviceData, address)
+			if err != nil {
+				return nil, fmt.Errorf(failedCreateHostDeviceFmt, hostDeviceData.Name, err)
+			}
+			hostDevices = append(hostDevices, *groupHostDevice)
+		}
+	}
+	return hostDevices, nil
+}
+
+func lookupHostDeviceByAlias(hostDevices []api.HostDevice, aliasName string) *api.HostDevice {
+	for i, hostDevice := range hostDevices {
+		if hostDevice.Alias != nil && hostDevice.Alias.GetName() == aliasName {
+			return &hostDevices[i]
+		}
+	}
+	return nil
+}
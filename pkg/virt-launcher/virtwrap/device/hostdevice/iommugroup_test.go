@@ -0,0 +1,152 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package hostdevice_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice"
+)
+
+var _ = Describe("IOMMU group", func() {
+	const (
+		gpuAddress   = "0000:01:00.0"
+		audioAddress = "0000:01:00.1"
+		usbAddress   = "0000:01:00.2"
+	)
+
+	Context("pool", func() {
+		It("has no devices given no resource in env", func() {
+			pool := hostdevice.NewIOMMUGroupPool([]string{resource0})
+			Expect(pool.Devices(gpuAddress)).To(BeEmpty())
+		})
+
+		It("loads the group devices of every allocated address", func() {
+			env := []envData{
+				newResourceEnv(v1.PCIIOMMUGroupResourcePrefix, resource0,
+					gpuAddress+"="+audioAddress+";"+usbAddress, pciAddresses0+"="+pciAddresses1),
+			}
+			withEnvironmentContext(env, func() {
+				pool := hostdevice.NewIOMMUGroupPool([]string{resource0})
+				Expect(pool.Devices(gpuAddress)).To(Equal([]string{audioAddress, usbAddress}))
+				Expect(pool.Devices(pciAddresses0)).To(Equal([]string{pciAddresses1}))
+				Expect(pool.Devices(audioAddress)).To(BeEmpty())
+			})
+		})
+
+		It("ignores malformed groups", func() {
+			env := []envData{newResourceEnv(v1.PCIIOMMUGroupResourcePrefix, resource0, gpuAddress, pciAddresses0+"=")}
+			withEnvironmentContext(env, func() {
+				pool := hostdevice.NewIOMMUGroupPool([]string{resource0})
+				Expect(pool.Devices(gpuAddress)).To(BeEmpty())
+				Expect(pool.Devices(pciAddresses0)).To(BeEmpty())
+			})
+		})
+	})
+
+	Context("host devices", func() {
+		var pool *stubIOMMUGroupPool
+		var pciHostDevices []api.HostDevice
+
+		BeforeEach(func() {
+			pool = &stubIOMMUGroupPool{devices: map[string][]string{gpuAddress: {audioAddress, usbAddress}}}
+			pciHostDevices, _ = hostdevice.CreatePCIHostDevices(
+				[]hostdevice.HostDeviceMetaData{{AliasPrefix: aliasPrefix, Name: devName0, ResourceName: resourceName0}},
+				&stubAddressPool{addresses: map[string][]string{resourceName0: {gpuAddress}}},
+			)
+			Expect(pciHostDevices).To(HaveLen(1))
+		})
+
+		It("creates no device when the whole group is not requested", func() {
+			hostDevicesMetaData := []hostdevice.HostDeviceMetaData{
+				{AliasPrefix: aliasPrefix, Name: devName0, ResourceName: resourceName0},
+			}
+			Expect(hostdevice.CreateIOMMUGroupHostDevices(hostDevicesMetaData, pciHostDevices, pool)).To(BeEmpty())
+		})
+
+		It("creates a device for every other device in the group", func() {
+			hostDevicesMetaData := []hostdevice.HostDeviceMetaData{
+				{AliasPrefix: aliasPrefix, Name: devName0, ResourceName: resourceName0, AttachIOMMUGroup: true},
+			}
+			hostDevices, err := hostdevice.CreateIOMMUGroupHostDevices(hostDevicesMetaData, pciHostDevices, pool)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hostDevices).To(Equal([]api.HostDevice{
+				{
+					Alias:   newAlias(devName0 + "-iommu0"),
+					Source:  api.HostDeviceSource{Address: &api.Address{Type: api.AddressPCI, Domain: "0x0000", Bus: "0x01", Slot: "0x00", Function: "0x1"}},
+					Type:    api.HostDevicePCI,
+					Managed: "no",
+				},
+				{
+					Alias:   newAlias(devName0 + "-iommu1"),
+					Source:  api.HostDeviceSource{Address: &api.Address{Type: api.AddressPCI, Domain: "0x0000", Bus: "0x01", Slot: "0x00", Function: "0x2"}},
+					Type:    api.HostDevicePCI,
+					Managed: "no",
+				},
+			}))
+		})
+
+		It("does not create twice a group device which was requested on its own", func() {
+			pciHostDevices, _ = hostdevice.CreatePCIHostDevices(
+				[]hostdevice.HostDeviceMetaData{
+					{AliasPrefix: aliasPrefix, Name: devName0, ResourceName: resourceName0},
+					{AliasPrefix: aliasPrefix, Name: devName1, ResourceName: resourceName1},
+				},
+				&stubAddressPool{addresses: map[string][]string{resourceName0: {gpuAddress}, resourceName1: {audioAddress}}},
+			)
+			hostDevicesMetaData := []hostdevice.HostDeviceMetaData{
+				{AliasPrefix: aliasPrefix, Name: devName0, ResourceName: resourceName0, AttachIOMMUGroup: true},
+				{AliasPrefix: aliasPrefix, Name: devName1, ResourceName: resourceName1},
+			}
+			hostDevices, err := hostdevice.CreateIOMMUGroupHostDevices(hostDevicesMetaData, pciHostDevices, pool)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hostDevices).To(HaveLen(1))
+			Expect(hostDevices[0].Alias).To(Equal(newAlias(devName0 + "-iommu1")))
+		})
+
+		It("creates no device when the requesting device was not allocated", func() {
+			hostDevicesMetaData := []hostdevice.HostDeviceMetaData{
+				{AliasPrefix: aliasPrefix, Name: devName1, ResourceName: resourceName1, AttachIOMMUGroup: true},
+			}
+			Expect(hostdevice.CreateIOMMUGroupHostDevices(hostDevicesMetaData, pciHostDevices, pool)).To(BeEmpty())
+		})
+
+		It("fails given a bad group device address", func() {
+			pool.devices[gpuAddress] = []string{"0bad0pci0address0"}
+			hostDevicesMetaData := []hostdevice.HostDeviceMetaData{
+				{AliasPrefix: aliasPrefix, Name: devName0, ResourceName: resourceName0, AttachIOMMUGroup: true},
+			}
+			_, err := hostdevice.CreateIOMMUGroupHostDevices(hostDevicesMetaData, pciHostDevices, pool)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
+
+type stubIOMMUGroupPool struct {
+	devices map[string][]string
+}
+
+func (p *stubIOMMUGroupPool) Devices(address string) []string {
+	return p.devices[address]
+}
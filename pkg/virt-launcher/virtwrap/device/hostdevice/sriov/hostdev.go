@@ -23,7 +23,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"os"
 	"path"
 	"time"
 
@@ -31,7 +30,6 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 
-	virtwait "kubevirt.io/kubevirt/pkg/apimachinery/wait"
 	"kubevirt.io/kubevirt/pkg/network/deviceinfo"
 	"kubevirt.io/kubevirt/pkg/network/downwardapi"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
@@ -64,16 +62,16 @@ func CreateHostDevices(vmi *v1.VirtualMachineInstance) ([]api.HostDevice, error)
 	return CreateHostDevicesFromIfacesAndPool(SRIOVInterfaces, pciAddressPoolWithNetworkStatus)
 }
 
-// newPCIAddressPoolWithNetworkStatusFromFile polls the given file path until populated, then uses it to create the
+// newPCIAddressPoolWithNetworkStatusFromFile waits for the given file path to be populated, then uses it to create the
 // PCI-Address Pool.
 // possible return values are:
 // - file populated - return PCI-Address Pool using the data in file.
-// - file empty post-polling (timeout) - return err to fail SyncVMI.
+// - file empty post-waiting (timeout) - return err to fail SyncVMI.
 // - other error reading file (i.e. file not exist) - return no error but PCIAddressWithNetworkStatusPool.Len() will return 0.
 func newPCIAddressPoolWithNetworkStatusFromFile(path string) (*PCIAddressWithNetworkStatusPool, error) {
 	const failedCreatePciPoolFmt = "failed to create PCI address pool with network status from file: %w"
 
-	networkDeviceInfoBytes, err := readFileUntilNotEmpty(path)
+	networkDeviceInfoBytes, err := readFileUntilNotEmpty(path, networkInfoWaitOptionsFromEnv())
 	if err != nil {
 		if isFileEmptyAfterTimeout(err, networkDeviceInfoBytes) {
 			return nil, fmt.Errorf(failedCreatePciPoolFmt, err)
@@ -88,19 +86,6 @@ func newPCIAddressPoolWithNetworkStatusFromFile(path string) (*PCIAddressWithNet
 	return pciPool, nil
 }
 
-func readFileUntilNotEmpty(networkPCIMapPath string) ([]byte, error) {
-	var networkPCIMapBytes []byte
-	err := virtwait.PollImmediately(100*time.Millisecond, time.Second, func(_ context.Context) (bool, error) {
-		var err error
-		networkPCIMapBytes, err = os.ReadFile(networkPCIMapPath)
-		return len(networkPCIMapBytes) > 0, err
-	})
-	if errors.Is(err, context.DeadlineExceeded) {
-		return nil, fmt.Errorf("%w: file is not populated with network-info", err)
-	}
-	return networkPCIMapBytes, err
-}
-
 func isFileEmptyAfterTimeout(err error, data []byte) bool {
 	return errors.Is(err, context.DeadlineExceeded) && len(data) == 0
 }
@@ -0,0 +1,177 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package sriov
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"kubevirt.io/client-go/log"
+
+	virtwait "kubevirt.io/kubevirt/pkg/apimachinery/wait"
+	"kubevirt.io/kubevirt/pkg/util"
+)
+
+const (
+	defaultNetworkInfoPollInterval = 100 * time.Millisecond
+	defaultNetworkInfoTimeout      = time.Second
+)
+
+var errNetworkInfoNotPopulated = fmt.Errorf("%w: file is not populated with network-info", context.DeadlineExceeded)
+
+// networkInfoWaitOptions tunes the wait for the network-info file, which may take longer than the
+// default timeout to be populated on clusters with a slow CNI.
+type networkInfoWaitOptions struct {
+	pollInterval time.Duration
+	timeout      time.Duration
+	// backoff doubles the poll interval after every attempt, up to the timeout.
+	backoff bool
+}
+
+// networkInfoWaitOptionsFromEnv reads the wait options set on the compute container,
+// keeping the default of any option which is not set or cannot be parsed.
+func networkInfoWaitOptionsFromEnv() networkInfoWaitOptions {
+	opts := networkInfoWaitOptions{
+		pollInterval: defaultNetworkInfoPollInterval,
+		timeout:      defaultNetworkInfoTimeout,
+	}
+	opts.pollInterval = durationFromEnv(util.ENV_VAR_NETWORK_INFO_POLL_INTERVAL, opts.pollInterval)
+	opts.timeout = durationFromEnv(util.ENV_VAR_NETWORK_INFO_TIMEOUT, opts.timeout)
+	if backoffStr, ok := os.LookupEnv(util.ENV_VAR_NETWORK_INFO_POLL_BACKOFF); ok {
+		if backoff, err := strconv.ParseBool(backoffStr); err == nil {
+			opts.backoff = backoff
+		} else {
+			log.Log.Reason(err).Warningf("ignoring invalid %s value %q", util.ENV_VAR_NETWORK_INFO_POLL_BACKOFF, backoffStr)
+		}
+	}
+	return opts
+}
+
+func durationFromEnv(envVarName string, defaultValue time.Duration) time.Duration {
+	durationStr, ok := os.LookupEnv(envVarName)
+	if !ok {
+		return defaultValue
+	}
+	duration, err := time.ParseDuration(durationStr)
+	if err != nil || duration <= 0 {
+		log.Log.Reason(err).Warningf("ignoring invalid %s value %q", envVarName, durationStr)
+		return defaultValue
+	}
+	return duration
+}
+
+// readFileUntilNotEmpty waits for the network-info file to be populated, reacting as soon as
+// kubelet projects the downward API volume. Polling is used when the directory cannot be watched.
+func readFileUntilNotEmpty(networkPCIMapPath string, opts networkInfoWaitOptions) ([]byte, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		log.Log.Reason(err).Warning("failed to create network-info watcher, falling back to polling")
+		return pollFileUntilNotEmpty(networkPCIMapPath, opts)
+	}
+	defer watcher.Close()
+
+	// kubelet replaces the projected files by swapping a symlink in the volume directory,
+	// so the directory is watched rather than the file itself.
+	if err := watcher.Add(filepath.Dir(networkPCIMapPath)); err != nil {
+		log.Log.Reason(err).Warning("failed to watch network-info, falling back to polling")
+		return pollFileUntilNotEmpty(networkPCIMapPath, opts)
+	}
+	return watchFileUntilNotEmpty(watcher, networkPCIMapPath, opts)
+}
+
+func watchFileUntilNotEmpty(watcher *fsnotify.Watcher, networkPCIMapPath string, opts networkInfoWaitOptions) ([]byte, error) {
+	start := time.Now()
+	deadline := time.NewTimer(opts.timeout)
+	defer deadline.Stop()
+
+	for {
+		networkPCIMapBytes, err := os.ReadFile(networkPCIMapPath)
+		if err != nil || len(networkPCIMapBytes) > 0 {
+			return networkPCIMapBytes, err
+		}
+
+		select {
+		case _, ok := <-watcher.Events:
+			if !ok {
+				opts.timeout = remainingTimeout(opts.timeout, start)
+				return pollFileUntilNotEmpty(networkPCIMapPath, opts)
+			}
+		case err, ok := <-watcher.Errors:
+			if ok {
+				log.Log.Reason(err).Warning("network-info watcher failed, falling back to polling")
+			}
+			opts.timeout = remainingTimeout(opts.timeout, start)
+			return pollFileUntilNotEmpty(networkPCIMapPath, opts)
+		case <-deadline.C:
+			return nil, errNetworkInfoNotPopulated
+		}
+	}
+}
+
+// remainingTimeout never drops below a poll interval, so that a watcher failing close to
+// the deadline still leaves the fallback some time for the file to be populated.
+func remainingTimeout(timeout time.Duration, start time.Time) time.Duration {
+	return max(timeout-time.Since(start), defaultNetworkInfoPollInterval)
+}
+
+func pollFileUntilNotEmpty(networkPCIMapPath string, opts networkInfoWaitOptions) ([]byte, error) {
+	var networkPCIMapBytes []byte
+	condition := func(_ context.Context) (bool, error) {
+		var err error
+		networkPCIMapBytes, err = os.ReadFile(networkPCIMapPath)
+		return len(networkPCIMapBytes) > 0, err
+	}
+
+	var err error
+	if opts.backoff {
+		err = pollWithBackoff(opts, condition)
+	} else {
+		err = virtwait.PollImmediately(opts.pollInterval, opts.timeout, condition)
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return nil, errNetworkInfoNotPopulated
+	}
+	return networkPCIMapBytes, err
+}
+
+func pollWithBackoff(opts networkInfoWaitOptions, condition wait.ConditionWithContextFunc) error {
+	ctx, cancel := context.WithTimeout(context.Background(), opts.timeout)
+	defer cancel()
+	backoff := wait.Backoff{
+		Duration: opts.pollInterval,
+		Factor:   2,
+		Steps:    math.MaxInt32,
+		Cap:      opts.timeout,
+	}
+	err := wait.ExponentialBackoffWithContext(ctx, backoff, condition)
+	if errors.Is(err, wait.ErrWaitTimeout) {
+		return context.DeadlineExceeded
+	}
+	return err
+}
@@ -0,0 +1,145 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package sriov
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/util"
+)
+
+var _ = Describe("network-info wait", func() {
+	Context("options", func() {
+		setEnv := func(name, value string) {
+			Expect(os.Setenv(name, value)).To(Succeed())
+			DeferCleanup(os.Unsetenv, name)
+		}
+
+		It("should use the defaults without environment variables", func() {
+			Expect(networkInfoWaitOptionsFromEnv()).To(Equal(networkInfoWaitOptions{
+				pollInterval: defaultNetworkInfoPollInterval,
+				timeout:      defaultNetworkInfoTimeout,
+			}))
+		})
+
+		It("should read the options from the environment variables", func() {
+			setEnv(util.ENV_VAR_NETWORK_INFO_POLL_INTERVAL, "250ms")
+			setEnv(util.ENV_VAR_NETWORK_INFO_TIMEOUT, "10s")
+			setEnv(util.ENV_VAR_NETWORK_INFO_POLL_BACKOFF, "true")
+			Expect(networkInfoWaitOptionsFromEnv()).To(Equal(networkInfoWaitOptions{
+				pollInterval: 250 * time.Millisecond,
+				timeout:      10 * time.Second,
+				backoff:      true,
+			}))
+		})
+
+		It("should keep the defaults of invalid environment variables", func() {
+			setEnv(util.ENV_VAR_NETWORK_INFO_POLL_INTERVAL, "-1s")
+			setEnv(util.ENV_VAR_NETWORK_INFO_TIMEOUT, "forever")
+			setEnv(util.ENV_VAR_NETWORK_INFO_POLL_BACKOFF, "maybe")
+			Expect(networkInfoWaitOptionsFromEnv()).To(Equal(networkInfoWaitOptions{
+				pollInterval: defaultNetworkInfoPollInterval,
+				timeout:      defaultNetworkInfoTimeout,
+			}))
+		})
+	})
+
+	Context("polling with backoff", func() {
+		var (
+			networkInfoPath string
+			opts            networkInfoWaitOptions
+		)
+
+		BeforeEach(func() {
+			opts = networkInfoWaitOptions{pollInterval: 10 * time.Millisecond, timeout: time.Second, backoff: true}
+			networkInfoPath = filepath.Join(GinkgoT().TempDir(), "network-info")
+			Expect(os.WriteFile(networkInfoPath, nil, 0o644)).To(Succeed())
+		})
+
+		It("should read the file once it is populated", func() {
+			time.AfterFunc(50*time.Millisecond, func() {
+				defer GinkgoRecover()
+				Expect(os.WriteFile(networkInfoPath, []byte("data"), 0o644)).To(Succeed())
+			})
+			Expect(pollFileUntilNotEmpty(networkInfoPath, opts)).To(Equal([]byte("data")))
+		})
+
+		It("should fail when the file is not populated before the timeout", func() {
+			opts.timeout = 100 * time.Millisecond
+			_, err := pollFileUntilNotEmpty(networkInfoPath, opts)
+			Expect(err).To(MatchError(errNetworkInfoNotPopulated))
+		})
+	})
+
+	Context("watching", func() {
+		var (
+			networkInfoPath string
+			watcher         *fsnotify.Watcher
+			opts            networkInfoWaitOptions
+		)
+
+		BeforeEach(func() {
+			networkInfoPath = filepath.Join(GinkgoT().TempDir(), "network-info")
+			Expect(os.WriteFile(networkInfoPath, nil, 0o644)).To(Succeed())
+
+			var err error
+			watcher, err = fsnotify.NewWatcher()
+			Expect(err).ToNot(HaveOccurred())
+			DeferCleanup(watcher.Close)
+			Expect(watcher.Add(filepath.Dir(networkInfoPath))).To(Succeed())
+
+			opts = networkInfoWaitOptions{pollInterval: 10 * time.Millisecond, timeout: time.Second}
+		})
+
+		populateAfter := func(delay time.Duration) {
+			time.AfterFunc(delay, func() {
+				defer GinkgoRecover()
+				Expect(os.WriteFile(networkInfoPath, []byte("data"), 0o644)).To(Succeed())
+			})
+		}
+
+		It("should read the file once it is populated", func() {
+			populateAfter(50 * time.Millisecond)
+			Expect(watchFileUntilNotEmpty(watcher, networkInfoPath, opts)).To(Equal([]byte("data")))
+		})
+
+		It("should fail when the file is not populated before the timeout", func() {
+			opts.timeout = 100 * time.Millisecond
+			_, err := watchFileUntilNotEmpty(watcher, networkInfoPath, opts)
+			Expect(err).To(MatchError(errNetworkInfoNotPopulated))
+		})
+
+		It("should fall back to polling when the watcher stops", func() {
+			Expect(watcher.Close()).To(Succeed())
+			populateAfter(50 * time.Millisecond)
+			Expect(watchFileUntilNotEmpty(watcher, networkInfoPath, opts)).To(Equal([]byte("data")))
+		})
+
+		It("should keep polling when the watcher stops after the timeout", func() {
+			Expect(remainingTimeout(time.Second, time.Now().Add(-2*time.Second))).To(Equal(defaultNetworkInfoPollInterval))
+		})
+	})
+})
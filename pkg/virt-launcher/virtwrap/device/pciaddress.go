@@ -20,6 +20,11 @@
 package device
 
 import (
+	"fmt"
+	"strconv"
+
+	v1 "kubevirt.io/api/core/v1"
+
 	hwutil "kubevirt.io/kubevirt/pkg/util/hardware"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
@@ -38,3 +43,126 @@ func NewPciAddressField(address string) (*api.Address, error) {
 		Function: "0x" + dbsfFields[3],
 	}, nil
 }
+
+// IteratePCIAddresses invokes the callback function for each PCI device specified in the domain
+func IteratePCIAddresses(spec *api.DomainSpec, callback func(address *api.Address) (*api.Address, error)) (err error) {
+	fn := func(address *api.Address) (*api.Address, error) {
+		if address == nil || address.Type == "" || address.Type == api.AddressPCI {
+			return callback(address)
+		}
+		return address, nil
+	}
+	for i, iface := range spec.Devices.Interfaces {
+		spec.Devices.Interfaces[i].Address, err = fn(iface.Address)
+		if err != nil {
+			return err
+		}
+	}
+	for i, hostDev := range spec.Devices.HostDevices {
+		if hostDev.Type != api.HostDevicePCI {
+			continue
+		}
+		spec.Devices.HostDevices[i].Address, err = fn(hostDev.Address)
+		if err != nil {
+			return err
+		}
+	}
+	for i, controller := range spec.Devices.Controllers {
+		// pci-root, pcie-root and pcie-expander-bus devices can by definition not have a PCI address
+		if controller.Model == "pci-root" ||
+			controller.Model == api.ControllerModelPCIeRoot ||
+			controller.Model == api.ControllerModelPCIeExpanderBus {
+			continue
+		}
+		spec.Devices.Controllers[i].Address, err = fn(controller.Address)
+		if err != nil {
+			return err
+		}
+	}
+	for i, disk := range spec.Devices.Disks {
+		if disk.Target.Bus != v1.DiskBusVirtio {
+			continue
+		}
+		spec.Devices.Disks[i].Address, err = fn(disk.Address)
+		if err != nil {
+			return err
+		}
+	}
+	for i, input := range spec.Devices.Inputs {
+		if input.Bus != v1.VirtIO {
+			continue
+		}
+		spec.Devices.Inputs[i].Address, err = fn(input.Address)
+		if err != nil {
+			return err
+		}
+	}
+	for i, watchdog := range spec.Devices.Watchdogs {
+		spec.Devices.Watchdogs[i].Address, err = fn(watchdog.Address)
+		if err != nil {
+			return err
+		}
+	}
+	if spec.Devices.Rng != nil {
+		spec.Devices.Rng.Address, err = fn(spec.Devices.Rng.Address)
+		if err != nil {
+			return err
+		}
+	}
+	if spec.Devices.Ballooning != nil {
+		spec.Devices.Ballooning.Address, err = fn(spec.Devices.Ballooning.Address)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidatePCIAddresses ensures that no two devices were explicitly requested at the same PCI address,
+// which libvirt would otherwise only reject once the domain gets defined.
+func ValidatePCIAddresses(spec *api.DomainSpec) error {
+	requested := map[pciAddressKey]struct{}{}
+	return IteratePCIAddresses(spec, func(address *api.Address) (*api.Address, error) {
+		if address == nil || address.Domain == "" {
+			return address, nil
+		}
+		key, err := newPCIAddressKey(address)
+		if err != nil {
+			return nil, err
+		}
+		if _, exists := requested[key]; exists {
+			return nil, fmt.Errorf("PCI address %s is requested by more than one device", key)
+		}
+		requested[key] = struct{}{}
+		return address, nil
+	})
+}
+
+// pciAddressKey identifies a PCI address regardless of the formatting of its fields, e.g. 0x0 and 0x00
+type pciAddressKey struct {
+	domain, bus, slot, function uint64
+}
+
+func newPCIAddressKey(address *api.Address) (pciAddressKey, error) {
+	var key pciAddressKey
+	for _, field := range []struct {
+		value string
+		dest  *uint64
+	}{
+		{address.Domain, &key.domain},
+		{address.Bus, &key.bus},
+		{address.Slot, &key.slot},
+		{address.Function, &key.function},
+	} {
+		value, err := strconv.ParseUint(field.value, 0, 32)
+		if err != nil {
+			return key, fmt.Errorf("failed to parse PCI address %s:%s:%s.%s: %v", address.Domain, address.Bus, address.Slot, address.Function, err)
+		}
+		*field.dest = value
+	}
+	return key, nil
+}
+
+func (k pciAddressKey) String() string {
+	return fmt.Sprintf("%04x:%02x:%02x.%x", k.domain, k.bus, k.slot, k.function)
+}
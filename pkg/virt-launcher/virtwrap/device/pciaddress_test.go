@@ -46,3 +46,43 @@ var _ = Describe("PCI Address", func() {
 		Expect(address).To(BeNil())
 	})
 })
+
+var _ = Describe("PCI address validation", func() {
+	pciAddress := func(bus, slot string) *api.Address {
+		return &api.Address{Type: api.AddressPCI, Domain: "0x0000", Bus: bus, Slot: slot, Function: "0x0"}
+	}
+
+	It("should accept devices requested at distinct addresses", func() {
+		spec := &api.DomainSpec{}
+		spec.Devices.Interfaces = []api.Interface{{Address: pciAddress("0x00", "0x05")}, {}}
+		spec.Devices.Disks = []api.Disk{{Target: api.DiskTarget{Bus: "virtio"}, Address: pciAddress("0x00", "0x06")}}
+		Expect(device.ValidatePCIAddresses(spec)).To(Succeed())
+	})
+
+	It("should ignore non virtio disks", func() {
+		spec := &api.DomainSpec{}
+		spec.Devices.Interfaces = []api.Interface{{Address: pciAddress("0x00", "0x05")}}
+		spec.Devices.Disks = []api.Disk{{Target: api.DiskTarget{Bus: "sata"}, Address: pciAddress("0x00", "0x05")}}
+		Expect(device.ValidatePCIAddresses(spec)).To(Succeed())
+	})
+
+	It("should reject an interface requested at the address of a disk", func() {
+		spec := &api.DomainSpec{}
+		spec.Devices.Interfaces = []api.Interface{{Address: pciAddress("0x00", "0x05")}}
+		spec.Devices.Disks = []api.Disk{{Target: api.DiskTarget{Bus: "virtio"}, Address: pciAddress("0x0", "0x5")}}
+		Expect(device.ValidatePCIAddresses(spec)).To(MatchError("PCI address 0000:00:05.0 is requested by more than one device"))
+	})
+
+	It("should reject a host device requested at the address of a controller", func() {
+		spec := &api.DomainSpec{}
+		spec.Devices.HostDevices = []api.HostDevice{{Type: api.HostDevicePCI, Address: pciAddress("0x01", "0x00")}}
+		spec.Devices.Controllers = []api.Controller{{Type: "pci", Model: "pcie-root-port", Address: pciAddress("0x01", "0x00")}}
+		Expect(device.ValidatePCIAddresses(spec)).To(MatchError(ContainSubstring("0000:01:00.0")))
+	})
+
+	It("should reject a malformed address", func() {
+		spec := &api.DomainSpec{}
+		spec.Devices.Interfaces = []api.Interface{{Address: pciAddress("0x00", "zz")}}
+		Expect(device.ValidatePCIAddresses(spec)).ToNot(Succeed())
+	})
+})
@@ -0,0 +1,180 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"fmt"
+
+	"libvirt.org/go/libvirt"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+var domainJobOperations = map[libvirt.DomainJobOperationType]string{
+	libvirt.DOMAIN_JOB_OPERATION_START:           "Start",
+	libvirt.DOMAIN_JOB_OPERATION_SAVE:            "Save",
+	libvirt.DOMAIN_JOB_OPERATION_RESTORE:         "Restore",
+	libvirt.DOMAIN_JOB_OPERATION_MIGRATION_IN:    "MigrationIn",
+	libvirt.DOMAIN_JOB_OPERATION_MIGRATION_OUT:   "MigrationOut",
+	libvirt.DOMAIN_JOB_OPERATION_SNAPSHOT:        "Snapshot",
+	libvirt.DOMAIN_JOB_OPERATION_SNAPSHOT_REVERT: "SnapshotRevert",
+	libvirt.DOMAIN_JOB_OPERATION_DUMP:            "Dump",
+	libvirt.DOMAIN_JOB_OPERATION_BACKUP:          "Backup",
+	libvirt.DOMAIN_JOB_OPERATION_SNAPSHOT_DELETE: "SnapshotDelete",
+}
+
+var blockJobOperations = map[libvirt.DomainBlockJobType]string{
+	libvirt.DOMAIN_BLOCK_JOB_TYPE_PULL:          "Pull",
+	libvirt.DOMAIN_BLOCK_JOB_TYPE_COPY:          "Copy",
+	libvirt.DOMAIN_BLOCK_JOB_TYPE_COMMIT:        "Commit",
+	libvirt.DOMAIN_BLOCK_JOB_TYPE_ACTIVE_COMMIT: "ActiveCommit",
+	libvirt.DOMAIN_BLOCK_JOB_TYPE_BACKUP:        "Backup",
+}
+
+func domainJobOperation(operation libvirt.DomainJobOperationType) string {
+	if name, ok := domainJobOperations[operation]; ok {
+		return name
+	}
+	return "Unknown"
+}
+
+func blockJobOperation(jobType libvirt.DomainBlockJobType) string {
+	if name, ok := blockJobOperations[jobType]; ok {
+		return name
+	}
+	return "Unknown"
+}
+
+// GetDomainJobs returns the domain job and the block jobs currently running on the domain of the VMI
+func (l *LibvirtDomainManager) GetDomainJobs(vmi *v1.VirtualMachineInstance) ([]v1.VirtualMachineInstanceDomainJob, error) {
+	domName := api.VMINamespaceKeyFunc(vmi)
+	dom, err := l.virConn.LookupDomainByName(domName)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Getting the domain for listing its jobs failed.")
+		return nil, err
+	}
+	defer dom.Free()
+
+	jobs := []v1.VirtualMachineInstanceDomainJob{}
+
+	stats, err := dom.GetJobStats(0)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Getting the domain job stats failed.")
+		return nil, err
+	}
+	if stats.Type != libvirt.DOMAIN_JOB_NONE {
+		jobs = append(jobs, v1.VirtualMachineInstanceDomainJob{
+			Type:      v1.DomainJobTypeDomain,
+			Operation: domainJobOperation(stats.Operation),
+			Processed: int64(stats.DataProcessed),
+			Total:     int64(stats.DataTotal),
+		})
+	}
+
+	domSpec, err := l.getDomainSpec(dom)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Getting the domain spec failed.")
+		return nil, err
+	}
+	for _, disk := range domSpec.Devices.Disks {
+		info, err := getBlockJob(dom, disk.Target.Device)
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Errorf("Getting the block job info of disk %s failed.", disk.Target.Device)
+			return nil, err
+		}
+		if info == nil {
+			continue
+		}
+		jobs = append(jobs, v1.VirtualMachineInstanceDomainJob{
+			Type:      v1.DomainJobTypeBlock,
+			Operation: blockJobOperation(info.Type),
+			Disk:      disk.Target.Device,
+			Processed: int64(info.Cur),
+			Total:     int64(info.End),
+		})
+	}
+
+	return jobs, nil
+}
+
+// CancelDomainJob aborts the domain job or the block job of a disk running on the domain of the VMI
+func (l *LibvirtDomainManager) CancelDomainJob(vmi *v1.VirtualMachineInstance, options *v1.CancelDomainJobOptions) error {
+	domName := api.VMINamespaceKeyFunc(vmi)
+	dom, err := l.virConn.LookupDomainByName(domName)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Getting the domain for cancelling its job failed.")
+		return err
+	}
+	defer dom.Free()
+
+	switch options.Type {
+	case v1.DomainJobTypeDomain:
+		stats, err := dom.GetJobStats(0)
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Error("Getting the domain job stats failed.")
+			return err
+		}
+		if stats.Type == libvirt.DOMAIN_JOB_NONE {
+			return fmt.Errorf("no domain job is running")
+		}
+		// Migrations have to go through the regular abort flow to keep the migration metadata consistent
+		if stats.Operation == libvirt.DOMAIN_JOB_OPERATION_MIGRATION_OUT {
+			return l.cancelMigration(vmi)
+		}
+		if err := dom.AbortJob(); err != nil {
+			log.Log.Object(vmi).Reason(err).Error("Aborting the domain job failed.")
+			return err
+		}
+	case v1.DomainJobTypeBlock:
+		info, err := getBlockJob(dom, options.Disk)
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Errorf("Getting the block job info of disk %s failed.", options.Disk)
+			return err
+		}
+		if info == nil {
+			return fmt.Errorf("no block job is running on disk %s", options.Disk)
+		}
+		if err := dom.BlockJobAbort(options.Disk, 0); err != nil {
+			log.Log.Object(vmi).Reason(err).Errorf("Aborting the block job of disk %s failed.", options.Disk)
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown domain job type %q", options.Type)
+	}
+
+	log.Log.Object(vmi).Infof("Cancelled the %s domain job, disk: %q", options.Type, options.Disk)
+	return nil
+}
+
+// getBlockJob returns the block job running on the disk, or nil when there is none
+func getBlockJob(dom cli.VirDomain, disk string) (*libvirt.DomainBlockJobInfo, error) {
+	info, err := dom.GetBlockJobInfo(disk, 0)
+	if err != nil {
+		return nil, err
+	}
+	if info.Type == libvirt.DOMAIN_BLOCK_JOB_TYPE_UNKNOWN {
+		return nil, nil
+	}
+	return info, nil
+}
@@ -143,9 +143,22 @@ func (e *EFIEnvironment) EFIVars(secureBoot bool, vmType SecureVMType) string {
 }
 
 func DetectEFIEnvironment(arch, ovmfPath string) *EFIEnvironment {
+	return newEFIEnvironment(arch, ovmfPath, getEFIBinaryIfExists)
+}
+
+// StaticEFIEnvironment returns an EFI environment which assumes that all the
+// firmware binaries are shipped in ovmfPath, without looking them up. It is
+// meant for rendering domains outside of the virt-launcher image.
+func StaticEFIEnvironment(arch, ovmfPath string) *EFIEnvironment {
+	return newEFIEnvironment(arch, ovmfPath, func(path, binary string) string {
+		return filepath.Join(path, binary)
+	})
+}
+
+func newEFIEnvironment(arch, ovmfPath string, lookup func(path, binary string) string) *EFIEnvironment {
 	if arch == "arm64" {
-		codeArm64 := getEFIBinaryIfExists(ovmfPath, EFICodeAARCH64)
-		varsArm64 := getEFIBinaryIfExists(ovmfPath, EFIVarsAARCH64)
+		codeArm64 := lookup(ovmfPath, EFICodeAARCH64)
+		varsArm64 := lookup(ovmfPath, EFIVarsAARCH64)
 
 		return &EFIEnvironment{
 			code: codeArm64,
@@ -154,12 +167,12 @@ func DetectEFIEnvironment(arch, ovmfPath string) *EFIEnvironment {
 	}
 
 	// detect EFI with SecureBoot
-	codeWithSB := getEFIBinaryIfExists(ovmfPath, EFICodeSecureBoot)
-	varsWithSB := getEFIBinaryIfExists(ovmfPath, EFIVarsSecureBoot)
+	codeWithSB := lookup(ovmfPath, EFICodeSecureBoot)
+	varsWithSB := lookup(ovmfPath, EFIVarsSecureBoot)
 
 	// detect EFI without SecureBoot
-	code := getEFIBinaryIfExists(ovmfPath, EFICode)
-	vars := getEFIBinaryIfExists(ovmfPath, EFIVars)
+	code := lookup(ovmfPath, EFICode)
+	vars := lookup(ovmfPath, EFIVars)
 	if code == "" {
 		// The combination (EFICodeSecureBoot + EFIVars) is valid
 		// for booting in EFI mode with SecureBoot disabled
@@ -167,13 +180,13 @@ func DetectEFIEnvironment(arch, ovmfPath string) *EFIEnvironment {
 	}
 
 	// detect EFI with SEV
-	codeWithSEV := getEFIBinaryIfExists(ovmfPath, EFICodeSEV)
-	varsWithSEV := getEFIBinaryIfExists(ovmfPath, EFIVarsSEV)
-	codeWithSNP := getEFIBinaryIfExists(ovmfPath, EFICodeSNP)
+	codeWithSEV := lookup(ovmfPath, EFICodeSEV)
+	varsWithSEV := lookup(ovmfPath, EFIVarsSEV)
+	codeWithSNP := lookup(ovmfPath, EFICodeSNP)
 
 	// detect EFI with TDX
-	codeWithTDX := getEFIBinaryIfExists(ovmfPath, EFICodeTDX)
-	codeWithTDXSB := getEFIBinaryIfExists(ovmfPath, EFICodeTDXSecureBoot)
+	codeWithTDX := lookup(ovmfPath, EFICodeTDX)
+	codeWithTDXSB := lookup(ovmfPath, EFICodeTDXSecureBoot)
 
 	return &EFIEnvironment{
 		codeSecureBoot:    codeWithSB,
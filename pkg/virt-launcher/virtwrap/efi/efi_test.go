@@ -138,4 +138,20 @@ var _ = Describe("EFI environment detection", func() {
 		Expect(efiEnv.EFIVars(secureBootEnabled, TDX)).To(Equal(""))
 		Expect(efiEnv.EFIVars(!secureBootEnabled, TDX)).To(Equal(""))
 	})
+
+	It("Static EFI Roms", func() {
+		const ovmfPath = "/usr/share/OVMF"
+		efiEnv := StaticEFIEnvironment("x86_64", ovmfPath)
+
+		Expect(efiEnv.Bootable(secureBootEnabled, None)).To(BeTrue())
+		Expect(efiEnv.Bootable(!secureBootEnabled, None)).To(BeTrue())
+		Expect(efiEnv.Bootable(!secureBootEnabled, SEV)).To(BeTrue())
+		Expect(efiEnv.EFICode(secureBootEnabled, None)).To(Equal(filepath.Join(ovmfPath, EFICodeSecureBoot)))
+		Expect(efiEnv.EFICode(!secureBootEnabled, None)).To(Equal(filepath.Join(ovmfPath, EFICode)))
+		Expect(efiEnv.EFIVars(!secureBootEnabled, None)).To(Equal(filepath.Join(ovmfPath, EFIVars)))
+
+		efiEnv = StaticEFIEnvironment("arm64", ovmfPath)
+		Expect(efiEnv.EFICode(!secureBootEnabled, None)).To(Equal(filepath.Join(ovmfPath, EFICodeAARCH64)))
+		Expect(efiEnv.EFIVars(!secureBootEnabled, None)).To(Equal(filepath.Join(ovmfPath, EFIVarsAARCH64)))
+	})
 })
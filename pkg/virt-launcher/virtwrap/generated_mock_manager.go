@@ -61,6 +61,20 @@ func (mr *MockDomainManagerMockRecorder) BackupVirtualMachine(arg0, arg1 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackupVirtualMachine", reflect.TypeOf((*MockDomainManager)(nil).BackupVirtualMachine), arg0, arg1)
 }
 
+// CancelDomainJob mocks base method.
+func (m *MockDomainManager) CancelDomainJob(arg0 *v1.VirtualMachineInstance, arg1 *v1.CancelDomainJobOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelDomainJob", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelDomainJob indicates an expected call of CancelDomainJob.
+func (mr *MockDomainManagerMockRecorder) CancelDomainJob(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDomainJob", reflect.TypeOf((*MockDomainManager)(nil).CancelDomainJob), arg0, arg1)
+}
+
 // CancelVMIMigration mocks base method.
 func (m *MockDomainManager) CancelVMIMigration(arg0 *v1.VirtualMachineInstance) error {
 	m.ctrl.T.Helper()
@@ -147,6 +161,21 @@ func (mr *MockDomainManagerMockRecorder) GetDomainDirtyRateStats(calculationDura
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainDirtyRateStats", reflect.TypeOf((*MockDomainManager)(nil).GetDomainDirtyRateStats), calculationDuration)
 }
 
+// GetDomainJobs mocks base method.
+func (m *MockDomainManager) GetDomainJobs(arg0 *v1.VirtualMachineInstance) ([]v1.VirtualMachineInstanceDomainJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDomainJobs", arg0)
+	ret0, _ := ret[0].([]v1.VirtualMachineInstanceDomainJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDomainJobs indicates an expected call of GetDomainJobs.
+func (mr *MockDomainManagerMockRecorder) GetDomainJobs(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainJobs", reflect.TypeOf((*MockDomainManager)(nil).GetDomainJobs), arg0)
+}
+
 // GetDomainStats mocks base method.
 func (m *MockDomainManager) GetDomainStats() (*stats.DomainStats, error) {
 	m.ctrl.T.Helper()
@@ -219,6 +248,21 @@ func (mr *MockDomainManagerMockRecorder) GetLaunchMeasurement(arg0 any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLaunchMeasurement", reflect.TypeOf((*MockDomainManager)(nil).GetLaunchMeasurement), arg0)
 }
 
+// GetQemuCommandLine mocks base method.
+func (m *MockDomainManager) GetQemuCommandLine(arg0 *v1.VirtualMachineInstance) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQemuCommandLine", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQemuCommandLine indicates an expected call of GetQemuCommandLine.
+func (mr *MockDomainManagerMockRecorder) GetQemuCommandLine(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQemuCommandLine", reflect.TypeOf((*MockDomainManager)(nil).GetQemuCommandLine), arg0)
+}
+
 // GetQemuVersion mocks base method.
 func (m *MockDomainManager) GetQemuVersion() (string, error) {
 	m.ctrl.T.Helper()
@@ -36,6 +36,8 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
+	"kubevirt.io/kubevirt/pkg/hooks"
+	hooksV1alpha3 "kubevirt.io/kubevirt/pkg/hooks/v1alpha3"
 	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
 	hotplugdisk "kubevirt.io/kubevirt/pkg/hotplug-disk"
 	osdisk "kubevirt.io/kubevirt/pkg/os/disk"
@@ -52,6 +54,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice/sriov"
 	domainerrors "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/errors"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/network"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/statsconv"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/util"
@@ -140,6 +143,42 @@ func hotUnplugHostDevices(virConn cli.Connection, dom cli.VirDomain) error {
 	return nil
 }
 
+// unplugInterfacesForMigration unplugs the interfaces the binding plugins cannot migrate. They are plugged
+// into the target domain once the migration completed, or back into the source domain when it failed.
+func (l *LibvirtDomainManager) unplugInterfacesForMigration(dom cli.VirDomain, ifaceNames []string) error {
+	if len(ifaceNames) == 0 {
+		return nil
+	}
+	domainSpec, err := util.GetDomainSpecWithFlags(dom, 0)
+	if err != nil {
+		return err
+	}
+
+	eventChan := make(chan interface{}, hostdevice.MaxConcurrentHotPlugDevicesEvents)
+	var callback libvirt.DomainEventDeviceRemovedCallback = func(c *libvirt.Connect, d *libvirt.Domain, event *libvirt.DomainEventDeviceRemoved) {
+		eventChan <- event.DevAlias
+	}
+	domainEvent := cli.NewDomainEventDeviceRemoved(l.virConn, dom, callback, eventChan)
+
+	const waitForDetachTimeout = 30 * time.Second
+	l.migrationReplugInterfaces, err = network.SafelyDetachInterfaces(domainSpec, ifaceNames, domainEvent, dom, waitForDetachTimeout)
+	return err
+}
+
+// replugInterfacesAfterFailedMigration plugs the interfaces unplugged for the migration back into the source domain
+func (l *LibvirtDomainManager) replugInterfacesAfterFailedMigration(vmi *v1.VirtualMachineInstance, dom cli.VirDomain) {
+	l.domainModifyLock.Lock()
+	defer l.domainModifyLock.Unlock()
+
+	if len(l.migrationReplugInterfaces) == 0 {
+		return
+	}
+	if err := network.AttachInterfaces(dom, l.migrationReplugInterfaces); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("failed to plug back the interfaces unplugged for the migration")
+	}
+	l.migrationReplugInterfaces = nil
+}
+
 // This returns domain xml without the metadata section, as it is only relevant to the source domain
 // Note: Unfortunately we can't just use UnMarshall + Marshall here, as that leads to unwanted XML alterations
 func migratableDomXML(dom cli.VirDomain, vmi *v1.VirtualMachineInstance, domSpec *api.DomainSpec) (string, error) {
@@ -404,6 +443,28 @@ func (l *LibvirtDomainManager) setMigrationAbortStatus(abortStatus v1.MigrationA
 	return l.setMigrationResultHelper(false, "", abortStatus)
 }
 
+// setMigrationDiskProgress stores how much of the migrated volumes has been copied.
+// The metadata is only updated when the progress advanced by at least one percent,
+// to avoid flooding virt-handler with domain updates.
+func (l *LibvirtDomainManager) setMigrationDiskProgress(info *libvirt.DomainJobInfo) {
+	if !info.DiskTotalSet || !info.DiskProcessedSet || info.DiskTotal == 0 {
+		return
+	}
+	l.metadataCache.Migration.WithSafeBlock(func(migrationMetadata *api.MigrationMetadata, _ bool) {
+		if migrationMetadata.EndTimestamp != nil {
+			return
+		}
+		if migrationMetadata.DiskTotal == info.DiskTotal &&
+			info.DiskProcessed >= migrationMetadata.DiskProcessed &&
+			(info.DiskProcessed-migrationMetadata.DiskProcessed)*100 < info.DiskTotal &&
+			info.DiskProcessed != info.DiskTotal {
+			return
+		}
+		migrationMetadata.DiskTotal = info.DiskTotal
+		migrationMetadata.DiskProcessed = info.DiskProcessed
+	})
+}
+
 func newMigrationMonitor(vmi *v1.VirtualMachineInstance, l *LibvirtDomainManager, options *cmdclient.MigrationOptions, migrationErr chan error) *migrationMonitor {
 	monitor := &migrationMonitor{
 		l:                        l,
@@ -688,6 +749,9 @@ func (m *migrationMonitor) startMonitor() {
 			if logInterval%monitorLogInterval == 0 {
 				logMigrationInfo(logger, string(migrationUID), jobStats)
 			}
+			if len(vmi.Status.MigratedVolumes) > 0 {
+				m.l.setMigrationDiskProgress(jobStats)
+			}
 		case libvirt.DOMAIN_JOB_NONE:
 			completedJobInfo = m.determineNonRunningMigrationStatus(dom)
 		case libvirt.DOMAIN_JOB_CANCELLED:
@@ -1039,6 +1103,11 @@ func (l *LibvirtDomainManager) migrateHelper(vmi *v1.VirtualMachineInstance, opt
 	}
 	migrateFlags := generateMigrationFlags(vmi.IsBlockMigration(), migratePaused, options)
 
+	replugIfaceNames, err := hooks.GetManager().NegotiateMigration(l.hooksCtx, vmi, hooksV1alpha3.MigrationRoleSource)
+	if err != nil {
+		return fmt.Errorf("failed to negotiate the migration with the hook sidecars: %v", err)
+	}
+
 	// anything that modifies the domain needs to be performed with the domainModifyLock held
 	// The domain params and unHotplug need to be performed in a critical section together.
 	critSection := func() error {
@@ -1048,6 +1117,9 @@ func (l *LibvirtDomainManager) migrateHelper(vmi *v1.VirtualMachineInstance, opt
 		if err := prepareDomainForMigration(l.virConn, dom); err != nil {
 			return fmt.Errorf("error encountered during preparing domain for migration: %v", err)
 		}
+		if err := l.unplugInterfacesForMigration(dom, replugIfaceNames); err != nil {
+			return fmt.Errorf("error encountered while unplugging interfaces for migration: %v", err)
+		}
 		domSpec, err := l.getDomainSpec(dom)
 		if err != nil {
 			return fmt.Errorf("failed to get domain spec: %v", err)
@@ -1061,6 +1133,7 @@ func (l *LibvirtDomainManager) migrateHelper(vmi *v1.VirtualMachineInstance, opt
 	}
 	err = critSection()
 	if err != nil {
+		l.replugInterfacesAfterFailedMigration(vmi, dom)
 		return err
 	}
 
@@ -1074,6 +1147,7 @@ func (l *LibvirtDomainManager) migrateHelper(vmi *v1.VirtualMachineInstance, opt
 
 	err = dom.MigrateToURI3(dstURI, params, migrateFlags)
 	if err != nil {
+		l.replugInterfacesAfterFailedMigration(vmi, dom)
 		l.setMigrationResult(true, err.Error(), "")
 		log.Log.Object(vmi).Errorf("migration failed with error: %v", err)
 		return fmt.Errorf("error encountered during MigrateToURI3 libvirt api call: %v", err)
@@ -26,6 +26,7 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	"k8s.io/apimachinery/pkg/types"
+	"libvirt.org/go/libvirt"
 	"libvirt.org/go/libvirtxml"
 
 	v1 "kubevirt.io/api/core/v1"
@@ -144,6 +145,38 @@ var _ = Describe("Live migration source", func() {
 			Entry("marking the migration as failed without an abortion result should return an error", true, v1.MigrationAbortStatus(""), false, errors.MigrationAbortInProgressError),
 			Entry("marking the migration as completed without an abortion result should return an error", false, v1.MigrationAbortStatus(""), false, errors.MigrationAbortInProgressError),
 		)
+
+		DescribeTable("disk progress", func(processed []uint64, expectedProcessed uint64) {
+			for _, p := range processed {
+				libvirtDomainManager.setMigrationDiskProgress(&libvirt.DomainJobInfo{
+					DiskTotalSet:     true,
+					DiskTotal:        1000,
+					DiskProcessedSet: true,
+					DiskProcessed:    p,
+				})
+			}
+			migrationMetadata, exists := libvirtDomainManager.metadataCache.Migration.Load()
+			Expect(exists).To(BeTrue(), "migrationMetadata not found")
+			Expect(migrationMetadata.DiskTotal).To(Equal(uint64(1000)))
+			Expect(migrationMetadata.DiskProcessed).To(Equal(expectedProcessed))
+		},
+			Entry("should be recorded", []uint64{100}, uint64(100)),
+			Entry("should not be updated for less than one percent of progress", []uint64{100, 105}, uint64(100)),
+			Entry("should be updated after one percent of progress", []uint64{100, 105, 110}, uint64(110)),
+			Entry("should always record the completion", []uint64{995, 1000}, uint64(1000)),
+		)
+
+		It("should not record disk progress once the migration has finished", func() {
+			libvirtDomainManager.setMigrationResult(false, "", "")
+			libvirtDomainManager.setMigrationDiskProgress(&libvirt.DomainJobInfo{
+				DiskTotalSet:     true,
+				DiskTotal:        1000,
+				DiskProcessedSet: true,
+				DiskProcessed:    100,
+			})
+			migrationMetadata, _ := libvirtDomainManager.metadataCache.Migration.Load()
+			Expect(migrationMetadata.DiskTotal).To(BeZero())
+		})
 	})
 
 	Context("classifyVolumesForMigration", func() {
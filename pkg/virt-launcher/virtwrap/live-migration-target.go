@@ -27,6 +27,7 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"time"
 
@@ -42,6 +43,7 @@ import (
 	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
 	"kubevirt.io/kubevirt/pkg/hooks"
+	hooksV1alpha3 "kubevirt.io/kubevirt/pkg/hooks/v1alpha3"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/storage/cbt"
 	"kubevirt.io/kubevirt/pkg/util"
@@ -51,6 +53,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/network"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/storage"
 )
 
@@ -59,6 +62,10 @@ const (
 )
 
 func (l *LibvirtDomainManager) finalizeMigrationTarget(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) error {
+	if err := l.replugMigratedInterfaces(vmi); err != nil {
+		return err
+	}
+
 	interfacesToReconnect := interfacesToReconnect(options)
 	if len(interfacesToReconnect) != 0 {
 		if err := l.reconnectGuestNics(vmi, interfacesToReconnect); err != nil {
@@ -70,6 +77,58 @@ func (l *LibvirtDomainManager) finalizeMigrationTarget(vmi *v1.VirtualMachineIns
 	return nil
 }
 
+// replugMigratedInterfaces plugs the interfaces the binding plugins unplugged from the source domain
+// before the migration, as generated by the plugins on the target
+func (l *LibvirtDomainManager) replugMigratedInterfaces(vmi *v1.VirtualMachineInstance) error {
+	l.domainModifyLock.Lock()
+	defer l.domainModifyLock.Unlock()
+
+	if len(l.migrationReplugInterfaces) == 0 {
+		return nil
+	}
+	dom, err := l.virConn.LookupDomainByName(api.VMINamespaceKeyFunc(vmi))
+	if err != nil {
+		return fmt.Errorf("failed to plug the migrated interfaces: %v", err)
+	}
+	defer dom.Free()
+
+	// The interfaces are not retried on failure, so that the network sync resumes
+	err = network.AttachInterfaces(dom, l.migrationReplugInterfaces)
+	l.migrationReplugInterfaces = nil
+	if err != nil {
+		return fmt.Errorf("failed to plug the migrated interfaces: %v", err)
+	}
+	return nil
+}
+
+// prepareInterfacesReplug keeps the interfaces the binding plugins ask to replug across the migration, as
+// generated by the plugins in the domain XML of the target, to plug them once the migration completed
+func (l *LibvirtDomainManager) prepareInterfacesReplug(vmi *v1.VirtualMachineInstance, domainXML string) error {
+	ifaceNames, err := hooks.GetManager().NegotiateMigration(l.hooksCtx, vmi, hooksV1alpha3.MigrationRoleTarget)
+	if err != nil {
+		return fmt.Errorf("failed to negotiate the migration with the hook sidecars: %v", err)
+	}
+	if len(ifaceNames) == 0 {
+		return nil
+	}
+
+	domainSpec := &api.DomainSpec{}
+	if err := xml.Unmarshal([]byte(domainXML), domainSpec); err != nil {
+		return fmt.Errorf("failed to unmarshal the domain XML of the hook sidecars: %v", err)
+	}
+	var replugIfaces []api.Interface
+	for _, iface := range domainSpec.Devices.Interfaces {
+		if iface.Alias != nil && slices.Contains(ifaceNames, iface.Alias.GetName()) {
+			replugIfaces = append(replugIfaces, iface)
+		}
+	}
+
+	l.domainModifyLock.Lock()
+	defer l.domainModifyLock.Unlock()
+	l.migrationReplugInterfaces = replugIfaces
+	return nil
+}
+
 func interfacesToReconnect(options *cmdv1.VirtualMachineOptions) map[string]struct{} {
 	interfaceMigrationOptions := options.InterfaceMigration
 	ifacesToRefresh := map[string]struct{}{}
@@ -217,10 +276,13 @@ func (l *LibvirtDomainManager) prepareMigrationTarget(
 	// Right now we need to call OnDefineDomain, so that additional setup, which might be done
 	// by the hook can also be done for the new target pod
 	hooksManager := hooks.GetManager()
-	_, err = hooksManager.OnDefineDomain(&dom.Spec, vmi)
+	domainXML, err := hooksManager.OnDefineDomain(l.hooksCtx, &dom.Spec, vmi)
 	if err != nil {
 		return fmt.Errorf("executing custom preStart hooks failed: %v", err)
 	}
+	if err := l.prepareInterfacesReplug(vmi, domainXML); err != nil {
+		return err
+	}
 
 	if shouldBlockMigrationTargetPreparation(vmi) {
 		return fmt.Errorf("Blocking preparation of migration target in order to satisfy a functional test condition")
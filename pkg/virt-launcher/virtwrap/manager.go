@@ -55,6 +55,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
 
 	backupv1 "kubevirt.io/api/backup/v1alpha1"
 	v1 "kubevirt.io/api/core/v1"
@@ -73,12 +74,14 @@ import (
 	"kubevirt.io/kubevirt/pkg/liveupdate/memory"
 	"kubevirt.io/kubevirt/pkg/network/cache"
 	netsriov "kubevirt.io/kubevirt/pkg/network/deviceinfo"
+	"kubevirt.io/kubevirt/pkg/network/metadataservice"
 	netsetup "kubevirt.io/kubevirt/pkg/network/setup"
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
 	osdisk "kubevirt.io/kubevirt/pkg/os/disk"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/safepath"
 	"kubevirt.io/kubevirt/pkg/storage/cbt"
+	"kubevirt.io/kubevirt/pkg/storage/encryption"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/unsafepath"
 	kutil "kubevirt.io/kubevirt/pkg/util"
@@ -125,6 +128,9 @@ const (
 
 const maxConcurrentHotplugHostDevices = 1
 
+// launcherCgroupDir is the cgroup of the compute container as seen from its own cgroup namespace
+const launcherCgroupDir = "/sys/fs/cgroup"
+
 type contextStore struct {
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -167,6 +173,9 @@ type DomainManager interface {
 	UpdateGuestMemory(vmi *v1.VirtualMachineInstance) error
 	GetDomainDirtyRateStats(calculationDuration time.Duration) (*stats.DomainStatsDirtyRate, error)
 	GetScreenshot(vmi *v1.VirtualMachineInstance) (*cmdv1.ScreenshotResponse, error)
+	GetDomainJobs(*v1.VirtualMachineInstance) ([]v1.VirtualMachineInstanceDomainJob, error)
+	CancelDomainJob(*v1.VirtualMachineInstance, *v1.CancelDomainJobOptions) error
+	GetQemuCommandLine(*v1.VirtualMachineInstance) ([]string, error)
 }
 
 type LibvirtDomainManager struct {
@@ -209,6 +218,16 @@ type LibvirtDomainManager struct {
 
 	hypervisorDeviceAvailable bool
 	hypervisorName            string
+
+	guestMetadataService *metadataservice.Service
+
+	// hooksCtx is cancelled once virt-launcher is asked to stop, cancelling the pending calls to the hook sidecars
+	hooksCtx context.Context
+
+	// migrationReplugInterfaces are the interfaces the binding plugins ask to replug across a migration: the ones
+	// unplugged from the source domain while it migrates, or the ones to plug into the target domain once it completed.
+	// Implicitly locked by domainModifyLock.
+	migrationReplugInterfaces []api.Interface
 }
 
 type pausedVMIs struct {
@@ -280,6 +299,8 @@ func newLibvirtDomainManager(connection cli.Connection, virtShareDir, ephemeralD
 		hookServer:                         hookServer,
 		hypervisorName:                     hypervisorName,
 		hypervisorDeviceAvailable:          hypervisorDeviceAvailable,
+		guestMetadataService:               metadataservice.New(),
+		hooksCtx:                           wait.ContextForChannel(stopChan),
 	}
 
 	manager.hotplugHostDevicesInProgress = make(chan struct{}, maxConcurrentHotplugHostDevices)
@@ -621,6 +642,35 @@ func (l *LibvirtDomainManager) hotPlugHostDevices(vmi *v1.VirtualMachineInstance
 		return fmt.Errorf("%s: %v", errMsgPrefix, hostdevice.AttachHostDevices(domain, sriovHostDevices))
 	}
 
+	genericHostDevices, err := generic.CreateHotplugHostDevices(vmi)
+	if err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+	genericHostDevices = hostdevice.DifferenceHostDevicesByAlias(genericHostDevices, domainSpec.Devices.HostDevices)
+	if err := hostdevice.AttachHostDevices(domain, genericHostDevices); err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+
+	return l.hotUnplugGenericHostDevices(vmi, domain, domainSpec)
+}
+
+func (l *LibvirtDomainManager) hotUnplugGenericHostDevices(vmi *v1.VirtualMachineInstance, dom cli.VirDomain, domainSpec *api.DomainSpec) error {
+	hostDevices := generic.GetHostDevicesToDetach(vmi, domainSpec)
+	if len(hostDevices) == 0 {
+		return nil
+	}
+
+	eventChan := make(chan interface{}, hostdevice.MaxConcurrentHotPlugDevicesEvents)
+	var callback libvirt.DomainEventDeviceRemovedCallback = func(c *libvirt.Connect, d *libvirt.Domain, event *libvirt.DomainEventDeviceRemoved) {
+		eventChan <- event.DevAlias
+	}
+
+	if domainEvent := cli.NewDomainEventDeviceRemoved(l.virConn, dom, callback, eventChan); domainEvent != nil {
+		const waitForDetachTimeout = 30 * time.Second
+		if err := hostdevice.SafelyDetachHostDevices(hostDevices, domainEvent, dom, waitForDetachTimeout); err != nil {
+			return fmt.Errorf("failed to hot-unplug host-devices: %v", err)
+		}
+	}
 	return nil
 }
 
@@ -763,7 +813,7 @@ func (l *LibvirtDomainManager) preStartHook(vmi *v1.VirtualMachineInstance, doma
 	// Pass cloud-init data to PreCloudInitIso hook
 	logger.Info("Starting PreCloudInitIso hook")
 	hooksManager := hooks.GetManager()
-	cloudInitData, err = hooksManager.PreCloudInitIso(vmi, cloudInitData)
+	cloudInitData, err = hooksManager.PreCloudInitIso(l.hooksCtx, vmi, cloudInitData)
 	if err != nil {
 		return domain, fmt.Errorf("PreCloudInitIso hook failed: %v", err)
 	}
@@ -856,12 +906,51 @@ func (l *LibvirtDomainManager) preStartHook(vmi *v1.VirtualMachineInstance, doma
 		return domain, fmt.Errorf("Starting qemu agent access credential propagation failed: %v", err)
 	}
 
+	if err := l.defineDiskEncryptionSecrets(domain); err != nil {
+		return domain, fmt.Errorf("defining disk encryption secrets failed: %v", err)
+	}
+
 	// expand disk image files if they're too small
 	expandDiskImagesOffline(vmi, domain)
 
 	return domain, err
 }
 
+// defineDiskEncryptionSecrets hands the passphrases of the encrypted disks to
+// libvirt as ephemeral and private secrets, so that they are only kept in memory
+func (l *LibvirtDomainManager) defineDiskEncryptionSecrets(domain *api.Domain) error {
+	for _, disk := range domain.Spec.Devices.Disks {
+		if disk.Source.Encryption == nil || disk.Source.Encryption.Secret == nil || disk.Alias == nil {
+			continue
+		}
+		passphrase, ok := os.LookupEnv(encryption.PassphraseEnvVarName(disk.Alias.GetName()))
+		if !ok || passphrase == "" {
+			return fmt.Errorf("no passphrase found for encrypted disk %s", disk.Alias.GetName())
+		}
+		volume := disk.Source.File
+		if volume == "" {
+			volume = disk.Source.Dev
+		}
+		secretXML, err := xml.Marshal(api.SecretSpec{
+			Ephemeral:   "yes",
+			Private:     "yes",
+			UUID:        disk.Source.Encryption.Secret.UUID,
+			Description: fmt.Sprintf("passphrase of encrypted disk %s", disk.Alias.GetName()),
+			Usage: api.SecretUsage{
+				Type:   "volume",
+				Volume: volume,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		if err := l.virConn.DefineSecret(string(secretXML), []byte(passphrase)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func expandDiskImagesOffline(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
 	logger := log.Log.Object(vmi)
 	for _, disk := range domain.Spec.Devices.Disks {
@@ -1122,11 +1211,15 @@ func (l *LibvirtDomainManager) generateConverterContext(vmi *v1.VirtualMachineIn
 		c.HotplugVolumes = hotplugVolumes
 		c.SRIOVDevices = sriovDevices
 
-		genericHostDevices, err := generic.CreateHostDevices(vmi.Spec.Domain.Devices.HostDevices)
+		genericHostDevices, err := generic.CreateHostDevices(generic.BootHostDevices(vmi))
 		if err != nil {
 			return nil, err
 		}
-		c.GenericHostDevices = genericHostDevices
+		hotplugHostDevices, err := generic.CreateHotplugHostDevices(vmi)
+		if err != nil {
+			return nil, err
+		}
+		c.GenericHostDevices = append(genericHostDevices, hotplugHostDevices...)
 
 		genericDRAHostDevices, err := dra.CreateDRAHostDevices(vmi, drautil.DefaultMetadataBasePath)
 		if err != nil {
@@ -1250,7 +1343,18 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmul
 	if options != nil {
 		domainAttachments = options.GetInterfaceDomainAttachment()
 	}
-	if err := network.Sync(domain, oldSpec, dom, vmi, domainAttachments); err != nil {
+	// The interfaces replugged across a migration are plugged by the migration, with the domain interfaces
+	// generated by their binding plugins, the network is not synced until they are
+	if len(l.migrationReplugInterfaces) == 0 {
+		if err := network.Sync(domain, oldSpec, dom, vmi, domainAttachments); err != nil {
+			return nil, err
+		}
+	} else {
+		logger.V(4).Info("Skipping the network sync, interfaces are unplugged for the migration")
+	}
+
+	if err := l.guestMetadataService.Sync(vmi); err != nil {
+		logger.Reason(err).Error("failed to sync the metadata service")
 		return nil, err
 	}
 
@@ -1344,27 +1448,33 @@ func (l *LibvirtDomainManager) syncDisks(
 	}
 
 	// Resize and notify the VM about changed disks
-	for _, disk := range domain.Spec.Devices.Disks {
-		if shouldExpandOnline(dom, disk) {
-			possibleGuestSize, ok := possibleGuestSize(disk)
-			if !ok {
-				logger.Warningf("Failed to get possible guest size from disk %v", disk)
-				break
-			}
-			flags := libvirt.DOMAIN_BLOCK_RESIZE_BYTES
-			if possibleGuestSize == 0 {
-				flags |= libvirt.DOMAIN_BLOCK_RESIZE_CAPACITY
-			}
-			err := dom.BlockResize(getSourceFile(disk), uint64(possibleGuestSize), flags)
-			if err != nil {
-				logger.Reason(err).Errorf("libvirt failed to expand disk image %v", disk)
-			}
-		}
-	}
+	expandDisksOnline(dom, domain.Spec.Devices.Disks, logger)
 
 	return nil
 }
 
+// expandDisksOnline resizes the block devices of the running domain whose backing
+// storage has grown. QEMU notifies the guest about the new capacity, so the
+// additional space becomes usable without restarting the VMI. A failure on one disk
+// does not prevent the remaining disks from being expanded.
+func expandDisksOnline(dom cli.VirDomain, disks []api.Disk, logger *log.FilteredLogger) {
+	for _, disk := range disks {
+		possibleGuestSize, ok := onlineExpansionSize(dom, disk)
+		if !ok {
+			continue
+		}
+		flags := libvirt.DOMAIN_BLOCK_RESIZE_BYTES
+		if possibleGuestSize == 0 {
+			flags |= libvirt.DOMAIN_BLOCK_RESIZE_CAPACITY
+		}
+		if err := dom.BlockResize(getSourceFile(disk), uint64(possibleGuestSize), flags); err != nil {
+			logger.Reason(err).Errorf("libvirt failed to expand disk image %v", disk)
+			continue
+		}
+		logger.Infof("Expanded disk %s of the running domain", disk.Alias.GetName())
+	}
+}
+
 func (l *LibvirtDomainManager) startDomain(
 	vmi *v1.VirtualMachineInstance,
 	dom cli.VirDomain,
@@ -1374,6 +1484,13 @@ func (l *LibvirtDomainManager) startDomain(
 		return err
 	}
 
+	// The sidecars, e.g. binding plugins waiting on their devices, complete the configuration of the VMI
+	// before QEMU opens the devices. Their fatal errors fail the VMI instead of the domain start.
+	if err := hooks.GetManager().CheckReadiness(l.hooksCtx, vmi); err != nil {
+		logger.Reason(err).Error("Sidecars failed to complete the configuration of the VirtualMachineInstance.")
+		return err
+	}
+
 	createFlags := getDomainCreateFlags(vmi)
 	if err := dom.CreateWithFlags(createFlags); err != nil {
 		logger.Reason(err).
@@ -1638,23 +1755,26 @@ func isBlockDeviceVolumeFunc(volumeName string) (bool, error) {
 	return false, fmt.Errorf("error checking for block device: %v", err)
 }
 
-func shouldExpandOnline(dom cli.VirDomain, disk api.Disk) bool {
+// onlineExpansionSize returns the size the disk of the running domain should be expanded to,
+// and whether it should be expanded at all. A block device is expanded to its full size, which is 0.
+func onlineExpansionSize(dom cli.VirDomain, disk api.Disk) (int64, bool) {
 	blockInfo, err := dom.GetBlockInfo(getSourceFile(disk), 0)
 	if err != nil {
 		log.DefaultLogger().Reason(err).Error("Failed to get block info")
-		return false
+		return 0, false
 	}
 	guestSize := blockInfo.Capacity
 	// If block device, expand if capacity is lower than physical
 	if disk.Source.Dev != "" {
-		return guestSize < blockInfo.Physical
+		return 0, guestSize < blockInfo.Physical
 	}
 
 	possibleGuestSize, ok := possibleGuestSize(disk)
-	if !ok || possibleGuestSize <= int64(guestSize) {
-		return false
+	if !ok {
+		log.DefaultLogger().Warningf("Failed to get possible guest size from disk %v", disk)
+		return 0, false
 	}
-	return true
+	return possibleGuestSize, possibleGuestSize > int64(guestSize)
 }
 
 func (l *LibvirtDomainManager) getDomainSpec(dom cli.VirDomain) (*api.DomainSpec, error) {
@@ -1978,7 +2098,7 @@ func (l *LibvirtDomainManager) ListAllDomains() ([]*api.Domain, error) {
 }
 
 func (l *LibvirtDomainManager) setDomainSpecWithHooks(vmi *v1.VirtualMachineInstance, origSpec *api.DomainSpec) (cli.VirDomain, error) {
-	return util.SetDomainSpecStrWithHooks(l.virConn, vmi, origSpec)
+	return util.SetDomainSpecStrWithHooks(l.hooksCtx, l.virConn, vmi, origSpec)
 }
 
 func (l *LibvirtDomainManager) GetQemuVersion() (string, error) {
@@ -2046,6 +2166,18 @@ func (l *LibvirtDomainManager) getDomainStats() ([]*stats.DomainStats, error) {
 		}
 	}
 
+	hookSidecars := hooks.GetManager().Stats()
+	for _, ds := range domstats {
+		ds.HookSidecars = hookSidecars
+	}
+
+	// the swap accounting is only available on cgroup v2 nodes
+	if hostSwap, err := stats.ReadHostSwap(launcherCgroupDir, uint64(os.Getpagesize())); err == nil {
+		for _, ds := range domstats {
+			ds.HostSwap = hostSwap
+		}
+	}
+
 	return domstats, nil
 }
 
@@ -2226,6 +2358,14 @@ func (l *LibvirtDomainManager) GetGuestInfo() v1.VirtualMachineInstanceGuestAgen
 		Timezone: fmt.Sprintf("%s, %d", sysInfo.Timezone.Zone, sysInfo.Timezone.Offset),
 	}
 
+	for _, driver := range l.agentData.GetDrivers() {
+		guestInfo.Drivers = append(guestInfo.Drivers, v1.VirtualMachineInstanceGuestOSDriver{
+			Name:     driver.Name,
+			Version:  driver.Version,
+			DeviceID: driver.DeviceID,
+		})
+	}
+
 	for _, user := range userInfo {
 		guestInfo.UserList = append(guestInfo.UserList, v1.VirtualMachineInstanceGuestOSUser{
 			UserName:  user.Name,
@@ -2329,6 +2469,17 @@ func (l *LibvirtDomainManager) GetScreenshot(vmi *v1.VirtualMachineInstance) (*c
 	}, nil
 }
 
+// GetQemuCommandLine returns the command line the QEMU process of the domain was started with, with its secrets redacted
+func (l *LibvirtDomainManager) GetQemuCommandLine(vmi *v1.VirtualMachineInstance) ([]string, error) {
+	domName := api.VMINamespaceKeyFunc(vmi)
+	args, err := util.ReadQemuCommandLine(util.GetQemuPidPath(domName, kutil.IsNonRootVMI(vmi)))
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Getting the QEMU command line failed.")
+		return nil, err
+	}
+	return args, nil
+}
+
 func (l *LibvirtDomainManager) GetSEVInfo() (*v1.SEVPlatformInfo, error) {
 	sevNodeParameters, err := l.virConn.GetSEVInfo()
 	if err != nil {
@@ -2374,8 +2525,15 @@ func (l *LibvirtDomainManager) GetLaunchMeasurement(vmi *v1.VirtualMachineInstan
 	if domainLaunchSecurityParameters.SEVPolicySet {
 		sevMeasurementInfo.Policy = domainLaunchSecurityParameters.SEVPolicy
 	}
+	if domainLaunchSecurityParameters.SEVSNPPolicySet {
+		sevMeasurementInfo.SNPPolicy = domainLaunchSecurityParameters.SEVSNPPolicy
+	}
 
-	loader := l.efiEnvironment.EFICode(false, efi.SEV) // no secureBoot, with sev
+	vmType := efi.SEV
+	if kutil.IsSEVSNPVMI(vmi) {
+		vmType = efi.SNP
+	}
+	loader := l.efiEnvironment.EFICode(false, vmType) // no secureBoot
 	f, err := os.Open(loader)
 	if err != nil {
 		log.Log.Object(vmi).Reason(err).Errorf("Error opening loader binary %s", loader)
@@ -47,6 +47,7 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 	api2 "kubevirt.io/client-go/api"
+	"kubevirt.io/client-go/log"
 
 	cloudinit "kubevirt.io/kubevirt/pkg/cloud-init"
 	ephemeraldiskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
@@ -3576,6 +3577,109 @@ var _ = Describe("Manager helper functions", func() {
 
 	})
 
+	Context("expandDisksOnline", func() {
+		var ctrl *gomock.Controller
+		var mockLibvirt *testing.Libvirt
+
+		newBlockDisk := func(name, dev string) api.Disk {
+			return api.Disk{
+				Alias:  api.NewUserDefinedAlias(name),
+				Source: api.DiskSource{Dev: dev},
+			}
+		}
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			mockLibvirt = testing.NewLibvirt(ctrl)
+		})
+
+		It("should only resize disks whose backing storage grew", func() {
+			mockLibvirt.DomainEXPECT().GetBlockInfo("/dev/grown", uint32(0)).Return(&libvirt.DomainBlockInfo{Capacity: 1024, Physical: 2048}, nil)
+			mockLibvirt.DomainEXPECT().GetBlockInfo("/dev/unchanged", uint32(0)).Return(&libvirt.DomainBlockInfo{Capacity: 2048, Physical: 2048}, nil)
+			mockLibvirt.DomainEXPECT().BlockResize("/dev/grown", uint64(0), libvirt.DOMAIN_BLOCK_RESIZE_BYTES|libvirt.DOMAIN_BLOCK_RESIZE_CAPACITY).Return(nil)
+
+			expandDisksOnline(mockLibvirt.VirtDomain, []api.Disk{
+				newBlockDisk("grown", "/dev/grown"),
+				newBlockDisk("unchanged", "/dev/unchanged"),
+			}, log.DefaultLogger())
+		})
+
+		It("should keep expanding the remaining disks when one resize fails", func() {
+			mockLibvirt.DomainEXPECT().GetBlockInfo(gomock.Any(), uint32(0)).Times(2).Return(&libvirt.DomainBlockInfo{Capacity: 1024, Physical: 2048}, nil)
+			mockLibvirt.DomainEXPECT().BlockResize("/dev/first", gomock.Any(), gomock.Any()).Return(fmt.Errorf("resize failed"))
+			mockLibvirt.DomainEXPECT().BlockResize("/dev/second", gomock.Any(), gomock.Any()).Return(nil)
+
+			expandDisksOnline(mockLibvirt.VirtDomain, []api.Disk{
+				newBlockDisk("first", "/dev/first"),
+				newBlockDisk("second", "/dev/second"),
+			}, log.DefaultLogger())
+		})
+
+		It("should keep expanding the remaining disks when the guest size of one cannot be computed", func() {
+			capacity := int64(4096)
+			// without a filesystem overhead, the possible guest size of a file disk cannot be computed
+			fileDisk := api.Disk{
+				Alias:    api.NewUserDefinedAlias("first"),
+				Source:   api.DiskSource{File: "/var/run/kubevirt-private/vmi-disks/first/disk.img"},
+				Capacity: &capacity,
+			}
+			mockLibvirt.DomainEXPECT().GetBlockInfo(fileDisk.Source.File, uint32(0)).Return(&libvirt.DomainBlockInfo{Capacity: 1024, Physical: 1024}, nil)
+			mockLibvirt.DomainEXPECT().GetBlockInfo("/dev/second", uint32(0)).Return(&libvirt.DomainBlockInfo{Capacity: 1024, Physical: 2048}, nil)
+			mockLibvirt.DomainEXPECT().BlockResize("/dev/second", uint64(0), libvirt.DOMAIN_BLOCK_RESIZE_BYTES|libvirt.DOMAIN_BLOCK_RESIZE_CAPACITY).Return(nil)
+
+			expandDisksOnline(mockLibvirt.VirtDomain, []api.Disk{
+				fileDisk,
+				newBlockDisk("second", "/dev/second"),
+			}, log.DefaultLogger())
+		})
+	})
+
+	Context("defineDiskEncryptionSecrets", func() {
+		var mockLibvirt *testing.Libvirt
+		var manager *LibvirtDomainManager
+
+		newDomain := func(disks ...api.Disk) *api.Domain {
+			domain := &api.Domain{}
+			domain.Spec.Devices.Disks = disks
+			return domain
+		}
+
+		encryptedDisk := api.Disk{
+			Alias: api.NewUserDefinedAlias("luks-disk"),
+			Source: api.DiskSource{
+				File: "/var/run/kubevirt-private/vmi-disks/luks-disk/disk.img",
+				Encryption: &api.DiskEncryption{
+					Format: "luks",
+					Secret: &api.DiskSecret{Type: "passphrase", UUID: "8c1b6b1e-6c36-5d0e-a0c5-1f3e2b4d7a90"},
+				},
+			},
+		}
+
+		BeforeEach(func() {
+			mockLibvirt = testing.NewLibvirt(gomock.NewController(GinkgoT()))
+			manager = &LibvirtDomainManager{virConn: mockLibvirt.VirtConnection}
+		})
+
+		It("should define an ephemeral private secret with the disk passphrase", func() {
+			GinkgoT().Setenv("KUBEVIRT_DISK_PASSPHRASE_LUKS_DISK", "top-secret")
+			mockLibvirt.ConnectionEXPECT().DefineSecret(gomock.Any(), []byte("top-secret")).DoAndReturn(func(secretXML string, _ []byte) error {
+				secret := api.SecretSpec{}
+				Expect(xml.Unmarshal([]byte(secretXML), &secret)).To(Succeed())
+				Expect(secret.Ephemeral).To(Equal("yes"))
+				Expect(secret.Private).To(Equal("yes"))
+				Expect(secret.UUID).To(Equal("8c1b6b1e-6c36-5d0e-a0c5-1f3e2b4d7a90"))
+				Expect(secret.Usage.Volume).To(Equal("/var/run/kubevirt-private/vmi-disks/luks-disk/disk.img"))
+				return nil
+			})
+
+			Expect(manager.defineDiskEncryptionSecrets(newDomain(encryptedDisk, api.Disk{Alias: api.NewUserDefinedAlias("plain")}))).To(Succeed())
+		})
+
+		It("should fail when the passphrase of an encrypted disk is missing", func() {
+			Expect(manager.defineDiskEncryptionSecrets(newDomain(encryptedDisk))).To(MatchError(ContainSubstring("no passphrase found")))
+		})
+	})
+
 	Context("configureLocalDiskToMigrate", func() {
 		const (
 			testvol = "test"
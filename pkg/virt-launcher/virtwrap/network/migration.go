@@ -0,0 +1,118 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package network
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice"
+)
+
+// SafelyDetachInterfaces detaches the domain interfaces of the given VMI interfaces from the live and
+// persistent domain, and waits for the guest to release them. It is used to unplug the interfaces the
+// binding plugins cannot migrate, before the domain is migrated.
+// The detached interfaces are returned also on failure, so that they can be plugged back.
+func SafelyDetachInterfaces(
+	domainSpec *api.DomainSpec,
+	ifaceNames []string,
+	eventDetach hostdevice.EventRegistrar,
+	dom domainClient,
+	timeout time.Duration,
+) ([]api.Interface, error) {
+	var ifacesToDetach []api.Interface
+	for _, ifaceName := range ifaceNames {
+		if iface := lookupDomainInterfaceByName(domainSpec.Devices.Interfaces, ifaceName); iface != nil {
+			ifacesToDetach = append(ifacesToDetach, *iface)
+		}
+	}
+	if len(ifacesToDetach) == 0 {
+		return nil, nil
+	}
+
+	if err := eventDetach.Register(); err != nil {
+		return nil, fmt.Errorf("failed to detach interfaces: %v", err)
+	}
+	defer func() {
+		if err := eventDetach.Deregister(); err != nil {
+			log.Log.Reason(err).Error("failed to detach interfaces")
+		}
+	}()
+
+	var detachedIfaces []api.Interface
+	for _, iface := range ifacesToDetach {
+		ifaceXML, err := xml.Marshal(iface)
+		if err != nil {
+			return detachedIfaces, fmt.Errorf("failed to encode (xml) interface %s, err: %v", iface.Alias.GetName(), err)
+		}
+		if err := dom.DetachDeviceFlags(string(ifaceXML), affectDeviceLiveAndConfigLibvirtFlags); err != nil {
+			return detachedIfaces, fmt.Errorf("failed to detach interface %s, err: %v", iface.Alias.GetName(), err)
+		}
+		detachedIfaces = append(detachedIfaces, iface)
+		log.Log.Infof("Successfully hot-unplug interface: %s", iface.Alias.GetName())
+	}
+
+	return detachedIfaces, waitInterfacesToDetach(eventDetach, detachedIfaces, timeout)
+}
+
+// AttachInterfaces attaches the domain interfaces to the live and persistent domain. It attempts to attach
+// all the interfaces, and returns the failures of all the interfaces which failed to attach.
+func AttachInterfaces(dom domainClient, ifaces []api.Interface) error {
+	var errs []error
+	for _, iface := range ifaces {
+		ifaceXML, err := xml.Marshal(iface)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to encode (xml) interface %s, err: %v", iface.Alias.GetName(), err))
+			continue
+		}
+		if err := dom.AttachDeviceFlags(string(ifaceXML), affectDeviceLiveAndConfigLibvirtFlags); err != nil {
+			errs = append(errs, fmt.Errorf("failed to attach interface %s, err: %v", iface.Alias.GetName(), err))
+			continue
+		}
+		log.Log.Infof("Successfully hot-plug interface: %s", iface.Alias.GetName())
+	}
+	return errors.Join(errs...)
+}
+
+func waitInterfacesToDetach(eventDetach hostdevice.EventRegistrar, ifaces []api.Interface, timeout time.Duration) error {
+	pendingIfaces := map[string]struct{}{}
+	for _, iface := range ifaces {
+		pendingIfaces[iface.Alias.GetName()] = struct{}{}
+	}
+
+	timeoutCh := time.After(timeout)
+	for len(pendingIfaces) > 0 {
+		select {
+		case deviceAlias := <-eventDetach.EventChannel():
+			delete(pendingIfaces, strings.TrimPrefix(deviceAlias.(string), api.UserAliasPrefix))
+		case <-timeoutCh:
+			return fmt.Errorf("failed to wait for interfaces detach, timeout reached: %v", slices.Sorted(maps.Keys(pendingIfaces)))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package network
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/testing"
+)
+
+var _ = Describe("interfaces replugged across migration", func() {
+	const (
+		blueIfaceXML = `<interface type=""><source></source><alias name="ua-blue"></alias></interface>`
+		redIfaceXML  = `<interface type=""><source></source><alias name="ua-red"></alias></interface>`
+
+		detachTimeout = 100 * time.Millisecond
+	)
+
+	var mockClient *testing.Libvirt
+
+	BeforeEach(func() {
+		mockClient = testing.NewLibvirt(gomock.NewController(GinkgoT()))
+	})
+
+	Context("detach", func() {
+		It("should detach the interfaces and wait for the guest to release them", func() {
+			mockClient.DomainEXPECT().DetachDeviceFlags(blueIfaceXML, affectDeviceLiveAndConfigLibvirtFlags).Return(nil)
+			mockClient.DomainEXPECT().DetachDeviceFlags(redIfaceXML, affectDeviceLiveAndConfigLibvirtFlags).Return(nil)
+			eventDetach := newFakeEventRegistrar("ua-blue", "ua-red")
+
+			detachedIfaces, err := SafelyDetachInterfaces(
+				&dummyDomain(defaultNet, "blue", "red").Spec, []string{"blue", "red"}, eventDetach, mockClient.VirtDomain, detachTimeout,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(detachedIfaces).To(Equal(dummyDomain("blue", "red").Spec.Devices.Interfaces))
+			Expect(eventDetach.registered).To(BeFalse())
+		})
+
+		It("should skip the interfaces which are not in the domain", func() {
+			eventDetach := newFakeEventRegistrar()
+
+			detachedIfaces, err := SafelyDetachInterfaces(
+				&dummyDomain(defaultNet).Spec, []string{"blue"}, eventDetach, mockClient.VirtDomain, detachTimeout,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(detachedIfaces).To(BeEmpty())
+		})
+
+		It("should return the detached interfaces when a detach fails", func() {
+			mockClient.DomainEXPECT().DetachDeviceFlags(blueIfaceXML, gomock.Any()).Return(nil)
+			mockClient.DomainEXPECT().DetachDeviceFlags(redIfaceXML, gomock.Any()).Return(fmt.Errorf("device busy"))
+
+			detachedIfaces, err := SafelyDetachInterfaces(
+				&dummyDomain("blue", "red").Spec, []string{"blue", "red"}, newFakeEventRegistrar(), mockClient.VirtDomain, detachTimeout,
+			)
+			Expect(err).To(MatchError(ContainSubstring("failed to detach interface red")))
+			Expect(detachedIfaces).To(Equal(dummyDomain("blue").Spec.Devices.Interfaces))
+		})
+
+		It("should fail when the guest does not release the interfaces in time", func() {
+			mockClient.DomainEXPECT().DetachDeviceFlags(gomock.Any(), gomock.Any()).Times(2).Return(nil)
+
+			detachedIfaces, err := SafelyDetachInterfaces(
+				&dummyDomain("blue", "red").Spec, []string{"blue", "red"}, newFakeEventRegistrar("ua-red"), mockClient.VirtDomain, detachTimeout,
+			)
+			Expect(err).To(MatchError("failed to wait for interfaces detach, timeout reached: [blue]"))
+			Expect(detachedIfaces).To(HaveLen(2))
+		})
+	})
+
+	Context("attach", func() {
+		It("should attach all the interfaces, reporting the ones which failed", func() {
+			mockClient.DomainEXPECT().AttachDeviceFlags(blueIfaceXML, affectDeviceLiveAndConfigLibvirtFlags).Return(fmt.Errorf("no device"))
+			mockClient.DomainEXPECT().AttachDeviceFlags(redIfaceXML, affectDeviceLiveAndConfigLibvirtFlags).Return(nil)
+
+			err := AttachInterfaces(mockClient.VirtDomain, dummyDomain("blue", "red").Spec.Devices.Interfaces)
+			Expect(err).To(MatchError("failed to attach interface blue, err: no device"))
+		})
+	})
+})
+
+type fakeEventRegistrar struct {
+	registered bool
+	events     chan interface{}
+}
+
+func newFakeEventRegistrar(removedDeviceAliases ...string) *fakeEventRegistrar {
+	events := make(chan interface{}, len(removedDeviceAliases))
+	for _, alias := range removedDeviceAliases {
+		events <- alias
+	}
+	return &fakeEventRegistrar{events: events}
+}
+
+func (f *fakeEventRegistrar) Register() error {
+	f.registered = true
+	return nil
+}
+
+func (f *fakeEventRegistrar) Deregister() error {
+	f.registered = false
+	return nil
+}
+
+func (f *fakeEventRegistrar) EventChannel() <-chan interface{} {
+	return f.events
+}
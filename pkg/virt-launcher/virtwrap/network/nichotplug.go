@@ -30,6 +30,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
+	neterrors "kubevirt.io/kubevirt/pkg/network/errors"
 	virtnetlink "kubevirt.io/kubevirt/pkg/network/link"
 	"kubevirt.io/kubevirt/pkg/network/namescheme"
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
@@ -68,7 +69,7 @@ func (vim *virtIOInterfaceManager) hotplugVirtioInterface(vmi *v1.VirtualMachine
 		log.Log.Infof("will hot plug %s", network.Name)
 
 		if err := vim.configurator.SetupPodNetworkPhase2(updatedDomain, []v1.Network{network}); err != nil {
-			return err
+			return neterrors.CreateInterfaceHotplugError(network.Name, err)
 		}
 
 		relevantIface := lookupDomainInterfaceByName(updatedDomain.Spec.Devices.Interfaces, network.Name)
@@ -88,12 +89,24 @@ func (vim *virtIOInterfaceManager) hotplugVirtioInterface(vmi *v1.VirtualMachine
 
 		if err := vim.dom.AttachDeviceFlags(strings.ToLower(string(ifaceXML)), affectDeviceLiveAndConfigLibvirtFlags); err != nil {
 			log.Log.Reason(err).Errorf("libvirt failed to attach interface %s: %v", network.Name, err)
-			return err
+			vim.releaseInterface(network.Name, ifaceXML)
+			return neterrors.CreateInterfaceHotplugError(network.Name, err)
 		}
 	}
 	return nil
 }
 
+// releaseInterface detaches an interface which failed to attach, in case libvirt has left it
+// partially attached (e.g. to the live domain but not to its persistent config).
+// The detach is expected to fail when nothing has been attached.
+func (vim *virtIOInterfaceManager) releaseInterface(networkName string, ifaceXML []byte) {
+	if err := vim.dom.DetachDeviceFlags(strings.ToLower(string(ifaceXML)), affectDeviceLiveAndConfigLibvirtFlags); err != nil {
+		log.Log.V(4).Infof("interface %s was not left attached: %v", networkName, err)
+		return
+	}
+	log.Log.Infof("released partially attached interface %s", networkName)
+}
+
 func (vim *virtIOInterfaceManager) updateDomainLinkState(currentDomain, desiredDomain *api.Domain) error {
 	currentDomainIfacesByAlias := indexedDomainInterfaces(currentDomain)
 	for _, desiredIface := range desiredDomain.Spec.Devices.Interfaces {
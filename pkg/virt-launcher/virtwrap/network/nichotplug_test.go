@@ -21,6 +21,7 @@ package network
 
 import (
 	"encoding/xml"
+	"errors"
 	"fmt"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -33,6 +34,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
 
+	neterrors "kubevirt.io/kubevirt/pkg/network/errors"
 	"kubevirt.io/kubevirt/pkg/network/namescheme"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
@@ -209,7 +211,12 @@ var _ = Describe("nic hotplug on virt-launcher", func() {
 				mockLibvirtClient(gomock.NewController(GinkgoT()), result).VirtDomain,
 				configurator,
 			)
-			Expect(networkInterfaceManager.hotplugVirtioInterface(vmi, currentDomain, updatedDomain)).To(MatchError("boom"))
+			err := networkInterfaceManager.hotplugVirtioInterface(vmi, currentDomain, updatedDomain)
+			Expect(err).To(MatchError(ContainSubstring("boom")))
+
+			var hotplugErr *neterrors.InterfaceHotplugError
+			Expect(errors.As(err, &hotplugErr)).To(BeTrue())
+			Expect(hotplugErr.Interface).To(Equal(networkName))
 		},
 		Entry("the VM network configurator ERRORs invoking setup networking phase#2",
 			vmiWithSingleBridgeInterfaceWithPodInterfaceReady(networkName, nadName),
@@ -377,6 +384,8 @@ func mockLibvirtClient(mockController *gomock.Controller, clientResult libvirtCl
 	mockClient := testing.NewLibvirt(mockController)
 	if clientResult.expectedError != nil {
 		mockClient.DomainEXPECT().AttachDeviceFlags(gomock.Any(), gomock.Any()).Return(clientResult.expectedError)
+		// The interface which failed to attach is released, in case libvirt left it partially attached
+		mockClient.DomainEXPECT().DetachDeviceFlags(gomock.Any(), gomock.Any()).Return(fmt.Errorf("device not found"))
 		return mockClient
 	}
 	mockClient.DomainEXPECT().AttachDeviceFlags(gomock.Any(), gomock.Any()).Times(clientResult.expectedAttachedDevices).Return(nil)
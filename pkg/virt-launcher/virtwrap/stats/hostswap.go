@@ -0,0 +1,80 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ */
+
+package stats
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	swapCurrentFile = "memory.swap.current"
+	memoryStatFile  = "memory.stat"
+)
+
+// ReadHostSwap reads the node swap usage and the swap traffic of the cgroup v2 in cgroupDir.
+// Counters which are not exposed by the kernel are left unset.
+func ReadHostSwap(cgroupDir string, pageSize uint64) (*DomainStatsHostSwap, error) {
+	hostSwap := &DomainStatsHostSwap{}
+
+	content, err := os.ReadFile(filepath.Join(cgroupDir, swapCurrentFile))
+	if err != nil {
+		return nil, err
+	}
+	usage, err := strconv.ParseUint(strings.TrimSpace(string(content)), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	hostSwap.UsageSet = true
+	hostSwap.Usage = usage
+
+	f, err := os.Open(filepath.Join(cgroupDir, memoryStatFile))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		pages, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		switch fields[0] {
+		case "pswpin":
+			hostSwap.SwapInSet = true
+			hostSwap.SwapIn = pages * pageSize
+		case "pswpout":
+			hostSwap.SwapOutSet = true
+			hostSwap.SwapOut = pages * pageSize
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return hostSwap, nil
+}
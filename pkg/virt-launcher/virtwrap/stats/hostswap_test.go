@@ -0,0 +1,77 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ */
+
+package stats_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+var _ = Describe("ReadHostSwap", func() {
+	const pageSize = 4096
+
+	var cgroupDir string
+
+	BeforeEach(func() {
+		cgroupDir = GinkgoT().TempDir()
+	})
+
+	writeFile := func(name, content string) {
+		Expect(os.WriteFile(filepath.Join(cgroupDir, name), []byte(content), 0o644)).To(Succeed())
+	}
+
+	It("should report the swap usage and traffic in bytes", func() {
+		writeFile("memory.swap.current", "8192\n")
+		writeFile("memory.stat", "anon 1024\npswpin 3\npswpout 5\nzswpin 7\n")
+
+		hostSwap, err := stats.ReadHostSwap(cgroupDir, pageSize)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*hostSwap).To(Equal(stats.DomainStatsHostSwap{
+			UsageSet:   true,
+			Usage:      8192,
+			SwapInSet:  true,
+			SwapIn:     3 * pageSize,
+			SwapOutSet: true,
+			SwapOut:    5 * pageSize,
+		}))
+	})
+
+	It("should leave the traffic unset if the kernel does not expose it", func() {
+		writeFile("memory.swap.current", "0\n")
+		writeFile("memory.stat", "anon 1024\n")
+
+		hostSwap, err := stats.ReadHostSwap(cgroupDir, pageSize)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hostSwap.UsageSet).To(BeTrue())
+		Expect(hostSwap.SwapInSet).To(BeFalse())
+		Expect(hostSwap.SwapOutSet).To(BeFalse())
+	})
+
+	It("should fail without swap accounting", func() {
+		writeFile("memory.stat", "anon 1024\n")
+
+		_, err := stats.ReadHostSwap(cgroupDir, pageSize)
+		Expect(err).To(MatchError(os.ErrNotExist))
+	})
+})
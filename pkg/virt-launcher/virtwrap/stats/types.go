@@ -73,6 +73,30 @@ type DomainStats struct {
 	NrVirtCpu uint
 	DirtyRate *DomainStatsDirtyRate
 	Load      *DomainStatsLoad
+	// requests sent by virt-launcher to the hook sidecars
+	HookSidecars []DomainStatsHookSidecar
+	// node swap used by the virt-launcher pod
+	HostSwap *DomainStatsHostSwap
+}
+
+type DomainStatsHostSwap struct {
+	UsageSet   bool
+	Usage      uint64
+	SwapInSet  bool
+	SwapIn     uint64
+	SwapOutSet bool
+	SwapOut    uint64
+}
+
+type DomainStatsHookSidecar struct {
+	// Container is the name of the sidecar container
+	Container string
+	// Plugin is the name of the plugin the sidecar runs, empty for sidecars not running a plugin
+	Plugin   string
+	Requests uint64
+	Errors   uint64
+	// ErrorsByCode counts the failed requests by the binding plugin error code they were classified with
+	ErrorsByCode map[string]uint64
 }
 
 type DomainStatsLoad struct {
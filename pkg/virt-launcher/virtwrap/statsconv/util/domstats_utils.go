@@ -285,7 +285,9 @@ var Testdataexpected = `{
      "MegabytesPerSecondSet": false,
      "MegabytesPerSecond": 0
    },
-   "Load": null
+   "Load": null,
+   "HookSidecars": null,
+   "HostSwap": null
  }`
 
 func LoadStats() ([]libvirt.DomainStats, error) {
@@ -2,6 +2,7 @@ package util
 
 import (
 	"bufio"
+	"context"
 	"encoding/base64"
 	"encoding/xml"
 	"errors"
@@ -137,9 +138,9 @@ func SetDomainSpecStr(virConn cli.Connection, vmi *v1.VirtualMachineInstance, wa
 	return dom, nil
 }
 
-func SetDomainSpecStrWithHooks(virConn cli.Connection, vmi *v1.VirtualMachineInstance, wantedSpec *api.DomainSpec) (cli.VirDomain, error) {
+func SetDomainSpecStrWithHooks(ctx context.Context, virConn cli.Connection, vmi *v1.VirtualMachineInstance, wantedSpec *api.DomainSpec) (cli.VirDomain, error) {
 	hooksManager := getHookManager()
-	domainSpec, err := hooksManager.OnDefineDomain(wantedSpec, vmi)
+	domainSpec, err := hooksManager.OnDefineDomain(ctx, wantedSpec, vmi)
 	if err != nil {
 		return nil, err
 	}
@@ -230,12 +231,20 @@ func (l LibvirtWrapper) StartVirtqemud(stopChan chan struct{}) {
 	// doesn't exit until virt-launcher is ready for it to. Virt-launcher traps signals
 	// to perform special shutdown logic. These processes need to live in the same
 	// container.
+	l.startLibvirtDaemon(stopChan, "virtqemud", "-f", "/var/run/libvirt/virtqemud.conf")
+}
+
+// StartVirtsecretd spawns the libvirt secret daemon, it is only needed when
+// secrets like the passphrases of encrypted disks are handed to libvirt
+func (l LibvirtWrapper) StartVirtsecretd(stopChan chan struct{}) {
+	l.startLibvirtDaemon(stopChan, "virtsecretd")
+}
 
+func (l LibvirtWrapper) startLibvirtDaemon(stopChan chan struct{}, daemon string, args ...string) {
 	go func() {
 		for {
 			exitChan := make(chan struct{})
-			args := []string{"-f", "/var/run/libvirt/virtqemud.conf"}
-			cmd := exec.Command("/usr/sbin/virtqemud", args...)
+			cmd := exec.Command(filepath.Join("/usr/sbin", daemon), args...)
 			if l.user != 0 {
 				cmd.SysProcAttr = &syscall.SysProcAttr{
 					AmbientCaps: []uintptr{unix.CAP_NET_BIND_SERVICE},
@@ -245,7 +254,7 @@ func (l LibvirtWrapper) StartVirtqemud(stopChan chan struct{}) {
 			// connect libvirt's stderr to our own stdout in order to see the logs in the container logs
 			reader, err := cmd.StderrPipe()
 			if err != nil {
-				log.Log.Reason(err).Error("failed to start virtqemud")
+				log.Log.Reason(err).Errorf("failed to start %s", daemon)
 				panic(err)
 			}
 
@@ -263,7 +272,7 @@ func (l LibvirtWrapper) StartVirtqemud(stopChan chan struct{}) {
 
 			err = cmd.Start()
 			if err != nil {
-				log.Log.Reason(err).Error("failed to start virtqemud")
+				log.Log.Reason(err).Errorf("failed to start %s", daemon)
 				panic(err)
 			}
 
@@ -277,11 +286,11 @@ func (l LibvirtWrapper) StartVirtqemud(stopChan chan struct{}) {
 				cmd.Process.Kill()
 				return
 			case <-exitChan:
-				log.Log.Errorf("virtqemud exited, restarting")
+				log.Log.Errorf("%s exited, restarting", daemon)
 			}
 
 			// this sleep is to avoid consuming all resources in the
-			// event of a virtqemud crash loop.
+			// event of a libvirt daemon crash loop.
 			time.Sleep(time.Second)
 		}
 	}()
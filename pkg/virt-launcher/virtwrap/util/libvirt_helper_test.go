@@ -3,6 +3,7 @@ package util
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"encoding/json"
 	"encoding/xml"
 	"fmt"
@@ -265,11 +266,11 @@ var _ = Describe("LibvirtHelper", func() {
 		defer func() {
 			getHookManager = hooks.GetManager
 		}()
-		mockHookManager.EXPECT().OnDefineDomain(wantedSpec, vmi).Return(string(mutatedSpecXml), nil)
+		mockHookManager.EXPECT().OnDefineDomain(gomock.Any(), wantedSpec, vmi).Return(string(mutatedSpecXml), nil)
 		mockLibvirt.ConnectionEXPECT().DomainDefineXML(string(mutatedSpecXml)).Return(mockLibvirt.VirtDomain, nil)
 		mockLibvirt.DomainEXPECT().Free()
 
-		dom, err := SetDomainSpecStrWithHooks(mockLibvirt.VirtConnection, vmi, wantedSpec)
+		dom, err := SetDomainSpecStrWithHooks(context.Background(), mockLibvirt.VirtConnection, vmi, wantedSpec)
 		Expect(err).NotTo(HaveOccurred())
 		dom.Free()
 
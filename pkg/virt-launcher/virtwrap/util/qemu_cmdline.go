@@ -0,0 +1,122 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package util
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const redactedValue = "<redacted>"
+
+// qemuSecretKeys are the keys of the QEMU options which may hold secrets, e.g. the encrypted data and the
+// initialization vector of the secret objects libvirt passes for the disk and the VNC/SPICE credentials.
+var qemuSecretKeys = map[string]bool{
+	"data":     true,
+	"iv":       true,
+	"password": true,
+	"passwd":   true,
+}
+
+// jsonSecretRegex matches the secret members of the JSON arguments, e.g. -object '{"qom-type":"secret",...}'
+var jsonSecretRegex = regexp.MustCompile(`"(data|iv|password|passwd)":"(?:[^"\\]|\\.)*"`)
+
+// GetQemuPidPath returns the path to the file libvirt writes the pid of the QEMU process of a domain to
+func GetQemuPidPath(domainName string, nonRoot bool) string {
+	if nonRoot {
+		return filepath.Join("/run", "libvirt", "qemu", "run", fmt.Sprintf("%s.pid", domainName))
+	}
+	return filepath.Join("/run", "libvirt", "qemu", fmt.Sprintf("%s.pid", domainName))
+}
+
+// ReadQemuCommandLine returns the command line of the QEMU process whose pid is written in the pid file,
+// with the values of the options which may hold secrets redacted.
+func ReadQemuCommandLine(pidPath string) ([]string, error) {
+	content, err := os.ReadFile(pidPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the QEMU pid file: %w", err)
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(content)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the QEMU pid file: %w", err)
+	}
+
+	cmdline, err := os.ReadFile(filepath.Join("/proc", strconv.Itoa(pid), "cmdline"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the QEMU command line: %w", err)
+	}
+	args := strings.Split(strings.TrimSuffix(string(cmdline), "\x00"), "\x00")
+
+	return RedactQemuCommandLine(args), nil
+}
+
+// RedactQemuCommandLine returns a copy of the QEMU arguments, where the values of the options which may hold
+// secrets are redacted. Both the JSON and the comma separated key=value syntaxes are handled.
+func RedactQemuCommandLine(args []string) []string {
+	redacted := make([]string, 0, len(args))
+	for _, arg := range args {
+		if strings.HasPrefix(arg, "{") {
+			redacted = append(redacted, jsonSecretRegex.ReplaceAllString(arg, `"$1":"`+redactedValue+`"`))
+			continue
+		}
+		redacted = append(redacted, redactKeyValueOptions(arg))
+	}
+	return redacted
+}
+
+// redactKeyValueOptions redacts the secrets of an argument in the key=value,key=value syntax,
+// where a double comma is an escaped comma of the value.
+func redactKeyValueOptions(arg string) string {
+	if !strings.Contains(arg, "=") {
+		return arg
+	}
+
+	options := splitKeyValueOptions(arg)
+	for i, option := range options {
+		key, _, found := strings.Cut(option, "=")
+		if found && qemuSecretKeys[key] {
+			options[i] = key + "=" + redactedValue
+		}
+	}
+	return strings.Join(options, ",")
+}
+
+func splitKeyValueOptions(arg string) []string {
+	var options []string
+	var option strings.Builder
+	for i := 0; i < len(arg); i++ {
+		if arg[i] != ',' {
+			option.WriteByte(arg[i])
+			continue
+		}
+		if i+1 < len(arg) && arg[i+1] == ',' {
+			option.WriteString(",,")
+			i++
+			continue
+		}
+		options = append(options, option.String())
+		option.Reset()
+	}
+	return append(options, option.String())
+}
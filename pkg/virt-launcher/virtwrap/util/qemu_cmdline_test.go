@@ -0,0 +1,85 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package util
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("QEMU command line", func() {
+	DescribeTable("RedactQemuCommandLine should redact the secrets", func(arg, expectedArg string) {
+		args := []string{"/usr/libexec/qemu-kvm", "-object", arg}
+		Expect(RedactQemuCommandLine(args)).To(Equal([]string{"/usr/libexec/qemu-kvm", "-object", expectedArg}))
+	},
+		Entry("of a JSON secret object",
+			`{"qom-type":"secret","id":"masterKey0","format":"raw","file":"/var/lib/libvirt/qemu/domain-1/master-key.aes"}`,
+			`{"qom-type":"secret","id":"masterKey0","format":"raw","file":"/var/lib/libvirt/qemu/domain-1/master-key.aes"}`,
+		),
+		Entry("of a JSON encrypted secret object",
+			`{"qom-type":"secret","id":"ua-disk0-secret0","data":"9eao5F8qtkGt+seB1HYivWIxbtwUu6MQtg==","keyid":"masterKey0","iv":"AAECAwQFBgcICQoLDA0ODw==","format":"base64"}`,
+			`{"qom-type":"secret","id":"ua-disk0-secret0","data":"<redacted>","keyid":"masterKey0","iv":"<redacted>","format":"base64"}`,
+		),
+		Entry("of a JSON object with an escaped quote",
+			`{"qom-type":"secret","id":"sec0","data":"a\"b","format":"raw"}`,
+			`{"qom-type":"secret","id":"sec0","data":"<redacted>","format":"raw"}`,
+		),
+		Entry("of comma separated options",
+			"secret,id=vnc-secret0,data=c2VjcmV0,keyid=masterKey0,iv=AAECAw==,format=base64",
+			"secret,id=vnc-secret0,data=<redacted>,keyid=masterKey0,iv=<redacted>,format=base64",
+		),
+		Entry("of comma separated options with an escaped comma",
+			"secret,id=sec0,data=foo,,bar,format=raw",
+			"secret,id=sec0,data=<redacted>,format=raw",
+		),
+	)
+
+	It("RedactQemuCommandLine should keep the netdev arguments", func() {
+		args := []string{
+			"-netdev", `{"type":"vhost-vdpa","vhostdev":"/dev/vhost-vdpa-0","id":"hostua-default"}`,
+			"-device", `{"driver":"virtio-net-pci","netdev":"hostua-default","id":"ua-default","mac":"02:00:00:00:00:01","bus":"pci.1","addr":"0x0"}`,
+			"-chardev", "socket,id=charua-default,path=/var/run/vhost-user/sock0,server=on",
+		}
+		Expect(RedactQemuCommandLine(args)).To(Equal(args))
+	})
+
+	It("ReadQemuCommandLine should read the command line of the process in the pid file", func() {
+		pidPath := filepath.Join(GinkgoT().TempDir(), "default_testvmi.pid")
+		Expect(os.WriteFile(pidPath, []byte(strconv.Itoa(os.Getpid())), 0o644)).To(Succeed())
+
+		Expect(ReadQemuCommandLine(pidPath)).To(Equal(os.Args))
+	})
+
+	It("ReadQemuCommandLine should fail without the pid file", func() {
+		_, err := ReadQemuCommandLine(filepath.Join(GinkgoT().TempDir(), "default_testvmi.pid"))
+		Expect(err).To(MatchError(ContainSubstring("failed to read the QEMU pid file")))
+	})
+
+	DescribeTable("GetQemuPidPath should return the pid file path", func(nonRoot bool, expectedPath string) {
+		Expect(GetQemuPidPath("default_testvmi", nonRoot)).To(Equal(expectedPath))
+	},
+		Entry("of a root VMI", false, "/run/libvirt/qemu/default_testvmi.pid"),
+		Entry("of a non root VMI", true, "/run/libvirt/qemu/run/default_testvmi.pid"),
+	)
+})
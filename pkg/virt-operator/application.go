@@ -314,6 +314,17 @@ func Execute() {
 		app.informers.ValidatingAdmissionPolicy = app.informerFactory.DummyOperatorValidatingAdmissionPolicy()
 	}
 
+	certManagerEnabled, err := util.IsCertManagerEnabled(app.clientSet)
+	if err != nil {
+		golog.Fatalf("Error checking for cert-manager: %v", err)
+	}
+	if certManagerEnabled {
+		log.Log.Info("cert-manager is defined")
+		app.config.CertManagerEnabled = true
+	} else {
+		log.Log.Info("cert-manager is not defined")
+	}
+
 	app.prepareCertManagers()
 
 	app.kubeVirtRecorder = app.getNewRecorder(k8sv1.NamespaceAll, VirtOperator)
@@ -475,7 +486,7 @@ func (app *VirtOperatorApp) Run() {
 
 }
 
-// Detects if ServiceMonitor or PrometheusRule crd has been applied or deleted that
+// Detects if ServiceMonitor, PrometheusRule or cert-manager crds have been applied or deleted that
 // re-initializing virt-operator.
 func (app *VirtOperatorApp) configModificationCallback() {
 	msgf := "Reinitialize virt-operator, %s has been %s"
@@ -499,6 +510,17 @@ func (app *VirtOperatorApp) configModificationCallback() {
 			log.Log.Infof(msgf, "PrometheusRule", "removed")
 		}
 		app.reInitChan <- "reinit"
+		return
+	}
+
+	cmEnabled := app.clusterConfig.HasCertManagerAPI()
+	if app.config.CertManagerEnabled != cmEnabled {
+		if !app.config.CertManagerEnabled && cmEnabled {
+			log.Log.Infof(msgf, "cert-manager", "introduced")
+		} else {
+			log.Log.Infof(msgf, "cert-manager", "removed")
+		}
+		app.reInitChan <- "reinit"
 	}
 }
 
@@ -1107,14 +1107,23 @@ func (c *KubeVirtController) syncInstallation(kv *v1.KubeVirt) error {
 			kv.Status.Phase = v1.KubeVirtPhaseDeployed
 			util.UpdateConditionsAvailable(kv)
 			kv.Status.ObservedGeneration = &kv.ObjectMeta.Generation
+			c.updateCertManagerCondition(kv)
 			return nil
 		}
 	}
 
+	c.updateCertManagerCondition(kv)
 	logger.Info("Processed deployment for this round")
 	return nil
 }
 
+// updateCertManagerCondition degrades the KubeVirt CR when cert-manager is configured but its API is not installed
+func (c *KubeVirtController) updateCertManagerCondition(kv *v1.KubeVirt) {
+	if kv.Spec.CertificateRotationStrategy.CertManager != nil && !c.config.CertManagerEnabled {
+		util.UpdateConditionsCertManagerUnavailable(kv)
+	}
+}
+
 func (c *KubeVirtController) isReady(kv *v1.KubeVirt) bool {
 
 	for _, obj := range c.stores.DeploymentCache.List() {
@@ -76,6 +76,8 @@ func (r *Reconciler) syncDeployment(origDeployment *appsv1.Deployment) (*appsv1.
 	}
 
 	switch deployment.Name {
+	case components.VirtAPIName:
+		components.InjectConsoleRecordingSink(kv.Spec.Configuration.ConsoleRecording, &deployment.Spec.Template.Spec)
 	case components.VirtTemplateApiserverDeploymentName:
 		if err := kvtls.InjectTLSConfigIntoDeployment(kv, deployment, components.VirtTemplateApiserverContainerName); err != nil {
 			return nil, err
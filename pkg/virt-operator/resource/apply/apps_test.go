@@ -1279,6 +1279,67 @@ var _ = Describe("Apply Apps", func() {
 			Entry("large cluster with 10 schedulable nodes", 10, 990, 2),
 		)
 
+		Context("console recording sink injection", func() {
+			var reconciler *Reconciler
+
+			BeforeEach(func() {
+				reconciler = &Reconciler{
+					clientset:    clientset,
+					kv:           kv,
+					expectations: &util.Expectations{Deployment: controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectationsWithName("Deployment"))},
+					stores:       util.Stores{DeploymentCache: &MockStore{get: nil}},
+				}
+				Expect(dpClient.AppsV1().Deployments(Namespace).Delete(context.TODO(), virtAPIDeployment.Name, metav1.DeleteOptions{})).To(Succeed())
+			})
+
+			It("should mount the PersistentVolumeClaim sink into virt-api", func() {
+				kv.Spec.Configuration.ConsoleRecording = &v1.ConsoleRecordingConfiguration{
+					Sink: v1.ConsoleRecordingSink{PersistentVolumeClaim: &v1.ConsoleRecordingPVCSink{ClaimName: "recordings"}},
+				}
+
+				createdDeployment, err := reconciler.syncDeployment(virtAPIDeployment)
+				Expect(err).ToNot(HaveOccurred())
+
+				pod := createdDeployment.Spec.Template.Spec
+				Expect(pod.Volumes).To(ContainElement(corev1.Volume{
+					Name: components.ConsoleRecordingVolumeName,
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "recordings"},
+					},
+				}))
+				Expect(pod.Containers[0].VolumeMounts).To(ContainElement(corev1.VolumeMount{
+					Name:      components.ConsoleRecordingVolumeName,
+					MountPath: components.ConsoleRecordingMountPath,
+				}))
+			})
+
+			It("should expose the S3 sink credentials to virt-api", func() {
+				kv.Spec.Configuration.ConsoleRecording = &v1.ConsoleRecordingConfiguration{
+					Sink: v1.ConsoleRecordingSink{S3: &v1.ConsoleRecordingS3Sink{CredentialsSecretName: "s3-credentials"}},
+				}
+
+				createdDeployment, err := reconciler.syncDeployment(virtAPIDeployment)
+				Expect(err).ToNot(HaveOccurred())
+
+				env := createdDeployment.Spec.Template.Spec.Containers[0].Env
+				Expect(env).To(ContainElement(corev1.EnvVar{
+					Name: components.ConsoleRecordingS3AccessKeyIDEnv,
+					ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "s3-credentials"},
+						Key:                  components.ConsoleRecordingS3AccessKeyIDKey,
+					}},
+				}))
+				Expect(env).To(ContainElement(HaveField("Name", components.ConsoleRecordingS3SecretAccessKeyEnv)))
+			})
+
+			It("should not touch virt-api when console recording is not configured", func() {
+				createdDeployment, err := reconciler.syncDeployment(virtAPIDeployment)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(createdDeployment.Spec.Template.Spec.Volumes).ToNot(ContainElement(HaveField("Name", components.ConsoleRecordingVolumeName)))
+			})
+		})
+
 		Context("virt-template TLS injection", func() {
 			const (
 				tlsCipherSuitesArg = "--tls-cipher-suites"
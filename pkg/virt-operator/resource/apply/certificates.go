@@ -78,3 +78,24 @@ func GetCertRenewBefore(config *k8sv1.KubeVirtSelfSignConfiguration) *metav1.Dur
 
 	return defaultDuration
 }
+
+func GetCertManagerCertDuration(config *k8sv1.KubeVirtCertificateRotateStrategy) *metav1.Duration {
+	if config.CertManager == nil || config.CertManager.Certificate == nil || config.CertManager.Certificate.Duration == nil {
+		return GetCertDuration(config.SelfSigned)
+	}
+
+	return config.CertManager.Certificate.Duration
+}
+
+func GetCertManagerCertRenewBefore(config *k8sv1.KubeVirtCertificateRotateStrategy) *metav1.Duration {
+	if config.CertManager == nil || config.CertManager.Certificate == nil {
+		return GetCertRenewBefore(config.SelfSigned)
+	}
+
+	if config.CertManager.Certificate.RenewBefore != nil {
+		return config.CertManager.Certificate.RenewBefore
+	}
+
+	certDuration := GetCertManagerCertDuration(config)
+	return &metav1.Duration{Duration: time.Duration(float64(certDuration.Duration) * 0.2)}
+}
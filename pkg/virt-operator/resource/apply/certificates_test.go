@@ -114,4 +114,34 @@ var _ = Describe("Certificates", func() {
 		})
 	})
 
+	Context("issued by cert-manager", func() {
+		var strategy *v1.KubeVirtCertificateRotateStrategy
+		BeforeEach(func() {
+			config.Server.Duration = twoDays
+			strategy = &v1.KubeVirtCertificateRotateStrategy{
+				SelfSigned:  config,
+				CertManager: &v1.KubeVirtCertManagerConfiguration{},
+			}
+		})
+
+		It("should fall back to the self signed Server configuration", func() {
+			Expect(GetCertManagerCertDuration(strategy)).To(Equal(twoDays))
+			Expect(GetCertManagerCertRenewBefore(strategy)).To(Equal(GetCertRenewBefore(config)))
+		})
+
+		It("should use the cert-manager Certificate configuration", func() {
+			strategy.CertManager.Certificate = &v1.CertConfig{Duration: fiveDays}
+
+			Expect(GetCertManagerCertDuration(strategy)).To(Equal(fiveDays))
+			// Default renew before is 20% of the cert-manager certificate duration
+			reference := &metav1.Duration{Duration: time.Duration(120 * float64(time.Hour) * 0.2)}
+			Expect(GetCertManagerCertRenewBefore(strategy)).To(Equal(reference))
+
+			By("Defining Certificate.RenewBefore")
+			strategy.CertManager.Certificate.RenewBefore = threeDays
+
+			Expect(GetCertManagerCertRenewBefore(strategy)).To(Equal(threeDays))
+		})
+	})
+
 })
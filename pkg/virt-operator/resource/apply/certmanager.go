@@ -0,0 +1,145 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package apply
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/certificates/triple/cert"
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
+)
+
+// isCertManagerActive tells whether the certificates are delegated to cert-manager, which requires its API to be installed
+func (r *Reconciler) isCertManagerActive() bool {
+	return r.kv.Spec.CertificateRotationStrategy.CertManager != nil && r.config.CertManagerEnabled
+}
+
+func (r *Reconciler) isCertManagerCertificateSecret(secret *corev1.Secret) bool {
+	return r.isCertManagerActive() && components.IsCertManagerCertificateSecret(secret.Name)
+}
+
+// createOrUpdateCertManagerIssuer ensures the dedicated CA and the issuer signing the certificates of the components with it
+func (r *Reconciler) createOrUpdateCertManagerIssuer() error {
+	meta := &metav1.ObjectMeta{}
+	version, imageRegistry, id := getTargetVersionRegistryID(r.kv)
+	injectOperatorMetadata(r.kv, meta, version, imageRegistry, id, true)
+
+	strategy := &r.kv.Spec.CertificateRotationStrategy
+	caCertificate := components.NewCertManagerCACertificate(r.kv.Namespace, meta.Labels, strategy.CertManager,
+		GetCADuration(strategy.SelfSigned), GetCARenewBefore(strategy.SelfSigned))
+	if err := r.createOrUpdateCertManagerObject(components.CertManagerCertificateGVR, caCertificate); err != nil {
+		return err
+	}
+
+	return r.createOrUpdateCertManagerObject(components.CertManagerIssuerGVR, components.NewCertManagerIssuer(r.kv.Namespace, meta.Labels))
+}
+
+func (r *Reconciler) createOrUpdateCertManagerCertificate(secret *corev1.Secret) error {
+	secret = secret.DeepCopy()
+	version, imageRegistry, id := getTargetVersionRegistryID(r.kv)
+	injectOperatorMetadata(r.kv, &secret.ObjectMeta, version, imageRegistry, id, true)
+
+	strategy := &r.kv.Spec.CertificateRotationStrategy
+	certificate, err := components.NewCertManagerCertificate(secret, GetCertManagerCertDuration(strategy), GetCertManagerCertRenewBefore(strategy))
+	if err != nil {
+		return err
+	}
+
+	return r.createOrUpdateCertManagerObject(components.CertManagerCertificateGVR, certificate)
+}
+
+func (r *Reconciler) createOrUpdateCertManagerObject(gvr schema.GroupVersionResource, obj *unstructured.Unstructured) error {
+	kind := obj.GetKind()
+	client := r.clientset.DynamicClient().Resource(gvr).Namespace(obj.GetNamespace())
+	existing, err := client.Get(context.Background(), obj.GetName(), metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		if _, err := client.Create(context.Background(), obj, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("unable to create cert-manager %s %s: %v", kind, obj.GetName(), err)
+		}
+		log.Log.V(2).Infof("cert-manager %s %s created", kind, obj.GetName())
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("unable to get cert-manager %s %s: %v", kind, obj.GetName(), err)
+	}
+
+	if equality.Semantic.DeepEqual(existing.Object["spec"], obj.Object["spec"]) &&
+		equality.Semantic.DeepEqual(existing.GetLabels(), obj.GetLabels()) {
+		log.Log.V(4).Infof("cert-manager %s %s is up-to-date", kind, obj.GetName())
+		return nil
+	}
+
+	existing.Object["spec"] = obj.Object["spec"]
+	existing.SetLabels(obj.GetLabels())
+	existing.SetAnnotations(obj.GetAnnotations())
+	if _, err := client.Update(context.Background(), existing, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("unable to update cert-manager %s %s: %v", kind, obj.GetName(), err)
+	}
+	log.Log.V(2).Infof("cert-manager %s %s updated", kind, obj.GetName())
+	return nil
+}
+
+// deleteCertManagerCertificate stops cert-manager from writing a secret taken back by the KubeVirt CA
+func (r *Reconciler) deleteCertManagerCertificate(secret *corev1.Secret) error {
+	obj, exists, err := r.stores.SecretCache.Get(secret)
+	if err != nil || !exists {
+		return err
+	}
+	certificateName, exists := obj.(*corev1.Secret).Annotations[components.CertManagerCertificateNameAnnotation]
+	if !exists {
+		return nil
+	}
+
+	err = r.clientset.DynamicClient().Resource(components.CertManagerCertificateGVR).Namespace(secret.Namespace).Delete(context.Background(), certificateName, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("unable to delete cert-manager certificate %s: %v", certificateName, err)
+	}
+	return nil
+}
+
+// getCertManagerCAs returns the valid certificates of the dedicated CA issuing the cert-manager certificates.
+// The CA of the configured issuer is deliberately not trusted, as it may sign certificates for anything else.
+func (r *Reconciler) getCertManagerCAs() []*tls.Certificate {
+	if !r.isCertManagerActive() {
+		return nil
+	}
+
+	obj, exists, err := r.stores.SecretCache.GetByKey(r.kv.Namespace + "/" + components.CertManagerCASecretName)
+	if err != nil || !exists {
+		return nil
+	}
+
+	certs, err := cert.ParseCertsPEM(obj.(*corev1.Secret).Data[corev1.TLSCertKey])
+	if err != nil {
+		log.Log.Reason(err).Warning("failed to parse the cert-manager CA certificate")
+		return nil
+	}
+	return getValidCerts(certs)
+}
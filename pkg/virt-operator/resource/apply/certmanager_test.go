@@ -0,0 +1,91 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package apply
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/certificates/triple"
+	"kubevirt.io/kubevirt/pkg/certificates/triple/cert"
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
+	"kubevirt.io/kubevirt/pkg/virt-operator/util"
+)
+
+var _ = Describe("cert-manager CAs", func() {
+	var r *Reconciler
+
+	newCASecret := func(name string) *corev1.Secret {
+		caKeyPair, err := triple.NewCA(name, time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: kubevirtNamespace,
+			},
+			Data: map[string][]byte{
+				corev1.TLSCertKey: cert.EncodeCertPEM(caKeyPair.Cert),
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		kv := &v1.KubeVirt{
+			ObjectMeta: metav1.ObjectMeta{Namespace: kubevirtNamespace},
+			Spec: v1.KubeVirtSpec{
+				CertificateRotationStrategy: v1.KubeVirtCertificateRotateStrategy{
+					CertManager: &v1.KubeVirtCertManagerConfiguration{},
+				},
+			},
+		}
+		stores := util.Stores{SecretCache: cache.NewStore(cache.MetaNamespaceKeyFunc)}
+		r = &Reconciler{
+			kv:     kv,
+			stores: stores,
+			config: util.OperatorConfig{CertManagerEnabled: true},
+		}
+	})
+
+	It("should trust the dedicated CA", func() {
+		Expect(r.stores.SecretCache.Add(newCASecret(components.CertManagerCASecretName))).To(Succeed())
+		Expect(r.getCertManagerCAs()).To(HaveLen(1))
+	})
+
+	It("should not trust the CA of the other secrets", func() {
+		secret := newCASecret(components.VirtHandlerCertSecretName)
+		secret.Data[corev1.ServiceAccountRootCAKey] = secret.Data[corev1.TLSCertKey]
+		Expect(r.stores.SecretCache.Add(secret)).To(Succeed())
+		Expect(r.getCertManagerCAs()).To(BeEmpty())
+	})
+
+	It("should not trust any CA when the cert-manager API is missing", func() {
+		Expect(r.stores.SecretCache.Add(newCASecret(components.CertManagerCASecretName))).To(Succeed())
+		r.config.CertManagerEnabled = false
+		Expect(r.getCertManagerCAs()).To(BeEmpty())
+	})
+})
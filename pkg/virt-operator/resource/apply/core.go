@@ -299,6 +299,12 @@ func createSecretPatch(secret *corev1.Secret) ([]byte, error) {
 
 func (r *Reconciler) createOrUpdateCertificateSecrets(queue workqueue.TypedRateLimitingInterface[string], caCert *tls.Certificate, duration *metav1.Duration, renewBefore *metav1.Duration, caRenewBefore *metav1.Duration) error {
 
+	if r.isCertManagerActive() {
+		if err := r.createOrUpdateCertManagerIssuer(); err != nil {
+			return err
+		}
+	}
+
 	for _, secret := range r.targetStrategy.CertificateSecrets() {
 
 		// The CA certificate needs to be handled separately and before other secrets, and ignore export CA
@@ -307,6 +313,17 @@ func (r *Reconciler) createOrUpdateCertificateSecrets(queue workqueue.TypedRateL
 			continue
 		}
 
+		if r.isCertManagerCertificateSecret(secret) {
+			if err := r.createOrUpdateCertManagerCertificate(secret); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := r.deleteCertManagerCertificate(secret); err != nil {
+			return err
+		}
+
 		_, err := r.createOrUpdateCertificateSecret(queue, caCert, secret, duration, renewBefore, caRenewBefore)
 		if err != nil {
 			return err
@@ -424,6 +441,7 @@ func (r *Reconciler) createOrUpdateComponentsWithCertificates(queue workqueue.Ty
 
 	log.Log.V(3).Info("reading external CA configmap")
 	externalCACerts := r.getRemotePublicCas()
+	externalCACerts = append(externalCACerts, r.getCertManagerCAs()...)
 	log.Log.V(3).Infof("found %d external CA certificates", len(externalCACerts))
 	// create/update CA config map
 	caBundle, err := r.createOrUpdateKubeVirtCAConfigMap(queue, caCert, externalCACerts, caRenewBefore, findRequiredCAConfigMap(components.KubeVirtCASecretName, r.targetStrategy.ConfigMaps()))
@@ -0,0 +1,193 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package components
+
+import (
+	"fmt"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	CertManagerGroup      = "cert-manager.io"
+	CertManagerIssuerKind = "Issuer"
+	// CertManagerCASecretName is the secret of the dedicated CA issuing the cert-manager certificates
+	CertManagerCASecretName = "kubevirt-cert-manager-ca"
+	// CertManagerIssuerName is the issuer of the KubeVirt install namespace signing with the dedicated CA
+	CertManagerIssuerName = "kubevirt-cert-manager-issuer"
+	// CertManagerCertificateNameAnnotation is set by cert-manager on the secrets it writes
+	CertManagerCertificateNameAnnotation = "cert-manager.io/certificate-name"
+)
+
+var CertManagerCertificateGVR = schema.GroupVersionResource{
+	Group:    CertManagerGroup,
+	Version:  "v1",
+	Resource: "certificates",
+}
+
+var CertManagerIssuerGVR = schema.GroupVersionResource{
+	Group:    CertManagerGroup,
+	Version:  "v1",
+	Resource: "issuers",
+}
+
+type certManagerCertificate struct {
+	// commonName defaults to the pod DNS name of the service
+	commonName string
+	// serviceName is set for server certificates, which are valid for the DNS names of the service
+	serviceName string
+}
+
+// certManagerCertificates are the certificates which can be issued by cert-manager, keyed by their secret name
+var certManagerCertificates = map[string]certManagerCertificate{
+	VirtApiCertSecretName:                    {serviceName: VirtApiServiceName},
+	VirtHandlerServerCertSecretName:          {commonName: "kubevirt.io:system:node:virt-handler", serviceName: VirtHandlerServiceName},
+	VirtHandlerCertSecretName:                {commonName: "kubevirt.io:system:client:virt-handler"},
+	VirtHandlerMigrationClientCertSecretName: {commonName: "kubevirt.io:system:client:migration"},
+	VirtHandlerVsockClientCertSecretName:     {commonName: "kubevirt.io:system:client:vsock"},
+}
+
+// IsCertManagerCertificateSecret tells whether the certificate of the secret is issued by cert-manager when it is configured
+func IsCertManagerCertificateSecret(secretName string) bool {
+	_, exists := certManagerCertificates[secretName]
+	return exists
+}
+
+// NewCertManagerCACertificate returns the cert-manager Certificate writing the dedicated CA, signed by the configured issuer.
+// Only this CA is trusted by the components, so that the other certificates of the configured issuer are not.
+func NewCertManagerCACertificate(namespace string, labels map[string]string, config *v1.KubeVirtCertManagerConfiguration, duration *metav1.Duration, renewBefore *metav1.Duration) *unstructured.Unstructured {
+	issuerKind := config.IssuerRef.Kind
+	if issuerKind == "" {
+		issuerKind = CertManagerIssuerKind
+	}
+	issuerGroup := config.IssuerRef.Group
+	if issuerGroup == "" {
+		issuerGroup = CertManagerGroup
+	}
+
+	spec := map[string]interface{}{
+		"isCA":       true,
+		"secretName": CertManagerCASecretName,
+		"commonName": "kubevirt.io:cert-manager-ca",
+		"duration":   duration.Duration.String(),
+		"issuerRef": map[string]interface{}{
+			"name":  config.IssuerRef.Name,
+			"kind":  issuerKind,
+			"group": issuerGroup,
+		},
+		"privateKey": map[string]interface{}{
+			"algorithm":      "ECDSA",
+			"rotationPolicy": "Always",
+		},
+		"renewBefore": renewBefore.Duration.String(),
+		"secretTemplate": map[string]interface{}{
+			"labels": stringMapToInterfaces(labels),
+		},
+	}
+
+	return newCertManagerObject("Certificate", CertManagerCASecretName, namespace, labels, spec)
+}
+
+// NewCertManagerIssuer returns the issuer signing the certificates of the components with the dedicated CA
+func NewCertManagerIssuer(namespace string, labels map[string]string) *unstructured.Unstructured {
+	spec := map[string]interface{}{
+		"ca": map[string]interface{}{
+			"secretName": CertManagerCASecretName,
+		},
+	}
+
+	return newCertManagerObject(CertManagerIssuerKind, CertManagerIssuerName, namespace, labels, spec)
+}
+
+// NewCertManagerCertificate returns the cert-manager Certificate writing the certificate of the secret
+func NewCertManagerCertificate(secret *k8sv1.Secret, duration *metav1.Duration, renewBefore *metav1.Duration) (*unstructured.Unstructured, error) {
+	template, exists := certManagerCertificates[secret.Name]
+	if !exists {
+		return nil, fmt.Errorf("no cert-manager certificate found for secret %s", secret.Name)
+	}
+
+	commonName := template.commonName
+	usage := "client auth"
+	var dnsNames []interface{}
+	if template.serviceName != "" {
+		if commonName == "" {
+			commonName = fmt.Sprintf(LocalPodDNStemplateString, template.serviceName, secret.Namespace)
+		}
+		usage = "server auth"
+		namespacedName := fmt.Sprintf("%s.%s", template.serviceName, secret.Namespace)
+		dnsNames = []interface{}{
+			template.serviceName,
+			namespacedName,
+			fmt.Sprintf("%s.svc", namespacedName),
+			fmt.Sprintf("%s.svc.%s", namespacedName, CaClusterLocal),
+		}
+	}
+
+	spec := map[string]interface{}{
+		"secretName": secret.Name,
+		"commonName": commonName,
+		"duration":   duration.Duration.String(),
+		"issuerRef": map[string]interface{}{
+			"name":  CertManagerIssuerName,
+			"kind":  CertManagerIssuerKind,
+			"group": CertManagerGroup,
+		},
+		"privateKey": map[string]interface{}{
+			"algorithm":      "ECDSA",
+			"rotationPolicy": "Always",
+		},
+		"renewBefore": renewBefore.Duration.String(),
+		"secretTemplate": map[string]interface{}{
+			"labels": stringMapToInterfaces(secret.Labels),
+		},
+		"usages": []interface{}{"digital signature", "key encipherment", usage},
+	}
+	if dnsNames != nil {
+		spec["dnsNames"] = dnsNames
+	}
+
+	certificate := newCertManagerObject("Certificate", secret.Name, secret.Namespace, secret.Labels, spec)
+	certificate.SetAnnotations(secret.Annotations)
+
+	return certificate, nil
+}
+
+func newCertManagerObject(kind, name, namespace string, labels map[string]string, spec map[string]interface{}) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{"spec": spec}}
+	obj.SetAPIVersion(CertManagerCertificateGVR.GroupVersion().String())
+	obj.SetKind(kind)
+	obj.SetName(name)
+	obj.SetNamespace(namespace)
+	obj.SetLabels(labels)
+	return obj
+}
+
+func stringMapToInterfaces(values map[string]string) map[string]interface{} {
+	result := map[string]interface{}{}
+	for key, value := range values {
+		result[key] = value
+	}
+	return result
+}
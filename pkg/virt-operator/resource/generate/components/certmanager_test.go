@@ -0,0 +1,169 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package components
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("cert-manager certificates", func() {
+	const namespace = "kubevirt"
+
+	var (
+		config      *v1.KubeVirtCertManagerConfiguration
+		duration    = &metav1.Duration{Duration: 24 * time.Hour}
+		renewBefore = &metav1.Duration{Duration: 4 * time.Hour}
+	)
+
+	BeforeEach(func() {
+		config = &v1.KubeVirtCertManagerConfiguration{
+			IssuerRef: v1.CertManagerIssuerReference{Name: "kubevirt-issuer"},
+		}
+	})
+
+	newSecret := func(name string) *k8sv1.Secret {
+		return &k8sv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Labels:    map[string]string{v1.AppLabel: ""},
+			},
+		}
+	}
+
+	DescribeTable("should only be issued for", func(secretName string, expected bool) {
+		Expect(IsCertManagerCertificateSecret(secretName)).To(Equal(expected))
+	},
+		Entry("virt-api", VirtApiCertSecretName, true),
+		Entry("virt-handler server", VirtHandlerServerCertSecretName, true),
+		Entry("virt-handler client", VirtHandlerCertSecretName, true),
+		Entry("virt-handler migration client", VirtHandlerMigrationClientCertSecretName, true),
+		Entry("virt-handler vsock client", VirtHandlerVsockClientCertSecretName, true),
+		Entry("not virt-controller", VirtControllerCertSecretName, false),
+		Entry("not the KubeVirt CA", KubeVirtCASecretName, false),
+	)
+
+	It("should fail for a secret not issued by cert-manager", func() {
+		_, err := NewCertManagerCertificate(newSecret(VirtControllerCertSecretName), duration, renewBefore)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should create a server certificate valid for the service", func() {
+		certificate, err := NewCertManagerCertificate(newSecret(VirtApiCertSecretName), duration, renewBefore)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(certificate.GetAPIVersion()).To(Equal("cert-manager.io/v1"))
+		Expect(certificate.GetKind()).To(Equal("Certificate"))
+		Expect(certificate.GetName()).To(Equal(VirtApiCertSecretName))
+		Expect(certificate.GetNamespace()).To(Equal(namespace))
+
+		spec := certificate.Object["spec"].(map[string]interface{})
+		Expect(spec).To(HaveKeyWithValue("secretName", VirtApiCertSecretName))
+		Expect(spec).To(HaveKeyWithValue("commonName", "virt-api.kubevirt.pod.cluster.local"))
+		Expect(spec).To(HaveKeyWithValue("duration", "24h0m0s"))
+		Expect(spec).To(HaveKeyWithValue("renewBefore", "4h0m0s"))
+		Expect(spec).To(HaveKeyWithValue("usages", ContainElement("server auth")))
+		Expect(spec).To(HaveKeyWithValue("dnsNames", ContainElements(
+			"virt-api", "virt-api.kubevirt", "virt-api.kubevirt.svc", "virt-api.kubevirt.svc.cluster.local",
+		)))
+		labels, exists, err := unstructured.NestedStringMap(certificate.Object, "spec", "secretTemplate", "labels")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeTrue())
+		Expect(labels).To(HaveKey(v1.AppLabel))
+	})
+
+	It("should create a client certificate without DNS names", func() {
+		certificate, err := NewCertManagerCertificate(newSecret(VirtHandlerMigrationClientCertSecretName), duration, renewBefore)
+		Expect(err).ToNot(HaveOccurred())
+
+		spec := certificate.Object["spec"].(map[string]interface{})
+		Expect(spec).To(HaveKeyWithValue("commonName", "kubevirt.io:system:client:migration"))
+		Expect(spec).To(HaveKeyWithValue("usages", ContainElement("client auth")))
+		Expect(spec).ToNot(HaveKey("dnsNames"))
+	})
+
+	It("should be issued by the dedicated issuer", func() {
+		certificate, err := NewCertManagerCertificate(newSecret(VirtHandlerServerCertSecretName), duration, renewBefore)
+		Expect(err).ToNot(HaveOccurred())
+
+		issuerRef, _, err := unstructured.NestedStringMap(certificate.Object, "spec", "issuerRef")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(issuerRef).To(Equal(map[string]string{
+			"name":  CertManagerIssuerName,
+			"kind":  "Issuer",
+			"group": "cert-manager.io",
+		}))
+	})
+
+	Context("dedicated CA", func() {
+		labels := map[string]string{v1.AppLabel: ""}
+
+		It("should default the issuer kind and group", func() {
+			certificate := NewCertManagerCACertificate(namespace, labels, config, duration, renewBefore)
+			Expect(certificate.GetKind()).To(Equal("Certificate"))
+			Expect(certificate.GetName()).To(Equal(CertManagerCASecretName))
+			Expect(certificate.GetNamespace()).To(Equal(namespace))
+
+			spec := certificate.Object["spec"].(map[string]interface{})
+			Expect(spec).To(HaveKeyWithValue("isCA", true))
+			Expect(spec).To(HaveKeyWithValue("secretName", CertManagerCASecretName))
+			issuerRef, _, err := unstructured.NestedStringMap(certificate.Object, "spec", "issuerRef")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(issuerRef).To(Equal(map[string]string{
+				"name":  "kubevirt-issuer",
+				"kind":  "Issuer",
+				"group": "cert-manager.io",
+			}))
+			secretLabels, exists, err := unstructured.NestedStringMap(certificate.Object, "spec", "secretTemplate", "labels")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeTrue())
+			Expect(secretLabels).To(HaveKey(v1.AppLabel))
+		})
+
+		It("should reference the configured issuer", func() {
+			config.IssuerRef.Kind = "ClusterIssuer"
+			config.IssuerRef.Group = "example.io"
+			certificate := NewCertManagerCACertificate(namespace, labels, config, duration, renewBefore)
+
+			issuerRef, _, err := unstructured.NestedStringMap(certificate.Object, "spec", "issuerRef")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(issuerRef).To(HaveKeyWithValue("kind", "ClusterIssuer"))
+			Expect(issuerRef).To(HaveKeyWithValue("group", "example.io"))
+		})
+
+		It("should be the CA of the dedicated issuer", func() {
+			issuer := NewCertManagerIssuer(namespace, labels)
+			Expect(issuer.GetKind()).To(Equal("Issuer"))
+			Expect(issuer.GetName()).To(Equal(CertManagerIssuerName))
+			Expect(issuer.GetNamespace()).To(Equal(namespace))
+
+			secretName, _, err := unstructured.NestedString(issuer.Object, "spec", "ca", "secretName")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secretName).To(Equal(CertManagerCASecretName))
+		})
+	})
+})
@@ -0,0 +1,81 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package components
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	ConsoleRecordingVolumeName = "console-recordings"
+	// ConsoleRecordingMountPath is where the PersistentVolumeClaim sink is mounted into virt-api
+	ConsoleRecordingMountPath = "/var/run/kubevirt/console-recordings"
+
+	// ConsoleRecordingS3AccessKeyIDEnv and ConsoleRecordingS3SecretAccessKeyEnv hold the S3 sink credentials in virt-api
+	ConsoleRecordingS3AccessKeyIDEnv     = "CONSOLE_RECORDING_S3_ACCESS_KEY_ID"
+	ConsoleRecordingS3SecretAccessKeyEnv = "CONSOLE_RECORDING_S3_SECRET_ACCESS_KEY"
+
+	ConsoleRecordingS3AccessKeyIDKey     = "accessKeyId"
+	ConsoleRecordingS3SecretAccessKeyKey = "secretAccessKey"
+)
+
+// InjectConsoleRecordingSink exposes the console recording sink configured in the KubeVirt CR to the virt-api pod
+func InjectConsoleRecordingSink(config *v1.ConsoleRecordingConfiguration, spec *corev1.PodSpec) {
+	if config == nil {
+		return
+	}
+	container := &spec.Containers[0]
+
+	if pvc := config.Sink.PersistentVolumeClaim; pvc != nil {
+		spec.Volumes = append(spec.Volumes, corev1.Volume{
+			Name: ConsoleRecordingVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: pvc.ClaimName,
+				},
+			},
+		})
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      ConsoleRecordingVolumeName,
+			MountPath: ConsoleRecordingMountPath,
+		})
+	}
+
+	if s3 := config.Sink.S3; s3 != nil {
+		container.Env = append(container.Env,
+			newSecretKeyEnvVar(ConsoleRecordingS3AccessKeyIDEnv, s3.CredentialsSecretName, ConsoleRecordingS3AccessKeyIDKey),
+			newSecretKeyEnvVar(ConsoleRecordingS3SecretAccessKeyEnv, s3.CredentialsSecretName, ConsoleRecordingS3SecretAccessKeyKey),
+		)
+	}
+}
+
+func newSecretKeyEnvVar(name, secretName, key string) corev1.EnvVar {
+	return corev1.EnvVar{
+		Name: name,
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+				Key:                  key,
+			},
+		},
+	}
+}
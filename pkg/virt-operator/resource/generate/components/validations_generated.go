@@ -660,6 +660,53 @@ var CRDsValidation map[string]string = map[string]string{
       properties:
         certificateRotateStrategy:
           properties:
+            certManager:
+              description: |-
+                CertManager delegates the virt-api and virt-handler certificates to cert-manager.
+                They are issued by a dedicated CA in the KubeVirt install namespace, which is the only cert-manager CA trusted by the components.
+                The remaining certificates keep being signed by the KubeVirt CA.
+              properties:
+                certificate:
+                  description: |-
+                    Certificate configuration
+                    Defaults to the configuration of the self signed server certificates
+                  properties:
+                    duration:
+                      description: The requested 'duration' (i.e. lifetime) of the
+                        Certificate.
+                      type: string
+                    renewBefore:
+                      description: |-
+                        The amount of time before the currently issued certificate's "notAfter"
+                        time that we will begin to attempt to renew the certificate.
+                      type: string
+                  type: object
+                issuerRef:
+                  description: IssuerRef references the cert-manager issuer signing
+                    the dedicated KubeVirt CA which issues the certificates
+                  properties:
+                    group:
+                      description: |-
+                        Group of the issuer
+                        Defaults to cert-manager.io
+                      type: string
+                    kind:
+                      description: |-
+                        Kind of the issuer, an Issuer has to be in the KubeVirt install namespace
+                        Defaults to Issuer
+                      enum:
+                      - Issuer
+                      - ClusterIssuer
+                      type: string
+                    name:
+                      description: Name of the issuer
+                      type: string
+                  required:
+                  - name
+                  type: object
+              required:
+              - issuerRef
+              type: object
             selfSigned:
               properties:
                 ca:
@@ -990,6 +1037,218 @@ var CRDsValidation map[string]string = map[string]string{
                       type: object
                   type: object
               type: object
+            consoleRecording:
+              description: ConsoleRecording configures the recording of the serial
+                console and VNC sessions opened through virt-api.
+              properties:
+                policies:
+                  description: |-
+                    Policies select the namespaces whose console sessions are recorded.
+                    Sessions in namespaces not selected by any policy are not recorded.
+                    When several policies select a namespace, the first one applies.
+                  items:
+                    description: ConsoleRecordingPolicy selects the console sessions
+                      recorded in a set of namespaces.
+                    properties:
+                      namespaceSelector:
+                        description: NamespaceSelector selects the namespaces the
+                          policy applies to. An empty selector selects all namespaces.
+                        properties:
+                          matchExpressions:
+                            description: matchExpressions is a list of label selector
+                              requirements. The requirements are ANDed.
+                            items:
+                              description: |-
+                                A label selector requirement is a selector that contains values, a key, and an operator that
+                                relates the key and values.
+                              properties:
+                                key:
+                                  description: key is the label key that the selector
+                                    applies to.
+                                  type: string
+                                operator:
+                                  description: |-
+                                    operator represents a key's relationship to a set of values.
+                                    Valid operators are In, NotIn, Exists and DoesNotExist.
+                                  type: string
+                                values:
+                                  description: |-
+                                    values is an array of string values. If the operator is In or NotIn,
+                                    the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                    the values array must be empty. This array is replaced during a strategic
+                                    merge patch.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              required:
+                              - key
+                              - operator
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          matchLabels:
+                            additionalProperties:
+                              type: string
+                            description: |-
+                              matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                              map is equivalent to an element of matchExpressions, whose key field is "key", the
+                              operator is "In", and the values array contains only "value". The requirements are ANDed.
+                            type: object
+                        type: object
+                        x-kubernetes-map-type: atomic
+                      required:
+                        description: |-
+                          Required refuses console sessions, and closes the running ones, when their transcript
+                          cannot be delivered to the sink. Otherwise, delivery failures are only logged.
+                        type: boolean
+                      sessions:
+                        description: Sessions are the kinds of sessions which are
+                          recorded. Defaults to Serial and VNC
+                        items:
+                          description: ConsoleSessionType is a kind of console session
+                            which can be recorded.
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: set
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+                sink:
+                  description: Sink receives the session transcripts. Exactly one
+                    sink must be set.
+                  properties:
+                    persistentVolumeClaim:
+                      description: PersistentVolumeClaim writes the transcripts as
+                        files on a volume mounted into virt-api.
+                      properties:
+                        claimName:
+                          description: |-
+                            ClaimName is the name of a PersistentVolumeClaim in the KubeVirt install namespace.
+                            It needs the ReadWriteMany access mode when virt-api runs more than one replica.
+                          type: string
+                      required:
+                      - claimName
+                      type: object
+                    s3:
+                      description: S3 uploads the transcripts as objects to an S3
+                        compatible bucket.
+                      properties:
+                        bucket:
+                          description: Bucket is the name of the bucket the transcripts
+                            are uploaded to
+                          type: string
+                        credentialsSecretName:
+                          description: |-
+                            CredentialsSecretName is the name of a secret in the KubeVirt install namespace
+                            holding the accessKeyId and secretAccessKey keys.
+                          type: string
+                        endpoint:
+                          description: Endpoint is the URL of the S3 service, e.g.
+                            https://s3.us-east-1.amazonaws.com
+                          type: string
+                        prefix:
+                          description: Prefix is prepended to the key of the uploaded
+                            objects
+                          type: string
+                        region:
+                          description: Region is the region of the bucket, used to
+                            sign the requests
+                          type: string
+                      required:
+                      - bucket
+                      - credentialsSecretName
+                      - endpoint
+                      - region
+                      type: object
+                    webhook:
+                      description: Webhook posts the transcripts to an HTTP endpoint.
+                      properties:
+                        caBundle:
+                          description: |-
+                            CABundle is the PEM encoded bundle used to verify the endpoint certificate.
+                            The system trust store is used when it is not set.
+                          format: byte
+                          type: string
+                        url:
+                          description: URL is the endpoint every transcript chunk
+                            is posted to
+                          type: string
+                      required:
+                      - url
+                      type: object
+                  type: object
+              required:
+              - policies
+              - sink
+              type: object
+            containerDiskPrePull:
+              description: |-
+                ContainerDiskPrePull configures the container disk images pulled ahead of time, and kept cached, on the nodes.
+                It is only taken into account when the ContainerDiskPrePull feature gate is enabled.
+              properties:
+                images:
+                  description: Images lists container disk images to pull on the nodes.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: set
+                nodeSelector:
+                  additionalProperties:
+                    type: string
+                  description: NodeSelector restricts the nodes the images are pulled
+                    on. Defaults to the nodes schedulable for VMIs.
+                  type: object
+                virtualMachineSelector:
+                  description: |-
+                    VirtualMachineSelector adds the container disk images referenced by the templates of the
+                    VirtualMachines matching the selector. An empty selector matches all the VirtualMachines.
+                    Images requiring an image pull secret are skipped.
+                  properties:
+                    matchExpressions:
+                      description: matchExpressions is a list of label selector requirements.
+                        The requirements are ANDed.
+                      items:
+                        description: |-
+                          A label selector requirement is a selector that contains values, a key, and an operator that
+                          relates the key and values.
+                        properties:
+                          key:
+                            description: key is the label key that the selector applies
+                              to.
+                            type: string
+                          operator:
+                            description: |-
+                              operator represents a key's relationship to a set of values.
+                              Valid operators are In, NotIn, Exists and DoesNotExist.
+                            type: string
+                          values:
+                            description: |-
+                              values is an array of string values. If the operator is In or NotIn,
+                              the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                              the values array must be empty. This array is replaced during a strategic
+                              merge patch.
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                        required:
+                        - key
+                        - operator
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    matchLabels:
+                      additionalProperties:
+                        type: string
+                      description: |-
+                        matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                        map is equivalent to an element of matchExpressions, whose key field is "key", the
+                        operator is "In", and the values array contains only "value". The requirements are ANDed.
+                      type: object
+                  type: object
+                  x-kubernetes-map-type: atomic
+              type: object
             controllerConfiguration:
               description: |-
                 ReloadableComponentConfiguration holds all generic k8s configuration options which can
@@ -1306,6 +1565,35 @@ var CRDsValidation map[string]string = map[string]string{
                   format: int32
                   type: integer
               type: object
+            loadAwareRebalancing:
+              description: |-
+                LoadAwareRebalancing configures how VMIs are live migrated off overloaded nodes.
+                It is only taken into account when the LoadAwareRebalancing feature gate is enabled.
+              properties:
+                cooldown:
+                  description: |-
+                    Cooldown is the minimum time between two rebalancing migrations off the same node,
+                    and between two rebalancing migrations of the same VMI. Defaults to 10 minutes
+                  type: string
+                cpuStealThreshold:
+                  description: |-
+                    CPUStealThreshold is the share of time, in percent, the vCPUs running on a node wait
+                    for a physical CPU, above which VMIs are migrated off the node. Defaults to 10
+                  format: int32
+                  type: integer
+                cpuUtilizationThreshold:
+                  description: |-
+                    CPUUtilizationThreshold is the node CPU utilization, in percent, above which
+                    VMIs are migrated off the node. Defaults to 85
+                  format: int32
+                  type: integer
+                maxParallelMigrations:
+                  description: |-
+                    MaxParallelMigrations is the number of rebalancing migrations allowed to run
+                    cluster-wide at the same time. Defaults to 2
+                  format: int32
+                  type: integer
+              type: object
             machineType:
               description: Deprecated. Use architectureConfiguration instead.
               type: string
@@ -1471,6 +1759,8 @@ var CRDsValidation map[string]string = map[string]string{
                       computeResourceOverhead:
                         description: |-
                           ComputeResourceOverhead specifies the resource overhead that should be added to the compute container when using the binding.
+                          Its memory and CPU requests are added once per VMI, whatever the number of interfaces using the binding.
+                          The CPU is added to the CPU limits as well when they are set, but not to dedicated CPUs.
                           version: v1alphav1
                         properties:
                           limits:
@@ -1511,6 +1801,31 @@ var CRDsValidation map[string]string = map[string]string{
                           Supported values: "device-info"
                           version: v1alphav1
                         type: string
+                      dryRunValidation:
+                        description: |-
+                          DryRunValidation configures an endpoint, served by the binding plugin, which validates the spec
+                          of the VMIs using the plugin on their creation, catching misconfigurations before they are launched.
+                          version: v1alphav1
+                        properties:
+                          caBundle:
+                            description: |-
+                              CABundle is the PEM encoded bundle used to verify the endpoint certificate.
+                              The system trust store is used when it is not set.
+                            format: byte
+                            type: string
+                          failurePolicy:
+                            description: |-
+                              FailurePolicy defines how an unreachable endpoint or an unexpected reply is handled.
+                              Fail rejects the VMI and Ignore admits it. Defaults to Fail.
+                            type: string
+                          webhookURL:
+                            description: |-
+                              WebhookURL is the HTTP endpoint the VMI spec is posted to.
+                              The endpoint replies whether the spec is allowed by the plugin and, when it is not, the reason.
+                            type: string
+                        required:
+                        - webhookURL
+                        type: object
                       migration:
                         description: |-
                           Migration means the VM using the plugin can be safely migrated
@@ -1529,16 +1844,142 @@ var CRDsValidation map[string]string = map[string]string{
                           If namespace is not specified, VMI namespace is assumed.
                           version: 1alphav1
                         type: string
+                      prerequisites:
+                        description: |-
+                          Prerequisites declares the cluster-wide requirements of the binding plugin.
+                          Their fulfillment is reported in the KubeVirt CR status.
+                          version: v1alphav1
+                        properties:
+                          deviceResources:
+                            description: |-
+                              DeviceResources lists the extended resources, exposed by device plugins,
+                              which are required to be advertised by at least one schedulable node.
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          featureGates:
+                            description: FeatureGates lists the feature gates which
+                              are required to be enabled.
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                        type: object
+                      resourceName:
+                        description: |-
+                          ResourceName references a device plugin resource the virt-launcher pod requests for each
+                          interface which uses the binding on the pod network.
+                          It allows the binding to handle a primary network whose default CNI attaches a device allocated
+                          by a device plugin (e.g. vDPA) and reports it in the device-info.
+                          Secondary networks request their resource through their NetworkAttachmentDefinition.
+                          version: v1alphav1
+                        type: string
                       sidecarImage:
                         description: |-
                           SidecarImage references a container image that runs in the virt-launcher pod.
                           The sidecar handles (libvirt) domain configuration and optional services.
                           version: 1alphav1
                         type: string
+                      sidecarResources:
+                        description: |-
+                          SidecarResources specifies the CPU and memory requests and limits of the plugin sidecar container.
+                          They take precedence over the resources set for all the hook sidecars through SupportContainerResources.
+                          version: v1alphav1
+                        properties:
+                          limits:
+                            additionalProperties:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                            description: |-
+                              Limits describes the maximum amount of compute resources allowed.
+                              More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                            type: object
+                          requests:
+                            additionalProperties:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                            description: |-
+                              Requests describes the minimum amount of compute resources required.
+                              If Requests is omitted for a container, it defaults to Limits if that is explicitly specified,
+                              otherwise to an implementation-defined value. Requests cannot exceed Limits.
+                              More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                            type: object
+                        type: object
                     type: object
                   type: object
                 defaultNetworkInterface:
                   type: string
+                interfaceEvents:
+                  description: |-
+                    InterfaceEvents configures the delivery of CloudEvents about the VMI interfaces
+                    being configured, hotplugged or removed, to let external SDN controllers react to them.
+                  properties:
+                    webhookURL:
+                      description: |-
+                        WebhookURL is the HTTP endpoint the events are posted to, in the CloudEvents structured JSON format.
+                        Delivery is best effort, failed deliveries are retried a few times before the event is dropped.
+                        A retried event may be received more than once, consumers can rely on the event id to detect duplicates.
+                      type: string
+                  required:
+                  - webhookURL
+                  type: object
+                macGeneration:
+                  description: |-
+                    MacGeneration enables the allocation of a MAC address for each VMI interface which does not specify one.
+                    By default, the MAC address is derived from the owner VM UID and the network name,
+                    and is kept across restarts of the VM.
+                  properties:
+                    allocator:
+                      description: |-
+                        Allocator selects how the MAC addresses are allocated.
+                        Deterministic derives them from the owner VM UID and the network name, Random picks them at random
+                        and External requests them from the webhook configured in external.
+                        Defaults to Deterministic.
+                      type: string
+                    external:
+                      description: |-
+                        External configures the webhook allocating the MAC addresses, required by the External allocator.
+                        The webhook is called by virt-controller when it creates the VMI of a VM, standalone VMIs are not covered.
+                      properties:
+                        webhookURL:
+                          description: WebhookURL is the HTTP endpoint the allocation
+                            requests are posted to.
+                          type: string
+                      required:
+                      - webhookURL
+                      type: object
+                    oui:
+                      description: |-
+                        OUI is the prefix used as the first three octets of the generated MAC addresses,
+                        formatted as "xx:xx:xx". It must represent a unicast address.
+                        Defaults to "02:6b:76", a locally administered prefix.
+                      type: string
+                  type: object
+                networkInterfaceMultiqueuePolicy:
+                  description: |-
+                    NetworkInterfaceMultiQueuePolicy is the default queue pairs policy of the VMIs which enable
+                    networkInterfaceMultiqueue without specifying their own policy.
+                  properties:
+                    maxQueues:
+                      description: |-
+                        MaxQueues caps the number of queue pairs of each interface.
+                        Defaults to, and cannot exceed, 256.
+                      format: int32
+                      type: integer
+                    vcpusPerQueue:
+                      description: |-
+                        VCPUsPerQueue is the number of guest vCPUs sharing a single queue pair.
+                        For example, 2 gives one queue pair for every two vCPUs. Defaults to 1.
+                      format: int32
+                      type: integer
+                  type: object
                 permitBridgeInterfaceOnPodNetwork:
                   type: boolean
                 permitSlirpInterface:
@@ -1546,6 +1987,54 @@ var CRDsValidation map[string]string = map[string]string{
                     DeprecatedPermitSlirpInterface is an alias for the deprecated PermitSlirpInterface.
                     Deprecated: Removed in v1.3.
                   type: boolean
+                promiscuousInterfacesNamespaceLabelSelector:
+                  description: |-
+                    PromiscuousInterfacesNamespaceLabelSelector selects the namespaces whose VMIs are allowed
+                    to declare promiscuous interfaces. When not set, promiscuous interfaces are not allowed.
+                  properties:
+                    matchExpressions:
+                      description: matchExpressions is a list of label selector requirements.
+                        The requirements are ANDed.
+                      items:
+                        description: |-
+                          A label selector requirement is a selector that contains values, a key, and an operator that
+                          relates the key and values.
+                        properties:
+                          key:
+                            description: key is the label key that the selector applies
+                              to.
+                            type: string
+                          operator:
+                            description: |-
+                              operator represents a key's relationship to a set of values.
+                              Valid operators are In, NotIn, Exists and DoesNotExist.
+                            type: string
+                          values:
+                            description: |-
+                              values is an array of string values. If the operator is In or NotIn,
+                              the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                              the values array must be empty. This array is replaced during a strategic
+                              merge patch.
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                        required:
+                        - key
+                        - operator
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    matchLabels:
+                      additionalProperties:
+                        type: string
+                      description: |-
+                        matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                        map is equivalent to an element of matchExpressions, whose key field is "key", the
+                        operator is "In", and the values array contains only "value". The requirements are ANDed.
+                      type: object
+                  type: object
+                  x-kubernetes-map-type: atomic
               type: object
             obsoleteCPUModels:
               additionalProperties:
@@ -1585,6 +2074,12 @@ var CRDsValidation map[string]string = map[string]string{
                           If true, KubeVirt will leave the allocation and monitoring to an
                           external device plugin
                         type: boolean
+                      manageDriverBinding:
+                        description: |-
+                          If true, virt-handler binds the devices of this resource to vfio-pci when they are
+                          allocated to a VMI, and returns them to their host driver once they are released.
+                          Devices found bound to vfio-pci while unused are considered released as well.
+                        type: boolean
                       pciVendorSelector:
                         description: The vendor_id:product_id tuple of the PCI device
                         type: string
@@ -1678,6 +2173,47 @@ var CRDsValidation map[string]string = map[string]string{
                 version:
                   type: string
               type: object
+            subresourceRateLimits:
+              description: SubresourceRateLimits configures how virt-api throttles
+                calls to VM and VMI subresources.
+              properties:
+                perNamespace:
+                  description: PerNamespace limits the subresource calls targeting
+                    every single namespace.
+                  properties:
+                    burst:
+                      description: |-
+                        Maximum burst for throttle.
+                        If it's zero, the component default will be used
+                      type: integer
+                    qps:
+                      description: |-
+                        QPS indicates the maximum QPS to the apiserver from this client.
+                        If it's zero, the component default will be used
+                      type: number
+                  required:
+                  - burst
+                  - qps
+                  type: object
+                perUser:
+                  description: PerUser limits the subresource calls issued by every
+                    single user.
+                  properties:
+                    burst:
+                      description: |-
+                        Maximum burst for throttle.
+                        If it's zero, the component default will be used
+                      type: integer
+                    qps:
+                      description: |-
+                        QPS indicates the maximum QPS to the apiserver from this client.
+                        If it's zero, the component default will be used
+                      type: number
+                  required:
+                  - burst
+                  - qps
+                  type: object
+              type: object
             supportContainerResources:
               description: SupportContainerResources specifies the resource requirements
                 for various types of supporting containers such as container disks/virtiofs/sidecars
@@ -1758,6 +2294,48 @@ var CRDsValidation map[string]string = map[string]string{
                   - VersionTLS13
                   type: string
               type: object
+            vcpuAutoscaling:
+              description: |-
+                VCPUAutoscaling configures when more CPU sockets are recommended for the VirtualMachines
+                opting in to CPU autoscaling.
+                It is only taken into account when the VCPUAutoscaling feature gate is enabled.
+              properties:
+                cooldown:
+                  description: Cooldown is the minimum time between two scale ups
+                    of the same VirtualMachine. Defaults to 30 minutes
+                  type: string
+                cpuStealThreshold:
+                  description: |-
+                    CPUStealThreshold is the share of time, in percent, the vCPUs wait for a physical CPU,
+                    above which one more socket is recommended. Defaults to 20
+                  format: int32
+                  type: integer
+                cpuUtilizationThreshold:
+                  description: |-
+                    CPUUtilizationThreshold is the vCPU utilization, in percent, above which
+                    one more socket is recommended. Defaults to 90
+                  format: int32
+                  type: integer
+                window:
+                  description: |-
+                    Window is how long the vCPU load must stay above one of the thresholds before one more
+                    socket is recommended. Defaults to 10 minutes
+                  type: string
+              type: object
+            virtControllerSharding:
+              description: |-
+                VirtControllerSharding splits the reconciliation of the namespaced workloads between several active
+                virt-controller instances.
+                It is only taken into account when the VirtControllerSharding feature gate is enabled.
+              properties:
+                shards:
+                  description: |-
+                    Shards is the number of namespace shards. It should not exceed the number of virt-controller replicas,
+                    since every instance holds at most one shard; the spare instances take over the shards of failed ones.
+                    Defaults to 1
+                  format: int32
+                  type: integer
+              type: object
             virtTemplateDeployment:
               description: VirtTemplateDeployment controls the deployment of virt-template
                 components
@@ -1802,6 +2380,29 @@ var CRDsValidation map[string]string = map[string]string{
               description: VMStateStorageClass is the name of the storage class to
                 use for the PVCs created to preserve VM state, like TPM.
               type: string
+            vmiStatusUpdates:
+              description: VMIStatusUpdates configures how virt-handler aggregates
+                the frequent updates of the VMI status.
+              properties:
+                guestAgentInfoInterval:
+                  description: |-
+                    GuestAgentInfoInterval is the minimal interval between two writes of the status carrying only
+                    changes of the guest OS information reported by the guest agent.
+                    Defaults to 0, which writes every change right away.
+                  type: string
+                interfacesInterval:
+                  description: |-
+                    InterfacesInterval is the minimal interval between two writes of the status carrying only
+                    changes of the interfaces, like the IP addresses reported by the guest agent.
+                    Defaults to 0, which writes every change right away.
+                  type: string
+                migrationProgressInterval:
+                  description: |-
+                    MigrationProgressInterval is the minimal interval between two writes of the status carrying only
+                    changes of the migration progress.
+                    Defaults to 0, which writes every change right away.
+                  type: string
+              type: object
             webhookConfiguration:
               description: |-
                 ReloadableComponentConfiguration holds all generic k8s configuration options which can
@@ -4033,6 +4634,39 @@ var CRDsValidation map[string]string = map[string]string{
             type: object
           type: array
           x-kubernetes-list-type: atomic
+        networkBindings:
+          description: |-
+            NetworkBindings reports the usage of the registered network binding plugins
+            and whether their prerequisites are satisfied.
+          items:
+            description: NetworkBindingPluginStatus reports the state of a network
+              binding plugin registered in the KubeVirt configuration.
+            properties:
+              name:
+                description: Name is the name under which the binding plugin is registered.
+                type: string
+              prerequisitesSatisfied:
+                description: PrerequisitesSatisfied reports whether all the declared
+                  prerequisites are satisfied cluster-wide.
+                type: boolean
+              unsatisfiedPrerequisites:
+                description: UnsatisfiedPrerequisites lists the declared prerequisites
+                  which are not satisfied.
+                items:
+                  type: string
+                type: array
+                x-kubernetes-list-type: atomic
+              virtualMachineInstances:
+                description: VirtualMachineInstances is the number of active VMIs
+                  using the binding plugin.
+                type: integer
+            required:
+            - name
+            - prerequisitesSatisfied
+            - virtualMachineInstances
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         observedDeploymentConfig:
           type: string
         observedDeploymentID:
@@ -4157,6 +4791,17 @@ var CRDsValidation map[string]string = map[string]string{
     spec:
       description: Spec contains the specification of VirtualMachineInstance created
       properties:
+        cpuAutoscaling:
+          description: |-
+            CPUAutoscaling opts the VirtualMachine in to the vertical scaling of its CPU sockets,
+            based on the vCPU utilization and steal time of its running VirtualMachineInstance.
+          properties:
+            policy:
+              description: |-
+                Policy tells whether the recommended number of sockets is only reported, or also
+                hotplugged into the running VirtualMachineInstance. Defaults to Recommend
+              type: string
+          type: object
         dataVolumeTemplates:
           description: |-
             dataVolumeTemplates is a list of dataVolumes that the VirtualMachineInstance template can reference.
@@ -6331,9 +6976,25 @@ var CRDsValidation map[string]string = map[string]string{
                                       Defaults to false.
                                     type: boolean
                                 type: object
+                              encryption:
+                                description: |-
+                                  Encryption opens the disk as a LUKS encrypted volume with the passphrase
+                                  stored in a Secret. The passphrase is handed to QEMU through libvirt secrets.
+                                properties:
+                                  secretName:
+                                    description: |-
+                                      SecretName is the name of a Secret in the namespace of the VMI.
+                                      The passphrase is read from the "passphrase" key of the Secret.
+                                    type: string
+                                required:
+                                - secretName
+                                type: object
                               errorPolicy:
-                                description: If specified, it can change the default
-                                  error policy (stop) for the disk
+                                description: |-
+                                  If specified, it can change the default error policy (stop) for the disk.
+                                  With stop, which is also used if not specified, and with retry, a VMI paused on I/O errors
+                                  is resumed automatically with a backoff until the storage recovers.
+                                  With pause it stays paused until it is unpaused.
                                 type: string
                               io:
                                 description: |-
@@ -6404,6 +7065,12 @@ var CRDsValidation map[string]string = map[string]string{
                           description: Whether to attach a GPU device to the vmi.
                           items:
                             properties:
+                              attachIOMMUGroup:
+                                description: |-
+                                  AttachIOMMUGroup requests that all the devices sharing the IOMMU group of the
+                                  allocated PCI device are passed through to the guest along with it.
+                                  The VMI fails to start if any of them is in use by a host driver.
+                                type: boolean
                               claimName:
                                 description: |-
                                   ClaimName needs to be provided from the list vmi.spec.resourceClaims[].name where this
@@ -6458,6 +7125,12 @@ var CRDsValidation map[string]string = map[string]string{
                           description: Whether to attach a host device to the vmi.
                           items:
                             properties:
+                              attachIOMMUGroup:
+                                description: |-
+                                  AttachIOMMUGroup requests that all the devices sharing the IOMMU group of the
+                                  allocated PCI device are passed through to the guest along with it.
+                                  The VMI fails to start if any of them is in use by a host driver.
+                                type: boolean
                               claimName:
                                 description: |-
                                   ClaimName needs to be provided from the list vmi.spec.resourceClaims[].name where this
@@ -6583,6 +7256,58 @@ var CRDsValidation map[string]string = map[string]string{
                                       to interface's DHCP server
                                     type: string
                                 type: object
+                              firewall:
+                                description: |-
+                                  Firewall defines the rules filtering the incoming traffic of the interface.
+                                  Supported only with the masquerade binding.
+                                properties:
+                                  ingress:
+                                    description: Ingress lists the rules allowing
+                                      incoming connections.
+                                    items:
+                                      description: FirewallRule allows the incoming
+                                        connections matching all of its fields.
+                                      properties:
+                                        from:
+                                          description: |-
+                                            Source CIDRs matched by the rule, for example 10.10.0.0/16 or fd00::/64.
+                                            All sources are matched when not specified.
+                                          items:
+                                            type: string
+                                          type: array
+                                        ports:
+                                          description: |-
+                                            Destination ports matched by the rule, supported only with the TCP and UDP protocols.
+                                            All ports are matched when not specified.
+                                          items:
+                                            format: int32
+                                            type: integer
+                                          type: array
+                                        protocol:
+                                          description: |-
+                                            Protocol matched by the rule. One of TCP, UDP or ICMP.
+                                            All protocols are matched when not specified.
+                                          type: string
+                                      type: object
+                                    type: array
+                                type: object
+                              interrupts:
+                                description: |-
+                                  Interrupts configures how the virtio device of the interface interrupts the guest, e.g. to give the
+                                  queues of a high queue count vDPA NIC enough MSI-X vectors. It applies to the interfaces generated by
+                                  network binding plugins as well. Supported only with the virtio model.
+                                properties:
+                                  mode:
+                                    description: Mode is the interrupt mode of the device, either
+                                      MSIX or INTx. Defaults to MSIX.
+                                    type: string
+                                  vectors:
+                                    description: |-
+                                      Vectors is the number of MSI-X vectors of the device. Defaults to the hypervisor default, which is
+                                      two vectors per queue pair plus two. It may not be set with the INTx mode.
+                                    format: int32
+                                    type: integer
+                                type: object
                               macAddress:
                                 description: 'Interface MAC address. For example:
                                   de:ad:00:00:be:af or DE-AD-00-00-BE-AF.'
@@ -6597,6 +7322,26 @@ var CRDsValidation map[string]string = map[string]string{
                                 description: InterfaceMasquerade connects to a given
                                   network using netfilter rules to nat the traffic.
                                 type: object
+                              mirror:
+                                description: |-
+                                  Mirror copies the traffic of the interface to a monitoring network, where an IDS or
+                                  monitoring appliance (VM or pod) inspects it.
+                                  Supported only with the bridge and masquerade bindings.
+                                properties:
+                                  direction:
+                                    description: |-
+                                      Direction of the mirrored traffic: ingress for the traffic received by the guest,
+                                      egress for the traffic sent by the guest, or both. Defaults to both.
+                                    type: string
+                                  networkName:
+                                    description: |-
+                                      NetworkName is the name of the multus NetworkAttachmentDefinition the mirrored traffic is sent to,
+                                      in the form <namespace>/<name> or <name> for the VMI namespace.
+                                      The virt-launcher pod is attached to it through an additional interface.
+                                    type: string
+                                required:
+                                - networkName
+                                type: object
                               model:
                                 description: |-
                                   Interface model.
@@ -6608,6 +7353,47 @@ var CRDsValidation map[string]string = map[string]string{
                                   Logical name of the interface as well as a reference to the associated networks.
                                   Must match the Name of a Network.
                                 type: string
+                              networkEmulation:
+                                description: |-
+                                  NetworkEmulation degrades the traffic sent to the guest through the interface, adding delay,
+                                  jitter and packet loss for chaos testing of the guest workloads.
+                                  Supported only with the bridge and masquerade bindings.
+                                properties:
+                                  delay:
+                                    description: Delay added to every packet sent
+                                      to the guest.
+                                    type: string
+                                  jitter:
+                                    description: Jitter is the random variation of
+                                      the delay, requires a delay.
+                                    type: string
+                                  lossPercentage:
+                                    description: |-
+                                      LossPercentage is the percentage of the packets sent to the guest which are dropped, between 0 and 100.
+                                      For example: "0.5".
+                                    type: string
+                                type: object
+                              offloads:
+                                description: |-
+                                  Offloads toggles the offloads the host applies to the traffic of the interface.
+                                  Supported only with the virtio model. Offloads which are not specified keep the hypervisor defaults.
+                                properties:
+                                  csum:
+                                    description: Checksum toggles the checksum offload.
+                                    type: boolean
+                                  mrgRxbuf:
+                                    description: MergeableRxBuffers toggles the mergeable
+                                      receive buffers.
+                                    type: boolean
+                                  tso:
+                                    description: TSO toggles the TCP segmentation
+                                      offload, for both IPv4 and IPv6.
+                                    type: boolean
+                                  ufo:
+                                    description: UFO toggles the UDP fragmentation
+                                      offload.
+                                    type: boolean
+                                type: object
                               passt:
                                 description: |-
                                   DeprecatedPasst is an alias to the deprecated Passt interface,
@@ -6653,6 +7439,27 @@ var CRDsValidation map[string]string = map[string]string{
                                   - port
                                   type: object
                                 type: array
+                              portsEnforcement:
+                                description: |-
+                                  PortsEnforcement defines how the listed ports are exposed.
+                                  With Strict, only the listed ports are forwarded to the guest, any other incoming traffic
+                                  is dropped and the list of ports can be updated while the VM is running.
+                                  Supported only with the masquerade binding.
+                                type: string
+                              promiscuous:
+                                description: |-
+                                  Promiscuous declares that the guest uses the interface as a trunk or in promiscuous mode,
+                                  sending and receiving frames of MAC addresses other than its own, as nested hypervisors and VNFs do.
+                                  The ports of the backing bridge are set in promiscuous mode to let these frames through.
+                                  Supported only with the bridge binding, in namespaces selected by the
+                                  promiscuousInterfacesNamespaceLabelSelector of the KubeVirt network configuration.
+                                type: boolean
+                              routerAdvertisement:
+                                description: |-
+                                  RouterAdvertisement enables IPv6 router advertisements to the guest, announcing the pod
+                                  as its default router and letting the guest get its IPv6 address over DHCPv6.
+                                  Supported only with the masquerade binding.
+                                type: object
                               slirp:
                                 description: |-
                                   DeprecatedSlirp is an alias to the deprecated Slirp interface
@@ -6695,6 +7502,25 @@ var CRDsValidation map[string]string = map[string]string{
                             depends on additional factors of the VirtualMachineInstance,
                             like the number of guest CPUs.
                           type: boolean
+                        networkInterfaceMultiqueuePolicy:
+                          description: |-
+                            NetworkInterfaceMultiQueuePolicy controls how many queue pairs are given to the multi-queue
+                            network interfaces, relative to the number of guest vCPUs.
+                            When not set, the cluster wide policy applies, and each vCPU gets its own queue pair.
+                          properties:
+                            maxQueues:
+                              description: |-
+                                MaxQueues caps the number of queue pairs of each interface.
+                                Defaults to, and cannot exceed, 256.
+                              format: int32
+                              type: integer
+                            vcpusPerQueue:
+                              description: |-
+                                VCPUsPerQueue is the number of guest vCPUs sharing a single queue pair.
+                                For example, 2 gives one queue pair for every two vCPUs. Defaults to 1.
+                              format: int32
+                              type: integer
+                          type: object
                         panicDevices:
                           description: PanicDevices provides additional crash information
                             when a guest crashes.
@@ -6727,6 +7553,21 @@ var CRDsValidation map[string]string = map[string]string{
                           required:
                           - name
                           type: object
+                        spice:
+                          description: Whether to attach a SPICE graphics device,
+                            reachable through the spice subresource.
+                          properties:
+                            audio:
+                              description: |-
+                                Audio streams the output of the VMI sound device over the SPICE connection.
+                                An ich9 sound device is emulated if none is set.
+                              type: boolean
+                            usbRedirection:
+                              description: |-
+                                USBRedirection attaches SpiceUSBRedirectionMaxNumberOf USB redirection
+                                channels, multiplexed over the SPICE connection.
+                              type: boolean
+                          type: object
                         tpm:
                           description: Whether to emulate a TPM device.
                           properties:
@@ -6757,6 +7598,24 @@ var CRDsValidation map[string]string = map[string]string{
                                 If not specified, the default is architecture-dependent (VGA for BIOS-based VMs, Bochs for EFI-based VMs on AMD64; virtio for Arm and s390x).
                               type: string
                           type: object
+                        virtioChannels:
+                          description: |-
+                            VirtioChannels lists the named virtio-serial channels exposed to the guest, alongside the serial console.
+                            Each channel is reachable through its own websocket subresource, enabling out-of-band management agents.
+                          items:
+                            description: VirtioChannel represents a virtio-serial
+                              port, exposed in the guest as /dev/virtio-ports/io.kubevirt.<name>.
+                            properties:
+                              name:
+                                description: |-
+                                  Name of the channel, used to reach it through the channel subresource.
+                                  Must be a DNS-1123 label and unique within the VMI.
+                                type: string
+                            required:
+                            - name
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
                         watchdog:
                           description: Watchdog describes a watchdog device which
                             can be added to the vmi.
@@ -7145,6 +8004,28 @@ var CRDsValidation map[string]string = map[string]string{
                               description: Arguments to be passed to the kernel at
                                 boot time
                               type: string
+                            volume:
+                              description: |-
+                                Volume defines the PersistentVolumeClaim or DataVolume volume that contains kernel artifacts.
+                                Container and Volume are mutually exclusive.
+                              properties:
+                                initrdPath:
+                                  description: the fully-qualified path to the ramdisk
+                                    image in the volume
+                                  type: string
+                                kernelPath:
+                                  description: The fully-qualified path to the kernel
+                                    image in the volume
+                                  type: string
+                                name:
+                                  description: |-
+                                    Name of the volume that contains initrd / kernel files.
+                                    It must match a PersistentVolumeClaim or DataVolume volume of the VMI in filesystem mode,
+                                    which is not used by any disk or filesystem.
+                                  type: string
+                              required:
+                              - name
+                              type: object
                           type: object
                         serial:
                           description: The system-serial-number in SMBIOS
@@ -7201,6 +8082,26 @@ var CRDsValidation map[string]string = map[string]string{
                           type: object
                         snp:
                           description: AMD SEV-SNP flags defined by the SEV-SNP specifications.
+                          properties:
+                            attestation:
+                              description: |-
+                                If specified, run the attestation process for a vmi.
+                                The launch is held until the guest owner provides the ID block and its authentication information.
+                              type: object
+                            hostData:
+                              description: Base64 encoded 32 bytes provided by the
+                                guest owner, included as is in the attestation reports
+                                of the guest.
+                              type: string
+                            idAuth:
+                              description: Base64 encoded ID authentication information,
+                                holding the signature of the ID block.
+                              type: string
+                            idBlock:
+                              description: |-
+                                Base64 encoded ID block, holding the expected launch measurement of the guest as signed by the guest owner.
+                                The guest is not launched if its measurement does not match.
+                              type: string
                           type: object
                         tdx:
                           description: Intel Trust Domain Extensions (TDX).
@@ -7273,6 +8174,26 @@ var CRDsValidation map[string]string = map[string]string{
                               - Required
                               type: string
                           type: object
+                        swap:
+                          description: |-
+                            Swap allows the VMI to use the swap of the node, for memory-bursty but latency-tolerant workloads.
+                            The swap has to be enabled on the node.
+                          properties:
+                            limit:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              description: |-
+                                Limit is the maximum amount of swap used by the VMI.
+                                Defaults to no limit.
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                            policy:
+                              description: |-
+                                Policy defines how swapped out memory is stored, Swap or Zswap.
+                                Defaults to Swap.
+                              type: string
+                          type: object
                       type: object
                     rebootPolicy:
                       description: |-
@@ -7330,6 +8251,28 @@ var CRDsValidation map[string]string = map[string]string{
                     - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
                     - "External": the VirtualMachineInstance will be protected and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
                   type: string
+                guestDNS:
+                  description: |-
+                    GuestDNS overrides the DNS configuration the guest receives over DHCP, independently of the
+                    DNS policy and configuration of the virt-launcher pod.
+                    Parameters which are not specified are taken from the pod DNS configuration.
+                  properties:
+                    nameservers:
+                      description: |-
+                        Nameservers is a list of IP addresses of the DNS servers the guest should use.
+                        The DNS servers of an IP family which has none in the list are taken from the pod DNS configuration.
+                      items:
+                        type: string
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    searches:
+                      description: Searches is a list of DNS search domains the guest
+                        should use.
+                      items:
+                        type: string
+                      type: array
+                      x-kubernetes-list-type: atomic
+                  type: object
                 hostname:
                   description: |-
                     Specifies the hostname of the vmi
@@ -7366,6 +8309,32 @@ var CRDsValidation map[string]string = map[string]string{
                         Defaults to 3. Minimum value is 1.
                       format: int32
                       type: integer
+                    guestAgentExec:
+                      description: |-
+                        GuestAgentExec runs a command inside the guest through the qemu-guest-agent and
+                        interprets its exit code. No network access to the guest is required.
+                      properties:
+                        command:
+                          description: |-
+                            Command is the command line to execute inside the guest. The first element is the
+                            executable, the remaining elements are passed as arguments. The command is not run
+                            in a shell, so shell instructions like pipes are only available by calling a shell explicitly.
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        successExitCodes:
+                          description: |-
+                            SuccessExitCodes lists the exit codes of the command which are considered a successful probe.
+                            Defaults to 0.
+                          items:
+                            format: int32
+                            type: integer
+                          type: array
+                          x-kubernetes-list-type: set
+                      required:
+                      - command
+                      type: object
                     guestAgentPing:
                       description: GuestAgentPing contacts the qemu-guest-agent for
                         availability checks.
@@ -7470,6 +8439,20 @@ var CRDsValidation map[string]string = map[string]string{
                       format: int32
                       type: integer
                   type: object
+                metadataService:
+                  description: |-
+                    MetadataService exposes the instance identity, the network configuration and user provided data
+                    to the guest, over HTTP on the link-local 169.254.169.254 address of the masquerade pod network.
+                  properties:
+                    data:
+                      additionalProperties:
+                        type: string
+                      description: |-
+                        Data is user provided key/value data served to the guest.
+                        It can be extended or updated after boot through the VirtualMachineInstance annotations
+                        prefixed with metadata.kubevirt.io/, which take precedence.
+                      type: object
+                  type: object
                 networks:
                   description: List of networks that can be attached to a vm's virtual
                     interface.
@@ -7500,6 +8483,18 @@ var CRDsValidation map[string]string = map[string]string{
                           Must be a DNS_LABEL and unique within the vm.
                           More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
                         type: string
+                      native:
+                        description: |-
+                          Native represents a secondary network provided by the Kubernetes
+                          native multi-network API (PodNetwork).
+                        properties:
+                          podNetworkName:
+                            description: PodNetworkName references a cluster scoped
+                              PodNetwork object.
+                            type: string
+                        required:
+                        - podNetworkName
+                        type: object
                       pod:
                         description: Represents the stock pod network interface.
                         properties:
@@ -7564,6 +8559,32 @@ var CRDsValidation map[string]string = map[string]string{
                         Defaults to 3. Minimum value is 1.
                       format: int32
                       type: integer
+                    guestAgentExec:
+                      description: |-
+                        GuestAgentExec runs a command inside the guest through the qemu-guest-agent and
+                        interprets its exit code. No network access to the guest is required.
+                      properties:
+                        command:
+                          description: |-
+                            Command is the command line to execute inside the guest. The first element is the
+                            executable, the remaining elements are passed as arguments. The command is not run
+                            in a shell, so shell instructions like pipes are only available by calling a shell explicitly.
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        successExitCodes:
+                          description: |-
+                            SuccessExitCodes lists the exit codes of the command which are considered a successful probe.
+                            Defaults to 0.
+                          items:
+                            format: int32
+                            type: integer
+                          type: array
+                          x-kubernetes-list-type: set
+                      required:
+                      - command
+                      type: object
                     guestAgentPing:
                       description: GuestAgentPing contacts the qemu-guest-agent for
                         availability checks.
@@ -8598,6 +9619,34 @@ var CRDsValidation map[string]string = map[string]string{
             - type
             type: object
           type: array
+        cpuRecommendation:
+          description: |-
+            CPURecommendation is the CPU sockets recommendation for the running VirtualMachineInstance,
+            reported when the VirtualMachine opts in to CPU autoscaling.
+          nullable: true
+          properties:
+            lastScaleTime:
+              description: LastScaleTime is when CPU sockets were last hotplugged
+                following the recommendation
+              format: date-time
+              nullable: true
+              type: string
+            overloadedSince:
+              description: OverloadedSince is when the vCPU load last went above the
+                thresholds, unset while it is below
+              format: date-time
+              nullable: true
+              type: string
+            reason:
+              description: Reason explains why more sockets are recommended
+              type: string
+            sockets:
+              description: Sockets is the recommended number of CPU sockets
+              format: int32
+              type: integer
+          required:
+          - sockets
+          type: object
         created:
           description: Created indicates if the virtual machine is created in the
             cluster
@@ -8611,6 +9660,58 @@ var CRDsValidation map[string]string = map[string]string{
             updated through an Update() before ObservedGeneration in Status.
           format: int64
           type: integer
+        guestDrivers:
+          description: |-
+            GuestDrivers are the last drivers reported by the guest agent of the VirtualMachineInstance.
+            They are kept while the VirtualMachine is stopped.
+          items:
+            description: VirtualMachineInstanceGuestOSDriver describes a driver the
+              guest uses for one of its devices
+            properties:
+              deviceID:
+                description: PCI vendor and device ID of the device handled by the
+                  driver, in the vendor:device format
+                type: string
+              name:
+                description: Name of the driver
+                type: string
+              version:
+                description: Version of the driver
+                type: string
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        guestOSInfo:
+          description: |-
+            GuestOSInfo is the last guest OS information reported by the guest agent of the VirtualMachineInstance.
+            It is kept while the VirtualMachine is stopped.
+          nullable: true
+          properties:
+            id:
+              description: Guest OS Id
+              type: string
+            kernelRelease:
+              description: Guest OS Kernel Release
+              type: string
+            kernelVersion:
+              description: Kernel version of the Guest OS
+              type: string
+            machine:
+              description: Machine type of the Guest OS
+              type: string
+            name:
+              description: Name of the Guest OS
+              type: string
+            prettyName:
+              description: Guest OS Pretty Name
+              type: string
+            version:
+              description: Guest OS Version
+              type: string
+            versionId:
+              description: Version ID of the Guest OS
+              type: string
+          type: object
         instancetypeRef:
           description: InstancetypeRef captures the state of any referenced instance
             type from the VirtualMachine
@@ -8878,9 +9979,25 @@ var CRDsValidation map[string]string = map[string]string{
                               Defaults to false.
                             type: boolean
                         type: object
+                      encryption:
+                        description: |-
+                          Encryption opens the disk as a LUKS encrypted volume with the passphrase
+                          stored in a Secret. The passphrase is handed to QEMU through libvirt secrets.
+                        properties:
+                          secretName:
+                            description: |-
+                              SecretName is the name of a Secret in the namespace of the VMI.
+                              The passphrase is read from the "passphrase" key of the Secret.
+                            type: string
+                        required:
+                        - secretName
+                        type: object
                       errorPolicy:
-                        description: If specified, it can change the default error
-                          policy (stop) for the disk
+                        description: |-
+                          If specified, it can change the default error policy (stop) for the disk.
+                          With stop, which is also used if not specified, and with retry, a VMI paused on I/O errors
+                          is resumed automatically with a backoff until the storage recovers.
+                          With pause it stays paused until it is unpaused.
                         type: string
                       io:
                         description: |-
@@ -9735,6 +10852,12 @@ var CRDsValidation map[string]string = map[string]string{
           description: Optionally defines any GPU devices associated with the instancetype.
           items:
             properties:
+              attachIOMMUGroup:
+                description: |-
+                  AttachIOMMUGroup requests that all the devices sharing the IOMMU group of the
+                  allocated PCI device are passed through to the guest along with it.
+                  The VMI fails to start if any of them is in use by a host driver.
+                type: boolean
               claimName:
                 description: |-
                   ClaimName needs to be provided from the list vmi.spec.resourceClaims[].name where this
@@ -9786,6 +10909,12 @@ var CRDsValidation map[string]string = map[string]string{
           description: Optionally defines any HostDevices associated with the instancetype.
           items:
             properties:
+              attachIOMMUGroup:
+                description: |-
+                  AttachIOMMUGroup requests that all the devices sharing the IOMMU group of the
+                  allocated PCI device are passed through to the guest along with it.
+                  The VMI fails to start if any of them is in use by a host driver.
+                type: boolean
               claimName:
                 description: |-
                   ClaimName needs to be provided from the list vmi.spec.resourceClaims[].name where this
@@ -9852,6 +10981,25 @@ var CRDsValidation map[string]string = map[string]string{
               type: object
             snp:
               description: AMD SEV-SNP flags defined by the SEV-SNP specifications.
+              properties:
+                attestation:
+                  description: |-
+                    If specified, run the attestation process for a vmi.
+                    The launch is held until the guest owner provides the ID block and its authentication information.
+                  type: object
+                hostData:
+                  description: Base64 encoded 32 bytes provided by the guest owner,
+                    included as is in the attestation reports of the guest.
+                  type: string
+                idAuth:
+                  description: Base64 encoded ID authentication information, holding
+                    the signature of the ID block.
+                  type: string
+                idBlock:
+                  description: |-
+                    Base64 encoded ID block, holding the expected launch measurement of the guest as signed by the guest owner.
+                    The guest is not launched if its measurement does not match.
+                  type: string
               type: object
             tdx:
               description: Intel Trust Domain Extensions (TDX).
@@ -10658,6 +11806,13 @@ var CRDsValidation map[string]string = map[string]string{
       description: VirtualMachineExportSpec is the spec for a VirtualMachineExport
         resource
       properties:
+        nbd:
+          description: |-
+            NBD additionally exposes the raw volumes over the Network Block Device protocol,
+            for block-level consumers doing sparse-aware, random-access reads.
+            The NBD endpoint is only reachable from within the cluster, requires TLS, and
+            authenticates clients through the export name, formatted as <volume>/<token>.
+          type: boolean
         source:
           description: |-
             TypedLocalObjectReference contains enough information to let you locate the
@@ -12408,9 +13563,25 @@ var CRDsValidation map[string]string = map[string]string{
                               Defaults to false.
                             type: boolean
                         type: object
+                      encryption:
+                        description: |-
+                          Encryption opens the disk as a LUKS encrypted volume with the passphrase
+                          stored in a Secret. The passphrase is handed to QEMU through libvirt secrets.
+                        properties:
+                          secretName:
+                            description: |-
+                              SecretName is the name of a Secret in the namespace of the VMI.
+                              The passphrase is read from the "passphrase" key of the Secret.
+                            type: string
+                        required:
+                        - secretName
+                        type: object
                       errorPolicy:
-                        description: If specified, it can change the default error
-                          policy (stop) for the disk
+                        description: |-
+                          If specified, it can change the default error policy (stop) for the disk.
+                          With stop, which is also used if not specified, and with retry, a VMI paused on I/O errors
+                          is resumed automatically with a backoff until the storage recovers.
+                          With pause it stays paused until it is unpaused.
                         type: string
                       io:
                         description: |-
@@ -12480,6 +13651,12 @@ var CRDsValidation map[string]string = map[string]string{
                   description: Whether to attach a GPU device to the vmi.
                   items:
                     properties:
+                      attachIOMMUGroup:
+                        description: |-
+                          AttachIOMMUGroup requests that all the devices sharing the IOMMU group of the
+                          allocated PCI device are passed through to the guest along with it.
+                          The VMI fails to start if any of them is in use by a host driver.
+                        type: boolean
                       claimName:
                         description: |-
                           ClaimName needs to be provided from the list vmi.spec.resourceClaims[].name where this
@@ -12533,6 +13710,12 @@ var CRDsValidation map[string]string = map[string]string{
                   description: Whether to attach a host device to the vmi.
                   items:
                     properties:
+                      attachIOMMUGroup:
+                        description: |-
+                          AttachIOMMUGroup requests that all the devices sharing the IOMMU group of the
+                          allocated PCI device are passed through to the guest along with it.
+                          The VMI fails to start if any of them is in use by a host driver.
+                        type: boolean
                       claimName:
                         description: |-
                           ClaimName needs to be provided from the list vmi.spec.resourceClaims[].name where this
@@ -12657,6 +13840,58 @@ var CRDsValidation map[string]string = map[string]string{
                               DHCP server
                             type: string
                         type: object
+                      firewall:
+                        description: |-
+                          Firewall defines the rules filtering the incoming traffic of the interface.
+                          Supported only with the masquerade binding.
+                        properties:
+                          ingress:
+                            description: Ingress lists the rules allowing incoming
+                              connections.
+                            items:
+                              description: FirewallRule allows the incoming connections
+                                matching all of its fields.
+                              properties:
+                                from:
+                                  description: |-
+                                    Source CIDRs matched by the rule, for example 10.10.0.0/16 or fd00::/64.
+                                    All sources are matched when not specified.
+                                  items:
+                                    type: string
+                                  type: array
+                                ports:
+                                  description: |-
+                                    Destination ports matched by the rule, supported only with the TCP and UDP protocols.
+                                    All ports are matched when not specified.
+                                  items:
+                                    format: int32
+                                    type: integer
+                                  type: array
+                                protocol:
+                                  description: |-
+                                    Protocol matched by the rule. One of TCP, UDP or ICMP.
+                                    All protocols are matched when not specified.
+                                  type: string
+                              type: object
+                            type: array
+                        type: object
+                      interrupts:
+                        description: |-
+                          Interrupts configures how the virtio device of the interface interrupts the guest, e.g. to give the
+                          queues of a high queue count vDPA NIC enough MSI-X vectors. It applies to the interfaces generated by
+                          network binding plugins as well. Supported only with the virtio model.
+                        properties:
+                          mode:
+                            description: Mode is the interrupt mode of the device, either
+                              MSIX or INTx. Defaults to MSIX.
+                            type: string
+                          vectors:
+                            description: |-
+                              Vectors is the number of MSI-X vectors of the device. Defaults to the hypervisor default, which is
+                              two vectors per queue pair plus two. It may not be set with the INTx mode.
+                            format: int32
+                            type: integer
+                        type: object
                       macAddress:
                         description: 'Interface MAC address. For example: de:ad:00:00:be:af
                           or DE-AD-00-00-BE-AF.'
@@ -12671,6 +13906,26 @@ var CRDsValidation map[string]string = map[string]string{
                         description: InterfaceMasquerade connects to a given network
                           using netfilter rules to nat the traffic.
                         type: object
+                      mirror:
+                        description: |-
+                          Mirror copies the traffic of the interface to a monitoring network, where an IDS or
+                          monitoring appliance (VM or pod) inspects it.
+                          Supported only with the bridge and masquerade bindings.
+                        properties:
+                          direction:
+                            description: |-
+                              Direction of the mirrored traffic: ingress for the traffic received by the guest,
+                              egress for the traffic sent by the guest, or both. Defaults to both.
+                            type: string
+                          networkName:
+                            description: |-
+                              NetworkName is the name of the multus NetworkAttachmentDefinition the mirrored traffic is sent to,
+                              in the form <namespace>/<name> or <name> for the VMI namespace.
+                              The virt-launcher pod is attached to it through an additional interface.
+                            type: string
+                        required:
+                        - networkName
+                        type: object
                       model:
                         description: |-
                           Interface model.
@@ -12682,6 +13937,45 @@ var CRDsValidation map[string]string = map[string]string{
                           Logical name of the interface as well as a reference to the associated networks.
                           Must match the Name of a Network.
                         type: string
+                      networkEmulation:
+                        description: |-
+                          NetworkEmulation degrades the traffic sent to the guest through the interface, adding delay,
+                          jitter and packet loss for chaos testing of the guest workloads.
+                          Supported only with the bridge and masquerade bindings.
+                        properties:
+                          delay:
+                            description: Delay added to every packet sent to the guest.
+                            type: string
+                          jitter:
+                            description: Jitter is the random variation of the delay,
+                              requires a delay.
+                            type: string
+                          lossPercentage:
+                            description: |-
+                              LossPercentage is the percentage of the packets sent to the guest which are dropped, between 0 and 100.
+                              For example: "0.5".
+                            type: string
+                        type: object
+                      offloads:
+                        description: |-
+                          Offloads toggles the offloads the host applies to the traffic of the interface.
+                          Supported only with the virtio model. Offloads which are not specified keep the hypervisor defaults.
+                        properties:
+                          csum:
+                            description: Checksum toggles the checksum offload.
+                            type: boolean
+                          mrgRxbuf:
+                            description: MergeableRxBuffers toggles the mergeable
+                              receive buffers.
+                            type: boolean
+                          tso:
+                            description: TSO toggles the TCP segmentation offload,
+                              for both IPv4 and IPv6.
+                            type: boolean
+                          ufo:
+                            description: UFO toggles the UDP fragmentation offload.
+                            type: boolean
+                        type: object
                       passt:
                         description: |-
                           DeprecatedPasst is an alias to the deprecated Passt interface,
@@ -12727,6 +14021,27 @@ var CRDsValidation map[string]string = map[string]string{
                           - port
                           type: object
                         type: array
+                      portsEnforcement:
+                        description: |-
+                          PortsEnforcement defines how the listed ports are exposed.
+                          With Strict, only the listed ports are forwarded to the guest, any other incoming traffic
+                          is dropped and the list of ports can be updated while the VM is running.
+                          Supported only with the masquerade binding.
+                        type: string
+                      promiscuous:
+                        description: |-
+                          Promiscuous declares that the guest uses the interface as a trunk or in promiscuous mode,
+                          sending and receiving frames of MAC addresses other than its own, as nested hypervisors and VNFs do.
+                          The ports of the backing bridge are set in promiscuous mode to let these frames through.
+                          Supported only with the bridge binding, in namespaces selected by the
+                          promiscuousInterfacesNamespaceLabelSelector of the KubeVirt network configuration.
+                        type: boolean
+                      routerAdvertisement:
+                        description: |-
+                          RouterAdvertisement enables IPv6 router advertisements to the guest, announcing the pod
+                          as its default router and letting the guest get its IPv6 address over DHCPv6.
+                          Supported only with the masquerade binding.
+                        type: object
                       slirp:
                         description: |-
                           DeprecatedSlirp is an alias to the deprecated Slirp interface
@@ -12768,6 +14083,25 @@ var CRDsValidation map[string]string = map[string]string{
                     factors of the VirtualMachineInstance, like the number of guest
                     CPUs.
                   type: boolean
+                networkInterfaceMultiqueuePolicy:
+                  description: |-
+                    NetworkInterfaceMultiQueuePolicy controls how many queue pairs are given to the multi-queue
+                    network interfaces, relative to the number of guest vCPUs.
+                    When not set, the cluster wide policy applies, and each vCPU gets its own queue pair.
+                  properties:
+                    maxQueues:
+                      description: |-
+                        MaxQueues caps the number of queue pairs of each interface.
+                        Defaults to, and cannot exceed, 256.
+                      format: int32
+                      type: integer
+                    vcpusPerQueue:
+                      description: |-
+                        VCPUsPerQueue is the number of guest vCPUs sharing a single queue pair.
+                        For example, 2 gives one queue pair for every two vCPUs. Defaults to 1.
+                      format: int32
+                      type: integer
+                  type: object
                 panicDevices:
                   description: PanicDevices provides additional crash information
                     when a guest crashes.
@@ -12799,6 +14133,21 @@ var CRDsValidation map[string]string = map[string]string{
                   required:
                   - name
                   type: object
+                spice:
+                  description: Whether to attach a SPICE graphics device, reachable
+                    through the spice subresource.
+                  properties:
+                    audio:
+                      description: |-
+                        Audio streams the output of the VMI sound device over the SPICE connection.
+                        An ich9 sound device is emulated if none is set.
+                      type: boolean
+                    usbRedirection:
+                      description: |-
+                        USBRedirection attaches SpiceUSBRedirectionMaxNumberOf USB redirection
+                        channels, multiplexed over the SPICE connection.
+                      type: boolean
+                  type: object
                 tpm:
                   description: Whether to emulate a TPM device.
                   properties:
@@ -12829,6 +14178,24 @@ var CRDsValidation map[string]string = map[string]string{
                         If not specified, the default is architecture-dependent (VGA for BIOS-based VMs, Bochs for EFI-based VMs on AMD64; virtio for Arm and s390x).
                       type: string
                   type: object
+                virtioChannels:
+                  description: |-
+                    VirtioChannels lists the named virtio-serial channels exposed to the guest, alongside the serial console.
+                    Each channel is reachable through its own websocket subresource, enabling out-of-band management agents.
+                  items:
+                    description: VirtioChannel represents a virtio-serial port, exposed
+                      in the guest as /dev/virtio-ports/io.kubevirt.<name>.
+                    properties:
+                      name:
+                        description: |-
+                          Name of the channel, used to reach it through the channel subresource.
+                          Must be a DNS-1123 label and unique within the VMI.
+                        type: string
+                    required:
+                    - name
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
                 watchdog:
                   description: Watchdog describes a watchdog device which can be added
                     to the vmi.
@@ -13210,6 +14577,28 @@ var CRDsValidation map[string]string = map[string]string{
                     kernelArgs:
                       description: Arguments to be passed to the kernel at boot time
                       type: string
+                    volume:
+                      description: |-
+                        Volume defines the PersistentVolumeClaim or DataVolume volume that contains kernel artifacts.
+                        Container and Volume are mutually exclusive.
+                      properties:
+                        initrdPath:
+                          description: the fully-qualified path to the ramdisk image
+                            in the volume
+                          type: string
+                        kernelPath:
+                          description: The fully-qualified path to the kernel image
+                            in the volume
+                          type: string
+                        name:
+                          description: |-
+                            Name of the volume that contains initrd / kernel files.
+                            It must match a PersistentVolumeClaim or DataVolume volume of the VMI in filesystem mode,
+                            which is not used by any disk or filesystem.
+                          type: string
+                      required:
+                      - name
+                      type: object
                   type: object
                 serial:
                   description: The system-serial-number in SMBIOS
@@ -13265,6 +14654,25 @@ var CRDsValidation map[string]string = map[string]string{
                   type: object
                 snp:
                   description: AMD SEV-SNP flags defined by the SEV-SNP specifications.
+                  properties:
+                    attestation:
+                      description: |-
+                        If specified, run the attestation process for a vmi.
+                        The launch is held until the guest owner provides the ID block and its authentication information.
+                      type: object
+                    hostData:
+                      description: Base64 encoded 32 bytes provided by the guest owner,
+                        included as is in the attestation reports of the guest.
+                      type: string
+                    idAuth:
+                      description: Base64 encoded ID authentication information, holding
+                        the signature of the ID block.
+                      type: string
+                    idBlock:
+                      description: |-
+                        Base64 encoded ID block, holding the expected launch measurement of the guest as signed by the guest owner.
+                        The guest is not launched if its measurement does not match.
+                      type: string
                   type: object
                 tdx:
                   description: Intel Trust Domain Extensions (TDX).
@@ -13336,6 +14744,26 @@ var CRDsValidation map[string]string = map[string]string{
                       - Required
                       type: string
                   type: object
+                swap:
+                  description: |-
+                    Swap allows the VMI to use the swap of the node, for memory-bursty but latency-tolerant workloads.
+                    The swap has to be enabled on the node.
+                  properties:
+                    limit:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: |-
+                        Limit is the maximum amount of swap used by the VMI.
+                        Defaults to no limit.
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    policy:
+                      description: |-
+                        Policy defines how swapped out memory is stored, Swap or Zswap.
+                        Defaults to Swap.
+                      type: string
+                  type: object
               type: object
             rebootPolicy:
               description: |-
@@ -13393,6 +14821,28 @@ var CRDsValidation map[string]string = map[string]string{
             - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
             - "External": the VirtualMachineInstance will be protected and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
           type: string
+        guestDNS:
+          description: |-
+            GuestDNS overrides the DNS configuration the guest receives over DHCP, independently of the
+            DNS policy and configuration of the virt-launcher pod.
+            Parameters which are not specified are taken from the pod DNS configuration.
+          properties:
+            nameservers:
+              description: |-
+                Nameservers is a list of IP addresses of the DNS servers the guest should use.
+                The DNS servers of an IP family which has none in the list are taken from the pod DNS configuration.
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+            searches:
+              description: Searches is a list of DNS search domains the guest should
+                use.
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+          type: object
         hostname:
           description: |-
             Specifies the hostname of the vmi
@@ -13429,6 +14879,32 @@ var CRDsValidation map[string]string = map[string]string{
                 Defaults to 3. Minimum value is 1.
               format: int32
               type: integer
+            guestAgentExec:
+              description: |-
+                GuestAgentExec runs a command inside the guest through the qemu-guest-agent and
+                interprets its exit code. No network access to the guest is required.
+              properties:
+                command:
+                  description: |-
+                    Command is the command line to execute inside the guest. The first element is the
+                    executable, the remaining elements are passed as arguments. The command is not run
+                    in a shell, so shell instructions like pipes are only available by calling a shell explicitly.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: atomic
+                successExitCodes:
+                  description: |-
+                    SuccessExitCodes lists the exit codes of the command which are considered a successful probe.
+                    Defaults to 0.
+                  items:
+                    format: int32
+                    type: integer
+                  type: array
+                  x-kubernetes-list-type: set
+              required:
+              - command
+              type: object
             guestAgentPing:
               description: GuestAgentPing contacts the qemu-guest-agent for availability
                 checks.
@@ -13533,6 +15009,20 @@ var CRDsValidation map[string]string = map[string]string{
               format: int32
               type: integer
           type: object
+        metadataService:
+          description: |-
+            MetadataService exposes the instance identity, the network configuration and user provided data
+            to the guest, over HTTP on the link-local 169.254.169.254 address of the masquerade pod network.
+          properties:
+            data:
+              additionalProperties:
+                type: string
+              description: |-
+                Data is user provided key/value data served to the guest.
+                It can be extended or updated after boot through the VirtualMachineInstance annotations
+                prefixed with metadata.kubevirt.io/, which take precedence.
+              type: object
+          type: object
         networks:
           description: List of networks that can be attached to a vm's virtual interface.
           items:
@@ -13562,6 +15052,18 @@ var CRDsValidation map[string]string = map[string]string{
                   Must be a DNS_LABEL and unique within the vm.
                   More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
                 type: string
+              native:
+                description: |-
+                  Native represents a secondary network provided by the Kubernetes
+                  native multi-network API (PodNetwork).
+                properties:
+                  podNetworkName:
+                    description: PodNetworkName references a cluster scoped PodNetwork
+                      object.
+                    type: string
+                required:
+                - podNetworkName
+                type: object
               pod:
                 description: Represents the stock pod network interface.
                 properties:
@@ -13626,6 +15128,32 @@ var CRDsValidation map[string]string = map[string]string{
                 Defaults to 3. Minimum value is 1.
               format: int32
               type: integer
+            guestAgentExec:
+              description: |-
+                GuestAgentExec runs a command inside the guest through the qemu-guest-agent and
+                interprets its exit code. No network access to the guest is required.
+              properties:
+                command:
+                  description: |-
+                    Command is the command line to execute inside the guest. The first element is the
+                    executable, the remaining elements are passed as arguments. The command is not run
+                    in a shell, so shell instructions like pipes are only available by calling a shell explicitly.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: atomic
+                successExitCodes:
+                  description: |-
+                    SuccessExitCodes lists the exit codes of the command which are considered a successful probe.
+                    Defaults to 0.
+                  items:
+                    format: int32
+                    type: integer
+                  type: array
+                  x-kubernetes-list-type: set
+              required:
+              - command
+              type: object
             guestAgentPing:
               description: GuestAgentPing contacts the qemu-guest-agent for availability
                 checks.
@@ -14557,6 +16085,24 @@ var CRDsValidation map[string]string = map[string]string{
             ActivePods is a mapping of pod UID to node name.
             It is possible for multiple pods to be running for a single VMI during migration.
           type: object
+        bootPhaseTimestamps:
+          description: |-
+            BootPhaseTimestamps records when the VirtualMachineInstance reached each of the steps of its start,
+            allowing to tell which of them delayed it
+          items:
+            description: VirtualMachineInstanceBootPhaseTimestamp gives a timestamp
+              in relation to when a boot phase was reached by a vmi
+            properties:
+              phase:
+                description: Phase is the boot phase reached by the VirtualMachineInstance
+                type: string
+              timestamp:
+                description: Timestamp is the time at which the boot phase was reached
+                format: date-time
+                type: string
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         changedBlockTracking:
           description: ChangedBlockTracking represents the status of the changedBlockTracking
           nullable: true
@@ -14687,6 +16233,26 @@ var CRDsValidation map[string]string = map[string]string{
             It will be set to "frozen" if the request was made, or unset otherwise.
             This does not reflect the actual state of the guest filesystem.
           type: string
+        guestDrivers:
+          description: GuestDrivers are the drivers the guest uses for its devices,
+            as reported by the guest agent
+          items:
+            description: VirtualMachineInstanceGuestOSDriver describes a driver the
+              guest uses for one of its devices
+            properties:
+              deviceID:
+                description: PCI vendor and device ID of the device handled by the
+                  driver, in the vendor:device format
+                type: string
+              name:
+                description: Name of the driver
+                type: string
+              version:
+                description: Version of the driver
+                type: string
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         guestOSInfo:
           description: Guest OS Information
           properties:
@@ -14715,10 +16281,59 @@ var CRDsValidation map[string]string = map[string]string{
               description: Version ID of the Guest OS
               type: string
           type: object
+        hostDeviceStatuses:
+          description: HostDeviceStatuses contains the statuses of the host devices
+            assigned to the VirtualMachineInstance
+          items:
+            description: HostDeviceStatus represents information about the status
+              of a host device assigned to the VirtualMachineInstance.
+            properties:
+              hotplugHostDevice:
+                description: If the host device is hotplugged, this will contain the
+                  hotplug status.
+                properties:
+                  attachPodName:
+                    description: AttachPodName is the name of the pod used to allocate
+                      the host device on the node.
+                    type: string
+                  attachPodUID:
+                    description: AttachPodUID is the UID of the pod used to allocate
+                      the host device on the node.
+                    type: string
+                  pciAddress:
+                    description: PCIAddress is the host PCI address of the device
+                      allocated to the attachment pod.
+                    type: string
+                type: object
+              message:
+                description: Message is a detailed message about the current host
+                  device phase
+                type: string
+              name:
+                description: Name is the name of the host device
+                type: string
+              phase:
+                description: Phase is the phase
+                type: string
+              reason:
+                description: Reason is a brief description of why we are in the current
+                  host device phase
+                type: string
+            required:
+            - name
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         interfaces:
           description: Interfaces represent the details of available network interfaces.
           items:
             properties:
+              hotplugFailedAttempts:
+                description: |-
+                  HotplugFailedAttempts counts the failed attempts to hot plug the interface into the running domain.
+                  The hotplug is rolled back once the attempts are exhausted.
+                format: int32
+                type: integer
               infoSource:
                 description: 'Specifies the origin of the interface data collected.
                   values: domain, guest-agent, multus-status.'
@@ -14959,6 +16574,24 @@ var CRDsValidation map[string]string = map[string]string{
             completed:
               description: Indicates the migration completed
               type: boolean
+            diskTransferProgress:
+              description: DiskTransferProgress reports how much of the migrated volumes
+                has been copied to the target
+              properties:
+                processedBytes:
+                  description: ProcessedBytes is the amount of volume data already
+                    copied to the target
+                  format: int64
+                  type: integer
+                totalBytes:
+                  description: TotalBytes is the amount of volume data to be copied
+                    to the target
+                  format: int64
+                  type: integer
+              required:
+              - processedBytes
+              - totalBytes
+              type: object
             endTimestamp:
               description: The time the migration action ended
               format: date-time
@@ -15560,6 +17193,24 @@ var CRDsValidation map[string]string = map[string]string{
             completed:
               description: Indicates the migration completed
               type: boolean
+            diskTransferProgress:
+              description: DiskTransferProgress reports how much of the migrated volumes
+                has been copied to the target
+              properties:
+                processedBytes:
+                  description: ProcessedBytes is the amount of volume data already
+                    copied to the target
+                  format: int64
+                  type: integer
+                totalBytes:
+                  description: TotalBytes is the amount of volume data to be copied
+                    to the target
+                  format: int64
+                  type: integer
+              required:
+              - processedBytes
+              - totalBytes
+              type: object
             endTimestamp:
               description: The time the migration action ended
               format: date-time
@@ -16265,9 +17916,25 @@ var CRDsValidation map[string]string = map[string]string{
                               Defaults to false.
                             type: boolean
                         type: object
+                      encryption:
+                        description: |-
+                          Encryption opens the disk as a LUKS encrypted volume with the passphrase
+                          stored in a Secret. The passphrase is handed to QEMU through libvirt secrets.
+                        properties:
+                          secretName:
+                            description: |-
+                              SecretName is the name of a Secret in the namespace of the VMI.
+                              The passphrase is read from the "passphrase" key of the Secret.
+                            type: string
+                        required:
+                        - secretName
+                        type: object
                       errorPolicy:
-                        description: If specified, it can change the default error
-                          policy (stop) for the disk
+                        description: |-
+                          If specified, it can change the default error policy (stop) for the disk.
+                          With stop, which is also used if not specified, and with retry, a VMI paused on I/O errors
+                          is resumed automatically with a backoff until the storage recovers.
+                          With pause it stays paused until it is unpaused.
                         type: string
                       io:
                         description: |-
@@ -16337,6 +18004,12 @@ var CRDsValidation map[string]string = map[string]string{
                   description: Whether to attach a GPU device to the vmi.
                   items:
                     properties:
+                      attachIOMMUGroup:
+                        description: |-
+                          AttachIOMMUGroup requests that all the devices sharing the IOMMU group of the
+                          allocated PCI device are passed through to the guest along with it.
+                          The VMI fails to start if any of them is in use by a host driver.
+                        type: boolean
                       claimName:
                         description: |-
                           ClaimName needs to be provided from the list vmi.spec.resourceClaims[].name where this
@@ -16390,6 +18063,12 @@ var CRDsValidation map[string]string = map[string]string{
                   description: Whether to attach a host device to the vmi.
                   items:
                     properties:
+                      attachIOMMUGroup:
+                        description: |-
+                          AttachIOMMUGroup requests that all the devices sharing the IOMMU group of the
+                          allocated PCI device are passed through to the guest along with it.
+                          The VMI fails to start if any of them is in use by a host driver.
+                        type: boolean
                       claimName:
                         description: |-
                           ClaimName needs to be provided from the list vmi.spec.resourceClaims[].name where this
@@ -16514,6 +18193,58 @@ var CRDsValidation map[string]string = map[string]string{
                               DHCP server
                             type: string
                         type: object
+                      firewall:
+                        description: |-
+                          Firewall defines the rules filtering the incoming traffic of the interface.
+                          Supported only with the masquerade binding.
+                        properties:
+                          ingress:
+                            description: Ingress lists the rules allowing incoming
+                              connections.
+                            items:
+                              description: FirewallRule allows the incoming connections
+                                matching all of its fields.
+                              properties:
+                                from:
+                                  description: |-
+                                    Source CIDRs matched by the rule, for example 10.10.0.0/16 or fd00::/64.
+                                    All sources are matched when not specified.
+                                  items:
+                                    type: string
+                                  type: array
+                                ports:
+                                  description: |-
+                                    Destination ports matched by the rule, supported only with the TCP and UDP protocols.
+                                    All ports are matched when not specified.
+                                  items:
+                                    format: int32
+                                    type: integer
+                                  type: array
+                                protocol:
+                                  description: |-
+                                    Protocol matched by the rule. One of TCP, UDP or ICMP.
+                                    All protocols are matched when not specified.
+                                  type: string
+                              type: object
+                            type: array
+                        type: object
+                      interrupts:
+                        description: |-
+                          Interrupts configures how the virtio device of the interface interrupts the guest, e.g. to give the
+                          queues of a high queue count vDPA NIC enough MSI-X vectors. It applies to the interfaces generated by
+                          network binding plugins as well. Supported only with the virtio model.
+                        properties:
+                          mode:
+                            description: Mode is the interrupt mode of the device, either
+                              MSIX or INTx. Defaults to MSIX.
+                            type: string
+                          vectors:
+                            description: |-
+                              Vectors is the number of MSI-X vectors of the device. Defaults to the hypervisor default, which is
+                              two vectors per queue pair plus two. It may not be set with the INTx mode.
+                            format: int32
+                            type: integer
+                        type: object
                       macAddress:
                         description: 'Interface MAC address. For example: de:ad:00:00:be:af
                           or DE-AD-00-00-BE-AF.'
@@ -16528,6 +18259,26 @@ var CRDsValidation map[string]string = map[string]string{
                         description: InterfaceMasquerade connects to a given network
                           using netfilter rules to nat the traffic.
                         type: object
+                      mirror:
+                        description: |-
+                          Mirror copies the traffic of the interface to a monitoring network, where an IDS or
+                          monitoring appliance (VM or pod) inspects it.
+                          Supported only with the bridge and masquerade bindings.
+                        properties:
+                          direction:
+                            description: |-
+                              Direction of the mirrored traffic: ingress for the traffic received by the guest,
+                              egress for the traffic sent by the guest, or both. Defaults to both.
+                            type: string
+                          networkName:
+                            description: |-
+                              NetworkName is the name of the multus NetworkAttachmentDefinition the mirrored traffic is sent to,
+                              in the form <namespace>/<name> or <name> for the VMI namespace.
+                              The virt-launcher pod is attached to it through an additional interface.
+                            type: string
+                        required:
+                        - networkName
+                        type: object
                       model:
                         description: |-
                           Interface model.
@@ -16539,6 +18290,45 @@ var CRDsValidation map[string]string = map[string]string{
                           Logical name of the interface as well as a reference to the associated networks.
                           Must match the Name of a Network.
                         type: string
+                      networkEmulation:
+                        description: |-
+                          NetworkEmulation degrades the traffic sent to the guest through the interface, adding delay,
+                          jitter and packet loss for chaos testing of the guest workloads.
+                          Supported only with the bridge and masquerade bindings.
+                        properties:
+                          delay:
+                            description: Delay added to every packet sent to the guest.
+                            type: string
+                          jitter:
+                            description: Jitter is the random variation of the delay,
+                              requires a delay.
+                            type: string
+                          lossPercentage:
+                            description: |-
+                              LossPercentage is the percentage of the packets sent to the guest which are dropped, between 0 and 100.
+                              For example: "0.5".
+                            type: string
+                        type: object
+                      offloads:
+                        description: |-
+                          Offloads toggles the offloads the host applies to the traffic of the interface.
+                          Supported only with the virtio model. Offloads which are not specified keep the hypervisor defaults.
+                        properties:
+                          csum:
+                            description: Checksum toggles the checksum offload.
+                            type: boolean
+                          mrgRxbuf:
+                            description: MergeableRxBuffers toggles the mergeable
+                              receive buffers.
+                            type: boolean
+                          tso:
+                            description: TSO toggles the TCP segmentation offload,
+                              for both IPv4 and IPv6.
+                            type: boolean
+                          ufo:
+                            description: UFO toggles the UDP fragmentation offload.
+                            type: boolean
+                        type: object
                       passt:
                         description: |-
                           DeprecatedPasst is an alias to the deprecated Passt interface,
@@ -16584,6 +18374,27 @@ var CRDsValidation map[string]string = map[string]string{
                           - port
                           type: object
                         type: array
+                      portsEnforcement:
+                        description: |-
+                          PortsEnforcement defines how the listed ports are exposed.
+                          With Strict, only the listed ports are forwarded to the guest, any other incoming traffic
+                          is dropped and the list of ports can be updated while the VM is running.
+                          Supported only with the masquerade binding.
+                        type: string
+                      promiscuous:
+                        description: |-
+                          Promiscuous declares that the guest uses the interface as a trunk or in promiscuous mode,
+                          sending and receiving frames of MAC addresses other than its own, as nested hypervisors and VNFs do.
+                          The ports of the backing bridge are set in promiscuous mode to let these frames through.
+                          Supported only with the bridge binding, in namespaces selected by the
+                          promiscuousInterfacesNamespaceLabelSelector of the KubeVirt network configuration.
+                        type: boolean
+                      routerAdvertisement:
+                        description: |-
+                          RouterAdvertisement enables IPv6 router advertisements to the guest, announcing the pod
+                          as its default router and letting the guest get its IPv6 address over DHCPv6.
+                          Supported only with the masquerade binding.
+                        type: object
                       slirp:
                         description: |-
                           DeprecatedSlirp is an alias to the deprecated Slirp interface
@@ -16625,6 +18436,25 @@ var CRDsValidation map[string]string = map[string]string{
                     factors of the VirtualMachineInstance, like the number of guest
                     CPUs.
                   type: boolean
+                networkInterfaceMultiqueuePolicy:
+                  description: |-
+                    NetworkInterfaceMultiQueuePolicy controls how many queue pairs are given to the multi-queue
+                    network interfaces, relative to the number of guest vCPUs.
+                    When not set, the cluster wide policy applies, and each vCPU gets its own queue pair.
+                  properties:
+                    maxQueues:
+                      description: |-
+                        MaxQueues caps the number of queue pairs of each interface.
+                        Defaults to, and cannot exceed, 256.
+                      format: int32
+                      type: integer
+                    vcpusPerQueue:
+                      description: |-
+                        VCPUsPerQueue is the number of guest vCPUs sharing a single queue pair.
+                        For example, 2 gives one queue pair for every two vCPUs. Defaults to 1.
+                      format: int32
+                      type: integer
+                  type: object
                 panicDevices:
                   description: PanicDevices provides additional crash information
                     when a guest crashes.
@@ -16656,6 +18486,21 @@ var CRDsValidation map[string]string = map[string]string{
                   required:
                   - name
                   type: object
+                spice:
+                  description: Whether to attach a SPICE graphics device, reachable
+                    through the spice subresource.
+                  properties:
+                    audio:
+                      description: |-
+                        Audio streams the output of the VMI sound device over the SPICE connection.
+                        An ich9 sound device is emulated if none is set.
+                      type: boolean
+                    usbRedirection:
+                      description: |-
+                        USBRedirection attaches SpiceUSBRedirectionMaxNumberOf USB redirection
+                        channels, multiplexed over the SPICE connection.
+                      type: boolean
+                  type: object
                 tpm:
                   description: Whether to emulate a TPM device.
                   properties:
@@ -16686,6 +18531,24 @@ var CRDsValidation map[string]string = map[string]string{
                         If not specified, the default is architecture-dependent (VGA for BIOS-based VMs, Bochs for EFI-based VMs on AMD64; virtio for Arm and s390x).
                       type: string
                   type: object
+                virtioChannels:
+                  description: |-
+                    VirtioChannels lists the named virtio-serial channels exposed to the guest, alongside the serial console.
+                    Each channel is reachable through its own websocket subresource, enabling out-of-band management agents.
+                  items:
+                    description: VirtioChannel represents a virtio-serial port, exposed
+                      in the guest as /dev/virtio-ports/io.kubevirt.<name>.
+                    properties:
+                      name:
+                        description: |-
+                          Name of the channel, used to reach it through the channel subresource.
+                          Must be a DNS-1123 label and unique within the VMI.
+                        type: string
+                    required:
+                    - name
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
                 watchdog:
                   description: Watchdog describes a watchdog device which can be added
                     to the vmi.
@@ -17067,6 +18930,28 @@ var CRDsValidation map[string]string = map[string]string{
                     kernelArgs:
                       description: Arguments to be passed to the kernel at boot time
                       type: string
+                    volume:
+                      description: |-
+                        Volume defines the PersistentVolumeClaim or DataVolume volume that contains kernel artifacts.
+                        Container and Volume are mutually exclusive.
+                      properties:
+                        initrdPath:
+                          description: the fully-qualified path to the ramdisk image
+                            in the volume
+                          type: string
+                        kernelPath:
+                          description: The fully-qualified path to the kernel image
+                            in the volume
+                          type: string
+                        name:
+                          description: |-
+                            Name of the volume that contains initrd / kernel files.
+                            It must match a PersistentVolumeClaim or DataVolume volume of the VMI in filesystem mode,
+                            which is not used by any disk or filesystem.
+                          type: string
+                      required:
+                      - name
+                      type: object
                   type: object
                 serial:
                   description: The system-serial-number in SMBIOS
@@ -17122,6 +19007,25 @@ var CRDsValidation map[string]string = map[string]string{
                   type: object
                 snp:
                   description: AMD SEV-SNP flags defined by the SEV-SNP specifications.
+                  properties:
+                    attestation:
+                      description: |-
+                        If specified, run the attestation process for a vmi.
+                        The launch is held until the guest owner provides the ID block and its authentication information.
+                      type: object
+                    hostData:
+                      description: Base64 encoded 32 bytes provided by the guest owner,
+                        included as is in the attestation reports of the guest.
+                      type: string
+                    idAuth:
+                      description: Base64 encoded ID authentication information, holding
+                        the signature of the ID block.
+                      type: string
+                    idBlock:
+                      description: |-
+                        Base64 encoded ID block, holding the expected launch measurement of the guest as signed by the guest owner.
+                        The guest is not launched if its measurement does not match.
+                      type: string
                   type: object
                 tdx:
                   description: Intel Trust Domain Extensions (TDX).
@@ -17193,6 +19097,26 @@ var CRDsValidation map[string]string = map[string]string{
                       - Required
                       type: string
                   type: object
+                swap:
+                  description: |-
+                    Swap allows the VMI to use the swap of the node, for memory-bursty but latency-tolerant workloads.
+                    The swap has to be enabled on the node.
+                  properties:
+                    limit:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: |-
+                        Limit is the maximum amount of swap used by the VMI.
+                        Defaults to no limit.
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    policy:
+                      description: |-
+                        Policy defines how swapped out memory is stored, Swap or Zswap.
+                        Defaults to Swap.
+                      type: string
+                  type: object
               type: object
             rebootPolicy:
               description: |-
@@ -18818,9 +20742,25 @@ var CRDsValidation map[string]string = map[string]string{
                                       Defaults to false.
                                     type: boolean
                                 type: object
+                              encryption:
+                                description: |-
+                                  Encryption opens the disk as a LUKS encrypted volume with the passphrase
+                                  stored in a Secret. The passphrase is handed to QEMU through libvirt secrets.
+                                properties:
+                                  secretName:
+                                    description: |-
+                                      SecretName is the name of a Secret in the namespace of the VMI.
+                                      The passphrase is read from the "passphrase" key of the Secret.
+                                    type: string
+                                required:
+                                - secretName
+                                type: object
                               errorPolicy:
-                                description: If specified, it can change the default
-                                  error policy (stop) for the disk
+                                description: |-
+                                  If specified, it can change the default error policy (stop) for the disk.
+                                  With stop, which is also used if not specified, and with retry, a VMI paused on I/O errors
+                                  is resumed automatically with a backoff until the storage recovers.
+                                  With pause it stays paused until it is unpaused.
                                 type: string
                               io:
                                 description: |-
@@ -18891,6 +20831,12 @@ var CRDsValidation map[string]string = map[string]string{
                           description: Whether to attach a GPU device to the vmi.
                           items:
                             properties:
+                              attachIOMMUGroup:
+                                description: |-
+                                  AttachIOMMUGroup requests that all the devices sharing the IOMMU group of the
+                                  allocated PCI device are passed through to the guest along with it.
+                                  The VMI fails to start if any of them is in use by a host driver.
+                                type: boolean
                               claimName:
                                 description: |-
                                   ClaimName needs to be provided from the list vmi.spec.resourceClaims[].name where this
@@ -18945,6 +20891,12 @@ var CRDsValidation map[string]string = map[string]string{
                           description: Whether to attach a host device to the vmi.
                           items:
                             properties:
+                              attachIOMMUGroup:
+                                description: |-
+                                  AttachIOMMUGroup requests that all the devices sharing the IOMMU group of the
+                                  allocated PCI device are passed through to the guest along with it.
+                                  The VMI fails to start if any of them is in use by a host driver.
+                                type: boolean
                               claimName:
                                 description: |-
                                   ClaimName needs to be provided from the list vmi.spec.resourceClaims[].name where this
@@ -19070,6 +21022,58 @@ var CRDsValidation map[string]string = map[string]string{
                                       to interface's DHCP server
                                     type: string
                                 type: object
+                              firewall:
+                                description: |-
+                                  Firewall defines the rules filtering the incoming traffic of the interface.
+                                  Supported only with the masquerade binding.
+                                properties:
+                                  ingress:
+                                    description: Ingress lists the rules allowing
+                                      incoming connections.
+                                    items:
+                                      description: FirewallRule allows the incoming
+                                        connections matching all of its fields.
+                                      properties:
+                                        from:
+                                          description: |-
+                                            Source CIDRs matched by the rule, for example 10.10.0.0/16 or fd00::/64.
+                                            All sources are matched when not specified.
+                                          items:
+                                            type: string
+                                          type: array
+                                        ports:
+                                          description: |-
+                                            Destination ports matched by the rule, supported only with the TCP and UDP protocols.
+                                            All ports are matched when not specified.
+                                          items:
+                                            format: int32
+                                            type: integer
+                                          type: array
+                                        protocol:
+                                          description: |-
+                                            Protocol matched by the rule. One of TCP, UDP or ICMP.
+                                            All protocols are matched when not specified.
+                                          type: string
+                                      type: object
+                                    type: array
+                                type: object
+                              interrupts:
+                                description: |-
+                                  Interrupts configures how the virtio device of the interface interrupts the guest, e.g. to give the
+                                  queues of a high queue count vDPA NIC enough MSI-X vectors. It applies to the interfaces generated by
+                                  network binding plugins as well. Supported only with the virtio model.
+                                properties:
+                                  mode:
+                                    description: Mode is the interrupt mode of the device, either
+                                      MSIX or INTx. Defaults to MSIX.
+                                    type: string
+                                  vectors:
+                                    description: |-
+                                      Vectors is the number of MSI-X vectors of the device. Defaults to the hypervisor default, which is
+                                      two vectors per queue pair plus two. It may not be set with the INTx mode.
+                                    format: int32
+                                    type: integer
+                                type: object
                               macAddress:
                                 description: 'Interface MAC address. For example:
                                   de:ad:00:00:be:af or DE-AD-00-00-BE-AF.'
@@ -19084,6 +21088,26 @@ var CRDsValidation map[string]string = map[string]string{
                                 description: InterfaceMasquerade connects to a given
                                   network using netfilter rules to nat the traffic.
                                 type: object
+                              mirror:
+                                description: |-
+                                  Mirror copies the traffic of the interface to a monitoring network, where an IDS or
+                                  monitoring appliance (VM or pod) inspects it.
+                                  Supported only with the bridge and masquerade bindings.
+                                properties:
+                                  direction:
+                                    description: |-
+                                      Direction of the mirrored traffic: ingress for the traffic received by the guest,
+                                      egress for the traffic sent by the guest, or both. Defaults to both.
+                                    type: string
+                                  networkName:
+                                    description: |-
+                                      NetworkName is the name of the multus NetworkAttachmentDefinition the mirrored traffic is sent to,
+                                      in the form <namespace>/<name> or <name> for the VMI namespace.
+                                      The virt-launcher pod is attached to it through an additional interface.
+                                    type: string
+                                required:
+                                - networkName
+                                type: object
                               model:
                                 description: |-
                                   Interface model.
@@ -19095,6 +21119,47 @@ var CRDsValidation map[string]string = map[string]string{
                                   Logical name of the interface as well as a reference to the associated networks.
                                   Must match the Name of a Network.
                                 type: string
+                              networkEmulation:
+                                description: |-
+                                  NetworkEmulation degrades the traffic sent to the guest through the interface, adding delay,
+                                  jitter and packet loss for chaos testing of the guest workloads.
+                                  Supported only with the bridge and masquerade bindings.
+                                properties:
+                                  delay:
+                                    description: Delay added to every packet sent
+                                      to the guest.
+                                    type: string
+                                  jitter:
+                                    description: Jitter is the random variation of
+                                      the delay, requires a delay.
+                                    type: string
+                                  lossPercentage:
+                                    description: |-
+                                      LossPercentage is the percentage of the packets sent to the guest which are dropped, between 0 and 100.
+                                      For example: "0.5".
+                                    type: string
+                                type: object
+                              offloads:
+                                description: |-
+                                  Offloads toggles the offloads the host applies to the traffic of the interface.
+                                  Supported only with the virtio model. Offloads which are not specified keep the hypervisor defaults.
+                                properties:
+                                  csum:
+                                    description: Checksum toggles the checksum offload.
+                                    type: boolean
+                                  mrgRxbuf:
+                                    description: MergeableRxBuffers toggles the mergeable
+                                      receive buffers.
+                                    type: boolean
+                                  tso:
+                                    description: TSO toggles the TCP segmentation
+                                      offload, for both IPv4 and IPv6.
+                                    type: boolean
+                                  ufo:
+                                    description: UFO toggles the UDP fragmentation
+                                      offload.
+                                    type: boolean
+                                type: object
                               passt:
                                 description: |-
                                   DeprecatedPasst is an alias to the deprecated Passt interface,
@@ -19140,6 +21205,27 @@ var CRDsValidation map[string]string = map[string]string{
                                   - port
                                   type: object
                                 type: array
+                              portsEnforcement:
+                                description: |-
+                                  PortsEnforcement defines how the listed ports are exposed.
+                                  With Strict, only the listed ports are forwarded to the guest, any other incoming traffic
+                                  is dropped and the list of ports can be updated while the VM is running.
+                                  Supported only with the masquerade binding.
+                                type: string
+                              promiscuous:
+                                description: |-
+                                  Promiscuous declares that the guest uses the interface as a trunk or in promiscuous mode,
+                                  sending and receiving frames of MAC addresses other than its own, as nested hypervisors and VNFs do.
+                                  The ports of the backing bridge are set in promiscuous mode to let these frames through.
+                                  Supported only with the bridge binding, in namespaces selected by the
+                                  promiscuousInterfacesNamespaceLabelSelector of the KubeVirt network configuration.
+                                type: boolean
+                              routerAdvertisement:
+                                description: |-
+                                  RouterAdvertisement enables IPv6 router advertisements to the guest, announcing the pod
+                                  as its default router and letting the guest get its IPv6 address over DHCPv6.
+                                  Supported only with the masquerade binding.
+                                type: object
                               slirp:
                                 description: |-
                                   DeprecatedSlirp is an alias to the deprecated Slirp interface
@@ -19182,6 +21268,25 @@ var CRDsValidation map[string]string = map[string]string{
                             depends on additional factors of the VirtualMachineInstance,
                             like the number of guest CPUs.
                           type: boolean
+                        networkInterfaceMultiqueuePolicy:
+                          description: |-
+                            NetworkInterfaceMultiQueuePolicy controls how many queue pairs are given to the multi-queue
+                            network interfaces, relative to the number of guest vCPUs.
+                            When not set, the cluster wide policy applies, and each vCPU gets its own queue pair.
+                          properties:
+                            maxQueues:
+                              description: |-
+                                MaxQueues caps the number of queue pairs of each interface.
+                                Defaults to, and cannot exceed, 256.
+                              format: int32
+                              type: integer
+                            vcpusPerQueue:
+                              description: |-
+                                VCPUsPerQueue is the number of guest vCPUs sharing a single queue pair.
+                                For example, 2 gives one queue pair for every two vCPUs. Defaults to 1.
+                              format: int32
+                              type: integer
+                          type: object
                         panicDevices:
                           description: PanicDevices provides additional crash information
                             when a guest crashes.
@@ -19214,6 +21319,21 @@ var CRDsValidation map[string]string = map[string]string{
                           required:
                           - name
                           type: object
+                        spice:
+                          description: Whether to attach a SPICE graphics device,
+                            reachable through the spice subresource.
+                          properties:
+                            audio:
+                              description: |-
+                                Audio streams the output of the VMI sound device over the SPICE connection.
+                                An ich9 sound device is emulated if none is set.
+                              type: boolean
+                            usbRedirection:
+                              description: |-
+                                USBRedirection attaches SpiceUSBRedirectionMaxNumberOf USB redirection
+                                channels, multiplexed over the SPICE connection.
+                              type: boolean
+                          type: object
                         tpm:
                           description: Whether to emulate a TPM device.
                           properties:
@@ -19244,6 +21364,24 @@ var CRDsValidation map[string]string = map[string]string{
                                 If not specified, the default is architecture-dependent (VGA for BIOS-based VMs, Bochs for EFI-based VMs on AMD64; virtio for Arm and s390x).
                               type: string
                           type: object
+                        virtioChannels:
+                          description: |-
+                            VirtioChannels lists the named virtio-serial channels exposed to the guest, alongside the serial console.
+                            Each channel is reachable through its own websocket subresource, enabling out-of-band management agents.
+                          items:
+                            description: VirtioChannel represents a virtio-serial
+                              port, exposed in the guest as /dev/virtio-ports/io.kubevirt.<name>.
+                            properties:
+                              name:
+                                description: |-
+                                  Name of the channel, used to reach it through the channel subresource.
+                                  Must be a DNS-1123 label and unique within the VMI.
+                                type: string
+                            required:
+                            - name
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
                         watchdog:
                           description: Watchdog describes a watchdog device which
                             can be added to the vmi.
@@ -19619,19 +21757,41 @@ var CRDsValidation map[string]string = map[string]string{
                                   type: string
                                 initrdPath:
                                   description: the fully-qualified path to the ramdisk
-                                    image in the host OS
+                                    image in the host OS
+                                  type: string
+                                kernelPath:
+                                  description: The fully-qualified path to the kernel
+                                    image in the host OS
+                                  type: string
+                              required:
+                              - image
+                              type: object
+                            kernelArgs:
+                              description: Arguments to be passed to the kernel at
+                                boot time
+                              type: string
+                            volume:
+                              description: |-
+                                Volume defines the PersistentVolumeClaim or DataVolume volume that contains kernel artifacts.
+                                Container and Volume are mutually exclusive.
+                              properties:
+                                initrdPath:
+                                  description: the fully-qualified path to the ramdisk
+                                    image in the volume
                                   type: string
                                 kernelPath:
                                   description: The fully-qualified path to the kernel
-                                    image in the host OS
+                                    image in the volume
+                                  type: string
+                                name:
+                                  description: |-
+                                    Name of the volume that contains initrd / kernel files.
+                                    It must match a PersistentVolumeClaim or DataVolume volume of the VMI in filesystem mode,
+                                    which is not used by any disk or filesystem.
                                   type: string
                               required:
-                              - image
+                              - name
                               type: object
-                            kernelArgs:
-                              description: Arguments to be passed to the kernel at
-                                boot time
-                              type: string
                           type: object
                         serial:
                           description: The system-serial-number in SMBIOS
@@ -19688,6 +21848,26 @@ var CRDsValidation map[string]string = map[string]string{
                           type: object
                         snp:
                           description: AMD SEV-SNP flags defined by the SEV-SNP specifications.
+                          properties:
+                            attestation:
+                              description: |-
+                                If specified, run the attestation process for a vmi.
+                                The launch is held until the guest owner provides the ID block and its authentication information.
+                              type: object
+                            hostData:
+                              description: Base64 encoded 32 bytes provided by the
+                                guest owner, included as is in the attestation reports
+                                of the guest.
+                              type: string
+                            idAuth:
+                              description: Base64 encoded ID authentication information,
+                                holding the signature of the ID block.
+                              type: string
+                            idBlock:
+                              description: |-
+                                Base64 encoded ID block, holding the expected launch measurement of the guest as signed by the guest owner.
+                                The guest is not launched if its measurement does not match.
+                              type: string
                           type: object
                         tdx:
                           description: Intel Trust Domain Extensions (TDX).
@@ -19760,6 +21940,26 @@ var CRDsValidation map[string]string = map[string]string{
                               - Required
                               type: string
                           type: object
+                        swap:
+                          description: |-
+                            Swap allows the VMI to use the swap of the node, for memory-bursty but latency-tolerant workloads.
+                            The swap has to be enabled on the node.
+                          properties:
+                            limit:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              description: |-
+                                Limit is the maximum amount of swap used by the VMI.
+                                Defaults to no limit.
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                            policy:
+                              description: |-
+                                Policy defines how swapped out memory is stored, Swap or Zswap.
+                                Defaults to Swap.
+                              type: string
+                          type: object
                       type: object
                     rebootPolicy:
                       description: |-
@@ -19817,6 +22017,28 @@ var CRDsValidation map[string]string = map[string]string{
                     - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
                     - "External": the VirtualMachineInstance will be protected and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
                   type: string
+                guestDNS:
+                  description: |-
+                    GuestDNS overrides the DNS configuration the guest receives over DHCP, independently of the
+                    DNS policy and configuration of the virt-launcher pod.
+                    Parameters which are not specified are taken from the pod DNS configuration.
+                  properties:
+                    nameservers:
+                      description: |-
+                        Nameservers is a list of IP addresses of the DNS servers the guest should use.
+                        The DNS servers of an IP family which has none in the list are taken from the pod DNS configuration.
+                      items:
+                        type: string
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    searches:
+                      description: Searches is a list of DNS search domains the guest
+                        should use.
+                      items:
+                        type: string
+                      type: array
+                      x-kubernetes-list-type: atomic
+                  type: object
                 hostname:
                   description: |-
                     Specifies the hostname of the vmi
@@ -19853,6 +22075,32 @@ var CRDsValidation map[string]string = map[string]string{
                         Defaults to 3. Minimum value is 1.
                       format: int32
                       type: integer
+                    guestAgentExec:
+                      description: |-
+                        GuestAgentExec runs a command inside the guest through the qemu-guest-agent and
+                        interprets its exit code. No network access to the guest is required.
+                      properties:
+                        command:
+                          description: |-
+                            Command is the command line to execute inside the guest. The first element is the
+                            executable, the remaining elements are passed as arguments. The command is not run
+                            in a shell, so shell instructions like pipes are only available by calling a shell explicitly.
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        successExitCodes:
+                          description: |-
+                            SuccessExitCodes lists the exit codes of the command which are considered a successful probe.
+                            Defaults to 0.
+                          items:
+                            format: int32
+                            type: integer
+                          type: array
+                          x-kubernetes-list-type: set
+                      required:
+                      - command
+                      type: object
                     guestAgentPing:
                       description: GuestAgentPing contacts the qemu-guest-agent for
                         availability checks.
@@ -19957,6 +22205,20 @@ var CRDsValidation map[string]string = map[string]string{
                       format: int32
                       type: integer
                   type: object
+                metadataService:
+                  description: |-
+                    MetadataService exposes the instance identity, the network configuration and user provided data
+                    to the guest, over HTTP on the link-local 169.254.169.254 address of the masquerade pod network.
+                  properties:
+                    data:
+                      additionalProperties:
+                        type: string
+                      description: |-
+                        Data is user provided key/value data served to the guest.
+                        It can be extended or updated after boot through the VirtualMachineInstance annotations
+                        prefixed with metadata.kubevirt.io/, which take precedence.
+                      type: object
+                  type: object
                 networks:
                   description: List of networks that can be attached to a vm's virtual
                     interface.
@@ -19987,6 +22249,18 @@ var CRDsValidation map[string]string = map[string]string{
                           Must be a DNS_LABEL and unique within the vm.
                           More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
                         type: string
+                      native:
+                        description: |-
+                          Native represents a secondary network provided by the Kubernetes
+                          native multi-network API (PodNetwork).
+                        properties:
+                          podNetworkName:
+                            description: PodNetworkName references a cluster scoped
+                              PodNetwork object.
+                            type: string
+                        required:
+                        - podNetworkName
+                        type: object
                       pod:
                         description: Represents the stock pod network interface.
                         properties:
@@ -20051,6 +22325,32 @@ var CRDsValidation map[string]string = map[string]string{
                         Defaults to 3. Minimum value is 1.
                       format: int32
                       type: integer
+                    guestAgentExec:
+                      description: |-
+                        GuestAgentExec runs a command inside the guest through the qemu-guest-agent and
+                        interprets its exit code. No network access to the guest is required.
+                      properties:
+                        command:
+                          description: |-
+                            Command is the command line to execute inside the guest. The first element is the
+                            executable, the remaining elements are passed as arguments. The command is not run
+                            in a shell, so shell instructions like pipes are only available by calling a shell explicitly.
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        successExitCodes:
+                          description: |-
+                            SuccessExitCodes lists the exit codes of the command which are considered a successful probe.
+                            Defaults to 0.
+                          items:
+                            format: int32
+                            type: integer
+                          type: array
+                          x-kubernetes-list-type: set
+                      required:
+                      - command
+                      type: object
                     guestAgentPing:
                       description: GuestAgentPing contacts the qemu-guest-agent for
                         availability checks.
@@ -21121,6 +23421,12 @@ var CRDsValidation map[string]string = map[string]string{
           description: Optionally defines any GPU devices associated with the instancetype.
           items:
             properties:
+              attachIOMMUGroup:
+                description: |-
+                  AttachIOMMUGroup requests that all the devices sharing the IOMMU group of the
+                  allocated PCI device are passed through to the guest along with it.
+                  The VMI fails to start if any of them is in use by a host driver.
+                type: boolean
               claimName:
                 description: |-
                   ClaimName needs to be provided from the list vmi.spec.resourceClaims[].name where this
@@ -21172,6 +23478,12 @@ var CRDsValidation map[string]string = map[string]string{
           description: Optionally defines any HostDevices associated with the instancetype.
           items:
             properties:
+              attachIOMMUGroup:
+                description: |-
+                  AttachIOMMUGroup requests that all the devices sharing the IOMMU group of the
+                  allocated PCI device are passed through to the guest along with it.
+                  The VMI fails to start if any of them is in use by a host driver.
+                type: boolean
               claimName:
                 description: |-
                   ClaimName needs to be provided from the list vmi.spec.resourceClaims[].name where this
@@ -21238,6 +23550,25 @@ var CRDsValidation map[string]string = map[string]string{
               type: object
             snp:
               description: AMD SEV-SNP flags defined by the SEV-SNP specifications.
+              properties:
+                attestation:
+                  description: |-
+                    If specified, run the attestation process for a vmi.
+                    The launch is held until the guest owner provides the ID block and its authentication information.
+                  type: object
+                hostData:
+                  description: Base64 encoded 32 bytes provided by the guest owner,
+                    included as is in the attestation reports of the guest.
+                  type: string
+                idAuth:
+                  description: Base64 encoded ID authentication information, holding
+                    the signature of the ID block.
+                  type: string
+                idBlock:
+                  description: |-
+                    Base64 encoded ID block, holding the expected launch measurement of the guest as signed by the guest owner.
+                    The guest is not launched if its measurement does not match.
+                  type: string
               type: object
             tdx:
               description: Intel Trust Domain Extensions (TDX).
@@ -21694,6 +24025,17 @@ var CRDsValidation map[string]string = map[string]string{
             spec:
               description: VirtualMachineSpec contains the VirtualMachine specification.
               properties:
+                cpuAutoscaling:
+                  description: |-
+                    CPUAutoscaling opts the VirtualMachine in to the vertical scaling of its CPU sockets,
+                    based on the vCPU utilization and steal time of its running VirtualMachineInstance.
+                  properties:
+                    policy:
+                      description: |-
+                        Policy tells whether the recommended number of sockets is only reported, or also
+                        hotplugged into the running VirtualMachineInstance. Defaults to Recommend
+                      type: string
+                  type: object
                 dataVolumeTemplates:
                   description: |-
                     dataVolumeTemplates is a list of dataVolumes that the VirtualMachineInstance template can reference.
@@ -23908,9 +26250,25 @@ var CRDsValidation map[string]string = map[string]string{
                                               Defaults to false.
                                             type: boolean
                                         type: object
+                                      encryption:
+                                        description: |-
+                                          Encryption opens the disk as a LUKS encrypted volume with the passphrase
+                                          stored in a Secret. The passphrase is handed to QEMU through libvirt secrets.
+                                        properties:
+                                          secretName:
+                                            description: |-
+                                              SecretName is the name of a Secret in the namespace of the VMI.
+                                              The passphrase is read from the "passphrase" key of the Secret.
+                                            type: string
+                                        required:
+                                        - secretName
+                                        type: object
                                       errorPolicy:
-                                        description: If specified, it can change the
-                                          default error policy (stop) for the disk
+                                        description: |-
+                                          If specified, it can change the default error policy (stop) for the disk.
+                                          With stop, which is also used if not specified, and with retry, a VMI paused on I/O errors
+                                          is resumed automatically with a backoff until the storage recovers.
+                                          With pause it stays paused until it is unpaused.
                                         type: string
                                       io:
                                         description: |-
@@ -23985,6 +26343,12 @@ var CRDsValidation map[string]string = map[string]string{
                                     vmi.
                                   items:
                                     properties:
+                                      attachIOMMUGroup:
+                                        description: |-
+                                          AttachIOMMUGroup requests that all the devices sharing the IOMMU group of the
+                                          allocated PCI device are passed through to the guest along with it.
+                                          The VMI fails to start if any of them is in use by a host driver.
+                                        type: boolean
                                       claimName:
                                         description: |-
                                           ClaimName needs to be provided from the list vmi.spec.resourceClaims[].name where this
@@ -24040,6 +26404,12 @@ var CRDsValidation map[string]string = map[string]string{
                                     the vmi.
                                   items:
                                     properties:
+                                      attachIOMMUGroup:
+                                        description: |-
+                                          AttachIOMMUGroup requests that all the devices sharing the IOMMU group of the
+                                          allocated PCI device are passed through to the guest along with it.
+                                          The VMI fails to start if any of them is in use by a host driver.
+                                        type: boolean
                                       claimName:
                                         description: |-
                                           ClaimName needs to be provided from the list vmi.spec.resourceClaims[].name where this
@@ -24168,6 +26538,59 @@ var CRDsValidation map[string]string = map[string]string{
                                               66 to interface's DHCP server
                                             type: string
                                         type: object
+                                      firewall:
+                                        description: |-
+                                          Firewall defines the rules filtering the incoming traffic of the interface.
+                                          Supported only with the masquerade binding.
+                                        properties:
+                                          ingress:
+                                            description: Ingress lists the rules allowing
+                                              incoming connections.
+                                            items:
+                                              description: FirewallRule allows the
+                                                incoming connections matching all
+                                                of its fields.
+                                              properties:
+                                                from:
+                                                  description: |-
+                                                    Source CIDRs matched by the rule, for example 10.10.0.0/16 or fd00::/64.
+                                                    All sources are matched when not specified.
+                                                  items:
+                                                    type: string
+                                                  type: array
+                                                ports:
+                                                  description: |-
+                                                    Destination ports matched by the rule, supported only with the TCP and UDP protocols.
+                                                    All ports are matched when not specified.
+                                                  items:
+                                                    format: int32
+                                                    type: integer
+                                                  type: array
+                                                protocol:
+                                                  description: |-
+                                                    Protocol matched by the rule. One of TCP, UDP or ICMP.
+                                                    All protocols are matched when not specified.
+                                                  type: string
+                                              type: object
+                                            type: array
+                                        type: object
+                                      interrupts:
+                                        description: |-
+                                          Interrupts configures how the virtio device of the interface interrupts the guest, e.g. to give the
+                                          queues of a high queue count vDPA NIC enough MSI-X vectors. It applies to the interfaces generated by
+                                          network binding plugins as well. Supported only with the virtio model.
+                                        properties:
+                                          mode:
+                                            description: Mode is the interrupt mode of the device, either
+                                              MSIX or INTx. Defaults to MSIX.
+                                            type: string
+                                          vectors:
+                                            description: |-
+                                              Vectors is the number of MSI-X vectors of the device. Defaults to the hypervisor default, which is
+                                              two vectors per queue pair plus two. It may not be set with the INTx mode.
+                                            format: int32
+                                            type: integer
+                                        type: object
                                       macAddress:
                                         description: 'Interface MAC address. For example:
                                           de:ad:00:00:be:af or DE-AD-00-00-BE-AF.'
@@ -24183,6 +26606,26 @@ var CRDsValidation map[string]string = map[string]string{
                                           to a given network using netfilter rules
                                           to nat the traffic.
                                         type: object
+                                      mirror:
+                                        description: |-
+                                          Mirror copies the traffic of the interface to a monitoring network, where an IDS or
+                                          monitoring appliance (VM or pod) inspects it.
+                                          Supported only with the bridge and masquerade bindings.
+                                        properties:
+                                          direction:
+                                            description: |-
+                                              Direction of the mirrored traffic: ingress for the traffic received by the guest,
+                                              egress for the traffic sent by the guest, or both. Defaults to both.
+                                            type: string
+                                          networkName:
+                                            description: |-
+                                              NetworkName is the name of the multus NetworkAttachmentDefinition the mirrored traffic is sent to,
+                                              in the form <namespace>/<name> or <name> for the VMI namespace.
+                                              The virt-launcher pod is attached to it through an additional interface.
+                                            type: string
+                                        required:
+                                        - networkName
+                                        type: object
                                       model:
                                         description: |-
                                           Interface model.
@@ -24194,6 +26637,48 @@ var CRDsValidation map[string]string = map[string]string{
                                           Logical name of the interface as well as a reference to the associated networks.
                                           Must match the Name of a Network.
                                         type: string
+                                      networkEmulation:
+                                        description: |-
+                                          NetworkEmulation degrades the traffic sent to the guest through the interface, adding delay,
+                                          jitter and packet loss for chaos testing of the guest workloads.
+                                          Supported only with the bridge and masquerade bindings.
+                                        properties:
+                                          delay:
+                                            description: Delay added to every packet
+                                              sent to the guest.
+                                            type: string
+                                          jitter:
+                                            description: Jitter is the random variation
+                                              of the delay, requires a delay.
+                                            type: string
+                                          lossPercentage:
+                                            description: |-
+                                              LossPercentage is the percentage of the packets sent to the guest which are dropped, between 0 and 100.
+                                              For example: "0.5".
+                                            type: string
+                                        type: object
+                                      offloads:
+                                        description: |-
+                                          Offloads toggles the offloads the host applies to the traffic of the interface.
+                                          Supported only with the virtio model. Offloads which are not specified keep the hypervisor defaults.
+                                        properties:
+                                          csum:
+                                            description: Checksum toggles the checksum
+                                              offload.
+                                            type: boolean
+                                          mrgRxbuf:
+                                            description: MergeableRxBuffers toggles
+                                              the mergeable receive buffers.
+                                            type: boolean
+                                          tso:
+                                            description: TSO toggles the TCP segmentation
+                                              offload, for both IPv4 and IPv6.
+                                            type: boolean
+                                          ufo:
+                                            description: UFO toggles the UDP fragmentation
+                                              offload.
+                                            type: boolean
+                                        type: object
                                       passt:
                                         description: |-
                                           DeprecatedPasst is an alias to the deprecated Passt interface,
@@ -24241,6 +26726,27 @@ var CRDsValidation map[string]string = map[string]string{
                                           - port
                                           type: object
                                         type: array
+                                      portsEnforcement:
+                                        description: |-
+                                          PortsEnforcement defines how the listed ports are exposed.
+                                          With Strict, only the listed ports are forwarded to the guest, any other incoming traffic
+                                          is dropped and the list of ports can be updated while the VM is running.
+                                          Supported only with the masquerade binding.
+                                        type: string
+                                      promiscuous:
+                                        description: |-
+                                          Promiscuous declares that the guest uses the interface as a trunk or in promiscuous mode,
+                                          sending and receiving frames of MAC addresses other than its own, as nested hypervisors and VNFs do.
+                                          The ports of the backing bridge are set in promiscuous mode to let these frames through.
+                                          Supported only with the bridge binding, in namespaces selected by the
+                                          promiscuousInterfacesNamespaceLabelSelector of the KubeVirt network configuration.
+                                        type: boolean
+                                      routerAdvertisement:
+                                        description: |-
+                                          RouterAdvertisement enables IPv6 router advertisements to the guest, announcing the pod
+                                          as its default router and letting the guest get its IPv6 address over DHCPv6.
+                                          Supported only with the masquerade binding.
+                                        type: object
                                       slirp:
                                         description: |-
                                           DeprecatedSlirp is an alias to the deprecated Slirp interface
@@ -24285,6 +26791,25 @@ var CRDsValidation map[string]string = map[string]string{
                                     factors of the VirtualMachineInstance, like the
                                     number of guest CPUs.
                                   type: boolean
+                                networkInterfaceMultiqueuePolicy:
+                                  description: |-
+                                    NetworkInterfaceMultiQueuePolicy controls how many queue pairs are given to the multi-queue
+                                    network interfaces, relative to the number of guest vCPUs.
+                                    When not set, the cluster wide policy applies, and each vCPU gets its own queue pair.
+                                  properties:
+                                    maxQueues:
+                                      description: |-
+                                        MaxQueues caps the number of queue pairs of each interface.
+                                        Defaults to, and cannot exceed, 256.
+                                      format: int32
+                                      type: integer
+                                    vcpusPerQueue:
+                                      description: |-
+                                        VCPUsPerQueue is the number of guest vCPUs sharing a single queue pair.
+                                        For example, 2 gives one queue pair for every two vCPUs. Defaults to 1.
+                                      format: int32
+                                      type: integer
+                                  type: object
                                 panicDevices:
                                   description: PanicDevices provides additional crash
                                     information when a guest crashes.
@@ -24318,6 +26843,21 @@ var CRDsValidation map[string]string = map[string]string{
                                   required:
                                   - name
                                   type: object
+                                spice:
+                                  description: Whether to attach a SPICE graphics
+                                    device, reachable through the spice subresource.
+                                  properties:
+                                    audio:
+                                      description: |-
+                                        Audio streams the output of the VMI sound device over the SPICE connection.
+                                        An ich9 sound device is emulated if none is set.
+                                      type: boolean
+                                    usbRedirection:
+                                      description: |-
+                                        USBRedirection attaches SpiceUSBRedirectionMaxNumberOf USB redirection
+                                        channels, multiplexed over the SPICE connection.
+                                      type: boolean
+                                  type: object
                                 tpm:
                                   description: Whether to emulate a TPM device.
                                   properties:
@@ -24348,6 +26888,24 @@ var CRDsValidation map[string]string = map[string]string{
                                         If not specified, the default is architecture-dependent (VGA for BIOS-based VMs, Bochs for EFI-based VMs on AMD64; virtio for Arm and s390x).
                                       type: string
                                   type: object
+                                virtioChannels:
+                                  description: |-
+                                    VirtioChannels lists the named virtio-serial channels exposed to the guest, alongside the serial console.
+                                    Each channel is reachable through its own websocket subresource, enabling out-of-band management agents.
+                                  items:
+                                    description: VirtioChannel represents a virtio-serial
+                                      port, exposed in the guest as /dev/virtio-ports/io.kubevirt.<name>.
+                                    properties:
+                                      name:
+                                        description: |-
+                                          Name of the channel, used to reach it through the channel subresource.
+                                          Must be a DNS-1123 label and unique within the VMI.
+                                        type: string
+                                    required:
+                                    - name
+                                    type: object
+                                  type: array
+                                  x-kubernetes-list-type: atomic
                                 watchdog:
                                   description: Watchdog describes a watchdog device
                                     which can be added to the vmi.
@@ -24740,6 +27298,28 @@ var CRDsValidation map[string]string = map[string]string{
                                       description: Arguments to be passed to the kernel
                                         at boot time
                                       type: string
+                                    volume:
+                                      description: |-
+                                        Volume defines the PersistentVolumeClaim or DataVolume volume that contains kernel artifacts.
+                                        Container and Volume are mutually exclusive.
+                                      properties:
+                                        initrdPath:
+                                          description: the fully-qualified path to
+                                            the ramdisk image in the volume
+                                          type: string
+                                        kernelPath:
+                                          description: The fully-qualified path to
+                                            the kernel image in the volume
+                                          type: string
+                                        name:
+                                          description: |-
+                                            Name of the volume that contains initrd / kernel files.
+                                            It must match a PersistentVolumeClaim or DataVolume volume of the VMI in filesystem mode,
+                                            which is not used by any disk or filesystem.
+                                          type: string
+                                      required:
+                                      - name
+                                      type: object
                                   type: object
                                 serial:
                                   description: The system-serial-number in SMBIOS
@@ -24799,6 +27379,27 @@ var CRDsValidation map[string]string = map[string]string{
                                 snp:
                                   description: AMD SEV-SNP flags defined by the SEV-SNP
                                     specifications.
+                                  properties:
+                                    attestation:
+                                      description: |-
+                                        If specified, run the attestation process for a vmi.
+                                        The launch is held until the guest owner provides the ID block and its authentication information.
+                                      type: object
+                                    hostData:
+                                      description: Base64 encoded 32 bytes provided
+                                        by the guest owner, included as is in the
+                                        attestation reports of the guest.
+                                      type: string
+                                    idAuth:
+                                      description: Base64 encoded ID authentication
+                                        information, holding the signature of the
+                                        ID block.
+                                      type: string
+                                    idBlock:
+                                      description: |-
+                                        Base64 encoded ID block, holding the expected launch measurement of the guest as signed by the guest owner.
+                                        The guest is not launched if its measurement does not match.
+                                      type: string
                                   type: object
                                 tdx:
                                   description: Intel Trust Domain Extensions (TDX).
@@ -24874,6 +27475,26 @@ var CRDsValidation map[string]string = map[string]string{
                                       - Required
                                       type: string
                                   type: object
+                                swap:
+                                  description: |-
+                                    Swap allows the VMI to use the swap of the node, for memory-bursty but latency-tolerant workloads.
+                                    The swap has to be enabled on the node.
+                                  properties:
+                                    limit:
+                                      anyOf:
+                                      - type: integer
+                                      - type: string
+                                      description: |-
+                                        Limit is the maximum amount of swap used by the VMI.
+                                        Defaults to no limit.
+                                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                      x-kubernetes-int-or-string: true
+                                    policy:
+                                      description: |-
+                                        Policy defines how swapped out memory is stored, Swap or Zswap.
+                                        Defaults to Swap.
+                                      type: string
+                                  type: object
                               type: object
                             rebootPolicy:
                               description: |-
@@ -24931,6 +27552,28 @@ var CRDsValidation map[string]string = map[string]string{
                             - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
                             - "External": the VirtualMachineInstance will be protected and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
                           type: string
+                        guestDNS:
+                          description: |-
+                            GuestDNS overrides the DNS configuration the guest receives over DHCP, independently of the
+                            DNS policy and configuration of the virt-launcher pod.
+                            Parameters which are not specified are taken from the pod DNS configuration.
+                          properties:
+                            nameservers:
+                              description: |-
+                                Nameservers is a list of IP addresses of the DNS servers the guest should use.
+                                The DNS servers of an IP family which has none in the list are taken from the pod DNS configuration.
+                              items:
+                                type: string
+                              type: array
+                              x-kubernetes-list-type: atomic
+                            searches:
+                              description: Searches is a list of DNS search domains
+                                the guest should use.
+                              items:
+                                type: string
+                              type: array
+                              x-kubernetes-list-type: atomic
+                          type: object
                         hostname:
                           description: |-
                             Specifies the hostname of the vmi
@@ -24967,6 +27610,32 @@ var CRDsValidation map[string]string = map[string]string{
                                 Defaults to 3. Minimum value is 1.
                               format: int32
                               type: integer
+                            guestAgentExec:
+                              description: |-
+                                GuestAgentExec runs a command inside the guest through the qemu-guest-agent and
+                                interprets its exit code. No network access to the guest is required.
+                              properties:
+                                command:
+                                  description: |-
+                                    Command is the command line to execute inside the guest. The first element is the
+                                    executable, the remaining elements are passed as arguments. The command is not run
+                                    in a shell, so shell instructions like pipes are only available by calling a shell explicitly.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                successExitCodes:
+                                  description: |-
+                                    SuccessExitCodes lists the exit codes of the command which are considered a successful probe.
+                                    Defaults to 0.
+                                  items:
+                                    format: int32
+                                    type: integer
+                                  type: array
+                                  x-kubernetes-list-type: set
+                              required:
+                              - command
+                              type: object
                             guestAgentPing:
                               description: GuestAgentPing contacts the qemu-guest-agent
                                 for availability checks.
@@ -25071,6 +27740,20 @@ var CRDsValidation map[string]string = map[string]string{
                               format: int32
                               type: integer
                           type: object
+                        metadataService:
+                          description: |-
+                            MetadataService exposes the instance identity, the network configuration and user provided data
+                            to the guest, over HTTP on the link-local 169.254.169.254 address of the masquerade pod network.
+                          properties:
+                            data:
+                              additionalProperties:
+                                type: string
+                              description: |-
+                                Data is user provided key/value data served to the guest.
+                                It can be extended or updated after boot through the VirtualMachineInstance annotations
+                                prefixed with metadata.kubevirt.io/, which take precedence.
+                              type: object
+                          type: object
                         networks:
                           description: List of networks that can be attached to a
                             vm's virtual interface.
@@ -25101,6 +27784,18 @@ var CRDsValidation map[string]string = map[string]string{
                                   Must be a DNS_LABEL and unique within the vm.
                                   More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
                                 type: string
+                              native:
+                                description: |-
+                                  Native represents a secondary network provided by the Kubernetes
+                                  native multi-network API (PodNetwork).
+                                properties:
+                                  podNetworkName:
+                                    description: PodNetworkName references a cluster
+                                      scoped PodNetwork object.
+                                    type: string
+                                required:
+                                - podNetworkName
+                                type: object
                               pod:
                                 description: Represents the stock pod network interface.
                                 properties:
@@ -25165,6 +27860,32 @@ var CRDsValidation map[string]string = map[string]string{
                                 Defaults to 3. Minimum value is 1.
                               format: int32
                               type: integer
+                            guestAgentExec:
+                              description: |-
+                                GuestAgentExec runs a command inside the guest through the qemu-guest-agent and
+                                interprets its exit code. No network access to the guest is required.
+                              properties:
+                                command:
+                                  description: |-
+                                    Command is the command line to execute inside the guest. The first element is the
+                                    executable, the remaining elements are passed as arguments. The command is not run
+                                    in a shell, so shell instructions like pipes are only available by calling a shell explicitly.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                successExitCodes:
+                                  description: |-
+                                    SuccessExitCodes lists the exit codes of the command which are considered a successful probe.
+                                    Defaults to 0.
+                                  items:
+                                    format: int32
+                                    type: integer
+                                  type: array
+                                  x-kubernetes-list-type: set
+                              required:
+                              - command
+                              type: object
                             guestAgentPing:
                               description: GuestAgentPing contacts the qemu-guest-agent
                                 for availability checks.
@@ -27230,6 +29951,17 @@ var CRDsValidation map[string]string = map[string]string{
                 spec:
                   description: VirtualMachineSpec contains the VirtualMachine specification.
                   properties:
+                    cpuAutoscaling:
+                      description: |-
+                        CPUAutoscaling opts the VirtualMachine in to the vertical scaling of its CPU sockets,
+                        based on the vCPU utilization and steal time of its running VirtualMachineInstance.
+                      properties:
+                        policy:
+                          description: |-
+                            Policy tells whether the recommended number of sockets is only reported, or also
+                            hotplugged into the running VirtualMachineInstance. Defaults to Recommend
+                          type: string
+                      type: object
                     dataVolumeTemplates:
                       description: |-
                         dataVolumeTemplates is a list of dataVolumes that the VirtualMachineInstance template can reference.
@@ -29472,10 +32204,25 @@ var CRDsValidation map[string]string = map[string]string{
                                                   Defaults to false.
                                                 type: boolean
                                             type: object
+                                          encryption:
+                                            description: |-
+                                              Encryption opens the disk as a LUKS encrypted volume with the passphrase
+                                              stored in a Secret. The passphrase is handed to QEMU through libvirt secrets.
+                                            properties:
+                                              secretName:
+                                                description: |-
+                                                  SecretName is the name of a Secret in the namespace of the VMI.
+                                                  The passphrase is read from the "passphrase" key of the Secret.
+                                                type: string
+                                            required:
+                                            - secretName
+                                            type: object
                                           errorPolicy:
-                                            description: If specified, it can change
-                                              the default error policy (stop) for
-                                              the disk
+                                            description: |-
+                                              If specified, it can change the default error policy (stop) for the disk.
+                                              With stop, which is also used if not specified, and with retry, a VMI paused on I/O errors
+                                              is resumed automatically with a backoff until the storage recovers.
+                                              With pause it stays paused until it is unpaused.
                                             type: string
                                           io:
                                             description: |-
@@ -29553,6 +32300,12 @@ var CRDsValidation map[string]string = map[string]string{
                                         to the vmi.
                                       items:
                                         properties:
+                                          attachIOMMUGroup:
+                                            description: |-
+                                              AttachIOMMUGroup requests that all the devices sharing the IOMMU group of the
+                                              allocated PCI device are passed through to the guest along with it.
+                                              The VMI fails to start if any of them is in use by a host driver.
+                                            type: boolean
                                           claimName:
                                             description: |-
                                               ClaimName needs to be provided from the list vmi.spec.resourceClaims[].name where this
@@ -29609,6 +32362,12 @@ var CRDsValidation map[string]string = map[string]string{
                                         to the vmi.
                                       items:
                                         properties:
+                                          attachIOMMUGroup:
+                                            description: |-
+                                              AttachIOMMUGroup requests that all the devices sharing the IOMMU group of the
+                                              allocated PCI device are passed through to the guest along with it.
+                                              The VMI fails to start if any of them is in use by a host driver.
+                                            type: boolean
                                           claimName:
                                             description: |-
                                               ClaimName needs to be provided from the list vmi.spec.resourceClaims[].name where this
@@ -29738,6 +32497,59 @@ var CRDsValidation map[string]string = map[string]string{
                                                   option 66 to interface's DHCP server
                                                 type: string
                                             type: object
+                                          firewall:
+                                            description: |-
+                                              Firewall defines the rules filtering the incoming traffic of the interface.
+                                              Supported only with the masquerade binding.
+                                            properties:
+                                              ingress:
+                                                description: Ingress lists the rules
+                                                  allowing incoming connections.
+                                                items:
+                                                  description: FirewallRule allows
+                                                    the incoming connections matching
+                                                    all of its fields.
+                                                  properties:
+                                                    from:
+                                                      description: |-
+                                                        Source CIDRs matched by the rule, for example 10.10.0.0/16 or fd00::/64.
+                                                        All sources are matched when not specified.
+                                                      items:
+                                                        type: string
+                                                      type: array
+                                                    ports:
+                                                      description: |-
+                                                        Destination ports matched by the rule, supported only with the TCP and UDP protocols.
+                                                        All ports are matched when not specified.
+                                                      items:
+                                                        format: int32
+                                                        type: integer
+                                                      type: array
+                                                    protocol:
+                                                      description: |-
+                                                        Protocol matched by the rule. One of TCP, UDP or ICMP.
+                                                        All protocols are matched when not specified.
+                                                      type: string
+                                                  type: object
+                                                type: array
+                                            type: object
+                                          interrupts:
+                                            description: |-
+                                              Interrupts configures how the virtio device of the interface interrupts the guest, e.g. to give the
+                                              queues of a high queue count vDPA NIC enough MSI-X vectors. It applies to the interfaces generated by
+                                              network binding plugins as well. Supported only with the virtio model.
+                                            properties:
+                                              mode:
+                                                description: Mode is the interrupt mode of the device, either
+                                                  MSIX or INTx. Defaults to MSIX.
+                                                type: string
+                                              vectors:
+                                                description: |-
+                                                  Vectors is the number of MSI-X vectors of the device. Defaults to the hypervisor default, which is
+                                                  two vectors per queue pair plus two. It may not be set with the INTx mode.
+                                                format: int32
+                                                type: integer
+                                            type: object
                                           macAddress:
                                             description: 'Interface MAC address. For
                                               example: de:ad:00:00:be:af or DE-AD-00-00-BE-AF.'
@@ -29753,6 +32565,26 @@ var CRDsValidation map[string]string = map[string]string{
                                               to a given network using netfilter rules
                                               to nat the traffic.
                                             type: object
+                                          mirror:
+                                            description: |-
+                                              Mirror copies the traffic of the interface to a monitoring network, where an IDS or
+                                              monitoring appliance (VM or pod) inspects it.
+                                              Supported only with the bridge and masquerade bindings.
+                                            properties:
+                                              direction:
+                                                description: |-
+                                                  Direction of the mirrored traffic: ingress for the traffic received by the guest,
+                                                  egress for the traffic sent by the guest, or both. Defaults to both.
+                                                type: string
+                                              networkName:
+                                                description: |-
+                                                  NetworkName is the name of the multus NetworkAttachmentDefinition the mirrored traffic is sent to,
+                                                  in the form <namespace>/<name> or <name> for the VMI namespace.
+                                                  The virt-launcher pod is attached to it through an additional interface.
+                                                type: string
+                                            required:
+                                            - networkName
+                                            type: object
                                           model:
                                             description: |-
                                               Interface model.
@@ -29764,6 +32596,49 @@ var CRDsValidation map[string]string = map[string]string{
                                               Logical name of the interface as well as a reference to the associated networks.
                                               Must match the Name of a Network.
                                             type: string
+                                          networkEmulation:
+                                            description: |-
+                                              NetworkEmulation degrades the traffic sent to the guest through the interface, adding delay,
+                                              jitter and packet loss for chaos testing of the guest workloads.
+                                              Supported only with the bridge and masquerade bindings.
+                                            properties:
+                                              delay:
+                                                description: Delay added to every
+                                                  packet sent to the guest.
+                                                type: string
+                                              jitter:
+                                                description: Jitter is the random
+                                                  variation of the delay, requires
+                                                  a delay.
+                                                type: string
+                                              lossPercentage:
+                                                description: |-
+                                                  LossPercentage is the percentage of the packets sent to the guest which are dropped, between 0 and 100.
+                                                  For example: "0.5".
+                                                type: string
+                                            type: object
+                                          offloads:
+                                            description: |-
+                                              Offloads toggles the offloads the host applies to the traffic of the interface.
+                                              Supported only with the virtio model. Offloads which are not specified keep the hypervisor defaults.
+                                            properties:
+                                              csum:
+                                                description: Checksum toggles the
+                                                  checksum offload.
+                                                type: boolean
+                                              mrgRxbuf:
+                                                description: MergeableRxBuffers toggles
+                                                  the mergeable receive buffers.
+                                                type: boolean
+                                              tso:
+                                                description: TSO toggles the TCP segmentation
+                                                  offload, for both IPv4 and IPv6.
+                                                type: boolean
+                                              ufo:
+                                                description: UFO toggles the UDP fragmentation
+                                                  offload.
+                                                type: boolean
+                                            type: object
                                           passt:
                                             description: |-
                                               DeprecatedPasst is an alias to the deprecated Passt interface,
@@ -29811,6 +32686,27 @@ var CRDsValidation map[string]string = map[string]string{
                                               - port
                                               type: object
                                             type: array
+                                          portsEnforcement:
+                                            description: |-
+                                              PortsEnforcement defines how the listed ports are exposed.
+                                              With Strict, only the listed ports are forwarded to the guest, any other incoming traffic
+                                              is dropped and the list of ports can be updated while the VM is running.
+                                              Supported only with the masquerade binding.
+                                            type: string
+                                          promiscuous:
+                                            description: |-
+                                              Promiscuous declares that the guest uses the interface as a trunk or in promiscuous mode,
+                                              sending and receiving frames of MAC addresses other than its own, as nested hypervisors and VNFs do.
+                                              The ports of the backing bridge are set in promiscuous mode to let these frames through.
+                                              Supported only with the bridge binding, in namespaces selected by the
+                                              promiscuousInterfacesNamespaceLabelSelector of the KubeVirt network configuration.
+                                            type: boolean
+                                          routerAdvertisement:
+                                            description: |-
+                                              RouterAdvertisement enables IPv6 router advertisements to the guest, announcing the pod
+                                              as its default router and letting the guest get its IPv6 address over DHCPv6.
+                                              Supported only with the masquerade binding.
+                                            type: object
                                           slirp:
                                             description: |-
                                               DeprecatedSlirp is an alias to the deprecated Slirp interface
@@ -29856,6 +32752,25 @@ var CRDsValidation map[string]string = map[string]string{
                                         factors of the VirtualMachineInstance, like
                                         the number of guest CPUs.
                                       type: boolean
+                                    networkInterfaceMultiqueuePolicy:
+                                      description: |-
+                                        NetworkInterfaceMultiQueuePolicy controls how many queue pairs are given to the multi-queue
+                                        network interfaces, relative to the number of guest vCPUs.
+                                        When not set, the cluster wide policy applies, and each vCPU gets its own queue pair.
+                                      properties:
+                                        maxQueues:
+                                          description: |-
+                                            MaxQueues caps the number of queue pairs of each interface.
+                                            Defaults to, and cannot exceed, 256.
+                                          format: int32
+                                          type: integer
+                                        vcpusPerQueue:
+                                          description: |-
+                                            VCPUsPerQueue is the number of guest vCPUs sharing a single queue pair.
+                                            For example, 2 gives one queue pair for every two vCPUs. Defaults to 1.
+                                          format: int32
+                                          type: integer
+                                      type: object
                                     panicDevices:
                                       description: PanicDevices provides additional
                                         crash information when a guest crashes.
@@ -29889,6 +32804,21 @@ var CRDsValidation map[string]string = map[string]string{
                                       required:
                                       - name
                                       type: object
+                                    spice:
+                                      description: Whether to attach a SPICE graphics
+                                        device, reachable through the spice subresource.
+                                      properties:
+                                        audio:
+                                          description: |-
+                                            Audio streams the output of the VMI sound device over the SPICE connection.
+                                            An ich9 sound device is emulated if none is set.
+                                          type: boolean
+                                        usbRedirection:
+                                          description: |-
+                                            USBRedirection attaches SpiceUSBRedirectionMaxNumberOf USB redirection
+                                            channels, multiplexed over the SPICE connection.
+                                          type: boolean
+                                      type: object
                                     tpm:
                                       description: Whether to emulate a TPM device.
                                       properties:
@@ -29919,6 +32849,24 @@ var CRDsValidation map[string]string = map[string]string{
                                             If not specified, the default is architecture-dependent (VGA for BIOS-based VMs, Bochs for EFI-based VMs on AMD64; virtio for Arm and s390x).
                                           type: string
                                       type: object
+                                    virtioChannels:
+                                      description: |-
+                                        VirtioChannels lists the named virtio-serial channels exposed to the guest, alongside the serial console.
+                                        Each channel is reachable through its own websocket subresource, enabling out-of-band management agents.
+                                      items:
+                                        description: VirtioChannel represents a virtio-serial
+                                          port, exposed in the guest as /dev/virtio-ports/io.kubevirt.<name>.
+                                        properties:
+                                          name:
+                                            description: |-
+                                              Name of the channel, used to reach it through the channel subresource.
+                                              Must be a DNS-1123 label and unique within the VMI.
+                                            type: string
+                                        required:
+                                        - name
+                                        type: object
+                                      type: array
+                                      x-kubernetes-list-type: atomic
                                     watchdog:
                                       description: Watchdog describes a watchdog device
                                         which can be added to the vmi.
@@ -30313,6 +33261,28 @@ var CRDsValidation map[string]string = map[string]string{
                                           description: Arguments to be passed to the
                                             kernel at boot time
                                           type: string
+                                        volume:
+                                          description: |-
+                                            Volume defines the PersistentVolumeClaim or DataVolume volume that contains kernel artifacts.
+                                            Container and Volume are mutually exclusive.
+                                          properties:
+                                            initrdPath:
+                                              description: the fully-qualified path
+                                                to the ramdisk image in the volume
+                                              type: string
+                                            kernelPath:
+                                              description: The fully-qualified path
+                                                to the kernel image in the volume
+                                              type: string
+                                            name:
+                                              description: |-
+                                                Name of the volume that contains initrd / kernel files.
+                                                It must match a PersistentVolumeClaim or DataVolume volume of the VMI in filesystem mode,
+                                                which is not used by any disk or filesystem.
+                                              type: string
+                                          required:
+                                          - name
+                                          type: object
                                       type: object
                                     serial:
                                       description: The system-serial-number in SMBIOS
@@ -30372,6 +33342,27 @@ var CRDsValidation map[string]string = map[string]string{
                                     snp:
                                       description: AMD SEV-SNP flags defined by the
                                         SEV-SNP specifications.
+                                      properties:
+                                        attestation:
+                                          description: |-
+                                            If specified, run the attestation process for a vmi.
+                                            The launch is held until the guest owner provides the ID block and its authentication information.
+                                          type: object
+                                        hostData:
+                                          description: Base64 encoded 32 bytes provided
+                                            by the guest owner, included as is in
+                                            the attestation reports of the guest.
+                                          type: string
+                                        idAuth:
+                                          description: Base64 encoded ID authentication
+                                            information, holding the signature of
+                                            the ID block.
+                                          type: string
+                                        idBlock:
+                                          description: |-
+                                            Base64 encoded ID block, holding the expected launch measurement of the guest as signed by the guest owner.
+                                            The guest is not launched if its measurement does not match.
+                                          type: string
                                       type: object
                                     tdx:
                                       description: Intel Trust Domain Extensions (TDX).
@@ -30447,6 +33438,26 @@ var CRDsValidation map[string]string = map[string]string{
                                           - Required
                                           type: string
                                       type: object
+                                    swap:
+                                      description: |-
+                                        Swap allows the VMI to use the swap of the node, for memory-bursty but latency-tolerant workloads.
+                                        The swap has to be enabled on the node.
+                                      properties:
+                                        limit:
+                                          anyOf:
+                                          - type: integer
+                                          - type: string
+                                          description: |-
+                                            Limit is the maximum amount of swap used by the VMI.
+                                            Defaults to no limit.
+                                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                          x-kubernetes-int-or-string: true
+                                        policy:
+                                          description: |-
+                                            Policy defines how swapped out memory is stored, Swap or Zswap.
+                                            Defaults to Swap.
+                                          type: string
+                                      type: object
                                   type: object
                                 rebootPolicy:
                                   description: |-
@@ -30504,6 +33515,28 @@ var CRDsValidation map[string]string = map[string]string{
                                 - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
                                 - "External": the VirtualMachineInstance will be protected and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
                               type: string
+                            guestDNS:
+                              description: |-
+                                GuestDNS overrides the DNS configuration the guest receives over DHCP, independently of the
+                                DNS policy and configuration of the virt-launcher pod.
+                                Parameters which are not specified are taken from the pod DNS configuration.
+                              properties:
+                                nameservers:
+                                  description: |-
+                                    Nameservers is a list of IP addresses of the DNS servers the guest should use.
+                                    The DNS servers of an IP family which has none in the list are taken from the pod DNS configuration.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                searches:
+                                  description: Searches is a list of DNS search domains
+                                    the guest should use.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              type: object
                             hostname:
                               description: |-
                                 Specifies the hostname of the vmi
@@ -30540,6 +33573,32 @@ var CRDsValidation map[string]string = map[string]string{
                                     Defaults to 3. Minimum value is 1.
                                   format: int32
                                   type: integer
+                                guestAgentExec:
+                                  description: |-
+                                    GuestAgentExec runs a command inside the guest through the qemu-guest-agent and
+                                    interprets its exit code. No network access to the guest is required.
+                                  properties:
+                                    command:
+                                      description: |-
+                                        Command is the command line to execute inside the guest. The first element is the
+                                        executable, the remaining elements are passed as arguments. The command is not run
+                                        in a shell, so shell instructions like pipes are only available by calling a shell explicitly.
+                                      items:
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                    successExitCodes:
+                                      description: |-
+                                        SuccessExitCodes lists the exit codes of the command which are considered a successful probe.
+                                        Defaults to 0.
+                                      items:
+                                        format: int32
+                                        type: integer
+                                      type: array
+                                      x-kubernetes-list-type: set
+                                  required:
+                                  - command
+                                  type: object
                                 guestAgentPing:
                                   description: GuestAgentPing contacts the qemu-guest-agent
                                     for availability checks.
@@ -30645,6 +33704,20 @@ var CRDsValidation map[string]string = map[string]string{
                                   format: int32
                                   type: integer
                               type: object
+                            metadataService:
+                              description: |-
+                                MetadataService exposes the instance identity, the network configuration and user provided data
+                                to the guest, over HTTP on the link-local 169.254.169.254 address of the masquerade pod network.
+                              properties:
+                                data:
+                                  additionalProperties:
+                                    type: string
+                                  description: |-
+                                    Data is user provided key/value data served to the guest.
+                                    It can be extended or updated after boot through the VirtualMachineInstance annotations
+                                    prefixed with metadata.kubevirt.io/, which take precedence.
+                                  type: object
+                              type: object
                             networks:
                               description: List of networks that can be attached to
                                 a vm's virtual interface.
@@ -30675,6 +33748,18 @@ var CRDsValidation map[string]string = map[string]string{
                                       Must be a DNS_LABEL and unique within the vm.
                                       More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
                                     type: string
+                                  native:
+                                    description: |-
+                                      Native represents a secondary network provided by the Kubernetes
+                                      native multi-network API (PodNetwork).
+                                    properties:
+                                      podNetworkName:
+                                        description: PodNetworkName references a cluster
+                                          scoped PodNetwork object.
+                                        type: string
+                                    required:
+                                    - podNetworkName
+                                    type: object
                                   pod:
                                     description: Represents the stock pod network
                                       interface.
@@ -30740,6 +33825,32 @@ var CRDsValidation map[string]string = map[string]string{
                                     Defaults to 3. Minimum value is 1.
                                   format: int32
                                   type: integer
+                                guestAgentExec:
+                                  description: |-
+                                    GuestAgentExec runs a command inside the guest through the qemu-guest-agent and
+                                    interprets its exit code. No network access to the guest is required.
+                                  properties:
+                                    command:
+                                      description: |-
+                                        Command is the command line to execute inside the guest. The first element is the
+                                        executable, the remaining elements are passed as arguments. The command is not run
+                                        in a shell, so shell instructions like pipes are only available by calling a shell explicitly.
+                                      items:
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                    successExitCodes:
+                                      description: |-
+                                        SuccessExitCodes lists the exit codes of the command which are considered a successful probe.
+                                        Defaults to 0.
+                                      items:
+                                        format: int32
+                                        type: integer
+                                      type: array
+                                      x-kubernetes-list-type: set
+                                  required:
+                                  - command
+                                  type: object
                                 guestAgentPing:
                                   description: GuestAgentPing contacts the qemu-guest-agent
                                     for availability checks.
@@ -31805,6 +34916,34 @@ var CRDsValidation map[string]string = map[string]string{
                         - type
                         type: object
                       type: array
+                    cpuRecommendation:
+                      description: |-
+                        CPURecommendation is the CPU sockets recommendation for the running VirtualMachineInstance,
+                        reported when the VirtualMachine opts in to CPU autoscaling.
+                      nullable: true
+                      properties:
+                        lastScaleTime:
+                          description: LastScaleTime is when CPU sockets were last
+                            hotplugged following the recommendation
+                          format: date-time
+                          nullable: true
+                          type: string
+                        overloadedSince:
+                          description: OverloadedSince is when the vCPU load last
+                            went above the thresholds, unset while it is below
+                          format: date-time
+                          nullable: true
+                          type: string
+                        reason:
+                          description: Reason explains why more sockets are recommended
+                          type: string
+                        sockets:
+                          description: Sockets is the recommended number of CPU sockets
+                          format: int32
+                          type: integer
+                      required:
+                      - sockets
+                      type: object
                     created:
                       description: Created indicates if the virtual machine is created
                         in the cluster
@@ -31818,6 +34957,58 @@ var CRDsValidation map[string]string = map[string]string{
                         updated through an Update() before ObservedGeneration in Status.
                       format: int64
                       type: integer
+                    guestDrivers:
+                      description: |-
+                        GuestDrivers are the last drivers reported by the guest agent of the VirtualMachineInstance.
+                        They are kept while the VirtualMachine is stopped.
+                      items:
+                        description: VirtualMachineInstanceGuestOSDriver describes
+                          a driver the guest uses for one of its devices
+                        properties:
+                          deviceID:
+                            description: PCI vendor and device ID of the device handled
+                              by the driver, in the vendor:device format
+                            type: string
+                          name:
+                            description: Name of the driver
+                            type: string
+                          version:
+                            description: Version of the driver
+                            type: string
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    guestOSInfo:
+                      description: |-
+                        GuestOSInfo is the last guest OS information reported by the guest agent of the VirtualMachineInstance.
+                        It is kept while the VirtualMachine is stopped.
+                      nullable: true
+                      properties:
+                        id:
+                          description: Guest OS Id
+                          type: string
+                        kernelRelease:
+                          description: Guest OS Kernel Release
+                          type: string
+                        kernelVersion:
+                          description: Kernel version of the Guest OS
+                          type: string
+                        machine:
+                          description: Machine type of the Guest OS
+                          type: string
+                        name:
+                          description: Name of the Guest OS
+                          type: string
+                        prettyName:
+                          description: Guest OS Pretty Name
+                          type: string
+                        version:
+                          description: Guest OS Version
+                          type: string
+                        versionId:
+                          description: Version ID of the Guest OS
+                          type: string
+                      type: object
                     instancetypeRef:
                       description: InstancetypeRef captures the state of any referenced
                         instance type from the VirtualMachine
@@ -32096,9 +35287,25 @@ var CRDsValidation map[string]string = map[string]string{
                                           Defaults to false.
                                         type: boolean
                                     type: object
+                                  encryption:
+                                    description: |-
+                                      Encryption opens the disk as a LUKS encrypted volume with the passphrase
+                                      stored in a Secret. The passphrase is handed to QEMU through libvirt secrets.
+                                    properties:
+                                      secretName:
+                                        description: |-
+                                          SecretName is the name of a Secret in the namespace of the VMI.
+                                          The passphrase is read from the "passphrase" key of the Secret.
+                                        type: string
+                                    required:
+                                    - secretName
+                                    type: object
                                   errorPolicy:
-                                    description: If specified, it can change the default
-                                      error policy (stop) for the disk
+                                    description: |-
+                                      If specified, it can change the default error policy (stop) for the disk.
+                                      With stop, which is also used if not specified, and with retry, a VMI paused on I/O errors
+                                      is resumed automatically with a backoff until the storage recovers.
+                                      With pause it stays paused until it is unpaused.
                                     type: string
                                   io:
                                     description: |-
@@ -89,6 +89,28 @@ func newApiServerClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "delete", "patch",
 				},
 			},
+			{
+				APIGroups: []string{
+					"",
+				},
+				Resources: []string{
+					"pods/ephemeralcontainers",
+				},
+				Verbs: []string{
+					"update",
+				},
+			},
+			{
+				APIGroups: []string{
+					"",
+				},
+				Resources: []string{
+					"events",
+				},
+				Verbs: []string{
+					"create",
+				},
+			},
 			{
 				APIGroups: []string{
 					GroupName,
@@ -72,6 +72,7 @@ const (
 	apiVMRestart        = "virtualmachines/restart"
 	apiVMAddVolume      = "virtualmachines/addvolume"
 	apiVMRemoveVolume   = "virtualmachines/removevolume"
+	apiVMChangeMedia    = "virtualmachines/changemedia"
 	apiVMMigrate        = "virtualmachines/migrate"
 	apiVMMemoryDump     = "virtualmachines/memorydump"
 	apiVMObjectGraph    = "virtualmachines/objectgraph"
@@ -80,6 +81,7 @@ const (
 	apiVMInstancesConsole                   = "virtualmachineinstances/console"
 	apiVMInstancesVNC                       = "virtualmachineinstances/vnc"
 	apiVMInstancesVNCScreenshot             = "virtualmachineinstances/vnc/screenshot"
+	apiVMInstancesMemoryDump                = "virtualmachineinstances/memorydump"
 	apiVMInstancesPortForward               = "virtualmachineinstances/portforward"
 	apiVMInstancesPause                     = "virtualmachineinstances/pause"
 	apiVMInstancesUnpause                   = "virtualmachineinstances/unpause"
@@ -88,6 +90,7 @@ const (
 	apiVMInstancesFreeze                    = "virtualmachineinstances/freeze"
 	apiVMInstancesUnfreeze                  = "virtualmachineinstances/unfreeze"
 	apiVMInstancesSoftReboot                = "virtualmachineinstances/softreboot"
+	apiVMInstancesNetworkResync             = "virtualmachineinstances/networkresync"
 	apiVMInstancesReset                     = "virtualmachineinstances/reset"
 	apiVMInstancesGuestOSInfo               = "virtualmachineinstances/guestosinfo"
 	apiVMInstancesFileSysList               = "virtualmachineinstances/filesystemlist"
@@ -95,10 +98,16 @@ const (
 	apiVMInstancesSEVFetchCertChain         = "virtualmachineinstances/sev/fetchcertchain"
 	apiVMInstancesSEVQueryLaunchMeasurement = "virtualmachineinstances/sev/querylaunchmeasurement"
 	apiVMInstancesSEVSetupSession           = "virtualmachineinstances/sev/setupsession"
+	apiVMInstancesSEVSetupSNPSession        = "virtualmachineinstances/sev/setupsnpsession"
 	apiVMInstancesSEVInjectLaunchSecret     = "virtualmachineinstances/sev/injectlaunchsecret"
 	apiVMInstancesUSBRedir                  = "virtualmachineinstances/usbredir"
+	apiVMInstancesChannel                   = "virtualmachineinstances/channel"
+	apiVMInstancesSpice                     = "virtualmachineinstances/spice"
 	apiVMInstancesObjectGraph               = "virtualmachineinstances/objectgraph"
 	apiVMInstancesEvacuateCancel            = "virtualmachineinstances/evacuate/cancel"
+	apiVMInstancesDomainJobs                = "virtualmachineinstances/domainjobs"
+	apiVMInstancesCancelDomainJob           = "virtualmachineinstances/canceldomainjob"
+	apiVMInstancesQemuCommandLine           = "virtualmachineinstances/qemucommandline"
 )
 
 func GetAllCluster() []runtime.Object {
@@ -217,8 +226,12 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
 					apiVMInstancesUSBRedir,
+					apiVMInstancesChannel,
+					apiVMInstancesSpice,
 					apiVMObjectGraph,
 					apiVMInstancesObjectGraph,
+					apiVMInstancesDomainJobs,
+					apiVMInstancesQemuCommandLine,
 				},
 				Verbs: []string{
 					"get",
@@ -236,15 +249,29 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesFreeze,
 					apiVMInstancesUnfreeze,
 					apiVMInstancesSoftReboot,
+					apiVMInstancesNetworkResync,
 					apiVMInstancesReset,
 					apiVMInstancesSEVSetupSession,
+					apiVMInstancesSEVSetupSNPSession,
 					apiVMInstancesSEVInjectLaunchSecret,
 					apiVMInstancesEvacuateCancel,
+					apiVMInstancesCancelDomainJob,
 				},
 				Verbs: []string{
 					"update",
 				},
 			},
+			{
+				APIGroups: []string{
+					virtv1.SubresourceGroupName,
+				},
+				Resources: []string{
+					apiVMInstancesMemoryDump,
+				},
+				Verbs: []string{
+					"get", "update", "delete",
+				},
+			},
 			{
 				APIGroups: []string{
 					virtv1.SubresourceGroupName,
@@ -267,6 +294,7 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMRestart,
 					apiVMAddVolume,
 					apiVMRemoveVolume,
+					apiVMChangeMedia,
 					apiVMMemoryDump,
 					apiVMEvacuateCancel,
 				},
@@ -426,8 +454,12 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
 					apiVMInstancesUSBRedir,
+					apiVMInstancesChannel,
+					apiVMInstancesSpice,
 					apiVMObjectGraph,
 					apiVMInstancesObjectGraph,
+					apiVMInstancesDomainJobs,
+					apiVMInstancesQemuCommandLine,
 				},
 				Verbs: []string{
 					"get",
@@ -445,15 +477,29 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesFreeze,
 					apiVMInstancesUnfreeze,
 					apiVMInstancesSoftReboot,
+					apiVMInstancesNetworkResync,
 					apiVMInstancesReset,
 					apiVMInstancesSEVSetupSession,
+					apiVMInstancesSEVSetupSNPSession,
 					apiVMInstancesSEVInjectLaunchSecret,
 					apiVMInstancesEvacuateCancel,
+					apiVMInstancesCancelDomainJob,
 				},
 				Verbs: []string{
 					"update",
 				},
 			},
+			{
+				APIGroups: []string{
+					virtv1.SubresourceGroupName,
+				},
+				Resources: []string{
+					apiVMInstancesMemoryDump,
+				},
+				Verbs: []string{
+					"get", "update", "delete",
+				},
+			},
 			{
 				APIGroups: []string{
 					virtv1.SubresourceGroupName,
@@ -476,6 +522,7 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMRestart,
 					apiVMAddVolume,
 					apiVMRemoveVolume,
+					apiVMChangeMedia,
 					apiVMMemoryDump,
 					apiVMEvacuateCancel,
 				},
@@ -694,6 +741,7 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesSEVQueryLaunchMeasurement,
 					apiVMObjectGraph,
 					apiVMInstancesObjectGraph,
+					apiVMInstancesDomainJobs,
 				},
 				Verbs: []string{
 					"get",
@@ -125,6 +125,17 @@ func newControllerRole(namespace string) *rbacv1.Role {
 					"get", "list", "watch", "delete", "update", "create", "patch",
 				},
 			},
+			{
+				APIGroups: []string{
+					"apps",
+				},
+				Resources: []string{
+					"daemonsets",
+				},
+				Verbs: []string{
+					"get", "create", "update", "delete",
+				},
+			},
 		},
 	}
 }
@@ -206,6 +217,17 @@ func baseControllerClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch", "delete", "update", "create", "patch",
 				},
 			},
+			{
+				APIGroups: []string{
+					"discovery.k8s.io",
+				},
+				Resources: []string{
+					"endpointslices",
+				},
+				Verbs: []string{
+					"get", "list", "watch", "delete", "update", "create",
+				},
+			},
 			{
 				APIGroups: []string{
 					"",
@@ -426,6 +448,7 @@ func baseControllerClusterRole() *rbacv1.ClusterRole {
 					"virtualmachineinstances/reset",
 					"virtualmachineinstances/softreboot",
 					"virtualmachineinstances/sev/setupsession",
+					"virtualmachineinstances/sev/setupsnpsession",
 					"virtualmachineinstances/sev/injectlaunchsecret",
 				},
 				Verbs: []string{
@@ -611,7 +634,7 @@ func newControllerClusterRole(includeNADRules bool) *rbacv1.ClusterRole {
 			Resources: []string{
 				"network-attachment-definitions",
 			},
-			Verbs: []string{"get"},
+			Verbs: []string{"get", "list", "watch"},
 		})
 	}
 	return cr
@@ -59,6 +59,28 @@ var _ = Describe("RBAC", func() {
 			Entry("for vmis", "kubevirt.io", "virtualmachineinstances"),
 		)
 
+		It("should allow managing the endpoint slices of secondary network services", func() {
+			clusterRole := getObject(forController, reflect.TypeOf(&rbacv1.ClusterRole{}), components.ControllerServiceAccountName).(*rbacv1.ClusterRole)
+			Expect(clusterRole.Rules).To(
+				ContainElement(gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+					"APIGroups": ContainElement("discovery.k8s.io"),
+					"Resources": ContainElement("endpointslices"),
+					"Verbs":     ContainElements("create", "update", "delete"),
+				})),
+			)
+		})
+
+		It("should allow managing the containerdisk pre-pull DaemonSet", func() {
+			role := getObject(forController, reflect.TypeOf(&rbacv1.Role{}), components.ControllerServiceAccountName).(*rbacv1.Role)
+			Expect(role.Rules).To(
+				ContainElement(gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+					"APIGroups": ContainElement("apps"),
+					"Resources": ContainElement("daemonsets"),
+					"Verbs":     ContainElements("get", "create", "update", "delete"),
+				})),
+			)
+		})
+
 		It("should include NAD rules when includeNADRules is true", func() {
 			clusterRole := getObject(forController, reflect.TypeOf(&rbacv1.ClusterRole{}), components.ControllerServiceAccountName).(*rbacv1.ClusterRole)
 			Expect(clusterRole.Rules).To(
@@ -606,6 +606,23 @@ func NewOperatorRole(namespace string) *rbacv1.Role {
 					"create",
 				},
 			},
+			{
+				APIGroups: []string{
+					components.CertManagerGroup,
+				},
+				Resources: []string{
+					"certificates",
+					"issuers",
+				},
+				Verbs: []string{
+					"create",
+					"get",
+					"list",
+					"watch",
+					"update",
+					"delete",
+				},
+			},
 			{
 				APIGroups: []string{
 					"coordination.k8s.io",
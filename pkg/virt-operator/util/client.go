@@ -46,8 +46,11 @@ const (
 	ConditionReasonDeploying                = "DeploymentInProgress"
 	ConditionReasonUpdating                 = "UpdateInProgress"
 	ConditionReasonDeleting                 = "DeletionInProgress"
+	ConditionReasonCertManagerUnavailable   = "CertManagerUnavailable"
 )
 
+const certManagerGroupVersion = "cert-manager.io/v1"
+
 func UpdateConditionsDeploying(kv *virtv1.KubeVirt) {
 	removeCondition(kv, virtv1.KubeVirtConditionSynchronized)
 	msg := fmt.Sprintf("Deploying version %s with registry %s",
@@ -95,6 +98,12 @@ func UpdateConditionsFailedError(kv *virtv1.KubeVirt, err error) {
 	updateCondition(kv, virtv1.KubeVirtConditionDegraded, k8sv1.ConditionTrue, ConditionReasonDeploymentFailedError, msg)
 }
 
+// UpdateConditionsCertManagerUnavailable reports the KubeVirt CA is used instead of cert-manager, whose API is missing
+func UpdateConditionsCertManagerUnavailable(kv *virtv1.KubeVirt) {
+	msg := "cert-manager is configured but its API is not installed, the certificates are signed by the KubeVirt CA"
+	updateCondition(kv, virtv1.KubeVirtConditionDegraded, k8sv1.ConditionTrue, ConditionReasonCertManagerUnavailable, msg)
+}
+
 func UpdateConditionsDeleting(kv *virtv1.KubeVirt) {
 	removeCondition(kv, virtv1.KubeVirtConditionCreated)
 	removeCondition(kv, virtv1.KubeVirtConditionSynchronized)
@@ -273,3 +282,29 @@ func IsValidatingAdmissionPolicyEnabled(clientset kubecli.KubevirtClient) (bool,
 
 	return false, nil
 }
+
+// IsCertManagerEnabled returns true if the cert-manager certificates and issuers resources are defined
+// and false otherwise.
+func IsCertManagerEnabled(clientset kubecli.KubevirtClient) (bool, error) {
+	_, apis, err := clientset.DiscoveryClient().ServerGroupsAndResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return false, err
+	}
+
+	for _, api := range apis {
+		if api.GroupVersion == certManagerGroupVersion {
+			hasCertificates, hasIssuers := false, false
+			for _, resource := range api.APIResources {
+				switch resource.Name {
+				case "certificates":
+					hasCertificates = true
+				case "issuers":
+					hasIssuers = true
+				}
+			}
+			return hasCertificates && hasIssuers, nil
+		}
+	}
+
+	return false, nil
+}
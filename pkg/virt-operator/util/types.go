@@ -33,6 +33,7 @@ type OperatorConfig struct {
 	PrometheusRulesEnabled                  bool
 	ValidatingAdmissionPolicyBindingEnabled bool
 	ValidatingAdmissionPolicyEnabled        bool
+	CertManagerEnabled                      bool
 }
 
 type Stores struct {
@@ -21,8 +21,10 @@ package webhooks
 
 import (
 	"context"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"slices"
 	"strconv"
 
@@ -44,6 +46,8 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 
+	"kubevirt.io/kubevirt/pkg/network/macallocator"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	validating_webhooks "kubevirt.io/kubevirt/pkg/util/webhooks/validating-webhooks"
@@ -82,6 +86,10 @@ func (admitter *KubeVirtUpdateAdmitter) Admit(ctx context.Context, ar *admission
 	results = append(results, validateGuestToRequestHeadroom(newKV.Spec.Configuration.AdditionalGuestMemoryOverheadRatio)...)
 	results = append(results, validateVirtTemplateDeployment(&newKV.Spec.Configuration)...)
 	results = append(results, validateRoleAggregationStrategy(&newKV.Spec.Configuration)...)
+	results = append(results, validateMacGenerationPolicy(newKV.Spec.Configuration.NetworkConfiguration)...)
+	results = append(results, validateNetworkBindingDryRunValidation(newKV.Spec.Configuration.NetworkConfiguration)...)
+	results = append(results, validateNetworkInterfaceMultiQueuePolicy(newKV.Spec.Configuration.NetworkConfiguration)...)
+	results = append(results, validateConsoleRecording(newKV.Spec.Configuration.ConsoleRecording)...)
 
 	if !equality.Semantic.DeepEqual(currKV.Spec.Configuration.TLSConfiguration, newKV.Spec.Configuration.TLSConfiguration) {
 		if newKV.Spec.Configuration.TLSConfiguration != nil {
@@ -563,3 +571,147 @@ func validateRoleAggregationStrategy(config *v1.KubeVirtConfiguration) []metav1.
 		Message: fmt.Sprintf("RoleAggregationStrategy cannot be set to Manual without enabling the %s feature gate", featuregate.OptOutRoleAggregation),
 	}}
 }
+
+func validateMacGenerationPolicy(networkConfig *v1.NetworkConfiguration) []metav1.StatusCause {
+	if networkConfig == nil || networkConfig.MacGeneration == nil {
+		return nil
+	}
+	policy := networkConfig.MacGeneration
+	const fieldPath = "spec.configuration.network.macGeneration"
+
+	var causes []metav1.StatusCause
+	if policy.OUI != "" {
+		if err := macallocator.ValidateOUI(policy.OUI); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   fieldPath + ".oui",
+				Message: err.Error(),
+			})
+		}
+	}
+
+	switch policy.Allocator {
+	case "", v1.MacAllocatorDeterministic, v1.MacAllocatorRandom:
+	case v1.MacAllocatorExternal:
+		if policy.External == nil || policy.External.WebhookURL == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Field:   fieldPath + ".external.webhookURL",
+				Message: "the External MAC allocator requires a webhook URL",
+			})
+		}
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:  metav1.CauseTypeFieldValueNotSupported,
+			Field: fieldPath + ".allocator",
+			Message: fmt.Sprintf("unsupported MAC allocator %q, supported allocators are %s, %s and %s", policy.Allocator,
+				v1.MacAllocatorDeterministic, v1.MacAllocatorRandom, v1.MacAllocatorExternal),
+		})
+	}
+	return causes
+}
+
+func validateNetworkBindingDryRunValidation(networkConfig *v1.NetworkConfiguration) []metav1.StatusCause {
+	if networkConfig == nil {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	for _, bindingName := range slices.Sorted(maps.Keys(networkConfig.Binding)) {
+		validation := networkConfig.Binding[bindingName].DryRunValidation
+		if validation == nil {
+			continue
+		}
+		fieldPath := fmt.Sprintf("spec.configuration.network.binding[%s].dryRunValidation", bindingName)
+		if validation.WebhookURL == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Field:   fieldPath + ".webhookURL",
+				Message: fmt.Sprintf("the dry run validation of network binding %s requires a webhook URL", bindingName),
+			})
+		}
+		switch validation.FailurePolicy {
+		case "", v1.InterfaceBindingDryRunFailurePolicyFail, v1.InterfaceBindingDryRunFailurePolicyIgnore:
+		default:
+			causes = append(causes, metav1.StatusCause{
+				Type:  metav1.CauseTypeFieldValueNotSupported,
+				Field: fieldPath + ".failurePolicy",
+				Message: fmt.Sprintf("unsupported failure policy %q, supported policies are %s and %s", validation.FailurePolicy,
+					v1.InterfaceBindingDryRunFailurePolicyFail, v1.InterfaceBindingDryRunFailurePolicyIgnore),
+			})
+		}
+		if len(validation.CABundle) > 0 && !x509.NewCertPool().AppendCertsFromPEM(validation.CABundle) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   fieldPath + ".caBundle",
+				Message: "the CA bundle does not contain any PEM encoded certificate",
+			})
+		}
+	}
+	return causes
+}
+
+func validateNetworkInterfaceMultiQueuePolicy(networkConfig *v1.NetworkConfiguration) []metav1.StatusCause {
+	if networkConfig == nil {
+		return nil
+	}
+	return vmispec.ValidateNetworkQueuesPolicy(
+		field.NewPath("spec", "configuration", "network", "networkInterfaceMultiqueuePolicy"),
+		networkConfig.NetworkInterfaceMultiQueuePolicy,
+	)
+}
+
+func validateConsoleRecording(config *v1.ConsoleRecordingConfiguration) []metav1.StatusCause {
+	if config == nil {
+		return nil
+	}
+	const fieldPath = "spec.configuration.consoleRecording"
+
+	var causes []metav1.StatusCause
+	sinks := 0
+	for _, set := range []bool{config.Sink.PersistentVolumeClaim != nil, config.Sink.S3 != nil, config.Sink.Webhook != nil} {
+		if set {
+			sinks++
+		}
+	}
+	if sinks != 1 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   fieldPath + ".sink",
+			Message: "exactly one of persistentVolumeClaim, s3 and webhook must be set",
+		})
+	}
+	if webhook := config.Sink.Webhook; webhook != nil && len(webhook.CABundle) > 0 {
+		if !x509.NewCertPool().AppendCertsFromPEM(webhook.CABundle) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   fieldPath + ".sink.webhook.caBundle",
+				Message: "the CA bundle does not contain any PEM encoded certificate",
+			})
+		}
+	}
+
+	for i, policy := range config.Policies {
+		policyPath := fmt.Sprintf("%s.policies[%d]", fieldPath, i)
+		if policy.NamespaceSelector != nil {
+			if _, err := metav1.LabelSelectorAsSelector(policy.NamespaceSelector); err != nil {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Field:   policyPath + ".namespaceSelector",
+					Message: err.Error(),
+				})
+			}
+		}
+		for _, session := range policy.Sessions {
+			if session != v1.ConsoleSessionSerial && session != v1.ConsoleSessionVNC {
+				causes = append(causes, metav1.StatusCause{
+					Type:  metav1.CauseTypeFieldValueNotSupported,
+					Field: policyPath + ".sessions",
+					Message: fmt.Sprintf("unsupported session type %q, supported types are %s and %s", session,
+						v1.ConsoleSessionSerial, v1.ConsoleSessionVNC),
+				})
+			}
+		}
+	}
+	return causes
+}
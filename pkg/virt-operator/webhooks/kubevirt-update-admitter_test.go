@@ -149,6 +149,140 @@ var _ = Describe("Validating KubeVirtUpdate Admitter", func() {
 		),
 	)
 
+	DescribeTable("validateNetworkInterfaceMultiQueuePolicy", func(networkConfig *v1.NetworkConfiguration, expectedFields []string) {
+		causes := validateNetworkInterfaceMultiQueuePolicy(networkConfig)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for i, field := range expectedFields {
+			Expect(causes[i].Type).To(Equal(metav1.CauseTypeFieldValueInvalid))
+			Expect(causes[i].Field).To(Equal(field))
+		}
+	},
+		Entry("should allow when the network configuration is nil", nil, nil),
+		Entry("should allow when the policy is nil", &v1.NetworkConfiguration{}, nil),
+		Entry("should allow a valid policy",
+			&v1.NetworkConfiguration{NetworkInterfaceMultiQueuePolicy: &v1.NetworkQueuesPolicy{
+				VCPUsPerQueue: pointer.P(uint32(2)), MaxQueues: pointer.P(uint32(32)),
+			}}, nil),
+		Entry("should reject an invalid policy",
+			&v1.NetworkConfiguration{NetworkInterfaceMultiQueuePolicy: &v1.NetworkQueuesPolicy{
+				VCPUsPerQueue: pointer.P(uint32(0)), MaxQueues: pointer.P(uint32(1024)),
+			}}, []string{
+				"spec.configuration.network.networkInterfaceMultiqueuePolicy.vcpusPerQueue",
+				"spec.configuration.network.networkInterfaceMultiqueuePolicy.maxQueues",
+			}),
+	)
+
+	DescribeTable("validateMacGenerationPolicy", func(networkConfig *v1.NetworkConfiguration, expectedFields []string) {
+		causes := validateMacGenerationPolicy(networkConfig)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for i, cause := range causes {
+			Expect(cause.Field).To(Equal(expectedFields[i]))
+		}
+	},
+		Entry("should allow when the network configuration is nil", nil, nil),
+		Entry("should allow when the MAC generation policy is nil", &v1.NetworkConfiguration{}, nil),
+		Entry("should allow the default OUI",
+			&v1.NetworkConfiguration{MacGeneration: &v1.MacGenerationPolicy{}}, nil),
+		Entry("should allow a unicast OUI",
+			&v1.NetworkConfiguration{MacGeneration: &v1.MacGenerationPolicy{OUI: "0a:bc:de"}}, nil),
+		Entry("should reject a malformed OUI",
+			&v1.NetworkConfiguration{MacGeneration: &v1.MacGenerationPolicy{OUI: "0a:bc"}},
+			[]string{"spec.configuration.network.macGeneration.oui"}),
+		Entry("should reject a multicast OUI",
+			&v1.NetworkConfiguration{MacGeneration: &v1.MacGenerationPolicy{OUI: "01:00:5e"}},
+			[]string{"spec.configuration.network.macGeneration.oui"}),
+		Entry("should allow the random allocator",
+			&v1.NetworkConfiguration{MacGeneration: &v1.MacGenerationPolicy{Allocator: v1.MacAllocatorRandom}}, nil),
+		Entry("should allow the external allocator with a webhook",
+			&v1.NetworkConfiguration{MacGeneration: &v1.MacGenerationPolicy{
+				Allocator: v1.MacAllocatorExternal,
+				External:  &v1.ExternalMacAllocator{WebhookURL: "http://allocator.example"},
+			}}, nil),
+		Entry("should reject the external allocator without a webhook",
+			&v1.NetworkConfiguration{MacGeneration: &v1.MacGenerationPolicy{Allocator: v1.MacAllocatorExternal}},
+			[]string{"spec.configuration.network.macGeneration.external.webhookURL"}),
+		Entry("should reject an unsupported allocator",
+			&v1.NetworkConfiguration{MacGeneration: &v1.MacGenerationPolicy{Allocator: "Sequential"}},
+			[]string{"spec.configuration.network.macGeneration.allocator"}),
+	)
+
+	DescribeTable("validateConsoleRecording", func(config *v1.ConsoleRecordingConfiguration, expectedFields []string) {
+		causes := validateConsoleRecording(config)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for i, cause := range causes {
+			Expect(cause.Field).To(Equal(expectedFields[i]))
+		}
+	},
+		Entry("should allow when console recording is not configured", nil, nil),
+		Entry("should allow a single sink",
+			&v1.ConsoleRecordingConfiguration{
+				Sink:     v1.ConsoleRecordingSink{Webhook: &v1.ConsoleRecordingWebhookSink{URL: "https://recorder.example"}},
+				Policies: []v1.ConsoleRecordingPolicy{{Sessions: []v1.ConsoleSessionType{v1.ConsoleSessionSerial}}},
+			}, nil),
+		Entry("should reject a missing sink",
+			&v1.ConsoleRecordingConfiguration{},
+			[]string{"spec.configuration.consoleRecording.sink"}),
+		Entry("should reject several sinks",
+			&v1.ConsoleRecordingConfiguration{Sink: v1.ConsoleRecordingSink{
+				PersistentVolumeClaim: &v1.ConsoleRecordingPVCSink{ClaimName: "recordings"},
+				Webhook:               &v1.ConsoleRecordingWebhookSink{URL: "https://recorder.example"},
+			}},
+			[]string{"spec.configuration.consoleRecording.sink"}),
+		Entry("should reject a webhook CA bundle without certificates",
+			&v1.ConsoleRecordingConfiguration{Sink: v1.ConsoleRecordingSink{
+				Webhook: &v1.ConsoleRecordingWebhookSink{URL: "https://recorder.example", CABundle: []byte("garbage")},
+			}},
+			[]string{"spec.configuration.consoleRecording.sink.webhook.caBundle"}),
+		Entry("should reject an invalid namespace selector",
+			&v1.ConsoleRecordingConfiguration{
+				Sink: v1.ConsoleRecordingSink{PersistentVolumeClaim: &v1.ConsoleRecordingPVCSink{ClaimName: "recordings"}},
+				Policies: []v1.ConsoleRecordingPolicy{{NamespaceSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "audit", Operator: "Matches"}},
+				}}},
+			},
+			[]string{"spec.configuration.consoleRecording.policies[0].namespaceSelector"}),
+		Entry("should reject an unsupported session type",
+			&v1.ConsoleRecordingConfiguration{
+				Sink:     v1.ConsoleRecordingSink{PersistentVolumeClaim: &v1.ConsoleRecordingPVCSink{ClaimName: "recordings"}},
+				Policies: []v1.ConsoleRecordingPolicy{{Sessions: []v1.ConsoleSessionType{"SPICE"}}},
+			},
+			[]string{"spec.configuration.consoleRecording.policies[0].sessions"}),
+	)
+
+	DescribeTable("validateNetworkBindingDryRunValidation", func(networkConfig *v1.NetworkConfiguration, expectedFields []string) {
+		causes := validateNetworkBindingDryRunValidation(networkConfig)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for i, cause := range causes {
+			Expect(cause.Field).To(Equal(expectedFields[i]))
+		}
+	},
+		Entry("should allow when the network configuration is nil", nil, nil),
+		Entry("should allow a binding without dry run validation",
+			&v1.NetworkConfiguration{Binding: map[string]v1.InterfaceBindingPlugin{"plugin": {SidecarImage: "image"}}}, nil),
+		Entry("should allow a dry run validation with a webhook",
+			&v1.NetworkConfiguration{Binding: map[string]v1.InterfaceBindingPlugin{"plugin": {
+				DryRunValidation: &v1.InterfaceBindingDryRunValidation{
+					WebhookURL:    "http://plugin.example/validate",
+					FailurePolicy: v1.InterfaceBindingDryRunFailurePolicyIgnore,
+				},
+			}}}, nil),
+		Entry("should reject a dry run validation without a webhook",
+			&v1.NetworkConfiguration{Binding: map[string]v1.InterfaceBindingPlugin{"plugin": {
+				DryRunValidation: &v1.InterfaceBindingDryRunValidation{},
+			}}},
+			[]string{"spec.configuration.network.binding[plugin].dryRunValidation.webhookURL"}),
+		Entry("should reject an unsupported failure policy",
+			&v1.NetworkConfiguration{Binding: map[string]v1.InterfaceBindingPlugin{"plugin": {
+				DryRunValidation: &v1.InterfaceBindingDryRunValidation{WebhookURL: "http://plugin.example/validate", FailurePolicy: "Retry"},
+			}}},
+			[]string{"spec.configuration.network.binding[plugin].dryRunValidation.failurePolicy"}),
+		Entry("should reject a CA bundle without certificates",
+			&v1.NetworkConfiguration{Binding: map[string]v1.InterfaceBindingPlugin{"plugin": {
+				DryRunValidation: &v1.InterfaceBindingDryRunValidation{WebhookURL: "https://plugin.example/validate", CABundle: []byte("garbage")},
+			}}},
+			[]string{"spec.configuration.network.binding[plugin].dryRunValidation.caBundle"}),
+	)
+
 	DescribeTable("validateSeccompConfiguration", func(seccompConfiguration *v1.SeccompConfiguration, expectedFields []string) {
 		causes := validateSeccompConfiguration(test, seccompConfiguration)
 		Expect(causes).To(HaveLen(len(expectedFields)))
@@ -0,0 +1,149 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package guestosinventory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	allNamespacesFlag = "all-namespaces"
+	withoutDriverFlag = "without-driver"
+
+	unknown = "<unknown>"
+)
+
+type command struct {
+	allNamespaces bool
+	withoutDriver string
+}
+
+func NewCommand() *cobra.Command {
+	c := command{}
+	cmd := &cobra.Command{
+		Use:   "guest-os-inventory",
+		Short: "List the guest OS, kernel and driver versions last reported by the guest agents of the VirtualMachines.",
+		Long: `List the guest OS, kernel and driver versions last reported by the guest agents of the VirtualMachines.
+The information is recorded in the status of the VirtualMachines, it is kept while they are stopped.
+VirtualMachines whose guest agent never reported anything are listed as unknown.`,
+		Example: usage(),
+		Args:    cobra.NoArgs,
+		RunE:    c.run,
+	}
+
+	cmd.Flags().BoolVarP(&c.allNamespaces, allNamespacesFlag, "A", false, "List the VirtualMachines of all namespaces.")
+	cmd.Flags().StringVar(&c.withoutDriver, withoutDriverFlag, "",
+		"Only list the VirtualMachines whose guest did not report a driver containing the given name (case insensitive), e.g. to find guests lacking multiqueue-capable drivers.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usage() string {
+	return `  # List the guest OS inventory of the VirtualMachines of the current namespace:
+  {{ProgramName}} adm guest-os-inventory
+
+  # List the VirtualMachines of all namespaces whose guest does not use the VirtIO network driver:
+  {{ProgramName}} adm guest-os-inventory --all-namespaces --without-driver="VirtIO Ethernet"`
+}
+
+func (c *command) run(cmd *cobra.Command, _ []string) error {
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+	if c.allNamespaces {
+		namespace = metav1.NamespaceAll
+	}
+
+	vmList, err := virtClient.VirtualMachine(namespace).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing VirtualMachines: %v", err)
+	}
+
+	vms := vmList.Items
+	sort.Slice(vms, func(i, j int) bool {
+		if vms[i].Namespace != vms[j].Namespace {
+			return vms[i].Namespace < vms[j].Namespace
+		}
+		return vms[i].Name < vms[j].Name
+	})
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "NAMESPACE\tNAME\tOS\tVERSION\tKERNEL\tDRIVERS")
+	for i := range vms {
+		info, drivers := vms[i].Status.GuestOSInfo, vms[i].Status.GuestDrivers
+		if c.withoutDriver != "" && (info == nil || hasDriver(drivers, c.withoutDriver)) {
+			continue
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", vms[i].Namespace, vms[i].Name, formatGuestOSInfo(info, drivers))
+	}
+	return w.Flush()
+}
+
+// hasDriver returns whether the guest reported a driver containing the given name
+func hasDriver(drivers []v1.VirtualMachineInstanceGuestOSDriver, name string) bool {
+	for _, driver := range drivers {
+		if strings.Contains(strings.ToLower(driver.Name), strings.ToLower(name)) {
+			return true
+		}
+	}
+	return false
+}
+
+func formatGuestOSInfo(info *v1.VirtualMachineInstanceGuestOSInfo, guestDrivers []v1.VirtualMachineInstanceGuestOSDriver) string {
+	if info == nil {
+		return strings.Join([]string{unknown, unknown, unknown, unknown}, "\t")
+	}
+
+	drivers := make([]string, 0, len(guestDrivers))
+	for _, driver := range guestDrivers {
+		if driver.Version == "" {
+			drivers = append(drivers, driver.Name)
+		} else {
+			drivers = append(drivers, fmt.Sprintf("%s (%s)", driver.Name, driver.Version))
+		}
+	}
+
+	return strings.Join([]string{
+		orUnknown(info.Name),
+		orUnknown(info.Version),
+		orUnknown(info.KernelRelease),
+		orUnknown(strings.Join(drivers, ", ")),
+	}, "\t")
+}
+
+func orUnknown(s string) string {
+	if s == "" {
+		return unknown
+	}
+	return s
+}
@@ -0,0 +1,135 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package guestosinventory_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("guest-os-inventory", func() {
+	var vmInterface *kubecli.MockVirtualMachineInterface
+
+	newVM := func(namespace, name string, info *v1.VirtualMachineInstanceGuestOSInfo, drivers ...v1.VirtualMachineInstanceGuestOSDriver) v1.VirtualMachine {
+		return v1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Status:     v1.VirtualMachineStatus{GuestOSInfo: info, GuestDrivers: drivers},
+		}
+	}
+
+	windowsInfo := &v1.VirtualMachineInstanceGuestOSInfo{
+		Name:          "Microsoft Windows Server 2022 Datacenter",
+		Version:       "2022",
+		KernelRelease: "20348",
+	}
+	windowsDrivers := []v1.VirtualMachineInstanceGuestOSDriver{
+		{Name: "Red Hat VirtIO Ethernet Adapter", Version: "100.85.104.20800", DeviceID: "1af4:1041"},
+		{Name: "Red Hat VirtIO SCSI controller", Version: "100.85.104.20800", DeviceID: "1af4:1048"},
+	}
+	legacyWindowsInfo := &v1.VirtualMachineInstanceGuestOSInfo{
+		Name:          "Microsoft Windows Server 2019 Datacenter",
+		Version:       "2019",
+		KernelRelease: "17763",
+	}
+	legacyWindowsDrivers := []v1.VirtualMachineInstanceGuestOSDriver{
+		{Name: "Intel(R) PRO/1000 MT Network Connection", DeviceID: "8086:100e"},
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+	})
+
+	expectList := func(namespace string, vms ...v1.VirtualMachine) {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(namespace).Return(vmInterface)
+		vmInterface.EXPECT().List(context.Background(), metav1.ListOptions{}).Return(&v1.VirtualMachineList{Items: vms}, nil)
+	}
+
+	It("should list the guest OS inventory of the VirtualMachines of the namespace", func() {
+		expectList(metav1.NamespaceDefault,
+			newVM(metav1.NamespaceDefault, "vm-b", nil),
+			newVM(metav1.NamespaceDefault, "vm-a", windowsInfo, windowsDrivers...),
+		)
+
+		out, err := testing.NewRepeatableVirtctlCommandWithOut("adm", "guest-os-inventory")()
+		Expect(err).ToNot(HaveOccurred())
+		lines := splitLines(out)
+		Expect(lines).To(HaveLen(3))
+		Expect(lines[0]).To(MatchRegexp(`^NAMESPACE\s+NAME\s+OS\s+VERSION\s+KERNEL\s+DRIVERS$`))
+		Expect(lines[1]).To(MatchRegexp(`^default\s+vm-a\s+Microsoft Windows Server 2022 Datacenter\s+2022\s+20348\s+` +
+			`Red Hat VirtIO Ethernet Adapter \(100\.85\.104\.20800\), Red Hat VirtIO SCSI controller \(100\.85\.104\.20800\)$`))
+		Expect(lines[2]).To(MatchRegexp(`^default\s+vm-b\s+<unknown>\s+<unknown>\s+<unknown>\s+<unknown>$`))
+	})
+
+	It("should list the VirtualMachines of all namespaces", func() {
+		expectList(metav1.NamespaceAll,
+			newVM("ns-b", "vm", windowsInfo, windowsDrivers...),
+			newVM("ns-a", "vm", legacyWindowsInfo, legacyWindowsDrivers...),
+		)
+
+		out, err := testing.NewRepeatableVirtctlCommandWithOut("adm", "guest-os-inventory", "--all-namespaces")()
+		Expect(err).ToNot(HaveOccurred())
+		lines := splitLines(out)
+		Expect(lines).To(HaveLen(3))
+		Expect(lines[1]).To(HavePrefix("ns-a"))
+		Expect(lines[1]).To(HaveSuffix("Intel(R) PRO/1000 MT Network Connection"))
+		Expect(lines[2]).To(HavePrefix("ns-b"))
+	})
+
+	It("should only list the VirtualMachines lacking a driver", func() {
+		expectList(metav1.NamespaceAll,
+			newVM("ns", "modern", windowsInfo, windowsDrivers...),
+			newVM("ns", "legacy", legacyWindowsInfo, legacyWindowsDrivers...),
+			newVM("ns", "never-started", nil),
+		)
+
+		out, err := testing.NewRepeatableVirtctlCommandWithOut("adm", "guest-os-inventory", "-A", "--without-driver=virtio ethernet")()
+		Expect(err).ToNot(HaveOccurred())
+		lines := splitLines(out)
+		Expect(lines).To(HaveLen(2))
+		Expect(lines[1]).To(MatchRegexp(`^ns\s+legacy\s+`))
+	})
+
+	It("should fail when the VirtualMachines cannot be listed", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(vmInterface)
+		vmInterface.EXPECT().List(context.Background(), metav1.ListOptions{}).Return(nil, errors.New("forbidden"))
+
+		_, err := testing.NewRepeatableVirtctlCommandWithOut("adm", "guest-os-inventory")()
+		Expect(err).To(MatchError("error listing VirtualMachines: forbidden"))
+	})
+})
+
+func splitLines(out []byte) []string {
+	return strings.Split(strings.TrimRight(string(out), "\n"), "\n")
+}
@@ -41,6 +41,7 @@ const defaultTimeoutMinutes = 5
 
 type consoleCommand struct {
 	timeout int
+	channel string
 }
 
 func NewCommand() *cobra.Command {
@@ -54,6 +55,8 @@ func NewCommand() *cobra.Command {
 	}
 	cmd.Flags().IntVar(&c.timeout, "timeout", defaultTimeoutMinutes,
 		"The number of minutes to wait for the virtual machine instance to be ready.")
+	cmd.Flags().StringVar(&c.channel, "channel", "",
+		"The name of a virtio channel of the virtual machine instance to connect to instead of the serial console.")
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
 }
@@ -62,7 +65,9 @@ func usage() string {
 	usage := `  # Connect to the console on VirtualMachineInstance 'myvmi':
   {{ProgramName}} console myvmi
   # Configure one minute timeout (default 5 minutes)
-  {{ProgramName}} console --timeout=1 myvmi`
+  {{ProgramName}} console --timeout=1 myvmi
+  # Connect to the virtio channel 'agent' on VirtualMachineInstance 'myvmi':
+  {{ProgramName}} console --channel=agent myvmi`
 
 	return usage
 }
@@ -91,8 +96,7 @@ func (c *consoleCommand) handleConsoleConnection(client kubecli.KubevirtClient,
 	signal.Notify(waitInterrupt, os.Interrupt)
 
 	go func() {
-		con, err := client.VirtualMachineInstance(namespace).SerialConsole(vmi,
-			&kvcorev1.SerialConsoleOptions{ConnectionTimeout: time.Duration(c.timeout) * time.Minute})
+		con, err := c.connect(client, namespace, vmi)
 		runningChan <- err
 
 		if err != nil {
@@ -131,6 +135,14 @@ func (c *consoleCommand) handleConsoleConnection(client kubecli.KubevirtClient,
 	return nil
 }
 
+func (c *consoleCommand) connect(client kubecli.KubevirtClient, namespace, vmi string) (kvcorev1.StreamInterface, error) {
+	if c.channel != "" {
+		return client.VirtualMachineInstance(namespace).Channel(vmi, c.channel)
+	}
+	return client.VirtualMachineInstance(namespace).SerialConsole(vmi,
+		&kvcorev1.SerialConsoleOptions{ConnectionTimeout: time.Duration(c.timeout) * time.Minute})
+}
+
 // Attach attaches stdin and stdout to the console
 // in -> stdinWriter | stdinReader -> console
 // out <- stdoutReader | stdoutWriter <- console
@@ -24,6 +24,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/virtctl/create/clone"
 	"kubevirt.io/kubevirt/pkg/virtctl/create/instancetype"
+	"kubevirt.io/kubevirt/pkg/virtctl/create/ovf"
 	"kubevirt.io/kubevirt/pkg/virtctl/create/preference"
 	"kubevirt.io/kubevirt/pkg/virtctl/create/vm"
 	"kubevirt.io/kubevirt/pkg/virtctl/templates"
@@ -46,6 +47,7 @@ func NewCommand() *cobra.Command {
 	cmd.AddCommand(preference.NewCommand())
 	cmd.AddCommand(instancetype.NewCommand())
 	cmd.AddCommand(clone.NewCommand())
+	cmd.AddCommand(ovf.NewCommand())
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 
 	return cmd
@@ -0,0 +1,177 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ovf
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	ovfconverter "kubevirt.io/kubevirt/pkg/ovf"
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+)
+
+const (
+	OVF = "ovf"
+
+	SourceFlag       = "source"
+	NameFlag         = "name"
+	DiskURLBaseFlag  = "disk-url-base"
+	StorageClassFlag = "storage-class"
+	NetworkFlag      = "network"
+)
+
+type createOVF struct {
+	namespace    string
+	source       string
+	name         string
+	diskURLBase  string
+	storageClass string
+	networks     []string
+}
+
+func NewCommand() *cobra.Command {
+	c := createOVF{}
+	cmd := &cobra.Command{
+		Use:     OVF,
+		Short:   "Create VirtualMachine and DataVolume manifests from an OVF descriptor or an OVA archive.",
+		Example: c.usage(),
+		Args:    cobra.NoArgs,
+		RunE:    c.run,
+	}
+
+	cmd.Flags().StringVar(&c.source, SourceFlag, "",
+		"Path to the OVF descriptor (.ovf) or OVA archive (.ova) to convert.")
+	cmd.Flags().StringVar(&c.name, NameFlag, "",
+		"Specify the name of the VM. Defaults to the name of the virtual system.")
+	cmd.Flags().StringVar(&c.diskURLBase, DiskURLBaseFlag, "",
+		"Base URL the disk files of the descriptor are served from. Without it, the DataVolumes wait for the disks to be uploaded.")
+	cmd.Flags().StringVar(&c.storageClass, StorageClassFlag, "",
+		"Specify the storage class of the DataVolumes.")
+	cmd.Flags().StringArrayVar(&c.networks, NetworkFlag, nil,
+		"Map an OVF network to a network attachment definition, in the form 'ovfNetwork:networkAttachmentDefinition'. "+
+			"A single unmapped network is connected to the pod network. Can be provided multiple times.")
+
+	if err := cmd.MarkFlagRequired(SourceFlag); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+func (c *createOVF) usage() string {
+	return `  # Create the manifests of a VM and of the DataVolumes its disks are uploaded to:
+  {{ProgramName}} create ovf --source web-01.ova
+
+  # Create the manifests of a VM whose disks are imported from a web server:
+  {{ProgramName}} create ovf --source web-01.ovf --disk-url-base http://images.example.com/web-01/
+
+  # Create the manifests of a VM connecting the OVF network "Storage Network" to a secondary network:
+  {{ProgramName}} create ovf --source web-01.ovf --network "Storage Network:storage-nad"
+
+  # Create the manifests of a VM and use them to create the resources with kubectl:
+  {{ProgramName}} create ovf --source web-01.ovf --disk-url-base http://images.example.com/web-01/ | kubectl create -f -`
+}
+
+func (c *createOVF) run(cmd *cobra.Command, _ []string) error {
+	_, namespace, overridden, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+	if overridden {
+		c.namespace = namespace
+	}
+
+	networkMappings, err := c.networkMappings()
+	if err != nil {
+		return err
+	}
+
+	envelope, err := c.parseSource()
+	if err != nil {
+		return err
+	}
+
+	vm, dataVolumes, err := ovfconverter.Convert(envelope, ovfconverter.Options{
+		Name:            c.name,
+		Namespace:       c.namespace,
+		DiskURLBase:     c.diskURLBase,
+		StorageClass:    c.storageClass,
+		NetworkMappings: networkMappings,
+	})
+	if err != nil {
+		return err
+	}
+
+	var manifests []string
+	for _, dataVolume := range dataVolumes {
+		out, err := yaml.Marshal(dataVolume)
+		if err != nil {
+			return err
+		}
+		manifests = append(manifests, string(out))
+	}
+	out, err := yaml.Marshal(vm)
+	if err != nil {
+		return err
+	}
+	manifests = append(manifests, string(out))
+
+	cmd.Print(strings.Join(manifests, "---\n"))
+	return nil
+}
+
+func (c *createOVF) networkMappings() (map[string]string, error) {
+	mappings := make(map[string]string, len(c.networks))
+	for _, network := range c.networks {
+		// OVF network names are free form, so only the last colon separates the network attachment definition
+		separator := strings.LastIndex(network, ":")
+		if separator <= 0 || separator == len(network)-1 {
+			return nil, fmt.Errorf("network mapping %q is invalid, expected 'ovfNetwork:networkAttachmentDefinition'", network)
+		}
+		mappings[network[:separator]] = network[separator+1:]
+	}
+	return mappings, nil
+}
+
+func (c *createOVF) parseSource() (*ovfconverter.Envelope, error) {
+	var parse func(io.Reader) (*ovfconverter.Envelope, error)
+	switch strings.ToLower(filepath.Ext(c.source)) {
+	case ".ovf":
+		parse = ovfconverter.Parse
+	case ".ova":
+		parse = ovfconverter.ParseOVA
+	default:
+		return nil, errors.New("source has to be an OVF descriptor (.ovf) or an OVA archive (.ova)")
+	}
+
+	file, err := os.Open(c.source)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return parse(file)
+}
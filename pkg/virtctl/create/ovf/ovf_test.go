@@ -0,0 +1,160 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ovf_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/yaml"
+
+	v1 "kubevirt.io/api/core/v1"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/create"
+	virtctlovf "kubevirt.io/kubevirt/pkg/virtctl/create/ovf"
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+const descriptor = `<?xml version="1.0" encoding="UTF-8"?>
+<Envelope xmlns="http://schemas.dmtf.org/ovf/envelope/1"
+          xmlns:ovf="http://schemas.dmtf.org/ovf/envelope/1"
+          xmlns:rasd="http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_ResourceAllocationSettingData">
+  <References>
+    <File ovf:id="file1" ovf:href="db-disk1.vmdk"/>
+  </References>
+  <DiskSection>
+    <Disk ovf:diskId="vmdisk1" ovf:fileRef="file1" ovf:capacity="10" ovf:capacityAllocationUnits="byte * 2^30"/>
+  </DiskSection>
+  <VirtualSystem ovf:id="db">
+    <Name>db</Name>
+    <VirtualHardwareSection>
+      <Item>
+        <rasd:InstanceID>1</rasd:InstanceID>
+        <rasd:ResourceType>3</rasd:ResourceType>
+        <rasd:VirtualQuantity>2</rasd:VirtualQuantity>
+      </Item>
+      <Item>
+        <rasd:AllocationUnits>byte * 2^20</rasd:AllocationUnits>
+        <rasd:InstanceID>2</rasd:InstanceID>
+        <rasd:ResourceType>4</rasd:ResourceType>
+        <rasd:VirtualQuantity>2048</rasd:VirtualQuantity>
+      </Item>
+      <Item>
+        <rasd:HostResource>ovf:/disk/vmdisk1</rasd:HostResource>
+        <rasd:InstanceID>3</rasd:InstanceID>
+        <rasd:ResourceType>17</rasd:ResourceType>
+      </Item>
+      <Item>
+        <rasd:Connection>VM Network</rasd:Connection>
+        <rasd:InstanceID>4</rasd:InstanceID>
+        <rasd:ResourceSubType>VmxNet3</rasd:ResourceSubType>
+        <rasd:ResourceType>10</rasd:ResourceType>
+      </Item>
+    </VirtualHardwareSection>
+  </VirtualSystem>
+</Envelope>
+`
+
+var _ = Describe("create ovf", func() {
+	var source string
+
+	BeforeEach(func() {
+		source = filepath.Join(GinkgoT().TempDir(), "db.ovf")
+		Expect(os.WriteFile(source, []byte(descriptor), 0600)).To(Succeed())
+	})
+
+	runCmd := func(flags ...string) (*v1.VirtualMachine, []cdiv1.DataVolume, error) {
+		args := append([]string{create.CREATE, virtctlovf.OVF}, flags...)
+		out, err := testing.NewRepeatableVirtctlCommandWithOut(args...)()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		manifests := strings.Split(string(out), "---\n")
+		var dataVolumes []cdiv1.DataVolume
+		for _, manifest := range manifests[:len(manifests)-1] {
+			dataVolume := cdiv1.DataVolume{}
+			Expect(yaml.Unmarshal([]byte(manifest), &dataVolume)).To(Succeed())
+			dataVolumes = append(dataVolumes, dataVolume)
+		}
+		vm := &v1.VirtualMachine{}
+		Expect(yaml.Unmarshal([]byte(manifests[len(manifests)-1]), vm)).To(Succeed())
+		return vm, dataVolumes, nil
+	}
+
+	It("should require a source", func() {
+		_, _, err := runCmd()
+		Expect(err).To(MatchError(ContainSubstring(`required flag(s) "source" not set`)))
+	})
+
+	It("should create the VM and DataVolume manifests", func() {
+		vm, dataVolumes, err := runCmd(
+			flag(virtctlovf.SourceFlag, source),
+			flag(virtctlovf.DiskURLBaseFlag, "http://images.example.com"),
+			flag(virtctlovf.StorageClassFlag, "fast"),
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(vm.Name).To(Equal("db"))
+		Expect(vm.Spec.Template.Spec.Domain.CPU.Sockets).To(Equal(uint32(2)))
+		Expect(vm.Spec.Template.Spec.Volumes).To(HaveLen(1))
+		Expect(vm.Spec.Template.Spec.Volumes[0].DataVolume.Name).To(Equal("db-disk-0"))
+
+		Expect(dataVolumes).To(HaveLen(1))
+		Expect(dataVolumes[0].Name).To(Equal("db-disk-0"))
+		Expect(dataVolumes[0].Spec.Source.HTTP.URL).To(Equal("http://images.example.com/db-disk1.vmdk"))
+		Expect(dataVolumes[0].Spec.Storage.StorageClassName).To(HaveValue(Equal("fast")))
+	})
+
+	It("should connect mapped OVF networks to network attachment definitions", func() {
+		vm, _, err := runCmd(
+			flag(virtctlovf.SourceFlag, source),
+			flag(virtctlovf.NameFlag, "my-db"),
+			flag(virtctlovf.NetworkFlag, "VM Network:ns1/vm-network"),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vm.Name).To(Equal("my-db"))
+		Expect(vm.Spec.Template.Spec.Networks[0].Multus.NetworkName).To(Equal("ns1/vm-network"))
+		Expect(vm.Spec.Template.Spec.Domain.Devices.Interfaces[0].Bridge).ToNot(BeNil())
+	})
+
+	DescribeTable("should fail", func(expectedErr string, flags ...string) {
+		_, _, err := runCmd(append([]string{flag(virtctlovf.SourceFlag, source)}, flags...)...)
+		Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+	},
+		Entry("given an invalid network mapping", "network mapping \"VM Network\" is invalid", flag(virtctlovf.NetworkFlag, "VM Network")),
+		Entry("given a network mapping without network attachment definition", "is invalid", flag(virtctlovf.NetworkFlag, "VM Network:")),
+	)
+
+	It("should fail given a source which is neither an OVF descriptor nor an OVA archive", func() {
+		_, _, err := runCmd(flag(virtctlovf.SourceFlag, "db.vmx"))
+		Expect(err).To(MatchError(ContainSubstring("source has to be an OVF descriptor (.ovf) or an OVA archive (.ova)")))
+	})
+})
+
+func flag(name, value string) string {
+	return fmt.Sprintf("--%s=%s", name, value)
+}
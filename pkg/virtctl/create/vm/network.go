@@ -0,0 +1,157 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/create/params"
+)
+
+const (
+	podNetworkName = "default"
+
+	bindingBridge = "bridge"
+	bindingSRIOV  = "sriov"
+)
+
+func (c *createVM) withNetworks(vm *v1.VirtualMachine) error {
+	spec := &vm.Spec.Template.Spec
+	// Specifying interfaces disables the automatic attachment of the pod network, keep it explicitly
+	spec.Networks = append(spec.Networks, *v1.DefaultPodNetwork())
+	spec.Domain.Devices.Interfaces = append(spec.Domain.Devices.Interfaces, v1.Interface{
+		Name:                   podNetworkName,
+		InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+	})
+
+	for _, networkFlag := range c.networks {
+		src := networkSource{}
+		if err := params.Map(NetworkFlag, networkFlag, &src); err != nil {
+			return err
+		}
+		if src.Source == "" {
+			return params.FlagErr(NetworkFlag, "src must be specified")
+		}
+
+		namespace, nadName, err := params.SplitPrefixedName(src.Source)
+		if err != nil {
+			return params.FlagErr(NetworkFlag, "invalid src \"%s\": %w", src.Source, err)
+		}
+		if err := c.validateNetworkAttachmentDefinition(namespace, nadName); err != nil {
+			return err
+		}
+
+		if src.Name == "" {
+			src.Name = nadName
+		}
+		if errs := validation.IsDNS1123Label(src.Name); len(errs) > 0 {
+			return params.FlagErr(NetworkFlag, "invalid name \"%s\": %s", src.Name, strings.Join(errs, ","))
+		}
+		for _, network := range spec.Networks {
+			if network.Name == src.Name {
+				return params.FlagErr(NetworkFlag, "there is already a network with name \"%s\"", src.Name)
+			}
+		}
+
+		iface, err := c.newInterface(src.Name, src.Binding)
+		if err != nil {
+			return err
+		}
+
+		spec.Networks = append(spec.Networks, v1.Network{
+			Name: src.Name,
+			NetworkSource: v1.NetworkSource{
+				Multus: &v1.MultusNetwork{NetworkName: src.Source},
+			},
+		})
+		spec.Domain.Devices.Interfaces = append(spec.Domain.Devices.Interfaces, iface)
+	}
+
+	return nil
+}
+
+func (c *createVM) newInterface(name, binding string) (v1.Interface, error) {
+	switch binding {
+	case "", bindingBridge:
+		return v1.Interface{
+			Name:                   name,
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+		}, nil
+	case bindingSRIOV:
+		return v1.Interface{
+			Name:                   name,
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}},
+		}, nil
+	}
+
+	// Anything else has to be a network binding plugin registered in the KubeVirt CR
+	if c.bindingPlugins == nil {
+		bindingPlugins, err := c.networkBindingPlugins()
+		if err != nil {
+			return v1.Interface{}, err
+		}
+		c.bindingPlugins = bindingPlugins
+	}
+	if _, exists := c.bindingPlugins[binding]; !exists {
+		return v1.Interface{}, params.FlagErr(NetworkFlag,
+			"binding \"%s\" is neither %s, %s nor a binding plugin registered in the KubeVirt CR", binding, bindingBridge, bindingSRIOV)
+	}
+	return v1.Interface{Name: name, Binding: &v1.PluginBinding{Name: binding}}, nil
+}
+
+func (c *createVM) validateNetworkAttachmentDefinition(namespace, name string) error {
+	if namespace == "" {
+		namespace = c.clientNamespace
+	}
+	_, err := c.virtClient.NetworkClient().K8sCniCncfIoV1().NetworkAttachmentDefinitions(namespace).Get(
+		context.Background(), name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return params.FlagErr(NetworkFlag, "network attachment definition \"%s/%s\" does not exist", namespace, name)
+	} else if err != nil {
+		return params.FlagErr(NetworkFlag, "failed to get network attachment definition \"%s/%s\": %w", namespace, name, err)
+	}
+	return nil
+}
+
+func (c *createVM) networkBindingPlugins() (map[string]v1.InterfaceBindingPlugin, error) {
+	kvList, err := c.virtClient.KubeVirt(metav1.NamespaceAll).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the KubeVirt CR to validate network binding plugins: %w", err)
+	}
+	if len(kvList.Items) == 0 {
+		return nil, fmt.Errorf("no KubeVirt CR found to validate network binding plugins")
+	}
+
+	bindingPlugins := map[string]v1.InterfaceBindingPlugin{}
+	if networkConfig := kvList.Items[0].Spec.Configuration.NetworkConfiguration; networkConfig != nil {
+		for name, plugin := range networkConfig.Binding {
+			bindingPlugins[name] = plugin
+		}
+	}
+	return bindingPlugins, nil
+}
@@ -120,3 +120,9 @@ type dataVolumeSourceVDDK struct {
 	Name         string             `param:"name"`
 	BootOrder    *uint              `param:"bootorder"`
 }
+
+type networkSource struct {
+	Name    string `param:"name"`
+	Source  string `param:"src"`
+	Binding string `param:"binding"`
+}
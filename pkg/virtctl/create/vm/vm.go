@@ -36,6 +36,7 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/api/instancetype"
+	"kubevirt.io/client-go/kubecli"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/pointer"
@@ -73,6 +74,8 @@ const (
 	CloudInitUserDataFlag    = "cloud-init-user-data"
 	CloudInitNetworkDataFlag = "cloud-init-network-data"
 
+	NetworkFlag = "network"
+
 	// Deprecated flags
 	DataSourceVolumeFlag = "volume-datasource"
 	ClonePvcVolumeFlag   = "volume-clone-pvc"
@@ -138,12 +141,17 @@ type createVM struct {
 	cloudInitUserData    string
 	cloudInitNetworkData string
 
+	networks []string
+
 	// Deprecated fields
 	dataSourceVolumes []string
 	clonePvcVolumes   []string
 	blankVolumes      []string
 
 	namespace                     string
+	clientNamespace               string
+	virtClient                    kubecli.KubevirtClient
+	bindingPlugins                map[string]v1.InterfaceBindingPlugin
 	explicitInstancetypeInference bool
 	explicitPreferenceInference   bool
 	memoryChanged                 bool
@@ -170,6 +178,7 @@ var flags = []string{
 	VolumeImportFlag,
 	SysprepVolumeFlag,
 	AccessCredFlag,
+	NetworkFlag,
 }
 
 var volumeImportOptions = map[string]func(string) (*cdiv1.DataVolumeSpec, *uint, error){
@@ -290,6 +299,11 @@ func NewCommand() *cobra.Command {
 	cmd.MarkFlagsMutuallyExclusive(CloudInitUserDataFlag, SSHKeyFlag)
 	cmd.MarkFlagsMutuallyExclusive(CloudInitUserDataFlag, GAManageSSHFlag)
 
+	cmd.Flags().StringArrayVar(&c.networks, NetworkFlag, c.networks,
+		fmt.Sprintf("Specify a network attachment definition to connect the VM to, in addition to the pod network. Can be provided multiple times.\n"+
+			"The network attachment definition and the binding plugin are validated against the cluster.\n"+
+			"Supported parameters: %s", params.Supported(networkSource{})))
+
 	// Deprecated flags
 	cmd.Flags().StringArrayVar(&c.dataSourceVolumes, DataSourceVolumeFlag, c.dataSourceVolumes,
 		"Specify a DataSource to be cloned by the VM. Can be provided multiple times.\n"+
@@ -426,13 +440,15 @@ func (c *createVM) run(cmd *cobra.Command, _ []string) error {
 func (c *createVM) setDefaults(cmd *cobra.Command) error {
 	c.cmd = cmd
 
-	_, namespace, overridden, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	virtClient, namespace, overridden, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
 	if err != nil {
 		return err
 	}
 	if overridden {
 		c.namespace = namespace
 	}
+	c.virtClient = virtClient
+	c.clientNamespace = namespace
 
 	if c.name == "" {
 		c.name = "vm-" + rand.String(randSuffixLength)
@@ -462,6 +478,7 @@ func (c *createVM) optFns() map[string]func(*v1.VirtualMachine) error {
 		VolumeImportFlag:        c.withImportedVolume,
 		SysprepVolumeFlag:       c.withSysprepVolume,
 		AccessCredFlag:          c.withAccessCredential,
+		NetworkFlag:             c.withNetworks,
 	}
 }
 
@@ -537,7 +554,13 @@ func (c *createVM) usage() string {
   {{ProgramName}} create vm --access-cred=type:password,src:my-pws
 
   # Create a manifest for a VirtualMachine with a Containerdisk and a Sysprep volume (source ConfigMap needs to exist)
-  {{ProgramName}} create vm --memory=1Gi --volume-containerdisk=src:my.registry/my-image:my-tag --volume-sysprep=src:my-cm`
+  {{ProgramName}} create vm --memory=1Gi --volume-containerdisk=src:my.registry/my-image:my-tag --volume-sysprep=src:my-cm
+
+  # Create a manifest for a VirtualMachine connected to a secondary network (network attachment definition needs to exist)
+  {{ProgramName}} create vm --network=src:my-ns/my-nad
+
+  # Create a manifest for a VirtualMachine connected to a secondary network through a binding plugin registered in the KubeVirt CR
+  {{ProgramName}} create vm --network=name:fast-net,src:my-nad,binding:vdpa`
 }
 
 func (c *createVM) newVM() (*v1.VirtualMachine, error) {
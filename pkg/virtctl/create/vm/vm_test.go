@@ -2,6 +2,7 @@
 package vm_test
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
 	"os"
@@ -9,18 +10,23 @@ import (
 	"strconv"
 	"strings"
 
+	networkv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	"go.uber.org/mock/gomock"
 
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/rand"
 
 	v1 "kubevirt.io/api/core/v1"
 	instancetypeapi "kubevirt.io/api/instancetype"
+	"kubevirt.io/client-go/kubecli"
 	generatedscheme "kubevirt.io/client-go/kubevirt/scheme"
+	fakenetworkclient "kubevirt.io/client-go/networkattachmentdefinitionclient/fake"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/pointer"
@@ -1867,6 +1873,132 @@ chpasswd: { expire: False }`
 			Entry("type ssh (explicit) and configdrive vs none", "type:ssh,src:my-src,method:configdrive", cloudInitNone, "configdrive vs none"),
 		)
 	})
+	Context("with networks", func() {
+		const (
+			nadNamespace  = "my-ns"
+			nadName       = "my-nad"
+			bindingPlugin = "vdpa"
+		)
+
+		var (
+			networkClient *fakenetworkclient.Clientset
+			kvInterface   *kubecli.MockKubeVirtInterface
+		)
+
+		BeforeEach(func() {
+			getClientFromClientConfig := kubecli.GetKubevirtClientFromClientConfig
+			DeferCleanup(func() {
+				kubecli.GetKubevirtClientFromClientConfig = getClientFromClientConfig
+			})
+
+			ctrl := gomock.NewController(GinkgoT())
+			kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+			kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+
+			networkClient = fakenetworkclient.NewSimpleClientset()
+			kubecli.MockKubevirtClientInstance.EXPECT().NetworkClient().Return(networkClient).AnyTimes()
+			_, err := networkClient.K8sCniCncfIoV1().NetworkAttachmentDefinitions(nadNamespace).Create(context.Background(),
+				&networkv1.NetworkAttachmentDefinition{ObjectMeta: metav1.ObjectMeta{Name: nadName, Namespace: nadNamespace}},
+				metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			kv := &v1.KubeVirt{
+				ObjectMeta: metav1.ObjectMeta{Name: "kubevirt", Namespace: "kubevirt"},
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						NetworkConfiguration: &v1.NetworkConfiguration{
+							Binding: map[string]v1.InterfaceBindingPlugin{
+								bindingPlugin: {SidecarImage: "my.registry/vdpa-binding:my-tag"},
+							},
+						},
+					},
+				},
+			}
+			kvInterface = kubecli.NewMockKubeVirtInterface(ctrl)
+			kubecli.MockKubevirtClientInstance.EXPECT().KubeVirt(metav1.NamespaceAll).Return(kvInterface).AnyTimes()
+			kvInterface.EXPECT().List(gomock.Any(), gomock.Any()).Return(kubecli.NewKubeVirtList(*kv), nil).AnyTimes()
+		})
+
+		It("VM with a secondary network and the pod network", func() {
+			out, err := runCmd(setFlag(NetworkFlag, "src:"+nadNamespace+"/"+nadName))
+			Expect(err).ToNot(HaveOccurred())
+			vm, err := decodeVM(out)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(vm.Spec.Template.Spec.Networks).To(ConsistOf(
+				*v1.DefaultPodNetwork(),
+				v1.Network{
+					Name: nadName,
+					NetworkSource: v1.NetworkSource{
+						Multus: &v1.MultusNetwork{NetworkName: nadNamespace + "/" + nadName},
+					},
+				},
+			))
+			Expect(vm.Spec.Template.Spec.Domain.Devices.Interfaces).To(ConsistOf(
+				v1.Interface{
+					Name:                   "default",
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+				},
+				v1.Interface{
+					Name:                   nadName,
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+				},
+			))
+		})
+
+		It("VM with a secondary network in the namespace of the client", func() {
+			out, err := runCmd(
+				setFlag("namespace", nadNamespace),
+				setFlag(NetworkFlag, "name:my-net,src:"+nadName+",binding:sriov"),
+			)
+			Expect(err).ToNot(HaveOccurred())
+			vm, err := decodeVM(out)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(vm.Spec.Template.Spec.Networks).To(ContainElement(v1.Network{
+				Name: "my-net",
+				NetworkSource: v1.NetworkSource{
+					Multus: &v1.MultusNetwork{NetworkName: nadName},
+				},
+			}))
+			Expect(vm.Spec.Template.Spec.Domain.Devices.Interfaces).To(ContainElement(v1.Interface{
+				Name:                   "my-net",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}},
+			}))
+		})
+
+		It("VM with a secondary network using a binding plugin", func() {
+			out, err := runCmd(setFlag(NetworkFlag, "name:fast-net,src:"+nadNamespace+"/"+nadName+",binding:"+bindingPlugin))
+			Expect(err).ToNot(HaveOccurred())
+			vm, err := decodeVM(out)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(vm.Spec.Template.Spec.Domain.Devices.Interfaces).To(ContainElement(v1.Interface{
+				Name:    "fast-net",
+				Binding: &v1.PluginBinding{Name: bindingPlugin},
+			}))
+		})
+
+		It("VM with a secondary network does not require the KubeVirt CR without a binding plugin", func() {
+			kvInterface.EXPECT().List(gomock.Any(), gomock.Any()).Times(0)
+
+			_, err := runCmd(setFlag(NetworkFlag, "src:"+nadNamespace+"/"+nadName))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		DescribeTable("Invalid arguments to NetworkFlag", func(params, errMsg string) {
+			out, err := runCmd(setFlag(NetworkFlag, params))
+			Expect(err).To(MatchError("failed to parse \"--network\" flag: " + errMsg))
+			Expect(out).To(BeEmpty())
+		},
+			Entry("Missing src", "name:my-net", "src must be specified"),
+			Entry("Invalid slash count in src", "src:a/b/c", "invalid src \"a/b/c\": invalid count 2 of slashes in prefix/name"),
+			Entry("Network attachment definition does not exist", "src:my-ns/does-not-exist", "network attachment definition \"my-ns/does-not-exist\" does not exist"),
+			Entry("Invalid name", "name:name.with.dot,src:my-ns/my-nad", "invalid name \"name.with.dot\": must not contain dots"),
+			Entry("Name of the pod network", "name:default,src:my-ns/my-nad", "there is already a network with name \"default\""),
+			Entry("Unknown binding", "src:my-ns/my-nad,binding:madeup", "binding \"madeup\" is neither bridge, sriov nor a binding plugin registered in the KubeVirt CR"),
+		)
+	})
 })
 
 func setFlag(flag, parameter string) string {
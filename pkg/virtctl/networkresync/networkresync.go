@@ -0,0 +1,71 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package networkresync
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_NETWORK_RESYNC = "network-resync"
+)
+
+func NewNetworkResyncCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "network-resync (VMI)",
+		Short: "Re-run the network setup of a running virtual machine instance",
+		Long: `Re-run the network setup of a running virtual machine instance.
+Networks which were not fully configured, e.g. due to a transient CNI or device-plugin failure,
+are configured again without restarting the virtual machine.`,
+		Args:    cobra.ExactArgs(1),
+		Example: usage(),
+		RunE:    Run,
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usage() string {
+	usage := "  # Re-sync the network of a virtualmachineinstance called 'myvmi':\n"
+	usage += fmt.Sprintf("  {{ProgramName}} %s myvmi", COMMAND_NETWORK_RESYNC)
+	return usage
+}
+
+func Run(cmd *cobra.Command, args []string) error {
+	vmi := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("Cannot obtain KubeVirt client: %v", err)
+	}
+
+	if err = virtClient.VirtualMachineInstance(namespace).NetworkResync(context.Background(), vmi); err != nil {
+		return fmt.Errorf("Error re-syncing the network of VirtualMachineInstance %s: %v", vmi, err)
+	}
+
+	fmt.Printf("VMI %s was scheduled to %s\n", vmi, COMMAND_NETWORK_RESYNC)
+	return nil
+}
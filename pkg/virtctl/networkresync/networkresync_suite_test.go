@@ -0,0 +1,11 @@
+package networkresync_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestNetworkResync(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
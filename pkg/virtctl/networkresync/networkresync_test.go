@@ -0,0 +1,58 @@
+package networkresync_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/virtctl/networkresync"
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Network resync", func() {
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+	var ctrl *gomock.Controller
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+	})
+
+	Context("With missing input parameters", func() {
+		It("should fail", func() {
+			cmd := testing.NewRepeatableVirtctlCommand(networkresync.COMMAND_NETWORK_RESYNC)
+			err := cmd()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	It("should resync the VMI network", func() {
+		vmi := libvmi.New()
+
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).Times(1)
+		vmiInterface.EXPECT().NetworkResync(context.Background(), vmi.Name).Return(nil).Times(1)
+
+		cmd := testing.NewRepeatableVirtctlCommand(networkresync.COMMAND_NETWORK_RESYNC, vmi.Name)
+		Expect(cmd()).To(Succeed())
+	})
+
+	It("should report a failure to resync the VMI network", func() {
+		vmi := libvmi.New()
+
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).Times(1)
+		vmiInterface.EXPECT().NetworkResync(context.Background(), vmi.Name).Return(errors.New("test error")).Times(1)
+
+		cmd := testing.NewRepeatableVirtctlCommand(networkresync.COMMAND_NETWORK_RESYNC, vmi.Name)
+		Expect(cmd()).To(MatchError(ContainSubstring("test error")))
+	})
+})
@@ -26,6 +26,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virtctl/guestfs"
 	"kubevirt.io/kubevirt/pkg/virtctl/imageupload"
 	"kubevirt.io/kubevirt/pkg/virtctl/memorydump"
+	"kubevirt.io/kubevirt/pkg/virtctl/networkresync"
 	"kubevirt.io/kubevirt/pkg/virtctl/objectgraph"
 	"kubevirt.io/kubevirt/pkg/virtctl/pause"
 	"kubevirt.io/kubevirt/pkg/virtctl/portforward"
@@ -126,6 +127,7 @@ func NewVirtctlCommandFn() *cobra.Command {
 		pause.NewCommand(),
 		unpause.NewCommand(),
 		softreboot.NewSoftRebootCommand(),
+		networkresync.NewNetworkResyncCommand(),
 		reset.NewResetCommand(),
 		expose.NewCommand(),
 		version.VersionCommand(),
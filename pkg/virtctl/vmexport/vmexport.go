@@ -36,8 +36,10 @@ import (
 
 	"github.com/cheggaaa/pb/v3"
 	"github.com/spf13/cobra"
+	"golang.org/x/time/rate"
 	k8sv1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -84,6 +86,8 @@ const (
 	LABELS_FLAG            = "--labels"
 	ANNOTATIONS_FLAG       = "--annotations"
 	READINESS_TIMEOUT_FLAG = "--readiness-timeout"
+	RESUME_FLAG            = "--resume"
+	BANDWIDTH_LIMIT_FLAG   = "--bandwidth-limit"
 
 	// Possible output format for manifests
 	OUTPUT_FORMAT_JSON = "json"
@@ -102,11 +106,16 @@ const (
 	// DefaultProcessingWaitTotal is the default maximum time used to wait for a virtualMachineExport to be ready
 	DefaultProcessingWaitTotal = 2 * time.Minute
 
+	// rangeHeader is the http header used to request the remaining part of a partially downloaded volume
+	rangeHeader = "Range"
 	// exportTokenHeader is the http header used to download the exported volume using the secret token
 	exportTokenHeader = "x-kubevirt-export-token"
 	// secretTokenKey is the entry used to store the token in the virtualMachineExport secret
 	secretTokenKey = "token"
 
+	// maxBandwidthBurst caps the amount of bytes read at once when the download bandwidth is limited
+	maxBandwidthBurst = 256 * 1024
+
 	// ErrRequiredFlag serves as error message when a mandatory flag is missing
 	ErrRequiredFlag = "need to specify the '%s' flag when using '%s'"
 	// ErrIncompatibleFlag serves as error message when an incompatible flag is used
@@ -147,6 +156,8 @@ var (
 	resourceLabels       []string
 	resourceAnnotations  []string
 	readinessTimeout     string
+	resume               bool
+	bandwidthLimit       string
 )
 
 type VMExportInfo struct {
@@ -172,6 +183,8 @@ type VMExportInfo struct {
 	ReadinessTimeout time.Duration
 	Labels           map[string]string
 	Annotations      map[string]string
+	Resume           bool
+	BandwidthLimit   int64
 }
 
 type command struct {
@@ -215,6 +228,9 @@ func usage() string {
 	# Download a volume as before but through local port 5410
 	{{ProgramName}} vmexport download vm1-export --volume=volume1 --output=disk.img.gz --port-forward --local-port=5410
 
+	# Download a raw volume at no more than 50MiB/s, resuming a previously interrupted download into disk.img
+	{{ProgramName}} vmexport download vm1-export --volume=volume1 --format=raw --output=disk.img --resume --bandwidth-limit=50Mi --retry=5
+
 	# Create a VirtualMachineExport and download the requested volume from it
 	{{ProgramName}} vmexport download vm1-export --vm=vm1 --volume=volume1 --output=disk.img.gz
 
@@ -261,6 +277,8 @@ func NewVirtualMachineExportCommand() *cobra.Command {
 	cmd.Flags().StringSliceVar(&resourceLabels, "labels", nil, "Specify custom labels to VM export object and its associated pod")
 	cmd.Flags().StringSliceVar(&resourceAnnotations, "annotations", nil, "Specify custom annotations to VM export object and its associated pod")
 	cmd.Flags().StringVar(&readinessTimeout, "readiness-timeout", "", "Specify maximum wait for VM export object to be ready")
+	cmd.Flags().BoolVar(&resume, "resume", false, "When used with the 'download' option, continues a previously interrupted download by appending to the existing output file. Only raw volumes can be resumed, other formats are downloaded again from the start")
+	cmd.Flags().StringVar(&bandwidthLimit, "bandwidth-limit", "", "When used with the 'download' option, limits the download rate to the given amount of bytes per second (e.g. 50Mi)")
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 
 	return cmd
@@ -336,7 +354,7 @@ func (c *command) initVMExportInfo(vmeInfo *VMExportInfo) error {
 	// User wants the output in a file, create
 	if outputFile != "" && outputFile != "-" {
 		vmeInfo.OutputFile = outputFile
-		output, err := os.Create(vmeInfo.OutputFile)
+		output, err := openOutputFile(vmeInfo.OutputFile, resume)
 		if err != nil {
 			return err
 		}
@@ -364,6 +382,14 @@ func (c *command) initVMExportInfo(vmeInfo *VMExportInfo) error {
 	vmeInfo.OutputFormat = manifestOutputFormat
 	vmeInfo.IncludeSecret = includeSecret
 	vmeInfo.ExportManifest = exportManifest
+	vmeInfo.Resume = resume
+	if bandwidthLimit != "" {
+		limit, err := resource.ParseQuantity(bandwidthLimit)
+		if err != nil {
+			return err
+		}
+		vmeInfo.BandwidthLimit = limit.Value()
+	}
 	if portForward {
 		vmeInfo.PortForward = portForward
 		vmeInfo.Insecure = true
@@ -589,20 +615,51 @@ func downloadVolume(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMac
 		return false, err
 	}
 
-	resp, err := HandleHTTPGetRequestFn(client, vmexport, downloadUrl, vmeInfo.Insecure, vmeInfo.ServiceURL, nil)
+	// When resuming, the data already present in the output file works as the download checkpoint
+	var offset int64
+	var headers map[string]string
+	if vmeInfo.Resume {
+		if offset, err = getOutputSize(vmeInfo.OutputWriter); err != nil {
+			return false, err
+		}
+		if offset > 0 {
+			headers = map[string]string{rangeHeader: fmt.Sprintf("bytes=%d-", offset)}
+		}
+	}
+
+	resp, err := HandleHTTPGetRequestFn(client, vmexport, downloadUrl, vmeInfo.Insecure, vmeInfo.ServiceURL, headers)
 	if err != nil {
 		return false, err
 	}
 	defer resp.Body.Close()
 
 	// Check server response
-	if resp.StatusCode != http.StatusOK {
+	switch {
+	case offset > 0 && resp.StatusCode == http.StatusPartialContent:
+		printToOutput("Resuming download from byte %d\n", offset)
+	case offset > 0 && resp.StatusCode == http.StatusRequestedRangeNotSatisfiable:
+		printToOutput("Output file is already complete\n")
+		return true, nil
+	case resp.StatusCode == http.StatusOK:
+		if offset > 0 {
+			// The server ignored the range request, the whole volume is coming again
+			printToOutput("Unable to resume the download, starting over\n")
+			if err := truncateOutput(vmeInfo.OutputWriter); err != nil {
+				return false, err
+			}
+		}
+	default:
 		printToOutput("Bad status: %s\n", resp.Status)
 		return false, nil
 	}
 
 	// Lastly, copy the file to the expected output
-	if err := copyFileWithProgressBar(vmeInfo.OutputWriter, resp, vmeInfo.Decompress); err != nil {
+	if err := copyFileWithProgressBar(vmeInfo.OutputWriter, resp, vmeInfo.Decompress, vmeInfo.BandwidthLimit); err != nil {
+		if vmeInfo.Resume {
+			// Keep what was written so far, the next attempt continues from there
+			printToOutput("Download interrupted: %v\n", err)
+			return false, nil
+		}
 		return false, err
 	}
 
@@ -650,6 +707,9 @@ func GetUrlFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vme
 	if volumeNumber > 1 && vmeInfo.VolumeName == "" {
 		return "", fmt.Errorf("detected more than one downloadable volume in '%s/%s' VirtualMachineExport: Select the expected volume using the --volume flag", vmexport.Namespace, vmexport.Name)
 	}
+	// Compressed volumes are generated on the fly and cannot be resumed, so prefer
+	// the raw volume when a resumable raw download has been requested.
+	preferRaw := vmeInfo.Resume && vmeInfo.Decompress
 	for _, exportVolume := range links.Volumes {
 		// Access the requested volume
 		if volumeNumber == 1 || exportVolume.Name == vmeInfo.VolumeName {
@@ -660,9 +720,12 @@ func GetUrlFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vme
 						return "", err
 					}
 				}
+				if preferRaw && format.Format == exportv1.KubeVirtRaw {
+					break
+				}
 				// By default, we always attempt to find and get the compressed file URL,
 				// so we only break the loop when one is found.
-				if format.Format == exportv1.KubeVirtGz || format.Format == exportv1.ArchiveGz {
+				if !preferRaw && (format.Format == exportv1.KubeVirtGz || format.Format == exportv1.ArchiveGz) {
 					break
 				}
 			}
@@ -795,14 +858,18 @@ func GetHTTPClient(transport *http.Transport, insecure bool) *http.Client {
 }
 
 // copyFileWithProgressBar serves as a wrapper to copy the file with a progress bar
-func copyFileWithProgressBar(output io.Writer, resp *http.Response, decompress bool) error {
+func copyFileWithProgressBar(output io.Writer, resp *http.Response, decompress bool, bandwidthLimit int64) error {
 	var rd io.Reader
 	barTemplate := fmt.Sprintf(`{{ "Downloading file:" }} {{counters . }} {{ cycle . %s }} {{speed . }}`, progressBarCycle)
 
 	// start bar based on our template
 	bar := pb.ProgressBarTemplate(barTemplate).Start(0)
 	defer bar.Finish()
-	barRd := bar.NewProxyReader(resp.Body)
+	var body io.Reader = resp.Body
+	if bandwidthLimit > 0 {
+		body = newThrottledReader(resp.Body, bandwidthLimit)
+	}
+	barRd := bar.NewProxyReader(body)
 	rd = barRd
 	bar.Start()
 
@@ -821,6 +888,64 @@ func copyFileWithProgressBar(output io.Writer, resp *http.Response, decompress b
 	return err
 }
 
+// throttledReader limits the rate at which the underlying reader can be consumed
+type throttledReader struct {
+	rd      io.Reader
+	limiter *rate.Limiter
+}
+
+func newThrottledReader(rd io.Reader, bytesPerSecond int64) *throttledReader {
+	burst := int(min(bytesPerSecond, maxBandwidthBurst))
+	return &throttledReader{
+		rd:      rd,
+		limiter: rate.NewLimiter(rate.Limit(bytesPerSecond), burst),
+	}
+}
+
+func (t *throttledReader) Read(p []byte) (int, error) {
+	if len(p) > t.limiter.Burst() {
+		p = p[:t.limiter.Burst()]
+	}
+	n, err := t.rd.Read(p)
+	if n > 0 {
+		if waitErr := t.limiter.WaitN(context.Background(), n); waitErr != nil {
+			return n, waitErr
+		}
+	}
+	return n, err
+}
+
+// openOutputFile opens the file the volume is downloaded into. When resuming, the existing
+// content is kept and new data is appended to it.
+func openOutputFile(path string, resume bool) (*os.File, error) {
+	if !resume {
+		return os.Create(path)
+	}
+	return os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0666)
+}
+
+// getOutputSize returns the amount of bytes already written into the output
+func getOutputSize(output io.Writer) (int64, error) {
+	f, ok := output.(*os.File)
+	if !ok {
+		return 0, fmt.Errorf("unable to resume the download: output is not a file")
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		return 0, err
+	}
+	return fi.Size(), nil
+}
+
+// truncateOutput discards the content previously written into the output
+func truncateOutput(output io.Writer) error {
+	f, ok := output.(*os.File)
+	if !ok {
+		return fmt.Errorf("unable to restart the download: output is not a file")
+	}
+	return f.Truncate(0)
+}
+
 // getOrCreateTokenSecret obtains a token secret to be used along with the virtualMachineExport
 func getOrCreateTokenSecret(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport) (*k8sv1.Secret, error) {
 	// Securely randomize a 20 char string to be used as a token
@@ -1013,6 +1138,13 @@ func handleDownloadFlags() error {
 		return fmt.Errorf(ErrInvalidValue, RETRY_FLAG, "positive integers")
 	}
 
+	if bandwidthLimit != "" {
+		limit, err := resource.ParseQuantity(bandwidthLimit)
+		if err != nil || limit.Sign() <= 0 {
+			return fmt.Errorf(ErrInvalidValue, BANDWIDTH_LIMIT_FLAG, "positive quantities")
+		}
+	}
+
 	if exportManifest {
 		if volumeName != "" {
 			return fmt.Errorf(ErrIncompatibleFlag, VOLUME_FLAG, MANIFEST_FLAG)
@@ -1026,6 +1158,13 @@ func handleDownloadFlags() error {
 		if pvc != "" {
 			return fmt.Errorf(ErrIncompatibleFlag, PVC_FLAG, MANIFEST_FLAG)
 		}
+
+		if resume {
+			return fmt.Errorf(ErrIncompatibleFlag, RESUME_FLAG, MANIFEST_FLAG)
+		}
+	}
+	if resume && outputFile == "-" {
+		return fmt.Errorf("cannot use '%s' when writing the volume into stdout, use '%s <FILE>' instead", RESUME_FLAG, OUTPUT_FLAG)
 	}
 	if !exportManifest && outputFile == "" {
 		return fmt.Errorf("warning: Binary output can mess up your terminal. Use '%s -' to output into stdout anyway or consider '%s <FILE>' to save to a file", OUTPUT_FLAG, OUTPUT_FLAG)
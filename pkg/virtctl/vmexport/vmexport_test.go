@@ -20,6 +20,7 @@
 package vmexport_test
 
 import (
+	"bytes"
 	"context"
 	cryptorand "crypto/rand"
 	"errors"
@@ -29,6 +30,7 @@ import (
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -384,6 +386,10 @@ var _ = Describe("vmexport", func() {
 			Entry("Using 'manifest' with invalid output_format_flag", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.OUTPUT_FORMAT_FLAG, "json/yaml"), runDownloadCmd, vmexport.MANIFEST_FLAG, setFlag(vmexport.OUTPUT_FORMAT_FLAG, "invalid")),
 			Entry("Using 'port-forward' with invalid port", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.LOCAL_PORT_FLAG, "valid port numbers"), runDownloadCmd, vmexport.PORT_FORWARD_FLAG, setFlag(vmexport.LOCAL_PORT_FLAG, "test")),
 			Entry("Using 'format' with invalid download format", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.FORMAT_FLAG, "gzip/raw"), runDownloadCmd, setFlag(vmexport.FORMAT_FLAG, "test")),
+			Entry("Using 'resume' with manifest", fmt.Sprintf(vmexport.ErrIncompatibleFlag, vmexport.RESUME_FLAG, vmexport.MANIFEST_FLAG), runDownloadCmd, vmexport.MANIFEST_FLAG, vmexport.RESUME_FLAG),
+			Entry("Using 'resume' when writing into stdout", fmt.Sprintf("cannot use '%s' when writing the volume into stdout, use '%s <FILE>' instead", vmexport.RESUME_FLAG, vmexport.OUTPUT_FLAG), runDownloadCmd, vmexport.RESUME_FLAG, setFlag(vmexport.OUTPUT_FLAG, "-")),
+			Entry("Using 'bandwidth-limit' with invalid value", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.BANDWIDTH_LIMIT_FLAG, "positive quantities"), runDownloadCmd, setFlag(vmexport.BANDWIDTH_LIMIT_FLAG, "test")),
+			Entry("Using 'bandwidth-limit' with zero value", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.BANDWIDTH_LIMIT_FLAG, "positive quantities"), runDownloadCmd, setFlag(vmexport.BANDWIDTH_LIMIT_FLAG, "0")),
 			Entry("Downloading volume without specifying output", fmt.Sprintf("warning: Binary output can mess up your terminal. Use '%s -' to output into stdout anyway or consider '%s <FILE>' to save to a file", vmexport.OUTPUT_FLAG, vmexport.OUTPUT_FLAG), runDownloadCmd),
 		)
 	})
@@ -679,6 +685,132 @@ var _ = Describe("vmexport", func() {
 		})
 	})
 
+	Context("Resumable download", func() {
+		const length = 4096
+
+		var content []byte
+
+		BeforeEach(func() {
+			content = make([]byte, length)
+			_, err := cryptorand.Read(content)
+			Expect(err).ToNot(HaveOccurred())
+
+			vme.Status = vmeStatusReady([]exportv1.VirtualMachineExportVolume{{
+				Name: volumeName,
+				Formats: []exportv1.VirtualMachineExportVolumeFormat{{
+					Format: exportv1.KubeVirtRaw,
+					Url:    server.URL,
+				}}},
+			})
+			_, err = virtClient.ExportV1beta1().VirtualMachineExports(metav1.NamespaceDefault).Create(context.Background(), vme, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = kubeClient.CoreV1().Secrets(metav1.NamespaceDefault).Create(context.Background(), secret, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		serveContent := func(w http.ResponseWriter, r *http.Request) {
+			http.ServeContent(w, r, "disk.img", time.Time{}, bytes.NewReader(content))
+		}
+
+		It("should continue a partial download from the existing output", func() {
+			var ranges []string
+			server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				ranges = append(ranges, r.Header.Get("Range"))
+				serveContent(w, r)
+			})
+			Expect(os.WriteFile(outputPath, content[:length/4], 0600)).To(Succeed())
+
+			err := runDownloadCmd(
+				setFlag(vmexport.VOLUME_FLAG, volumeName),
+				setFlag(vmexport.OUTPUT_FLAG, outputPath),
+				vmexport.RESUME_FLAG,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ranges).To(Equal([]string{fmt.Sprintf("bytes=%d-", length/4)}))
+			Expect(os.ReadFile(outputPath)).To(Equal(content))
+		})
+
+		It("should resume from the last checkpoint when the transfer is interrupted", func() {
+			attempts := 0
+			server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts++
+				if attempts == 1 {
+					// Announce the whole volume but cut the connection halfway
+					w.Header().Set("Content-Length", strconv.Itoa(length))
+					_, err := w.Write(content[:length/2])
+					Expect(err).ToNot(HaveOccurred())
+					return
+				}
+				Expect(r.Header.Get("Range")).To(Equal(fmt.Sprintf("bytes=%d-", length/2)))
+				serveContent(w, r)
+			})
+
+			err := runDownloadCmd(
+				setFlag(vmexport.VOLUME_FLAG, volumeName),
+				setFlag(vmexport.OUTPUT_FLAG, outputPath),
+				setFlag(vmexport.RETRY_FLAG, "1"),
+				vmexport.RESUME_FLAG,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(attempts).To(Equal(2))
+			Expect(os.ReadFile(outputPath)).To(Equal(content))
+		})
+
+		It("should start over when the server does not support range requests", func() {
+			server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				_, err := w.Write(content)
+				Expect(err).ToNot(HaveOccurred())
+			})
+			Expect(os.WriteFile(outputPath, []byte("stale data"), 0600)).To(Succeed())
+
+			err := runDownloadCmd(
+				setFlag(vmexport.VOLUME_FLAG, volumeName),
+				setFlag(vmexport.OUTPUT_FLAG, outputPath),
+				vmexport.RESUME_FLAG,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(os.ReadFile(outputPath)).To(Equal(content))
+		})
+
+		It("should succeed without downloading when the output is already complete", func() {
+			server.Config.Handler = http.HandlerFunc(serveContent)
+			Expect(os.WriteFile(outputPath, content, 0600)).To(Succeed())
+
+			err := runDownloadCmd(
+				setFlag(vmexport.VOLUME_FLAG, volumeName),
+				setFlag(vmexport.OUTPUT_FLAG, outputPath),
+				vmexport.RESUME_FLAG,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(os.ReadFile(outputPath)).To(Equal(content))
+		})
+
+		It("should overwrite the existing output when not resuming", func() {
+			server.Config.Handler = http.HandlerFunc(serveContent)
+			Expect(os.WriteFile(outputPath, content[:length/4], 0600)).To(Succeed())
+
+			err := runDownloadCmd(
+				setFlag(vmexport.VOLUME_FLAG, volumeName),
+				setFlag(vmexport.OUTPUT_FLAG, outputPath),
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(os.ReadFile(outputPath)).To(Equal(content))
+		})
+
+		It("should download the volume with a bandwidth limit", func() {
+			server.Config.Handler = http.HandlerFunc(serveContent)
+
+			err := runDownloadCmd(
+				setFlag(vmexport.VOLUME_FLAG, volumeName),
+				setFlag(vmexport.OUTPUT_FLAG, outputPath),
+				setFlag(vmexport.BANDWIDTH_LIMIT_FLAG, "1Mi"),
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(os.ReadFile(outputPath)).To(Equal(content))
+		})
+	})
+
 	Context("Manifest", func() {
 		const (
 			manifestUrl = "/test/all"
@@ -971,6 +1103,33 @@ var _ = Describe("vmexport", func() {
 			Expect(url).Should(Equal("raw"))
 		})
 
+		It("Should get raw URL when resuming a raw download", func() {
+			vme.Status = vmeStatusReady([]exportv1.VirtualMachineExportVolume{{
+				Name: volumeName,
+				Formats: []exportv1.VirtualMachineExportVolumeFormat{
+					{
+						Format: exportv1.KubeVirtGz,
+						Url:    "compressed",
+					},
+					{
+						Format: exportv1.KubeVirtRaw,
+						Url:    "raw",
+					},
+				}},
+			})
+			vmeInfo := &vmexport.VMExportInfo{
+				Name:       vme.Name,
+				VolumeName: volumeName,
+				Decompress: true,
+				Resume:     true,
+			}
+
+			url, err := vmexport.GetUrlFromVirtualMachineExport(vme, vmeInfo)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(url).Should(Equal("raw"))
+			Expect(vmeInfo.Decompress).To(BeFalse())
+		})
+
 		It("Should not get any URL when there's no valid options", func() {
 			vme.Status = vmeStatusReady([]exportv1.VirtualMachineExportVolume{{
 				Name: volumeName,
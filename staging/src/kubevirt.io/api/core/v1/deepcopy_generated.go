@@ -214,6 +214,84 @@ func (in *BIOS) DeepCopy() *BIOS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BatchOperationItemResult) DeepCopyInto(out *BatchOperationItemResult) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BatchOperationItemResult.
+func (in *BatchOperationItemResult) DeepCopy() *BatchOperationItemResult {
+	if in == nil {
+		return nil
+	}
+	out := new(BatchOperationItemResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BatchOperationOptions) DeepCopyInto(out *BatchOperationOptions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxConcurrency != nil {
+		in, out := &in.MaxConcurrency, &out.MaxConcurrency
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BatchOperationOptions.
+func (in *BatchOperationOptions) DeepCopy() *BatchOperationOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(BatchOperationOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BatchOperationResult) DeepCopyInto(out *BatchOperationResult) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BatchOperationItemResult, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BatchOperationResult.
+func (in *BatchOperationResult) DeepCopy() *BatchOperationResult {
+	if in == nil {
+		return nil
+	}
+	out := new(BatchOperationResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BatchOperationResult) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BlockSize) DeepCopyInto(out *BlockSize) {
 	*out = *in
@@ -318,6 +396,22 @@ func (in *CPU) DeepCopy() *CPU {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CPUAutoscaling) DeepCopyInto(out *CPUAutoscaling) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CPUAutoscaling.
+func (in *CPUAutoscaling) DeepCopy() *CPUAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(CPUAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CPUFeature) DeepCopyInto(out *CPUFeature) {
 	*out = *in
@@ -350,6 +444,22 @@ func (in *CPUTopology) DeepCopy() *CPUTopology {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CancelDomainJobOptions) DeepCopyInto(out *CancelDomainJobOptions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CancelDomainJobOptions.
+func (in *CancelDomainJobOptions) DeepCopy() *CancelDomainJobOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(CancelDomainJobOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertConfig) DeepCopyInto(out *CertConfig) {
 	*out = *in
@@ -376,6 +486,48 @@ func (in *CertConfig) DeepCopy() *CertConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertManagerIssuerReference) DeepCopyInto(out *CertManagerIssuerReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertManagerIssuerReference.
+func (in *CertManagerIssuerReference) DeepCopy() *CertManagerIssuerReference {
+	if in == nil {
+		return nil
+	}
+	out := new(CertManagerIssuerReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChangeMediaOptions) DeepCopyInto(out *ChangeMediaOptions) {
+	*out = *in
+	if in.VolumeSource != nil {
+		in, out := &in.VolumeSource, &out.VolumeSource
+		*out = new(HotplugVolumeSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChangeMediaOptions.
+func (in *ChangeMediaOptions) DeepCopy() *ChangeMediaOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ChangeMediaOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChangedBlockTrackingSelectors) DeepCopyInto(out *ChangedBlockTrackingSelectors) {
 	*out = *in
@@ -747,6 +899,140 @@ func (in *ConfigMapVolumeSource) DeepCopy() *ConfigMapVolumeSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsoleRecordingConfiguration) DeepCopyInto(out *ConsoleRecordingConfiguration) {
+	*out = *in
+	in.Sink.DeepCopyInto(&out.Sink)
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]ConsoleRecordingPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleRecordingConfiguration.
+func (in *ConsoleRecordingConfiguration) DeepCopy() *ConsoleRecordingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsoleRecordingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsoleRecordingPVCSink) DeepCopyInto(out *ConsoleRecordingPVCSink) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleRecordingPVCSink.
+func (in *ConsoleRecordingPVCSink) DeepCopy() *ConsoleRecordingPVCSink {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsoleRecordingPVCSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsoleRecordingPolicy) DeepCopyInto(out *ConsoleRecordingPolicy) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Sessions != nil {
+		in, out := &in.Sessions, &out.Sessions
+		*out = make([]ConsoleSessionType, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleRecordingPolicy.
+func (in *ConsoleRecordingPolicy) DeepCopy() *ConsoleRecordingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsoleRecordingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsoleRecordingS3Sink) DeepCopyInto(out *ConsoleRecordingS3Sink) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleRecordingS3Sink.
+func (in *ConsoleRecordingS3Sink) DeepCopy() *ConsoleRecordingS3Sink {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsoleRecordingS3Sink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsoleRecordingSink) DeepCopyInto(out *ConsoleRecordingSink) {
+	*out = *in
+	if in.PersistentVolumeClaim != nil {
+		in, out := &in.PersistentVolumeClaim, &out.PersistentVolumeClaim
+		*out = new(ConsoleRecordingPVCSink)
+		**out = **in
+	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(ConsoleRecordingS3Sink)
+		**out = **in
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(ConsoleRecordingWebhookSink)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleRecordingSink.
+func (in *ConsoleRecordingSink) DeepCopy() *ConsoleRecordingSink {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsoleRecordingSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsoleRecordingWebhookSink) DeepCopyInto(out *ConsoleRecordingWebhookSink) {
+	*out = *in
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleRecordingWebhookSink.
+func (in *ConsoleRecordingWebhookSink) DeepCopy() *ConsoleRecordingWebhookSink {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsoleRecordingWebhookSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContainerDiskInfo) DeepCopyInto(out *ContainerDiskInfo) {
 	*out = *in
@@ -763,6 +1049,39 @@ func (in *ContainerDiskInfo) DeepCopy() *ContainerDiskInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerDiskPrePullConfiguration) DeepCopyInto(out *ContainerDiskPrePullConfiguration) {
+	*out = *in
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VirtualMachineSelector != nil {
+		in, out := &in.VirtualMachineSelector, &out.VirtualMachineSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerDiskPrePullConfiguration.
+func (in *ContainerDiskPrePullConfiguration) DeepCopy() *ContainerDiskPrePullConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerDiskPrePullConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContainerDiskSource) DeepCopyInto(out *ContainerDiskSource) {
 	*out = *in
@@ -998,6 +1317,44 @@ func (in *DataVolumeTemplateSpec) DeepCopy() *DataVolumeTemplateSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DebugAttachOptions) DeepCopyInto(out *DebugAttachOptions) {
+	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DebugAttachOptions.
+func (in *DebugAttachOptions) DeepCopy() *DebugAttachOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(DebugAttachOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DebugAttachResult) DeepCopyInto(out *DebugAttachResult) {
+	*out = *in
+	in.ExpirationTimestamp.DeepCopyInto(&out.ExpirationTimestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DebugAttachResult.
+func (in *DebugAttachResult) DeepCopy() *DebugAttachResult {
+	if in == nil {
+		return nil
+	}
+	out := new(DebugAttachResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeprecatedInterfaceMacvtap) DeepCopyInto(out *DeprecatedInterfaceMacvtap) {
 	*out = *in
@@ -1176,6 +1533,11 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.NetworkInterfaceMultiQueuePolicy != nil {
+		in, out := &in.NetworkInterfaceMultiQueuePolicy, &out.NetworkInterfaceMultiQueuePolicy
+		*out = new(NetworkQueuesPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.GPUs != nil {
 		in, out := &in.GPUs, &out.GPUs
 		*out = make([]GPU, len(*in))
@@ -1219,6 +1581,11 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 		*out = new(SoundDevice)
 		**out = **in
 	}
+	if in.Spice != nil {
+		in, out := &in.Spice, &out.Spice
+		*out = new(SpiceDevice)
+		**out = **in
+	}
 	if in.TPM != nil {
 		in, out := &in.TPM, &out.TPM
 		*out = new(TPMDevice)
@@ -1229,6 +1596,11 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 		*out = new(VideoDevice)
 		**out = **in
 	}
+	if in.VirtioChannels != nil {
+		in, out := &in.VirtioChannels, &out.VirtioChannels
+		*out = make([]VirtioChannel, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1324,6 +1696,11 @@ func (in *Disk) DeepCopyInto(out *Disk) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(DiskEncryption)
+		**out = **in
+	}
 	return
 }
 
@@ -1358,12 +1735,28 @@ func (in *DiskDevice) DeepCopyInto(out *DiskDevice) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskDevice.
-func (in *DiskDevice) DeepCopy() *DiskDevice {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskDevice.
+func (in *DiskDevice) DeepCopy() *DiskDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskEncryption) DeepCopyInto(out *DiskEncryption) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskEncryption.
+func (in *DiskEncryption) DeepCopy() *DiskEncryption {
 	if in == nil {
 		return nil
 	}
-	out := new(DiskDevice)
+	out := new(DiskEncryption)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1664,6 +2057,22 @@ func (in *EvacuateCancelOptions) DeepCopy() *EvacuateCancelOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalMacAllocator) DeepCopyInto(out *ExternalMacAllocator) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalMacAllocator.
+func (in *ExternalMacAllocator) DeepCopy() *ExternalMacAllocator {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalMacAllocator)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FeatureAPIC) DeepCopyInto(out *FeatureAPIC) {
 	*out = *in
@@ -1927,6 +2336,32 @@ func (in *FilesystemVirtiofs) DeepCopy() *FilesystemVirtiofs {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRule) DeepCopyInto(out *FirewallRule) {
+	*out = *in
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRule.
+func (in *FirewallRule) DeepCopy() *FirewallRule {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Firmware) DeepCopyInto(out *Firmware) {
 	*out = *in
@@ -2074,6 +2509,32 @@ func (in *GuestAgentCommandInfo) DeepCopy() *GuestAgentCommandInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestAgentExec) DeepCopyInto(out *GuestAgentExec) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SuccessExitCodes != nil {
+		in, out := &in.SuccessExitCodes, &out.SuccessExitCodes
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestAgentExec.
+func (in *GuestAgentExec) DeepCopy() *GuestAgentExec {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestAgentExec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GuestAgentPing) DeepCopyInto(out *GuestAgentPing) {
 	*out = *in
@@ -2090,6 +2551,32 @@ func (in *GuestAgentPing) DeepCopy() *GuestAgentPing {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestDNSConfig) DeepCopyInto(out *GuestDNSConfig) {
+	*out = *in
+	if in.Nameservers != nil {
+		in, out := &in.Nameservers, &out.Nameservers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Searches != nil {
+		in, out := &in.Searches, &out.Searches
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestDNSConfig.
+func (in *GuestDNSConfig) DeepCopy() *GuestDNSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestDNSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HPETTimer) DeepCopyInto(out *HPETTimer) {
 	*out = *in
@@ -2119,6 +2606,11 @@ func (in *Handler) DeepCopyInto(out *Handler) {
 		*out = new(corev1.ExecAction)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GuestAgentExec != nil {
+		in, out := &in.GuestAgentExec, &out.GuestAgentExec
+		*out = new(GuestAgentExec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.GuestAgentPing != nil {
 		in, out := &in.GuestAgentPing, &out.GuestAgentPing
 		*out = new(GuestAgentPing)
@@ -2168,6 +2660,27 @@ func (in *HostDevice) DeepCopy() *HostDevice {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostDeviceStatus) DeepCopyInto(out *HostDeviceStatus) {
+	*out = *in
+	if in.HotplugHostDevice != nil {
+		in, out := &in.HotplugHostDevice, &out.HotplugHostDevice
+		*out = new(HotplugHostDeviceStatus)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostDeviceStatus.
+func (in *HostDeviceStatus) DeepCopy() *HostDeviceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HostDeviceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostDisk) DeepCopyInto(out *HostDisk) {
 	*out = *in
@@ -2190,6 +2703,22 @@ func (in *HostDisk) DeepCopy() *HostDisk {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HotplugHostDeviceStatus) DeepCopyInto(out *HotplugHostDeviceStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HotplugHostDeviceStatus.
+func (in *HotplugHostDeviceStatus) DeepCopy() *HotplugHostDeviceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HotplugHostDeviceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HotplugVolumeSource) DeepCopyInto(out *HotplugVolumeSource) {
 	*out = *in
@@ -2436,6 +2965,36 @@ func (in *Interface) DeepCopyInto(out *Interface) {
 		*out = make([]Port, len(*in))
 		copy(*out, *in)
 	}
+	if in.Firewall != nil {
+		in, out := &in.Firewall, &out.Firewall
+		*out = new(InterfaceFirewall)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Offloads != nil {
+		in, out := &in.Offloads, &out.Offloads
+		*out = new(InterfaceOffloads)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Interrupts != nil {
+		in, out := &in.Interrupts, &out.Interrupts
+		*out = new(InterfaceInterrupts)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RouterAdvertisement != nil {
+		in, out := &in.RouterAdvertisement, &out.RouterAdvertisement
+		*out = new(InterfaceRouterAdvertisement)
+		**out = **in
+	}
+	if in.NetworkEmulation != nil {
+		in, out := &in.NetworkEmulation, &out.NetworkEmulation
+		*out = new(InterfaceNetworkEmulation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Mirror != nil {
+		in, out := &in.Mirror, &out.Mirror
+		*out = new(InterfaceMirror)
+		**out = **in
+	}
 	if in.BootOrder != nil {
 		in, out := &in.BootOrder, &out.BootOrder
 		*out = new(uint)
@@ -2500,86 +3059,286 @@ func (in *InterfaceBindingMethod) DeepCopyInto(out *InterfaceBindingMethod) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceBindingMethod.
-func (in *InterfaceBindingMethod) DeepCopy() *InterfaceBindingMethod {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceBindingMethod.
+func (in *InterfaceBindingMethod) DeepCopy() *InterfaceBindingMethod {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceBindingMethod)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceBindingDryRunValidation) DeepCopyInto(out *InterfaceBindingDryRunValidation) {
+	*out = *in
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceBindingDryRunValidation.
+func (in *InterfaceBindingDryRunValidation) DeepCopy() *InterfaceBindingDryRunValidation {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceBindingDryRunValidation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceBindingMigration) DeepCopyInto(out *InterfaceBindingMigration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceBindingMigration.
+func (in *InterfaceBindingMigration) DeepCopy() *InterfaceBindingMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceBindingMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceBindingPlugin) DeepCopyInto(out *InterfaceBindingPlugin) {
+	*out = *in
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(InterfaceBindingMigration)
+		**out = **in
+	}
+	if in.ComputeResourceOverhead != nil {
+		in, out := &in.ComputeResourceOverhead, &out.ComputeResourceOverhead
+		*out = new(ResourceRequirementsWithoutClaims)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SidecarResources != nil {
+		in, out := &in.SidecarResources, &out.SidecarResources
+		*out = new(ResourceRequirementsWithoutClaims)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Prerequisites != nil {
+		in, out := &in.Prerequisites, &out.Prerequisites
+		*out = new(InterfaceBindingPrerequisites)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DryRunValidation != nil {
+		in, out := &in.DryRunValidation, &out.DryRunValidation
+		*out = new(InterfaceBindingDryRunValidation)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceBindingPlugin.
+func (in *InterfaceBindingPlugin) DeepCopy() *InterfaceBindingPlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceBindingPlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceBindingPrerequisites) DeepCopyInto(out *InterfaceBindingPrerequisites) {
+	*out = *in
+	if in.FeatureGates != nil {
+		in, out := &in.FeatureGates, &out.FeatureGates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeviceResources != nil {
+		in, out := &in.DeviceResources, &out.DeviceResources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceBindingPrerequisites.
+func (in *InterfaceBindingPrerequisites) DeepCopy() *InterfaceBindingPrerequisites {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceBindingPrerequisites)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceBridge) DeepCopyInto(out *InterfaceBridge) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceBridge.
+func (in *InterfaceBridge) DeepCopy() *InterfaceBridge {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceBridge)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceEventsConfiguration) DeepCopyInto(out *InterfaceEventsConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceEventsConfiguration.
+func (in *InterfaceEventsConfiguration) DeepCopy() *InterfaceEventsConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceEventsConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceFirewall) DeepCopyInto(out *InterfaceFirewall) {
+	*out = *in
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = make([]FirewallRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceFirewall.
+func (in *InterfaceFirewall) DeepCopy() *InterfaceFirewall {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceFirewall)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceInterrupts) DeepCopyInto(out *InterfaceInterrupts) {
+	*out = *in
+	if in.Vectors != nil {
+		in, out := &in.Vectors, &out.Vectors
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceInterrupts.
+func (in *InterfaceInterrupts) DeepCopy() *InterfaceInterrupts {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceBindingMethod)
+	out := new(InterfaceInterrupts)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceBindingMigration) DeepCopyInto(out *InterfaceBindingMigration) {
+func (in *InterfaceMasquerade) DeepCopyInto(out *InterfaceMasquerade) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceBindingMigration.
-func (in *InterfaceBindingMigration) DeepCopy() *InterfaceBindingMigration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceMasquerade.
+func (in *InterfaceMasquerade) DeepCopy() *InterfaceMasquerade {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceBindingMigration)
+	out := new(InterfaceMasquerade)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceBindingPlugin) DeepCopyInto(out *InterfaceBindingPlugin) {
+func (in *InterfaceMirror) DeepCopyInto(out *InterfaceMirror) {
 	*out = *in
-	if in.Migration != nil {
-		in, out := &in.Migration, &out.Migration
-		*out = new(InterfaceBindingMigration)
-		**out = **in
-	}
-	if in.ComputeResourceOverhead != nil {
-		in, out := &in.ComputeResourceOverhead, &out.ComputeResourceOverhead
-		*out = new(ResourceRequirementsWithoutClaims)
-		(*in).DeepCopyInto(*out)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceBindingPlugin.
-func (in *InterfaceBindingPlugin) DeepCopy() *InterfaceBindingPlugin {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceMirror.
+func (in *InterfaceMirror) DeepCopy() *InterfaceMirror {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceBindingPlugin)
+	out := new(InterfaceMirror)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceBridge) DeepCopyInto(out *InterfaceBridge) {
+func (in *InterfaceNetworkEmulation) DeepCopyInto(out *InterfaceNetworkEmulation) {
 	*out = *in
+	if in.Delay != nil {
+		in, out := &in.Delay, &out.Delay
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Jitter != nil {
+		in, out := &in.Jitter, &out.Jitter
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceBridge.
-func (in *InterfaceBridge) DeepCopy() *InterfaceBridge {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceNetworkEmulation.
+func (in *InterfaceNetworkEmulation) DeepCopy() *InterfaceNetworkEmulation {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceBridge)
+	out := new(InterfaceNetworkEmulation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceMasquerade) DeepCopyInto(out *InterfaceMasquerade) {
+func (in *InterfaceOffloads) DeepCopyInto(out *InterfaceOffloads) {
 	*out = *in
+	if in.TSO != nil {
+		in, out := &in.TSO, &out.TSO
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UFO != nil {
+		in, out := &in.UFO, &out.UFO
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MergeableRxBuffers != nil {
+		in, out := &in.MergeableRxBuffers, &out.MergeableRxBuffers
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Checksum != nil {
+		in, out := &in.Checksum, &out.Checksum
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceMasquerade.
-func (in *InterfaceMasquerade) DeepCopy() *InterfaceMasquerade {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceOffloads.
+func (in *InterfaceOffloads) DeepCopy() *InterfaceOffloads {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceMasquerade)
+	out := new(InterfaceOffloads)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2600,6 +3359,22 @@ func (in *InterfacePasstBinding) DeepCopy() *InterfacePasstBinding {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceRouterAdvertisement) DeepCopyInto(out *InterfaceRouterAdvertisement) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceRouterAdvertisement.
+func (in *InterfaceRouterAdvertisement) DeepCopy() *InterfaceRouterAdvertisement {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceRouterAdvertisement)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfaceSRIOV) DeepCopyInto(out *InterfaceSRIOV) {
 	*out = *in
@@ -2666,6 +3441,11 @@ func (in *KernelBoot) DeepCopyInto(out *KernelBoot) {
 		*out = new(KernelBootContainer)
 		**out = **in
 	}
+	if in.Volume != nil {
+		in, out := &in.Volume, &out.Volume
+		*out = new(KernelBootVolume)
+		**out = **in
+	}
 	return
 }
 
@@ -2721,6 +3501,22 @@ func (in *KernelBootStatus) DeepCopy() *KernelBootStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KernelBootVolume) DeepCopyInto(out *KernelBootVolume) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KernelBootVolume.
+func (in *KernelBootVolume) DeepCopy() *KernelBootVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(KernelBootVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KernelInfo) DeepCopyInto(out *KernelInfo) {
 	*out = *in
@@ -2765,6 +3561,28 @@ func (in *KubeVirt) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeVirtCertManagerConfiguration) DeepCopyInto(out *KubeVirtCertManagerConfiguration) {
+	*out = *in
+	out.IssuerRef = in.IssuerRef
+	if in.Certificate != nil {
+		in, out := &in.Certificate, &out.Certificate
+		*out = new(CertConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeVirtCertManagerConfiguration.
+func (in *KubeVirtCertManagerConfiguration) DeepCopy() *KubeVirtCertManagerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeVirtCertManagerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeVirtCertificateRotateStrategy) DeepCopyInto(out *KubeVirtCertificateRotateStrategy) {
 	*out = *in
@@ -2773,6 +3591,11 @@ func (in *KubeVirtCertificateRotateStrategy) DeepCopyInto(out *KubeVirtCertifica
 		*out = new(KubeVirtSelfSignConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CertManager != nil {
+		in, out := &in.CertManager, &out.CertManager
+		*out = new(KubeVirtCertManagerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2981,6 +3804,41 @@ func (in *KubeVirtConfiguration) DeepCopyInto(out *KubeVirtConfiguration) {
 		*out = new(RoleAggregationStrategy)
 		**out = **in
 	}
+	if in.SubresourceRateLimits != nil {
+		in, out := &in.SubresourceRateLimits, &out.SubresourceRateLimits
+		*out = new(SubresourceRateLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoadAwareRebalancing != nil {
+		in, out := &in.LoadAwareRebalancing, &out.LoadAwareRebalancing
+		*out = new(LoadAwareRebalancingConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConsoleRecording != nil {
+		in, out := &in.ConsoleRecording, &out.ConsoleRecording
+		*out = new(ConsoleRecordingConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VCPUAutoscaling != nil {
+		in, out := &in.VCPUAutoscaling, &out.VCPUAutoscaling
+		*out = new(VCPUAutoscalingConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ContainerDiskPrePull != nil {
+		in, out := &in.ContainerDiskPrePull, &out.ContainerDiskPrePull
+		*out = new(ContainerDiskPrePullConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VirtControllerSharding != nil {
+		in, out := &in.VirtControllerSharding, &out.VirtControllerSharding
+		*out = new(VirtControllerShardingConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VMIStatusUpdates != nil {
+		in, out := &in.VMIStatusUpdates, &out.VMIStatusUpdates
+		*out = new(VMIStatusUpdatesConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3133,6 +3991,13 @@ func (in *KubeVirtStatus) DeepCopyInto(out *KubeVirtStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.NetworkBindings != nil {
+		in, out := &in.NetworkBindings, &out.NetworkBindings
+		*out = make([]NetworkBindingPluginStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -3188,7 +4053,7 @@ func (in *LaunchSecurity) DeepCopyInto(out *LaunchSecurity) {
 	if in.SNP != nil {
 		in, out := &in.SNP, &out.SNP
 		*out = new(SEVSNP)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.TDX != nil {
 		in, out := &in.TDX, &out.TDX
@@ -3234,6 +4099,42 @@ func (in *LiveUpdateConfiguration) DeepCopy() *LiveUpdateConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadAwareRebalancingConfiguration) DeepCopyInto(out *LoadAwareRebalancingConfiguration) {
+	*out = *in
+	if in.CPUUtilizationThreshold != nil {
+		in, out := &in.CPUUtilizationThreshold, &out.CPUUtilizationThreshold
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.CPUStealThreshold != nil {
+		in, out := &in.CPUStealThreshold, &out.CPUStealThreshold
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Cooldown != nil {
+		in, out := &in.Cooldown, &out.Cooldown
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxParallelMigrations != nil {
+		in, out := &in.MaxParallelMigrations, &out.MaxParallelMigrations
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadAwareRebalancingConfiguration.
+func (in *LoadAwareRebalancingConfiguration) DeepCopy() *LoadAwareRebalancingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadAwareRebalancingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LogVerbosity) DeepCopyInto(out *LogVerbosity) {
 	*out = *in
@@ -3273,6 +4174,27 @@ func (in *LunTarget) DeepCopy() *LunTarget {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MacGenerationPolicy) DeepCopyInto(out *MacGenerationPolicy) {
+	*out = *in
+	if in.External != nil {
+		in, out := &in.External, &out.External
+		*out = new(ExternalMacAllocator)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MacGenerationPolicy.
+func (in *MacGenerationPolicy) DeepCopy() *MacGenerationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MacGenerationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Machine) DeepCopyInto(out *Machine) {
 	*out = *in
@@ -3366,6 +4288,11 @@ func (in *Memory) DeepCopyInto(out *Memory) {
 		*out = new(ReservedOverhead)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Swap != nil {
+		in, out := &in.Swap, &out.Swap
+		*out = new(MemorySwap)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3432,6 +4359,50 @@ func (in *MemoryStatus) DeepCopy() *MemoryStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemorySwap) DeepCopyInto(out *MemorySwap) {
+	*out = *in
+	if in.Limit != nil {
+		in, out := &in.Limit, &out.Limit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemorySwap.
+func (in *MemorySwap) DeepCopy() *MemorySwap {
+	if in == nil {
+		return nil
+	}
+	out := new(MemorySwap)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetadataService) DeepCopyInto(out *MetadataService) {
+	*out = *in
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetadataService.
+func (in *MetadataService) DeepCopy() *MetadataService {
+	if in == nil {
+		return nil
+	}
+	out := new(MetadataService)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MigrateOptions) DeepCopyInto(out *MigrateOptions) {
 	*out = *in
@@ -3547,6 +4518,22 @@ func (in *MigrationConfiguration) DeepCopy() *MigrationConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationDiskTransferProgress) DeepCopyInto(out *MigrationDiskTransferProgress) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationDiskTransferProgress.
+func (in *MigrationDiskTransferProgress) DeepCopy() *MigrationDiskTransferProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationDiskTransferProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MultusNetwork) DeepCopyInto(out *MultusNetwork) {
 	*out = *in
@@ -3600,6 +4587,22 @@ func (in *NUMAGuestMappingPassthrough) DeepCopy() *NUMAGuestMappingPassthrough {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NativeNetwork) DeepCopyInto(out *NativeNetwork) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NativeNetwork.
+func (in *NativeNetwork) DeepCopy() *NativeNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(NativeNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Network) DeepCopyInto(out *Network) {
 	*out = *in
@@ -3617,6 +4620,27 @@ func (in *Network) DeepCopy() *Network {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkBindingPluginStatus) DeepCopyInto(out *NetworkBindingPluginStatus) {
+	*out = *in
+	if in.UnsatisfiedPrerequisites != nil {
+		in, out := &in.UnsatisfiedPrerequisites, &out.UnsatisfiedPrerequisites
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkBindingPluginStatus.
+func (in *NetworkBindingPluginStatus) DeepCopy() *NetworkBindingPluginStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkBindingPluginStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkConfiguration) DeepCopyInto(out *NetworkConfiguration) {
 	*out = *in
@@ -3637,15 +4661,61 @@ func (in *NetworkConfiguration) DeepCopyInto(out *NetworkConfiguration) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.MacGeneration != nil {
+		in, out := &in.MacGeneration, &out.MacGeneration
+		*out = new(MacGenerationPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InterfaceEvents != nil {
+		in, out := &in.InterfaceEvents, &out.InterfaceEvents
+		*out = new(InterfaceEventsConfiguration)
+		**out = **in
+	}
+	if in.PromiscuousInterfacesNamespaceLabelSelector != nil {
+		in, out := &in.PromiscuousInterfacesNamespaceLabelSelector, &out.PromiscuousInterfacesNamespaceLabelSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkInterfaceMultiQueuePolicy != nil {
+		in, out := &in.NetworkInterfaceMultiQueuePolicy, &out.NetworkInterfaceMultiQueuePolicy
+		*out = new(NetworkQueuesPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkConfiguration.
+func (in *NetworkConfiguration) DeepCopy() *NetworkConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkQueuesPolicy) DeepCopyInto(out *NetworkQueuesPolicy) {
+	*out = *in
+	if in.VCPUsPerQueue != nil {
+		in, out := &in.VCPUsPerQueue, &out.VCPUsPerQueue
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxQueues != nil {
+		in, out := &in.MaxQueues, &out.MaxQueues
+		*out = new(uint32)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkConfiguration.
-func (in *NetworkConfiguration) DeepCopy() *NetworkConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkQueuesPolicy.
+func (in *NetworkQueuesPolicy) DeepCopy() *NetworkQueuesPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkConfiguration)
+	out := new(NetworkQueuesPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3663,6 +4733,11 @@ func (in *NetworkSource) DeepCopyInto(out *NetworkSource) {
 		*out = new(MultusNetwork)
 		**out = **in
 	}
+	if in.Native != nil {
+		in, out := &in.Native, &out.Native
+		*out = new(NativeNetwork)
+		**out = **in
+	}
 	return
 }
 
@@ -4526,6 +5601,11 @@ func (in *SEVPolicy) DeepCopy() *SEVPolicy {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SEVSNP) DeepCopyInto(out *SEVSNP) {
 	*out = *in
+	if in.Attestation != nil {
+		in, out := &in.Attestation, &out.Attestation
+		*out = new(SEVAttestation)
+		**out = **in
+	}
 	return
 }
 
@@ -4539,6 +5619,22 @@ func (in *SEVSNP) DeepCopy() *SEVSNP {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SEVSNPSessionOptions) DeepCopyInto(out *SEVSNPSessionOptions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SEVSNPSessionOptions.
+func (in *SEVSNPSessionOptions) DeepCopy() *SEVSNPSessionOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(SEVSNPSessionOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SEVSecretOptions) DeepCopyInto(out *SEVSecretOptions) {
 	*out = *in
@@ -4747,6 +5843,22 @@ func (in *SoundDevice) DeepCopy() *SoundDevice {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpiceDevice) DeepCopyInto(out *SpiceDevice) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpiceDevice.
+func (in *SpiceDevice) DeepCopy() *SpiceDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(SpiceDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StartOptions) DeepCopyInto(out *StartOptions) {
 	*out = *in
@@ -4822,6 +5934,32 @@ func (in *StorageMigratedVolumeInfo) DeepCopy() *StorageMigratedVolumeInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubresourceRateLimits) DeepCopyInto(out *SubresourceRateLimits) {
+	*out = *in
+	if in.PerUser != nil {
+		in, out := &in.PerUser, &out.PerUser
+		*out = new(TokenBucketRateLimiter)
+		**out = **in
+	}
+	if in.PerNamespace != nil {
+		in, out := &in.PerNamespace, &out.PerNamespace
+		*out = new(TokenBucketRateLimiter)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubresourceRateLimits.
+func (in *SubresourceRateLimits) DeepCopy() *SubresourceRateLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(SubresourceRateLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SupportContainerResources) DeepCopyInto(out *SupportContainerResources) {
 	*out = *in
@@ -5243,6 +6381,42 @@ func (in *UtilityVolume) DeepCopy() *UtilityVolume {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VCPUAutoscalingConfiguration) DeepCopyInto(out *VCPUAutoscalingConfiguration) {
+	*out = *in
+	if in.CPUUtilizationThreshold != nil {
+		in, out := &in.CPUUtilizationThreshold, &out.CPUUtilizationThreshold
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.CPUStealThreshold != nil {
+		in, out := &in.CPUStealThreshold, &out.CPUStealThreshold
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Cooldown != nil {
+		in, out := &in.Cooldown, &out.Cooldown
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VCPUAutoscalingConfiguration.
+func (in *VCPUAutoscalingConfiguration) DeepCopy() *VCPUAutoscalingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(VCPUAutoscalingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VGPUDisplayOptions) DeepCopyInto(out *VGPUDisplayOptions) {
 	*out = *in
@@ -5306,6 +6480,37 @@ func (in *VMISelector) DeepCopy() *VMISelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMIStatusUpdatesConfiguration) DeepCopyInto(out *VMIStatusUpdatesConfiguration) {
+	*out = *in
+	if in.InterfacesInterval != nil {
+		in, out := &in.InterfacesInterval, &out.InterfacesInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.GuestAgentInfoInterval != nil {
+		in, out := &in.GuestAgentInfoInterval, &out.GuestAgentInfoInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MigrationProgressInterval != nil {
+		in, out := &in.MigrationProgressInterval, &out.MigrationProgressInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMIStatusUpdatesConfiguration.
+func (in *VMIStatusUpdatesConfiguration) DeepCopy() *VMIStatusUpdatesConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(VMIStatusUpdatesConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VSOCKOptions) DeepCopyInto(out *VSOCKOptions) {
 	*out = *in
@@ -5343,6 +6548,27 @@ func (in *VideoDevice) DeepCopy() *VideoDevice {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtControllerShardingConfiguration) DeepCopyInto(out *VirtControllerShardingConfiguration) {
+	*out = *in
+	if in.Shards != nil {
+		in, out := &in.Shards, &out.Shards
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtControllerShardingConfiguration.
+func (in *VirtControllerShardingConfiguration) DeepCopy() *VirtControllerShardingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtControllerShardingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtTemplateDeployment) DeepCopyInto(out *VirtTemplateDeployment) {
 	*out = *in
@@ -5364,6 +6590,22 @@ func (in *VirtTemplateDeployment) DeepCopy() *VirtTemplateDeployment {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtioChannel) DeepCopyInto(out *VirtioChannel) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtioChannel.
+func (in *VirtioChannel) DeepCopy() *VirtioChannel {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtioChannel)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachine) DeepCopyInto(out *VirtualMachine) {
 	*out = *in
@@ -5392,6 +6634,30 @@ func (in *VirtualMachine) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineCPURecommendation) DeepCopyInto(out *VirtualMachineCPURecommendation) {
+	*out = *in
+	if in.OverloadedSince != nil {
+		in, out := &in.OverloadedSince, &out.OverloadedSince
+		*out = (*in).DeepCopy()
+	}
+	if in.LastScaleTime != nil {
+		in, out := &in.LastScaleTime, &out.LastScaleTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineCPURecommendation.
+func (in *VirtualMachineCPURecommendation) DeepCopy() *VirtualMachineCPURecommendation {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineCPURecommendation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineCondition) DeepCopyInto(out *VirtualMachineCondition) {
 	*out = *in
@@ -5477,6 +6743,23 @@ func (in *VirtualMachineInstanceBackupStatus) DeepCopy() *VirtualMachineInstance
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceBootPhaseTimestamp) DeepCopyInto(out *VirtualMachineInstanceBootPhaseTimestamp) {
+	*out = *in
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceBootPhaseTimestamp.
+func (in *VirtualMachineInstanceBootPhaseTimestamp) DeepCopy() *VirtualMachineInstanceBootPhaseTimestamp {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceBootPhaseTimestamp)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceCommonMigrationState) DeepCopyInto(out *VirtualMachineInstanceCommonMigrationState) {
 	*out = *in
@@ -5536,6 +6819,53 @@ func (in *VirtualMachineInstanceCondition) DeepCopy() *VirtualMachineInstanceCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceDomainJob) DeepCopyInto(out *VirtualMachineInstanceDomainJob) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceDomainJob.
+func (in *VirtualMachineInstanceDomainJob) DeepCopy() *VirtualMachineInstanceDomainJob {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceDomainJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceDomainJobList) DeepCopyInto(out *VirtualMachineInstanceDomainJobList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineInstanceDomainJob, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceDomainJobList.
+func (in *VirtualMachineInstanceDomainJobList) DeepCopy() *VirtualMachineInstanceDomainJobList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceDomainJobList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineInstanceDomainJobList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceFileSystem) DeepCopyInto(out *VirtualMachineInstanceFileSystem) {
 	*out = *in
@@ -5639,6 +6969,11 @@ func (in *VirtualMachineInstanceGuestAgentInfo) DeepCopyInto(out *VirtualMachine
 		copy(*out, *in)
 	}
 	out.OS = in.OS
+	if in.Drivers != nil {
+		in, out := &in.Drivers, &out.Drivers
+		*out = make([]VirtualMachineInstanceGuestOSDriver, len(*in))
+		copy(*out, *in)
+	}
 	if in.UserList != nil {
 		in, out := &in.UserList, &out.UserList
 		*out = make([]VirtualMachineInstanceGuestOSUser, len(*in))
@@ -5666,6 +7001,22 @@ func (in *VirtualMachineInstanceGuestAgentInfo) DeepCopyObject() runtime.Object
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceGuestOSDriver) DeepCopyInto(out *VirtualMachineInstanceGuestOSDriver) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceGuestOSDriver.
+func (in *VirtualMachineInstanceGuestOSDriver) DeepCopy() *VirtualMachineInstanceGuestOSDriver {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceGuestOSDriver)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceGuestOSInfo) DeepCopyInto(out *VirtualMachineInstanceGuestOSInfo) {
 	*out = *in
@@ -5988,6 +7339,11 @@ func (in *VirtualMachineInstanceMigrationState) DeepCopyInto(out *VirtualMachine
 		x := (*in).DeepCopy()
 		*out = &x
 	}
+	if in.DiskTransferProgress != nil {
+		in, out := &in.DiskTransferProgress, &out.DiskTransferProgress
+		*out = new(MigrationDiskTransferProgress)
+		**out = **in
+	}
 	return
 }
 
@@ -6246,6 +7602,36 @@ func (in *VirtualMachineInstanceProfile) DeepCopy() *VirtualMachineInstanceProfi
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceQemuCommandLine) DeepCopyInto(out *VirtualMachineInstanceQemuCommandLine) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceQemuCommandLine.
+func (in *VirtualMachineInstanceQemuCommandLine) DeepCopy() *VirtualMachineInstanceQemuCommandLine {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceQemuCommandLine)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineInstanceQemuCommandLine) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceReplicaSet) DeepCopyInto(out *VirtualMachineInstanceReplicaSet) {
 	*out = *in
@@ -6453,6 +7839,16 @@ func (in *VirtualMachineInstanceSpec) DeepCopyInto(out *VirtualMachineInstanceSp
 		*out = new(corev1.PodDNSConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GuestDNS != nil {
+		in, out := &in.GuestDNS, &out.GuestDNS
+		*out = new(GuestDNSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MetadataService != nil {
+		in, out := &in.MetadataService, &out.MetadataService
+		*out = new(MetadataService)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AccessCredentials != nil {
 		in, out := &in.AccessCredentials, &out.AccessCredentials
 		*out = make([]AccessCredential, len(*in))
@@ -6504,6 +7900,13 @@ func (in *VirtualMachineInstanceStatus) DeepCopyInto(out *VirtualMachineInstance
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.BootPhaseTimestamps != nil {
+		in, out := &in.BootPhaseTimestamps, &out.BootPhaseTimestamps
+		*out = make([]VirtualMachineInstanceBootPhaseTimestamp, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Interfaces != nil {
 		in, out := &in.Interfaces, &out.Interfaces
 		*out = make([]VirtualMachineInstanceNetworkInterface, len(*in))
@@ -6512,6 +7915,11 @@ func (in *VirtualMachineInstanceStatus) DeepCopyInto(out *VirtualMachineInstance
 		}
 	}
 	out.GuestOSInfo = in.GuestOSInfo
+	if in.GuestDrivers != nil {
+		in, out := &in.GuestDrivers, &out.GuestDrivers
+		*out = make([]VirtualMachineInstanceGuestOSDriver, len(*in))
+		copy(*out, *in)
+	}
 	if in.MigrationState != nil {
 		in, out := &in.MigrationState, &out.MigrationState
 		*out = new(VirtualMachineInstanceMigrationState)
@@ -6536,6 +7944,13 @@ func (in *VirtualMachineInstanceStatus) DeepCopyInto(out *VirtualMachineInstance
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.HostDeviceStatuses != nil {
+		in, out := &in.HostDeviceStatuses, &out.HostDeviceStatuses
+		*out = make([]HostDeviceStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.KernelBootStatus != nil {
 		in, out := &in.KernelBootStatus, &out.KernelBootStatus
 		*out = new(KernelBootStatus)
@@ -6737,6 +8152,11 @@ func (in *VirtualMachineSpec) DeepCopyInto(out *VirtualMachineSpec) {
 		*out = new(UpdateVolumesStrategy)
 		**out = **in
 	}
+	if in.CPUAutoscaling != nil {
+		in, out := &in.CPUAutoscaling, &out.CPUAutoscaling
+		*out = new(CPUAutoscaling)
+		**out = **in
+	}
 	return
 }
 
@@ -6867,6 +8287,21 @@ func (in *VirtualMachineStatus) DeepCopyInto(out *VirtualMachineStatus) {
 		*out = new(InstancetypeStatusRef)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GuestOSInfo != nil {
+		in, out := &in.GuestOSInfo, &out.GuestOSInfo
+		*out = new(VirtualMachineInstanceGuestOSInfo)
+		**out = **in
+	}
+	if in.GuestDrivers != nil {
+		in, out := &in.GuestDrivers, &out.GuestDrivers
+		*out = make([]VirtualMachineInstanceGuestOSDriver, len(*in))
+		copy(*out, *in)
+	}
+	if in.CPURecommendation != nil {
+		in, out := &in.CPURecommendation, &out.CPURecommendation
+		*out = new(VirtualMachineCPURecommendation)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
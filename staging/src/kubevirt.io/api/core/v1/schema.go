@@ -24,6 +24,7 @@ import (
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -47,6 +48,8 @@ const (
 	DiskErrorPolicyIgnore   DiskErrorPolicy = "ignore"
 	DiskErrorPolicyReport   DiskErrorPolicy = "report"
 	DiskErrorPolicyEnospace DiskErrorPolicy = "enospace"
+	DiskErrorPolicyRetry    DiskErrorPolicy = "retry"
+	DiskErrorPolicyPause    DiskErrorPolicy = "pause"
 )
 
 type PanicDeviceModel string
@@ -323,6 +326,20 @@ type KernelBootContainer struct {
 	InitrdPath string `json:"initrdPath,omitempty"`
 }
 
+// If set, the VM will be booted from the defined kernel / initrd stored on a volume.
+type KernelBootVolume struct {
+	// Name of the volume that contains initrd / kernel files.
+	// It must match a PersistentVolumeClaim or DataVolume volume of the VMI in filesystem mode,
+	// which is not used by any disk or filesystem.
+	Name string `json:"name"`
+	// The fully-qualified path to the kernel image in the volume
+	//+optional
+	KernelPath string `json:"kernelPath,omitempty"`
+	// the fully-qualified path to the ramdisk image in the volume
+	//+optional
+	InitrdPath string `json:"initrdPath,omitempty"`
+}
+
 // Represents the firmware blob used to assist in the kernel boot process.
 // Used for setting the kernel, initrd and command line arguments
 type KernelBoot struct {
@@ -330,6 +347,10 @@ type KernelBoot struct {
 	KernelArgs string `json:"kernelArgs,omitempty"`
 	// Container defines the container that containes kernel artifacts
 	Container *KernelBootContainer `json:"container,omitempty"`
+	// Volume defines the PersistentVolumeClaim or DataVolume volume that contains kernel artifacts.
+	// Container and Volume are mutually exclusive.
+	// +optional
+	Volume *KernelBootVolume `json:"volume,omitempty"`
 }
 
 type ResourceRequirements struct {
@@ -442,6 +463,34 @@ type Memory struct {
 	// and its characteristics.
 	// +optional
 	ReservedOverhead *ReservedOverhead `json:"reservedOverhead,omitempty"`
+	// Swap allows the VMI to use the swap of the node, for memory-bursty but latency-tolerant workloads.
+	// The swap has to be enabled on the node.
+	// +optional
+	Swap *MemorySwap `json:"swap,omitempty"`
+}
+
+// MemorySwapPolicy defines how swapped out memory of the VMI is stored on the node.
+type MemorySwapPolicy string
+
+const (
+	// MemorySwapPolicySwap lets swapped out memory be written to the swap devices of the node,
+	// it is first compressed in memory if zswap is enabled on the node.
+	MemorySwapPolicySwap MemorySwapPolicy = "Swap"
+	// MemorySwapPolicyZswap keeps swapped out memory compressed in memory by zswap, it is never
+	// written back to the swap devices of the node.
+	MemorySwapPolicyZswap MemorySwapPolicy = "Zswap"
+)
+
+// MemorySwap configures the swap of the node used by the VMI.
+type MemorySwap struct {
+	// Policy defines how swapped out memory is stored, Swap or Zswap.
+	// Defaults to Swap.
+	// +optional
+	Policy MemorySwapPolicy `json:"policy,omitempty"`
+	// Limit is the maximum amount of swap used by the VMI.
+	// Defaults to no limit.
+	// +optional
+	Limit *resource.Quantity `json:"limit,omitempty"`
 }
 
 type MemoryStatus struct {
@@ -580,6 +629,11 @@ type Devices struct {
 	// If specified, virtual network interfaces configured with a virtio bus will also enable the vhost multiqueue feature for network devices. The number of queues created depends on additional factors of the VirtualMachineInstance, like the number of guest CPUs.
 	// +optional
 	NetworkInterfaceMultiQueue *bool `json:"networkInterfaceMultiqueue,omitempty"`
+	// NetworkInterfaceMultiQueuePolicy controls how many queue pairs are given to the multi-queue
+	// network interfaces, relative to the number of guest vCPUs.
+	// When not set, the cluster wide policy applies, and each vCPU gets its own queue pair.
+	// +optional
+	NetworkInterfaceMultiQueuePolicy *NetworkQueuesPolicy `json:"networkInterfaceMultiqueuePolicy,omitempty"`
 	//Whether to attach a GPU device to the vmi.
 	// +optional
 	// +listType=atomic
@@ -605,14 +659,36 @@ type Devices struct {
 	// Whether to emulate a sound device.
 	// +optional
 	Sound *SoundDevice `json:"sound,omitempty"`
+	// Whether to attach a SPICE graphics device, reachable through the spice subresource.
+	// +optional
+	Spice *SpiceDevice `json:"spice,omitempty"`
 	// Whether to emulate a TPM device.
 	// +optional
 	TPM *TPMDevice `json:"tpm,omitempty"`
 	// Video describes the video device configuration for the vmi.
 	// +optional
 	Video *VideoDevice `json:"video,omitempty"`
+	// VirtioChannels lists the named virtio-serial channels exposed to the guest, alongside the serial console.
+	// Each channel is reachable through its own websocket subresource, enabling out-of-band management agents.
+	// +optional
+	// +listType=atomic
+	VirtioChannels []VirtioChannel `json:"virtioChannels,omitempty"`
+}
+
+// VirtioChannel represents a virtio-serial port, exposed in the guest as /dev/virtio-ports/io.kubevirt.<name>.
+type VirtioChannel struct {
+	// Name of the channel, used to reach it through the channel subresource.
+	// Must be a DNS-1123 label and unique within the VMI.
+	Name string `json:"name"`
 }
 
+const (
+	// Represents the upper limit of virtio channels allowed on a VMI.
+	VirtioChannelsMaxNumberOf = 8
+	// Prefix of the virtio-serial port name the guest sees for each virtio channel.
+	VirtioChannelGuestNamePrefix = "io.kubevirt."
+)
+
 // Represent a subset of client devices that can be accessed by VMI. At the
 // moment only, USB devices using Usbredir's library and tooling. Another fit
 // would be a smartcard with libcacard.
@@ -639,6 +715,23 @@ type SoundDevice struct {
 	Model string `json:"model,omitempty"`
 }
 
+// Represents the user's configuration of the SPICE graphics device of the VMI.
+type SpiceDevice struct {
+	// Audio streams the output of the VMI sound device over the SPICE connection.
+	// An ich9 sound device is emulated if none is set.
+	// +optional
+	Audio bool `json:"audio,omitempty"`
+	// USBRedirection attaches SpiceUSBRedirectionMaxNumberOf USB redirection
+	// channels, multiplexed over the SPICE connection.
+	// +optional
+	USBRedirection bool `json:"usbRedirection,omitempty"`
+}
+
+// Represents the upper limit of USB devices redirected over SPICE.
+const (
+	SpiceUSBRedirectionMaxNumberOf = 4
+)
+
 type TPMDevice struct {
 	// Enabled allows a user to explicitly disable the vTPM even when one is enabled by a preference referenced by the VirtualMachine
 	// Defaults to True
@@ -705,6 +798,11 @@ type GPU struct {
 	// If specified, the virtual network interface address and its tag will be provided to the guest via config drive
 	// +optional
 	Tag string `json:"tag,omitempty"`
+	// AttachIOMMUGroup requests that all the devices sharing the IOMMU group of the
+	// allocated PCI device are passed through to the guest along with it.
+	// The VMI fails to start if any of them is in use by a host driver.
+	// +optional
+	AttachIOMMUGroup bool `json:"attachIOMMUGroup,omitempty"`
 }
 
 type ClaimRequest struct {
@@ -754,6 +852,11 @@ type HostDevice struct {
 	// If specified, the virtual network interface address and its tag will be provided to the guest via config drive
 	// +optional
 	Tag string `json:"tag,omitempty"`
+	// AttachIOMMUGroup requests that all the devices sharing the IOMMU group of the
+	// allocated PCI device are passed through to the guest along with it.
+	// The VMI fails to start if any of them is in use by a host driver.
+	// +optional
+	AttachIOMMUGroup bool `json:"attachIOMMUGroup,omitempty"`
 }
 
 type Disk struct {
@@ -797,13 +900,27 @@ type Disk struct {
 	// If specified the disk is made sharable and multiple write from different VMs are permitted
 	// +optional
 	Shareable *bool `json:"shareable,omitempty"`
-	// If specified, it can change the default error policy (stop) for the disk
+	// If specified, it can change the default error policy (stop) for the disk.
+	// With stop, which is also used if not specified, and with retry, a VMI paused on I/O errors
+	// is resumed automatically with a backoff until the storage recovers.
+	// With pause it stays paused until it is unpaused.
 	// +optional
 	ErrorPolicy *DiskErrorPolicy `json:"errorPolicy,omitempty"`
 	// ChangedBlockTracking indicates this disk should have CBT option
 	// Defaults to false.
 	// +optional
 	ChangedBlockTracking *bool `json:"changedBlockTracking,omitempty"`
+	// Encryption opens the disk as a LUKS encrypted volume with the passphrase
+	// stored in a Secret. The passphrase is handed to QEMU through libvirt secrets.
+	// +optional
+	Encryption *DiskEncryption `json:"encryption,omitempty"`
+}
+
+// DiskEncryption references the Secret holding the LUKS passphrase of a disk.
+type DiskEncryption struct {
+	// SecretName is the name of a Secret in the namespace of the VMI.
+	// The passphrase is read from the "passphrase" key of the Secret.
+	SecretName string `json:"secretName"`
 }
 
 // CustomBlockSize represents the desired logical and physical block size for a VM disk.
@@ -883,6 +1000,20 @@ type SEVPolicy struct {
 }
 
 type SEVSNP struct {
+	// If specified, run the attestation process for a vmi.
+	// The launch is held until the guest owner provides the ID block and its authentication information.
+	// +optional
+	Attestation *SEVAttestation `json:"attestation,omitempty"`
+	// Base64 encoded ID block, holding the expected launch measurement of the guest as signed by the guest owner.
+	// The guest is not launched if its measurement does not match.
+	// +optional
+	IDBlock string `json:"idBlock,omitempty"`
+	// Base64 encoded ID authentication information, holding the signature of the ID block.
+	// +optional
+	IDAuth string `json:"idAuth,omitempty"`
+	// Base64 encoded 32 bytes provided by the guest owner, included as is in the attestation reports of the guest.
+	// +optional
+	HostData string `json:"hostData,omitempty"`
 }
 
 type SEVAttestation struct {
@@ -1456,6 +1587,47 @@ type Interface struct {
 	Binding *PluginBinding `json:"binding,omitempty"`
 	// List of ports to be forwarded to the virtual machine.
 	Ports []Port `json:"ports,omitempty"`
+	// PortsEnforcement defines how the listed ports are exposed.
+	// With Strict, only the listed ports are forwarded to the guest, any other incoming traffic
+	// is dropped and the list of ports can be updated while the VM is running.
+	// Supported only with the masquerade binding.
+	// +optional
+	PortsEnforcement PortsEnforcement `json:"portsEnforcement,omitempty"`
+	// Firewall defines the rules filtering the incoming traffic of the interface.
+	// Supported only with the masquerade binding.
+	// +optional
+	Firewall *InterfaceFirewall `json:"firewall,omitempty"`
+	// Offloads toggles the offloads the host applies to the traffic of the interface.
+	// Supported only with the virtio model. Offloads which are not specified keep the hypervisor defaults.
+	// +optional
+	Offloads *InterfaceOffloads `json:"offloads,omitempty"`
+	// Interrupts configures how the virtio device of the interface interrupts the guest, e.g. to give the
+	// queues of a high queue count vDPA NIC enough MSI-X vectors. It applies to the interfaces generated by
+	// network binding plugins as well. Supported only with the virtio model.
+	// +optional
+	Interrupts *InterfaceInterrupts `json:"interrupts,omitempty"`
+	// RouterAdvertisement enables IPv6 router advertisements to the guest, announcing the pod
+	// as its default router and letting the guest get its IPv6 address over DHCPv6.
+	// Supported only with the masquerade binding.
+	// +optional
+	RouterAdvertisement *InterfaceRouterAdvertisement `json:"routerAdvertisement,omitempty"`
+	// NetworkEmulation degrades the traffic sent to the guest through the interface, adding delay,
+	// jitter and packet loss for chaos testing of the guest workloads.
+	// Supported only with the bridge and masquerade bindings.
+	// +optional
+	NetworkEmulation *InterfaceNetworkEmulation `json:"networkEmulation,omitempty"`
+	// Mirror copies the traffic of the interface to a monitoring network, where an IDS or
+	// monitoring appliance (VM or pod) inspects it.
+	// Supported only with the bridge and masquerade bindings.
+	// +optional
+	Mirror *InterfaceMirror `json:"mirror,omitempty"`
+	// Promiscuous declares that the guest uses the interface as a trunk or in promiscuous mode,
+	// sending and receiving frames of MAC addresses other than its own, as nested hypervisors and VNFs do.
+	// The ports of the backing bridge are set in promiscuous mode to let these frames through.
+	// Supported only with the bridge binding, in namespaces selected by the
+	// promiscuousInterfacesNamespaceLabelSelector of the KubeVirt network configuration.
+	// +optional
+	Promiscuous bool `json:"promiscuous,omitempty"`
 	// Interface MAC address. For example: de:ad:00:00:be:af or DE-AD-00-00-BE-AF.
 	MacAddress string `json:"macAddress,omitempty"`
 	// BootOrder is an integer value > 0, used to determine ordering of boot devices.
@@ -1595,6 +1767,107 @@ type PluginBinding struct {
 	Name string `json:"name"`
 }
 
+// InterfaceFirewall defines the L3/L4 rules allowing incoming connections to the virtual machine.
+// Incoming connections not matching any rule are dropped, while replies to connections
+// initiated by the virtual machine are always allowed.
+type InterfaceFirewall struct {
+	// Ingress lists the rules allowing incoming connections.
+	// +optional
+	Ingress []FirewallRule `json:"ingress,omitempty"`
+}
+
+// FirewallRule allows the incoming connections matching all of its fields.
+type FirewallRule struct {
+	// Protocol matched by the rule. One of TCP, UDP or ICMP.
+	// All protocols are matched when not specified.
+	// +optional
+	Protocol string `json:"protocol,omitempty"`
+	// Destination ports matched by the rule, supported only with the TCP and UDP protocols.
+	// All ports are matched when not specified.
+	// +optional
+	Ports []int32 `json:"ports,omitempty"`
+	// Source CIDRs matched by the rule, for example 10.10.0.0/16 or fd00::/64.
+	// All sources are matched when not specified.
+	// +optional
+	From []string `json:"from,omitempty"`
+}
+
+// InterfaceOffloads toggles the host side offloads of a virtio interface, for workloads
+// which need them deterministically disabled, like DPDK running in the guest.
+type InterfaceOffloads struct {
+	// TSO toggles the TCP segmentation offload, for both IPv4 and IPv6.
+	// +optional
+	TSO *bool `json:"tso,omitempty"`
+	// UFO toggles the UDP fragmentation offload.
+	// +optional
+	UFO *bool `json:"ufo,omitempty"`
+	// MergeableRxBuffers toggles the mergeable receive buffers.
+	// +optional
+	MergeableRxBuffers *bool `json:"mrgRxbuf,omitempty"`
+	// Checksum toggles the checksum offload.
+	// +optional
+	Checksum *bool `json:"csum,omitempty"`
+}
+
+// InterfaceInterruptMode is the mode the virtio device of an interface interrupts the guest with
+type InterfaceInterruptMode string
+
+const (
+	// InterfaceInterruptModeMSIX interrupts the guest with MSI-X vectors, the hypervisor default
+	InterfaceInterruptModeMSIX InterfaceInterruptMode = "MSIX"
+	// InterfaceInterruptModeINTx disables MSI-X, interrupting the guest with the legacy INTx pin interrupt,
+	// for the guests which do not support MSI-X
+	InterfaceInterruptModeINTx InterfaceInterruptMode = "INTx"
+)
+
+// InterfaceInterrupts configures the interrupts of the virtio device of an interface.
+type InterfaceInterrupts struct {
+	// Mode is the interrupt mode of the device, either MSIX or INTx. Defaults to MSIX.
+	// +optional
+	Mode InterfaceInterruptMode `json:"mode,omitempty"`
+	// Vectors is the number of MSI-X vectors of the device. Defaults to the hypervisor default, which is
+	// two vectors per queue pair plus two. It may not be set with the INTx mode.
+	// +optional
+	Vectors *uint32 `json:"vectors,omitempty"`
+}
+
+// InterfaceRouterAdvertisement enables an IPv6 router advertisement responder in the virt-launcher pod.
+type InterfaceRouterAdvertisement struct{}
+
+// InterfaceNetworkEmulation defines the network emulation applied on the tap device backing an interface.
+type InterfaceNetworkEmulation struct {
+	// Delay added to every packet sent to the guest.
+	// +optional
+	Delay *metav1.Duration `json:"delay,omitempty"`
+	// Jitter is the random variation of the delay, requires a delay.
+	// +optional
+	Jitter *metav1.Duration `json:"jitter,omitempty"`
+	// LossPercentage is the percentage of the packets sent to the guest which are dropped, between 0 and 100.
+	// For example: "0.5".
+	// +optional
+	LossPercentage string `json:"lossPercentage,omitempty"`
+}
+
+// InterfaceMirror defines where the traffic of an interface is mirrored to.
+type InterfaceMirror struct {
+	// NetworkName is the name of the multus NetworkAttachmentDefinition the mirrored traffic is sent to,
+	// in the form <namespace>/<name> or <name> for the VMI namespace.
+	// The virt-launcher pod is attached to it through an additional interface.
+	NetworkName string `json:"networkName"`
+	// Direction of the mirrored traffic: ingress for the traffic received by the guest,
+	// egress for the traffic sent by the guest, or both. Defaults to both.
+	// +optional
+	Direction InterfaceMirrorDirection `json:"direction,omitempty"`
+}
+
+type InterfaceMirrorDirection string
+
+const (
+	InterfaceMirrorIngress InterfaceMirrorDirection = "ingress"
+	InterfaceMirrorEgress  InterfaceMirrorDirection = "egress"
+	InterfaceMirrorBoth    InterfaceMirrorDirection = "both"
+)
+
 // Port represents a port to expose from the virtual machine.
 // Default protocol TCP.
 // The port field is mandatory
@@ -1613,6 +1886,14 @@ type Port struct {
 	Port int32 `json:"port"`
 }
 
+// PortsEnforcement defines how the ports listed on an interface are exposed.
+type PortsEnforcement string
+
+const (
+	// PortsEnforcementStrict exposes only the listed ports, dropping any other incoming traffic.
+	PortsEnforcementStrict PortsEnforcement = "Strict"
+)
+
 type AccessCredentialSecretSource struct {
 	// SecretName represents the name of the secret in the VMI's namespace
 	SecretName string `json:"secretName"`
@@ -1740,6 +2021,10 @@ type Network struct {
 type NetworkSource struct {
 	Pod    *PodNetwork    `json:"pod,omitempty"`
 	Multus *MultusNetwork `json:"multus,omitempty"`
+	// Native represents a secondary network provided by the Kubernetes
+	// native multi-network API (PodNetwork).
+	// +optional
+	Native *NativeNetwork `json:"native,omitempty"`
 }
 
 // Represents the stock pod network interface.
@@ -1769,6 +2054,19 @@ func (podNet *PodNetwork) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// NetworkQueuesPolicy controls the ratio between the guest vCPUs and the queue pairs of the
+// multi-queue network interfaces.
+type NetworkQueuesPolicy struct {
+	// VCPUsPerQueue is the number of guest vCPUs sharing a single queue pair.
+	// For example, 2 gives one queue pair for every two vCPUs. Defaults to 1.
+	// +optional
+	VCPUsPerQueue *uint32 `json:"vcpusPerQueue,omitempty"`
+	// MaxQueues caps the number of queue pairs of each interface.
+	// Defaults to, and cannot exceed, 256.
+	// +optional
+	MaxQueues *uint32 `json:"maxQueues,omitempty"`
+}
+
 // Rng represents the random device passed from host
 type Rng struct {
 }
@@ -1785,6 +2083,13 @@ type MultusNetwork struct {
 	Default bool `json:"default,omitempty"`
 }
 
+// Represents a secondary network provided by the Kubernetes native
+// multi-network API.
+type NativeNetwork struct {
+	// PodNetworkName references a cluster scoped PodNetwork object.
+	PodNetworkName string `json:"podNetworkName"`
+}
+
 // CPUTopology allows specifying the amount of cores, sockets
 // and threads.
 type CPUTopology struct {
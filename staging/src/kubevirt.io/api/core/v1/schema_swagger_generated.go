@@ -148,11 +148,21 @@ func (KernelBootContainer) SwaggerDoc() map[string]string {
 	}
 }
 
+func (KernelBootVolume) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "If set, the VM will be booted from the defined kernel / initrd stored on a volume.",
+		"name":       "Name of the volume that contains initrd / kernel files.\nIt must match a PersistentVolumeClaim or DataVolume volume of the VMI in filesystem mode,\nwhich is not used by any disk or filesystem.",
+		"kernelPath": "The fully-qualified path to the kernel image in the volume\n+optional",
+		"initrdPath": "the fully-qualified path to the ramdisk image in the volume\n+optional",
+	}
+}
+
 func (KernelBoot) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":           "Represents the firmware blob used to assist in the kernel boot process.\nUsed for setting the kernel, initrd and command line arguments",
 		"kernelArgs": "Arguments to be passed to the kernel at boot time",
 		"container":  "Container defines the container that containes kernel artifacts",
+		"volume":     "Volume defines the PersistentVolumeClaim or DataVolume volume that contains kernel artifacts.\nContainer and Volume are mutually exclusive.\n+optional",
 	}
 }
 
@@ -214,6 +224,15 @@ func (Memory) SwaggerDoc() map[string]string {
 		"guest":            "Guest allows to specifying the amount of memory which is visible inside the Guest OS.\nThe Guest must lie between Requests and Limits from the resources section.\nDefaults to the requested memory in the resources section if not specified.\n+ optional",
 		"maxGuest":         "MaxGuest allows to specify the maximum amount of memory which is visible inside the Guest OS.\nThe delta between MaxGuest and Guest is the amount of memory that can be hot(un)plugged.",
 		"reservedOverhead": "ReservedOverhead configures the memory overhead applied to a VM\nand its characteristics.\n+optional",
+		"swap":             "Swap allows the VMI to use the swap of the node, for memory-bursty but latency-tolerant workloads.\nThe swap has to be enabled on the node.\n+optional",
+	}
+}
+
+func (MemorySwap) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "MemorySwap configures the swap of the node used by the VMI.",
+		"policy": "Policy defines how swapped out memory is stored, Swap or Zswap.\nDefaults to Swap.\n+optional",
+		"limit":  "Limit is the maximum amount of swap used by the VMI.\nDefaults to no limit.\n+optional",
 	}
 }
 
@@ -265,31 +284,41 @@ func (ACPI) SwaggerDoc() map[string]string {
 
 func (Devices) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"useVirtioTransitional":      "Fall back to legacy virtio 0.9 support if virtio bus is selected on devices.\nThis is helpful for old machines like CentOS6 or RHEL6 which\ndo not understand virtio_non_transitional (virtio 1.0).",
-		"disableHotplug":             "DisableHotplug disabled the ability to hotplug disks.",
-		"disks":                      "Disks describes disks, cdroms and luns which are connected to the vmi.\n+kubebuilder:validation:MaxItems:=256",
-		"watchdog":                   "Watchdog describes a watchdog device which can be added to the vmi.",
-		"interfaces":                 "Interfaces describe network interfaces which are added to the vmi.\n+kubebuilder:validation:MaxItems:=256",
-		"inputs":                     "Inputs describe input devices",
-		"autoattachPodInterface":     "Whether to attach a pod network interface. Defaults to true.",
-		"autoattachGraphicsDevice":   "Whether to attach the default graphics device or not.\nVNC will not be available if set to false. Defaults to true.",
-		"autoattachSerialConsole":    "Whether to attach the default virtio-serial console or not.\nSerial console access will not be available if set to false. Defaults to true.",
-		"logSerialConsole":           "Whether to log the auto-attached default serial console or not.\nSerial console logs will be collect to a file and then streamed from a named `guest-console-log`.\nNot relevant if autoattachSerialConsole is disabled.\nDefaults to cluster wide setting on VirtualMachineOptions.",
-		"autoattachMemBalloon":       "Whether to attach the Memory balloon device with default period.\nPeriod can be adjusted in virt-config.\nDefaults to true.\n+optional",
-		"autoattachInputDevice":      "Whether to attach an Input Device.\nDefaults to false.\n+optional",
-		"autoattachVSOCK":            "Whether to attach the VSOCK CID to the VM or not.\nVSOCK access will be available if set to true. Defaults to false.",
-		"rng":                        "Whether to have random number generator from host\n+optional",
-		"blockMultiQueue":            "Whether or not to enable virtio multi-queue for block devices.\nDefaults to false.\n+optional",
-		"networkInterfaceMultiqueue": "If specified, virtual network interfaces configured with a virtio bus will also enable the vhost multiqueue feature for network devices. The number of queues created depends on additional factors of the VirtualMachineInstance, like the number of guest CPUs.\n+optional",
-		"gpus":                       "Whether to attach a GPU device to the vmi.\n+optional\n+listType=atomic",
-		"downwardMetrics":            "DownwardMetrics creates a virtio serials for exposing the downward metrics to the vmi.\n+optional",
-		"panicDevices":               "PanicDevices provides additional crash information when a guest crashes.\n+optional\n+listtype=atomic",
-		"filesystems":                "Filesystems describes filesystem which is connected to the vmi.\n+optional\n+listType=atomic",
-		"hostDevices":                "Whether to attach a host device to the vmi.\n+optional\n+listType=atomic",
-		"clientPassthrough":          "To configure and access client devices such as redirecting USB\n+optional",
-		"sound":                      "Whether to emulate a sound device.\n+optional",
-		"tpm":                        "Whether to emulate a TPM device.\n+optional",
-		"video":                      "Video describes the video device configuration for the vmi.\n+optional",
+		"useVirtioTransitional":            "Fall back to legacy virtio 0.9 support if virtio bus is selected on devices.\nThis is helpful for old machines like CentOS6 or RHEL6 which\ndo not understand virtio_non_transitional (virtio 1.0).",
+		"disableHotplug":                   "DisableHotplug disabled the ability to hotplug disks.",
+		"disks":                            "Disks describes disks, cdroms and luns which are connected to the vmi.\n+kubebuilder:validation:MaxItems:=256",
+		"watchdog":                         "Watchdog describes a watchdog device which can be added to the vmi.",
+		"interfaces":                       "Interfaces describe network interfaces which are added to the vmi.\n+kubebuilder:validation:MaxItems:=256",
+		"inputs":                           "Inputs describe input devices",
+		"autoattachPodInterface":           "Whether to attach a pod network interface. Defaults to true.",
+		"autoattachGraphicsDevice":         "Whether to attach the default graphics device or not.\nVNC will not be available if set to false. Defaults to true.",
+		"autoattachSerialConsole":          "Whether to attach the default virtio-serial console or not.\nSerial console access will not be available if set to false. Defaults to true.",
+		"logSerialConsole":                 "Whether to log the auto-attached default serial console or not.\nSerial console logs will be collect to a file and then streamed from a named `guest-console-log`.\nNot relevant if autoattachSerialConsole is disabled.\nDefaults to cluster wide setting on VirtualMachineOptions.",
+		"autoattachMemBalloon":             "Whether to attach the Memory balloon device with default period.\nPeriod can be adjusted in virt-config.\nDefaults to true.\n+optional",
+		"autoattachInputDevice":            "Whether to attach an Input Device.\nDefaults to false.\n+optional",
+		"autoattachVSOCK":                  "Whether to attach the VSOCK CID to the VM or not.\nVSOCK access will be available if set to true. Defaults to false.",
+		"rng":                              "Whether to have random number generator from host\n+optional",
+		"blockMultiQueue":                  "Whether or not to enable virtio multi-queue for block devices.\nDefaults to false.\n+optional",
+		"networkInterfaceMultiqueue":       "If specified, virtual network interfaces configured with a virtio bus will also enable the vhost multiqueue feature for network devices. The number of queues created depends on additional factors of the VirtualMachineInstance, like the number of guest CPUs.\n+optional",
+		"networkInterfaceMultiqueuePolicy": "NetworkInterfaceMultiQueuePolicy controls how many queue pairs are given to the multi-queue\nnetwork interfaces, relative to the number of guest vCPUs.\nWhen not set, the cluster wide policy applies, and each vCPU gets its own queue pair.\n+optional",
+		"gpus":                             "Whether to attach a GPU device to the vmi.\n+optional\n+listType=atomic",
+		"downwardMetrics":                  "DownwardMetrics creates a virtio serials for exposing the downward metrics to the vmi.\n+optional",
+		"panicDevices":                     "PanicDevices provides additional crash information when a guest crashes.\n+optional\n+listtype=atomic",
+		"filesystems":                      "Filesystems describes filesystem which is connected to the vmi.\n+optional\n+listType=atomic",
+		"hostDevices":                      "Whether to attach a host device to the vmi.\n+optional\n+listType=atomic",
+		"clientPassthrough":                "To configure and access client devices such as redirecting USB\n+optional",
+		"sound":                            "Whether to emulate a sound device.\n+optional",
+		"spice":                            "Whether to attach a SPICE graphics device, reachable through the spice subresource.\n+optional",
+		"tpm":                              "Whether to emulate a TPM device.\n+optional",
+		"video":                            "Video describes the video device configuration for the vmi.\n+optional",
+		"virtioChannels":                   "VirtioChannels lists the named virtio-serial channels exposed to the guest, alongside the serial console.\nEach channel is reachable through its own websocket subresource, enabling out-of-band management agents.\n+optional\n+listType=atomic",
+	}
+}
+
+func (VirtioChannel) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "VirtioChannel represents a virtio-serial port, exposed in the guest as /dev/virtio-ports/io.kubevirt.<name>.",
+		"name": "Name of the channel, used to reach it through the channel subresource.\nMust be a DNS-1123 label and unique within the VMI.",
 	}
 }
 
@@ -307,6 +336,14 @@ func (SoundDevice) SwaggerDoc() map[string]string {
 	}
 }
 
+func (SpiceDevice) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "Represents the user's configuration of the SPICE graphics device of the VMI.",
+		"audio":          "Audio streams the output of the VMI sound device over the SPICE connection.\nAn ich9 sound device is emulated if none is set.\n+optional",
+		"usbRedirection": "USBRedirection attaches SpiceUSBRedirectionMaxNumberOf USB redirection\nchannels, multiplexed over the SPICE connection.\n+optional",
+	}
+}
+
 func (TPMDevice) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"enabled":    "Enabled allows a user to explicitly disable the vTPM even when one is enabled by a preference referenced by the VirtualMachine\nDefaults to True",
@@ -345,9 +382,10 @@ func (DownwardMetrics) SwaggerDoc() map[string]string {
 
 func (GPU) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"name":       "Name of the GPU device as exposed by a device plugin",
-		"deviceName": "DeviceName is the name of the device provisioned by device-plugins",
-		"tag":        "If specified, the virtual network interface address and its tag will be provided to the guest via config drive\n+optional",
+		"name":             "Name of the GPU device as exposed by a device plugin",
+		"deviceName":       "DeviceName is the name of the device provisioned by device-plugins",
+		"tag":              "If specified, the virtual network interface address and its tag will be provided to the guest via config drive\n+optional",
+		"attachIOMMUGroup": "AttachIOMMUGroup requests that all the devices sharing the IOMMU group of the\nallocated PCI device are passed through to the guest along with it.\nThe VMI fails to start if any of them is in use by a host driver.\n+optional",
 	}
 }
 
@@ -377,8 +415,9 @@ func (PanicDevice) SwaggerDoc() map[string]string {
 
 func (HostDevice) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"deviceName": "DeviceName is the name of the device provisioned by device-plugins",
-		"tag":        "If specified, the virtual network interface address and its tag will be provided to the guest via config drive\n+optional",
+		"deviceName":       "DeviceName is the name of the device provisioned by device-plugins",
+		"tag":              "If specified, the virtual network interface address and its tag will be provided to the guest via config drive\n+optional",
+		"attachIOMMUGroup": "AttachIOMMUGroup requests that all the devices sharing the IOMMU group of the\nallocated PCI device are passed through to the guest along with it.\nThe VMI fails to start if any of them is in use by a host driver.\n+optional",
 	}
 }
 
@@ -393,8 +432,16 @@ func (Disk) SwaggerDoc() map[string]string {
 		"tag":                  "If specified, disk address and its tag will be provided to the guest via config drive metadata\n+optional",
 		"blockSize":            "If specified, the virtual disk will be presented with the given block sizes.\n+optional",
 		"shareable":            "If specified the disk is made sharable and multiple write from different VMs are permitted\n+optional",
-		"errorPolicy":          "If specified, it can change the default error policy (stop) for the disk\n+optional",
+		"errorPolicy":          "If specified, it can change the default error policy (stop) for the disk.\nWith stop, which is also used if not specified, and with retry, a VMI paused on I/O errors\nis resumed automatically with a backoff until the storage recovers.\nWith pause it stays paused until it is unpaused.\n+optional",
 		"changedBlockTracking": "ChangedBlockTracking indicates this disk should have CBT option\nDefaults to false.\n+optional",
+		"encryption":           "Encryption opens the disk as a LUKS encrypted volume with the passphrase\nstored in a Secret. The passphrase is handed to QEMU through libvirt secrets.\n+optional",
+	}
+}
+
+func (DiskEncryption) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "DiskEncryption references the Secret holding the LUKS passphrase of a disk.",
+		"secretName": "SecretName is the name of a Secret in the namespace of the VMI.\nThe passphrase is read from the \"passphrase\" key of the Secret.",
 	}
 }
 
@@ -451,7 +498,12 @@ func (SEVPolicy) SwaggerDoc() map[string]string {
 }
 
 func (SEVSNP) SwaggerDoc() map[string]string {
-	return map[string]string{}
+	return map[string]string{
+		"attestation": "If specified, run the attestation process for a vmi.\nThe launch is held until the guest owner provides the ID block and its authentication information.\n+optional",
+		"idBlock":     "Base64 encoded ID block, holding the expected launch measurement of the guest as signed by the guest owner.\nThe guest is not launched if its measurement does not match.\n+optional",
+		"idAuth":      "Base64 encoded ID authentication information, holding the signature of the ID block.\n+optional",
+		"hostData":    "Base64 encoded 32 bytes provided by the guest owner, included as is in the attestation reports of the guest.\n+optional",
+	}
 }
 
 func (SEVAttestation) SwaggerDoc() map[string]string {
@@ -743,17 +795,25 @@ func (Diag288Watchdog) SwaggerDoc() map[string]string {
 
 func (Interface) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"name":        "Logical name of the interface as well as a reference to the associated networks.\nMust match the Name of a Network.",
-		"model":       "Interface model.\nOne of: e1000, e1000e, igb, ne2k_pci, pcnet, rtl8139, virtio.\nDefaults to virtio.",
-		"binding":     "Binding specifies the binding plugin that will be used to connect the interface to the guest.\nIt provides an alternative to InterfaceBindingMethod.\nversion: 1alphav1",
-		"ports":       "List of ports to be forwarded to the virtual machine.",
-		"macAddress":  "Interface MAC address. For example: de:ad:00:00:be:af or DE-AD-00-00-BE-AF.",
-		"bootOrder":   "BootOrder is an integer value > 0, used to determine ordering of boot devices.\nLower values take precedence.\nEach interface or disk that has a boot order must have a unique value.\nInterfaces without a boot order are not tried.\n+optional",
-		"pciAddress":  "If specified, the virtual network interface will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10\n+optional",
-		"dhcpOptions": "If specified the network interface will pass additional DHCP options to the VMI\n+optional",
-		"tag":         "If specified, the virtual network interface address and its tag will be provided to the guest via config drive\n+optional",
-		"acpiIndex":   "If specified, the ACPI index is used to provide network interface device naming, that is stable across changes\nin PCI addresses assigned to the device.\nThis value is required to be unique across all devices and be between 1 and (16*1024-1).\n+optional",
-		"state":       "State represents the requested operational state of the interface.\nThe supported values are:\n`absent`, expressing a request to remove the interface.\n`down`, expressing a request to set the link down.\n`up`, expressing a request to set the link up.\nEmpty value functions as `up`.\n+optional",
+		"name":                "Logical name of the interface as well as a reference to the associated networks.\nMust match the Name of a Network.",
+		"model":               "Interface model.\nOne of: e1000, e1000e, igb, ne2k_pci, pcnet, rtl8139, virtio.\nDefaults to virtio.",
+		"binding":             "Binding specifies the binding plugin that will be used to connect the interface to the guest.\nIt provides an alternative to InterfaceBindingMethod.\nversion: 1alphav1",
+		"ports":               "List of ports to be forwarded to the virtual machine.",
+		"portsEnforcement":    "PortsEnforcement defines how the listed ports are exposed.\nWith Strict, only the listed ports are forwarded to the guest, any other incoming traffic\nis dropped and the list of ports can be updated while the VM is running.\nSupported only with the masquerade binding.\n+optional",
+		"firewall":            "Firewall defines the rules filtering the incoming traffic of the interface.\nSupported only with the masquerade binding.\n+optional",
+		"offloads":            "Offloads toggles the offloads the host applies to the traffic of the interface.\nSupported only with the virtio model. Offloads which are not specified keep the hypervisor defaults.\n+optional",
+		"interrupts":          "Interrupts configures how the virtio device of the interface interrupts the guest, e.g. to give the\nqueues of a high queue count vDPA NIC enough MSI-X vectors. It applies to the interfaces generated by\nnetwork binding plugins as well. Supported only with the virtio model.\n+optional",
+		"routerAdvertisement": "RouterAdvertisement enables IPv6 router advertisements to the guest, announcing the pod\nas its default router and letting the guest get its IPv6 address over DHCPv6.\nSupported only with the masquerade binding.\n+optional",
+		"networkEmulation":    "NetworkEmulation degrades the traffic sent to the guest through the interface, adding delay,\njitter and packet loss for chaos testing of the guest workloads.\nSupported only with the bridge and masquerade bindings.\n+optional",
+		"mirror":              "Mirror copies the traffic of the interface to a monitoring network, where an IDS or\nmonitoring appliance (VM or pod) inspects it.\nSupported only with the bridge and masquerade bindings.\n+optional",
+		"promiscuous":         "Promiscuous declares that the guest uses the interface as a trunk or in promiscuous mode,\nsending and receiving frames of MAC addresses other than its own, as nested hypervisors and VNFs do.\nThe ports of the backing bridge are set in promiscuous mode to let these frames through.\nSupported only with the bridge binding, in namespaces selected by the\npromiscuousInterfacesNamespaceLabelSelector of the KubeVirt network configuration.\n+optional",
+		"macAddress":          "Interface MAC address. For example: de:ad:00:00:be:af or DE-AD-00-00-BE-AF.",
+		"bootOrder":           "BootOrder is an integer value > 0, used to determine ordering of boot devices.\nLower values take precedence.\nEach interface or disk that has a boot order must have a unique value.\nInterfaces without a boot order are not tried.\n+optional",
+		"pciAddress":          "If specified, the virtual network interface will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10\n+optional",
+		"dhcpOptions":         "If specified the network interface will pass additional DHCP options to the VMI\n+optional",
+		"tag":                 "If specified, the virtual network interface address and its tag will be provided to the guest via config drive\n+optional",
+		"acpiIndex":           "If specified, the ACPI index is used to provide network interface device naming, that is stable across changes\nin PCI addresses assigned to the device.\nThis value is required to be unique across all devices and be between 1 and (16*1024-1).\n+optional",
+		"state":               "State represents the requested operational state of the interface.\nThe supported values are:\n`absent`, expressing a request to remove the interface.\n`down`, expressing a request to set the link down.\n`up`, expressing a request to set the link up.\nEmpty value functions as `up`.\n+optional",
 	}
 }
 
@@ -833,6 +893,63 @@ func (PluginBinding) SwaggerDoc() map[string]string {
 	}
 }
 
+func (InterfaceFirewall) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "InterfaceFirewall defines the L3/L4 rules allowing incoming connections to the virtual machine.\nIncoming connections not matching any rule are dropped, while replies to connections\ninitiated by the virtual machine are always allowed.",
+		"ingress": "Ingress lists the rules allowing incoming connections.\n+optional",
+	}
+}
+
+func (FirewallRule) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "FirewallRule allows the incoming connections matching all of its fields.",
+		"protocol": "Protocol matched by the rule. One of TCP, UDP or ICMP.\nAll protocols are matched when not specified.\n+optional",
+		"ports":    "Destination ports matched by the rule, supported only with the TCP and UDP protocols.\nAll ports are matched when not specified.\n+optional",
+		"from":     "Source CIDRs matched by the rule, for example 10.10.0.0/16 or fd00::/64.\nAll sources are matched when not specified.\n+optional",
+	}
+}
+
+func (InterfaceOffloads) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "InterfaceOffloads toggles the host side offloads of a virtio interface, for workloads\nwhich need them deterministically disabled, like DPDK running in the guest.",
+		"tso":      "TSO toggles the TCP segmentation offload, for both IPv4 and IPv6.\n+optional",
+		"ufo":      "UFO toggles the UDP fragmentation offload.\n+optional",
+		"mrgRxbuf": "MergeableRxBuffers toggles the mergeable receive buffers.\n+optional",
+		"csum":     "Checksum toggles the checksum offload.\n+optional",
+	}
+}
+
+func (InterfaceInterrupts) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "InterfaceInterrupts configures the interrupts of the virtio device of an interface.",
+		"mode":    "Mode is the interrupt mode of the device, either MSIX or INTx. Defaults to MSIX.\n+optional",
+		"vectors": "Vectors is the number of MSI-X vectors of the device. Defaults to the hypervisor default, which is\ntwo vectors per queue pair plus two. It may not be set with the INTx mode.\n+optional",
+	}
+}
+
+func (InterfaceRouterAdvertisement) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "InterfaceRouterAdvertisement enables an IPv6 router advertisement responder in the virt-launcher pod.",
+	}
+}
+
+func (InterfaceMirror) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "InterfaceMirror defines where the traffic of an interface is mirrored to.",
+		"networkName": "NetworkName is the name of the multus NetworkAttachmentDefinition the mirrored traffic is sent to,\nin the form <namespace>/<name> or <name> for the VMI namespace.\nThe virt-launcher pod is attached to it through an additional interface.",
+		"direction":   "Direction of the mirrored traffic: ingress for the traffic received by the guest,\negress for the traffic sent by the guest, or both. Defaults to both.\n+optional",
+	}
+}
+
+func (InterfaceNetworkEmulation) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "InterfaceNetworkEmulation defines the network emulation applied on the tap device backing an interface.",
+		"delay":          "Delay added to every packet sent to the guest.\n+optional",
+		"jitter":         "Jitter is the random variation of the delay, requires a delay.\n+optional",
+		"lossPercentage": "LossPercentage is the percentage of the packets sent to the guest which are dropped, between 0 and 100.\nFor example: \"0.5\".\n+optional",
+	}
+}
+
 func (Port) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":         "Port represents a port to expose from the virtual machine.\nDefault protocol TCP.\nThe port field is mandatory",
@@ -936,7 +1053,8 @@ func (Network) SwaggerDoc() map[string]string {
 
 func (NetworkSource) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"": "Represents the source resource that will be connected to the vm.\nOnly one of its members may be specified.",
+		"":       "Represents the source resource that will be connected to the vm.\nOnly one of its members may be specified.",
+		"native": "Native represents a secondary network provided by the Kubernetes\nnative multi-network API (PodNetwork).\n+optional",
 	}
 }
 
@@ -948,6 +1066,14 @@ func (PodNetwork) SwaggerDoc() map[string]string {
 	}
 }
 
+func (NetworkQueuesPolicy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "NetworkQueuesPolicy controls the ratio between the guest vCPUs and the queue pairs of the\nmulti-queue network interfaces.",
+		"vcpusPerQueue": "VCPUsPerQueue is the number of guest vCPUs sharing a single queue pair.\nFor example, 2 gives one queue pair for every two vCPUs. Defaults to 1.\n+optional",
+		"maxQueues":     "MaxQueues caps the number of queue pairs of each interface.\nDefaults to, and cannot exceed, 256.\n+optional",
+	}
+}
+
 func (Rng) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"": "Rng represents the random device passed from host",
@@ -962,6 +1088,13 @@ func (MultusNetwork) SwaggerDoc() map[string]string {
 	}
 }
 
+func (NativeNetwork) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "Represents a secondary network provided by the Kubernetes native\nmulti-network API.",
+		"podNetworkName": "PodNetworkName references a cluster scoped PodNetwork object.",
+	}
+}
+
 func (CPUTopology) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":        "CPUTopology allows specifying the amount of cores, sockets\nand threads.",
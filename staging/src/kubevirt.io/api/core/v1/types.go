@@ -165,6 +165,15 @@ type VirtualMachineInstanceSpec struct {
 	// configuration based on DNSPolicy.
 	// +optional
 	DNSConfig *k8sv1.PodDNSConfig `json:"dnsConfig,omitempty" protobuf:"bytes,26,opt,name=dnsConfig"`
+	// GuestDNS overrides the DNS configuration the guest receives over DHCP, independently of the
+	// DNS policy and configuration of the virt-launcher pod.
+	// Parameters which are not specified are taken from the pod DNS configuration.
+	// +optional
+	GuestDNS *GuestDNSConfig `json:"guestDNS,omitempty"`
+	// MetadataService exposes the instance identity, the network configuration and user provided data
+	// to the guest, over HTTP on the link-local 169.254.169.254 address of the masquerade pod network.
+	// +optional
+	MetadataService *MetadataService `json:"metadataService,omitempty"`
 	// Specifies a set of public keys to inject into the vm guest
 	// +listType=atomic
 	// +optional
@@ -197,6 +206,28 @@ type VirtualMachineInstanceSpec struct {
 	UtilityVolumes []UtilityVolume `json:"utilityVolumes,omitempty"`
 }
 
+// GuestDNSConfig is the DNS configuration delivered to the guest.
+type GuestDNSConfig struct {
+	// Nameservers is a list of IP addresses of the DNS servers the guest should use.
+	// The DNS servers of an IP family which has none in the list are taken from the pod DNS configuration.
+	// +listType=atomic
+	// +optional
+	Nameservers []string `json:"nameservers,omitempty"`
+	// Searches is a list of DNS search domains the guest should use.
+	// +listType=atomic
+	// +optional
+	Searches []string `json:"searches,omitempty"`
+}
+
+// MetadataService is the configuration of the metadata endpoint served to the guest.
+type MetadataService struct {
+	// Data is user provided key/value data served to the guest.
+	// It can be extended or updated after boot through the VirtualMachineInstance annotations
+	// prefixed with metadata.kubevirt.io/, which take precedence.
+	// +optional
+	Data map[string]string `json:"data,omitempty"`
+}
+
 func (vmiSpec *VirtualMachineInstanceSpec) UnmarshalJSON(data []byte) error {
 	type VMISpecAlias VirtualMachineInstanceSpec
 	var vmiSpecAlias VMISpecAlias
@@ -225,6 +256,33 @@ type VirtualMachineInstancePhaseTransitionTimestamp struct {
 	PhaseTransitionTimestamp metav1.Time `json:"phaseTransitionTimestamp,omitempty"`
 }
 
+// VirtualMachineInstanceBootPhase is a step the VirtualMachineInstance goes through while starting
+type VirtualMachineInstanceBootPhase string
+
+const (
+	// BootPhasePodScheduled is reached when the virt-launcher pod was bound to a node
+	BootPhasePodScheduled VirtualMachineInstanceBootPhase = "PodScheduled"
+	// BootPhaseNetworkReady is reached when the virt-launcher pod sandbox, including its CNI networks, was created
+	BootPhaseNetworkReady VirtualMachineInstanceBootPhase = "NetworkReady"
+	// BootPhaseLauncherReady is reached when the virt-launcher pod is ready, after its devices were allocated
+	// and its hook sidecars registered
+	BootPhaseLauncherReady VirtualMachineInstanceBootPhase = "LauncherReady"
+	// BootPhaseDevicesReady is reached when virt-handler finished preparing the disks, networks and devices
+	BootPhaseDevicesReady VirtualMachineInstanceBootPhase = "DevicesReady"
+	// BootPhaseDomainDefined is reached when the domain was defined in virt-launcher
+	BootPhaseDomainDefined VirtualMachineInstanceBootPhase = "DomainDefined"
+	// BootPhaseGuestAgentConnected is reached when the guest agent connected for the first time
+	BootPhaseGuestAgentConnected VirtualMachineInstanceBootPhase = "GuestAgentConnected"
+)
+
+// VirtualMachineInstanceBootPhaseTimestamp gives a timestamp in relation to when a boot phase was reached by a vmi
+type VirtualMachineInstanceBootPhaseTimestamp struct {
+	// Phase is the boot phase reached by the VirtualMachineInstance
+	Phase VirtualMachineInstanceBootPhase `json:"phase,omitempty"`
+	// Timestamp is the time at which the boot phase was reached
+	Timestamp metav1.Time `json:"timestamp,omitempty"`
+}
+
 type TopologyHints struct {
 	TSCFrequency *int64 `json:"tscFrequency,omitempty"`
 }
@@ -245,10 +303,19 @@ type VirtualMachineInstanceStatus struct {
 	// +listType=atomic
 	// +optional
 	PhaseTransitionTimestamps []VirtualMachineInstancePhaseTransitionTimestamp `json:"phaseTransitionTimestamps,omitempty"`
+	// BootPhaseTimestamps records when the VirtualMachineInstance reached each of the steps of its start,
+	// allowing to tell which of them delayed it
+	// +listType=atomic
+	// +optional
+	BootPhaseTimestamps []VirtualMachineInstanceBootPhaseTimestamp `json:"bootPhaseTimestamps,omitempty"`
 	// Interfaces represent the details of available network interfaces.
 	Interfaces []VirtualMachineInstanceNetworkInterface `json:"interfaces,omitempty"`
 	// Guest OS Information
 	GuestOSInfo VirtualMachineInstanceGuestOSInfo `json:"guestOSInfo,omitempty"`
+	// GuestDrivers are the drivers the guest uses for its devices, as reported by the guest agent
+	// +listType=atomic
+	// +optional
+	GuestDrivers []VirtualMachineInstanceGuestOSDriver `json:"guestDrivers,omitempty"`
 	// Represents the status of a live migration
 	MigrationState *VirtualMachineInstanceMigrationState `json:"migrationState,omitempty"`
 	// Represents the method using which the vmi can be migrated: live migration or block migration
@@ -278,6 +345,11 @@ type VirtualMachineInstanceStatus struct {
 	// +listType=atomic
 	VolumeStatus []VolumeStatus `json:"volumeStatus,omitempty"`
 
+	// HostDeviceStatuses contains the statuses of the host devices assigned to the VirtualMachineInstance
+	// +optional
+	// +listType=atomic
+	HostDeviceStatuses []HostDeviceStatus `json:"hostDeviceStatuses,omitempty"`
+
 	// KernelBootStatus contains info about the kernelBootContainer
 	// +optional
 	KernelBootStatus *KernelBootStatus `json:"kernelBootStatus,omitempty"`
@@ -474,6 +546,50 @@ const (
 	MemoryDumpVolumeFailed VolumePhase = "MemoryDumpFailed"
 )
 
+// HostDeviceStatus represents information about the status of a host device assigned to the VirtualMachineInstance.
+type HostDeviceStatus struct {
+	// Name is the name of the host device
+	Name string `json:"name"`
+	// Phase is the phase
+	Phase HostDevicePhase `json:"phase,omitempty"`
+	// Reason is a brief description of why we are in the current host device phase
+	Reason string `json:"reason,omitempty"`
+	// Message is a detailed message about the current host device phase
+	Message string `json:"message,omitempty"`
+	// If the host device is hotplugged, this will contain the hotplug status.
+	HotplugHostDevice *HotplugHostDeviceStatus `json:"hotplugHostDevice,omitempty"`
+}
+
+// HotplugHostDeviceStatus represents the hotplug status of the host device
+type HotplugHostDeviceStatus struct {
+	// AttachPodName is the name of the pod used to allocate the host device on the node.
+	AttachPodName string `json:"attachPodName,omitempty"`
+	// AttachPodUID is the UID of the pod used to allocate the host device on the node.
+	AttachPodUID types.UID `json:"attachPodUID,omitempty"`
+	// PCIAddress is the host PCI address of the device allocated to the attachment pod.
+	PCIAddress string `json:"pciAddress,omitempty"`
+}
+
+// HostDevicePhase indicates the current phase of the host device hotplug process.
+type HostDevicePhase string
+
+const (
+	// HostDevicePending means the host device is waiting to be allocated on the node.
+	HostDevicePending HostDevicePhase = "Pending"
+	// HostDeviceAllocated means the host device has been allocated to the attachment pod on the node.
+	HostDeviceAllocated HostDevicePhase = "Allocated"
+	// HostDeviceMountedToPod means the VFIO group of the host device is available in the virt-launcher pod.
+	HostDeviceMountedToPod HostDevicePhase = "MountedToPod"
+	// HostDeviceReady means the host device is ready to be used by the VirtualMachineInstance.
+	HostDeviceReady HostDevicePhase = "Ready"
+	// HostDeviceDetaching means the host device is being detached from the VirtualMachineInstance.
+	HostDeviceDetaching HostDevicePhase = "Detaching"
+	// HostDeviceDetached means the host device has been detached from the VirtualMachineInstance, and its attachment pod can be removed.
+	HostDeviceDetached HostDevicePhase = "Detached"
+	// HostDeviceFailed means the host device could not be hotplugged.
+	HostDeviceFailed HostDevicePhase = "Failed"
+)
+
 func (v *VirtualMachineInstance) IsScheduling() bool {
 	return v.Status.Phase == Scheduling
 }
@@ -691,6 +807,9 @@ const (
 
 	// VirtualMachineInstanceEvictionRequested indicates that an eviction has been requested for the VMI
 	VirtualMachineInstanceEvictionRequested VirtualMachineInstanceConditionType = "EvictionRequested"
+
+	// VirtualMachineInstanceGuestDriverMissing indicates that the guest lacks the drivers of some of its devices
+	VirtualMachineInstanceGuestDriverMissing VirtualMachineInstanceConditionType = "GuestDriverMissing"
 )
 
 // These are valid reasons for VMI conditions.
@@ -738,6 +857,9 @@ const (
 
 	// Indicates that an eviction has been requested for the VMI
 	VirtualMachineInstanceReasonEvictionRequested = "EvictionRequested"
+
+	// Indicates that the guest has no virtio-net driver bound to some of its virtio network interfaces
+	VirtualMachineInstanceReasonVirtioNetDriverMissing = "VirtioNetDriverMissing"
 )
 
 const (
@@ -851,6 +973,9 @@ type VirtualMachineInstanceNetworkInterface struct {
 	QueueCount int32 `json:"queueCount,omitempty"`
 	// LinkState Reports the current operational link state`. values: up, down.
 	LinkState string `json:"linkState,omitempty"`
+	// HotplugFailedAttempts counts the failed attempts to hot plug the interface into the running domain.
+	// The hotplug is rolled back once the attempts are exhausted.
+	HotplugFailedAttempts int32 `json:"hotplugFailedAttempts,omitempty"`
 }
 
 type VirtualMachineInstanceGuestOSInfo struct {
@@ -872,6 +997,16 @@ type VirtualMachineInstanceGuestOSInfo struct {
 	ID string `json:"id,omitempty"`
 }
 
+// VirtualMachineInstanceGuestOSDriver describes a driver the guest uses for one of its devices
+type VirtualMachineInstanceGuestOSDriver struct {
+	// Name of the driver
+	Name string `json:"name,omitempty"`
+	// Version of the driver
+	Version string `json:"version,omitempty"`
+	// PCI vendor and device ID of the device handled by the driver, in the vendor:device format
+	DeviceID string `json:"deviceID,omitempty"`
+}
+
 // +k8s:openapi-gen=true
 type MigrationNetworkType string
 
@@ -1007,6 +1142,17 @@ type VirtualMachineInstanceMigrationState struct {
 	// TargetMemoryOverhead is the memory overhead of the target virt-launcher pod
 	// +optional
 	TargetMemoryOverhead *resource.Quantity `json:"targetMemoryOverhead,omitempty"`
+	// DiskTransferProgress reports how much of the migrated volumes has been copied to the target
+	// +optional
+	DiskTransferProgress *MigrationDiskTransferProgress `json:"diskTransferProgress,omitempty"`
+}
+
+// MigrationDiskTransferProgress reports the progress of copying volumes during a storage live migration
+type MigrationDiskTransferProgress struct {
+	// TotalBytes is the amount of volume data to be copied to the target
+	TotalBytes int64 `json:"totalBytes"`
+	// ProcessedBytes is the amount of volume data already copied to the target
+	ProcessedBytes int64 `json:"processedBytes"`
 }
 
 type MigrationAbortStatus string
@@ -1132,6 +1278,15 @@ const (
 	// This annotation indicates that a migration is the result of an
 	// automated workload update
 	WorkloadUpdateMigrationAnnotation string = "kubevirt.io/workloadUpdateMigration"
+	// This annotation indicates that a migration was created to free capacity
+	// for the higher priority VMI it references
+	PreemptionMigrationAnnotation string = "kubevirt.io/preemptionMigration"
+	// This annotation indicates that a migration was created to relieve the
+	// overloaded node it references
+	LoadRebalanceMigrationAnnotation string = "kubevirt.io/loadRebalanceMigration"
+	// This annotation lists, comma separated, the overloaded nodes the target
+	// of a load rebalancing migration must not be scheduled on
+	LoadRebalanceExcludedNodesAnnotation string = "kubevirt.io/loadRebalanceExcludedNodes"
 	// This annotation indicates to abort any migration due to an automated
 	// workload update. It should only be used for testing purposes.
 	WorkloadUpdateMigrationAbortionAnnotation string = "kubevirt.io/testWorkloadUpdateMigrationAbortion"
@@ -1142,6 +1297,17 @@ const (
 	// if a particular node is alive and hence should be available for new
 	// virtual machine instance scheduling. Used on Node.
 	VirtHandlerHeartbeat string = "kubevirt.io/heartbeat"
+	// This annotation is updated by virt-handler along with the heartbeat and
+	// holds the CPU utilization of the node, in percent. Used on Node.
+	NodeCPUUtilizationAnnotation string = "kubevirt.io/cpu-utilization"
+	// This annotation is updated by virt-handler along with the heartbeat and
+	// holds the share of time, in percent, the vCPUs of the node waited for a
+	// physical CPU. Used on Node.
+	NodeVCPUStealAnnotation string = "kubevirt.io/vcpu-steal"
+	// This annotation is updated by virt-handler along with the heartbeat and
+	// holds the vCPU utilization and steal time, in percent, of the VMIs
+	// running on the node, keyed by namespace/name. Used on Node.
+	NodeVMIVCPULoadAnnotation string = "kubevirt.io/vmi-vcpu-load"
 	// This label indicates what launcher image a VMI is currently running with.
 	OutdatedLauncherImageLabel string = "kubevirt.io/outdatedLauncherImage"
 	// Namespace recommended by Kubernetes for commonly recognized labels
@@ -1216,6 +1382,16 @@ const (
 	CPUModelVendorLabel = "cpu-vendor.node.kubevirt.io/"
 	// This label represents supported machine type on the node
 	SupportedMachineTypeLabel = "machine-type.node.kubevirt.io/"
+	// This label represents host features detected on the node
+	HostFeatureLabel = "host-feature.node.kubevirt.io/"
+	// This label marks the node as capable of running nested virtualization
+	NestedVirtualizationHostFeatureLabel = HostFeatureLabel + "nested-virtualization"
+	// This label marks the node as having an enabled IOMMU, required to pass through host devices
+	IOMMUHostFeatureLabel = HostFeatureLabel + "iommu"
+	// This label marks the node as having the vhost-vdpa driver loaded
+	VhostVDPAHostFeatureLabel = HostFeatureLabel + "vhost-vdpa"
+	// This label is followed by a hugepage size supported by the node, e.g. hugepages-2Mi
+	HugepagesHostFeatureLabel = HostFeatureLabel + "hugepages-"
 
 	VirtIO = "virtio"
 
@@ -1391,6 +1567,14 @@ const (
 	// This annotation is set by virt-handler based on the cluster configuration.
 	QGSSocketPathAnnotation = "kubevirt.io/qgs-socket-path"
 
+	// SecondaryNetworkEndpointsLabel marks a Service without a selector, whose endpoints are the IP addresses
+	// reported by the selected VMIs on the secondary network named by the label value.
+	SecondaryNetworkEndpointsLabel = "kubevirt.io/secondary-network-endpoints"
+
+	// SecondaryNetworkEndpointsSelectorAnnotation holds the label selector of the VMIs backing a Service
+	// marked with the SecondaryNetworkEndpointsLabel.
+	SecondaryNetworkEndpointsSelectorAnnotation = "kubevirt.io/secondary-network-endpoints-selector"
+
 	// AllowAccessClusterServicesNPLabel is a pod label to be set by virt-components to indicate that they require
 	// access to cluster services otherwise blocked by the strict network policy (NP).
 	// This label will be applied to the following virt pods:
@@ -1960,6 +2144,30 @@ type VirtualMachineSpec struct {
 
 	// UpdateVolumesStrategy is the strategy to apply on volumes updates
 	UpdateVolumesStrategy *UpdateVolumesStrategy `json:"updateVolumesStrategy,omitempty"`
+
+	// CPUAutoscaling opts the VirtualMachine in to the vertical scaling of its CPU sockets,
+	// based on the vCPU utilization and steal time of its running VirtualMachineInstance.
+	// +optional
+	CPUAutoscaling *CPUAutoscaling `json:"cpuAutoscaling,omitempty"`
+}
+
+// CPUAutoscalingPolicy tells what is done with the CPU sockets recommendation of a VirtualMachine.
+type CPUAutoscalingPolicy string
+
+const (
+	// CPUAutoscalingPolicyRecommend only reports the recommended number of sockets in the VirtualMachine status.
+	CPUAutoscalingPolicyRecommend CPUAutoscalingPolicy = "Recommend"
+	// CPUAutoscalingPolicyAuto also hotplugs the recommended number of sockets into the running VirtualMachineInstance.
+	CPUAutoscalingPolicyAuto CPUAutoscalingPolicy = "Auto"
+)
+
+// CPUAutoscaling configures the vertical scaling of the CPU sockets of a VirtualMachine.
+// Sockets are only ever added, up to the maxSockets of the VirtualMachineInstance.
+type CPUAutoscaling struct {
+	// Policy tells whether the recommended number of sockets is only reported, or also
+	// hotplugged into the running VirtualMachineInstance. Defaults to Recommend
+	// +optional
+	Policy CPUAutoscalingPolicy `json:"policy,omitempty"`
 }
 
 // StateChangeRequestType represents the existing state change requests that are possible
@@ -2104,6 +2312,42 @@ type VirtualMachineStatus struct {
 	//+nullable
 	//+optional
 	PreferenceRef *InstancetypeStatusRef `json:"preferenceRef,omitempty"`
+
+	// GuestOSInfo is the last guest OS information reported by the guest agent of the VirtualMachineInstance.
+	// It is kept while the VirtualMachine is stopped.
+	// +nullable
+	// +optional
+	GuestOSInfo *VirtualMachineInstanceGuestOSInfo `json:"guestOSInfo,omitempty"`
+
+	// GuestDrivers are the last drivers reported by the guest agent of the VirtualMachineInstance.
+	// They are kept while the VirtualMachine is stopped.
+	// +listType=atomic
+	// +optional
+	GuestDrivers []VirtualMachineInstanceGuestOSDriver `json:"guestDrivers,omitempty"`
+
+	// CPURecommendation is the CPU sockets recommendation for the running VirtualMachineInstance,
+	// reported when the VirtualMachine opts in to CPU autoscaling.
+	// +nullable
+	// +optional
+	CPURecommendation *VirtualMachineCPURecommendation `json:"cpuRecommendation,omitempty"`
+}
+
+// VirtualMachineCPURecommendation holds the recommended number of CPU sockets of a VirtualMachine,
+// and the state used to only recommend more sockets on a sustained vCPU load.
+type VirtualMachineCPURecommendation struct {
+	// Sockets is the recommended number of CPU sockets
+	Sockets uint32 `json:"sockets"`
+	// Reason explains why more sockets are recommended
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// OverloadedSince is when the vCPU load last went above the thresholds, unset while it is below
+	// +nullable
+	// +optional
+	OverloadedSince *metav1.Time `json:"overloadedSince,omitempty"`
+	// LastScaleTime is when CPU sockets were last hotplugged following the recommendation
+	// +nullable
+	// +optional
+	LastScaleTime *metav1.Time `json:"lastScaleTime,omitempty"`
 }
 
 type ControllerRevisionRef struct {
@@ -2316,6 +2560,10 @@ type Handler struct {
 	// If the guest agent is not available, this probe will fail.
 	// +optional
 	Exec *k8sv1.ExecAction `json:"exec,omitempty" protobuf:"bytes,1,opt,name=exec"`
+	// GuestAgentExec runs a command inside the guest through the qemu-guest-agent and
+	// interprets its exit code. No network access to the guest is required.
+	// +optional
+	GuestAgentExec *GuestAgentExec `json:"guestAgentExec,omitempty"`
 	// GuestAgentPing contacts the qemu-guest-agent for availability checks.
 	// +optional
 	GuestAgentPing *GuestAgentPing `json:"guestAgentPing,omitempty"`
@@ -2408,8 +2656,43 @@ type CertConfig struct {
 	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
 }
 
+// KubeVirtCertManagerConfiguration delegates the issuance and rotation of the virt-api and
+// virt-handler certificates, including the ones virt-handler uses towards virt-launcher, to cert-manager
+type KubeVirtCertManagerConfiguration struct {
+	// IssuerRef references the cert-manager issuer signing the dedicated KubeVirt CA which issues the certificates
+	IssuerRef CertManagerIssuerReference `json:"issuerRef"`
+
+	// Certificate configuration
+	// Defaults to the configuration of the self signed server certificates
+	// +optional
+	Certificate *CertConfig `json:"certificate,omitempty"`
+}
+
+// CertManagerIssuerReference references a cert-manager Issuer or ClusterIssuer
+type CertManagerIssuerReference struct {
+	// Name of the issuer
+	Name string `json:"name"`
+
+	// Kind of the issuer, an Issuer has to be in the KubeVirt install namespace
+	// Defaults to Issuer
+	// +kubebuilder:validation:Enum=Issuer;ClusterIssuer
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// Group of the issuer
+	// Defaults to cert-manager.io
+	// +optional
+	Group string `json:"group,omitempty"`
+}
+
 type KubeVirtCertificateRotateStrategy struct {
 	SelfSigned *KubeVirtSelfSignConfiguration `json:"selfSigned,omitempty"`
+
+	// CertManager delegates the virt-api and virt-handler certificates to cert-manager.
+	// They are issued by a dedicated CA in the KubeVirt install namespace, which is the only cert-manager CA trusted by the components.
+	// The remaining certificates keep being signed by the KubeVirt CA.
+	// +optional
+	CertManager *KubeVirtCertManagerConfiguration `json:"certManager,omitempty"`
 }
 
 type WorkloadUpdateMethod string
@@ -2611,6 +2894,25 @@ type KubeVirtStatus struct {
 	// +optional
 	// +listType=atomic
 	SynchronizationAddresses []string `json:"synchronizationAddresses,omitempty" optional:"true"`
+	// NetworkBindings reports the usage of the registered network binding plugins
+	// and whether their prerequisites are satisfied.
+	// +optional
+	// +listType=atomic
+	NetworkBindings []NetworkBindingPluginStatus `json:"networkBindings,omitempty" optional:"true"`
+}
+
+// NetworkBindingPluginStatus reports the state of a network binding plugin registered in the KubeVirt configuration.
+type NetworkBindingPluginStatus struct {
+	// Name is the name under which the binding plugin is registered.
+	Name string `json:"name"`
+	// VirtualMachineInstances is the number of active VMIs using the binding plugin.
+	VirtualMachineInstances int `json:"virtualMachineInstances"`
+	// PrerequisitesSatisfied reports whether all the declared prerequisites are satisfied cluster-wide.
+	PrerequisitesSatisfied bool `json:"prerequisitesSatisfied"`
+	// UnsatisfiedPrerequisites lists the declared prerequisites which are not satisfied.
+	// +optional
+	// +listType=atomic
+	UnsatisfiedPrerequisites []string `json:"unsatisfiedPrerequisites,omitempty"`
 }
 
 // KubeVirtPhase is a label for the phase of a KubeVirt deployment at the current time.
@@ -2793,6 +3095,51 @@ type EvacuateCancelOptions struct {
 	EvacuationNodeName string `json:"evacuationNodeName"`
 }
 
+// BatchOperationOptions may be provided on a batch lifecycle request, which applies
+// an operation to all VirtualMachines of a namespace matching a label selector.
+type BatchOperationOptions struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// LabelSelector selects the VirtualMachines the operation is applied to.
+	// An empty selector selects all VirtualMachines of the namespace.
+	LabelSelector *metav1.LabelSelector `json:"labelSelector"`
+	// MaxConcurrency is the maximum number of VirtualMachines the operation
+	// is applied to in parallel. Defaults to 10.
+	// +optional
+	MaxConcurrency *int32 `json:"maxConcurrency,omitempty"`
+	// When present, indicates that modifications should not be
+	// persisted. An invalid or unrecognized dryRun directive will
+	// result in an error response and no further processing of the
+	// request. Valid values are:
+	// - All: all dry run stages will be processed
+	// +optional
+	// +listType=atomic
+	DryRun []string `json:"dryRun,omitempty"`
+}
+
+// BatchOperationResult reports the outcome of a batch lifecycle request.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type BatchOperationResult struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Items holds the outcome of the operation for every selected VirtualMachine, ordered by name.
+	// +optional
+	// +listType=atomic
+	Items []BatchOperationItemResult `json:"items,omitempty"`
+}
+
+// BatchOperationItemResult reports the outcome of a batch lifecycle request for a single VirtualMachine.
+type BatchOperationItemResult struct {
+	// Name of the VirtualMachine.
+	Name string `json:"name"`
+	// Succeeded indicates whether the operation was applied to the VirtualMachine.
+	Succeeded bool `json:"succeeded"`
+	// Error describes why the operation could not be applied to the VirtualMachine.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
 // VirtualMachineInstanceGuestAgentInfo represents information from the installed guest agent
 //
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -2807,6 +3154,9 @@ type VirtualMachineInstanceGuestAgentInfo struct {
 	Hostname string `json:"hostname,omitempty"`
 	// OS contains the guest operating system information
 	OS VirtualMachineInstanceGuestOSInfo `json:"os,omitempty"`
+	// Drivers the guest uses for its devices
+	// +listType=atomic
+	Drivers []VirtualMachineInstanceGuestOSDriver `json:"drivers,omitempty"`
 	// Timezone is guest os current timezone
 	Timezone string `json:"timezone,omitempty"`
 	// UserList is a list of active guest OS users
@@ -2962,6 +3312,103 @@ type RemoveVolumeOptions struct {
 	DryRun []string `json:"dryRun,omitempty"`
 }
 
+// ChangeMediaOptions is provided when inserting or ejecting the media of a CD-ROM disk
+type ChangeMediaOptions struct {
+	// Name represents the name of the CD-ROM disk whose media is changed
+	Name string `json:"name"`
+	// VolumeSource represents the media to insert into the CD-ROM.
+	// The current media is ejected when it is not set.
+	// +optional
+	VolumeSource *HotplugVolumeSource `json:"volumeSource,omitempty"`
+	// When present, indicates that modifications should not be
+	// persisted. An invalid or unrecognized dryRun directive will
+	// result in an error response and no further processing of the
+	// request. Valid values are:
+	// - All: all dry run stages will be processed
+	// +optional
+	// +listType=atomic
+	DryRun []string `json:"dryRun,omitempty"`
+}
+
+// DebugAttachOptions are used when attaching a debug container to the virt-launcher pod of a VMI
+type DebugAttachOptions struct {
+	// Reason explains why the debug container is needed, it is recorded for auditing
+	Reason string `json:"reason"`
+	// Privileged runs the debug container privileged, giving it access to the host.
+	// Otherwise it runs with the security context of the compute container.
+	// +optional
+	Privileged bool `json:"privileged,omitempty"`
+	// Duration after which the debug container exits.
+	// Defaults to 15 minutes and may not exceed 1 hour.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
+// DebugAttachResult identifies the debug container attached to the virt-launcher pod of a VMI
+type DebugAttachResult struct {
+	// PodName is the name of the virt-launcher pod the debug container is attached to
+	PodName string `json:"podName"`
+	// ContainerName is the name of the debug container
+	ContainerName string `json:"containerName"`
+	// ExpirationTimestamp is the time after which the debug container exits
+	ExpirationTimestamp metav1.Time `json:"expirationTimestamp"`
+}
+
+// DomainJobType is the kind of a libvirt job running on the domain of a VMI
+type DomainJobType string
+
+const (
+	// DomainJobTypeDomain is the job running on the whole domain, e.g. an outgoing migration or a backup
+	DomainJobTypeDomain DomainJobType = "Domain"
+	// DomainJobTypeBlock is a job running on a single disk of the domain, e.g. a block copy
+	DomainJobTypeBlock DomainJobType = "Block"
+)
+
+// VirtualMachineInstanceDomainJobList comprises the libvirt jobs in progress on the domain of a VMI
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineInstanceDomainJobList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineInstanceDomainJob `json:"items"`
+}
+
+// VirtualMachineInstanceDomainJob represents a libvirt job in progress on the domain of a VMI
+type VirtualMachineInstanceDomainJob struct {
+	// Type of the job, either Domain or Block
+	Type DomainJobType `json:"type"`
+	// Operation carried out by the job, e.g. MigrationOut, Backup or Copy
+	Operation string `json:"operation,omitempty"`
+	// Disk is the target of the disk a Block job runs on
+	// +optional
+	Disk string `json:"disk,omitempty"`
+	// Processed is the amount of data processed so far, in bytes
+	// +optional
+	Processed int64 `json:"processed,omitempty"`
+	// Total is the amount of data to be processed, in bytes
+	// +optional
+	Total int64 `json:"total,omitempty"`
+}
+
+// CancelDomainJobOptions are used when cancelling a libvirt job in progress on the domain of a VMI
+type CancelDomainJobOptions struct {
+	// Type of the job to cancel, either Domain or Block
+	Type DomainJobType `json:"type"`
+	// Disk is the target of the disk the Block job to cancel runs on, it is required for Block jobs
+	// +optional
+	Disk string `json:"disk,omitempty"`
+}
+
+// VirtualMachineInstanceQemuCommandLine is the command line the QEMU process of a VMI was started with.
+// Secrets passed on the command line are redacted.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineInstanceQemuCommandLine struct {
+	metav1.TypeMeta `json:",inline"`
+	// Args are the arguments of the QEMU process, starting with the path of its binary
+	Args []string `json:"args"`
+}
+
 type TokenBucketRateLimiter struct {
 	// QPS indicates the maximum QPS to the apiserver from this client.
 	// If it's zero, the component default will be used
@@ -3097,6 +3544,225 @@ type KubeVirtConfiguration struct {
 	// +optional
 	// +kubebuilder:validation:Enum=AggregateToDefault;Manual
 	RoleAggregationStrategy *RoleAggregationStrategy `json:"roleAggregationStrategy,omitempty"`
+
+	// SubresourceRateLimits configures how virt-api throttles calls to VM and VMI subresources.
+	// +optional
+	SubresourceRateLimits *SubresourceRateLimits `json:"subresourceRateLimits,omitempty"`
+
+	// LoadAwareRebalancing configures how VMIs are live migrated off overloaded nodes.
+	// It is only taken into account when the LoadAwareRebalancing feature gate is enabled.
+	// +optional
+	LoadAwareRebalancing *LoadAwareRebalancingConfiguration `json:"loadAwareRebalancing,omitempty"`
+
+	// ConsoleRecording configures the recording of the serial console and VNC sessions opened through virt-api.
+	// +optional
+	ConsoleRecording *ConsoleRecordingConfiguration `json:"consoleRecording,omitempty"`
+
+	// VCPUAutoscaling configures when more CPU sockets are recommended for the VirtualMachines
+	// opting in to CPU autoscaling.
+	// It is only taken into account when the VCPUAutoscaling feature gate is enabled.
+	// +optional
+	VCPUAutoscaling *VCPUAutoscalingConfiguration `json:"vcpuAutoscaling,omitempty"`
+
+	// ContainerDiskPrePull configures the container disk images pulled ahead of time, and kept cached, on the nodes.
+	// It is only taken into account when the ContainerDiskPrePull feature gate is enabled.
+	// +optional
+	ContainerDiskPrePull *ContainerDiskPrePullConfiguration `json:"containerDiskPrePull,omitempty"`
+
+	// VirtControllerSharding splits the reconciliation of the namespaced workloads between several active
+	// virt-controller instances.
+	// It is only taken into account when the VirtControllerSharding feature gate is enabled.
+	// +optional
+	VirtControllerSharding *VirtControllerShardingConfiguration `json:"virtControllerSharding,omitempty"`
+
+	// VMIStatusUpdates configures how virt-handler aggregates the frequent updates of the VMI status.
+	// +optional
+	VMIStatusUpdates *VMIStatusUpdatesConfiguration `json:"vmiStatusUpdates,omitempty"`
+}
+
+// SubresourceRateLimits holds the token buckets virt-api applies to VM and VMI subresource calls.
+// Interactive subresources, like console and VNC, are never throttled. Migration related
+// subresources are accounted in a dedicated lane, so bulk lifecycle calls cannot starve them.
+type SubresourceRateLimits struct {
+	// PerUser limits the subresource calls issued by every single user.
+	// +optional
+	PerUser *TokenBucketRateLimiter `json:"perUser,omitempty"`
+	// PerNamespace limits the subresource calls targeting every single namespace.
+	// +optional
+	PerNamespace *TokenBucketRateLimiter `json:"perNamespace,omitempty"`
+}
+
+// LoadAwareRebalancingConfiguration holds the thresholds and budget used to live migrate VMIs
+// off nodes whose CPUs are overloaded, as reported by virt-handler.
+type LoadAwareRebalancingConfiguration struct {
+	// CPUUtilizationThreshold is the node CPU utilization, in percent, above which
+	// VMIs are migrated off the node. Defaults to 85
+	// +optional
+	CPUUtilizationThreshold *uint32 `json:"cpuUtilizationThreshold,omitempty"`
+	// CPUStealThreshold is the share of time, in percent, the vCPUs running on a node wait
+	// for a physical CPU, above which VMIs are migrated off the node. Defaults to 10
+	// +optional
+	CPUStealThreshold *uint32 `json:"cpuStealThreshold,omitempty"`
+	// Cooldown is the minimum time between two rebalancing migrations off the same node,
+	// and between two rebalancing migrations of the same VMI. Defaults to 10 minutes
+	// +optional
+	Cooldown *metav1.Duration `json:"cooldown,omitempty"`
+	// MaxParallelMigrations is the number of rebalancing migrations allowed to run
+	// cluster-wide at the same time. Defaults to 2
+	// +optional
+	MaxParallelMigrations *uint32 `json:"maxParallelMigrations,omitempty"`
+}
+
+// VCPUAutoscalingConfiguration holds the thresholds a VirtualMachineInstance vCPU load, as reported
+// by virt-handler, must stay above before one more CPU socket is recommended.
+type VCPUAutoscalingConfiguration struct {
+	// CPUUtilizationThreshold is the vCPU utilization, in percent, above which
+	// one more socket is recommended. Defaults to 90
+	// +optional
+	CPUUtilizationThreshold *uint32 `json:"cpuUtilizationThreshold,omitempty"`
+	// CPUStealThreshold is the share of time, in percent, the vCPUs wait for a physical CPU,
+	// above which one more socket is recommended. Defaults to 20
+	// +optional
+	CPUStealThreshold *uint32 `json:"cpuStealThreshold,omitempty"`
+	// Window is how long the vCPU load must stay above one of the thresholds before one more
+	// socket is recommended. Defaults to 10 minutes
+	// +optional
+	Window *metav1.Duration `json:"window,omitempty"`
+	// Cooldown is the minimum time between two scale ups of the same VirtualMachine. Defaults to 30 minutes
+	// +optional
+	Cooldown *metav1.Duration `json:"cooldown,omitempty"`
+}
+
+// ContainerDiskPrePullConfiguration selects the container disk images kept cached on the nodes, so that
+// creating many VMs at once does not stampede the registry and their boot time does not depend on image pulls.
+type ContainerDiskPrePullConfiguration struct {
+	// Images lists container disk images to pull on the nodes.
+	// +optional
+	// +listType=set
+	Images []string `json:"images,omitempty"`
+	// VirtualMachineSelector adds the container disk images referenced by the templates of the
+	// VirtualMachines matching the selector. An empty selector matches all the VirtualMachines.
+	// Images requiring an image pull secret are skipped.
+	// +optional
+	VirtualMachineSelector *metav1.LabelSelector `json:"virtualMachineSelector,omitempty"`
+	// NodeSelector restricts the nodes the images are pulled on. Defaults to the nodes schedulable for VMIs.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+}
+
+// VirtControllerShardingConfiguration splits the namespaces into shards by hashing their names. Every shard is
+// reconciled by the single virt-controller instance holding its lease, so that several instances handle the
+// VirtualMachines, VirtualMachineInstances, migrations and replica sets at the same time. Cluster wide
+// loops keep running on the leader only.
+type VirtControllerShardingConfiguration struct {
+	// Shards is the number of namespace shards. It should not exceed the number of virt-controller replicas,
+	// since every instance holds at most one shard; the spare instances take over the shards of failed ones.
+	// Defaults to 1
+	// +optional
+	Shards *uint32 `json:"shards,omitempty"`
+}
+
+// VMIStatusUpdatesConfiguration spaces the writes of the VMI status carrying only frequently changing,
+// informational fields. Such changes are held back by virt-handler and written together once their
+// interval elapsed since the last write of the status. Changes of any other field, like the phase or
+// the conditions, are written right away, along with the held back ones.
+type VMIStatusUpdatesConfiguration struct {
+	// InterfacesInterval is the minimal interval between two writes of the status carrying only
+	// changes of the interfaces, like the IP addresses reported by the guest agent.
+	// Defaults to 0, which writes every change right away.
+	// +optional
+	InterfacesInterval *metav1.Duration `json:"interfacesInterval,omitempty"`
+	// GuestAgentInfoInterval is the minimal interval between two writes of the status carrying only
+	// changes of the guest OS information reported by the guest agent.
+	// Defaults to 0, which writes every change right away.
+	// +optional
+	GuestAgentInfoInterval *metav1.Duration `json:"guestAgentInfoInterval,omitempty"`
+	// MigrationProgressInterval is the minimal interval between two writes of the status carrying only
+	// changes of the migration progress.
+	// Defaults to 0, which writes every change right away.
+	// +optional
+	MigrationProgressInterval *metav1.Duration `json:"migrationProgressInterval,omitempty"`
+}
+
+// ConsoleRecordingConfiguration configures where the transcripts of console sessions are streamed to,
+// and which sessions are recorded.
+type ConsoleRecordingConfiguration struct {
+	// Sink receives the session transcripts. Exactly one sink must be set.
+	Sink ConsoleRecordingSink `json:"sink"`
+	// Policies select the namespaces whose console sessions are recorded.
+	// Sessions in namespaces not selected by any policy are not recorded.
+	// When several policies select a namespace, the first one applies.
+	// +listType=atomic
+	Policies []ConsoleRecordingPolicy `json:"policies"`
+}
+
+// ConsoleSessionType is a kind of console session which can be recorded.
+type ConsoleSessionType string
+
+const (
+	ConsoleSessionSerial ConsoleSessionType = "Serial"
+	ConsoleSessionVNC    ConsoleSessionType = "VNC"
+)
+
+// ConsoleRecordingPolicy selects the console sessions recorded in a set of namespaces.
+type ConsoleRecordingPolicy struct {
+	// NamespaceSelector selects the namespaces the policy applies to. An empty selector selects all namespaces.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// Sessions are the kinds of sessions which are recorded. Defaults to Serial and VNC
+	// +optional
+	// +listType=set
+	Sessions []ConsoleSessionType `json:"sessions,omitempty"`
+	// Required refuses console sessions, and closes the running ones, when their transcript
+	// cannot be delivered to the sink. Otherwise, delivery failures are only logged.
+	// +optional
+	Required bool `json:"required,omitempty"`
+}
+
+// ConsoleRecordingSink holds the destination of the console session transcripts.
+type ConsoleRecordingSink struct {
+	// PersistentVolumeClaim writes the transcripts as files on a volume mounted into virt-api.
+	// +optional
+	PersistentVolumeClaim *ConsoleRecordingPVCSink `json:"persistentVolumeClaim,omitempty"`
+	// S3 uploads the transcripts as objects to an S3 compatible bucket.
+	// +optional
+	S3 *ConsoleRecordingS3Sink `json:"s3,omitempty"`
+	// Webhook posts the transcripts to an HTTP endpoint.
+	// +optional
+	Webhook *ConsoleRecordingWebhookSink `json:"webhook,omitempty"`
+}
+
+// ConsoleRecordingPVCSink writes the transcripts to a PersistentVolumeClaim.
+type ConsoleRecordingPVCSink struct {
+	// ClaimName is the name of a PersistentVolumeClaim in the KubeVirt install namespace.
+	// It needs the ReadWriteMany access mode when virt-api runs more than one replica.
+	ClaimName string `json:"claimName"`
+}
+
+// ConsoleRecordingS3Sink uploads the transcripts to an S3 compatible bucket.
+type ConsoleRecordingS3Sink struct {
+	// Endpoint is the URL of the S3 service, e.g. https://s3.us-east-1.amazonaws.com
+	Endpoint string `json:"endpoint"`
+	// Region is the region of the bucket, used to sign the requests
+	Region string `json:"region"`
+	// Bucket is the name of the bucket the transcripts are uploaded to
+	Bucket string `json:"bucket"`
+	// Prefix is prepended to the key of the uploaded objects
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+	// CredentialsSecretName is the name of a secret in the KubeVirt install namespace
+	// holding the accessKeyId and secretAccessKey keys.
+	CredentialsSecretName string `json:"credentialsSecretName"`
+}
+
+// ConsoleRecordingWebhookSink posts the transcripts to an HTTP endpoint.
+type ConsoleRecordingWebhookSink struct {
+	// URL is the endpoint every transcript chunk is posted to
+	URL string `json:"url"`
+	// CABundle is the PEM encoded bundle used to verify the endpoint certificate.
+	// The system trust store is used when it is not set.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
 }
 
 // QGSConfiguration holds QGS configuration
@@ -3434,6 +4100,9 @@ const (
 	PCIResourcePrefix  = "PCI_RESOURCE"
 	MDevResourcePrefix = "MDEV_PCI_RESOURCE"
 	USBResourcePrefix  = "USB_RESOURCE"
+	// PCIIOMMUGroupResourcePrefix prefixes the variables listing, for each allocated PCI device,
+	// the other devices sharing its IOMMU group
+	PCIIOMMUGroupResourcePrefix = "PCI_IOMMU_GROUP_RESOURCE"
 )
 
 // PermittedHostDevices holds information about devices allowed for passthrough
@@ -3473,6 +4142,11 @@ type PciHostDevice struct {
 	// If true, KubeVirt will leave the allocation and monitoring to an
 	// external device plugin
 	ExternalResourceProvider bool `json:"externalResourceProvider,omitempty"`
+	// If true, virt-handler binds the devices of this resource to vfio-pci when they are
+	// allocated to a VMI, and returns them to their host driver once they are released.
+	// Devices found bound to vfio-pci while unused are considered released as well.
+	// +optional
+	ManageDriverBinding bool `json:"manageDriverBinding,omitempty"`
 }
 
 // MediatedHostDevice represents a host mediated device allowed for passthrough
@@ -3534,6 +4208,64 @@ type NetworkConfiguration struct {
 	DeprecatedPermitSlirpInterface    *bool                             `json:"permitSlirpInterface,omitempty"`
 	PermitBridgeInterfaceOnPodNetwork *bool                             `json:"permitBridgeInterfaceOnPodNetwork,omitempty"`
 	Binding                           map[string]InterfaceBindingPlugin `json:"binding,omitempty"`
+	// MacGeneration enables the allocation of a MAC address for each VMI interface which does not specify one.
+	// By default, the MAC address is derived from the owner VM UID and the network name,
+	// and is kept across restarts of the VM.
+	// +optional
+	MacGeneration *MacGenerationPolicy `json:"macGeneration,omitempty"`
+	// InterfaceEvents configures the delivery of CloudEvents about the VMI interfaces
+	// being configured, hotplugged or removed, to let external SDN controllers react to them.
+	// +optional
+	InterfaceEvents *InterfaceEventsConfiguration `json:"interfaceEvents,omitempty"`
+	// PromiscuousInterfacesNamespaceLabelSelector selects the namespaces whose VMIs are allowed
+	// to declare promiscuous interfaces. When not set, promiscuous interfaces are not allowed.
+	// +optional
+	PromiscuousInterfacesNamespaceLabelSelector *metav1.LabelSelector `json:"promiscuousInterfacesNamespaceLabelSelector,omitempty"`
+	// NetworkInterfaceMultiQueuePolicy is the default queue pairs policy of the VMIs which enable
+	// networkInterfaceMultiqueue without specifying their own policy.
+	// +optional
+	NetworkInterfaceMultiQueuePolicy *NetworkQueuesPolicy `json:"networkInterfaceMultiqueuePolicy,omitempty"`
+}
+
+// InterfaceEventsConfiguration configures the delivery of VMI interface events.
+type InterfaceEventsConfiguration struct {
+	// WebhookURL is the HTTP endpoint the events are posted to, in the CloudEvents structured JSON format.
+	// Delivery is best effort, failed deliveries are retried a few times before the event is dropped.
+	// A retried event may be received more than once, consumers can rely on the event id to detect duplicates.
+	WebhookURL string `json:"webhookURL"`
+}
+
+// MacGenerationPolicy configures the allocation of interface MAC addresses.
+type MacGenerationPolicy struct {
+	// OUI is the prefix used as the first three octets of the generated MAC addresses,
+	// formatted as "xx:xx:xx". It must represent a unicast address.
+	// Defaults to "02:6b:76", a locally administered prefix.
+	// +optional
+	OUI string `json:"oui,omitempty"`
+	// Allocator selects how the MAC addresses are allocated.
+	// Deterministic derives them from the owner VM UID and the network name, Random picks them at random
+	// and External requests them from the webhook configured in external.
+	// Defaults to Deterministic.
+	// +optional
+	Allocator MacAllocatorType `json:"allocator,omitempty"`
+	// External configures the webhook allocating the MAC addresses, required by the External allocator.
+	// The webhook is called by virt-controller when it creates the VMI of a VM, standalone VMIs are not covered.
+	// +optional
+	External *ExternalMacAllocator `json:"external,omitempty"`
+}
+
+type MacAllocatorType string
+
+const (
+	MacAllocatorDeterministic MacAllocatorType = "Deterministic"
+	MacAllocatorRandom        MacAllocatorType = "Random"
+	MacAllocatorExternal      MacAllocatorType = "External"
+)
+
+// ExternalMacAllocator configures an external MAC address allocator, like a MAC pool manager.
+type ExternalMacAllocator struct {
+	// WebhookURL is the HTTP endpoint the allocation requests are posted to.
+	WebhookURL string `json:"webhookURL"`
 }
 
 type InterfaceBindingPlugin struct {
@@ -3560,10 +4292,74 @@ type InterfaceBindingPlugin struct {
 	// +optional
 	DownwardAPI NetworkBindingDownwardAPIType `json:"downwardAPI,omitempty"`
 
+	// ResourceName references a device plugin resource the virt-launcher pod requests for each
+	// interface which uses the binding on the pod network.
+	// It allows the binding to handle a primary network whose default CNI attaches a device allocated
+	// by a device plugin (e.g. vDPA) and reports it in the device-info.
+	// Secondary networks request their resource through their NetworkAttachmentDefinition.
+	// version: v1alphav1
+	// +optional
+	ResourceName string `json:"resourceName,omitempty"`
+
 	// ComputeResourceOverhead specifies the resource overhead that should be added to the compute container when using the binding.
+	// Its memory and CPU requests are added once per VMI, whatever the number of interfaces using the binding.
+	// The CPU is added to the CPU limits as well when they are set, but not to dedicated CPUs.
 	// version: v1alphav1
 	// +optional
 	ComputeResourceOverhead *ResourceRequirementsWithoutClaims `json:"computeResourceOverhead,omitempty"`
+
+	// SidecarResources specifies the CPU and memory requests and limits of the plugin sidecar container.
+	// They take precedence over the resources set for all the hook sidecars through SupportContainerResources.
+	// version: v1alphav1
+	// +optional
+	SidecarResources *ResourceRequirementsWithoutClaims `json:"sidecarResources,omitempty"`
+
+	// Prerequisites declares the cluster-wide requirements of the binding plugin.
+	// Their fulfillment is reported in the KubeVirt CR status.
+	// version: v1alphav1
+	// +optional
+	Prerequisites *InterfaceBindingPrerequisites `json:"prerequisites,omitempty"`
+
+	// DryRunValidation configures an endpoint, served by the binding plugin, which validates the spec
+	// of the VMIs using the plugin on their creation, catching misconfigurations before they are launched.
+	// version: v1alphav1
+	// +optional
+	DryRunValidation *InterfaceBindingDryRunValidation `json:"dryRunValidation,omitempty"`
+}
+
+// InterfaceBindingDryRunValidation configures the validation of VMI specs by a binding plugin.
+type InterfaceBindingDryRunValidation struct {
+	// WebhookURL is the HTTP endpoint the VMI spec is posted to.
+	// The endpoint replies whether the spec is allowed by the plugin and, when it is not, the reason.
+	WebhookURL string `json:"webhookURL"`
+	// FailurePolicy defines how an unreachable endpoint or an unexpected reply is handled.
+	// Fail rejects the VMI and Ignore admits it. Defaults to Fail.
+	// +optional
+	FailurePolicy InterfaceBindingDryRunFailurePolicy `json:"failurePolicy,omitempty"`
+	// CABundle is the PEM encoded bundle used to verify the endpoint certificate.
+	// The system trust store is used when it is not set.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+}
+
+type InterfaceBindingDryRunFailurePolicy string
+
+const (
+	InterfaceBindingDryRunFailurePolicyFail   InterfaceBindingDryRunFailurePolicy = "Fail"
+	InterfaceBindingDryRunFailurePolicyIgnore InterfaceBindingDryRunFailurePolicy = "Ignore"
+)
+
+// InterfaceBindingPrerequisites describes what the binding plugin requires from the cluster in order to function.
+type InterfaceBindingPrerequisites struct {
+	// FeatureGates lists the feature gates which are required to be enabled.
+	// +optional
+	// +listType=atomic
+	FeatureGates []string `json:"featureGates,omitempty"`
+	// DeviceResources lists the extended resources, exposed by device plugins,
+	// which are required to be advertised by at least one schedulable node.
+	// +optional
+	// +listType=atomic
+	DeviceResources []string `json:"deviceResources,omitempty"`
 }
 
 // ResourceRequirementsWithoutClaims describes the compute resource requirements.
@@ -3618,6 +4414,20 @@ const (
 type GuestAgentPing struct {
 }
 
+// GuestAgentExec configures the guest-agent based exec probe
+type GuestAgentExec struct {
+	// Command is the command line to execute inside the guest. The first element is the
+	// executable, the remaining elements are passed as arguments. The command is not run
+	// in a shell, so shell instructions like pipes are only available by calling a shell explicitly.
+	// +listType=atomic
+	Command []string `json:"command"`
+	// SuccessExitCodes lists the exit codes of the command which are considered a successful probe.
+	// Defaults to 0.
+	// +optional
+	// +listType=set
+	SuccessExitCodes []int32 `json:"successExitCodes,omitempty"`
+}
+
 type ProfilerResult struct {
 	PprofData map[string][]byte `json:"pprofData,omitempty"`
 }
@@ -3804,6 +4614,8 @@ type SEVMeasurementInfo struct {
 	Policy uint `json:"policy,omitempty"`
 	// SHA256 of the loader binary
 	LoaderSHA string `json:"loaderSHA,omitempty"`
+	// Policy of the SEV-SNP guest.
+	SNPPolicy uint64 `json:"snpPolicy,omitempty"`
 }
 
 // SEVSessionOptions is used to provide SEV session parameters.
@@ -3814,6 +4626,16 @@ type SEVSessionOptions struct {
 	DHCert string `json:"dhCert,omitempty"`
 }
 
+// SEVSNPSessionOptions is used to provide the SEV-SNP launch parameters of the guest owner.
+type SEVSNPSessionOptions struct {
+	// Base64 encoded ID block, holding the expected launch measurement of the guest.
+	IDBlock string `json:"idBlock,omitempty"`
+	// Base64 encoded ID authentication information, holding the signature of the ID block.
+	IDAuth string `json:"idAuth,omitempty"`
+	// Base64 encoded 32 bytes included as is in the attestation reports of the guest.
+	HostData string `json:"hostData,omitempty"`
+}
+
 // SEVSecretOptions is used to provide a secret for a running guest.
 type SEVSecretOptions struct {
 	// Base64 encoded header needed to decrypt the secret.
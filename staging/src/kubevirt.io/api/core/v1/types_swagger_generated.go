@@ -37,6 +37,8 @@ func (VirtualMachineInstanceSpec) SwaggerDoc() map[string]string {
 		"networks":                      "List of networks that can be attached to a vm's virtual interface.\n+kubebuilder:validation:MaxItems:=256",
 		"dnsPolicy":                     "Set DNS policy for the pod.\nDefaults to \"ClusterFirst\".\nValid values are 'ClusterFirstWithHostNet', 'ClusterFirst', 'Default' or 'None'.\nDNS parameters given in DNSConfig will be merged with the policy selected with DNSPolicy.\nTo have DNS options set along with hostNetwork, you have to specify DNS policy\nexplicitly to 'ClusterFirstWithHostNet'.\n+optional",
 		"dnsConfig":                     "Specifies the DNS parameters of a pod.\nParameters specified here will be merged to the generated DNS\nconfiguration based on DNSPolicy.\n+optional",
+		"guestDNS":                      "GuestDNS overrides the DNS configuration the guest receives over DHCP, independently of the\nDNS policy and configuration of the virt-launcher pod.\nParameters which are not specified are taken from the pod DNS configuration.\n+optional",
+		"metadataService":               "MetadataService exposes the instance identity, the network configuration and user provided data\nto the guest, over HTTP on the link-local 169.254.169.254 address of the masquerade pod network.\n+optional",
 		"accessCredentials":             "Specifies a set of public keys to inject into the vm guest\n+listType=atomic\n+optional\n+kubebuilder:validation:MaxItems:=256",
 		"architecture":                  "Specifies the architecture of the vm guest you are attempting to run. Defaults to the compiled architecture of the KubeVirt components",
 		"resourceClaims":                "ResourceClaims define which ResourceClaims must be allocated\nand reserved before the VMI, hence virt-launcher pod is allowed to start. The resources\nwill be made available to the domain which consumes them\nby name.\n\nThis is an alpha field and requires enabling the\nDynamicResourceAllocation feature gate in kubernetes\n https://kubernetes.io/docs/concepts/scheduling-eviction/dynamic-resource-allocation/\nThis field should only be configured if one of the feature-gates GPUsWithDRA or HostDevicesWithDRA is enabled.\nThis feature is in alpha.\n\n+listType=map\n+listMapKey=name\n+optional",
@@ -44,6 +46,21 @@ func (VirtualMachineInstanceSpec) SwaggerDoc() map[string]string {
 	}
 }
 
+func (GuestDNSConfig) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "GuestDNSConfig is the DNS configuration delivered to the guest.",
+		"nameservers": "Nameservers is a list of IP addresses of the DNS servers the guest should use.\nThe DNS servers of an IP family which has none in the list are taken from the pod DNS configuration.\n+listType=atomic\n+optional",
+		"searches":    "Searches is a list of DNS search domains the guest should use.\n+listType=atomic\n+optional",
+	}
+}
+
+func (MetadataService) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "MetadataService is the configuration of the metadata endpoint served to the guest.",
+		"data": "Data is user provided key/value data served to the guest.\nIt can be extended or updated after boot through the VirtualMachineInstance annotations\nprefixed with metadata.kubevirt.io/, which take precedence.\n+optional",
+	}
+}
+
 func (VirtualMachineInstancePhaseTransitionTimestamp) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                         "VirtualMachineInstancePhaseTransitionTimestamp gives a timestamp in relation to when a phase is set on a vmi",
@@ -52,6 +69,14 @@ func (VirtualMachineInstancePhaseTransitionTimestamp) SwaggerDoc() map[string]st
 	}
 }
 
+func (VirtualMachineInstanceBootPhaseTimestamp) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "VirtualMachineInstanceBootPhaseTimestamp gives a timestamp in relation to when a boot phase was reached by a vmi",
+		"phase":     "Phase is the boot phase reached by the VirtualMachineInstance",
+		"timestamp": "Timestamp is the time at which the boot phase was reached",
+	}
+}
+
 func (TopologyHints) SwaggerDoc() map[string]string {
 	return map[string]string{}
 }
@@ -64,8 +89,10 @@ func (VirtualMachineInstanceStatus) SwaggerDoc() map[string]string {
 		"conditions":                    "Conditions are specific points in VirtualMachineInstance's pod runtime.",
 		"phase":                         "Phase is the status of the VirtualMachineInstance in kubernetes world. It is not the VirtualMachineInstance status, but partially correlates to it.",
 		"phaseTransitionTimestamps":     "PhaseTransitionTimestamp is the timestamp of when the last phase change occurred\n+listType=atomic\n+optional",
+		"bootPhaseTimestamps":           "BootPhaseTimestamps records when the VirtualMachineInstance reached each of the steps of its start,\nallowing to tell which of them delayed it\n+listType=atomic\n+optional",
 		"interfaces":                    "Interfaces represent the details of available network interfaces.",
 		"guestOSInfo":                   "Guest OS Information",
+		"guestDrivers":                  "GuestDrivers are the drivers the guest uses for its devices, as reported by the guest agent\n+listType=atomic\n+optional",
 		"migrationState":                "Represents the status of a live migration",
 		"migrationMethod":               "Represents the method using which the vmi can be migrated: live migration or block migration",
 		"migrationTransport":            "This represents the migration transport",
@@ -74,6 +101,7 @@ func (VirtualMachineInstanceStatus) SwaggerDoc() map[string]string {
 		"evacuationNodeName":            "EvacuationNodeName is used to track the eviction process of a VMI. It stores the name of the node that we want\nto evacuate. It is meant to be used by KubeVirt core components only and can't be set or modified by users.\n+optional",
 		"activePods":                    "ActivePods is a mapping of pod UID to node name.\nIt is possible for multiple pods to be running for a single VMI during migration.",
 		"volumeStatus":                  "VolumeStatus contains the statuses of all the volumes\n+optional\n+listType=atomic",
+		"hostDeviceStatuses":            "HostDeviceStatuses contains the statuses of the host devices assigned to the VirtualMachineInstance\n+optional\n+listType=atomic",
 		"kernelBootStatus":              "KernelBootStatus contains info about the kernelBootContainer\n+optional",
 		"fsFreezeStatus":                "FSFreezeStatus indicates whether a freeze operation was requested for the guest filesystem.\nIt will be set to \"frozen\" if the request was made, or unset otherwise.\nThis does not reflect the actual state of the guest filesystem.\n+optional",
 		"topologyHints":                 "+optional",
@@ -174,6 +202,26 @@ func (ContainerDiskInfo) SwaggerDoc() map[string]string {
 	}
 }
 
+func (HostDeviceStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                  "HostDeviceStatus represents information about the status of a host device assigned to the VirtualMachineInstance.",
+		"name":              "Name is the name of the host device",
+		"phase":             "Phase is the phase",
+		"reason":            "Reason is a brief description of why we are in the current host device phase",
+		"message":           "Message is a detailed message about the current host device phase",
+		"hotplugHostDevice": "If the host device is hotplugged, this will contain the hotplug status.",
+	}
+}
+
+func (HotplugHostDeviceStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "HotplugHostDeviceStatus represents the hotplug status of the host device",
+		"attachPodName": "AttachPodName is the name of the pod used to allocate the host device on the node.",
+		"attachPodUID":  "AttachPodUID is the UID of the pod used to allocate the host device on the node.",
+		"pciAddress":    "PCIAddress is the host PCI address of the device allocated to the attachment pod.",
+	}
+}
+
 func (VirtualMachineInstanceCondition) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"lastProbeTime":      "+nullable",
@@ -190,15 +238,16 @@ func (VirtualMachineInstanceMigrationCondition) SwaggerDoc() map[string]string {
 
 func (VirtualMachineInstanceNetworkInterface) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"ipAddress":        "IP address of a Virtual Machine interface. It is always the first item of\nIPs",
-		"mac":              "Hardware address of a Virtual Machine interface",
-		"name":             "Name of the interface, corresponds to name of the network assigned to the interface",
-		"ipAddresses":      "List of all IP addresses of a Virtual Machine interface",
-		"podInterfaceName": "PodInterfaceName represents the name of the pod network interface",
-		"interfaceName":    "The interface name inside the Virtual Machine",
-		"infoSource":       "Specifies the origin of the interface data collected. values: domain, guest-agent, multus-status.",
-		"queueCount":       "Specifies how many queues are allocated by MultiQueue",
-		"linkState":        "LinkState Reports the current operational link state`. values: up, down.",
+		"ipAddress":             "IP address of a Virtual Machine interface. It is always the first item of\nIPs",
+		"mac":                   "Hardware address of a Virtual Machine interface",
+		"name":                  "Name of the interface, corresponds to name of the network assigned to the interface",
+		"ipAddresses":           "List of all IP addresses of a Virtual Machine interface",
+		"podInterfaceName":      "PodInterfaceName represents the name of the pod network interface",
+		"interfaceName":         "The interface name inside the Virtual Machine",
+		"infoSource":            "Specifies the origin of the interface data collected. values: domain, guest-agent, multus-status.",
+		"queueCount":            "Specifies how many queues are allocated by MultiQueue",
+		"linkState":             "LinkState Reports the current operational link state`. values: up, down.",
+		"hotplugFailedAttempts": "HotplugFailedAttempts counts the failed attempts to hot plug the interface into the running domain.\nThe hotplug is rolled back once the attempts are exhausted.",
 	}
 }
 
@@ -215,6 +264,15 @@ func (VirtualMachineInstanceGuestOSInfo) SwaggerDoc() map[string]string {
 	}
 }
 
+func (VirtualMachineInstanceGuestOSDriver) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "VirtualMachineInstanceGuestOSDriver describes a driver the guest uses for one of its devices",
+		"name":     "Name of the driver",
+		"version":  "Version of the driver",
+		"deviceID": "PCI vendor and device ID of the device handled by the driver, in the vendor:device format",
+	}
+}
+
 func (VirtualMachineInstanceCommonMigrationState) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"node":                      "The source node that the VMI originated on",
@@ -279,6 +337,15 @@ func (VirtualMachineInstanceMigrationState) SwaggerDoc() map[string]string {
 		"targetState":                    "TargetState contains migration state managed by the target virt handler",
 		"migrationNetworkType":           "The type of migration network, either 'pod' or 'migration'",
 		"targetMemoryOverhead":           "TargetMemoryOverhead is the memory overhead of the target virt-launcher pod\n+optional",
+		"diskTransferProgress":           "DiskTransferProgress reports how much of the migrated volumes has been copied to the target\n+optional",
+	}
+}
+
+func (MigrationDiskTransferProgress) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "MigrationDiskTransferProgress reports the progress of copying volumes during a storage live migration",
+		"totalBytes":     "TotalBytes is the amount of volume data to be copied to the target",
+		"processedBytes": "ProcessedBytes is the amount of volume data already copied to the target",
 	}
 }
 
@@ -440,6 +507,14 @@ func (VirtualMachineSpec) SwaggerDoc() map[string]string {
 		"template":              "Template is the direct specification of VirtualMachineInstance",
 		"dataVolumeTemplates":   "dataVolumeTemplates is a list of dataVolumes that the VirtualMachineInstance template can reference.\nDataVolumes in this list are dynamically created for the VirtualMachine and are tied to the VirtualMachine's life-cycle.",
 		"updateVolumesStrategy": "UpdateVolumesStrategy is the strategy to apply on volumes updates",
+		"cpuAutoscaling":        "CPUAutoscaling opts the VirtualMachine in to the vertical scaling of its CPU sockets,\nbased on the vCPU utilization and steal time of its running VirtualMachineInstance.\n+optional",
+	}
+}
+
+func (CPUAutoscaling) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "CPUAutoscaling configures the vertical scaling of the CPU sockets of a VirtualMachine.\nSockets are only ever added, up to the maxSockets of the VirtualMachineInstance.",
+		"policy": "Policy tells whether the recommended number of sockets is only reported, or also\nhotplugged into the running VirtualMachineInstance. Defaults to Recommend\n+optional",
 	}
 }
 
@@ -470,6 +545,19 @@ func (VirtualMachineStatus) SwaggerDoc() map[string]string {
 		"changedBlockTracking":   "ChangedBlockTracking represents the status of the changedBlockTracking\n+nullable\n+optional",
 		"instancetypeRef":        "InstancetypeRef captures the state of any referenced instance type from the VirtualMachine\n+nullable\n+optional",
 		"preferenceRef":          "PreferenceRef captures the state of any referenced preference from the VirtualMachine\n+nullable\n+optional",
+		"guestOSInfo":            "GuestOSInfo is the last guest OS information reported by the guest agent of the VirtualMachineInstance.\nIt is kept while the VirtualMachine is stopped.\n+nullable\n+optional",
+		"guestDrivers":           "GuestDrivers are the last drivers reported by the guest agent of the VirtualMachineInstance.\nThey are kept while the VirtualMachine is stopped.\n+listType=atomic\n+optional",
+		"cpuRecommendation":      "CPURecommendation is the CPU sockets recommendation for the running VirtualMachineInstance,\nreported when the VirtualMachine opts in to CPU autoscaling.\n+nullable\n+optional",
+	}
+}
+
+func (VirtualMachineCPURecommendation) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineCPURecommendation holds the recommended number of CPU sockets of a VirtualMachine,\nand the state used to only recommend more sockets on a sustained vCPU load.",
+		"sockets":         "Sockets is the recommended number of CPU sockets",
+		"reason":          "Reason explains why more sockets are recommended\n+optional",
+		"overloadedSince": "OverloadedSince is when the vCPU load last went above the thresholds, unset while it is below\n+nullable\n+optional",
+		"lastScaleTime":   "LastScaleTime is when CPU sockets were last hotplugged following the recommendation\n+nullable\n+optional",
 	}
 }
 
@@ -558,6 +646,7 @@ func (Handler) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":               "Handler defines a specific action that should be taken",
 		"exec":           "One and only one of the following should be specified.\nExec specifies the action to take, it will be executed on the guest through the qemu-guest-agent.\nIf the guest agent is not available, this probe will fail.\n+optional",
+		"guestAgentExec": "GuestAgentExec runs a command inside the guest through the qemu-guest-agent and\ninterprets its exit code. No network access to the guest is required.\n+optional",
 		"guestAgentPing": "GuestAgentPing contacts the qemu-guest-agent for availability checks.\n+optional",
 		"httpGet":        "HTTPGet specifies the http request to perform.\n+optional",
 		"tcpSocket":      "TCPSocket specifies an action involving a TCP port.\nTCP hooks not yet supported\n+optional",
@@ -605,8 +694,27 @@ func (CertConfig) SwaggerDoc() map[string]string {
 	}
 }
 
+func (KubeVirtCertManagerConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "KubeVirtCertManagerConfiguration delegates the issuance and rotation of the virt-api and\nvirt-handler certificates, including the ones virt-handler uses towards virt-launcher, to cert-manager",
+		"issuerRef":   "IssuerRef references the cert-manager issuer signing the dedicated KubeVirt CA which issues the certificates",
+		"certificate": "Certificate configuration\nDefaults to the configuration of the self signed server certificates\n+optional",
+	}
+}
+
+func (CertManagerIssuerReference) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "CertManagerIssuerReference references a cert-manager Issuer or ClusterIssuer",
+		"name":  "Name of the issuer",
+		"kind":  "Kind of the issuer, an Issuer has to be in the KubeVirt install namespace\nDefaults to Issuer\n+kubebuilder:validation:Enum=Issuer;ClusterIssuer\n+optional",
+		"group": "Group of the issuer\nDefaults to cert-manager.io\n+optional",
+	}
+}
+
 func (KubeVirtCertificateRotateStrategy) SwaggerDoc() map[string]string {
-	return map[string]string{}
+	return map[string]string{
+		"certManager": "CertManager delegates the virt-api and virt-handler certificates to cert-manager.\nThey are issued by a dedicated CA in the KubeVirt install namespace, which is the only cert-manager CA trusted by the components.\nThe remaining certificates keep being signed by the KubeVirt CA.\n+optional",
+	}
 }
 
 func (KubeVirtWorkloadUpdateStrategy) SwaggerDoc() map[string]string {
@@ -676,6 +784,17 @@ func (KubeVirtStatus) SwaggerDoc() map[string]string {
 		"":                         "KubeVirtStatus represents information pertaining to a KubeVirt deployment.",
 		"generations":              "+listType=atomic",
 		"synchronizationAddresses": "+optional\n+listType=atomic",
+		"networkBindings":          "NetworkBindings reports the usage of the registered network binding plugins\nand whether their prerequisites are satisfied.\n+optional\n+listType=atomic",
+	}
+}
+
+func (NetworkBindingPluginStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                         "NetworkBindingPluginStatus reports the state of a network binding plugin registered in the KubeVirt configuration.",
+		"name":                     "Name is the name under which the binding plugin is registered.",
+		"virtualMachineInstances":  "VirtualMachineInstances is the number of active VMIs using the binding plugin.",
+		"prerequisitesSatisfied":   "PrerequisitesSatisfied reports whether all the declared prerequisites are satisfied cluster-wide.",
+		"unsatisfiedPrerequisites": "UnsatisfiedPrerequisites lists the declared prerequisites which are not satisfied.\n+optional\n+listType=atomic",
 	}
 }
 
@@ -740,6 +859,31 @@ func (EvacuateCancelOptions) SwaggerDoc() map[string]string {
 	}
 }
 
+func (BatchOperationOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "BatchOperationOptions may be provided on a batch lifecycle request, which applies\nan operation to all VirtualMachines of a namespace matching a label selector.",
+		"labelSelector":  "LabelSelector selects the VirtualMachines the operation is applied to.\nAn empty selector selects all VirtualMachines of the namespace.",
+		"maxConcurrency": "MaxConcurrency is the maximum number of VirtualMachines the operation\nis applied to in parallel. Defaults to 10.\n+optional",
+		"dryRun":         "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+	}
+}
+
+func (BatchOperationResult) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "BatchOperationResult reports the outcome of a batch lifecycle request.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"items": "Items holds the outcome of the operation for every selected VirtualMachine, ordered by name.\n+optional\n+listType=atomic",
+	}
+}
+
+func (BatchOperationItemResult) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "BatchOperationItemResult reports the outcome of a batch lifecycle request for a single VirtualMachine.",
+		"name":      "Name of the VirtualMachine.",
+		"succeeded": "Succeeded indicates whether the operation was applied to the VirtualMachine.",
+		"error":     "Error describes why the operation could not be applied to the VirtualMachine.\n+optional",
+	}
+}
+
 func (VirtualMachineInstanceGuestAgentInfo) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                  "VirtualMachineInstanceGuestAgentInfo represents information from the installed guest agent\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
@@ -747,6 +891,7 @@ func (VirtualMachineInstanceGuestAgentInfo) SwaggerDoc() map[string]string {
 		"supportedCommands": "Return command list the guest agent supports\n+listType=atomic",
 		"hostname":          "Hostname represents FQDN of a guest",
 		"os":                "OS contains the guest operating system information",
+		"drivers":           "Drivers the guest uses for its devices\n+listType=atomic",
 		"timezone":          "Timezone is guest os current timezone",
 		"userList":          "UserList is a list of active guest OS users",
 		"fsInfo":            "FSInfo is a guest os filesystem information containing the disk mapping and disk mounts with usage",
@@ -842,6 +987,65 @@ func (RemoveVolumeOptions) SwaggerDoc() map[string]string {
 	}
 }
 
+func (ChangeMediaOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "ChangeMediaOptions is provided when inserting or ejecting the media of a CD-ROM disk",
+		"name":         "Name represents the name of the CD-ROM disk whose media is changed",
+		"volumeSource": "VolumeSource represents the media to insert into the CD-ROM.\nThe current media is ejected when it is not set.\n+optional",
+		"dryRun":       "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+	}
+}
+
+func (DebugAttachOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "DebugAttachOptions are used when attaching a debug container to the virt-launcher pod of a VMI",
+		"reason":     "Reason explains why the debug container is needed, it is recorded for auditing",
+		"privileged": "Privileged runs the debug container privileged, giving it access to the host.\nOtherwise it runs with the security context of the compute container.\n+optional",
+		"duration":   "Duration after which the debug container exits.\nDefaults to 15 minutes and may not exceed 1 hour.\n+optional",
+	}
+}
+
+func (DebugAttachResult) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                    "DebugAttachResult identifies the debug container attached to the virt-launcher pod of a VMI",
+		"podName":             "PodName is the name of the virt-launcher pod the debug container is attached to",
+		"containerName":       "ContainerName is the name of the debug container",
+		"expirationTimestamp": "ExpirationTimestamp is the time after which the debug container exits",
+	}
+}
+
+func (VirtualMachineInstanceDomainJobList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineInstanceDomainJobList comprises the libvirt jobs in progress on the domain of a VMI\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
+
+func (VirtualMachineInstanceDomainJob) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "VirtualMachineInstanceDomainJob represents a libvirt job in progress on the domain of a VMI",
+		"type":      "Type of the job, either Domain or Block",
+		"operation": "Operation carried out by the job, e.g. MigrationOut, Backup or Copy",
+		"disk":      "Disk is the target of the disk a Block job runs on\n+optional",
+		"processed": "Processed is the amount of data processed so far, in bytes\n+optional",
+		"total":     "Total is the amount of data to be processed, in bytes\n+optional",
+	}
+}
+
+func (CancelDomainJobOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "CancelDomainJobOptions are used when cancelling a libvirt job in progress on the domain of a VMI",
+		"type": "Type of the job to cancel, either Domain or Block",
+		"disk": "Disk is the target of the disk the Block job to cancel runs on, it is required for Block jobs\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceQemuCommandLine) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "VirtualMachineInstanceQemuCommandLine is the command line the QEMU process of a VMI was started with.\nSecrets passed on the command line are redacted.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"args": "Args are the arguments of the QEMU process, starting with the path of its binary",
+	}
+}
+
 func (TokenBucketRateLimiter) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"qps":   "QPS indicates the maximum QPS to the apiserver from this client.\nIf it's zero, the component default will be used",
@@ -890,6 +1094,118 @@ func (KubeVirtConfiguration) SwaggerDoc() map[string]string {
 		"changedBlockTrackingLabelSelectors": "ChangedBlockTrackingLabelSelectors defines label selectors. VMs matching these selectors will have changed block tracking enabled.\nEnabling changedBlockTracking is mandatory for performing storage-agnostic backups and incremental backups.\n+nullable",
 		"confidentialCompute":                "QGS configuration for attestation on the Intel TDX Platform\n+nullable",
 		"roleAggregationStrategy":            "RoleAggregationStrategy controls whether RBAC cluster roles should be aggregated\nto the default Kubernetes roles (admin, edit, view).\nWhen set to \"AggregateToDefault\" (default) or not specified, the aggregate-to-* labels are added to the cluster roles.\nWhen set to \"Manual\", the labels are not added, and roles will not be aggregated to the default roles.\nSetting this field to \"Manual\" requires the OptOutRoleAggregation feature gate to be enabled.\nThis is an Alpha feature and subject to change.\n+optional\n+kubebuilder:validation:Enum=AggregateToDefault;Manual",
+		"subresourceRateLimits":              "SubresourceRateLimits configures how virt-api throttles calls to VM and VMI subresources.\n+optional",
+		"loadAwareRebalancing":               "LoadAwareRebalancing configures how VMIs are live migrated off overloaded nodes.\nIt is only taken into account when the LoadAwareRebalancing feature gate is enabled.\n+optional",
+		"consoleRecording":                   "ConsoleRecording configures the recording of the serial console and VNC sessions opened through virt-api.\n+optional",
+		"vcpuAutoscaling":                    "VCPUAutoscaling configures when more CPU sockets are recommended for the VirtualMachines\nopting in to CPU autoscaling.\nIt is only taken into account when the VCPUAutoscaling feature gate is enabled.\n+optional",
+		"containerDiskPrePull":               "ContainerDiskPrePull configures the container disk images pulled ahead of time, and kept cached, on the nodes.\nIt is only taken into account when the ContainerDiskPrePull feature gate is enabled.\n+optional",
+		"virtControllerSharding":             "VirtControllerSharding splits the reconciliation of the namespaced workloads between several active\nvirt-controller instances.\nIt is only taken into account when the VirtControllerSharding feature gate is enabled.\n+optional",
+		"vmiStatusUpdates":                   "VMIStatusUpdates configures how virt-handler aggregates the frequent updates of the VMI status.\n+optional",
+	}
+}
+
+func (SubresourceRateLimits) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "SubresourceRateLimits holds the token buckets virt-api applies to VM and VMI subresource calls.\nInteractive subresources, like console and VNC, are never throttled. Migration related\nsubresources are accounted in a dedicated lane, so bulk lifecycle calls cannot starve them.",
+		"perUser":      "PerUser limits the subresource calls issued by every single user.\n+optional",
+		"perNamespace": "PerNamespace limits the subresource calls targeting every single namespace.\n+optional",
+	}
+}
+
+func (LoadAwareRebalancingConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                        "LoadAwareRebalancingConfiguration holds the thresholds and budget used to live migrate VMIs\noff nodes whose CPUs are overloaded, as reported by virt-handler.",
+		"cpuUtilizationThreshold": "CPUUtilizationThreshold is the node CPU utilization, in percent, above which\nVMIs are migrated off the node. Defaults to 85\n+optional",
+		"cpuStealThreshold":       "CPUStealThreshold is the share of time, in percent, the vCPUs running on a node wait\nfor a physical CPU, above which VMIs are migrated off the node. Defaults to 10\n+optional",
+		"cooldown":                "Cooldown is the minimum time between two rebalancing migrations off the same node,\nand between two rebalancing migrations of the same VMI. Defaults to 10 minutes\n+optional",
+		"maxParallelMigrations":   "MaxParallelMigrations is the number of rebalancing migrations allowed to run\ncluster-wide at the same time. Defaults to 2\n+optional",
+	}
+}
+
+func (VCPUAutoscalingConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                        "VCPUAutoscalingConfiguration holds the thresholds a VirtualMachineInstance vCPU load, as reported\nby virt-handler, must stay above before one more CPU socket is recommended.",
+		"cpuUtilizationThreshold": "CPUUtilizationThreshold is the vCPU utilization, in percent, above which\none more socket is recommended. Defaults to 90\n+optional",
+		"cpuStealThreshold":       "CPUStealThreshold is the share of time, in percent, the vCPUs wait for a physical CPU,\nabove which one more socket is recommended. Defaults to 20\n+optional",
+		"window":                  "Window is how long the vCPU load must stay above one of the thresholds before one more\nsocket is recommended. Defaults to 10 minutes\n+optional",
+		"cooldown":                "Cooldown is the minimum time between two scale ups of the same VirtualMachine. Defaults to 30 minutes\n+optional",
+	}
+}
+
+func (ContainerDiskPrePullConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                       "ContainerDiskPrePullConfiguration selects the container disk images kept cached on the nodes, so that\ncreating many VMs at once does not stampede the registry and their boot time does not depend on image pulls.",
+		"images":                 "Images lists container disk images to pull on the nodes.\n+optional\n+listType=set",
+		"virtualMachineSelector": "VirtualMachineSelector adds the container disk images referenced by the templates of the\nVirtualMachines matching the selector. An empty selector matches all the VirtualMachines.\nImages requiring an image pull secret are skipped.\n+optional",
+		"nodeSelector":           "NodeSelector restricts the nodes the images are pulled on. Defaults to the nodes schedulable for VMIs.\n+optional",
+	}
+}
+
+func (VirtControllerShardingConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtControllerShardingConfiguration splits the namespaces into shards by hashing their names. Every shard is\nreconciled by the single virt-controller instance holding its lease, so that several instances handle the\nVirtualMachines, VirtualMachineInstances, migrations and replica sets at the same time. Cluster wide\nloops keep running on the leader only.",
+		"shards": "Shards is the number of namespace shards. It should not exceed the number of virt-controller replicas,\nsince every instance holds at most one shard; the spare instances take over the shards of failed ones.\nDefaults to 1\n+optional",
+	}
+}
+
+func (VMIStatusUpdatesConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                          "VMIStatusUpdatesConfiguration spaces the writes of the VMI status carrying only frequently changing,\ninformational fields. Such changes are held back by virt-handler and written together once their\ninterval elapsed since the last write of the status. Changes of any other field, like the phase or\nthe conditions, are written right away, along with the held back ones.",
+		"interfacesInterval":        "InterfacesInterval is the minimal interval between two writes of the status carrying only\nchanges of the interfaces, like the IP addresses reported by the guest agent.\nDefaults to 0, which writes every change right away.\n+optional",
+		"guestAgentInfoInterval":    "GuestAgentInfoInterval is the minimal interval between two writes of the status carrying only\nchanges of the guest OS information reported by the guest agent.\nDefaults to 0, which writes every change right away.\n+optional",
+		"migrationProgressInterval": "MigrationProgressInterval is the minimal interval between two writes of the status carrying only\nchanges of the migration progress.\nDefaults to 0, which writes every change right away.\n+optional",
+	}
+}
+
+func (ConsoleRecordingConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "ConsoleRecordingConfiguration configures where the transcripts of console sessions are streamed to,\nand which sessions are recorded.",
+		"sink":     "Sink receives the session transcripts. Exactly one sink must be set.",
+		"policies": "Policies select the namespaces whose console sessions are recorded.\nSessions in namespaces not selected by any policy are not recorded.\nWhen several policies select a namespace, the first one applies.\n+listType=atomic",
+	}
+}
+
+func (ConsoleRecordingPolicy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                  "ConsoleRecordingPolicy selects the console sessions recorded in a set of namespaces.",
+		"namespaceSelector": "NamespaceSelector selects the namespaces the policy applies to. An empty selector selects all namespaces.\n+optional",
+		"sessions":          "Sessions are the kinds of sessions which are recorded. Defaults to Serial and VNC\n+optional\n+listType=set",
+		"required":          "Required refuses console sessions, and closes the running ones, when their transcript\ncannot be delivered to the sink. Otherwise, delivery failures are only logged.\n+optional",
+	}
+}
+
+func (ConsoleRecordingSink) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                      "ConsoleRecordingSink holds the destination of the console session transcripts.",
+		"persistentVolumeClaim": "PersistentVolumeClaim writes the transcripts as files on a volume mounted into virt-api.\n+optional",
+		"s3":                    "S3 uploads the transcripts as objects to an S3 compatible bucket.\n+optional",
+		"webhook":               "Webhook posts the transcripts to an HTTP endpoint.\n+optional",
+	}
+}
+
+func (ConsoleRecordingPVCSink) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "ConsoleRecordingPVCSink writes the transcripts to a PersistentVolumeClaim.",
+		"claimName": "ClaimName is the name of a PersistentVolumeClaim in the KubeVirt install namespace.\nIt needs the ReadWriteMany access mode when virt-api runs more than one replica.",
+	}
+}
+
+func (ConsoleRecordingS3Sink) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                      "ConsoleRecordingS3Sink uploads the transcripts to an S3 compatible bucket.",
+		"endpoint":              "Endpoint is the URL of the S3 service, e.g. https://s3.us-east-1.amazonaws.com",
+		"region":                "Region is the region of the bucket, used to sign the requests",
+		"bucket":                "Bucket is the name of the bucket the transcripts are uploaded to",
+		"prefix":                "Prefix is prepended to the key of the uploaded objects\n+optional",
+		"credentialsSecretName": "CredentialsSecretName is the name of a secret in the KubeVirt install namespace\nholding the accessKeyId and secretAccessKey keys.",
+	}
+}
+
+func (ConsoleRecordingWebhookSink) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "ConsoleRecordingWebhookSink posts the transcripts to an HTTP endpoint.",
+		"url":      "URL is the endpoint every transcript chunk is posted to",
+		"caBundle": "CABundle is the PEM encoded bundle used to verify the endpoint certificate.\nThe system trust store is used when it is not set.\n+optional",
 	}
 }
 
@@ -1082,6 +1398,7 @@ func (PciHostDevice) SwaggerDoc() map[string]string {
 		"pciVendorSelector":        "The vendor_id:product_id tuple of the PCI device",
 		"resourceName":             "The name of the resource that is representing the device. Exposed by\na device plugin and requested by VMs. Typically of the form\nvendor.com/product_name",
 		"externalResourceProvider": "If true, KubeVirt will leave the allocation and monitoring to an\nexternal device plugin",
+		"manageDriverBinding":      "If true, virt-handler binds the devices of this resource to vfio-pci when they are\nallocated to a VMI, and returns them to their host driver once they are released.\nDevices found bound to vfio-pci while unused are considered released as well.\n+optional",
 	}
 }
 
@@ -1121,6 +1438,33 @@ func (NetworkConfiguration) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                     "NetworkConfiguration holds network options",
 		"permitSlirpInterface": "DeprecatedPermitSlirpInterface is an alias for the deprecated PermitSlirpInterface.\nDeprecated: Removed in v1.3.",
+		"macGeneration":        "MacGeneration enables the allocation of a MAC address for each VMI interface which does not specify one.\nBy default, the MAC address is derived from the owner VM UID and the network name,\nand is kept across restarts of the VM.\n+optional",
+		"interfaceEvents":      "InterfaceEvents configures the delivery of CloudEvents about the VMI interfaces\nbeing configured, hotplugged or removed, to let external SDN controllers react to them.\n+optional",
+		"promiscuousInterfacesNamespaceLabelSelector": "PromiscuousInterfacesNamespaceLabelSelector selects the namespaces whose VMIs are allowed\nto declare promiscuous interfaces. When not set, promiscuous interfaces are not allowed.\n+optional",
+		"networkInterfaceMultiqueuePolicy":            "NetworkInterfaceMultiQueuePolicy is the default queue pairs policy of the VMIs which enable\nnetworkInterfaceMultiqueue without specifying their own policy.\n+optional",
+	}
+}
+
+func (InterfaceEventsConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "InterfaceEventsConfiguration configures the delivery of VMI interface events.",
+		"webhookURL": "WebhookURL is the HTTP endpoint the events are posted to, in the CloudEvents structured JSON format.\nDelivery is best effort, failed deliveries are retried a few times before the event is dropped.\nA retried event may be received more than once, consumers can rely on the event id to detect duplicates.",
+	}
+}
+
+func (MacGenerationPolicy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "MacGenerationPolicy configures the allocation of interface MAC addresses.",
+		"oui":       "OUI is the prefix used as the first three octets of the generated MAC addresses,\nformatted as \"xx:xx:xx\". It must represent a unicast address.\nDefaults to \"02:6b:76\", a locally administered prefix.\n+optional",
+		"allocator": "Allocator selects how the MAC addresses are allocated.\nDeterministic derives them from the owner VM UID and the network name, Random picks them at random\nand External requests them from the webhook configured in external.\nDefaults to Deterministic.\n+optional",
+		"external":  "External configures the webhook allocating the MAC addresses, required by the External allocator.\nThe webhook is called by virt-controller when it creates the VMI of a VM, standalone VMIs are not covered.\n+optional",
+	}
+}
+
+func (ExternalMacAllocator) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "ExternalMacAllocator configures an external MAC address allocator, like a MAC pool manager.",
+		"webhookURL": "WebhookURL is the HTTP endpoint the allocation requests are posted to.",
 	}
 }
 
@@ -1131,7 +1475,28 @@ func (InterfaceBindingPlugin) SwaggerDoc() map[string]string {
 		"domainAttachmentType":        "DomainAttachmentType is a standard domain network attachment method kubevirt supports.\nSupported values: \"tap\", \"managedTap\" (since v1.4).\nThe standard domain attachment can be used instead or in addition to the sidecarImage.\nversion: 1alphav1",
 		"migration":                   "Migration means the VM using the plugin can be safely migrated\nversion: 1alphav1",
 		"downwardAPI":                 "DownwardAPI specifies what kind of data should be exposed to the binding plugin sidecar.\nSupported values: \"device-info\"\nversion: v1alphav1\n+optional",
-		"computeResourceOverhead":     "ComputeResourceOverhead specifies the resource overhead that should be added to the compute container when using the binding.\nversion: v1alphav1\n+optional",
+		"resourceName":                "ResourceName references a device plugin resource the virt-launcher pod requests for each\ninterface which uses the binding on the pod network.\nIt allows the binding to handle a primary network whose default CNI attaches a device allocated\nby a device plugin (e.g. vDPA) and reports it in the device-info.\nSecondary networks request their resource through their NetworkAttachmentDefinition.\nversion: v1alphav1\n+optional",
+		"computeResourceOverhead":     "ComputeResourceOverhead specifies the resource overhead that should be added to the compute container when using the binding.\nIts memory and CPU requests are added once per VMI, whatever the number of interfaces using the binding.\nThe CPU is added to the CPU limits as well when they are set, but not to dedicated CPUs.\nversion: v1alphav1\n+optional",
+		"sidecarResources":            "SidecarResources specifies the CPU and memory requests and limits of the plugin sidecar container.\nThey take precedence over the resources set for all the hook sidecars through SupportContainerResources.\nversion: v1alphav1\n+optional",
+		"prerequisites":               "Prerequisites declares the cluster-wide requirements of the binding plugin.\nTheir fulfillment is reported in the KubeVirt CR status.\nversion: v1alphav1\n+optional",
+		"dryRunValidation":            "DryRunValidation configures an endpoint, served by the binding plugin, which validates the spec\nof the VMIs using the plugin on their creation, catching misconfigurations before they are launched.\nversion: v1alphav1\n+optional",
+	}
+}
+
+func (InterfaceBindingDryRunValidation) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "InterfaceBindingDryRunValidation configures the validation of VMI specs by a binding plugin.",
+		"webhookURL":    "WebhookURL is the HTTP endpoint the VMI spec is posted to.\nThe endpoint replies whether the spec is allowed by the plugin and, when it is not, the reason.",
+		"failurePolicy": "FailurePolicy defines how an unreachable endpoint or an unexpected reply is handled.\nFail rejects the VMI and Ignore admits it. Defaults to Fail.\n+optional",
+		"caBundle":      "CABundle is the PEM encoded bundle used to verify the endpoint certificate.\nThe system trust store is used when it is not set.\n+optional",
+	}
+}
+
+func (InterfaceBindingPrerequisites) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "InterfaceBindingPrerequisites describes what the binding plugin requires from the cluster in order to function.",
+		"featureGates":    "FeatureGates lists the feature gates which are required to be enabled.\n+optional\n+listType=atomic",
+		"deviceResources": "DeviceResources lists the extended resources, exposed by device plugins,\nwhich are required to be advertised by at least one schedulable node.\n+optional\n+listType=atomic",
 	}
 }
 
@@ -1155,6 +1520,14 @@ func (GuestAgentPing) SwaggerDoc() map[string]string {
 	}
 }
 
+func (GuestAgentExec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "GuestAgentExec configures the guest-agent based exec probe",
+		"command":          "Command is the command line to execute inside the guest. The first element is the\nexecutable, the remaining elements are passed as arguments. The command is not run\nin a shell, so shell instructions like pipes are only available by calling a shell explicitly.\n+listType=atomic",
+		"successExitCodes": "SuccessExitCodes lists the exit codes of the command which are considered a successful probe.\nDefaults to 0.\n+optional\n+listType=set",
+	}
+}
+
 func (ProfilerResult) SwaggerDoc() map[string]string {
 	return map[string]string{}
 }
@@ -1214,6 +1587,7 @@ func (SEVMeasurementInfo) SwaggerDoc() map[string]string {
 		"buildID":     "Build ID of the SEV host.",
 		"policy":      "Policy of the SEV guest.",
 		"loaderSHA":   "SHA256 of the loader binary",
+		"snpPolicy":   "Policy of the SEV-SNP guest.",
 	}
 }
 
@@ -1225,6 +1599,15 @@ func (SEVSessionOptions) SwaggerDoc() map[string]string {
 	}
 }
 
+func (SEVSNPSessionOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "SEVSNPSessionOptions is used to provide the SEV-SNP launch parameters of the guest owner.",
+		"idBlock":  "Base64 encoded ID block, holding the expected launch measurement of the guest.",
+		"idAuth":   "Base64 encoded ID authentication information, holding the signature of the ID block.",
+		"hostData": "Base64 encoded 32 bytes included as is in the attestation reports of the guest.",
+	}
+}
+
 func (SEVSecretOptions) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":       "SEVSecretOptions is used to provide a secret for a running guest.",
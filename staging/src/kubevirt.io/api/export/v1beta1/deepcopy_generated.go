@@ -239,6 +239,11 @@ func (in *VirtualMachineExportSpec) DeepCopyInto(out *VirtualMachineExportSpec)
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.NBD != nil {
+		in, out := &in.NBD, &out.NBD
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -67,6 +67,13 @@ type VirtualMachineExportSpec struct {
 	// If this field is omitted, a reasonable default is applied.
 	// +optional
 	TTLDuration *metav1.Duration `json:"ttlDuration,omitempty"`
+
+	// NBD additionally exposes the raw volumes over the Network Block Device protocol,
+	// for block-level consumers doing sparse-aware, random-access reads.
+	// The NBD endpoint is only reachable from within the cluster, requires TLS, and
+	// authenticates clients through the export name, formatted as <volume>/<token>.
+	// +optional
+	NBD *bool `json:"nbd,omitempty"`
 }
 
 // VirtualMachineExportPhase is the current phase of the VirtualMachineExport
@@ -186,6 +193,8 @@ const (
 	Dir ExportVolumeFormat = "dir"
 	// ArchiveGz is a tarred and gzipped version of the root of a PersistentVolumeClaim
 	ArchiveGz ExportVolumeFormat = "tar.gz"
+	// NBD is the volume in RAW format, exposed read-only over TLS-protected NBD
+	NBD ExportVolumeFormat = "nbd"
 )
 
 // VirtualMachineExportVolumeFormat contains the format type and URL to get the volume in that format
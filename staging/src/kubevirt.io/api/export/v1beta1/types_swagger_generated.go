@@ -21,6 +21,7 @@ func (VirtualMachineExportSpec) SwaggerDoc() map[string]string {
 		"":               "VirtualMachineExportSpec is the spec for a VirtualMachineExport resource",
 		"tokenSecretRef": "+optional\nTokenSecretRef is the name of the custom-defined secret that contains the token used by the export server pod",
 		"ttlDuration":    "ttlDuration limits the lifetime of an export\nIf this field is set, after this duration has passed from counting from CreationTimestamp,\nthe export is eligible to be automatically deleted.\nIf this field is omitted, a reasonable default is applied.\n+optional",
+		"nbd":            "NBD additionally exposes the raw volumes over the Network Block Device protocol,\nfor block-level consumers doing sparse-aware, random-access reads.\nThe NBD endpoint is only reachable from within the cluster, requires TLS, and\nauthenticates clients through the export name, formatted as <volume>/<token>.\n+optional",
 	}
 }
 
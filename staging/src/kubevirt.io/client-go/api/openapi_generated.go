@@ -357,13 +357,20 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.ArchSpecificConfiguration":                                               schema_kubevirtio_api_core_v1_ArchSpecificConfiguration(ref),
 		"kubevirt.io/api/core/v1.AuthorizedKeysFile":                                                      schema_kubevirtio_api_core_v1_AuthorizedKeysFile(ref),
 		"kubevirt.io/api/core/v1.BIOS":                                                                    schema_kubevirtio_api_core_v1_BIOS(ref),
+		"kubevirt.io/api/core/v1.BatchOperationItemResult":                                                schema_kubevirtio_api_core_v1_BatchOperationItemResult(ref),
+		"kubevirt.io/api/core/v1.BatchOperationOptions":                                                   schema_kubevirtio_api_core_v1_BatchOperationOptions(ref),
+		"kubevirt.io/api/core/v1.BatchOperationResult":                                                    schema_kubevirtio_api_core_v1_BatchOperationResult(ref),
 		"kubevirt.io/api/core/v1.BlockSize":                                                               schema_kubevirtio_api_core_v1_BlockSize(ref),
 		"kubevirt.io/api/core/v1.Bootloader":                                                              schema_kubevirtio_api_core_v1_Bootloader(ref),
 		"kubevirt.io/api/core/v1.CDRomTarget":                                                             schema_kubevirtio_api_core_v1_CDRomTarget(ref),
 		"kubevirt.io/api/core/v1.CPU":                                                                     schema_kubevirtio_api_core_v1_CPU(ref),
+		"kubevirt.io/api/core/v1.CPUAutoscaling":                                                          schema_kubevirtio_api_core_v1_CPUAutoscaling(ref),
 		"kubevirt.io/api/core/v1.CPUFeature":                                                              schema_kubevirtio_api_core_v1_CPUFeature(ref),
 		"kubevirt.io/api/core/v1.CPUTopology":                                                             schema_kubevirtio_api_core_v1_CPUTopology(ref),
+		"kubevirt.io/api/core/v1.CancelDomainJobOptions":                                                  schema_kubevirtio_api_core_v1_CancelDomainJobOptions(ref),
 		"kubevirt.io/api/core/v1.CertConfig":                                                              schema_kubevirtio_api_core_v1_CertConfig(ref),
+		"kubevirt.io/api/core/v1.CertManagerIssuerReference":                                              schema_kubevirtio_api_core_v1_CertManagerIssuerReference(ref),
+		"kubevirt.io/api/core/v1.ChangeMediaOptions":                                                      schema_kubevirtio_api_core_v1_ChangeMediaOptions(ref),
 		"kubevirt.io/api/core/v1.ChangedBlockTrackingSelectors":                                           schema_kubevirtio_api_core_v1_ChangedBlockTrackingSelectors(ref),
 		"kubevirt.io/api/core/v1.ChangedBlockTrackingStatus":                                              schema_kubevirtio_api_core_v1_ChangedBlockTrackingStatus(ref),
 		"kubevirt.io/api/core/v1.Chassis":                                                                 schema_kubevirtio_api_core_v1_Chassis(ref),
@@ -381,7 +388,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.ConfidentialComputeConfiguration":                                        schema_kubevirtio_api_core_v1_ConfidentialComputeConfiguration(ref),
 		"kubevirt.io/api/core/v1.ConfigDriveSSHPublicKeyAccessCredentialPropagation":                      schema_kubevirtio_api_core_v1_ConfigDriveSSHPublicKeyAccessCredentialPropagation(ref),
 		"kubevirt.io/api/core/v1.ConfigMapVolumeSource":                                                   schema_kubevirtio_api_core_v1_ConfigMapVolumeSource(ref),
+		"kubevirt.io/api/core/v1.ConsoleRecordingConfiguration":                                           schema_kubevirtio_api_core_v1_ConsoleRecordingConfiguration(ref),
+		"kubevirt.io/api/core/v1.ConsoleRecordingPVCSink":                                                 schema_kubevirtio_api_core_v1_ConsoleRecordingPVCSink(ref),
+		"kubevirt.io/api/core/v1.ConsoleRecordingPolicy":                                                  schema_kubevirtio_api_core_v1_ConsoleRecordingPolicy(ref),
+		"kubevirt.io/api/core/v1.ConsoleRecordingS3Sink":                                                  schema_kubevirtio_api_core_v1_ConsoleRecordingS3Sink(ref),
+		"kubevirt.io/api/core/v1.ConsoleRecordingSink":                                                    schema_kubevirtio_api_core_v1_ConsoleRecordingSink(ref),
+		"kubevirt.io/api/core/v1.ConsoleRecordingWebhookSink":                                             schema_kubevirtio_api_core_v1_ConsoleRecordingWebhookSink(ref),
 		"kubevirt.io/api/core/v1.ContainerDiskInfo":                                                       schema_kubevirtio_api_core_v1_ContainerDiskInfo(ref),
+		"kubevirt.io/api/core/v1.ContainerDiskPrePullConfiguration":                                       schema_kubevirtio_api_core_v1_ContainerDiskPrePullConfiguration(ref),
 		"kubevirt.io/api/core/v1.ContainerDiskSource":                                                     schema_kubevirtio_api_core_v1_ContainerDiskSource(ref),
 		"kubevirt.io/api/core/v1.ContainerPathVolumeSource":                                               schema_kubevirtio_api_core_v1_ContainerPathVolumeSource(ref),
 		"kubevirt.io/api/core/v1.ControllerRevisionRef":                                                   schema_kubevirtio_api_core_v1_ControllerRevisionRef(ref),
@@ -394,6 +408,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.DataVolumeSource":                                                        schema_kubevirtio_api_core_v1_DataVolumeSource(ref),
 		"kubevirt.io/api/core/v1.DataVolumeTemplateDummyStatus":                                           schema_kubevirtio_api_core_v1_DataVolumeTemplateDummyStatus(ref),
 		"kubevirt.io/api/core/v1.DataVolumeTemplateSpec":                                                  schema_kubevirtio_api_core_v1_DataVolumeTemplateSpec(ref),
+		"kubevirt.io/api/core/v1.DebugAttachOptions":                                                      schema_kubevirtio_api_core_v1_DebugAttachOptions(ref),
+		"kubevirt.io/api/core/v1.DebugAttachResult":                                                       schema_kubevirtio_api_core_v1_DebugAttachResult(ref),
 		"kubevirt.io/api/core/v1.DeprecatedInterfaceMacvtap":                                              schema_kubevirtio_api_core_v1_DeprecatedInterfaceMacvtap(ref),
 		"kubevirt.io/api/core/v1.DeprecatedInterfacePasst":                                                schema_kubevirtio_api_core_v1_DeprecatedInterfacePasst(ref),
 		"kubevirt.io/api/core/v1.DeprecatedInterfaceSlirp":                                                schema_kubevirtio_api_core_v1_DeprecatedInterfaceSlirp(ref),
@@ -404,6 +420,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.DisableSerialConsoleLog":                                                 schema_kubevirtio_api_core_v1_DisableSerialConsoleLog(ref),
 		"kubevirt.io/api/core/v1.Disk":                                                                    schema_kubevirtio_api_core_v1_Disk(ref),
 		"kubevirt.io/api/core/v1.DiskDevice":                                                              schema_kubevirtio_api_core_v1_DiskDevice(ref),
+		"kubevirt.io/api/core/v1.DiskEncryption":                                                          schema_kubevirtio_api_core_v1_DiskEncryption(ref),
 		"kubevirt.io/api/core/v1.DiskIOThreads":                                                           schema_kubevirtio_api_core_v1_DiskIOThreads(ref),
 		"kubevirt.io/api/core/v1.DiskTarget":                                                              schema_kubevirtio_api_core_v1_DiskTarget(ref),
 		"kubevirt.io/api/core/v1.DiskVerification":                                                        schema_kubevirtio_api_core_v1_DiskVerification(ref),
@@ -416,6 +433,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.EmptyDiskSource":                                                         schema_kubevirtio_api_core_v1_EmptyDiskSource(ref),
 		"kubevirt.io/api/core/v1.EphemeralVolumeSource":                                                   schema_kubevirtio_api_core_v1_EphemeralVolumeSource(ref),
 		"kubevirt.io/api/core/v1.EvacuateCancelOptions":                                                   schema_kubevirtio_api_core_v1_EvacuateCancelOptions(ref),
+		"kubevirt.io/api/core/v1.ExternalMacAllocator":                                                    schema_kubevirtio_api_core_v1_ExternalMacAllocator(ref),
 		"kubevirt.io/api/core/v1.FeatureAPIC":                                                             schema_kubevirtio_api_core_v1_FeatureAPIC(ref),
 		"kubevirt.io/api/core/v1.FeatureHyperv":                                                           schema_kubevirtio_api_core_v1_FeatureHyperv(ref),
 		"kubevirt.io/api/core/v1.FeatureKVM":                                                              schema_kubevirtio_api_core_v1_FeatureKVM(ref),
@@ -425,17 +443,22 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.Features":                                                                schema_kubevirtio_api_core_v1_Features(ref),
 		"kubevirt.io/api/core/v1.Filesystem":                                                              schema_kubevirtio_api_core_v1_Filesystem(ref),
 		"kubevirt.io/api/core/v1.FilesystemVirtiofs":                                                      schema_kubevirtio_api_core_v1_FilesystemVirtiofs(ref),
+		"kubevirt.io/api/core/v1.FirewallRule":                                                            schema_kubevirtio_api_core_v1_FirewallRule(ref),
 		"kubevirt.io/api/core/v1.Firmware":                                                                schema_kubevirtio_api_core_v1_Firmware(ref),
 		"kubevirt.io/api/core/v1.Flags":                                                                   schema_kubevirtio_api_core_v1_Flags(ref),
 		"kubevirt.io/api/core/v1.FreezeUnfreezeTimeout":                                                   schema_kubevirtio_api_core_v1_FreezeUnfreezeTimeout(ref),
 		"kubevirt.io/api/core/v1.GPU":                                                                     schema_kubevirtio_api_core_v1_GPU(ref),
 		"kubevirt.io/api/core/v1.GenerationStatus":                                                        schema_kubevirtio_api_core_v1_GenerationStatus(ref),
 		"kubevirt.io/api/core/v1.GuestAgentCommandInfo":                                                   schema_kubevirtio_api_core_v1_GuestAgentCommandInfo(ref),
+		"kubevirt.io/api/core/v1.GuestAgentExec":                                                          schema_kubevirtio_api_core_v1_GuestAgentExec(ref),
 		"kubevirt.io/api/core/v1.GuestAgentPing":                                                          schema_kubevirtio_api_core_v1_GuestAgentPing(ref),
+		"kubevirt.io/api/core/v1.GuestDNSConfig":                                                          schema_kubevirtio_api_core_v1_GuestDNSConfig(ref),
 		"kubevirt.io/api/core/v1.HPETTimer":                                                               schema_kubevirtio_api_core_v1_HPETTimer(ref),
 		"kubevirt.io/api/core/v1.Handler":                                                                 schema_kubevirtio_api_core_v1_Handler(ref),
 		"kubevirt.io/api/core/v1.HostDevice":                                                              schema_kubevirtio_api_core_v1_HostDevice(ref),
+		"kubevirt.io/api/core/v1.HostDeviceStatus":                                                        schema_kubevirtio_api_core_v1_HostDeviceStatus(ref),
 		"kubevirt.io/api/core/v1.HostDisk":                                                                schema_kubevirtio_api_core_v1_HostDisk(ref),
+		"kubevirt.io/api/core/v1.HotplugHostDeviceStatus":                                                 schema_kubevirtio_api_core_v1_HotplugHostDeviceStatus(ref),
 		"kubevirt.io/api/core/v1.HotplugVolumeSource":                                                     schema_kubevirtio_api_core_v1_HotplugVolumeSource(ref),
 		"kubevirt.io/api/core/v1.HotplugVolumeStatus":                                                     schema_kubevirtio_api_core_v1_HotplugVolumeStatus(ref),
 		"kubevirt.io/api/core/v1.Hugepages":                                                               schema_kubevirtio_api_core_v1_Hugepages(ref),
@@ -449,20 +472,31 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.InstancetypeMatcher":                                                     schema_kubevirtio_api_core_v1_InstancetypeMatcher(ref),
 		"kubevirt.io/api/core/v1.InstancetypeStatusRef":                                                   schema_kubevirtio_api_core_v1_InstancetypeStatusRef(ref),
 		"kubevirt.io/api/core/v1.Interface":                                                               schema_kubevirtio_api_core_v1_Interface(ref),
+		"kubevirt.io/api/core/v1.InterfaceBindingDryRunValidation":                                        schema_kubevirtio_api_core_v1_InterfaceBindingDryRunValidation(ref),
 		"kubevirt.io/api/core/v1.InterfaceBindingMethod":                                                  schema_kubevirtio_api_core_v1_InterfaceBindingMethod(ref),
 		"kubevirt.io/api/core/v1.InterfaceBindingMigration":                                               schema_kubevirtio_api_core_v1_InterfaceBindingMigration(ref),
 		"kubevirt.io/api/core/v1.InterfaceBindingPlugin":                                                  schema_kubevirtio_api_core_v1_InterfaceBindingPlugin(ref),
+		"kubevirt.io/api/core/v1.InterfaceBindingPrerequisites":                                           schema_kubevirtio_api_core_v1_InterfaceBindingPrerequisites(ref),
 		"kubevirt.io/api/core/v1.InterfaceBridge":                                                         schema_kubevirtio_api_core_v1_InterfaceBridge(ref),
+		"kubevirt.io/api/core/v1.InterfaceEventsConfiguration":                                            schema_kubevirtio_api_core_v1_InterfaceEventsConfiguration(ref),
+		"kubevirt.io/api/core/v1.InterfaceFirewall":                                                       schema_kubevirtio_api_core_v1_InterfaceFirewall(ref),
+		"kubevirt.io/api/core/v1.InterfaceInterrupts":                                                     schema_kubevirtio_api_core_v1_InterfaceInterrupts(ref),
 		"kubevirt.io/api/core/v1.InterfaceMasquerade":                                                     schema_kubevirtio_api_core_v1_InterfaceMasquerade(ref),
+		"kubevirt.io/api/core/v1.InterfaceMirror":                                                         schema_kubevirtio_api_core_v1_InterfaceMirror(ref),
+		"kubevirt.io/api/core/v1.InterfaceNetworkEmulation":                                               schema_kubevirtio_api_core_v1_InterfaceNetworkEmulation(ref),
+		"kubevirt.io/api/core/v1.InterfaceOffloads":                                                       schema_kubevirtio_api_core_v1_InterfaceOffloads(ref),
 		"kubevirt.io/api/core/v1.InterfacePasstBinding":                                                   schema_kubevirtio_api_core_v1_InterfacePasstBinding(ref),
+		"kubevirt.io/api/core/v1.InterfaceRouterAdvertisement":                                            schema_kubevirtio_api_core_v1_InterfaceRouterAdvertisement(ref),
 		"kubevirt.io/api/core/v1.InterfaceSRIOV":                                                          schema_kubevirtio_api_core_v1_InterfaceSRIOV(ref),
 		"kubevirt.io/api/core/v1.KSMConfiguration":                                                        schema_kubevirtio_api_core_v1_KSMConfiguration(ref),
 		"kubevirt.io/api/core/v1.KVMTimer":                                                                schema_kubevirtio_api_core_v1_KVMTimer(ref),
 		"kubevirt.io/api/core/v1.KernelBoot":                                                              schema_kubevirtio_api_core_v1_KernelBoot(ref),
 		"kubevirt.io/api/core/v1.KernelBootContainer":                                                     schema_kubevirtio_api_core_v1_KernelBootContainer(ref),
 		"kubevirt.io/api/core/v1.KernelBootStatus":                                                        schema_kubevirtio_api_core_v1_KernelBootStatus(ref),
+		"kubevirt.io/api/core/v1.KernelBootVolume":                                                        schema_kubevirtio_api_core_v1_KernelBootVolume(ref),
 		"kubevirt.io/api/core/v1.KernelInfo":                                                              schema_kubevirtio_api_core_v1_KernelInfo(ref),
 		"kubevirt.io/api/core/v1.KubeVirt":                                                                schema_kubevirtio_api_core_v1_KubeVirt(ref),
+		"kubevirt.io/api/core/v1.KubeVirtCertManagerConfiguration":                                        schema_kubevirtio_api_core_v1_KubeVirtCertManagerConfiguration(ref),
 		"kubevirt.io/api/core/v1.KubeVirtCertificateRotateStrategy":                                       schema_kubevirtio_api_core_v1_KubeVirtCertificateRotateStrategy(ref),
 		"kubevirt.io/api/core/v1.KubeVirtCondition":                                                       schema_kubevirtio_api_core_v1_KubeVirtCondition(ref),
 		"kubevirt.io/api/core/v1.KubeVirtConfiguration":                                                   schema_kubevirtio_api_core_v1_KubeVirtConfiguration(ref),
@@ -473,21 +507,29 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.KubeVirtWorkloadUpdateStrategy":                                          schema_kubevirtio_api_core_v1_KubeVirtWorkloadUpdateStrategy(ref),
 		"kubevirt.io/api/core/v1.LaunchSecurity":                                                          schema_kubevirtio_api_core_v1_LaunchSecurity(ref),
 		"kubevirt.io/api/core/v1.LiveUpdateConfiguration":                                                 schema_kubevirtio_api_core_v1_LiveUpdateConfiguration(ref),
+		"kubevirt.io/api/core/v1.LoadAwareRebalancingConfiguration":                                       schema_kubevirtio_api_core_v1_LoadAwareRebalancingConfiguration(ref),
 		"kubevirt.io/api/core/v1.LogVerbosity":                                                            schema_kubevirtio_api_core_v1_LogVerbosity(ref),
 		"kubevirt.io/api/core/v1.LunTarget":                                                               schema_kubevirtio_api_core_v1_LunTarget(ref),
+		"kubevirt.io/api/core/v1.MacGenerationPolicy":                                                     schema_kubevirtio_api_core_v1_MacGenerationPolicy(ref),
 		"kubevirt.io/api/core/v1.Machine":                                                                 schema_kubevirtio_api_core_v1_Machine(ref),
 		"kubevirt.io/api/core/v1.MediatedDevicesConfiguration":                                            schema_kubevirtio_api_core_v1_MediatedDevicesConfiguration(ref),
 		"kubevirt.io/api/core/v1.MediatedHostDevice":                                                      schema_kubevirtio_api_core_v1_MediatedHostDevice(ref),
 		"kubevirt.io/api/core/v1.Memory":                                                                  schema_kubevirtio_api_core_v1_Memory(ref),
 		"kubevirt.io/api/core/v1.MemoryDumpVolumeSource":                                                  schema_kubevirtio_api_core_v1_MemoryDumpVolumeSource(ref),
 		"kubevirt.io/api/core/v1.MemoryStatus":                                                            schema_kubevirtio_api_core_v1_MemoryStatus(ref),
+		"kubevirt.io/api/core/v1.MemorySwap":                                                              schema_kubevirtio_api_core_v1_MemorySwap(ref),
+		"kubevirt.io/api/core/v1.MetadataService":                                                         schema_kubevirtio_api_core_v1_MetadataService(ref),
 		"kubevirt.io/api/core/v1.MigrateOptions":                                                          schema_kubevirtio_api_core_v1_MigrateOptions(ref),
 		"kubevirt.io/api/core/v1.MigrationConfiguration":                                                  schema_kubevirtio_api_core_v1_MigrationConfiguration(ref),
+		"kubevirt.io/api/core/v1.MigrationDiskTransferProgress":                                           schema_kubevirtio_api_core_v1_MigrationDiskTransferProgress(ref),
 		"kubevirt.io/api/core/v1.MultusNetwork":                                                           schema_kubevirtio_api_core_v1_MultusNetwork(ref),
 		"kubevirt.io/api/core/v1.NUMA":                                                                    schema_kubevirtio_api_core_v1_NUMA(ref),
 		"kubevirt.io/api/core/v1.NUMAGuestMappingPassthrough":                                             schema_kubevirtio_api_core_v1_NUMAGuestMappingPassthrough(ref),
+		"kubevirt.io/api/core/v1.NativeNetwork":                                                           schema_kubevirtio_api_core_v1_NativeNetwork(ref),
 		"kubevirt.io/api/core/v1.Network":                                                                 schema_kubevirtio_api_core_v1_Network(ref),
+		"kubevirt.io/api/core/v1.NetworkBindingPluginStatus":                                              schema_kubevirtio_api_core_v1_NetworkBindingPluginStatus(ref),
 		"kubevirt.io/api/core/v1.NetworkConfiguration":                                                    schema_kubevirtio_api_core_v1_NetworkConfiguration(ref),
+		"kubevirt.io/api/core/v1.NetworkQueuesPolicy":                                                     schema_kubevirtio_api_core_v1_NetworkQueuesPolicy(ref),
 		"kubevirt.io/api/core/v1.NetworkSource":                                                           schema_kubevirtio_api_core_v1_NetworkSource(ref),
 		"kubevirt.io/api/core/v1.NoCloudSSHPublicKeyAccessCredentialPropagation":                          schema_kubevirtio_api_core_v1_NoCloudSSHPublicKeyAccessCredentialPropagation(ref),
 		"kubevirt.io/api/core/v1.NodeMediatedDeviceTypesConfig":                                           schema_kubevirtio_api_core_v1_NodeMediatedDeviceTypesConfig(ref),
@@ -526,6 +568,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.SEVPlatformInfo":                                                         schema_kubevirtio_api_core_v1_SEVPlatformInfo(ref),
 		"kubevirt.io/api/core/v1.SEVPolicy":                                                               schema_kubevirtio_api_core_v1_SEVPolicy(ref),
 		"kubevirt.io/api/core/v1.SEVSNP":                                                                  schema_kubevirtio_api_core_v1_SEVSNP(ref),
+		"kubevirt.io/api/core/v1.SEVSNPSessionOptions":                                                    schema_kubevirtio_api_core_v1_SEVSNPSessionOptions(ref),
 		"kubevirt.io/api/core/v1.SEVSecretOptions":                                                        schema_kubevirtio_api_core_v1_SEVSecretOptions(ref),
 		"kubevirt.io/api/core/v1.SEVSessionOptions":                                                       schema_kubevirtio_api_core_v1_SEVSessionOptions(ref),
 		"kubevirt.io/api/core/v1.SMBiosConfiguration":                                                     schema_kubevirtio_api_core_v1_SMBiosConfiguration(ref),
@@ -537,9 +580,11 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.SecretVolumeSource":                                                      schema_kubevirtio_api_core_v1_SecretVolumeSource(ref),
 		"kubevirt.io/api/core/v1.ServiceAccountVolumeSource":                                              schema_kubevirtio_api_core_v1_ServiceAccountVolumeSource(ref),
 		"kubevirt.io/api/core/v1.SoundDevice":                                                             schema_kubevirtio_api_core_v1_SoundDevice(ref),
+		"kubevirt.io/api/core/v1.SpiceDevice":                                                             schema_kubevirtio_api_core_v1_SpiceDevice(ref),
 		"kubevirt.io/api/core/v1.StartOptions":                                                            schema_kubevirtio_api_core_v1_StartOptions(ref),
 		"kubevirt.io/api/core/v1.StopOptions":                                                             schema_kubevirtio_api_core_v1_StopOptions(ref),
 		"kubevirt.io/api/core/v1.StorageMigratedVolumeInfo":                                               schema_kubevirtio_api_core_v1_StorageMigratedVolumeInfo(ref),
+		"kubevirt.io/api/core/v1.SubresourceRateLimits":                                                   schema_kubevirtio_api_core_v1_SubresourceRateLimits(ref),
 		"kubevirt.io/api/core/v1.SupportContainerResources":                                               schema_kubevirtio_api_core_v1_SupportContainerResources(ref),
 		"kubevirt.io/api/core/v1.SyNICTimer":                                                              schema_kubevirtio_api_core_v1_SyNICTimer(ref),
 		"kubevirt.io/api/core/v1.SysprepSource":                                                           schema_kubevirtio_api_core_v1_SysprepSource(ref),
@@ -559,23 +604,32 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.UserPasswordAccessCredentialPropagationMethod":                           schema_kubevirtio_api_core_v1_UserPasswordAccessCredentialPropagationMethod(ref),
 		"kubevirt.io/api/core/v1.UserPasswordAccessCredentialSource":                                      schema_kubevirtio_api_core_v1_UserPasswordAccessCredentialSource(ref),
 		"kubevirt.io/api/core/v1.UtilityVolume":                                                           schema_kubevirtio_api_core_v1_UtilityVolume(ref),
+		"kubevirt.io/api/core/v1.VCPUAutoscalingConfiguration":                                            schema_kubevirtio_api_core_v1_VCPUAutoscalingConfiguration(ref),
 		"kubevirt.io/api/core/v1.VGPUDisplayOptions":                                                      schema_kubevirtio_api_core_v1_VGPUDisplayOptions(ref),
 		"kubevirt.io/api/core/v1.VGPUOptions":                                                             schema_kubevirtio_api_core_v1_VGPUOptions(ref),
 		"kubevirt.io/api/core/v1.VMISelector":                                                             schema_kubevirtio_api_core_v1_VMISelector(ref),
+		"kubevirt.io/api/core/v1.VMIStatusUpdatesConfiguration":                                           schema_kubevirtio_api_core_v1_VMIStatusUpdatesConfiguration(ref),
 		"kubevirt.io/api/core/v1.VSOCKOptions":                                                            schema_kubevirtio_api_core_v1_VSOCKOptions(ref),
 		"kubevirt.io/api/core/v1.VideoDevice":                                                             schema_kubevirtio_api_core_v1_VideoDevice(ref),
+		"kubevirt.io/api/core/v1.VirtControllerShardingConfiguration":                                     schema_kubevirtio_api_core_v1_VirtControllerShardingConfiguration(ref),
 		"kubevirt.io/api/core/v1.VirtTemplateDeployment":                                                  schema_kubevirtio_api_core_v1_VirtTemplateDeployment(ref),
+		"kubevirt.io/api/core/v1.VirtioChannel":                                                           schema_kubevirtio_api_core_v1_VirtioChannel(ref),
 		"kubevirt.io/api/core/v1.VirtualMachine":                                                          schema_kubevirtio_api_core_v1_VirtualMachine(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineCPURecommendation":                                         schema_kubevirtio_api_core_v1_VirtualMachineCPURecommendation(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineCondition":                                                 schema_kubevirtio_api_core_v1_VirtualMachineCondition(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstance":                                                  schema_kubevirtio_api_core_v1_VirtualMachineInstance(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceBackupStatus":                                      schema_kubevirtio_api_core_v1_VirtualMachineInstanceBackupStatus(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceBootPhaseTimestamp":                                schema_kubevirtio_api_core_v1_VirtualMachineInstanceBootPhaseTimestamp(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceCommonMigrationState":                              schema_kubevirtio_api_core_v1_VirtualMachineInstanceCommonMigrationState(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceCondition":                                         schema_kubevirtio_api_core_v1_VirtualMachineInstanceCondition(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceDomainJob":                                         schema_kubevirtio_api_core_v1_VirtualMachineInstanceDomainJob(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceDomainJobList":                                     schema_kubevirtio_api_core_v1_VirtualMachineInstanceDomainJobList(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystem":                                        schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystem(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystemDisk":                                    schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystemDisk(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystemInfo":                                    schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystemInfo(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystemList":                                    schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystemList(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceGuestAgentInfo":                                    schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestAgentInfo(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSDriver":                                     schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestOSDriver(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSInfo":                                       schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestOSInfo(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSUser":                                       schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestOSUser(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSUserList":                                   schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestOSUserList(ref),
@@ -597,6 +651,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.VirtualMachineInstancePresetList":                                        schema_kubevirtio_api_core_v1_VirtualMachineInstancePresetList(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstancePresetSpec":                                        schema_kubevirtio_api_core_v1_VirtualMachineInstancePresetSpec(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceProfile":                                           schema_kubevirtio_api_core_v1_VirtualMachineInstanceProfile(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceQemuCommandLine":                                   schema_kubevirtio_api_core_v1_VirtualMachineInstanceQemuCommandLine(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceReplicaSet":                                        schema_kubevirtio_api_core_v1_VirtualMachineInstanceReplicaSet(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceReplicaSetCondition":                               schema_kubevirtio_api_core_v1_VirtualMachineInstanceReplicaSetCondition(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceReplicaSetList":                                    schema_kubevirtio_api_core_v1_VirtualMachineInstanceReplicaSetList(ref),
@@ -18872,6 +18927,154 @@ func schema_kubevirtio_api_core_v1_BIOS(ref common.ReferenceCallback) common.Ope
 	}
 }
 
+func schema_kubevirtio_api_core_v1_BatchOperationItemResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BatchOperationItemResult reports the outcome of a batch lifecycle request for a single VirtualMachine.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the VirtualMachine.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"succeeded": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Succeeded indicates whether the operation was applied to the VirtualMachine.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Error describes why the operation could not be applied to the VirtualMachine.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "succeeded"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_BatchOperationOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BatchOperationOptions may be provided on a batch lifecycle request, which applies an operation to all VirtualMachines of a namespace matching a label selector.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labelSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LabelSelector selects the VirtualMachines the operation is applied to. An empty selector selects all VirtualMachines of the namespace.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"maxConcurrency": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxConcurrency is the maximum number of VirtualMachines the operation is applied to in parallel. Defaults to 10.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"dryRun": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "When present, indicates that modifications should not be persisted. An invalid or unrecognized dryRun directive will result in an error response and no further processing of the request. Valid values are: - All: all dry run stages will be processed",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"labelSelector"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_BatchOperationResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BatchOperationResult reports the outcome of a batch lifecycle request.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"items": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Items holds the outcome of the operation for every selected VirtualMachine, ordered by name.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.BatchOperationItemResult"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.BatchOperationItemResult"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_BlockSize(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -19047,6 +19250,26 @@ func schema_kubevirtio_api_core_v1_CPU(ref common.ReferenceCallback) common.Open
 	}
 }
 
+func schema_kubevirtio_api_core_v1_CPUAutoscaling(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CPUAutoscaling configures the vertical scaling of the CPU sockets of a VirtualMachine. Sockets are only ever added, up to the maxSockets of the VirtualMachineInstance.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policy tells whether the recommended number of sockets is only reported, or also hotplugged into the running VirtualMachineInstance. Defaults to Recommend",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_CPUFeature(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -19110,6 +19333,35 @@ func schema_kubevirtio_api_core_v1_CPUTopology(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_kubevirtio_api_core_v1_CancelDomainJobOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CancelDomainJobOptions are used when cancelling a libvirt job in progress on the domain of a VMI",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of the job to cancel, either Domain or Block",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"disk": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Disk is the target of the disk the Block job to cancel runs on, it is required for Block jobs",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_CertConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -19137,6 +19389,92 @@ func schema_kubevirtio_api_core_v1_CertConfig(ref common.ReferenceCallback) comm
 	}
 }
 
+func schema_kubevirtio_api_core_v1_CertManagerIssuerReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CertManagerIssuerReference references a cert-manager Issuer or ClusterIssuer",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the issuer",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind of the issuer, an Issuer has to be in the KubeVirt install namespace Defaults to Issuer",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Group of the issuer Defaults to cert-manager.io",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_ChangeMediaOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ChangeMediaOptions is provided when inserting or ejecting the media of a CD-ROM disk",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name represents the name of the CD-ROM disk whose media is changed",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumeSource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeSource represents the media to insert into the CD-ROM. The current media is ejected when it is not set.",
+							Ref:         ref("kubevirt.io/api/core/v1.HotplugVolumeSource"),
+						},
+					},
+					"dryRun": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "When present, indicates that modifications should not be persisted. An invalid or unrecognized dryRun directive will result in an error response and no further processing of the request. Valid values are: - All: all dry run stages will be processed",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.HotplugVolumeSource"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_ChangedBlockTrackingSelectors(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -19643,26 +19981,310 @@ func schema_kubevirtio_api_core_v1_ConfigMapVolumeSource(ref common.ReferenceCal
 	}
 }
 
-func schema_kubevirtio_api_core_v1_ContainerDiskInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_core_v1_ConsoleRecordingConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ContainerDiskInfo shows info about the containerdisk",
+				Description: "ConsoleRecordingConfiguration configures where the transcripts of console sessions are streamed to, and which sessions are recorded.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"checksum": {
+					"sink": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Checksum is the checksum of the rootdisk or kernel artifacts inside the containerdisk",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "Sink receives the session transcripts. Exactly one sink must be set.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubevirt.io/api/core/v1.ConsoleRecordingSink"),
 						},
 					},
-				},
+					"policies": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Policies select the namespaces whose console sessions are recorded. Sessions in namespaces not selected by any policy are not recorded. When several policies select a namespace, the first one applies.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.ConsoleRecordingPolicy"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"sink", "policies"},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.ConsoleRecordingPolicy", "kubevirt.io/api/core/v1.ConsoleRecordingSink"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_ConsoleRecordingPVCSink(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConsoleRecordingPVCSink writes the transcripts to a PersistentVolumeClaim.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"claimName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClaimName is the name of a PersistentVolumeClaim in the KubeVirt install namespace. It needs the ReadWriteMany access mode when virt-api runs more than one replica.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"claimName"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_ConsoleRecordingPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConsoleRecordingPolicy selects the console sessions recorded in a set of namespaces.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespaceSelector selects the namespaces the policy applies to. An empty selector selects all namespaces.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"sessions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Sessions are the kinds of sessions which are recorded. Defaults to Serial and VNC",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"required": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Required refuses console sessions, and closes the running ones, when their transcript cannot be delivered to the sink. Otherwise, delivery failures are only logged.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_ConsoleRecordingS3Sink(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConsoleRecordingS3Sink uploads the transcripts to an S3 compatible bucket.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"endpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Endpoint is the URL of the S3 service, e.g. https://s3.us-east-1.amazonaws.com",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"region": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Region is the region of the bucket, used to sign the requests",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"bucket": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Bucket is the name of the bucket the transcripts are uploaded to",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"prefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefix is prepended to the key of the uploaded objects",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"credentialsSecretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialsSecretName is the name of a secret in the KubeVirt install namespace holding the accessKeyId and secretAccessKey keys.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"endpoint", "region", "bucket", "credentialsSecretName"},
 			},
 		},
 	}
 }
 
+func schema_kubevirtio_api_core_v1_ConsoleRecordingSink(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConsoleRecordingSink holds the destination of the console session transcripts.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"persistentVolumeClaim": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PersistentVolumeClaim writes the transcripts as files on a volume mounted into virt-api.",
+							Ref:         ref("kubevirt.io/api/core/v1.ConsoleRecordingPVCSink"),
+						},
+					},
+					"s3": {
+						SchemaProps: spec.SchemaProps{
+							Description: "S3 uploads the transcripts as objects to an S3 compatible bucket.",
+							Ref:         ref("kubevirt.io/api/core/v1.ConsoleRecordingS3Sink"),
+						},
+					},
+					"webhook": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Webhook posts the transcripts to an HTTP endpoint.",
+							Ref:         ref("kubevirt.io/api/core/v1.ConsoleRecordingWebhookSink"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.ConsoleRecordingPVCSink", "kubevirt.io/api/core/v1.ConsoleRecordingS3Sink", "kubevirt.io/api/core/v1.ConsoleRecordingWebhookSink"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_ConsoleRecordingWebhookSink(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConsoleRecordingWebhookSink posts the transcripts to an HTTP endpoint.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is the endpoint every transcript chunk is posted to",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"caBundle": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CABundle is the PEM encoded bundle used to verify the endpoint certificate. The system trust store is used when it is not set.",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+				},
+				Required: []string{"url"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_ContainerDiskInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ContainerDiskInfo shows info about the containerdisk",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"checksum": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Checksum is the checksum of the rootdisk or kernel artifacts inside the containerdisk",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_ContainerDiskPrePullConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ContainerDiskPrePullConfiguration selects the container disk images kept cached on the nodes, so that creating many VMs at once does not stampede the registry and their boot time does not depend on image pulls.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"images": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Images lists container disk images to pull on the nodes.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"virtualMachineSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtualMachineSelector adds the container disk images referenced by the templates of the VirtualMachines matching the selector. An empty selector matches all the VirtualMachines. Images requiring an image pull secret are skipped.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector restricts the nodes the images are pulled on. Defaults to the nodes schedulable for VMIs.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_ContainerDiskSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -20063,6 +20685,82 @@ func schema_kubevirtio_api_core_v1_DataVolumeTemplateSpec(ref common.ReferenceCa
 	}
 }
 
+func schema_kubevirtio_api_core_v1_DebugAttachOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DebugAttachOptions are used when attaching a debug container to the virt-launcher pod of a VMI",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason explains why the debug container is needed, it is recorded for auditing",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"privileged": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Privileged runs the debug container privileged, giving it access to the host. Otherwise it runs with the security context of the compute container.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration after which the debug container exits. Defaults to 15 minutes and may not exceed 1 hour.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"reason"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_DebugAttachResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DebugAttachResult identifies the debug container attached to the virt-launcher pod of a VMI",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"podName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodName is the name of the virt-launcher pod the debug container is attached to",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"containerName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContainerName is the name of the debug container",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expirationTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationTimestamp is the time after which the debug container exits",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"podName", "containerName", "expirationTimestamp"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_DeprecatedInterfaceMacvtap(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -20363,6 +21061,12 @@ func schema_kubevirtio_api_core_v1_Devices(ref common.ReferenceCallback) common.
 							Format:      "",
 						},
 					},
+					"networkInterfaceMultiqueuePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkInterfaceMultiQueuePolicy controls how many queue pairs are given to the multi-queue network interfaces, relative to the number of guest vCPUs. When not set, the cluster wide policy applies, and each vCPU gets its own queue pair.",
+							Ref:         ref("kubevirt.io/api/core/v1.NetworkQueuesPolicy"),
+						},
+					},
 					"gpus": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -20452,6 +21156,12 @@ func schema_kubevirtio_api_core_v1_Devices(ref common.ReferenceCallback) common.
 							Ref:         ref("kubevirt.io/api/core/v1.SoundDevice"),
 						},
 					},
+					"spice": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether to attach a SPICE graphics device, reachable through the spice subresource.",
+							Ref:         ref("kubevirt.io/api/core/v1.SpiceDevice"),
+						},
+					},
 					"tpm": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Whether to emulate a TPM device.",
@@ -20464,11 +21174,30 @@ func schema_kubevirtio_api_core_v1_Devices(ref common.ReferenceCallback) common.
 							Ref:         ref("kubevirt.io/api/core/v1.VideoDevice"),
 						},
 					},
+					"virtioChannels": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtioChannels lists the named virtio-serial channels exposed to the guest, alongside the serial console. Each channel is reachable through its own websocket subresource, enabling out-of-band management agents.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.VirtioChannel"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.ClientPassthroughDevices", "kubevirt.io/api/core/v1.Disk", "kubevirt.io/api/core/v1.DownwardMetrics", "kubevirt.io/api/core/v1.Filesystem", "kubevirt.io/api/core/v1.GPU", "kubevirt.io/api/core/v1.HostDevice", "kubevirt.io/api/core/v1.Input", "kubevirt.io/api/core/v1.Interface", "kubevirt.io/api/core/v1.PanicDevice", "kubevirt.io/api/core/v1.Rng", "kubevirt.io/api/core/v1.SoundDevice", "kubevirt.io/api/core/v1.TPMDevice", "kubevirt.io/api/core/v1.VideoDevice", "kubevirt.io/api/core/v1.Watchdog"},
+			"kubevirt.io/api/core/v1.ClientPassthroughDevices", "kubevirt.io/api/core/v1.Disk", "kubevirt.io/api/core/v1.DownwardMetrics", "kubevirt.io/api/core/v1.Filesystem", "kubevirt.io/api/core/v1.GPU", "kubevirt.io/api/core/v1.HostDevice", "kubevirt.io/api/core/v1.Input", "kubevirt.io/api/core/v1.Interface", "kubevirt.io/api/core/v1.NetworkQueuesPolicy", "kubevirt.io/api/core/v1.PanicDevice", "kubevirt.io/api/core/v1.Rng", "kubevirt.io/api/core/v1.SoundDevice", "kubevirt.io/api/core/v1.SpiceDevice", "kubevirt.io/api/core/v1.TPMDevice", "kubevirt.io/api/core/v1.VideoDevice", "kubevirt.io/api/core/v1.VirtioChannel", "kubevirt.io/api/core/v1.Watchdog"},
 	}
 }
 
@@ -20601,7 +21330,7 @@ func schema_kubevirtio_api_core_v1_Disk(ref common.ReferenceCallback) common.Ope
 					},
 					"errorPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If specified, it can change the default error policy (stop) for the disk",
+							Description: "If specified, it can change the default error policy (stop) for the disk.\nWith stop, which is also used if not specified, and with retry, a VMI paused on I/O errors\nis resumed automatically with a backoff until the storage recovers.\nWith pause it stays paused until it is unpaused.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -20613,12 +21342,18 @@ func schema_kubevirtio_api_core_v1_Disk(ref common.ReferenceCallback) common.Ope
 							Format:      "",
 						},
 					},
+					"encryption": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Encryption opens the disk as a LUKS encrypted volume with the passphrase stored in a Secret. The passphrase is handed to QEMU through libvirt secrets.",
+							Ref:         ref("kubevirt.io/api/core/v1.DiskEncryption"),
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.BlockSize", "kubevirt.io/api/core/v1.CDRomTarget", "kubevirt.io/api/core/v1.DiskTarget", "kubevirt.io/api/core/v1.LunTarget"},
+			"kubevirt.io/api/core/v1.BlockSize", "kubevirt.io/api/core/v1.CDRomTarget", "kubevirt.io/api/core/v1.DiskEncryption", "kubevirt.io/api/core/v1.DiskTarget", "kubevirt.io/api/core/v1.LunTarget"},
 	}
 }
 
@@ -20643,15 +21378,37 @@ func schema_kubevirtio_api_core_v1_DiskDevice(ref common.ReferenceCallback) comm
 					},
 					"cdrom": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Attach a volume as a cdrom to the vmi.",
-							Ref:         ref("kubevirt.io/api/core/v1.CDRomTarget"),
+							Description: "Attach a volume as a cdrom to the vmi.",
+							Ref:         ref("kubevirt.io/api/core/v1.CDRomTarget"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.CDRomTarget", "kubevirt.io/api/core/v1.DiskTarget", "kubevirt.io/api/core/v1.LunTarget"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_DiskEncryption(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DiskEncryption references the Secret holding the LUKS passphrase of a disk.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretName is the name of a Secret in the namespace of the VMI. The passphrase is read from the \"passphrase\" key of the Secret.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"secretName"},
 			},
 		},
-		Dependencies: []string{
-			"kubevirt.io/api/core/v1.CDRomTarget", "kubevirt.io/api/core/v1.DiskTarget", "kubevirt.io/api/core/v1.LunTarget"},
 	}
 }
 
@@ -21047,6 +21804,28 @@ func schema_kubevirtio_api_core_v1_EvacuateCancelOptions(ref common.ReferenceCal
 	}
 }
 
+func schema_kubevirtio_api_core_v1_ExternalMacAllocator(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExternalMacAllocator configures an external MAC address allocator, like a MAC pool manager.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"webhookURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WebhookURL is the HTTP endpoint the allocation requests are posted to.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"webhookURL"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_FeatureAPIC(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -21359,6 +22138,56 @@ func schema_kubevirtio_api_core_v1_FilesystemVirtiofs(ref common.ReferenceCallba
 	}
 }
 
+func schema_kubevirtio_api_core_v1_FirewallRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FirewallRule allows the incoming connections matching all of its fields.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"protocol": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Protocol matched by the rule. One of TCP, UDP or ICMP. All protocols are matched when not specified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Destination ports matched by the rule, supported only with the TCP and UDP protocols. All ports are matched when not specified.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
+					"from": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Source CIDRs matched by the rule, for example 10.10.0.0/16 or fd00::/64. All sources are matched when not specified.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_Firmware(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -21531,6 +22360,13 @@ func schema_kubevirtio_api_core_v1_GPU(ref common.ReferenceCallback) common.Open
 							Format:      "",
 						},
 					},
+					"attachIOMMUGroup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AttachIOMMUGroup requests that all the devices sharing the IOMMU group of the allocated PCI device are passed through to the guest along with it. The VMI fails to start if any of them is in use by a host driver.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
@@ -21627,6 +22463,60 @@ func schema_kubevirtio_api_core_v1_GuestAgentCommandInfo(ref common.ReferenceCal
 	}
 }
 
+func schema_kubevirtio_api_core_v1_GuestAgentExec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GuestAgentExec configures the guest-agent based exec probe",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"command": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Command is the command line to execute inside the guest. The first element is the executable, the remaining elements are passed as arguments. The command is not run in a shell, so shell instructions like pipes are only available by calling a shell explicitly.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"successExitCodes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "SuccessExitCodes lists the exit codes of the command which are considered a successful probe. Defaults to 0.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"command"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_GuestAgentPing(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -21638,6 +22528,59 @@ func schema_kubevirtio_api_core_v1_GuestAgentPing(ref common.ReferenceCallback)
 	}
 }
 
+func schema_kubevirtio_api_core_v1_GuestDNSConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GuestDNSConfig is the DNS configuration delivered to the guest.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nameservers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Nameservers is a list of IP addresses of the DNS servers the guest should use. The DNS servers of an IP family which has none in the list are taken from the pod DNS configuration.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"searches": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Searches is a list of DNS search domains the guest should use.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_HPETTimer(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -21677,6 +22620,12 @@ func schema_kubevirtio_api_core_v1_Handler(ref common.ReferenceCallback) common.
 							Ref:         ref("k8s.io/api/core/v1.ExecAction"),
 						},
 					},
+					"guestAgentExec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestAgentExec runs a command inside the guest through the qemu-guest-agent and interprets its exit code. No network access to the guest is required.",
+							Ref:         ref("kubevirt.io/api/core/v1.GuestAgentExec"),
+						},
+					},
 					"guestAgentPing": {
 						SchemaProps: spec.SchemaProps{
 							Description: "GuestAgentPing contacts the qemu-guest-agent for availability checks.",
@@ -21699,7 +22648,7 @@ func schema_kubevirtio_api_core_v1_Handler(ref common.ReferenceCallback) common.
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.ExecAction", "k8s.io/api/core/v1.HTTPGetAction", "k8s.io/api/core/v1.TCPSocketAction", "kubevirt.io/api/core/v1.GuestAgentPing"},
+			"k8s.io/api/core/v1.ExecAction", "k8s.io/api/core/v1.HTTPGetAction", "k8s.io/api/core/v1.TCPSocketAction", "kubevirt.io/api/core/v1.GuestAgentExec", "kubevirt.io/api/core/v1.GuestAgentPing"},
 	}
 }
 
@@ -21744,10 +22693,68 @@ func schema_kubevirtio_api_core_v1_HostDevice(ref common.ReferenceCallback) comm
 							Format:      "",
 						},
 					},
+					"attachIOMMUGroup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AttachIOMMUGroup requests that all the devices sharing the IOMMU group of the allocated PCI device are passed through to the guest along with it. The VMI fails to start if any of them is in use by a host driver.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_HostDeviceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HostDeviceStatus represents information about the status of a host device assigned to the VirtualMachineInstance.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the host device",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the phase",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is a brief description of why we are in the current host device phase",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a detailed message about the current host device phase",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hotplugHostDevice": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If the host device is hotplugged, this will contain the hotplug status.",
+							Ref:         ref("kubevirt.io/api/core/v1.HotplugHostDeviceStatus"),
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
 		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.HotplugHostDeviceStatus"},
 	}
 }
 
@@ -21796,6 +22803,40 @@ func schema_kubevirtio_api_core_v1_HostDisk(ref common.ReferenceCallback) common
 	}
 }
 
+func schema_kubevirtio_api_core_v1_HotplugHostDeviceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HotplugHostDeviceStatus represents the hotplug status of the host device",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"attachPodName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AttachPodName is the name of the pod used to allocate the host device on the node.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"attachPodUID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AttachPodUID is the UID of the pod used to allocate the host device on the node.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"pciAddress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PCIAddress is the host PCI address of the device allocated to the attachment pod.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_HotplugVolumeSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -22197,6 +23238,56 @@ func schema_kubevirtio_api_core_v1_Interface(ref common.ReferenceCallback) commo
 							},
 						},
 					},
+					"portsEnforcement": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PortsEnforcement defines how the listed ports are exposed. With Strict, only the listed ports are forwarded to the guest, any other incoming traffic is dropped and the list of ports can be updated while the VM is running. Supported only with the masquerade binding.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"firewall": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Firewall defines the rules filtering the incoming traffic of the interface. Supported only with the masquerade binding.",
+							Ref:         ref("kubevirt.io/api/core/v1.InterfaceFirewall"),
+						},
+					},
+					"offloads": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Offloads toggles the offloads the host applies to the traffic of the interface. Supported only with the virtio model. Offloads which are not specified keep the hypervisor defaults.",
+							Ref:         ref("kubevirt.io/api/core/v1.InterfaceOffloads"),
+						},
+					},
+					"interrupts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interrupts configures how the virtio device of the interface interrupts the guest, e.g. to give the queues of a high queue count vDPA NIC enough MSI-X vectors. It applies to the interfaces generated by network binding plugins as well. Supported only with the virtio model.",
+							Ref:         ref("kubevirt.io/api/core/v1.InterfaceInterrupts"),
+						},
+					},
+					"routerAdvertisement": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RouterAdvertisement enables IPv6 router advertisements to the guest, announcing the pod as its default router and letting the guest get its IPv6 address over DHCPv6. Supported only with the masquerade binding.",
+							Ref:         ref("kubevirt.io/api/core/v1.InterfaceRouterAdvertisement"),
+						},
+					},
+					"networkEmulation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkEmulation degrades the traffic sent to the guest through the interface, adding delay, jitter and packet loss for chaos testing of the guest workloads. Supported only with the bridge and masquerade bindings.",
+							Ref:         ref("kubevirt.io/api/core/v1.InterfaceNetworkEmulation"),
+						},
+					},
+					"mirror": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mirror copies the traffic of the interface to a monitoring network, where an IDS or monitoring appliance (VM or pod) inspects it. Supported only with the bridge and masquerade bindings.",
+							Ref:         ref("kubevirt.io/api/core/v1.InterfaceMirror"),
+						},
+					},
+					"promiscuous": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Promiscuous declares that the guest uses the interface as a trunk or in promiscuous mode, sending and receiving frames of MAC addresses other than its own, as nested hypervisors and VNFs do. The ports of the backing bridge are set in promiscuous mode to let these frames through. Supported only with the bridge binding, in namespaces selected by the promiscuousInterfacesNamespaceLabelSelector of the KubeVirt network configuration.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"macAddress": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Interface MAC address. For example: de:ad:00:00:be:af or DE-AD-00-00-BE-AF.",
@@ -22250,7 +23341,43 @@ func schema_kubevirtio_api_core_v1_Interface(ref common.ReferenceCallback) commo
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.DHCPOptions", "kubevirt.io/api/core/v1.DeprecatedInterfaceMacvtap", "kubevirt.io/api/core/v1.DeprecatedInterfacePasst", "kubevirt.io/api/core/v1.DeprecatedInterfaceSlirp", "kubevirt.io/api/core/v1.InterfaceBridge", "kubevirt.io/api/core/v1.InterfaceMasquerade", "kubevirt.io/api/core/v1.InterfacePasstBinding", "kubevirt.io/api/core/v1.InterfaceSRIOV", "kubevirt.io/api/core/v1.PluginBinding", "kubevirt.io/api/core/v1.Port"},
+			"kubevirt.io/api/core/v1.DHCPOptions", "kubevirt.io/api/core/v1.DeprecatedInterfaceMacvtap", "kubevirt.io/api/core/v1.DeprecatedInterfacePasst", "kubevirt.io/api/core/v1.DeprecatedInterfaceSlirp", "kubevirt.io/api/core/v1.InterfaceBridge", "kubevirt.io/api/core/v1.InterfaceFirewall", "kubevirt.io/api/core/v1.InterfaceInterrupts", "kubevirt.io/api/core/v1.InterfaceMasquerade", "kubevirt.io/api/core/v1.InterfaceMirror", "kubevirt.io/api/core/v1.InterfaceNetworkEmulation", "kubevirt.io/api/core/v1.InterfaceOffloads", "kubevirt.io/api/core/v1.InterfacePasstBinding", "kubevirt.io/api/core/v1.InterfaceRouterAdvertisement", "kubevirt.io/api/core/v1.InterfaceSRIOV", "kubevirt.io/api/core/v1.PluginBinding", "kubevirt.io/api/core/v1.Port"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_InterfaceBindingDryRunValidation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InterfaceBindingDryRunValidation configures the validation of VMI specs by a binding plugin.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"webhookURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WebhookURL is the HTTP endpoint the VMI spec is posted to. The endpoint replies whether the spec is allowed by the plugin and, when it is not, the reason.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"failurePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailurePolicy defines how an unreachable endpoint or an unexpected reply is handled. Fail rejects the VMI and Ignore admits it. Defaults to Fail.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"caBundle": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CABundle is the PEM encoded bundle used to verify the endpoint certificate. The system trust store is used when it is not set.",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+				},
+				Required: []string{"webhookURL"},
+			},
+		},
 	}
 }
 
@@ -22359,54 +23486,325 @@ func schema_kubevirtio_api_core_v1_InterfaceBindingPlugin(ref common.ReferenceCa
 							Ref:         ref("kubevirt.io/api/core/v1.InterfaceBindingMigration"),
 						},
 					},
-					"downwardAPI": {
+					"downwardAPI": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DownwardAPI specifies what kind of data should be exposed to the binding plugin sidecar. Supported values: \"device-info\" version: v1alphav1",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceName references a device plugin resource the virt-launcher pod requests for each interface which uses the binding on the pod network. It allows the binding to handle a primary network whose default CNI attaches a device allocated by a device plugin (e.g. vDPA) and reports it in the device-info. Secondary networks request their resource through their NetworkAttachmentDefinition. version: v1alphav1",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"computeResourceOverhead": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComputeResourceOverhead specifies the resource overhead that should be added to the compute container when using the binding. Its memory and CPU requests are added once per VMI, whatever the number of interfaces using the binding. The CPU is added to the CPU limits as well when they are set, but not to dedicated CPUs. version: v1alphav1",
+							Ref:         ref("kubevirt.io/api/core/v1.ResourceRequirementsWithoutClaims"),
+						},
+					},
+					"sidecarResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SidecarResources specifies the CPU and memory requests and limits of the plugin sidecar container. They take precedence over the resources set for all the hook sidecars through SupportContainerResources. version: v1alphav1",
+							Ref:         ref("kubevirt.io/api/core/v1.ResourceRequirementsWithoutClaims"),
+						},
+					},
+					"prerequisites": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prerequisites declares the cluster-wide requirements of the binding plugin. Their fulfillment is reported in the KubeVirt CR status. version: v1alphav1",
+							Ref:         ref("kubevirt.io/api/core/v1.InterfaceBindingPrerequisites"),
+						},
+					},
+					"dryRunValidation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DryRunValidation configures an endpoint, served by the binding plugin, which validates the spec of the VMIs using the plugin on their creation, catching misconfigurations before they are launched. version: v1alphav1",
+							Ref:         ref("kubevirt.io/api/core/v1.InterfaceBindingDryRunValidation"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.InterfaceBindingDryRunValidation", "kubevirt.io/api/core/v1.InterfaceBindingMigration", "kubevirt.io/api/core/v1.InterfaceBindingPrerequisites", "kubevirt.io/api/core/v1.ResourceRequirementsWithoutClaims"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_InterfaceBindingPrerequisites(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InterfaceBindingPrerequisites describes what the binding plugin requires from the cluster in order to function.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"featureGates": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "FeatureGates lists the feature gates which are required to be enabled.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"deviceResources": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "DeviceResources lists the extended resources, exposed by device plugins, which are required to be advertised by at least one schedulable node.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_InterfaceBridge(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InterfaceBridge connects to a given network via a linux bridge.",
+				Type:        []string{"object"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_InterfaceEventsConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InterfaceEventsConfiguration configures the delivery of VMI interface events.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"webhookURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WebhookURL is the HTTP endpoint the events are posted to, in the CloudEvents structured JSON format. Delivery is best effort, failed deliveries are retried a few times before the event is dropped. A retried event may be received more than once, consumers can rely on the event id to detect duplicates.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"webhookURL"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_InterfaceFirewall(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InterfaceFirewall defines the L3/L4 rules allowing incoming connections to the virtual machine. Incoming connections not matching any rule are dropped, while replies to connections initiated by the virtual machine are always allowed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ingress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ingress lists the rules allowing incoming connections.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.FirewallRule"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.FirewallRule"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_InterfaceInterrupts(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InterfaceInterrupts configures the interrupts of the virtio device of an interface.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mode is the interrupt mode of the device, either MSIX or INTx. Defaults to MSIX.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"vectors": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Vectors is the number of MSI-X vectors of the device. Defaults to the hypervisor default, which is two vectors per queue pair plus two. It may not be set with the INTx mode.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_InterfaceMasquerade(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InterfaceMasquerade connects to a given network using netfilter rules to nat the traffic.",
+				Type:        []string{"object"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_InterfaceMirror(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InterfaceMirror defines where the traffic of an interface is mirrored to.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"networkName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkName is the name of the multus NetworkAttachmentDefinition the mirrored traffic is sent to, in the form <namespace>/<name> or <name> for the VMI namespace. The virt-launcher pod is attached to it through an additional interface.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"direction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Direction of the mirrored traffic: ingress for the traffic received by the guest, egress for the traffic sent by the guest, or both. Defaults to both.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"networkName"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_InterfaceNetworkEmulation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InterfaceNetworkEmulation defines the network emulation applied on the tap device backing an interface.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"delay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Delay added to every packet sent to the guest.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"jitter": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DownwardAPI specifies what kind of data should be exposed to the binding plugin sidecar. Supported values: \"device-info\" version: v1alphav1",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Jitter is the random variation of the delay, requires a delay.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"computeResourceOverhead": {
+					"lossPercentage": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComputeResourceOverhead specifies the resource overhead that should be added to the compute container when using the binding. version: v1alphav1",
-							Ref:         ref("kubevirt.io/api/core/v1.ResourceRequirementsWithoutClaims"),
+							Description: "LossPercentage is the percentage of the packets sent to the guest which are dropped, between 0 and 100. For example: \"0.5\".",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.InterfaceBindingMigration", "kubevirt.io/api/core/v1.ResourceRequirementsWithoutClaims"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_kubevirtio_api_core_v1_InterfaceBridge(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_core_v1_InterfaceOffloads(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InterfaceBridge connects to a given network via a linux bridge.",
+				Description: "InterfaceOffloads toggles the host side offloads of a virtio interface, for workloads which need them deterministically disabled, like DPDK running in the guest.",
 				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tso": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TSO toggles the TCP segmentation offload, for both IPv4 and IPv6.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"ufo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UFO toggles the UDP fragmentation offload.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"mrgRxbuf": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MergeableRxBuffers toggles the mergeable receive buffers.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"csum": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Checksum toggles the checksum offload.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
 			},
 		},
 	}
 }
 
-func schema_kubevirtio_api_core_v1_InterfaceMasquerade(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_core_v1_InterfacePasstBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InterfaceMasquerade connects to a given network using netfilter rules to nat the traffic.",
+				Description: "InterfacePasstBinding connects to a given network using passt usermode networking.",
 				Type:        []string{"object"},
 			},
 		},
 	}
 }
 
-func schema_kubevirtio_api_core_v1_InterfacePasstBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_core_v1_InterfaceRouterAdvertisement(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InterfacePasstBinding connects to a given network using passt usermode networking.",
+				Description: "InterfaceRouterAdvertisement enables an IPv6 router advertisement responder in the virt-launcher pod.",
 				Type:        []string{"object"},
 			},
 		},
@@ -22484,11 +23882,17 @@ func schema_kubevirtio_api_core_v1_KernelBoot(ref common.ReferenceCallback) comm
 							Ref:         ref("kubevirt.io/api/core/v1.KernelBootContainer"),
 						},
 					},
+					"volume": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Volume defines the PersistentVolumeClaim or DataVolume volume that contains kernel artifacts. Container and Volume are mutually exclusive.",
+							Ref:         ref("kubevirt.io/api/core/v1.KernelBootVolume"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.KernelBootContainer"},
+			"kubevirt.io/api/core/v1.KernelBootContainer", "kubevirt.io/api/core/v1.KernelBootVolume"},
 	}
 }
 
@@ -22570,6 +23974,42 @@ func schema_kubevirtio_api_core_v1_KernelBootStatus(ref common.ReferenceCallback
 	}
 }
 
+func schema_kubevirtio_api_core_v1_KernelBootVolume(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "If set, the VM will be booted from the defined kernel / initrd stored on a volume.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the volume that contains initrd / kernel files. It must match a PersistentVolumeClaim or DataVolume volume of the VMI in filesystem mode, which is not used by any disk or filesystem.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kernelPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The fully-qualified path to the kernel image in the volume",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"initrdPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "the fully-qualified path to the ramdisk image in the volume",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_KernelInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -22638,6 +24078,35 @@ func schema_kubevirtio_api_core_v1_KubeVirt(ref common.ReferenceCallback) common
 	}
 }
 
+func schema_kubevirtio_api_core_v1_KubeVirtCertManagerConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeVirtCertManagerConfiguration delegates the issuance and rotation of the virt-api and virt-handler certificates, including the ones virt-handler uses towards virt-launcher, to cert-manager",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"issuerRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IssuerRef references the cert-manager issuer signing the dedicated KubeVirt CA which issues the certificates",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubevirt.io/api/core/v1.CertManagerIssuerReference"),
+						},
+					},
+					"certificate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Certificate configuration Defaults to the configuration of the self signed server certificates",
+							Ref:         ref("kubevirt.io/api/core/v1.CertConfig"),
+						},
+					},
+				},
+				Required: []string{"issuerRef"},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.CertConfig", "kubevirt.io/api/core/v1.CertManagerIssuerReference"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_KubeVirtCertificateRotateStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -22649,11 +24118,17 @@ func schema_kubevirtio_api_core_v1_KubeVirtCertificateRotateStrategy(ref common.
 							Ref: ref("kubevirt.io/api/core/v1.KubeVirtSelfSignConfiguration"),
 						},
 					},
+					"certManager": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CertManager delegates the virt-api and virt-handler certificates to cert-manager. They are issued by a dedicated CA in the KubeVirt install namespace, which is the only cert-manager CA trusted by the components. The remaining certificates keep being signed by the KubeVirt CA.",
+							Ref:         ref("kubevirt.io/api/core/v1.KubeVirtCertManagerConfiguration"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.KubeVirtSelfSignConfiguration"},
+			"kubevirt.io/api/core/v1.KubeVirtCertManagerConfiguration", "kubevirt.io/api/core/v1.KubeVirtSelfSignConfiguration"},
 	}
 }
 
@@ -23019,11 +24494,53 @@ func schema_kubevirtio_api_core_v1_KubeVirtConfiguration(ref common.ReferenceCal
 							Format:      "",
 						},
 					},
+					"subresourceRateLimits": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SubresourceRateLimits configures how virt-api throttles calls to VM and VMI subresources.",
+							Ref:         ref("kubevirt.io/api/core/v1.SubresourceRateLimits"),
+						},
+					},
+					"loadAwareRebalancing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LoadAwareRebalancing configures how VMIs are live migrated off overloaded nodes. It is only taken into account when the LoadAwareRebalancing feature gate is enabled.",
+							Ref:         ref("kubevirt.io/api/core/v1.LoadAwareRebalancingConfiguration"),
+						},
+					},
+					"consoleRecording": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConsoleRecording configures the recording of the serial console and VNC sessions opened through virt-api.",
+							Ref:         ref("kubevirt.io/api/core/v1.ConsoleRecordingConfiguration"),
+						},
+					},
+					"vcpuAutoscaling": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VCPUAutoscaling configures when more CPU sockets are recommended for the VirtualMachines opting in to CPU autoscaling. It is only taken into account when the VCPUAutoscaling feature gate is enabled.",
+							Ref:         ref("kubevirt.io/api/core/v1.VCPUAutoscalingConfiguration"),
+						},
+					},
+					"containerDiskPrePull": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContainerDiskPrePull configures the container disk images pulled ahead of time, and kept cached, on the nodes. It is only taken into account when the ContainerDiskPrePull feature gate is enabled.",
+							Ref:         ref("kubevirt.io/api/core/v1.ContainerDiskPrePullConfiguration"),
+						},
+					},
+					"virtControllerSharding": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtControllerSharding splits the reconciliation of the namespaced workloads between several active virt-controller instances. It is only taken into account when the VirtControllerSharding feature gate is enabled.",
+							Ref:         ref("kubevirt.io/api/core/v1.VirtControllerShardingConfiguration"),
+						},
+					},
+					"vmiStatusUpdates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VMIStatusUpdates configures how virt-handler aggregates the frequent updates of the VMI status.",
+							Ref:         ref("kubevirt.io/api/core/v1.VMIStatusUpdatesConfiguration"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "kubevirt.io/api/core/v1.ArchConfiguration", "kubevirt.io/api/core/v1.ChangedBlockTrackingSelectors", "kubevirt.io/api/core/v1.CommonInstancetypesDeployment", "kubevirt.io/api/core/v1.ConfidentialComputeConfiguration", "kubevirt.io/api/core/v1.DeveloperConfiguration", "kubevirt.io/api/core/v1.HypervisorConfiguration", "kubevirt.io/api/core/v1.InstancetypeConfiguration", "kubevirt.io/api/core/v1.KSMConfiguration", "kubevirt.io/api/core/v1.LiveUpdateConfiguration", "kubevirt.io/api/core/v1.MediatedDevicesConfiguration", "kubevirt.io/api/core/v1.MigrationConfiguration", "kubevirt.io/api/core/v1.NetworkConfiguration", "kubevirt.io/api/core/v1.PermittedHostDevices", "kubevirt.io/api/core/v1.ReloadableComponentConfiguration", "kubevirt.io/api/core/v1.SMBiosConfiguration", "kubevirt.io/api/core/v1.SeccompConfiguration", "kubevirt.io/api/core/v1.SupportContainerResources", "kubevirt.io/api/core/v1.TLSConfiguration", "kubevirt.io/api/core/v1.VirtTemplateDeployment", "kubevirt.io/api/core/v1.VirtualMachineOptions"},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "kubevirt.io/api/core/v1.ArchConfiguration", "kubevirt.io/api/core/v1.ChangedBlockTrackingSelectors", "kubevirt.io/api/core/v1.CommonInstancetypesDeployment", "kubevirt.io/api/core/v1.ConfidentialComputeConfiguration", "kubevirt.io/api/core/v1.ConsoleRecordingConfiguration", "kubevirt.io/api/core/v1.ContainerDiskPrePullConfiguration", "kubevirt.io/api/core/v1.DeveloperConfiguration", "kubevirt.io/api/core/v1.HypervisorConfiguration", "kubevirt.io/api/core/v1.InstancetypeConfiguration", "kubevirt.io/api/core/v1.KSMConfiguration", "kubevirt.io/api/core/v1.LiveUpdateConfiguration", "kubevirt.io/api/core/v1.LoadAwareRebalancingConfiguration", "kubevirt.io/api/core/v1.MediatedDevicesConfiguration", "kubevirt.io/api/core/v1.MigrationConfiguration", "kubevirt.io/api/core/v1.NetworkConfiguration", "kubevirt.io/api/core/v1.PermittedHostDevices", "kubevirt.io/api/core/v1.ReloadableComponentConfiguration", "kubevirt.io/api/core/v1.SMBiosConfiguration", "kubevirt.io/api/core/v1.SeccompConfiguration", "kubevirt.io/api/core/v1.SubresourceRateLimits", "kubevirt.io/api/core/v1.SupportContainerResources", "kubevirt.io/api/core/v1.TLSConfiguration", "kubevirt.io/api/core/v1.VCPUAutoscalingConfiguration", "kubevirt.io/api/core/v1.VMIStatusUpdatesConfiguration", "kubevirt.io/api/core/v1.VirtControllerShardingConfiguration", "kubevirt.io/api/core/v1.VirtTemplateDeployment", "kubevirt.io/api/core/v1.VirtualMachineOptions"},
 	}
 }
 
@@ -23404,11 +24921,30 @@ func schema_kubevirtio_api_core_v1_KubeVirtStatus(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"networkBindings": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkBindings reports the usage of the registered network binding plugins and whether their prerequisites are satisfied.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.NetworkBindingPluginStatus"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.GenerationStatus", "kubevirt.io/api/core/v1.KubeVirtCondition"},
+			"kubevirt.io/api/core/v1.GenerationStatus", "kubevirt.io/api/core/v1.KubeVirtCondition", "kubevirt.io/api/core/v1.NetworkBindingPluginStatus"},
 	}
 }
 
@@ -23526,6 +25062,48 @@ func schema_kubevirtio_api_core_v1_LiveUpdateConfiguration(ref common.ReferenceC
 	}
 }
 
+func schema_kubevirtio_api_core_v1_LoadAwareRebalancingConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LoadAwareRebalancingConfiguration holds the thresholds and budget used to live migrate VMIs off nodes whose CPUs are overloaded, as reported by virt-handler.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cpuUtilizationThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPUUtilizationThreshold is the node CPU utilization, in percent, above which VMIs are migrated off the node. Defaults to 85",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"cpuStealThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPUStealThreshold is the share of time, in percent, the vCPUs running on a node wait for a physical CPU, above which VMIs are migrated off the node. Defaults to 10",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"cooldown": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cooldown is the minimum time between two rebalancing migrations off the same node, and between two rebalancing migrations of the same VMI. Defaults to 10 minutes",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"maxParallelMigrations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxParallelMigrations is the number of rebalancing migrations allowed to run cluster-wide at the same time. Defaults to 2",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_LogVerbosity(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -23624,6 +25202,41 @@ func schema_kubevirtio_api_core_v1_LunTarget(ref common.ReferenceCallback) commo
 	}
 }
 
+func schema_kubevirtio_api_core_v1_MacGenerationPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MacGenerationPolicy configures the allocation of interface MAC addresses.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"oui": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OUI is the prefix used as the first three octets of the generated MAC addresses, formatted as \"xx:xx:xx\". It must represent a unicast address. Defaults to \"02:6b:76\", a locally administered prefix.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"allocator": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Allocator selects how the MAC addresses are allocated. Deterministic derives them from the owner VM UID and the network name, Random picks them at random and External requests them from the webhook configured in external. Defaults to Deterministic.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"external": {
+						SchemaProps: spec.SchemaProps{
+							Description: "External configures the webhook allocating the MAC addresses, required by the External allocator. The webhook is called by virt-controller when it creates the VMI of a VM, standalone VMIs are not covered.",
+							Ref:         ref("kubevirt.io/api/core/v1.ExternalMacAllocator"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.ExternalMacAllocator"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_Machine(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -23788,11 +25401,17 @@ func schema_kubevirtio_api_core_v1_Memory(ref common.ReferenceCallback) common.O
 							Ref:         ref("kubevirt.io/api/core/v1.ReservedOverhead"),
 						},
 					},
+					"swap": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Swap allows the VMI to use the swap of the node, for memory-bursty but latency-tolerant workloads. The swap has to be enabled on the node.",
+							Ref:         ref("kubevirt.io/api/core/v1.MemorySwap"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity", "kubevirt.io/api/core/v1.Hugepages", "kubevirt.io/api/core/v1.ReservedOverhead"},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "kubevirt.io/api/core/v1.Hugepages", "kubevirt.io/api/core/v1.MemorySwap", "kubevirt.io/api/core/v1.ReservedOverhead"},
 	}
 }
 
@@ -23825,47 +25444,104 @@ func schema_kubevirtio_api_core_v1_MemoryDumpVolumeSource(ref common.ReferenceCa
 						},
 					},
 				},
-				Required: []string{"claimName"},
+				Required: []string{"claimName"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_MemoryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"guestAtBoot": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestAtBoot specifies with how much memory the VirtualMachine intiallly booted with.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"guestCurrent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestCurrent specifies how much memory is currently available for the VirtualMachine.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"guestRequested": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestRequested specifies how much memory was requested (hotplug) for the VirtualMachine.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"memoryOverhead": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MemoryOverhead specifies the memory overhead added by the virtualization infrastructure for the virt-launcher pod.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_MemorySwap(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MemorySwap configures the swap of the node used by the VMI.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policy defines how swapped out memory is stored, Swap or Zswap. Defaults to Swap.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"limit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Limit is the maximum amount of swap used by the VMI. Defaults to no limit.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
-func schema_kubevirtio_api_core_v1_MemoryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_core_v1_MetadataService(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "MetadataService is the configuration of the metadata endpoint served to the guest.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"guestAtBoot": {
-						SchemaProps: spec.SchemaProps{
-							Description: "GuestAtBoot specifies with how much memory the VirtualMachine intiallly booted with.",
-							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
-						},
-					},
-					"guestCurrent": {
-						SchemaProps: spec.SchemaProps{
-							Description: "GuestCurrent specifies how much memory is currently available for the VirtualMachine.",
-							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
-						},
-					},
-					"guestRequested": {
-						SchemaProps: spec.SchemaProps{
-							Description: "GuestRequested specifies how much memory was requested (hotplug) for the VirtualMachine.",
-							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
-						},
-					},
-					"memoryOverhead": {
+					"data": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MemoryOverhead specifies the memory overhead added by the virtualization infrastructure for the virt-launcher pod.",
-							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+							Description: "Data is user provided key/value data served to the guest. It can be extended or updated after boot through the VirtualMachineInstance annotations prefixed with metadata.kubevirt.io/, which take precedence.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
@@ -24044,6 +25720,36 @@ func schema_kubevirtio_api_core_v1_MigrationConfiguration(ref common.ReferenceCa
 	}
 }
 
+func schema_kubevirtio_api_core_v1_MigrationDiskTransferProgress(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MigrationDiskTransferProgress reports the progress of copying volumes during a storage live migration",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"totalBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalBytes is the amount of volume data to be copied to the target",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"processedBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProcessedBytes is the amount of volume data already copied to the target",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"totalBytes", "processedBytes"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_MultusNetwork(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -24104,6 +25810,28 @@ func schema_kubevirtio_api_core_v1_NUMAGuestMappingPassthrough(ref common.Refere
 	}
 }
 
+func schema_kubevirtio_api_core_v1_NativeNetwork(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Represents a secondary network provided by the Kubernetes native multi-network API.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"podNetworkName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodNetworkName references a cluster scoped PodNetwork object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"podNetworkName"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_Network(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -24129,12 +25857,76 @@ func schema_kubevirtio_api_core_v1_Network(ref common.ReferenceCallback) common.
 							Ref: ref("kubevirt.io/api/core/v1.MultusNetwork"),
 						},
 					},
+					"native": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Native represents a secondary network provided by the Kubernetes native multi-network API (PodNetwork).",
+							Ref:         ref("kubevirt.io/api/core/v1.NativeNetwork"),
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.MultusNetwork", "kubevirt.io/api/core/v1.PodNetwork"},
+			"kubevirt.io/api/core/v1.MultusNetwork", "kubevirt.io/api/core/v1.NativeNetwork", "kubevirt.io/api/core/v1.PodNetwork"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_NetworkBindingPluginStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkBindingPluginStatus reports the state of a network binding plugin registered in the KubeVirt configuration.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name under which the binding plugin is registered.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"virtualMachineInstances": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtualMachineInstances is the number of active VMIs using the binding plugin.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"prerequisitesSatisfied": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PrerequisitesSatisfied reports whether all the declared prerequisites are satisfied cluster-wide.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"unsatisfiedPrerequisites": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "UnsatisfiedPrerequisites lists the declared prerequisites which are not satisfied.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "virtualMachineInstances", "prerequisitesSatisfied"},
+			},
+		},
 	}
 }
 
@@ -24178,11 +25970,62 @@ func schema_kubevirtio_api_core_v1_NetworkConfiguration(ref common.ReferenceCall
 							},
 						},
 					},
+					"macGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MacGeneration enables the allocation of a MAC address for each VMI interface which does not specify one. By default, the MAC address is derived from the owner VM UID and the network name, and is kept across restarts of the VM.",
+							Ref:         ref("kubevirt.io/api/core/v1.MacGenerationPolicy"),
+						},
+					},
+					"interfaceEvents": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InterfaceEvents configures the delivery of CloudEvents about the VMI interfaces being configured, hotplugged or removed, to let external SDN controllers react to them.",
+							Ref:         ref("kubevirt.io/api/core/v1.InterfaceEventsConfiguration"),
+						},
+					},
+					"promiscuousInterfacesNamespaceLabelSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PromiscuousInterfacesNamespaceLabelSelector selects the namespaces whose VMIs are allowed to declare promiscuous interfaces. When not set, promiscuous interfaces are not allowed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"networkInterfaceMultiqueuePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkInterfaceMultiQueuePolicy is the default queue pairs policy of the VMIs which enable networkInterfaceMultiqueue without specifying their own policy.",
+							Ref:         ref("kubevirt.io/api/core/v1.NetworkQueuesPolicy"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.InterfaceBindingPlugin"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "kubevirt.io/api/core/v1.InterfaceBindingPlugin", "kubevirt.io/api/core/v1.InterfaceEventsConfiguration", "kubevirt.io/api/core/v1.MacGenerationPolicy", "kubevirt.io/api/core/v1.NetworkQueuesPolicy"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_NetworkQueuesPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkQueuesPolicy controls the ratio between the guest vCPUs and the queue pairs of the multi-queue network interfaces.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"vcpusPerQueue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VCPUsPerQueue is the number of guest vCPUs sharing a single queue pair. For example, 2 gives one queue pair for every two vCPUs. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxQueues": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxQueues caps the number of queue pairs of each interface. Defaults to, and cannot exceed, 256.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -24203,11 +26046,17 @@ func schema_kubevirtio_api_core_v1_NetworkSource(ref common.ReferenceCallback) c
 							Ref: ref("kubevirt.io/api/core/v1.MultusNetwork"),
 						},
 					},
+					"native": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Native represents a secondary network provided by the Kubernetes native multi-network API (PodNetwork).",
+							Ref:         ref("kubevirt.io/api/core/v1.NativeNetwork"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.MultusNetwork", "kubevirt.io/api/core/v1.PodNetwork"},
+			"kubevirt.io/api/core/v1.MultusNetwork", "kubevirt.io/api/core/v1.NativeNetwork", "kubevirt.io/api/core/v1.PodNetwork"},
 	}
 }
 
@@ -24566,6 +26415,13 @@ func schema_kubevirtio_api_core_v1_PciHostDevice(ref common.ReferenceCallback) c
 							Format:      "",
 						},
 					},
+					"manageDriverBinding": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If true, virt-handler binds the devices of this resource to vfio-pci when they are allocated to a VMI, and returns them to their host driver once they are released. Devices found bound to vfio-pci while unused are considered released as well.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"pciVendorSelector", "resourceName"},
 			},
@@ -24917,6 +26773,12 @@ func schema_kubevirtio_api_core_v1_Probe(ref common.ReferenceCallback) common.Op
 							Ref:         ref("k8s.io/api/core/v1.ExecAction"),
 						},
 					},
+					"guestAgentExec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestAgentExec runs a command inside the guest through the qemu-guest-agent and interprets its exit code. No network access to the guest is required.",
+							Ref:         ref("kubevirt.io/api/core/v1.GuestAgentExec"),
+						},
+					},
 					"guestAgentPing": {
 						SchemaProps: spec.SchemaProps{
 							Description: "GuestAgentPing contacts the qemu-guest-agent for availability checks.",
@@ -24974,7 +26836,7 @@ func schema_kubevirtio_api_core_v1_Probe(ref common.ReferenceCallback) common.Op
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.ExecAction", "k8s.io/api/core/v1.HTTPGetAction", "k8s.io/api/core/v1.TCPSocketAction", "kubevirt.io/api/core/v1.GuestAgentPing"},
+			"k8s.io/api/core/v1.ExecAction", "k8s.io/api/core/v1.HTTPGetAction", "k8s.io/api/core/v1.TCPSocketAction", "kubevirt.io/api/core/v1.GuestAgentExec", "kubevirt.io/api/core/v1.GuestAgentPing"},
 	}
 }
 
@@ -25500,6 +27362,13 @@ func schema_kubevirtio_api_core_v1_SEVMeasurementInfo(ref common.ReferenceCallba
 							Format:      "",
 						},
 					},
+					"snpPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policy of the SEV-SNP guest.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
 			},
 		},
@@ -25571,6 +27440,71 @@ func schema_kubevirtio_api_core_v1_SEVSNP(ref common.ReferenceCallback) common.O
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"attestation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified, run the attestation process for a vmi.\nThe launch is held until the guest owner provides the ID block and its authentication information.",
+							Ref:         ref("kubevirt.io/api/core/v1.SEVAttestation"),
+						},
+					},
+					"idBlock": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Base64 encoded ID block, holding the expected launch measurement of the guest as signed by the guest owner.\nThe guest is not launched if its measurement does not match.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"idAuth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Base64 encoded ID authentication information, holding the signature of the ID block.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hostData": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Base64 encoded 32 bytes provided by the guest owner, included as is in the attestation reports of the guest.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.SEVAttestation"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_SEVSNPSessionOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SEVSNPSessionOptions is used to provide the SEV-SNP launch parameters of the guest owner.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"idBlock": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Base64 encoded ID block, holding the expected launch measurement of the guest.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"idAuth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Base64 encoded ID authentication information, holding the signature of the ID block.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hostData": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Base64 encoded 32 bytes included as is in the attestation reports of the guest.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
 			},
 		},
 	}
@@ -25880,6 +27814,33 @@ func schema_kubevirtio_api_core_v1_SoundDevice(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_kubevirtio_api_core_v1_SpiceDevice(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Represents the user's configuration of the SPICE graphics device of the VMI.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"audio": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Audio streams the output of the VMI sound device over the SPICE connection. An ich9 sound device is emulated if none is set.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"usbRedirection": {
+						SchemaProps: spec.SchemaProps{
+							Description: "USBRedirection attaches SpiceUSBRedirectionMaxNumberOf USB redirection channels, multiplexed over the SPICE connection.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_StartOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -26024,6 +27985,33 @@ func schema_kubevirtio_api_core_v1_StorageMigratedVolumeInfo(ref common.Referenc
 	}
 }
 
+func schema_kubevirtio_api_core_v1_SubresourceRateLimits(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SubresourceRateLimits holds the token buckets virt-api applies to VM and VMI subresource calls. Interactive subresources, like console and VNC, are never throttled. Migration related subresources are accounted in a dedicated lane, so bulk lifecycle calls cannot starve them.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"perUser": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PerUser limits the subresource calls issued by every single user.",
+							Ref:         ref("kubevirt.io/api/core/v1.TokenBucketRateLimiter"),
+						},
+					},
+					"perNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PerNamespace limits the subresource calls targeting every single namespace.",
+							Ref:         ref("kubevirt.io/api/core/v1.TokenBucketRateLimiter"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.TokenBucketRateLimiter"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_SupportContainerResources(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -26589,6 +28577,47 @@ func schema_kubevirtio_api_core_v1_UtilityVolume(ref common.ReferenceCallback) c
 	}
 }
 
+func schema_kubevirtio_api_core_v1_VCPUAutoscalingConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VCPUAutoscalingConfiguration holds the thresholds a VirtualMachineInstance vCPU load, as reported by virt-handler, must stay above before one more CPU socket is recommended.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cpuUtilizationThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPUUtilizationThreshold is the vCPU utilization, in percent, above which one more socket is recommended. Defaults to 90",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"cpuStealThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPUStealThreshold is the share of time, in percent, the vCPUs wait for a physical CPU, above which one more socket is recommended. Defaults to 20",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"window": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Window is how long the vCPU load must stay above one of the thresholds before one more socket is recommended. Defaults to 10 minutes",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"cooldown": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cooldown is the minimum time between two scale ups of the same VirtualMachine. Defaults to 30 minutes",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_VGPUDisplayOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -26656,6 +28685,39 @@ func schema_kubevirtio_api_core_v1_VMISelector(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_kubevirtio_api_core_v1_VMIStatusUpdatesConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VMIStatusUpdatesConfiguration spaces the writes of the VMI status carrying only frequently changing, informational fields. Such changes are held back by virt-handler and written together once their interval elapsed since the last write of the status. Changes of any other field, like the phase or the conditions, are written right away, along with the held back ones.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"interfacesInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InterfacesInterval is the minimal interval between two writes of the status carrying only changes of the interfaces, like the IP addresses reported by the guest agent. Defaults to 0, which writes every change right away.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"guestAgentInfoInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestAgentInfoInterval is the minimal interval between two writes of the status carrying only changes of the guest OS information reported by the guest agent. Defaults to 0, which writes every change right away.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"migrationProgressInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MigrationProgressInterval is the minimal interval between two writes of the status carrying only changes of the migration progress. Defaults to 0, which writes every change right away.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_VSOCKOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -26701,6 +28763,26 @@ func schema_kubevirtio_api_core_v1_VideoDevice(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_kubevirtio_api_core_v1_VirtControllerShardingConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtControllerShardingConfiguration splits the namespaces into shards by hashing their names. Every shard is reconciled by the single virt-controller instance holding its lease, so that several instances handle the VirtualMachines, VirtualMachineInstances, migrations and replica sets at the same time. Cluster wide loops keep running on the leader only.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"shards": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Shards is the number of namespace shards. It should not exceed the number of virt-controller replicas, since every instance holds at most one shard; the spare instances take over the shards of failed ones. Defaults to 1",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_VirtTemplateDeployment(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -26720,6 +28802,28 @@ func schema_kubevirtio_api_core_v1_VirtTemplateDeployment(ref common.ReferenceCa
 	}
 }
 
+func schema_kubevirtio_api_core_v1_VirtioChannel(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtioChannel represents a virtio-serial port, exposed in the guest as /dev/virtio-ports/io.kubevirt.<name>.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the channel, used to reach it through the channel subresource. Must be a DNS-1123 label and unique within the VMI.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_VirtualMachine(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -26770,6 +28874,49 @@ func schema_kubevirtio_api_core_v1_VirtualMachine(ref common.ReferenceCallback)
 	}
 }
 
+func schema_kubevirtio_api_core_v1_VirtualMachineCPURecommendation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineCPURecommendation holds the recommended number of CPU sockets of a VirtualMachine, and the state used to only recommend more sockets on a sustained vCPU load.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"sockets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sockets is the recommended number of CPU sockets",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason explains why more sockets are recommended",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"overloadedSince": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OverloadedSince is when the vCPU load last went above the thresholds, unset while it is below",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastScaleTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastScaleTime is when CPU sockets were last hotplugged following the recommendation",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"sockets"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_VirtualMachineCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -26952,6 +29099,34 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceBackupStatus(ref common
 	}
 }
 
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceBootPhaseTimestamp(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineInstanceBootPhaseTimestamp gives a timestamp in relation to when a boot phase was reached by a vmi",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the boot phase reached by the VirtualMachineInstance",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timestamp is the time at which the boot phase was reached",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_VirtualMachineInstanceCommonMigrationState(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -26988,93 +29163,192 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceCommonMigrationState(re
 					},
 					"domainNamespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace used in the name of the source libvirt domain. Can be used to find and modify paths in the domain",
+							Description: "Namespace used in the name of the source libvirt domain. Can be used to find and modify paths in the domain",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"syncAddress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The ip address/fqdn:port combination to use to synchronize the VMI with the target.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"persistentStatePVCName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If the VMI being migrated uses persistent features (backend-storage), its source PVC name is saved here",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"selinuxContext": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SELinuxContext is the actual SELinux context of the pod",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"virtualMachineInstanceUID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtualMachineInstanceUID is the UID of the target virtual machine instance",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"lastProbeTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"type", "status"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceDomainJob(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineInstanceDomainJob represents a libvirt job in progress on the domain of a VMI",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of the job, either Domain or Block",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"syncAddress": {
+					"operation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The ip address/fqdn:port combination to use to synchronize the VMI with the target.",
+							Description: "Operation carried out by the job, e.g. MigrationOut, Backup or Copy",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"persistentStatePVCName": {
+					"disk": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If the VMI being migrated uses persistent features (backend-storage), its source PVC name is saved here",
+							Description: "Disk is the target of the disk a Block job runs on",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"selinuxContext": {
+					"processed": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SELinuxContext is the actual SELinux context of the pod",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Processed is the amount of data processed so far, in bytes",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"virtualMachineInstanceUID": {
+					"total": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VirtualMachineInstanceUID is the UID of the target virtual machine instance",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Total is the amount of data to be processed, in bytes",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
 				},
+				Required: []string{"type"},
 			},
 		},
 	}
 }
 
-func schema_kubevirtio_api_core_v1_VirtualMachineInstanceCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceDomainJobList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "VirtualMachineInstanceDomainJobList comprises the libvirt jobs in progress on the domain of a VMI",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"status": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"lastProbeTime": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"lastTransitionTime": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"reason": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"message": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.VirtualMachineInstanceDomainJob"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"type", "status"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/core/v1.VirtualMachineInstanceDomainJob"},
 	}
 }
 
@@ -27308,6 +29582,25 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestAgentInfo(ref comm
 							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSInfo"),
 						},
 					},
+					"drivers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Drivers the guest uses for its devices",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSDriver"),
+									},
+								},
+							},
+						},
+					},
 					"timezone": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Timezone is guest os current timezone",
@@ -27347,7 +29640,41 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestAgentInfo(ref comm
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.GuestAgentCommandInfo", "kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystemInfo", "kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSInfo", "kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSUser"},
+			"kubevirt.io/api/core/v1.GuestAgentCommandInfo", "kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystemInfo", "kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSDriver", "kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSInfo", "kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSUser"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestOSDriver(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineInstanceGuestOSDriver describes a driver the guest uses for one of its devices",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the driver",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version of the driver",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deviceID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PCI vendor and device ID of the device handled by the driver, in the vendor:device format",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -28119,11 +30446,17 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationState(ref comm
 							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 						},
 					},
+					"diskTransferProgress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DiskTransferProgress reports how much of the migrated volumes has been copied to the target",
+							Ref:         ref("kubevirt.io/api/core/v1.MigrationDiskTransferProgress"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/core/v1.MigrationConfiguration", "kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationSourceState", "kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationTargetState"},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/core/v1.MigrationConfiguration", "kubevirt.io/api/core/v1.MigrationDiskTransferProgress", "kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationSourceState", "kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationTargetState"},
 	}
 }
 
@@ -28451,6 +30784,13 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceNetworkInterface(ref co
 							Format:      "",
 						},
 					},
+					"hotplugFailedAttempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HotplugFailedAttempts counts the failed attempts to hot plug the interface into the running domain. The hotplug is rolled back once the attempts are exhausted.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 			},
 		},
@@ -28624,6 +30964,49 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceProfile(ref common.Refe
 	}
 }
 
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceQemuCommandLine(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineInstanceQemuCommandLine is the command line the QEMU process of a VMI was started with. Secrets passed on the command line are redacted.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"args": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Args are the arguments of the QEMU process, starting with the path of its binary",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"args"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_VirtualMachineInstanceReplicaSet(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -29041,6 +31424,18 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceSpec(ref common.Referen
 							Ref:         ref("k8s.io/api/core/v1.PodDNSConfig"),
 						},
 					},
+					"guestDNS": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestDNS overrides the DNS configuration the guest receives over DHCP, independently of the DNS policy and configuration of the virt-launcher pod. Parameters which are not specified are taken from the pod DNS configuration.",
+							Ref:         ref("kubevirt.io/api/core/v1.GuestDNSConfig"),
+						},
+					},
+					"metadataService": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MetadataService exposes the instance identity, the network configuration and user provided data to the guest, over HTTP on the link-local 169.254.169.254 address of the masquerade pod network.",
+							Ref:         ref("kubevirt.io/api/core/v1.MetadataService"),
+						},
+					},
 					"accessCredentials": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -29116,7 +31511,7 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceSpec(ref common.Referen
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.PodResourceClaim", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.TopologySpreadConstraint", "kubevirt.io/api/core/v1.AccessCredential", "kubevirt.io/api/core/v1.DomainSpec", "kubevirt.io/api/core/v1.Network", "kubevirt.io/api/core/v1.Probe", "kubevirt.io/api/core/v1.UtilityVolume", "kubevirt.io/api/core/v1.Volume"},
+			"k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.PodResourceClaim", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.TopologySpreadConstraint", "kubevirt.io/api/core/v1.AccessCredential", "kubevirt.io/api/core/v1.DomainSpec", "kubevirt.io/api/core/v1.GuestDNSConfig", "kubevirt.io/api/core/v1.MetadataService", "kubevirt.io/api/core/v1.Network", "kubevirt.io/api/core/v1.Probe", "kubevirt.io/api/core/v1.UtilityVolume", "kubevirt.io/api/core/v1.Volume"},
 	}
 }
 
@@ -29181,6 +31576,25 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceStatus(ref common.Refer
 							},
 						},
 					},
+					"bootPhaseTimestamps": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "BootPhaseTimestamps records when the VirtualMachineInstance reached each of the steps of its start, allowing to tell which of them delayed it",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.VirtualMachineInstanceBootPhaseTimestamp"),
+									},
+								},
+							},
+						},
+					},
 					"interfaces": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Interfaces represent the details of available network interfaces.",
@@ -29202,6 +31616,25 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceStatus(ref common.Refer
 							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSInfo"),
 						},
 					},
+					"guestDrivers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestDrivers are the drivers the guest uses for its devices, as reported by the guest agent",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSDriver"),
+									},
+								},
+							},
+						},
+					},
 					"migrationState": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Represents the status of a live migration",
@@ -29279,6 +31712,25 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceStatus(ref common.Refer
 							},
 						},
 					},
+					"hostDeviceStatuses": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "HostDeviceStatuses contains the statuses of the host devices assigned to the VirtualMachineInstance",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.HostDeviceStatus"),
+									},
+								},
+							},
+						},
+					},
 					"kernelBootStatus": {
 						SchemaProps: spec.SchemaProps{
 							Description: "KernelBootStatus contains info about the kernelBootContainer",
@@ -29373,7 +31825,7 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceStatus(ref common.Refer
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.CPUTopology", "kubevirt.io/api/core/v1.ChangedBlockTrackingStatus", "kubevirt.io/api/core/v1.KernelBootStatus", "kubevirt.io/api/core/v1.Machine", "kubevirt.io/api/core/v1.MemoryStatus", "kubevirt.io/api/core/v1.StorageMigratedVolumeInfo", "kubevirt.io/api/core/v1.TopologyHints", "kubevirt.io/api/core/v1.VirtualMachineInstanceCondition", "kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSInfo", "kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationState", "kubevirt.io/api/core/v1.VirtualMachineInstanceNetworkInterface", "kubevirt.io/api/core/v1.VirtualMachineInstancePhaseTransitionTimestamp", "kubevirt.io/api/core/v1.VolumeStatus"},
+			"kubevirt.io/api/core/v1.CPUTopology", "kubevirt.io/api/core/v1.ChangedBlockTrackingStatus", "kubevirt.io/api/core/v1.HostDeviceStatus", "kubevirt.io/api/core/v1.KernelBootStatus", "kubevirt.io/api/core/v1.Machine", "kubevirt.io/api/core/v1.MemoryStatus", "kubevirt.io/api/core/v1.StorageMigratedVolumeInfo", "kubevirt.io/api/core/v1.TopologyHints", "kubevirt.io/api/core/v1.VirtualMachineInstanceBootPhaseTimestamp", "kubevirt.io/api/core/v1.VirtualMachineInstanceCondition", "kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSDriver", "kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSInfo", "kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationState", "kubevirt.io/api/core/v1.VirtualMachineInstanceNetworkInterface", "kubevirt.io/api/core/v1.VirtualMachineInstancePhaseTransitionTimestamp", "kubevirt.io/api/core/v1.VolumeStatus"},
 	}
 }
 
@@ -29604,12 +32056,18 @@ func schema_kubevirtio_api_core_v1_VirtualMachineSpec(ref common.ReferenceCallba
 							Format:      "",
 						},
 					},
+					"cpuAutoscaling": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPUAutoscaling opts the VirtualMachine in to the vertical scaling of its CPU sockets, based on the vCPU utilization and steal time of its running VirtualMachineInstance.",
+							Ref:         ref("kubevirt.io/api/core/v1.CPUAutoscaling"),
+						},
+					},
 				},
 				Required: []string{"template"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.DataVolumeTemplateSpec", "kubevirt.io/api/core/v1.InstancetypeMatcher", "kubevirt.io/api/core/v1.PreferenceMatcher", "kubevirt.io/api/core/v1.VirtualMachineInstanceTemplateSpec"},
+			"kubevirt.io/api/core/v1.CPUAutoscaling", "kubevirt.io/api/core/v1.DataVolumeTemplateSpec", "kubevirt.io/api/core/v1.InstancetypeMatcher", "kubevirt.io/api/core/v1.PreferenceMatcher", "kubevirt.io/api/core/v1.VirtualMachineInstanceTemplateSpec"},
 	}
 }
 
@@ -29849,11 +32307,42 @@ func schema_kubevirtio_api_core_v1_VirtualMachineStatus(ref common.ReferenceCall
 							Ref:         ref("kubevirt.io/api/core/v1.InstancetypeStatusRef"),
 						},
 					},
+					"guestOSInfo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestOSInfo is the last guest OS information reported by the guest agent of the VirtualMachineInstance. It is kept while the VirtualMachine is stopped.",
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSInfo"),
+						},
+					},
+					"guestDrivers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestDrivers are the last drivers reported by the guest agent of the VirtualMachineInstance. They are kept while the VirtualMachine is stopped.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSDriver"),
+									},
+								},
+							},
+						},
+					},
+					"cpuRecommendation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPURecommendation is the CPU sockets recommendation for the running VirtualMachineInstance, reported when the VirtualMachine opts in to CPU autoscaling.",
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineCPURecommendation"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.ChangedBlockTrackingStatus", "kubevirt.io/api/core/v1.InstancetypeStatusRef", "kubevirt.io/api/core/v1.VirtualMachineCondition", "kubevirt.io/api/core/v1.VirtualMachineMemoryDumpRequest", "kubevirt.io/api/core/v1.VirtualMachineStartFailure", "kubevirt.io/api/core/v1.VirtualMachineStateChangeRequest", "kubevirt.io/api/core/v1.VirtualMachineVolumeRequest", "kubevirt.io/api/core/v1.VolumeSnapshotStatus", "kubevirt.io/api/core/v1.VolumeUpdateState"},
+			"kubevirt.io/api/core/v1.ChangedBlockTrackingStatus", "kubevirt.io/api/core/v1.InstancetypeStatusRef", "kubevirt.io/api/core/v1.VirtualMachineCPURecommendation", "kubevirt.io/api/core/v1.VirtualMachineCondition", "kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSDriver", "kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSInfo", "kubevirt.io/api/core/v1.VirtualMachineMemoryDumpRequest", "kubevirt.io/api/core/v1.VirtualMachineStartFailure", "kubevirt.io/api/core/v1.VirtualMachineStateChangeRequest", "kubevirt.io/api/core/v1.VirtualMachineVolumeRequest", "kubevirt.io/api/core/v1.VolumeSnapshotStatus", "kubevirt.io/api/core/v1.VolumeUpdateState"},
 	}
 }
 
@@ -31206,6 +33695,13 @@ func schema_kubevirtio_api_export_v1beta1_VirtualMachineExportSpec(ref common.Re
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
+					"nbd": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NBD additionally exposes the raw volumes over the Network Block Device protocol, for block-level consumers doing sparse-aware, random-access reads. The NBD endpoint is only reachable from within the cluster, requires TLS, and authenticates clients through the export name, formatted as <volume>/<token>.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"source"},
 			},
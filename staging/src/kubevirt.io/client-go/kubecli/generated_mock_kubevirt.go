@@ -11,6 +11,7 @@ package kubecli
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 	time "time"
 
@@ -1477,6 +1478,35 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) Backup(ctx, name, bac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Backup", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).Backup), ctx, name, backupOptions)
 }
 
+// CancelDomainJob mocks base method.
+func (m *MockVirtualMachineInstanceInterface) CancelDomainJob(ctx context.Context, name string, cancelDomainJobOptions *v122.CancelDomainJobOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelDomainJob", ctx, name, cancelDomainJobOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelDomainJob indicates an expected call of CancelDomainJob.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) CancelDomainJob(ctx, name, cancelDomainJobOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDomainJob", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).CancelDomainJob), ctx, name, cancelDomainJobOptions)
+}
+
+// Channel mocks base method.
+func (m *MockVirtualMachineInstanceInterface) Channel(name, channel string) (v123.StreamInterface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Channel", name, channel)
+	ret0, _ := ret[0].(v123.StreamInterface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Channel indicates an expected call of Channel.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) Channel(name, channel any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Channel", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).Channel), name, channel)
+}
+
 // Create mocks base method.
 func (m *MockVirtualMachineInstanceInterface) Create(ctx context.Context, virtualMachineInstance *v122.VirtualMachineInstance, opts v12.CreateOptions) (*v122.VirtualMachineInstance, error) {
 	m.ctrl.T.Helper()
@@ -1492,6 +1522,21 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) Create(ctx, virtualMa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).Create), ctx, virtualMachineInstance, opts)
 }
 
+// DebugAttach mocks base method.
+func (m *MockVirtualMachineInstanceInterface) DebugAttach(ctx context.Context, name string, debugAttachOptions *v122.DebugAttachOptions) (v122.DebugAttachResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DebugAttach", ctx, name, debugAttachOptions)
+	ret0, _ := ret[0].(v122.DebugAttachResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DebugAttach indicates an expected call of DebugAttach.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) DebugAttach(ctx, name, debugAttachOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DebugAttach", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).DebugAttach), ctx, name, debugAttachOptions)
+}
+
 // Delete mocks base method.
 func (m *MockVirtualMachineInstanceInterface) Delete(ctx context.Context, name string, opts v12.DeleteOptions) error {
 	m.ctrl.T.Helper()
@@ -1520,6 +1565,21 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) DeleteCollection(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCollection", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).DeleteCollection), ctx, opts, listOpts)
 }
 
+// DomainJobs mocks base method.
+func (m *MockVirtualMachineInstanceInterface) DomainJobs(ctx context.Context, name string) (v122.VirtualMachineInstanceDomainJobList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DomainJobs", ctx, name)
+	ret0, _ := ret[0].(v122.VirtualMachineInstanceDomainJobList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DomainJobs indicates an expected call of DomainJobs.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) DomainJobs(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainJobs", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).DomainJobs), ctx, name)
+}
+
 // EvacuateCancel mocks base method.
 func (m *MockVirtualMachineInstanceInterface) EvacuateCancel(ctx context.Context, name string, evacuateCancelOptions *v122.EvacuateCancelOptions) error {
 	m.ctrl.T.Helper()
@@ -1608,6 +1668,49 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) List(ctx, opts any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).List), ctx, opts)
 }
 
+// MemoryDump mocks base method.
+func (m *MockVirtualMachineInstanceInterface) MemoryDump(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MemoryDump", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MemoryDump indicates an expected call of MemoryDump.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) MemoryDump(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MemoryDump", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).MemoryDump), ctx, name)
+}
+
+// MemoryDumpStream mocks base method.
+func (m *MockVirtualMachineInstanceInterface) MemoryDumpStream(ctx context.Context, name string, offset int64) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MemoryDumpStream", ctx, name, offset)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MemoryDumpStream indicates an expected call of MemoryDumpStream.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) MemoryDumpStream(ctx, name, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MemoryDumpStream", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).MemoryDumpStream), ctx, name, offset)
+}
+
+// NetworkResync mocks base method.
+func (m *MockVirtualMachineInstanceInterface) NetworkResync(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NetworkResync", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NetworkResync indicates an expected call of NetworkResync.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) NetworkResync(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NetworkResync", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).NetworkResync), ctx, name)
+}
+
 // ObjectGraph mocks base method.
 func (m *MockVirtualMachineInstanceInterface) ObjectGraph(ctx context.Context, name string, objectGraphOptions *v122.ObjectGraphOptions) (v122.ObjectGraphNode, error) {
 	m.ctrl.T.Helper()
@@ -1672,6 +1775,21 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) PortForward(name, por
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PortForward", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).PortForward), name, port, protocol)
 }
 
+// QemuCommandLine mocks base method.
+func (m *MockVirtualMachineInstanceInterface) QemuCommandLine(ctx context.Context, name string) (v122.VirtualMachineInstanceQemuCommandLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QemuCommandLine", ctx, name)
+	ret0, _ := ret[0].(v122.VirtualMachineInstanceQemuCommandLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QemuCommandLine indicates an expected call of QemuCommandLine.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) QemuCommandLine(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QemuCommandLine", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).QemuCommandLine), ctx, name)
+}
+
 // RedefineCheckpoint mocks base method.
 func (m *MockVirtualMachineInstanceInterface) RedefineCheckpoint(ctx context.Context, name string, checkpoint *v1alpha18.BackupCheckpoint) error {
 	m.ctrl.T.Helper()
@@ -1686,6 +1804,20 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) RedefineCheckpoint(ct
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedefineCheckpoint", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).RedefineCheckpoint), ctx, name, checkpoint)
 }
 
+// RemoveMemoryDump mocks base method.
+func (m *MockVirtualMachineInstanceInterface) RemoveMemoryDump(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMemoryDump", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMemoryDump indicates an expected call of RemoveMemoryDump.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) RemoveMemoryDump(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMemoryDump", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).RemoveMemoryDump), ctx, name)
+}
+
 // RemoveVolume mocks base method.
 func (m *MockVirtualMachineInstanceInterface) RemoveVolume(ctx context.Context, name string, removeVolumeOptions *v122.RemoveVolumeOptions) error {
 	m.ctrl.T.Helper()
@@ -1758,6 +1890,20 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) SEVQueryLaunchMeasure
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SEVQueryLaunchMeasurement", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).SEVQueryLaunchMeasurement), ctx, name)
 }
 
+// SEVSetupSNPSession mocks base method.
+func (m *MockVirtualMachineInstanceInterface) SEVSetupSNPSession(ctx context.Context, name string, sevSNPSessionOptions *v122.SEVSNPSessionOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SEVSetupSNPSession", ctx, name, sevSNPSessionOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SEVSetupSNPSession indicates an expected call of SEVSetupSNPSession.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) SEVSetupSNPSession(ctx, name, sevSNPSessionOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SEVSetupSNPSession", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).SEVSetupSNPSession), ctx, name, sevSNPSessionOptions)
+}
+
 // SEVSetupSession mocks base method.
 func (m *MockVirtualMachineInstanceInterface) SEVSetupSession(ctx context.Context, name string, sevSessionOptions *v122.SEVSessionOptions) error {
 	m.ctrl.T.Helper()
@@ -1816,6 +1962,21 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) SoftReboot(ctx, name
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftReboot", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).SoftReboot), ctx, name)
 }
 
+// Spice mocks base method.
+func (m *MockVirtualMachineInstanceInterface) Spice(name string) (v123.StreamInterface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Spice", name)
+	ret0, _ := ret[0].(v123.StreamInterface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Spice indicates an expected call of Spice.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) Spice(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Spice", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).Spice), name)
+}
+
 // USBRedir mocks base method.
 func (m *MockVirtualMachineInstanceInterface) USBRedir(vmiName string) (v123.StreamInterface, error) {
 	m.ctrl.T.Helper()
@@ -2326,6 +2487,35 @@ func (mr *MockVirtualMachineInterfaceMockRecorder) AddVolume(ctx, name, addVolum
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVolume", reflect.TypeOf((*MockVirtualMachineInterface)(nil).AddVolume), ctx, name, addVolumeOptions)
 }
 
+// Batch mocks base method.
+func (m *MockVirtualMachineInterface) Batch(ctx context.Context, operation string, batchOptions *v122.BatchOperationOptions) (*v122.BatchOperationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Batch", ctx, operation, batchOptions)
+	ret0, _ := ret[0].(*v122.BatchOperationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Batch indicates an expected call of Batch.
+func (mr *MockVirtualMachineInterfaceMockRecorder) Batch(ctx, operation, batchOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Batch", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Batch), ctx, operation, batchOptions)
+}
+
+// ChangeMedia mocks base method.
+func (m *MockVirtualMachineInterface) ChangeMedia(ctx context.Context, name string, changeMediaOptions *v122.ChangeMediaOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeMedia", ctx, name, changeMediaOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeMedia indicates an expected call of ChangeMedia.
+func (mr *MockVirtualMachineInterfaceMockRecorder) ChangeMedia(ctx, name, changeMediaOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeMedia", reflect.TypeOf((*MockVirtualMachineInterface)(nil).ChangeMedia), ctx, name, changeMediaOptions)
+}
+
 // Create mocks base method.
 func (m *MockVirtualMachineInterface) Create(ctx context.Context, virtualMachine *v122.VirtualMachine, opts v12.CreateOptions) (*v122.VirtualMachine, error) {
 	m.ctrl.T.Helper()
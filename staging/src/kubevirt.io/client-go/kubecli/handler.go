@@ -22,7 +22,9 @@ import (
 const (
 	consoleTemplateURI            = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/console"
 	usbredirTemplateURI           = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/usbredir"
+	channelTemplateURI            = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/channel/%s"
 	vncTemplateURI                = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/vnc"
+	spiceTemplateURI              = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/spice"
 	vsockTemplateURI              = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/vsock"
 	pauseTemplateURI              = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/pause"
 	unpauseTemplateURI            = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/unpause"
@@ -32,10 +34,15 @@ const (
 	unfreezeTemplateURI           = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/unfreeze"
 	resetTemplateURI              = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/reset"
 	softRebootTemplateURI         = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/softreboot"
+	networkResyncTemplateURI      = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/networkresync"
 	guestInfoTemplateURI          = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/guestosinfo"
 	userListTemplateURI           = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/userlist"
 	filesystemListTemplateURI     = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/filesystemlist"
+	domainJobsTemplateURI         = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/domainjobs"
+	cancelDomainJobTemplateURI    = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/canceldomainjob"
+	qemuCommandLineTemplateURI    = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/qemucommandline"
 	screenshotTemplateURI         = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/vnc/screenshot"
+	memoryDumpTemplateURI         = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/memorydump"
 
 	sevFetchCertChainTemplateURI         = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/sev/fetchcertchain"
 	sevQueryLaunchMeasurementTemplateURI = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/sev/querylaunchmeasurement"
@@ -61,8 +68,11 @@ type VirtHandlerConn interface {
 	ConnectionDetails() (ip string, port int, err error)
 	ConsoleURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	USBRedirURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	ChannelURI(vmi *virtv1.VirtualMachineInstance, channel string) (string, error)
 	VNCURI(vmi *virtv1.VirtualMachineInstance, preserveSession bool) (string, error)
+	SpiceURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	ScreenshotURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	MemoryDumpURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	VSOCKURI(vmi *virtv1.VirtualMachineInstance, port string, tls string) (string, error)
 	PauseURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	UnpauseURI(vmi *virtv1.VirtualMachineInstance) (string, error)
@@ -70,17 +80,22 @@ type VirtHandlerConn interface {
 	UnfreezeURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	ResetURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	SoftRebootURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	NetworkResyncURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	SEVFetchCertChainURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	SEVQueryLaunchMeasurementURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	SEVInjectLaunchSecretURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	Pod() (pod *v1.Pod, err error)
 	Put(url string, body io.ReadCloser) error
 	Get(url, contentType string) (string, error)
+	Do(req *http.Request) (*http.Response, error)
 	GuestInfoURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	UserListURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	FilesystemListURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	BackupURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	RedefineCheckpointURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	DomainJobsURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	CancelDomainJobURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	QemuCommandLineURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 }
 
 type virtHandler struct {
@@ -191,6 +206,15 @@ func (v *virtHandlerConn) USBRedirURI(vmi *virtv1.VirtualMachineInstance) (strin
 	return v.formatURI(usbredirTemplateURI, vmi)
 }
 
+func (v *virtHandlerConn) ChannelURI(vmi *virtv1.VirtualMachineInstance, channel string) (string, error) {
+	ip, port, err := v.ConnectionDetails()
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf(channelTemplateURI, formatIpForUri(ip), port, vmi.ObjectMeta.Namespace, vmi.ObjectMeta.Name, url.PathEscape(channel)), nil
+}
+
 func (v *virtHandlerConn) VNCURI(vmi *virtv1.VirtualMachineInstance, preserveSession bool) (string, error) {
 	baseURI, err := v.formatURI(vncTemplateURI, vmi)
 	if err != nil {
@@ -206,10 +230,18 @@ func (v *virtHandlerConn) VNCURI(vmi *virtv1.VirtualMachineInstance, preserveSes
 	return u.String(), nil
 }
 
+func (v *virtHandlerConn) SpiceURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(spiceTemplateURI, vmi)
+}
+
 func (v *virtHandlerConn) ScreenshotURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
 	return v.formatURI(screenshotTemplateURI, vmi)
 }
 
+func (v *virtHandlerConn) MemoryDumpURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(memoryDumpTemplateURI, vmi)
+}
+
 func (v *virtHandlerConn) VSOCKURI(vmi *virtv1.VirtualMachineInstance, port string, tls string) (string, error) {
 	baseURI, err := v.formatURI(vsockTemplateURI, vmi)
 	if err != nil {
@@ -242,6 +274,10 @@ func (v *virtHandlerConn) SoftRebootURI(vmi *virtv1.VirtualMachineInstance) (str
 	return v.formatURI(softRebootTemplateURI, vmi)
 }
 
+func (v *virtHandlerConn) NetworkResyncURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(networkResyncTemplateURI, vmi)
+}
+
 func (v *virtHandlerConn) PauseURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
 	return v.formatURI(pauseTemplateURI, vmi)
 }
@@ -312,6 +348,12 @@ func (v *virtHandlerConn) Get(url, contentType string) (string, error) {
 	return response, nil
 }
 
+// Do sends the request to virt-handler and returns the response without
+// interpreting it, so that the caller can stream the response body.
+func (v *virtHandlerConn) Do(req *http.Request) (*http.Response, error) {
+	return v.httpClient.Do(req)
+}
+
 func (v *virtHandlerConn) GuestInfoURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
 	return v.formatURI(guestInfoTemplateURI, vmi)
 }
@@ -331,6 +373,18 @@ func (v *virtHandlerConn) FilesystemListURI(vmi *virtv1.VirtualMachineInstance)
 	return v.formatURI(filesystemListTemplateURI, vmi)
 }
 
+func (v *virtHandlerConn) DomainJobsURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(domainJobsTemplateURI, vmi)
+}
+
+func (v *virtHandlerConn) CancelDomainJobURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(cancelDomainJobTemplateURI, vmi)
+}
+
+func (v *virtHandlerConn) QemuCommandLineURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(qemuCommandLineTemplateURI, vmi)
+}
+
 func (v *virtHandlerConn) SEVFetchCertChainURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
 	return v.formatURI(sevFetchCertChainTemplateURI, vmi)
 }
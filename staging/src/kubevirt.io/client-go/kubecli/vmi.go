@@ -63,12 +63,20 @@ func (v *vmis) USBRedir(name string) (kvcorev1.StreamInterface, error) {
 	return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "usbredir", url.Values{})
 }
 
+func (v *vmis) Channel(name, channel string) (kvcorev1.StreamInterface, error) {
+	return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "channel/"+url.PathEscape(channel), url.Values{})
+}
+
 func (v *vmis) VNC(name string, preserveSession bool) (kvcorev1.StreamInterface, error) {
 	queryParams := url.Values{}
 	queryParams.Add("preserveSession", strconv.FormatBool(preserveSession))
 	return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "vnc", queryParams)
 }
 
+func (v *vmis) Spice(name string) (kvcorev1.StreamInterface, error) {
+	return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "spice", url.Values{})
+}
+
 func (v *vmis) PortForward(name string, port int, protocol string) (kvcorev1.StreamInterface, error) {
 	return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, buildPortForwardResourcePath(port, protocol), url.Values{})
 }
@@ -101,6 +101,13 @@ func (c *fakeVirtualMachines) RemoveVolume(ctx context.Context, name string, rem
 	return err
 }
 
+func (c *fakeVirtualMachines) ChangeMedia(ctx context.Context, name string, changeMediaOptions *v1.ChangeMediaOptions) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "changemedia", name, changeMediaOptions), nil)
+
+	return err
+}
+
 func (c *fakeVirtualMachines) PortForward(name string, port int, protocol string) (kubevirtv1.StreamInterface, error) {
 	return nil, nil
 }
@@ -118,3 +125,13 @@ func (c *fakeVirtualMachines) EvacuateCancel(ctx context.Context, name string, e
 
 	return err
 }
+
+func (c *fakeVirtualMachines) Batch(ctx context.Context, operation string, batchOptions *v1.BatchOperationOptions) (*v1.BatchOperationResult, error) {
+	obj, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "batch/"+operation, "", batchOptions), &v1.BatchOperationResult{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.BatchOperationResult), err
+}
@@ -21,6 +21,7 @@ package fake
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"k8s.io/client-go/testing"
@@ -39,10 +40,18 @@ func (c *fakeVirtualMachineInstances) USBRedir(vmiName string) (kvcorev1.StreamI
 	return nil, nil
 }
 
+func (c *fakeVirtualMachineInstances) Channel(name, channel string) (kvcorev1.StreamInterface, error) {
+	return nil, nil
+}
+
 func (c *fakeVirtualMachineInstances) VNC(name string, preserveSession bool) (kvcorev1.StreamInterface, error) {
 	return nil, nil
 }
 
+func (c *fakeVirtualMachineInstances) Spice(name string) (kvcorev1.StreamInterface, error) {
+	return nil, nil
+}
+
 func (c *fakeVirtualMachineInstances) Screenshot(ctx context.Context, name string, options *v1.ScreenshotOptions) ([]byte, error) {
 	return nil, nil
 }
@@ -79,6 +88,24 @@ func (c *fakeVirtualMachineInstances) Unfreeze(ctx context.Context, name string)
 	return err
 }
 
+func (c *fakeVirtualMachineInstances) MemoryDump(ctx context.Context, name string) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "memorydump", name, struct{}{}), nil)
+
+	return err
+}
+
+func (c *fakeVirtualMachineInstances) MemoryDumpStream(ctx context.Context, name string, offset int64) (io.ReadCloser, error) {
+	return nil, nil
+}
+
+func (c *fakeVirtualMachineInstances) RemoveMemoryDump(ctx context.Context, name string) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteSubresourceAction(c.Resource(), "memorydump", c.Namespace(), name), nil)
+
+	return err
+}
+
 func (c *fakeVirtualMachineInstances) Reset(ctx context.Context, name string) error {
 	_, err := c.Fake.
 		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "reset", name, struct{}{}), nil)
@@ -93,6 +120,13 @@ func (c *fakeVirtualMachineInstances) SoftReboot(ctx context.Context, name strin
 	return err
 }
 
+func (c *fakeVirtualMachineInstances) NetworkResync(ctx context.Context, name string) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "networkresync", name, struct{}{}), nil)
+
+	return err
+}
+
 func (c *fakeVirtualMachineInstances) GuestOsInfo(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestAgentInfo, error) {
 	_, err := c.Fake.
 		Invokes(testing.NewGetSubresourceAction(c.Resource(), c.Namespace(), "guestosinfo", name), &v1.VirtualMachineInstanceGuestAgentInfo{})
@@ -154,6 +188,13 @@ func (c *fakeVirtualMachineInstances) SEVSetupSession(ctx context.Context, name
 	return err
 }
 
+func (c *fakeVirtualMachineInstances) SEVSetupSNPSession(ctx context.Context, name string, sevSNPSessionOptions *v1.SEVSNPSessionOptions) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "sev/setupsnpsession", name, sevSNPSessionOptions), nil)
+
+	return err
+}
+
 func (c *fakeVirtualMachineInstances) SEVInjectLaunchSecret(ctx context.Context, name string, sevSecretOptions *v1.SEVSecretOptions) error {
 	_, err := c.Fake.
 		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "sev/injectlaunchsecret", name, sevSecretOptions), nil)
@@ -175,6 +216,34 @@ func (c *fakeVirtualMachineInstances) EvacuateCancel(ctx context.Context, name s
 	return err
 }
 
+func (c *fakeVirtualMachineInstances) DebugAttach(ctx context.Context, name string, debugAttachOptions *v1.DebugAttachOptions) (v1.DebugAttachResult, error) {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "debugattach", name, debugAttachOptions), nil)
+
+	return v1.DebugAttachResult{}, err
+}
+
+func (c *fakeVirtualMachineInstances) DomainJobs(ctx context.Context, name string) (v1.VirtualMachineInstanceDomainJobList, error) {
+	_, err := c.Fake.
+		Invokes(testing.NewGetSubresourceAction(c.Resource(), c.Namespace(), "domainjobs", name), &v1.VirtualMachineInstanceDomainJobList{})
+
+	return v1.VirtualMachineInstanceDomainJobList{}, err
+}
+
+func (c *fakeVirtualMachineInstances) CancelDomainJob(ctx context.Context, name string, cancelDomainJobOptions *v1.CancelDomainJobOptions) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "canceldomainjob", name, cancelDomainJobOptions), nil)
+
+	return err
+}
+
+func (c *fakeVirtualMachineInstances) QemuCommandLine(ctx context.Context, name string) (v1.VirtualMachineInstanceQemuCommandLine, error) {
+	_, err := c.Fake.
+		Invokes(testing.NewGetSubresourceAction(c.Resource(), c.Namespace(), "qemucommandline", name), &v1.VirtualMachineInstanceQemuCommandLine{})
+
+	return v1.VirtualMachineInstanceQemuCommandLine{}, err
+}
+
 func (c *fakeVirtualMachineInstances) Backup(ctx context.Context, name string, backupOptions *backupv1.BackupOptions) error {
 	_, err := c.Fake.
 		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "backup", name, backupOptions), nil)
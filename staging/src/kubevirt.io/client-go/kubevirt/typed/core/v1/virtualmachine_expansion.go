@@ -43,11 +43,13 @@ type VirtualMachineExpansion interface {
 	Migrate(ctx context.Context, name string, migrateOptions *v1.MigrateOptions) error
 	AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error
 	RemoveVolume(ctx context.Context, name string, removeVolumeOptions *v1.RemoveVolumeOptions) error
+	ChangeMedia(ctx context.Context, name string, changeMediaOptions *v1.ChangeMediaOptions) error
 	PortForward(name string, port int, protocol string) (StreamInterface, error)
 	MemoryDump(ctx context.Context, name string, memoryDumpRequest *v1.VirtualMachineMemoryDumpRequest) error
 	RemoveMemoryDump(ctx context.Context, name string) error
 	ObjectGraph(ctx context.Context, name string, objectGraphOptions *v1.ObjectGraphOptions) (v1.ObjectGraphNode, error)
 	EvacuateCancel(ctx context.Context, name string, evacuateCancelOptions *v1.EvacuateCancelOptions) error
+	Batch(ctx context.Context, operation string, batchOptions *v1.BatchOperationOptions) (*v1.BatchOperationResult, error)
 }
 
 func (c *virtualMachines) GetWithExpandedSpec(ctx context.Context, name string) (*v1.VirtualMachine, error) {
@@ -166,6 +168,23 @@ func (c *virtualMachines) RemoveVolume(ctx context.Context, name string, removeV
 		Error()
 }
 
+func (c *virtualMachines) ChangeMedia(ctx context.Context, name string, changeMediaOptions *v1.ChangeMediaOptions) error {
+	body, err := json.Marshal(changeMediaOptions)
+	if err != nil {
+		return err
+	}
+
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmSubresourceURLFmt, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachines").
+		Name(name).
+		SubResource("changemedia").
+		Body(body).
+		Do(ctx).
+		Error()
+}
+
 func (c *virtualMachines) PortForward(name string, port int, protocol string) (StreamInterface, error) {
 	// TODO not implemented yet
 	//  requires clientConfig
@@ -237,3 +256,24 @@ func (c *virtualMachines) EvacuateCancel(ctx context.Context, name string, evacu
 		Do(ctx).
 		Error()
 }
+
+// Batch applies the lifecycle operation to all VirtualMachines of the namespace
+// matching the label selector of the options.
+func (c *virtualMachines) Batch(ctx context.Context, operation string, batchOptions *v1.BatchOperationOptions) (*v1.BatchOperationResult, error) {
+	body, err := json.Marshal(batchOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &v1.BatchOperationResult{}
+	err = c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmSubresourceURLFmt, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("batch").
+		Name(operation).
+		Body(body).
+		Do(ctx).
+		Into(result)
+
+	return result, err
+}
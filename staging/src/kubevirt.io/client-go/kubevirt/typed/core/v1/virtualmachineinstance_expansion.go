@@ -23,6 +23,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -41,7 +42,9 @@ type SerialConsoleOptions struct {
 type VirtualMachineInstanceExpansion interface {
 	SerialConsole(name string, options *SerialConsoleOptions) (StreamInterface, error)
 	USBRedir(vmiName string) (StreamInterface, error)
+	Channel(name, channel string) (StreamInterface, error)
 	VNC(name string, preserveSession bool) (StreamInterface, error)
+	Spice(name string) (StreamInterface, error)
 	Screenshot(ctx context.Context, name string, options *v1.ScreenshotOptions) ([]byte, error)
 	PortForward(name string, port int, protocol string) (StreamInterface, error)
 	Backup(ctx context.Context, name string, backupOptions *backupv1.BackupOptions) error
@@ -52,6 +55,7 @@ type VirtualMachineInstanceExpansion interface {
 	Unfreeze(ctx context.Context, name string) error
 	Reset(ctx context.Context, name string) error
 	SoftReboot(ctx context.Context, name string) error
+	NetworkResync(ctx context.Context, name string) error
 	GuestOsInfo(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestAgentInfo, error)
 	UserList(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestOSUserList, error)
 	FilesystemList(ctx context.Context, name string) (v1.VirtualMachineInstanceFileSystemList, error)
@@ -62,8 +66,13 @@ type VirtualMachineInstanceExpansion interface {
 	SEVFetchCertChain(ctx context.Context, name string) (v1.SEVPlatformInfo, error)
 	SEVQueryLaunchMeasurement(ctx context.Context, name string) (v1.SEVMeasurementInfo, error)
 	SEVSetupSession(ctx context.Context, name string, sevSessionOptions *v1.SEVSessionOptions) error
+	SEVSetupSNPSession(ctx context.Context, name string, sevSNPSessionOptions *v1.SEVSNPSessionOptions) error
 	SEVInjectLaunchSecret(ctx context.Context, name string, sevSecretOptions *v1.SEVSecretOptions) error
 	EvacuateCancel(ctx context.Context, name string, evacuateCancelOptions *v1.EvacuateCancelOptions) error
+	DebugAttach(ctx context.Context, name string, debugAttachOptions *v1.DebugAttachOptions) (v1.DebugAttachResult, error)
+	DomainJobs(ctx context.Context, name string) (v1.VirtualMachineInstanceDomainJobList, error)
+	CancelDomainJob(ctx context.Context, name string, cancelDomainJobOptions *v1.CancelDomainJobOptions) error
+	QemuCommandLine(ctx context.Context, name string) (v1.VirtualMachineInstanceQemuCommandLine, error)
 }
 
 func (c *virtualMachineInstances) SerialConsole(name string, options *SerialConsoleOptions) (StreamInterface, error) {
@@ -78,12 +87,24 @@ func (c *virtualMachineInstances) USBRedir(vmiName string) (StreamInterface, err
 	return nil, fmt.Errorf("USBRedir is not implemented yet in generated client")
 }
 
+func (c *virtualMachineInstances) Channel(name, channel string) (StreamInterface, error) {
+	// TODO not implemented yet
+	//  requires clientConfig
+	return nil, fmt.Errorf("Channel is not implemented yet in generated client")
+}
+
 func (c *virtualMachineInstances) VNC(name string, preserveSession bool) (StreamInterface, error) {
 	// TODO not implemented yet
 	//  requires clientConfig
 	return nil, fmt.Errorf("VNC is not implemented yet in generated client")
 }
 
+func (c *virtualMachineInstances) Spice(name string) (StreamInterface, error) {
+	// TODO not implemented yet
+	//  requires clientConfig
+	return nil, fmt.Errorf("Spice is not implemented yet in generated client")
+}
+
 func (c *virtualMachineInstances) Screenshot(ctx context.Context, name string, options *v1.ScreenshotOptions) ([]byte, error) {
 	moveCursor := "false"
 	if options.MoveCursor == true {
@@ -218,6 +239,46 @@ func (c *virtualMachineInstances) Unfreeze(ctx context.Context, name string) err
 		Error()
 }
 
+// MemoryDump dumps the memory of the VMI into the ephemeral storage of its pod
+func (c *virtualMachineInstances) MemoryDump(ctx context.Context, name string) error {
+	log.Log.Infof("Memory dump VMI %s", name)
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("memorydump").
+		Do(ctx).
+		Error()
+}
+
+// MemoryDumpStream streams the memory dump of the VMI starting at the given offset.
+// A conflict error is returned while the memory dump is still in progress.
+func (c *virtualMachineInstances) MemoryDumpStream(ctx context.Context, name string, offset int64) (io.ReadCloser, error) {
+	request := c.GetClient().Get().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("memorydump")
+	if offset > 0 {
+		request = request.SetHeader("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+	return request.Stream(ctx)
+}
+
+// RemoveMemoryDump removes the memory dump of the VMI from the ephemeral storage of its pod
+func (c *virtualMachineInstances) RemoveMemoryDump(ctx context.Context, name string) error {
+	return c.GetClient().Delete().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("memorydump").
+		Do(ctx).
+		Error()
+}
+
 func (c *virtualMachineInstances) Reset(ctx context.Context, name string) error {
 	log.Log.Infof("Reset VMI")
 	return c.GetClient().Put().
@@ -242,6 +303,18 @@ func (c *virtualMachineInstances) SoftReboot(ctx context.Context, name string) e
 		Error()
 }
 
+func (c *virtualMachineInstances) NetworkResync(ctx context.Context, name string) error {
+	log.Log.Infof("NetworkResync VMI")
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("networkresync").
+		Do(ctx).
+		Error()
+}
+
 func (c *virtualMachineInstances) GuestOsInfo(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestAgentInfo, error) {
 	guestInfo := v1.VirtualMachineInstanceGuestAgentInfo{}
 	// WORKAROUND:
@@ -422,6 +495,23 @@ func (c *virtualMachineInstances) SEVSetupSession(ctx context.Context, name stri
 		Error()
 }
 
+func (c *virtualMachineInstances) SEVSetupSNPSession(ctx context.Context, name string, sevSNPSessionOptions *v1.SEVSNPSessionOptions) error {
+	body, err := json.Marshal(sevSNPSessionOptions)
+	if err != nil {
+		return fmt.Errorf("cannot Marshal to json: %s", err)
+	}
+
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("sev", "setupsnpsession").
+		Body(body).
+		Do(ctx).
+		Error()
+}
+
 func (c *virtualMachineInstances) SEVInjectLaunchSecret(ctx context.Context, name string, sevSecretOptions *v1.SEVSecretOptions) error {
 	body, err := json.Marshal(sevSecretOptions)
 	if err != nil {
@@ -454,3 +544,72 @@ func (c *virtualMachineInstances) EvacuateCancel(ctx context.Context, name strin
 		Do(ctx).
 		Error()
 }
+
+func (c *virtualMachineInstances) DebugAttach(ctx context.Context, name string, debugAttachOptions *v1.DebugAttachOptions) (v1.DebugAttachResult, error) {
+	result := v1.DebugAttachResult{}
+	body, err := json.Marshal(debugAttachOptions)
+	if err != nil {
+		return result, err
+	}
+
+	rawResult, err := c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("debugattach").
+		Body(body).
+		Do(ctx).
+		Raw()
+	if err != nil {
+		return result, err
+	}
+
+	err = json.Unmarshal(rawResult, &result)
+	return result, err
+}
+
+func (c *virtualMachineInstances) DomainJobs(ctx context.Context, name string) (v1.VirtualMachineInstanceDomainJobList, error) {
+	jobList := v1.VirtualMachineInstanceDomainJobList{}
+	err := c.GetClient().Get().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("domainjobs").
+		Do(ctx).
+		Into(&jobList)
+
+	return jobList, err
+}
+
+func (c *virtualMachineInstances) CancelDomainJob(ctx context.Context, name string, cancelDomainJobOptions *v1.CancelDomainJobOptions) error {
+	body, err := json.Marshal(cancelDomainJobOptions)
+	if err != nil {
+		return err
+	}
+
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("canceldomainjob").
+		Body(body).
+		Do(ctx).
+		Error()
+}
+
+func (c *virtualMachineInstances) QemuCommandLine(ctx context.Context, name string) (v1.VirtualMachineInstanceQemuCommandLine, error) {
+	commandLine := v1.VirtualMachineInstanceQemuCommandLine{}
+	err := c.GetClient().Get().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("qemucommandline").
+		Do(ctx).
+		Into(&commandLine)
+
+	return commandLine, err
+}
@@ -429,6 +429,10 @@ var _ = Describe("[rfe_id:500][crit:high][vendor:cnv-qe@redhat.com][level:compon
 				"virtualmachineinstances", "softreboot",
 				allowUpdateFor("admin", "edit"),
 				denyAllFor("view", "migrate", "default")),
+			Entry("on vmi networkresync",
+				"virtualmachineinstances", "networkresync",
+				allowUpdateFor("admin", "edit"),
+				denyAllFor("view", "migrate", "default")),
 			Entry("on vmi portforward",
 				"virtualmachineinstances", "portforward",
 				allowGetFor("admin", "edit"),
@@ -452,6 +456,10 @@ var _ = Describe("[rfe_id:500][crit:high][vendor:cnv-qe@redhat.com][level:compon
 				"virtualmachineinstances", "sev/setupsession",
 				allowUpdateFor("admin", "edit"),
 				denyAllFor("migrate", "default")),
+			Entry("on vmi sev/setupsnpsession",
+				"virtualmachineinstances", "sev/setupsnpsession",
+				allowUpdateFor("admin", "edit"),
+				denyAllFor("migrate", "default")),
 			Entry("on vmi sev/injectlaunchsecret",
 				"virtualmachineinstances", "sev/injectlaunchsecret",
 				allowUpdateFor("admin", "edit"),
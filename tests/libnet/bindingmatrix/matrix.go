@@ -0,0 +1,178 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Package bindingmatrix generates combinations of secondary interface bindings and
+// interface features, so a single table driven test can cover all of them and
+// surface the combinations which are not supported.
+package bindingmatrix
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+)
+
+type Binding string
+
+const (
+	Bridge    Binding = "bridge"
+	SRIOV     Binding = "sriov"
+	VDPA      Binding = "vdpa"
+	VhostUser Binding = "vhostuser"
+)
+
+// IsPlugin reports whether the binding is provided by a network binding plugin,
+// which needs to be registered in the KubeVirt CR.
+func (b Binding) IsPlugin() bool {
+	return b == VDPA || b == VhostUser
+}
+
+// Combination describes a single secondary interface setup to be tested.
+type Combination struct {
+	Binding    Binding
+	Model      string
+	MultiQueue bool
+	Hotplug    bool
+	Migration  bool
+}
+
+// Dimensions lists the values of each axis the combinations are generated from.
+type Dimensions struct {
+	Bindings   []Binding
+	Models     []string
+	MultiQueue []bool
+	Hotplug    []bool
+	Migration  []bool
+}
+
+// DefaultDimensions covers every known binding with every interface feature.
+func DefaultDimensions() Dimensions {
+	return Dimensions{
+		Bindings:   []Binding{Bridge, SRIOV, VDPA, VhostUser},
+		Models:     []string{v1.VirtIO, "e1000e"},
+		MultiQueue: []bool{false, true},
+		Hotplug:    []bool{false, true},
+		Migration:  []bool{false, true},
+	}
+}
+
+// Generate returns the cartesian product of the given dimensions.
+// SR-IOV interfaces are passed through to the guest and have no emulated model,
+// therefore the model axis is collapsed for them.
+func Generate(dimensions Dimensions) []Combination {
+	var combinations []Combination
+	for _, binding := range dimensions.Bindings {
+		models := dimensions.Models
+		if binding == SRIOV {
+			models = []string{""}
+		}
+		for _, model := range models {
+			for _, multiQueue := range dimensions.MultiQueue {
+				for _, hotplug := range dimensions.Hotplug {
+					for _, migration := range dimensions.Migration {
+						combinations = append(combinations, Combination{
+							Binding:    binding,
+							Model:      model,
+							MultiQueue: multiQueue,
+							Hotplug:    hotplug,
+							Migration:  migration,
+						})
+					}
+				}
+			}
+		}
+	}
+	return combinations
+}
+
+// Entries wraps each combination in a table entry, to be consumed by DescribeTable.
+// The entry body receives the Combination as its single parameter.
+// When provided, decoratorsFor returns the decorators (e.g. labels) of each entry.
+func Entries(combinations []Combination, decoratorsFor func(Combination) []interface{}) []TableEntry {
+	var entries []TableEntry
+	for _, combination := range combinations {
+		var args []interface{}
+		if decoratorsFor != nil {
+			args = append(args, decoratorsFor(combination)...)
+		}
+		args = append(args, combination)
+		entries = append(entries, Entry(combination.String(), args...))
+	}
+	return entries
+}
+
+func (c Combination) String() string {
+	parts := []string{string(c.Binding)}
+	if c.Model != "" {
+		parts = append(parts, c.Model)
+	}
+	if c.MultiQueue {
+		parts = append(parts, "multiqueue")
+	}
+	if c.Hotplug {
+		parts = append(parts, "hotplug")
+	}
+	if c.Migration {
+		parts = append(parts, "migration")
+	}
+	return strings.Join(parts, "/")
+}
+
+// Interface returns the secondary interface spec matching the combination.
+func (c Combination) Interface(name string) v1.Interface {
+	var iface v1.Interface
+	switch c.Binding {
+	case Bridge:
+		iface = libvmi.InterfaceDeviceWithBridgeBinding(name)
+	case SRIOV:
+		iface = libvmi.InterfaceDeviceWithSRIOVBinding(name)
+	default:
+		iface = libvmi.InterfaceWithBindingPlugin(name, v1.PluginBinding{Name: string(c.Binding)})
+	}
+	if c.Model != "" {
+		iface = libvmi.InterfaceWithModel(iface, c.Model)
+	}
+	return iface
+}
+
+// VMIOptions returns the options applying the combination on a VMI connected to the given
+// network attachment definition.
+// When the combination requires hotplug, the secondary interface is left out and is
+// expected to be added to the running VM using the Interface and Network specs.
+func (c Combination) VMIOptions(networkName, netAttachDefName string) []libvmi.Option {
+	var opts []libvmi.Option
+	if !c.Hotplug {
+		opts = append(opts,
+			libvmi.WithInterface(c.Interface(networkName)),
+			libvmi.WithNetwork(libvmi.MultusNetwork(networkName, netAttachDefName)),
+		)
+	}
+	if c.MultiQueue {
+		const multiQueueCPUs = 2
+		opts = append(opts,
+			libvmi.WithNetworkInterfaceMultiQueue(true),
+			libvmi.WithCPUCount(multiQueueCPUs, 1, 1),
+		)
+	}
+	return opts
+}
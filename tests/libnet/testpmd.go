@@ -0,0 +1,116 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package libnet
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/tests/console"
+)
+
+type TestpmdForwardMode string
+
+const (
+	TestpmdTxOnly TestpmdForwardMode = "txonly"
+	TestpmdRxOnly TestpmdForwardMode = "rxonly"
+
+	testpmdLogPath = "/tmp/testpmd.log"
+)
+
+// DPDKPort describes a guest interface handed over to DPDK.
+type DPDKPort struct {
+	PCIAddress string
+	MAC        string
+}
+
+// BindToDPDK detaches the guest interface from the kernel and binds it to vfio-pci,
+// reserving the hugepages DPDK requires.
+// The guest image is expected to ship dpdk-devbind.py and dpdk-testpmd.
+func BindToDPDK(vmi *v1.VirtualMachineInstance, interfaceName string) (DPDKPort, error) {
+	const timeout = 30 * time.Second
+
+	var port DPDKPort
+	var err error
+	port.PCIAddress, err = console.RunCommandAndStoreOutput(vmi, fmt.Sprintf("basename $(readlink /sys/class/net/%s/device)", interfaceName), timeout)
+	if err != nil {
+		return DPDKPort{}, fmt.Errorf("could not read the PCI address of interface %s on VMI %s: %w", interfaceName, vmi.Name, err)
+	}
+	port.MAC, err = console.RunCommandAndStoreOutput(vmi, fmt.Sprintf("cat /sys/class/net/%s/address", interfaceName), timeout)
+	if err != nil {
+		return DPDKPort{}, fmt.Errorf("could not read the MAC address of interface %s on VMI %s: %w", interfaceName, vmi.Name, err)
+	}
+
+	for _, cmd := range []string{
+		"echo 512 > /sys/kernel/mm/hugepages/hugepages-2048kB/nr_hugepages",
+		// The guest has no vIOMMU, therefore vfio is used in no-IOMMU mode
+		"modprobe vfio enable_unsafe_noiommu_mode=1 && modprobe vfio-pci",
+		fmt.Sprintf("dpdk-devbind.py --bind=vfio-pci %s", port.PCIAddress),
+	} {
+		if err := console.RunCommand(vmi, cmd, timeout); err != nil {
+			return DPDKPort{}, fmt.Errorf("could not bind interface %s to DPDK on VMI %s: %w", interfaceName, vmi.Name, err)
+		}
+	}
+	return port, nil
+}
+
+// StartTestpmd runs DPDK testpmd in the background on the given port, printing the
+// port statistics every second. In txonly mode the packets are sent to peerMAC.
+func StartTestpmd(vmi *v1.VirtualMachineInstance, port DPDKPort, forwardMode TestpmdForwardMode, peerMAC string) error {
+	const timeout = 15 * time.Second
+
+	args := []string{"--forward-mode=" + string(forwardMode), "--auto-start", "--stats-period=1"}
+	if peerMAC != "" {
+		args = append(args, "--eth-peer=0,"+peerMAC)
+	}
+	cmd := fmt.Sprintf("nohup dpdk-testpmd -l 0-1 -a %s -- %s > %s 2>&1 &", port.PCIAddress, strings.Join(args, " "), testpmdLogPath)
+	if err := console.RunCommand(vmi, cmd, timeout); err != nil {
+		return fmt.Errorf("could not start testpmd on VMI %s: %w", vmi.Name, err)
+	}
+	return nil
+}
+
+// TestpmdRxPackets returns the number of packets received by the testpmd port so far.
+func TestpmdRxPackets(vmi *v1.VirtualMachineInstance) (uint64, error) {
+	return testpmdCounter(vmi, "RX-packets")
+}
+
+// TestpmdTxPackets returns the number of packets sent by the testpmd port so far.
+func TestpmdTxPackets(vmi *v1.VirtualMachineInstance) (uint64, error) {
+	return testpmdCounter(vmi, "TX-packets")
+}
+
+func testpmdCounter(vmi *v1.VirtualMachineInstance, counter string) (uint64, error) {
+	const timeout = 15 * time.Second
+	cmd := fmt.Sprintf("grep -o '%s: *[0-9]*' %s | tail -1 | awk '{print $2}'", counter, testpmdLogPath)
+	output, err := console.RunCommandAndStoreOutput(vmi, cmd, timeout)
+	if err != nil {
+		return 0, fmt.Errorf("could not read the testpmd %s on VMI %s: %w", counter, vmi.Name, err)
+	}
+	packets, err := strconv.ParseUint(strings.TrimSpace(output), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("unexpected testpmd %s on VMI %s: %q", counter, vmi.Name, output)
+	}
+	return packets, nil
+}
@@ -0,0 +1,260 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package network
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/tests/console"
+	"kubevirt.io/kubevirt/tests/decorators"
+	"kubevirt.io/kubevirt/tests/flags"
+	"kubevirt.io/kubevirt/tests/framework/kubevirt"
+	"kubevirt.io/kubevirt/tests/framework/matcher"
+	"kubevirt.io/kubevirt/tests/libkubevirt"
+	"kubevirt.io/kubevirt/tests/libkubevirt/config"
+	"kubevirt.io/kubevirt/tests/libmigration"
+	"kubevirt.io/kubevirt/tests/libnet"
+	"kubevirt.io/kubevirt/tests/libnet/bindingmatrix"
+	"kubevirt.io/kubevirt/tests/libvmifact"
+	"kubevirt.io/kubevirt/tests/libwait"
+	"kubevirt.io/kubevirt/tests/testsuite"
+)
+
+var _ = Describe(SIG("interface binding matrix", Serial, func() {
+	const (
+		networkName    = "matrixnet"
+		guestIfaceName = "eth1"
+		subnetMask     = "/24"
+		serverIP       = "10.1.3.1"
+		clientIP       = "10.1.3.2"
+	)
+
+	BeforeEach(func() {
+		err := config.RegisterKubevirtConfigChange(
+			config.WithWorkloadUpdateStrategy(&v1.KubeVirtWorkloadUpdateStrategy{
+				WorkloadUpdateMethods: []v1.WorkloadUpdateMethod{v1.WorkloadUpdateMethodLiveMigrate},
+			}),
+			config.WithVMRolloutStrategy(pointer.P(v1.VMRolloutStrategyLiveUpdate)),
+		)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	DescribeTable("should provide connectivity over the secondary network", func(combination bindingmatrix.Combination) {
+		nadName := prepareMatrixNetwork(combination.Binding)
+		namespace := testsuite.GetTestNamespace(nil)
+
+		By("Starting a client VM applying the combination: " + combination.String())
+		opts := append([]libvmi.Option{
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+			libvmi.WithNetwork(v1.DefaultPodNetwork()),
+		}, combination.VMIOptions(networkName, nadName)...)
+		clientVM := libvmi.NewVirtualMachine(libvmifact.NewAlpineWithTestTooling(opts...), libvmi.WithRunStrategy(v1.RunStrategyAlways))
+		clientVM, err := kubevirt.Client().VirtualMachine(namespace).Create(context.Background(), clientVM, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Eventually(matcher.ThisVM(clientVM)).WithTimeout(6 * time.Minute).WithPolling(3 * time.Second).
+			Should(matcher.HaveConditionTrue(v1.VirtualMachineInstanceAgentConnected))
+		clientVMI, err := kubevirt.Client().VirtualMachineInstance(namespace).Get(context.Background(), clientVM.Name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(console.LoginToAlpine(clientVMI)).To(Succeed())
+
+		if combination.Hotplug {
+			By("Hotplugging the secondary interface")
+			Expect(libnet.PatchVMWithNewInterface(
+				clientVM, *libvmi.MultusNetwork(networkName, nadName), combination.Interface(networkName),
+			)).To(Succeed())
+			libnet.WaitForSingleHotPlugIfaceOnVMISpec(clientVMI, networkName, nadName)
+			Eventually(func() error {
+				return libnet.InterfaceExists(clientVMI, guestIfaceName)
+			}).WithTimeout(5 * time.Minute).WithPolling(5 * time.Second).Should(Succeed())
+		}
+
+		Expect(libnet.AddIPAddress(clientVMI, guestIfaceName, clientIP+subnetMask)).To(Succeed())
+		Expect(libnet.SetInterfaceUp(clientVMI, guestIfaceName)).To(Succeed())
+
+		By("Starting a server VMI on the client node, connected to the secondary network")
+		serverVMI := libvmifact.NewAlpineWithTestTooling(
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+			libvmi.WithNetwork(v1.DefaultPodNetwork()),
+			libvmi.WithInterface(bindingmatrix.Combination{Binding: combination.Binding}.Interface(networkName)),
+			libvmi.WithNetwork(libvmi.MultusNetwork(networkName, nadName)),
+			libvmi.WithNodeAffinityFor(clientVMI.Status.NodeName),
+		)
+		serverVMI, err = kubevirt.Client().VirtualMachineInstance(namespace).Create(context.Background(), serverVMI, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		serverVMI = libwait.WaitUntilVMIReady(serverVMI, console.LoginToAlpine)
+		Expect(libnet.AddIPAddress(serverVMI, guestIfaceName, serverIP+subnetMask)).To(Succeed())
+		Expect(libnet.SetInterfaceUp(serverVMI, guestIfaceName)).To(Succeed())
+
+		By("Checking connectivity between the VMIs")
+		Expect(libnet.PingFromVMConsole(clientVMI, serverIP)).To(Succeed())
+
+		if combination.Migration {
+			// The server is pinned to the migration source node, which is not necessarily
+			// reachable from the target node over the secondary network, therefore only
+			// the presence of the interface is verified after the migration.
+			By("Migrating the client VMI")
+			migration := libmigration.New(clientVMI.Name, clientVMI.Namespace)
+			migration = libmigration.RunMigrationAndExpectToCompleteWithDefaultTimeout(kubevirt.Client(), migration)
+			clientVMI = libmigration.ConfirmVMIPostMigration(kubevirt.Client(), clientVMI, migration)
+			Expect(console.LoginToAlpine(clientVMI)).To(Succeed())
+			Expect(libnet.InterfaceExists(clientVMI, guestIfaceName)).To(Succeed())
+		}
+	}, bindingmatrix.Entries(bindingmatrix.Generate(bindingmatrix.DefaultDimensions()), matrixEntryDecorators))
+
+	It("should forward DPDK testpmd traffic over vDPA interfaces", decorators.NetCustomBindingPlugins, func() {
+		if flags.DPDKGuestImage == "" {
+			Skip("DPDK guest image is not provided")
+		}
+		const measurementPeriod = 10 * time.Second
+
+		nadName := prepareMatrixNetwork(bindingmatrix.VDPA)
+		namespace := testsuite.GetTestNamespace(nil)
+		vdpaIface := bindingmatrix.Combination{Binding: bindingmatrix.VDPA}.Interface(networkName)
+
+		By("Starting the receiver and sender VMIs on the same node")
+		receiverVMI, err := kubevirt.Client().VirtualMachineInstance(namespace).Create(
+			context.Background(), newDPDKVMI(vdpaIface, nadName), metav1.CreateOptions{},
+		)
+		Expect(err).ToNot(HaveOccurred())
+		receiverVMI = libwait.WaitUntilVMIReady(receiverVMI, console.LoginToFedora)
+
+		senderVMI, err := kubevirt.Client().VirtualMachineInstance(namespace).Create(
+			context.Background(), newDPDKVMI(vdpaIface, nadName, libvmi.WithNodeAffinityFor(receiverVMI.Status.NodeName)), metav1.CreateOptions{},
+		)
+		Expect(err).ToNot(HaveOccurred())
+		senderVMI = libwait.WaitUntilVMIReady(senderVMI, console.LoginToFedora)
+
+		By("Binding the vDPA interfaces to DPDK")
+		receiverPort, err := libnet.BindToDPDK(receiverVMI, guestIfaceName)
+		Expect(err).ToNot(HaveOccurred())
+		senderPort, err := libnet.BindToDPDK(senderVMI, guestIfaceName)
+		Expect(err).ToNot(HaveOccurred())
+
+		By("Running testpmd in the receiver and sender VMIs")
+		Expect(libnet.StartTestpmd(receiverVMI, receiverPort, libnet.TestpmdRxOnly, "")).To(Succeed())
+		Expect(libnet.StartTestpmd(senderVMI, senderPort, libnet.TestpmdTxOnly, receiverPort.MAC)).To(Succeed())
+
+		By("Checking the receiver gets the traffic of the sender")
+		Eventually(func() (uint64, error) {
+			return libnet.TestpmdRxPackets(receiverVMI)
+		}).WithTimeout(time.Minute).WithPolling(5 * time.Second).Should(BeNumerically(">", 0))
+
+		By("Measuring the throughput of the vDPA datapath")
+		rxStart, err := libnet.TestpmdRxPackets(receiverVMI)
+		Expect(err).ToNot(HaveOccurred())
+		time.Sleep(measurementPeriod)
+		rxEnd, err := libnet.TestpmdRxPackets(receiverVMI)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rxEnd).To(BeNumerically(">", rxStart))
+		AddReportEntry("vDPA testpmd throughput (packets per second)", (rxEnd-rxStart)/uint64(measurementPeriod.Seconds()))
+	})
+}))
+
+// newDPDKVMI returns a VMI booting the DPDK guest image, connected to the secondary network
+// through the given interface. testpmd runs on two vCPUs.
+func newDPDKVMI(secondaryIface v1.Interface, nadName string, opts ...libvmi.Option) *v1.VirtualMachineInstance {
+	const dpdkCPUs = 2
+	dpdkOpts := []libvmi.Option{
+		libvmi.WithMemoryRequest("2Gi"),
+		libvmi.WithRng(),
+		libvmi.WithCPUCount(dpdkCPUs, 1, 1),
+		libvmi.WithContainerDisk("disk0", flags.DPDKGuestImage),
+		libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+		libvmi.WithNetwork(v1.DefaultPodNetwork()),
+		libvmi.WithInterface(secondaryIface),
+		libvmi.WithNetwork(libvmi.MultusNetwork(secondaryIface.Name, nadName)),
+	}
+	return libvmi.New(append(dpdkOpts, opts...)...)
+}
+
+func matrixEntryDecorators(combination bindingmatrix.Combination) []interface{} {
+	var entryDecorators []interface{}
+	switch {
+	case combination.Binding == bindingmatrix.SRIOV:
+		entryDecorators = append(entryDecorators, decorators.SRIOV)
+	case combination.Binding.IsPlugin():
+		entryDecorators = append(entryDecorators, decorators.NetCustomBindingPlugins)
+	default:
+		entryDecorators = append(entryDecorators, decorators.Multus)
+	}
+	if combination.Hotplug {
+		entryDecorators = append(entryDecorators, decorators.InPlaceHotplugNICs)
+	}
+	if combination.Migration {
+		entryDecorators = append(entryDecorators, decorators.RequiresTwoSchedulableNodes)
+	}
+	return entryDecorators
+}
+
+// prepareMatrixNetwork makes the secondary network of the given binding available in the test
+// namespace, returning the network attachment definition name.
+// The test is skipped when the cluster lacks what the binding requires.
+// Binding plugins are expected to be registered in the KubeVirt CR by the lane, together with
+// a network attachment definition named after the plugin in the test namespace.
+func prepareMatrixNetwork(binding bindingmatrix.Binding) string {
+	namespace := testsuite.GetTestNamespace(nil)
+
+	switch binding {
+	case bindingmatrix.Bridge:
+		const bridgeNADName = "matrix-bridge"
+		_, err := libnet.CreateNetAttachDef(context.Background(), namespace, libnet.NewBridgeNetAttachDef(bridgeNADName, linuxBridgeName))
+		Expect(err).NotTo(HaveOccurred())
+		return bridgeNADName
+	case bindingmatrix.SRIOV:
+		const sriovNADName = "matrix-sriov"
+		sriovResourceName := readSRIOVResourceName()
+		if err := validateSRIOVSetup(sriovResourceName, 1); err != nil {
+			Skip(err.Error())
+		}
+		netAttachDef := libnet.NewSriovNetAttachDef(sriovNADName, defaultVLAN)
+		netAttachDef.Annotations = map[string]string{libnet.ResourceNameAnnotation: sriovResourceName}
+		_, err := libnet.CreateNetAttachDef(context.Background(), namespace, netAttachDef)
+		Expect(err).NotTo(HaveOccurred())
+		return sriovNADName
+	default:
+		kv := libkubevirt.GetCurrentKv(kubevirt.Client())
+		netConfig := kv.Spec.Configuration.NetworkConfiguration
+		if netConfig == nil || netConfig.Binding == nil {
+			Skip("binding plugin " + string(binding) + " is not registered")
+		}
+		if _, exists := netConfig.Binding[string(binding)]; !exists {
+			Skip("binding plugin " + string(binding) + " is not registered")
+		}
+		_, err := kubevirt.Client().NetworkClient().K8sCniCncfIoV1().NetworkAttachmentDefinitions(namespace).Get(
+			context.Background(), string(binding), metav1.GetOptions{},
+		)
+		if k8serrors.IsNotFound(err) {
+			Skip("network attachment definition " + string(binding) + " is not provisioned")
+		}
+		Expect(err).NotTo(HaveOccurred())
+		return string(binding)
+	}
+}
@@ -0,0 +1,7 @@
+import sys
+import xml.etree.ElementTree as ET
+
+domain = ET.fromstring(sys.stdin.read())
+entry = ET.SubElement(domain.find("sysinfo/baseBoard"), "entry", {"name": "manufacturer"})
+entry.text = "Radical Edward"
+print(ET.tostring(domain, encoding="unicode"))
@@ -61,11 +61,15 @@ const (
 	sidecarShimImage     = "sidecar-shim"
 	sidecarContainerName = "hook-sidecar-0"
 	configMapKey         = "my_script"
+	scriptHookKey        = "my_script.py"
 )
 
 //go:embed testdata/sidecar-hook-configmap.sh
 var configMapData string
 
+//go:embed testdata/sidecar-hook-script.py
+var scriptHookData string
+
 var _ = Describe("[sig-compute]HookSidecars", decorators.SigCompute, func() {
 
 	var (
@@ -286,6 +290,27 @@ var _ = Describe("[sig-compute]HookSidecars", decorators.SigCompute, func() {
 			)
 		})
 
+		Context("with script hook in sidecar hook annotation", func() {
+
+			It("should update domain XML with SM BIOS properties using the built-in python interpreter", func() {
+				cm := RenderConfigMap()
+				cm.Data = map[string]string{scriptHookKey: scriptHookData}
+				cm, err := virtClient.CoreV1().ConfigMaps(testsuite.GetTestNamespace(vmi)).Create(context.TODO(), cm, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				vmi.ObjectMeta.Annotations = RenderSidecarWithScriptHook(cm.Name)
+				vmi = libvmops.RunVMIAndExpectLaunch(vmi, libvmops.StartupTimeoutSecondsXHuge)
+
+				By("Logging in to the guest")
+				Expect(console.LoginToAlpine(vmi)).To(Succeed())
+
+				By("Verifying SMBIOS baseboard manufacturer from inside the guest")
+				Expect(console.SafeExpectBatch(vmi, []expect.Batcher{
+					&expect.BSnd{S: "cat /sys/class/dmi/id/board_vendor\n"},
+					&expect.BExp{R: "Radical Edward"},
+				}, 30)).To(Succeed(), "SMBIOS baseboard manufacturer should match the configured value")
+			})
+		})
+
 	})
 })
 
@@ -335,6 +360,13 @@ func RenderSidecarWithConfigMapWithoutImage(version, name string) map[string]str
 	}
 }
 
+func RenderSidecarWithScriptHook(name string) map[string]string {
+	return map[string]string{
+		"hooks.kubevirt.io/hookSidecars": fmt.Sprintf(`[{"script": {"configMapName": "%s", "key": "%s", "hook": "onDefineDomain", "interpreter": "python3"}}]`,
+			name, scriptHookKey),
+	}
+}
+
 func RenderConfigMap() *k8sv1.ConfigMap {
 	return &k8sv1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{